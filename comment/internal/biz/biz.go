@@ -3,11 +3,24 @@ package biz
 type Biz struct {
 	CommentBiz
 	CommentInteractBiz
+	CommentTrendBiz
+	CommentReportBiz
+	CommentEmojiBiz
+	CommentSettingBiz
 }
 
 func New() Biz {
+	interactBiz := NewCommentInteractBiz()
+	trendBiz := NewCommentTrendBiz(interactBiz)
+	emojiBiz := NewCommentEmojiBiz()
+	settingBiz := NewCommentSettingBiz()
+	commentBiz := NewCommentBiz(trendBiz, emojiBiz, settingBiz)
 	return Biz{
-		CommentBiz:         NewCommentBiz(),
-		CommentInteractBiz: NewCommentInteractBiz(),
+		CommentBiz:         commentBiz,
+		CommentInteractBiz: interactBiz,
+		CommentTrendBiz:    trendBiz,
+		CommentReportBiz:   NewCommentReportBiz(commentBiz),
+		CommentEmojiBiz:    emojiBiz,
+		CommentSettingBiz:  settingBiz,
 	}
 }
@@ -22,21 +22,33 @@ import (
 	"github.com/ryanreadbooks/whimer/misc/xsql"
 )
 
-type CommentBiz struct{}
+type CommentBiz struct {
+	trendBiz   CommentTrendBiz
+	emojiBiz   CommentEmojiBiz
+	settingBiz CommentSettingBiz
+}
 
 // 评论基础功能领域
-func NewCommentBiz() CommentBiz {
-	return CommentBiz{}
+func NewCommentBiz(trendBiz CommentTrendBiz, emojiBiz CommentEmojiBiz, settingBiz CommentSettingBiz) CommentBiz {
+	return CommentBiz{
+		trendBiz:   trendBiz,
+		emojiBiz:   emojiBiz,
+		settingBiz: settingBiz,
+	}
 }
 
-func (b *CommentBiz) addCommentAssets(ctx context.Context, newCommentId int64, req *model.AddCommentReq) error {
-	if req.Type == model.CommentImageText {
-		// 插入评论图片资源
-		assets := makeCommentAssetPO(newCommentId, req.Images)
-		err := infra.Dao().CommentAssetDao.BatchInsert(ctx, assets)
-		if err != nil {
-			return xerror.Wrapf(err, "comment biz batch insert image assets failed")
-		}
+// 插入评论的图片和表情资源
+func (b *CommentBiz) addCommentAssets(ctx context.Context, newCommentId int64,
+	typ model.CommentType, images []*commentv1.CommentReqImage, emojis []*model.EmojiItem) error {
+	var assets []*dao.CommentAsset
+	if typ == model.CommentImageText {
+		assets = append(assets, makeCommentAssetPO(newCommentId, images)...)
+	}
+	assets = append(assets, makeEmojiAssetPO(newCommentId, emojis)...)
+
+	err := infra.Dao().CommentAssetDao.BatchInsert(ctx, assets)
+	if err != nil {
+		return xerror.Wrapf(err, "comment biz batch insert assets failed")
 	}
 
 	return nil
@@ -68,6 +80,11 @@ func (b *CommentBiz) AddComment(ctx context.Context, req *model.AddCommentReq) (
 		ip       = xnet.IpAsBytes(metadata.ClientIp(ctx))
 	)
 
+	// 被禁止评论的用户不能发表评论
+	if err := b.checkUserBanned(ctx, uid); err != nil {
+		return nil, err
+	}
+
 	// 必须笔记存在才可以添加评论
 	noteExitsRes, err := dep.GetNoter().IsNoteExist(ctx,
 		&notev1.IsNoteExistRequest{
@@ -83,6 +100,23 @@ func (b *CommentBiz) AddComment(ctx context.Context, req *model.AddCommentReq) (
 		return nil, global.ErrNoNote
 	}
 
+	// 作者的评论设置 命中屏蔽词的评论等待作者审核
+	held, err := b.settingBiz.CheckAddable(ctx, uid, oid, req.Content)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "comment biz check setting failed").WithCtx(ctx)
+	}
+
+	state := model.CommentStateNormal
+	if held {
+		state = model.CommentStateHeld
+	}
+
+	// 内容中引用的表情必须存在
+	emojis, err := b.emojiBiz.ResolveContent(ctx, req.Content)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "comment biz resolve emojis failed").WithCtx(ctx)
+	}
+
 	var newCommentId int64
 
 	now := time.Now().Unix()
@@ -94,7 +128,7 @@ func (b *CommentBiz) AddComment(ctx context.Context, req *model.AddCommentReq) (
 		RootId:   rootId,
 		ParentId: parentId,
 		ReplyUid: req.ReplyUid,
-		State:    int8(model.CommentStateNormal),
+		State:    int8(state),
 		Ip:       ip,
 		Ctime:    now,
 		Mtime:    now,
@@ -117,7 +151,7 @@ func (b *CommentBiz) AddComment(ctx context.Context, req *model.AddCommentReq) (
 				return xerror.Wrapf(err, "comment biz insert root comment failed")
 			}
 
-			if err := b.addCommentAssets(ctx, newCommentId, req); err != nil {
+			if err := b.addCommentAssets(ctx, newCommentId, req.Type, req.Images, emojis); err != nil {
 				return err
 			}
 
@@ -142,7 +176,7 @@ func (b *CommentBiz) AddComment(ctx context.Context, req *model.AddCommentReq) (
 				return xerror.Wrapf(err, "comment biz dao insert comment failed")
 			}
 
-			if err := b.addCommentAssets(ctx, newCommentId, req); err != nil {
+			if err := b.addCommentAssets(ctx, newCommentId, req.Type, req.Images, emojis); err != nil {
 				return err
 			}
 
@@ -160,13 +194,16 @@ func (b *CommentBiz) AddComment(ctx context.Context, req *model.AddCommentReq) (
 		return nil, xerror.Wrapf(err, "comment biz failed to insert comment")
 	}
 
-	concurrent.DoneIn(10*time.Second, func(ctx context.Context) {
-		if err := infra.Dao().CommentDao.IncrCommentCount(ctx, oid); err != nil {
-			xlog.Msg("comment biz incr comment count failed").Err(err).Extras("oid", oid).Errorx(ctx)
-		}
-	})
+	// 等待审核的评论在通过后才计数
+	if !held {
+		concurrent.DoneIn(10*time.Second, func(ctx context.Context) {
+			if err := infra.Dao().CommentDao.IncrCommentCount(ctx, oid); err != nil {
+				xlog.Msg("comment biz incr comment count failed").Err(err).Extras("oid", oid).Errorx(ctx)
+			}
+		})
+	}
 
-	return &model.AddCommentRes{Uid: uid, CommentId: newCommentId}, nil
+	return &model.AddCommentRes{Uid: uid, CommentId: newCommentId, Held: held}, nil
 }
 
 func (b *CommentBiz) findByIdForUpdate(ctx context.Context, commentId int64) (*model.CommentItem, error) {
@@ -181,7 +218,26 @@ func (b *CommentBiz) findByIdForUpdate(ctx context.Context, commentId int64) (*m
 	return NewCommentItemFromDao(c), nil
 }
 
+// 检查用户是否被禁止发表评论
+func (b *CommentBiz) checkUserBanned(ctx context.Context, uid int64) error {
+	ban, err := infra.Dao().CommentBanDao.Get(ctx, uid)
+	if err != nil {
+		if xsql.IsNoRecord(err) {
+			return nil
+		}
+		return xerror.Wrapf(err, "comment biz get user ban failed").WithExtra("uid", uid).WithCtx(ctx)
+	}
+
+	if model.IsBanActive(ban.ExpireAt, time.Now()) {
+		return xerror.Wrap(global.ErrUserBanned)
+	}
+
+	return nil
+}
+
 // 检查是否能够发布子评论
+//
+// 被隐藏或者等待作者审核的评论不能回复
 func (b *CommentBiz) isCommentAddable(ctx context.Context, rootId, parentId int64) error {
 	// 两个都需要检查
 	if rootId != 0 {
@@ -193,15 +249,20 @@ func (b *CommentBiz) isCommentAddable(ctx context.Context, rootId, parentId int6
 		if !root.IsRoot() {
 			return xerror.Wrap(global.ErrRootCommentIsNotRoot)
 		}
-		return nil
+		if !root.IsVisible() {
+			return xerror.Wrap(global.ErrCommentNotReplyable)
+		}
 	}
 
+	// 回复的是root下的子评论时 子评论本身也需要可见
 	if parentId != 0 && rootId != parentId {
-		_, err := b.findByIdForUpdate(ctx, parentId)
+		parent, err := b.findByIdForUpdate(ctx, parentId)
 		if err != nil {
 			return xerror.Wrap(err)
 		}
-		return nil
+		if !parent.IsVisible() {
+			return xerror.Wrap(global.ErrCommentNotReplyable)
+		}
 	}
 
 	return nil
@@ -227,68 +288,89 @@ func (b *CommentBiz) DelComment(ctx context.Context, oid, commentId int64) error
 		return xerror.Wrapf(err, "comment biz check comment is not deletable")
 	}
 
-	// 开始删除
-	// 删除的如果是主评论 需要一并删除所有子评论
-	// 否则就只删除评论本身
-	// 删除子评论的子评论也只是只删除评论本身
 	err = infra.Dao().Transact(ctx, func(ctx context.Context) error {
-		_, err := b.findByIdForUpdate(ctx, commentId)
+		return b.deleteComment(ctx, existingComment)
+	})
+
+	if err != nil {
+		return xerror.Wrapf(err, "comment biz failed to delete comment")
+	}
+
+	// 缓存中减少一个 等待审核的评论没有计数
+	if existingComment.State != model.CommentStateHeld {
+		concurrent.DoneIn(10*time.Second, func(ctx context.Context) {
+			infra.Dao().CommentDao.DecrCommentCount(ctx, existingComment.Oid)
+		})
+	}
+
+	return nil
+}
+
+// 删除评论 需要在事务中执行
+//
+// 删除的如果是主评论 需要一并删除所有子评论
+// 否则就只删除评论本身
+// 删除子评论的子评论也只是只删除评论本身
+func (b *CommentBiz) deleteComment(ctx context.Context, existingComment *model.CommentItem) error {
+	commentId := existingComment.Id
+	_, err := b.findByIdForUpdate(ctx, commentId)
+	if err != nil {
+		return xerror.Wrapf(err, "comment biz find by for update failed")
+	}
+
+	// 先将评论本身删掉
+	err = infra.Dao().CommentDao.DeleteById(ctx, commentId)
+	if err != nil {
+		return xerror.Wrapf(err, "comment biz dao delete root by id failed")
+	}
+
+	// 删除的是主评论
+	if model.IsRoot(existingComment.RootId, existingComment.ParentId) {
+		// 删除评论下的资源
+		err = infra.Dao().CommentAssetDao.BatchDeleteByRoot(ctx, commentId)
 		if err != nil {
-			return xerror.Wrapf(err, "comment biz find by for update failed")
+			return xerror.Wrapf(err, "comment biz dao delete asset by root failed")
 		}
 
-		// 先将评论本身删掉
-		err = infra.Dao().CommentDao.DeleteById(ctx, commentId)
+		// 删除历史版本
+		err = infra.Dao().CommentEditHistoryDao.BatchDeleteByRoot(ctx, commentId)
 		if err != nil {
-			return xerror.Wrapf(err, "comment biz dao delete root by id failed")
+			return xerror.Wrapf(err, "comment biz dao delete edit history by root failed")
 		}
 
-		// 删除的是主评论
-		if model.IsRoot(existingComment.RootId, existingComment.ParentId) {
-			// 删除评论下的资源
-			err = infra.Dao().CommentAssetDao.BatchDeleteByRoot(ctx, commentId)
-			if err != nil {
-				return xerror.Wrapf(err, "comment biz dao delete asset by root failed")
-			}
-
-			// 删除其下子评论
-			err = infra.Dao().CommentDao.DeleteByRoot(ctx, commentId)
-			if err != nil {
-				return xerror.Wrapf(err, "comment biz dao delete comments by rootid failed")
-			}
-
-			// 删除ext
-			err = infra.Dao().CommentExtDao.BatchDeleteByRoot(ctx, commentId)
-			if err != nil {
-				return xerror.Wrapf(err, "comment biz dao delete ext by root failed")
-			}
-
-			return nil
-		} else { // 删除的不是主评论
-			// 删除评论下的资源
-			err = infra.Dao().CommentAssetDao.DeleteByCommentId(ctx, commentId)
-			if err != nil {
-				return xerror.Wrapf(err, "comment biz dao delete asset by comment id failed")
-			}
+		// 删除其下子评论
+		err = infra.Dao().CommentDao.DeleteByRoot(ctx, commentId)
+		if err != nil {
+			return xerror.Wrapf(err, "comment biz dao delete comments by rootid failed")
+		}
 
-			// 删除ext
-			err = infra.Dao().CommentExtDao.Delete(ctx, commentId)
-			if err != nil {
-				return xerror.Wrapf(err, "comment biz dao delete ext failed")
-			}
+		// 删除ext
+		err = infra.Dao().CommentExtDao.BatchDeleteByRoot(ctx, commentId)
+		if err != nil {
+			return xerror.Wrapf(err, "comment biz dao delete ext by root failed")
 		}
 
 		return nil
-	})
+	}
 
+	// 删除的不是主评论
+	// 删除评论下的资源
+	err = infra.Dao().CommentAssetDao.DeleteByCommentId(ctx, commentId)
 	if err != nil {
-		return xerror.Wrapf(err, "comment biz failed to delete comment")
+		return xerror.Wrapf(err, "comment biz dao delete asset by comment id failed")
 	}
 
-	// 缓存中减少一个
-	concurrent.DoneIn(10*time.Second, func(ctx context.Context) {
-		infra.Dao().CommentDao.DecrCommentCount(ctx, existingComment.Oid)
-	})
+	// 删除ext
+	err = infra.Dao().CommentExtDao.Delete(ctx, commentId)
+	if err != nil {
+		return xerror.Wrapf(err, "comment biz dao delete ext failed")
+	}
+
+	// 删除历史版本
+	err = infra.Dao().CommentEditHistoryDao.DeleteByCommentId(ctx, commentId)
+	if err != nil {
+		return xerror.Wrapf(err, "comment biz dao delete edit history failed")
+	}
 
 	return nil
 }
@@ -379,28 +461,46 @@ func (b *CommentBiz) isCommentDeletable(ctx context.Context, uid int64, item *mo
 
 // 仅获取根评论, 不获取根评论下的子评论
 // 每次返回10条
-func (b *CommentBiz) GetRootComments(ctx context.Context, oid int64, cursor int64, want int, sortBy int8) (*model.PageComments, error) {
+//
+// 按时间排序时使用cursor分页 按热度排序时使用trendCursor分页
+func (b *CommentBiz) GetRootComments(ctx context.Context, oid int64, cursor int64, trendCursor string,
+	want int, sortBy int8) (*model.PageComments, error) {
 	if want <= 0 {
 		want = 18
 	}
 
-	data, err := infra.Dao().CommentDao.GetRoots(ctx, oid, cursor, want)
-	if err != nil {
-		return nil, xerror.Wrapf(err, "comment biz failed to get roots").WithCtx(ctx).
-			WithExtras("oid", oid, "cursor", cursor)
-	}
+	var (
+		data            []*dao.Comment
+		nextCursor      int64
+		nextTrendCursor string
+		hasNext         bool
+		err             error
+	)
 
-	// 计算下一个cursor
-	dataLen := len(data)
-	var nextCursor int64 = 0
-	hasNext := dataLen == want
-	if dataLen > 0 {
-		nextCursor = data[dataLen-1].Id
-	}
-	if !hasNext {
-		nextCursor = 0
+	if sortBy == model.SortByTrend {
+		data, nextTrendCursor, hasNext, err = b.trendBiz.PageRoots(ctx, oid, trendCursor, want)
+		if err != nil {
+			return nil, xerror.Wrapf(err, "comment biz failed to get trend roots").WithCtx(ctx).
+				WithExtras("oid", oid, "trendCursor", trendCursor)
+		}
+	} else {
+		data, err = infra.Dao().CommentDao.GetRoots(ctx, oid, cursor, want)
+		if err != nil {
+			return nil, xerror.Wrapf(err, "comment biz failed to get roots").WithCtx(ctx).
+				WithExtras("oid", oid, "cursor", cursor)
+		}
+
+		// 计算下一个cursor
+		hasNext = len(data) == want
+		if len(data) > 0 {
+			nextCursor = data[len(data)-1].Id
+		}
+		if !hasNext {
+			nextCursor = 0
+		}
 	}
 
+	dataLen := len(data)
 	items := make([]*model.CommentItem, 0, dataLen)
 	rootIds := make([]int64, 0, dataLen)
 	for _, item := range data {
@@ -429,9 +529,10 @@ func (b *CommentBiz) GetRootComments(ctx context.Context, oid int64, cursor int6
 	}
 
 	return &model.PageComments{
-		Items:      items,
-		NextCursor: nextCursor,
-		HasNext:    hasNext,
+		Items:           items,
+		NextCursor:      nextCursor,
+		NextTrendCursor: nextTrendCursor,
+		HasNext:         hasNext,
 	}, nil
 }
 
@@ -635,7 +736,12 @@ func (b *CommentBiz) PopulateSubCommentsCount(ctx context.Context, items []*mode
 	return nil
 }
 
-// 填充评论的图片资源
+// 纯文本评论只有引用了表情才有资源
+func hasCommentAssets(item *model.CommentItem) bool {
+	return item.Type != model.CommentText || model.HasEmojiToken(item.Content)
+}
+
+// 填充评论的图片和表情资源
 func (b *CommentBiz) PopulateCommentImages(ctx context.Context, items []*model.CommentItem) error {
 	if len(items) == 0 {
 		return nil
@@ -643,7 +749,7 @@ func (b *CommentBiz) PopulateCommentImages(ctx context.Context, items []*model.C
 
 	commentIds := make([]int64, 0, len(items))
 	for _, r := range items {
-		if r.Type != model.CommentText {
+		if hasCommentAssets(r) {
 			commentIds = append(commentIds, r.Id)
 		}
 	}
@@ -667,11 +773,12 @@ func (b *CommentBiz) PopulateCommentImages(ctx context.Context, items []*model.C
 		})
 	}
 
-	// 填充图片资源
+	// 填充图片和表情资源
 	for _, item := range items {
-		if item.Type != model.CommentText {
+		if hasCommentAssets(item) {
 			if assets, ok := assetsMap[item.Id]; ok {
 				item.Images = makePbCommentImage(assets)
+				item.Emojis = makePbCommentEmoji(assets)
 			}
 		}
 	}
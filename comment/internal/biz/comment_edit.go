@@ -0,0 +1,176 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/comment/internal/config"
+	"github.com/ryanreadbooks/whimer/comment/internal/global"
+	"github.com/ryanreadbooks/whimer/comment/internal/infra"
+	"github.com/ryanreadbooks/whimer/comment/internal/infra/dao"
+	"github.com/ryanreadbooks/whimer/comment/internal/model"
+	commentv1 "github.com/ryanreadbooks/whimer/idl/gen/go/comment/api/v1"
+	"github.com/ryanreadbooks/whimer/misc/metadata"
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xlog"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+)
+
+// 检查评论是否可以被用户编辑
+func (b *CommentBiz) isCommentEditable(uid int64, c *dao.Comment, now time.Time) error {
+	if c.Uid != uid {
+		return xerror.Wrap(global.ErrYouDontOwnThis)
+	}
+
+	// 被隐藏的评论等待审核 不能通过编辑绕过
+	if c.State != int8(model.CommentStateNormal) ||
+		(c.Type != model.CommentText && c.Type != model.CommentImageText) {
+		return xerror.Wrap(global.ErrCommentNotEditable)
+	}
+
+	if now.Sub(time.Unix(c.Ctime, 0)) > config.Conf.Edit.Window {
+		return xerror.Wrap(global.ErrEditWindowExpired)
+	}
+
+	return nil
+}
+
+// 用户编辑评论
+//
+// 编辑前的版本会被保存到历史版本中 返回本次编辑增减的@用户
+func (b *CommentBiz) EditComment(ctx context.Context, req *model.EditCommentReq) (*model.EditCommentRes, error) {
+	var (
+		uid = metadata.Uid(ctx)
+		now = time.Now()
+		res = &model.EditCommentRes{Etime: now.Unix()}
+	)
+
+	// 内容中引用的表情必须存在
+	emojis, err := b.emojiBiz.ResolveContent(ctx, req.Content)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "comment biz resolve emojis failed").
+			WithExtras("commentId", req.CommentId, "uid", uid).WithCtx(ctx)
+	}
+
+	err = infra.Dao().Transact(ctx, func(ctx context.Context) error {
+		c, err := infra.Dao().CommentDao.FindByIdForUpdate(ctx, req.CommentId)
+		if err != nil {
+			if xsql.IsNoRecord(err) {
+				return xerror.Wrap(global.ErrCommentNotFound)
+			}
+			return xerror.Wrapf(err, "comment biz find comment failed")
+		}
+
+		if err := b.isCommentEditable(uid, c, now); err != nil {
+			return err
+		}
+
+		// 保存编辑前的版本
+		before := NewCommentItemFromDao(c)
+		if err := b.PopulateCommentImages(ctx, []*model.CommentItem{before}); err != nil {
+			return xerror.Wrapf(err, "comment biz populate images failed")
+		}
+		if err := b.PopulateCommentExt(ctx, []*model.CommentItem{before}); err != nil {
+			return xerror.Wrapf(err, "comment biz populate ext failed")
+		}
+
+		images, _ := json.Marshal(before.Images)
+		atUsers, _ := json.Marshal(before.AtUsers)
+		err = infra.Dao().CommentEditHistoryDao.Insert(ctx, &dao.CommentEditHistory{
+			CommentId: c.Id,
+			Type:      c.Type,
+			Content:   c.Content,
+			Images:    images,
+			AtUsers:   atUsers,
+			Ctime:     now.Unix(),
+		})
+		if err != nil {
+			return xerror.Wrapf(err, "comment biz insert edit history failed")
+		}
+
+		err = infra.Dao().CommentDao.UpdateContent(ctx, c.Oid, c.Id, req.Type(), req.Content, now.Unix())
+		if err != nil {
+			return xerror.Wrapf(err, "comment biz update content failed")
+		}
+
+		// 图片和表情资源整体替换
+		if len(before.Images) > 0 || len(before.Emojis) > 0 {
+			if err := infra.Dao().CommentAssetDao.DeleteByCommentId(ctx, c.Id); err != nil {
+				return xerror.Wrapf(err, "comment biz delete assets failed")
+			}
+		}
+		if err := b.addCommentAssets(ctx, c.Id, req.Type(), req.Images, emojis); err != nil {
+			return err
+		}
+
+		if len(req.AtUsers) > 0 {
+			atUsersJSON, err := json.Marshal(req.AtUsers)
+			if err != nil {
+				return xerror.Wrapf(err, "comment biz marshal at users failed")
+			}
+			if err := b.addCommentExts(ctx, c.Id, &dao.CommentExt{AtUsers: atUsersJSON}); err != nil {
+				return xerror.Wrapf(err, "comment biz upsert ext failed")
+			}
+		} else if len(before.AtUsers) > 0 {
+			if err := infra.Dao().CommentExtDao.Delete(ctx, c.Id); err != nil {
+				return xerror.Wrapf(err, "comment biz delete ext failed")
+			}
+		}
+
+		// 编辑后命中作者设置的屏蔽词同样需要等待作者审核
+		setting, err := b.settingBiz.GetSetting(ctx, c.Oid)
+		if err != nil {
+			return xerror.Wrapf(err, "comment biz get setting failed")
+		}
+		if _, hit := setting.MatchKeyword(req.Content); hit && c.Uid != setting.Owner {
+			err = infra.Dao().CommentDao.SetState(ctx, c.Oid, c.Id, int8(model.CommentStateHeld))
+			if err != nil {
+				return xerror.Wrapf(err, "comment biz hold comment failed")
+			}
+			res.Held = true
+		}
+
+		res.AddedAtUsers, res.RemovedAtUsers = model.DiffAtUsers(before.AtUsers, req.AtUsers)
+		return nil
+	})
+	if err != nil {
+		return nil, xerror.Wrapf(err, "comment biz edit comment failed").
+			WithExtras("commentId", req.CommentId, "uid", uid).WithCtx(ctx)
+	}
+
+	return res, nil
+}
+
+// 获取评论的历史版本 按照编辑时间从新到旧排列
+func (b *CommentBiz) GetEditHistory(ctx context.Context, commentId int64) ([]*model.CommentEditVersion, error) {
+	histories, err := infra.Dao().CommentEditHistoryDao.ListByCommentId(ctx, commentId, model.MaxEditHistoryCount)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "comment biz list edit history failed").
+			WithExtra("commentId", commentId).WithCtx(ctx)
+	}
+
+	versions := make([]*model.CommentEditVersion, 0, len(histories))
+	for _, h := range histories {
+		var (
+			images  []*commentv1.CommentItemImage
+			atUsers []*commentv1.CommentAtUser
+		)
+		if err := json.Unmarshal(h.Images, &images); err != nil {
+			xlog.Msg("comment biz unmarshal history images failed").Err(err).Extras("id", h.Id).Errorx(ctx)
+		}
+		if err := json.Unmarshal(h.AtUsers, &atUsers); err != nil {
+			xlog.Msg("comment biz unmarshal history at users failed").Err(err).Extras("id", h.Id).Errorx(ctx)
+		}
+
+		versions = append(versions, &model.CommentEditVersion{
+			Type:    h.Type,
+			Content: h.Content,
+			Images:  images,
+			AtUsers: atUsers,
+			Ctime:   h.Ctime,
+		})
+	}
+
+	return versions, nil
+}
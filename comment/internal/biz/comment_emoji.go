@@ -0,0 +1,249 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/comment/internal/global"
+	"github.com/ryanreadbooks/whimer/comment/internal/infra"
+	"github.com/ryanreadbooks/whimer/comment/internal/infra/dao"
+	"github.com/ryanreadbooks/whimer/comment/internal/model"
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xslice"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+)
+
+type CommentEmojiBiz struct{}
+
+// 评论表情包领域
+func NewCommentEmojiBiz() CommentEmojiBiz {
+	return CommentEmojiBiz{}
+}
+
+func (b *CommentEmojiBiz) ListPacks(ctx context.Context, includeDisabled bool) ([]*model.EmojiPack, error) {
+	packs, err := infra.Dao().CommentEmojiDao.ListPacks(ctx, includeDisabled)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "comment emoji biz list packs failed").WithCtx(ctx)
+	}
+
+	res := make([]*model.EmojiPack, 0, len(packs))
+	for _, p := range packs {
+		res = append(res, NewEmojiPackFromDao(p))
+	}
+
+	return res, nil
+}
+
+// 获取表情包及其表情
+func (b *CommentEmojiBiz) GetPack(ctx context.Context, code string, includeDisabled bool) (
+	*model.EmojiPack, []*model.EmojiItem, error,
+) {
+	pack, err := infra.Dao().CommentEmojiDao.GetPackByCode(ctx, code)
+	if err != nil {
+		if xsql.IsNoRecord(err) {
+			return nil, nil, xerror.Wrap(global.ErrEmojiPackNotFound)
+		}
+		return nil, nil, xerror.Wrapf(err, "comment emoji biz get pack failed").WithExtra("code", code).WithCtx(ctx)
+	}
+
+	if pack.Enabled != model.EmojiEnabled && !includeDisabled {
+		return nil, nil, xerror.Wrap(global.ErrEmojiPackNotFound)
+	}
+
+	items, err := infra.Dao().CommentEmojiDao.ListItems(ctx, pack.Id, includeDisabled)
+	if err != nil {
+		return nil, nil, xerror.Wrapf(err, "comment emoji biz list items failed").WithExtra("code", code).WithCtx(ctx)
+	}
+
+	res := make([]*model.EmojiItem, 0, len(items))
+	for _, item := range items {
+		res = append(res, NewEmojiItemFromDao(pack.Code, item))
+	}
+
+	return NewEmojiPackFromDao(pack), res, nil
+}
+
+// 批量查找上架中的表情 不存在或者已下架的表情不返回
+func (b *CommentEmojiBiz) BatchGetItems(ctx context.Context, tokens []model.EmojiToken) (
+	map[model.EmojiToken]*model.EmojiItem, error,
+) {
+	res := make(map[model.EmojiToken]*model.EmojiItem, len(tokens))
+	if len(tokens) == 0 {
+		return res, nil
+	}
+
+	packCodes := make([]string, 0, len(tokens))
+	codes := make([]string, 0, len(tokens))
+	for _, t := range tokens {
+		packCodes = append(packCodes, t.Pack)
+		codes = append(codes, t.Code)
+	}
+
+	packs, err := infra.Dao().CommentEmojiDao.BatchGetEnabledPacks(ctx, xslice.Uniq(packCodes))
+	if err != nil {
+		return nil, xerror.Wrapf(err, "comment emoji biz batch get packs failed").WithCtx(ctx)
+	}
+	if len(packs) == 0 {
+		return res, nil
+	}
+
+	packIds := make([]int64, 0, len(packs))
+	packCodeById := make(map[int64]string, len(packs))
+	for _, p := range packs {
+		packIds = append(packIds, p.Id)
+		packCodeById[p.Id] = p.Code
+	}
+
+	items, err := infra.Dao().CommentEmojiDao.BatchGetEnabledItems(ctx, packIds, xslice.Uniq(codes))
+	if err != nil {
+		return nil, xerror.Wrapf(err, "comment emoji biz batch get items failed").WithCtx(ctx)
+	}
+
+	wanted := make(map[model.EmojiToken]struct{}, len(tokens))
+	for _, t := range tokens {
+		wanted[t] = struct{}{}
+	}
+
+	for _, item := range items {
+		e := NewEmojiItemFromDao(packCodeById[item.PackId], item)
+		if _, ok := wanted[e.Token()]; ok {
+			res[e.Token()] = e
+		}
+	}
+
+	return res, nil
+}
+
+// 解析评论内容中引用的表情 按照出现顺序返回
+//
+// 引用了不存在或者已下架的表情时返回错误
+func (b *CommentEmojiBiz) ResolveContent(ctx context.Context, content string) ([]*model.EmojiItem, error) {
+	tokens := model.ParseEmojiTokens(content)
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+
+	items, err := b.BatchGetItems(ctx, tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*model.EmojiItem, 0, len(tokens))
+	for _, t := range tokens {
+		item, ok := items[t]
+		if !ok {
+			return nil, xerror.Wrap(global.ErrEmojiNotFound).WithExtra("token", t.String())
+		}
+		res = append(res, item)
+	}
+
+	return res, nil
+}
+
+// 新增或者更新表情包 有变更时递增表情包版本
+func (b *CommentEmojiBiz) UpsertPack(ctx context.Context, req *model.UpsertEmojiPackReq) (*model.EmojiPack, error) {
+	var (
+		now = time.Now().Unix()
+		res *dao.CommentEmojiPack
+	)
+
+	err := infra.Dao().Transact(ctx, func(ctx context.Context) error {
+		pack, err := infra.Dao().CommentEmojiDao.GetPackByCodeForUpdate(ctx, req.Code)
+		if err != nil && !xsql.IsNoRecord(err) {
+			return xerror.Wrapf(err, "comment emoji biz get pack failed")
+		}
+
+		if pack == nil {
+			pack = &dao.CommentEmojiPack{Code: req.Code, Ctime: now}
+		} else if pack.Name == req.Name && pack.CoverKey == req.CoverKey &&
+			pack.Enabled == emojiStateOf(req.Enabled) {
+			// 没有变更
+			res = pack
+			return nil
+		}
+
+		pack.Name = req.Name
+		pack.CoverKey = req.CoverKey
+		pack.Enabled = emojiStateOf(req.Enabled)
+		pack.Version++
+		pack.Mtime = now
+		if err := infra.Dao().CommentEmojiDao.UpsertPack(ctx, pack); err != nil {
+			return xerror.Wrapf(err, "comment emoji biz upsert pack failed")
+		}
+
+		res, err = infra.Dao().CommentEmojiDao.GetPackByCode(ctx, req.Code)
+		if err != nil {
+			return xerror.Wrapf(err, "comment emoji biz get upserted pack failed")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, xerror.Wrapf(err, "comment emoji biz upsert pack failed").WithExtra("code", req.Code).WithCtx(ctx)
+	}
+
+	return NewEmojiPackFromDao(res), nil
+}
+
+// 新增或者更新表情 有变更时表情和所属的表情包都使用新的版本
+func (b *CommentEmojiBiz) UpsertItem(ctx context.Context, req *model.UpsertEmojiItemReq) (*model.EmojiItem, error) {
+	var (
+		now = time.Now().Unix()
+		res *model.EmojiItem
+	)
+
+	err := infra.Dao().Transact(ctx, func(ctx context.Context) error {
+		// 锁住表情包 保证版本递增
+		pack, err := infra.Dao().CommentEmojiDao.GetPackByCodeForUpdate(ctx, req.Pack)
+		if err != nil {
+			if xsql.IsNoRecord(err) {
+				return xerror.Wrap(global.ErrEmojiPackNotFound)
+			}
+			return xerror.Wrapf(err, "comment emoji biz get pack failed")
+		}
+
+		item, err := infra.Dao().CommentEmojiDao.GetItem(ctx, pack.Id, req.Code)
+		if err != nil && !xsql.IsNoRecord(err) {
+			return xerror.Wrapf(err, "comment emoji biz get item failed")
+		}
+
+		if item != nil && req.SameAs(NewEmojiItemFromDao(pack.Code, item)) {
+			res = NewEmojiItemFromDao(pack.Code, item)
+			return nil
+		}
+
+		version := pack.Version + 1
+		if item == nil {
+			item = &dao.CommentEmojiItem{PackId: pack.Id, Code: req.Code, Ctime: now}
+		}
+		item.Name = req.Name
+		item.StoreKey = req.StoreKey
+		item.Width = req.Width
+		item.Height = req.Height
+		item.Format = req.Format
+		item.Enabled = emojiStateOf(req.Enabled)
+		item.Version = version
+		item.Mtime = now
+		if err := infra.Dao().CommentEmojiDao.UpsertItem(ctx, item); err != nil {
+			return xerror.Wrapf(err, "comment emoji biz upsert item failed")
+		}
+
+		if err := infra.Dao().CommentEmojiDao.UpdatePackVersion(ctx, pack.Id, version, now); err != nil {
+			return xerror.Wrapf(err, "comment emoji biz update pack version failed")
+		}
+
+		item, err = infra.Dao().CommentEmojiDao.GetItem(ctx, pack.Id, req.Code)
+		if err != nil {
+			return xerror.Wrapf(err, "comment emoji biz get upserted item failed")
+		}
+		res = NewEmojiItemFromDao(pack.Code, item)
+
+		return nil
+	})
+	if err != nil {
+		return nil, xerror.Wrapf(err, "comment emoji biz upsert item failed").
+			WithExtras("pack", req.Pack, "code", req.Code).WithCtx(ctx)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,102 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/comment/internal/infra"
+	"github.com/ryanreadbooks/whimer/comment/internal/model"
+	"github.com/ryanreadbooks/whimer/misc/concurrent"
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xlog"
+	"github.com/ryanreadbooks/whimer/misc/xslice"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+)
+
+// 按照发布时间从早到晚分页获取对象下等待作者审核的评论
+func (b *CommentBiz) ListHeldComments(ctx context.Context, oid, cursor int64, count int) (*model.PageHeldComments, error) {
+	if count <= 0 || count > model.MaxHeldCommentCnt {
+		count = model.MaxHeldCommentCnt
+	}
+
+	data, err := infra.Dao().CommentDao.PageByState(ctx, oid, int8(model.CommentStateHeld), cursor, count)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "comment biz page held comments failed").
+			WithExtras("oid", oid, "cursor", cursor).WithCtx(ctx)
+	}
+
+	items := NewCommentItemSliceFromDao(data)
+	if err := b.PopulateCommentImages(ctx, items); err != nil {
+		return nil, xerror.Wrapf(err, "comment biz failed to populate images").WithExtra("oid", oid).WithCtx(ctx)
+	}
+
+	if err := b.PopulateCommentExt(ctx, items); err != nil {
+		xlog.Msg("comment biz populate at users failed").Extras("oid", oid).Errorx(ctx)
+	}
+
+	res := &model.PageHeldComments{
+		Items:   items,
+		HasNext: len(data) == count,
+	}
+	if res.HasNext {
+		res.NextCursor = data[len(data)-1].Id
+	}
+
+	return res, nil
+}
+
+// 通过对象下等待作者审核的评论 返回实际通过的评论及其@的用户
+//
+// 不属于该对象或者不在等待审核状态的评论会被忽略
+func (b *CommentBiz) ApproveHeldComments(ctx context.Context, oid int64, commentIds []int64) ([]*model.CommentItem, error) {
+	var approved []*model.CommentItem
+
+	commentIds = xslice.Uniq(commentIds)
+	err := infra.Dao().Transact(ctx, func(ctx context.Context) error {
+		for _, id := range commentIds {
+			c, err := infra.Dao().CommentDao.FindByIdForUpdate(ctx, id)
+			if err != nil {
+				if xsql.IsNoRecord(err) {
+					continue
+				}
+				return xerror.Wrapf(err, "comment biz find comment failed").WithExtra("commentId", id)
+			}
+
+			if c.Oid != oid || c.State != int8(model.CommentStateHeld) {
+				continue
+			}
+
+			err = infra.Dao().CommentDao.SetState(ctx, c.Oid, c.Id, int8(model.CommentStateNormal))
+			if err != nil {
+				return xerror.Wrapf(err, "comment biz approve comment failed").WithExtra("commentId", id)
+			}
+
+			item := NewCommentItemFromDao(c)
+			item.State = model.CommentStateNormal
+			approved = append(approved, item)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, xerror.Wrapf(err, "comment biz approve held comments failed").
+			WithExtras("oid", oid, "commentIds", commentIds).WithCtx(ctx)
+	}
+
+	// 审核通过后才计入评论数
+	if len(approved) > 0 {
+		concurrent.DoneInCtx(ctx, 10*time.Second, func(ctx context.Context) {
+			err := infra.Dao().CommentDao.IncrCommentCountBy(ctx, oid, int64(len(approved)))
+			if err != nil {
+				xlog.Msg("comment biz incr comment count failed").Err(err).Extras("oid", oid).Errorx(ctx)
+			}
+		})
+	}
+
+	// 审核通过前没有通知被@的用户 需要返回@的用户
+	if err := b.PopulateCommentExt(ctx, approved); err != nil {
+		xlog.Msg("comment biz populate at users failed").Err(err).Extras("oid", oid).Errorx(ctx)
+	}
+
+	return approved, nil
+}
@@ -0,0 +1,255 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/comment/internal/config"
+	"github.com/ryanreadbooks/whimer/comment/internal/global"
+	"github.com/ryanreadbooks/whimer/comment/internal/infra"
+	"github.com/ryanreadbooks/whimer/comment/internal/infra/dao"
+	"github.com/ryanreadbooks/whimer/comment/internal/infra/dep"
+	"github.com/ryanreadbooks/whimer/comment/internal/model"
+	systemv1 "github.com/ryanreadbooks/whimer/idl/gen/go/msger/api/system/v1"
+	"github.com/ryanreadbooks/whimer/misc/concurrent"
+	"github.com/ryanreadbooks/whimer/misc/metadata"
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xlog"
+	"github.com/ryanreadbooks/whimer/misc/xslice"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+)
+
+type CommentReportBiz struct {
+	commentBiz CommentBiz
+}
+
+// 评论举报领域
+func NewCommentReportBiz(commentBiz CommentBiz) CommentReportBiz {
+	return CommentReportBiz{
+		commentBiz: commentBiz,
+	}
+}
+
+// 用户举报评论
+//
+// 待处理的举报数量达到阈值后自动隐藏评论
+func (b *CommentReportBiz) ReportComment(ctx context.Context, req *model.ReportCommentReq) error {
+	var (
+		uid       = metadata.Uid(ctx)
+		threshold = config.Conf.Report.HideThreshold
+	)
+
+	err := infra.Dao().Transact(ctx, func(ctx context.Context) error {
+		comment, err := infra.Dao().CommentDao.FindByIdForUpdate(ctx, req.CommentId)
+		if err != nil {
+			if xsql.IsNoRecord(err) {
+				return xerror.Wrap(global.ErrCommentNotFound)
+			}
+			return xerror.Wrapf(err, "comment report biz find comment failed")
+		}
+
+		if comment.Uid == uid {
+			return xerror.Wrap(global.ErrCantReportYourself)
+		}
+
+		now := time.Now().Unix()
+		_, err = infra.Dao().CommentReportDao.Insert(ctx, &dao.CommentReport{
+			CommentId:  comment.Id,
+			Oid:        comment.Oid,
+			Reporter:   uid,
+			Reason:     int8(req.Reason),
+			Detail:     req.Detail,
+			Resolution: int8(model.ReportPending),
+			Ctime:      now,
+			Utime:      now,
+		})
+		if err != nil {
+			if xsql.IsDuplicate(err) {
+				return xerror.Wrap(global.ErrAlreadyReported)
+			}
+			return xerror.Wrapf(err, "comment report biz insert report failed")
+		}
+
+		err = infra.Dao().CommentDao.AddReport(ctx, comment.Id)
+		if err != nil {
+			return xerror.Wrapf(err, "comment report biz add report count failed")
+		}
+
+		if threshold <= 0 || comment.State != int8(model.CommentStateNormal) {
+			return nil
+		}
+
+		pending, err := infra.Dao().CommentReportDao.CountPending(ctx, comment.Id)
+		if err != nil {
+			return xerror.Wrapf(err, "comment report biz count pending reports failed")
+		}
+
+		if pending >= threshold {
+			err = infra.Dao().CommentDao.SetState(ctx, comment.Oid, comment.Id, int8(model.CommentStateHidden))
+			if err != nil {
+				return xerror.Wrapf(err, "comment report biz hide comment failed")
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return xerror.Wrapf(err, "comment report biz report comment failed").
+			WithExtras("commentId", req.CommentId, "uid", uid).WithCtx(ctx)
+	}
+
+	return nil
+}
+
+// 按照举报时间从早到晚分页获取待处理的举报
+func (b *CommentReportBiz) ListPendingReports(ctx context.Context, cursor int64, count int) (*model.PendingReports, error) {
+	if count <= 0 || count > model.MaxPendingReportCnt {
+		count = model.MaxPendingReportCnt
+	}
+
+	reports, err := infra.Dao().CommentReportDao.PagePending(ctx, cursor, count)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "comment report biz page pending reports failed").
+			WithExtras("cursor", cursor, "count", count).WithCtx(ctx)
+	}
+
+	items := make([]*model.ReportItem, 0, len(reports))
+	commentIds := make([]int64, 0, len(reports))
+	for _, r := range reports {
+		items = append(items, NewReportItemFromDao(r))
+		commentIds = append(commentIds, r.CommentId)
+	}
+
+	comments, err := b.commentBiz.BatchGetComment(ctx, xslice.Uniq(commentIds))
+	if err != nil {
+		return nil, xerror.Wrapf(err, "comment report biz batch get comments failed").WithCtx(ctx)
+	}
+
+	res := &model.PendingReports{
+		Items:    items,
+		Comments: xslice.MakeMap(comments, func(v *model.CommentItem) int64 { return v.Id }),
+		HasNext:  len(reports) == count,
+	}
+	if res.HasNext {
+		res.NextCursor = reports[len(reports)-1].Id
+	}
+
+	return res, nil
+}
+
+// 处理评论的全部待处理举报 处理完成后通知举报人
+//
+// 返回被处理的评论以及处理的举报数量 评论已经被删除时只处理举报
+func (b *CommentReportBiz) ResolveReports(ctx context.Context, commentId int64,
+	resolution model.ReportResolution, banSeconds int64) (*model.CommentItem, int64, error) {
+	var (
+		operator = metadata.Uid(ctx)
+		now      = time.Now()
+		comment  *model.CommentItem
+		deleted  bool
+		resolved int64
+		reports  []*dao.CommentReport
+	)
+
+	err := infra.Dao().Transact(ctx, func(ctx context.Context) error {
+		// 锁住评论 避免处理期间有新的举报
+		c, err := infra.Dao().CommentDao.FindByIdForUpdate(ctx, commentId)
+		if err != nil && !xsql.IsNoRecord(err) {
+			return xerror.Wrapf(err, "comment report biz find comment failed")
+		}
+
+		reports, err = infra.Dao().CommentReportDao.GetPending(ctx, commentId)
+		if err != nil {
+			return xerror.Wrapf(err, "comment report biz get pending reports failed")
+		}
+		if len(reports) == 0 {
+			return xerror.Wrap(global.ErrNoPendingReport)
+		}
+
+		resolved, err = infra.Dao().CommentReportDao.Resolve(ctx, commentId, int8(resolution), operator, now.Unix())
+		if err != nil {
+			return xerror.Wrapf(err, "comment report biz resolve reports failed")
+		}
+
+		if c == nil {
+			return nil
+		}
+
+		comment = NewCommentItemFromDao(c)
+		if !resolution.ShouldDelete() {
+			// 恢复被自动隐藏的评论
+			if c.State == int8(model.CommentStateHidden) {
+				err = infra.Dao().CommentDao.SetState(ctx, c.Oid, c.Id, int8(model.CommentStateNormal))
+				if err != nil {
+					return xerror.Wrapf(err, "comment report biz restore comment failed")
+				}
+			}
+
+			return nil
+		}
+
+		if err := b.commentBiz.deleteComment(ctx, comment); err != nil {
+			return xerror.Wrapf(err, "comment report biz delete comment failed")
+		}
+		deleted = true
+
+		if resolution == model.ReportBanAuthor {
+			err = infra.Dao().CommentBanDao.Upsert(ctx, &dao.CommentUserBan{
+				Uid:       c.Uid,
+				CommentId: c.Id,
+				Operator:  operator,
+				ExpireAt:  model.BanExpireAt(now, banSeconds),
+				Ctime:     now.Unix(),
+				Mtime:     now.Unix(),
+			})
+			if err != nil {
+				return xerror.Wrapf(err, "comment report biz ban author failed")
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, 0, xerror.Wrapf(err, "comment report biz resolve failed").
+			WithExtras("commentId", commentId, "resolution", resolution).WithCtx(ctx)
+	}
+
+	if deleted && comment.State != model.CommentStateHeld {
+		concurrent.DoneInCtx(ctx, 10*time.Second, func(ctx context.Context) {
+			if err := infra.Dao().CommentDao.DecrCommentCount(ctx, comment.Oid); err != nil {
+				xlog.Msg("comment report biz decr comment count failed").Err(err).Extras("oid", comment.Oid).Errorx(ctx)
+			}
+		})
+	}
+
+	b.notifyReporters(ctx, reports, resolution)
+
+	return comment, resolved, nil
+}
+
+// 异步通知举报人处理结果
+func (b *CommentReportBiz) notifyReporters(ctx context.Context, reports []*dao.CommentReport, resolution model.ReportResolution) {
+	reporters := make([]int64, 0, len(reports))
+	for _, r := range reports {
+		reporters = append(reporters, r.Reporter)
+	}
+	reporters = xslice.Uniq(reporters)
+
+	contents := make([]*systemv1.SystemNoticeContent, 0, len(reporters))
+	for _, reporter := range reporters {
+		contents = append(contents, &systemv1.SystemNoticeContent{
+			TargetUid: reporter,
+			Title:     model.ReportNoticeTitle,
+			Content:   resolution.Notice(),
+		})
+	}
+
+	concurrent.DoneInCtx(ctx, 10*time.Second, func(ctx context.Context) {
+		_, err := dep.GetSystemNotifier().NotifySystemNotice(ctx, &systemv1.NotifySystemNoticeRequest{
+			Contents: contents,
+		})
+		if err != nil {
+			xlog.Msg("comment report biz notify reporters failed").Err(err).Extras("reporters", reporters).Errorx(ctx)
+		}
+	})
+}
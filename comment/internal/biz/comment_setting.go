@@ -0,0 +1,207 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/comment/internal/global"
+	"github.com/ryanreadbooks/whimer/comment/internal/infra"
+	"github.com/ryanreadbooks/whimer/comment/internal/infra/dao"
+	"github.com/ryanreadbooks/whimer/comment/internal/infra/dep"
+	"github.com/ryanreadbooks/whimer/comment/internal/model"
+	notev1 "github.com/ryanreadbooks/whimer/idl/gen/go/note/api/v1"
+	relationv1 "github.com/ryanreadbooks/whimer/idl/gen/go/relation/api/v1"
+	"github.com/ryanreadbooks/whimer/misc/metadata"
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+)
+
+type CommentSettingBiz struct{}
+
+// 作者评论管理领域
+func NewCommentSettingBiz() CommentSettingBiz {
+	return CommentSettingBiz{}
+}
+
+// 检查用户是否为评论对象的作者
+func (b *CommentSettingBiz) CheckObjectOwner(ctx context.Context, uid, oid int64) error {
+	resp, err := dep.GetNoter().IsUserOwnNote(ctx, &notev1.IsUserOwnNoteRequest{
+		Uid:    uid,
+		NoteId: oid,
+	})
+	if err != nil {
+		return xerror.Wrapf(err, "comment setting biz failed to check owner").
+			WithExtras("uid", uid, "oid", oid).WithCtx(ctx)
+	}
+
+	if !resp.GetResult() {
+		return xerror.Wrap(global.ErrNotObjectOwner)
+	}
+
+	return nil
+}
+
+// 获取对象的评论设置 没有设置过时返回默认设置
+func (b *CommentSettingBiz) GetSetting(ctx context.Context, oid int64) (*model.CommentSetting, error) {
+	s, err := infra.Dao().CommentSettingDao.Get(ctx, oid)
+	if err != nil {
+		if xsql.IsNoRecord(err) {
+			return model.DefaultCommentSetting(oid), nil
+		}
+		return nil, xerror.Wrapf(err, "comment setting biz get setting failed").WithExtra("oid", oid).WithCtx(ctx)
+	}
+
+	return NewCommentSettingFromDao(s), nil
+}
+
+// 作者更新对象的评论设置
+func (b *CommentSettingBiz) UpdateSetting(ctx context.Context, req *model.UpdateCommentSettingReq) (
+	*model.CommentSetting, error,
+) {
+	var (
+		uid = metadata.Uid(ctx)
+		now = time.Now().Unix()
+	)
+
+	if err := b.CheckObjectOwner(ctx, uid, req.Oid); err != nil {
+		return nil, err
+	}
+
+	keywords, err := json.Marshal(req.BlockedKeywords)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "comment setting biz marshal keywords failed")
+	}
+
+	err = infra.Dao().CommentSettingDao.Upsert(ctx, &dao.CommentSetting{
+		Oid:             req.Oid,
+		Owner:           uid,
+		Mode:            int8(req.Mode),
+		BlockedKeywords: keywords,
+		Ctime:           now,
+		Mtime:           now,
+	})
+	if err != nil {
+		return nil, xerror.Wrapf(err, "comment setting biz upsert setting failed").
+			WithExtras("oid", req.Oid, "uid", uid).WithCtx(ctx)
+	}
+
+	return &model.CommentSetting{
+		Oid:             req.Oid,
+		Owner:           uid,
+		Mode:            req.Mode,
+		BlockedKeywords: req.BlockedKeywords,
+		Mtime:           now,
+	}, nil
+}
+
+// 作者禁止/解除禁止用户在其所有对象下评论
+func (b *CommentSettingBiz) MuteUser(ctx context.Context, target int64, action int8) error {
+	var (
+		owner = metadata.Uid(ctx)
+		err   error
+	)
+
+	if target == owner {
+		return xerror.Wrap(global.ErrCantMuteYourself)
+	}
+
+	if action == ActionDo {
+		err = infra.Dao().CommentSettingDao.InsertMute(ctx, &dao.CommentUserMute{
+			Owner: owner,
+			Uid:   target,
+			Ctime: time.Now().Unix(),
+		})
+	} else {
+		err = infra.Dao().CommentSettingDao.DeleteMute(ctx, owner, target)
+	}
+	if err != nil {
+		return xerror.Wrapf(err, "comment setting biz mute user failed").
+			WithExtras("owner", owner, "target", target, "action", action).WithCtx(ctx)
+	}
+
+	return nil
+}
+
+// 分页获取作者禁止评论的用户
+func (b *CommentSettingBiz) ListMutedUsers(ctx context.Context, cursor int64, count int) (*model.PageMutedUsers, error) {
+	var owner = metadata.Uid(ctx)
+
+	if count <= 0 || count > model.MaxMutedUserCnt {
+		count = model.MaxMutedUserCnt
+	}
+	if cursor <= 0 {
+		cursor = math.MaxInt64
+	}
+
+	mutes, err := infra.Dao().CommentSettingDao.PageMutes(ctx, owner, cursor, count)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "comment setting biz page mutes failed").
+			WithExtras("owner", owner, "cursor", cursor).WithCtx(ctx)
+	}
+
+	items := make([]*model.MutedUser, 0, len(mutes))
+	for _, m := range mutes {
+		items = append(items, NewMutedUserFromDao(m))
+	}
+
+	res := &model.PageMutedUsers{
+		Items:   items,
+		HasNext: len(mutes) == count,
+	}
+	if res.HasNext {
+		res.NextCursor = mutes[len(mutes)-1].Id
+	}
+
+	return res, nil
+}
+
+// 检查用户能否在对象下发表评论
+//
+// 作者本人不受限制 命中作者设置的屏蔽词时评论需要等待作者审核 此时返回held为true
+func (b *CommentSettingBiz) CheckAddable(ctx context.Context, uid, oid int64, content string) (held bool, err error) {
+	authorResp, err := dep.GetNoteFeeder().GetNoteAuthor(ctx, &notev1.GetNoteAuthorRequest{NoteId: oid})
+	if err != nil {
+		return false, xerror.Wrapf(err, "comment setting biz get note author failed").WithExtra("oid", oid).WithCtx(ctx)
+	}
+
+	author := authorResp.GetAuthor()
+	if author == uid {
+		return false, nil
+	}
+
+	muted, err := infra.Dao().CommentSettingDao.IsMuted(ctx, author, uid)
+	if err != nil {
+		return false, xerror.Wrapf(err, "comment setting biz check muted failed").
+			WithExtras("author", author, "uid", uid).WithCtx(ctx)
+	}
+	if muted {
+		return false, xerror.Wrap(global.ErrMutedByAuthor)
+	}
+
+	setting, err := b.GetSetting(ctx, oid)
+	if err != nil {
+		return false, err
+	}
+
+	switch setting.Mode {
+	case model.CommentModeClosed:
+		return false, xerror.Wrap(global.ErrCommentClosed)
+	case model.CommentModeFollowers:
+		resp, err := dep.GetRelationer().CheckUserFollowed(ctx, &relationv1.CheckUserFollowedRequest{
+			Uid:   uid,
+			Other: author,
+		})
+		if err != nil {
+			return false, xerror.Wrapf(err, "comment setting biz check user followed failed").
+				WithExtras("uid", uid, "author", author).WithCtx(ctx)
+		}
+		if !resp.GetFollowed() {
+			return false, xerror.Wrap(global.ErrCommentFollowersOnly)
+		}
+	}
+
+	_, held = setting.MatchKeyword(content)
+	return held, nil
+}
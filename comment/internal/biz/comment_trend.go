@@ -0,0 +1,219 @@
+package biz
+
+import (
+	"context"
+
+	"github.com/ryanreadbooks/whimer/comment/internal/infra"
+	"github.com/ryanreadbooks/whimer/comment/internal/infra/dao"
+	"github.com/ryanreadbooks/whimer/comment/internal/model"
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xslice"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+)
+
+const (
+	// 重建热度排行时最多加载的主评论数量
+	maxTrendRebuildRoots = 1000
+	// 每次向计数服务批量获取的数量
+	trendStatsBatchSize = 100
+	// 排行中的评论被删除或置顶时需要继续往后取 最多取的轮数
+	maxTrendPageRounds = 3
+)
+
+type CommentTrendBiz struct {
+	interactBiz CommentInteractBiz
+}
+
+// 评论热度排行领域
+func NewCommentTrendBiz(interactBiz CommentInteractBiz) CommentTrendBiz {
+	return CommentTrendBiz{
+		interactBiz: interactBiz,
+	}
+}
+
+// 计算主评论的热度
+func (b *CommentTrendBiz) computeTrend(ctx context.Context, items []*model.CommentItem) ([]dao.TrendItem, error) {
+	err := xslice.BatchExec(items, trendStatsBatchSize, func(start, end int) error {
+		batch := items[start:end]
+		rootIds := make([]int64, 0, len(batch))
+		for _, item := range batch {
+			rootIds = append(rootIds, item.Id)
+		}
+
+		subs, err := infra.Dao().CommentDao.BatchCountSubs(ctx, rootIds)
+		if err != nil {
+			return xerror.Wrapf(err, "comment trend biz batch count subs failed")
+		}
+		for _, item := range batch {
+			item.SubsCount = subs[item.Id]
+		}
+
+		if err := b.interactBiz.PopulateLikes(ctx, batch); err != nil {
+			return xerror.Wrapf(err, "comment trend biz populate likes failed")
+		}
+
+		if err := b.interactBiz.PopulateHates(ctx, batch); err != nil {
+			return xerror.Wrapf(err, "comment trend biz populate hates failed")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	trendItems := make([]dao.TrendItem, 0, len(items))
+	for _, item := range items {
+		trendItems = append(trendItems, dao.TrendItem{
+			Id:    item.Id,
+			Score: model.TrendScore(item.LikeCount, item.HateCount, item.SubsCount, item.Ctime),
+		})
+	}
+
+	return trendItems, nil
+}
+
+// 排行不存在时从数据库重建
+func (b *CommentTrendBiz) ensureTrend(ctx context.Context, oid int64) error {
+	exists, err := infra.Dao().CommentTrendCache.Exists(ctx, oid)
+	if err != nil {
+		return xerror.Wrapf(err, "comment trend biz check trend exists failed")
+	}
+	if exists {
+		return nil
+	}
+
+	// 只对较新的主评论建立排行
+	roots, err := infra.Dao().CommentDao.GetRoots(ctx, oid, 0, maxTrendRebuildRoots)
+	if err != nil && !xsql.IsNoRecord(err) {
+		return xerror.Wrapf(err, "comment trend biz get roots failed")
+	}
+	if len(roots) == 0 {
+		return nil
+	}
+
+	trendItems, err := b.computeTrend(ctx, NewCommentItemSliceFromDao(roots))
+	if err != nil {
+		return xerror.Wrapf(err, "comment trend biz compute trend failed")
+	}
+
+	err = infra.Dao().CommentTrendCache.Rebuild(ctx, oid, trendItems)
+	if err != nil {
+		return xerror.Wrapf(err, "comment trend biz rebuild trend failed")
+	}
+
+	return nil
+}
+
+// 按热度分页获取主评论
+func (b *CommentTrendBiz) PageRoots(ctx context.Context, oid int64, cursor string, want int) (
+	[]*dao.Comment, string, bool, error,
+) {
+	c, err := model.ParseTrendCursor(cursor)
+	if err != nil {
+		return nil, "", false, xerror.Wrap(err)
+	}
+
+	if err := b.ensureTrend(ctx, oid); err != nil {
+		return nil, "", false, xerror.Wrapf(err, "comment trend biz ensure trend failed").WithExtra("oid", oid)
+	}
+
+	var (
+		res     = make([]*dao.Comment, 0, want)
+		hasNext = true
+	)
+
+	for round := 0; round < maxTrendPageRounds && hasNext && len(res) < want; round++ {
+		items, err := infra.Dao().CommentTrendCache.Page(ctx, oid, c, want-len(res))
+		if err != nil {
+			return nil, "", false, xerror.Wrapf(err, "comment trend biz page trend failed").
+				WithExtras("oid", oid, "cursor", cursor)
+		}
+
+		hasNext = len(items) == want-len(res)
+		if len(items) == 0 {
+			break
+		}
+		// 游标按照排行推进 不受过滤影响
+		c = model.TrendCursor{Score: items[len(items)-1].Score, Id: items[len(items)-1].Id}
+
+		comments, err := b.validRoots(ctx, oid, items)
+		if err != nil {
+			return nil, "", false, err
+		}
+		res = append(res, comments...)
+	}
+
+	if !hasNext {
+		return res, "", false, nil
+	}
+
+	return res, c.String(), true, nil
+}
+
+// 过滤排行中已经不存在、已经置顶或者被隐藏的评论
+func (b *CommentTrendBiz) validRoots(ctx context.Context, oid int64, items []dao.TrendItem) ([]*dao.Comment, error) {
+	ids := make([]int64, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.Id)
+	}
+
+	comments, err := infra.Dao().CommentDao.BatchFindById(ctx, ids)
+	if err != nil && !xsql.IsNoRecord(err) {
+		return nil, xerror.Wrapf(err, "comment trend biz batch find roots failed").WithExtra("ids", ids)
+	}
+
+	mapping := make(map[int64]*dao.Comment, len(comments))
+	for _, c := range comments {
+		mapping[c.Id] = c
+	}
+
+	res := make([]*dao.Comment, 0, len(items))
+	stale := make([]int64, 0)
+	for _, item := range items {
+		c, ok := mapping[item.Id]
+		if !ok || c.Oid != oid {
+			stale = append(stale, item.Id)
+			continue
+		}
+		// 置顶或者被隐藏的评论不出现在排行中
+		if c.IsPin == dao.AlreadyPinned || c.State != int8(model.CommentStateNormal) {
+			continue
+		}
+		res = append(res, c)
+	}
+
+	if len(stale) > 0 {
+		// 已删除的评论直接移出排行
+		_ = infra.Dao().CommentTrendCache.Remove(ctx, oid, stale...)
+	}
+
+	return res, nil
+}
+
+// 主评论的点赞点踩或者子评论数量变化后更新其热度
+func (b *CommentTrendBiz) RefreshRoot(ctx context.Context, rootId int64) error {
+	root, err := infra.Dao().CommentDao.FindById(ctx, rootId)
+	if err != nil {
+		if xsql.IsNoRecord(err) {
+			return nil
+		}
+		return xerror.Wrapf(err, "comment trend biz find root failed").WithExtra("rootId", rootId).WithCtx(ctx)
+	}
+
+	if !model.IsRoot(root.RootId, root.ParentId) {
+		return nil
+	}
+
+	trendItems, err := b.computeTrend(ctx, []*model.CommentItem{NewCommentItemFromDao(root)})
+	if err != nil {
+		return xerror.Wrapf(err, "comment trend biz compute trend failed").WithExtra("rootId", rootId).WithCtx(ctx)
+	}
+
+	err = infra.Dao().CommentTrendCache.UpdateWhenExist(ctx, root.Oid, trendItems[0])
+	if err != nil {
+		return xerror.Wrapf(err, "comment trend biz update trend failed").WithExtra("rootId", rootId).WithCtx(ctx)
+	}
+
+	return nil
+}
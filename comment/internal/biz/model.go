@@ -25,6 +25,8 @@ func NewCommentItemFromDao(d *dao.Comment) *model.CommentItem {
 		Mtime:      d.Mtime,
 		Ip:         xnet.BytesIpAsString(d.Ip),
 		IsPin:      d.IsPin == dao.AlreadyPinned,
+		Etime:      d.Etime,
+		State:      model.CommentState(d.State),
 	}
 }
 
@@ -37,15 +39,79 @@ func NewCommentItemSliceFromDao(ds []*dao.Comment) []*model.CommentItem {
 	return result
 }
 
+func NewReportItemFromDao(d *dao.CommentReport) *model.ReportItem {
+	return &model.ReportItem{
+		Id:         d.Id,
+		CommentId:  d.CommentId,
+		Oid:        d.Oid,
+		Reporter:   d.Reporter,
+		Reason:     model.ReportReason(d.Reason),
+		Detail:     d.Detail,
+		Resolution: model.ReportResolution(d.Resolution),
+		Ctime:      d.Ctime,
+		Utime:      d.Utime,
+	}
+}
+
 type ImageAssetMetadata struct {
 	Width  uint32 `json:"w"`
 	Height uint32 `json:"h"`
 	Format string `json:"f"`
 }
 
+// 表情资源的元数据 保存引用时的表情版本
+type EmojiAssetMetadata struct {
+	Pack    string `json:"p"`
+	Code    string `json:"c"`
+	Width   uint32 `json:"w"`
+	Height  uint32 `json:"h"`
+	Format  string `json:"f"`
+	Version int64  `json:"v"`
+}
+
+func NewEmojiPackFromDao(d *dao.CommentEmojiPack) *model.EmojiPack {
+	return &model.EmojiPack{
+		Id:       d.Id,
+		Code:     d.Code,
+		Name:     d.Name,
+		CoverKey: d.CoverKey,
+		Version:  d.Version,
+		Enabled:  d.Enabled == model.EmojiEnabled,
+		Ctime:    d.Ctime,
+		Mtime:    d.Mtime,
+	}
+}
+
+func NewEmojiItemFromDao(pack string, d *dao.CommentEmojiItem) *model.EmojiItem {
+	return &model.EmojiItem{
+		Id:       d.Id,
+		Pack:     pack,
+		Code:     d.Code,
+		Name:     d.Name,
+		StoreKey: d.StoreKey,
+		Width:    d.Width,
+		Height:   d.Height,
+		Format:   d.Format,
+		Version:  d.Version,
+		Enabled:  d.Enabled == model.EmojiEnabled,
+		Ctime:    d.Ctime,
+		Mtime:    d.Mtime,
+	}
+}
+
+func emojiStateOf(enabled bool) int8 {
+	if enabled {
+		return model.EmojiEnabled
+	}
+	return model.EmojiDisabled
+}
+
 func makePbCommentImage(assets []*dao.CommentAsset) []*commentv1.CommentItemImage {
 	imgs := make([]*commentv1.CommentItemImage, 0, len(assets))
 	for _, img := range assets {
+		if img.Type != model.CommentAssetImage {
+			continue
+		}
 		var meta ImageAssetMetadata
 		_ = json.Unmarshal(img.Metadata, &meta)
 		imgs = append(imgs, &commentv1.CommentItemImage{
@@ -81,3 +147,70 @@ func makeCommentAssetPO(commentId int64, images []*commentv1.CommentReqImage) []
 
 	return assets
 }
+
+func makePbCommentEmoji(assets []*dao.CommentAsset) []*commentv1.CommentEmoji {
+	emojis := make([]*commentv1.CommentEmoji, 0)
+	for _, asset := range assets {
+		if asset.Type != model.CommentAssetCustomEmoji {
+			continue
+		}
+		var meta EmojiAssetMetadata
+		_ = json.Unmarshal(asset.Metadata, &meta)
+		emojis = append(emojis, &commentv1.CommentEmoji{
+			Token:   model.EmojiToken{Pack: meta.Pack, Code: meta.Code}.String(),
+			Pack:    meta.Pack,
+			Code:    meta.Code,
+			Key:     asset.StoreKey,
+			Width:   meta.Width,
+			Height:  meta.Height,
+			Format:  meta.Format,
+			Version: meta.Version,
+		})
+	}
+
+	return emojis
+}
+
+func makeEmojiAssetPO(commentId int64, emojis []*model.EmojiItem) []*dao.CommentAsset {
+	assets := make([]*dao.CommentAsset, 0, len(emojis))
+	for _, e := range emojis {
+		meta := EmojiAssetMetadata{
+			Pack:    e.Pack,
+			Code:    e.Code,
+			Width:   e.Width,
+			Height:  e.Height,
+			Format:  e.Format,
+			Version: e.Version,
+		}
+		metadata, _ := json.Marshal(&meta)
+		assets = append(assets, &dao.CommentAsset{
+			CommentId: commentId,
+			Type:      model.CommentAssetCustomEmoji,
+			StoreKey:  e.StoreKey,
+			Metadata:  metadata,
+		})
+	}
+
+	return assets
+}
+
+func NewCommentSettingFromDao(d *dao.CommentSetting) *model.CommentSetting {
+	s := &model.CommentSetting{
+		Oid:   d.Oid,
+		Owner: d.Owner,
+		Mode:  model.CommentMode(d.Mode),
+		Mtime: d.Mtime,
+	}
+	if len(d.BlockedKeywords) > 0 {
+		_ = json.Unmarshal(d.BlockedKeywords, &s.BlockedKeywords)
+	}
+
+	return s
+}
+
+func NewMutedUserFromDao(d *dao.CommentUserMute) *model.MutedUser {
+	return &model.MutedUser{
+		Uid:   d.Uid,
+		Ctime: d.Ctime,
+	}
+}
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/ryanreadbooks/whimer/misc/xconf"
 	"github.com/zeromicro/go-zero/core/logx"
 	"github.com/zeromicro/go-zero/core/stores/redis"
@@ -26,12 +28,17 @@ type Config struct {
 			Passport xconf.Discovery `json:"passport"`
 			Note     xconf.Discovery `json:"note"`
 			Counter  xconf.Discovery `json:"counter"`
+			Msger    xconf.Discovery `json:"msger"`
+			Relation xconf.Discovery `json:"relation"`
 		} `json:"grpc"`
 	} `json:"external"`
 
 	Seqer Seqer           `json:"seqer"`
 	Redis redis.RedisConf `json:"redis"`
 
+	Report Report `json:"report"`
+	Edit   Edit   `json:"edit"`
+
 	Cron struct {
 	} `json:"cron"`
 }
@@ -43,3 +50,15 @@ func (c *Config) Init() error {
 type Seqer struct {
 	Addr string `json:"addr"`
 }
+
+// 评论举报
+type Report struct {
+	// 待处理的举报达到该数量后自动隐藏评论
+	HideThreshold int64 `json:"hide_threshold,default=5"`
+}
+
+// 评论编辑
+type Edit struct {
+	// 评论发布后可以编辑的时长
+	Window time.Duration `json:"window,default=15m"`
+}
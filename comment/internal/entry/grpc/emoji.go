@@ -0,0 +1,127 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/ryanreadbooks/whimer/comment/internal/global"
+	"github.com/ryanreadbooks/whimer/comment/internal/model"
+	"github.com/ryanreadbooks/whimer/comment/internal/srv"
+	commentv1 "github.com/ryanreadbooks/whimer/idl/gen/go/comment/api/v1"
+)
+
+// 一次最多查找的表情数量
+const maxBatchGetEmojiCount = 100
+
+type CommentEmojiServiceServer struct {
+	commentv1.UnimplementedCommentEmojiServiceServer
+
+	Svc *srv.Service
+}
+
+func NewCommentEmojiServiceServer(ctx *srv.Service) *CommentEmojiServiceServer {
+	return &CommentEmojiServiceServer{
+		Svc: ctx,
+	}
+}
+
+// 列出表情包
+func (s *CommentEmojiServiceServer) ListEmojiPacks(ctx context.Context,
+	in *commentv1.ListEmojiPacksRequest) (*commentv1.ListEmojiPacksResponse, error) {
+	packs, err := s.Svc.EmojiSrv.ListPacks(ctx, in.GetIncludeDisabled())
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*commentv1.EmojiPack, 0, len(packs))
+	for _, p := range packs {
+		res = append(res, p.AsPb())
+	}
+
+	return &commentv1.ListEmojiPacksResponse{Packs: res}, nil
+}
+
+// 获取表情包及其表情
+func (s *CommentEmojiServiceServer) GetEmojiPack(ctx context.Context,
+	in *commentv1.GetEmojiPackRequest) (*commentv1.GetEmojiPackResponse, error) {
+	if !model.IsValidEmojiCode(in.GetCode()) {
+		return nil, global.ErrInvalidEmojiCode
+	}
+
+	pack, items, err := s.Svc.EmojiSrv.GetPack(ctx, in.GetCode(), in.GetIncludeDisabled())
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*commentv1.EmojiItem, 0, len(items))
+	for _, item := range items {
+		res = append(res, item.AsPb())
+	}
+
+	return &commentv1.GetEmojiPackResponse{Pack: pack.AsPb(), Items: res}, nil
+}
+
+// 批量查找表情
+func (s *CommentEmojiServiceServer) BatchGetEmojiItems(ctx context.Context,
+	in *commentv1.BatchGetEmojiItemsRequest) (*commentv1.BatchGetEmojiItemsResponse, error) {
+	if len(in.GetTokens()) == 0 || len(in.GetTokens()) > maxBatchGetEmojiCount {
+		return nil, global.ErrArgs.Msg("表情数量错误")
+	}
+
+	items, err := s.Svc.EmojiSrv.BatchGetItems(ctx, in.GetTokens())
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]*commentv1.EmojiItem, len(items))
+	for token, item := range items {
+		res[token] = item.AsPb()
+	}
+
+	return &commentv1.BatchGetEmojiItemsResponse{Items: res}, nil
+}
+
+// 新增或者更新表情包
+func (s *CommentEmojiServiceServer) UpsertEmojiPack(ctx context.Context,
+	in *commentv1.UpsertEmojiPackRequest) (*commentv1.UpsertEmojiPackResponse, error) {
+	req := &model.UpsertEmojiPackReq{
+		Code:     in.GetCode(),
+		Name:     in.GetName(),
+		CoverKey: in.GetCoverKey(),
+		Enabled:  in.GetEnabled(),
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	pack, err := s.Svc.EmojiSrv.UpsertPack(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &commentv1.UpsertEmojiPackResponse{Pack: pack.AsPb()}, nil
+}
+
+// 新增或者更新表情
+func (s *CommentEmojiServiceServer) UpsertEmojiItem(ctx context.Context,
+	in *commentv1.UpsertEmojiItemRequest) (*commentv1.UpsertEmojiItemResponse, error) {
+	req := &model.UpsertEmojiItemReq{
+		Pack:     in.GetPack(),
+		Code:     in.GetCode(),
+		Name:     in.GetName(),
+		StoreKey: in.GetKey(),
+		Width:    in.GetWidth(),
+		Height:   in.GetHeight(),
+		Format:   in.GetFormat(),
+		Enabled:  in.GetEnabled(),
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	item, err := s.Svc.EmojiSrv.UpsertItem(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &commentv1.UpsertEmojiItemResponse{Item: item.AsPb()}, nil
+}
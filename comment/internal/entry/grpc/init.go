@@ -13,6 +13,9 @@ import (
 func Init(c zrpc.RpcServerConf, svc *srv.Service) *zrpc.RpcServer {
 	server := zrpc.MustNewServer(c, func(s *grpc.Server) {
 		commentv1.RegisterCommentServiceServer(s, NewCommentServiceServer(svc))
+		commentv1.RegisterCommentModerationServiceServer(s, NewCommentModerationServiceServer(svc))
+		commentv1.RegisterCommentEmojiServiceServer(s, NewCommentEmojiServiceServer(svc))
+		commentv1.RegisterCommentSettingServiceServer(s, NewCommentSettingServiceServer(svc))
 		xgrpc.EnableReflectionIfNecessary(c, s)
 	})
 	interceptor.InstallUnaryServerInterceptors(server,
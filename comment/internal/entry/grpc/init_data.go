@@ -14,4 +14,8 @@ var uidCheckIgnoredMethods = []string{
 	commentv1.CommentService_GetCommentDislikeCount_FullMethodName,
 	commentv1.CommentService_CheckUserOnObject_FullMethodName,
 	commentv1.CommentService_BatchCheckUserOnObject_FullMethodName,
+	commentv1.CommentService_GetCommentEditHistory_FullMethodName,
+	commentv1.CommentEmojiService_ListEmojiPacks_FullMethodName,
+	commentv1.CommentEmojiService_GetEmojiPack_FullMethodName,
+	commentv1.CommentEmojiService_BatchGetEmojiItems_FullMethodName,
 }
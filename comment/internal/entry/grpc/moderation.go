@@ -0,0 +1,72 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/ryanreadbooks/whimer/comment/internal/global"
+	"github.com/ryanreadbooks/whimer/comment/internal/model"
+	"github.com/ryanreadbooks/whimer/comment/internal/srv"
+	commentv1 "github.com/ryanreadbooks/whimer/idl/gen/go/comment/api/v1"
+)
+
+type CommentModerationServiceServer struct {
+	commentv1.UnimplementedCommentModerationServiceServer
+
+	Svc *srv.Service
+}
+
+func NewCommentModerationServiceServer(ctx *srv.Service) *CommentModerationServiceServer {
+	return &CommentModerationServiceServer{
+		Svc: ctx,
+	}
+}
+
+// 获取待处理的举报
+func (s *CommentModerationServiceServer) ListPendingReports(ctx context.Context,
+	in *commentv1.ListPendingReportsRequest) (*commentv1.ListPendingReportsResponse, error) {
+	res, err := s.Svc.CommentSrv.ListPendingReports(ctx, in.GetCursor(), int(in.GetCount()))
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]*commentv1.CommentReport, 0, len(res.Items))
+	for _, item := range res.Items {
+		reports = append(reports, item.AsPb())
+	}
+
+	comments := make(map[int64]*commentv1.CommentItem, len(res.Comments))
+	for id, c := range res.Comments {
+		comments[id] = c.AsPb()
+	}
+
+	return &commentv1.ListPendingReportsResponse{
+		Reports:    reports,
+		Comments:   comments,
+		NextCursor: res.NextCursor,
+		HasNext:    res.HasNext,
+	}, nil
+}
+
+// 处理举报
+func (s *CommentModerationServiceServer) ResolveReports(ctx context.Context,
+	in *commentv1.ResolveReportsRequest) (*commentv1.ResolveReportsResponse, error) {
+	if in.GetCommentId() <= 0 {
+		return nil, global.ErrInvalidCommentId
+	}
+
+	resolution, err := model.ReportResolutionFromPb(in.GetResolution())
+	if err != nil {
+		return nil, err
+	}
+
+	if in.GetBanSeconds() < 0 {
+		return nil, global.ErrArgs
+	}
+
+	resolved, err := s.Svc.CommentSrv.ResolveReports(ctx, in.GetCommentId(), resolution, in.GetBanSeconds())
+	if err != nil {
+		return nil, err
+	}
+
+	return &commentv1.ResolveReportsResponse{Resolved: resolved}, nil
+}
@@ -51,9 +51,56 @@ func (s *CommentServiceServer) AddComment(ctx context.Context, in *commentv1.Add
 
 	return &commentv1.AddCommentResponse{
 		CommentId: res.CommentId,
+		Held:      res.Held,
 	}, nil
 }
 
+// 编辑评论
+func (s *CommentServiceServer) EditComment(ctx context.Context, in *commentv1.EditCommentRequest) (*commentv1.EditCommentResponse, error) {
+	req := &model.EditCommentReq{
+		CommentId: in.GetCommentId(),
+		Content:   in.GetContent(),
+		Images:    in.GetImages(),
+		AtUsers:   in.GetAtUsers(),
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	res, err := s.Svc.CommentSrv.EditComment(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &commentv1.EditCommentResponse{
+		Etime:          res.Etime,
+		AddedAtUsers:   res.AddedAtUsers,
+		RemovedAtUsers: res.RemovedAtUsers,
+		Held:           res.Held,
+	}, nil
+}
+
+// 获取评论的编辑历史
+func (s *CommentServiceServer) GetCommentEditHistory(ctx context.Context, in *commentv1.GetCommentEditHistoryRequest) (
+	*commentv1.GetCommentEditHistoryResponse, error) {
+	if in.GetCommentId() <= 0 {
+		return nil, global.ErrInvalidCommentId
+	}
+
+	versions, err := s.Svc.CommentSrv.GetCommentEditHistory(ctx, in.GetCommentId())
+	if err != nil {
+		return nil, err
+	}
+
+	pbVersions := make([]*commentv1.CommentEditVersion, 0, len(versions))
+	for _, v := range versions {
+		pbVersions = append(pbVersions, v.AsPb())
+	}
+
+	return &commentv1.GetCommentEditHistoryResponse{Versions: pbVersions}, nil
+}
+
 // 删除评论
 func (s *CommentServiceServer) DelComment(ctx context.Context, in *commentv1.DelCommentRequest) (*commentv1.DelCommentResponse, error) {
 	if in.CommentId <= 0 {
@@ -107,9 +154,28 @@ func (s *CommentServiceServer) DislikeAction(ctx context.Context, in *commentv1.
 	return &commentv1.DislikeActionResponse{}, nil
 }
 
-// TODO 举报
+// 举报
 func (s *CommentServiceServer) ReportComment(ctx context.Context,
 	in *commentv1.ReportCommentRequest) (*commentv1.ReportCommentResponse, error) {
+	reason, err := model.ReportReasonFromPb(in.GetReason())
+	if err != nil {
+		return nil, err
+	}
+
+	req := &model.ReportCommentReq{
+		CommentId: in.GetCommentId(),
+		Reason:    reason,
+		Detail:    in.GetDetail(),
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	err = s.Svc.CommentSrv.ReportComment(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
 	return &commentv1.ReportCommentResponse{}, nil
 }
 
@@ -132,15 +198,16 @@ func (s *CommentServiceServer) PinComment(ctx context.Context,
 // 分页获取主评论
 func (s *CommentServiceServer) PageGetComment(ctx context.Context,
 	in *commentv1.PageGetCommentRequest) (*commentv1.PageGetCommentResponse, error) {
-	resp, err := s.Svc.CommentSrv.PageGetRootComments(ctx, in.Oid, in.Cursor, int8(in.SortBy))
+	resp, err := s.Svc.CommentSrv.PageGetRootComments(ctx, in.Oid, in.Cursor, in.TrendCursor, int8(in.SortBy))
 	if err != nil {
 		return nil, err
 	}
 
 	return &commentv1.PageGetCommentResponse{
-		Comments:   model.ItemsAsPbs(resp.Items),
-		HasNext:    resp.HasNext,
-		NextCursor: resp.NextCursor,
+		Comments:        model.ItemsAsPbs(resp.Items),
+		HasNext:         resp.HasNext,
+		NextCursor:      resp.NextCursor,
+		NextTrendCursor: resp.NextTrendCursor,
 	}, nil
 }
 
@@ -182,29 +249,31 @@ func (s *CommentServiceServer) PageGetSubCommentV2(ctx context.Context,
 
 func (s *CommentServiceServer) PageGetDetailedComment(ctx context.Context,
 	in *commentv1.PageGetDetailedCommentRequest) (*commentv1.PageGetDetailedCommentResponse, error) {
-	resp, err := s.Svc.CommentSrv.PageGetObjectComments(ctx, in.Oid, in.Cursor, int8(in.SortBy))
+	resp, err := s.Svc.CommentSrv.PageGetObjectComments(ctx, in.Oid, in.Cursor, in.TrendCursor, int8(in.SortBy))
 	if err != nil {
 		return nil, err
 	}
 
 	return &commentv1.PageGetDetailedCommentResponse{
-		Comments:   model.DetailedItemsAsPbs(resp.Items),
-		NextCursor: resp.NextCursor,
-		HasNext:    resp.HasNext,
+		Comments:        model.DetailedItemsAsPbs(resp.Items),
+		NextCursor:      resp.NextCursor,
+		NextTrendCursor: resp.NextTrendCursor,
+		HasNext:         resp.HasNext,
 	}, nil
 }
 
 func (s *CommentServiceServer) PageGetDetailedCommentV2(ctx context.Context,
 	in *commentv1.PageGetDetailedCommentV2Request) (*commentv1.PageGetDetailedCommentV2Response, error) {
-	resp, err := s.Svc.CommentSrv.PageGetObjectCommentsV2(ctx, in.Oid, in.Cursor, int8(in.SortBy))
+	resp, err := s.Svc.CommentSrv.PageGetObjectCommentsV2(ctx, in.Oid, in.Cursor, in.TrendCursor, int8(in.SortBy))
 	if err != nil {
 		return nil, err
 	}
 
 	return &commentv1.PageGetDetailedCommentV2Response{
-		RootComments: model.DetailedItemsV2AsPbs(resp.Items),
-		NextCursor:   resp.NextCursor,
-		HasNext:      resp.HasNext,
+		RootComments:    model.DetailedItemsV2AsPbs(resp.Items),
+		NextCursor:      resp.NextCursor,
+		NextTrendCursor: resp.NextTrendCursor,
+		HasNext:         resp.HasNext,
 	}, nil
 }
 
@@ -0,0 +1,152 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/ryanreadbooks/whimer/comment/internal/global"
+	"github.com/ryanreadbooks/whimer/comment/internal/model"
+	"github.com/ryanreadbooks/whimer/comment/internal/srv"
+	commentv1 "github.com/ryanreadbooks/whimer/idl/gen/go/comment/api/v1"
+)
+
+// 一次最多通过的评论数量
+const maxApproveHeldCount = 50
+
+type CommentSettingServiceServer struct {
+	commentv1.UnimplementedCommentSettingServiceServer
+
+	Svc *srv.Service
+}
+
+func NewCommentSettingServiceServer(ctx *srv.Service) *CommentSettingServiceServer {
+	return &CommentSettingServiceServer{
+		Svc: ctx,
+	}
+}
+
+// 获取对象的评论设置
+func (s *CommentSettingServiceServer) GetCommentSetting(ctx context.Context,
+	in *commentv1.GetCommentSettingRequest) (*commentv1.GetCommentSettingResponse, error) {
+	if in.GetOid() <= 0 {
+		return nil, global.ErrObjectIdEmpty
+	}
+
+	setting, err := s.Svc.SettingSrv.GetSetting(ctx, in.GetOid())
+	if err != nil {
+		return nil, err
+	}
+
+	return &commentv1.GetCommentSettingResponse{Setting: setting.AsPb()}, nil
+}
+
+// 更新对象的评论设置
+func (s *CommentSettingServiceServer) UpdateCommentSetting(ctx context.Context,
+	in *commentv1.UpdateCommentSettingRequest) (*commentv1.UpdateCommentSettingResponse, error) {
+	mode, err := model.CommentModeFromPb(in.GetMode())
+	if err != nil {
+		return nil, err
+	}
+
+	req := &model.UpdateCommentSettingReq{
+		Oid:             in.GetOid(),
+		Mode:            mode,
+		BlockedKeywords: in.GetBlockedKeywords(),
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	setting, err := s.Svc.SettingSrv.UpdateSetting(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &commentv1.UpdateCommentSettingResponse{Setting: setting.AsPb()}, nil
+}
+
+// 禁止/解除禁止用户评论
+func (s *CommentSettingServiceServer) MuteCommentUser(ctx context.Context,
+	in *commentv1.MuteCommentUserRequest) (*commentv1.MuteCommentUserResponse, error) {
+	if in.GetUid() <= 0 {
+		return nil, global.ErrArgs
+	}
+
+	if in.Action != commentv1.CommentAction_COMMENT_ACTION_DO &&
+		in.Action != commentv1.CommentAction_COMMENT_ACTION_UNDO {
+		return nil, global.ErrUnsupportedAction
+	}
+
+	err := s.Svc.SettingSrv.MuteUser(ctx, in.GetUid(), int8(in.Action))
+	if err != nil {
+		return nil, err
+	}
+
+	return &commentv1.MuteCommentUserResponse{}, nil
+}
+
+// 获取禁止评论的用户
+func (s *CommentSettingServiceServer) ListMutedCommentUsers(ctx context.Context,
+	in *commentv1.ListMutedCommentUsersRequest) (*commentv1.ListMutedCommentUsersResponse, error) {
+	res, err := s.Svc.SettingSrv.ListMutedUsers(ctx, in.GetCursor(), int(in.GetCount()))
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*commentv1.MutedCommentUser, 0, len(res.Items))
+	for _, u := range res.Items {
+		users = append(users, u.AsPb())
+	}
+
+	return &commentv1.ListMutedCommentUsersResponse{
+		Users:      users,
+		NextCursor: res.NextCursor,
+		HasNext:    res.HasNext,
+	}, nil
+}
+
+// 获取等待审核的评论
+func (s *CommentSettingServiceServer) ListHeldComments(ctx context.Context,
+	in *commentv1.ListHeldCommentsRequest) (*commentv1.ListHeldCommentsResponse, error) {
+	if in.GetOid() <= 0 {
+		return nil, global.ErrObjectIdEmpty
+	}
+
+	res, err := s.Svc.SettingSrv.ListHeldComments(ctx, in.GetOid(), in.GetCursor(), int(in.GetCount()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &commentv1.ListHeldCommentsResponse{
+		Comments:   model.ItemsAsPbs(res.Items),
+		NextCursor: res.NextCursor,
+		HasNext:    res.HasNext,
+	}, nil
+}
+
+// 通过等待审核的评论
+func (s *CommentSettingServiceServer) ApproveHeldComments(ctx context.Context,
+	in *commentv1.ApproveHeldCommentsRequest) (*commentv1.ApproveHeldCommentsResponse, error) {
+	if in.GetOid() <= 0 {
+		return nil, global.ErrObjectIdEmpty
+	}
+
+	ids := in.GetCommentIds()
+	if len(ids) == 0 || len(ids) > maxApproveHeldCount {
+		return nil, global.ErrArgs
+	}
+	for _, id := range ids {
+		if id <= 0 {
+			return nil, global.ErrInvalidCommentId
+		}
+	}
+
+	approved, err := s.Svc.SettingSrv.ApproveHeldComments(ctx, in.GetOid(), ids)
+	if err != nil {
+		return nil, err
+	}
+
+	return &commentv1.ApproveHeldCommentsResponse{
+		Approved: int64(len(approved)),
+		Comments: model.ItemsAsPbs(approved),
+	}, nil
+}
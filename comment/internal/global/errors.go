@@ -31,6 +31,18 @@ const (
 	ErrCommentGetCommentDislikeCountCode
 	ErrCommentUnsupportedActionCode
 	ErrCommentNoPinCommentCode
+	ErrCommentInvalidTrendCursorCode
+	ErrCommentInvalidReportReasonCode
+	ErrCommentReportDetailTooLongCode
+	ErrCommentInvalidReportResolutionCode
+	ErrCommentCantReportYourselfCode
+	ErrCommentAlreadyReportedCode
+	ErrCommentTooManyEmojisCode
+	ErrCommentInvalidEmojiCodeCode
+	ErrCommentInvalidCommentModeCode
+	ErrCommentBlockedKeywordTooLongCode
+	ErrCommentTooManyBlockedKeywordsCode
+	ErrCommentCantMuteYourselfCode
 )
 
 const (
@@ -46,6 +58,14 @@ const (
 
 	ErrCommentYouDontOwnThisCode = ErrPermissionCode + iota
 	ErrCommentYouCantPinCommentCode
+	ErrCommentUserBannedCode
+	ErrCommentEditWindowExpiredCode
+	ErrCommentNotEditableCode
+	ErrCommentNotObjectOwnerCode
+	ErrCommentClosedCode
+	ErrCommentFollowersOnlyCode
+	ErrCommentMutedByAuthorCode
+	ErrCommentNotReplyableCode
 )
 
 const (
@@ -53,6 +73,9 @@ const (
 
 	ErrCommentNoNoteCode = ErrNotFoundCode + iota
 	ErrCommentCommentNotFoundCode
+	ErrCommentNoPendingReportCode
+	ErrCommentEmojiNotFoundCode
+	ErrCommentEmojiPackNotFoundCode
 )
 
 // 业务错误定义
@@ -91,4 +114,31 @@ var (
 	ErrGetCommentDislikeCount = ErrBizCommentArgs.ErrCode(ErrCommentGetCommentDislikeCountCode).Msg("获取评论点踩失败")
 	ErrUnsupportedAction      = ErrBizCommentArgs.ErrCode(ErrCommentUnsupportedActionCode).Msg("不支持的操作")
 	ErrNoPinComment           = ErrBizCommentArgs.ErrCode(ErrCommentNoPinCommentCode).Msg("无置顶评论")
+	ErrInvalidTrendCursor     = ErrBizCommentArgs.ErrCode(ErrCommentInvalidTrendCursorCode).Msg("分页游标错误")
+
+	ErrInvalidReportReason     = ErrBizCommentArgs.ErrCode(ErrCommentInvalidReportReasonCode).Msg("举报原因错误")
+	ErrReportDetailTooLong     = ErrBizCommentArgs.ErrCode(ErrCommentReportDetailTooLongCode).Msg("举报说明太长")
+	ErrInvalidReportResolution = ErrBizCommentArgs.ErrCode(ErrCommentInvalidReportResolutionCode).Msg("举报处理方式错误")
+	ErrCantReportYourself      = ErrBizCommentArgs.ErrCode(ErrCommentCantReportYourselfCode).Msg("不能举报自己的评论")
+	ErrAlreadyReported         = ErrBizCommentArgs.ErrCode(ErrCommentAlreadyReportedCode).Msg("你已经举报过该评论")
+	ErrNoPendingReport         = ErrNotFound.ErrCode(ErrCommentNoPendingReportCode).Msg("该评论没有待处理的举报")
+	ErrUserBanned              = ErrBizCommentDenied.ErrCode(ErrCommentUserBannedCode).Msg("你已被禁止发表评论")
+
+	ErrEditWindowExpired  = ErrBizCommentDenied.ErrCode(ErrCommentEditWindowExpiredCode).Msg("评论已超过可编辑时间")
+	ErrCommentNotEditable = ErrBizCommentDenied.ErrCode(ErrCommentNotEditableCode).Msg("该评论不能编辑")
+
+	ErrTooManyEmojis     = ErrBizCommentArgs.ErrCode(ErrCommentTooManyEmojisCode).Msg("评论中的表情太多")
+	ErrInvalidEmojiCode  = ErrBizCommentArgs.ErrCode(ErrCommentInvalidEmojiCodeCode).Msg("表情代码错误")
+	ErrEmojiNotFound     = ErrNotFound.ErrCode(ErrCommentEmojiNotFoundCode).Msg("表情不存在或已下架")
+	ErrEmojiPackNotFound = ErrNotFound.ErrCode(ErrCommentEmojiPackNotFoundCode).Msg("表情包不存在")
+
+	ErrInvalidCommentMode     = ErrBizCommentArgs.ErrCode(ErrCommentInvalidCommentModeCode).Msg("评论模式错误")
+	ErrBlockedKeywordTooLong  = ErrBizCommentArgs.ErrCode(ErrCommentBlockedKeywordTooLongCode).Msg("屏蔽词太长")
+	ErrTooManyBlockedKeywords = ErrBizCommentArgs.ErrCode(ErrCommentTooManyBlockedKeywordsCode).Msg("屏蔽词太多")
+	ErrCantMuteYourself       = ErrBizCommentArgs.ErrCode(ErrCommentCantMuteYourselfCode).Msg("不能禁止自己评论")
+	ErrNotObjectOwner         = ErrBizCommentDenied.ErrCode(ErrCommentNotObjectOwnerCode).Msg("你不是该内容的作者")
+	ErrCommentClosed          = ErrBizCommentDenied.ErrCode(ErrCommentClosedCode).Msg("作者已关闭评论")
+	ErrCommentFollowersOnly   = ErrBizCommentDenied.ErrCode(ErrCommentFollowersOnlyCode).Msg("作者仅允许关注者评论")
+	ErrMutedByAuthor          = ErrBizCommentDenied.ErrCode(ErrCommentMutedByAuthorCode).Msg("你已被作者禁止评论")
+	ErrCommentNotReplyable    = ErrBizCommentDenied.ErrCode(ErrCommentNotReplyableCode).Msg("该评论审核中，暂时无法回复")
 )
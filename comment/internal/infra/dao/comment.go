@@ -25,6 +25,7 @@ type Comment struct {
 	Ip       []byte            `json:"ip"      db:"ip"`
 	Ctime    int64             `json:"ctime"   db:"ctime"`
 	Mtime    int64             `json:"mtime"   db:"mtime"`
+	Etime    int64             `json:"etime"   db:"etime"` // 最后编辑时间 0表示未编辑过
 }
 
 type RootParent struct {
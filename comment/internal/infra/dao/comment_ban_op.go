@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+)
+
+type CommentBanDao struct {
+	db *xsql.DB
+}
+
+func NewCommentBanDao(db *xsql.DB) *CommentBanDao {
+	return &CommentBanDao{
+		db: db,
+	}
+}
+
+const (
+	banFields = "uid,comment_id,operator,expire_at,ctime,mtime"
+
+	sqlSelBan    = "SELECT " + banFields + " FROM comment_user_ban WHERE uid=?"
+	sqlUpsertBan = "INSERT INTO comment_user_ban(" + banFields + ") VALUES(?,?,?,?,?,?) " +
+		"ON DUPLICATE KEY UPDATE comment_id=VALUES(comment_id), operator=VALUES(operator), " +
+		"expire_at=VALUES(expire_at), mtime=VALUES(mtime)"
+)
+
+func (d *CommentBanDao) Get(ctx context.Context, uid int64) (*CommentUserBan, error) {
+	var res CommentUserBan
+	err := d.db.QueryRowCtx(ctx, &res, sqlSelBan, uid)
+	if err != nil {
+		return nil, xsql.ConvertError(err)
+	}
+
+	return &res, nil
+}
+
+// 重复禁止时覆盖原有的记录
+func (d *CommentBanDao) Upsert(ctx context.Context, ban *CommentUserBan) error {
+	_, err := d.db.ExecCtx(ctx, sqlUpsertBan,
+		ban.Uid,
+		ban.CommentId,
+		ban.Operator,
+		ban.ExpireAt,
+		ban.Ctime,
+		ban.Mtime)
+
+	return xsql.ConvertError(err)
+}
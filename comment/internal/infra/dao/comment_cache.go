@@ -24,6 +24,7 @@ const (
 const (
 	pinnedCommentForOidCacheKey = "comment:pinned:%d" // 置顶评论
 	commentCountForOidCacheKey  = "comment:count:%d"  // 评论数量
+	commentTrendForOidCacheKey  = "comment:trend:%d"  // 主评论热度排行
 )
 
 const (
@@ -38,6 +39,10 @@ func getCommentCountCacheKey(oid int64) string {
 	return fmt.Sprintf(commentCountForOidCacheKey, oid)
 }
 
+func getCommentTrendCacheKey(oid int64) string {
+	return fmt.Sprintf(commentTrendForOidCacheKey, oid)
+}
+
 func getAssetListCacheKey(commentId int64) string {
 	return fmt.Sprintf(assetListCacheKey, commentId)
 }
@@ -0,0 +1,18 @@
+package dao
+
+import (
+	"encoding/json"
+
+	"github.com/ryanreadbooks/whimer/comment/internal/model"
+)
+
+// comment_edit_history表 评论被编辑前的版本
+type CommentEditHistory struct {
+	Id        int64             `db:"id"         json:"id"`
+	CommentId int64             `db:"comment_id" json:"comment_id"`
+	Type      model.CommentType `db:"type"       json:"type"`
+	Content   string            `db:"content"    json:"content"`
+	Images    json.RawMessage   `db:"images"     json:"images"`   // 该版本的图片资源
+	AtUsers   json.RawMessage   `db:"at_users"   json:"at_users"` // 该版本的@用户
+	Ctime     int64             `db:"ctime"      json:"ctime"`    // 该版本被替换的时间
+}
@@ -0,0 +1,66 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+)
+
+type CommentEditHistoryDao struct {
+	db *xsql.DB
+}
+
+func NewCommentEditHistoryDao(db *xsql.DB) *CommentEditHistoryDao {
+	return &CommentEditHistoryDao{
+		db: db,
+	}
+}
+
+const (
+	editHistoryFields          = "id,comment_id,type,content,images,at_users,ctime"
+	editHistoryFieldsWithoutId = "comment_id,type,content,images,at_users,ctime"
+
+	sqlInsertEditHistory = "INSERT INTO comment_edit_history(" + editHistoryFieldsWithoutId + ") VALUES(?,?,?,?,?,?)"
+	sqlSelEditHistory    = "SELECT " + editHistoryFields + " FROM comment_edit_history WHERE comment_id=? ORDER BY id DESC LIMIT ?"
+	sqlDelEditHistory    = "DELETE FROM comment_edit_history WHERE comment_id=?"
+
+	// 先查comment再删comment_edit_history
+	sqlBatchDelEditHistoryBySelectedCommentId = "DELETE FROM comment_edit_history WHERE comment_id IN (SELECT id FROM comment WHERE root=?) OR comment_id=?"
+)
+
+func (d *CommentEditHistoryDao) Insert(ctx context.Context, h *CommentEditHistory) error {
+	images, atUsers := h.Images, h.AtUsers
+	if images == nil {
+		images = json.RawMessage("[]")
+	}
+	if atUsers == nil {
+		atUsers = json.RawMessage("[]")
+	}
+
+	_, err := d.db.ExecCtx(ctx, sqlInsertEditHistory, h.CommentId, h.Type, h.Content, images, atUsers, h.Ctime)
+	return xsql.ConvertError(err)
+}
+
+// 按照编辑时间从新到旧获取最多limit个历史版本
+func (d *CommentEditHistoryDao) ListByCommentId(ctx context.Context, commentId int64, limit int) ([]*CommentEditHistory, error) {
+	var res = make([]*CommentEditHistory, 0)
+	err := d.db.QueryRowsCtx(ctx, &res, sqlSelEditHistory, commentId, limit)
+	if err != nil {
+		return nil, xsql.ConvertError(err)
+	}
+
+	return res, nil
+}
+
+func (d *CommentEditHistoryDao) DeleteByCommentId(ctx context.Context, commentId int64) error {
+	_, err := d.db.ExecCtx(ctx, sqlDelEditHistory, commentId)
+	return xsql.ConvertError(err)
+}
+
+// 删除根评论为root的子评论的所有历史版本, 并且一并删除root的历史版本
+func (d *CommentEditHistoryDao) BatchDeleteByRoot(ctx context.Context, root int64) error {
+	_, err := d.db.ExecCtx(ctx, sqlBatchDelEditHistoryBySelectedCommentId, root, root)
+	return xerror.Wrap(xsql.ConvertError(err))
+}
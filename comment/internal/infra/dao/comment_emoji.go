@@ -0,0 +1,31 @@
+package dao
+
+// comment_emoji_pack表 uk(code)
+type CommentEmojiPack struct {
+	Id       int64  `db:"id"        json:"id"`
+	Code     string `db:"code"      json:"code"`
+	Name     string `db:"name"      json:"name"`
+	CoverKey string `db:"cover_key" json:"cover_key"`
+	Version  int64  `db:"version"   json:"version"` // 表情包及其表情每次变更时递增
+	Enabled  int8   `db:"enabled"   json:"enabled"`
+	Ctime    int64  `db:"ctime"     json:"ctime"`
+	Mtime    int64  `db:"mtime"     json:"mtime"`
+}
+
+// comment_emoji_item表 uk(pack_id, code)
+//
+// 表情资源更新时store_key指向新的对象 旧对象保留给已经引用它的评论
+type CommentEmojiItem struct {
+	Id       int64  `db:"id"        json:"id"`
+	PackId   int64  `db:"pack_id"   json:"pack_id"`
+	Code     string `db:"code"      json:"code"`
+	Name     string `db:"name"      json:"name"`
+	StoreKey string `db:"store_key" json:"store_key"`
+	Width    uint32 `db:"width"     json:"width"`
+	Height   uint32 `db:"height"    json:"height"`
+	Format   string `db:"format"    json:"format"`
+	Version  int64  `db:"version"   json:"version"` // 最后一次变更时的表情包版本
+	Enabled  int8   `db:"enabled"   json:"enabled"`
+	Ctime    int64  `db:"ctime"     json:"ctime"`
+	Mtime    int64  `db:"mtime"     json:"mtime"`
+}
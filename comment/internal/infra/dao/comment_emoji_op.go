@@ -0,0 +1,179 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xslice"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+)
+
+type CommentEmojiDao struct {
+	db *xsql.DB
+}
+
+func NewCommentEmojiDao(db *xsql.DB) *CommentEmojiDao {
+	return &CommentEmojiDao{
+		db: db,
+	}
+}
+
+const (
+	emojiPackFields = "id,code,name,cover_key,version,enabled,ctime,mtime"
+	emojiItemFields = "id,pack_id,code,name,store_key,width,height,format,version,enabled,ctime,mtime"
+
+	sqlUpsertEmojiPack = "INSERT INTO comment_emoji_pack(code,name,cover_key,version,enabled,ctime,mtime) VALUES(?,?,?,?,?,?,?) " +
+		"ON DUPLICATE KEY UPDATE name=VALUES(name), cover_key=VALUES(cover_key), version=VALUES(version), " +
+		"enabled=VALUES(enabled), mtime=VALUES(mtime)"
+	sqlSelEmojiPackByCode          = "SELECT " + emojiPackFields + " FROM comment_emoji_pack WHERE code=?"
+	sqlSelEmojiPackByCodeForUpdate = sqlSelEmojiPackByCode + " FOR UPDATE"
+	sqlListEmojiPacks              = "SELECT " + emojiPackFields + " FROM comment_emoji_pack ORDER BY id"
+	sqlListEnabledEmojiPacks       = "SELECT " + emojiPackFields + " FROM comment_emoji_pack WHERE enabled=1 ORDER BY id"
+	sqlBatchSelEnabledEmojiPacks   = "SELECT " + emojiPackFields + " FROM comment_emoji_pack WHERE enabled=1 AND code IN (%s)"
+	sqlUpdEmojiPackVersion         = "UPDATE comment_emoji_pack SET version=?, mtime=? WHERE id=?"
+
+	sqlUpsertEmojiItem = "INSERT INTO comment_emoji_item(pack_id,code,name,store_key,width,height,format,version,enabled,ctime,mtime) " +
+		"VALUES(?,?,?,?,?,?,?,?,?,?,?) " +
+		"ON DUPLICATE KEY UPDATE name=VALUES(name), store_key=VALUES(store_key), width=VALUES(width), height=VALUES(height), " +
+		"format=VALUES(format), version=VALUES(version), enabled=VALUES(enabled), mtime=VALUES(mtime)"
+	sqlSelEmojiItem              = "SELECT " + emojiItemFields + " FROM comment_emoji_item WHERE pack_id=? AND code=?"
+	sqlListEmojiItems            = "SELECT " + emojiItemFields + " FROM comment_emoji_item WHERE pack_id=? ORDER BY id"
+	sqlListEnabledEmojiItems     = "SELECT " + emojiItemFields + " FROM comment_emoji_item WHERE pack_id=? AND enabled=1 ORDER BY id"
+	sqlBatchSelEnabledEmojiItems = "SELECT " + emojiItemFields + " FROM comment_emoji_item WHERE enabled=1 AND pack_id IN (%s) AND code IN (%s)"
+)
+
+// 按照code新增或者更新表情包
+func (d *CommentEmojiDao) UpsertPack(ctx context.Context, p *CommentEmojiPack) error {
+	_, err := d.db.ExecCtx(ctx, sqlUpsertEmojiPack,
+		p.Code,
+		p.Name,
+		p.CoverKey,
+		p.Version,
+		p.Enabled,
+		p.Ctime,
+		p.Mtime)
+
+	return xsql.ConvertError(err)
+}
+
+func (d *CommentEmojiDao) GetPackByCode(ctx context.Context, code string) (*CommentEmojiPack, error) {
+	var res CommentEmojiPack
+	err := d.db.QueryRowCtx(ctx, &res, sqlSelEmojiPackByCode, code)
+	if err != nil {
+		return nil, xsql.ConvertError(err)
+	}
+
+	return &res, nil
+}
+
+func (d *CommentEmojiDao) GetPackByCodeForUpdate(ctx context.Context, code string) (*CommentEmojiPack, error) {
+	var res CommentEmojiPack
+	err := d.db.QueryRowCtx(ctx, &res, sqlSelEmojiPackByCodeForUpdate, code)
+	if err != nil {
+		return nil, xsql.ConvertError(err)
+	}
+
+	return &res, nil
+}
+
+func (d *CommentEmojiDao) ListPacks(ctx context.Context, includeDisabled bool) ([]*CommentEmojiPack, error) {
+	sql := sqlListEnabledEmojiPacks
+	if includeDisabled {
+		sql = sqlListEmojiPacks
+	}
+
+	var res = make([]*CommentEmojiPack, 0)
+	err := d.db.QueryRowsCtx(ctx, &res, sql)
+	if err != nil {
+		return nil, xsql.ConvertError(err)
+	}
+
+	return res, nil
+}
+
+// 批量获取上架中的表情包
+func (d *CommentEmojiDao) BatchGetEnabledPacks(ctx context.Context, codes []string) ([]*CommentEmojiPack, error) {
+	if len(codes) == 0 {
+		return nil, nil
+	}
+
+	sql := fmt.Sprintf(sqlBatchSelEnabledEmojiPacks, xslice.JoinStrings(xslice.Repeat("?", len(codes))))
+	var res = make([]*CommentEmojiPack, 0, len(codes))
+	err := d.db.QueryRowsCtx(ctx, &res, sql, xslice.Any(codes)...)
+	if err != nil {
+		return nil, xerror.Wrap(xsql.ConvertError(err))
+	}
+
+	return res, nil
+}
+
+func (d *CommentEmojiDao) UpdatePackVersion(ctx context.Context, id, version, mtime int64) error {
+	_, err := d.db.ExecCtx(ctx, sqlUpdEmojiPackVersion, version, mtime, id)
+	return xsql.ConvertError(err)
+}
+
+// 按照(pack_id, code)新增或者更新表情
+func (d *CommentEmojiDao) UpsertItem(ctx context.Context, item *CommentEmojiItem) error {
+	_, err := d.db.ExecCtx(ctx, sqlUpsertEmojiItem,
+		item.PackId,
+		item.Code,
+		item.Name,
+		item.StoreKey,
+		item.Width,
+		item.Height,
+		item.Format,
+		item.Version,
+		item.Enabled,
+		item.Ctime,
+		item.Mtime)
+
+	return xsql.ConvertError(err)
+}
+
+func (d *CommentEmojiDao) GetItem(ctx context.Context, packId int64, code string) (*CommentEmojiItem, error) {
+	var res CommentEmojiItem
+	err := d.db.QueryRowCtx(ctx, &res, sqlSelEmojiItem, packId, code)
+	if err != nil {
+		return nil, xsql.ConvertError(err)
+	}
+
+	return &res, nil
+}
+
+func (d *CommentEmojiDao) ListItems(ctx context.Context, packId int64, includeDisabled bool) ([]*CommentEmojiItem, error) {
+	sql := sqlListEnabledEmojiItems
+	if includeDisabled {
+		sql = sqlListEmojiItems
+	}
+
+	var res = make([]*CommentEmojiItem, 0)
+	err := d.db.QueryRowsCtx(ctx, &res, sql, packId)
+	if err != nil {
+		return nil, xsql.ConvertError(err)
+	}
+
+	return res, nil
+}
+
+// 获取多个表情包中指定代码的上架表情
+//
+// 结果是packIds和codes的笛卡尔积的子集 调用方需要自行按照(pack_id, code)过滤
+func (d *CommentEmojiDao) BatchGetEnabledItems(ctx context.Context, packIds []int64, codes []string) ([]*CommentEmojiItem, error) {
+	if len(packIds) == 0 || len(codes) == 0 {
+		return nil, nil
+	}
+
+	sql := fmt.Sprintf(sqlBatchSelEnabledEmojiItems,
+		xslice.JoinStrings(xslice.Repeat("?", len(packIds))),
+		xslice.JoinStrings(xslice.Repeat("?", len(codes))))
+	args := append(xslice.Any(packIds), xslice.Any(codes)...)
+
+	var res = make([]*CommentEmojiItem, 0, len(codes))
+	err := d.db.QueryRowsCtx(ctx, &res, sql, args...)
+	if err != nil {
+		return nil, xerror.Wrap(xsql.ConvertError(err))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,65 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestEmojiDao(t *testing.T) {
+	Convey("TestEmojiDao", t, func() {
+		now := time.Now().Unix()
+		err := testEmojiDao.UpsertPack(testCtx, &CommentEmojiPack{
+			Code:    "test_doge",
+			Name:    "狗头",
+			Version: 1,
+			Enabled: 1,
+			Ctime:   now,
+			Mtime:   now,
+		})
+		So(err, ShouldBeNil)
+
+		pack, err := testEmojiDao.GetPackByCode(testCtx, "test_doge")
+		So(err, ShouldBeNil)
+		So(pack.Name, ShouldEqual, "狗头")
+
+		for _, code := range []string{"smile", "cry"} {
+			err = testEmojiDao.UpsertItem(testCtx, &CommentEmojiItem{
+				PackId:   pack.Id,
+				Code:     code,
+				StoreKey: "emoji/test_doge/" + code + ".png",
+				Width:    64,
+				Height:   64,
+				Format:   "png",
+				Version:  1,
+				Enabled:  1,
+				Ctime:    now,
+				Mtime:    now,
+			})
+			So(err, ShouldBeNil)
+		}
+
+		// 下架一个表情
+		item, err := testEmojiDao.GetItem(testCtx, pack.Id, "cry")
+		So(err, ShouldBeNil)
+		item.Enabled = 0
+		item.Version = 2
+		So(testEmojiDao.UpsertItem(testCtx, item), ShouldBeNil)
+		So(testEmojiDao.UpdatePackVersion(testCtx, pack.Id, 2, now), ShouldBeNil)
+
+		items, err := testEmojiDao.ListItems(testCtx, pack.Id, false)
+		So(err, ShouldBeNil)
+		So(len(items), ShouldEqual, 1)
+
+		packs, err := testEmojiDao.BatchGetEnabledPacks(testCtx, []string{"test_doge", "test_none"})
+		So(err, ShouldBeNil)
+		So(len(packs), ShouldEqual, 1)
+		So(packs[0].Version, ShouldEqual, 2)
+
+		items, err = testEmojiDao.BatchGetEnabledItems(testCtx, []int64{pack.Id}, []string{"smile", "cry"})
+		So(err, ShouldBeNil)
+		So(len(items), ShouldEqual, 1)
+		So(items[0].Code, ShouldEqual, "smile")
+	})
+}
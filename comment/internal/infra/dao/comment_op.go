@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/ryanreadbooks/whimer/comment/internal/model"
 	"github.com/ryanreadbooks/whimer/misc/xcache"
 	xcachev2 "github.com/ryanreadbooks/whimer/misc/xcache/v2"
 	"github.com/ryanreadbooks/whimer/misc/xerror"
@@ -41,12 +42,13 @@ func NewCommentDao(db *xsql.DB, rd *redis.Redis) *CommentDao {
 
 // all sqls here
 const (
-	fields          = "id,oid,type,content,uid,root,parent,ruid,state,`like`,dislike,report,pin,ip,ctime,mtime"
-	fieldsWithoutId = "oid,type,content,uid,root,parent,ruid,state,`like`,dislike,report,pin,ip,ctime,mtime"
+	fields          = "id,oid,type,content,uid,root,parent,ruid,state,`like`,dislike,report,pin,ip,ctime,mtime,etime"
+	fieldsWithoutId = "oid,type,content,uid,root,parent,ruid,state,`like`,dislike,report,pin,ip,ctime,mtime,etime"
 
 	forUpdate = "FOR UPDATE"
 
 	sqlUdState    = "UPDATE comment SET state=?, mtime=? WHERE id=?"
+	sqlUdContent  = "UPDATE comment SET type=?, content=?, etime=?, mtime=? WHERE id=?"
 	sqlIncLike    = "UPDATE comment SET `like`=`like`+1, mtime=? WHERE id=?"
 	sqlDecLike    = "UPDATE comment SET `like`=`like`-1, mtime=? WHERE id=?"
 	sqlIncDislike = "UPDATE comment SET dislike=dislike+1, mtime=? WHERE id=?"
@@ -72,27 +74,28 @@ const (
 
 const (
 	sqlSelRootParentById = "SELECT id,root,parent,oid,pin FROM comment WHERE id=?"
-	sqlCountByO          = "SELECT COUNT(*) FROM comment WHERE oid=?"
-	sqlBatchCountByO     = "SELECT oid, COUNT(*) AS cnt FROM comment WHERE oid IN (%s) GROUP BY oid"
+	sqlCountByO          = "SELECT COUNT(*) FROM comment WHERE oid=? AND state!=2" // 等待作者审核的评论不计数
+	sqlBatchCountByO     = "SELECT oid, COUNT(*) AS cnt FROM comment WHERE oid IN (%s) AND state!=2 GROUP BY oid"
 	sqlCountByOU         = "SELECT COUNT(*) FROM comment WHERE oid=? AND uid=?"
-	sqlCountGbO          = "SELECT oid, COUNT(*) AS cnt FROM comment GROUP BY oid"
-	sqlCountGbOLimit     = "SELECT oid, COUNT(*) AS cnt FROM comment GROUP BY oid LIMIT ?,?"
-	sqlSelPinned         = "SELECT " + fields + " FROM comment WHERE oid=? AND pin=1 LIMIT 1"
+	sqlCountGbO          = "SELECT oid, COUNT(*) AS cnt FROM comment WHERE state!=2 GROUP BY oid"
+	sqlCountGbOLimit     = "SELECT oid, COUNT(*) AS cnt FROM comment WHERE state!=2 GROUP BY oid LIMIT ?,?"
+	sqlSelPinned         = "SELECT " + fields + " FROM comment WHERE oid=? AND pin=1 AND state=0 LIMIT 1"
 	sqlSel               = "SELECT " + fields + " FROM comment WHERE id=?"
 	sqlSel4Ud            = "SELECT " + fields + " FROM comment WHERE id=? FOR UPDATE"
 	sqlSelByO            = "SELECT " + fields + " FROM comment WHERE oid=? %s"
 	sqlSelByRoot         = "SELECT " + fields + " FROM comment WHERE root=? %s"
-	sqlSelRoots          = "SELECT " + fields + " FROM comment WHERE %s oid=? AND root=0 AND pin=0 ORDER BY ctime DESC LIMIT ?"
-	sqlSelSubs           = "SELECT " + fields + " FROM comment WHERE id>? AND oid=? AND root=? ORDER BY ctime ASC LIMIT ?"
-	sqlPageSelSubs       = "SELECT " + fields + " FROM comment WHERE oid=? AND root=? ORDER BY ctime ASC, id ASC LIMIT ?,?"
-	sqlBatchCountSubs    = "SELECT root, COUNT(id) cnt FROM comment WHERE root!=0 AND root IN (%s) GROUP BY root"
-	sqlCountSubs         = "SELECT COUNT(*) FROM comment WHERE oid=? AND root=?"
+	sqlSelRoots          = "SELECT " + fields + " FROM comment WHERE %s oid=? AND root=0 AND pin=0 AND state=0 ORDER BY ctime DESC LIMIT ?"
+	sqlSelSubs           = "SELECT " + fields + " FROM comment WHERE id>? AND oid=? AND root=? AND state=0 ORDER BY ctime ASC LIMIT ?"
+	sqlPageSelSubs       = "SELECT " + fields + " FROM comment WHERE oid=? AND root=? AND state=0 ORDER BY ctime ASC, id ASC LIMIT ?,?"
+	sqlBatchCountSubs    = "SELECT root, COUNT(id) cnt FROM comment WHERE root!=0 AND root IN (%s) AND state=0 GROUP BY root"
+	sqlCountSubs         = "SELECT COUNT(*) FROM comment WHERE oid=? AND root=? AND state=0"
 	sqlBatchSelAll       = "SELECT " + fields + " FROM comment WHERE id IN (%s)"
 	sqlSelUid            = "SELECT uid FROM comment WHERE id=?"
+	sqlPageSelByState    = "SELECT " + fields + " FROM comment WHERE oid=? AND state=? AND id>? ORDER BY id ASC LIMIT ?"
 )
 
 var (
-	sqlInsert = fmt.Sprintf("INSERT INTO comment(%s) VALUES(?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)", fieldsWithoutId)
+	sqlInsert = fmt.Sprintf("INSERT INTO comment(%s) VALUES(?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)", fieldsWithoutId)
 )
 
 func (r *CommentDao) FindByIdForUpdate(ctx context.Context, id int64) (*Comment, error) {
@@ -215,7 +218,8 @@ func (r *CommentDao) Insert(ctx context.Context, model *Comment) (int64, error)
 		model.IsPin,
 		model.Ip,
 		model.Ctime,
-		model.Mtime)
+		model.Mtime,
+		model.Etime)
 	if err != nil {
 		return 0, xsql.ConvertError(err)
 	}
@@ -309,6 +313,43 @@ func (r *CommentDao) SubDisLike(ctx context.Context, id int64) error {
 	return r.updateCount(ctx, sqlDecDislike, id)
 }
 
+// 修改评论状态
+func (r *CommentDao) SetState(ctx context.Context, oid, id int64, state int8) error {
+	// 被隐藏的可能是置顶评论
+	defer func() {
+		if _, err := r.pinnedCache.Del(ctx, getPinnedCommentCacheKey(oid)); err != nil {
+			xlog.Msg("pinned cache del pinned failed").Extra("oid", oid).Errorx(ctx)
+		}
+	}()
+
+	_, err := r.db.ExecCtx(ctx, sqlUdState, state, time.Now().Unix(), id)
+	return xsql.ConvertError(err)
+}
+
+// 按照id从小到大分页获取对象下指定状态的评论
+func (r *CommentDao) PageByState(ctx context.Context, oid int64, state int8, cursor int64, count int) ([]*Comment, error) {
+	var res = make([]*Comment, 0, count)
+	err := r.db.QueryRowsCtx(ctx, &res, sqlPageSelByState, oid, state, cursor, count)
+	if err != nil {
+		return nil, xsql.ConvertError(err)
+	}
+
+	return res, nil
+}
+
+// 编辑评论内容
+func (r *CommentDao) UpdateContent(ctx context.Context, oid, id int64, typ model.CommentType, content string, etime int64) error {
+	// 编辑的可能是置顶评论
+	defer func() {
+		if _, err := r.pinnedCache.Del(ctx, getPinnedCommentCacheKey(oid)); err != nil {
+			xlog.Msg("pinned cache del pinned failed").Extra("oid", oid).Errorx(ctx)
+		}
+	}()
+
+	_, err := r.db.ExecCtx(ctx, sqlUdContent, typ, content, etime, etime, id)
+	return xsql.ConvertError(err)
+}
+
 func (r *CommentDao) setPin(ctx context.Context, oid, id int64, pin bool) error {
 	// 移除缓存
 	defer func() {
@@ -472,6 +513,10 @@ func (r *CommentDao) IncrCommentCount(ctx context.Context, oid int64) error {
 	return r.cache.IncrCommentCountWhenExist(ctx, oid, 1)
 }
 
+func (r *CommentDao) IncrCommentCountBy(ctx context.Context, oid, increment int64) error {
+	return r.cache.IncrCommentCountWhenExist(ctx, oid, increment)
+}
+
 func (r *CommentDao) DecrCommentCount(ctx context.Context, oid int64) error {
 	return r.cache.DecrCommentCountWhenExist(ctx, oid, 1)
 }
@@ -16,6 +16,9 @@ var (
 	testCommentDao      *CommentDao
 	testCommentAssetDao *CommentAssetDao
 	testCommentExtDao   *CommentExtDao
+	testReportDao       *CommentReportDao
+	testEmojiDao        *CommentEmojiDao
+	testSettingDao      *CommentSettingDao
 	testCtx             = context.TODO()
 	testCache           *redis.Redis
 )
@@ -36,6 +39,9 @@ func TestMain(m *testing.M) {
 	testCommentDao = NewCommentDao(xsql.New(db), testCache)
 	testCommentAssetDao = NewCommentAssetDao(xsql.New(db), testCache)
 	testCommentExtDao = NewCommentExtDao(xsql.New(db), testCache)
+	testReportDao = NewCommentReportDao(xsql.New(db))
+	testEmojiDao = NewCommentEmojiDao(xsql.New(db))
+	testSettingDao = NewCommentSettingDao(xsql.New(db))
 	m.Run()
 }
 
@@ -0,0 +1,25 @@
+package dao
+
+// comment_report表 同一个用户对同一条评论只能举报一次 uk(comment_id, reporter)
+type CommentReport struct {
+	Id         int64  `db:"id"         json:"id"`
+	CommentId  int64  `db:"comment_id" json:"comment_id"`
+	Oid        int64  `db:"oid"        json:"oid"`
+	Reporter   int64  `db:"reporter"   json:"reporter"`
+	Reason     int8   `db:"reason"     json:"reason"`
+	Detail     string `db:"detail"     json:"detail"`
+	Resolution int8   `db:"resolution" json:"resolution"`
+	Operator   int64  `db:"operator"   json:"operator"` // 处理举报的审核人员
+	Ctime      int64  `db:"ctime"      json:"ctime"`
+	Utime      int64  `db:"utime"      json:"utime"`
+}
+
+// comment_user_ban表 被禁止发表评论的用户 pk(uid)
+type CommentUserBan struct {
+	Uid       int64 `db:"uid"        json:"uid"`
+	CommentId int64 `db:"comment_id" json:"comment_id"` // 导致被禁止的评论
+	Operator  int64 `db:"operator"   json:"operator"`
+	ExpireAt  int64 `db:"expire_at"  json:"expire_at"` // 0表示永久
+	Ctime     int64 `db:"ctime"      json:"ctime"`
+	Mtime     int64 `db:"mtime"      json:"mtime"`
+}
@@ -0,0 +1,87 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+)
+
+type CommentReportDao struct {
+	db *xsql.DB
+}
+
+func NewCommentReportDao(db *xsql.DB) *CommentReportDao {
+	return &CommentReportDao{
+		db: db,
+	}
+}
+
+const (
+	reportFields          = "id,comment_id,oid,reporter,reason,detail,resolution,operator,ctime,utime"
+	reportFieldsWithoutId = "comment_id,oid,reporter,reason,detail,resolution,operator,ctime,utime"
+
+	sqlInsertReport       = "INSERT INTO comment_report(" + reportFieldsWithoutId + ") VALUES(?,?,?,?,?,?,?,?,?)"
+	sqlCountPendingReport = "SELECT COUNT(*) FROM comment_report WHERE comment_id=? AND resolution=0"
+	sqlSelPendingReport   = "SELECT " + reportFields + " FROM comment_report WHERE comment_id=? AND resolution=0"
+	sqlPagePendingReport  = "SELECT " + reportFields + " FROM comment_report WHERE id>? AND resolution=0 ORDER BY id ASC LIMIT ?"
+	sqlResolveReport      = "UPDATE comment_report SET resolution=?, operator=?, utime=? WHERE comment_id=? AND resolution=0"
+)
+
+// 重复举报返回[xsql.ErrDuplicate]
+func (d *CommentReportDao) Insert(ctx context.Context, report *CommentReport) (int64, error) {
+	res, err := d.db.ExecCtx(ctx, sqlInsertReport,
+		report.CommentId,
+		report.Oid,
+		report.Reporter,
+		report.Reason,
+		report.Detail,
+		report.Resolution,
+		report.Operator,
+		report.Ctime,
+		report.Utime)
+	if err != nil {
+		return 0, xsql.ConvertError(err)
+	}
+
+	id, _ := res.LastInsertId()
+	return id, nil
+}
+
+// 评论的待处理举报数量
+func (d *CommentReportDao) CountPending(ctx context.Context, commentId int64) (int64, error) {
+	var cnt int64
+	err := d.db.QueryRowCtx(ctx, &cnt, sqlCountPendingReport, commentId)
+	return cnt, xsql.ConvertError(err)
+}
+
+// 评论的全部待处理举报
+func (d *CommentReportDao) GetPending(ctx context.Context, commentId int64) ([]*CommentReport, error) {
+	var res = make([]*CommentReport, 0)
+	err := d.db.QueryRowsCtx(ctx, &res, sqlSelPendingReport, commentId)
+	if err != nil {
+		return nil, xsql.ConvertError(err)
+	}
+
+	return res, nil
+}
+
+// 按照id从小到大分页获取待处理举报
+func (d *CommentReportDao) PagePending(ctx context.Context, cursor int64, count int) ([]*CommentReport, error) {
+	var res = make([]*CommentReport, 0, count)
+	err := d.db.QueryRowsCtx(ctx, &res, sqlPagePendingReport, cursor, count)
+	if err != nil {
+		return nil, xsql.ConvertError(err)
+	}
+
+	return res, nil
+}
+
+// 处理评论的全部待处理举报 返回处理的数量
+func (d *CommentReportDao) Resolve(ctx context.Context, commentId int64, resolution int8, operator, utime int64) (int64, error) {
+	res, err := d.db.ExecCtx(ctx, sqlResolveReport, resolution, operator, utime, commentId)
+	if err != nil {
+		return 0, xsql.ConvertError(err)
+	}
+
+	return res.RowsAffected()
+}
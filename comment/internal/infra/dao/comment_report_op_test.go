@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestReportDao(t *testing.T) {
+	Convey("TestReportDao", t, func() {
+		const commentId = 990001
+		now := time.Now().Unix()
+
+		for _, reporter := range []int64{100, 101} {
+			_, err := testReportDao.Insert(testCtx, &CommentReport{
+				CommentId: commentId,
+				Oid:       1,
+				Reporter:  reporter,
+				Reason:    1,
+				Ctime:     now,
+				Utime:     now,
+			})
+			So(err, ShouldBeNil)
+		}
+
+		// 同一个用户不能重复举报
+		_, err := testReportDao.Insert(testCtx, &CommentReport{CommentId: commentId, Reporter: 100})
+		So(xsql.IsDuplicate(err), ShouldBeTrue)
+
+		cnt, err := testReportDao.CountPending(testCtx, commentId)
+		So(err, ShouldBeNil)
+		So(cnt, ShouldEqual, 2)
+
+		pendings, err := testReportDao.PagePending(testCtx, 0, 100)
+		So(err, ShouldBeNil)
+		So(len(pendings), ShouldBeGreaterThanOrEqualTo, 2)
+
+		resolved, err := testReportDao.Resolve(testCtx, commentId, 1, 200, now)
+		So(err, ShouldBeNil)
+		So(resolved, ShouldEqual, 2)
+
+		cnt, err = testReportDao.CountPending(testCtx, commentId)
+		So(err, ShouldBeNil)
+		So(cnt, ShouldEqual, 0)
+	})
+}
@@ -0,0 +1,21 @@
+package dao
+
+import "encoding/json"
+
+// comment_setting表 作者对评论对象的管理设置 pk(oid)
+type CommentSetting struct {
+	Oid             int64           `db:"oid"              json:"oid"`
+	Owner           int64           `db:"owner"            json:"owner"`
+	Mode            int8            `db:"mode"             json:"mode"`
+	BlockedKeywords json.RawMessage `db:"blocked_keywords" json:"blocked_keywords"`
+	Ctime           int64           `db:"ctime"            json:"ctime"`
+	Mtime           int64           `db:"mtime"            json:"mtime"`
+}
+
+// comment_user_mute表 被作者禁止在其所有对象下评论的用户 uk(owner, uid)
+type CommentUserMute struct {
+	Id    int64 `db:"id"    json:"id"`
+	Owner int64 `db:"owner" json:"owner"`
+	Uid   int64 `db:"uid"   json:"uid"`
+	Ctime int64 `db:"ctime" json:"ctime"`
+}
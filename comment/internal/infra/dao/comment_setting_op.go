@@ -0,0 +1,86 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+)
+
+type CommentSettingDao struct {
+	db *xsql.DB
+}
+
+func NewCommentSettingDao(db *xsql.DB) *CommentSettingDao {
+	return &CommentSettingDao{
+		db: db,
+	}
+}
+
+const (
+	settingFields = "oid,owner,mode,blocked_keywords,ctime,mtime"
+	muteFields    = "id,owner,uid,ctime"
+
+	sqlSelSetting    = "SELECT " + settingFields + " FROM comment_setting WHERE oid=?"
+	sqlUpsertSetting = "INSERT INTO comment_setting(" + settingFields + ") VALUES(?,?,?,?,?,?) " +
+		"ON DUPLICATE KEY UPDATE owner=VALUES(owner), mode=VALUES(mode), " +
+		"blocked_keywords=VALUES(blocked_keywords), mtime=VALUES(mtime)"
+
+	sqlInsertMute = "INSERT IGNORE INTO comment_user_mute(owner,uid,ctime) VALUES(?,?,?)"
+	sqlDelMute    = "DELETE FROM comment_user_mute WHERE owner=? AND uid=?"
+	sqlCountMute  = "SELECT COUNT(*) FROM comment_user_mute WHERE owner=? AND uid=?"
+	sqlPageMutes  = "SELECT " + muteFields + " FROM comment_user_mute WHERE owner=? AND id<? ORDER BY id DESC LIMIT ?"
+)
+
+func (d *CommentSettingDao) Get(ctx context.Context, oid int64) (*CommentSetting, error) {
+	var res CommentSetting
+	err := d.db.QueryRowCtx(ctx, &res, sqlSelSetting, oid)
+	if err != nil {
+		return nil, xsql.ConvertError(err)
+	}
+
+	return &res, nil
+}
+
+func (d *CommentSettingDao) Upsert(ctx context.Context, s *CommentSetting) error {
+	_, err := d.db.ExecCtx(ctx, sqlUpsertSetting,
+		s.Oid,
+		s.Owner,
+		s.Mode,
+		s.BlockedKeywords,
+		s.Ctime,
+		s.Mtime)
+
+	return xsql.ConvertError(err)
+}
+
+// 重复禁止时忽略
+func (d *CommentSettingDao) InsertMute(ctx context.Context, m *CommentUserMute) error {
+	_, err := d.db.ExecCtx(ctx, sqlInsertMute, m.Owner, m.Uid, m.Ctime)
+	return xsql.ConvertError(err)
+}
+
+func (d *CommentSettingDao) DeleteMute(ctx context.Context, owner, uid int64) error {
+	_, err := d.db.ExecCtx(ctx, sqlDelMute, owner, uid)
+	return xsql.ConvertError(err)
+}
+
+func (d *CommentSettingDao) IsMuted(ctx context.Context, owner, uid int64) (bool, error) {
+	var cnt int64
+	err := d.db.QueryRowCtx(ctx, &cnt, sqlCountMute, owner, uid)
+	if err != nil {
+		return false, xsql.ConvertError(err)
+	}
+
+	return cnt > 0, nil
+}
+
+// 按照id从大到小分页获取作者禁止评论的用户
+func (d *CommentSettingDao) PageMutes(ctx context.Context, owner, cursor int64, count int) ([]*CommentUserMute, error) {
+	var res = make([]*CommentUserMute, 0, count)
+	err := d.db.QueryRowsCtx(ctx, &res, sqlPageMutes, owner, cursor, count)
+	if err != nil {
+		return nil, xsql.ConvertError(err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,60 @@
+package dao
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSettingDao(t *testing.T) {
+	Convey("TestSettingDao", t, func() {
+		const (
+			oid   = 990001
+			owner = 100
+		)
+		now := time.Now().Unix()
+
+		_, err := testSettingDao.Get(testCtx, oid+1)
+		So(xsql.IsNoRecord(err), ShouldBeTrue)
+
+		keywords, _ := json.Marshal([]string{"广告", "spam"})
+		err = testSettingDao.Upsert(testCtx, &CommentSetting{
+			Oid:             oid,
+			Owner:           owner,
+			Mode:            2,
+			BlockedKeywords: keywords,
+			Ctime:           now,
+			Mtime:           now,
+		})
+		So(err, ShouldBeNil)
+
+		s, err := testSettingDao.Get(testCtx, oid)
+		So(err, ShouldBeNil)
+		So(s.Mode, ShouldEqual, 2)
+		So(string(s.BlockedKeywords), ShouldEqual, string(keywords))
+
+		for _, uid := range []int64{200, 201, 200} {
+			err = testSettingDao.InsertMute(testCtx, &CommentUserMute{Owner: owner, Uid: uid, Ctime: now})
+			So(err, ShouldBeNil)
+		}
+
+		muted, err := testSettingDao.IsMuted(testCtx, owner, 200)
+		So(err, ShouldBeNil)
+		So(muted, ShouldBeTrue)
+
+		mutes, err := testSettingDao.PageMutes(testCtx, owner, math.MaxInt64, 10)
+		So(err, ShouldBeNil)
+		So(len(mutes), ShouldBeGreaterThanOrEqualTo, 2)
+
+		err = testSettingDao.DeleteMute(testCtx, owner, 200)
+		So(err, ShouldBeNil)
+		muted, err = testSettingDao.IsMuted(testCtx, owner, 200)
+		So(err, ShouldBeNil)
+		So(muted, ShouldBeFalse)
+	})
+}
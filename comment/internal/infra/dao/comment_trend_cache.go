@@ -0,0 +1,148 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/ryanreadbooks/whimer/comment/internal/model"
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xtime"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// 热度排行中的一条主评论
+type TrendItem struct {
+	Id    int64
+	Score int64
+}
+
+// 已经存在排行才更新 否则等待下次读取时从数据库重建
+var zaddWhenExistScript = redis.NewScript(`
+	if redis.call('EXISTS', KEYS[1]) == 1 then
+		return redis.call('ZADD', KEYS[1], ARGV[1], ARGV[2])
+	end
+	return 0`)
+
+// CommentTrendCache 每个被评论对象的主评论热度排行
+//
+// member为补齐位数的评论id 保证相同热度时按照id从大到小排列
+type CommentTrendCache struct {
+	rd *redis.Redis
+}
+
+func NewCommentTrendCache(rd *redis.Redis) *CommentTrendCache {
+	return &CommentTrendCache{
+		rd: rd,
+	}
+}
+
+func formatTrendMember(id int64) string {
+	return fmt.Sprintf("%019d", id)
+}
+
+func pairsToTrendItems(pairs []redis.Pair) []TrendItem {
+	items := make([]TrendItem, 0, len(pairs))
+	for _, p := range pairs {
+		id, err := strconv.ParseInt(p.Key, 10, 64)
+		if err != nil {
+			continue
+		}
+		items = append(items, TrendItem{Id: id, Score: p.Score})
+	}
+
+	return items
+}
+
+func (c *CommentTrendCache) Exists(ctx context.Context, oid int64) (bool, error) {
+	ok, err := c.rd.ExistsCtx(ctx, getCommentTrendCacheKey(oid))
+	return ok, xerror.Wrap(err)
+}
+
+// 全量写入排行
+func (c *CommentTrendCache) Rebuild(ctx context.Context, oid int64, items []TrendItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	key := getCommentTrendCacheKey(oid)
+	members := make([]goredis.Z, 0, len(items))
+	for _, item := range items {
+		members = append(members, goredis.Z{
+			Score:  float64(item.Score),
+			Member: formatTrendMember(item.Id),
+		})
+	}
+
+	err := c.rd.PipelinedCtx(ctx, func(p redis.Pipeliner) error {
+		p.ZAdd(ctx, key, members...)
+		p.Expire(ctx, key, xtime.DayJitter(time.Hour*2))
+		return nil
+	})
+
+	return xerror.Wrap(err)
+}
+
+// 排行存在时更新主评论热度
+func (c *CommentTrendCache) UpdateWhenExist(ctx context.Context, oid int64, item TrendItem) error {
+	_, err := c.rd.ScriptRunCtx(ctx, zaddWhenExistScript,
+		[]string{getCommentTrendCacheKey(oid)},
+		strconv.FormatInt(item.Score, 10), formatTrendMember(item.Id))
+
+	return xerror.Wrap(err)
+}
+
+func (c *CommentTrendCache) Remove(ctx context.Context, oid int64, ids ...int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	members := make([]any, 0, len(ids))
+	for _, id := range ids {
+		members = append(members, formatTrendMember(id))
+	}
+	_, err := c.rd.ZremCtx(ctx, getCommentTrendCacheKey(oid), members...)
+
+	return xerror.Wrap(err)
+}
+
+// 按照(Score, Id)从大到小获取排在游标之后的want条主评论
+func (c *CommentTrendCache) Page(ctx context.Context, oid int64, cursor model.TrendCursor, want int) ([]TrendItem, error) {
+	key := getCommentTrendCacheKey(oid)
+	if cursor.IsZero() {
+		pairs, err := c.rd.ZrevrangeWithScoresCtx(ctx, key, 0, int64(want-1))
+		if err != nil {
+			return nil, xerror.Wrap(err)
+		}
+
+		return pairsToTrendItems(pairs), nil
+	}
+
+	// 先取和游标相同热度且id更小的
+	ties, err := c.rd.ZrevrangebyscoreWithScoresCtx(ctx, key, cursor.Score, cursor.Score)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	items := make([]TrendItem, 0, want)
+	for _, item := range pairsToTrendItems(ties) {
+		if cursor.After(item.Score, item.Id) && len(items) < want {
+			items = append(items, item)
+		}
+	}
+
+	if len(items) == want {
+		return items, nil
+	}
+
+	// 再取热度更低的
+	pairs, err := c.rd.ZrevrangebyscoreWithScoresAndLimitCtx(ctx, key, math.MinInt64, cursor.Score-1, 0, want-len(items))
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return append(items, pairsToTrendItems(pairs)...), nil
+}
@@ -16,6 +16,17 @@ type Dao struct {
 	CommentDao      *CommentDao
 	CommentAssetDao *CommentAssetDao
 	CommentExtDao   *CommentExtDao
+
+	CommentReportDao *CommentReportDao
+	CommentBanDao    *CommentBanDao
+
+	CommentEditHistoryDao *CommentEditHistoryDao
+
+	CommentEmojiDao *CommentEmojiDao
+
+	CommentSettingDao *CommentSettingDao
+
+	CommentTrendCache *CommentTrendCache
 }
 
 func MustNew(c *config.Config, cache *redis.Redis) *Dao {
@@ -40,6 +51,17 @@ func MustNew(c *config.Config, cache *redis.Redis) *Dao {
 		CommentDao:      NewCommentDao(db, cache),
 		CommentAssetDao: NewCommentAssetDao(db, cache),
 		CommentExtDao:   NewCommentExtDao(db, cache),
+
+		CommentReportDao: NewCommentReportDao(db),
+		CommentBanDao:    NewCommentBanDao(db),
+
+		CommentEditHistoryDao: NewCommentEditHistoryDao(db),
+
+		CommentEmojiDao: NewCommentEmojiDao(db),
+
+		CommentSettingDao: NewCommentSettingDao(db),
+
+		CommentTrendCache: NewCommentTrendCache(cache),
 	}
 }
 
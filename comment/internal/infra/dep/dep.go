@@ -3,7 +3,9 @@ package dep
 import (
 	"github.com/ryanreadbooks/whimer/comment/internal/config"
 	counterv1 "github.com/ryanreadbooks/whimer/idl/gen/go/counter/api/v1"
+	systemv1 "github.com/ryanreadbooks/whimer/idl/gen/go/msger/api/system/v1"
 	notev1 "github.com/ryanreadbooks/whimer/idl/gen/go/note/api/v1"
+	relationv1 "github.com/ryanreadbooks/whimer/idl/gen/go/relation/api/v1"
 	"github.com/ryanreadbooks/whimer/misc/idgen"
 	"github.com/ryanreadbooks/whimer/misc/xgrpc"
 
@@ -11,21 +13,32 @@ import (
 )
 
 var (
-	noter              notev1.NoteCreatorServiceClient // 笔记服务
-	counter            counterv1.CounterServiceClient  // 计数服务
+	noter              notev1.NoteCreatorServiceClient    // 笔记服务
+	noteFeeder         notev1.NoteFeedServiceClient       // 笔记服务
+	counter            counterv1.CounterServiceClient     // 计数服务
+	systemNotifier     systemv1.NotificationServiceClient // 系统消息服务
+	relationer         relationv1.RelationServiceClient   // 关系服务
 	commentIdGenerator foliumsdk.IClient
 	err                error
 )
 
 func Init(c *config.Config) {
-	noter = notev1.NewNoteCreatorServiceClient(
-		xgrpc.NewRecoverableClientConn(c.External.Grpc.Note),
-	)
+	noteConn := xgrpc.NewRecoverableClientConn(c.External.Grpc.Note)
+	noter = notev1.NewNoteCreatorServiceClient(noteConn)
+	noteFeeder = notev1.NewNoteFeedServiceClient(noteConn)
 
 	counter = counterv1.NewCounterServiceClient(
 		xgrpc.NewRecoverableClientConn(c.External.Grpc.Counter),
 	)
 
+	systemNotifier = systemv1.NewNotificationServiceClient(
+		xgrpc.NewRecoverableClientConn(c.External.Grpc.Msger),
+	)
+
+	relationer = relationv1.NewRelationServiceClient(
+		xgrpc.NewRecoverableClientConn(c.External.Grpc.Relation),
+	)
+
 	initCommentIdgen(c)
 }
 
@@ -33,10 +46,22 @@ func GetNoter() notev1.NoteCreatorServiceClient {
 	return noter
 }
 
+func GetNoteFeeder() notev1.NoteFeedServiceClient {
+	return noteFeeder
+}
+
 func GetCounter() counterv1.CounterServiceClient {
 	return counter
 }
 
+func GetSystemNotifier() systemv1.NotificationServiceClient {
+	return systemNotifier
+}
+
+func GetRelationer() relationv1.RelationServiceClient {
+	return relationer
+}
+
 func CommentIdgen() foliumsdk.IClient {
 	return commentIdGenerator
 }
@@ -20,6 +20,9 @@ type CommentItem struct {
 	IsPin      bool                          `json:"is_pin"`
 	Images     []*commentv1.CommentItemImage `json:"images"`
 	AtUsers    []*commentv1.CommentAtUser    `json:"at_users"` // @用户列表
+	Etime      int64                         `json:"etime"`    // 最后编辑时间 0表示未编辑过
+	Emojis     []*commentv1.CommentEmoji     `json:"emojis"`   // 内容中引用的表情
+	State      CommentState                  `json:"state"`
 
 	// 下面的字段需要额外填充
 	LikeCount int64 `json:"like_count"`
@@ -31,6 +34,15 @@ func (r *CommentItem) IsRoot() bool {
 	return r.RootId == 0 && r.ParentId == 0
 }
 
+// 评论是否正常展示
+func (r *CommentItem) IsVisible() bool {
+	return r.State == CommentStateNormal
+}
+
+func (r *CommentItem) IsEdited() bool {
+	return r.Etime > 0
+}
+
 func (r *CommentItem) AsPb() *commentv1.CommentItem {
 	return &commentv1.CommentItem{
 		Id:        r.Id,
@@ -50,6 +62,9 @@ func (r *CommentItem) AsPb() *commentv1.CommentItem {
 		SubsCount: r.SubsCount,
 		Images:    r.Images,
 		AtUsers:   r.AtUsers,
+		IsEdited:  r.IsEdited(),
+		Etime:     r.Etime,
+		Emojis:    r.Emojis,
 	}
 }
 
@@ -70,9 +85,10 @@ func (r *DetailedCommentItem) AsPb() *commentv1.DetailedCommentItem {
 }
 
 type PageComments struct {
-	Items      []*CommentItem
-	NextCursor int64
-	HasNext    bool
+	Items           []*CommentItem
+	NextCursor      int64
+	NextTrendCursor string // 按热度排序时的下一页游标
+	HasNext         bool
 }
 
 type PageCommentsWithTotal struct {
@@ -86,15 +102,17 @@ type DetailedCommentItemV2 struct {
 }
 
 type PageDetailedCommentsV2 struct {
-	Items      []*DetailedCommentItemV2
-	NextCursor int64
-	HasNext    bool
+	Items           []*DetailedCommentItemV2
+	NextCursor      int64
+	NextTrendCursor string
+	HasNext         bool
 }
 
 type PageDetailedComments struct {
-	Items      []*DetailedCommentItem
-	NextCursor int64
-	HasNext    bool
+	Items           []*DetailedCommentItem
+	NextCursor      int64
+	NextTrendCursor string
+	HasNext         bool
 }
 
 func ItemsAsPbs(rs []*CommentItem) []*commentv1.CommentItem {
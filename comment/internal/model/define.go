@@ -46,13 +46,20 @@ const (
 	IsPinned    = 1
 )
 
+// 主评论排序方式
+const (
+	SortByTime  = int8(commentv1.SortType_ByTime)
+	SortByTrend = int8(commentv1.SortType_ByTrend)
+)
+
 // 评论状态
 type CommentState int8
 
 // 评论状态
 const (
-	// TODO define more comment state
 	CommentStateNormal CommentState = 0
+	CommentStateHidden CommentState = 1 // 被举报后隐藏 等待审核
+	CommentStateHeld   CommentState = 2 // 命中作者设置的屏蔽词 等待作者审核
 )
 
 // 评论资源类型
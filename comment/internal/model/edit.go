@@ -0,0 +1,118 @@
+package model
+
+import (
+	"unicode/utf8"
+
+	"github.com/ryanreadbooks/whimer/comment/internal/global"
+	commentv1 "github.com/ryanreadbooks/whimer/idl/gen/go/comment/api/v1"
+)
+
+// 最多返回的历史版本数量
+const MaxEditHistoryCount = 50
+
+// 编辑评论参数
+type EditCommentReq struct {
+	CommentId int64                        `json:"comment_id"`
+	Content   string                       `json:"content"`
+	Images    []*commentv1.CommentReqImage `json:"images"`
+	AtUsers   []*commentv1.CommentAtUser   `json:"at_users"`
+}
+
+// 编辑后的评论类型 带图片的为图文评论
+func (r *EditCommentReq) Type() CommentType {
+	if len(r.Images) > 0 {
+		return CommentImageText
+	}
+
+	return CommentText
+}
+
+func (r *EditCommentReq) Validate() error {
+	if r == nil {
+		return global.ErrNilReq
+	}
+
+	if r.CommentId <= 0 {
+		return global.ErrInvalidCommentId
+	}
+
+	cLen := utf8.RuneCountInString(r.Content)
+	if cLen > MaxContentLen {
+		return global.ErrContentTooLong
+	}
+
+	switch r.Type() {
+	case CommentText:
+		if cLen < MinContentLen {
+			return global.ErrContentTooShort
+		}
+	case CommentImageText:
+		if len(r.Images) > MaxCommentImageCount {
+			return global.ErrInvalidImageCount
+		}
+	}
+
+	if err := checkEmojiCount(r.Content); err != nil {
+		return err
+	}
+
+	if len(r.AtUsers) > 0 {
+		r.AtUsers = FilterInvalidAtUsers(r.AtUsers)
+	}
+
+	return nil
+}
+
+// 编辑评论结果
+type EditCommentRes struct {
+	Etime          int64
+	AddedAtUsers   []*commentv1.CommentAtUser // 新增的@用户
+	RemovedAtUsers []*commentv1.CommentAtUser // 移除的@用户
+	Held           bool                       // 编辑后等待作者审核
+}
+
+// 按照uid比较编辑前后的@用户
+func DiffAtUsers(before, after []*commentv1.CommentAtUser) (added, removed []*commentv1.CommentAtUser) {
+	beforeUids := make(map[int64]struct{}, len(before))
+	for _, u := range before {
+		beforeUids[u.GetUid()] = struct{}{}
+	}
+	afterUids := make(map[int64]struct{}, len(after))
+	for _, u := range after {
+		afterUids[u.GetUid()] = struct{}{}
+	}
+
+	for _, u := range after {
+		if _, ok := beforeUids[u.GetUid()]; !ok {
+			added = append(added, u)
+			beforeUids[u.GetUid()] = struct{}{} // 去重
+		}
+	}
+	for _, u := range before {
+		if _, ok := afterUids[u.GetUid()]; !ok {
+			removed = append(removed, u)
+			afterUids[u.GetUid()] = struct{}{} // 去重
+		}
+	}
+
+	return
+}
+
+// 评论的一个历史版本
+type CommentEditVersion struct {
+	Type    CommentType
+	Content string
+	Images  []*commentv1.CommentItemImage
+	AtUsers []*commentv1.CommentAtUser
+	Ctime   int64 // 该版本被替换的时间
+}
+
+func (v *CommentEditVersion) AsPb() *commentv1.CommentEditVersion {
+	return &commentv1.CommentEditVersion{
+		Type:    CommentTypeToPb(v.Type),
+		Content: v.Content,
+		Images:  v.Images,
+		AtUsers: v.AtUsers,
+		Ctime:   v.Ctime,
+	}
+}
@@ -0,0 +1,62 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	commentv1 "github.com/ryanreadbooks/whimer/idl/gen/go/comment/api/v1"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestEditCommentReqValidate(t *testing.T) {
+	Convey("EditCommentReq.Validate", t, func() {
+		So((&EditCommentReq{CommentId: 0, Content: "a"}).Validate(), ShouldNotBeNil)
+		So((&EditCommentReq{CommentId: 1, Content: ""}).Validate(), ShouldNotBeNil)
+		So((&EditCommentReq{CommentId: 1, Content: strings.Repeat("a", MaxContentLen+1)}).Validate(), ShouldNotBeNil)
+
+		req := &EditCommentReq{CommentId: 1, Content: "typo fixed"}
+		So(req.Validate(), ShouldBeNil)
+		So(req.Type(), ShouldEqual, CommentText)
+
+		// 图文评论可以没有文字
+		req = &EditCommentReq{CommentId: 1, Images: []*commentv1.CommentReqImage{{StoreKey: "k"}}}
+		So(req.Validate(), ShouldBeNil)
+		So(req.Type(), ShouldEqual, CommentImageText)
+
+		req.Images = make([]*commentv1.CommentReqImage, MaxCommentImageCount+1)
+		So(req.Validate(), ShouldNotBeNil)
+
+		req = &EditCommentReq{CommentId: 1, Content: "a", AtUsers: []*commentv1.CommentAtUser{
+			{Uid: 1, Nickname: "a"}, {Uid: 0, Nickname: "b"}, {Uid: 2},
+		}}
+		So(req.Validate(), ShouldBeNil)
+		So(len(req.AtUsers), ShouldEqual, 1)
+	})
+}
+
+func TestDiffAtUsers(t *testing.T) {
+	Convey("DiffAtUsers", t, func() {
+		u := func(uid int64) *commentv1.CommentAtUser {
+			return &commentv1.CommentAtUser{Uid: uid, Nickname: "n"}
+		}
+		uids := func(us []*commentv1.CommentAtUser) []int64 {
+			res := []int64{}
+			for _, u := range us {
+				res = append(res, u.Uid)
+			}
+			return res
+		}
+
+		added, removed := DiffAtUsers([]*commentv1.CommentAtUser{u(1), u(2)}, []*commentv1.CommentAtUser{u(2), u(3), u(3)})
+		So(uids(added), ShouldResemble, []int64{3})
+		So(uids(removed), ShouldResemble, []int64{1})
+
+		added, removed = DiffAtUsers(nil, []*commentv1.CommentAtUser{u(1)})
+		So(uids(added), ShouldResemble, []int64{1})
+		So(removed, ShouldBeEmpty)
+
+		added, removed = DiffAtUsers([]*commentv1.CommentAtUser{u(1)}, []*commentv1.CommentAtUser{u(1)})
+		So(added, ShouldBeEmpty)
+		So(removed, ShouldBeEmpty)
+	})
+}
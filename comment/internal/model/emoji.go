@@ -0,0 +1,219 @@
+package model
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/ryanreadbooks/whimer/comment/internal/global"
+	commentv1 "github.com/ryanreadbooks/whimer/idl/gen/go/comment/api/v1"
+)
+
+const (
+	// 一条评论中最多引用的不同表情数量
+	MaxCommentEmojiCount = 20
+	// 表情包代码和表情代码的最大长度
+	MaxEmojiCodeLen = 32
+)
+
+// 表情包和表情的状态
+const (
+	EmojiDisabled int8 = 0
+	EmojiEnabled  int8 = 1
+)
+
+var (
+	emojiCodeRegex  = regexp.MustCompile(`^[a-z0-9_]{1,32}$`)
+	emojiTokenRegex = regexp.MustCompile(`\[([a-z0-9_]{1,32}):([a-z0-9_]{1,32})\]`)
+)
+
+// 评论内容中的表情引用 格式为[pack:item]
+type EmojiToken struct {
+	Pack string
+	Code string
+}
+
+func (t EmojiToken) String() string {
+	return "[" + t.Pack + ":" + t.Code + "]"
+}
+
+func IsValidEmojiCode(code string) bool {
+	return emojiCodeRegex.MatchString(code)
+}
+
+// 解析单个表情引用 s必须完整匹配[pack:item]
+func ParseEmojiToken(s string) (EmojiToken, bool) {
+	m := emojiTokenRegex.FindStringSubmatchIndex(s)
+	if m == nil || m[0] != 0 || m[1] != len(s) {
+		return EmojiToken{}, false
+	}
+
+	return EmojiToken{Pack: s[m[2]:m[3]], Code: s[m[4]:m[5]]}, true
+}
+
+// 按照出现顺序解析内容中的表情引用 结果已去重
+func ParseEmojiTokens(content string) []EmojiToken {
+	if !strings.Contains(content, "[") {
+		return nil
+	}
+
+	matches := emojiTokenRegex.FindAllStringSubmatch(content, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	seen := make(map[EmojiToken]struct{}, len(matches))
+	tokens := make([]EmojiToken, 0, len(matches))
+	for _, m := range matches {
+		t := EmojiToken{Pack: m[1], Code: m[2]}
+		if _, ok := seen[t]; ok {
+			continue
+		}
+		seen[t] = struct{}{}
+		tokens = append(tokens, t)
+	}
+
+	return tokens
+}
+
+// 内容是否引用了表情
+func HasEmojiToken(content string) bool {
+	return strings.Contains(content, "[") && emojiTokenRegex.MatchString(content)
+}
+
+// 内容是否只包含一个表情 表情评论的内容必须满足
+func IsSingleEmoji(content string) bool {
+	_, ok := ParseEmojiToken(strings.TrimSpace(content))
+	return ok
+}
+
+func checkEmojiCount(content string) error {
+	if len(ParseEmojiTokens(content)) > MaxCommentEmojiCount {
+		return global.ErrTooManyEmojis
+	}
+
+	return nil
+}
+
+// 表情包
+type EmojiPack struct {
+	Id       int64
+	Code     string
+	Name     string
+	CoverKey string
+	Version  int64
+	Enabled  bool
+	Ctime    int64
+	Mtime    int64
+}
+
+func (p *EmojiPack) AsPb() *commentv1.EmojiPack {
+	return &commentv1.EmojiPack{
+		Id:       p.Id,
+		Code:     p.Code,
+		Name:     p.Name,
+		CoverKey: p.CoverKey,
+		Version:  p.Version,
+		Enabled:  p.Enabled,
+		Ctime:    p.Ctime,
+		Mtime:    p.Mtime,
+	}
+}
+
+// 表情包中的表情
+type EmojiItem struct {
+	Id       int64
+	Pack     string
+	Code     string
+	Name     string
+	StoreKey string
+	Width    uint32
+	Height   uint32
+	Format   string
+	Version  int64
+	Enabled  bool
+	Ctime    int64
+	Mtime    int64
+}
+
+func (i *EmojiItem) Token() EmojiToken {
+	return EmojiToken{Pack: i.Pack, Code: i.Code}
+}
+
+func (i *EmojiItem) AsPb() *commentv1.EmojiItem {
+	return &commentv1.EmojiItem{
+		Id:      i.Id,
+		Pack:    i.Pack,
+		Code:    i.Code,
+		Name:    i.Name,
+		Key:     i.StoreKey,
+		Width:   i.Width,
+		Height:  i.Height,
+		Format:  i.Format,
+		Version: i.Version,
+		Enabled: i.Enabled,
+		Ctime:   i.Ctime,
+		Mtime:   i.Mtime,
+	}
+}
+
+// 新增或者更新表情包参数
+type UpsertEmojiPackReq struct {
+	Code     string
+	Name     string
+	CoverKey string
+	Enabled  bool
+}
+
+func (r *UpsertEmojiPackReq) Validate() error {
+	if r == nil {
+		return global.ErrNilReq
+	}
+
+	if !IsValidEmojiCode(r.Code) {
+		return global.ErrInvalidEmojiCode
+	}
+
+	if r.Name == "" {
+		return global.ErrArgs.Msg("表情包名称为空")
+	}
+
+	return nil
+}
+
+// 新增或者更新表情参数
+type UpsertEmojiItemReq struct {
+	Pack     string
+	Code     string
+	Name     string
+	StoreKey string
+	Width    uint32
+	Height   uint32
+	Format   string
+	Enabled  bool
+}
+
+func (r *UpsertEmojiItemReq) Validate() error {
+	if r == nil {
+		return global.ErrNilReq
+	}
+
+	if !IsValidEmojiCode(r.Pack) || !IsValidEmojiCode(r.Code) {
+		return global.ErrInvalidEmojiCode
+	}
+
+	if r.StoreKey == "" || r.Width == 0 || r.Height == 0 || r.Format == "" {
+		return global.ErrArgs.Msg("表情资源信息不完整")
+	}
+
+	return nil
+}
+
+// 表情资源是否和已有的表情一致
+func (r *UpsertEmojiItemReq) SameAs(item *EmojiItem) bool {
+	return r.Name == item.Name &&
+		r.StoreKey == item.StoreKey &&
+		r.Width == item.Width &&
+		r.Height == item.Height &&
+		r.Format == item.Format &&
+		r.Enabled == item.Enabled
+}
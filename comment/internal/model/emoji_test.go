@@ -0,0 +1,83 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	commentv1 "github.com/ryanreadbooks/whimer/idl/gen/go/comment/api/v1"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestParseEmojiToken(t *testing.T) {
+	Convey("ParseEmojiToken", t, func() {
+		tk, ok := ParseEmojiToken("[doge:smile]")
+		So(ok, ShouldBeTrue)
+		So(tk, ShouldResemble, EmojiToken{Pack: "doge", Code: "smile"})
+		So(tk.String(), ShouldEqual, "[doge:smile]")
+
+		for _, s := range []string{"", "doge:smile", "[doge:smile]x", "x[doge:smile]", "[Doge:smile]", "[doge:]", "[doge:smile:1]"} {
+			_, ok := ParseEmojiToken(s)
+			So(ok, ShouldBeFalse)
+		}
+	})
+}
+
+func TestParseEmojiTokens(t *testing.T) {
+	Convey("ParseEmojiTokens", t, func() {
+		So(ParseEmojiTokens("no emoji here"), ShouldBeEmpty)
+		So(ParseEmojiTokens("[not a token]"), ShouldBeEmpty)
+
+		tokens := ParseEmojiTokens("hi[doge:smile] [cat:cry][doge:smile]!")
+		So(tokens, ShouldResemble, []EmojiToken{{Pack: "doge", Code: "smile"}, {Pack: "cat", Code: "cry"}})
+
+		So(HasEmojiToken("hi[doge:smile]"), ShouldBeTrue)
+		So(HasEmojiToken("hi[doge]"), ShouldBeFalse)
+
+		So(IsSingleEmoji(" [doge:smile] "), ShouldBeTrue)
+		So(IsSingleEmoji("[doge:smile][cat:cry]"), ShouldBeFalse)
+		So(IsSingleEmoji("hi [doge:smile]"), ShouldBeFalse)
+	})
+}
+
+func TestAddCommentReqEmoji(t *testing.T) {
+	Convey("AddCommentReq.Validate with emojis", t, func() {
+		newReq := func(typ CommentType, content string) *AddCommentReq {
+			return &AddCommentReq{Type: typ, Oid: 1, ReplyUid: 1, Content: content}
+		}
+
+		So(newReq(CommentCustomEmoji, "[doge:smile]").Validate(), ShouldBeNil)
+		So(newReq(CommentCustomEmoji, "hi [doge:smile]").Validate(), ShouldNotBeNil)
+		So(newReq(CommentText, "hi [doge:smile]").Validate(), ShouldBeNil)
+
+		req := newReq(CommentCustomEmoji, "[doge:smile]")
+		req.Images = []*commentv1.CommentReqImage{{StoreKey: "k"}}
+		So(req.Validate(), ShouldNotBeNil)
+
+		var sb strings.Builder
+		for i := 0; i <= MaxCommentEmojiCount; i++ {
+			sb.WriteString(EmojiToken{Pack: "p", Code: strings.Repeat("a", i+1)}.String())
+		}
+		So(newReq(CommentText, sb.String()).Validate(), ShouldNotBeNil)
+		// 重复的表情只计算一次
+		So(newReq(CommentText, strings.Repeat("[doge:smile]", MaxCommentEmojiCount+1)).Validate(), ShouldBeNil)
+	})
+}
+
+func TestUpsertEmojiReqValidate(t *testing.T) {
+	Convey("UpsertEmoji*Req.Validate", t, func() {
+		So((&UpsertEmojiPackReq{Code: "doge", Name: "狗头"}).Validate(), ShouldBeNil)
+		So((&UpsertEmojiPackReq{Code: "Doge", Name: "狗头"}).Validate(), ShouldNotBeNil)
+		So((&UpsertEmojiPackReq{Code: "doge"}).Validate(), ShouldNotBeNil)
+
+		req := &UpsertEmojiItemReq{Pack: "doge", Code: "smile", StoreKey: "emoji/doge/smile_v1.png", Width: 64, Height: 64, Format: "png"}
+		So(req.Validate(), ShouldBeNil)
+
+		item := &EmojiItem{StoreKey: req.StoreKey, Width: 64, Height: 64, Format: "png"}
+		So(req.SameAs(item), ShouldBeTrue)
+		item.StoreKey = "emoji/doge/smile_v2.png"
+		So(req.SameAs(item), ShouldBeFalse)
+
+		req.Code = strings.Repeat("a", MaxEmojiCodeLen+1)
+		So(req.Validate(), ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,161 @@
+package model
+
+import (
+	"time"
+	"unicode/utf8"
+
+	"github.com/ryanreadbooks/whimer/comment/internal/global"
+	commentv1 "github.com/ryanreadbooks/whimer/idl/gen/go/comment/api/v1"
+)
+
+// 举报原因
+type ReportReason int8
+
+const (
+	ReportReasonSpam    = ReportReason(commentv1.ReportReason_REPORT_REASON_SPAM)    // 垃圾广告
+	ReportReasonAbuse   = ReportReason(commentv1.ReportReason_REPORT_REASON_ABUSE)   // 辱骂攻击
+	ReportReasonPorn    = ReportReason(commentv1.ReportReason_REPORT_REASON_PORN)    // 色情低俗
+	ReportReasonIllegal = ReportReason(commentv1.ReportReason_REPORT_REASON_ILLEGAL) // 违法违规
+	ReportReasonOther   = ReportReason(commentv1.ReportReason_REPORT_REASON_OTHER)   // 其它
+)
+
+func ReportReasonFromPb(r commentv1.ReportReason) (ReportReason, error) {
+	switch r {
+	case commentv1.ReportReason_REPORT_REASON_SPAM,
+		commentv1.ReportReason_REPORT_REASON_ABUSE,
+		commentv1.ReportReason_REPORT_REASON_PORN,
+		commentv1.ReportReason_REPORT_REASON_ILLEGAL,
+		commentv1.ReportReason_REPORT_REASON_OTHER:
+		return ReportReason(r), nil
+	}
+
+	return 0, global.ErrInvalidReportReason
+}
+
+func (r ReportReason) AsPb() commentv1.ReportReason {
+	return commentv1.ReportReason(r)
+}
+
+// 举报处理结果
+type ReportResolution int8
+
+const (
+	ReportPending   = ReportResolution(commentv1.ReportResolution_REPORT_RESOLUTION_PENDING)    // 待处理
+	ReportKeep      = ReportResolution(commentv1.ReportResolution_REPORT_RESOLUTION_KEEP)       // 保留评论
+	ReportDelete    = ReportResolution(commentv1.ReportResolution_REPORT_RESOLUTION_DELETE)     // 删除评论
+	ReportBanAuthor = ReportResolution(commentv1.ReportResolution_REPORT_RESOLUTION_BAN_AUTHOR) // 删除评论并禁止作者评论
+)
+
+// 只能将举报处理为非待处理的结果
+func ReportResolutionFromPb(r commentv1.ReportResolution) (ReportResolution, error) {
+	switch r {
+	case commentv1.ReportResolution_REPORT_RESOLUTION_KEEP,
+		commentv1.ReportResolution_REPORT_RESOLUTION_DELETE,
+		commentv1.ReportResolution_REPORT_RESOLUTION_BAN_AUTHOR:
+		return ReportResolution(r), nil
+	}
+
+	return 0, global.ErrInvalidReportResolution
+}
+
+func (r ReportResolution) AsPb() commentv1.ReportResolution {
+	return commentv1.ReportResolution(r)
+}
+
+// 处理结果是否需要删除评论
+func (r ReportResolution) ShouldDelete() bool {
+	return r == ReportDelete || r == ReportBanAuthor
+}
+
+// 通知举报人的处理结果
+func (r ReportResolution) Notice() string {
+	switch r {
+	case ReportKeep:
+		return "经审核，你举报的评论暂未发现违规，感谢你的反馈。"
+	case ReportDelete:
+		return "经审核，你举报的评论存在违规，已被删除，感谢你的反馈。"
+	case ReportBanAuthor:
+		return "经审核，你举报的评论存在违规，已被删除，评论作者已被禁止发表评论，感谢你的反馈。"
+	}
+
+	return ""
+}
+
+const (
+	ReportNoticeTitle   = "举报处理结果"
+	MaxReportDetailLen  = 200
+	MaxPendingReportCnt = 50 // 一次最多获取的待处理举报
+)
+
+type ReportCommentReq struct {
+	CommentId int64
+	Reason    ReportReason
+	Detail    string
+}
+
+func (r *ReportCommentReq) Validate() error {
+	if r == nil {
+		return global.ErrNilReq
+	}
+
+	if r.CommentId <= 0 {
+		return global.ErrInvalidCommentId
+	}
+
+	if utf8.RuneCountInString(r.Detail) > MaxReportDetailLen {
+		return global.ErrReportDetailTooLong
+	}
+
+	return nil
+}
+
+// 一条举报记录
+type ReportItem struct {
+	Id         int64
+	CommentId  int64
+	Oid        int64
+	Reporter   int64
+	Reason     ReportReason
+	Detail     string
+	Resolution ReportResolution
+	Ctime      int64
+	Utime      int64
+}
+
+func (r *ReportItem) AsPb() *commentv1.CommentReport {
+	return &commentv1.CommentReport{
+		Id:         r.Id,
+		CommentId:  r.CommentId,
+		Oid:        r.Oid,
+		Reporter:   r.Reporter,
+		Reason:     r.Reason.AsPb(),
+		Detail:     r.Detail,
+		Resolution: r.Resolution.AsPb(),
+		Ctime:      r.Ctime,
+		Utime:      r.Utime,
+	}
+}
+
+type PendingReports struct {
+	Items      []*ReportItem
+	Comments   map[int64]*CommentItem
+	NextCursor int64
+	HasNext    bool
+}
+
+// 永久禁止评论
+const BanForever = 0
+
+// 计算禁止评论的截止时间
+func BanExpireAt(now time.Time, banSeconds int64) int64 {
+	if banSeconds <= 0 {
+		return BanForever
+	}
+
+	return now.Unix() + banSeconds
+}
+
+// 禁止评论是否仍然生效
+func IsBanActive(expireAt int64, now time.Time) bool {
+	return expireAt == BanForever || expireAt > now.Unix()
+}
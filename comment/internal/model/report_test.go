@@ -0,0 +1,60 @@
+package model
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	commentv1 "github.com/ryanreadbooks/whimer/idl/gen/go/comment/api/v1"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestReportFromPb(t *testing.T) {
+	Convey("ReportFromPb", t, func() {
+		Convey("reason", func() {
+			r, err := ReportReasonFromPb(commentv1.ReportReason_REPORT_REASON_ABUSE)
+			So(err, ShouldBeNil)
+			So(r, ShouldEqual, ReportReasonAbuse)
+
+			_, err = ReportReasonFromPb(commentv1.ReportReason_REPORT_REASON_UNSPECIFIED)
+			So(err, ShouldNotBeNil)
+			_, err = ReportReasonFromPb(commentv1.ReportReason(100))
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("resolution", func() {
+			r, err := ReportResolutionFromPb(commentv1.ReportResolution_REPORT_RESOLUTION_BAN_AUTHOR)
+			So(err, ShouldBeNil)
+			So(r, ShouldEqual, ReportBanAuthor)
+			So(r.ShouldDelete(), ShouldBeTrue)
+			So(ReportKeep.ShouldDelete(), ShouldBeFalse)
+
+			_, err = ReportResolutionFromPb(commentv1.ReportResolution_REPORT_RESOLUTION_PENDING)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestReportCommentReqValidate(t *testing.T) {
+	Convey("ReportCommentReq.Validate", t, func() {
+		var nilReq *ReportCommentReq
+		So(nilReq.Validate(), ShouldNotBeNil)
+		So((&ReportCommentReq{CommentId: 0}).Validate(), ShouldNotBeNil)
+		So((&ReportCommentReq{CommentId: 1, Detail: "广告"}).Validate(), ShouldBeNil)
+		So((&ReportCommentReq{CommentId: 1, Detail: strings.Repeat("广", MaxReportDetailLen)}).Validate(), ShouldBeNil)
+		So((&ReportCommentReq{CommentId: 1, Detail: strings.Repeat("广", MaxReportDetailLen+1)}).Validate(), ShouldNotBeNil)
+	})
+}
+
+func TestBan(t *testing.T) {
+	Convey("Ban", t, func() {
+		now := time.Unix(1735660800, 0)
+
+		So(BanExpireAt(now, 0), ShouldEqual, BanForever)
+		So(BanExpireAt(now, 3600), ShouldEqual, now.Unix()+3600)
+
+		So(IsBanActive(BanForever, now), ShouldBeTrue)
+		So(IsBanActive(now.Unix()+1, now), ShouldBeTrue)
+		So(IsBanActive(now.Unix(), now), ShouldBeFalse)
+	})
+}
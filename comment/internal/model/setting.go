@@ -0,0 +1,146 @@
+package model
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ryanreadbooks/whimer/comment/internal/global"
+	commentv1 "github.com/ryanreadbooks/whimer/idl/gen/go/comment/api/v1"
+)
+
+// 对象的评论模式
+type CommentMode int8
+
+const (
+	CommentModeOpen      = CommentMode(commentv1.CommentMode_COMMENT_MODE_OPEN)      // 所有人可评论
+	CommentModeClosed    = CommentMode(commentv1.CommentMode_COMMENT_MODE_CLOSED)    // 关闭评论
+	CommentModeFollowers = CommentMode(commentv1.CommentMode_COMMENT_MODE_FOLLOWERS) // 仅关注作者的用户可评论
+)
+
+func CommentModeFromPb(m commentv1.CommentMode) (CommentMode, error) {
+	switch m {
+	case commentv1.CommentMode_COMMENT_MODE_OPEN,
+		commentv1.CommentMode_COMMENT_MODE_CLOSED,
+		commentv1.CommentMode_COMMENT_MODE_FOLLOWERS:
+		return CommentMode(m), nil
+	}
+
+	return 0, global.ErrInvalidCommentMode
+}
+
+func (m CommentMode) AsPb() commentv1.CommentMode {
+	return commentv1.CommentMode(m)
+}
+
+const (
+	MaxBlockedKeywordCnt = 50
+	MaxBlockedKeywordLen = 20
+	MaxHeldCommentCnt    = 50 // 一次最多获取的待审核评论
+	MaxMutedUserCnt      = 50 // 一次最多获取的禁止评论用户
+)
+
+// 作者对评论对象的管理设置
+type CommentSetting struct {
+	Oid             int64
+	Owner           int64
+	Mode            CommentMode
+	BlockedKeywords []string // 已经统一为小写
+	Mtime           int64
+}
+
+// 没有设置过的对象使用默认设置
+func DefaultCommentSetting(oid int64) *CommentSetting {
+	return &CommentSetting{Oid: oid, Mode: CommentModeOpen}
+}
+
+// 评论内容命中的第一个屏蔽词 忽略大小写
+func (s *CommentSetting) MatchKeyword(content string) (string, bool) {
+	if len(s.BlockedKeywords) == 0 {
+		return "", false
+	}
+
+	content = strings.ToLower(content)
+	for _, kw := range s.BlockedKeywords {
+		if strings.Contains(content, kw) {
+			return kw, true
+		}
+	}
+
+	return "", false
+}
+
+func (s *CommentSetting) AsPb() *commentv1.CommentSetting {
+	return &commentv1.CommentSetting{
+		Oid:             s.Oid,
+		Mode:            s.Mode.AsPb(),
+		BlockedKeywords: s.BlockedKeywords,
+		Mtime:           s.Mtime,
+	}
+}
+
+// 更新评论设置参数
+type UpdateCommentSettingReq struct {
+	Oid             int64
+	Mode            CommentMode
+	BlockedKeywords []string
+}
+
+// 校验的同时将屏蔽词去除首尾空白 统一为小写并去重
+func (r *UpdateCommentSettingReq) Validate() error {
+	if r == nil {
+		return global.ErrNilReq
+	}
+
+	if r.Oid <= 0 {
+		return global.ErrObjectIdEmpty
+	}
+
+	keywords := make([]string, 0, len(r.BlockedKeywords))
+	seen := make(map[string]struct{}, len(r.BlockedKeywords))
+	for _, kw := range r.BlockedKeywords {
+		kw = strings.ToLower(strings.TrimSpace(kw))
+		if kw == "" {
+			continue
+		}
+		if utf8.RuneCountInString(kw) > MaxBlockedKeywordLen {
+			return global.ErrBlockedKeywordTooLong
+		}
+		if _, ok := seen[kw]; ok {
+			continue
+		}
+		seen[kw] = struct{}{}
+		keywords = append(keywords, kw)
+	}
+
+	if len(keywords) > MaxBlockedKeywordCnt {
+		return global.ErrTooManyBlockedKeywords
+	}
+	r.BlockedKeywords = keywords
+
+	return nil
+}
+
+// 被作者禁止评论的用户
+type MutedUser struct {
+	Uid   int64
+	Ctime int64
+}
+
+func (u *MutedUser) AsPb() *commentv1.MutedCommentUser {
+	return &commentv1.MutedCommentUser{
+		Uid:   u.Uid,
+		Ctime: u.Ctime,
+	}
+}
+
+type PageMutedUsers struct {
+	Items      []*MutedUser
+	NextCursor int64
+	HasNext    bool
+}
+
+type PageHeldComments struct {
+	Items      []*CommentItem
+	NextCursor int64
+	HasNext    bool
+}
@@ -0,0 +1,61 @@
+package model
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/ryanreadbooks/whimer/comment/internal/global"
+	commentv1 "github.com/ryanreadbooks/whimer/idl/gen/go/comment/api/v1"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCommentModeFromPb(t *testing.T) {
+	Convey("CommentModeFromPb", t, func() {
+		m, err := CommentModeFromPb(commentv1.CommentMode_COMMENT_MODE_FOLLOWERS)
+		So(err, ShouldBeNil)
+		So(m, ShouldEqual, CommentModeFollowers)
+
+		_, err = CommentModeFromPb(commentv1.CommentMode(100))
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestCommentSettingMatchKeyword(t *testing.T) {
+	Convey("CommentSetting.MatchKeyword", t, func() {
+		s := DefaultCommentSetting(1)
+		_, hit := s.MatchKeyword("加V看广告")
+		So(hit, ShouldBeFalse)
+
+		s.BlockedKeywords = []string{"广告", "spam"}
+		kw, hit := s.MatchKeyword("加V看广告")
+		So(hit, ShouldBeTrue)
+		So(kw, ShouldEqual, "广告")
+
+		_, hit = s.MatchKeyword("this is SPAM")
+		So(hit, ShouldBeTrue)
+
+		_, hit = s.MatchKeyword("正常评论")
+		So(hit, ShouldBeFalse)
+	})
+}
+
+func TestUpdateCommentSettingReqValidate(t *testing.T) {
+	Convey("UpdateCommentSettingReq.Validate", t, func() {
+		So((&UpdateCommentSettingReq{}).Validate(), ShouldNotBeNil)
+
+		req := &UpdateCommentSettingReq{Oid: 1, BlockedKeywords: []string{" Spam ", "spam", "", "广告"}}
+		So(req.Validate(), ShouldBeNil)
+		So(req.BlockedKeywords, ShouldResemble, []string{"spam", "广告"})
+
+		req = &UpdateCommentSettingReq{Oid: 1, BlockedKeywords: []string{strings.Repeat("长", MaxBlockedKeywordLen+1)}}
+		So(req.Validate(), ShouldEqual, global.ErrBlockedKeywordTooLong)
+
+		keywords := make([]string, 0, MaxBlockedKeywordCnt+1)
+		for i := 0; i <= MaxBlockedKeywordCnt; i++ {
+			keywords = append(keywords, "kw"+strconv.Itoa(i))
+		}
+		req = &UpdateCommentSettingReq{Oid: 1, BlockedKeywords: keywords}
+		So(req.Validate(), ShouldEqual, global.ErrTooManyBlockedKeywords)
+	})
+}
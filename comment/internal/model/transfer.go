@@ -9,7 +9,7 @@ import (
 
 // 发表评论参数
 type AddCommentReq struct {
-	Type     CommentType                  `json:"type"`    // 评论类型 (0-文本; 1-图文)
+	Type     CommentType                  `json:"type"`    // 评论类型 (0-文本; 1-图文; 2-表情)
 	Oid      int64                        `json:"nid"`     // 对象id
 	Content  string                       `json:"content"` // 评论内容
 	RootId   int64                        `json:"pid"`     // 根评论id
@@ -25,7 +25,7 @@ func (r *AddCommentReq) Validate() error {
 	}
 
 	// 评论类型校验
-	if r.Type != CommentText && r.Type != CommentImageText {
+	if r.Type != CommentText && r.Type != CommentImageText && r.Type != CommentCustomEmoji {
 		return global.ErrUnsupportedType
 	}
 
@@ -53,6 +53,15 @@ func (r *AddCommentReq) Validate() error {
 		if imageLen <= 0 || imageLen > MaxCommentImageCount {
 			return global.ErrInvalidImageCount
 		}
+	case CommentCustomEmoji:
+		// 表情评论只能包含一个表情
+		if !IsSingleEmoji(r.Content) || len(r.Images) > 0 {
+			return global.ErrUnsupportedType
+		}
+	}
+
+	if err := checkEmojiCount(r.Content); err != nil {
+		return err
 	}
 
 	// 评论的关系
@@ -72,6 +81,7 @@ func (r *AddCommentReq) Validate() error {
 type AddCommentRes struct {
 	CommentId int64
 	Uid       int64
+	Held      bool // 等待作者审核
 }
 
 func FilterInvalidAtUsers(atUsers []*commentv1.CommentAtUser) []*commentv1.CommentAtUser {
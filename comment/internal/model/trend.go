@@ -0,0 +1,86 @@
+package model
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/ryanreadbooks/whimer/comment/internal/global"
+)
+
+// 热度计算参数
+const (
+	trendEpoch      = 1704038400 // 2024-01-01 00:00:00 +08:00 热度时间起点
+	trendDecay      = 45000      // 每晚发布该秒数 需要多十倍的互动才能保持相同热度
+	trendScale      = 1e6        // 热度放大倍数 放大后取整保存
+	trendSubsWeight = 2          // 子评论数量的权重
+)
+
+// 计算主评论的热度
+//
+// 热度 = sign(w) * log10(max(|w|, 1)) + (ctime - epoch) / decay
+// 其中 w = like - hate + 2 * subs
+//
+// 时间衰减通过发布时间体现 同一条评论的热度只在互动数量变化时改变 不随当前时间变化
+func TrendScore(like, hate, subs, ctime int64) int64 {
+	w := float64(like - hate + trendSubsWeight*subs)
+	order := math.Log10(math.Max(math.Abs(w), 1))
+	var sign float64
+	switch {
+	case w > 0:
+		sign = 1
+	case w < 0:
+		sign = -1
+	}
+
+	age := float64(ctime-trendEpoch) / trendDecay
+	return int64(math.Round((sign*order + age) * trendScale))
+}
+
+// 按热度排序时的分页游标 按照(Score, Id)从大到小排列
+type TrendCursor struct {
+	Score int64
+	Id    int64
+}
+
+// 第一页的游标为空
+func (c TrendCursor) IsZero() bool {
+	return c.Id == 0
+}
+
+// 游标在(Score, Id)之后
+func (c TrendCursor) After(score, id int64) bool {
+	return score < c.Score || (score == c.Score && id < c.Id)
+}
+
+func (c TrendCursor) String() string {
+	if c.IsZero() {
+		return ""
+	}
+
+	return strconv.FormatInt(c.Score, 10) + "_" + strconv.FormatInt(c.Id, 10)
+}
+
+// 解析格式为score_id的游标 空字符串表示从第一页开始
+func ParseTrendCursor(s string) (TrendCursor, error) {
+	if s == "" {
+		return TrendCursor{}, nil
+	}
+
+	scoreStr, idStr, ok := strings.Cut(s, "_")
+	if !ok {
+		return TrendCursor{}, global.ErrInvalidTrendCursor
+	}
+
+	score, err := strconv.ParseInt(scoreStr, 10, 64)
+	if err != nil {
+		return TrendCursor{}, global.ErrInvalidTrendCursor
+	}
+
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil || id <= 0 {
+		return TrendCursor{}, global.ErrInvalidTrendCursor
+	}
+
+	return TrendCursor{Score: score, Id: id}, nil
+}
@@ -0,0 +1,59 @@
+package model
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTrendScore(t *testing.T) {
+	Convey("TrendScore", t, func() {
+		const ctime = 1735660800
+
+		Convey("more interactions rank higher", func() {
+			So(TrendScore(10, 0, 0, ctime), ShouldBeGreaterThan, TrendScore(1, 0, 0, ctime))
+			So(TrendScore(0, 0, 10, ctime), ShouldBeGreaterThan, TrendScore(10, 0, 0, ctime))
+			So(TrendScore(0, 10, 0, ctime), ShouldBeLessThan, TrendScore(0, 0, 0, ctime))
+		})
+
+		Convey("newer comments rank higher", func() {
+			So(TrendScore(5, 0, 0, ctime+3600), ShouldBeGreaterThan, TrendScore(5, 0, 0, ctime))
+			// 晚发布一个衰减周期等价于十倍的互动
+			So(TrendScore(1, 0, 0, ctime+trendDecay), ShouldEqual, TrendScore(10, 0, 0, ctime))
+		})
+	})
+}
+
+func TestTrendCursor(t *testing.T) {
+	Convey("TrendCursor", t, func() {
+		Convey("empty cursor", func() {
+			c, err := ParseTrendCursor("")
+			So(err, ShouldBeNil)
+			So(c.IsZero(), ShouldBeTrue)
+			So(c.String(), ShouldEqual, "")
+		})
+
+		Convey("round trip", func() {
+			for _, c := range []TrendCursor{{Score: 1234567, Id: 99}, {Score: -42, Id: 1}} {
+				parsed, err := ParseTrendCursor(c.String())
+				So(err, ShouldBeNil)
+				So(parsed, ShouldResemble, c)
+			}
+		})
+
+		Convey("invalid cursor", func() {
+			for _, s := range []string{"abc", "1_", "_1", "1_0", "1_x", "1.5_2"} {
+				_, err := ParseTrendCursor(s)
+				So(err, ShouldNotBeNil)
+			}
+		})
+
+		Convey("after", func() {
+			c := TrendCursor{Score: 100, Id: 10}
+			So(c.After(99, 20), ShouldBeTrue)
+			So(c.After(100, 9), ShouldBeTrue)
+			So(c.After(100, 10), ShouldBeFalse)
+			So(c.After(101, 1), ShouldBeFalse)
+		})
+	})
+}
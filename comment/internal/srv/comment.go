@@ -9,10 +9,12 @@ import (
 	"github.com/ryanreadbooks/whimer/comment/internal/infra/dep"
 	"github.com/ryanreadbooks/whimer/comment/internal/model"
 	notev1 "github.com/ryanreadbooks/whimer/idl/gen/go/note/api/v1"
+	"github.com/ryanreadbooks/whimer/misc/concurrent"
 	"github.com/ryanreadbooks/whimer/misc/metadata"
 	"github.com/ryanreadbooks/whimer/misc/recovery"
 	"github.com/ryanreadbooks/whimer/misc/xerror"
 	"github.com/ryanreadbooks/whimer/misc/xlog"
+	"github.com/ryanreadbooks/whimer/misc/xmap"
 	"github.com/ryanreadbooks/whimer/misc/xslice"
 
 	"golang.org/x/sync/errgroup"
@@ -21,15 +23,32 @@ import (
 type CommentSrv struct {
 	CommentBiz         biz.CommentBiz
 	CommentInteractBiz biz.CommentInteractBiz
+	CommentTrendBiz    biz.CommentTrendBiz
+	CommentReportBiz   biz.CommentReportBiz
 }
 
 func NewCommentSrv(s *Service, biz biz.Biz) *CommentSrv {
 	return &CommentSrv{
 		CommentBiz:         biz.CommentBiz,
 		CommentInteractBiz: biz.CommentInteractBiz,
+		CommentTrendBiz:    biz.CommentTrendBiz,
+		CommentReportBiz:   biz.CommentReportBiz,
 	}
 }
 
+// 异步更新主评论的热度
+func (s *CommentSrv) refreshTrend(ctx context.Context, rootId int64) {
+	if rootId == 0 {
+		return
+	}
+
+	concurrent.DoneInCtx(ctx, 10*time.Second, func(ctx context.Context) {
+		if err := s.CommentTrendBiz.RefreshRoot(ctx, rootId); err != nil {
+			xlog.Msg("comment srv refresh trend failed").Err(err).Extras("rootId", rootId).Errorx(ctx)
+		}
+	})
+}
+
 // 用户发表评论
 func (s *CommentSrv) AddComment(ctx context.Context, req *model.AddCommentReq) (*model.AddCommentRes, error) {
 	res, err := s.CommentBiz.AddComment(ctx, req)
@@ -37,9 +56,42 @@ func (s *CommentSrv) AddComment(ctx context.Context, req *model.AddCommentReq) (
 		return nil, xerror.Wrapf(err, "comment srv failed to add comment").WithCtx(ctx).WithExtra("req", req)
 	}
 
+	// 等待作者审核的评论通过后再更新热度
+	if res.Held {
+		return res, nil
+	}
+
+	// 新增主评论或者主评论的子评论数量变化
+	if model.IsRoot(req.RootId, req.ParentId) {
+		s.refreshTrend(ctx, res.CommentId)
+	} else {
+		s.refreshTrend(ctx, req.RootId)
+	}
+
+	return res, nil
+}
+
+// 用户编辑评论
+func (s *CommentSrv) EditComment(ctx context.Context, req *model.EditCommentReq) (*model.EditCommentRes, error) {
+	res, err := s.CommentBiz.EditComment(ctx, req)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "comment srv failed to edit comment").WithCtx(ctx).WithExtra("req", req)
+	}
+
 	return res, nil
 }
 
+// 获取评论的编辑历史
+func (s *CommentSrv) GetCommentEditHistory(ctx context.Context, commentId int64) ([]*model.CommentEditVersion, error) {
+	versions, err := s.CommentBiz.GetEditHistory(ctx, commentId)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "comment srv failed to get edit history").
+			WithCtx(ctx).WithExtra("commentId", commentId)
+	}
+
+	return versions, nil
+}
+
 // 用户删除评论
 func (s *CommentSrv) DelComment(ctx context.Context, oid, commentId int64) error {
 	err := s.CommentBiz.DelComment(ctx, oid, commentId)
@@ -54,7 +106,7 @@ func (s *CommentSrv) DelComment(ctx context.Context, oid, commentId int64) error
 
 // 用户点赞/取消点赞某条评论
 func (s *CommentSrv) LikeComment(ctx context.Context, commentId int64, action int8) error {
-	_, err := s.CommentBiz.GetComment(ctx, commentId,
+	comment, err := s.CommentBiz.GetComment(ctx, commentId,
 		biz.DoNotPopulateExt(), biz.DoNotPopulateImages())
 	if err != nil {
 		return xerror.Wrapf(err, "comment srv pin comment failed").WithCtx(ctx)
@@ -67,12 +119,17 @@ func (s *CommentSrv) LikeComment(ctx context.Context, commentId int64, action in
 			WithExtras("commentId", commentId, "action", action)
 	}
 
+	// 只有主评论参与热度排行
+	if comment.IsRoot() {
+		s.refreshTrend(ctx, commentId)
+	}
+
 	return nil
 }
 
 // 用户点踩/取消点踩某条评论
 func (s *CommentSrv) DislikeComment(ctx context.Context, commentId int64, action int8) error {
-	_, err := s.CommentBiz.GetComment(ctx, commentId,
+	comment, err := s.CommentBiz.GetComment(ctx, commentId,
 		biz.DoNotPopulateExt(), biz.DoNotPopulateImages())
 	if err != nil {
 		return xerror.Wrapf(err, "comment srv pin comment failed").WithCtx(ctx)
@@ -85,6 +142,11 @@ func (s *CommentSrv) DislikeComment(ctx context.Context, commentId int64, action
 			WithExtras("commentId", commentId, "action", action)
 	}
 
+	// 只有主评论参与热度排行
+	if comment.IsRoot() {
+		s.refreshTrend(ctx, commentId)
+	}
+
 	return nil
 }
 
@@ -131,16 +193,65 @@ func (s *CommentSrv) PinComment(ctx context.Context, oid, commentId int64, actio
 			WithCtx(ctx).
 			WithExtras("commentId", commentId, "action", action, "oid", oid)
 	}
+
+	// 取消置顶后重新回到热度排行中
+	if action == biz.ActionUndo {
+		s.refreshTrend(ctx, commentId)
+	}
+
 	return nil
 }
 
+// 用户举报评论
+func (s *CommentSrv) ReportComment(ctx context.Context, req *model.ReportCommentReq) error {
+	err := s.CommentReportBiz.ReportComment(ctx, req)
+	if err != nil {
+		return xerror.Wrapf(err, "comment srv failed to report comment").WithCtx(ctx).WithExtra("req", req)
+	}
+
+	return nil
+}
+
+// 获取待处理的举报
+func (s *CommentSrv) ListPendingReports(ctx context.Context, cursor int64, count int) (*model.PendingReports, error) {
+	res, err := s.CommentReportBiz.ListPendingReports(ctx, cursor, count)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "comment srv failed to list pending reports").WithCtx(ctx)
+	}
+
+	if err := s.CommentInteractBiz.PopulateLikes(ctx, xmap.Values(res.Comments)); err != nil {
+		xlog.Msg("comment srv failed to populate reported comments").Err(err).Errorx(ctx)
+	}
+
+	return res, nil
+}
+
+// 处理评论的举报
+func (s *CommentSrv) ResolveReports(ctx context.Context, commentId int64,
+	resolution model.ReportResolution, banSeconds int64) (int64, error) {
+	comment, resolved, err := s.CommentReportBiz.ResolveReports(ctx, commentId, resolution, banSeconds)
+	if err != nil {
+		return 0, xerror.Wrapf(err, "comment srv failed to resolve reports").
+			WithCtx(ctx).WithExtras("commentId", commentId, "resolution", resolution)
+	}
+
+	// 保留的主评论重新回到热度排行中
+	if comment != nil && comment.IsRoot() && !resolution.ShouldDelete() {
+		s.refreshTrend(ctx, commentId)
+	}
+
+	return resolved, nil
+}
+
 // 分页获取主评论
-func (s *CommentSrv) PageGetRootComments(ctx context.Context, oid, cursor int64, sortBy int8) (*model.PageComments, error) {
+func (s *CommentSrv) PageGetRootComments(ctx context.Context, oid, cursor int64, trendCursor string, sortBy int8) (
+	*model.PageComments, error,
+) {
 	const (
 		want = 18
 	)
 
-	rootComments, err := s.CommentBiz.GetRootComments(ctx, oid, cursor, want, sortBy)
+	rootComments, err := s.CommentBiz.GetRootComments(ctx, oid, cursor, trendCursor, want, sortBy)
 	if err != nil {
 		return nil, xerror.Wrapf(err, "comment srv failed to get root comments").WithCtx(ctx)
 	}
@@ -190,12 +301,12 @@ func (s *CommentSrv) PageListSubComments(ctx context.Context, oid, rootId int64,
 }
 
 // 获取对象的评论，包含主评论及其下的子评论
-func (s *CommentSrv) PageGetObjectComments(ctx context.Context, oid, cursor int64, sortBy int8) (
+func (s *CommentSrv) PageGetObjectComments(ctx context.Context, oid, cursor int64, trendCursor string, sortBy int8) (
 	*model.PageDetailedComments, error,
 ) {
 
 	// 先拿主评论
-	roots, err := s.PageGetRootComments(ctx, oid, cursor, sortBy)
+	roots, err := s.PageGetRootComments(ctx, oid, cursor, trendCursor, sortBy)
 	if err != nil {
 		return nil, xerror.Wrapf(err, "comment srv failed to get object replies").
 			WithCtx(ctx).WithExtras("oid", oid, "cursor", cursor, "sortBy", sortBy)
@@ -238,19 +349,20 @@ func (s *CommentSrv) PageGetObjectComments(ctx context.Context, oid, cursor int6
 		})
 	}
 	ret := model.PageDetailedComments{
-		Items:      comments,
-		NextCursor: roots.NextCursor,
-		HasNext:    roots.HasNext,
+		Items:           comments,
+		NextCursor:      roots.NextCursor,
+		NextTrendCursor: roots.NextTrendCursor,
+		HasNext:         roots.HasNext,
 	}
 
 	return &ret, nil
 }
 
-func (s *CommentSrv) PageGetObjectCommentsV2(ctx context.Context, oid, cursor int64, sortBy int8) (
+func (s *CommentSrv) PageGetObjectCommentsV2(ctx context.Context, oid, cursor int64, trendCursor string, sortBy int8) (
 	*model.PageDetailedCommentsV2, error,
 ) {
 	// 先拿主评论
-	roots, err := s.PageGetRootComments(ctx, oid, cursor, sortBy)
+	roots, err := s.PageGetRootComments(ctx, oid, cursor, trendCursor, sortBy)
 	if err != nil {
 		return nil, xerror.Wrapf(err, "comment srv failed to get object replies").
 			WithCtx(ctx).WithExtras("oid", oid, "cursor", cursor, "sortBy", sortBy)
@@ -297,9 +409,10 @@ func (s *CommentSrv) PageGetObjectCommentsV2(ctx context.Context, oid, cursor in
 		})
 	}
 	ret := model.PageDetailedCommentsV2{
-		Items:      comments,
-		NextCursor: roots.NextCursor,
-		HasNext:    roots.HasNext,
+		Items:           comments,
+		NextCursor:      roots.NextCursor,
+		NextTrendCursor: roots.NextTrendCursor,
+		HasNext:         roots.HasNext,
 	}
 
 	return &ret, nil
@@ -0,0 +1,83 @@
+package srv
+
+import (
+	"context"
+
+	"github.com/ryanreadbooks/whimer/comment/internal/biz"
+	"github.com/ryanreadbooks/whimer/comment/internal/model"
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+)
+
+type EmojiSrv struct {
+	CommentEmojiBiz biz.CommentEmojiBiz
+}
+
+func NewEmojiSrv(s *Service, biz biz.Biz) *EmojiSrv {
+	return &EmojiSrv{
+		CommentEmojiBiz: biz.CommentEmojiBiz,
+	}
+}
+
+// 列出表情包
+func (s *EmojiSrv) ListPacks(ctx context.Context, includeDisabled bool) ([]*model.EmojiPack, error) {
+	packs, err := s.CommentEmojiBiz.ListPacks(ctx, includeDisabled)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "emoji srv failed to list packs").WithCtx(ctx)
+	}
+
+	return packs, nil
+}
+
+// 获取表情包及其表情
+func (s *EmojiSrv) GetPack(ctx context.Context, code string, includeDisabled bool) (
+	*model.EmojiPack, []*model.EmojiItem, error,
+) {
+	pack, items, err := s.CommentEmojiBiz.GetPack(ctx, code, includeDisabled)
+	if err != nil {
+		return nil, nil, xerror.Wrapf(err, "emoji srv failed to get pack").WithCtx(ctx).WithExtra("code", code)
+	}
+
+	return pack, items, nil
+}
+
+// 批量查找表情 无法解析的引用直接忽略
+func (s *EmojiSrv) BatchGetItems(ctx context.Context, rawTokens []string) (map[string]*model.EmojiItem, error) {
+	tokens := make([]model.EmojiToken, 0, len(rawTokens))
+	for _, raw := range rawTokens {
+		if t, ok := model.ParseEmojiToken(raw); ok {
+			tokens = append(tokens, t)
+		}
+	}
+
+	items, err := s.CommentEmojiBiz.BatchGetItems(ctx, tokens)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "emoji srv failed to batch get items").WithCtx(ctx)
+	}
+
+	res := make(map[string]*model.EmojiItem, len(items))
+	for t, item := range items {
+		res[t.String()] = item
+	}
+
+	return res, nil
+}
+
+// 新增或者更新表情包
+func (s *EmojiSrv) UpsertPack(ctx context.Context, req *model.UpsertEmojiPackReq) (*model.EmojiPack, error) {
+	pack, err := s.CommentEmojiBiz.UpsertPack(ctx, req)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "emoji srv failed to upsert pack").WithCtx(ctx).WithExtra("req", req)
+	}
+
+	return pack, nil
+}
+
+// 新增或者更新表情
+func (s *EmojiSrv) UpsertItem(ctx context.Context, req *model.UpsertEmojiItemReq) (*model.EmojiItem, error) {
+	item, err := s.CommentEmojiBiz.UpsertItem(ctx, req)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "emoji srv failed to upsert item").WithCtx(ctx).WithExtra("req", req)
+	}
+
+	return item, nil
+}
@@ -7,6 +7,8 @@ import (
 
 type Service struct {
 	CommentSrv *CommentSrv
+	EmojiSrv   *EmojiSrv
+	SettingSrv *SettingSrv
 }
 
 // 初始化一个service
@@ -16,6 +18,8 @@ func NewService(c *config.Config) *Service {
 	// 基础设施初始化
 	biz := biz.New()
 	s.CommentSrv = NewCommentSrv(s, biz)
+	s.EmojiSrv = NewEmojiSrv(s, biz)
+	s.SettingSrv = NewSettingSrv(s, biz)
 
 	return s
 }
@@ -0,0 +1,112 @@
+package srv
+
+import (
+	"context"
+
+	"github.com/ryanreadbooks/whimer/comment/internal/biz"
+	"github.com/ryanreadbooks/whimer/comment/internal/model"
+	"github.com/ryanreadbooks/whimer/misc/metadata"
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+)
+
+type SettingSrv struct {
+	commentSrv *CommentSrv
+
+	CommentBiz        biz.CommentBiz
+	CommentSettingBiz biz.CommentSettingBiz
+}
+
+func NewSettingSrv(s *Service, biz biz.Biz) *SettingSrv {
+	return &SettingSrv{
+		commentSrv:        s.CommentSrv,
+		CommentBiz:        biz.CommentBiz,
+		CommentSettingBiz: biz.CommentSettingBiz,
+	}
+}
+
+// 作者获取对象的评论设置
+func (s *SettingSrv) GetSetting(ctx context.Context, oid int64) (*model.CommentSetting, error) {
+	if err := s.CommentSettingBiz.CheckObjectOwner(ctx, metadata.Uid(ctx), oid); err != nil {
+		return nil, xerror.Wrapf(err, "setting srv check owner failed").WithCtx(ctx)
+	}
+
+	setting, err := s.CommentSettingBiz.GetSetting(ctx, oid)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "setting srv failed to get setting").WithCtx(ctx).WithExtra("oid", oid)
+	}
+
+	return setting, nil
+}
+
+// 作者更新对象的评论设置
+func (s *SettingSrv) UpdateSetting(ctx context.Context, req *model.UpdateCommentSettingReq) (*model.CommentSetting, error) {
+	setting, err := s.CommentSettingBiz.UpdateSetting(ctx, req)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "setting srv failed to update setting").WithCtx(ctx).WithExtra("req", req)
+	}
+
+	return setting, nil
+}
+
+// 作者禁止/解除禁止用户评论
+func (s *SettingSrv) MuteUser(ctx context.Context, uid int64, action int8) error {
+	err := s.CommentSettingBiz.MuteUser(ctx, uid, action)
+	if err != nil {
+		return xerror.Wrapf(err, "setting srv failed to mute user").WithCtx(ctx).WithExtras("uid", uid, "action", action)
+	}
+
+	return nil
+}
+
+// 获取作者禁止评论的用户
+func (s *SettingSrv) ListMutedUsers(ctx context.Context, cursor int64, count int) (*model.PageMutedUsers, error) {
+	res, err := s.CommentSettingBiz.ListMutedUsers(ctx, cursor, count)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "setting srv failed to list muted users").WithCtx(ctx)
+	}
+
+	return res, nil
+}
+
+// 作者获取对象下等待审核的评论
+func (s *SettingSrv) ListHeldComments(ctx context.Context, oid, cursor int64, count int) (*model.PageHeldComments, error) {
+	if err := s.CommentSettingBiz.CheckObjectOwner(ctx, metadata.Uid(ctx), oid); err != nil {
+		return nil, xerror.Wrapf(err, "setting srv check owner failed").WithCtx(ctx)
+	}
+
+	res, err := s.CommentBiz.ListHeldComments(ctx, oid, cursor, count)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "setting srv failed to list held comments").WithCtx(ctx).WithExtra("oid", oid)
+	}
+
+	return res, nil
+}
+
+// 作者通过对象下等待审核的评论 返回通过的评论
+func (s *SettingSrv) ApproveHeldComments(ctx context.Context, oid int64, commentIds []int64) ([]*model.CommentItem, error) {
+	if err := s.CommentSettingBiz.CheckObjectOwner(ctx, metadata.Uid(ctx), oid); err != nil {
+		return nil, xerror.Wrapf(err, "setting srv check owner failed").WithCtx(ctx)
+	}
+
+	approved, err := s.CommentBiz.ApproveHeldComments(ctx, oid, commentIds)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "setting srv failed to approve held comments").
+			WithCtx(ctx).WithExtras("oid", oid, "commentIds", commentIds)
+	}
+
+	// 通过的主评论进入热度排行 子评论更新其主评论的热度
+	refreshed := make(map[int64]struct{}, len(approved))
+	for _, c := range approved {
+		rootId := c.RootId
+		if c.IsRoot() {
+			rootId = c.Id
+		}
+		if _, ok := refreshed[rootId]; ok {
+			continue
+		}
+		refreshed[rootId] = struct{}{}
+		s.commentSrv.refreshTrend(ctx, rootId)
+	}
+
+	return approved, nil
+}
@@ -3,45 +3,85 @@ package biz
 import (
 	"context"
 
+	"github.com/redis/go-redis/v9"
 	"github.com/ryanreadbooks/whimer/conductor/internal/biz/shard"
 	"github.com/ryanreadbooks/whimer/conductor/internal/config"
 	"github.com/ryanreadbooks/whimer/conductor/internal/infra"
+	"github.com/ryanreadbooks/whimer/conductor/internal/infra/dao"
 )
 
 type Biz struct {
 	rootCtx context.Context
 	cancel  context.CancelFunc
+	dao     *dao.Dao
 
 	NamespaceBiz *NamespaceBiz
 	TaskBiz      *TaskBiz
 	WorkerBiz    *WorkerBiz
-	ShardBiz     *shard.Biz
+	ShardBiz     shard.Sharder
 	CallbackBiz  *CallbackBiz
+	ScheduleBiz  *ScheduleBiz
+	WorkflowBiz  *WorkflowBiz
+	WatchBiz     *WatchBiz
+}
+
+// Deps Biz 依赖的基础设施
+type Deps struct {
+	Dao   *dao.Dao
+	Shard shard.Sharder
+	// 为空时任务事件只分发给本实例的订阅者
+	PubSub redis.UniversalClient
 }
 
 func NewBiz(rootCtx context.Context, c *config.Config) *Biz {
+	return NewBizWithDeps(rootCtx, c, Deps{
+		Dao:    infra.Dao(),
+		Shard:  shard.NewBiz(c, infra.Etcd()),
+		PubSub: infra.PubSub(),
+	})
+}
+
+// NewBizWithDeps 使用指定的基础设施创建 Biz 内嵌模式下使用
+func NewBizWithDeps(rootCtx context.Context, c *config.Config, deps Deps) *Biz {
+	taskBiz := NewTaskBiz(
+		c,
+		deps.Dao.TaskDao,
+		deps.Dao.TaskHistoryDao,
+		deps.Dao.IdempotencyDao,
+	)
+
+	namespaceBiz := NewNamespaceBiz(deps.Dao.NamespaceDao)
+
 	return &Biz{
 		rootCtx: rootCtx,
+		dao:     deps.Dao,
 
-		NamespaceBiz: NewNamespaceBiz(infra.Dao().NamespaceDao),
-		TaskBiz: NewTaskBiz(
-			infra.Dao().TaskDao,
-			infra.Dao().TaskHistoryDao,
+		NamespaceBiz: namespaceBiz,
+		TaskBiz:      taskBiz,
+		WorkerBiz:    NewWorkerBiz(c, deps.Dao.WorkerDao),
+		ShardBiz:     deps.Shard,
+		CallbackBiz:  NewCallbackBiz(c, deps.Dao.CallbackDao, namespaceBiz),
+		ScheduleBiz:  NewScheduleBiz(deps.Dao.ScheduleDao, taskBiz),
+		WorkflowBiz: NewWorkflowBiz(
+			deps.Dao.TaskDao,
+			deps.Dao.TaskHistoryDao,
+			taskBiz,
 		),
-		WorkerBiz:   NewWorkerBiz(c),
-		ShardBiz:    shard.NewBiz(c, infra.Etcd()),
-		CallbackBiz: NewCallbackBiz(),
+		WatchBiz: NewWatchBiz(deps.PubSub),
 	}
 }
 
 func (b *Biz) Start() {
 	b.ShardBiz.Run(b.rootCtx)
+	b.WatchBiz.Run(b.rootCtx)
 }
 
 func (b *Biz) Stop() {
 	b.ShardBiz.Stop()
+	b.CallbackBiz.Close()
+	b.WatchBiz.Stop()
 }
 
 func (b *Biz) Tx(ctx context.Context, fn func(ctx context.Context) error) error {
-	return infra.Dao().DB().Transact(ctx, fn)
+	return b.dao.Transact(ctx, fn)
 }
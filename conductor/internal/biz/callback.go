@@ -4,109 +4,345 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand/v2"
 	"net/http"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/ryanreadbooks/whimer/conductor/internal/biz/model"
-	"github.com/ryanreadbooks/whimer/misc/concurrent"
+	"github.com/ryanreadbooks/whimer/conductor/internal/config"
+	"github.com/ryanreadbooks/whimer/conductor/internal/infra/dao"
+	callbackv1 "github.com/ryanreadbooks/whimer/idl/gen/go/conductor/api/callback/v1"
 	"github.com/ryanreadbooks/whimer/misc/trace"
+	"github.com/ryanreadbooks/whimer/misc/uuid"
+	"github.com/ryanreadbooks/whimer/misc/xerror"
 	"github.com/ryanreadbooks/whimer/misc/xhttp/client"
-	"github.com/ryanreadbooks/whimer/misc/xlog"
+	"github.com/zeromicro/go-zero/zrpc"
+	"google.golang.org/grpc/metadata"
 )
 
-// CallbackPayload 回调请求体
-type CallbackPayload struct {
-	TaskId      string          `json:"task_id"`
-	Namespace   string          `json:"namespace"`
-	TaskType    string          `json:"task_type"`
-	State       model.TaskState `json:"state"`
-	OutputArgs  []byte          `json:"output_args,omitempty"`
-	ErrorMsg    string          `json:"error_msg,omitempty"`
-	TraceId     string          `json:"trace_id,omitempty"`
-	CompletedAt int64           `json:"completed_at"`
-}
+// 记录的失败原因最大长度
+const maxCallbackErrorLen = 512
 
 // CallbackBiz 回调业务逻辑
+//
+// 任务到达终态时在同一事务中写入回调投递记录 由扫描协程投递 失败后按指数退避重试
 type CallbackBiz struct {
-	httpcli *http.Client
+	callbackDao  dao.CallbackStore
+	namespaceBiz *NamespaceBiz
+
+	httpcli     *http.Client
+	timeout     time.Duration
+	maxAttempts int32
+	backoffBase time.Duration
+	backoffMax  time.Duration
+
+	grpcMu      sync.Mutex
+	grpcClients map[string]zrpc.Client // target -> client
 }
 
 // NewCallbackBiz 创建回调业务逻辑
-func NewCallbackBiz() *CallbackBiz {
-	// 使用 builder 构建带重试和链路追踪的 HTTP 客户端
+func NewCallbackBiz(c *config.Config, callbackDao dao.CallbackStore, namespaceBiz *NamespaceBiz) *CallbackBiz {
+	timeout := c.CallbackConfig.GetTimeout()
+
+	// 重试由投递记录驱动 客户端本身不重试
 	cli := client.NewBuilder().
-		WithTimeout(10 * time.Second).
-		WithDefaultRetry().
+		WithTimeout(timeout).
 		WithTracing(). // 注入 trace id 到请求头
 		Build()
 
 	return &CallbackBiz{
-		httpcli: cli,
+		callbackDao:  callbackDao,
+		namespaceBiz: namespaceBiz,
+		httpcli:      cli,
+		timeout:      timeout,
+		maxAttempts:  c.CallbackConfig.GetMaxAttempts(),
+		backoffBase:  c.CallbackConfig.GetBackoffBase(),
+		backoffMax:   c.CallbackConfig.GetBackoffMax(),
+		grpcClients:  make(map[string]zrpc.Client),
 	}
 }
 
-// TriggerCallback 触发回调（异步执行）
-func (b *CallbackBiz) TriggerCallback(ctx context.Context, callbackUrl string, payload *CallbackPayload) {
-	if callbackUrl == "" {
-		return
-	}
-
-	// 从 payload.TraceId 创建 SpanContext 并注入到 context 中
-	// traceid 格式为 W3C traceparent: 00-{trace-id}-{span-id}-{flags}
-	traceCtx := trace.ContextWithTraceparent(ctx, payload.TraceId)
-
-	// TODO 引入协程池 控制并发数量
-	concurrent.SafeGo2(traceCtx, concurrent.SafeGo2Opt{
-		Name:       "conductor.callback.trigger",
-		LogOnError: true,
-		Job: func(ctx context.Context) error {
-			b.doCallback(ctx, callbackUrl, payload)
-			return nil
-		},
-	})
-}
+// EnqueueCallback 写入回调投递记录 任务未设置回调时不做任何操作
+//
+// 需要和任务终态的更新在同一个事务中调用
+func (b *CallbackBiz) EnqueueCallback(
+	ctx context.Context,
+	task *model.Task,
+	payload *model.CallbackPayload,
+) error {
+	target, targetType := task.CallbackTarget()
+	if target == "" {
+		return nil
+	}
 
-func (b *CallbackBiz) doCallback(ctx context.Context, callbackUrl string, payload *CallbackPayload) {
 	body, err := json.Marshal(payload)
 	if err != nil {
-		xlog.Msg("callback marshal payload failed").
-			Extras("callbackUrl", callbackUrl, "taskId", payload.TaskId).
-			Err(err).Errorx(ctx)
-		return
+		return xerror.Wrapf(err, "callback biz marshal payload failed").
+			WithExtra("taskId", task.Id.String()).
+			WithCtx(ctx)
+	}
+
+	now := time.Now().UnixMilli()
+	po := &dao.CallbackPO{
+		Id:            uuid.NewUUID(),
+		TaskId:        task.Id,
+		Namespace:     task.Namespace,
+		Shard:         model.CalculateShardHash(task.TaskType),
+		Target:        target,
+		TargetType:    string(targetType),
+		Payload:       body,
+		State:         string(model.CallbackStatePending),
+		MaxAttempts:   b.maxAttempts,
+		NextRetryTime: now,
+		Ctime:         now,
+		Utime:         now,
+		Version:       1,
+	}
+	err = b.callbackDao.Insert(ctx, po)
+	if err != nil {
+		return xerror.Wrapf(err, "callback dao insert failed").
+			WithExtra("taskId", task.Id.String()).
+			WithCtx(ctx)
+	}
+
+	return nil
+}
+
+// GetDueCallbacks 获取分片范围内已到投递时间的回调
+func (b *CallbackBiz) GetDueCallbacks(
+	ctx context.Context,
+	shardStart, shardEnd int,
+	limit int32,
+) ([]*model.TaskCallback, error) {
+	pos, err := b.callbackDao.ListDue(ctx,
+		string(model.CallbackStatePending),
+		shardStart, shardEnd,
+		time.Now().UnixMilli(),
+		limit)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "callback dao list due failed").WithCtx(ctx)
+	}
+
+	callbacks := make([]*model.TaskCallback, 0, len(pos))
+	for _, po := range pos {
+		callbacks = append(callbacks, model.TaskCallbackFromPO(po))
+	}
+	return callbacks, nil
+}
+
+// ListTaskCallbacks 获取任务的回调投递记录
+func (b *CallbackBiz) ListTaskCallbacks(ctx context.Context, taskId uuid.UUID) ([]*model.TaskCallback, error) {
+	pos, err := b.callbackDao.ListByTaskId(ctx, taskId)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "callback dao list by task id failed").
+			WithExtra("taskId", taskId.String()).
+			WithCtx(ctx)
+	}
+
+	callbacks := make([]*model.TaskCallback, 0, len(pos))
+	for _, po := range pos {
+		callbacks = append(callbacks, model.TaskCallbackFromPO(po))
+	}
+	return callbacks, nil
+}
+
+// Lease 占用回调的投递权
+//
+// 占用期间其它实例不会投递该回调；进程在投递中途退出时 占用到期后会被重新投递
+// 返回 false 表示已被其它实例占用
+func (b *CallbackBiz) Lease(ctx context.Context, cb *model.TaskCallback) (bool, error) {
+	now := time.Now().UnixMilli()
+	leaseUntil := now + (2 * b.timeout).Milliseconds()
+	ok, err := b.callbackDao.Lease(ctx, cb.Id, cb.Version, leaseUntil, now)
+	if err != nil {
+		return false, xerror.Wrapf(err, "callback dao lease failed").
+			WithExtra("callbackId", cb.Id.String()).
+			WithCtx(ctx)
+	}
+	if ok {
+		cb.Version++
+		cb.NextRetryTime = leaseUntil
+	}
+
+	return ok, nil
+}
+
+// Deliver 投递一次回调 返回投递失败的原因
+func (b *CallbackBiz) Deliver(ctx context.Context, cb *model.TaskCallback) error {
+	var payload model.CallbackPayload
+	if err := json.Unmarshal(cb.Payload, &payload); err == nil {
+		// traceid 格式为 W3C traceparent: 00-{trace-id}-{span-id}-{flags}
+		ctx = trace.ContextWithTraceparent(ctx, payload.TraceId)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, callbackUrl, bytes.NewReader(body))
+	// 每次投递时读取密钥 重置密钥后立即生效
+	ns, err := b.namespaceBiz.Get(ctx, cb.Namespace)
 	if err != nil {
-		xlog.Msg("callback create request failed").
-			Extras("callbackUrl", callbackUrl, "taskId", payload.TaskId).
-			Err(err).Errorx(ctx)
-		return
+		return xerror.Wrapf(err, "callback biz get namespace failed").
+			WithExtra("namespace", cb.Namespace).
+			WithCtx(ctx)
+	}
+
+	headers := b.signedHeaders(cb, ns.CallbackSecret)
+	switch cb.TargetType {
+	case model.CallbackTargetGrpc:
+		return b.deliverGrpc(ctx, cb, headers)
+	default:
+		return b.deliverHttp(ctx, cb, headers)
+	}
+}
+
+// signedHeaders 回调请求头 命名空间未设置密钥时不签名
+func (b *CallbackBiz) signedHeaders(cb *model.TaskCallback, secret string) map[string]string {
+	headers := map[string]string{
+		model.CallbackHeaderId:      cb.Id.String(),
+		model.CallbackHeaderAttempt: strconv.Itoa(int(cb.Attempts) + 1),
+	}
+	if secret != "" {
+		ts := time.Now().Unix()
+		headers[model.CallbackHeaderTimestamp] = strconv.FormatInt(ts, 10)
+		headers[model.CallbackHeaderSignature] = model.SignCallback(secret, ts, cb.Payload)
+	}
+	return headers
+}
+
+func (b *CallbackBiz) deliverHttp(ctx context.Context, cb *model.TaskCallback, headers map[string]string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, cb.Target, bytes.NewReader(cb.Payload))
+	if err != nil {
+		return fmt.Errorf("create request failed: %w", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
 
 	resp, err := b.httpcli.Do(req)
 	if err != nil {
-		xlog.Msg("callback request failed").
-			Extras("callbackUrl", callbackUrl, "taskId", payload.TaskId).
-			Err(err).Errorx(ctx)
-		return
+		return fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	// 2xx 视为成功
 	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
-		xlog.Msg("callback success").
-			Extras("callbackUrl", callbackUrl,
-				"taskId", payload.TaskId,
-				"statusCode", resp.StatusCode,
-				"responseBody", resp.Body).
-			Infox(ctx)
-		return
-	}
-
-	xlog.Msg("callback failed with status").
-		Extras("callbackUrl", callbackUrl,
-			"taskId", payload.TaskId,
-			"statusCode", resp.StatusCode).
-		Errorx(ctx)
+		return nil
+	}
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxCallbackErrorLen))
+	return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, respBody)
+}
+
+func (b *CallbackBiz) deliverGrpc(ctx context.Context, cb *model.TaskCallback, headers map[string]string) error {
+	cli, err := b.getGrpcClient(cb.Target)
+	if err != nil {
+		return fmt.Errorf("create grpc client failed: %w", err)
+	}
+
+	md := make(metadata.MD, len(headers))
+	for k, v := range headers {
+		md.Set(strings.ToLower(k), v)
+	}
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	_, err = callbackv1.NewCallbackServiceClient(cli.Conn()).
+		OnTaskCompleted(ctx, &callbackv1.OnTaskCompletedRequest{
+			Payload: cb.Payload,
+		})
+	if err != nil {
+		return fmt.Errorf("grpc call failed: %w", err)
+	}
+
+	return nil
+}
+
+// getGrpcClient 按目标地址复用连接
+func (b *CallbackBiz) getGrpcClient(target string) (zrpc.Client, error) {
+	b.grpcMu.Lock()
+	defer b.grpcMu.Unlock()
+
+	if cli, ok := b.grpcClients[target]; ok {
+		return cli, nil
+	}
+
+	cli, err := zrpc.NewClientWithTarget(target, zrpc.WithTimeout(b.timeout))
+	if err != nil {
+		return nil, err
+	}
+	b.grpcClients[target] = cli
+	return cli, nil
+}
+
+// RecordResult 记录一次投递的结果
+//
+// 投递失败且未用尽次数时按指数退避安排下次投递 用尽后置为 failure
+func (b *CallbackBiz) RecordResult(ctx context.Context, cb *model.TaskCallback, deliverErr error) error {
+	now := time.Now().UnixMilli()
+	po := &dao.CallbackPO{
+		Id:            cb.Id,
+		State:         string(model.CallbackStateSuccess),
+		Attempts:      cb.Attempts + 1,
+		NextRetryTime: cb.NextRetryTime,
+		LastError:     cb.LastError,
+		Utime:         now,
+		Version:       cb.Version,
+	}
+	if deliverErr != nil {
+		po.LastError = deliverErr.Error()
+		if len(po.LastError) > maxCallbackErrorLen {
+			po.LastError = po.LastError[:maxCallbackErrorLen]
+		}
+		if cb.Exhausted() {
+			po.State = string(model.CallbackStateFailure)
+		} else {
+			po.State = string(model.CallbackStatePending)
+			po.NextRetryTime = now + b.backoff(cb.Attempts).Milliseconds()
+		}
+	}
+
+	ok, err := b.callbackDao.UpdateResult(ctx, po)
+	if err != nil {
+		return xerror.Wrapf(err, "callback dao update result failed").
+			WithExtra("callbackId", cb.Id.String()).
+			WithCtx(ctx)
+	}
+	if !ok {
+		// 占用已过期并被其它实例重新投递 以其它实例的结果为准
+		return xerror.Wrapf(xerror.ErrInternal, "callback lease lost").
+			WithExtra("callbackId", cb.Id.String()).
+			WithCtx(ctx)
+	}
+
+	cb.State = model.CallbackState(po.State)
+	cb.Attempts = po.Attempts
+	cb.NextRetryTime = po.NextRetryTime
+	cb.LastError = po.LastError
+	cb.Version++
+
+	return nil
+}
+
+// backoff 第 attempts+1 次投递失败后的等待时长 指数增长并加入抖动
+func (b *CallbackBiz) backoff(attempts int32) time.Duration {
+	d := b.backoffMax
+	if attempts < 30 {
+		d = min(b.backoffBase<<attempts, b.backoffMax)
+	}
+	// [d/2, d]
+	half := d / 2
+	return half + time.Duration(rand.Int64N(int64(half)+1))
+}
+
+// Close 关闭 gRPC 回调连接
+func (b *CallbackBiz) Close() {
+	b.grpcMu.Lock()
+	defer b.grpcMu.Unlock()
+
+	for target, cli := range b.grpcClients {
+		_ = cli.Conn().Close()
+		delete(b.grpcClients, target)
+	}
 }
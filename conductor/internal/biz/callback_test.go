@@ -0,0 +1,66 @@
+package biz
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/conductor/internal/biz/model"
+	"github.com/ryanreadbooks/whimer/misc/uuid"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func newTestCallbackBiz() *CallbackBiz {
+	return &CallbackBiz{
+		maxAttempts: 3,
+		backoffBase: 5 * time.Second,
+		backoffMax:  10 * time.Minute,
+	}
+}
+
+func TestCallbackBackoff(t *testing.T) {
+	Convey("测试退避时长指数增长", t, func() {
+		b := newTestCallbackBiz()
+
+		for attempts := int32(0); attempts < 5; attempts++ {
+			d := b.backoff(attempts)
+			upper := b.backoffBase << attempts
+			So(d, ShouldBeGreaterThanOrEqualTo, upper/2)
+			So(d, ShouldBeLessThanOrEqualTo, upper)
+		}
+	})
+
+	Convey("测试退避时长不超过上限", t, func() {
+		b := newTestCallbackBiz()
+
+		for _, attempts := range []int32{10, 31, 100} {
+			d := b.backoff(attempts)
+			So(d, ShouldBeGreaterThanOrEqualTo, b.backoffMax/2)
+			So(d, ShouldBeLessThanOrEqualTo, b.backoffMax)
+		}
+	})
+}
+
+func TestCallbackSignedHeaders(t *testing.T) {
+	Convey("测试回调请求头", t, func() {
+		b := newTestCallbackBiz()
+		cb := &model.TaskCallback{
+			Id:       uuid.NewUUID(),
+			Payload:  []byte(`{"task_id":"1"}`),
+			Attempts: 1,
+		}
+
+		Convey("设置密钥时带签名", func() {
+			headers := b.signedHeaders(cb, "secret")
+			So(headers[model.CallbackHeaderAttempt], ShouldEqual, "2")
+			So(headers[model.CallbackHeaderSignature], ShouldStartWith, model.CallbackSignaturePrefix)
+			So(headers[model.CallbackHeaderTimestamp], ShouldNotBeEmpty)
+		})
+
+		Convey("未设置密钥时不签名", func() {
+			headers := b.signedHeaders(cb, "")
+			So(headers[model.CallbackHeaderId], ShouldEqual, cb.Id.String())
+			So(headers, ShouldNotContainKey, model.CallbackHeaderSignature)
+		})
+	})
+}
@@ -0,0 +1,163 @@
+package biz
+
+import (
+	"sync"
+
+	"github.com/ryanreadbooks/whimer/conductor/internal/biz/model"
+	"github.com/ryanreadbooks/whimer/misc/uuid"
+)
+
+// 权重为1的命名空间每出队一个任务虚拟时间前进的步长
+const dispatchStrideBase = 1 << 20
+
+// nsDispatchQueue 单个命名空间的待分发任务 按优先级分桶 同优先级内 FIFO
+type nsDispatchQueue struct {
+	name    string
+	weight  int32
+	pass    uint64 // 虚拟时间 越小越先被选中
+	buckets [model.MaxTaskPriority + 1][]*model.Task
+	size    int
+}
+
+// before 同优先级下是否应先于 o 出队 虚拟时间相同时按名称排序保证顺序稳定
+func (q *nsDispatchQueue) before(o *nsDispatchQueue) bool {
+	if q.pass != o.pass {
+		return q.pass < o.pass
+	}
+	return q.name < o.name
+}
+
+func (q *nsDispatchQueue) topPriority() int32 {
+	for p := model.MaxTaskPriority; p >= model.MinTaskPriority; p-- {
+		if len(q.buckets[p]) > 0 {
+			return p
+		}
+	}
+	return -1
+}
+
+// DispatchQueue 待分发任务队列
+//
+// 出队时优先选择优先级最高的任务；同一优先级下各命名空间按权重分享（stride scheduling），
+// 避免单个命名空间的大量任务饿死其它命名空间。同一个任务重复入队会被忽略
+type DispatchQueue struct {
+	mu sync.Mutex
+
+	namespaces map[string]*nsDispatchQueue
+	queued     map[uuid.UUID]struct{}
+	vtime      uint64 // 最近一次出队的虚拟时间
+
+	notifyCh chan struct{}
+}
+
+func NewDispatchQueue() *DispatchQueue {
+	return &DispatchQueue{
+		namespaces: make(map[string]*nsDispatchQueue),
+		queued:     make(map[uuid.UUID]struct{}),
+		notifyCh:   make(chan struct{}, 1),
+	}
+}
+
+// Push 任务入队 weight 为任务所属命名空间的权重 返回是否入队成功
+func (q *DispatchQueue) Push(task *model.Task, weight int32) bool {
+	if weight <= 0 {
+		weight = model.DefaultNamespaceWeight
+	}
+	priority := min(max(task.Priority, model.MinTaskPriority), model.MaxTaskPriority)
+
+	q.mu.Lock()
+	if _, ok := q.queued[task.Id]; ok {
+		q.mu.Unlock()
+		return false
+	}
+
+	nsq := q.namespaces[task.Namespace]
+	if nsq == nil {
+		nsq = &nsDispatchQueue{name: task.Namespace}
+		q.namespaces[task.Namespace] = nsq
+	}
+	if nsq.size == 0 {
+		// 重新变为活跃的命名空间不能补偿空闲期间的份额
+		nsq.pass = max(nsq.pass, q.minActivePassLocked())
+	}
+	nsq.weight = weight
+	nsq.buckets[priority] = append(nsq.buckets[priority], task)
+	nsq.size++
+	q.queued[task.Id] = struct{}{}
+	q.mu.Unlock()
+
+	select {
+	case q.notifyCh <- struct{}{}:
+	default:
+	}
+
+	return true
+}
+
+// Pop 取出下一个应被分发的任务 队列为空时返回 nil
+func (q *DispatchQueue) Pop() *model.Task {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var (
+		picked   *nsDispatchQueue
+		priority int32 = -1
+	)
+	for _, nsq := range q.namespaces {
+		p := nsq.topPriority()
+		if p < 0 {
+			continue
+		}
+		if p > priority || (p == priority && nsq.before(picked)) {
+			picked = nsq
+			priority = p
+		}
+	}
+	if picked == nil {
+		return nil
+	}
+
+	task := picked.buckets[priority][0]
+	picked.buckets[priority][0] = nil
+	picked.buckets[priority] = picked.buckets[priority][1:]
+	picked.size--
+	q.vtime = picked.pass
+	picked.pass += uint64(dispatchStrideBase / picked.weight)
+	delete(q.queued, task.Id)
+
+	return task
+}
+
+// minActivePassLocked 当前有任务的命名空间中最小的虚拟时间
+func (q *DispatchQueue) minActivePassLocked() uint64 {
+	var (
+		pass   uint64
+		active bool
+	)
+	for _, nsq := range q.namespaces {
+		if nsq.size == 0 {
+			continue
+		}
+		if !active || nsq.pass < pass {
+			pass = nsq.pass
+			active = true
+		}
+	}
+
+	if !active {
+		return q.vtime
+	}
+	return pass
+}
+
+// Len 队列中的任务数
+func (q *DispatchQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.queued)
+}
+
+// Notify 有新任务入队时会收到通知
+func (q *DispatchQueue) Notify() <-chan struct{} {
+	return q.notifyCh
+}
@@ -0,0 +1,100 @@
+package biz
+
+import (
+	"testing"
+
+	"github.com/ryanreadbooks/whimer/conductor/internal/biz/model"
+	"github.com/ryanreadbooks/whimer/misc/uuid"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func newTestTask(namespace string, priority int32) *model.Task {
+	return &model.Task{
+		Id:        uuid.NewUUID(),
+		Namespace: namespace,
+		TaskType:  "test_task",
+		Priority:  priority,
+	}
+}
+
+func TestDispatchQueue_Priority(t *testing.T) {
+	Convey("测试高优先级任务先出队", t, func() {
+		q := NewDispatchQueue()
+
+		low := newTestTask("ns", 0)
+		high := newTestTask("ns", 5)
+		q.Push(low, 1)
+		q.Push(high, 1)
+
+		So(q.Pop(), ShouldEqual, high)
+		So(q.Pop(), ShouldEqual, low)
+		So(q.Pop(), ShouldBeNil)
+	})
+
+	Convey("测试其它命名空间的高优先级任务先出队", t, func() {
+		q := NewDispatchQueue()
+
+		for range 10 {
+			q.Push(newTestTask("noisy", 0), 10)
+		}
+		high := newTestTask("quiet", 5)
+		q.Push(high, 1)
+
+		So(q.Pop(), ShouldEqual, high)
+	})
+}
+
+func TestDispatchQueue_FairShare(t *testing.T) {
+	Convey("测试同优先级下按权重分享", t, func() {
+		q := NewDispatchQueue()
+
+		for range 100 {
+			q.Push(newTestTask("a", 0), 3)
+			q.Push(newTestTask("b", 0), 1)
+		}
+
+		counts := make(map[string]int)
+		for range 40 {
+			counts[q.Pop().Namespace]++
+		}
+
+		So(counts["a"], ShouldEqual, 30)
+		So(counts["b"], ShouldEqual, 10)
+	})
+
+	Convey("测试大量任务的命名空间不会饿死其它命名空间", t, func() {
+		q := NewDispatchQueue()
+
+		for range 100 {
+			q.Push(newTestTask("noisy", 0), 1)
+		}
+		for range 3 {
+			q.Pop()
+		}
+
+		// 后加入的命名空间不会因为之前空闲而连续出队
+		q.Push(newTestTask("quiet", 0), 1)
+		q.Push(newTestTask("quiet", 0), 1)
+
+		first, second := q.Pop(), q.Pop()
+		So([]string{first.Namespace, second.Namespace}, ShouldContain, "quiet")
+		So([]string{first.Namespace, second.Namespace}, ShouldContain, "noisy")
+	})
+}
+
+func TestDispatchQueue_Dedup(t *testing.T) {
+	Convey("测试重复入队被忽略", t, func() {
+		q := NewDispatchQueue()
+
+		task := newTestTask("ns", 0)
+		So(q.Push(task, 1), ShouldBeTrue)
+		So(q.Push(task, 1), ShouldBeFalse)
+		So(q.Len(), ShouldEqual, 1)
+
+		Convey("出队后可以再次入队", func() {
+			So(q.Pop(), ShouldEqual, task)
+			So(q.Push(task, 1), ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,105 @@
+package model
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+
+	"github.com/ryanreadbooks/whimer/conductor/internal/infra/dao"
+	"github.com/ryanreadbooks/whimer/misc/uuid"
+)
+
+// 回调请求头 gRPC 回调时作为 metadata 传递（小写）
+const (
+	CallbackHeaderId        = "X-Conductor-Callback-Id"
+	CallbackHeaderAttempt   = "X-Conductor-Attempt"
+	CallbackHeaderTimestamp = "X-Conductor-Timestamp"
+	CallbackHeaderSignature = "X-Conductor-Signature"
+)
+
+// 签名前缀 标明签名算法
+const CallbackSignaturePrefix = "sha256="
+
+type CallbackTargetType string
+
+const (
+	CallbackTargetHttp CallbackTargetType = "http"
+	CallbackTargetGrpc CallbackTargetType = "grpc"
+)
+
+type CallbackState string
+
+const (
+	CallbackStatePending CallbackState = "pending" // 等待投递
+	CallbackStateSuccess CallbackState = "success" // 投递成功
+	CallbackStateFailure CallbackState = "failure" // 投递次数用尽
+)
+
+// CallbackPayload 回调请求体
+type CallbackPayload struct {
+	TaskId      string    `json:"task_id"`
+	Namespace   string    `json:"namespace"`
+	TaskType    string    `json:"task_type"`
+	State       TaskState `json:"state"`
+	OutputArgs  []byte    `json:"output_args,omitempty"`
+	ErrorMsg    string    `json:"error_msg,omitempty"`
+	TraceId     string    `json:"trace_id,omitempty"`
+	CompletedAt int64     `json:"completed_at"`
+}
+
+type TaskCallback struct {
+	Id            uuid.UUID
+	TaskId        uuid.UUID
+	Namespace     string
+	Target        string
+	TargetType    CallbackTargetType
+	Payload       []byte
+	State         CallbackState
+	Attempts      int32
+	MaxAttempts   int32
+	NextRetryTime int64 // 下次投递时间 unix ms
+	LastError     string
+	Ctime         int64
+	Utime         int64
+	Version       int64
+}
+
+func TaskCallbackFromPO(po *dao.CallbackPO) *TaskCallback {
+	if po == nil {
+		return nil
+	}
+
+	return &TaskCallback{
+		Id:            po.Id,
+		TaskId:        po.TaskId,
+		Namespace:     po.Namespace,
+		Target:        po.Target,
+		TargetType:    CallbackTargetType(po.TargetType),
+		Payload:       po.Payload,
+		State:         CallbackState(po.State),
+		Attempts:      po.Attempts,
+		MaxAttempts:   po.MaxAttempts,
+		NextRetryTime: po.NextRetryTime,
+		LastError:     po.LastError,
+		Ctime:         po.Ctime,
+		Utime:         po.Utime,
+		Version:       po.Version,
+	}
+}
+
+// Exhausted 本次投递失败后是否已用尽投递次数
+func (c *TaskCallback) Exhausted() bool {
+	return c.Attempts+1 >= c.MaxAttempts
+}
+
+// SignCallback 计算回调签名
+//
+// 签名内容为 "{timestamp}.{body}" 使用命名空间的回调密钥做 HMAC-SHA256
+func SignCallback(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return CallbackSignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,55 @@
+package model
+
+import (
+	"time"
+
+	"github.com/ryanreadbooks/whimer/misc/uuid"
+)
+
+type TaskEventType string
+
+const (
+	TaskEventState    TaskEventType = "state"    // 状态变更
+	TaskEventProgress TaskEventType = "progress" // worker 上报进度
+)
+
+// TaskEvent 任务事件 经 redis 广播到所有实例
+type TaskEvent struct {
+	Type      TaskEventType `json:"type"`
+	TaskId    uuid.UUID     `json:"task_id"`
+	Namespace string        `json:"namespace"`
+	TaskType  string        `json:"task_type"`
+	State     TaskState     `json:"state"`
+	Progress  int64         `json:"progress,omitempty"`
+	Time      int64         `json:"time"` // unix ms
+}
+
+// NewTaskStateEvent 任务状态变更为 state 的事件
+func NewTaskStateEvent(task *Task, state TaskState) *TaskEvent {
+	return &TaskEvent{
+		Type:      TaskEventState,
+		TaskId:    task.Id,
+		Namespace: task.Namespace,
+		TaskType:  task.TaskType,
+		State:     state,
+		Time:      time.Now().UnixMilli(),
+	}
+}
+
+// NewTaskProgressEvent 任务进度事件
+func NewTaskProgressEvent(task *Task, progress int64) *TaskEvent {
+	return &TaskEvent{
+		Type:      TaskEventProgress,
+		TaskId:    task.Id,
+		Namespace: task.Namespace,
+		TaskType:  task.TaskType,
+		State:     task.State,
+		Progress:  progress,
+		Time:      time.Now().UnixMilli(),
+	}
+}
+
+// IsFinal 任务进入终态 之后不会再有事件
+func (e *TaskEvent) IsFinal() bool {
+	return e.Type == TaskEventState && e.State.IsTerminal()
+}
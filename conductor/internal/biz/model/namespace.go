@@ -6,10 +6,16 @@ import (
 	"github.com/ryanreadbooks/whimer/conductor/internal/infra/dao"
 )
 
+// DefaultNamespaceWeight 未设置权重时的默认分发权重
+const DefaultNamespaceWeight = 1
+
 type Namespace struct {
-	Id    string    `json:"id"`
-	Name  string    `json:"name"`
-	Ctime time.Time `json:"ctime"`
+	Id             string    `json:"id"`
+	Name           string    `json:"name"`
+	Weight         int32     `json:"weight"`          // 分发权重
+	MaxInflight    int64     `json:"max_inflight"`    // 同时在执行的任务数上限 0表示不限制
+	CallbackSecret string    `json:"callback_secret"` // 回调签名密钥
+	Ctime          time.Time `json:"ctime"`
 }
 
 func NamespaceFromPO(po *dao.NamespacePO) *Namespace {
@@ -18,9 +24,22 @@ func NamespaceFromPO(po *dao.NamespacePO) *Namespace {
 	}
 
 	ctime := time.UnixMilli(po.Id.UnixMill())
+	weight := po.Weight
+	if weight <= 0 {
+		weight = DefaultNamespaceWeight
+	}
+
 	return &Namespace{
-		Id:    po.Id.String(),
-		Name:  po.Name,
-		Ctime: ctime,
+		Id:             po.Id.String(),
+		Name:           po.Name,
+		Weight:         weight,
+		MaxInflight:    po.MaxInflight,
+		CallbackSecret: po.CallbackSecret,
+		Ctime:          ctime,
 	}
 }
+
+// InflightExceeded 在执行的任务数是否已达上限
+func (n *Namespace) InflightExceeded(inflight int64) bool {
+	return n.MaxInflight > 0 && inflight >= n.MaxInflight
+}
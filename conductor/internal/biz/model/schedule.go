@@ -0,0 +1,106 @@
+package model
+
+import (
+	"bytes"
+	"text/template"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/ryanreadbooks/whimer/conductor/internal/infra/dao"
+	"github.com/ryanreadbooks/whimer/misc/uuid"
+)
+
+type ScheduleState string
+
+const (
+	ScheduleStateEnabled ScheduleState = "enabled" // 启用
+	ScheduleStatePaused  ScheduleState = "paused"  // 暂停
+)
+
+type Schedule struct {
+	Id           uuid.UUID
+	Namespace    string
+	Name         string
+	TaskType     string
+	CronExpr     string
+	InputArgs    []byte // 输入参数模板
+	CallbackUrl  string
+	MaxRetryCnt  int64 // -1 无限重试, 0 不重试
+	ExpireAfter  int64 // 生成任务的存活时长 ms, 0 表示不过期
+	State        ScheduleState
+	NextFireTime int64 // 下次触发时间 unix ms
+	LastFireTime int64 // 上次触发时间 unix ms
+	Ctime        int64
+	Utime        int64
+	Version      int64
+}
+
+func ScheduleFromPO(po *dao.SchedulePO) *Schedule {
+	return &Schedule{
+		Id:           po.Id,
+		Namespace:    po.Namespace,
+		Name:         po.Name,
+		TaskType:     po.TaskType,
+		CronExpr:     po.CronExpr,
+		InputArgs:    po.InputArgs,
+		CallbackUrl:  po.CallbackUrl,
+		MaxRetryCnt:  po.MaxRetryCnt,
+		ExpireAfter:  po.ExpireAfter,
+		State:        ScheduleState(po.State),
+		NextFireTime: po.NextFireTime,
+		LastFireTime: po.LastFireTime,
+		Ctime:        po.Ctime,
+		Utime:        po.Utime,
+		Version:      po.Version,
+	}
+}
+
+// CalculateScheduleShard 定时任务按 namespace/name 计算分片
+func CalculateScheduleShard(namespace, name string) int {
+	return CalculateShardHash(namespace + "/" + name)
+}
+
+// ParseCronExpr 解析标准5段cron表达式 支持 @every 1m, @daily 等描述符
+func ParseCronExpr(expr string) (cron.Schedule, error) {
+	return cron.ParseStandard(expr)
+}
+
+// NextFireAfter 计算 after 之后的下一次触发时间 unix ms
+func NextFireAfter(expr string, after time.Time) (int64, error) {
+	sched, err := ParseCronExpr(expr)
+	if err != nil {
+		return 0, err
+	}
+
+	return sched.Next(after).UnixMilli(), nil
+}
+
+type scheduleInputData struct {
+	Name     string
+	FireTime int64
+}
+
+// RenderInput 渲染本次触发的输入参数
+//
+// 输入参数中包含模板语法时可引用 {{.Name}} 和 {{.FireTime}}（unix ms）
+func (s *Schedule) RenderInput(fireTime int64) ([]byte, error) {
+	if !bytes.Contains(s.InputArgs, []byte("{{")) {
+		return s.InputArgs, nil
+	}
+
+	tmpl, err := template.New(s.Name).Parse(string(s.InputArgs))
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, scheduleInputData{
+		Name:     s.Name,
+		FireTime: fireTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -11,6 +11,7 @@ import (
 type TaskState string
 
 const (
+	TaskStateBlocked      TaskState = "blocked"       // 等待上游任务完成（工作流）
 	TaskStateInited       TaskState = "inited"        // 已创建（首次）
 	TaskStatePendingRetry TaskState = "pending_retry" // 待重试（失败后等待重新分发）
 	TaskStateDispatched   TaskState = "dispatched"    // 已分发给 Worker
@@ -21,6 +22,11 @@ const (
 	TaskStateExpired      TaskState = "expired"       // 已过期
 )
 
+const (
+	MinTaskPriority int32 = 0
+	MaxTaskPriority int32 = 9
+)
+
 // IsPending 是否处于待分发状态（inited 或 pending_retry）
 func (s TaskState) IsPending() bool {
 	return s == TaskStateInited || s == TaskStatePendingRetry
@@ -36,7 +42,7 @@ func (s TaskState) IsTerminal() bool {
 // 
 // failure: failure, aborted, expired
 // 
-// running: inited, pending_retry, dispatched, running
+// running: blocked, inited, pending_retry, dispatched, running
 func (s TaskState) ExternalState() TaskState {
 	switch s {
 	case TaskStateSuccess:
@@ -48,27 +54,69 @@ func (s TaskState) ExternalState() TaskState {
 	}
 }
 
+// IsValid 是否为已定义的状态
+func (s TaskState) IsValid() bool {
+	switch s {
+	case TaskStateBlocked, TaskStateInited, TaskStatePendingRetry, TaskStateDispatched,
+		TaskStateRunning, TaskStateSuccess, TaskStateFailure, TaskStateAborted, TaskStateExpired:
+		return true
+	}
+	return false
+}
+
+// IsRetryable 是否可以人工重试（failure, expired）
+func (s TaskState) IsRetryable() bool {
+	return s == TaskStateFailure || s == TaskStateExpired
+}
+
+// IsAbortable 是否可以终止（blocked, inited, dispatched, running, pending_retry）
+func (s TaskState) IsAbortable() bool {
+	return s.IsValid() && !s.IsTerminal()
+}
+
 type Task struct {
-	Id          uuid.UUID
-	Namespace   string
-	TaskType    string
-	InputArgs   []byte
-	OutputArgs  []byte
-	CallbackUrl string
-	State       TaskState
-	TraceId     string
-	MaxRetryCnt int64        // -1 无限重试, 0 不重试
-	CurRetryCnt int64        // 当前已重试次数
-	ExpireTime  int64        // 过期时间 unix ms
-	Settings    TaskSettings // 额外设置
-	Ctime       int64
-	Utime       int64
+	Id           uuid.UUID
+	Namespace    string
+	TaskType     string
+	InputArgs    []byte
+	OutputArgs   []byte
+	CallbackUrl  string
+	State        TaskState
+	TraceId      string
+	MaxRetryCnt  int64        // -1 无限重试, 0 不重试
+	CurRetryCnt  int64        // 当前已重试次数
+	ExpireTime   int64        // 过期时间 unix ms
+	NotBefore    int64        // 最早执行时间 unix ms
+	Priority     int32        // 优先级 越大越优先分发
+	WorkflowId   uuid.UUID    // 所属工作流id
+	WorkflowNode string       // 在工作流中的节点名
+	WorkerId     string       // 最近一次分发到的 worker
+	LeaseExpire  int64        // 执行租约到期时间 unix ms 0 表示无租约
+	Deadline     int64        // 执行截止时间 unix ms 0 表示不限制
+	Settings     TaskSettings // 额外设置
+	Ctime        int64
+	Utime        int64
 }
 
 // TaskSettings 任务额外设置（可选）
 type TaskSettings struct {
 	// 可扩展的额外配置
 	Extra map[string]any `json:"extra,omitempty"`
+
+	// 上游节点 节点名 -> 任务id
+	Parents map[string]string `json:"parents,omitempty"`
+
+	// 上游节点的输出参数 放行时写入
+	ParentOutputs map[string][]byte `json:"parent_outputs,omitempty"`
+
+	// 放行后的过期时长 ms
+	ExpireAfter int64 `json:"expire_after,omitempty"`
+
+	// gRPC 回调目标
+	CallbackGrpcTarget string `json:"callback_grpc_target,omitempty"`
+
+	// worker 标签选择器 只分发给标签全部匹配的 worker
+	WorkerSelector map[string]string `json:"worker_selector,omitempty"`
 }
 
 func TaskFromPO(po *dao.TaskPO) *Task {
@@ -81,20 +129,27 @@ func TaskFromPO(po *dao.TaskPO) *Task {
 	}
 
 	return &Task{
-		Id:          po.Id,
-		Namespace:   po.Namespace,
-		TaskType:    po.TaskType,
-		InputArgs:   po.InputArgs,
-		OutputArgs:  po.OutputArgs,
-		CallbackUrl: po.CallbackUrl,
-		State:       TaskState(po.State),
-		TraceId:     po.TraceId,
-		MaxRetryCnt: po.MaxRetryCnt,
-		CurRetryCnt: po.CurRetryCnt,
-		ExpireTime:  po.ExpireTime,
-		Settings:    settings,
-		Ctime:       po.Ctime,
-		Utime:       po.Utime,
+		Id:           po.Id,
+		Namespace:    po.Namespace,
+		TaskType:     po.TaskType,
+		InputArgs:    po.InputArgs,
+		OutputArgs:   po.OutputArgs,
+		CallbackUrl:  po.CallbackUrl,
+		State:        TaskState(po.State),
+		TraceId:      po.TraceId,
+		MaxRetryCnt:  po.MaxRetryCnt,
+		CurRetryCnt:  po.CurRetryCnt,
+		ExpireTime:   po.ExpireTime,
+		NotBefore:    po.NotBefore,
+		Priority:     po.Priority,
+		WorkflowId:   po.WorkflowId,
+		WorkflowNode: po.WorkflowNode,
+		WorkerId:     po.WorkerId,
+		LeaseExpire:  po.LeaseExpire,
+		Deadline:     po.Deadline,
+		Settings:     settings,
+		Ctime:        po.Ctime,
+		Utime:        po.Utime,
 	}
 }
 
@@ -103,6 +158,39 @@ func (t *Task) IsExpired(now int64) bool {
 	return t.ExpireTime > 0 && now > t.ExpireTime
 }
 
+// IsLeaseExpired 执行租约是否已过期 即 worker 超过租约时长未上报心跳
+func (t *Task) IsLeaseExpired(now int64) bool {
+	return t.LeaseExpire > 0 && now > t.LeaseExpire
+}
+
+// IsExecutionTimeout 是否已超出执行截止时间
+func (t *Task) IsExecutionTimeout(now int64) bool {
+	return t.Deadline > 0 && now > t.Deadline
+}
+
+// IsHeldBy 任务是否仍由 worker 持有（已分发或执行中且分配给该 worker）
+//
+// 任务被取消 过期或租约过期被回收后不再由原 worker 持有；workerId 为空时不校验 worker
+func (t *Task) IsHeldBy(workerId string) bool {
+	if t.State != TaskStateDispatched && t.State != TaskStateRunning {
+		return false
+	}
+	return workerId == "" || t.WorkerId == workerId
+}
+
+// CallbackTarget 回调目标 未设置回调时 target 为空
+func (t *Task) CallbackTarget() (string, CallbackTargetType) {
+	if t.Settings.CallbackGrpcTarget != "" {
+		return t.Settings.CallbackGrpcTarget, CallbackTargetGrpc
+	}
+	return t.CallbackUrl, CallbackTargetHttp
+}
+
+// InWorkflow 是否属于工作流
+func (t *Task) InWorkflow() bool {
+	return !t.WorkflowId.IsZero()
+}
+
 // CanRetry 检查任务是否可以重试
 func (t *Task) CanRetry() bool {
 	if t.MaxRetryCnt < 0 {
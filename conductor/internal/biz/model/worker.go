@@ -0,0 +1,85 @@
+package model
+
+import (
+	"encoding/json"
+
+	"github.com/ryanreadbooks/whimer/conductor/internal/infra/dao"
+	"github.com/ryanreadbooks/whimer/misc/uuid"
+	"github.com/ryanreadbooks/whimer/misc/xlog"
+)
+
+// Worker 已注册的 worker
+type Worker struct {
+	Id            string
+	Ip            string
+	TaskTypes     []string
+	Labels        map[string]string
+	Concurrency   int32 // worker 声明的并发度 仅用于展示
+	RegisterTime  int64 // unix ms
+	LastHeartbeat int64 // unix ms
+
+	RunningTaskIds []uuid.UUID // 当前分配给该 worker 的任务
+}
+
+func WorkerFromPO(po *dao.WorkerPO) *Worker {
+	w := &Worker{
+		Id:            po.Id,
+		Ip:            po.Ip,
+		Concurrency:   po.Concurrency,
+		RegisterTime:  po.RegisterTime,
+		LastHeartbeat: po.LastHeartbeat,
+	}
+	if len(po.TaskTypes) > 0 {
+		if err := json.Unmarshal(po.TaskTypes, &w.TaskTypes); err != nil {
+			xlog.Msgf("failed to unmarshal worker task types: %v", err).Err(err).Error()
+		}
+	}
+	if len(po.Labels) > 0 {
+		if err := json.Unmarshal(po.Labels, &w.Labels); err != nil {
+			xlog.Msgf("failed to unmarshal worker labels: %v", err).Err(err).Error()
+		}
+	}
+
+	return w
+}
+
+func (w *Worker) ToPO() *dao.WorkerPO {
+	po := &dao.WorkerPO{
+		Id:            w.Id,
+		Ip:            w.Ip,
+		Concurrency:   w.Concurrency,
+		RegisterTime:  w.RegisterTime,
+		LastHeartbeat: w.LastHeartbeat,
+	}
+	po.TaskTypes, _ = json.Marshal(w.TaskTypes)
+	po.Labels, _ = json.Marshal(w.Labels)
+
+	return po
+}
+
+// IsAlive 最近心跳时间在 timeout 内视为在线
+func (w *Worker) IsAlive(now, timeout int64) bool {
+	return now-w.LastHeartbeat <= timeout
+}
+
+// HandlesTaskType worker 是否处理该任务类型
+func (w *Worker) HandlesTaskType(taskType string) bool {
+	for _, t := range w.TaskTypes {
+		if t == taskType {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchLabels 标签是否满足选择器 选择器中的每一对都必须相等
+//
+// 空选择器匹配任意标签
+func MatchLabels(selector, labels map[string]string) bool {
+	for k, v := range selector {
+		if lv, ok := labels[k]; !ok || lv != v {
+			return false
+		}
+	}
+	return true
+}
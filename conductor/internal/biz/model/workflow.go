@@ -0,0 +1,107 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	errWorkflowEmpty = errors.New("workflow has no nodes")
+	errWorkflowCycle = errors.New("workflow has cycle")
+)
+
+// WorkflowNode 工作流节点定义
+type WorkflowNode struct {
+	Name               string
+	TaskType           string
+	InputArgs          []byte
+	CallbackUrl        string
+	CallbackGrpcTarget string            // gRPC 回调目标 与 CallbackUrl 二选一
+	MaxRetryCnt        int64             // -1 无限重试, 0 不重试
+	ExpireAfter        int64             // 放行后的过期时长 ms, 0 表示不过期
+	DependsOn          []string          // 上游节点名
+	WorkerSelector     map[string]string // worker 标签选择器
+}
+
+// SortWorkflowNodes 校验工作流定义并按拓扑序返回节点
+//
+// 节点名必须唯一，依赖的节点必须存在，且不能有环
+func SortWorkflowNodes(nodes []*WorkflowNode) ([]*WorkflowNode, error) {
+	if len(nodes) == 0 {
+		return nil, errWorkflowEmpty
+	}
+
+	byName := make(map[string]*WorkflowNode, len(nodes))
+	for _, node := range nodes {
+		if node.Name == "" {
+			return nil, errors.New("workflow node name is empty")
+		}
+		if _, ok := byName[node.Name]; ok {
+			return nil, fmt.Errorf("workflow node %s is duplicated", node.Name)
+		}
+		byName[node.Name] = node
+	}
+
+	inDegree := make(map[string]int, len(nodes))
+	children := make(map[string][]string, len(nodes))
+	for _, node := range nodes {
+		seen := make(map[string]struct{}, len(node.DependsOn))
+		for _, parent := range node.DependsOn {
+			if _, ok := byName[parent]; !ok {
+				return nil, fmt.Errorf("workflow node %s depends on unknown node %s", node.Name, parent)
+			}
+			if _, ok := seen[parent]; ok {
+				continue
+			}
+			seen[parent] = struct{}{}
+			inDegree[node.Name]++
+			children[parent] = append(children[parent], node.Name)
+		}
+	}
+
+	// 按提交顺序做 Kahn 排序 保证结果稳定
+	sorted := make([]*WorkflowNode, 0, len(nodes))
+	queue := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		if inDegree[node.Name] == 0 {
+			queue = append(queue, node.Name)
+		}
+	}
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		sorted = append(sorted, byName[name])
+		for _, child := range children[name] {
+			inDegree[child]--
+			if inDegree[child] == 0 {
+				queue = append(queue, child)
+			}
+		}
+	}
+
+	if len(sorted) != len(nodes) {
+		return nil, errWorkflowCycle
+	}
+
+	return sorted, nil
+}
+
+// WorkflowState 根据工作流内所有任务的状态计算工作流状态
+//
+// 任一任务失败则为 failure，全部成功则为 success，否则为 running
+func WorkflowState(tasks []*Task) TaskState {
+	allSuccess := true
+	for _, task := range tasks {
+		switch task.State.ExternalState() {
+		case TaskStateFailure:
+			return TaskStateFailure
+		case TaskStateRunning:
+			allSuccess = false
+		}
+	}
+
+	if allSuccess {
+		return TaskStateSuccess
+	}
+	return TaskStateRunning
+}
@@ -2,6 +2,8 @@ package biz
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 
 	"github.com/ryanreadbooks/whimer/conductor/internal/biz/model"
 	"github.com/ryanreadbooks/whimer/conductor/internal/global"
@@ -12,22 +14,40 @@ import (
 )
 
 type NamespaceBiz struct {
-	namespaceDao *dao.NamespaceDao
+	namespaceDao dao.NamespaceStore
 }
 
-func NewNamespaceBiz(namespaceDao *dao.NamespaceDao) *NamespaceBiz {
+func NewNamespaceBiz(namespaceDao dao.NamespaceStore) *NamespaceBiz {
 	return &NamespaceBiz{
 		namespaceDao: namespaceDao,
 	}
 }
 
-func (b *NamespaceBiz) Create(ctx context.Context, name string) (*model.Namespace, error) {
+// Create 创建命名空间 weight 为0时使用默认权重
+func (b *NamespaceBiz) Create(
+	ctx context.Context,
+	name string,
+	weight int32,
+	maxInflight int64,
+) (*model.Namespace, error) {
+	if weight <= 0 {
+		weight = model.DefaultNamespaceWeight
+	}
+
+	secret, err := newCallbackSecret()
+	if err != nil {
+		return nil, xerror.Wrapf(err, "namespace biz generate callback secret failed").WithCtx(ctx)
+	}
+
 	po := &dao.NamespacePO{
-		Id:   uuid.NewUUID(),
-		Name: name,
+		Id:             uuid.NewUUID(),
+		Name:           name,
+		Weight:         weight,
+		MaxInflight:    maxInflight,
+		CallbackSecret: secret,
 	}
 
-	err := b.namespaceDao.Insert(ctx, po)
+	err = b.namespaceDao.Insert(ctx, po)
 	if err != nil {
 		if xsql.IsDuplicate(err) {
 			return nil, global.ErrNamespaceAlreadyExists
@@ -52,6 +72,70 @@ func (b *NamespaceBiz) Get(ctx context.Context, name string) (*model.Namespace,
 	return model.NamespaceFromPO(po), nil
 }
 
+// UpdateQuota 更新命名空间的分发权重和配额
+func (b *NamespaceBiz) UpdateQuota(
+	ctx context.Context,
+	name string,
+	weight int32,
+	maxInflight int64,
+) (*model.Namespace, error) {
+	po, err := b.namespaceDao.GetByName(ctx, name)
+	if err != nil {
+		if xsql.IsNoRecord(err) {
+			return nil, global.ErrNamespaceNotFound
+		}
+
+		return nil, xerror.Wrapf(err, "namespace dao get by name failed").WithExtra("name", name).WithCtx(ctx)
+	}
+
+	po.Weight = weight
+	po.MaxInflight = maxInflight
+	err = b.namespaceDao.UpdateQuota(ctx, po)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "namespace dao update quota failed").WithExtra("name", name).WithCtx(ctx)
+	}
+
+	return model.NamespaceFromPO(po), nil
+}
+
+// ResetCallbackSecret 重新生成命名空间的回调签名密钥
+func (b *NamespaceBiz) ResetCallbackSecret(ctx context.Context, name string) (*model.Namespace, error) {
+	po, err := b.namespaceDao.GetByName(ctx, name)
+	if err != nil {
+		if xsql.IsNoRecord(err) {
+			return nil, global.ErrNamespaceNotFound
+		}
+
+		return nil, xerror.Wrapf(err, "namespace dao get by name failed").WithExtra("name", name).WithCtx(ctx)
+	}
+
+	po.CallbackSecret, err = newCallbackSecret()
+	if err != nil {
+		return nil, xerror.Wrapf(err, "namespace biz generate callback secret failed").WithCtx(ctx)
+	}
+
+	err = b.namespaceDao.UpdateCallbackSecret(ctx, po)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "namespace dao update callback secret failed").WithExtra("name", name).WithCtx(ctx)
+	}
+
+	return model.NamespaceFromPO(po), nil
+}
+
+// ListAll 获取所有命名空间
+func (b *NamespaceBiz) ListAll(ctx context.Context) ([]*model.Namespace, error) {
+	pos, err := b.namespaceDao.ListAll(ctx)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "namespace dao list all failed").WithCtx(ctx)
+	}
+
+	namespaces := make([]*model.Namespace, 0, len(pos))
+	for _, po := range pos {
+		namespaces = append(namespaces, model.NamespaceFromPO(po))
+	}
+	return namespaces, nil
+}
+
 type ListNamespaceResult struct {
 	Namespaces []*model.Namespace
 	Total      int64
@@ -80,3 +164,12 @@ func (b *NamespaceBiz) List(ctx context.Context, page, count int) (*ListNamespac
 		Total:      total,
 	}, nil
 }
+
+// newCallbackSecret 生成 32 字节随机回调密钥
+func newCallbackSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,309 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/conductor/internal/biz/model"
+	"github.com/ryanreadbooks/whimer/conductor/internal/global"
+	"github.com/ryanreadbooks/whimer/conductor/internal/infra/dao"
+	"github.com/ryanreadbooks/whimer/misc/uuid"
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+)
+
+type ScheduleBiz struct {
+	scheduleDao dao.ScheduleStore
+	taskBiz     *TaskBiz
+}
+
+func NewScheduleBiz(
+	scheduleDao dao.ScheduleStore,
+	taskBiz *TaskBiz,
+) *ScheduleBiz {
+	return &ScheduleBiz{
+		scheduleDao: scheduleDao,
+		taskBiz:     taskBiz,
+	}
+}
+
+type UpsertScheduleRequest struct {
+	Namespace   string
+	Name        string
+	TaskType    string
+	CronExpr    string
+	InputArgs   []byte
+	CallbackUrl string
+	MaxRetryCnt int64 // -1 无限重试, 0 不重试
+	ExpireAfter int64 // 生成任务的存活时长 ms, 0 表示不过期
+}
+
+// Upsert 创建或更新定时任务
+//
+// 更新时会按新的cron表达式重新计算下次触发时间，但不改变启用/暂停状态
+func (b *ScheduleBiz) Upsert(ctx context.Context, req *UpsertScheduleRequest) (*model.Schedule, error) {
+	now := time.Now()
+	nextFireTime, err := model.NextFireAfter(req.CronExpr, now)
+	if err != nil {
+		return nil, global.ErrInvalidCronExpr
+	}
+
+	// 提前校验输入参数模板 避免触发时才失败
+	tmpl := &model.Schedule{Name: req.Name, InputArgs: req.InputArgs}
+	if _, err := tmpl.RenderInput(nextFireTime); err != nil {
+		return nil, global.ErrArgs.Msg("输入参数模板不合法")
+	}
+
+	po, err := b.scheduleDao.GetByName(ctx, req.Namespace, req.Name)
+	if err != nil && !xsql.IsNoRecord(err) {
+		return nil, xerror.Wrapf(err, "schedule biz get by name failed").
+			WithExtra("namespace", req.Namespace).
+			WithExtra("name", req.Name).
+			WithCtx(ctx)
+	}
+
+	if po == nil {
+		po = &dao.SchedulePO{
+			Id:           uuid.NewUUID(),
+			Namespace:    req.Namespace,
+			Name:         req.Name,
+			Shard:        model.CalculateScheduleShard(req.Namespace, req.Name),
+			TaskType:     req.TaskType,
+			CronExpr:     req.CronExpr,
+			InputArgs:    req.InputArgs,
+			CallbackUrl:  req.CallbackUrl,
+			MaxRetryCnt:  req.MaxRetryCnt,
+			ExpireAfter:  req.ExpireAfter,
+			State:        string(model.ScheduleStateEnabled),
+			NextFireTime: nextFireTime,
+			Ctime:        now.UnixMilli(),
+			Utime:        now.UnixMilli(),
+			Version:      1,
+		}
+		err = b.scheduleDao.Insert(ctx, po)
+		if err != nil {
+			return nil, xerror.Wrapf(err, "schedule biz insert failed").
+				WithExtra("namespace", req.Namespace).
+				WithExtra("name", req.Name).
+				WithCtx(ctx)
+		}
+
+		return model.ScheduleFromPO(po), nil
+	}
+
+	po.TaskType = req.TaskType
+	po.CronExpr = req.CronExpr
+	po.InputArgs = req.InputArgs
+	po.CallbackUrl = req.CallbackUrl
+	po.MaxRetryCnt = req.MaxRetryCnt
+	po.ExpireAfter = req.ExpireAfter
+	po.NextFireTime = nextFireTime
+	po.Utime = now.UnixMilli()
+	err = b.scheduleDao.UpdateSpec(ctx, po)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "schedule biz update spec failed").
+			WithExtra("namespace", req.Namespace).
+			WithExtra("name", req.Name).
+			WithCtx(ctx)
+	}
+	po.Version++
+
+	return model.ScheduleFromPO(po), nil
+}
+
+// Get 获取定时任务
+func (b *ScheduleBiz) Get(ctx context.Context, namespace, name string) (*model.Schedule, error) {
+	po, err := b.scheduleDao.GetByName(ctx, namespace, name)
+	if err != nil {
+		if xsql.IsNoRecord(err) {
+			return nil, global.ErrScheduleNotFound
+		}
+
+		return nil, xerror.Wrapf(err, "schedule biz get by name failed").
+			WithExtra("namespace", namespace).
+			WithExtra("name", name).
+			WithCtx(ctx)
+	}
+
+	return model.ScheduleFromPO(po), nil
+}
+
+type ListScheduleResult struct {
+	Schedules []*model.Schedule
+	Total     int64
+}
+
+// List 分页获取命名空间下的定时任务
+func (b *ScheduleBiz) List(ctx context.Context, namespace string, page, count int) (*ListScheduleResult, error) {
+	offset := (page - 1) * count
+
+	pos, err := b.scheduleDao.ListByNamespace(ctx, namespace, offset, count)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "schedule dao list failed").WithExtra("namespace", namespace).WithCtx(ctx)
+	}
+
+	total, err := b.scheduleDao.CountByNamespace(ctx, namespace)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "schedule dao count failed").WithExtra("namespace", namespace).WithCtx(ctx)
+	}
+
+	schedules := make([]*model.Schedule, 0, len(pos))
+	for _, po := range pos {
+		schedules = append(schedules, model.ScheduleFromPO(po))
+	}
+
+	return &ListScheduleResult{
+		Schedules: schedules,
+		Total:     total,
+	}, nil
+}
+
+// Pause 暂停定时任务
+func (b *ScheduleBiz) Pause(ctx context.Context, namespace, name string) error {
+	schedule, err := b.Get(ctx, namespace, name)
+	if err != nil {
+		return err
+	}
+
+	if schedule.State == model.ScheduleStatePaused {
+		return nil
+	}
+
+	err = b.scheduleDao.UpdateState(ctx, schedule.Id,
+		string(model.ScheduleStatePaused),
+		schedule.NextFireTime,
+		time.Now().UnixMilli())
+	if err != nil {
+		return xerror.Wrapf(err, "schedule biz pause failed").
+			WithExtra("namespace", namespace).
+			WithExtra("name", name).
+			WithCtx(ctx)
+	}
+
+	return nil
+}
+
+// Resume 恢复定时任务
+//
+// 暂停期间错过的触发不会补发，从当前时间重新计算下次触发时间
+func (b *ScheduleBiz) Resume(ctx context.Context, namespace, name string) error {
+	schedule, err := b.Get(ctx, namespace, name)
+	if err != nil {
+		return err
+	}
+
+	if schedule.State == model.ScheduleStateEnabled {
+		return nil
+	}
+
+	now := time.Now()
+	nextFireTime, err := model.NextFireAfter(schedule.CronExpr, now)
+	if err != nil {
+		return global.ErrInvalidCronExpr
+	}
+
+	err = b.scheduleDao.UpdateState(ctx, schedule.Id,
+		string(model.ScheduleStateEnabled),
+		nextFireTime,
+		now.UnixMilli())
+	if err != nil {
+		return xerror.Wrapf(err, "schedule biz resume failed").
+			WithExtra("namespace", namespace).
+			WithExtra("name", name).
+			WithCtx(ctx)
+	}
+
+	return nil
+}
+
+// Delete 删除定时任务 已生成的任务不受影响
+func (b *ScheduleBiz) Delete(ctx context.Context, namespace, name string) error {
+	schedule, err := b.Get(ctx, namespace, name)
+	if err != nil {
+		return err
+	}
+
+	err = b.scheduleDao.DeleteById(ctx, schedule.Id)
+	if err != nil {
+		return xerror.Wrapf(err, "schedule biz delete failed").
+			WithExtra("namespace", namespace).
+			WithExtra("name", name).
+			WithCtx(ctx)
+	}
+
+	return nil
+}
+
+// GetDueSchedules 获取已到触发时间的定时任务
+func (b *ScheduleBiz) GetDueSchedules(
+	ctx context.Context,
+	shardStart, shardEnd int, // [shardStart, shardEnd)
+	limit int32,
+) ([]*model.Schedule, error) {
+	pos, err := b.scheduleDao.ListDue(ctx,
+		string(model.ScheduleStateEnabled),
+		shardStart, shardEnd,
+		time.Now().UnixMilli(),
+		limit)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "schedule biz get due schedules failed").WithCtx(ctx)
+	}
+
+	schedules := make([]*model.Schedule, 0, len(pos))
+	for _, po := range pos {
+		schedules = append(schedules, model.ScheduleFromPO(po))
+	}
+	return schedules, nil
+}
+
+// Fire 触发一次定时任务 生成一个普通任务
+//
+// 需要在事务中调用；通过 version 乐观锁保证同一次触发只会生成一个任务，
+// 停机期间错过的多次触发只补发一次。返回 nil 表示本次触发已被处理。
+func (b *ScheduleBiz) Fire(ctx context.Context, schedule *model.Schedule) (*model.Task, error) {
+	now := time.Now()
+	nextFireTime, err := model.NextFireAfter(schedule.CronExpr, now)
+	if err != nil {
+		return nil, xerror.Wrapf(global.ErrInvalidCronExpr, "schedule biz fire failed").
+			WithExtra("scheduleId", schedule.Id.String()).
+			WithCtx(ctx)
+	}
+
+	fireTime := schedule.NextFireTime
+	ok, err := b.scheduleDao.UpdateFired(ctx, schedule.Id, schedule.Version,
+		fireTime, nextFireTime, now.UnixMilli())
+	if err != nil {
+		return nil, xerror.Wrapf(err, "schedule biz update fired failed").
+			WithExtra("scheduleId", schedule.Id.String()).
+			WithCtx(ctx)
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	inputArgs, err := schedule.RenderInput(fireTime)
+	if err != nil {
+		return nil, xerror.Wrapf(xerror.ErrArgs.Msg(err.Error()), "schedule biz render input failed").
+			WithExtra("scheduleId", schedule.Id.String()).
+			WithCtx(ctx)
+	}
+
+	var expireTime int64
+	if schedule.ExpireAfter > 0 {
+		expireTime = now.UnixMilli() + schedule.ExpireAfter
+	}
+
+	resp, err := b.taskBiz.RegisterTask(ctx, &RegisterTaskRequest{
+		TaskType:    schedule.TaskType,
+		Namespace:   schedule.Namespace,
+		InputArgs:   inputArgs,
+		CallbackUrl: schedule.CallbackUrl,
+		MaxRetryCnt: schedule.MaxRetryCnt,
+		ExpireTime:  expireTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Task, nil
+}
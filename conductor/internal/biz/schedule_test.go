@@ -0,0 +1,160 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/conductor/internal/biz/model"
+	"github.com/ryanreadbooks/whimer/conductor/internal/biz/shard"
+	"github.com/ryanreadbooks/whimer/conductor/internal/config"
+	"github.com/ryanreadbooks/whimer/conductor/internal/infra/dao/memory"
+	"github.com/ryanreadbooks/whimer/misc/uuid"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// newTestBiz 使用内存存储创建 Biz
+func newTestBiz() *Biz {
+	return NewBizWithDeps(context.Background(), &config.Config{}, Deps{
+		Dao:   memory.NewDao(),
+		Shard: shard.NewStandalone(),
+	})
+}
+
+func getPendingTaskIds(b *Biz, namespace string, shardStart, shardEnd int) map[uuid.UUID]bool {
+	tasks, err := b.TaskBiz.GetPendingTasks(context.Background(), namespace, model.TaskStateInited,
+		shardStart, shardEnd,
+		model.MaxTaskPriority+1, uuid.EmptyUUID(),
+		100)
+	So(err, ShouldBeNil)
+
+	ids := make(map[uuid.UUID]bool, len(tasks))
+	for _, task := range tasks {
+		ids[task.Id] = true
+	}
+	return ids
+}
+
+func TestPendingTasksNotBefore(t *testing.T) {
+	Convey("测试未到最早执行时间的任务不分发", t, func() {
+		var (
+			b   = newTestBiz()
+			ctx = context.Background()
+			now = time.Now().UnixMilli()
+		)
+
+		cases := []struct {
+			name      string
+			notBefore int64
+			pending   bool
+		}{
+			{name: "未设置", notBefore: 0, pending: true},
+			{name: "已到时间", notBefore: now - 1000, pending: true},
+			{name: "未到时间", notBefore: now + time.Minute.Milliseconds(), pending: false},
+		}
+
+		taskIds := make([]uuid.UUID, len(cases))
+		for i, c := range cases {
+			resp, err := b.TaskBiz.RegisterTask(ctx, &RegisterTaskRequest{
+				TaskType:  "delay",
+				Namespace: "test",
+				NotBefore: c.notBefore,
+			})
+			So(err, ShouldBeNil)
+			So(resp.Task.State, ShouldEqual, model.TaskStateInited)
+			taskIds[i] = resp.Task.Id
+		}
+
+		pending := getPendingTaskIds(b, "test", 0, model.SizeOfShard)
+		for i, c := range cases {
+			Convey(c.name, func() {
+				So(pending[taskIds[i]], ShouldEqual, c.pending)
+			})
+		}
+	})
+}
+
+func TestScheduleShardOwnership(t *testing.T) {
+	Convey("测试定时任务由持有分片的实例触发且只触发一次", t, func() {
+		var (
+			b   = newTestBiz()
+			ctx = context.Background()
+		)
+
+		// 模拟 4 个实例各持有一段分片
+		ranges := []shard.Range{
+			{ShardId: 0, Start: 0, End: 256},
+			{ShardId: 1, Start: 256, End: 512},
+			{ShardId: 2, Start: 512, End: 768},
+			{ShardId: 3, Start: 768, End: model.SizeOfShard},
+		}
+
+		names := []string{"daily-report", "hourly-sync", "clean-expired", "rebuild-index", "summary"}
+		past := time.Now().Add(-time.Minute).UnixMilli()
+		for _, name := range names {
+			schedule, err := b.ScheduleBiz.Upsert(ctx, &UpsertScheduleRequest{
+				Namespace: "test",
+				Name:      name,
+				TaskType:  "cron",
+				CronExpr:  "@every 1h",
+			})
+			So(err, ShouldBeNil)
+
+			// 调整为已到触发时间
+			err = b.dao.ScheduleDao.UpdateState(ctx, schedule.Id,
+				string(model.ScheduleStateEnabled), past, time.Now().UnixMilli())
+			So(err, ShouldBeNil)
+		}
+
+		Convey("每个定时任务只属于一个分片范围", func() {
+			owners := make(map[string]int, len(names))
+			for _, r := range ranges {
+				schedules, err := b.ScheduleBiz.GetDueSchedules(ctx, r.Start, r.End, 100)
+				So(err, ShouldBeNil)
+				for _, schedule := range schedules {
+					shardVal := model.CalculateScheduleShard(schedule.Namespace, schedule.Name)
+					So(shardVal, ShouldBeBetweenOrEqual, r.Start, r.End-1)
+					owners[schedule.Name]++
+				}
+			}
+
+			So(owners, ShouldHaveLength, len(names))
+			for _, name := range names {
+				So(owners[name], ShouldEqual, 1)
+			}
+		})
+
+		Convey("同一次触发只生成一个任务", func() {
+			schedules, err := b.ScheduleBiz.GetDueSchedules(ctx, 0, model.SizeOfShard, 100)
+			So(err, ShouldBeNil)
+			So(schedules, ShouldHaveLength, len(names))
+
+			for _, schedule := range schedules {
+				fire := func() *model.Task {
+					var task *model.Task
+					err := b.Tx(ctx, func(ctx context.Context) error {
+						var txErr error
+						task, txErr = b.ScheduleBiz.Fire(ctx, schedule)
+						return txErr
+					})
+					So(err, ShouldBeNil)
+					return task
+				}
+
+				task := fire()
+				So(task, ShouldNotBeNil)
+				So(task.Namespace, ShouldEqual, "test")
+				So(task.TaskType, ShouldEqual, "cron")
+
+				// 持有旧版本的实例再次触发
+				So(fire(), ShouldBeNil)
+			}
+
+			// 下次触发时间已推进
+			schedules, err = b.ScheduleBiz.GetDueSchedules(ctx, 0, model.SizeOfShard, 100)
+			So(err, ShouldBeNil)
+			So(schedules, ShouldBeEmpty)
+		})
+	})
+}
@@ -0,0 +1,48 @@
+package shard
+
+import "context"
+
+// Sharder 分片持有者 决定当前实例负责扫描哪些分片
+type Sharder interface {
+	Run(ctx context.Context)
+	Stop()
+
+	// GetShardRange 获取当前持有的分片范围
+	GetShardRange() Range
+	// HasShard 判断是否持有分片
+	HasShard() bool
+	// InRange 判断值是否在当前分片范围内
+	InRange(val int) bool
+}
+
+var (
+	_ Sharder = (*Biz)(nil)
+	_ Sharder = (*Standalone)(nil)
+)
+
+// Standalone 单实例部署时使用 始终持有全部分片 不依赖 etcd
+type Standalone struct {
+	shardRange Range
+}
+
+func NewStandalone() *Standalone {
+	return &Standalone{
+		shardRange: Range{ShardId: 0, Start: 0, End: sizeOfShard},
+	}
+}
+
+func (s *Standalone) Run(ctx context.Context) {}
+
+func (s *Standalone) Stop() {}
+
+func (s *Standalone) GetShardRange() Range {
+	return s.shardRange
+}
+
+func (s *Standalone) HasShard() bool {
+	return true
+}
+
+func (s *Standalone) InRange(val int) bool {
+	return val >= s.shardRange.Start && val < s.shardRange.End
+}
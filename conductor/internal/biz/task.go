@@ -6,25 +6,40 @@ import (
 	"time"
 
 	"github.com/ryanreadbooks/whimer/conductor/internal/biz/model"
+	"github.com/ryanreadbooks/whimer/conductor/internal/config"
+	"github.com/ryanreadbooks/whimer/conductor/internal/global"
 	"github.com/ryanreadbooks/whimer/conductor/internal/infra/dao"
 	"github.com/ryanreadbooks/whimer/misc/uuid"
 	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/propagation"
 )
 
 type TaskBiz struct {
-	taskDao        *dao.TaskDao
-	taskHistoryDao *dao.TaskHistoryDao
+	taskDao        dao.TaskStore
+	taskHistoryDao dao.TaskHistoryStore
+	idempotencyDao dao.IdempotencyStore
+
+	// 幂等键去重窗口
+	idempotencyWindow time.Duration
+
+	// 租约时长和执行超时时间
+	taskConf *config.TaskConfig
 }
 
 func NewTaskBiz(
-	taskDao *dao.TaskDao,
-	taskHistoryDao *dao.TaskHistoryDao,
+	c *config.Config,
+	taskDao dao.TaskStore,
+	taskHistoryDao dao.TaskHistoryStore,
+	idempotencyDao dao.IdempotencyStore,
 ) *TaskBiz {
 	return &TaskBiz{
-		taskDao:        taskDao,
-		taskHistoryDao: taskHistoryDao,
+		taskDao:           taskDao,
+		taskHistoryDao:    taskHistoryDao,
+		idempotencyDao:    idempotencyDao,
+		idempotencyWindow: c.TaskConfig.GetIdempotencyWindow(),
+		taskConf:          &c.TaskConfig,
 	}
 }
 
@@ -35,10 +50,30 @@ type RegisterTaskRequest struct {
 	CallbackUrl string `json:"callback_url"`
 	MaxRetryCnt int64  `json:"max_retry_cnt"` // -1 无限重试, 0 不重试
 	ExpireTime  int64  `json:"expire_time"`   // 过期时间 unix ms
+	NotBefore   int64  `json:"not_before"`    // 最早执行时间 unix ms
+	Priority    int32  `json:"priority"`      // 优先级 越大越优先分发
+
+	// gRPC 回调目标 与 CallbackUrl 二选一
+	CallbackGrpcTarget string `json:"callback_grpc_target"`
+
+	// worker 标签选择器 为空则可分发给任意 worker
+	WorkerSelector map[string]string `json:"worker_selector"`
+
+	// 工作流相关 不属于工作流时为空
+	WorkflowId   uuid.UUID         `json:"workflow_id"`
+	WorkflowNode string            `json:"workflow_node"`
+	Parents      map[string]string `json:"parents"`      // 上游节点 节点名 -> 任务id
+	ExpireAfter  int64             `json:"expire_after"` // 放行后的过期时长 ms
+
+	// 幂等键 为空则不去重
+	IdempotencyKey string `json:"idempotency_key"`
 }
 
 type RegisterTaskResponse struct {
 	Task *model.Task
+
+	// 命中幂等键 Task 为已有任务
+	Duplicated bool
 }
 
 // RegisterTask 创建任务
@@ -54,24 +89,55 @@ func (b *TaskBiz) RegisterTask(
 	traceId := carrier.Get("traceparent")
 	now := time.Now().UnixMilli()
 
-	settings := model.TaskSettings{}
+	settings := model.TaskSettings{
+		Parents:            req.Parents,
+		ExpireAfter:        req.ExpireAfter,
+		CallbackGrpcTarget: req.CallbackGrpcTarget,
+		WorkerSelector:     req.WorkerSelector,
+	}
 	settingBytes, err := json.Marshal(&settings)
 	if err != nil {
 		return nil, xerror.Wrapf(err, "task biz register task failed").WithCtx(ctx)
 	}
 
+	// 有上游节点的任务需要等待上游全部成功后才放行
+	state := model.TaskStateInited
+	if len(req.Parents) > 0 {
+		state = model.TaskStateBlocked
+	}
+
+	taskId := uuid.NewUUID()
+	if req.IdempotencyKey != "" {
+		existing, err := b.claimIdempotencyKey(ctx, req.Namespace, req.IdempotencyKey, taskId, now)
+		if err != nil {
+			return nil, xerror.Wrapf(err, "task biz register task failed").
+				WithExtra("idempotencyKey", req.IdempotencyKey).
+				WithCtx(ctx)
+		}
+		if existing != nil {
+			return &RegisterTaskResponse{
+				Task:       existing,
+				Duplicated: true,
+			}, nil
+		}
+	}
+
 	shard := model.CalculateShardHash(req.TaskType)
 	taskPo := &dao.TaskPO{
-		Id:            uuid.NewUUID(),
+		Id:            taskId,
 		Namespace:     req.Namespace,
 		TaskType:      req.TaskType,
 		TaskTypeShard: shard,
 		InputArgs:     req.InputArgs,
-		State:         string(model.TaskStateInited),
+		State:         string(state),
 		CallbackUrl:   req.CallbackUrl,
 		TraceId:       traceId,
 		MaxRetryCnt:   req.MaxRetryCnt,
 		ExpireTime:    req.ExpireTime,
+		NotBefore:     req.NotBefore,
+		Priority:      req.Priority,
+		WorkflowId:    req.WorkflowId,
+		WorkflowNode:  req.WorkflowNode,
 		Settings:      settingBytes,
 		Ctime:         now,
 		Utime:         now,
@@ -84,7 +150,7 @@ func (b *TaskBiz) RegisterTask(
 
 	taskHistoryPo := &dao.TaskHistoryPO{
 		TaskId: taskPo.Id,
-		State:  string(model.TaskStateInited),
+		State:  string(state),
 		Ctime:  now,
 	}
 
@@ -98,6 +164,71 @@ func (b *TaskBiz) RegisterTask(
 	}, nil
 }
 
+// claimIdempotencyKey 将幂等键绑定到新任务
+//
+// 幂等键在去重窗口内已被绑定时返回已有任务，绑定成功时返回 nil
+func (b *TaskBiz) claimIdempotencyKey(
+	ctx context.Context,
+	namespace, idemKey string,
+	taskId uuid.UUID,
+	now int64,
+) (*model.Task, error) {
+	expireTime := now + b.idempotencyWindow.Milliseconds()
+
+	err := b.idempotencyDao.Insert(ctx, &dao.IdempotencyPO{
+		Namespace:  namespace,
+		IdemKey:    idemKey,
+		TaskId:     taskId,
+		ExpireTime: expireTime,
+		Ctime:      now,
+	})
+	if err == nil {
+		return nil, nil
+	}
+	if !xsql.IsDuplicate(err) {
+		return nil, err
+	}
+
+	po, err := b.idempotencyDao.GetByKey(ctx, namespace, idemKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if po.ExpireTime <= now {
+		// 已超出去重窗口 重新绑定到新任务
+		ok, err := b.idempotencyDao.Renew(ctx, namespace, idemKey, po.TaskId, taskId, expireTime, now)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return nil, nil
+		}
+
+		// 被并发请求抢先绑定
+		po, err = b.idempotencyDao.GetByKey(ctx, namespace, idemKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	taskPo, err := b.taskDao.GetById(ctx, po.TaskId)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.TaskFromPO(taskPo), nil
+}
+
+// CleanExpiredIdempotencyKeys 清理超出去重窗口的幂等键
+func (b *TaskBiz) CleanExpiredIdempotencyKeys(ctx context.Context, limit int) (int64, error) {
+	cnt, err := b.idempotencyDao.DeleteExpired(ctx, time.Now().UnixMilli(), limit)
+	if err != nil {
+		return 0, xerror.Wrapf(err, "task biz clean expired idempotency keys failed").WithCtx(ctx)
+	}
+
+	return cnt, nil
+}
+
 // GetTask 获取任务
 func (b *TaskBiz) GetTask(
 	ctx context.Context,
@@ -105,11 +236,133 @@ func (b *TaskBiz) GetTask(
 
 	taskPo, err := b.taskDao.GetById(ctx, taskId)
 	if err != nil {
+		if xsql.IsNoRecord(err) {
+			return nil, global.ErrTaskNotFound
+		}
+
 		return nil, xerror.Wrapf(err, "task biz get task failed").WithCtx(ctx)
 	}
 	return model.TaskFromPO(taskPo), nil
 }
 
+type ListTasksRequest struct {
+	Namespace  string
+	TaskType   string
+	State      model.TaskState
+	CtimeStart int64 // [CtimeStart, CtimeEnd) unix ms, 0 表示不限制
+	CtimeEnd   int64
+	Cursor     uuid.UUID // 上一页最后一个任务id 首页为 MaxUUID
+	Count      int32
+}
+
+type ListTasksResult struct {
+	Tasks      []*model.Task
+	NextCursor uuid.UUID
+	HasMore    bool
+}
+
+// ListTasks 按条件分页列出任务 按创建时间倒序
+func (b *TaskBiz) ListTasks(ctx context.Context, req *ListTasksRequest) (*ListTasksResult, error) {
+	// 多取一条用于判断是否还有下一页
+	pos, err := b.taskDao.ListTasks(ctx, &dao.ListTasksFilter{
+		Namespace:  req.Namespace,
+		TaskType:   req.TaskType,
+		State:      string(req.State),
+		CtimeStart: req.CtimeStart,
+		CtimeEnd:   req.CtimeEnd,
+	}, req.Cursor, req.Count+1)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "task biz list tasks failed").
+			WithExtra("namespace", req.Namespace).
+			WithCtx(ctx)
+	}
+
+	result := &ListTasksResult{}
+	if len(pos) > int(req.Count) {
+		pos = pos[:req.Count]
+		result.HasMore = true
+	}
+
+	result.Tasks = make([]*model.Task, 0, len(pos))
+	for _, po := range pos {
+		result.Tasks = append(result.Tasks, model.TaskFromPO(po))
+	}
+	if len(pos) > 0 {
+		result.NextCursor = pos[len(pos)-1].Id
+	}
+
+	return result, nil
+}
+
+// RequeueTask 人工重试 将失败或过期的任务重新放回待重试队列并清空重试计数
+//
+// 需要在事务中调用；expireTime 为重试后的过期时间，为 0 时如果原过期时间已过则不再过期。
+// 工作流中的任务失败后下游已被终止，不支持单独重试
+func (b *TaskBiz) RequeueTask(ctx context.Context, taskId uuid.UUID, expireTime int64) error {
+	task, err := b.GetTask(ctx, taskId)
+	if err != nil {
+		return err
+	}
+
+	if !task.State.IsRetryable() {
+		return global.ErrTaskNotRetryable
+	}
+	if task.InWorkflow() {
+		return global.ErrTaskNotRetryable.Msg("工作流中的任务不支持单独重试")
+	}
+
+	now := time.Now().UnixMilli()
+	if expireTime == 0 && !task.IsExpired(now) {
+		expireTime = task.ExpireTime
+	}
+
+	ok, err := b.taskDao.UpdateRequeue(ctx, taskId,
+		[]string{string(model.TaskStateFailure), string(model.TaskStateExpired)},
+		string(model.TaskStatePendingRetry),
+		expireTime, now)
+	if err != nil {
+		return xerror.Wrapf(err, "task biz requeue task failed").
+			WithExtra("taskId", taskId.String()).
+			WithCtx(ctx)
+	}
+	if !ok {
+		// 状态已被并发修改
+		return global.ErrTaskNotRetryable
+	}
+
+	err = b.taskHistoryDao.Insert(ctx, &dao.TaskHistoryPO{
+		TaskId: taskId,
+		State:  string(model.TaskStatePendingRetry),
+		Ctime:  now,
+	})
+	if err != nil {
+		return xerror.Wrapf(err, "task biz insert task history failed").WithCtx(ctx)
+	}
+
+	return nil
+}
+
+// AbortTask 终止任务 只有非终态的任务可以被终止
+//
+// 需要在事务中调用；返回终止前的任务
+func (b *TaskBiz) AbortTask(ctx context.Context, taskId uuid.UUID) (*model.Task, error) {
+	task, err := b.GetTask(ctx, taskId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !task.State.IsAbortable() {
+		return nil, global.ErrTaskNotAbortable
+	}
+
+	err = b.UpdateTaskState(ctx, taskId, model.TaskStateAborted)
+	if err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
+
 // GetTaskHistorys 获取任务历史
 func (b *TaskBiz) GetTaskHistorys(
 	ctx context.Context,
@@ -126,19 +379,30 @@ func (b *TaskBiz) GetTaskHistorys(
 	return taskHistories, nil
 }
 
-// GetInitedTasks 获取创建成功但未被分配的任务
-func (b *TaskBiz) GetInitedTasks(
+// GetPendingTasks 按优先级从高到低获取命名空间下待分发（inited 或 pending_retry）的任务
+//
+// 返回排在 (cursorPriority, cursorId) 之后的任务
+func (b *TaskBiz) GetPendingTasks(
 	ctx context.Context,
+	namespace string,
+	state model.TaskState,
 	shardStart, shardEnd int, // [shardStart, shardEnd)
+	cursorPriority int32,
+	cursorId uuid.UUID,
 	limit int32,
-	offset uuid.UUID,
 ) ([]*model.Task, error) {
-	pos, err := b.taskDao.ListTaskByState(ctx,
-		string(model.TaskStateInited),
+	pos, err := b.taskDao.ListPendingByPriority(ctx,
+		namespace,
+		string(state),
 		shardStart, shardEnd,
-		limit, offset)
+		time.Now().UnixMilli(),
+		dao.PriorityCursor{Priority: cursorPriority, Id: cursorId},
+		limit)
 	if err != nil {
-		return nil, xerror.Wrapf(err, "task biz get inited tasks failed").WithCtx(ctx)
+		return nil, xerror.Wrapf(err, "task biz get pending tasks failed").
+			WithExtra("namespace", namespace).
+			WithExtra("state", state).
+			WithCtx(ctx)
 	}
 	tasks := make([]*model.Task, 0, len(pos))
 	for _, taskPo := range pos {
@@ -147,6 +411,47 @@ func (b *TaskBiz) GetInitedTasks(
 	return tasks, nil
 }
 
+// HasPendingAbove 是否有在 afterId 之后注册且优先级高于 priority 的待分发任务
+func (b *TaskBiz) HasPendingAbove(
+	ctx context.Context,
+	namespace string,
+	state model.TaskState,
+	shardStart, shardEnd int, // [shardStart, shardEnd)
+	priority int32,
+	afterId uuid.UUID,
+) (bool, error) {
+	ok, err := b.taskDao.HasPendingAbove(ctx,
+		namespace,
+		string(state),
+		shardStart, shardEnd,
+		time.Now().UnixMilli(),
+		priority, afterId)
+	if err != nil {
+		return false, xerror.Wrapf(err, "task biz check pending above failed").
+			WithExtra("namespace", namespace).
+			WithExtra("state", state).
+			WithCtx(ctx)
+	}
+	return ok, nil
+}
+
+// CountInflight 按命名空间统计已分发和执行中的任务数
+func (b *TaskBiz) CountInflight(ctx context.Context) (map[string]int64, error) {
+	counts, err := b.taskDao.CountByStatesGroupByNamespace(ctx, []string{
+		string(model.TaskStateDispatched),
+		string(model.TaskStateRunning),
+	})
+	if err != nil {
+		return nil, xerror.Wrapf(err, "task biz count inflight failed").WithCtx(ctx)
+	}
+
+	inflight := make(map[string]int64, len(counts))
+	for _, c := range counts {
+		inflight[c.Namespace] = c.Cnt
+	}
+	return inflight, nil
+}
+
 // GetExpiredTasks 获取已过期的任务
 func (b *TaskBiz) GetExpiredTasks(
 	ctx context.Context,
@@ -165,6 +470,24 @@ func (b *TaskBiz) GetExpiredTasks(
 	return tasks, nil
 }
 
+// GetLeaseExpiredTasks 获取租约过期或执行超时的任务
+func (b *TaskBiz) GetLeaseExpiredTasks(
+	ctx context.Context,
+	shardStart, shardEnd int,
+	limit int32,
+) ([]*model.Task, error) {
+	now := time.Now().UnixMilli()
+	pos, err := b.taskDao.ListLeaseExpired(ctx, shardStart, shardEnd, now, limit)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "task biz get lease expired tasks failed").WithCtx(ctx)
+	}
+	tasks := make([]*model.Task, 0, len(pos))
+	for _, taskPo := range pos {
+		tasks = append(tasks, model.TaskFromPO(taskPo))
+	}
+	return tasks, nil
+}
+
 // UpdateTaskState 更新任务状态
 func (b *TaskBiz) UpdateTaskState(ctx context.Context, taskId uuid.UUID, state model.TaskState) error {
 	now := time.Now().UnixMilli()
@@ -188,14 +511,135 @@ func (b *TaskBiz) UpdateTaskState(ctx context.Context, taskId uuid.UUID, state m
 	return nil
 }
 
+// MarkDispatched 将任务标记为已分发 同时记录分发到的 worker 并设置接受任务的租约
+//
+// worker 可能在标记前就已接受任务 此时不再更新 返回 false
+func (b *TaskBiz) MarkDispatched(ctx context.Context, task *model.Task) (bool, error) {
+	now := time.Now()
+	leaseExpire := now.Add(b.taskConf.GetLeaseTimeout(task.TaskType)).UnixMilli()
+	ok, err := b.taskDao.UpdateDispatched(ctx, task.Id,
+		[]string{string(model.TaskStateInited), string(model.TaskStatePendingRetry)},
+		string(model.TaskStateDispatched),
+		task.WorkerId, leaseExpire, now.UnixMilli())
+	if err != nil {
+		return false, xerror.Wrapf(err, "task biz mark dispatched failed").
+			WithExtras("taskId", task.Id.String(), "workerId", task.WorkerId).
+			WithCtx(ctx)
+	}
+	if !ok {
+		return false, nil
+	}
+
+	taskHistoryPo := &dao.TaskHistoryPO{
+		TaskId: task.Id,
+		State:  string(model.TaskStateDispatched),
+		Ctime:  now.UnixMilli(),
+	}
+	err = b.taskHistoryDao.Insert(ctx, taskHistoryPo)
+	if err != nil {
+		return false, xerror.Wrapf(err, "task biz insert task history failed").WithCtx(ctx)
+	}
+
+	return true, nil
+}
+
+// AcceptTask worker 接受任务 更新为执行中并设置执行租约和执行截止时间
+//
+// 需要在事务中调用；分发后的状态可能还未写入 因此待分发的任务也可以被接受
+func (b *TaskBiz) AcceptTask(ctx context.Context, taskId uuid.UUID, workerId string) (*model.Task, error) {
+	task, err := b.GetTask(ctx, taskId)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	leaseExpire := now.Add(b.taskConf.GetLeaseTimeout(task.TaskType)).UnixMilli()
+	var deadline int64
+	if timeout := b.taskConf.GetExecutionTimeout(task.TaskType); timeout > 0 {
+		deadline = now.Add(timeout).UnixMilli()
+	}
+
+	ok, err := b.taskDao.UpdateAccepted(ctx, taskId,
+		[]string{
+			string(model.TaskStateInited),
+			string(model.TaskStatePendingRetry),
+			string(model.TaskStateDispatched),
+		},
+		string(model.TaskStateRunning),
+		workerId, leaseExpire, deadline, now.UnixMilli())
+	if err != nil {
+		return nil, xerror.Wrapf(err, "task biz accept task failed").
+			WithExtras("taskId", taskId.String(), "workerId", workerId).
+			WithCtx(ctx)
+	}
+	if !ok {
+		return nil, global.ErrTaskNotAcceptable
+	}
+
+	taskHistoryPo := &dao.TaskHistoryPO{
+		TaskId: taskId,
+		State:  string(model.TaskStateRunning),
+		Ctime:  now.UnixMilli(),
+	}
+	err = b.taskHistoryDao.Insert(ctx, taskHistoryPo)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "task biz insert task history failed").WithCtx(ctx)
+	}
+
+	return task, nil
+}
+
+// RenewLease 续期执行中任务的租约 返回任务是否仍由该 worker 持有
+func (b *TaskBiz) RenewLease(ctx context.Context, task *model.Task, workerId string) (bool, error) {
+	leaseExpire := time.Now().Add(b.taskConf.GetLeaseTimeout(task.TaskType)).UnixMilli()
+	ok, err := b.taskDao.RenewLease(ctx, task.Id, string(model.TaskStateRunning), workerId, leaseExpire)
+	if err != nil {
+		return false, xerror.Wrapf(err, "task biz renew lease failed").
+			WithExtras("taskId", task.Id.String(), "workerId", workerId).
+			WithCtx(ctx)
+	}
+
+	return ok, nil
+}
+
+// GetWorkerAssignments 获取分配给 worker 且未完成的任务 workerId -> taskIds
+func (b *TaskBiz) GetWorkerAssignments(
+	ctx context.Context,
+	workerIds []string,
+) (map[string][]uuid.UUID, error) {
+	assignments, err := b.taskDao.ListAssignedByWorkerIds(ctx, workerIds, []string{
+		string(model.TaskStateDispatched),
+		string(model.TaskStateRunning),
+	})
+	if err != nil {
+		return nil, xerror.Wrapf(err, "task biz list worker assignments failed").WithCtx(ctx)
+	}
+
+	result := make(map[string][]uuid.UUID, len(workerIds))
+	for _, a := range assignments {
+		result[a.WorkerId] = append(result[a.WorkerId], a.TaskId)
+	}
+
+	return result, nil
+}
+
+// 任务由 worker 持有时的状态
+var workerHeldStates = []string{
+	string(model.TaskStateDispatched),
+	string(model.TaskStateRunning),
+}
+
 // CompleteTask Worker 完成任务
+//
+// 需要在事务中调用；任务已不由该 worker 持有（已回收、已中止等）时不更新 返回 false
 func (b *TaskBiz) CompleteTask(
 	ctx context.Context,
 	taskId uuid.UUID,
+	workerId string,
 	success bool,
 	outputArgs []byte,
 	errorMsg []byte,
-) error {
+) (bool, error) {
 	now := time.Now().UnixMilli()
 	state := model.TaskStateSuccess
 	if !success {
@@ -206,12 +650,15 @@ func (b *TaskBiz) CompleteTask(
 		}
 	}
 
-	err := b.taskDao.UpdateComplete(ctx, taskId, string(state), outputArgs, now)
+	ok, err := b.taskDao.UpdateComplete(ctx, taskId, workerHeldStates, workerId, string(state), outputArgs, now)
 	if err != nil {
-		return xerror.Wrapf(err, "task biz complete task failed").
-			WithExtra("taskId", taskId.String()).
+		return false, xerror.Wrapf(err, "task biz complete task failed").
+			WithExtras("taskId", taskId.String(), "workerId", workerId).
 			WithCtx(ctx)
 	}
+	if !ok {
+		return false, nil
+	}
 
 	// 记录任务状态变更历史
 	taskHistoryPo := &dao.TaskHistoryPO{
@@ -221,21 +668,27 @@ func (b *TaskBiz) CompleteTask(
 	}
 	err = b.taskHistoryDao.Insert(ctx, taskHistoryPo)
 	if err != nil {
-		return xerror.Wrapf(err, "task biz insert task history failed").WithCtx(ctx)
+		return false, xerror.Wrapf(err, "task biz insert task history failed").WithCtx(ctx)
 	}
 
-	return nil
+	return true, nil
 }
 
 // RetryTask 将任务标记为待重试状态，同时增加重试计数
-func (b *TaskBiz) RetryTask(ctx context.Context, taskId uuid.UUID) error {
+//
+// 需要在事务中调用；任务已不由该 worker 持有时不更新 返回 false
+func (b *TaskBiz) RetryTask(ctx context.Context, taskId uuid.UUID, workerId string) (bool, error) {
 	now := time.Now().UnixMilli()
-	err := b.taskDao.UpdateRetry(ctx, taskId, string(model.TaskStatePendingRetry), now)
+	ok, err := b.taskDao.UpdateRetry(ctx, taskId, workerHeldStates, workerId,
+		string(model.TaskStatePendingRetry), now)
 	if err != nil {
-		return xerror.Wrapf(err, "task biz retry task failed").
-			WithExtra("taskId", taskId.String()).
+		return false, xerror.Wrapf(err, "task biz retry task failed").
+			WithExtras("taskId", taskId.String(), "workerId", workerId).
 			WithCtx(ctx)
 	}
+	if !ok {
+		return false, nil
+	}
 
 	// 记录任务状态变更历史
 	taskHistoryPo := &dao.TaskHistoryPO{
@@ -245,31 +698,52 @@ func (b *TaskBiz) RetryTask(ctx context.Context, taskId uuid.UUID) error {
 	}
 	err = b.taskHistoryDao.Insert(ctx, taskHistoryPo)
 	if err != nil {
-		return xerror.Wrapf(err, "task biz insert task history failed").WithCtx(ctx)
+		return false, xerror.Wrapf(err, "task biz insert task history failed").WithCtx(ctx)
 	}
 
-	return nil
+	return true, nil
 }
 
-// GetPendingRetryTasks 获取待重试状态的任务
-func (b *TaskBiz) GetPendingRetryTasks(
-	ctx context.Context,
-	shardStart, shardEnd int,
-	limit int32,
-	offset uuid.UUID,
-) ([]*model.Task, error) {
-	pos, err := b.taskDao.ListTaskByState(ctx,
-		string(model.TaskStatePendingRetry),
-		shardStart, shardEnd,
-		limit, offset)
+// ReclaimTask 回收租约过期或执行超时的任务
+//
+// 需要在事务中调用；还有重试次数时放回待重试队列并计入重试次数，否则标记为失败并记录 reason。
+// 返回回收后的状态，任务已被 worker 续租或完成时不做修改并返回原状态
+func (b *TaskBiz) ReclaimTask(ctx context.Context, task *model.Task, reason string) (model.TaskState, error) {
+	var (
+		state      = model.TaskStateFailure
+		incrRetry  = false
+		outputArgs = []byte(reason)
+	)
+	if task.CanRetry() {
+		state = model.TaskStatePendingRetry
+		incrRetry = true
+		outputArgs = nil
+	}
+
+	now := time.Now().UnixMilli()
+	ok, err := b.taskDao.UpdateReclaim(ctx, task.Id,
+		string(task.State), task.LeaseExpire,
+		string(state), incrRetry, outputArgs, now)
 	if err != nil {
-		return nil, xerror.Wrapf(err, "task biz get pending retry tasks failed").WithCtx(ctx)
+		return task.State, xerror.Wrapf(err, "task biz reclaim task failed").
+			WithExtra("taskId", task.Id.String()).
+			WithCtx(ctx)
 	}
-	tasks := make([]*model.Task, 0, len(pos))
-	for _, taskPo := range pos {
-		tasks = append(tasks, model.TaskFromPO(taskPo))
+	if !ok {
+		return task.State, nil
 	}
-	return tasks, nil
+
+	taskHistoryPo := &dao.TaskHistoryPO{
+		TaskId: task.Id,
+		State:  string(state),
+		Ctime:  now,
+	}
+	err = b.taskHistoryDao.Insert(ctx, taskHistoryPo)
+	if err != nil {
+		return task.State, xerror.Wrapf(err, "task biz insert task history failed").WithCtx(ctx)
+	}
+
+	return state, nil
 }
 
 // ExpireTask 将任务标记为过期
@@ -0,0 +1,189 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/conductor/internal/biz/model"
+	"github.com/ryanreadbooks/whimer/conductor/internal/global"
+	"github.com/ryanreadbooks/whimer/misc/uuid"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func registerWithKey(b *Biz, namespace, idemKey string) *RegisterTaskResponse {
+	var resp *RegisterTaskResponse
+	err := b.Tx(context.Background(), func(ctx context.Context) error {
+		var txErr error
+		resp, txErr = b.TaskBiz.RegisterTask(ctx, &RegisterTaskRequest{
+			TaskType:       "transcode",
+			Namespace:      namespace,
+			IdempotencyKey: idemKey,
+		})
+		return txErr
+	})
+	So(err, ShouldBeNil)
+	return resp
+}
+
+func TestRegisterTaskIdempotency(t *testing.T) {
+	Convey("测试幂等键去重", t, func() {
+		b := newTestBiz()
+		first := registerWithKey(b, "note", "asset-1")
+		So(first.Duplicated, ShouldBeFalse)
+
+		cases := []struct {
+			name       string
+			namespace  string
+			idemKey    string
+			duplicated bool
+		}{
+			{name: "相同命名空间和幂等键返回已有任务", namespace: "note", idemKey: "asset-1", duplicated: true},
+			{name: "不同幂等键创建新任务", namespace: "note", idemKey: "asset-2", duplicated: false},
+			{name: "不同命名空间创建新任务", namespace: "comment", idemKey: "asset-1", duplicated: false},
+			{name: "未设置幂等键不去重", namespace: "note", idemKey: "", duplicated: false},
+		}
+
+		for _, c := range cases {
+			Convey(c.name, func() {
+				resp := registerWithKey(b, c.namespace, c.idemKey)
+				So(resp.Duplicated, ShouldEqual, c.duplicated)
+				if c.duplicated {
+					So(resp.Task.Id, ShouldEqual, first.Task.Id)
+				} else {
+					So(resp.Task.Id, ShouldNotEqual, first.Task.Id)
+				}
+			})
+		}
+	})
+
+	Convey("测试超出去重窗口后重新绑定", t, func() {
+		b := newTestBiz()
+		b.TaskBiz.idempotencyWindow = 10 * time.Millisecond
+
+		first := registerWithKey(b, "note", "asset-1")
+		So(registerWithKey(b, "note", "asset-1").Task.Id, ShouldEqual, first.Task.Id)
+
+		time.Sleep(20 * time.Millisecond)
+		renewed := registerWithKey(b, "note", "asset-1")
+		So(renewed.Duplicated, ShouldBeFalse)
+		So(renewed.Task.Id, ShouldNotEqual, first.Task.Id)
+
+		// 之后的注册返回重新绑定的任务
+		again := registerWithKey(b, "note", "asset-1")
+		So(again.Duplicated, ShouldBeTrue)
+		So(again.Task.Id, ShouldEqual, renewed.Task.Id)
+	})
+}
+
+// registerInState 注册任务并直接置为指定状态
+func registerInState(b *Biz, state model.TaskState) uuid.UUID {
+	ctx := context.Background()
+	resp, err := b.TaskBiz.RegisterTask(ctx, &RegisterTaskRequest{
+		TaskType:  "transcode",
+		Namespace: "note",
+	})
+	So(err, ShouldBeNil)
+	So(b.TaskBiz.UpdateTaskState(ctx, resp.Task.Id, state), ShouldBeNil)
+	return resp.Task.Id
+}
+
+var allTaskStates = []model.TaskState{
+	model.TaskStateBlocked,
+	model.TaskStateInited,
+	model.TaskStatePendingRetry,
+	model.TaskStateDispatched,
+	model.TaskStateRunning,
+	model.TaskStateSuccess,
+	model.TaskStateFailure,
+	model.TaskStateAborted,
+	model.TaskStateExpired,
+}
+
+func TestRequeueTaskStateGuard(t *testing.T) {
+	Convey("测试只有失败或过期的任务可以人工重试", t, func() {
+		b := newTestBiz()
+		ctx := context.Background()
+
+		retryable := map[model.TaskState]bool{
+			model.TaskStateFailure: true,
+			model.TaskStateExpired: true,
+		}
+
+		for _, state := range allTaskStates {
+			Convey(string(state), func() {
+				taskId := registerInState(b, state)
+				err := b.Tx(ctx, func(ctx context.Context) error {
+					return b.TaskBiz.RequeueTask(ctx, taskId, 0)
+				})
+
+				task, getErr := b.TaskBiz.GetTask(ctx, taskId)
+				So(getErr, ShouldBeNil)
+				if retryable[state] {
+					So(err, ShouldBeNil)
+					So(task.State, ShouldEqual, model.TaskStatePendingRetry)
+				} else {
+					So(errors.Is(err, global.ErrTaskNotRetryable), ShouldBeTrue)
+					So(task.State, ShouldEqual, state)
+				}
+			})
+		}
+
+		Convey("工作流中的任务不支持单独重试", func() {
+			var resp *RegisterWorkflowResponse
+			err := b.Tx(ctx, func(ctx context.Context) error {
+				var txErr error
+				resp, txErr = b.WorkflowBiz.RegisterWorkflow(ctx, &RegisterWorkflowRequest{
+					Namespace: "note",
+					Nodes:     []*model.WorkflowNode{node("probe")},
+				})
+				return txErr
+			})
+			So(err, ShouldBeNil)
+
+			taskId := resp.TaskIds["probe"]
+			So(b.TaskBiz.UpdateTaskState(ctx, taskId, model.TaskStateFailure), ShouldBeNil)
+			err = b.Tx(ctx, func(ctx context.Context) error {
+				return b.TaskBiz.RequeueTask(ctx, taskId, 0)
+			})
+			So(errors.Is(err, global.ErrTaskNotRetryable), ShouldBeTrue)
+		})
+	})
+}
+
+func TestAbortTaskStateGuard(t *testing.T) {
+	Convey("测试只有非终态的任务可以终止", t, func() {
+		b := newTestBiz()
+		ctx := context.Background()
+
+		for _, state := range allTaskStates {
+			Convey(string(state), func() {
+				taskId := registerInState(b, state)
+				var aborted *model.Task
+				err := b.Tx(ctx, func(ctx context.Context) error {
+					var txErr error
+					aborted, txErr = b.TaskBiz.AbortTask(ctx, taskId)
+					return txErr
+				})
+
+				task, getErr := b.TaskBiz.GetTask(ctx, taskId)
+				So(getErr, ShouldBeNil)
+				if state.IsTerminal() {
+					So(errors.Is(err, global.ErrTaskNotAbortable), ShouldBeTrue)
+					So(task.State, ShouldEqual, state)
+				} else {
+					So(err, ShouldBeNil)
+					So(aborted.State, ShouldEqual, state)
+					So(task.State, ShouldEqual, model.TaskStateAborted)
+				}
+			})
+		}
+
+		Convey("任务不存在", func() {
+			_, err := b.TaskBiz.AbortTask(ctx, uuid.NewUUID())
+			So(errors.Is(err, global.ErrTaskNotFound), ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,232 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/ryanreadbooks/whimer/conductor/internal/biz/model"
+	"github.com/ryanreadbooks/whimer/misc/concurrent"
+	"github.com/ryanreadbooks/whimer/misc/uuid"
+	"github.com/ryanreadbooks/whimer/misc/xlog"
+)
+
+// 所有实例共用的任务事件频道
+const taskEventChannel = "conductor:task_event"
+
+// 单个订阅者缓冲的事件数 消费跟不上时断开订阅
+const watcherBufferSize = 256
+
+// TaskWatcher 任务事件订阅者
+type TaskWatcher struct {
+	// 订阅单个任务时非空
+	taskId uuid.UUID
+
+	// 订阅命名空间时非空
+	namespace string
+	taskTypes map[string]struct{}
+
+	eventCh chan *model.TaskEvent
+
+	// 被动断开 消费过慢
+	overflowed bool
+	closed     bool
+}
+
+// Events 事件通道 订阅被取消或消费过慢时关闭
+func (w *TaskWatcher) Events() <-chan *model.TaskEvent {
+	return w.eventCh
+}
+
+// Single 是否只订阅单个任务
+func (w *TaskWatcher) Single() bool {
+	return !w.taskId.IsZero()
+}
+
+// Overflowed 是否因为消费过慢被断开
+func (w *TaskWatcher) Overflowed() bool {
+	return w.overflowed
+}
+
+func (w *TaskWatcher) match(event *model.TaskEvent) bool {
+	if w.Single() {
+		return w.taskId == event.TaskId
+	}
+
+	if w.namespace != event.Namespace {
+		return false
+	}
+	if len(w.taskTypes) == 0 {
+		return true
+	}
+	_, ok := w.taskTypes[event.TaskType]
+	return ok
+}
+
+// WatchBiz 任务事件的发布和订阅
+//
+// 事件发布到 redis 所有实例订阅同一频道后分发给本地订阅者
+// 因此 worker 和订阅方连接到不同实例时也能收到事件
+//
+// 未配置 redis 时只分发给本实例的订阅者 用于单实例部署
+type WatchBiz struct {
+	rdb redis.UniversalClient
+
+	mu       sync.Mutex
+	watchers map[*TaskWatcher]struct{}
+
+	quitCh chan struct{}
+	doneCh chan struct{}
+}
+
+func NewWatchBiz(rdb redis.UniversalClient) *WatchBiz {
+	return &WatchBiz{
+		rdb:      rdb,
+		watchers: make(map[*TaskWatcher]struct{}),
+		quitCh:   make(chan struct{}),
+		doneCh:   make(chan struct{}),
+	}
+}
+
+// Publish 发布任务事件 失败只记录日志 不影响任务流转
+func (b *WatchBiz) Publish(ctx context.Context, event *model.TaskEvent) {
+	if b.rdb == nil {
+		b.dispatch(event)
+		return
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		xlog.Msg("marshal task event failed").Err(err).Errorx(ctx)
+		return
+	}
+
+	err = b.rdb.Publish(ctx, taskEventChannel, data).Err()
+	if err != nil {
+		xlog.Msg("publish task event failed").
+			Extras("taskId", event.TaskId.String(), "type", event.Type).
+			Err(err).
+			Errorx(ctx)
+	}
+}
+
+// WatchTask 订阅单个任务的事件
+func (b *WatchBiz) WatchTask(taskId uuid.UUID) *TaskWatcher {
+	w := &TaskWatcher{
+		taskId:  taskId,
+		eventCh: make(chan *model.TaskEvent, watcherBufferSize),
+	}
+	b.addWatcher(w)
+	return w
+}
+
+// WatchNamespace 订阅命名空间的事件 taskTypes 为空时订阅全部任务类型
+func (b *WatchBiz) WatchNamespace(namespace string, taskTypes []string) *TaskWatcher {
+	w := &TaskWatcher{
+		namespace: namespace,
+		taskTypes: make(map[string]struct{}, len(taskTypes)),
+		eventCh:   make(chan *model.TaskEvent, watcherBufferSize),
+	}
+	for _, t := range taskTypes {
+		w.taskTypes[t] = struct{}{}
+	}
+	b.addWatcher(w)
+	return w
+}
+
+// Unwatch 取消订阅
+func (b *WatchBiz) Unwatch(w *TaskWatcher) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closeWatcherLocked(w)
+}
+
+func (b *WatchBiz) addWatcher(w *TaskWatcher) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.watchers[w] = struct{}{}
+}
+
+func (b *WatchBiz) closeWatcherLocked(w *TaskWatcher) {
+	if w.closed {
+		return
+	}
+	w.closed = true
+	delete(b.watchers, w)
+	close(w.eventCh)
+}
+
+// dispatch 将事件分发给本地匹配的订阅者
+func (b *WatchBiz) dispatch(event *model.TaskEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for w := range b.watchers {
+		if !w.match(event) {
+			continue
+		}
+
+		select {
+		case w.eventCh <- event:
+		default:
+			// 缓冲已满 断开订阅 由订阅方重新订阅
+			w.overflowed = true
+			b.closeWatcherLocked(w)
+		}
+	}
+}
+
+// Run 订阅 redis 频道 直到 Stop
+func (b *WatchBiz) Run(ctx context.Context) {
+	if b.rdb == nil {
+		close(b.doneCh)
+		return
+	}
+
+	concurrent.SafeGo2(ctx, concurrent.SafeGo2Opt{
+		Name:             "conductor.watch.subscribe",
+		InheritCtxCancel: true,
+		Job: func(ctx context.Context) error {
+			defer close(b.doneCh)
+
+			sub := b.rdb.Subscribe(ctx, taskEventChannel)
+			defer sub.Close()
+
+			// 断线后由 go-redis 自动重连并重新订阅
+			msgCh := sub.Channel()
+			for {
+				select {
+				case <-b.quitCh:
+					return nil
+				case <-ctx.Done():
+					return nil
+				case msg, ok := <-msgCh:
+					if !ok {
+						return nil
+					}
+
+					var event model.TaskEvent
+					err := json.Unmarshal([]byte(msg.Payload), &event)
+					if err != nil {
+						xlog.Msg("unmarshal task event failed").Err(err).Errorx(ctx)
+						continue
+					}
+					b.dispatch(&event)
+				}
+			}
+		},
+	})
+}
+
+// Stop 停止订阅并断开所有本地订阅者
+func (b *WatchBiz) Stop() {
+	close(b.quitCh)
+	<-b.doneCh
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for w := range b.watchers {
+		b.closeWatcherLocked(w)
+	}
+}
@@ -0,0 +1,67 @@
+package biz
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ryanreadbooks/whimer/conductor/internal/biz/model"
+	"github.com/ryanreadbooks/whimer/misc/uuid"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestWatchDispatch(t *testing.T) {
+	Convey("测试任务事件分发给本地订阅者", t, func() {
+		b := NewWatchBiz(nil)
+
+		taskId := uuid.NewUUID()
+		task := &model.Task{Id: taskId, Namespace: "note", TaskType: "transcode"}
+
+		taskWatcher := b.WatchTask(taskId)
+		nsWatcher := b.WatchNamespace("note", nil)
+		typeWatcher := b.WatchNamespace("note", []string{"cover"})
+		otherWatcher := b.WatchNamespace("comment", nil)
+
+		b.dispatch(model.NewTaskStateEvent(task, model.TaskStateRunning))
+
+		So(len(taskWatcher.Events()), ShouldEqual, 1)
+		So(len(nsWatcher.Events()), ShouldEqual, 1)
+		So(len(typeWatcher.Events()), ShouldEqual, 0)
+		So(len(otherWatcher.Events()), ShouldEqual, 0)
+
+		Convey("取消订阅后关闭事件通道", func() {
+			b.Unwatch(taskWatcher)
+			<-taskWatcher.Events()
+			_, ok := <-taskWatcher.Events()
+			So(ok, ShouldBeFalse)
+			So(taskWatcher.Overflowed(), ShouldBeFalse)
+
+			// 重复取消不 panic
+			So(func() { b.Unwatch(taskWatcher) }, ShouldNotPanic)
+		})
+
+		Convey("消费过慢时断开订阅", func() {
+			for i := 0; i < watcherBufferSize; i++ {
+				b.dispatch(model.NewTaskProgressEvent(task, int64(i)))
+			}
+			So(nsWatcher.Overflowed(), ShouldBeTrue)
+			So(len(b.watchers), ShouldEqual, 2)
+		})
+	})
+}
+
+func TestTaskEventJson(t *testing.T) {
+	Convey("测试任务事件序列化", t, func() {
+		task := &model.Task{Id: uuid.NewUUID(), Namespace: "note", TaskType: "transcode"}
+		event := model.NewTaskStateEvent(task, model.TaskStateSuccess)
+
+		data, err := json.Marshal(event)
+		So(err, ShouldBeNil)
+
+		var got model.TaskEvent
+		So(json.Unmarshal(data, &got), ShouldBeNil)
+		So(got.TaskId, ShouldEqual, task.Id)
+		So(got.State, ShouldEqual, model.TaskStateSuccess)
+		So(got.IsFinal(), ShouldBeTrue)
+	})
+}
@@ -8,7 +8,9 @@ import (
 
 	"github.com/ryanreadbooks/whimer/conductor/internal/biz/model"
 	"github.com/ryanreadbooks/whimer/conductor/internal/config"
+	"github.com/ryanreadbooks/whimer/conductor/internal/infra/dao"
 	"github.com/ryanreadbooks/whimer/misc/uuid"
+	"github.com/ryanreadbooks/whimer/misc/xerror"
 	"github.com/ryanreadbooks/whimer/misc/xlog"
 )
 
@@ -16,32 +18,46 @@ import (
 type waitingWorker struct {
 	workerId string
 	taskType string
+	labels   map[string]string
 	taskCh   chan *model.Task
 	doneCh   chan struct{}
 	element  *list.Element // 在链表中的位置，用于 O(1) 删除
 }
 
-// WorkerBiz 管理 Worker 长轮询
+// 同一 worker 两次心跳落库的最小间隔
+const workerHeartbeatInterval = 10 * time.Second
+
+// WorkerBiz 管理 Worker 长轮询和注册表
 type WorkerBiz struct {
 	conf *config.Config
 
+	workerDao dao.WorkerStore
+
 	mu sync.Mutex
 
 	// 按 task_type 分组的等待 Worker 链表 (FIFO)
 	waitingWorkers map[string]*list.List
+
+	hbMu sync.Mutex
+
+	// 最近一次心跳落库时间 workerId -> unix ms
+	heartbeats map[string]int64
 }
 
-func NewWorkerBiz(conf *config.Config) *WorkerBiz {
+func NewWorkerBiz(conf *config.Config, workerDao dao.WorkerStore) *WorkerBiz {
 	return &WorkerBiz{
 		conf:           conf,
+		workerDao:      workerDao,
 		waitingWorkers: make(map[string]*list.List),
+		heartbeats:     make(map[string]int64),
 	}
 }
 
-// Worker 等待任务
+// Worker 等待任务 只会收到 selector 与 labels 匹配的任务
 func (b *WorkerBiz) WaitForTask(
 	ctx context.Context,
 	workerId, taskType string,
+	labels map[string]string,
 ) (*model.Task, error) {
 	timeout := b.conf.WorkerConfig.GetLongPollTimeout()
 	timer := time.NewTimer(timeout)
@@ -50,6 +66,7 @@ func (b *WorkerBiz) WaitForTask(
 	w := &waitingWorker{
 		workerId: workerId,
 		taskType: taskType,
+		labels:   labels,
 		taskCh:   make(chan *model.Task, 1),
 		doneCh:   make(chan struct{}),
 	}
@@ -89,24 +106,32 @@ func (b *WorkerBiz) dispatchTaskLocked(ctx context.Context, task *model.Task) bo
 		return false
 	}
 
-	for {
-		front := l.Front()
-		if front == nil {
-			return false
-		}
-
-		w := front.Value.(*waitingWorker)
-		l.Remove(front)
-		w.element = nil
+	for e := l.Front(); e != nil; {
+		next := e.Next()
+		w := e.Value.(*waitingWorker)
 
-		// 跳过已超时的 worker
+		// 移除已超时的 worker
 		select {
 		case <-w.doneCh:
+			l.Remove(e)
+			w.element = nil
+			e = next
 			continue
 		default:
 		}
 
+		// 标签不匹配的 worker 继续等待
+		if !model.MatchLabels(task.Settings.WorkerSelector, w.labels) {
+			e = next
+			continue
+		}
+
+		l.Remove(e)
+		w.element = nil
+		e = next
+
 		// 发送任务
+		task.WorkerId = w.workerId
 		select {
 		case w.taskCh <- task:
 			xlog.Msg("task dispatched to worker").
@@ -119,6 +144,8 @@ func (b *WorkerBiz) dispatchTaskLocked(ctx context.Context, task *model.Task) bo
 			continue
 		}
 	}
+
+	return false
 }
 
 // GetWaitingCount 获取指定 taskType 的等待 Worker 数量
@@ -174,3 +201,134 @@ func (b *WorkerBiz) removeWaiting(w *waitingWorker) {
 		w.element = nil
 	}
 }
+
+// RegisterWorker 注册 worker 已存在时覆盖
+func (b *WorkerBiz) RegisterWorker(ctx context.Context, worker *model.Worker) error {
+	now := time.Now().UnixMilli()
+	worker.RegisterTime = now
+	worker.LastHeartbeat = now
+
+	err := b.workerDao.Upsert(ctx, worker.ToPO())
+	if err != nil {
+		return xerror.Wrapf(err, "worker biz register worker failed").
+			WithExtra("workerId", worker.Id).
+			WithCtx(ctx)
+	}
+
+	b.setHeartbeat(worker.Id, now)
+
+	return nil
+}
+
+// UnregisterWorker 注销 worker
+func (b *WorkerBiz) UnregisterWorker(ctx context.Context, workerId string) error {
+	err := b.workerDao.DeleteById(ctx, workerId)
+	if err != nil {
+		return xerror.Wrapf(err, "worker biz unregister worker failed").
+			WithExtra("workerId", workerId).
+			WithCtx(ctx)
+	}
+
+	b.hbMu.Lock()
+	delete(b.heartbeats, workerId)
+	b.hbMu.Unlock()
+
+	return nil
+}
+
+// Heartbeat 记录 worker 心跳 同一 worker 落库有最小间隔
+//
+// 未注册过的 worker 以当前轮询的信息补录
+func (b *WorkerBiz) Heartbeat(ctx context.Context, worker *model.Worker) error {
+	if worker.Id == "" {
+		return nil
+	}
+
+	now := time.Now().UnixMilli()
+	b.hbMu.Lock()
+	last := b.heartbeats[worker.Id]
+	b.hbMu.Unlock()
+	if now-last < workerHeartbeatInterval.Milliseconds() {
+		return nil
+	}
+
+	exists, err := b.workerDao.UpdateHeartbeat(ctx, worker.Id, now)
+	if err != nil {
+		return xerror.Wrapf(err, "worker biz update heartbeat failed").
+			WithExtra("workerId", worker.Id).
+			WithCtx(ctx)
+	}
+
+	if !exists {
+		worker.RegisterTime = now
+		worker.LastHeartbeat = now
+		err = b.workerDao.Insert(ctx, worker.ToPO())
+		if err != nil {
+			return xerror.Wrapf(err, "worker biz insert worker failed").
+				WithExtra("workerId", worker.Id).
+				WithCtx(ctx)
+		}
+	}
+
+	b.setHeartbeat(worker.Id, now)
+
+	return nil
+}
+
+func (b *WorkerBiz) setHeartbeat(workerId string, now int64) {
+	b.hbMu.Lock()
+	defer b.hbMu.Unlock()
+	if b.heartbeats == nil {
+		b.heartbeats = make(map[string]int64)
+	}
+	b.heartbeats[workerId] = now
+}
+
+type ListWorkersRequest struct {
+	TaskType       string            // 为空不过滤
+	Selector       map[string]string // 为空不过滤
+	IncludeOffline bool
+}
+
+// ListWorkers 列出已注册的 worker 默认只包含在线的
+func (b *WorkerBiz) ListWorkers(ctx context.Context, req *ListWorkersRequest) ([]*model.Worker, error) {
+	var since int64
+	if !req.IncludeOffline {
+		since = time.Now().Add(-b.conf.WorkerConfig.GetHeartbeatTimeout()).UnixMilli()
+	}
+
+	pos, err := b.workerDao.ListByHeartbeat(ctx, since)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "worker biz list workers failed").WithCtx(ctx)
+	}
+
+	workers := make([]*model.Worker, 0, len(pos))
+	for _, po := range pos {
+		w := model.WorkerFromPO(po)
+		if req.TaskType != "" && !w.HandlesTaskType(req.TaskType) {
+			continue
+		}
+		if !model.MatchLabels(req.Selector, w.Labels) {
+			continue
+		}
+		workers = append(workers, w)
+	}
+
+	return workers, nil
+}
+
+// IsWorkerAlive worker 是否在线
+func (b *WorkerBiz) IsWorkerAlive(w *model.Worker) bool {
+	return w.IsAlive(time.Now().UnixMilli(), b.conf.WorkerConfig.GetHeartbeatTimeout().Milliseconds())
+}
+
+// CleanOfflineWorkers 清理离线超过保留时长的 worker
+func (b *WorkerBiz) CleanOfflineWorkers(ctx context.Context, limit int) (int64, error) {
+	before := time.Now().Add(-b.conf.WorkerConfig.GetOfflineRetention()).UnixMilli()
+	cnt, err := b.workerDao.DeleteStale(ctx, before, limit)
+	if err != nil {
+		return 0, xerror.Wrapf(err, "worker biz clean offline workers failed").WithCtx(ctx)
+	}
+
+	return cnt, nil
+}
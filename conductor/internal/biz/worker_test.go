@@ -159,3 +159,60 @@ func TestDispatchAfterRemove(t *testing.T) {
 		})
 	})
 }
+
+func TestDispatchWithSelector(t *testing.T) {
+	Convey("测试按标签选择 worker 分发", t, func() {
+		b := newTestWorkerBiz()
+		taskType := "transcode"
+
+		cpuWorker := &waitingWorker{
+			workerId: "worker-cpu",
+			taskType: taskType,
+			labels:   map[string]string{"cpu": "16"},
+			taskCh:   make(chan *model.Task, 1),
+			doneCh:   make(chan struct{}),
+		}
+		av1Worker := &waitingWorker{
+			workerId: "worker-av1",
+			taskType: taskType,
+			labels:   map[string]string{"ffmpeg": "av1", "cpu": "16"},
+			taskCh:   make(chan *model.Task, 1),
+			doneCh:   make(chan struct{}),
+		}
+		b.addWaiting(cpuWorker)
+		b.addWaiting(av1Worker)
+
+		Convey("跳过标签不匹配的 worker", func() {
+			task := &model.Task{
+				TaskType: taskType,
+				Settings: model.TaskSettings{
+					WorkerSelector: map[string]string{"ffmpeg": "av1"},
+				},
+			}
+			So(b.DispatchTask(context.Background(), task), ShouldBeTrue)
+			So(task.WorkerId, ShouldEqual, "worker-av1")
+			So(len(av1Worker.taskCh), ShouldEqual, 1)
+			So(len(cpuWorker.taskCh), ShouldEqual, 0)
+
+			// 不匹配的 worker 仍在队列中
+			So(b.GetWaitingCount(taskType), ShouldEqual, 1)
+		})
+
+		Convey("没有匹配的 worker 时不分发", func() {
+			task := &model.Task{
+				TaskType: taskType,
+				Settings: model.TaskSettings{
+					WorkerSelector: map[string]string{"gpu": "a100"},
+				},
+			}
+			So(b.DispatchTask(context.Background(), task), ShouldBeFalse)
+			So(b.GetWaitingCount(taskType), ShouldEqual, 2)
+		})
+
+		Convey("没有选择器时按 FIFO 分发", func() {
+			task := &model.Task{TaskType: taskType}
+			So(b.DispatchTask(context.Background(), task), ShouldBeTrue)
+			So(task.WorkerId, ShouldEqual, "worker-cpu")
+		})
+	})
+}
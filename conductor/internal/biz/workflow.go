@@ -0,0 +1,247 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/conductor/internal/biz/model"
+	"github.com/ryanreadbooks/whimer/conductor/internal/global"
+	"github.com/ryanreadbooks/whimer/conductor/internal/infra/dao"
+	"github.com/ryanreadbooks/whimer/misc/uuid"
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+)
+
+type WorkflowBiz struct {
+	taskDao        dao.TaskStore
+	taskHistoryDao dao.TaskHistoryStore
+	taskBiz        *TaskBiz
+}
+
+func NewWorkflowBiz(
+	taskDao dao.TaskStore,
+	taskHistoryDao dao.TaskHistoryStore,
+	taskBiz *TaskBiz,
+) *WorkflowBiz {
+	return &WorkflowBiz{
+		taskDao:        taskDao,
+		taskHistoryDao: taskHistoryDao,
+		taskBiz:        taskBiz,
+	}
+}
+
+type RegisterWorkflowRequest struct {
+	Namespace string
+	Nodes     []*model.WorkflowNode
+	Priority  int32 // 工作流内所有任务的优先级
+}
+
+type RegisterWorkflowResponse struct {
+	WorkflowId uuid.UUID
+	TaskIds    map[string]uuid.UUID // 节点名 -> 任务id
+}
+
+// RegisterWorkflow 注册工作流
+//
+// 需要在事务中调用；没有上游的节点直接进入 inited，其余节点进入 blocked 等待放行
+func (b *WorkflowBiz) RegisterWorkflow(
+	ctx context.Context,
+	req *RegisterWorkflowRequest,
+) (*RegisterWorkflowResponse, error) {
+	nodes, err := model.SortWorkflowNodes(req.Nodes)
+	if err != nil {
+		return nil, global.ErrInvalidWorkflow.Msgf("工作流定义不合法: %s", err.Error())
+	}
+
+	workflowId := uuid.NewUUID()
+	taskIds := make(map[string]uuid.UUID, len(nodes))
+	for _, node := range nodes {
+		var parents map[string]string
+		if len(node.DependsOn) > 0 {
+			parents = make(map[string]string, len(node.DependsOn))
+			for _, parent := range node.DependsOn {
+				parents[parent] = taskIds[parent].String()
+			}
+		}
+
+		// 没有上游的节点立即放行 直接计算过期时间
+		var expireTime int64
+		if len(parents) == 0 && node.ExpireAfter > 0 {
+			expireTime = time.Now().UnixMilli() + node.ExpireAfter
+		}
+
+		resp, err := b.taskBiz.RegisterTask(ctx, &RegisterTaskRequest{
+			TaskType:           node.TaskType,
+			Namespace:          req.Namespace,
+			InputArgs:          node.InputArgs,
+			CallbackUrl:        node.CallbackUrl,
+			CallbackGrpcTarget: node.CallbackGrpcTarget,
+			MaxRetryCnt:        node.MaxRetryCnt,
+			ExpireTime:         expireTime,
+			Priority:           req.Priority,
+			WorkflowId:         workflowId,
+			WorkflowNode:       node.Name,
+			Parents:            parents,
+			ExpireAfter:        node.ExpireAfter,
+			WorkerSelector:     node.WorkerSelector,
+		})
+		if err != nil {
+			return nil, xerror.Wrapf(err, "workflow biz register node failed").
+				WithExtra("workflowId", workflowId.String()).
+				WithExtra("node", node.Name).
+				WithCtx(ctx)
+		}
+
+		taskIds[node.Name] = resp.Task.Id
+	}
+
+	return &RegisterWorkflowResponse{
+		WorkflowId: workflowId,
+		TaskIds:    taskIds,
+	}, nil
+}
+
+// GetWorkflowTasks 获取工作流下的所有任务
+func (b *WorkflowBiz) GetWorkflowTasks(ctx context.Context, workflowId uuid.UUID) ([]*model.Task, error) {
+	pos, err := b.taskDao.ListByWorkflowId(ctx, workflowId)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "workflow biz list tasks failed").
+			WithExtra("workflowId", workflowId.String()).
+			WithCtx(ctx)
+	}
+
+	if len(pos) == 0 {
+		return nil, global.ErrWorkflowNotFound
+	}
+
+	tasks := make([]*model.Task, 0, len(pos))
+	for _, po := range pos {
+		tasks = append(tasks, model.TaskFromPO(po))
+	}
+	return tasks, nil
+}
+
+// GetBlockedTasks 获取等待上游完成的任务
+func (b *WorkflowBiz) GetBlockedTasks(
+	ctx context.Context,
+	shardStart, shardEnd int, // [shardStart, shardEnd)
+	limit int32,
+	offset uuid.UUID,
+) ([]*model.Task, error) {
+	pos, err := b.taskDao.ListTaskByState(ctx,
+		string(model.TaskStateBlocked),
+		shardStart, shardEnd,
+		time.Now().UnixMilli(),
+		limit, offset)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "workflow biz get blocked tasks failed").WithCtx(ctx)
+	}
+	tasks := make([]*model.Task, 0, len(pos))
+	for _, taskPo := range pos {
+		tasks = append(tasks, model.TaskFromPO(taskPo))
+	}
+	return tasks, nil
+}
+
+// TryRelease 检查上游任务状态并尝试放行
+//
+// 需要在事务中调用；上游全部成功时将任务置为 inited 并带上上游输出，
+// 上游有任一失败时级联终止该任务；返回任务的新状态，未变化时返回原状态
+func (b *WorkflowBiz) TryRelease(ctx context.Context, task *model.Task) (model.TaskState, error) {
+	parentIds := make([]uuid.UUID, 0, len(task.Settings.Parents))
+	for _, parentId := range task.Settings.Parents {
+		id, err := uuid.ParseString(parentId)
+		if err != nil {
+			return task.State, xerror.Wrapf(err, "workflow biz parse parent id failed").
+				WithExtra("taskId", task.Id.String()).
+				WithExtra("parentId", parentId).
+				WithCtx(ctx)
+		}
+		parentIds = append(parentIds, id)
+	}
+
+	pos, err := b.taskDao.GetByIds(ctx, parentIds)
+	if err != nil {
+		return task.State, xerror.Wrapf(err, "workflow biz get parents failed").
+			WithExtra("taskId", task.Id.String()).
+			WithCtx(ctx)
+	}
+
+	parents := make(map[uuid.UUID]*dao.TaskPO, len(pos))
+	for _, po := range pos {
+		parents[po.Id] = po
+	}
+
+	var (
+		outputs = make(map[string][]byte, len(task.Settings.Parents))
+		waiting bool
+	)
+	for node, parentId := range task.Settings.Parents {
+		id, _ := uuid.ParseString(parentId)
+		parent, ok := parents[id]
+		if !ok {
+			// 上游任务已不存在 无法继续
+			return b.abort(ctx, task)
+		}
+
+		state := model.TaskState(parent.State)
+		switch {
+		case state == model.TaskStateSuccess:
+			outputs[node] = parent.OutputArgs
+		case state.IsTerminal():
+			return b.abort(ctx, task)
+		default:
+			waiting = true
+		}
+	}
+
+	if waiting {
+		// 上游仍在执行
+		return task.State, nil
+	}
+
+	settings := task.Settings
+	settings.ParentOutputs = outputs
+	settingBytes, err := json.Marshal(&settings)
+	if err != nil {
+		return task.State, xerror.Wrapf(err, "workflow biz marshal settings failed").WithCtx(ctx)
+	}
+
+	now := time.Now().UnixMilli()
+	var expireTime int64
+	if settings.ExpireAfter > 0 {
+		expireTime = now + settings.ExpireAfter
+	}
+
+	ok, err := b.taskDao.UpdateRelease(ctx, task.Id,
+		string(model.TaskStateBlocked), string(model.TaskStateInited),
+		expireTime, settingBytes, now)
+	if err != nil {
+		return task.State, xerror.Wrapf(err, "workflow biz release task failed").
+			WithExtra("taskId", task.Id.String()).
+			WithCtx(ctx)
+	}
+	if !ok {
+		return task.State, nil
+	}
+
+	err = b.taskHistoryDao.Insert(ctx, &dao.TaskHistoryPO{
+		TaskId: task.Id,
+		State:  string(model.TaskStateInited),
+		Ctime:  now,
+	})
+	if err != nil {
+		return task.State, xerror.Wrapf(err, "workflow biz insert task history failed").WithCtx(ctx)
+	}
+
+	return model.TaskStateInited, nil
+}
+
+func (b *WorkflowBiz) abort(ctx context.Context, task *model.Task) (model.TaskState, error) {
+	err := b.taskBiz.UpdateTaskState(ctx, task.Id, model.TaskStateAborted)
+	if err != nil {
+		return task.State, err
+	}
+
+	return model.TaskStateAborted, nil
+}
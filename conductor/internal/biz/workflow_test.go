@@ -0,0 +1,159 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ryanreadbooks/whimer/conductor/internal/biz/model"
+	"github.com/ryanreadbooks/whimer/conductor/internal/global"
+	"github.com/ryanreadbooks/whimer/misc/uuid"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func node(name string, dependsOn ...string) *model.WorkflowNode {
+	return &model.WorkflowNode{
+		Name:      name,
+		TaskType:  name,
+		DependsOn: dependsOn,
+	}
+}
+
+// finishTask 模拟 worker 接受并完成任务
+func finishTask(b *Biz, taskId uuid.UUID, success bool, output []byte) {
+	err := b.Tx(context.Background(), func(ctx context.Context) error {
+		if _, err := b.TaskBiz.AcceptTask(ctx, taskId, "worker-1"); err != nil {
+			return err
+		}
+		ok, err := b.TaskBiz.CompleteTask(ctx, taskId, "worker-1", success, output, output)
+		So(ok, ShouldBeTrue)
+		return err
+	})
+	So(err, ShouldBeNil)
+}
+
+func tryRelease(b *Biz, taskId uuid.UUID) model.TaskState {
+	ctx := context.Background()
+	task, err := b.TaskBiz.GetTask(ctx, taskId)
+	So(err, ShouldBeNil)
+
+	var state model.TaskState
+	err = b.Tx(ctx, func(ctx context.Context) error {
+		var txErr error
+		state, txErr = b.WorkflowBiz.TryRelease(ctx, task)
+		return txErr
+	})
+	So(err, ShouldBeNil)
+	return state
+}
+
+func TestRegisterWorkflowInvalid(t *testing.T) {
+	Convey("测试拒绝不合法的工作流定义", t, func() {
+		b := newTestBiz()
+
+		cases := []struct {
+			name  string
+			nodes []*model.WorkflowNode
+		}{
+			{name: "没有节点", nodes: nil},
+			{name: "节点名为空", nodes: []*model.WorkflowNode{node("")}},
+			{name: "节点名重复", nodes: []*model.WorkflowNode{node("probe"), node("probe")}},
+			{name: "依赖不存在的节点", nodes: []*model.WorkflowNode{node("probe"), node("transcode", "unknown")}},
+			{name: "依赖自身", nodes: []*model.WorkflowNode{node("probe", "probe")}},
+			{name: "两个节点成环", nodes: []*model.WorkflowNode{node("a", "b"), node("b", "a")}},
+			{name: "多个节点成环", nodes: []*model.WorkflowNode{
+				node("probe"), node("a", "probe", "c"), node("b", "a"), node("c", "b"),
+			}},
+		}
+
+		for _, c := range cases {
+			Convey(c.name, func() {
+				_, err := b.WorkflowBiz.RegisterWorkflow(context.Background(), &RegisterWorkflowRequest{
+					Namespace: "test",
+					Nodes:     c.nodes,
+				})
+				So(errors.Is(err, global.ErrInvalidWorkflow), ShouldBeTrue)
+			})
+		}
+	})
+}
+
+func TestWorkflowRelease(t *testing.T) {
+	Convey("测试上游全部成功后放行下游", t, func() {
+		var (
+			b   = newTestBiz()
+			ctx = context.Background()
+		)
+
+		// probe -> (transcode_720, transcode_1080) -> thumbnail
+		var resp *RegisterWorkflowResponse
+		err := b.Tx(ctx, func(ctx context.Context) error {
+			var txErr error
+			resp, txErr = b.WorkflowBiz.RegisterWorkflow(ctx, &RegisterWorkflowRequest{
+				Namespace: "test",
+				Nodes: []*model.WorkflowNode{
+					node("thumbnail", "transcode_720", "transcode_1080"),
+					node("transcode_720", "probe"),
+					node("transcode_1080", "probe"),
+					node("probe"),
+				},
+			})
+			return txErr
+		})
+		So(err, ShouldBeNil)
+		So(resp.TaskIds, ShouldHaveLength, 4)
+
+		tasks, err := b.WorkflowBiz.GetWorkflowTasks(ctx, resp.WorkflowId)
+		So(err, ShouldBeNil)
+		states := make(map[string]model.TaskState, len(tasks))
+		for _, task := range tasks {
+			states[task.WorkflowNode] = task.State
+		}
+		So(states, ShouldResemble, map[string]model.TaskState{
+			"probe":          model.TaskStateInited,
+			"transcode_720":  model.TaskStateBlocked,
+			"transcode_1080": model.TaskStateBlocked,
+			"thumbnail":      model.TaskStateBlocked,
+		})
+
+		finishTask(b, resp.TaskIds["probe"], true, []byte(`{"duration":10}`))
+		So(tryRelease(b, resp.TaskIds["transcode_720"]), ShouldEqual, model.TaskStateInited)
+		So(tryRelease(b, resp.TaskIds["transcode_1080"]), ShouldEqual, model.TaskStateInited)
+
+		released, err := b.TaskBiz.GetTask(ctx, resp.TaskIds["transcode_720"])
+		So(err, ShouldBeNil)
+		So(released.Settings.ParentOutputs, ShouldResemble, map[string][]byte{
+			"probe": []byte(`{"duration":10}`),
+		})
+
+		Convey("部分上游完成时继续等待", func() {
+			finishTask(b, resp.TaskIds["transcode_720"], true, []byte(`"720"`))
+			So(tryRelease(b, resp.TaskIds["thumbnail"]), ShouldEqual, model.TaskStateBlocked)
+
+			finishTask(b, resp.TaskIds["transcode_1080"], true, []byte(`"1080"`))
+			So(tryRelease(b, resp.TaskIds["thumbnail"]), ShouldEqual, model.TaskStateInited)
+
+			thumbnail, err := b.TaskBiz.GetTask(ctx, resp.TaskIds["thumbnail"])
+			So(err, ShouldBeNil)
+			So(thumbnail.State, ShouldEqual, model.TaskStateInited)
+			So(thumbnail.Settings.ParentOutputs, ShouldResemble, map[string][]byte{
+				"transcode_720":  []byte(`"720"`),
+				"transcode_1080": []byte(`"1080"`),
+			})
+
+			// 重复放行不改变状态
+			So(tryRelease(b, resp.TaskIds["thumbnail"]), ShouldEqual, model.TaskStateInited)
+		})
+
+		Convey("上游失败时终止下游", func() {
+			finishTask(b, resp.TaskIds["transcode_720"], true, nil)
+			finishTask(b, resp.TaskIds["transcode_1080"], false, []byte("unsupported codec"))
+			So(tryRelease(b, resp.TaskIds["thumbnail"]), ShouldEqual, model.TaskStateAborted)
+
+			thumbnail, err := b.TaskBiz.GetTask(ctx, resp.TaskIds["thumbnail"])
+			So(err, ShouldBeNil)
+			So(thumbnail.State, ShouldEqual, model.TaskStateAborted)
+		})
+	})
+}
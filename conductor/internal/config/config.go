@@ -37,6 +37,12 @@ type Config struct {
 
 	// Worker 配置
 	WorkerConfig WorkerConfig `json:"worker_config"`
+
+	// 任务配置
+	TaskConfig TaskConfig `json:"task_config"`
+
+	// 回调配置
+	CallbackConfig CallbackConfig `json:"callback_config"`
 }
 
 type ScanConfig struct {
@@ -46,6 +52,10 @@ type ScanConfig struct {
 	RetryInterval time.Duration `json:"retry_interval,omitempty"`
 	// 过期扫描间隔，默认 10s
 	ExpireInterval time.Duration `json:"expire_interval,omitempty"`
+	// 定时任务触发扫描间隔，默认 1s
+	ScheduleInterval time.Duration `json:"schedule_interval,omitempty"`
+	// 回调投递扫描间隔，默认 1s
+	CallbackInterval time.Duration `json:"callback_interval,omitempty"`
 }
 
 func (c *ScanConfig) GetProcessInterval() time.Duration {
@@ -69,6 +79,20 @@ func (c *ScanConfig) GetExpireInterval() time.Duration {
 	return c.ExpireInterval
 }
 
+func (c *ScanConfig) GetScheduleInterval() time.Duration {
+	if c.ScheduleInterval <= 0 {
+		return 1 * time.Second
+	}
+	return c.ScheduleInterval
+}
+
+func (c *ScanConfig) GetCallbackInterval() time.Duration {
+	if c.CallbackInterval <= 0 {
+		return 1 * time.Second
+	}
+	return c.CallbackInterval
+}
+
 type ShardConfig struct {
 	// 抢占重试间隔，默认 500ms
 	ClaimRetryInterval time.Duration `json:"claim_retry_interval,omitempty"`
@@ -93,6 +117,10 @@ func (c *ShardConfig) GetCheckInterval() time.Duration {
 type WorkerConfig struct {
 	// 长轮询超时时间，默认 30s
 	LongPollTimeout time.Duration `json:"long_poll_timeout,omitempty"`
+	// 心跳超时时间 超过该时间未轮询视为离线，默认 90s
+	HeartbeatTimeout time.Duration `json:"heartbeat_timeout,omitempty"`
+	// 离线 worker 保留时长 超过后从注册表删除，默认 24h
+	OfflineRetention time.Duration `json:"offline_retention,omitempty"`
 }
 
 func (c *WorkerConfig) GetLongPollTimeout() time.Duration {
@@ -102,6 +130,121 @@ func (c *WorkerConfig) GetLongPollTimeout() time.Duration {
 	return c.LongPollTimeout
 }
 
+func (c *WorkerConfig) GetHeartbeatTimeout() time.Duration {
+	if c.HeartbeatTimeout <= 0 {
+		return 90 * time.Second
+	}
+	return c.HeartbeatTimeout
+}
+
+func (c *WorkerConfig) GetOfflineRetention() time.Duration {
+	if c.OfflineRetention <= 0 {
+		return 24 * time.Hour
+	}
+	return c.OfflineRetention
+}
+
+type TaskConfig struct {
+	// 幂等键去重窗口，默认 24h
+	IdempotencyWindow time.Duration `json:"idempotency_window,omitempty"`
+	// 执行租约时长 worker 超过该时间未上报心跳时回收任务，默认 60s
+	LeaseTimeout time.Duration `json:"lease_timeout,omitempty"`
+	// 执行超时时间 从 worker 接受任务开始计算，默认不限制
+	ExecutionTimeout time.Duration `json:"execution_timeout,omitempty"`
+	// 按任务类型覆盖租约时长和执行超时时间
+	TaskTypes []TaskTypeConfig `json:"task_types,omitempty"`
+}
+
+type TaskTypeConfig struct {
+	TaskType string `json:"task_type"`
+	// 执行租约时长，为空时使用 TaskConfig.LeaseTimeout
+	LeaseTimeout time.Duration `json:"lease_timeout,omitempty"`
+	// 执行超时时间，为空时使用 TaskConfig.ExecutionTimeout
+	ExecutionTimeout time.Duration `json:"execution_timeout,omitempty"`
+}
+
+func (c *TaskConfig) GetIdempotencyWindow() time.Duration {
+	if c.IdempotencyWindow <= 0 {
+		return 24 * time.Hour
+	}
+	return c.IdempotencyWindow
+}
+
+func (c *TaskConfig) getTaskType(taskType string) *TaskTypeConfig {
+	for i := range c.TaskTypes {
+		if c.TaskTypes[i].TaskType == taskType {
+			return &c.TaskTypes[i]
+		}
+	}
+	return nil
+}
+
+func (c *TaskConfig) GetLeaseTimeout(taskType string) time.Duration {
+	if tc := c.getTaskType(taskType); tc != nil && tc.LeaseTimeout > 0 {
+		return tc.LeaseTimeout
+	}
+	if c.LeaseTimeout <= 0 {
+		return 60 * time.Second
+	}
+	return c.LeaseTimeout
+}
+
+// GetExecutionTimeout 获取任务类型的执行超时时间 返回 0 表示不限制
+func (c *TaskConfig) GetExecutionTimeout(taskType string) time.Duration {
+	if tc := c.getTaskType(taskType); tc != nil && tc.ExecutionTimeout > 0 {
+		return tc.ExecutionTimeout
+	}
+	return max(c.ExecutionTimeout, 0)
+}
+
+type CallbackConfig struct {
+	// 最大投递次数，默认 10
+	MaxAttempts int32 `json:"max_attempts,omitempty"`
+	// 单次投递超时时间，默认 10s
+	Timeout time.Duration `json:"timeout,omitempty"`
+	// 同时投递的回调数，默认 16
+	Concurrency int `json:"concurrency,omitempty"`
+	// 重试退避的初始间隔，默认 5s
+	BackoffBase time.Duration `json:"backoff_base,omitempty"`
+	// 重试退避的最大间隔，默认 10m
+	BackoffMax time.Duration `json:"backoff_max,omitempty"`
+}
+
+func (c *CallbackConfig) GetMaxAttempts() int32 {
+	if c.MaxAttempts <= 0 {
+		return 10
+	}
+	return c.MaxAttempts
+}
+
+func (c *CallbackConfig) GetTimeout() time.Duration {
+	if c.Timeout <= 0 {
+		return 10 * time.Second
+	}
+	return c.Timeout
+}
+
+func (c *CallbackConfig) GetConcurrency() int {
+	if c.Concurrency <= 0 {
+		return 16
+	}
+	return c.Concurrency
+}
+
+func (c *CallbackConfig) GetBackoffBase() time.Duration {
+	if c.BackoffBase <= 0 {
+		return 5 * time.Second
+	}
+	return c.BackoffBase
+}
+
+func (c *CallbackConfig) GetBackoffMax() time.Duration {
+	if c.BackoffMax <= 0 {
+		return 10 * time.Minute
+	}
+	return c.BackoffMax
+}
+
 type KafkaConfig struct {
 	Brokers  string `json:"brokers"`
 	Username string `json:"username"`
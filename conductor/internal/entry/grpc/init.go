@@ -3,6 +3,7 @@ package grpc
 import (
 	"github.com/ryanreadbooks/whimer/conductor/internal/service"
 	namespaceservice "github.com/ryanreadbooks/whimer/idl/gen/go/conductor/api/namespaceservice/v1"
+	scheduleservice "github.com/ryanreadbooks/whimer/idl/gen/go/conductor/api/scheduleservice/v1"
 	taskservice "github.com/ryanreadbooks/whimer/idl/gen/go/conductor/api/taskservice/v1"
 	workerservice "github.com/ryanreadbooks/whimer/idl/gen/go/conductor/api/workerservice/v1"
 	"github.com/ryanreadbooks/whimer/misc/xgrpc"
@@ -13,13 +14,20 @@ import (
 
 func Init(c zrpc.RpcServerConf, srv *service.Service) *zrpc.RpcServer {
 	server := zrpc.MustNewServer(c, func(s *grpc.Server) {
-		namespaceservice.RegisterNamespaceServiceServer(s, NewNamespaceServiceServer(srv))
-		taskservice.RegisterTaskServiceServer(s, NewTaskServiceServer(srv))
-		workerservice.RegisterWorkerServiceServer(s, NewWorkerServiceServer(srv))
+		Register(s, srv)
 		xgrpc.EnableReflectionIfNecessary(c, s)
 	})
 
 	interceptor.InstallUnaryServerInterceptors(server)
+	interceptor.InstallStreamServerInterceptors(server)
 
 	return server
 }
+
+// Register 注册所有 gRPC 服务
+func Register(s *grpc.Server, srv *service.Service) {
+	namespaceservice.RegisterNamespaceServiceServer(s, NewNamespaceServiceServer(srv))
+	taskservice.RegisterTaskServiceServer(s, NewTaskServiceServer(srv))
+	workerservice.RegisterWorkerServiceServer(s, NewWorkerServiceServer(srv))
+	scheduleservice.RegisterScheduleServiceServer(s, NewScheduleServiceServer(srv))
+}
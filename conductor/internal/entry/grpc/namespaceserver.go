@@ -3,6 +3,7 @@ package grpc
 import (
 	"context"
 
+	"github.com/ryanreadbooks/whimer/conductor/internal/biz/model"
 	"github.com/ryanreadbooks/whimer/conductor/internal/service"
 	namespacev1 "github.com/ryanreadbooks/whimer/idl/gen/go/conductor/api/namespace/v1"
 	namespaceservice "github.com/ryanreadbooks/whimer/idl/gen/go/conductor/api/namespaceservice/v1"
@@ -20,19 +21,66 @@ func NewNamespaceServiceServer(srv *service.Service) *NamespaceServiceServer {
 	}
 }
 
+func namespaceToProto(ns *model.Namespace) *namespacev1.Namespace {
+	return &namespacev1.Namespace{
+		Id:          ns.Id,
+		Name:        ns.Name,
+		Weight:      ns.Weight,
+		MaxInflight: ns.MaxInflight,
+	}
+}
+
 // CreateNamespace 创建命名空间
 func (s *NamespaceServiceServer) CreateNamespace(ctx context.Context,
 	in *namespaceservice.CreateNamespaceRequest) (*namespaceservice.CreateNamespaceResponse, error) {
-	ns, err := s.srv.NamespaceService.CreateNamespace(ctx, in.Name)
+	ns, err := s.srv.NamespaceService.CreateNamespace(ctx, &service.NamespaceReq{
+		Name:        in.Name,
+		Weight:      in.Weight,
+		MaxInflight: in.MaxInflight,
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	// 回调签名密钥只在创建和重置时返回
+	pbNs := namespaceToProto(ns)
+	pbNs.CallbackSecret = ns.CallbackSecret
+
 	return &namespaceservice.CreateNamespaceResponse{
-		Namespace: &namespacev1.Namespace{
-			Id:   ns.Id,
-			Name: ns.Name,
-		},
+		Namespace: pbNs,
+	}, nil
+}
+
+// UpdateNamespace 更新命名空间的分发权重和配额
+func (s *NamespaceServiceServer) UpdateNamespace(ctx context.Context,
+	in *namespaceservice.UpdateNamespaceRequest) (*namespaceservice.UpdateNamespaceResponse, error) {
+	ns, err := s.srv.NamespaceService.UpdateNamespace(ctx, &service.NamespaceReq{
+		Name:        in.Name,
+		Weight:      in.Weight,
+		MaxInflight: in.MaxInflight,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &namespaceservice.UpdateNamespaceResponse{
+		Namespace: namespaceToProto(ns),
+	}, nil
+}
+
+// ResetCallbackSecret 重置回调签名密钥
+func (s *NamespaceServiceServer) ResetCallbackSecret(ctx context.Context,
+	in *namespaceservice.ResetCallbackSecretRequest) (*namespaceservice.ResetCallbackSecretResponse, error) {
+	ns, err := s.srv.NamespaceService.ResetCallbackSecret(ctx, in.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	pbNs := namespaceToProto(ns)
+	pbNs.CallbackSecret = ns.CallbackSecret
+
+	return &namespaceservice.ResetCallbackSecretResponse{
+		Namespace: pbNs,
 	}, nil
 }
 
@@ -46,10 +94,7 @@ func (s *NamespaceServiceServer) ListNamespace(ctx context.Context,
 
 	namespaces := make([]*namespacev1.Namespace, 0, len(result.Namespaces))
 	for _, ns := range result.Namespaces {
-		namespaces = append(namespaces, &namespacev1.Namespace{
-			Id:   ns.Id,
-			Name: ns.Name,
-		})
+		namespaces = append(namespaces, namespaceToProto(ns))
 	}
 
 	return &namespaceservice.ListNamespaceResponse{
@@ -0,0 +1,128 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/ryanreadbooks/whimer/conductor/internal/biz/model"
+	"github.com/ryanreadbooks/whimer/conductor/internal/service"
+	schedulev1 "github.com/ryanreadbooks/whimer/idl/gen/go/conductor/api/schedule/v1"
+	scheduleservice "github.com/ryanreadbooks/whimer/idl/gen/go/conductor/api/scheduleservice/v1"
+)
+
+type ScheduleServiceServer struct {
+	scheduleservice.UnimplementedScheduleServiceServer
+
+	srv *service.Service
+}
+
+func NewScheduleServiceServer(srv *service.Service) *ScheduleServiceServer {
+	return &ScheduleServiceServer{
+		srv: srv,
+	}
+}
+
+func scheduleToProto(s *model.Schedule) *schedulev1.Schedule {
+	return &schedulev1.Schedule{
+		Id:           s.Id.String(),
+		Namespace:    s.Namespace,
+		Name:         s.Name,
+		TaskType:     s.TaskType,
+		CronExpr:     s.CronExpr,
+		InputArgs:    s.InputArgs,
+		CallbackUrl:  s.CallbackUrl,
+		MaxRetryCnt:  s.MaxRetryCnt,
+		ExpireAfter:  s.ExpireAfter,
+		State:        string(s.State),
+		NextFireTime: s.NextFireTime,
+		LastFireTime: s.LastFireTime,
+		Ctime:        s.Ctime,
+		Utime:        s.Utime,
+	}
+}
+
+// UpsertSchedule 创建或更新定时任务
+func (s *ScheduleServiceServer) UpsertSchedule(ctx context.Context,
+	in *scheduleservice.UpsertScheduleRequest) (*scheduleservice.UpsertScheduleResponse, error) {
+	schedule, err := s.srv.ScheduleService.UpsertSchedule(ctx, &service.UpsertScheduleReq{
+		Namespace:   in.Namespace,
+		Name:        in.Name,
+		TaskType:    in.TaskType,
+		CronExpr:    in.CronExpr,
+		InputArgs:   in.InputArgs,
+		CallbackUrl: in.CallbackUrl,
+		MaxRetryCnt: in.MaxRetryCnt,
+		ExpireAfter: in.ExpireAfter,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &scheduleservice.UpsertScheduleResponse{
+		Schedule: scheduleToProto(schedule),
+	}, nil
+}
+
+// GetSchedule 获取定时任务
+func (s *ScheduleServiceServer) GetSchedule(ctx context.Context,
+	in *scheduleservice.GetScheduleRequest) (*scheduleservice.GetScheduleResponse, error) {
+	schedule, err := s.srv.ScheduleService.GetSchedule(ctx, in.Namespace, in.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &scheduleservice.GetScheduleResponse{
+		Schedule: scheduleToProto(schedule),
+	}, nil
+}
+
+// ListSchedule 分页列出定时任务
+func (s *ScheduleServiceServer) ListSchedule(ctx context.Context,
+	in *scheduleservice.ListScheduleRequest) (*scheduleservice.ListScheduleResponse, error) {
+	result, err := s.srv.ScheduleService.ListSchedule(ctx, in.Namespace, int(in.Page), int(in.Count))
+	if err != nil {
+		return nil, err
+	}
+
+	schedules := make([]*schedulev1.Schedule, 0, len(result.Schedules))
+	for _, schedule := range result.Schedules {
+		schedules = append(schedules, scheduleToProto(schedule))
+	}
+
+	return &scheduleservice.ListScheduleResponse{
+		Schedules: schedules,
+		Total:     result.Total,
+	}, nil
+}
+
+// PauseSchedule 暂停定时任务
+func (s *ScheduleServiceServer) PauseSchedule(ctx context.Context,
+	in *scheduleservice.PauseScheduleRequest) (*scheduleservice.PauseScheduleResponse, error) {
+	err := s.srv.ScheduleService.PauseSchedule(ctx, in.Namespace, in.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &scheduleservice.PauseScheduleResponse{}, nil
+}
+
+// ResumeSchedule 恢复定时任务
+func (s *ScheduleServiceServer) ResumeSchedule(ctx context.Context,
+	in *scheduleservice.ResumeScheduleRequest) (*scheduleservice.ResumeScheduleResponse, error) {
+	err := s.srv.ScheduleService.ResumeSchedule(ctx, in.Namespace, in.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &scheduleservice.ResumeScheduleResponse{}, nil
+}
+
+// DeleteSchedule 删除定时任务
+func (s *ScheduleServiceServer) DeleteSchedule(ctx context.Context,
+	in *scheduleservice.DeleteScheduleRequest) (*scheduleservice.DeleteScheduleResponse, error) {
+	err := s.srv.ScheduleService.DeleteSchedule(ctx, in.Namespace, in.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &scheduleservice.DeleteScheduleResponse{}, nil
+}
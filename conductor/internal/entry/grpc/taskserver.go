@@ -3,6 +3,7 @@ package grpc
 import (
 	"context"
 
+	"github.com/ryanreadbooks/whimer/conductor/internal/biz/model"
 	"github.com/ryanreadbooks/whimer/conductor/internal/service"
 	taskv1 "github.com/ryanreadbooks/whimer/idl/gen/go/conductor/api/task/v1"
 	taskservice "github.com/ryanreadbooks/whimer/idl/gen/go/conductor/api/taskservice/v1"
@@ -20,6 +21,38 @@ func NewTaskServiceServer(srv *service.Service) *TaskServiceServer {
 	}
 }
 
+func taskToProto(task *model.Task) *taskv1.Task {
+	t := &taskv1.Task{
+		Id:           task.Id.String(),
+		Namespace:    task.Namespace,
+		TaskType:     task.TaskType,
+		InputArgs:    task.InputArgs,
+		OutputArgs:   task.OutputArgs,
+		CallbackUrl:  task.CallbackUrl,
+		State:        string(task.State.ExternalState()),
+		MaxRetryCnt:  task.MaxRetryCnt,
+		ExpireTime:   task.ExpireTime,
+		NotBefore:    task.NotBefore,
+		Ctime:        task.Ctime,
+		Utime:        task.Utime,
+		TraceId:      task.TraceId,
+		WorkflowNode: task.WorkflowNode,
+
+		InternalState: string(task.State),
+		CurRetryCnt:   task.CurRetryCnt,
+		Priority:      task.Priority,
+
+		CallbackGrpcTarget: task.Settings.CallbackGrpcTarget,
+		WorkerSelector:     task.Settings.WorkerSelector,
+		WorkerId:           task.WorkerId,
+	}
+	if task.InWorkflow() {
+		t.WorkflowId = task.WorkflowId.String()
+	}
+
+	return t
+}
+
 // RegisterTask 注册任务
 func (s *TaskServiceServer) RegisterTask(ctx context.Context,
 	in *taskservice.RegisterTaskRequest) (*taskservice.RegisterTaskResponse, error) {
@@ -28,6 +61,10 @@ func (s *TaskServiceServer) RegisterTask(ctx context.Context,
 	if in.ExpireTime != nil {
 		expireTime = in.ExpireTime.AsTime().UnixMilli()
 	}
+	var notBefore int64
+	if in.NotBefore != nil {
+		notBefore = in.NotBefore.AsTime().UnixMilli()
+	}
 
 	resp, err := s.srv.TaskService.RegisterTask(ctx, &service.RegisterTaskReq{
 		TaskType:    in.TaskType,
@@ -36,13 +73,20 @@ func (s *TaskServiceServer) RegisterTask(ctx context.Context,
 		CallbackUrl: in.CallbackUrl,
 		MaxRetryCnt: in.MaxRetryCnt,
 		ExpireTime:  expireTime,
+		NotBefore:   notBefore,
+		Priority:    in.Priority,
+
+		CallbackGrpcTarget: in.CallbackGrpcTarget,
+		IdempotencyKey:     in.IdempotencyKey,
+		WorkerSelector:     in.WorkerSelector,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	return &taskservice.RegisterTaskResponse{
-		TaskId: resp.TaskId,
+		TaskId:     resp.TaskId,
+		Duplicated: resp.Duplicated,
 	}, nil
 }
 
@@ -55,20 +99,7 @@ func (s *TaskServiceServer) GetTask(ctx context.Context,
 	}
 
 	return &taskservice.GetTaskResponse{
-		Task: &taskv1.Task{
-			Id:          task.Id.String(),
-			Namespace:   task.Namespace,
-			TaskType:    task.TaskType,
-			InputArgs:   task.InputArgs,
-			OutputArgs:  task.OutputArgs,
-			CallbackUrl: task.CallbackUrl,
-			State:       string(task.State.ExternalState()),
-			MaxRetryCnt: task.MaxRetryCnt,
-			ExpireTime:  task.ExpireTime,
-			Ctime:       task.Ctime,
-			Utime:       task.Utime,
-			TraceId:     task.TraceId,
-		},
+		Task: taskToProto(task),
 	}, nil
 }
 
@@ -82,3 +113,208 @@ func (s *TaskServiceServer) AbortTask(ctx context.Context,
 
 	return &taskservice.AbortTaskResponse{}, nil
 }
+
+// RegisterWorkflow 注册工作流
+func (s *TaskServiceServer) RegisterWorkflow(ctx context.Context,
+	in *taskservice.RegisterWorkflowRequest) (*taskservice.RegisterWorkflowResponse, error) {
+	nodes := make([]*model.WorkflowNode, 0, len(in.Nodes))
+	for _, node := range in.Nodes {
+		nodes = append(nodes, &model.WorkflowNode{
+			Name:               node.Name,
+			TaskType:           node.TaskType,
+			InputArgs:          node.InputArgs,
+			CallbackUrl:        node.CallbackUrl,
+			CallbackGrpcTarget: node.CallbackGrpcTarget,
+			MaxRetryCnt:        node.MaxRetryCnt,
+			ExpireAfter:        node.ExpireAfter,
+			DependsOn:          node.DependsOn,
+			WorkerSelector:     node.WorkerSelector,
+		})
+	}
+
+	resp, err := s.srv.TaskService.RegisterWorkflow(ctx, &service.RegisterWorkflowReq{
+		Namespace: in.Namespace,
+		Nodes:     nodes,
+		Priority:  in.Priority,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &taskservice.RegisterWorkflowResponse{
+		WorkflowId: resp.WorkflowId,
+		TaskIds:    resp.TaskIds,
+	}, nil
+}
+
+// GetWorkflow 获取工作流
+func (s *TaskServiceServer) GetWorkflow(ctx context.Context,
+	in *taskservice.GetWorkflowRequest) (*taskservice.GetWorkflowResponse, error) {
+	resp, err := s.srv.TaskService.GetWorkflow(ctx, in.WorkflowId)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]*taskv1.Task, 0, len(resp.Tasks))
+	for _, task := range resp.Tasks {
+		tasks = append(tasks, taskToProto(task))
+	}
+
+	return &taskservice.GetWorkflowResponse{
+		WorkflowId: resp.WorkflowId,
+		State:      string(resp.State),
+		Tasks:      tasks,
+	}, nil
+}
+
+// ListTasks 按条件分页列出任务
+func (s *TaskServiceServer) ListTasks(ctx context.Context,
+	in *taskservice.ListTasksRequest) (*taskservice.ListTasksResponse, error) {
+	var ctimeStart, ctimeEnd int64
+	if in.CtimeStart != nil {
+		ctimeStart = in.CtimeStart.AsTime().UnixMilli()
+	}
+	if in.CtimeEnd != nil {
+		ctimeEnd = in.CtimeEnd.AsTime().UnixMilli()
+	}
+
+	resp, err := s.srv.TaskService.ListTasks(ctx, &service.ListTasksReq{
+		Namespace:  in.Namespace,
+		TaskType:   in.TaskType,
+		State:      in.State,
+		CtimeStart: ctimeStart,
+		CtimeEnd:   ctimeEnd,
+		Cursor:     in.Cursor,
+		Count:      in.Count,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]*taskv1.Task, 0, len(resp.Tasks))
+	for _, task := range resp.Tasks {
+		tasks = append(tasks, taskToProto(task))
+	}
+
+	return &taskservice.ListTasksResponse{
+		Tasks:      tasks,
+		NextCursor: resp.NextCursor,
+		HasMore:    resp.HasMore,
+	}, nil
+}
+
+// RetryTask 人工重试任务
+func (s *TaskServiceServer) RetryTask(ctx context.Context,
+	in *taskservice.RetryTaskRequest) (*taskservice.RetryTaskResponse, error) {
+	var expireTime int64
+	if in.ExpireTime != nil {
+		expireTime = in.ExpireTime.AsTime().UnixMilli()
+	}
+
+	err := s.srv.TaskService.RetryTask(ctx, in.TaskId, expireTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &taskservice.RetryTaskResponse{}, nil
+}
+
+// BatchAbortTask 批量终止任务
+func (s *TaskServiceServer) BatchAbortTask(ctx context.Context,
+	in *taskservice.BatchAbortTaskRequest) (*taskservice.BatchAbortTaskResponse, error) {
+	resp, err := s.srv.TaskService.BatchAbortTask(ctx, in.TaskIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &taskservice.BatchAbortTaskResponse{
+		AbortedTaskIds: resp.AbortedTaskIds,
+		FailedTaskIds:  resp.FailedTaskIds,
+	}, nil
+}
+
+// GetTaskHistory 获取任务状态变更记录
+func (s *TaskServiceServer) GetTaskHistory(ctx context.Context,
+	in *taskservice.GetTaskHistoryRequest) (*taskservice.GetTaskHistoryResponse, error) {
+	histories, err := s.srv.TaskService.GetTaskHistory(ctx, in.TaskId)
+	if err != nil {
+		return nil, err
+	}
+
+	pbHistories := make([]*taskv1.TaskHistory, 0, len(histories))
+	for _, history := range histories {
+		pbHistories = append(pbHistories, &taskv1.TaskHistory{
+			State: string(history.State),
+			Ctime: history.Ctime,
+		})
+	}
+
+	return &taskservice.GetTaskHistoryResponse{
+		Histories: pbHistories,
+	}, nil
+}
+
+// GetTaskCallbacks 获取任务的回调投递记录
+func (s *TaskServiceServer) GetTaskCallbacks(ctx context.Context,
+	in *taskservice.GetTaskCallbacksRequest) (*taskservice.GetTaskCallbacksResponse, error) {
+	callbacks, err := s.srv.TaskService.GetTaskCallbacks(ctx, in.TaskId)
+	if err != nil {
+		return nil, err
+	}
+
+	pbCallbacks := make([]*taskv1.TaskCallback, 0, len(callbacks))
+	for _, cb := range callbacks {
+		pbCallbacks = append(pbCallbacks, &taskv1.TaskCallback{
+			Id:            cb.Id.String(),
+			Target:        cb.Target,
+			TargetType:    string(cb.TargetType),
+			State:         string(cb.State),
+			Attempts:      cb.Attempts,
+			MaxAttempts:   cb.MaxAttempts,
+			NextRetryTime: cb.NextRetryTime,
+			LastError:     cb.LastError,
+			Ctime:         cb.Ctime,
+			Utime:         cb.Utime,
+		})
+	}
+
+	return &taskservice.GetTaskCallbacksResponse{
+		Callbacks: pbCallbacks,
+	}, nil
+}
+
+func taskEventToProto(event *model.TaskEvent) *taskv1.TaskEvent {
+	e := &taskv1.TaskEvent{
+		Type:          taskv1.TaskEventType_TASK_EVENT_TYPE_STATE,
+		TaskId:        event.TaskId.String(),
+		Namespace:     event.Namespace,
+		TaskType:      event.TaskType,
+		State:         string(event.State.ExternalState()),
+		InternalState: string(event.State),
+		Time:          event.Time,
+	}
+	if event.Type == model.TaskEventProgress {
+		e.Type = taskv1.TaskEventType_TASK_EVENT_TYPE_PROGRESS
+		e.Progress = event.Progress
+	}
+
+	return e
+}
+
+// WatchTask 订阅单个任务的事件
+func (s *TaskServiceServer) WatchTask(in *taskservice.WatchTaskRequest,
+	stream taskservice.TaskService_WatchTaskServer) error {
+	return s.srv.TaskService.WatchTask(stream.Context(), in.TaskId,
+		func(event *model.TaskEvent) error {
+			return stream.Send(taskEventToProto(event))
+		})
+}
+
+// WatchNamespace 订阅命名空间下任务的事件
+func (s *TaskServiceServer) WatchNamespace(in *taskservice.WatchNamespaceRequest,
+	stream taskservice.TaskService_WatchNamespaceServer) error {
+	return s.srv.TaskService.WatchNamespace(stream.Context(), in.Namespace, in.TaskTypes,
+		func(event *model.TaskEvent) error {
+			return stream.Send(taskEventToProto(event))
+		})
+}
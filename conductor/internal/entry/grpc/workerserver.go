@@ -5,6 +5,7 @@ import (
 
 	"github.com/ryanreadbooks/whimer/conductor/internal/service"
 	taskv1 "github.com/ryanreadbooks/whimer/idl/gen/go/conductor/api/task/v1"
+	workerv1 "github.com/ryanreadbooks/whimer/idl/gen/go/conductor/api/worker/v1"
 	workerservice "github.com/ryanreadbooks/whimer/idl/gen/go/conductor/api/workerservice/v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -27,18 +28,27 @@ func (s *WorkerServiceServer) LongPoll(
 	in *workerservice.LongPollRequest,
 ) (*workerservice.LongPollResponse, error) {
 	var (
-		workerId string
-		taskType string
+		workerId    string
+		taskType    string
+		ip          string
+		labels      map[string]string
+		concurrency int32
 	)
 
 	if in.Worker != nil {
 		workerId = in.GetWorker().GetId()
 		taskType = in.GetWorker().GetAbility().GetTaskType()
+		ip = in.GetWorker().GetMetadata().GetIp()
+		labels = in.GetWorker().GetLabels()
+		concurrency = in.GetWorker().GetConcurrency()
 	}
 
 	resp, err := s.srv.WorkerService.LongPoll(ctx, &service.LongPollRequest{
-		WorkerId: workerId,
-		TaskType: taskType,
+		WorkerId:    workerId,
+		TaskType:    taskType,
+		Ip:          ip,
+		Labels:      labels,
+		Concurrency: concurrency,
 	})
 	if err != nil {
 		return nil, err
@@ -49,20 +59,30 @@ func (s *WorkerServiceServer) LongPoll(
 		return &workerservice.LongPollResponse{}, nil
 	}
 
+	// 不返回 callbackurl 和 outputargs
+	task := &taskv1.Task{
+		Id:            resp.Task.Id.String(),
+		Namespace:     resp.Task.Namespace,
+		TaskType:      resp.Task.TaskType,
+		InputArgs:     resp.Task.InputArgs,
+		State:         string(resp.Task.State),
+		MaxRetryCnt:   resp.Task.MaxRetryCnt,
+		ExpireTime:    resp.Task.ExpireTime,
+		Ctime:         resp.Task.Ctime,
+		Utime:         resp.Task.Utime,
+		TraceId:       resp.Task.TraceId,
+		WorkflowNode:  resp.Task.WorkflowNode,
+		ParentOutputs: resp.Task.Settings.ParentOutputs,
+
+		WorkerSelector: resp.Task.Settings.WorkerSelector,
+		WorkerId:       resp.Task.WorkerId,
+	}
+	if resp.Task.InWorkflow() {
+		task.WorkflowId = resp.Task.WorkflowId.String()
+	}
+
 	return &workerservice.LongPollResponse{
-		// 不返回 callbackurl 和 outputargs
-		Task: &taskv1.Task{
-			Id:          resp.Task.Id.String(),
-			Namespace:   resp.Task.Namespace,
-			TaskType:    resp.Task.TaskType,
-			InputArgs:   resp.Task.InputArgs,
-			State:       string(resp.Task.State),
-			MaxRetryCnt: resp.Task.MaxRetryCnt,
-			ExpireTime:  resp.Task.ExpireTime,
-			Ctime:       resp.Task.Ctime,
-			Utime:       resp.Task.Utime,
-			TraceId:     resp.Task.TraceId,
-		},
+		Task: task,
 	}, nil
 }
 
@@ -72,7 +92,8 @@ func (s *WorkerServiceServer) AcceptTask(
 	in *workerservice.AcceptTaskRequest,
 ) (*workerservice.AcceptTaskResponse, error) {
 	err := s.srv.WorkerService.AcceptTask(ctx, &service.AcceptTaskRequest{
-		TaskId: in.TaskId,
+		TaskId:   in.TaskId,
+		WorkerId: in.WorkerId,
 	})
 	if err != nil {
 		return nil, err
@@ -92,6 +113,7 @@ func (s *WorkerServiceServer) CompleteTask(
 		OutputArgs: in.OutputArgs,
 		ErrorMsg:   in.ErrorMsg,
 		Retryable:  in.Retryable,
+		WorkerId:   in.WorkerId,
 	})
 	if err != nil {
 		return nil, err
@@ -108,6 +130,7 @@ func (s *WorkerServiceServer) ReportTask(
 	resp, err := s.srv.WorkerService.ReportTask(ctx, &service.ReportTaskRequest{
 		TaskId:   in.TaskId,
 		Progress: in.Progress,
+		WorkerId: in.WorkerId,
 	})
 	if err != nil {
 		return nil, err
@@ -124,3 +147,74 @@ func (s *WorkerServiceServer) ReportTask(
 
 	return pbResp, nil
 }
+
+// RegisterWorker worker 注册
+func (s *WorkerServiceServer) RegisterWorker(
+	ctx context.Context,
+	in *workerservice.RegisterWorkerRequest,
+) (*workerservice.RegisterWorkerResponse, error) {
+	err := s.srv.WorkerService.RegisterWorker(ctx, &service.RegisterWorkerRequest{
+		WorkerId:    in.Id,
+		Ip:          in.Ip,
+		TaskTypes:   in.TaskTypes,
+		Labels:      in.Labels,
+		Concurrency: in.Concurrency,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &workerservice.RegisterWorkerResponse{}, nil
+}
+
+// UnregisterWorker worker 注销
+func (s *WorkerServiceServer) UnregisterWorker(
+	ctx context.Context,
+	in *workerservice.UnregisterWorkerRequest,
+) (*workerservice.UnregisterWorkerResponse, error) {
+	err := s.srv.WorkerService.UnregisterWorker(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &workerservice.UnregisterWorkerResponse{}, nil
+}
+
+// ListWorkers 列出已注册的 worker
+func (s *WorkerServiceServer) ListWorkers(
+	ctx context.Context,
+	in *workerservice.ListWorkersRequest,
+) (*workerservice.ListWorkersResponse, error) {
+	workers, err := s.srv.WorkerService.ListWorkers(ctx, &service.ListWorkersRequest{
+		TaskType:       in.TaskType,
+		Selector:       in.Selector,
+		IncludeOffline: in.IncludeOffline,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*workerv1.WorkerInfo, 0, len(workers))
+	for _, w := range workers {
+		taskIds := make([]string, 0, len(w.RunningTaskIds))
+		for _, id := range w.RunningTaskIds {
+			taskIds = append(taskIds, id.String())
+		}
+
+		infos = append(infos, &workerv1.WorkerInfo{
+			Id:             w.Id,
+			Ip:             w.Ip,
+			TaskTypes:      w.TaskTypes,
+			Labels:         w.Labels,
+			Concurrency:    w.Concurrency,
+			RegisterTime:   w.RegisterTime,
+			LastHeartbeat:  w.LastHeartbeat,
+			Alive:          s.srv.WorkerService.IsWorkerAlive(w),
+			RunningTaskIds: taskIds,
+		})
+	}
+
+	return &workerservice.ListWorkersResponse{
+		Workers: infos,
+	}, nil
+}
@@ -18,6 +18,16 @@ const (
 
 	ErrNamespaceAlreadyExistsCode = ErrInvalidArgsCode + iota
 	ErrNamespaceNotFoundCode
+	ErrScheduleNotFoundCode
+	ErrInvalidCronExprCode
+	ErrInvalidWorkflowCode
+	ErrWorkflowNotFoundCode
+	ErrTaskNotFoundCode
+	ErrTaskNotRetryableCode
+	ErrInvalidTaskStateCode
+	ErrInvalidCallbackTargetCode
+	ErrTaskNotAcceptableCode
+	ErrTaskNotAbortableCode
 )
 
 var (
@@ -31,4 +41,15 @@ var (
 	ErrPermDenied             = ErrBizDenied.Msg("操作权限不足")
 	ErrNamespaceAlreadyExists = ErrBizArgs.ErrCode(ErrNamespaceAlreadyExistsCode).Msg("命名空间已存在")
 	ErrNamespaceNotFound      = ErrBizArgs.ErrCode(ErrNamespaceNotFoundCode).Msg("命名空间不存在")
+	ErrScheduleNotFound       = ErrBizArgs.ErrCode(ErrScheduleNotFoundCode).Msg("定时任务不存在")
+	ErrInvalidCronExpr        = ErrBizArgs.ErrCode(ErrInvalidCronExprCode).Msg("cron表达式不合法")
+	ErrInvalidWorkflow        = ErrBizArgs.ErrCode(ErrInvalidWorkflowCode).Msg("工作流定义不合法")
+	ErrWorkflowNotFound       = ErrBizArgs.ErrCode(ErrWorkflowNotFoundCode).Msg("工作流不存在")
+	ErrTaskNotFound           = ErrBizArgs.ErrCode(ErrTaskNotFoundCode).Msg("任务不存在")
+	ErrTaskNotRetryable       = ErrBizArgs.ErrCode(ErrTaskNotRetryableCode).Msg("任务当前状态不可重试")
+	ErrInvalidTaskState       = ErrBizArgs.ErrCode(ErrInvalidTaskStateCode).Msg("任务状态不合法")
+	ErrInvalidCallbackTarget  = ErrBizArgs.ErrCode(ErrInvalidCallbackTargetCode).Msg("callback_url 与 callback_grpc_target 只能设置一个")
+	ErrTaskNotAcceptable      = ErrBizArgs.ErrCode(ErrTaskNotAcceptableCode).Msg("任务当前状态不可接受")
+	ErrTaskNotAbortable       = ErrBizArgs.ErrCode(ErrTaskNotAbortableCode).Msg("任务当前状态不可终止")
+	ErrWatchInterrupted       = ErrBizInternal.Msg("任务订阅中断, 请重新订阅")
 )
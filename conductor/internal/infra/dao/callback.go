@@ -0,0 +1,61 @@
+package dao
+
+import (
+	"github.com/ryanreadbooks/whimer/misc/uuid"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+)
+
+const (
+	callbackPOTableName = "conductor_callback"
+)
+
+var (
+	callbackPOFields = xsql.GetFieldSlice(&CallbackPO{})
+)
+
+// CallbackPO 任务回调投递记录 任务到达终态时与任务状态在同一事务中写入
+type CallbackPO struct {
+	Id            uuid.UUID `db:"id"              json:"id"`
+	TaskId        uuid.UUID `db:"task_id"         json:"task_id"`
+	Namespace     string    `db:"namespace"       json:"namespace"`
+	Shard         int       `db:"shard"           json:"shard"`           // 与任务的 task_type_shard 相同
+	Target        string    `db:"target"          json:"target"`          // http url 或 gRPC 目标地址
+	TargetType    string    `db:"target_type"     json:"target_type"`     // http, grpc
+	Payload       []byte    `db:"payload"         json:"payload"`         // 回调内容 json
+	State         string    `db:"state"           json:"state"`           // pending, success, failure
+	Attempts      int32     `db:"attempts"        json:"attempts"`        // 已投递次数
+	MaxAttempts   int32     `db:"max_attempts"    json:"max_attempts"`    // 最大投递次数
+	NextRetryTime int64     `db:"next_retry_time" json:"next_retry_time"` // 下次投递时间 unix ms
+	LastError     string    `db:"last_error"      json:"last_error"`      // 最近一次投递失败的原因
+	Ctime         int64     `db:"ctime"           json:"ctime"`
+	Utime         int64     `db:"utime"           json:"utime"`
+	Version       int64     `db:"version"         json:"version"`
+}
+
+func (CallbackPO) TableName() string {
+	return callbackPOTableName
+}
+
+func (s *CallbackPO) Values() []any {
+	payload := s.Payload
+	if s.Payload == nil {
+		payload = []byte{}
+	}
+	return []any{
+		s.Id,
+		s.TaskId,
+		s.Namespace,
+		s.Shard,
+		s.Target,
+		s.TargetType,
+		payload,
+		s.State,
+		s.Attempts,
+		s.MaxAttempts,
+		s.NextRetryTime,
+		s.LastError,
+		s.Ctime,
+		s.Utime,
+		s.Version,
+	}
+}
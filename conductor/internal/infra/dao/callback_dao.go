@@ -0,0 +1,149 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/huandu/go-sqlbuilder"
+	"github.com/ryanreadbooks/whimer/misc/uuid"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+)
+
+type CallbackDao struct {
+	db *xsql.DB
+}
+
+func NewCallbackDao(db *xsql.DB) *CallbackDao {
+	return &CallbackDao{
+		db: db,
+	}
+}
+
+func (d *CallbackDao) Insert(ctx context.Context, po *CallbackPO) error {
+	ib := sqlbuilder.NewInsertBuilder()
+	ib.InsertInto(callbackPOTableName)
+	ib.Cols(callbackPOFields...)
+	ib.Values(po.Values()...)
+
+	sql, args := ib.Build()
+	_, err := d.db.ExecCtx(ctx, sql, args...)
+	if err != nil {
+		return xsql.ConvertError(err)
+	}
+
+	return nil
+}
+
+func (d *CallbackDao) ListByTaskId(ctx context.Context, taskId uuid.UUID) ([]*CallbackPO, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+	sb.Select(callbackPOFields...)
+	sb.From(callbackPOTableName)
+	sb.Where(sb.Equal("task_id", taskId))
+	sb.OrderByAsc("id")
+
+	sql, args := sb.Build()
+	var pos []*CallbackPO
+	err := d.db.QueryRowsCtx(ctx, &pos, sql, args...)
+	if err != nil {
+		return nil, xsql.ConvertError(err)
+	}
+
+	return pos, nil
+}
+
+// ListDue 查询分片范围内已到投递时间的回调
+func (d *CallbackDao) ListDue(
+	ctx context.Context,
+	state string,
+	shardStart, shardEnd int, // [shardStart, shardEnd)
+	now int64,
+	limit int32,
+) ([]*CallbackPO, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+	sb.Select(callbackPOFields...)
+	sb.From(callbackPOTableName)
+	sb.Where(
+		sb.Equal("state", state),
+		sb.GreaterEqualThan("shard", shardStart),
+		sb.LessThan("shard", shardEnd),
+		sb.LessEqualThan("next_retry_time", now),
+	)
+	sb.OrderByAsc("next_retry_time")
+	sb.Limit(int(limit))
+
+	sql, args := sb.Build()
+	var pos []*CallbackPO
+	err := d.db.QueryRowsCtx(ctx, &pos, sql, args...)
+	if err != nil {
+		return nil, xsql.ConvertError(err)
+	}
+
+	return pos, nil
+}
+
+// Lease 占用回调的投递权 将下次投递时间推迟到 leaseUntil
+//
+// 以 version 做条件 返回是否占用成功；失败说明已被其它实例占用
+func (d *CallbackDao) Lease(
+	ctx context.Context,
+	id uuid.UUID,
+	version int64,
+	leaseUntil, utime int64,
+) (bool, error) {
+	ub := sqlbuilder.NewUpdateBuilder()
+	ub.Update(callbackPOTableName)
+	ub.Set(
+		ub.Assign("next_retry_time", leaseUntil),
+		ub.Assign("utime", utime),
+		ub.Incr("version"),
+	)
+	ub.Where(
+		ub.Equal("id", id),
+		ub.Equal("version", version),
+	)
+
+	sql, args := ub.Build()
+	res, err := d.db.ExecCtx(ctx, sql, args...)
+	if err != nil {
+		return false, xsql.ConvertError(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, xsql.ConvertError(err)
+	}
+
+	return affected > 0, nil
+}
+
+// UpdateResult 记录一次投递的结果
+//
+// 以 version 做条件 返回是否更新成功
+func (d *CallbackDao) UpdateResult(ctx context.Context, po *CallbackPO) (bool, error) {
+	ub := sqlbuilder.NewUpdateBuilder()
+	ub.Update(callbackPOTableName)
+	ub.Set(
+		ub.Assign("state", po.State),
+		ub.Assign("attempts", po.Attempts),
+		ub.Assign("next_retry_time", po.NextRetryTime),
+		ub.Assign("last_error", po.LastError),
+		ub.Assign("utime", po.Utime),
+		ub.Incr("version"),
+	)
+	ub.Where(
+		ub.Equal("id", po.Id),
+		ub.Equal("version", po.Version),
+	)
+
+	sql, args := ub.Build()
+	res, err := d.db.ExecCtx(ctx, sql, args...)
+	if err != nil {
+		return false, xsql.ConvertError(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, xsql.ConvertError(err)
+	}
+
+	return affected > 0, nil
+}
@@ -10,11 +10,41 @@ import (
 )
 
 type Dao struct {
-	db *xsql.DB
+	db *xsql.DB // 非 MySQL 实现时为 nil
+	tx Transactor
 
-	NamespaceDao   *NamespaceDao
-	TaskDao        *TaskDao
-	TaskHistoryDao *TaskHistoryDao
+	NamespaceDao   NamespaceStore
+	TaskDao        TaskStore
+	TaskHistoryDao TaskHistoryStore
+	ScheduleDao    ScheduleStore
+	IdempotencyDao IdempotencyStore
+	CallbackDao    CallbackStore
+	WorkerDao      WorkerStore
+}
+
+// Stores 自定义存储实现
+type Stores struct {
+	Namespace   NamespaceStore
+	Task        TaskStore
+	TaskHistory TaskHistoryStore
+	Schedule    ScheduleStore
+	Idempotency IdempotencyStore
+	Callback    CallbackStore
+	Worker      WorkerStore
+}
+
+// NewWithStores 使用自定义存储实现创建 Dao
+func NewWithStores(tx Transactor, s Stores) *Dao {
+	return &Dao{
+		tx:             tx,
+		NamespaceDao:   s.Namespace,
+		TaskDao:        s.Task,
+		TaskHistoryDao: s.TaskHistory,
+		ScheduleDao:    s.Schedule,
+		IdempotencyDao: s.Idempotency,
+		CallbackDao:    s.Callback,
+		WorkerDao:      s.Worker,
+	}
 }
 
 func MustNew(c *config.Config, cache *redis.Redis) *Dao {
@@ -39,9 +69,14 @@ func MustNew(c *config.Config, cache *redis.Redis) *Dao {
 
 	return &Dao{
 		db:             db,
+		tx:             db,
 		NamespaceDao:   NewNamespaceDao(db, cache),
 		TaskDao:        NewTaskDao(db),
 		TaskHistoryDao: NewTaskHistoryDao(db),
+		ScheduleDao:    NewScheduleDao(db),
+		IdempotencyDao: NewIdempotencyDao(db),
+		CallbackDao:    NewCallbackDao(db),
+		WorkerDao:      NewWorkerDao(db),
 	}
 }
 
@@ -50,5 +85,5 @@ func (d *Dao) DB() *xsql.DB {
 }
 
 func (d *Dao) Transact(ctx context.Context, fn func(ctx context.Context) error) error {
-	return d.db.Transact(ctx, fn)
+	return d.tx.Transact(ctx, fn)
 }
@@ -0,0 +1,37 @@
+package dao
+
+import (
+	"github.com/ryanreadbooks/whimer/misc/uuid"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+)
+
+const (
+	idempotencyPOTableName = "conductor_task_idempotency"
+)
+
+var (
+	idempotencyPOFields = xsql.GetFieldSlice(&IdempotencyPO{})
+)
+
+// IdempotencyPO 任务幂等键 (namespace, idem_key) 唯一
+type IdempotencyPO struct {
+	Namespace  string    `db:"namespace"   json:"namespace"`
+	IdemKey    string    `db:"idem_key"    json:"idem_key"`
+	TaskId     uuid.UUID `db:"task_id"     json:"task_id"`
+	ExpireTime int64     `db:"expire_time" json:"expire_time"` // 去重窗口结束时间 unix ms
+	Ctime      int64     `db:"ctime"       json:"ctime"`
+}
+
+func (IdempotencyPO) TableName() string {
+	return idempotencyPOTableName
+}
+
+func (s *IdempotencyPO) Values() []any {
+	return []any{
+		s.Namespace,
+		s.IdemKey,
+		s.TaskId,
+		s.ExpireTime,
+		s.Ctime,
+	}
+}
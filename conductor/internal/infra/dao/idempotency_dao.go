@@ -0,0 +1,110 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/huandu/go-sqlbuilder"
+	"github.com/ryanreadbooks/whimer/misc/uuid"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+)
+
+type IdempotencyDao struct {
+	db *xsql.DB
+}
+
+func NewIdempotencyDao(db *xsql.DB) *IdempotencyDao {
+	return &IdempotencyDao{
+		db: db,
+	}
+}
+
+func (d *IdempotencyDao) Insert(ctx context.Context, po *IdempotencyPO) error {
+	ib := sqlbuilder.NewInsertBuilder()
+	ib.InsertInto(idempotencyPOTableName)
+	ib.Cols(idempotencyPOFields...)
+	ib.Values(po.Values()...)
+
+	sql, args := ib.Build()
+	_, err := d.db.ExecCtx(ctx, sql, args...)
+	if err != nil {
+		return xsql.ConvertError(err)
+	}
+
+	return nil
+}
+
+func (d *IdempotencyDao) GetByKey(ctx context.Context, namespace, idemKey string) (*IdempotencyPO, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+	sb.Select(idempotencyPOFields...)
+	sb.From(idempotencyPOTableName)
+	sb.Where(
+		sb.Equal("namespace", namespace),
+		sb.Equal("idem_key", idemKey),
+	)
+
+	sql, args := sb.Build()
+	var po IdempotencyPO
+	err := d.db.QueryRowCtx(ctx, &po, sql, args...)
+	if err != nil {
+		return nil, xsql.ConvertError(err)
+	}
+
+	return &po, nil
+}
+
+// Renew 幂等键过期后重新绑定到新任务
+//
+// 以旧任务id做条件 返回是否更新成功；更新失败说明已被其它请求抢先绑定
+func (d *IdempotencyDao) Renew(
+	ctx context.Context,
+	namespace, idemKey string,
+	oldTaskId, newTaskId uuid.UUID,
+	expireTime, ctime int64,
+) (bool, error) {
+	ub := sqlbuilder.NewUpdateBuilder()
+	ub.Update(idempotencyPOTableName)
+	ub.Set(
+		ub.Assign("task_id", newTaskId),
+		ub.Assign("expire_time", expireTime),
+		ub.Assign("ctime", ctime),
+	)
+	ub.Where(
+		ub.Equal("namespace", namespace),
+		ub.Equal("idem_key", idemKey),
+		ub.Equal("task_id", oldTaskId),
+	)
+
+	sql, args := ub.Build()
+	res, err := d.db.ExecCtx(ctx, sql, args...)
+	if err != nil {
+		return false, xsql.ConvertError(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, xsql.ConvertError(err)
+	}
+
+	return affected > 0, nil
+}
+
+// DeleteExpired 清理已过期的幂等键
+func (d *IdempotencyDao) DeleteExpired(ctx context.Context, now int64, limit int) (int64, error) {
+	db := sqlbuilder.NewDeleteBuilder()
+	db.DeleteFrom(idempotencyPOTableName)
+	db.Where(db.LessThan("expire_time", now))
+	db.Limit(limit)
+
+	sql, args := db.Build()
+	res, err := d.db.ExecCtx(ctx, sql, args...)
+	if err != nil {
+		return 0, xsql.ConvertError(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, xsql.ConvertError(err)
+	}
+
+	return affected, nil
+}
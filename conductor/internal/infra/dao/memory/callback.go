@@ -0,0 +1,108 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/ryanreadbooks/whimer/conductor/internal/infra/dao"
+	"github.com/ryanreadbooks/whimer/misc/uuid"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+)
+
+type CallbackStore struct {
+	db *DB
+}
+
+var _ dao.CallbackStore = (*CallbackStore)(nil)
+
+func (s *CallbackStore) Insert(ctx context.Context, po *dao.CallbackPO) error {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	if _, ok := t.callbacks[po.Id]; ok {
+		return xsql.ErrDuplicate
+	}
+	t.callbacks[po.Id] = copyPO(po)
+
+	return nil
+}
+
+func (s *CallbackStore) ListByTaskId(ctx context.Context, taskId uuid.UUID) ([]*dao.CallbackPO, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	pos := make([]*dao.CallbackPO, 0)
+	for _, po := range t.callbacks {
+		if po.TaskId == taskId {
+			pos = append(pos, copyPO(po))
+		}
+	}
+	sortById(pos, func(po *dao.CallbackPO) uuid.UUID { return po.Id })
+
+	return pos, nil
+}
+
+func (s *CallbackStore) ListDue(
+	ctx context.Context,
+	state string,
+	shardStart, shardEnd int,
+	now int64,
+	limit int32,
+) ([]*dao.CallbackPO, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	pos := make([]*dao.CallbackPO, 0)
+	for _, po := range t.callbacks {
+		if po.State == state && inShard(po.Shard, shardStart, shardEnd) && po.NextRetryTime <= now {
+			pos = append(pos, copyPO(po))
+		}
+	}
+	sort.Slice(pos, func(i, j int) bool { return pos[i].NextRetryTime < pos[j].NextRetryTime })
+
+	return limitOf(pos, int(limit)), nil
+}
+
+func (s *CallbackStore) Lease(
+	ctx context.Context,
+	id uuid.UUID,
+	version int64,
+	leaseUntil, utime int64,
+) (bool, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	po, ok := t.callbacks[id]
+	if !ok || po.Version != version {
+		return false, nil
+	}
+
+	cp := copyPO(po)
+	cp.NextRetryTime = leaseUntil
+	cp.Utime = utime
+	cp.Version++
+	t.callbacks[id] = cp
+
+	return true, nil
+}
+
+func (s *CallbackStore) UpdateResult(ctx context.Context, po *dao.CallbackPO) (bool, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	cur, ok := t.callbacks[po.Id]
+	if !ok || cur.Version != po.Version {
+		return false, nil
+	}
+
+	cp := copyPO(cur)
+	cp.State = po.State
+	cp.Attempts = po.Attempts
+	cp.NextRetryTime = po.NextRetryTime
+	cp.LastError = po.LastError
+	cp.Utime = po.Utime
+	cp.Version++
+	t.callbacks[po.Id] = cp
+
+	return true, nil
+}
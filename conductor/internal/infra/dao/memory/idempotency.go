@@ -0,0 +1,85 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/ryanreadbooks/whimer/conductor/internal/infra/dao"
+	"github.com/ryanreadbooks/whimer/misc/uuid"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+)
+
+type IdempotencyStore struct {
+	db *DB
+}
+
+var _ dao.IdempotencyStore = (*IdempotencyStore)(nil)
+
+func (s *IdempotencyStore) Insert(ctx context.Context, po *dao.IdempotencyPO) error {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	key := idemKey{namespace: po.Namespace, key: po.IdemKey}
+	if _, ok := t.idempotency[key]; ok {
+		return xsql.ErrDuplicate
+	}
+	t.idempotency[key] = copyPO(po)
+
+	return nil
+}
+
+func (s *IdempotencyStore) GetByKey(ctx context.Context, namespace, key string) (*dao.IdempotencyPO, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	po, ok := t.idempotency[idemKey{namespace: namespace, key: key}]
+	if !ok {
+		return nil, xsql.ErrNoRecord
+	}
+
+	return copyPO(po), nil
+}
+
+func (s *IdempotencyStore) Renew(
+	ctx context.Context,
+	namespace, key string,
+	oldTaskId, newTaskId uuid.UUID,
+	expireTime, ctime int64,
+) (bool, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	k := idemKey{namespace: namespace, key: key}
+	po, ok := t.idempotency[k]
+	if !ok || po.TaskId != oldTaskId {
+		return false, nil
+	}
+
+	cp := copyPO(po)
+	cp.TaskId = newTaskId
+	cp.ExpireTime = expireTime
+	cp.Ctime = ctime
+	t.idempotency[k] = cp
+
+	return true, nil
+}
+
+func (s *IdempotencyStore) DeleteExpired(ctx context.Context, now int64, limit int) (int64, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	expired := make([]*dao.IdempotencyPO, 0)
+	for _, po := range t.idempotency {
+		if po.ExpireTime < now {
+			expired = append(expired, po)
+		}
+	}
+	sort.Slice(expired, func(i, j int) bool { return expired[i].ExpireTime < expired[j].ExpireTime })
+	expired = limitOf(expired, limit)
+
+	for _, po := range expired {
+		delete(t.idempotency, idemKey{namespace: po.Namespace, key: po.IdemKey})
+	}
+
+	return int64(len(expired)), nil
+}
@@ -0,0 +1,138 @@
+package memory
+
+import (
+	"context"
+	"maps"
+	"sort"
+	"sync"
+
+	"github.com/ryanreadbooks/whimer/conductor/internal/infra/dao"
+	"github.com/ryanreadbooks/whimer/misc/uuid"
+)
+
+// DB 内存存储 用于内嵌模式和测试 数据不落盘
+//
+// 所有表共用一把锁 事务期间独占整个库 事务失败时整体回滚
+type DB struct {
+	mu sync.Mutex
+	t  *tables
+}
+
+type txKey struct{}
+
+type idemKey struct {
+	namespace string
+	key       string
+}
+
+type tables struct {
+	namespaces  map[uuid.UUID]*dao.NamespacePO
+	tasks       map[uuid.UUID]*dao.TaskPO
+	histories   map[int64]*dao.TaskHistoryPO
+	historySeq  int64
+	schedules   map[uuid.UUID]*dao.SchedulePO
+	idempotency map[idemKey]*dao.IdempotencyPO
+	callbacks   map[uuid.UUID]*dao.CallbackPO
+	workers     map[string]*dao.WorkerPO
+}
+
+func newTables() *tables {
+	return &tables{
+		namespaces:  make(map[uuid.UUID]*dao.NamespacePO),
+		tasks:       make(map[uuid.UUID]*dao.TaskPO),
+		histories:   make(map[int64]*dao.TaskHistoryPO),
+		schedules:   make(map[uuid.UUID]*dao.SchedulePO),
+		idempotency: make(map[idemKey]*dao.IdempotencyPO),
+		callbacks:   make(map[uuid.UUID]*dao.CallbackPO),
+		workers:     make(map[string]*dao.WorkerPO),
+	}
+}
+
+// clone 浅拷贝各表 记录只会被整体替换 不会原地修改 因此可以共享
+func (t *tables) clone() *tables {
+	return &tables{
+		namespaces:  maps.Clone(t.namespaces),
+		tasks:       maps.Clone(t.tasks),
+		histories:   maps.Clone(t.histories),
+		historySeq:  t.historySeq,
+		schedules:   maps.Clone(t.schedules),
+		idempotency: maps.Clone(t.idempotency),
+		callbacks:   maps.Clone(t.callbacks),
+		workers:     maps.Clone(t.workers),
+	}
+}
+
+func New() *DB {
+	return &DB{
+		t: newTables(),
+	}
+}
+
+// NewDao 创建使用内存存储的 Dao
+func NewDao() *dao.Dao {
+	db := New()
+	return dao.NewWithStores(db, dao.Stores{
+		Namespace:   &NamespaceStore{db: db},
+		Task:        &TaskStore{db: db},
+		TaskHistory: &TaskHistoryStore{db: db},
+		Schedule:    &ScheduleStore{db: db},
+		Idempotency: &IdempotencyStore{db: db},
+		Callback:    &CallbackStore{db: db},
+		Worker:      &WorkerStore{db: db},
+	})
+}
+
+func (d *DB) inTx(ctx context.Context) bool {
+	db, _ := ctx.Value(txKey{}).(*DB)
+	return db == d
+}
+
+// lock 获取表的访问权 事务内的调用已经持有锁
+func (d *DB) lock(ctx context.Context) (*tables, func()) {
+	if d.inTx(ctx) {
+		return d.t, func() {}
+	}
+
+	d.mu.Lock()
+	return d.t, d.mu.Unlock
+}
+
+// Transact 在同一事务中执行 fn 嵌套调用时复用外层事务
+func (d *DB) Transact(ctx context.Context, fn func(ctx context.Context) error) error {
+	if d.inTx(ctx) {
+		return fn(ctx)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	snapshot := d.t.clone()
+	err := fn(context.WithValue(ctx, txKey{}, d))
+	if err != nil {
+		d.t = snapshot
+	}
+
+	return err
+}
+
+func copyPO[T any](po *T) *T {
+	cp := *po
+	return &cp
+}
+
+func inShard(shard, start, end int) bool {
+	return shard >= start && shard < end
+}
+
+func sortById[T any](pos []*T, id func(*T) uuid.UUID) {
+	sort.Slice(pos, func(i, j int) bool {
+		return id(pos[i]).LessThan(id(pos[j]))
+	})
+}
+
+func limitOf[T any](pos []*T, limit int) []*T {
+	if limit >= 0 && len(pos) > limit {
+		return pos[:limit]
+	}
+	return pos
+}
@@ -0,0 +1,171 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/ryanreadbooks/whimer/conductor/internal/infra/dao"
+	"github.com/ryanreadbooks/whimer/misc/uuid"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+)
+
+type NamespaceStore struct {
+	db *DB
+}
+
+var _ dao.NamespaceStore = (*NamespaceStore)(nil)
+
+func (s *NamespaceStore) Insert(ctx context.Context, po *dao.NamespacePO) error {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	if _, ok := t.namespaces[po.Id]; ok {
+		return xsql.ErrDuplicate
+	}
+	for _, ns := range t.namespaces {
+		if ns.Name == po.Name {
+			return xsql.ErrDuplicate
+		}
+	}
+	t.namespaces[po.Id] = copyPO(po)
+
+	return nil
+}
+
+func (s *NamespaceStore) findById(t *tables, id []byte) *dao.NamespacePO {
+	for _, ns := range t.namespaces {
+		if bytes.Equal(ns.Id.UUID[:], id) {
+			return ns
+		}
+	}
+	return nil
+}
+
+func (s *NamespaceStore) GetById(ctx context.Context, id []byte) (*dao.NamespacePO, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	ns := s.findById(t, id)
+	if ns == nil {
+		return nil, xsql.ErrNoRecord
+	}
+
+	return copyPO(ns), nil
+}
+
+func (s *NamespaceStore) GetByName(ctx context.Context, name string) (*dao.NamespacePO, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	for _, ns := range t.namespaces {
+		if ns.Name == name {
+			return copyPO(ns), nil
+		}
+	}
+
+	return nil, xsql.ErrNoRecord
+}
+
+func (s *NamespaceStore) UpdateById(ctx context.Context, id []byte, po *dao.NamespacePO) error {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	ns := s.findById(t, id)
+	if ns == nil {
+		return nil
+	}
+	for _, other := range t.namespaces {
+		if other != ns && other.Name == po.Name {
+			return xsql.ErrDuplicate
+		}
+	}
+
+	cp := copyPO(ns)
+	cp.Name = po.Name
+	cp.Weight = po.Weight
+	cp.MaxInflight = po.MaxInflight
+	t.namespaces[cp.Id] = cp
+
+	return nil
+}
+
+func (s *NamespaceStore) DeleteById(ctx context.Context, id []byte) error {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	ns := s.findById(t, id)
+	if ns != nil {
+		delete(t.namespaces, ns.Id)
+	}
+
+	return nil
+}
+
+func (s *NamespaceStore) UpdateQuota(ctx context.Context, po *dao.NamespacePO) error {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	ns, ok := t.namespaces[po.Id]
+	if !ok {
+		return nil
+	}
+
+	cp := copyPO(ns)
+	cp.Weight = po.Weight
+	cp.MaxInflight = po.MaxInflight
+	t.namespaces[cp.Id] = cp
+
+	return nil
+}
+
+func (s *NamespaceStore) UpdateCallbackSecret(ctx context.Context, po *dao.NamespacePO) error {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	ns, ok := t.namespaces[po.Id]
+	if !ok {
+		return nil
+	}
+
+	cp := copyPO(ns)
+	cp.CallbackSecret = po.CallbackSecret
+	t.namespaces[cp.Id] = cp
+
+	return nil
+}
+
+func (s *NamespaceStore) ListAll(ctx context.Context) ([]*dao.NamespacePO, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	return s.sorted(t), nil
+}
+
+func (s *NamespaceStore) List(ctx context.Context, offset, limit int) ([]*dao.NamespacePO, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	pos := s.sorted(t)
+	if offset >= len(pos) {
+		return nil, nil
+	}
+
+	return limitOf(pos[offset:], limit), nil
+}
+
+func (s *NamespaceStore) Count(ctx context.Context) (int64, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	return int64(len(t.namespaces)), nil
+}
+
+func (s *NamespaceStore) sorted(t *tables) []*dao.NamespacePO {
+	pos := make([]*dao.NamespacePO, 0, len(t.namespaces))
+	for _, ns := range t.namespaces {
+		pos = append(pos, copyPO(ns))
+	}
+	sortById(pos, func(po *dao.NamespacePO) uuid.UUID { return po.Id })
+
+	return pos
+}
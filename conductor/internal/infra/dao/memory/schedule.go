@@ -0,0 +1,182 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/ryanreadbooks/whimer/conductor/internal/infra/dao"
+	"github.com/ryanreadbooks/whimer/misc/uuid"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+)
+
+type ScheduleStore struct {
+	db *DB
+}
+
+var _ dao.ScheduleStore = (*ScheduleStore)(nil)
+
+func (s *ScheduleStore) Insert(ctx context.Context, po *dao.SchedulePO) error {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	if _, ok := t.schedules[po.Id]; ok {
+		return xsql.ErrDuplicate
+	}
+	for _, sc := range t.schedules {
+		if sc.Namespace == po.Namespace && sc.Name == po.Name {
+			return xsql.ErrDuplicate
+		}
+	}
+	t.schedules[po.Id] = copyPO(po)
+
+	return nil
+}
+
+func (s *ScheduleStore) GetByName(ctx context.Context, namespace, name string) (*dao.SchedulePO, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	for _, sc := range t.schedules {
+		if sc.Namespace == namespace && sc.Name == name {
+			return copyPO(sc), nil
+		}
+	}
+
+	return nil, xsql.ErrNoRecord
+}
+
+func (s *ScheduleStore) listByNamespace(t *tables, namespace string) []*dao.SchedulePO {
+	pos := make([]*dao.SchedulePO, 0)
+	for _, sc := range t.schedules {
+		if sc.Namespace == namespace {
+			pos = append(pos, copyPO(sc))
+		}
+	}
+	sortById(pos, func(po *dao.SchedulePO) uuid.UUID { return po.Id })
+
+	return pos
+}
+
+func (s *ScheduleStore) ListByNamespace(
+	ctx context.Context,
+	namespace string,
+	offset, limit int,
+) ([]*dao.SchedulePO, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	pos := s.listByNamespace(t, namespace)
+	if offset >= len(pos) {
+		return nil, nil
+	}
+
+	return limitOf(pos[offset:], limit), nil
+}
+
+func (s *ScheduleStore) CountByNamespace(ctx context.Context, namespace string) (int64, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	return int64(len(s.listByNamespace(t, namespace))), nil
+}
+
+func (s *ScheduleStore) ListDue(
+	ctx context.Context,
+	state string,
+	shardStart, shardEnd int,
+	now int64,
+	limit int32,
+) ([]*dao.SchedulePO, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	pos := make([]*dao.SchedulePO, 0)
+	for _, sc := range t.schedules {
+		if sc.State == state && inShard(sc.Shard, shardStart, shardEnd) && sc.NextFireTime <= now {
+			pos = append(pos, copyPO(sc))
+		}
+	}
+	sort.Slice(pos, func(i, j int) bool { return pos[i].NextFireTime < pos[j].NextFireTime })
+
+	return limitOf(pos, int(limit)), nil
+}
+
+// update 修改单个定时任务 返回是否存在
+func (s *ScheduleStore) update(t *tables, id uuid.UUID, fn func(po *dao.SchedulePO)) bool {
+	po, ok := t.schedules[id]
+	if !ok {
+		return false
+	}
+
+	cp := copyPO(po)
+	fn(cp)
+	t.schedules[id] = cp
+
+	return true
+}
+
+func (s *ScheduleStore) UpdateSpec(ctx context.Context, po *dao.SchedulePO) error {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	s.update(t, po.Id, func(cp *dao.SchedulePO) {
+		cp.TaskType = po.TaskType
+		cp.CronExpr = po.CronExpr
+		cp.InputArgs = po.InputArgs
+		cp.CallbackUrl = po.CallbackUrl
+		cp.MaxRetryCnt = po.MaxRetryCnt
+		cp.ExpireAfter = po.ExpireAfter
+		cp.NextFireTime = po.NextFireTime
+		cp.Utime = po.Utime
+		cp.Version++
+	})
+
+	return nil
+}
+
+func (s *ScheduleStore) UpdateState(ctx context.Context, id uuid.UUID, state string, nextFireTime, utime int64) error {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	s.update(t, id, func(cp *dao.SchedulePO) {
+		cp.State = state
+		cp.NextFireTime = nextFireTime
+		cp.Utime = utime
+		cp.Version++
+	})
+
+	return nil
+}
+
+func (s *ScheduleStore) UpdateFired(
+	ctx context.Context,
+	id uuid.UUID,
+	version int64,
+	lastFireTime, nextFireTime, utime int64,
+) (bool, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	cur, ok := t.schedules[id]
+	if !ok || cur.Version != version {
+		return false, nil
+	}
+
+	s.update(t, id, func(cp *dao.SchedulePO) {
+		cp.LastFireTime = lastFireTime
+		cp.NextFireTime = nextFireTime
+		cp.Utime = utime
+		cp.Version++
+	})
+
+	return true, nil
+}
+
+func (s *ScheduleStore) DeleteById(ctx context.Context, id uuid.UUID) error {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	delete(t.schedules, id)
+
+	return nil
+}
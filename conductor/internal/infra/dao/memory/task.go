@@ -0,0 +1,574 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"github.com/ryanreadbooks/whimer/conductor/internal/infra/dao"
+	"github.com/ryanreadbooks/whimer/misc/uuid"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+)
+
+type TaskStore struct {
+	db *DB
+}
+
+var _ dao.TaskStore = (*TaskStore)(nil)
+
+// 非终态 与 TaskDao.ListExpiredTasks 保持一致
+var unfinishedTaskStates = []string{"inited", "dispatched", "running", "pending_retry"}
+
+func (s *TaskStore) Insert(ctx context.Context, po *dao.TaskPO) error {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	if _, ok := t.tasks[po.Id]; ok {
+		return xsql.ErrDuplicate
+	}
+	t.tasks[po.Id] = copyPO(po)
+
+	return nil
+}
+
+func (s *TaskStore) GetById(ctx context.Context, id uuid.UUID) (*dao.TaskPO, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	po, ok := t.tasks[id]
+	if !ok {
+		return nil, xsql.ErrNoRecord
+	}
+
+	return copyPO(po), nil
+}
+
+// filter 按 id 升序返回满足条件的任务
+func (s *TaskStore) filter(t *tables, match func(po *dao.TaskPO) bool) []*dao.TaskPO {
+	pos := make([]*dao.TaskPO, 0)
+	for _, po := range t.tasks {
+		if match(po) {
+			pos = append(pos, copyPO(po))
+		}
+	}
+	sortById(pos, func(po *dao.TaskPO) uuid.UUID { return po.Id })
+
+	return pos
+}
+
+// update 修改单个任务 返回任务是否存在
+func (s *TaskStore) update(t *tables, id uuid.UUID, fn func(po *dao.TaskPO)) bool {
+	po, ok := t.tasks[id]
+	if !ok {
+		return false
+	}
+
+	cp := copyPO(po)
+	fn(cp)
+	t.tasks[id] = cp
+
+	return true
+}
+
+func (s *TaskStore) GetByNamespaceAndTaskTypeAndShard(
+	ctx context.Context,
+	namespace string,
+	taskType string,
+	shard int,
+) ([]*dao.TaskPO, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	return s.filter(t, func(po *dao.TaskPO) bool {
+		return po.Namespace == namespace && po.TaskType == taskType && po.TaskTypeShard == shard
+	}), nil
+}
+
+func (s *TaskStore) ListTaskByState(
+	ctx context.Context,
+	state string,
+	shardStart, shardEnd int,
+	now int64,
+	limit int32,
+	offset uuid.UUID,
+) ([]*dao.TaskPO, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	pos := s.filter(t, func(po *dao.TaskPO) bool {
+		return po.State == state &&
+			inShard(po.TaskTypeShard, shardStart, shardEnd) &&
+			po.NotBefore <= now &&
+			po.Id.GreaterThan(offset)
+	})
+
+	return limitOf(pos, int(limit)), nil
+}
+
+func (s *TaskStore) ListExpiredTasks(
+	ctx context.Context,
+	shardStart, shardEnd int,
+	now int64,
+	limit int32,
+) ([]*dao.TaskPO, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	pos := s.filter(t, func(po *dao.TaskPO) bool {
+		return slices.Contains(unfinishedTaskStates, po.State) &&
+			inShard(po.TaskTypeShard, shardStart, shardEnd) &&
+			po.ExpireTime > 0 &&
+			po.ExpireTime < now
+	})
+
+	return limitOf(pos, int(limit)), nil
+}
+
+func (s *TaskStore) UpdateById(ctx context.Context, id uuid.UUID, po *dao.TaskPO) error {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	cur, ok := t.tasks[id]
+	if !ok || cur.Version != po.Version {
+		return nil
+	}
+
+	s.update(t, id, func(cp *dao.TaskPO) {
+		cp.Namespace = po.Namespace
+		cp.TaskType = po.TaskType
+		cp.TaskTypeShard = po.TaskTypeShard
+		cp.InputArgs = po.InputArgs
+		cp.OutputArgs = po.OutputArgs
+		cp.CallbackUrl = po.CallbackUrl
+		cp.State = po.State
+		cp.TraceId = po.TraceId
+		cp.Utime = po.Utime
+		cp.MaxRetryCnt = po.MaxRetryCnt
+		cp.ExpireTime = po.ExpireTime
+		cp.NotBefore = po.NotBefore
+		cp.Priority = po.Priority
+		cp.Settings = po.Settings
+		cp.Version = po.Version + 1
+	})
+
+	return nil
+}
+
+func (s *TaskStore) DeleteById(ctx context.Context, id uuid.UUID) error {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	delete(t.tasks, id)
+
+	return nil
+}
+
+func (s *TaskStore) UpdateState(ctx context.Context, id uuid.UUID, state string, utime int64) error {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	s.update(t, id, func(cp *dao.TaskPO) {
+		cp.State = state
+		cp.Utime = utime
+		cp.Version++
+	})
+
+	return nil
+}
+
+func (s *TaskStore) UpdateDispatched(
+	ctx context.Context,
+	id uuid.UUID,
+	fromStates []string,
+	toState, workerId string,
+	leaseExpire int64,
+	utime int64,
+) (bool, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	cur, ok := t.tasks[id]
+	if !ok || !slices.Contains(fromStates, cur.State) {
+		return false, nil
+	}
+
+	s.update(t, id, func(cp *dao.TaskPO) {
+		cp.State = toState
+		cp.WorkerId = workerId
+		cp.LeaseExpire = leaseExpire
+		cp.Deadline = 0
+		cp.Utime = utime
+		cp.Version++
+	})
+
+	return true, nil
+}
+
+func (s *TaskStore) UpdateAccepted(
+	ctx context.Context,
+	id uuid.UUID,
+	fromStates []string,
+	toState, workerId string,
+	leaseExpire, deadline int64,
+	utime int64,
+) (bool, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	cur, ok := t.tasks[id]
+	if !ok || !slices.Contains(fromStates, cur.State) {
+		return false, nil
+	}
+
+	s.update(t, id, func(cp *dao.TaskPO) {
+		cp.State = toState
+		cp.WorkerId = workerId
+		cp.LeaseExpire = leaseExpire
+		cp.Deadline = deadline
+		cp.Utime = utime
+		cp.Version++
+	})
+
+	return true, nil
+}
+
+func (s *TaskStore) RenewLease(
+	ctx context.Context,
+	id uuid.UUID,
+	state, workerId string,
+	leaseExpire int64,
+) (bool, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	cur, ok := t.tasks[id]
+	if !ok || cur.State != state || (workerId != "" && cur.WorkerId != workerId) {
+		return false, nil
+	}
+
+	s.update(t, id, func(cp *dao.TaskPO) {
+		cp.LeaseExpire = leaseExpire
+	})
+
+	return true, nil
+}
+
+func (s *TaskStore) ListLeaseExpired(
+	ctx context.Context,
+	shardStart, shardEnd int,
+	now int64,
+	limit int32,
+) ([]*dao.TaskPO, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	pos := s.filter(t, func(po *dao.TaskPO) bool {
+		return (po.State == "dispatched" || po.State == "running") &&
+			inShard(po.TaskTypeShard, shardStart, shardEnd) &&
+			((po.LeaseExpire > 0 && po.LeaseExpire < now) ||
+				(po.Deadline > 0 && po.Deadline < now))
+	})
+
+	return limitOf(pos, int(limit)), nil
+}
+
+func (s *TaskStore) UpdateReclaim(
+	ctx context.Context,
+	id uuid.UUID,
+	fromState string,
+	leaseExpire int64,
+	toState string,
+	incrRetry bool,
+	outputArgs []byte,
+	utime int64,
+) (bool, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	cur, ok := t.tasks[id]
+	if !ok || cur.State != fromState || cur.LeaseExpire != leaseExpire {
+		return false, nil
+	}
+
+	s.update(t, id, func(cp *dao.TaskPO) {
+		cp.State = toState
+		cp.LeaseExpire = 0
+		cp.Deadline = 0
+		cp.Utime = utime
+		cp.Version++
+		if incrRetry {
+			cp.CurRetryCnt++
+		}
+		if outputArgs != nil {
+			cp.OutputArgs = outputArgs
+		}
+	})
+
+	return true, nil
+}
+
+func (s *TaskStore) UpdateRetry(
+	ctx context.Context,
+	id uuid.UUID,
+	fromStates []string,
+	workerId, state string,
+	utime int64,
+) (bool, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	if !isHeldBy(t.tasks[id], fromStates, workerId) {
+		return false, nil
+	}
+
+	s.update(t, id, func(cp *dao.TaskPO) {
+		cp.State = state
+		cp.Utime = utime
+		cp.CurRetryCnt++
+		cp.Version++
+	})
+
+	return true, nil
+}
+
+func (s *TaskStore) UpdateComplete(
+	ctx context.Context,
+	id uuid.UUID,
+	fromStates []string,
+	workerId, state string,
+	outputArgs []byte,
+	utime int64,
+) (bool, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	if !isHeldBy(t.tasks[id], fromStates, workerId) {
+		return false, nil
+	}
+
+	s.update(t, id, func(cp *dao.TaskPO) {
+		cp.State = state
+		cp.OutputArgs = outputArgs
+		cp.Utime = utime
+		cp.Version++
+	})
+
+	return true, nil
+}
+
+func isHeldBy(cur *dao.TaskPO, fromStates []string, workerId string) bool {
+	if cur == nil || !slices.Contains(fromStates, cur.State) {
+		return false
+	}
+	return workerId == "" || cur.WorkerId == workerId
+}
+
+func (s *TaskStore) GetByIds(ctx context.Context, ids []uuid.UUID) ([]*dao.TaskPO, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	pos := make([]*dao.TaskPO, 0, len(ids))
+	for _, id := range ids {
+		if po, ok := t.tasks[id]; ok {
+			pos = append(pos, copyPO(po))
+		}
+	}
+
+	return pos, nil
+}
+
+func (s *TaskStore) ListByWorkflowId(ctx context.Context, workflowId uuid.UUID) ([]*dao.TaskPO, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	return s.filter(t, func(po *dao.TaskPO) bool {
+		return po.WorkflowId == workflowId
+	}), nil
+}
+
+func (s *TaskStore) UpdateRelease(
+	ctx context.Context,
+	id uuid.UUID,
+	fromState, toState string,
+	expireTime int64,
+	settings []byte,
+	utime int64,
+) (bool, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	cur, ok := t.tasks[id]
+	if !ok || cur.State != fromState {
+		return false, nil
+	}
+
+	s.update(t, id, func(cp *dao.TaskPO) {
+		cp.State = toState
+		cp.ExpireTime = expireTime
+		cp.Settings = settings
+		cp.Utime = utime
+		cp.Version++
+	})
+
+	return true, nil
+}
+
+func (s *TaskStore) ListTasks(
+	ctx context.Context,
+	filter *dao.ListTasksFilter,
+	cursor uuid.UUID,
+	limit int32,
+) ([]*dao.TaskPO, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	pos := s.filter(t, func(po *dao.TaskPO) bool {
+		if po.Namespace != filter.Namespace || !po.Id.LessThan(cursor) {
+			return false
+		}
+		if filter.TaskType != "" && po.TaskType != filter.TaskType {
+			return false
+		}
+		if filter.State != "" && po.State != filter.State {
+			return false
+		}
+		if filter.CtimeStart > 0 && po.Ctime < filter.CtimeStart {
+			return false
+		}
+		if filter.CtimeEnd > 0 && po.Ctime >= filter.CtimeEnd {
+			return false
+		}
+		return true
+	})
+	slices.Reverse(pos)
+
+	return limitOf(pos, int(limit)), nil
+}
+
+func (s *TaskStore) UpdateRequeue(
+	ctx context.Context,
+	id uuid.UUID,
+	fromStates []string,
+	toState string,
+	expireTime int64,
+	utime int64,
+) (bool, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	cur, ok := t.tasks[id]
+	if !ok || !slices.Contains(fromStates, cur.State) {
+		return false, nil
+	}
+
+	s.update(t, id, func(cp *dao.TaskPO) {
+		cp.State = toState
+		cp.CurRetryCnt = 0
+		cp.ExpireTime = expireTime
+		cp.Utime = utime
+		cp.Version++
+	})
+
+	return true, nil
+}
+
+func (s *TaskStore) ListPendingByPriority(
+	ctx context.Context,
+	namespace string,
+	state string,
+	shardStart, shardEnd int,
+	now int64,
+	cursor dao.PriorityCursor,
+	limit int32,
+) ([]*dao.TaskPO, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	pos := s.filter(t, func(po *dao.TaskPO) bool {
+		return po.Namespace == namespace &&
+			po.State == state &&
+			inShard(po.TaskTypeShard, shardStart, shardEnd) &&
+			po.NotBefore <= now &&
+			(po.Priority < cursor.Priority ||
+				(po.Priority == cursor.Priority && po.Id.GreaterThan(cursor.Id)))
+	})
+	// filter 已按 id 升序 稳定排序后即为 (priority DESC, id ASC)
+	sort.SliceStable(pos, func(i, j int) bool {
+		return pos[i].Priority > pos[j].Priority
+	})
+
+	return limitOf(pos, int(limit)), nil
+}
+
+func (s *TaskStore) HasPendingAbove(
+	ctx context.Context,
+	namespace string,
+	state string,
+	shardStart, shardEnd int,
+	now int64,
+	priority int32,
+	afterId uuid.UUID,
+) (bool, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	for _, po := range t.tasks {
+		if po.Namespace == namespace &&
+			po.State == state &&
+			inShard(po.TaskTypeShard, shardStart, shardEnd) &&
+			po.NotBefore <= now &&
+			po.Priority > priority &&
+			po.Id.GreaterThan(afterId) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (s *TaskStore) CountByStatesGroupByNamespace(ctx context.Context, states []string) ([]*dao.NamespaceCount, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	counts := make(map[string]int64)
+	for _, po := range t.tasks {
+		if slices.Contains(states, po.State) {
+			counts[po.Namespace]++
+		}
+	}
+
+	res := make([]*dao.NamespaceCount, 0, len(counts))
+	for namespace, cnt := range counts {
+		res = append(res, &dao.NamespaceCount{Namespace: namespace, Cnt: cnt})
+	}
+
+	return res, nil
+}
+
+func (s *TaskStore) ListAssignedByWorkerIds(
+	ctx context.Context,
+	workerIds []string,
+	states []string,
+) ([]*dao.WorkerAssignment, error) {
+	if len(workerIds) == 0 || len(states) == 0 {
+		return nil, nil
+	}
+
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	pos := s.filter(t, func(po *dao.TaskPO) bool {
+		return slices.Contains(workerIds, po.WorkerId) && slices.Contains(states, po.State)
+	})
+
+	assignments := make([]*dao.WorkerAssignment, 0, len(pos))
+	for _, po := range pos {
+		assignments = append(assignments, &dao.WorkerAssignment{TaskId: po.Id, WorkerId: po.WorkerId})
+	}
+
+	return assignments, nil
+}
@@ -0,0 +1,88 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/ryanreadbooks/whimer/conductor/internal/infra/dao"
+	"github.com/ryanreadbooks/whimer/misc/uuid"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+)
+
+type TaskHistoryStore struct {
+	db *DB
+}
+
+var _ dao.TaskHistoryStore = (*TaskHistoryStore)(nil)
+
+// Insert id 为 0 时自增分配
+func (s *TaskHistoryStore) Insert(ctx context.Context, po *dao.TaskHistoryPO) error {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	cp := copyPO(po)
+	if cp.Id == 0 {
+		t.historySeq++
+		cp.Id = t.historySeq
+	} else if _, ok := t.histories[cp.Id]; ok {
+		return xsql.ErrDuplicate
+	}
+	t.historySeq = max(t.historySeq, cp.Id)
+	t.histories[cp.Id] = cp
+
+	return nil
+}
+
+func (s *TaskHistoryStore) GetById(ctx context.Context, id int64) (*dao.TaskHistoryPO, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	po, ok := t.histories[id]
+	if !ok {
+		return nil, xsql.ErrNoRecord
+	}
+
+	return copyPO(po), nil
+}
+
+func (s *TaskHistoryStore) GetByTaskId(ctx context.Context, taskId uuid.UUID) ([]*dao.TaskHistoryPO, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	pos := make([]*dao.TaskHistoryPO, 0)
+	for _, po := range t.histories {
+		if po.TaskId == taskId {
+			pos = append(pos, copyPO(po))
+		}
+	}
+	sort.Slice(pos, func(i, j int) bool { return pos[i].Id < pos[j].Id })
+
+	return pos, nil
+}
+
+func (s *TaskHistoryStore) UpdateById(ctx context.Context, id int64, po *dao.TaskHistoryPO) error {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	cur, ok := t.histories[id]
+	if !ok {
+		return nil
+	}
+
+	cp := copyPO(cur)
+	cp.TaskId = po.TaskId
+	cp.State = po.State
+	cp.Ctime = po.Ctime
+	t.histories[id] = cp
+
+	return nil
+}
+
+func (s *TaskHistoryStore) DeleteById(ctx context.Context, id int64) error {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	delete(t.histories, id)
+
+	return nil
+}
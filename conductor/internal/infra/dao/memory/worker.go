@@ -0,0 +1,102 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/ryanreadbooks/whimer/conductor/internal/infra/dao"
+)
+
+type WorkerStore struct {
+	db *DB
+}
+
+var _ dao.WorkerStore = (*WorkerStore)(nil)
+
+func (s *WorkerStore) Upsert(ctx context.Context, po *dao.WorkerPO) error {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	t.workers[po.Id] = copyPO(po)
+
+	return nil
+}
+
+func (s *WorkerStore) Insert(ctx context.Context, po *dao.WorkerPO) error {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	cur, ok := t.workers[po.Id]
+	if !ok {
+		t.workers[po.Id] = copyPO(po)
+		return nil
+	}
+
+	cp := copyPO(cur)
+	cp.LastHeartbeat = po.LastHeartbeat
+	t.workers[po.Id] = cp
+
+	return nil
+}
+
+func (s *WorkerStore) UpdateHeartbeat(ctx context.Context, id string, heartbeat int64) (bool, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	cur, ok := t.workers[id]
+	if !ok {
+		return false, nil
+	}
+
+	cp := copyPO(cur)
+	cp.LastHeartbeat = heartbeat
+	t.workers[id] = cp
+
+	return true, nil
+}
+
+func (s *WorkerStore) DeleteById(ctx context.Context, id string) error {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	delete(t.workers, id)
+
+	return nil
+}
+
+func (s *WorkerStore) ListByHeartbeat(ctx context.Context, since int64) ([]*dao.WorkerPO, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	pos := make([]*dao.WorkerPO, 0)
+	for _, po := range t.workers {
+		if po.LastHeartbeat >= since {
+			pos = append(pos, copyPO(po))
+		}
+	}
+	sort.Slice(pos, func(i, j int) bool { return pos[i].Id < pos[j].Id })
+
+	return pos, nil
+}
+
+func (s *WorkerStore) DeleteStale(ctx context.Context, before int64, limit int) (int64, error) {
+	t, unlock := s.db.lock(ctx)
+	defer unlock()
+
+	stale := make([]string, 0)
+	for id, po := range t.workers {
+		if po.LastHeartbeat < before {
+			stale = append(stale, id)
+		}
+	}
+	sort.Strings(stale)
+	if limit >= 0 && len(stale) > limit {
+		stale = stale[:limit]
+	}
+
+	for _, id := range stale {
+		delete(t.workers, id)
+	}
+
+	return int64(len(stale)), nil
+}
@@ -1,8 +1,8 @@
 package dao
 
 import (
-	"github.com/ryanreadbooks/whimer/misc/xsql"
 	"github.com/ryanreadbooks/whimer/misc/uuid"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
 )
 
 const (
@@ -14,8 +14,11 @@ var (
 )
 
 type NamespacePO struct {
-	Id   uuid.UUID `db:"id"   json:"id"`   
-	Name string `db:"name" json:"name"` 
+	Id             uuid.UUID `db:"id"              json:"id"`
+	Name           string    `db:"name"            json:"name"`
+	Weight         int32     `db:"weight"          json:"weight"`          // 分发权重
+	MaxInflight    int64     `db:"max_inflight"    json:"max_inflight"`    // 同时在执行的任务数上限 0表示不限制
+	CallbackSecret string    `db:"callback_secret" json:"callback_secret"` // 回调签名密钥
 }
 
 func (NamespacePO) TableName() string {
@@ -26,5 +29,8 @@ func (s *NamespacePO) Values() []any {
 	return []any{
 		s.Id,
 		s.Name,
+		s.Weight,
+		s.MaxInflight,
+		s.CallbackSecret,
 	}
 }
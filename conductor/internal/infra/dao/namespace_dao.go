@@ -99,6 +99,8 @@ func (d *NamespaceDao) UpdateById(ctx context.Context, id []byte, po *NamespaceP
 	ub.Update(namespacePOTableName)
 	ub.Set(
 		ub.Assign("name", po.Name),
+		ub.Assign("weight", po.Weight),
+		ub.Assign("max_inflight", po.MaxInflight),
 	)
 	ub.Where(ub.Equal("id", id))
 
@@ -133,6 +135,64 @@ func (d *NamespaceDao) DeleteById(ctx context.Context, id []byte) error {
 	return nil
 }
 
+// UpdateQuota 更新分发权重和配额
+func (d *NamespaceDao) UpdateQuota(ctx context.Context, po *NamespacePO) error {
+	ub := sqlbuilder.NewUpdateBuilder()
+	ub.Update(namespacePOTableName)
+	ub.Set(
+		ub.Assign("weight", po.Weight),
+		ub.Assign("max_inflight", po.MaxInflight),
+	)
+	ub.Where(ub.Equal("id", po.Id))
+
+	sql, args := ub.Build()
+	_, err := d.db.ExecCtx(ctx, sql, args...)
+	if err != nil {
+		return xsql.ConvertError(err)
+	}
+
+	// 清除缓存
+	d.cache.Del(ctx, fmtNamespaceCacheKey(po.Id.String()))
+
+	return nil
+}
+
+// UpdateCallbackSecret 更新回调签名密钥
+func (d *NamespaceDao) UpdateCallbackSecret(ctx context.Context, po *NamespacePO) error {
+	ub := sqlbuilder.NewUpdateBuilder()
+	ub.Update(namespacePOTableName)
+	ub.Set(ub.Assign("callback_secret", po.CallbackSecret))
+	ub.Where(ub.Equal("id", po.Id))
+
+	sql, args := ub.Build()
+	_, err := d.db.ExecCtx(ctx, sql, args...)
+	if err != nil {
+		return xsql.ConvertError(err)
+	}
+
+	// 清除缓存
+	d.cache.Del(ctx, fmtNamespaceCacheKey(po.Id.String()))
+
+	return nil
+}
+
+// ListAll 查询所有 namespace
+func (d *NamespaceDao) ListAll(ctx context.Context) ([]*NamespacePO, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+	sb.Select(namespacePOFields...)
+	sb.From(namespacePOTableName)
+	sb.OrderByAsc("id")
+
+	sql, args := sb.Build()
+	var pos []*NamespacePO
+	err := d.db.QueryRowsCtx(ctx, &pos, sql, args...)
+	if err != nil {
+		return nil, xsql.ConvertError(err)
+	}
+
+	return pos, nil
+}
+
 // List 分页查询 namespace
 func (d *NamespaceDao) List(ctx context.Context, offset, limit int) ([]*NamespacePO, error) {
 	sb := sqlbuilder.NewSelectBuilder()
@@ -0,0 +1,62 @@
+package dao
+
+import (
+	"github.com/ryanreadbooks/whimer/misc/uuid"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+)
+
+const (
+	schedulePOTableName = "conductor_schedule"
+)
+
+var (
+	schedulePOFields = xsql.GetFieldSlice(&SchedulePO{})
+)
+
+type SchedulePO struct {
+	Id           uuid.UUID `db:"id"             json:"id"`
+	Namespace    string    `db:"namespace"      json:"namespace"`
+	Name         string    `db:"name"           json:"name"` // 同一 namespace 下唯一
+	Shard        int       `db:"shard"          json:"shard"`
+	TaskType     string    `db:"task_type"      json:"task_type"`
+	CronExpr     string    `db:"cron_expr"      json:"cron_expr"`
+	InputArgs    []byte    `db:"input_args"     json:"input_args"` // 输入参数模板
+	CallbackUrl  string    `db:"callback_url"   json:"callback_url"`
+	MaxRetryCnt  int64     `db:"max_retry_cnt"  json:"max_retry_cnt"`
+	ExpireAfter  int64     `db:"expire_after"   json:"expire_after"` // 生成任务的存活时长 ms
+	State        string    `db:"state"          json:"state"`
+	NextFireTime int64     `db:"next_fire_time" json:"next_fire_time"` // 下次触发时间 unix ms
+	LastFireTime int64     `db:"last_fire_time" json:"last_fire_time"` // 上次触发时间 unix ms
+	Ctime        int64     `db:"ctime"          json:"ctime"`
+	Utime        int64     `db:"utime"          json:"utime"`
+	Version      int64     `db:"version"        json:"version"`
+}
+
+func (SchedulePO) TableName() string {
+	return schedulePOTableName
+}
+
+func (s *SchedulePO) Values() []any {
+	inputArgs := s.InputArgs
+	if s.InputArgs == nil {
+		inputArgs = []byte{}
+	}
+	return []any{
+		s.Id,
+		s.Namespace,
+		s.Name,
+		s.Shard,
+		s.TaskType,
+		s.CronExpr,
+		inputArgs,
+		s.CallbackUrl,
+		s.MaxRetryCnt,
+		s.ExpireAfter,
+		s.State,
+		s.NextFireTime,
+		s.LastFireTime,
+		s.Ctime,
+		s.Utime,
+		s.Version,
+	}
+}
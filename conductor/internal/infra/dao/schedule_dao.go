@@ -0,0 +1,217 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/huandu/go-sqlbuilder"
+	"github.com/ryanreadbooks/whimer/misc/uuid"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+)
+
+type ScheduleDao struct {
+	db *xsql.DB
+}
+
+func NewScheduleDao(db *xsql.DB) *ScheduleDao {
+	return &ScheduleDao{
+		db: db,
+	}
+}
+
+func (d *ScheduleDao) Insert(ctx context.Context, po *SchedulePO) error {
+	ib := sqlbuilder.NewInsertBuilder()
+	ib.InsertInto(schedulePOTableName)
+	ib.Cols(schedulePOFields...)
+	ib.Values(po.Values()...)
+
+	sql, args := ib.Build()
+	_, err := d.db.ExecCtx(ctx, sql, args...)
+	if err != nil {
+		return xsql.ConvertError(err)
+	}
+
+	return nil
+}
+
+func (d *ScheduleDao) GetByName(ctx context.Context, namespace, name string) (*SchedulePO, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+	sb.Select(schedulePOFields...)
+	sb.From(schedulePOTableName)
+	sb.Where(
+		sb.Equal("namespace", namespace),
+		sb.Equal("name", name),
+	)
+
+	sql, args := sb.Build()
+	var po SchedulePO
+	err := d.db.QueryRowCtx(ctx, &po, sql, args...)
+	if err != nil {
+		return nil, xsql.ConvertError(err)
+	}
+
+	return &po, nil
+}
+
+// ListByNamespace 分页查询命名空间下的定时任务
+func (d *ScheduleDao) ListByNamespace(ctx context.Context, namespace string, offset, limit int) ([]*SchedulePO, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+	sb.Select(schedulePOFields...)
+	sb.From(schedulePOTableName)
+	sb.Where(sb.Equal("namespace", namespace))
+	sb.OrderByAsc("id")
+	sb.Offset(offset)
+	sb.Limit(limit)
+
+	sql, args := sb.Build()
+	var pos []*SchedulePO
+	err := d.db.QueryRowsCtx(ctx, &pos, sql, args...)
+	if err != nil {
+		return nil, xsql.ConvertError(err)
+	}
+
+	return pos, nil
+}
+
+// CountByNamespace 统计命名空间下的定时任务总数
+func (d *ScheduleDao) CountByNamespace(ctx context.Context, namespace string) (int64, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+	sb.Select("COUNT(*)")
+	sb.From(schedulePOTableName)
+	sb.Where(sb.Equal("namespace", namespace))
+
+	sql, args := sb.Build()
+	var count int64
+	err := d.db.QueryRowCtx(ctx, &count, sql, args...)
+	if err != nil {
+		return 0, xsql.ConvertError(err)
+	}
+
+	return count, nil
+}
+
+// ListDue 查询已到触发时间的定时任务
+func (d *ScheduleDao) ListDue(
+	ctx context.Context,
+	state string,
+	shardStart, shardEnd int, // [shardStart, shardEnd)
+	now int64,
+	limit int32,
+) ([]*SchedulePO, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+	sb.Select(schedulePOFields...)
+	sb.From(schedulePOTableName)
+	sb.Where(
+		sb.Equal("state", state),
+		sb.GreaterEqualThan("shard", shardStart),
+		sb.LessThan("shard", shardEnd),
+		sb.LessEqualThan("next_fire_time", now),
+	)
+	sb.OrderByAsc("next_fire_time")
+	sb.Limit(int(limit))
+
+	sql, args := sb.Build()
+	var pos []*SchedulePO
+	err := d.db.QueryRowsCtx(ctx, &pos, sql, args...)
+	if err != nil {
+		return nil, xsql.ConvertError(err)
+	}
+
+	return pos, nil
+}
+
+// UpdateSpec 更新定时任务定义
+func (d *ScheduleDao) UpdateSpec(ctx context.Context, po *SchedulePO) error {
+	ub := sqlbuilder.NewUpdateBuilder()
+	ub.Update(schedulePOTableName)
+	ub.Set(
+		ub.Assign("task_type", po.TaskType),
+		ub.Assign("cron_expr", po.CronExpr),
+		ub.Assign("input_args", po.InputArgs),
+		ub.Assign("callback_url", po.CallbackUrl),
+		ub.Assign("max_retry_cnt", po.MaxRetryCnt),
+		ub.Assign("expire_after", po.ExpireAfter),
+		ub.Assign("next_fire_time", po.NextFireTime),
+		ub.Assign("utime", po.Utime),
+		ub.Incr("version"),
+	)
+	ub.Where(ub.Equal("id", po.Id))
+
+	sql, args := ub.Build()
+	_, err := d.db.ExecCtx(ctx, sql, args...)
+	if err != nil {
+		return xsql.ConvertError(err)
+	}
+
+	return nil
+}
+
+// UpdateState 更新定时任务状态
+func (d *ScheduleDao) UpdateState(ctx context.Context, id uuid.UUID, state string, nextFireTime, utime int64) error {
+	ub := sqlbuilder.NewUpdateBuilder()
+	ub.Update(schedulePOTableName)
+	ub.Set(
+		ub.Assign("state", state),
+		ub.Assign("next_fire_time", nextFireTime),
+		ub.Assign("utime", utime),
+		ub.Incr("version"),
+	)
+	ub.Where(ub.Equal("id", id))
+
+	sql, args := ub.Build()
+	_, err := d.db.ExecCtx(ctx, sql, args...)
+	if err != nil {
+		return xsql.ConvertError(err)
+	}
+
+	return nil
+}
+
+// UpdateFired 推进触发时间
+//
+// 通过 version 做乐观锁，返回是否更新成功；更新失败说明该次触发已经被处理
+func (d *ScheduleDao) UpdateFired(
+	ctx context.Context,
+	id uuid.UUID,
+	version int64,
+	lastFireTime, nextFireTime, utime int64,
+) (bool, error) {
+	ub := sqlbuilder.NewUpdateBuilder()
+	ub.Update(schedulePOTableName)
+	ub.Set(
+		ub.Assign("last_fire_time", lastFireTime),
+		ub.Assign("next_fire_time", nextFireTime),
+		ub.Assign("utime", utime),
+		ub.Incr("version"),
+	)
+	ub.Where(
+		ub.Equal("id", id),
+		ub.Equal("version", version),
+	)
+
+	sql, args := ub.Build()
+	res, err := d.db.ExecCtx(ctx, sql, args...)
+	if err != nil {
+		return false, xsql.ConvertError(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, xsql.ConvertError(err)
+	}
+
+	return affected > 0, nil
+}
+
+func (d *ScheduleDao) DeleteById(ctx context.Context, id uuid.UUID) error {
+	db := sqlbuilder.NewDeleteBuilder()
+	db.DeleteFrom(schedulePOTableName)
+	db.Where(db.Equal("id", id))
+
+	sql, args := db.Build()
+	_, err := d.db.ExecCtx(ctx, sql, args...)
+	if err != nil {
+		return xsql.ConvertError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/ryanreadbooks/whimer/misc/uuid"
+)
+
+// 存储接口 默认由 MySQL 实现 内嵌模式下使用内存实现
+//
+// 实现需要和 MySQL 保持一致的语义 记录不存在返回 xsql.ErrNoRecord 唯一键冲突返回 xsql.ErrDuplicate
+
+// Transactor 在同一事务中执行 fn
+type Transactor interface {
+	Transact(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type NamespaceStore interface {
+	Insert(ctx context.Context, po *NamespacePO) error
+	GetById(ctx context.Context, id []byte) (*NamespacePO, error)
+	GetByName(ctx context.Context, name string) (*NamespacePO, error)
+	UpdateById(ctx context.Context, id []byte, po *NamespacePO) error
+	DeleteById(ctx context.Context, id []byte) error
+	UpdateQuota(ctx context.Context, po *NamespacePO) error
+	UpdateCallbackSecret(ctx context.Context, po *NamespacePO) error
+	ListAll(ctx context.Context) ([]*NamespacePO, error)
+	List(ctx context.Context, offset, limit int) ([]*NamespacePO, error)
+	Count(ctx context.Context) (int64, error)
+}
+
+type TaskStore interface {
+	Insert(ctx context.Context, po *TaskPO) error
+	GetById(ctx context.Context, id uuid.UUID) (*TaskPO, error)
+	GetByNamespaceAndTaskTypeAndShard(ctx context.Context, namespace, taskType string, shard int) ([]*TaskPO, error)
+	ListTaskByState(ctx context.Context, state string, shardStart, shardEnd int, now int64, limit int32, offset uuid.UUID) ([]*TaskPO, error)
+	ListExpiredTasks(ctx context.Context, shardStart, shardEnd int, now int64, limit int32) ([]*TaskPO, error)
+	UpdateById(ctx context.Context, id uuid.UUID, po *TaskPO) error
+	DeleteById(ctx context.Context, id uuid.UUID) error
+	UpdateState(ctx context.Context, id uuid.UUID, state string, utime int64) error
+	UpdateDispatched(ctx context.Context, id uuid.UUID, fromStates []string, toState, workerId string, leaseExpire, utime int64) (bool, error)
+	UpdateAccepted(ctx context.Context, id uuid.UUID, fromStates []string, toState, workerId string, leaseExpire, deadline, utime int64) (bool, error)
+	RenewLease(ctx context.Context, id uuid.UUID, state, workerId string, leaseExpire int64) (bool, error)
+	ListLeaseExpired(ctx context.Context, shardStart, shardEnd int, now int64, limit int32) ([]*TaskPO, error)
+	UpdateReclaim(ctx context.Context, id uuid.UUID, fromState string, leaseExpire int64, toState string, incrRetry bool, outputArgs []byte, utime int64) (bool, error)
+	UpdateRetry(ctx context.Context, id uuid.UUID, fromStates []string, workerId, state string, utime int64) (bool, error)
+	UpdateComplete(ctx context.Context, id uuid.UUID, fromStates []string, workerId, state string, outputArgs []byte, utime int64) (bool, error)
+	GetByIds(ctx context.Context, ids []uuid.UUID) ([]*TaskPO, error)
+	ListByWorkflowId(ctx context.Context, workflowId uuid.UUID) ([]*TaskPO, error)
+	UpdateRelease(ctx context.Context, id uuid.UUID, fromState, toState string, expireTime int64, settings []byte, utime int64) (bool, error)
+	ListTasks(ctx context.Context, filter *ListTasksFilter, cursor uuid.UUID, limit int32) ([]*TaskPO, error)
+	UpdateRequeue(ctx context.Context, id uuid.UUID, fromStates []string, toState string, expireTime, utime int64) (bool, error)
+	ListPendingByPriority(ctx context.Context, namespace, state string, shardStart, shardEnd int, now int64, cursor PriorityCursor, limit int32) ([]*TaskPO, error)
+	HasPendingAbove(ctx context.Context, namespace, state string, shardStart, shardEnd int, now int64, priority int32, afterId uuid.UUID) (bool, error)
+	CountByStatesGroupByNamespace(ctx context.Context, states []string) ([]*NamespaceCount, error)
+	ListAssignedByWorkerIds(ctx context.Context, workerIds []string, states []string) ([]*WorkerAssignment, error)
+}
+
+type TaskHistoryStore interface {
+	Insert(ctx context.Context, po *TaskHistoryPO) error
+	GetById(ctx context.Context, id int64) (*TaskHistoryPO, error)
+	GetByTaskId(ctx context.Context, taskId uuid.UUID) ([]*TaskHistoryPO, error)
+	UpdateById(ctx context.Context, id int64, po *TaskHistoryPO) error
+	DeleteById(ctx context.Context, id int64) error
+}
+
+type ScheduleStore interface {
+	Insert(ctx context.Context, po *SchedulePO) error
+	GetByName(ctx context.Context, namespace, name string) (*SchedulePO, error)
+	ListByNamespace(ctx context.Context, namespace string, offset, limit int) ([]*SchedulePO, error)
+	CountByNamespace(ctx context.Context, namespace string) (int64, error)
+	ListDue(ctx context.Context, state string, shardStart, shardEnd int, now int64, limit int32) ([]*SchedulePO, error)
+	UpdateSpec(ctx context.Context, po *SchedulePO) error
+	UpdateState(ctx context.Context, id uuid.UUID, state string, nextFireTime, utime int64) error
+	UpdateFired(ctx context.Context, id uuid.UUID, version int64, lastFireTime, nextFireTime, utime int64) (bool, error)
+	DeleteById(ctx context.Context, id uuid.UUID) error
+}
+
+type IdempotencyStore interface {
+	Insert(ctx context.Context, po *IdempotencyPO) error
+	GetByKey(ctx context.Context, namespace, idemKey string) (*IdempotencyPO, error)
+	Renew(ctx context.Context, namespace, idemKey string, oldTaskId, newTaskId uuid.UUID, expireTime, ctime int64) (bool, error)
+	DeleteExpired(ctx context.Context, now int64, limit int) (int64, error)
+}
+
+type CallbackStore interface {
+	Insert(ctx context.Context, po *CallbackPO) error
+	ListByTaskId(ctx context.Context, taskId uuid.UUID) ([]*CallbackPO, error)
+	ListDue(ctx context.Context, state string, shardStart, shardEnd int, now int64, limit int32) ([]*CallbackPO, error)
+	Lease(ctx context.Context, id uuid.UUID, version int64, leaseUntil, utime int64) (bool, error)
+	UpdateResult(ctx context.Context, po *CallbackPO) (bool, error)
+}
+
+type WorkerStore interface {
+	Upsert(ctx context.Context, po *WorkerPO) error
+	Insert(ctx context.Context, po *WorkerPO) error
+	UpdateHeartbeat(ctx context.Context, id string, heartbeat int64) (bool, error)
+	DeleteById(ctx context.Context, id string) error
+	ListByHeartbeat(ctx context.Context, since int64) ([]*WorkerPO, error)
+	DeleteStale(ctx context.Context, before int64, limit int) (int64, error)
+}
+
+var (
+	_ NamespaceStore   = (*NamespaceDao)(nil)
+	_ TaskStore        = (*TaskDao)(nil)
+	_ TaskHistoryStore = (*TaskHistoryDao)(nil)
+	_ ScheduleStore    = (*ScheduleDao)(nil)
+	_ IdempotencyStore = (*IdempotencyDao)(nil)
+	_ CallbackStore    = (*CallbackDao)(nil)
+	_ WorkerStore      = (*WorkerDao)(nil)
+)
@@ -28,6 +28,13 @@ type TaskPO struct {
 	MaxRetryCnt   int64     `db:"max_retry_cnt"   json:"max_retry_cnt"` // -1表示无限重试直到超时, 0表示不重试
 	CurRetryCnt   int64     `db:"cur_retry_cnt"   json:"cur_retry_cnt"` // 当前已重试次数
 	ExpireTime    int64     `db:"expire_time"     json:"expire_time"`   // 任务过期时间 unix ms
+	NotBefore     int64     `db:"not_before"      json:"not_before"`    // 任务最早执行时间 unix ms
+	Priority      int32     `db:"priority"        json:"priority"`      // 优先级 越大越优先分发
+	WorkerId      string    `db:"worker_id"       json:"worker_id"`     // 最近一次分发到的 worker
+	LeaseExpire   int64     `db:"lease_expire"    json:"lease_expire"`  // 执行租约到期时间 unix ms 0表示无租约
+	Deadline      int64     `db:"deadline"        json:"deadline"`      // 执行截止时间 unix ms 0表示不限制
+	WorkflowId    uuid.UUID `db:"workflow_id"     json:"workflow_id"`   // 所属工作流id 不属于工作流则为空
+	WorkflowNode  string    `db:"workflow_node"   json:"workflow_node"` // 在工作流中的节点名
 	Settings      []byte    `db:"settings"        json:"settings"`      // 额外设置
 	Version       int64     `db:"version"         json:"version"`
 }
@@ -64,6 +71,13 @@ func (s *TaskPO) Values() []any {
 		s.MaxRetryCnt,
 		s.CurRetryCnt,
 		s.ExpireTime,
+		s.NotBefore,
+		s.Priority,
+		s.WorkerId,
+		s.LeaseExpire,
+		s.Deadline,
+		s.WorkflowId,
+		s.WorkflowNode,
 		settings,
 		s.Version,
 	}
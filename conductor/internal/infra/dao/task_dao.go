@@ -73,11 +73,12 @@ func (d *TaskDao) GetByNamespaceAndTaskTypeAndShard(ctx context.Context,
 	return pos, nil
 }
 
-// ListTaskByState 按状态分页查询任务
+// ListTaskByState 按状态分页查询已到达执行时间（not_before <= now）的任务
 func (d *TaskDao) ListTaskByState(
 	ctx context.Context,
 	state string,
 	shardStart, shardEnd int, // [shardStart, shardEnd)
+	now int64,
 	limit int32,
 	offset uuid.UUID,
 ) ([]*TaskPO, error) {
@@ -88,6 +89,7 @@ func (d *TaskDao) ListTaskByState(
 		sb.Equal("state", state),
 		sb.GreaterEqualThan("task_type_shard", shardStart),
 		sb.LessThan("task_type_shard", shardEnd),
+		sb.LessEqualThan("not_before", now),
 		sb.GreaterThan("id", offset),
 	)
 	sb.OrderByAsc("id")
@@ -131,6 +133,43 @@ func (d *TaskDao) ListExpiredTasks(
 	return pos, nil
 }
 
+// ListLeaseExpired 查询租约过期或超出执行截止时间的已分发和执行中任务
+func (d *TaskDao) ListLeaseExpired(
+	ctx context.Context,
+	shardStart, shardEnd int,
+	now int64,
+	limit int32,
+) ([]*TaskPO, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+	sb.Select(taskPOFields...)
+	sb.From(taskPOTableName)
+	sb.Where(
+		sb.In("state", "dispatched", "running"),
+		sb.GreaterEqualThan("task_type_shard", shardStart),
+		sb.LessThan("task_type_shard", shardEnd),
+		sb.Or(
+			sb.And(
+				sb.GreaterThan("lease_expire", 0),
+				sb.LessThan("lease_expire", now),
+			),
+			sb.And(
+				sb.GreaterThan("deadline", 0),
+				sb.LessThan("deadline", now),
+			),
+		),
+	)
+	sb.OrderByAsc("id")
+	sb.Limit(int(limit))
+
+	sql, args := sb.Build()
+	var pos []*TaskPO
+	err := d.db.QueryRowsCtx(ctx, &pos, sql, args...)
+	if err != nil {
+		return nil, xsql.ConvertError(err)
+	}
+	return pos, nil
+}
+
 func (d *TaskDao) UpdateById(ctx context.Context, id uuid.UUID, po *TaskPO) error {
 	ub := sqlbuilder.NewUpdateBuilder()
 	ub.Update(taskPOTableName)
@@ -146,6 +185,8 @@ func (d *TaskDao) UpdateById(ctx context.Context, id uuid.UUID, po *TaskPO) erro
 		ub.Assign("utime", po.Utime),
 		ub.Assign("max_retry_cnt", po.MaxRetryCnt),
 		ub.Assign("expire_time", po.ExpireTime),
+		ub.Assign("not_before", po.NotBefore),
+		ub.Assign("priority", po.Priority),
 		ub.Assign("settings", po.Settings),
 		ub.Assign("version", po.Version+1),
 	)
@@ -196,8 +237,142 @@ func (d *TaskDao) UpdateState(ctx context.Context, id uuid.UUID, state string, u
 	return nil
 }
 
+// UpdateDispatched 更新任务为已分发状态 同时记录分发到的 worker 和接受任务的租约
+//
+// 只有当前状态在 fromStates 中时才会更新，返回是否更新成功
+func (d *TaskDao) UpdateDispatched(
+	ctx context.Context,
+	id uuid.UUID,
+	fromStates []string,
+	toState, workerId string,
+	leaseExpire int64,
+	utime int64,
+) (bool, error) {
+	states := make([]any, 0, len(fromStates))
+	for _, state := range fromStates {
+		states = append(states, state)
+	}
+
+	ub := sqlbuilder.NewUpdateBuilder()
+	ub.Update(taskPOTableName)
+	ub.Set(
+		ub.Assign("state", toState),
+		ub.Assign("worker_id", workerId),
+		ub.Assign("lease_expire", leaseExpire),
+		ub.Assign("deadline", 0),
+		ub.Assign("utime", utime),
+		ub.Incr("version"),
+	)
+	ub.Where(
+		ub.Equal("id", id),
+		ub.In("state", states...),
+	)
+
+	sql, args := ub.Build()
+	res, err := d.db.ExecCtx(ctx, sql, args...)
+	if err != nil {
+		return false, xsql.ConvertError(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, xsql.ConvertError(err)
+	}
+
+	return affected > 0, nil
+}
+
+// UpdateAccepted 更新任务为执行中 同时设置执行租约和执行截止时间
+//
+// 只有当前状态在 fromStates 中时才会更新，返回是否更新成功
+func (d *TaskDao) UpdateAccepted(
+	ctx context.Context,
+	id uuid.UUID,
+	fromStates []string,
+	toState, workerId string,
+	leaseExpire, deadline int64,
+	utime int64,
+) (bool, error) {
+	states := make([]any, 0, len(fromStates))
+	for _, state := range fromStates {
+		states = append(states, state)
+	}
+
+	ub := sqlbuilder.NewUpdateBuilder()
+	ub.Update(taskPOTableName)
+	ub.Set(
+		ub.Assign("state", toState),
+		ub.Assign("worker_id", workerId),
+		ub.Assign("lease_expire", leaseExpire),
+		ub.Assign("deadline", deadline),
+		ub.Assign("utime", utime),
+		ub.Incr("version"),
+	)
+	ub.Where(
+		ub.Equal("id", id),
+		ub.In("state", states...),
+	)
+
+	sql, args := ub.Build()
+	res, err := d.db.ExecCtx(ctx, sql, args...)
+	if err != nil {
+		return false, xsql.ConvertError(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, xsql.ConvertError(err)
+	}
+
+	return affected > 0, nil
+}
+
+// RenewLease 续期执行租约
+//
+// 只有任务处于 state 且仍分配给 workerId 时才会更新，workerId 为空时不校验 worker；返回是否更新成功
+func (d *TaskDao) RenewLease(
+	ctx context.Context,
+	id uuid.UUID,
+	state, workerId string,
+	leaseExpire int64,
+) (bool, error) {
+	ub := sqlbuilder.NewUpdateBuilder()
+	ub.Update(taskPOTableName)
+	ub.Set(
+		ub.Assign("lease_expire", leaseExpire),
+	)
+	ub.Where(
+		ub.Equal("id", id),
+		ub.Equal("state", state),
+	)
+	if workerId != "" {
+		ub.Where(ub.Equal("worker_id", workerId))
+	}
+
+	sql, args := ub.Build()
+	res, err := d.db.ExecCtx(ctx, sql, args...)
+	if err != nil {
+		return false, xsql.ConvertError(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, xsql.ConvertError(err)
+	}
+
+	return affected > 0, nil
+}
+
 // UpdateRetry 更新任务为重试状态，同时增加重试计数
-func (d *TaskDao) UpdateRetry(ctx context.Context, id uuid.UUID, state string, utime int64) error {
+//
+// 只有任务处于 fromStates 且仍由 workerId 持有时才会更新（workerId 为空时不校验 worker），返回是否更新成功
+func (d *TaskDao) UpdateRetry(
+	ctx context.Context,
+	id uuid.UUID,
+	fromStates []string,
+	workerId, state string,
+	utime int64,
+) (bool, error) {
 	ub := sqlbuilder.NewUpdateBuilder()
 	ub.Update(taskPOTableName)
 	ub.Set(
@@ -206,24 +381,100 @@ func (d *TaskDao) UpdateRetry(ctx context.Context, id uuid.UUID, state string, u
 		ub.Incr("cur_retry_cnt"),
 		ub.Incr("version"),
 	)
-	ub.Where(ub.Equal("id", id))
+	ub.Where(heldByCond(&ub.Cond, id, fromStates, workerId)...)
 
 	sql, args := ub.Build()
-	_, err := d.db.ExecCtx(ctx, sql, args...)
+	res, err := d.db.ExecCtx(ctx, sql, args...)
 	if err != nil {
-		return xsql.ConvertError(err)
+		return false, xsql.ConvertError(err)
 	}
 
-	return nil
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, xsql.ConvertError(err)
+	}
+
+	return affected > 0, nil
+}
+
+// 任务处于 fromStates 且由 workerId 持有
+func heldByCond(cond *sqlbuilder.Cond, id uuid.UUID, fromStates []string, workerId string) []string {
+	states := make([]any, 0, len(fromStates))
+	for _, state := range fromStates {
+		states = append(states, state)
+	}
+
+	exprs := []string{
+		cond.Equal("id", id),
+		cond.In("state", states...),
+	}
+	if workerId != "" {
+		exprs = append(exprs, cond.Equal("worker_id", workerId))
+	}
+
+	return exprs
+}
+
+// UpdateReclaim 回收租约过期或执行超时的任务 同时清空租约
+//
+// 只有当前状态为 fromState 且租约未被续期时才会更新，返回是否更新成功；
+// incrRetry 为 true 时增加重试计数，outputArgs 不为 nil 时记录到 output_args
+func (d *TaskDao) UpdateReclaim(
+	ctx context.Context,
+	id uuid.UUID,
+	fromState string,
+	leaseExpire int64,
+	toState string,
+	incrRetry bool,
+	outputArgs []byte,
+	utime int64,
+) (bool, error) {
+	ub := sqlbuilder.NewUpdateBuilder()
+	ub.Update(taskPOTableName)
+	ub.Set(
+		ub.Assign("state", toState),
+		ub.Assign("lease_expire", 0),
+		ub.Assign("deadline", 0),
+		ub.Assign("utime", utime),
+		ub.Incr("version"),
+	)
+	if incrRetry {
+		ub.SetMore(ub.Incr("cur_retry_cnt"))
+	}
+	if outputArgs != nil {
+		ub.SetMore(ub.Assign("output_args", outputArgs))
+	}
+	ub.Where(
+		ub.Equal("id", id),
+		ub.Equal("state", fromState),
+		ub.Equal("lease_expire", leaseExpire),
+	)
+
+	sql, args := ub.Build()
+	res, err := d.db.ExecCtx(ctx, sql, args...)
+	if err != nil {
+		return false, xsql.ConvertError(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, xsql.ConvertError(err)
+	}
+
+	return affected > 0, nil
 }
 
+// UpdateComplete 更新任务为终态
+//
+// 只有任务处于 fromStates 且仍由 workerId 持有时才会更新（workerId 为空时不校验 worker），返回是否更新成功
 func (d *TaskDao) UpdateComplete(
 	ctx context.Context,
 	id uuid.UUID,
-	state string,
+	fromStates []string,
+	workerId, state string,
 	outputArgs []byte,
 	utime int64,
-) error {
+) (bool, error) {
 	ub := sqlbuilder.NewUpdateBuilder()
 	ub.Update(taskPOTableName)
 	ub.Set(
@@ -232,15 +483,20 @@ func (d *TaskDao) UpdateComplete(
 		ub.Assign("utime", utime),
 		ub.Incr("version"),
 	)
-	ub.Where(ub.Equal("id", id))
+	ub.Where(heldByCond(&ub.Cond, id, fromStates, workerId)...)
 
 	sql, args := ub.Build()
-	_, err := d.db.ExecCtx(ctx, sql, args...)
+	res, err := d.db.ExecCtx(ctx, sql, args...)
 	if err != nil {
-		return xsql.ConvertError(err)
+		return false, xsql.ConvertError(err)
 	}
 
-	return nil
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, xsql.ConvertError(err)
+	}
+
+	return affected > 0, nil
 }
 
 // ListFailureTasks 查询失败状态且可重试的任务
@@ -273,3 +529,330 @@ func (d *TaskDao) UpdateComplete(
 // 	}
 // 	return pos, nil
 // }
+
+// GetByIds 批量获取任务
+func (d *TaskDao) GetByIds(ctx context.Context, ids []uuid.UUID) ([]*TaskPO, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	args := make([]any, 0, len(ids))
+	for _, id := range ids {
+		args = append(args, id)
+	}
+
+	sb := sqlbuilder.NewSelectBuilder()
+	sb.Select(taskPOFields...)
+	sb.From(taskPOTableName)
+	sb.Where(sb.In("id", args...))
+
+	sql, sqlArgs := sb.Build()
+	var pos []*TaskPO
+	err := d.db.QueryRowsCtx(ctx, &pos, sql, sqlArgs...)
+	if err != nil {
+		return nil, xsql.ConvertError(err)
+	}
+
+	return pos, nil
+}
+
+// ListByWorkflowId 获取工作流下的所有任务
+func (d *TaskDao) ListByWorkflowId(ctx context.Context, workflowId uuid.UUID) ([]*TaskPO, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+	sb.Select(taskPOFields...)
+	sb.From(taskPOTableName)
+	sb.Where(sb.Equal("workflow_id", workflowId))
+	sb.OrderByAsc("id")
+
+	sql, args := sb.Build()
+	var pos []*TaskPO
+	err := d.db.QueryRowsCtx(ctx, &pos, sql, args...)
+	if err != nil {
+		return nil, xsql.ConvertError(err)
+	}
+
+	return pos, nil
+}
+
+// UpdateRelease 放行等待上游的任务
+//
+// 只有当前状态为 fromState 时才会更新，返回是否更新成功
+func (d *TaskDao) UpdateRelease(
+	ctx context.Context,
+	id uuid.UUID,
+	fromState, toState string,
+	expireTime int64,
+	settings []byte,
+	utime int64,
+) (bool, error) {
+	ub := sqlbuilder.NewUpdateBuilder()
+	ub.Update(taskPOTableName)
+	ub.Set(
+		ub.Assign("state", toState),
+		ub.Assign("expire_time", expireTime),
+		ub.Assign("settings", settings),
+		ub.Assign("utime", utime),
+		ub.Incr("version"),
+	)
+	ub.Where(
+		ub.Equal("id", id),
+		ub.Equal("state", fromState),
+	)
+
+	sql, args := ub.Build()
+	res, err := d.db.ExecCtx(ctx, sql, args...)
+	if err != nil {
+		return false, xsql.ConvertError(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, xsql.ConvertError(err)
+	}
+
+	return affected > 0, nil
+}
+
+// ListTasksFilter 列出任务的过滤条件 零值表示不过滤
+type ListTasksFilter struct {
+	Namespace  string
+	TaskType   string
+	State      string
+	CtimeStart int64 // [CtimeStart, CtimeEnd) unix ms
+	CtimeEnd   int64
+}
+
+// ListTasks 按条件倒序分页列出任务 返回 id < cursor 的任务
+func (d *TaskDao) ListTasks(
+	ctx context.Context,
+	filter *ListTasksFilter,
+	cursor uuid.UUID,
+	limit int32,
+) ([]*TaskPO, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+	sb.Select(taskPOFields...)
+	sb.From(taskPOTableName)
+	sb.Where(
+		sb.Equal("namespace", filter.Namespace),
+		sb.LessThan("id", cursor),
+	)
+	if filter.TaskType != "" {
+		sb.Where(sb.Equal("task_type", filter.TaskType))
+	}
+	if filter.State != "" {
+		sb.Where(sb.Equal("state", filter.State))
+	}
+	if filter.CtimeStart > 0 {
+		sb.Where(sb.GreaterEqualThan("ctime", filter.CtimeStart))
+	}
+	if filter.CtimeEnd > 0 {
+		sb.Where(sb.LessThan("ctime", filter.CtimeEnd))
+	}
+	sb.OrderByDesc("id")
+	sb.Limit(int(limit))
+
+	sql, args := sb.Build()
+	var pos []*TaskPO
+	err := d.db.QueryRowsCtx(ctx, &pos, sql, args...)
+	if err != nil {
+		return nil, xsql.ConvertError(err)
+	}
+
+	return pos, nil
+}
+
+// UpdateRequeue 将任务重新放回待重试队列 同时清空重试计数
+//
+// 只有当前状态在 fromStates 中时才会更新，返回是否更新成功
+func (d *TaskDao) UpdateRequeue(
+	ctx context.Context,
+	id uuid.UUID,
+	fromStates []string,
+	toState string,
+	expireTime int64,
+	utime int64,
+) (bool, error) {
+	states := make([]any, 0, len(fromStates))
+	for _, state := range fromStates {
+		states = append(states, state)
+	}
+
+	ub := sqlbuilder.NewUpdateBuilder()
+	ub.Update(taskPOTableName)
+	ub.Set(
+		ub.Assign("state", toState),
+		ub.Assign("cur_retry_cnt", 0),
+		ub.Assign("expire_time", expireTime),
+		ub.Assign("utime", utime),
+		ub.Incr("version"),
+	)
+	ub.Where(
+		ub.Equal("id", id),
+		ub.In("state", states...),
+	)
+
+	sql, args := ub.Build()
+	res, err := d.db.ExecCtx(ctx, sql, args...)
+	if err != nil {
+		return false, xsql.ConvertError(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, xsql.ConvertError(err)
+	}
+
+	return affected > 0, nil
+}
+
+// PriorityCursor 按 (priority DESC, id ASC) 扫描的游标
+type PriorityCursor struct {
+	Priority int32
+	Id       uuid.UUID
+}
+
+// ListPendingByPriority 按优先级从高到低分页查询命名空间下已到达执行时间的任务
+//
+// 返回排在 cursor 之后的任务
+func (d *TaskDao) ListPendingByPriority(
+	ctx context.Context,
+	namespace string,
+	state string,
+	shardStart, shardEnd int, // [shardStart, shardEnd)
+	now int64,
+	cursor PriorityCursor,
+	limit int32,
+) ([]*TaskPO, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+	sb.Select(taskPOFields...)
+	sb.From(taskPOTableName)
+	sb.Where(
+		sb.Equal("namespace", namespace),
+		sb.Equal("state", state),
+		sb.GreaterEqualThan("task_type_shard", shardStart),
+		sb.LessThan("task_type_shard", shardEnd),
+		sb.LessEqualThan("not_before", now),
+		sb.Or(
+			sb.LessThan("priority", cursor.Priority),
+			sb.And(
+				sb.Equal("priority", cursor.Priority),
+				sb.GreaterThan("id", cursor.Id),
+			),
+		),
+	)
+	sb.OrderByDesc("priority")
+	sb.OrderByAsc("id")
+	sb.Limit(int(limit))
+
+	sql, args := sb.Build()
+	var pos []*TaskPO
+	err := d.db.QueryRowsCtx(ctx, &pos, sql, args...)
+	if err != nil {
+		return nil, xsql.ConvertError(err)
+	}
+	return pos, nil
+}
+
+// HasPendingAbove 是否存在 id 大于 afterId 且优先级高于 priority 的任务
+func (d *TaskDao) HasPendingAbove(
+	ctx context.Context,
+	namespace string,
+	state string,
+	shardStart, shardEnd int, // [shardStart, shardEnd)
+	now int64,
+	priority int32,
+	afterId uuid.UUID,
+) (bool, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+	sb.Select("priority")
+	sb.From(taskPOTableName)
+	sb.Where(
+		sb.Equal("namespace", namespace),
+		sb.Equal("state", state),
+		sb.GreaterEqualThan("task_type_shard", shardStart),
+		sb.LessThan("task_type_shard", shardEnd),
+		sb.LessEqualThan("not_before", now),
+		sb.GreaterThan("priority", priority),
+		sb.GreaterThan("id", afterId),
+	)
+	sb.Limit(1)
+
+	sql, args := sb.Build()
+	var priorities []int32
+	err := d.db.QueryRowsCtx(ctx, &priorities, sql, args...)
+	if err != nil {
+		return false, xsql.ConvertError(err)
+	}
+	return len(priorities) > 0, nil
+}
+
+type NamespaceCount struct {
+	Namespace string `db:"namespace"`
+	Cnt       int64  `db:"cnt"`
+}
+
+// CountByStatesGroupByNamespace 按命名空间统计处于指定状态的任务数
+func (d *TaskDao) CountByStatesGroupByNamespace(ctx context.Context, states []string) ([]*NamespaceCount, error) {
+	args := make([]any, 0, len(states))
+	for _, state := range states {
+		args = append(args, state)
+	}
+
+	sb := sqlbuilder.NewSelectBuilder()
+	sb.Select("namespace", sb.As("COUNT(*)", "cnt"))
+	sb.From(taskPOTableName)
+	sb.Where(sb.In("state", args...))
+	sb.GroupBy("namespace")
+
+	sql, sqlArgs := sb.Build()
+	var counts []*NamespaceCount
+	err := d.db.QueryRowsCtx(ctx, &counts, sql, sqlArgs...)
+	if err != nil {
+		return nil, xsql.ConvertError(err)
+	}
+	return counts, nil
+}
+
+// WorkerAssignment 分配给 worker 的任务
+type WorkerAssignment struct {
+	TaskId   uuid.UUID `db:"id"`
+	WorkerId string    `db:"worker_id"`
+}
+
+// ListAssignedByWorkerIds 查询分配给指定 worker 且处于指定状态的任务
+func (d *TaskDao) ListAssignedByWorkerIds(
+	ctx context.Context,
+	workerIds []string,
+	states []string,
+) ([]*WorkerAssignment, error) {
+	if len(workerIds) == 0 || len(states) == 0 {
+		return nil, nil
+	}
+
+	workerArgs := make([]any, 0, len(workerIds))
+	for _, workerId := range workerIds {
+		workerArgs = append(workerArgs, workerId)
+	}
+	stateArgs := make([]any, 0, len(states))
+	for _, state := range states {
+		stateArgs = append(stateArgs, state)
+	}
+
+	sb := sqlbuilder.NewSelectBuilder()
+	sb.Select("id", "worker_id")
+	sb.From(taskPOTableName)
+	sb.Where(
+		sb.In("worker_id", workerArgs...),
+		sb.In("state", stateArgs...),
+	)
+	sb.OrderByAsc("id")
+
+	sql, sqlArgs := sb.Build()
+	var assignments []*WorkerAssignment
+	err := d.db.QueryRowsCtx(ctx, &assignments, sql, sqlArgs...)
+	if err != nil {
+		return nil, xsql.ConvertError(err)
+	}
+
+	return assignments, nil
+}
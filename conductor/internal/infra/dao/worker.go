@@ -0,0 +1,48 @@
+package dao
+
+import (
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+)
+
+const (
+	workerPOTableName = "conductor_worker"
+)
+
+var (
+	workerPOFields = xsql.GetFieldSlice(&WorkerPO{})
+)
+
+// WorkerPO 已注册的 worker
+type WorkerPO struct {
+	Id            string `db:"id"             json:"id"`
+	Ip            string `db:"ip"             json:"ip"`
+	TaskTypes     []byte `db:"task_types"     json:"task_types"` // json 数组
+	Labels        []byte `db:"labels"         json:"labels"`     // json 对象
+	Concurrency   int32  `db:"concurrency"    json:"concurrency"`
+	RegisterTime  int64  `db:"register_time"  json:"register_time"`  // unix ms
+	LastHeartbeat int64  `db:"last_heartbeat" json:"last_heartbeat"` // unix ms
+}
+
+func (WorkerPO) TableName() string {
+	return workerPOTableName
+}
+
+func (s *WorkerPO) Values() []any {
+	taskTypes := s.TaskTypes
+	if s.TaskTypes == nil {
+		taskTypes = []byte{}
+	}
+	labels := s.Labels
+	if s.Labels == nil {
+		labels = []byte{}
+	}
+	return []any{
+		s.Id,
+		s.Ip,
+		taskTypes,
+		labels,
+		s.Concurrency,
+		s.RegisterTime,
+		s.LastHeartbeat,
+	}
+}
@@ -0,0 +1,148 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/huandu/go-sqlbuilder"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+)
+
+type WorkerDao struct {
+	db *xsql.DB
+}
+
+func NewWorkerDao(db *xsql.DB) *WorkerDao {
+	return &WorkerDao{
+		db: db,
+	}
+}
+
+// Upsert 注册 worker 已存在时覆盖能力和标签
+func (d *WorkerDao) Upsert(ctx context.Context, po *WorkerPO) error {
+	ib := sqlbuilder.NewInsertBuilder()
+	ib.InsertInto(workerPOTableName)
+	ib.Cols(workerPOFields...)
+	ib.Values(po.Values()...)
+	ib.SQL("ON DUPLICATE KEY UPDATE ip=VALUES(ip), task_types=VALUES(task_types), labels=VALUES(labels), " +
+		"concurrency=VALUES(concurrency), register_time=VALUES(register_time), last_heartbeat=VALUES(last_heartbeat)")
+
+	sql, args := ib.Build()
+	_, err := d.db.ExecCtx(ctx, sql, args...)
+	if err != nil {
+		return xsql.ConvertError(err)
+	}
+
+	return nil
+}
+
+// Insert 插入 worker 已存在时不做处理
+func (d *WorkerDao) Insert(ctx context.Context, po *WorkerPO) error {
+	ib := sqlbuilder.NewInsertBuilder()
+	ib.InsertInto(workerPOTableName)
+	ib.Cols(workerPOFields...)
+	ib.Values(po.Values()...)
+	ib.SQL("ON DUPLICATE KEY UPDATE last_heartbeat=VALUES(last_heartbeat)")
+
+	sql, args := ib.Build()
+	_, err := d.db.ExecCtx(ctx, sql, args...)
+	if err != nil {
+		return xsql.ConvertError(err)
+	}
+
+	return nil
+}
+
+// UpdateHeartbeat 更新心跳时间 返回 worker 是否存在
+func (d *WorkerDao) UpdateHeartbeat(ctx context.Context, id string, heartbeat int64) (bool, error) {
+	ub := sqlbuilder.NewUpdateBuilder()
+	ub.Update(workerPOTableName)
+	ub.Set(ub.Assign("last_heartbeat", heartbeat))
+	ub.Where(ub.Equal("id", id))
+
+	sql, args := ub.Build()
+	res, err := d.db.ExecCtx(ctx, sql, args...)
+	if err != nil {
+		return false, xsql.ConvertError(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, xsql.ConvertError(err)
+	}
+
+	// 心跳时间未变化时 affected 也为 0 这里按存在处理
+	if affected == 0 {
+		return d.exists(ctx, id)
+	}
+
+	return true, nil
+}
+
+func (d *WorkerDao) exists(ctx context.Context, id string) (bool, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+	sb.Select("COUNT(*)")
+	sb.From(workerPOTableName)
+	sb.Where(sb.Equal("id", id))
+
+	sql, args := sb.Build()
+	var count int64
+	err := d.db.QueryRowCtx(ctx, &count, sql, args...)
+	if err != nil {
+		return false, xsql.ConvertError(err)
+	}
+
+	return count > 0, nil
+}
+
+func (d *WorkerDao) DeleteById(ctx context.Context, id string) error {
+	db := sqlbuilder.NewDeleteBuilder()
+	db.DeleteFrom(workerPOTableName)
+	db.Where(db.Equal("id", id))
+
+	sql, args := db.Build()
+	_, err := d.db.ExecCtx(ctx, sql, args...)
+	if err != nil {
+		return xsql.ConvertError(err)
+	}
+
+	return nil
+}
+
+// ListByHeartbeat 查询最近心跳时间不早于 since 的 worker
+func (d *WorkerDao) ListByHeartbeat(ctx context.Context, since int64) ([]*WorkerPO, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+	sb.Select(workerPOFields...)
+	sb.From(workerPOTableName)
+	sb.Where(sb.GreaterEqualThan("last_heartbeat", since))
+	sb.OrderByAsc("id")
+
+	sql, args := sb.Build()
+	var pos []*WorkerPO
+	err := d.db.QueryRowsCtx(ctx, &pos, sql, args...)
+	if err != nil {
+		return nil, xsql.ConvertError(err)
+	}
+
+	return pos, nil
+}
+
+// DeleteStale 清理最近心跳时间早于 before 的 worker
+func (d *WorkerDao) DeleteStale(ctx context.Context, before int64, limit int) (int64, error) {
+	db := sqlbuilder.NewDeleteBuilder()
+	db.DeleteFrom(workerPOTableName)
+	db.Where(db.LessThan("last_heartbeat", before))
+	db.Limit(limit)
+
+	sql, args := db.Build()
+	res, err := d.db.ExecCtx(ctx, sql, args...)
+	if err != nil {
+		return 0, xsql.ConvertError(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, xsql.ConvertError(err)
+	}
+
+	return affected, nil
+}
@@ -1,18 +1,21 @@
 package infra
 
 import (
+	"strings"
 	"sync"
 
 	"github.com/ryanreadbooks/whimer/conductor/internal/config"
 	infradao "github.com/ryanreadbooks/whimer/conductor/internal/infra/dao"
 	"github.com/ryanreadbooks/whimer/conductor/internal/infra/etcd"
 
+	goredis "github.com/redis/go-redis/v9"
 	"github.com/zeromicro/go-zero/core/stores/redis"
 )
 
 var (
 	dao     *infradao.Dao
 	cache   *redis.Redis
+	pubsub  goredis.UniversalClient
 	etcdCli *etcd.Client
 	once    sync.Once
 )
@@ -21,6 +24,11 @@ func Init(c *config.Config) {
 	once.Do(func() {
 		etcdCli = etcd.MustNew(c)
 		cache = redis.MustNewRedis(c.Redis)
+		// go-zero 的 redis 不支持订阅 单独建立连接用于 pub/sub
+		pubsub = goredis.NewUniversalClient(&goredis.UniversalOptions{
+			Addrs:    strings.Split(c.Redis.Host, ","),
+			Password: c.Redis.Pass,
+		})
 		dao = infradao.MustNew(c, cache)
 	})
 }
@@ -33,8 +41,16 @@ func Cache() *redis.Redis {
 	return cache
 }
 
+// PubSub 用于跨实例广播任务事件
+func PubSub() goredis.UniversalClient {
+	return pubsub
+}
+
 func Close() {
 	dao.DB().Close()
+	if pubsub != nil {
+		pubsub.Close()
+	}
 	if etcdCli != nil {
 		etcdCli.GetClient().Close()
 	}
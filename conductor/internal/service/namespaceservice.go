@@ -17,9 +17,25 @@ func NewNamespaceService(bizz *biz.Biz) *NamespaceService {
 	}
 }
 
+type NamespaceReq struct {
+	Name        string
+	Weight      int32 // 分发权重
+	MaxInflight int64 // 同时在执行的任务数上限 0表示不限制
+}
+
 // CreateNamespace 创建命名空间
-func (s *NamespaceService) CreateNamespace(ctx context.Context, name string) (*model.Namespace, error) {
-	return s.namespaceBiz.Create(ctx, name)
+func (s *NamespaceService) CreateNamespace(ctx context.Context, req *NamespaceReq) (*model.Namespace, error) {
+	return s.namespaceBiz.Create(ctx, req.Name, req.Weight, req.MaxInflight)
+}
+
+// UpdateNamespace 更新命名空间的分发权重和配额
+func (s *NamespaceService) UpdateNamespace(ctx context.Context, req *NamespaceReq) (*model.Namespace, error) {
+	return s.namespaceBiz.UpdateQuota(ctx, req.Name, req.Weight, req.MaxInflight)
+}
+
+// ResetCallbackSecret 重置命名空间的回调签名密钥
+func (s *NamespaceService) ResetCallbackSecret(ctx context.Context, name string) (*model.Namespace, error) {
+	return s.namespaceBiz.ResetCallbackSecret(ctx, name)
 }
 
 // ListNamespace 分页列出命名空间
@@ -15,67 +15,105 @@ import (
 )
 
 const (
-	defaultScanLimit    = 100
-	defaultTaskChBuffer = 10000
+	defaultScanLimit = 100
 )
 
+// pendingCursor 待分发任务按 (priority DESC, id ASC) 扫描的游标
+type pendingCursor struct {
+	priority int32
+	id       uuid.UUID
+
+	// 本轮扫描开始时生成的id 之后注册的更高优先级任务会让扫描从头开始
+	passStart uuid.UUID
+}
+
+func newPendingCursor() pendingCursor {
+	return pendingCursor{
+		priority:  model.MaxTaskPriority + 1,
+		id:        uuid.EmptyUUID(),
+		passStart: uuid.NewUUID(),
+	}
+}
+
+// atStart 是否处于一轮扫描的开头
+func (c pendingCursor) atStart() bool {
+	return c.priority > model.MaxTaskPriority
+}
+
+type pendingCursorKey struct {
+	state     model.TaskState
+	namespace string
+}
+
 // scanState 扫描状态
 type scanState struct {
-	mu                 sync.RWMutex
-	initedOffset       uuid.UUID
-	pendingRetryOffset uuid.UUID
+	mu             sync.RWMutex
+	pendingCursors map[pendingCursorKey]pendingCursor
+	blockedOffset  uuid.UUID
 }
 
 func newScanState() *scanState {
 	return &scanState{
-		initedOffset:       uuid.EmptyUUID(),
-		pendingRetryOffset: uuid.EmptyUUID(),
+		pendingCursors: make(map[pendingCursorKey]pendingCursor),
+		blockedOffset:  uuid.EmptyUUID(),
 	}
 }
 
-func (s *scanState) GetInitedOffset() uuid.UUID {
+func (s *scanState) GetPendingCursor(state model.TaskState, namespace string) pendingCursor {
 	s.mu.RLock()
-	defer s.mu.RUnlock()
-	return s.initedOffset
+	cursor, ok := s.pendingCursors[pendingCursorKey{state, namespace}]
+	s.mu.RUnlock()
+	if !ok {
+		return newPendingCursor()
+	}
+	return cursor
 }
 
-func (s *scanState) SetInitedOffset(offset uuid.UUID) {
+func (s *scanState) SetPendingCursor(state model.TaskState, namespace string, cursor pendingCursor) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	s.initedOffset = offset
+	s.pendingCursors[pendingCursorKey{state, namespace}] = cursor
 }
 
-func (s *scanState) ResetInited() {
-	s.SetInitedOffset(uuid.EmptyUUID())
+func (s *scanState) ResetPendingCursor(state model.TaskState, namespace string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.pendingCursors, pendingCursorKey{state, namespace})
 }
 
-func (s *scanState) GetPendingRetryOffset() uuid.UUID {
+func (s *scanState) GetBlockedOffset() uuid.UUID {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
-	return s.pendingRetryOffset
+	return s.blockedOffset
 }
 
-func (s *scanState) SetPendingRetryOffset(offset uuid.UUID) {
+func (s *scanState) SetBlockedOffset(offset uuid.UUID) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	s.pendingRetryOffset = offset
+	s.blockedOffset = offset
 }
 
-func (s *scanState) ResetPendingRetry() {
-	s.SetPendingRetryOffset(uuid.EmptyUUID())
+func (s *scanState) ResetBlocked() {
+	s.SetBlockedOffset(uuid.EmptyUUID())
 }
 
 type ScanService struct {
-	conf      *config.Config
-	bizz      *biz.Biz
-	shardBiz  *shard.Biz
-	taskBiz   *biz.TaskBiz
-	workerBiz *biz.WorkerBiz
+	conf         *config.Config
+	bizz         *biz.Biz
+	shardBiz     shard.Sharder
+	taskBiz      *biz.TaskBiz
+	workerBiz    *biz.WorkerBiz
+	namespaceBiz *biz.NamespaceBiz
+
+	scheduleBiz *biz.ScheduleBiz
+	workflowBiz *biz.WorkflowBiz
+	callbackBiz *biz.CallbackBiz
+	watchBiz    *biz.WatchBiz
 
 	state *scanState
 
-	// 任务传递 channel
-	taskCh chan *model.Task
+	// 待分发任务 按优先级和命名空间权重出队
+	dispatchQueue *biz.DispatchQueue
 
 	quitCh chan struct{}
 	doneCh chan struct{}
@@ -83,15 +121,21 @@ type ScanService struct {
 
 func NewScanService(conf *config.Config, bizz *biz.Biz) *ScanService {
 	return &ScanService{
-		conf:      conf,
-		bizz:      bizz,
-		shardBiz:  bizz.ShardBiz,
-		taskBiz:   bizz.TaskBiz,
-		workerBiz: bizz.WorkerBiz,
-		state:     newScanState(),
-		taskCh:    make(chan *model.Task, defaultTaskChBuffer),
-		quitCh:    make(chan struct{}),
-		doneCh:    make(chan struct{}),
+		conf:          conf,
+		bizz:          bizz,
+		shardBiz:      bizz.ShardBiz,
+		taskBiz:       bizz.TaskBiz,
+		workerBiz:     bizz.WorkerBiz,
+		namespaceBiz:  bizz.NamespaceBiz,
+		state:         newScanState(),
+		dispatchQueue: biz.NewDispatchQueue(),
+		quitCh:        make(chan struct{}),
+		doneCh:        make(chan struct{}),
+
+		scheduleBiz: bizz.ScheduleBiz,
+		workflowBiz: bizz.WorkflowBiz,
+		callbackBiz: bizz.CallbackBiz,
+		watchBiz:    bizz.WatchBiz,
 	}
 }
 
@@ -126,6 +170,26 @@ func (s *ScanService) Start(ctx context.Context) {
 			return nil
 		},
 	})
+
+	// 定时任务触发协程
+	concurrent.SafeGo2(ctx, concurrent.SafeGo2Opt{
+		Name:             "conductor.scan.schedule",
+		InheritCtxCancel: true,
+		Job: func(ctx context.Context) error {
+			s.scheduleScanLoop(ctx)
+			return nil
+		},
+	})
+
+	// 回调投递协程
+	concurrent.SafeGo2(ctx, concurrent.SafeGo2Opt{
+		Name:             "conductor.scan.callback",
+		InheritCtxCancel: true,
+		Job: func(ctx context.Context) error {
+			s.callbackScanLoop(ctx)
+			return nil
+		},
+	})
 }
 
 func (s *ScanService) Stop() {
@@ -158,60 +222,116 @@ func (s *ScanService) doDispatchScan(ctx context.Context) {
 
 	shardRange := s.shardBiz.GetShardRange()
 
-	// 扫描 inited 状态任务
-	s.scanInitedTasks(ctx, shardRange)
+	// 放行上游已完成的 blocked 状态任务
+	s.scanBlockedTasks(ctx, shardRange)
 
-	// 扫描 pending_retry 状态任务
-	s.scanPendingRetryTasks(ctx, shardRange)
+	namespaces, err := s.namespaceBiz.ListAll(ctx)
+	if err != nil {
+		xlog.Msg("scan list namespaces failed").Err(err).Errorx(ctx)
+		return
+	}
+
+	// 已分发和执行中的任务数 用于限制各命名空间的配额
+	// 多实例各自按全局数量判断 实际在执行的数量可能短暂超出配额
+	inflight, err := s.taskBiz.CountInflight(ctx)
+	if err != nil {
+		xlog.Msg("scan count inflight tasks failed").Err(err).Errorx(ctx)
+		return
+	}
+
+	for _, ns := range namespaces {
+		// 扫描 inited 状态任务
+		s.scanPendingTasks(ctx, shardRange, ns, model.TaskStateInited, inflight)
+
+		// 扫描 pending_retry 状态任务
+		s.scanPendingTasks(ctx, shardRange, ns, model.TaskStatePendingRetry, inflight)
+	}
 }
 
-func (s *ScanService) scanInitedTasks(ctx context.Context, shardRange shard.Range) {
-	offset := s.state.GetInitedOffset()
+// scanPendingTasks 按优先级扫描命名空间下的待分发任务并放入分发队列
+//
+// 每个命名空间单独维护游标，避免任务多的命名空间占满每一批扫描
+func (s *ScanService) scanPendingTasks(
+	ctx context.Context,
+	shardRange shard.Range,
+	ns *model.Namespace,
+	state model.TaskState,
+	inflight map[string]int64,
+) {
+	limit := int64(defaultScanLimit)
+	if ns.MaxInflight > 0 {
+		limit = min(limit, ns.MaxInflight-inflight[ns.Name])
+	}
+	if limit <= 0 {
+		// 已达配额 游标保持不变
+		return
+	}
+
+	cursor := s.state.GetPendingCursor(state, ns.Name)
+	if !cursor.atStart() {
+		// 本轮扫描开始后有更高优先级的任务注册 从头开始扫描
+		above, err := s.taskBiz.HasPendingAbove(ctx, ns.Name, state,
+			shardRange.Start, shardRange.End,
+			cursor.priority, cursor.passStart)
+		if err != nil {
+			xlog.Msg("scan check pending above failed").
+				Extras("shardRange", shardRange.String(), "namespace", ns.Name, "state", state).
+				Err(err).
+				Errorx(ctx)
+			return
+		}
+		if above {
+			cursor = newPendingCursor()
+		}
+	}
 
-	tasks, err := s.taskBiz.GetInitedTasks(ctx,
+	tasks, err := s.taskBiz.GetPendingTasks(ctx, ns.Name, state,
 		shardRange.Start, shardRange.End,
-		defaultScanLimit, offset)
+		cursor.priority, cursor.id,
+		int32(limit))
 	if err != nil {
-		xlog.Msg("scan inited tasks failed").
-			Extras("shardRange", shardRange.String(), "offset", offset.String()).
+		xlog.Msg("scan pending tasks failed").
+			Extras("shardRange", shardRange.String(), "namespace", ns.Name, "state", state,
+				"priority", cursor.priority, "offset", cursor.id.String()).
 			Err(err).
 			Errorx(ctx)
 		return
 	}
 
 	if len(tasks) == 0 {
-		s.state.ResetInited()
+		s.state.ResetPendingCursor(state, ns.Name)
 		return
 	}
 
 	for _, task := range tasks {
-		select {
-		case <-s.quitCh:
-			return
-		case <-ctx.Done():
-			return
-		case s.taskCh <- task:
+		if s.dispatchQueue.Push(task, ns.Weight) {
+			inflight[ns.Name]++
 		}
 	}
 
 	lastTask := tasks[len(tasks)-1]
-	s.state.SetInitedOffset(lastTask.Id)
+	cursor.priority = lastTask.Priority
+	cursor.id = lastTask.Id
+	s.state.SetPendingCursor(state, ns.Name, cursor)
 
-	xlog.Msg("scan inited batch completed").
+	xlog.Msg("scan pending batch completed").
 		Extras("shardRange", shardRange.String(),
+			"namespace", ns.Name,
+			"state", state,
 			"count", len(tasks),
+			"newPriority", lastTask.Priority,
 			"newOffset", lastTask.Id.String()).
 		Debugx(ctx)
 }
 
-func (s *ScanService) scanPendingRetryTasks(ctx context.Context, shardRange shard.Range) {
-	offset := s.state.GetPendingRetryOffset()
+func (s *ScanService) scanBlockedTasks(ctx context.Context, shardRange shard.Range) {
+	offset := s.state.GetBlockedOffset()
 
-	tasks, err := s.taskBiz.GetPendingRetryTasks(ctx,
+	tasks, err := s.workflowBiz.GetBlockedTasks(ctx,
 		shardRange.Start, shardRange.End,
 		defaultScanLimit, offset)
 	if err != nil {
-		xlog.Msg("scan pending_retry tasks failed").
+		xlog.Msg("scan blocked tasks failed").
 			Extras("shardRange", shardRange.String(), "offset", offset.String()).
 			Err(err).
 			Errorx(ctx)
@@ -219,24 +339,40 @@ func (s *ScanService) scanPendingRetryTasks(ctx context.Context, shardRange shar
 	}
 
 	if len(tasks) == 0 {
-		s.state.ResetPendingRetry()
+		s.state.ResetBlocked()
 		return
 	}
 
 	for _, task := range tasks {
-		select {
-		case <-s.quitCh:
-			return
-		case <-ctx.Done():
-			return
-		case s.taskCh <- task:
+		var newState model.TaskState
+		err := s.bizz.Tx(ctx, func(ctx context.Context) error {
+			var txErr error
+			newState, txErr = s.workflowBiz.TryRelease(ctx, task)
+			return txErr
+		})
+		if err != nil {
+			xlog.Msg("release blocked task failed").
+				Extras("taskId", task.Id.String()).
+				Err(err).
+				Errorx(ctx)
+			continue
+		}
+
+		if newState != task.State {
+			s.watchBiz.Publish(ctx, model.NewTaskStateEvent(task, newState))
+			xlog.Msg("blocked task resolved").
+				Extras("taskId", task.Id.String(),
+					"workflowId", task.WorkflowId.String(),
+					"node", task.WorkflowNode,
+					"state", string(newState)).
+				Infox(ctx)
 		}
 	}
 
 	lastTask := tasks[len(tasks)-1]
-	s.state.SetPendingRetryOffset(lastTask.Id)
+	s.state.SetBlockedOffset(lastTask.Id)
 
-	xlog.Msg("scan pending_retry batch completed").
+	xlog.Msg("scan blocked batch completed").
 		Extras("shardRange", shardRange.String(),
 			"count", len(tasks),
 			"newOffset", lastTask.Id.String()).
@@ -250,8 +386,10 @@ func (s *ScanService) processLoop(ctx context.Context) {
 			return
 		case <-ctx.Done():
 			return
-		case task := <-s.taskCh:
-			s.processTask(ctx, task)
+		case <-s.dispatchQueue.Notify():
+			for task := s.dispatchQueue.Pop(); task != nil; task = s.dispatchQueue.Pop() {
+				s.processTask(ctx, task)
+			}
 		}
 	}
 }
@@ -267,21 +405,30 @@ func (s *ScanService) processTask(ctx context.Context, task *model.Task) {
 		return
 	}
 
-	// 分发成功，更新任务状态为 dispatched
+	// 分发成功，更新任务状态为 dispatched 并记录分发到的 worker
+	var marked bool
 	err := s.bizz.Tx(ctx, func(ctx context.Context) error {
-		return s.taskBiz.UpdateTaskState(ctx, task.Id, model.TaskStateDispatched)
+		var txErr error
+		marked, txErr = s.taskBiz.MarkDispatched(ctx, task)
+		return txErr
 	})
 	if err != nil {
 		xlog.Msg("update task state to dispatched failed").
-			Extras("taskId", task.Id.String()).
+			Extras("taskId", task.Id.String(), "workerId", task.WorkerId).
 			Err(err).
 			Errorx(ctx)
 		return
 	}
 
+	// 未更新说明 worker 已先一步接受任务
+	if marked {
+		s.watchBiz.Publish(ctx, model.NewTaskStateEvent(task, model.TaskStateDispatched))
+	}
+
 	xlog.Msg("task dispatched").
 		Extras("taskId", task.Id.String(),
 			"taskType", task.TaskType,
+			"workerId", task.WorkerId,
 			"state", string(task.State)).
 		Infox(ctx)
 }
@@ -309,8 +456,13 @@ func (s *ScanService) doExpireScan(ctx context.Context) {
 		return
 	}
 
+	s.cleanExpiredIdempotencyKeys(ctx)
+	s.cleanOfflineWorkers(ctx)
+
 	shardRange := s.shardBiz.GetShardRange()
 
+	s.reclaimLeaseExpiredTasks(ctx, shardRange)
+
 	tasks, err := s.taskBiz.GetExpiredTasks(ctx,
 		shardRange.Start, shardRange.End,
 		defaultScanLimit)
@@ -338,6 +490,7 @@ func (s *ScanService) doExpireScan(ctx context.Context) {
 			continue
 		}
 
+		s.watchBiz.Publish(ctx, model.NewTaskStateEvent(task, model.TaskStateExpired))
 		xlog.Msg("task expired due to timeout").
 			Extras("taskId", task.Id.String(),
 				"expireTime", task.ExpireTime).
@@ -349,3 +502,312 @@ func (s *ScanService) doExpireScan(ctx context.Context) {
 			"count", len(tasks)).
 		Debugx(ctx)
 }
+
+// reclaimLeaseExpiredTasks 回收租约过期或执行超时的任务
+//
+// 还有重试次数的任务放回待重试队列 否则标记为失败并投递回调
+func (s *ScanService) reclaimLeaseExpiredTasks(ctx context.Context, shardRange shard.Range) {
+	tasks, err := s.taskBiz.GetLeaseExpiredTasks(ctx,
+		shardRange.Start, shardRange.End,
+		defaultScanLimit)
+	if err != nil {
+		xlog.Msg("scan lease expired tasks failed").
+			Extras("shardRange", shardRange.String()).
+			Err(err).
+			Errorx(ctx)
+		return
+	}
+
+	if len(tasks) == 0 {
+		return
+	}
+
+	now := time.Now().UnixMilli()
+	for _, task := range tasks {
+		reason := "lease expired"
+		if task.IsExecutionTimeout(now) {
+			reason = "execution timeout"
+		}
+
+		var newState model.TaskState
+		err := s.bizz.Tx(ctx, func(ctx context.Context) error {
+			var txErr error
+			newState, txErr = s.taskBiz.ReclaimTask(ctx, task, reason)
+			if txErr != nil || newState != model.TaskStateFailure {
+				return txErr
+			}
+
+			return s.callbackBiz.EnqueueCallback(ctx, task, &model.CallbackPayload{
+				TaskId:      task.Id.String(),
+				Namespace:   task.Namespace,
+				TaskType:    task.TaskType,
+				State:       model.TaskStateFailure,
+				ErrorMsg:    reason,
+				TraceId:     task.TraceId,
+				CompletedAt: time.Now().UnixMilli(),
+			})
+		})
+		if err != nil {
+			xlog.Msg("reclaim task failed").
+				Extras("taskId", task.Id.String()).
+				Err(err).
+				Errorx(ctx)
+			continue
+		}
+
+		if newState == task.State {
+			// worker 已续租或完成
+			continue
+		}
+
+		s.watchBiz.Publish(ctx, model.NewTaskStateEvent(task, newState))
+		xlog.Msg("task reclaimed").
+			Extras("taskId", task.Id.String(),
+				"taskType", task.TaskType,
+				"workerId", task.WorkerId,
+				"reason", reason,
+				"retryCnt", task.CurRetryCnt,
+				"state", string(newState)).
+			Infox(ctx)
+	}
+
+	xlog.Msg("scan lease expired batch completed").
+		Extras("shardRange", shardRange.String(),
+			"count", len(tasks)).
+		Debugx(ctx)
+}
+
+// cleanExpiredIdempotencyKeys 清理超出去重窗口的幂等键 删除操作幂等 多实例同时执行无影响
+func (s *ScanService) cleanExpiredIdempotencyKeys(ctx context.Context) {
+	cnt, err := s.taskBiz.CleanExpiredIdempotencyKeys(ctx, defaultScanLimit)
+	if err != nil {
+		xlog.Msg("clean expired idempotency keys failed").Err(err).Errorx(ctx)
+		return
+	}
+
+	if cnt > 0 {
+		xlog.Msg("clean expired idempotency keys completed").
+			Extras("count", cnt).
+			Debugx(ctx)
+	}
+}
+
+// cleanOfflineWorkers 清理离线超过保留时长的 worker 删除操作幂等 多实例同时执行无影响
+func (s *ScanService) cleanOfflineWorkers(ctx context.Context) {
+	cnt, err := s.workerBiz.CleanOfflineWorkers(ctx, defaultScanLimit)
+	if err != nil {
+		xlog.Msg("clean offline workers failed").Err(err).Errorx(ctx)
+		return
+	}
+
+	if cnt > 0 {
+		xlog.Msg("clean offline workers completed").
+			Extras("count", cnt).
+			Debugx(ctx)
+	}
+}
+
+// ========== 定时任务触发扫描 ==========
+
+func (s *ScanService) scheduleScanLoop(ctx context.Context) {
+	ticker := time.NewTicker(s.conf.ScanConfig.GetScheduleInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.quitCh:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.doScheduleScan(ctx)
+		}
+	}
+}
+
+func (s *ScanService) doScheduleScan(ctx context.Context) {
+	if !s.shardBiz.HasShard() {
+		return
+	}
+
+	shardRange := s.shardBiz.GetShardRange()
+
+	schedules, err := s.scheduleBiz.GetDueSchedules(ctx,
+		shardRange.Start, shardRange.End,
+		defaultScanLimit)
+	if err != nil {
+		xlog.Msg("scan due schedules failed").
+			Extras("shardRange", shardRange.String()).
+			Err(err).
+			Errorx(ctx)
+		return
+	}
+
+	if len(schedules) == 0 {
+		return
+	}
+
+	for _, schedule := range schedules {
+		var task *model.Task
+		err := s.bizz.Tx(ctx, func(ctx context.Context) error {
+			var txErr error
+			task, txErr = s.scheduleBiz.Fire(ctx, schedule)
+			return txErr
+		})
+		if err != nil {
+			xlog.Msg("fire schedule failed").
+				Extras("namespace", schedule.Namespace, "name", schedule.Name).
+				Err(err).
+				Errorx(ctx)
+			continue
+		}
+
+		if task == nil {
+			// 已被其它实例触发
+			continue
+		}
+
+		s.watchBiz.Publish(ctx, model.NewTaskStateEvent(task, task.State))
+		xlog.Msg("schedule fired").
+			Extras("namespace", schedule.Namespace,
+				"name", schedule.Name,
+				"fireTime", schedule.NextFireTime,
+				"taskId", task.Id.String()).
+			Infox(ctx)
+	}
+
+	xlog.Msg("scan schedule batch completed").
+		Extras("shardRange", shardRange.String(),
+			"count", len(schedules)).
+		Debugx(ctx)
+}
+
+// ========== 回调投递扫描 ==========
+
+func (s *ScanService) callbackScanLoop(ctx context.Context) {
+	ticker := time.NewTicker(s.conf.ScanConfig.GetCallbackInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.quitCh:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.doCallbackScan(ctx)
+		}
+	}
+}
+
+func (s *ScanService) doCallbackScan(ctx context.Context) {
+	if !s.shardBiz.HasShard() {
+		return
+	}
+
+	shardRange := s.shardBiz.GetShardRange()
+
+	callbacks, err := s.callbackBiz.GetDueCallbacks(ctx,
+		shardRange.Start, shardRange.End,
+		defaultScanLimit)
+	if err != nil {
+		xlog.Msg("scan due callbacks failed").
+			Extras("shardRange", shardRange.String()).
+			Err(err).
+			Errorx(ctx)
+		return
+	}
+
+	if len(callbacks) == 0 {
+		return
+	}
+
+	// 限制同时投递的数量 本批全部投递完成后再开始下一轮扫描
+	var (
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, s.conf.CallbackConfig.GetConcurrency())
+	)
+	for _, cb := range callbacks {
+		// 先占用投递名额再租约 避免租约在等待名额时过期导致重复投递
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return
+		}
+
+		ok, err := s.callbackBiz.Lease(ctx, cb)
+		if err != nil {
+			<-sem
+			xlog.Msg("lease callback failed").
+				Extras("callbackId", cb.Id.String()).
+				Err(err).
+				Errorx(ctx)
+			continue
+		}
+		if !ok {
+			// 已被其它实例占用
+			<-sem
+			continue
+		}
+
+		wg.Add(1)
+		concurrent.SafeGo2(ctx, concurrent.SafeGo2Opt{
+			Name:       "conductor.callback.deliver",
+			LogOnError: true,
+			Job: func(ctx context.Context) error {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+				s.deliverCallback(ctx, cb)
+				return nil
+			},
+		})
+	}
+	wg.Wait()
+
+	xlog.Msg("scan callback batch completed").
+		Extras("shardRange", shardRange.String(),
+			"count", len(callbacks)).
+		Debugx(ctx)
+}
+
+func (s *ScanService) deliverCallback(ctx context.Context, cb *model.TaskCallback) {
+	deliverErr := s.callbackBiz.Deliver(ctx, cb)
+	err := s.callbackBiz.RecordResult(ctx, cb, deliverErr)
+	if err != nil {
+		xlog.Msg("record callback result failed").
+			Extras("callbackId", cb.Id.String(), "taskId", cb.TaskId.String()).
+			Err(err).
+			Errorx(ctx)
+		return
+	}
+
+	switch cb.State {
+	case model.CallbackStateSuccess:
+		xlog.Msg("callback delivered").
+			Extras("callbackId", cb.Id.String(),
+				"taskId", cb.TaskId.String(),
+				"target", cb.Target,
+				"attempts", cb.Attempts).
+			Infox(ctx)
+	case model.CallbackStateFailure:
+		xlog.Msg("callback failed after max attempts").
+			Extras("callbackId", cb.Id.String(),
+				"taskId", cb.TaskId.String(),
+				"target", cb.Target,
+				"attempts", cb.Attempts).
+			Err(deliverErr).
+			Errorx(ctx)
+	default:
+		xlog.Msg("callback failed, will retry").
+			Extras("callbackId", cb.Id.String(),
+				"taskId", cb.TaskId.String(),
+				"target", cb.Target,
+				"attempts", cb.Attempts,
+				"nextRetryTime", cb.NextRetryTime).
+			Err(deliverErr).
+			Errorx(ctx)
+	}
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ryanreadbooks/whimer/conductor/internal/biz"
+	"github.com/ryanreadbooks/whimer/conductor/internal/biz/model"
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+)
+
+type ScheduleService struct {
+	bizz         *biz.Biz
+	namespaceBiz *biz.NamespaceBiz
+	scheduleBiz  *biz.ScheduleBiz
+}
+
+func NewScheduleService(bizz *biz.Biz) *ScheduleService {
+	return &ScheduleService{
+		bizz:         bizz,
+		namespaceBiz: bizz.NamespaceBiz,
+		scheduleBiz:  bizz.ScheduleBiz,
+	}
+}
+
+type UpsertScheduleReq struct {
+	Namespace   string
+	Name        string
+	TaskType    string
+	CronExpr    string
+	InputArgs   []byte
+	CallbackUrl string
+	MaxRetryCnt int64 // -1 无限重试, 0 不重试
+	ExpireAfter int64 // 生成任务的存活时长 ms
+}
+
+// UpsertSchedule 创建或更新定时任务
+func (s *ScheduleService) UpsertSchedule(ctx context.Context, req *UpsertScheduleReq) (*model.Schedule, error) {
+	// 校验 namespace 是否存在
+	_, err := s.namespaceBiz.Get(ctx, req.Namespace)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "namespace not found").
+			WithExtra("namespace", req.Namespace).WithCtx(ctx)
+	}
+
+	var schedule *model.Schedule
+	err = s.bizz.Tx(ctx, func(ctx context.Context) error {
+		var txErr error
+		schedule, txErr = s.scheduleBiz.Upsert(ctx, &biz.UpsertScheduleRequest{
+			Namespace:   req.Namespace,
+			Name:        req.Name,
+			TaskType:    req.TaskType,
+			CronExpr:    req.CronExpr,
+			InputArgs:   req.InputArgs,
+			CallbackUrl: req.CallbackUrl,
+			MaxRetryCnt: req.MaxRetryCnt,
+			ExpireAfter: req.ExpireAfter,
+		})
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+// GetSchedule 获取定时任务
+func (s *ScheduleService) GetSchedule(ctx context.Context, namespace, name string) (*model.Schedule, error) {
+	return s.scheduleBiz.Get(ctx, namespace, name)
+}
+
+// ListSchedule 分页列出命名空间下的定时任务
+func (s *ScheduleService) ListSchedule(ctx context.Context, namespace string, page, count int) (*biz.ListScheduleResult, error) {
+	return s.scheduleBiz.List(ctx, namespace, page, count)
+}
+
+// PauseSchedule 暂停定时任务
+func (s *ScheduleService) PauseSchedule(ctx context.Context, namespace, name string) error {
+	return s.scheduleBiz.Pause(ctx, namespace, name)
+}
+
+// ResumeSchedule 恢复定时任务
+func (s *ScheduleService) ResumeSchedule(ctx context.Context, namespace, name string) error {
+	return s.scheduleBiz.Resume(ctx, namespace, name)
+}
+
+// DeleteSchedule 删除定时任务
+func (s *ScheduleService) DeleteSchedule(ctx context.Context, namespace, name string) error {
+	return s.scheduleBiz.Delete(ctx, namespace, name)
+}
@@ -12,6 +12,7 @@ type Service struct {
 	TaskService      *TaskService
 	WorkerService    *WorkerService
 	ScanService      *ScanService
+	ScheduleService  *ScheduleService
 }
 
 func NewService(c *config.Config, bizz *biz.Biz) *Service {
@@ -20,6 +21,7 @@ func NewService(c *config.Config, bizz *biz.Biz) *Service {
 		TaskService:      NewTaskService(bizz),
 		WorkerService:    NewWorkerService(bizz),
 		ScanService:      NewScanService(c, bizz),
+		ScheduleService:  NewScheduleService(bizz),
 	}
 }
 
@@ -2,17 +2,23 @@ package service
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/ryanreadbooks/whimer/conductor/internal/biz"
 	"github.com/ryanreadbooks/whimer/conductor/internal/biz/model"
+	"github.com/ryanreadbooks/whimer/conductor/internal/global"
 	"github.com/ryanreadbooks/whimer/misc/uuid"
 	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xlog"
 )
 
 type TaskService struct {
 	bizz         *biz.Biz
 	namespaceBiz *biz.NamespaceBiz
 	taskBiz      *biz.TaskBiz
+	workflowBiz  *biz.WorkflowBiz
+	callbackBiz  *biz.CallbackBiz
+	watchBiz     *biz.WatchBiz
 }
 
 func NewTaskService(bizz *biz.Biz) *TaskService {
@@ -20,6 +26,9 @@ func NewTaskService(bizz *biz.Biz) *TaskService {
 		bizz:         bizz,
 		namespaceBiz: bizz.NamespaceBiz,
 		taskBiz:      bizz.TaskBiz,
+		workflowBiz:  bizz.WorkflowBiz,
+		callbackBiz:  bizz.CallbackBiz,
+		watchBiz:     bizz.WatchBiz,
 	}
 }
 
@@ -30,14 +39,25 @@ type RegisterTaskReq struct {
 	CallbackUrl string
 	MaxRetryCnt int64 // -1 无限重试, 0 不重试
 	ExpireTime  int64 // 过期时间 unix ms
+	NotBefore   int64 // 最早执行时间 unix ms
+	Priority    int32 // 优先级 越大越优先分发
+
+	CallbackGrpcTarget string            // gRPC 回调目标 与 CallbackUrl 二选一
+	IdempotencyKey     string            // 幂等键 为空则不去重
+	WorkerSelector     map[string]string // worker 标签选择器
 }
 
 type RegisterTaskResp struct {
-	TaskId string
+	TaskId     string
+	Duplicated bool // 命中幂等键 返回的是已有任务
 }
 
 // RegisterTask 注册任务
 func (s *TaskService) RegisterTask(ctx context.Context, req *RegisterTaskReq) (*RegisterTaskResp, error) {
+	if req.CallbackUrl != "" && req.CallbackGrpcTarget != "" {
+		return nil, global.ErrInvalidCallbackTarget
+	}
+
 	// 校验 namespace 是否存在
 	_, err := s.namespaceBiz.Get(ctx, req.Namespace)
 	if err != nil {
@@ -55,6 +75,12 @@ func (s *TaskService) RegisterTask(ctx context.Context, req *RegisterTaskReq) (*
 			CallbackUrl: req.CallbackUrl,
 			MaxRetryCnt: req.MaxRetryCnt,
 			ExpireTime:  req.ExpireTime,
+			NotBefore:   req.NotBefore,
+			Priority:    req.Priority,
+
+			CallbackGrpcTarget: req.CallbackGrpcTarget,
+			IdempotencyKey:     req.IdempotencyKey,
+			WorkerSelector:     req.WorkerSelector,
 		})
 		return txErr
 	})
@@ -62,8 +88,13 @@ func (s *TaskService) RegisterTask(ctx context.Context, req *RegisterTaskReq) (*
 		return nil, err
 	}
 
+	if !resp.Duplicated {
+		s.watchBiz.Publish(ctx, model.NewTaskStateEvent(resp.Task, resp.Task.State))
+	}
+
 	return &RegisterTaskResp{
-		TaskId: resp.Task.Id.String(),
+		TaskId:     resp.Task.Id.String(),
+		Duplicated: resp.Duplicated,
 	}, nil
 }
 
@@ -89,21 +120,338 @@ func (s *TaskService) AbortTask(ctx context.Context, taskId string) error {
 		return xerror.ErrArgs.Msg("invalid task id")
 	}
 
-	// 只有非终态的任务可以被终止
-	task, err := s.taskBiz.GetTask(ctx, id)
+	var task *model.Task
+	err = s.bizz.Tx(ctx, func(ctx context.Context) error {
+		var txErr error
+		task, txErr = s.taskBiz.AbortTask(ctx, id)
+		return txErr
+	})
 	if err != nil {
 		return err
 	}
 
-	// 允许 abort 的状态：inited, dispatched, running, pending_retry
-	switch task.State {
-	case model.TaskStateInited, model.TaskStateDispatched, model.TaskStateRunning, model.TaskStatePendingRetry:
-		// 可以 abort
-	default:
-		return xerror.ErrArgs.Msg("task cannot be aborted")
+	s.watchBiz.Publish(ctx, model.NewTaskStateEvent(task, model.TaskStateAborted))
+
+	return nil
+}
+
+type ListTasksReq struct {
+	Namespace  string
+	TaskType   string
+	State      string // 内部状态 为空则不过滤
+	CtimeStart int64  // [CtimeStart, CtimeEnd) unix ms, 0 表示不限制
+	CtimeEnd   int64
+	Cursor     string
+	Count      int32
+}
+
+type ListTasksResp struct {
+	Tasks      []*model.Task
+	NextCursor string
+	HasMore    bool
+}
+
+// ListTasks 按条件分页列出任务
+func (s *TaskService) ListTasks(ctx context.Context, req *ListTasksReq) (*ListTasksResp, error) {
+	state := model.TaskState(req.State)
+	if state != "" && !state.IsValid() {
+		return nil, global.ErrInvalidTaskState
 	}
 
-	return s.bizz.Tx(ctx, func(ctx context.Context) error {
-		return s.taskBiz.UpdateTaskState(ctx, id, model.TaskStateAborted)
+	cursor := uuid.MaxUUID()
+	if req.Cursor != "" {
+		var err error
+		cursor, err = uuid.ParseString(req.Cursor)
+		if err != nil {
+			return nil, xerror.ErrArgs.Msg("invalid cursor")
+		}
+	}
+
+	result, err := s.taskBiz.ListTasks(ctx, &biz.ListTasksRequest{
+		Namespace:  req.Namespace,
+		TaskType:   req.TaskType,
+		State:      state,
+		CtimeStart: req.CtimeStart,
+		CtimeEnd:   req.CtimeEnd,
+		Cursor:     cursor,
+		Count:      req.Count,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ListTasksResp{
+		Tasks:   result.Tasks,
+		HasMore: result.HasMore,
+	}
+	if len(result.Tasks) > 0 {
+		resp.NextCursor = result.NextCursor.String()
+	}
+
+	return resp, nil
+}
+
+// RetryTask 人工重试失败或过期的任务
+//
+// expireTime 为重试后的过期时间 unix ms，为 0 时如果原过期时间已过则不再过期
+func (s *TaskService) RetryTask(ctx context.Context, taskId string, expireTime int64) error {
+	id, err := uuid.ParseString(taskId)
+	if err != nil {
+		return xerror.ErrArgs.Msg("invalid task id")
+	}
+
+	err = s.bizz.Tx(ctx, func(ctx context.Context) error {
+		return s.taskBiz.RequeueTask(ctx, id, expireTime)
 	})
+	if err != nil {
+		return err
+	}
+
+	s.publishCurrentState(ctx, id)
+
+	return nil
+}
+
+// publishCurrentState 查询任务当前状态并发布 用于调用方手上没有任务信息的场景
+func (s *TaskService) publishCurrentState(ctx context.Context, taskId uuid.UUID) {
+	task, err := s.taskBiz.GetTask(ctx, taskId)
+	if err != nil {
+		xlog.Msg("get task for event failed").
+			Extras("taskId", taskId.String()).
+			Err(err).
+			Errorx(ctx)
+		return
+	}
+
+	s.watchBiz.Publish(ctx, model.NewTaskStateEvent(task, task.State))
+}
+
+type BatchAbortTaskResp struct {
+	AbortedTaskIds []string
+	FailedTaskIds  map[string]string // 任务id -> 失败原因
+}
+
+// BatchAbortTask 批量终止任务 每个任务单独处理 互不影响
+func (s *TaskService) BatchAbortTask(ctx context.Context, taskIds []string) (*BatchAbortTaskResp, error) {
+	resp := &BatchAbortTaskResp{
+		AbortedTaskIds: make([]string, 0, len(taskIds)),
+		FailedTaskIds:  make(map[string]string),
+	}
+
+	for _, taskId := range taskIds {
+		err := s.AbortTask(ctx, taskId)
+		if err != nil {
+			reason, ok := abortFailedReason(err)
+			if !ok {
+				xlog.Msg("batch abort task failed").
+					Extras("taskId", taskId).
+					Err(err).
+					Errorx(ctx)
+			}
+			resp.FailedTaskIds[taskId] = reason
+			continue
+		}
+
+		resp.AbortedTaskIds = append(resp.AbortedTaskIds, taskId)
+	}
+
+	return resp, nil
+}
+
+// abortFailedReason 返回终止失败的原因 非业务错误时返回 false
+func abortFailedReason(err error) (string, bool) {
+	if xerr, ok := xerror.Cause(err).(*xerror.Error); ok && xerr.StatusCode < http.StatusInternalServerError {
+		return xerr.Message, true
+	}
+
+	return global.ErrInternal.Message, false
+}
+
+// GetTaskHistory 获取任务状态变更记录
+func (s *TaskService) GetTaskHistory(ctx context.Context, taskId string) ([]*model.TaskHistory, error) {
+	id, err := uuid.ParseString(taskId)
+	if err != nil {
+		return nil, xerror.ErrArgs.Msg("invalid task id")
+	}
+
+	// 先确认任务存在
+	_, err = s.taskBiz.GetTask(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.taskBiz.GetTaskHistorys(ctx, id)
+}
+
+// GetTaskCallbacks 获取任务的回调投递记录
+func (s *TaskService) GetTaskCallbacks(ctx context.Context, taskId string) ([]*model.TaskCallback, error) {
+	id, err := uuid.ParseString(taskId)
+	if err != nil {
+		return nil, xerror.ErrArgs.Msg("invalid task id")
+	}
+
+	// 先确认任务存在
+	_, err = s.taskBiz.GetTask(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.callbackBiz.ListTaskCallbacks(ctx, id)
+}
+
+type RegisterWorkflowReq struct {
+	Namespace string
+	Nodes     []*model.WorkflowNode
+	Priority  int32
+}
+
+type RegisterWorkflowResp struct {
+	WorkflowId string
+	TaskIds    map[string]string // 节点名 -> 任务id
+}
+
+// RegisterWorkflow 注册工作流
+func (s *TaskService) RegisterWorkflow(ctx context.Context, req *RegisterWorkflowReq) (*RegisterWorkflowResp, error) {
+	for _, node := range req.Nodes {
+		if node.CallbackUrl != "" && node.CallbackGrpcTarget != "" {
+			return nil, global.ErrInvalidCallbackTarget
+		}
+	}
+
+	// 校验 namespace 是否存在
+	_, err := s.namespaceBiz.Get(ctx, req.Namespace)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "namespace not found").
+			WithExtra("namespace", req.Namespace).WithCtx(ctx)
+	}
+
+	var resp *biz.RegisterWorkflowResponse
+	err = s.bizz.Tx(ctx, func(ctx context.Context) error {
+		var txErr error
+		resp, txErr = s.workflowBiz.RegisterWorkflow(ctx, &biz.RegisterWorkflowRequest{
+			Namespace: req.Namespace,
+			Nodes:     req.Nodes,
+			Priority:  req.Priority,
+		})
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	taskIds := make(map[string]string, len(resp.TaskIds))
+	for node, taskId := range resp.TaskIds {
+		taskIds[node] = taskId.String()
+	}
+
+	return &RegisterWorkflowResp{
+		WorkflowId: resp.WorkflowId.String(),
+		TaskIds:    taskIds,
+	}, nil
+}
+
+type GetWorkflowResp struct {
+	WorkflowId string
+	State      model.TaskState
+	Tasks      []*model.Task
+}
+
+// GetWorkflow 获取工作流
+func (s *TaskService) GetWorkflow(ctx context.Context, workflowId string) (*GetWorkflowResp, error) {
+	id, err := uuid.ParseString(workflowId)
+	if err != nil {
+		return nil, xerror.ErrArgs.Msg("invalid workflow id")
+	}
+
+	tasks, err := s.workflowBiz.GetWorkflowTasks(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetWorkflowResp{
+		WorkflowId: workflowId,
+		State:      model.WorkflowState(tasks),
+		Tasks:      tasks,
+	}, nil
+}
+
+// WatchTask 订阅单个任务的事件
+//
+// 先发送一次任务当前状态 之后发送状态变更和进度 任务进入终态后返回
+func (s *TaskService) WatchTask(
+	ctx context.Context,
+	taskId string,
+	send func(event *model.TaskEvent) error,
+) error {
+	id, err := uuid.ParseString(taskId)
+	if err != nil {
+		return xerror.ErrArgs.Msg("invalid task id")
+	}
+
+	// 先订阅再查询当前状态 避免两者之间的事件丢失
+	w := s.watchBiz.WatchTask(id)
+	defer s.watchBiz.Unwatch(w)
+
+	task, err := s.taskBiz.GetTask(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	current := model.NewTaskStateEvent(task, task.State)
+	current.Time = task.Utime
+	if err := send(current); err != nil {
+		return err
+	}
+	if current.IsFinal() {
+		return nil
+	}
+
+	return s.forwardEvents(ctx, w, send)
+}
+
+// WatchNamespace 订阅命名空间下任务的事件 直到调用方取消
+func (s *TaskService) WatchNamespace(
+	ctx context.Context,
+	namespace string,
+	taskTypes []string,
+	send func(event *model.TaskEvent) error,
+) error {
+	_, err := s.namespaceBiz.Get(ctx, namespace)
+	if err != nil {
+		return xerror.Wrapf(err, "namespace not found").
+			WithExtra("namespace", namespace).WithCtx(ctx)
+	}
+
+	w := s.watchBiz.WatchNamespace(namespace, taskTypes)
+	defer s.watchBiz.Unwatch(w)
+
+	return s.forwardEvents(ctx, w, send)
+}
+
+// forwardEvents 转发订阅到的事件 订阅单个任务时任务进入终态后返回
+func (s *TaskService) forwardEvents(
+	ctx context.Context,
+	w *biz.TaskWatcher,
+	send func(event *model.TaskEvent) error,
+) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-w.Events():
+			if !ok {
+				if w.Overflowed() {
+					xlog.Msg("task watcher overflowed").Infox(ctx)
+				}
+				return global.ErrWatchInterrupted
+			}
+
+			if err := send(event); err != nil {
+				return err
+			}
+			if w.Single() && event.IsFinal() {
+				return nil
+			}
+		}
+	}
 }
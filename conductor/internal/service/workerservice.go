@@ -10,6 +10,7 @@ import (
 	"github.com/ryanreadbooks/whimer/conductor/internal/biz/model"
 	"github.com/ryanreadbooks/whimer/misc/uuid"
 	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xlog"
 )
 
 type WorkerService struct {
@@ -17,6 +18,7 @@ type WorkerService struct {
 	workerBiz   *biz.WorkerBiz
 	taskBiz     *biz.TaskBiz
 	callbackBiz *biz.CallbackBiz
+	watchBiz    *biz.WatchBiz
 }
 
 func NewWorkerService(bizz *biz.Biz) *WorkerService {
@@ -25,12 +27,16 @@ func NewWorkerService(bizz *biz.Biz) *WorkerService {
 		workerBiz:   bizz.WorkerBiz,
 		taskBiz:     bizz.TaskBiz,
 		callbackBiz: bizz.CallbackBiz,
+		watchBiz:    bizz.WatchBiz,
 	}
 }
 
 type LongPollRequest struct {
-	WorkerId string
-	TaskType string
+	WorkerId    string
+	TaskType    string
+	Ip          string
+	Labels      map[string]string
+	Concurrency int32
 }
 
 type LongPollResponse struct {
@@ -39,7 +45,22 @@ type LongPollResponse struct {
 
 // LongPoll Worker 长轮询获取任务
 func (s *WorkerService) LongPoll(ctx context.Context, req *LongPollRequest) (*LongPollResponse, error) {
-	task, err := s.workerBiz.WaitForTask(ctx, req.WorkerId, req.TaskType)
+	// 轮询即心跳 失败不影响获取任务
+	err := s.workerBiz.Heartbeat(ctx, &model.Worker{
+		Id:          req.WorkerId,
+		Ip:          req.Ip,
+		TaskTypes:   []string{req.TaskType},
+		Labels:      req.Labels,
+		Concurrency: req.Concurrency,
+	})
+	if err != nil {
+		xlog.Msg("worker heartbeat failed").
+			Extras("workerId", req.WorkerId).
+			Err(err).
+			Errorx(ctx)
+	}
+
+	task, err := s.workerBiz.WaitForTask(ctx, req.WorkerId, req.TaskType, req.Labels)
 	if err != nil {
 		// 正常的长轮询超时/取消
 		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
@@ -51,24 +72,109 @@ func (s *WorkerService) LongPoll(ctx context.Context, req *LongPollRequest) (*Lo
 	return &LongPollResponse{Task: task}, nil
 }
 
+type RegisterWorkerRequest struct {
+	WorkerId    string
+	Ip          string
+	TaskTypes   []string
+	Labels      map[string]string
+	Concurrency int32
+}
+
+// RegisterWorker 注册 worker 声明能力
+func (s *WorkerService) RegisterWorker(ctx context.Context, req *RegisterWorkerRequest) error {
+	err := s.workerBiz.RegisterWorker(ctx, &model.Worker{
+		Id:          req.WorkerId,
+		Ip:          req.Ip,
+		TaskTypes:   req.TaskTypes,
+		Labels:      req.Labels,
+		Concurrency: req.Concurrency,
+	})
+	if err != nil {
+		return xerror.Wrapf(err, "worker service register worker failed").WithCtx(ctx)
+	}
+
+	return nil
+}
+
+// UnregisterWorker 注销 worker
+func (s *WorkerService) UnregisterWorker(ctx context.Context, workerId string) error {
+	err := s.workerBiz.UnregisterWorker(ctx, workerId)
+	if err != nil {
+		return xerror.Wrapf(err, "worker service unregister worker failed").WithCtx(ctx)
+	}
+
+	return nil
+}
+
+type ListWorkersRequest struct {
+	TaskType       string
+	Selector       map[string]string
+	IncludeOffline bool
+}
+
+// ListWorkers 列出 worker 及其当前分配的任务
+func (s *WorkerService) ListWorkers(ctx context.Context, req *ListWorkersRequest) ([]*model.Worker, error) {
+	workers, err := s.workerBiz.ListWorkers(ctx, &biz.ListWorkersRequest{
+		TaskType:       req.TaskType,
+		Selector:       req.Selector,
+		IncludeOffline: req.IncludeOffline,
+	})
+	if err != nil {
+		return nil, xerror.Wrapf(err, "worker service list workers failed").WithCtx(ctx)
+	}
+
+	if len(workers) == 0 {
+		return workers, nil
+	}
+
+	workerIds := make([]string, 0, len(workers))
+	for _, w := range workers {
+		workerIds = append(workerIds, w.Id)
+	}
+
+	assignments, err := s.taskBiz.GetWorkerAssignments(ctx, workerIds)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "worker service list workers failed").WithCtx(ctx)
+	}
+
+	for _, w := range workers {
+		w.RunningTaskIds = assignments[w.Id]
+	}
+
+	return workers, nil
+}
+
+// IsWorkerAlive worker 是否在线
+func (s *WorkerService) IsWorkerAlive(w *model.Worker) bool {
+	return s.workerBiz.IsWorkerAlive(w)
+}
+
 type AcceptTaskRequest struct {
-	TaskId string
+	TaskId   string
+	WorkerId string
 }
 
-// AcceptTask Worker 接受任务，更新状态为 running
+// AcceptTask Worker 接受任务，更新状态为 running 并开始计算租约和执行超时
 func (s *WorkerService) AcceptTask(ctx context.Context, req *AcceptTaskRequest) error {
 	taskId, err := uuid.ParseString(req.TaskId)
 	if err != nil {
 		return xerror.ErrArgs.Msg("invalid task id")
 	}
 
+	var task *model.Task
 	err = s.bizz.Tx(ctx, func(ctx context.Context) error {
-		return s.taskBiz.UpdateTaskState(ctx, taskId, model.TaskStateRunning)
+		var txErr error
+		task, txErr = s.taskBiz.AcceptTask(ctx, taskId, req.WorkerId)
+		return txErr
 	})
 	if err != nil {
-		return xerror.Wrapf(err, "worker service accept task failed").WithCtx(ctx)
+		return xerror.Wrapf(err, "worker service accept task failed").
+			WithExtra("workerId", req.WorkerId).
+			WithCtx(ctx)
 	}
 
+	s.watchBiz.Publish(ctx, model.NewTaskStateEvent(task, model.TaskStateRunning))
+
 	return nil
 }
 
@@ -78,11 +184,13 @@ type CompleteTaskRequest struct {
 	OutputArgs []byte
 	ErrorMsg   []byte
 	Retryable  bool // 失败时是否可重试（由 worker 指定）
+	WorkerId   string
 }
 
 type ReportTaskRequest struct {
 	TaskId   string
 	Progress int64
+	WorkerId string
 }
 
 type ReportTaskResponse struct {
@@ -104,12 +212,22 @@ func (s *WorkerService) ReportTask(ctx context.Context, req *ReportTaskRequest)
 		return nil, xerror.Wrapf(err, "worker service report task failed").WithCtx(ctx)
 	}
 
-	aborted := task.State == model.TaskStateAborted
+	// 任务被取消 过期或因租约过期被回收后 通知 worker 终止执行
+	aborted := !task.IsHeldBy(req.WorkerId)
+	if !aborted && task.State == model.TaskStateRunning {
+		held, err := s.taskBiz.RenewLease(ctx, task, req.WorkerId)
+		if err != nil {
+			return nil, xerror.Wrapf(err, "worker service report task failed").WithCtx(ctx)
+		}
+		aborted = !held
+	}
+
 	resp := &ReportTaskResponse{Aborted: aborted}
 
 	// 如果任务未被终止，返回建议的下次上报时间
 	if !aborted {
 		resp.NextReportTime = time.Now().Add(defaultReportInterval)
+		s.watchBiz.Publish(ctx, model.NewTaskProgressEvent(task, req.Progress))
 	}
 
 	return resp, nil
@@ -128,38 +246,47 @@ func (s *WorkerService) CompleteTask(ctx context.Context, req *CompleteTaskReque
 		return xerror.Wrapf(err, "worker service get task failed").WithCtx(ctx)
 	}
 
-	// 如果任务已是终态（如 aborted, expired），不再更新状态
-	if task.State.IsTerminal() {
+	// 如果任务已是终态（如 aborted, expired）或已被回收分发给其它 worker，不再更新状态
+	// 事务中更新时会再次校验 这里只是提前返回
+	if !task.IsHeldBy(req.WorkerId) {
+		s.logTaskNotHeld(ctx, taskId, req.WorkerId)
 		return nil
 	}
 
 	// 失败、可重试、且还有重试次数时，放入重试队列，不触发回调
 	if !req.Success && req.Retryable && task.CanRetry() {
+		var retried bool
 		err = s.bizz.Tx(ctx, func(ctx context.Context) error {
-			return s.taskBiz.RetryTask(ctx, taskId)
+			retried, err = s.taskBiz.RetryTask(ctx, taskId, req.WorkerId)
+			return err
 		})
 		if err != nil {
 			return xerror.Wrapf(err, "worker service retry task failed").WithCtx(ctx)
 		}
+		if !retried {
+			s.logTaskNotHeld(ctx, taskId, req.WorkerId)
+			return nil
+		}
+
+		s.watchBiz.Publish(ctx, model.NewTaskStateEvent(task, model.TaskStatePendingRetry))
 		return nil
 	}
 
 	// 成功或最终失败（重试次数用尽），更新为终态
-	err = s.bizz.Tx(ctx, func(ctx context.Context) error {
-		return s.taskBiz.CompleteTask(ctx, taskId, req.Success, req.OutputArgs, req.ErrorMsg)
-	})
-	if err != nil {
-		return xerror.Wrapf(err, "worker service complete task failed").WithCtx(ctx)
+	// 回调投递记录和终态在同一事务中写入 由扫描协程负责投递
+	state := model.TaskStateSuccess
+	if !req.Success {
+		state = model.TaskStateFailure
 	}
-
-	// 只有终态才触发回调
-	if task.CallbackUrl != "" {
-		state := model.TaskStateSuccess
-		if !req.Success {
-			state = model.TaskStateFailure
+	var completed bool
+	err = s.bizz.Tx(ctx, func(ctx context.Context) error {
+		completed, err = s.taskBiz.CompleteTask(ctx, taskId, req.WorkerId, req.Success, req.OutputArgs, req.ErrorMsg)
+		if err != nil || !completed {
+			// 任务在此期间已被回收或中止 不能覆盖新的结果 也不能重复回调
+			return err
 		}
 
-		s.callbackBiz.TriggerCallback(ctx, task.CallbackUrl, &biz.CallbackPayload{
+		return s.callbackBiz.EnqueueCallback(ctx, task, &model.CallbackPayload{
 			TaskId:      taskId.String(),
 			Namespace:   task.Namespace,
 			TaskType:    task.TaskType,
@@ -169,7 +296,22 @@ func (s *WorkerService) CompleteTask(ctx context.Context, req *CompleteTaskReque
 			TraceId:     task.TraceId,
 			CompletedAt: time.Now().UnixMilli(),
 		})
+	})
+	if err != nil {
+		return xerror.Wrapf(err, "worker service complete task failed").WithCtx(ctx)
+	}
+	if !completed {
+		s.logTaskNotHeld(ctx, taskId, req.WorkerId)
+		return nil
 	}
 
+	s.watchBiz.Publish(ctx, model.NewTaskStateEvent(task, state))
+
 	return nil
 }
+
+func (s *WorkerService) logTaskNotHeld(ctx context.Context, taskId uuid.UUID, workerId string) {
+	xlog.Msg("task no longer held by worker, ignore result").
+		Extras("taskId", taskId.String(), "workerId", workerId).
+		Infox(ctx)
+}
@@ -0,0 +1,189 @@
+package embedded
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/conductor/internal/biz"
+	"github.com/ryanreadbooks/whimer/conductor/internal/biz/shard"
+	"github.com/ryanreadbooks/whimer/conductor/internal/config"
+	grpcentry "github.com/ryanreadbooks/whimer/conductor/internal/entry/grpc"
+	"github.com/ryanreadbooks/whimer/conductor/internal/infra/dao/memory"
+	"github.com/ryanreadbooks/whimer/conductor/internal/service"
+	"github.com/ryanreadbooks/whimer/conductor/pkg/go/sdk/producer"
+	"github.com/ryanreadbooks/whimer/conductor/pkg/go/sdk/worker"
+	"github.com/ryanreadbooks/whimer/misc/xgrpc/interceptor"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	defaultScanInterval    = 50 * time.Millisecond
+	defaultLongPollTimeout = time.Second
+	listenerBufferSize     = 1 << 20
+)
+
+// Options 内嵌 conductor 配置
+type Options struct {
+	// 启动时创建的命名空间
+	Namespaces []string
+
+	// 扫描间隔（默认 50ms）决定任务分发 重试 过期和回调投递的延迟
+	ScanInterval time.Duration
+
+	// 长轮询超时时间（默认 1s）worker 停止时需要等待进行中的长轮询返回
+	LongPollTimeout time.Duration
+
+	// 执行租约时长（默认 60s）worker 超过该时间未上报心跳时任务被回收
+	LeaseTimeout time.Duration
+
+	// 按任务类型设置的执行超时时间 未设置的任务类型不限制
+	ExecutionTimeouts map[string]time.Duration
+}
+
+// Conductor 在进程内运行的 conductor
+//
+// 使用内存存储 单实例持有全部分片 任务事件只在进程内分发 不依赖 MySQL redis 和 etcd
+//
+// 服务通过进程内的 gRPC 连接提供 producer 和 worker 的行为与连接独立部署的 conductor 一致
+// 数据不持久化 Stop 后全部丢失 主要用于在 go test 中端到端地测试 producer 和 worker
+type Conductor struct {
+	cancel context.CancelFunc
+	bizz   *biz.Biz
+	srv    *service.Service
+	server *grpc.Server
+	lis    *bufconn.Listener
+
+	// producer 和 worker 的客户端拦截器不同 分开建立连接
+	producerConn *grpc.ClientConn
+	workerConn   *grpc.ClientConn
+
+	stopOnce sync.Once
+}
+
+// Start 启动内嵌 conductor
+func Start(opts Options) (*Conductor, error) {
+	if opts.ScanInterval <= 0 {
+		opts.ScanInterval = defaultScanInterval
+	}
+	if opts.LongPollTimeout <= 0 {
+		opts.LongPollTimeout = defaultLongPollTimeout
+	}
+
+	c := &config.Config{}
+	c.ScanConfig = config.ScanConfig{
+		ProcessInterval:  opts.ScanInterval,
+		RetryInterval:    opts.ScanInterval,
+		ExpireInterval:   opts.ScanInterval,
+		ScheduleInterval: opts.ScanInterval,
+		CallbackInterval: opts.ScanInterval,
+	}
+	c.WorkerConfig.LongPollTimeout = opts.LongPollTimeout
+	c.TaskConfig.LeaseTimeout = opts.LeaseTimeout
+	for taskType, timeout := range opts.ExecutionTimeouts {
+		c.TaskConfig.TaskTypes = append(c.TaskConfig.TaskTypes, config.TaskTypeConfig{
+			TaskType:         taskType,
+			ExecutionTimeout: timeout,
+		})
+	}
+
+	rootCtx, cancel := context.WithCancel(context.Background())
+	bizz := biz.NewBizWithDeps(rootCtx, c, biz.Deps{
+		Dao:   memory.NewDao(),
+		Shard: shard.NewStandalone(),
+	})
+	srv := service.NewService(c, bizz)
+
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(interceptor.DefaultUnaryServerInterceptors()...),
+		grpc.ChainStreamInterceptor(interceptor.DefaultStreamServerInterceptors()...),
+	)
+	grpcentry.Register(server, srv)
+
+	e := &Conductor{
+		cancel: cancel,
+		bizz:   bizz,
+		srv:    srv,
+		server: server,
+		lis:    bufconn.Listen(listenerBufferSize),
+	}
+
+	var err error
+	// 与 xgrpc 默认客户端保持一致 producer 需要把错误还原成 xerror
+	e.producerConn, err = e.dial(grpc.WithChainUnaryInterceptor(
+		interceptor.UnaryClientErrorHandler,
+		interceptor.UnaryClientMetadataInject,
+	))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	e.workerConn, err = e.dial()
+	if err != nil {
+		e.producerConn.Close()
+		cancel()
+		return nil, err
+	}
+
+	bizz.Start()
+	srv.Start(rootCtx)
+	go server.Serve(e.lis)
+
+	for _, namespace := range opts.Namespaces {
+		err = e.CreateNamespace(rootCtx, namespace)
+		if err != nil {
+			e.Stop()
+			return nil, err
+		}
+	}
+
+	return e, nil
+}
+
+func (e *Conductor) dial(opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	opts = append(opts,
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return e.lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+
+	return grpc.NewClient("passthrough:///conductor", opts...)
+}
+
+// CreateNamespace 创建命名空间
+func (e *Conductor) CreateNamespace(ctx context.Context, name string) error {
+	_, err := e.srv.NamespaceService.CreateNamespace(ctx, &service.NamespaceReq{Name: name})
+	return err
+}
+
+// NewProducer 创建连接到内嵌 conductor 的任务客户端
+func (e *Conductor) NewProducer(namespace string) (*producer.Client, error) {
+	return producer.New(producer.ClientOptions{
+		Namespace: namespace,
+		Conn:      e.producerConn,
+	})
+}
+
+// NewWorker 创建连接到内嵌 conductor 的 worker 忽略 opts.HostConf
+func (e *Conductor) NewWorker(opts worker.Options) (*worker.Worker, error) {
+	opts.Conn = e.workerConn
+	return worker.New(opts)
+}
+
+// Stop 停止内嵌 conductor 应在所有 worker 停止后调用
+func (e *Conductor) Stop() {
+	e.stopOnce.Do(func() {
+		// 长轮询不会主动结束 直接断开所有连接
+		e.server.Stop()
+		e.srv.Stop()
+		e.bizz.Stop()
+		e.cancel()
+
+		e.producerConn.Close()
+		e.workerConn.Close()
+	})
+}
@@ -0,0 +1,148 @@
+package embedded
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/conductor/pkg/go/sdk/producer"
+	"github.com/ryanreadbooks/whimer/conductor/pkg/go/sdk/task"
+	"github.com/ryanreadbooks/whimer/conductor/pkg/go/sdk/worker"
+)
+
+type echoInput struct {
+	Msg string `json:"msg"`
+}
+
+// TestProducerAndWorker 端到端测试：注册任务 由 worker 执行后等待终态
+func TestProducerAndWorker(t *testing.T) {
+	c, err := Start(Options{Namespaces: []string{"test"}})
+	if err != nil {
+		t.Fatalf("start embedded conductor failed: %v", err)
+	}
+	defer c.Stop()
+
+	w, err := c.NewWorker(worker.Options{Concurrency: 2})
+	if err != nil {
+		t.Fatalf("create worker failed: %v", err)
+	}
+	w.RegisterHandler("echo", func(ctx context.Context, task *worker.Task) worker.Result {
+		var input echoInput
+		if err := task.UnmarshalInput(&input); err != nil {
+			return worker.Result{Error: err}
+		}
+		return worker.Result{Output: input}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	go w.Run(ctx)
+	defer w.Stop()
+
+	client, err := c.NewProducer("test")
+	if err != nil {
+		t.Fatalf("create producer failed: %v", err)
+	}
+
+	taskId, err := client.Schedule(ctx, "echo", echoInput{Msg: "hello"}, producer.ScheduleOptions{
+		ExpireAfter: time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("schedule task failed: %v", err)
+	}
+
+	got, err := client.Wait(ctx, taskId)
+	if err != nil {
+		t.Fatalf("wait task failed: %v", err)
+	}
+	if got.State != task.TaskStateSuccess {
+		t.Fatalf("task state = %s, want %s", got.State, task.TaskStateSuccess)
+	}
+
+	var output echoInput
+	if err := got.UnmarshalOutput(&output); err != nil {
+		t.Fatalf("unmarshal output failed: %v", err)
+	}
+	if output.Msg != "hello" {
+		t.Fatalf("task output = %q, want %q", output.Msg, "hello")
+	}
+}
+
+// TestExecutionTimeoutReclaim 执行超时的任务被回收并计入重试次数 重试用尽后失败
+func TestExecutionTimeoutReclaim(t *testing.T) {
+	c, err := Start(Options{
+		Namespaces:        []string{"test"},
+		ExecutionTimeouts: map[string]time.Duration{"slow": 200 * time.Millisecond},
+	})
+	if err != nil {
+		t.Fatalf("start embedded conductor failed: %v", err)
+	}
+	defer c.Stop()
+
+	w, err := c.NewWorker(worker.Options{HeartbeatInterval: 50 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("create worker failed: %v", err)
+	}
+
+	var attempts atomic.Int32
+	w.RegisterHandler("slow", func(ctx context.Context, task *worker.Task) worker.Result {
+		attempts.Add(1)
+		// 一直执行到被服务端回收
+		<-ctx.Done()
+		return worker.Result{Error: ctx.Err()}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	go w.Run(ctx)
+	defer w.Stop()
+
+	client, err := c.NewProducer("test")
+	if err != nil {
+		t.Fatalf("create producer failed: %v", err)
+	}
+
+	taskId, err := client.Schedule(ctx, "slow", nil, producer.ScheduleOptions{
+		MaxRetry:    1,
+		ExpireAfter: time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("schedule task failed: %v", err)
+	}
+
+	got, err := client.Wait(ctx, taskId)
+	if err != nil {
+		t.Fatalf("wait task failed: %v", err)
+	}
+	if got.State != task.TaskStateFailure {
+		t.Fatalf("task state = %s, want %s", got.State, task.TaskStateFailure)
+	}
+	if got.CurRetryCnt != 1 {
+		t.Fatalf("task retry count = %d, want 1", got.CurRetryCnt)
+	}
+	if n := attempts.Load(); n != 2 {
+		t.Fatalf("handler attempts = %d, want 2", n)
+	}
+}
+
+// TestNamespaceNotFound 未创建的命名空间不能注册任务
+func TestNamespaceNotFound(t *testing.T) {
+	c, err := Start(Options{})
+	if err != nil {
+		t.Fatalf("start embedded conductor failed: %v", err)
+	}
+	defer c.Stop()
+
+	client, err := c.NewProducer("missing")
+	if err != nil {
+		t.Fatalf("create producer failed: %v", err)
+	}
+
+	_, err = client.Schedule(context.Background(), "echo", nil, producer.ScheduleOptions{})
+	if err == nil {
+		t.Fatal("schedule task in missing namespace should fail")
+	}
+}
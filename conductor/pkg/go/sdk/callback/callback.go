@@ -0,0 +1,121 @@
+package callback
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/conductor/internal/biz/model"
+	"google.golang.org/grpc/metadata"
+)
+
+// 回调请求头 gRPC 回调时在 metadata 中（小写）
+const (
+	HeaderCallbackId = model.CallbackHeaderId
+	HeaderAttempt    = model.CallbackHeaderAttempt
+	HeaderTimestamp  = model.CallbackHeaderTimestamp
+	HeaderSignature  = model.CallbackHeaderSignature
+)
+
+// DefaultTolerance 默认允许的签名时间偏差 超出则视为重放
+const DefaultTolerance = 5 * time.Minute
+
+var (
+	ErrMissingSignature = errors.New("conductor callback: missing signature")
+	ErrInvalidSignature = errors.New("conductor callback: invalid signature")
+	ErrInvalidTimestamp = errors.New("conductor callback: invalid timestamp")
+	ErrTimestampExpired = errors.New("conductor callback: timestamp out of tolerance")
+)
+
+// Payload 回调内容
+type Payload = model.CallbackPayload
+
+// ParsePayload 解析回调内容
+func ParsePayload(body []byte) (*Payload, error) {
+	var payload Payload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+	return &payload, nil
+}
+
+// Verify 校验回调签名
+//
+// secret 为命名空间的回调密钥；tolerance <= 0 时使用 DefaultTolerance
+func Verify(secret, timestamp, signature string, body []byte, tolerance time.Duration) error {
+	if timestamp == "" || signature == "" {
+		return ErrMissingSignature
+	}
+
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidTimestamp
+	}
+
+	if tolerance <= 0 {
+		tolerance = DefaultTolerance
+	}
+	if diff := time.Since(time.Unix(ts, 0)); diff > tolerance || diff < -tolerance {
+		return ErrTimestampExpired
+	}
+
+	expected := model.SignCallback(secret, ts, body)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// VerifyRequest 校验 HTTP 回调请求的签名 返回请求体
+//
+// 读取后会重置 r.Body 以便后续继续解析请求
+func VerifyRequest(r *http.Request, secret string, tolerance time.Duration) ([]byte, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	err = Verify(secret,
+		r.Header.Get(HeaderTimestamp),
+		r.Header.Get(HeaderSignature),
+		body,
+		tolerance)
+	if err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
+
+// VerifyIncomingContext 在 CallbackService 实现中校验 gRPC 回调的签名
+//
+// payload 为 OnTaskCompletedRequest.Payload
+func VerifyIncomingContext(ctx context.Context, secret string, payload []byte, tolerance time.Duration) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ErrMissingSignature
+	}
+
+	return Verify(secret,
+		firstValue(md, HeaderTimestamp),
+		firstValue(md, HeaderSignature),
+		payload,
+		tolerance)
+}
+
+func firstValue(md metadata.MD, key string) string {
+	vals := md.Get(key)
+	if len(vals) == 0 {
+		return ""
+	}
+	return vals[0]
+}
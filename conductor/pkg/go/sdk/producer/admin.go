@@ -0,0 +1,225 @@
+package producer
+
+import (
+	"context"
+	"time"
+
+	taskservice "github.com/ryanreadbooks/whimer/idl/gen/go/conductor/api/taskservice/v1"
+	workerservice "github.com/ryanreadbooks/whimer/idl/gen/go/conductor/api/workerservice/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListTasksOptions 列出任务的过滤条件
+type ListTasksOptions struct {
+	// 命名空间（可选，不设置则使用 ClientOptions 中的默认值）
+	Namespace string
+
+	// 任务类型 为空则不过滤
+	TaskType string
+
+	// 内部状态 为空则不过滤
+	//
+	// blocked, inited, pending_retry, dispatched, running, success, failure, aborted, expired
+	State string
+
+	// 创建时间范围 [CtimeStart, CtimeEnd) 零值表示不限制
+	CtimeStart time.Time
+	CtimeEnd   time.Time
+
+	// 分页游标 首页为空 后续传入上一页返回的 NextCursor
+	Cursor string
+
+	// 每页数量 [1, 100]
+	Count int32
+}
+
+// ListTasksResult 列出任务的结果
+type ListTasksResult struct {
+	Tasks      []*Task // 按创建时间倒序
+	NextCursor string
+	HasMore    bool
+}
+
+// TaskHistory 任务状态变更记录
+type TaskHistory struct {
+	State string // 变更后的内部状态
+	Ctime int64
+}
+
+// ListTasks 按条件分页列出任务
+func (c *Client) ListTasks(ctx context.Context, opts ListTasksOptions) (*ListTasksResult, error) {
+	req := &taskservice.ListTasksRequest{
+		Namespace: c.namespaceOr(opts.Namespace),
+		TaskType:  opts.TaskType,
+		State:     opts.State,
+		Cursor:    opts.Cursor,
+		Count:     opts.Count,
+	}
+	if !opts.CtimeStart.IsZero() {
+		req.CtimeStart = timestamppb.New(opts.CtimeStart)
+	}
+	if !opts.CtimeEnd.IsZero() {
+		req.CtimeEnd = timestamppb.New(opts.CtimeEnd)
+	}
+
+	resp, err := c.client.ListTasks(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]*Task, 0, len(resp.Tasks))
+	for _, task := range resp.Tasks {
+		tasks = append(tasks, taskFromProto(task))
+	}
+
+	return &ListTasksResult{
+		Tasks:      tasks,
+		NextCursor: resp.NextCursor,
+		HasMore:    resp.HasMore,
+	}, nil
+}
+
+// RetryTask 人工重试失败或过期的任务 重试计数会被清空
+//
+// expireTime 为重试后的过期时间 零值时如果原过期时间已过则不再过期
+func (c *Client) RetryTask(ctx context.Context, taskId string, expireTime time.Time) error {
+	req := &taskservice.RetryTaskRequest{
+		TaskId: taskId,
+	}
+	if !expireTime.IsZero() {
+		req.ExpireTime = timestamppb.New(expireTime)
+	}
+
+	_, err := c.client.RetryTask(ctx, req)
+	return err
+}
+
+// BatchAbortTask 批量终止任务 一次最多100个
+//
+// 返回成功终止的任务id以及终止失败的任务id和原因
+func (c *Client) BatchAbortTask(ctx context.Context, taskIds []string) ([]string, map[string]string, error) {
+	resp, err := c.client.BatchAbortTask(ctx, &taskservice.BatchAbortTaskRequest{
+		TaskIds: taskIds,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp.AbortedTaskIds, resp.FailedTaskIds, nil
+}
+
+// GetTaskHistory 获取任务状态变更记录 按时间正序
+func (c *Client) GetTaskHistory(ctx context.Context, taskId string) ([]*TaskHistory, error) {
+	resp, err := c.client.GetTaskHistory(ctx, &taskservice.GetTaskHistoryRequest{
+		TaskId: taskId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	histories := make([]*TaskHistory, 0, len(resp.Histories))
+	for _, history := range resp.Histories {
+		histories = append(histories, &TaskHistory{
+			State: history.State,
+			Ctime: history.Ctime,
+		})
+	}
+
+	return histories, nil
+}
+
+// TaskCallback 任务回调投递记录
+type TaskCallback struct {
+	Id            string
+	Target        string // http url 或 gRPC 目标地址
+	TargetType    string // http, grpc
+	State         string // pending, success, failure
+	Attempts      int32  // 已投递次数
+	MaxAttempts   int32
+	NextRetryTime int64 // 下次投递时间 unix ms
+	LastError     string
+	Ctime         int64
+	Utime         int64
+}
+
+// GetTaskCallbacks 获取任务的回调投递记录
+func (c *Client) GetTaskCallbacks(ctx context.Context, taskId string) ([]*TaskCallback, error) {
+	resp, err := c.client.GetTaskCallbacks(ctx, &taskservice.GetTaskCallbacksRequest{
+		TaskId: taskId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	callbacks := make([]*TaskCallback, 0, len(resp.Callbacks))
+	for _, cb := range resp.Callbacks {
+		callbacks = append(callbacks, &TaskCallback{
+			Id:            cb.Id,
+			Target:        cb.Target,
+			TargetType:    cb.TargetType,
+			State:         cb.State,
+			Attempts:      cb.Attempts,
+			MaxAttempts:   cb.MaxAttempts,
+			NextRetryTime: cb.NextRetryTime,
+			LastError:     cb.LastError,
+			Ctime:         cb.Ctime,
+			Utime:         cb.Utime,
+		})
+	}
+
+	return callbacks, nil
+}
+
+// WorkerInfo 已注册的 worker
+type WorkerInfo struct {
+	Id             string
+	Ip             string
+	TaskTypes      []string
+	Labels         map[string]string
+	Concurrency    int32
+	RegisterTime   int64 // unix ms
+	LastHeartbeat  int64 // unix ms
+	Alive          bool
+	RunningTaskIds []string // 当前分配给该 worker 的任务
+}
+
+// ListWorkersOptions 列出 worker 的过滤条件
+type ListWorkersOptions struct {
+	// 任务类型 为空则不过滤
+	TaskType string
+
+	// 标签选择器 只返回标签全部匹配的 worker
+	Selector map[string]string
+
+	// 是否包含已离线的 worker
+	IncludeOffline bool
+}
+
+// ListWorkers 列出已注册的 worker 及其当前执行的任务
+func (c *Client) ListWorkers(ctx context.Context, opts ListWorkersOptions) ([]*WorkerInfo, error) {
+	resp, err := c.workerClient.ListWorkers(ctx, &workerservice.ListWorkersRequest{
+		TaskType:       opts.TaskType,
+		Selector:       opts.Selector,
+		IncludeOffline: opts.IncludeOffline,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	workers := make([]*WorkerInfo, 0, len(resp.Workers))
+	for _, w := range resp.Workers {
+		workers = append(workers, &WorkerInfo{
+			Id:             w.Id,
+			Ip:             w.Ip,
+			TaskTypes:      w.TaskTypes,
+			Labels:         w.Labels,
+			Concurrency:    w.Concurrency,
+			RegisterTime:   w.RegisterTime,
+			LastHeartbeat:  w.LastHeartbeat,
+			Alive:          w.Alive,
+			RunningTaskIds: w.RunningTaskIds,
+		})
+	}
+
+	return workers, nil
+}
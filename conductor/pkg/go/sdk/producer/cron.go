@@ -0,0 +1,151 @@
+package producer
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	schedulev1 "github.com/ryanreadbooks/whimer/idl/gen/go/conductor/api/schedule/v1"
+	scheduleservice "github.com/ryanreadbooks/whimer/idl/gen/go/conductor/api/scheduleservice/v1"
+)
+
+// CronOptions 定时任务的选项
+type CronOptions struct {
+	// 命名空间（可选，不设置则使用 ClientOptions 中的默认值）
+	Namespace string
+
+	// 每次触发生成的任务执行成功的回调 URL
+	CallbackUrl string
+
+	// 每次触发生成的任务最大重试次数 (-1: 无限重试, 0: 不重试, >0: 指定次数)
+	MaxRetry int64
+
+	// 每次触发生成的任务过期时长 不设置则不过期
+	ExpireAfter time.Duration
+}
+
+// Schedule 定时任务信息
+type Schedule struct {
+	Id           string
+	Namespace    string
+	Name         string
+	TaskType     string
+	CronExpr     string
+	InputArgs    []byte
+	CallbackUrl  string
+	MaxRetryCnt  int64
+	ExpireAfter  int64
+	State        string
+	NextFireTime int64
+	LastFireTime int64
+	Ctime        int64
+	Utime        int64
+}
+
+func scheduleFromProto(s *schedulev1.Schedule) *Schedule {
+	if s == nil {
+		return nil
+	}
+	return &Schedule{
+		Id:           s.Id,
+		Namespace:    s.Namespace,
+		Name:         s.Name,
+		TaskType:     s.TaskType,
+		CronExpr:     s.CronExpr,
+		InputArgs:    s.InputArgs,
+		CallbackUrl:  s.CallbackUrl,
+		MaxRetryCnt:  s.MaxRetryCnt,
+		ExpireAfter:  s.ExpireAfter,
+		State:        s.State,
+		NextFireTime: s.NextFireTime,
+		LastFireTime: s.LastFireTime,
+		Ctime:        s.Ctime,
+		Utime:        s.Utime,
+	}
+}
+
+func (c *Client) namespaceOr(namespace string) string {
+	if namespace == "" {
+		return c.opts.Namespace
+	}
+	return namespace
+}
+
+// UpsertCron 创建或更新定时任务 同一命名空间下 name 唯一
+//
+// cronExpr 为标准5段cron表达式 也支持 @every 1m, @daily 等描述符；
+// 每次触发都会以 input 为输入参数生成一个 taskType 类型的任务，
+// 序列化后的 input 中可使用 {{.Name}} 和 {{.FireTime}} 模板引用定时任务名称和触发时间
+func (c *Client) UpsertCron(
+	ctx context.Context,
+	name string,
+	cronExpr string,
+	taskType string,
+	input any,
+	opts CronOptions,
+) (*Schedule, error) {
+	var inputArgs []byte
+	var err error
+	if input != nil {
+		inputArgs, err = json.Marshal(input)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := c.scheduleClient.UpsertSchedule(ctx, &scheduleservice.UpsertScheduleRequest{
+		Namespace:   c.namespaceOr(opts.Namespace),
+		Name:        name,
+		TaskType:    taskType,
+		CronExpr:    cronExpr,
+		InputArgs:   inputArgs,
+		CallbackUrl: opts.CallbackUrl,
+		MaxRetryCnt: opts.MaxRetry,
+		ExpireAfter: opts.ExpireAfter.Milliseconds(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduleFromProto(resp.Schedule), nil
+}
+
+// GetCron 获取定时任务 namespace 为空时使用默认命名空间
+func (c *Client) GetCron(ctx context.Context, namespace, name string) (*Schedule, error) {
+	resp, err := c.scheduleClient.GetSchedule(ctx, &scheduleservice.GetScheduleRequest{
+		Namespace: c.namespaceOr(namespace),
+		Name:      name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduleFromProto(resp.Schedule), nil
+}
+
+// PauseCron 暂停定时任务
+func (c *Client) PauseCron(ctx context.Context, namespace, name string) error {
+	_, err := c.scheduleClient.PauseSchedule(ctx, &scheduleservice.PauseScheduleRequest{
+		Namespace: c.namespaceOr(namespace),
+		Name:      name,
+	})
+	return err
+}
+
+// ResumeCron 恢复定时任务 暂停期间错过的触发不会补发
+func (c *Client) ResumeCron(ctx context.Context, namespace, name string) error {
+	_, err := c.scheduleClient.ResumeSchedule(ctx, &scheduleservice.ResumeScheduleRequest{
+		Namespace: c.namespaceOr(namespace),
+		Name:      name,
+	})
+	return err
+}
+
+// DeleteCron 删除定时任务
+func (c *Client) DeleteCron(ctx context.Context, namespace, name string) error {
+	_, err := c.scheduleClient.DeleteSchedule(ctx, &scheduleservice.DeleteScheduleRequest{
+		Namespace: c.namespaceOr(namespace),
+		Name:      name,
+	})
+	return err
+}
@@ -5,10 +5,13 @@ import (
 	"encoding/json"
 	"time"
 
+	scheduleservice "github.com/ryanreadbooks/whimer/idl/gen/go/conductor/api/scheduleservice/v1"
 	taskv1 "github.com/ryanreadbooks/whimer/idl/gen/go/conductor/api/task/v1"
 	taskservice "github.com/ryanreadbooks/whimer/idl/gen/go/conductor/api/taskservice/v1"
+	workerservice "github.com/ryanreadbooks/whimer/idl/gen/go/conductor/api/workerservice/v1"
 	"github.com/ryanreadbooks/whimer/misc/xconf"
 	"github.com/ryanreadbooks/whimer/misc/xgrpc"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -16,12 +19,17 @@ import (
 type ClientOptions struct {
 	HostConf  xconf.Discovery
 	Namespace string
+
+	// 已建立的连接（可选）设置后忽略 HostConf 如连接内嵌的 conductor
+	Conn grpc.ClientConnInterface
 }
 
 // Client 任务客户端
 type Client struct {
-	opts   ClientOptions
-	client taskservice.TaskServiceClient
+	opts           ClientOptions
+	client         taskservice.TaskServiceClient
+	scheduleClient scheduleservice.ScheduleServiceClient
+	workerClient   workerservice.WorkerServiceClient
 }
 
 // New 创建任务客户端
@@ -30,11 +38,14 @@ func New(opts ClientOptions) (*Client, error) {
 		opts: opts,
 	}
 
-	client := taskservice.NewTaskServiceClient(
-		xgrpc.NewRecoverableClientConn(opts.HostConf),
-	)
+	conn := opts.Conn
+	if conn == nil {
+		conn = xgrpc.NewRecoverableClientConn(opts.HostConf)
+	}
 
-	p.client = client
+	p.client = taskservice.NewTaskServiceClient(conn)
+	p.scheduleClient = scheduleservice.NewScheduleServiceClient(conn)
+	p.workerClient = workerservice.NewWorkerServiceClient(conn)
 
 	return p, nil
 }
@@ -50,9 +61,28 @@ type Task struct {
 	State       string
 	MaxRetryCnt int64
 	ExpireTime  int64
+	NotBefore   int64
+	Priority    int32
 	Ctime       int64
 	Utime       int64
 	TraceId     string
+
+	// 所属工作流 不属于工作流时为空
+	WorkflowId   string
+	WorkflowNode string
+
+	// 内部状态 比 State 更细 用于排查问题
+	InternalState string
+	CurRetryCnt   int64
+
+	// gRPC 回调目标
+	CallbackGrpcTarget string
+
+	// worker 标签选择器
+	WorkerSelector map[string]string
+
+	// 最近一次分发到的 worker 未分发时为空
+	WorkerId string
 }
 
 // UnmarshalOutput 反序列化输出结果
@@ -77,9 +107,22 @@ func taskFromProto(t *taskv1.Task) *Task {
 		State:       t.State,
 		MaxRetryCnt: t.MaxRetryCnt,
 		ExpireTime:  t.ExpireTime,
+		NotBefore:   t.NotBefore,
+		Priority:    t.Priority,
 		Ctime:       t.Ctime,
 		Utime:       t.Utime,
 		TraceId:     t.TraceId,
+
+		WorkflowId:   t.WorkflowId,
+		WorkflowNode: t.WorkflowNode,
+
+		InternalState: t.InternalState,
+		CurRetryCnt:   t.CurRetryCnt,
+
+		CallbackGrpcTarget: t.CallbackGrpcTarget,
+
+		WorkerSelector: t.WorkerSelector,
+		WorkerId:       t.WorkerId,
 	}
 }
 
@@ -88,15 +131,23 @@ type ScheduleOptions struct {
 	// 命名空间（可选，不设置则使用 ClientOptions 中的默认值）
 	Namespace string
 
-	// 执行成功的回调 URL, 失败 过期等状态均不回调
+	// 任务执行成功或最终失败时的回调 URL, 终止 过期等状态均不回调
 	//
 	// 回调地址应该接收HTTP POST方法
 	//
-	// 当成功响应时应该返回HTTP 200状态码, 响应体中的内容会被忽略
+	// 当成功响应时应该返回HTTP 2xx状态码, 响应体中的内容会被忽略
+	//
+	// 非2xx响应或请求失败时会按指数退避重试, 同一回调可能被投递多次, 被回调方需要保证接口幂等
 	//
-	// 当前设计上 回调只会触发一次, 后续会加入非200自动重试N次, 被回调方需要保证接口幂等
+	// 请求头中带有命名空间密钥计算的签名 可使用 callback.VerifyRequest 校验
 	CallbackUrl string
 
+	// gRPC 回调目标（与 CallbackUrl 二选一）
+	//
+	// 被回调方需实现 conductor.api.callback.v1.CallbackService
+	// 支持 grpc 标准 target 格式 如 dns:///host:port 以及 etcd://hosts/key
+	CallbackGrpcTarget string
+
 	// 任务执行最大重试次数 (-1: 无限重试, 0: 不重试, >0: 指定次数)
 	MaxRetry int64
 
@@ -105,6 +156,27 @@ type ScheduleOptions struct {
 
 	// 任务执行过期时长（与 ExpireTime 二选一） 精度到秒
 	ExpireAfter time.Duration
+
+	// 任务最早执行时间 在此之前任务不会被分发
+	NotBefore time.Time
+
+	// 任务延迟执行时长（与 NotBefore 二选一）
+	Delay time.Duration
+
+	// 幂等键（可选）同一命名空间下相同幂等键在去重窗口内重复提交时不会创建新任务 直接返回已有任务id
+	//
+	// 适用于调用方超时重试等可能重复提交的场景
+	IdempotencyKey string
+
+	// 优先级 [0, 9] 越大越优先分发 默认为0
+	//
+	// 工作者不足时高优先级的任务先被分发 例如用户触发的任务应高于批量回刷任务
+	Priority int32
+
+	// worker 标签选择器（可选）任务只会分发给标签全部匹配的 worker
+	//
+	// 例如 {"ffmpeg": "av1"} 只分发给声明了 ffmpeg=av1 标签的 worker, 没有匹配的 worker 时任务会一直等待
+	WorkerSelector map[string]string
 }
 
 // 提交任务后返回 通过设置callback接收任务执行结果
@@ -146,6 +218,11 @@ func (c *Client) scheduleRaw(
 		InputArgs:   inputArgs,
 		CallbackUrl: opts.CallbackUrl,
 		MaxRetryCnt: opts.MaxRetry,
+		Priority:    opts.Priority,
+
+		CallbackGrpcTarget: opts.CallbackGrpcTarget,
+		IdempotencyKey:     opts.IdempotencyKey,
+		WorkerSelector:     opts.WorkerSelector,
 	}
 
 	if !opts.ExpireTime.IsZero() {
@@ -154,6 +231,12 @@ func (c *Client) scheduleRaw(
 		req.ExpireTime = timestamppb.New(time.Now().Add(opts.ExpireAfter))
 	}
 
+	if !opts.NotBefore.IsZero() {
+		req.NotBefore = timestamppb.New(opts.NotBefore)
+	} else if opts.Delay > 0 {
+		req.NotBefore = timestamppb.New(time.Now().Add(opts.Delay))
+	}
+
 	resp, err := c.client.RegisterTask(ctx, req)
 	if err != nil {
 		return "", err
@@ -0,0 +1,190 @@
+package producer
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/conductor/pkg/go/sdk/task"
+	taskv1 "github.com/ryanreadbooks/whimer/idl/gen/go/conductor/api/task/v1"
+	taskservice "github.com/ryanreadbooks/whimer/idl/gen/go/conductor/api/taskservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 任务事件类型
+const (
+	TaskEventState    = "state"    // 状态变更
+	TaskEventProgress = "progress" // worker 上报进度
+)
+
+const (
+	watchRetryMinBackoff = 200 * time.Millisecond
+	watchRetryMaxBackoff = 5 * time.Second
+)
+
+// TaskEvent 任务事件
+type TaskEvent struct {
+	Type          string // state, progress
+	TaskId        string
+	Namespace     string
+	TaskType      string
+	State         string // success, failure, running
+	InternalState string
+	Progress      int64 // 仅 progress 事件有效
+	Time          int64 // unix ms
+}
+
+// IsFinal 任务是否已进入终态
+func (e *TaskEvent) IsFinal() bool {
+	return e.Type == TaskEventState && e.State != task.TaskStateRunning
+}
+
+func taskEventFromProto(e *taskv1.TaskEvent) *TaskEvent {
+	event := &TaskEvent{
+		Type:          TaskEventState,
+		TaskId:        e.TaskId,
+		Namespace:     e.Namespace,
+		TaskType:      e.TaskType,
+		State:         e.State,
+		InternalState: e.InternalState,
+		Progress:      e.Progress,
+		Time:          e.Time,
+	}
+	if e.Type == taskv1.TaskEventType_TASK_EVENT_TYPE_PROGRESS {
+		event.Type = TaskEventProgress
+	}
+
+	return event
+}
+
+// Wait 阻塞等待任务进入终态 返回终态时的任务信息
+//
+// 不需要轮询 GetTask 也不需要提供回调地址
+func (c *Client) Wait(ctx context.Context, taskId string) (*Task, error) {
+	err := c.Watch(ctx, taskId, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetTask(ctx, taskId)
+}
+
+// Watch 订阅单个任务的状态变更和进度 直到任务进入终态
+//
+// 首个事件为订阅时任务的当前状态；连接中断时自动重新订阅 重新订阅后可能收到重复的事件
+func (c *Client) Watch(ctx context.Context, taskId string, fn func(event *TaskEvent)) error {
+	return c.watchLoop(ctx, true, func(ctx context.Context) (grpc.ServerStreamingClient[taskv1.TaskEvent], error) {
+		return c.client.WatchTask(ctx, &taskservice.WatchTaskRequest{
+			TaskId: taskId,
+		})
+	}, fn)
+}
+
+// WatchNamespaceOptions 订阅命名空间的选项
+type WatchNamespaceOptions struct {
+	// 命名空间（可选，不设置则使用 ClientOptions 中的默认值）
+	Namespace string
+
+	// 只订阅指定类型的任务 为空则订阅全部
+	TaskTypes []string
+}
+
+// WatchNamespace 订阅命名空间下所有任务的状态变更和进度 直到 ctx 被取消
+//
+// 连接中断期间的事件会丢失 需要完整状态时应配合 GetTask 使用
+func (c *Client) WatchNamespace(
+	ctx context.Context,
+	opts WatchNamespaceOptions,
+	fn func(event *TaskEvent),
+) error {
+	req := &taskservice.WatchNamespaceRequest{
+		Namespace: c.namespaceOr(opts.Namespace),
+		TaskTypes: opts.TaskTypes,
+	}
+	return c.watchLoop(ctx, false, func(ctx context.Context) (grpc.ServerStreamingClient[taskv1.TaskEvent], error) {
+		return c.client.WatchNamespace(ctx, req)
+	}, fn)
+}
+
+// watchLoop 订阅直到任务进入终态（single）或 ctx 被取消 可重试的错误自动重新订阅
+func (c *Client) watchLoop(
+	ctx context.Context,
+	single bool,
+	open func(ctx context.Context) (grpc.ServerStreamingClient[taskv1.TaskEvent], error),
+	fn func(event *TaskEvent),
+) error {
+	backoff := watchRetryMinBackoff
+	for {
+		received, done, err := c.watchOnce(ctx, single, open, fn)
+		if done {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if !isWatchRetryable(err) {
+			return err
+		}
+
+		if received {
+			backoff = watchRetryMinBackoff
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, watchRetryMaxBackoff)
+	}
+}
+
+// watchOnce 建立一次订阅 返回是否收到过事件以及任务是否已进入终态
+func (c *Client) watchOnce(
+	ctx context.Context,
+	single bool,
+	open func(ctx context.Context) (grpc.ServerStreamingClient[taskv1.TaskEvent], error),
+	fn func(event *TaskEvent),
+) (received bool, done bool, err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := open(ctx)
+	if err != nil {
+		return false, false, err
+	}
+
+	for {
+		pbEvent, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				// 订阅单个任务时服务端在终态后关闭 正常情况下不会走到这里
+				return received, false, nil
+			}
+			return received, false, err
+		}
+
+		received = true
+		event := taskEventFromProto(pbEvent)
+		if fn != nil {
+			fn(event)
+		}
+		if single && event.IsFinal() {
+			return received, true, nil
+		}
+	}
+}
+
+func isWatchRetryable(err error) bool {
+	if err == nil {
+		return true
+	}
+
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.NotFound, codes.PermissionDenied, codes.Unauthenticated:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,129 @@
+package producer
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	taskservice "github.com/ryanreadbooks/whimer/idl/gen/go/conductor/api/taskservice/v1"
+)
+
+// WorkflowNode 工作流节点
+type WorkflowNode struct {
+	// 节点名 同一工作流内唯一
+	Name string
+
+	// 任务类型
+	TaskType string
+
+	// 输入参数 会被序列化为json
+	Input any
+
+	// 依赖的上游节点名 所有上游节点成功后才会放行；任一上游失败则该节点被终止
+	//
+	// 节点被放行后可在 worker 中通过 Task.ParentOutputs 读取上游节点的输出
+	DependsOn []string
+
+	// 节点任务执行成功或最终失败时的回调 URL
+	CallbackUrl string
+
+	// 节点任务的 gRPC 回调目标（与 CallbackUrl 二选一）
+	CallbackGrpcTarget string
+
+	// 节点任务执行最大重试次数 (-1: 无限重试, 0: 不重试, >0: 指定次数)
+	MaxRetry int64
+
+	// 节点任务过期时长 从节点被放行时开始计算
+	ExpireAfter time.Duration
+
+	// 节点任务的 worker 标签选择器
+	WorkerSelector map[string]string
+}
+
+// WorkflowOptions 提交工作流的选项
+type WorkflowOptions struct {
+	// 命名空间（可选，不设置则使用 ClientOptions 中的默认值）
+	Namespace string
+
+	// 工作流内所有任务的优先级 [0, 9]
+	Priority int32
+}
+
+// WorkflowResult 提交工作流的结果
+type WorkflowResult struct {
+	WorkflowId string
+	TaskIds    map[string]string // 节点名 -> 任务id
+}
+
+// Workflow 工作流信息
+type Workflow struct {
+	Id    string
+	State string // success, failure, running
+	Tasks []*Task
+}
+
+// SubmitWorkflow 一次提交一组有依赖关系的任务 节点之间不能有环
+func (c *Client) SubmitWorkflow(
+	ctx context.Context,
+	nodes []WorkflowNode,
+	opts WorkflowOptions,
+) (*WorkflowResult, error) {
+	pbNodes := make([]*taskservice.WorkflowNode, 0, len(nodes))
+	for _, node := range nodes {
+		var inputArgs []byte
+		if node.Input != nil {
+			var err error
+			inputArgs, err = json.Marshal(node.Input)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		pbNodes = append(pbNodes, &taskservice.WorkflowNode{
+			Name:               node.Name,
+			TaskType:           node.TaskType,
+			InputArgs:          inputArgs,
+			CallbackUrl:        node.CallbackUrl,
+			CallbackGrpcTarget: node.CallbackGrpcTarget,
+			MaxRetryCnt:        node.MaxRetry,
+			ExpireAfter:        node.ExpireAfter.Milliseconds(),
+			DependsOn:          node.DependsOn,
+			WorkerSelector:     node.WorkerSelector,
+		})
+	}
+
+	resp, err := c.client.RegisterWorkflow(ctx, &taskservice.RegisterWorkflowRequest{
+		Namespace: c.namespaceOr(opts.Namespace),
+		Nodes:     pbNodes,
+		Priority:  opts.Priority,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &WorkflowResult{
+		WorkflowId: resp.WorkflowId,
+		TaskIds:    resp.TaskIds,
+	}, nil
+}
+
+// GetWorkflow 获取工作流信息
+func (c *Client) GetWorkflow(ctx context.Context, workflowId string) (*Workflow, error) {
+	resp, err := c.client.GetWorkflow(ctx, &taskservice.GetWorkflowRequest{
+		WorkflowId: workflowId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]*Task, 0, len(resp.Tasks))
+	for _, task := range resp.Tasks {
+		tasks = append(tasks, taskFromProto(task))
+	}
+
+	return &Workflow{
+		Id:    resp.WorkflowId,
+		State: resp.State,
+		Tasks: tasks,
+	}, nil
+}
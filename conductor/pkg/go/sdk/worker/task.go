@@ -17,6 +17,24 @@ type Task struct {
 	ExpireTime  int64
 	Ctime       int64
 	TraceId     string
+
+	// 所属工作流 不属于工作流时为空
+	WorkflowId   string
+	WorkflowNode string
+
+	// 上游节点的输出参数 key为上游节点名
+	ParentOutputs map[string][]byte
+}
+
+// UnmarshalParentOutput 反序列化上游节点的输出结果
+//
+// 上游节点不存在或没有输出时不做任何处理
+func (t *Task) UnmarshalParentOutput(node string, v any) error {
+	output := t.ParentOutputs[node]
+	if len(output) == 0 {
+		return nil
+	}
+	return json.Unmarshal(output, v)
 }
 
 // UnmarshalInput 反序列化输入参数
@@ -41,5 +59,9 @@ func taskFromProto(t *taskv1.Task) *Task {
 		ExpireTime:  t.ExpireTime,
 		Ctime:       t.Ctime,
 		TraceId:     t.TraceId,
+
+		WorkflowId:    t.WorkflowId,
+		WorkflowNode:  t.WorkflowNode,
+		ParentOutputs: t.ParentOutputs,
 	}
 }
@@ -11,9 +11,11 @@ import (
 	workerv1 "github.com/ryanreadbooks/whimer/idl/gen/go/conductor/api/worker/v1"
 	workerservice "github.com/ryanreadbooks/whimer/idl/gen/go/conductor/api/workerservice/v1"
 	"github.com/ryanreadbooks/whimer/misc/concurrent"
+	"github.com/ryanreadbooks/whimer/misc/uuid"
 	"github.com/ryanreadbooks/whimer/misc/xconf"
 	"github.com/ryanreadbooks/whimer/misc/xgrpc"
 	"github.com/ryanreadbooks/whimer/misc/xlog"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -22,7 +24,12 @@ import (
 type Options struct {
 	HostConf xconf.Discovery
 
-	// Worker ID（可选）
+	// 已建立的连接（可选）设置后忽略 HostConf 如连接内嵌的 conductor
+	//
+	// 连接不应安装会转换 gRPC 错误的客户端拦截器 否则无法判断上报错误是否可重试
+	Conn grpc.ClientConnInterface
+
+	// Worker ID（可选 为空时自动生成）
 	WorkerId string
 
 	// Worker IP（可选）
@@ -31,11 +38,16 @@ type Options struct {
 	// 并发数（同时处理的任务数）
 	Concurrency int
 
+	// Worker 标签（可选）如 ffmpeg=av1 cpu=16
+	// 带有 worker selector 的任务只会分发给标签全部匹配的 worker
+	Labels map[string]string
+
 	// 上报重试配置
 	ReportRetry RetryOptions
 
 	// 心跳上报间隔（默认 10s）
-	// 用于定期检测任务是否被终止
+	// 用于定期检测任务是否被终止 同时续期任务的执行租约
+	// 需小于服务端的租约时长（默认 60s）否则执行中的任务会被回收
 	HeartbeatInterval time.Duration
 }
 
@@ -65,10 +77,16 @@ type Worker struct {
 	stopping atomic.Bool
 }
 
-const defaultHeartbeatInterval = 10 * time.Second
+const (
+	defaultHeartbeatInterval = 10 * time.Second
+	unregisterTimeout        = 3 * time.Second
+)
 
 // New 创建 Worker
 func New(opts Options) (*Worker, error) {
+	if opts.WorkerId == "" {
+		opts.WorkerId = uuid.NewUUID().String()
+	}
 	if opts.Concurrency <= 0 {
 		opts.Concurrency = 1
 	}
@@ -96,14 +114,15 @@ func New(opts Options) (*Worker, error) {
 		doneCh:       make(chan struct{}),
 	}
 
-	client := workerservice.NewWorkerServiceClient(
-		xgrpc.NewRecoverableClientConn(
+	conn := opts.Conn
+	if conn == nil {
+		conn = xgrpc.NewRecoverableClientConn(
 			opts.HostConf,
 			xgrpc.WithoutDefaultInterceptor(),
-		),
-	)
+		)
+	}
 
-	w.client = client
+	w.client = workerservice.NewWorkerServiceClient(conn)
 
 	return w, nil
 }
@@ -144,6 +163,18 @@ func (w *Worker) Run(ctx context.Context) error {
 		return nil
 	}
 
+	// 注册失败不影响轮询 服务端会在轮询时补录
+	_, err := w.client.RegisterWorker(ctx, &workerservice.RegisterWorkerRequest{
+		Id:          w.opts.WorkerId,
+		Ip:          w.opts.IP,
+		TaskTypes:   taskTypes,
+		Labels:      w.opts.Labels,
+		Concurrency: int32(w.opts.Concurrency),
+	})
+	if err != nil {
+		xlog.Msg("register worker failed").Extras("workerId", w.opts.WorkerId).Err(err).Errorx(ctx)
+	}
+
 	ctx, w.cancel = context.WithCancel(ctx)
 
 	var wg sync.WaitGroup
@@ -171,6 +202,15 @@ func (w *Worker) Stop() {
 		w.cancel()
 	}
 	<-w.doneCh
+
+	ctx, cancel := context.WithTimeout(context.Background(), unregisterTimeout)
+	defer cancel()
+	_, err := w.client.UnregisterWorker(ctx, &workerservice.UnregisterWorkerRequest{
+		Id: w.opts.WorkerId,
+	})
+	if err != nil {
+		xlog.Msg("unregister worker failed").Extras("workerId", w.opts.WorkerId).Err(err).Errorx(ctx)
+	}
 }
 
 func (w *Worker) pollLoop(ctx context.Context, taskType string, sem chan struct{}) {
@@ -228,7 +268,9 @@ func (w *Worker) poll(ctx context.Context, taskType string) (*Task, error) {
 			Metadata: &workerv1.WorkerMetadata{
 				Ip: w.opts.IP,
 			},
-			State: workerv1.WorkerState_WORKER_STATE_READY,
+			State:       workerv1.WorkerState_WORKER_STATE_READY,
+			Labels:      w.opts.Labels,
+			Concurrency: int32(w.opts.Concurrency),
 		},
 	})
 	if err != nil {
@@ -240,7 +282,8 @@ func (w *Worker) poll(ctx context.Context, taskType string) (*Task, error) {
 
 func (w *Worker) processTask(ctx context.Context, task *Task) {
 	_, err := w.client.AcceptTask(ctx, &workerservice.AcceptTaskRequest{
-		TaskId: task.Id,
+		TaskId:   task.Id,
+		WorkerId: w.opts.WorkerId,
 	})
 	if err != nil {
 		xlog.Msg("accept task failed").Extras("taskId", task.Id).Err(err).Errorx(ctx)
@@ -313,6 +356,7 @@ func (w *Worker) reportTaskProgress(ctx context.Context, taskId string, progress
 	resp, err := w.client.ReportTask(ctx, &workerservice.ReportTaskRequest{
 		TaskId:   taskId,
 		Progress: progress,
+		WorkerId: w.opts.WorkerId,
 	})
 	if err != nil {
 		// 上报失败时不终止任务，只记录日志
@@ -342,6 +386,7 @@ func (w *Worker) completeTask(
 		Success:    success,
 		ErrorMsg:   []byte(errMsg),
 		Retryable:  retryable,
+		WorkerId:   w.opts.WorkerId,
 	}
 
 	ctx = context.WithoutCancel(ctx)
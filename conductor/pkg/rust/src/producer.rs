@@ -6,6 +6,7 @@ use misc_rs::xgrpc::discovery::{DiscoveryError, EtcdConfig, EtcdDiscovery};
 use prost_types::Timestamp;
 use serde::Serialize;
 use serde::de::DeserializeOwned;
+use std::collections::HashMap;
 use std::fmt::{Display, Formatter};
 use std::time::{Duration, SystemTime, UNIX_EPOCH};
 use whimer_idl_rust::conductor::api::task::v1 as taskv1;
@@ -130,6 +131,12 @@ pub struct Task {
   pub utime: i64,
   /// 链路追踪 ID。
   pub trace_id: String,
+  /// 最早执行时间（Unix ms）。
+  pub not_before: i64,
+  /// 所属工作流 ID，不属于工作流时为空。
+  pub workflow_id: String,
+  /// 在工作流中的节点名。
+  pub workflow_node: String,
 }
 
 impl Task {
@@ -153,14 +160,26 @@ impl Task {
 pub struct ScheduleOptions {
   /// 覆盖默认命名空间；为空时回退到客户端默认值。
   pub namespace: Option<String>,
-  /// 任务回调 URL。
+  /// 任务回调 URL；失败时按指数退避重试，请求头带有命名空间密钥计算的签名。
   pub callback_url: String,
+  /// gRPC 回调目标（与 `callback_url` 二选一），被回调方需实现 `CallbackService`。
+  pub callback_grpc_target: String,
   /// 最大重试次数。
   pub max_retry: i64,
   /// 绝对过期时间。
   pub expire_time: Option<SystemTime>,
   /// 相对过期时间（基于当前时间偏移）。
   pub expire_after: Option<Duration>,
+  /// 最早执行时间；不设置则立即执行。
+  pub not_before: Option<SystemTime>,
+  /// 相对延迟执行时长（与 `not_before` 二选一）。
+  pub delay: Option<Duration>,
+  /// 幂等键；同一命名空间下去重窗口内重复提交时直接返回已有任务 ID。
+  pub idempotency_key: Option<String>,
+  /// 优先级 `[0, 9]`，越大越优先分发，默认为 0。
+  pub priority: i32,
+  /// Worker 标签选择器；任务只分发给标签全部匹配的 Worker。
+  pub worker_selector: HashMap<String, String>,
 }
 
 /// Producer 客户端。
@@ -221,6 +240,11 @@ impl Client {
       callback_url: opts.callback_url,
       max_retry_cnt: opts.max_retry,
       expire_time: None,
+      not_before: None,
+      idempotency_key: opts.idempotency_key.unwrap_or_default(),
+      priority: opts.priority,
+      callback_grpc_target: opts.callback_grpc_target,
+      worker_selector: opts.worker_selector,
     };
 
     if let Some(expire_time) = opts.expire_time {
@@ -229,6 +253,12 @@ impl Client {
       req.expire_time = Some(system_time_to_timestamp(SystemTime::now() + expire_after)?);
     }
 
+    if let Some(not_before) = opts.not_before {
+      req.not_before = Some(system_time_to_timestamp(not_before)?);
+    } else if let Some(delay) = opts.delay {
+      req.not_before = Some(system_time_to_timestamp(SystemTime::now() + delay)?);
+    }
+
     let mut client = self.client.clone();
     let resp = client.register_task(req).await?.into_inner();
     Ok(resp.task_id)
@@ -277,6 +307,9 @@ fn task_from_proto(task: taskv1::Task) -> Task {
     ctime: task.ctime,
     utime: task.utime,
     trace_id: task.trace_id,
+    not_before: task.not_before,
+    workflow_id: task.workflow_id,
+    workflow_node: task.workflow_node,
   }
 }
 
@@ -111,6 +111,8 @@ pub struct WorkerOptions {
   pub ip: String,
   /// 并发处理上限。
   pub concurrency: usize,
+  /// Worker 标签（如 `ffmpeg=av1`），带 worker selector 的任务只分发给标签全部匹配的 Worker。
+  pub labels: HashMap<String, String>,
   /// 结果上报重试策略。
   pub report_retry: RetryOptions,
   /// 心跳上报间隔。
@@ -127,6 +129,7 @@ impl Default for WorkerOptions {
       worker_id: String::new(),
       ip: String::new(),
       concurrency: DEFAULT_CONCURRENCY,
+      labels: HashMap::new(),
       report_retry: RetryOptions::default(),
       heartbeat_interval: DEFAULT_HEARTBEAT_INTERVAL,
       balance_channel_capacity: DEFAULT_BALANCE_CHANNEL_CAPACITY,
@@ -166,9 +169,28 @@ pub struct Task {
   pub ctime: i64,
   /// 链路追踪 ID。
   pub trace_id: String,
+  /// 所属工作流 ID，不属于工作流时为空。
+  pub workflow_id: String,
+  /// 在工作流中的节点名。
+  pub workflow_node: String,
+  /// 上游节点的输出参数（JSON bytes），key 为上游节点名。
+  pub parent_outputs: HashMap<String, Vec<u8>>,
 }
 
 impl Task {
+  /// 将上游节点 `node` 的输出反序列化为指定类型。
+  ///
+  /// 当上游节点不存在或输出为空时返回 `Ok(None)`。
+  pub fn unmarshal_parent_output<T: DeserializeOwned>(
+    &self,
+    node: &str,
+  ) -> std::result::Result<Option<T>, serde_json::Error> {
+    match self.parent_outputs.get(node) {
+      Some(output) if !output.is_empty() => Ok(Some(serde_json::from_slice::<T>(output)?)),
+      _ => Ok(None),
+    }
+  }
+
   /// 将 `input_args` 反序列化为指定类型。
   ///
   /// 当输入为空时返回 `Ok(None)`。
@@ -605,6 +627,8 @@ impl Worker {
           mem_usage: 0.0,
         }),
         state: workerv1::WorkerState::Ready as i32,
+        labels: self.inner.opts.labels.clone(),
+        concurrency: self.inner.opts.concurrency as i32,
       }),
     };
 
@@ -676,6 +700,7 @@ impl Worker {
     client
       .accept_task(workerservicev1::AcceptTaskRequest {
         task_id: task_id.to_string(),
+        worker_id: self.inner.opts.worker_id.clone(),
       })
       .await?;
     Ok(())
@@ -687,6 +712,7 @@ impl Worker {
       .report_task(workerservicev1::ReportTaskRequest {
         task_id: task_id.to_string(),
         progress,
+        worker_id: self.inner.opts.worker_id.clone(),
       })
       .await
     {
@@ -710,6 +736,7 @@ impl Worker {
       success,
       error_msg: result.error.unwrap_or_default().into_bytes().into(),
       retryable: result.retryable,
+      worker_id: self.inner.opts.worker_id.clone(),
     };
 
     for attempt in 1..=max_attempts {
@@ -816,6 +843,13 @@ fn task_from_proto(task: taskv1::Task) -> Task {
     expire_time: task.expire_time,
     ctime: task.ctime,
     trace_id: task.trace_id,
+    workflow_id: task.workflow_id,
+    workflow_node: task.workflow_node,
+    parent_outputs: task
+      .parent_outputs
+      .into_iter()
+      .map(|(node, output)| (node, output.to_vec()))
+      .collect(),
   }
 }
 
@@ -5,7 +5,7 @@ go 1.24.0
 require (
 	github.com/mitchellh/mapstructure v1.5.0
 	github.com/redis/go-redis/v9 v9.11.0
-	github.com/robfig/cron/v3 v3.0.1
+	github.com/ryanreadbooks/whimer/conductor v0.0.0-00010101000000-000000000000
 	github.com/ryanreadbooks/whimer/misc v0.0.0-00010101000000-000000000000
 	github.com/smartystreets/goconvey v1.8.1
 	github.com/zeromicro/go-zero v1.7.3
@@ -64,6 +64,7 @@ require (
 	github.com/prometheus/client_model v0.6.1 // indirect
 	github.com/prometheus/common v0.55.0 // indirect
 	github.com/prometheus/procfs v0.15.1 // indirect
+	github.com/robfig/cron/v3 v3.0.1 // indirect
 	github.com/ryanreadbooks/whimer/idl/gen/go v0.0.0
 	github.com/segmentio/kafka-go v0.4.47 // indirect
 	github.com/smarty/assertions v1.15.0 // indirect
@@ -117,3 +118,5 @@ require (
 replace github.com/ryanreadbooks/whimer/misc => ../misc
 
 replace github.com/ryanreadbooks/whimer/idl/gen/go => ../idl/gen/go
+
+replace github.com/ryanreadbooks/whimer/conductor => ../conductor
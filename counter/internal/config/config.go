@@ -2,6 +2,7 @@ package config
 
 import (
 	"github.com/ryanreadbooks/whimer/misc/obfuscate"
+	"github.com/ryanreadbooks/whimer/misc/xconf"
 	"github.com/zeromicro/go-zero/core/logx"
 	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/zrpc"
@@ -22,9 +23,12 @@ type Config struct {
 
 	Redis redis.RedisConf `json:"redis"`
 
+	// 定时任务由 conductor 调度
 	Cron struct {
 		SummarySpec string `json:"summary_spec"`
 	} `json:"cron"`
 
+	Conductor xconf.Discovery `json:"conductor"`
+
 	Obfuscate obfuscate.Config `json:"obfuscate"`
 }
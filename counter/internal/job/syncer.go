@@ -2,34 +2,65 @@ package job
 
 import (
 	"context"
+	"time"
 
-	"github.com/robfig/cron/v3"
+	"github.com/ryanreadbooks/whimer/conductor/pkg/go/sdk/producer"
+	"github.com/ryanreadbooks/whimer/conductor/pkg/go/sdk/worker"
 	"github.com/ryanreadbooks/whimer/counter/internal/config"
 	"github.com/ryanreadbooks/whimer/counter/internal/srv"
+	"github.com/ryanreadbooks/whimer/misc/concurrent"
 	"github.com/ryanreadbooks/whimer/misc/xlog"
+	"github.com/ryanreadbooks/whimer/misc/xretry"
 )
 
+const (
+	conductorNamespace = "counter"
+
+	cronSyncRecordSummary     = "counter.sync_record_summary"
+	taskTypeSyncRecordSummary = "counter.sync_record_summary"
+)
+
+// Syncer 通过 conductor 定时任务触发计数汇总同步
 type Syncer struct {
-	c   *cron.Cron
-	srv *srv.Service
+	cfg      *config.Config
+	srv      *srv.Service
+	producer *producer.Client
+	worker   *worker.Worker
+
+	ctx    context.Context
+	cancel context.CancelFunc
 }
 
 func NewSyncer(cfg *config.Config, srv *srv.Service) (*Syncer, error) {
-	c := cron.New(cron.WithChain(
-		cron.Recover(&xlog.CronLogger{}),
-	))
-
-	s := &Syncer{
-		c:   c,
-		srv: srv,
+	p, err := producer.New(producer.ClientOptions{
+		HostConf:  cfg.Conductor,
+		Namespace: conductorNamespace,
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	_, err := c.AddFunc(cfg.Cron.SummarySpec, s.SyncRecordSummary)
+	w, err := worker.New(worker.Options{
+		HostConf:    cfg.Conductor,
+		Concurrency: 1,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return s, err
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &Syncer{
+		cfg:      cfg,
+		srv:      srv,
+		producer: p,
+		worker:   w,
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+
+	w.RegisterHandler(taskTypeSyncRecordSummary, s.SyncRecordSummary)
+
+	return s, nil
 }
 
 func MustNewSyncer(cfg *config.Config, srv *srv.Service) *Syncer {
@@ -40,22 +71,67 @@ func MustNewSyncer(cfg *config.Config, srv *srv.Service) *Syncer {
 	}
 }
 
-func (s *Syncer) SyncRecordSummary() {
+func (s *Syncer) SyncRecordSummary(ctx context.Context, task *worker.Task) worker.Result {
 	xlog.Msg("counter record summary syncer starts running...").Info()
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
 	err := s.srv.CounterSrv.CounterBiz.SyncSummaryFromRecords(ctx)
 	if err != nil {
 		xlog.Msg("counter record summary syncer failed").Err(err).Error()
+		return worker.Result{Error: err}
 	}
 	xlog.Msg("counter record summary syncer done.").Info()
+
+	return worker.Result{}
+}
+
+func (s *Syncer) Start() {
+	concurrent.SafeGo2(s.ctx, concurrent.SafeGo2Opt{
+		Name:             "counter.syncer.upsert_cron",
+		InheritCtxCancel: true,
+		Job: func(ctx context.Context) error {
+			s.upsertCron(ctx)
+			return nil
+		},
+	})
+
+	concurrent.SafeGo2(s.ctx, concurrent.SafeGo2Opt{
+		Name:             "counter.syncer.worker",
+		InheritCtxCancel: true,
+		Job: func(ctx context.Context) error {
+			return s.worker.Run(ctx)
+		},
+	})
 }
 
-func (c *Syncer) Start() {
-	c.c.Start()
+// 注册定时任务 失败时退避重试直到成功或者退出
+func (s *Syncer) upsertCron(ctx context.Context) {
+	backoff := xretry.NewPermenantBackoff(time.Second, time.Minute, 2.0)
+	for {
+		_, err := s.producer.UpsertCron(ctx,
+			cronSyncRecordSummary,
+			s.cfg.Cron.SummarySpec,
+			taskTypeSyncRecordSummary,
+			nil,
+			producer.CronOptions{})
+		if err == nil {
+			return
+		}
+
+		wait, _ := backoff.NextBackOff()
+		xlog.Msg("counter syncer upsert cron failed, retrying").
+			Err(err).
+			Extras("retry", backoff.GetCurrentRetry(), "wait", wait).
+			Error()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+	}
 }
 
-func (c *Syncer) Stop() {
-	c.c.Stop()
+func (s *Syncer) Stop() {
+	s.worker.Stop()
+	s.cancel()
 	xlog.Msg("counter syncer stopped.").Info()
 }
@@ -117,6 +117,118 @@ func (CommentType) EnumDescriptor() ([]byte, []int) {
 	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{1}
 }
 
+// 举报原因
+type ReportReason int32
+
+const (
+	ReportReason_REPORT_REASON_UNSPECIFIED ReportReason = 0
+	ReportReason_REPORT_REASON_SPAM        ReportReason = 1 // 垃圾广告
+	ReportReason_REPORT_REASON_ABUSE       ReportReason = 2 // 辱骂攻击
+	ReportReason_REPORT_REASON_PORN        ReportReason = 3 // 色情低俗
+	ReportReason_REPORT_REASON_ILLEGAL     ReportReason = 4 // 违法违规
+	ReportReason_REPORT_REASON_OTHER       ReportReason = 5 // 其它
+)
+
+// Enum value maps for ReportReason.
+var (
+	ReportReason_name = map[int32]string{
+		0: "REPORT_REASON_UNSPECIFIED",
+		1: "REPORT_REASON_SPAM",
+		2: "REPORT_REASON_ABUSE",
+		3: "REPORT_REASON_PORN",
+		4: "REPORT_REASON_ILLEGAL",
+		5: "REPORT_REASON_OTHER",
+	}
+	ReportReason_value = map[string]int32{
+		"REPORT_REASON_UNSPECIFIED": 0,
+		"REPORT_REASON_SPAM":        1,
+		"REPORT_REASON_ABUSE":       2,
+		"REPORT_REASON_PORN":        3,
+		"REPORT_REASON_ILLEGAL":     4,
+		"REPORT_REASON_OTHER":       5,
+	}
+)
+
+func (x ReportReason) Enum() *ReportReason {
+	p := new(ReportReason)
+	*p = x
+	return p
+}
+
+func (x ReportReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_comment_api_v1_comment_proto_enumTypes[2].Descriptor()
+}
+
+func (ReportReason) Type() protoreflect.EnumType {
+	return &file_comment_api_v1_comment_proto_enumTypes[2]
+}
+
+func (x ReportReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportReason.Descriptor instead.
+func (ReportReason) EnumDescriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{2}
+}
+
+// 举报处理结果
+type ReportResolution int32
+
+const (
+	ReportResolution_REPORT_RESOLUTION_PENDING    ReportResolution = 0 // 待处理
+	ReportResolution_REPORT_RESOLUTION_KEEP       ReportResolution = 1 // 保留评论
+	ReportResolution_REPORT_RESOLUTION_DELETE     ReportResolution = 2 // 删除评论
+	ReportResolution_REPORT_RESOLUTION_BAN_AUTHOR ReportResolution = 3 // 删除评论并禁止作者评论
+)
+
+// Enum value maps for ReportResolution.
+var (
+	ReportResolution_name = map[int32]string{
+		0: "REPORT_RESOLUTION_PENDING",
+		1: "REPORT_RESOLUTION_KEEP",
+		2: "REPORT_RESOLUTION_DELETE",
+		3: "REPORT_RESOLUTION_BAN_AUTHOR",
+	}
+	ReportResolution_value = map[string]int32{
+		"REPORT_RESOLUTION_PENDING":    0,
+		"REPORT_RESOLUTION_KEEP":       1,
+		"REPORT_RESOLUTION_DELETE":     2,
+		"REPORT_RESOLUTION_BAN_AUTHOR": 3,
+	}
+)
+
+func (x ReportResolution) Enum() *ReportResolution {
+	p := new(ReportResolution)
+	*p = x
+	return p
+}
+
+func (x ReportResolution) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportResolution) Descriptor() protoreflect.EnumDescriptor {
+	return file_comment_api_v1_comment_proto_enumTypes[3].Descriptor()
+}
+
+func (ReportResolution) Type() protoreflect.EnumType {
+	return &file_comment_api_v1_comment_proto_enumTypes[3]
+}
+
+func (x ReportResolution) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportResolution.Descriptor instead.
+func (ReportResolution) EnumDescriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{3}
+}
+
 type SortType int32
 
 const (
@@ -147,11 +259,11 @@ func (x SortType) String() string {
 }
 
 func (SortType) Descriptor() protoreflect.EnumDescriptor {
-	return file_comment_api_v1_comment_proto_enumTypes[2].Descriptor()
+	return file_comment_api_v1_comment_proto_enumTypes[4].Descriptor()
 }
 
 func (SortType) Type() protoreflect.EnumType {
-	return &file_comment_api_v1_comment_proto_enumTypes[2]
+	return &file_comment_api_v1_comment_proto_enumTypes[4]
 }
 
 func (x SortType) Number() protoreflect.EnumNumber {
@@ -160,7 +272,57 @@ func (x SortType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SortType.Descriptor instead.
 func (SortType) EnumDescriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{2}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{4}
+}
+
+// 对象的评论模式
+type CommentMode int32
+
+const (
+	CommentMode_COMMENT_MODE_OPEN      CommentMode = 0 // 所有人可评论
+	CommentMode_COMMENT_MODE_CLOSED    CommentMode = 1 // 关闭评论
+	CommentMode_COMMENT_MODE_FOLLOWERS CommentMode = 2 // 仅关注作者的用户可评论
+)
+
+// Enum value maps for CommentMode.
+var (
+	CommentMode_name = map[int32]string{
+		0: "COMMENT_MODE_OPEN",
+		1: "COMMENT_MODE_CLOSED",
+		2: "COMMENT_MODE_FOLLOWERS",
+	}
+	CommentMode_value = map[string]int32{
+		"COMMENT_MODE_OPEN":      0,
+		"COMMENT_MODE_CLOSED":    1,
+		"COMMENT_MODE_FOLLOWERS": 2,
+	}
+)
+
+func (x CommentMode) Enum() *CommentMode {
+	p := new(CommentMode)
+	*p = x
+	return p
+}
+
+func (x CommentMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommentMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_comment_api_v1_comment_proto_enumTypes[5].Descriptor()
+}
+
+func (CommentMode) Type() protoreflect.EnumType {
+	return &file_comment_api_v1_comment_proto_enumTypes[5]
+}
+
+func (x CommentMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommentMode.Descriptor instead.
+func (CommentMode) EnumDescriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{5}
 }
 
 type CommentAtUser struct {
@@ -400,6 +562,7 @@ type AddCommentResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	CommentId int64 `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"` // 评论id
+	Held      bool  `protobuf:"varint,2,opt,name=held,proto3" json:"held,omitempty"`                            // 评论命中作者设置的屏蔽词 等待作者审核
 }
 
 func (x *AddCommentResponse) Reset() {
@@ -441,6 +604,13 @@ func (x *AddCommentResponse) GetCommentId() int64 {
 	return 0
 }
 
+func (x *AddCommentResponse) GetHeld() bool {
+	if x != nil {
+		return x.Held
+	}
+	return false
+}
+
 // 删除评论
 type DelCommentRequest struct {
 	state         protoimpl.MessageState
@@ -728,6 +898,10 @@ type ReportCommentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	CommentId int64        `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"` // 被举报的评论id
+	Reason    ReportReason `protobuf:"varint,2,opt,name=reason,proto3,enum=comment.api.v1.ReportReason" json:"reason,omitempty"`
+	Detail    string       `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"` // 补充说明
 }
 
 func (x *ReportCommentRequest) Reset() {
@@ -762,6 +936,27 @@ func (*ReportCommentRequest) Descriptor() ([]byte, []int) {
 	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{10}
 }
 
+func (x *ReportCommentRequest) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *ReportCommentRequest) GetReason() ReportReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ReportReason_REPORT_REASON_UNSPECIFIED
+}
+
+func (x *ReportCommentRequest) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
 type ReportCommentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -908,9 +1103,10 @@ type PageGetCommentRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Oid    int64    `protobuf:"varint,1,opt,name=oid,proto3" json:"oid,omitempty"`
-	Cursor int64    `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"`                                            // 起始游标
-	SortBy SortType `protobuf:"varint,3,opt,name=sort_by,json=sortBy,proto3,enum=comment.api.v1.SortType" json:"sort_by,omitempty"` // 排序方式
+	Oid         int64    `protobuf:"varint,1,opt,name=oid,proto3" json:"oid,omitempty"`
+	Cursor      int64    `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"`                                            // 起始游标
+	SortBy      SortType `protobuf:"varint,3,opt,name=sort_by,json=sortBy,proto3,enum=comment.api.v1.SortType" json:"sort_by,omitempty"` // 排序方式
+	TrendCursor string   `protobuf:"bytes,4,opt,name=trend_cursor,json=trendCursor,proto3" json:"trend_cursor,omitempty"`                // 按热度排序时的起始游标
 }
 
 func (x *PageGetCommentRequest) Reset() {
@@ -966,6 +1162,13 @@ func (x *PageGetCommentRequest) GetSortBy() SortType {
 	return SortType_ByTime
 }
 
+func (x *PageGetCommentRequest) GetTrendCursor() string {
+	if x != nil {
+		return x.TrendCursor
+	}
+	return ""
+}
+
 // 评论实体
 type CommentItem struct {
 	state         protoimpl.MessageState
@@ -989,6 +1192,9 @@ type CommentItem struct {
 	SubsCount int64               `protobuf:"varint,15,opt,name=subs_count,json=subsCount,proto3" json:"subs_count,omitempty"`     // 子评论数
 	Images    []*CommentItemImage `protobuf:"bytes,16,rep,name=images,proto3" json:"images,omitempty"`                             // 评论图片资源
 	AtUsers   []*CommentAtUser    `protobuf:"bytes,17,rep,name=at_users,json=atUsers,proto3" json:"at_users,omitempty"`            // @用户列表
+	IsEdited  bool                `protobuf:"varint,18,opt,name=is_edited,json=isEdited,proto3" json:"is_edited,omitempty"`        // 是否被编辑过
+	Etime     int64               `protobuf:"varint,19,opt,name=etime,proto3" json:"etime,omitempty"`                              // 最后编辑时间
+	Emojis    []*CommentEmoji     `protobuf:"bytes,20,rep,name=emojis,proto3" json:"emojis,omitempty"`                             // 内容中引用的表情
 }
 
 func (x *CommentItem) Reset() {
@@ -1142,6 +1348,131 @@ func (x *CommentItem) GetAtUsers() []*CommentAtUser {
 	return nil
 }
 
+func (x *CommentItem) GetIsEdited() bool {
+	if x != nil {
+		return x.IsEdited
+	}
+	return false
+}
+
+func (x *CommentItem) GetEtime() int64 {
+	if x != nil {
+		return x.Etime
+	}
+	return 0
+}
+
+func (x *CommentItem) GetEmojis() []*CommentEmoji {
+	if x != nil {
+		return x.Emojis
+	}
+	return nil
+}
+
+// 评论内容中以[pack:item]形式引用的表情 发布时解析并固定版本
+type CommentEmoji struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token   string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // 内容中的引用文本 如[doge:smile]
+	Pack    string `protobuf:"bytes,2,opt,name=pack,proto3" json:"pack,omitempty"`   // 表情包代码
+	Code    string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`   // 表情代码
+	Key     string `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`     // 表情资源的存储key
+	Width   uint32 `protobuf:"varint,5,opt,name=width,proto3" json:"width,omitempty"`
+	Height  uint32 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Format  string `protobuf:"bytes,7,opt,name=format,proto3" json:"format,omitempty"`
+	Version int64  `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"` // 引用时的表情版本
+}
+
+func (x *CommentEmoji) Reset() {
+	*x = CommentEmoji{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentEmoji) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentEmoji) ProtoMessage() {}
+
+func (x *CommentEmoji) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentEmoji.ProtoReflect.Descriptor instead.
+func (*CommentEmoji) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CommentEmoji) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CommentEmoji) GetPack() string {
+	if x != nil {
+		return x.Pack
+	}
+	return ""
+}
+
+func (x *CommentEmoji) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CommentEmoji) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CommentEmoji) GetWidth() uint32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *CommentEmoji) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *CommentEmoji) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *CommentEmoji) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type CommentItemImageMeta struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1156,7 +1487,7 @@ type CommentItemImageMeta struct {
 func (x *CommentItemImageMeta) Reset() {
 	*x = CommentItemImageMeta{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[16]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1169,7 +1500,7 @@ func (x *CommentItemImageMeta) String() string {
 func (*CommentItemImageMeta) ProtoMessage() {}
 
 func (x *CommentItemImageMeta) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[16]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1182,7 +1513,7 @@ func (x *CommentItemImageMeta) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommentItemImageMeta.ProtoReflect.Descriptor instead.
 func (*CommentItemImageMeta) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{16}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *CommentItemImageMeta) GetWidth() uint32 {
@@ -1225,7 +1556,7 @@ type CommentItemImage struct {
 func (x *CommentItemImage) Reset() {
 	*x = CommentItemImage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[17]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1238,7 +1569,7 @@ func (x *CommentItemImage) String() string {
 func (*CommentItemImage) ProtoMessage() {}
 
 func (x *CommentItemImage) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[17]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1251,7 +1582,7 @@ func (x *CommentItemImage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommentItemImage.ProtoReflect.Descriptor instead.
 func (*CommentItemImage) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{17}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *CommentItemImage) GetKey() string {
@@ -1273,15 +1604,16 @@ type PageGetCommentResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Comments   []*CommentItem `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`                        // 评论数据
-	NextCursor int64          `protobuf:"varint,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // 分页获取下一次请求的游标
-	HasNext    bool           `protobuf:"varint,3,opt,name=has_next,json=hasNext,proto3" json:"has_next,omitempty"`          // 是否还有数据
+	Comments        []*CommentItem `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`                                        // 评论数据
+	NextCursor      int64          `protobuf:"varint,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`                 // 分页获取下一次请求的游标
+	HasNext         bool           `protobuf:"varint,3,opt,name=has_next,json=hasNext,proto3" json:"has_next,omitempty"`                          // 是否还有数据
+	NextTrendCursor string         `protobuf:"bytes,4,opt,name=next_trend_cursor,json=nextTrendCursor,proto3" json:"next_trend_cursor,omitempty"` // 按热度排序时下一次请求的游标
 }
 
 func (x *PageGetCommentResponse) Reset() {
 	*x = PageGetCommentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[18]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1294,7 +1626,7 @@ func (x *PageGetCommentResponse) String() string {
 func (*PageGetCommentResponse) ProtoMessage() {}
 
 func (x *PageGetCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[18]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1307,7 +1639,7 @@ func (x *PageGetCommentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PageGetCommentResponse.ProtoReflect.Descriptor instead.
 func (*PageGetCommentResponse) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{18}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *PageGetCommentResponse) GetComments() []*CommentItem {
@@ -1331,6 +1663,13 @@ func (x *PageGetCommentResponse) GetHasNext() bool {
 	return false
 }
 
+func (x *PageGetCommentResponse) GetNextTrendCursor() string {
+	if x != nil {
+		return x.NextTrendCursor
+	}
+	return ""
+}
+
 // 获取子评论
 type PageGetSubCommentRequest struct {
 	state         protoimpl.MessageState
@@ -1345,7 +1684,7 @@ type PageGetSubCommentRequest struct {
 func (x *PageGetSubCommentRequest) Reset() {
 	*x = PageGetSubCommentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[19]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1358,7 +1697,7 @@ func (x *PageGetSubCommentRequest) String() string {
 func (*PageGetSubCommentRequest) ProtoMessage() {}
 
 func (x *PageGetSubCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[19]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1371,7 +1710,7 @@ func (x *PageGetSubCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PageGetSubCommentRequest.ProtoReflect.Descriptor instead.
 func (*PageGetSubCommentRequest) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{19}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *PageGetSubCommentRequest) GetOid() int64 {
@@ -1408,7 +1747,7 @@ type PageGetSubCommentResponse struct {
 func (x *PageGetSubCommentResponse) Reset() {
 	*x = PageGetSubCommentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[20]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1421,7 +1760,7 @@ func (x *PageGetSubCommentResponse) String() string {
 func (*PageGetSubCommentResponse) ProtoMessage() {}
 
 func (x *PageGetSubCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[20]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1434,7 +1773,7 @@ func (x *PageGetSubCommentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PageGetSubCommentResponse.ProtoReflect.Descriptor instead.
 func (*PageGetSubCommentResponse) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{20}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *PageGetSubCommentResponse) GetComments() []*CommentItem {
@@ -1472,7 +1811,7 @@ type PageGetSubCommentV2Request struct {
 func (x *PageGetSubCommentV2Request) Reset() {
 	*x = PageGetSubCommentV2Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[21]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1485,7 +1824,7 @@ func (x *PageGetSubCommentV2Request) String() string {
 func (*PageGetSubCommentV2Request) ProtoMessage() {}
 
 func (x *PageGetSubCommentV2Request) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[21]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1498,7 +1837,7 @@ func (x *PageGetSubCommentV2Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PageGetSubCommentV2Request.ProtoReflect.Descriptor instead.
 func (*PageGetSubCommentV2Request) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{21}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *PageGetSubCommentV2Request) GetOid() int64 {
@@ -1541,7 +1880,7 @@ type PageGetSubCommentV2Response struct {
 func (x *PageGetSubCommentV2Response) Reset() {
 	*x = PageGetSubCommentV2Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[22]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1554,7 +1893,7 @@ func (x *PageGetSubCommentV2Response) String() string {
 func (*PageGetSubCommentV2Response) ProtoMessage() {}
 
 func (x *PageGetSubCommentV2Response) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[22]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1567,7 +1906,7 @@ func (x *PageGetSubCommentV2Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PageGetSubCommentV2Response.ProtoReflect.Descriptor instead.
 func (*PageGetSubCommentV2Response) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{22}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *PageGetSubCommentV2Response) GetComments() []*CommentItem {
@@ -1598,7 +1937,7 @@ type DetailedSubComment struct {
 func (x *DetailedSubComment) Reset() {
 	*x = DetailedSubComment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[23]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1611,7 +1950,7 @@ func (x *DetailedSubComment) String() string {
 func (*DetailedSubComment) ProtoMessage() {}
 
 func (x *DetailedSubComment) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[23]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1624,7 +1963,7 @@ func (x *DetailedSubComment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DetailedSubComment.ProtoReflect.Descriptor instead.
 func (*DetailedSubComment) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{23}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *DetailedSubComment) GetItems() []*CommentItem {
@@ -1661,7 +2000,7 @@ type DetailedCommentItem struct {
 func (x *DetailedCommentItem) Reset() {
 	*x = DetailedCommentItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[24]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1674,7 +2013,7 @@ func (x *DetailedCommentItem) String() string {
 func (*DetailedCommentItem) ProtoMessage() {}
 
 func (x *DetailedCommentItem) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[24]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1687,7 +2026,7 @@ func (x *DetailedCommentItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DetailedCommentItem.ProtoReflect.Descriptor instead.
 func (*DetailedCommentItem) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{24}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *DetailedCommentItem) GetRoot() *CommentItem {
@@ -1709,15 +2048,16 @@ type PageGetDetailedCommentRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Oid    int64    `protobuf:"varint,1,opt,name=oid,proto3" json:"oid,omitempty"`
-	Cursor int64    `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"`                                            // 起始游标
-	SortBy SortType `protobuf:"varint,3,opt,name=sort_by,json=sortBy,proto3,enum=comment.api.v1.SortType" json:"sort_by,omitempty"` // 排序方式
+	Oid         int64    `protobuf:"varint,1,opt,name=oid,proto3" json:"oid,omitempty"`
+	Cursor      int64    `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"`                                            // 起始游标
+	SortBy      SortType `protobuf:"varint,3,opt,name=sort_by,json=sortBy,proto3,enum=comment.api.v1.SortType" json:"sort_by,omitempty"` // 排序方式
+	TrendCursor string   `protobuf:"bytes,4,opt,name=trend_cursor,json=trendCursor,proto3" json:"trend_cursor,omitempty"`                // 按热度排序时的起始游标
 }
 
 func (x *PageGetDetailedCommentRequest) Reset() {
 	*x = PageGetDetailedCommentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[25]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1730,7 +2070,7 @@ func (x *PageGetDetailedCommentRequest) String() string {
 func (*PageGetDetailedCommentRequest) ProtoMessage() {}
 
 func (x *PageGetDetailedCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[25]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1743,7 +2083,7 @@ func (x *PageGetDetailedCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PageGetDetailedCommentRequest.ProtoReflect.Descriptor instead.
 func (*PageGetDetailedCommentRequest) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{25}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *PageGetDetailedCommentRequest) GetOid() int64 {
@@ -1767,20 +2107,28 @@ func (x *PageGetDetailedCommentRequest) GetSortBy() SortType {
 	return SortType_ByTime
 }
 
+func (x *PageGetDetailedCommentRequest) GetTrendCursor() string {
+	if x != nil {
+		return x.TrendCursor
+	}
+	return ""
+}
+
 type PageGetDetailedCommentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Comments   []*DetailedCommentItem `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"` // 主评论
-	NextCursor int64                  `protobuf:"varint,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
-	HasNext    bool                   `protobuf:"varint,3,opt,name=has_next,json=hasNext,proto3" json:"has_next,omitempty"`
+	Comments        []*DetailedCommentItem `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"` // 主评论
+	NextCursor      int64                  `protobuf:"varint,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasNext         bool                   `protobuf:"varint,3,opt,name=has_next,json=hasNext,proto3" json:"has_next,omitempty"`
+	NextTrendCursor string                 `protobuf:"bytes,4,opt,name=next_trend_cursor,json=nextTrendCursor,proto3" json:"next_trend_cursor,omitempty"` // 按热度排序时下一次请求的游标
 }
 
 func (x *PageGetDetailedCommentResponse) Reset() {
 	*x = PageGetDetailedCommentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[26]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1793,7 +2141,7 @@ func (x *PageGetDetailedCommentResponse) String() string {
 func (*PageGetDetailedCommentResponse) ProtoMessage() {}
 
 func (x *PageGetDetailedCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[26]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1806,7 +2154,7 @@ func (x *PageGetDetailedCommentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PageGetDetailedCommentResponse.ProtoReflect.Descriptor instead.
 func (*PageGetDetailedCommentResponse) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{26}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *PageGetDetailedCommentResponse) GetComments() []*DetailedCommentItem {
@@ -1830,20 +2178,28 @@ func (x *PageGetDetailedCommentResponse) GetHasNext() bool {
 	return false
 }
 
+func (x *PageGetDetailedCommentResponse) GetNextTrendCursor() string {
+	if x != nil {
+		return x.NextTrendCursor
+	}
+	return ""
+}
+
 type PageGetDetailedCommentV2Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Oid    int64    `protobuf:"varint,1,opt,name=oid,proto3" json:"oid,omitempty"`
-	Cursor int64    `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"`                                            // 起始游标
-	SortBy SortType `protobuf:"varint,3,opt,name=sort_by,json=sortBy,proto3,enum=comment.api.v1.SortType" json:"sort_by,omitempty"` // 排序方式
+	Oid         int64    `protobuf:"varint,1,opt,name=oid,proto3" json:"oid,omitempty"`
+	Cursor      int64    `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"`                                            // 起始游标
+	SortBy      SortType `protobuf:"varint,3,opt,name=sort_by,json=sortBy,proto3,enum=comment.api.v1.SortType" json:"sort_by,omitempty"` // 排序方式
+	TrendCursor string   `protobuf:"bytes,4,opt,name=trend_cursor,json=trendCursor,proto3" json:"trend_cursor,omitempty"`                // 按热度排序时的起始游标
 }
 
 func (x *PageGetDetailedCommentV2Request) Reset() {
 	*x = PageGetDetailedCommentV2Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[27]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1856,7 +2212,7 @@ func (x *PageGetDetailedCommentV2Request) String() string {
 func (*PageGetDetailedCommentV2Request) ProtoMessage() {}
 
 func (x *PageGetDetailedCommentV2Request) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[27]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1869,7 +2225,7 @@ func (x *PageGetDetailedCommentV2Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PageGetDetailedCommentV2Request.ProtoReflect.Descriptor instead.
 func (*PageGetDetailedCommentV2Request) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{27}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *PageGetDetailedCommentV2Request) GetOid() int64 {
@@ -1893,6 +2249,13 @@ func (x *PageGetDetailedCommentV2Request) GetSortBy() SortType {
 	return SortType_ByTime
 }
 
+func (x *PageGetDetailedCommentV2Request) GetTrendCursor() string {
+	if x != nil {
+		return x.TrendCursor
+	}
+	return ""
+}
+
 // 代表主评论的子评论信息
 type DetailedSubCommentV2 struct {
 	state         protoimpl.MessageState
@@ -1906,7 +2269,7 @@ type DetailedSubCommentV2 struct {
 func (x *DetailedSubCommentV2) Reset() {
 	*x = DetailedSubCommentV2{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[28]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1919,7 +2282,7 @@ func (x *DetailedSubCommentV2) String() string {
 func (*DetailedSubCommentV2) ProtoMessage() {}
 
 func (x *DetailedSubCommentV2) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[28]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1932,7 +2295,7 @@ func (x *DetailedSubCommentV2) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DetailedSubCommentV2.ProtoReflect.Descriptor instead.
 func (*DetailedSubCommentV2) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{28}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *DetailedSubCommentV2) GetItems() []*CommentItem {
@@ -1962,7 +2325,7 @@ type DetailedCommentItemV2 struct {
 func (x *DetailedCommentItemV2) Reset() {
 	*x = DetailedCommentItemV2{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[29]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1975,7 +2338,7 @@ func (x *DetailedCommentItemV2) String() string {
 func (*DetailedCommentItemV2) ProtoMessage() {}
 
 func (x *DetailedCommentItemV2) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[29]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1988,7 +2351,7 @@ func (x *DetailedCommentItemV2) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DetailedCommentItemV2.ProtoReflect.Descriptor instead.
 func (*DetailedCommentItemV2) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{29}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *DetailedCommentItemV2) GetRoot() *CommentItem {
@@ -2010,15 +2373,16 @@ type PageGetDetailedCommentV2Response struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RootComments []*DetailedCommentItemV2 `protobuf:"bytes,1,rep,name=root_comments,json=rootComments,proto3" json:"root_comments,omitempty"` // 主评论
-	NextCursor   int64                    `protobuf:"varint,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
-	HasNext      bool                     `protobuf:"varint,3,opt,name=has_next,json=hasNext,proto3" json:"has_next,omitempty"`
+	RootComments    []*DetailedCommentItemV2 `protobuf:"bytes,1,rep,name=root_comments,json=rootComments,proto3" json:"root_comments,omitempty"` // 主评论
+	NextCursor      int64                    `protobuf:"varint,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasNext         bool                     `protobuf:"varint,3,opt,name=has_next,json=hasNext,proto3" json:"has_next,omitempty"`
+	NextTrendCursor string                   `protobuf:"bytes,4,opt,name=next_trend_cursor,json=nextTrendCursor,proto3" json:"next_trend_cursor,omitempty"` // 按热度排序时下一次请求的游标
 }
 
 func (x *PageGetDetailedCommentV2Response) Reset() {
 	*x = PageGetDetailedCommentV2Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[30]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2031,7 +2395,7 @@ func (x *PageGetDetailedCommentV2Response) String() string {
 func (*PageGetDetailedCommentV2Response) ProtoMessage() {}
 
 func (x *PageGetDetailedCommentV2Response) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[30]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2044,7 +2408,7 @@ func (x *PageGetDetailedCommentV2Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PageGetDetailedCommentV2Response.ProtoReflect.Descriptor instead.
 func (*PageGetDetailedCommentV2Response) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{30}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *PageGetDetailedCommentV2Response) GetRootComments() []*DetailedCommentItemV2 {
@@ -2068,6 +2432,13 @@ func (x *PageGetDetailedCommentV2Response) GetHasNext() bool {
 	return false
 }
 
+func (x *PageGetDetailedCommentV2Response) GetNextTrendCursor() string {
+	if x != nil {
+		return x.NextTrendCursor
+	}
+	return ""
+}
+
 // 获取置顶评论
 type GetPinnedCommentRequest struct {
 	state         protoimpl.MessageState
@@ -2080,7 +2451,7 @@ type GetPinnedCommentRequest struct {
 func (x *GetPinnedCommentRequest) Reset() {
 	*x = GetPinnedCommentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[31]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2093,7 +2464,7 @@ func (x *GetPinnedCommentRequest) String() string {
 func (*GetPinnedCommentRequest) ProtoMessage() {}
 
 func (x *GetPinnedCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[31]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2106,7 +2477,7 @@ func (x *GetPinnedCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPinnedCommentRequest.ProtoReflect.Descriptor instead.
 func (*GetPinnedCommentRequest) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{31}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *GetPinnedCommentRequest) GetOid() int64 {
@@ -2127,7 +2498,7 @@ type GetPinnedCommentResponse struct {
 func (x *GetPinnedCommentResponse) Reset() {
 	*x = GetPinnedCommentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[32]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2140,7 +2511,7 @@ func (x *GetPinnedCommentResponse) String() string {
 func (*GetPinnedCommentResponse) ProtoMessage() {}
 
 func (x *GetPinnedCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[32]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2153,7 +2524,7 @@ func (x *GetPinnedCommentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPinnedCommentResponse.ProtoReflect.Descriptor instead.
 func (*GetPinnedCommentResponse) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{32}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *GetPinnedCommentResponse) GetItem() *DetailedCommentItem {
@@ -2175,7 +2546,7 @@ type CountCommentRequest struct {
 func (x *CountCommentRequest) Reset() {
 	*x = CountCommentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[33]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2188,7 +2559,7 @@ func (x *CountCommentRequest) String() string {
 func (*CountCommentRequest) ProtoMessage() {}
 
 func (x *CountCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[33]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2201,7 +2572,7 @@ func (x *CountCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CountCommentRequest.ProtoReflect.Descriptor instead.
 func (*CountCommentRequest) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{33}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *CountCommentRequest) GetOid() int64 {
@@ -2222,7 +2593,7 @@ type CountCommentResponse struct {
 func (x *CountCommentResponse) Reset() {
 	*x = CountCommentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[34]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2235,7 +2606,7 @@ func (x *CountCommentResponse) String() string {
 func (*CountCommentResponse) ProtoMessage() {}
 
 func (x *CountCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[34]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2248,7 +2619,7 @@ func (x *CountCommentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CountCommentResponse.ProtoReflect.Descriptor instead.
 func (*CountCommentResponse) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{34}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *CountCommentResponse) GetCount() int64 {
@@ -2269,7 +2640,7 @@ type BatchCountCommentRequest struct {
 func (x *BatchCountCommentRequest) Reset() {
 	*x = BatchCountCommentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[35]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2282,7 +2653,7 @@ func (x *BatchCountCommentRequest) String() string {
 func (*BatchCountCommentRequest) ProtoMessage() {}
 
 func (x *BatchCountCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[35]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2295,7 +2666,7 @@ func (x *BatchCountCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchCountCommentRequest.ProtoReflect.Descriptor instead.
 func (*BatchCountCommentRequest) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{35}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *BatchCountCommentRequest) GetOids() []int64 {
@@ -2316,7 +2687,7 @@ type BatchCountCommentResponse struct {
 func (x *BatchCountCommentResponse) Reset() {
 	*x = BatchCountCommentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[36]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2329,7 +2700,7 @@ func (x *BatchCountCommentResponse) String() string {
 func (*BatchCountCommentResponse) ProtoMessage() {}
 
 func (x *BatchCountCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[36]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2342,7 +2713,7 @@ func (x *BatchCountCommentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchCountCommentResponse.ProtoReflect.Descriptor instead.
 func (*BatchCountCommentResponse) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{36}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *BatchCountCommentResponse) GetNumbers() map[int64]int64 {
@@ -2363,7 +2734,7 @@ type GetCommentLikeCountRequest struct {
 func (x *GetCommentLikeCountRequest) Reset() {
 	*x = GetCommentLikeCountRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[37]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2376,7 +2747,7 @@ func (x *GetCommentLikeCountRequest) String() string {
 func (*GetCommentLikeCountRequest) ProtoMessage() {}
 
 func (x *GetCommentLikeCountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[37]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2389,7 +2760,7 @@ func (x *GetCommentLikeCountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCommentLikeCountRequest.ProtoReflect.Descriptor instead.
 func (*GetCommentLikeCountRequest) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{37}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *GetCommentLikeCountRequest) GetCommentId() int64 {
@@ -2411,7 +2782,7 @@ type GetCommentLikeCountResponse struct {
 func (x *GetCommentLikeCountResponse) Reset() {
 	*x = GetCommentLikeCountResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[38]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2424,7 +2795,7 @@ func (x *GetCommentLikeCountResponse) String() string {
 func (*GetCommentLikeCountResponse) ProtoMessage() {}
 
 func (x *GetCommentLikeCountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[38]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2437,7 +2808,7 @@ func (x *GetCommentLikeCountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCommentLikeCountResponse.ProtoReflect.Descriptor instead.
 func (*GetCommentLikeCountResponse) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{38}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *GetCommentLikeCountResponse) GetCommentId() int64 {
@@ -2465,7 +2836,7 @@ type GetCommentDislikeCountRequest struct {
 func (x *GetCommentDislikeCountRequest) Reset() {
 	*x = GetCommentDislikeCountRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[39]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2478,7 +2849,7 @@ func (x *GetCommentDislikeCountRequest) String() string {
 func (*GetCommentDislikeCountRequest) ProtoMessage() {}
 
 func (x *GetCommentDislikeCountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[39]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2491,7 +2862,7 @@ func (x *GetCommentDislikeCountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCommentDislikeCountRequest.ProtoReflect.Descriptor instead.
 func (*GetCommentDislikeCountRequest) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{39}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GetCommentDislikeCountRequest) GetCommentId() int64 {
@@ -2513,7 +2884,7 @@ type GetCommentDislikeCountResponse struct {
 func (x *GetCommentDislikeCountResponse) Reset() {
 	*x = GetCommentDislikeCountResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[40]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2526,7 +2897,7 @@ func (x *GetCommentDislikeCountResponse) String() string {
 func (*GetCommentDislikeCountResponse) ProtoMessage() {}
 
 func (x *GetCommentDislikeCountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[40]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2539,7 +2910,7 @@ func (x *GetCommentDislikeCountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCommentDislikeCountResponse.ProtoReflect.Descriptor instead.
 func (*GetCommentDislikeCountResponse) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{40}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *GetCommentDislikeCountResponse) GetCommentId() int64 {
@@ -2568,7 +2939,7 @@ type CheckUserOnObjectRequest struct {
 func (x *CheckUserOnObjectRequest) Reset() {
 	*x = CheckUserOnObjectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[41]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2581,7 +2952,7 @@ func (x *CheckUserOnObjectRequest) String() string {
 func (*CheckUserOnObjectRequest) ProtoMessage() {}
 
 func (x *CheckUserOnObjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[41]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2594,7 +2965,7 @@ func (x *CheckUserOnObjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckUserOnObjectRequest.ProtoReflect.Descriptor instead.
 func (*CheckUserOnObjectRequest) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{41}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *CheckUserOnObjectRequest) GetOid() int64 {
@@ -2622,7 +2993,7 @@ type CheckUserOnObjectResponse struct {
 func (x *CheckUserOnObjectResponse) Reset() {
 	*x = CheckUserOnObjectResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[42]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2635,7 +3006,7 @@ func (x *CheckUserOnObjectResponse) String() string {
 func (*CheckUserOnObjectResponse) ProtoMessage() {}
 
 func (x *CheckUserOnObjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[42]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2648,7 +3019,7 @@ func (x *CheckUserOnObjectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckUserOnObjectResponse.ProtoReflect.Descriptor instead.
 func (*CheckUserOnObjectResponse) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{42}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *CheckUserOnObjectResponse) GetResult() *OidCommented {
@@ -2669,7 +3040,7 @@ type BatchCheckUserOnObjectRequest struct {
 func (x *BatchCheckUserOnObjectRequest) Reset() {
 	*x = BatchCheckUserOnObjectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[43]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2682,7 +3053,7 @@ func (x *BatchCheckUserOnObjectRequest) String() string {
 func (*BatchCheckUserOnObjectRequest) ProtoMessage() {}
 
 func (x *BatchCheckUserOnObjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[43]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2695,7 +3066,7 @@ func (x *BatchCheckUserOnObjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchCheckUserOnObjectRequest.ProtoReflect.Descriptor instead.
 func (*BatchCheckUserOnObjectRequest) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{43}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *BatchCheckUserOnObjectRequest) GetMappings() map[int64]*BatchCheckUserOnObjectRequest_Objects {
@@ -2717,7 +3088,7 @@ type OidCommented struct {
 func (x *OidCommented) Reset() {
 	*x = OidCommented{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[44]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2730,7 +3101,7 @@ func (x *OidCommented) String() string {
 func (*OidCommented) ProtoMessage() {}
 
 func (x *OidCommented) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[44]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2743,7 +3114,7 @@ func (x *OidCommented) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OidCommented.ProtoReflect.Descriptor instead.
 func (*OidCommented) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{44}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *OidCommented) GetOid() int64 {
@@ -2771,7 +3142,7 @@ type OidCommentedList struct {
 func (x *OidCommentedList) Reset() {
 	*x = OidCommentedList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[45]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2784,7 +3155,7 @@ func (x *OidCommentedList) String() string {
 func (*OidCommentedList) ProtoMessage() {}
 
 func (x *OidCommentedList) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[45]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2797,7 +3168,7 @@ func (x *OidCommentedList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OidCommentedList.ProtoReflect.Descriptor instead.
 func (*OidCommentedList) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{45}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *OidCommentedList) GetList() []*OidCommented {
@@ -2818,7 +3189,7 @@ type BatchCheckUserOnObjectResponse struct {
 func (x *BatchCheckUserOnObjectResponse) Reset() {
 	*x = BatchCheckUserOnObjectResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[46]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2831,7 +3202,7 @@ func (x *BatchCheckUserOnObjectResponse) String() string {
 func (*BatchCheckUserOnObjectResponse) ProtoMessage() {}
 
 func (x *BatchCheckUserOnObjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[46]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2844,7 +3215,7 @@ func (x *BatchCheckUserOnObjectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchCheckUserOnObjectResponse.ProtoReflect.Descriptor instead.
 func (*BatchCheckUserOnObjectResponse) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{46}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *BatchCheckUserOnObjectResponse) GetResults() map[int64]*OidCommentedList {
@@ -2865,7 +3236,7 @@ type BatchCheckUserLikeCommentRequest struct {
 func (x *BatchCheckUserLikeCommentRequest) Reset() {
 	*x = BatchCheckUserLikeCommentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[47]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2878,7 +3249,7 @@ func (x *BatchCheckUserLikeCommentRequest) String() string {
 func (*BatchCheckUserLikeCommentRequest) ProtoMessage() {}
 
 func (x *BatchCheckUserLikeCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[47]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2891,7 +3262,7 @@ func (x *BatchCheckUserLikeCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchCheckUserLikeCommentRequest.ProtoReflect.Descriptor instead.
 func (*BatchCheckUserLikeCommentRequest) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{47}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *BatchCheckUserLikeCommentRequest) GetMappings() map[int64]*BatchCheckUserLikeCommentRequest_CommentIdList {
@@ -2913,7 +3284,7 @@ type CommentLiked struct {
 func (x *CommentLiked) Reset() {
 	*x = CommentLiked{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[48]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2926,7 +3297,7 @@ func (x *CommentLiked) String() string {
 func (*CommentLiked) ProtoMessage() {}
 
 func (x *CommentLiked) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[48]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2939,7 +3310,7 @@ func (x *CommentLiked) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommentLiked.ProtoReflect.Descriptor instead.
 func (*CommentLiked) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{48}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *CommentLiked) GetCommentId() int64 {
@@ -2967,7 +3338,7 @@ type BatchCheckUserLikeCommentResponse struct {
 func (x *BatchCheckUserLikeCommentResponse) Reset() {
 	*x = BatchCheckUserLikeCommentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[49]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2980,7 +3351,7 @@ func (x *BatchCheckUserLikeCommentResponse) String() string {
 func (*BatchCheckUserLikeCommentResponse) ProtoMessage() {}
 
 func (x *BatchCheckUserLikeCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[49]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2993,7 +3364,7 @@ func (x *BatchCheckUserLikeCommentResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use BatchCheckUserLikeCommentResponse.ProtoReflect.Descriptor instead.
 func (*BatchCheckUserLikeCommentResponse) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{49}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *BatchCheckUserLikeCommentResponse) GetResults() map[int64]*BatchCheckUserLikeCommentResponse_CommentLikedList {
@@ -3014,7 +3385,7 @@ type BatchCheckCommentExistRequest struct {
 func (x *BatchCheckCommentExistRequest) Reset() {
 	*x = BatchCheckCommentExistRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[50]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3027,7 +3398,7 @@ func (x *BatchCheckCommentExistRequest) String() string {
 func (*BatchCheckCommentExistRequest) ProtoMessage() {}
 
 func (x *BatchCheckCommentExistRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[50]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3040,7 +3411,7 @@ func (x *BatchCheckCommentExistRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchCheckCommentExistRequest.ProtoReflect.Descriptor instead.
 func (*BatchCheckCommentExistRequest) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{50}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *BatchCheckCommentExistRequest) GetIds() []int64 {
@@ -3061,7 +3432,7 @@ type BatchCheckCommentExistResponse struct {
 func (x *BatchCheckCommentExistResponse) Reset() {
 	*x = BatchCheckCommentExistResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[51]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3074,7 +3445,7 @@ func (x *BatchCheckCommentExistResponse) String() string {
 func (*BatchCheckCommentExistResponse) ProtoMessage() {}
 
 func (x *BatchCheckCommentExistResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[51]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3087,7 +3458,7 @@ func (x *BatchCheckCommentExistResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchCheckCommentExistResponse.ProtoReflect.Descriptor instead.
 func (*BatchCheckCommentExistResponse) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{51}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *BatchCheckCommentExistResponse) GetExistence() map[int64]bool {
@@ -3108,7 +3479,7 @@ type GetCommentRequest struct {
 func (x *GetCommentRequest) Reset() {
 	*x = GetCommentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[52]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3121,7 +3492,7 @@ func (x *GetCommentRequest) String() string {
 func (*GetCommentRequest) ProtoMessage() {}
 
 func (x *GetCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[52]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3134,7 +3505,7 @@ func (x *GetCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCommentRequest.ProtoReflect.Descriptor instead.
 func (*GetCommentRequest) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{52}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *GetCommentRequest) GetCommentId() int64 {
@@ -3156,7 +3527,7 @@ type GetCommentResponse struct {
 func (x *GetCommentResponse) Reset() {
 	*x = GetCommentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[53]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3169,7 +3540,7 @@ func (x *GetCommentResponse) String() string {
 func (*GetCommentResponse) ProtoMessage() {}
 
 func (x *GetCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[53]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3182,7 +3553,7 @@ func (x *GetCommentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCommentResponse.ProtoReflect.Descriptor instead.
 func (*GetCommentResponse) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{53}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *GetCommentResponse) GetItem() *CommentItem {
@@ -3203,7 +3574,7 @@ type GetCommentUserRequest struct {
 func (x *GetCommentUserRequest) Reset() {
 	*x = GetCommentUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[54]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3216,7 +3587,7 @@ func (x *GetCommentUserRequest) String() string {
 func (*GetCommentUserRequest) ProtoMessage() {}
 
 func (x *GetCommentUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[54]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3229,7 +3600,7 @@ func (x *GetCommentUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCommentUserRequest.ProtoReflect.Descriptor instead.
 func (*GetCommentUserRequest) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{54}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *GetCommentUserRequest) GetCommentId() int64 {
@@ -3250,7 +3621,7 @@ type GetCommentUserResponse struct {
 func (x *GetCommentUserResponse) Reset() {
 	*x = GetCommentUserResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[55]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3263,7 +3634,7 @@ func (x *GetCommentUserResponse) String() string {
 func (*GetCommentUserResponse) ProtoMessage() {}
 
 func (x *GetCommentUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[55]
+	mi := &file_comment_api_v1_comment_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3276,7 +3647,7 @@ func (x *GetCommentUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCommentUserResponse.ProtoReflect.Descriptor instead.
 func (*GetCommentUserResponse) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{55}
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *GetCommentUserResponse) GetUid() int64 {
@@ -3286,16 +3657,20 @@ func (x *GetCommentUserResponse) GetUid() int64 {
 	return 0
 }
 
-type BatchCheckUserOnObjectRequest_Objects struct {
+// 编辑评论 只有评论作者可以在发布后的一段时间内编辑
+type EditCommentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Oids []int64 `protobuf:"varint,1,rep,packed,name=oids,proto3" json:"oids,omitempty"`
+	CommentId int64              `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	Content   string             `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`                // 新的评论内容
+	Images    []*CommentReqImage `protobuf:"bytes,3,rep,name=images,proto3" json:"images,omitempty"`                  // 新的图片资源 为空时评论变为纯文本
+	AtUsers   []*CommentAtUser   `protobuf:"bytes,4,rep,name=at_users,json=atUsers,proto3" json:"at_users,omitempty"` // 新的@用户列表
 }
 
-func (x *BatchCheckUserOnObjectRequest_Objects) Reset() {
-	*x = BatchCheckUserOnObjectRequest_Objects{}
+func (x *EditCommentRequest) Reset() {
+	*x = EditCommentRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_comment_api_v1_comment_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3303,13 +3678,13 @@ func (x *BatchCheckUserOnObjectRequest_Objects) Reset() {
 	}
 }
 
-func (x *BatchCheckUserOnObjectRequest_Objects) String() string {
+func (x *EditCommentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchCheckUserOnObjectRequest_Objects) ProtoMessage() {}
+func (*EditCommentRequest) ProtoMessage() {}
 
-func (x *BatchCheckUserOnObjectRequest_Objects) ProtoReflect() protoreflect.Message {
+func (x *EditCommentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_comment_api_v1_comment_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3321,44 +3696,67 @@ func (x *BatchCheckUserOnObjectRequest_Objects) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchCheckUserOnObjectRequest_Objects.ProtoReflect.Descriptor instead.
-func (*BatchCheckUserOnObjectRequest_Objects) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{43, 0}
+// Deprecated: Use EditCommentRequest.ProtoReflect.Descriptor instead.
+func (*EditCommentRequest) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *BatchCheckUserOnObjectRequest_Objects) GetOids() []int64 {
+func (x *EditCommentRequest) GetCommentId() int64 {
 	if x != nil {
-		return x.Oids
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *EditCommentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *EditCommentRequest) GetImages() []*CommentReqImage {
+	if x != nil {
+		return x.Images
 	}
 	return nil
 }
 
-// mappings => {uid: [rid1, rid2, ..., ridN]}
-type BatchCheckUserLikeCommentRequest_CommentIdList struct {
+func (x *EditCommentRequest) GetAtUsers() []*CommentAtUser {
+	if x != nil {
+		return x.AtUsers
+	}
+	return nil
+}
+
+type EditCommentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ids []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	Etime          int64            `protobuf:"varint,1,opt,name=etime,proto3" json:"etime,omitempty"`                                          // 编辑时间
+	AddedAtUsers   []*CommentAtUser `protobuf:"bytes,2,rep,name=added_at_users,json=addedAtUsers,proto3" json:"added_at_users,omitempty"`       // 本次编辑新增的@用户
+	RemovedAtUsers []*CommentAtUser `protobuf:"bytes,3,rep,name=removed_at_users,json=removedAtUsers,proto3" json:"removed_at_users,omitempty"` // 本次编辑移除的@用户
+	Held           bool             `protobuf:"varint,4,opt,name=held,proto3" json:"held,omitempty"`                                            // 编辑后命中作者设置的屏蔽词 等待作者审核
 }
 
-func (x *BatchCheckUserLikeCommentRequest_CommentIdList) Reset() {
-	*x = BatchCheckUserLikeCommentRequest_CommentIdList{}
+func (x *EditCommentResponse) Reset() {
+	*x = EditCommentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[60]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BatchCheckUserLikeCommentRequest_CommentIdList) String() string {
+func (x *EditCommentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchCheckUserLikeCommentRequest_CommentIdList) ProtoMessage() {}
+func (*EditCommentResponse) ProtoMessage() {}
 
-func (x *BatchCheckUserLikeCommentRequest_CommentIdList) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[60]
+func (x *EditCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3369,45 +3767,69 @@ func (x *BatchCheckUserLikeCommentRequest_CommentIdList) ProtoReflect() protoref
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchCheckUserLikeCommentRequest_CommentIdList.ProtoReflect.Descriptor instead.
-func (*BatchCheckUserLikeCommentRequest_CommentIdList) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{47, 0}
+// Deprecated: Use EditCommentResponse.ProtoReflect.Descriptor instead.
+func (*EditCommentResponse) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *BatchCheckUserLikeCommentRequest_CommentIdList) GetIds() []int64 {
+func (x *EditCommentResponse) GetEtime() int64 {
 	if x != nil {
-		return x.Ids
+		return x.Etime
+	}
+	return 0
+}
+
+func (x *EditCommentResponse) GetAddedAtUsers() []*CommentAtUser {
+	if x != nil {
+		return x.AddedAtUsers
 	}
 	return nil
 }
 
-// results => {uid: [{comment_id, liked}, {comment_id, liked}, ...,
-// {comment_id, liked}]}
-type BatchCheckUserLikeCommentResponse_CommentLikedList struct {
+func (x *EditCommentResponse) GetRemovedAtUsers() []*CommentAtUser {
+	if x != nil {
+		return x.RemovedAtUsers
+	}
+	return nil
+}
+
+func (x *EditCommentResponse) GetHeld() bool {
+	if x != nil {
+		return x.Held
+	}
+	return false
+}
+
+// 评论的一个历史版本
+type CommentEditVersion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	List []*CommentLiked `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Type    CommentType         `protobuf:"varint,1,opt,name=type,proto3,enum=comment.api.v1.CommentType" json:"type,omitempty"`
+	Content string              `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Images  []*CommentItemImage `protobuf:"bytes,3,rep,name=images,proto3" json:"images,omitempty"`
+	AtUsers []*CommentAtUser    `protobuf:"bytes,4,rep,name=at_users,json=atUsers,proto3" json:"at_users,omitempty"`
+	Ctime   int64               `protobuf:"varint,5,opt,name=ctime,proto3" json:"ctime,omitempty"` // 该版本被替换的时间
 }
 
-func (x *BatchCheckUserLikeCommentResponse_CommentLikedList) Reset() {
-	*x = BatchCheckUserLikeCommentResponse_CommentLikedList{}
+func (x *CommentEditVersion) Reset() {
+	*x = CommentEditVersion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comment_api_v1_comment_proto_msgTypes[62]
+		mi := &file_comment_api_v1_comment_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BatchCheckUserLikeCommentResponse_CommentLikedList) String() string {
+func (x *CommentEditVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchCheckUserLikeCommentResponse_CommentLikedList) ProtoMessage() {}
+func (*CommentEditVersion) ProtoMessage() {}
 
-func (x *BatchCheckUserLikeCommentResponse_CommentLikedList) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_api_v1_comment_proto_msgTypes[62]
+func (x *CommentEditVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3418,790 +3840,3921 @@ func (x *BatchCheckUserLikeCommentResponse_CommentLikedList) ProtoReflect() prot
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchCheckUserLikeCommentResponse_CommentLikedList.ProtoReflect.Descriptor instead.
-func (*BatchCheckUserLikeCommentResponse_CommentLikedList) Descriptor() ([]byte, []int) {
-	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{49, 0}
+// Deprecated: Use CommentEditVersion.ProtoReflect.Descriptor instead.
+func (*CommentEditVersion) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *BatchCheckUserLikeCommentResponse_CommentLikedList) GetList() []*CommentLiked {
+func (x *CommentEditVersion) GetType() CommentType {
 	if x != nil {
-		return x.List
+		return x.Type
+	}
+	return CommentType_TEXT
+}
+
+func (x *CommentEditVersion) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CommentEditVersion) GetImages() []*CommentItemImage {
+	if x != nil {
+		return x.Images
 	}
 	return nil
 }
 
-var File_comment_api_v1_comment_proto protoreflect.FileDescriptor
+func (x *CommentEditVersion) GetAtUsers() []*CommentAtUser {
+	if x != nil {
+		return x.AtUsers
+	}
+	return nil
+}
 
-var file_comment_api_v1_comment_proto_rawDesc = []byte{
-	0x0a, 0x1c, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x1a, 0x1b,
-	0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x65, 0x78, 0x74,
-	0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3d, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x41, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x03, 0x75, 0x69, 0x64, 0x22, 0xb6, 0x02, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x74, 0x49, 0x64,
-	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a,
-	0x09, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x55, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x06, 0x69, 0x6d,
-	0x61, 0x67, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61,
-	0x67, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x61, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18,
-	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x07, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x22, 0x74, 0x0a,
-	0x0f, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x77, 0x69,
-	0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66,
-	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72,
-	0x6d, 0x61, 0x74, 0x22, 0x33, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x56, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
-	0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x03, 0x6f, 0x69, 0x64,
-	0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x72, 0x0a, 0x11, 0x4c, 0x69, 0x6b, 0x65, 0x41, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0a, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
-	0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69,
-	0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x75, 0x0a, 0x14, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48,
-	0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
-	0x12, 0x35, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x69, 0x73, 0x6c, 0x69,
-	0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x16, 0x0a, 0x14, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x8d, 0x01, 0x0a, 0x11, 0x50, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x03, 0x6f,
-	0x69, 0x64, 0x12, 0x26, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52,
-	0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x06, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x14, 0x0a, 0x12, 0x50, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7d, 0x0a, 0x15, 0x50, 0x61, 0x67, 0x65, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x19, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba,
-	0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63,
-	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72,
-	0x73, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06,
-	0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x22, 0xfa, 0x03, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12, 0x2f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x1b,
-	0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72,
-	0x75, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x72, 0x75, 0x69, 0x64, 0x12,
-	0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6b, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x68, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x68, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x74,
-	0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18,
-	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f,
-	0x70, 0x69, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x50, 0x69, 0x6e,
-	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x38, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x61, 0x74, 0x5f,
-	0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x07, 0x61, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x73, 0x22, 0x70, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74,
-	0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x77,
-	0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74,
-	0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72,
-	0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x5e, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x49, 0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x04, 0x6d,
-	0x65, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x52,
-	0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0x8d, 0x01, 0x0a, 0x16, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x37, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52,
-	0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78,
-	0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
-	0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61,
-	0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61,
-	0x73, 0x4e, 0x65, 0x78, 0x74, 0x22, 0x6f, 0x0a, 0x18, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74,
-	0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x19, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07,
-	0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x07,
-	0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba,
-	0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x16,
-	0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x90, 0x01, 0x0a, 0x19, 0x50, 0x61, 0x67, 0x65, 0x47,
-	0x65, 0x74, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49,
-	0x74, 0x65, 0x6d, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a,
-	0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x19,
-	0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x22, 0x83, 0x01, 0x0a, 0x1a, 0x50, 0x61,
-	0x67, 0x65, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56,
-	0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x03,
-	0x6f, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x72,
-	0x6f, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
-	0x6c, 0x0a, 0x1b, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37,
-	0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x08, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x83, 0x01,
-	0x0a, 0x12, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d,
-	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f,
-	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65,
-	0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f,
-	0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4e,
-	0x65, 0x78, 0x74, 0x22, 0x8d, 0x01, 0x0a, 0x13, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x2f, 0x0a, 0x04, 0x72,
-	0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x45, 0x0a, 0x0c,
-	0x73, 0x75, 0x62, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x75, 0x62, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x1d, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x03, 0x6f, 0x69, 0x64,
-	0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74,
-	0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x22, 0x9d, 0x01, 0x0a, 0x1e,
-	0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f,
-	0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
-	0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
-	0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x22, 0x87, 0x01, 0x0a, 0x1f,
-	0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x19, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48,
-	0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75,
-	0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73,
-	0x6f, 0x72, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73,
-	0x6f, 0x72, 0x74, 0x42, 0x79, 0x22, 0x5f, 0x0a, 0x14, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65,
-	0x64, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x12, 0x31, 0x0a,
-	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x91, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x56, 0x32,
-	0x12, 0x2f, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f,
-	0x74, 0x12, 0x47, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65,
-	0x64, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x52, 0x0b, 0x73,
-	0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xaa, 0x01, 0x0a, 0x20, 0x50,
-	0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x4a, 0x0a, 0x0d, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x56, 0x32, 0x52, 0x0c, 0x72,
-	0x6f, 0x6f, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e,
-	0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08,
-	0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x22, 0x34, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x69,
-	0x6e, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
-	0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x22, 0x53, 0x0a,
-	0x18, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x69, 0x74, 0x65,
-	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65,
-	0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74,
-	0x65, 0x6d, 0x22, 0x30, 0x0a, 0x13, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x6f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52,
-	0x03, 0x6f, 0x69, 0x64, 0x22, 0x2c, 0x0a, 0x14, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0x2e, 0x0a, 0x18, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x6f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x04, 0x6f, 0x69,
-	0x64, 0x73, 0x22, 0xa9, 0x01, 0x0a, 0x19, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x50, 0x0a, 0x07, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x36, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x44,
-	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0a,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x49, 0x64, 0x22, 0x52, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x47, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba,
-	0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49,
-	0x64, 0x22, 0x55, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44,
-	0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x47, 0x0a, 0x18, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12,
-	0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69,
-	0x64, 0x22, 0x51, 0x0a, 0x19, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e,
-	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34,
-	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x4f, 0x69, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x52, 0x06, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x22, 0xa3, 0x02, 0x0a, 0x1d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x63, 0x0a, 0x08, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e,
-	0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x9a, 0x01, 0x04, 0x08, 0x01, 0x10,
-	0x32, 0x52, 0x08, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x29, 0x0a, 0x07, 0x4f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x04, 0x6f, 0x69, 0x64, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x92, 0x01, 0x04, 0x08, 0x01, 0x10, 0x32,
-	0x52, 0x04, 0x6f, 0x69, 0x64, 0x73, 0x1a, 0x72, 0x0a, 0x0d, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
-	0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x4b, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3e, 0x0a, 0x0c, 0x4f, 0x69,
-	0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x22, 0x44, 0x0a, 0x10, 0x4f, 0x69,
-	0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x30,
-	0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x69,
-	0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74,
-	0x22, 0xd5, 0x01, 0x0a, 0x1e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55,
-	0x73, 0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x5c, 0x0a, 0x0c, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x69, 0x64,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9e, 0x02, 0x0a, 0x20, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5a, 0x0a,
-	0x08, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x3e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c,
-	0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x2e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x08, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x21, 0x0a, 0x0d, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x69, 0x64, 0x73, 0x1a, 0x7b, 0x0a, 0x0d,
-	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x54, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69,
-	0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x43, 0x0a, 0x0c, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x22, 0xc3,
-	0x02, 0x0a, 0x21, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65,
-	0x72, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x44,
-	0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x52, 0x04,
-	0x6c, 0x69, 0x73, 0x74, 0x1a, 0x7e, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x58, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x4c, 0x69, 0x6b, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0x31, 0x0a, 0x1d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x03, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0xbb, 0x01, 0x0a, 0x1e, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x09, 0x65, 0x78,
-	0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x78,
-	0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x65, 0x78,
-	0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0x3c, 0x0a, 0x0e, 0x45, 0x78, 0x69, 0x73, 0x74,
-	0x65, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x32, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x45, 0x0a, 0x12, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x2f, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d,
-	0x22, 0x36, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x2a, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x03, 0x75, 0x69, 0x64, 0x2a, 0x3f, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54,
-	0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x44, 0x4f, 0x10, 0x00, 0x12, 0x15,
-	0x0a, 0x11, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
-	0x5f, 0x44, 0x4f, 0x10, 0x01, 0x2a, 0x39, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x45, 0x58, 0x54, 0x10, 0x00, 0x12, 0x0e,
-	0x0a, 0x0a, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x10, 0x01, 0x12, 0x10,
-	0x0a, 0x0c, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x45, 0x4d, 0x4f, 0x4a, 0x49, 0x10, 0x02,
-	0x2a, 0x23, 0x0a, 0x08, 0x53, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06,
-	0x42, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x79, 0x54, 0x72,
-	0x65, 0x6e, 0x64, 0x10, 0x01, 0x32, 0xcd, 0x12, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a,
-	0x0a, 0x44, 0x65, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x65, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x53, 0x0a, 0x0a, 0x4c, 0x69, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x6c, 0x69,
-	0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b,
-	0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0a, 0x50, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x0e, 0x50, 0x61, 0x67, 0x65,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x08,
-	0x01, 0x12, 0x70, 0x0a, 0x11, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x53,
-	0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x29, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0x82, 0xb5, 0x18,
-	0x02, 0x08, 0x01, 0x12, 0x6e, 0x0a, 0x13, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x53, 0x75,
-	0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x12, 0x2a, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
-	0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x53,
-	0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x16, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
-	0x67, 0x65, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0x82, 0xb5,
-	0x18, 0x02, 0x08, 0x01, 0x12, 0x7d, 0x0a, 0x18, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32,
-	0x12, 0x2f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65,
-	0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x30, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e,
-	0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0x82, 0xb5, 0x18, 0x02,
-	0x08, 0x01, 0x12, 0x61, 0x0a, 0x0c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0x82,
-	0xb5, 0x18, 0x02, 0x08, 0x01, 0x12, 0x70, 0x0a, 0x11, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12, 0x76, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12,
-	0x7f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x73,
-	0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01,
-	0x12, 0x70, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x4f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72,
-	0x4f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x29, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0x82, 0xb5, 0x18, 0x02,
-	0x08, 0x01, 0x12, 0x7f, 0x0a, 0x16, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x2d, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0x82, 0xb5, 0x18,
-	0x02, 0x08, 0x01, 0x12, 0x80, 0x01, 0x0a, 0x19, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x30, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65,
-	0x72, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55,
-	0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x16, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74,
-	0x12, 0x2d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x53, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xb7, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x39, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x79, 0x61, 0x6e, 0x72, 0x65, 0x61,
-	0x64, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x77, 0x68, 0x69, 0x6d, 0x65, 0x72, 0x2f, 0x69, 0x64,
-	0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x41, 0x58, 0xaa, 0x02, 0x0e,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x70, 0x69, 0x2e, 0x56, 0x31, 0xca, 0x02,
-	0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0xe2,
-	0x02, 0x1a, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31,
-	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a, 0x3a, 0x56, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *CommentEditVersion) GetCtime() int64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
 }
 
-var (
-	file_comment_api_v1_comment_proto_rawDescOnce sync.Once
-	file_comment_api_v1_comment_proto_rawDescData = file_comment_api_v1_comment_proto_rawDesc
-)
+type GetCommentEditHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func file_comment_api_v1_comment_proto_rawDescGZIP() []byte {
-	file_comment_api_v1_comment_proto_rawDescOnce.Do(func() {
-		file_comment_api_v1_comment_proto_rawDescData = protoimpl.X.CompressGZIP(file_comment_api_v1_comment_proto_rawDescData)
-	})
-	return file_comment_api_v1_comment_proto_rawDescData
+	CommentId int64 `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
 }
 
-var file_comment_api_v1_comment_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_comment_api_v1_comment_proto_msgTypes = make([]protoimpl.MessageInfo, 65)
-var file_comment_api_v1_comment_proto_goTypes = []any{
-	(CommentAction)(0),                            // 0: comment.api.v1.CommentAction
-	(CommentType)(0),                              // 1: comment.api.v1.CommentType
-	(SortType)(0),                                 // 2: comment.api.v1.SortType
-	(*CommentAtUser)(nil),                         // 3: comment.api.v1.CommentAtUser
-	(*AddCommentRequest)(nil),                     // 4: comment.api.v1.AddCommentRequest
-	(*CommentReqImage)(nil),                       // 5: comment.api.v1.CommentReqImage
-	(*AddCommentResponse)(nil),                    // 6: comment.api.v1.AddCommentResponse
-	(*DelCommentRequest)(nil),                     // 7: comment.api.v1.DelCommentRequest
-	(*DelCommentResponse)(nil),                    // 8: comment.api.v1.DelCommentResponse
-	(*LikeActionRequest)(nil),                     // 9: comment.api.v1.LikeActionRequest
-	(*LikeActionResponse)(nil),                    // 10: comment.api.v1.LikeActionResponse
-	(*DislikeActionRequest)(nil),                  // 11: comment.api.v1.DislikeActionRequest
-	(*DislikeActionResponse)(nil),                 // 12: comment.api.v1.DislikeActionResponse
-	(*ReportCommentRequest)(nil),                  // 13: comment.api.v1.ReportCommentRequest
-	(*ReportCommentResponse)(nil),                 // 14: comment.api.v1.ReportCommentResponse
-	(*PinCommentRequest)(nil),                     // 15: comment.api.v1.PinCommentRequest
-	(*PinCommentResponse)(nil),                    // 16: comment.api.v1.PinCommentResponse
-	(*PageGetCommentRequest)(nil),                 // 17: comment.api.v1.PageGetCommentRequest
-	(*CommentItem)(nil),                           // 18: comment.api.v1.CommentItem
-	(*CommentItemImageMeta)(nil),                  // 19: comment.api.v1.CommentItemImageMeta
-	(*CommentItemImage)(nil),                      // 20: comment.api.v1.CommentItemImage
-	(*PageGetCommentResponse)(nil),                // 21: comment.api.v1.PageGetCommentResponse
-	(*PageGetSubCommentRequest)(nil),              // 22: comment.api.v1.PageGetSubCommentRequest
-	(*PageGetSubCommentResponse)(nil),             // 23: comment.api.v1.PageGetSubCommentResponse
-	(*PageGetSubCommentV2Request)(nil),            // 24: comment.api.v1.PageGetSubCommentV2Request
-	(*PageGetSubCommentV2Response)(nil),           // 25: comment.api.v1.PageGetSubCommentV2Response
-	(*DetailedSubComment)(nil),                    // 26: comment.api.v1.DetailedSubComment
-	(*DetailedCommentItem)(nil),                   // 27: comment.api.v1.DetailedCommentItem
-	(*PageGetDetailedCommentRequest)(nil),         // 28: comment.api.v1.PageGetDetailedCommentRequest
-	(*PageGetDetailedCommentResponse)(nil),        // 29: comment.api.v1.PageGetDetailedCommentResponse
-	(*PageGetDetailedCommentV2Request)(nil),       // 30: comment.api.v1.PageGetDetailedCommentV2Request
-	(*DetailedSubCommentV2)(nil),                  // 31: comment.api.v1.DetailedSubCommentV2
-	(*DetailedCommentItemV2)(nil),                 // 32: comment.api.v1.DetailedCommentItemV2
-	(*PageGetDetailedCommentV2Response)(nil),      // 33: comment.api.v1.PageGetDetailedCommentV2Response
-	(*GetPinnedCommentRequest)(nil),               // 34: comment.api.v1.GetPinnedCommentRequest
-	(*GetPinnedCommentResponse)(nil),              // 35: comment.api.v1.GetPinnedCommentResponse
-	(*CountCommentRequest)(nil),                   // 36: comment.api.v1.CountCommentRequest
-	(*CountCommentResponse)(nil),                  // 37: comment.api.v1.CountCommentResponse
-	(*BatchCountCommentRequest)(nil),              // 38: comment.api.v1.BatchCountCommentRequest
-	(*BatchCountCommentResponse)(nil),             // 39: comment.api.v1.BatchCountCommentResponse
-	(*GetCommentLikeCountRequest)(nil),            // 40: comment.api.v1.GetCommentLikeCountRequest
-	(*GetCommentLikeCountResponse)(nil),           // 41: comment.api.v1.GetCommentLikeCountResponse
-	(*GetCommentDislikeCountRequest)(nil),         // 42: comment.api.v1.GetCommentDislikeCountRequest
-	(*GetCommentDislikeCountResponse)(nil),        // 43: comment.api.v1.GetCommentDislikeCountResponse
-	(*CheckUserOnObjectRequest)(nil),              // 44: comment.api.v1.CheckUserOnObjectRequest
-	(*CheckUserOnObjectResponse)(nil),             // 45: comment.api.v1.CheckUserOnObjectResponse
-	(*BatchCheckUserOnObjectRequest)(nil),         // 46: comment.api.v1.BatchCheckUserOnObjectRequest
-	(*OidCommented)(nil),                          // 47: comment.api.v1.OidCommented
-	(*OidCommentedList)(nil),                      // 48: comment.api.v1.OidCommentedList
-	(*BatchCheckUserOnObjectResponse)(nil),        // 49: comment.api.v1.BatchCheckUserOnObjectResponse
-	(*BatchCheckUserLikeCommentRequest)(nil),      // 50: comment.api.v1.BatchCheckUserLikeCommentRequest
-	(*CommentLiked)(nil),                          // 51: comment.api.v1.CommentLiked
-	(*BatchCheckUserLikeCommentResponse)(nil),     // 52: comment.api.v1.BatchCheckUserLikeCommentResponse
-	(*BatchCheckCommentExistRequest)(nil),         // 53: comment.api.v1.BatchCheckCommentExistRequest
-	(*BatchCheckCommentExistResponse)(nil),        // 54: comment.api.v1.BatchCheckCommentExistResponse
-	(*GetCommentRequest)(nil),                     // 55: comment.api.v1.GetCommentRequest
-	(*GetCommentResponse)(nil),                    // 56: comment.api.v1.GetCommentResponse
-	(*GetCommentUserRequest)(nil),                 // 57: comment.api.v1.GetCommentUserRequest
-	(*GetCommentUserResponse)(nil),                // 58: comment.api.v1.GetCommentUserResponse
-	nil,                                           // 59: comment.api.v1.BatchCountCommentResponse.NumbersEntry
-	(*BatchCheckUserOnObjectRequest_Objects)(nil), // 60: comment.api.v1.BatchCheckUserOnObjectRequest.Objects
-	nil, // 61: comment.api.v1.BatchCheckUserOnObjectRequest.MappingsEntry
-	nil, // 62: comment.api.v1.BatchCheckUserOnObjectResponse.ResultsEntry
-	(*BatchCheckUserLikeCommentRequest_CommentIdList)(nil), // 63: comment.api.v1.BatchCheckUserLikeCommentRequest.CommentIdList
-	nil, // 64: comment.api.v1.BatchCheckUserLikeCommentRequest.MappingsEntry
-	(*BatchCheckUserLikeCommentResponse_CommentLikedList)(nil), // 65: comment.api.v1.BatchCheckUserLikeCommentResponse.CommentLikedList
-	nil, // 66: comment.api.v1.BatchCheckUserLikeCommentResponse.ResultsEntry
-	nil, // 67: comment.api.v1.BatchCheckCommentExistResponse.ExistenceEntry
+func (x *GetCommentEditHistoryRequest) Reset() {
+	*x = GetCommentEditHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_comment_api_v1_comment_proto_depIdxs = []int32{
-	1,  // 0: comment.api.v1.AddCommentRequest.type:type_name -> comment.api.v1.CommentType
-	5,  // 1: comment.api.v1.AddCommentRequest.images:type_name -> comment.api.v1.CommentReqImage
-	3,  // 2: comment.api.v1.AddCommentRequest.at_users:type_name -> comment.api.v1.CommentAtUser
-	0,  // 3: comment.api.v1.LikeActionRequest.action:type_name -> comment.api.v1.CommentAction
-	0,  // 4: comment.api.v1.DislikeActionRequest.action:type_name -> comment.api.v1.CommentAction
-	0,  // 5: comment.api.v1.PinCommentRequest.action:type_name -> comment.api.v1.CommentAction
-	2,  // 6: comment.api.v1.PageGetCommentRequest.sort_by:type_name -> comment.api.v1.SortType
-	1,  // 7: comment.api.v1.CommentItem.type:type_name -> comment.api.v1.CommentType
-	20, // 8: comment.api.v1.CommentItem.images:type_name -> comment.api.v1.CommentItemImage
-	3,  // 9: comment.api.v1.CommentItem.at_users:type_name -> comment.api.v1.CommentAtUser
-	19, // 10: comment.api.v1.CommentItemImage.meta:type_name -> comment.api.v1.CommentItemImageMeta
-	18, // 11: comment.api.v1.PageGetCommentResponse.comments:type_name -> comment.api.v1.CommentItem
-	18, // 12: comment.api.v1.PageGetSubCommentResponse.comments:type_name -> comment.api.v1.CommentItem
-	18, // 13: comment.api.v1.PageGetSubCommentV2Response.comments:type_name -> comment.api.v1.CommentItem
-	18, // 14: comment.api.v1.DetailedSubComment.items:type_name -> comment.api.v1.CommentItem
-	18, // 15: comment.api.v1.DetailedCommentItem.root:type_name -> comment.api.v1.CommentItem
-	26, // 16: comment.api.v1.DetailedCommentItem.sub_comments:type_name -> comment.api.v1.DetailedSubComment
-	2,  // 17: comment.api.v1.PageGetDetailedCommentRequest.sort_by:type_name -> comment.api.v1.SortType
-	27, // 18: comment.api.v1.PageGetDetailedCommentResponse.comments:type_name -> comment.api.v1.DetailedCommentItem
-	2,  // 19: comment.api.v1.PageGetDetailedCommentV2Request.sort_by:type_name -> comment.api.v1.SortType
-	18, // 20: comment.api.v1.DetailedSubCommentV2.items:type_name -> comment.api.v1.CommentItem
-	18, // 21: comment.api.v1.DetailedCommentItemV2.root:type_name -> comment.api.v1.CommentItem
-	31, // 22: comment.api.v1.DetailedCommentItemV2.sub_comments:type_name -> comment.api.v1.DetailedSubCommentV2
-	32, // 23: comment.api.v1.PageGetDetailedCommentV2Response.root_comments:type_name -> comment.api.v1.DetailedCommentItemV2
-	27, // 24: comment.api.v1.GetPinnedCommentResponse.item:type_name -> comment.api.v1.DetailedCommentItem
-	59, // 25: comment.api.v1.BatchCountCommentResponse.numbers:type_name -> comment.api.v1.BatchCountCommentResponse.NumbersEntry
-	47, // 26: comment.api.v1.CheckUserOnObjectResponse.result:type_name -> comment.api.v1.OidCommented
-	61, // 27: comment.api.v1.BatchCheckUserOnObjectRequest.mappings:type_name -> comment.api.v1.BatchCheckUserOnObjectRequest.MappingsEntry
-	47, // 28: comment.api.v1.OidCommentedList.list:type_name -> comment.api.v1.OidCommented
-	62, // 29: comment.api.v1.BatchCheckUserOnObjectResponse.results:type_name -> comment.api.v1.BatchCheckUserOnObjectResponse.ResultsEntry
-	64, // 30: comment.api.v1.BatchCheckUserLikeCommentRequest.mappings:type_name -> comment.api.v1.BatchCheckUserLikeCommentRequest.MappingsEntry
-	66, // 31: comment.api.v1.BatchCheckUserLikeCommentResponse.results:type_name -> comment.api.v1.BatchCheckUserLikeCommentResponse.ResultsEntry
-	67, // 32: comment.api.v1.BatchCheckCommentExistResponse.existence:type_name -> comment.api.v1.BatchCheckCommentExistResponse.ExistenceEntry
-	18, // 33: comment.api.v1.GetCommentResponse.item:type_name -> comment.api.v1.CommentItem
-	60, // 34: comment.api.v1.BatchCheckUserOnObjectRequest.MappingsEntry.value:type_name -> comment.api.v1.BatchCheckUserOnObjectRequest.Objects
-	48, // 35: comment.api.v1.BatchCheckUserOnObjectResponse.ResultsEntry.value:type_name -> comment.api.v1.OidCommentedList
-	63, // 36: comment.api.v1.BatchCheckUserLikeCommentRequest.MappingsEntry.value:type_name -> comment.api.v1.BatchCheckUserLikeCommentRequest.CommentIdList
-	51, // 37: comment.api.v1.BatchCheckUserLikeCommentResponse.CommentLikedList.list:type_name -> comment.api.v1.CommentLiked
-	65, // 38: comment.api.v1.BatchCheckUserLikeCommentResponse.ResultsEntry.value:type_name -> comment.api.v1.BatchCheckUserLikeCommentResponse.CommentLikedList
-	4,  // 39: comment.api.v1.CommentService.AddComment:input_type -> comment.api.v1.AddCommentRequest
-	7,  // 40: comment.api.v1.CommentService.DelComment:input_type -> comment.api.v1.DelCommentRequest
-	9,  // 41: comment.api.v1.CommentService.LikeAction:input_type -> comment.api.v1.LikeActionRequest
-	11, // 42: comment.api.v1.CommentService.DislikeAction:input_type -> comment.api.v1.DislikeActionRequest
-	13, // 43: comment.api.v1.CommentService.ReportComment:input_type -> comment.api.v1.ReportCommentRequest
-	15, // 44: comment.api.v1.CommentService.PinComment:input_type -> comment.api.v1.PinCommentRequest
-	17, // 45: comment.api.v1.CommentService.PageGetComment:input_type -> comment.api.v1.PageGetCommentRequest
-	22, // 46: comment.api.v1.CommentService.PageGetSubComment:input_type -> comment.api.v1.PageGetSubCommentRequest
-	24, // 47: comment.api.v1.CommentService.PageGetSubCommentV2:input_type -> comment.api.v1.PageGetSubCommentV2Request
-	28, // 48: comment.api.v1.CommentService.PageGetDetailedComment:input_type -> comment.api.v1.PageGetDetailedCommentRequest
-	30, // 49: comment.api.v1.CommentService.PageGetDetailedCommentV2:input_type -> comment.api.v1.PageGetDetailedCommentV2Request
-	34, // 50: comment.api.v1.CommentService.GetPinnedComment:input_type -> comment.api.v1.GetPinnedCommentRequest
-	36, // 51: comment.api.v1.CommentService.CountComment:input_type -> comment.api.v1.CountCommentRequest
-	38, // 52: comment.api.v1.CommentService.BatchCountComment:input_type -> comment.api.v1.BatchCountCommentRequest
-	40, // 53: comment.api.v1.CommentService.GetCommentLikeCount:input_type -> comment.api.v1.GetCommentLikeCountRequest
-	42, // 54: comment.api.v1.CommentService.GetCommentDislikeCount:input_type -> comment.api.v1.GetCommentDislikeCountRequest
-	44, // 55: comment.api.v1.CommentService.CheckUserOnObject:input_type -> comment.api.v1.CheckUserOnObjectRequest
-	46, // 56: comment.api.v1.CommentService.BatchCheckUserOnObject:input_type -> comment.api.v1.BatchCheckUserOnObjectRequest
-	50, // 57: comment.api.v1.CommentService.BatchCheckUserLikeComment:input_type -> comment.api.v1.BatchCheckUserLikeCommentRequest
-	53, // 58: comment.api.v1.CommentService.BatchCheckCommentExist:input_type -> comment.api.v1.BatchCheckCommentExistRequest
-	55, // 59: comment.api.v1.CommentService.GetComment:input_type -> comment.api.v1.GetCommentRequest
-	57, // 60: comment.api.v1.CommentService.GetCommentUser:input_type -> comment.api.v1.GetCommentUserRequest
-	6,  // 61: comment.api.v1.CommentService.AddComment:output_type -> comment.api.v1.AddCommentResponse
-	8,  // 62: comment.api.v1.CommentService.DelComment:output_type -> comment.api.v1.DelCommentResponse
-	10, // 63: comment.api.v1.CommentService.LikeAction:output_type -> comment.api.v1.LikeActionResponse
-	12, // 64: comment.api.v1.CommentService.DislikeAction:output_type -> comment.api.v1.DislikeActionResponse
-	14, // 65: comment.api.v1.CommentService.ReportComment:output_type -> comment.api.v1.ReportCommentResponse
-	16, // 66: comment.api.v1.CommentService.PinComment:output_type -> comment.api.v1.PinCommentResponse
-	21, // 67: comment.api.v1.CommentService.PageGetComment:output_type -> comment.api.v1.PageGetCommentResponse
-	23, // 68: comment.api.v1.CommentService.PageGetSubComment:output_type -> comment.api.v1.PageGetSubCommentResponse
-	25, // 69: comment.api.v1.CommentService.PageGetSubCommentV2:output_type -> comment.api.v1.PageGetSubCommentV2Response
-	29, // 70: comment.api.v1.CommentService.PageGetDetailedComment:output_type -> comment.api.v1.PageGetDetailedCommentResponse
-	33, // 71: comment.api.v1.CommentService.PageGetDetailedCommentV2:output_type -> comment.api.v1.PageGetDetailedCommentV2Response
-	35, // 72: comment.api.v1.CommentService.GetPinnedComment:output_type -> comment.api.v1.GetPinnedCommentResponse
-	37, // 73: comment.api.v1.CommentService.CountComment:output_type -> comment.api.v1.CountCommentResponse
-	39, // 74: comment.api.v1.CommentService.BatchCountComment:output_type -> comment.api.v1.BatchCountCommentResponse
-	41, // 75: comment.api.v1.CommentService.GetCommentLikeCount:output_type -> comment.api.v1.GetCommentLikeCountResponse
-	43, // 76: comment.api.v1.CommentService.GetCommentDislikeCount:output_type -> comment.api.v1.GetCommentDislikeCountResponse
-	45, // 77: comment.api.v1.CommentService.CheckUserOnObject:output_type -> comment.api.v1.CheckUserOnObjectResponse
-	49, // 78: comment.api.v1.CommentService.BatchCheckUserOnObject:output_type -> comment.api.v1.BatchCheckUserOnObjectResponse
-	52, // 79: comment.api.v1.CommentService.BatchCheckUserLikeComment:output_type -> comment.api.v1.BatchCheckUserLikeCommentResponse
-	54, // 80: comment.api.v1.CommentService.BatchCheckCommentExist:output_type -> comment.api.v1.BatchCheckCommentExistResponse
-	56, // 81: comment.api.v1.CommentService.GetComment:output_type -> comment.api.v1.GetCommentResponse
-	58, // 82: comment.api.v1.CommentService.GetCommentUser:output_type -> comment.api.v1.GetCommentUserResponse
-	61, // [61:83] is the sub-list for method output_type
-	39, // [39:61] is the sub-list for method input_type
-	39, // [39:39] is the sub-list for extension type_name
-	39, // [39:39] is the sub-list for extension extendee
-	0,  // [0:39] is the sub-list for field type_name
+
+func (x *GetCommentEditHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_comment_api_v1_comment_proto_init() }
-func file_comment_api_v1_comment_proto_init() {
-	if File_comment_api_v1_comment_proto != nil {
-		return
+func (*GetCommentEditHistoryRequest) ProtoMessage() {}
+
+func (x *GetCommentEditHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_comment_api_v1_comment_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*CommentAtUser); i {
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentEditHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCommentEditHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetCommentEditHistoryRequest) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+type GetCommentEditHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Versions []*CommentEditVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"` // 按照编辑时间从新到旧排列
+}
+
+func (x *GetCommentEditHistoryResponse) Reset() {
+	*x = GetCommentEditHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCommentEditHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentEditHistoryResponse) ProtoMessage() {}
+
+func (x *GetCommentEditHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentEditHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetCommentEditHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetCommentEditHistoryResponse) GetVersions() []*CommentEditVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+// 一条举报记录
+type CommentReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CommentId  int64            `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"` // 被举报的评论
+	Oid        int64            `protobuf:"varint,3,opt,name=oid,proto3" json:"oid,omitempty"`                              // 被评论对象id
+	Reporter   int64            `protobuf:"varint,4,opt,name=reporter,proto3" json:"reporter,omitempty"`                    // 举报人
+	Reason     ReportReason     `protobuf:"varint,5,opt,name=reason,proto3,enum=comment.api.v1.ReportReason" json:"reason,omitempty"`
+	Detail     string           `protobuf:"bytes,6,opt,name=detail,proto3" json:"detail,omitempty"`
+	Resolution ReportResolution `protobuf:"varint,7,opt,name=resolution,proto3,enum=comment.api.v1.ReportResolution" json:"resolution,omitempty"`
+	Ctime      int64            `protobuf:"varint,8,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	Utime      int64            `protobuf:"varint,9,opt,name=utime,proto3" json:"utime,omitempty"`
+}
+
+func (x *CommentReport) Reset() {
+	*x = CommentReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentReport) ProtoMessage() {}
+
+func (x *CommentReport) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentReport.ProtoReflect.Descriptor instead.
+func (*CommentReport) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CommentReport) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CommentReport) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *CommentReport) GetOid() int64 {
+	if x != nil {
+		return x.Oid
+	}
+	return 0
+}
+
+func (x *CommentReport) GetReporter() int64 {
+	if x != nil {
+		return x.Reporter
+	}
+	return 0
+}
+
+func (x *CommentReport) GetReason() ReportReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ReportReason_REPORT_REASON_UNSPECIFIED
+}
+
+func (x *CommentReport) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *CommentReport) GetResolution() ReportResolution {
+	if x != nil {
+		return x.Resolution
+	}
+	return ReportResolution_REPORT_RESOLUTION_PENDING
+}
+
+func (x *CommentReport) GetCtime() int64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+func (x *CommentReport) GetUtime() int64 {
+	if x != nil {
+		return x.Utime
+	}
+	return 0
+}
+
+// 获取待处理的举报 按照举报时间从早到晚排列
+type ListPendingReportsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cursor int64 `protobuf:"varint,1,opt,name=cursor,proto3" json:"cursor,omitempty"` // 起始游标
+	Count  int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ListPendingReportsRequest) Reset() {
+	*x = ListPendingReportsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPendingReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingReportsRequest) ProtoMessage() {}
+
+func (x *ListPendingReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingReportsRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingReportsRequest) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListPendingReportsRequest) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *ListPendingReportsRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ListPendingReportsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reports    []*CommentReport       `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	Comments   map[int64]*CommentItem `protobuf:"bytes,2,rep,name=comments,proto3" json:"comments,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 被举报的评论 comment_id -> comment
+	NextCursor int64                  `protobuf:"varint,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasNext    bool                   `protobuf:"varint,4,opt,name=has_next,json=hasNext,proto3" json:"has_next,omitempty"`
+}
+
+func (x *ListPendingReportsResponse) Reset() {
+	*x = ListPendingReportsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPendingReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingReportsResponse) ProtoMessage() {}
+
+func (x *ListPendingReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingReportsResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingReportsResponse) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListPendingReportsResponse) GetReports() []*CommentReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+func (x *ListPendingReportsResponse) GetComments() map[int64]*CommentItem {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *ListPendingReportsResponse) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *ListPendingReportsResponse) GetHasNext() bool {
+	if x != nil {
+		return x.HasNext
+	}
+	return false
+}
+
+// 处理评论的全部待处理举报
+type ResolveReportsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentId  int64            `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	Resolution ReportResolution `protobuf:"varint,2,opt,name=resolution,proto3,enum=comment.api.v1.ReportResolution" json:"resolution,omitempty"`
+	BanSeconds int64            `protobuf:"varint,3,opt,name=ban_seconds,json=banSeconds,proto3" json:"ban_seconds,omitempty"` // 禁止评论的时长 0表示永久
+}
+
+func (x *ResolveReportsRequest) Reset() {
+	*x = ResolveReportsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveReportsRequest) ProtoMessage() {}
+
+func (x *ResolveReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveReportsRequest.ProtoReflect.Descriptor instead.
+func (*ResolveReportsRequest) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ResolveReportsRequest) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *ResolveReportsRequest) GetResolution() ReportResolution {
+	if x != nil {
+		return x.Resolution
+	}
+	return ReportResolution_REPORT_RESOLUTION_PENDING
+}
+
+func (x *ResolveReportsRequest) GetBanSeconds() int64 {
+	if x != nil {
+		return x.BanSeconds
+	}
+	return 0
+}
+
+type ResolveReportsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Resolved int64 `protobuf:"varint,1,opt,name=resolved,proto3" json:"resolved,omitempty"` // 处理的举报数量
+}
+
+func (x *ResolveReportsResponse) Reset() {
+	*x = ResolveReportsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveReportsResponse) ProtoMessage() {}
+
+func (x *ResolveReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveReportsResponse.ProtoReflect.Descriptor instead.
+func (*ResolveReportsResponse) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ResolveReportsResponse) GetResolved() int64 {
+	if x != nil {
+		return x.Resolved
+	}
+	return 0
+}
+
+// 表情包
+type EmojiPack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code     string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"` // 表情包代码 全局唯一
+	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CoverKey string `protobuf:"bytes,4,opt,name=cover_key,json=coverKey,proto3" json:"cover_key,omitempty"` // 封面的存储key
+	Version  int64  `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`                  // 表情包及其表情每次变更时递增 客户端据此判断是否需要更新
+	Enabled  bool   `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Ctime    int64  `protobuf:"varint,7,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	Mtime    int64  `protobuf:"varint,8,opt,name=mtime,proto3" json:"mtime,omitempty"`
+}
+
+func (x *EmojiPack) Reset() {
+	*x = EmojiPack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmojiPack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmojiPack) ProtoMessage() {}
+
+func (x *EmojiPack) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmojiPack.ProtoReflect.Descriptor instead.
+func (*EmojiPack) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *EmojiPack) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EmojiPack) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *EmojiPack) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EmojiPack) GetCoverKey() string {
+	if x != nil {
+		return x.CoverKey
+	}
+	return ""
+}
+
+func (x *EmojiPack) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *EmojiPack) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *EmojiPack) GetCtime() int64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+func (x *EmojiPack) GetMtime() int64 {
+	if x != nil {
+		return x.Mtime
+	}
+	return 0
+}
+
+// 表情包中的一个表情
+type EmojiItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pack    string `protobuf:"bytes,2,opt,name=pack,proto3" json:"pack,omitempty"` // 所属表情包代码
+	Code    string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"` // 表情代码 表情包内唯一
+	Name    string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Key     string `protobuf:"bytes,5,opt,name=key,proto3" json:"key,omitempty"` // 表情资源的存储key
+	Width   uint32 `protobuf:"varint,6,opt,name=width,proto3" json:"width,omitempty"`
+	Height  uint32 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	Format  string `protobuf:"bytes,8,opt,name=format,proto3" json:"format,omitempty"`
+	Version int64  `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"` // 表情最后一次变更时的表情包版本
+	Enabled bool   `protobuf:"varint,10,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Ctime   int64  `protobuf:"varint,11,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	Mtime   int64  `protobuf:"varint,12,opt,name=mtime,proto3" json:"mtime,omitempty"`
+}
+
+func (x *EmojiItem) Reset() {
+	*x = EmojiItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmojiItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmojiItem) ProtoMessage() {}
+
+func (x *EmojiItem) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmojiItem.ProtoReflect.Descriptor instead.
+func (*EmojiItem) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *EmojiItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EmojiItem) GetPack() string {
+	if x != nil {
+		return x.Pack
+	}
+	return ""
+}
+
+func (x *EmojiItem) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *EmojiItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EmojiItem) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *EmojiItem) GetWidth() uint32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *EmojiItem) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *EmojiItem) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *EmojiItem) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *EmojiItem) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *EmojiItem) GetCtime() int64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+func (x *EmojiItem) GetMtime() int64 {
+	if x != nil {
+		return x.Mtime
+	}
+	return 0
+}
+
+type ListEmojiPacksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IncludeDisabled bool `protobuf:"varint,1,opt,name=include_disabled,json=includeDisabled,proto3" json:"include_disabled,omitempty"` // 是否包含已下架的表情包
+}
+
+func (x *ListEmojiPacksRequest) Reset() {
+	*x = ListEmojiPacksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEmojiPacksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmojiPacksRequest) ProtoMessage() {}
+
+func (x *ListEmojiPacksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmojiPacksRequest.ProtoReflect.Descriptor instead.
+func (*ListEmojiPacksRequest) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListEmojiPacksRequest) GetIncludeDisabled() bool {
+	if x != nil {
+		return x.IncludeDisabled
+	}
+	return false
+}
+
+type ListEmojiPacksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Packs []*EmojiPack `protobuf:"bytes,1,rep,name=packs,proto3" json:"packs,omitempty"`
+}
+
+func (x *ListEmojiPacksResponse) Reset() {
+	*x = ListEmojiPacksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEmojiPacksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmojiPacksResponse) ProtoMessage() {}
+
+func (x *ListEmojiPacksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmojiPacksResponse.ProtoReflect.Descriptor instead.
+func (*ListEmojiPacksResponse) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListEmojiPacksResponse) GetPacks() []*EmojiPack {
+	if x != nil {
+		return x.Packs
+	}
+	return nil
+}
+
+type GetEmojiPackRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code            string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	IncludeDisabled bool   `protobuf:"varint,2,opt,name=include_disabled,json=includeDisabled,proto3" json:"include_disabled,omitempty"` // 是否包含已下架的表情
+}
+
+func (x *GetEmojiPackRequest) Reset() {
+	*x = GetEmojiPackRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEmojiPackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmojiPackRequest) ProtoMessage() {}
+
+func (x *GetEmojiPackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmojiPackRequest.ProtoReflect.Descriptor instead.
+func (*GetEmojiPackRequest) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetEmojiPackRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *GetEmojiPackRequest) GetIncludeDisabled() bool {
+	if x != nil {
+		return x.IncludeDisabled
+	}
+	return false
+}
+
+type GetEmojiPackResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pack  *EmojiPack   `protobuf:"bytes,1,opt,name=pack,proto3" json:"pack,omitempty"`
+	Items []*EmojiItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *GetEmojiPackResponse) Reset() {
+	*x = GetEmojiPackResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEmojiPackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmojiPackResponse) ProtoMessage() {}
+
+func (x *GetEmojiPackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmojiPackResponse.ProtoReflect.Descriptor instead.
+func (*GetEmojiPackResponse) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetEmojiPackResponse) GetPack() *EmojiPack {
+	if x != nil {
+		return x.Pack
+	}
+	return nil
+}
+
+func (x *GetEmojiPackResponse) GetItems() []*EmojiItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// 按照[pack:item]批量查找表情
+type BatchGetEmojiItemsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tokens []string `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+}
+
+func (x *BatchGetEmojiItemsRequest) Reset() {
+	*x = BatchGetEmojiItemsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetEmojiItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetEmojiItemsRequest) ProtoMessage() {}
+
+func (x *BatchGetEmojiItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetEmojiItemsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetEmojiItemsRequest) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *BatchGetEmojiItemsRequest) GetTokens() []string {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type BatchGetEmojiItemsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items map[string]*EmojiItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // token -> item 不存在或已下架的不返回
+}
+
+func (x *BatchGetEmojiItemsResponse) Reset() {
+	*x = BatchGetEmojiItemsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetEmojiItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetEmojiItemsResponse) ProtoMessage() {}
+
+func (x *BatchGetEmojiItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetEmojiItemsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetEmojiItemsResponse) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *BatchGetEmojiItemsResponse) GetItems() map[string]*EmojiItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type UpsertEmojiPackRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code     string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CoverKey string `protobuf:"bytes,3,opt,name=cover_key,json=coverKey,proto3" json:"cover_key,omitempty"`
+	Enabled  bool   `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *UpsertEmojiPackRequest) Reset() {
+	*x = UpsertEmojiPackRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpsertEmojiPackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertEmojiPackRequest) ProtoMessage() {}
+
+func (x *UpsertEmojiPackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertEmojiPackRequest.ProtoReflect.Descriptor instead.
+func (*UpsertEmojiPackRequest) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *UpsertEmojiPackRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *UpsertEmojiPackRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpsertEmojiPackRequest) GetCoverKey() string {
+	if x != nil {
+		return x.CoverKey
+	}
+	return ""
+}
+
+func (x *UpsertEmojiPackRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type UpsertEmojiPackResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pack *EmojiPack `protobuf:"bytes,1,opt,name=pack,proto3" json:"pack,omitempty"`
+}
+
+func (x *UpsertEmojiPackResponse) Reset() {
+	*x = UpsertEmojiPackResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpsertEmojiPackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertEmojiPackResponse) ProtoMessage() {}
+
+func (x *UpsertEmojiPackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertEmojiPackResponse.ProtoReflect.Descriptor instead.
+func (*UpsertEmojiPackResponse) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *UpsertEmojiPackResponse) GetPack() *EmojiPack {
+	if x != nil {
+		return x.Pack
+	}
+	return nil
+}
+
+type UpsertEmojiItemRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pack    string `protobuf:"bytes,1,opt,name=pack,proto3" json:"pack,omitempty"`
+	Code    string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name    string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Key     string `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	Width   uint32 `protobuf:"varint,5,opt,name=width,proto3" json:"width,omitempty"`
+	Height  uint32 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Format  string `protobuf:"bytes,7,opt,name=format,proto3" json:"format,omitempty"`
+	Enabled bool   `protobuf:"varint,8,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *UpsertEmojiItemRequest) Reset() {
+	*x = UpsertEmojiItemRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpsertEmojiItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertEmojiItemRequest) ProtoMessage() {}
+
+func (x *UpsertEmojiItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertEmojiItemRequest.ProtoReflect.Descriptor instead.
+func (*UpsertEmojiItemRequest) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *UpsertEmojiItemRequest) GetPack() string {
+	if x != nil {
+		return x.Pack
+	}
+	return ""
+}
+
+func (x *UpsertEmojiItemRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *UpsertEmojiItemRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpsertEmojiItemRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *UpsertEmojiItemRequest) GetWidth() uint32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *UpsertEmojiItemRequest) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *UpsertEmojiItemRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *UpsertEmojiItemRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type UpsertEmojiItemResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *EmojiItem `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *UpsertEmojiItemResponse) Reset() {
+	*x = UpsertEmojiItemResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpsertEmojiItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertEmojiItemResponse) ProtoMessage() {}
+
+func (x *UpsertEmojiItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertEmojiItemResponse.ProtoReflect.Descriptor instead.
+func (*UpsertEmojiItemResponse) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *UpsertEmojiItemResponse) GetItem() *EmojiItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+// 作者对评论对象的管理设置
+type CommentSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Oid             int64       `protobuf:"varint,1,opt,name=oid,proto3" json:"oid,omitempty"`
+	Mode            CommentMode `protobuf:"varint,2,opt,name=mode,proto3,enum=comment.api.v1.CommentMode" json:"mode,omitempty"`
+	BlockedKeywords []string    `protobuf:"bytes,3,rep,name=blocked_keywords,json=blockedKeywords,proto3" json:"blocked_keywords,omitempty"` // 命中的评论需要作者审核后才展示
+	Mtime           int64       `protobuf:"varint,4,opt,name=mtime,proto3" json:"mtime,omitempty"`
+}
+
+func (x *CommentSetting) Reset() {
+	*x = CommentSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentSetting) ProtoMessage() {}
+
+func (x *CommentSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentSetting.ProtoReflect.Descriptor instead.
+func (*CommentSetting) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *CommentSetting) GetOid() int64 {
+	if x != nil {
+		return x.Oid
+	}
+	return 0
+}
+
+func (x *CommentSetting) GetMode() CommentMode {
+	if x != nil {
+		return x.Mode
+	}
+	return CommentMode_COMMENT_MODE_OPEN
+}
+
+func (x *CommentSetting) GetBlockedKeywords() []string {
+	if x != nil {
+		return x.BlockedKeywords
+	}
+	return nil
+}
+
+func (x *CommentSetting) GetMtime() int64 {
+	if x != nil {
+		return x.Mtime
+	}
+	return 0
+}
+
+type GetCommentSettingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Oid int64 `protobuf:"varint,1,opt,name=oid,proto3" json:"oid,omitempty"`
+}
+
+func (x *GetCommentSettingRequest) Reset() {
+	*x = GetCommentSettingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCommentSettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentSettingRequest) ProtoMessage() {}
+
+func (x *GetCommentSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentSettingRequest.ProtoReflect.Descriptor instead.
+func (*GetCommentSettingRequest) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetCommentSettingRequest) GetOid() int64 {
+	if x != nil {
+		return x.Oid
+	}
+	return 0
+}
+
+type GetCommentSettingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Setting *CommentSetting `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting,omitempty"`
+}
+
+func (x *GetCommentSettingResponse) Reset() {
+	*x = GetCommentSettingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCommentSettingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentSettingResponse) ProtoMessage() {}
+
+func (x *GetCommentSettingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentSettingResponse.ProtoReflect.Descriptor instead.
+func (*GetCommentSettingResponse) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetCommentSettingResponse) GetSetting() *CommentSetting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+type UpdateCommentSettingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Oid             int64       `protobuf:"varint,1,opt,name=oid,proto3" json:"oid,omitempty"`
+	Mode            CommentMode `protobuf:"varint,2,opt,name=mode,proto3,enum=comment.api.v1.CommentMode" json:"mode,omitempty"`
+	BlockedKeywords []string    `protobuf:"bytes,3,rep,name=blocked_keywords,json=blockedKeywords,proto3" json:"blocked_keywords,omitempty"`
+}
+
+func (x *UpdateCommentSettingRequest) Reset() {
+	*x = UpdateCommentSettingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateCommentSettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCommentSettingRequest) ProtoMessage() {}
+
+func (x *UpdateCommentSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCommentSettingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCommentSettingRequest) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *UpdateCommentSettingRequest) GetOid() int64 {
+	if x != nil {
+		return x.Oid
+	}
+	return 0
+}
+
+func (x *UpdateCommentSettingRequest) GetMode() CommentMode {
+	if x != nil {
+		return x.Mode
+	}
+	return CommentMode_COMMENT_MODE_OPEN
+}
+
+func (x *UpdateCommentSettingRequest) GetBlockedKeywords() []string {
+	if x != nil {
+		return x.BlockedKeywords
+	}
+	return nil
+}
+
+type UpdateCommentSettingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Setting *CommentSetting `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting,omitempty"`
+}
+
+func (x *UpdateCommentSettingResponse) Reset() {
+	*x = UpdateCommentSettingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateCommentSettingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCommentSettingResponse) ProtoMessage() {}
+
+func (x *UpdateCommentSettingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCommentSettingResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCommentSettingResponse) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *UpdateCommentSettingResponse) GetSetting() *CommentSetting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+// 禁止/解除禁止用户在作者的所有对象下评论
+type MuteCommentUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid    int64         `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Action CommentAction `protobuf:"varint,2,opt,name=action,proto3,enum=comment.api.v1.CommentAction" json:"action,omitempty"`
+}
+
+func (x *MuteCommentUserRequest) Reset() {
+	*x = MuteCommentUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MuteCommentUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteCommentUserRequest) ProtoMessage() {}
+
+func (x *MuteCommentUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteCommentUserRequest.ProtoReflect.Descriptor instead.
+func (*MuteCommentUserRequest) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *MuteCommentUserRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *MuteCommentUserRequest) GetAction() CommentAction {
+	if x != nil {
+		return x.Action
+	}
+	return CommentAction_COMMENT_ACTION_UNDO
+}
+
+type MuteCommentUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MuteCommentUserResponse) Reset() {
+	*x = MuteCommentUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MuteCommentUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteCommentUserResponse) ProtoMessage() {}
+
+func (x *MuteCommentUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteCommentUserResponse.ProtoReflect.Descriptor instead.
+func (*MuteCommentUserResponse) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{85}
+}
+
+type MutedCommentUser struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid   int64 `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Ctime int64 `protobuf:"varint,2,opt,name=ctime,proto3" json:"ctime,omitempty"` // 禁止的时间
+}
+
+func (x *MutedCommentUser) Reset() {
+	*x = MutedCommentUser{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MutedCommentUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutedCommentUser) ProtoMessage() {}
+
+func (x *MutedCommentUser) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutedCommentUser.ProtoReflect.Descriptor instead.
+func (*MutedCommentUser) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *MutedCommentUser) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *MutedCommentUser) GetCtime() int64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+// 获取作者禁止评论的用户 按照禁止时间从晚到早排列
+type ListMutedCommentUsersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cursor int64 `protobuf:"varint,1,opt,name=cursor,proto3" json:"cursor,omitempty"` // 起始游标
+	Count  int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ListMutedCommentUsersRequest) Reset() {
+	*x = ListMutedCommentUsersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMutedCommentUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMutedCommentUsersRequest) ProtoMessage() {}
+
+func (x *ListMutedCommentUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMutedCommentUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListMutedCommentUsersRequest) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ListMutedCommentUsersRequest) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *ListMutedCommentUsersRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ListMutedCommentUsersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users      []*MutedCommentUser `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextCursor int64               `protobuf:"varint,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasNext    bool                `protobuf:"varint,3,opt,name=has_next,json=hasNext,proto3" json:"has_next,omitempty"`
+}
+
+func (x *ListMutedCommentUsersResponse) Reset() {
+	*x = ListMutedCommentUsersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMutedCommentUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMutedCommentUsersResponse) ProtoMessage() {}
+
+func (x *ListMutedCommentUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMutedCommentUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListMutedCommentUsersResponse) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ListMutedCommentUsersResponse) GetUsers() []*MutedCommentUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListMutedCommentUsersResponse) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *ListMutedCommentUsersResponse) GetHasNext() bool {
+	if x != nil {
+		return x.HasNext
+	}
+	return false
+}
+
+// 获取对象下等待作者审核的评论 按照发布时间从早到晚排列
+type ListHeldCommentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Oid    int64 `protobuf:"varint,1,opt,name=oid,proto3" json:"oid,omitempty"`
+	Cursor int64 `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"` // 起始游标
+	Count  int32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ListHeldCommentsRequest) Reset() {
+	*x = ListHeldCommentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListHeldCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHeldCommentsRequest) ProtoMessage() {}
+
+func (x *ListHeldCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHeldCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListHeldCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ListHeldCommentsRequest) GetOid() int64 {
+	if x != nil {
+		return x.Oid
+	}
+	return 0
+}
+
+func (x *ListHeldCommentsRequest) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *ListHeldCommentsRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ListHeldCommentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comments   []*CommentItem `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	NextCursor int64          `protobuf:"varint,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasNext    bool           `protobuf:"varint,3,opt,name=has_next,json=hasNext,proto3" json:"has_next,omitempty"`
+}
+
+func (x *ListHeldCommentsResponse) Reset() {
+	*x = ListHeldCommentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListHeldCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHeldCommentsResponse) ProtoMessage() {}
+
+func (x *ListHeldCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHeldCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListHeldCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListHeldCommentsResponse) GetComments() []*CommentItem {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *ListHeldCommentsResponse) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *ListHeldCommentsResponse) GetHasNext() bool {
+	if x != nil {
+		return x.HasNext
+	}
+	return false
+}
+
+// 通过等待审核的评论
+type ApproveHeldCommentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Oid        int64   `protobuf:"varint,1,opt,name=oid,proto3" json:"oid,omitempty"`
+	CommentIds []int64 `protobuf:"varint,2,rep,packed,name=comment_ids,json=commentIds,proto3" json:"comment_ids,omitempty"`
+}
+
+func (x *ApproveHeldCommentsRequest) Reset() {
+	*x = ApproveHeldCommentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveHeldCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveHeldCommentsRequest) ProtoMessage() {}
+
+func (x *ApproveHeldCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveHeldCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ApproveHeldCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ApproveHeldCommentsRequest) GetOid() int64 {
+	if x != nil {
+		return x.Oid
+	}
+	return 0
+}
+
+func (x *ApproveHeldCommentsRequest) GetCommentIds() []int64 {
+	if x != nil {
+		return x.CommentIds
+	}
+	return nil
+}
+
+type ApproveHeldCommentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Approved int64          `protobuf:"varint,1,opt,name=approved,proto3" json:"approved,omitempty"` // 通过的评论数量
+	Comments []*CommentItem `protobuf:"bytes,2,rep,name=comments,proto3" json:"comments,omitempty"`  // 通过的评论 包含@的用户 用于通过后通知被@的用户
+}
+
+func (x *ApproveHeldCommentsResponse) Reset() {
+	*x = ApproveHeldCommentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveHeldCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveHeldCommentsResponse) ProtoMessage() {}
+
+func (x *ApproveHeldCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveHeldCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ApproveHeldCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ApproveHeldCommentsResponse) GetApproved() int64 {
+	if x != nil {
+		return x.Approved
+	}
+	return 0
+}
+
+func (x *ApproveHeldCommentsResponse) GetComments() []*CommentItem {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+type BatchCheckUserOnObjectRequest_Objects struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Oids []int64 `protobuf:"varint,1,rep,packed,name=oids,proto3" json:"oids,omitempty"`
+}
+
+func (x *BatchCheckUserOnObjectRequest_Objects) Reset() {
+	*x = BatchCheckUserOnObjectRequest_Objects{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCheckUserOnObjectRequest_Objects) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCheckUserOnObjectRequest_Objects) ProtoMessage() {}
+
+func (x *BatchCheckUserOnObjectRequest_Objects) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCheckUserOnObjectRequest_Objects.ProtoReflect.Descriptor instead.
+func (*BatchCheckUserOnObjectRequest_Objects) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{44, 0}
+}
+
+func (x *BatchCheckUserOnObjectRequest_Objects) GetOids() []int64 {
+	if x != nil {
+		return x.Oids
+	}
+	return nil
+}
+
+// mappings => {uid: [rid1, rid2, ..., ridN]}
+type BatchCheckUserLikeCommentRequest_CommentIdList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *BatchCheckUserLikeCommentRequest_CommentIdList) Reset() {
+	*x = BatchCheckUserLikeCommentRequest_CommentIdList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCheckUserLikeCommentRequest_CommentIdList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCheckUserLikeCommentRequest_CommentIdList) ProtoMessage() {}
+
+func (x *BatchCheckUserLikeCommentRequest_CommentIdList) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCheckUserLikeCommentRequest_CommentIdList.ProtoReflect.Descriptor instead.
+func (*BatchCheckUserLikeCommentRequest_CommentIdList) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{48, 0}
+}
+
+func (x *BatchCheckUserLikeCommentRequest_CommentIdList) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// results => {uid: [{comment_id, liked}, {comment_id, liked}, ...,
+// {comment_id, liked}]}
+type BatchCheckUserLikeCommentResponse_CommentLikedList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*CommentLiked `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *BatchCheckUserLikeCommentResponse_CommentLikedList) Reset() {
+	*x = BatchCheckUserLikeCommentResponse_CommentLikedList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comment_api_v1_comment_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCheckUserLikeCommentResponse_CommentLikedList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCheckUserLikeCommentResponse_CommentLikedList) ProtoMessage() {}
+
+func (x *BatchCheckUserLikeCommentResponse_CommentLikedList) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_api_v1_comment_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCheckUserLikeCommentResponse_CommentLikedList.ProtoReflect.Descriptor instead.
+func (*BatchCheckUserLikeCommentResponse_CommentLikedList) Descriptor() ([]byte, []int) {
+	return file_comment_api_v1_comment_proto_rawDescGZIP(), []int{50, 0}
+}
+
+func (x *BatchCheckUserLikeCommentResponse_CommentLikedList) GetList() []*CommentLiked {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+var File_comment_api_v1_comment_proto protoreflect.FileDescriptor
+
+var file_comment_api_v1_comment_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x1a, 0x1b,
+	0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x65, 0x78, 0x74,
+	0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3d, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x41, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x03, 0x75, 0x69, 0x64, 0x22, 0xb6, 0x02, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x74, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x55, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x06, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x61, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x07, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x22, 0x74, 0x0a,
+	0x0f, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x77, 0x69,
+	0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x22, 0x47, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x65, 0x6c, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x68, 0x65, 0x6c, 0x64, 0x22, 0x56, 0x0a, 0x11,
+	0x44, 0x65, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x26, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x03, 0x6f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52,
+	0x03, 0x6f, 0x69, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x72, 0x0a, 0x11, 0x4c, 0x69,
+	0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x26, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x14,
+	0x0a, 0x12, 0x4c, 0x69, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x75, 0x0a, 0x14, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x17, 0x0a, 0x15, 0x44,
+	0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa0, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0xc8, 0x01, 0x52,
+	0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x8d, 0x01, 0x0a, 0x11, 0x50, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x03, 0x6f, 0x69,
+	0x64, 0x12, 0x26, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x14, 0x0a, 0x12, 0x50, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa0, 0x01, 0x0a, 0x15, 0x50, 0x61, 0x67, 0x65, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba,
+	0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06,
+	0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x5f,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72,
+	0x65, 0x6e, 0x64, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xe3, 0x04, 0x0a, 0x0b, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12, 0x2f, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x74, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x75, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x72, 0x75,
+	0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6b, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x68, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x70, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x15, 0x0a, 0x06,
+	0x69, 0x73, 0x5f, 0x70, 0x69, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73,
+	0x50, 0x69, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x38, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x10, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x08,
+	0x61, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x07, 0x61,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x65, 0x64, 0x69,
+	0x74, 0x65, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x45, 0x64, 0x69,
+	0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x65, 0x6d, 0x6f,
+	0x6a, 0x69, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x52, 0x06, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x73, 0x22,
+	0xbe, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x63, 0x6b, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x70, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x22, 0x5e, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65,
+	0x74, 0x61, 0x22, 0xb9, 0x01, 0x0a, 0x16, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
+	0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x08, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78,
+	0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6e,
+	0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65,
+	0x78, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x74, 0x72, 0x65, 0x6e, 0x64,
+	0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x6f,
+	0x0a, 0x18, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x6f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00,
+	0x52, 0x03, 0x6f, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52,
+	0x06, 0x72, 0x6f, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22,
+	0x90, 0x01, 0x0a, 0x19, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
+	0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x08, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78,
+	0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6e,
+	0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65,
+	0x78, 0x74, 0x22, 0x83, 0x01, 0x0a, 0x1a, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x53, 0x75,
+	0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x19, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07,
+	0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x07,
+	0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba,
+	0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x6c, 0x0a, 0x1b, 0x50, 0x61, 0x67, 0x65,
+	0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x83, 0x01, 0x0a, 0x12, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x22, 0x8d, 0x01, 0x0a,
+	0x13, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x74, 0x65, 0x6d, 0x12, 0x2f, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x45, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x0b, 0x73, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xa8, 0x01, 0x0a,
+	0x1d, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
+	0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04,
+	0x22, 0x02, 0x20, 0x00, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x6f,
+	0x72, 0x74, 0x42, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x5f, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x65, 0x6e,
+	0x64, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xc9, 0x01, 0x0a, 0x1e, 0x50, 0x61, 0x67, 0x65,
+	0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08,
+	0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x74, 0x72, 0x65, 0x6e, 0x64, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x22, 0xaa, 0x01, 0x0a, 0x1f, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x03, 0x6f,
+	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x6f,
+	0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x21, 0x0a,
+	0x0c, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x22, 0x5f, 0x0a, 0x14, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x75, 0x62, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x12, 0x31, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x22, 0x91, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x56, 0x32, 0x12, 0x2f, 0x0a, 0x04, 0x72,
+	0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x47, 0x0a, 0x0c,
+	0x73, 0x75, 0x62, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x75, 0x62, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xd6, 0x01, 0x0a, 0x20, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65,
+	0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x56, 0x32, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x72, 0x6f,
+	0x6f, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x56, 0x32, 0x52, 0x0c, 0x72, 0x6f, 0x6f, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78,
+	0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6e,
+	0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65,
+	0x78, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x74, 0x72, 0x65, 0x6e, 0x64,
+	0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x34,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x6f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52,
+	0x03, 0x6f, 0x69, 0x64, 0x22, 0x53, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65,
+	0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x37, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x30, 0x0a, 0x13, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba,
+	0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x22, 0x2c, 0x0a, 0x14, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2e, 0x0a, 0x18, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x03, 0x52, 0x04, 0x6f, 0x69, 0x64, 0x73, 0x22, 0xa9, 0x01, 0x0a, 0x19, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x07, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x07, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x44, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00,
+	0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x52, 0x0a, 0x1b, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x47, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x73,
+	0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x26, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x55, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x47, 0x0a, 0x18, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x6f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20,
+	0x00, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x51, 0x0a, 0x19, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x69, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x65, 0x64, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xa3, 0x02, 0x0a, 0x1d,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x63, 0x0a,
+	0x08, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x3b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f,
+	0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0a, 0xba, 0x48,
+	0x07, 0x9a, 0x01, 0x04, 0x08, 0x01, 0x10, 0x32, 0x52, 0x08, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x73, 0x1a, 0x29, 0x0a, 0x07, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x1e, 0x0a,
+	0x04, 0x6f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07,
+	0x92, 0x01, 0x04, 0x08, 0x01, 0x10, 0x32, 0x52, 0x04, 0x6f, 0x69, 0x64, 0x73, 0x1a, 0x72, 0x0a,
+	0x0d, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x4b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x35, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f,
+	0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x3e, 0x0a, 0x0c, 0x4f, 0x69, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x65,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+	0x6f, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x65,
+	0x64, 0x22, 0x44, 0x0a, 0x10, 0x4f, 0x69, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x65,
+	0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x69, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x65,
+	0x64, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0xd5, 0x01, 0x0a, 0x1e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x1a, 0x5c, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x69, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x9e, 0x02, 0x0a, 0x20, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73,
+	0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x5a, 0x0a, 0x08, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73,
+	0x1a, 0x21, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03,
+	0x69, 0x64, 0x73, 0x1a, 0x7b, 0x0a, 0x0d, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x54, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x43, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x6c, 0x69, 0x6b, 0x65, 0x64, 0x22, 0xc3, 0x02, 0x0a, 0x21, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x44, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x4c, 0x69, 0x6b, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x4c, 0x69, 0x6b, 0x65, 0x64, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x1a, 0x7e, 0x0a, 0x0c, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x58, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x31, 0x0a, 0x1d, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0xbb,
+	0x01, 0x0a, 0x1e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x09, 0x65, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0x3c,
+	0x0a, 0x0e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x32, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x22, 0x45, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x36, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22,
+	0x2a, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0xc9, 0x01, 0x0a, 0x12,
+	0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x26, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52,
+	0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x38, 0x0a,
+	0x08, 0x61, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x07,
+	0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x22, 0xcd, 0x01, 0x0a, 0x13, 0x45, 0x64, 0x69, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0e, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0c, 0x61, 0x64,
+	0x64, 0x65, 0x64, 0x41, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x47, 0x0a, 0x10, 0x72, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x41, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x65, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x04, 0x68, 0x65, 0x6c, 0x64, 0x22, 0xe9, 0x01, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x45, 0x64, 0x69, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x06, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x61, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x07, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x74,
+	0x69, 0x6d, 0x65, 0x22, 0x46, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x45, 0x64, 0x69, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00,
+	0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x5f, 0x0a, 0x1d, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x64, 0x69, 0x74, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x08,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x64, 0x69, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa8, 0x02, 0x0a,
+	0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x10, 0x0a,
+	0x03, 0x6f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x40, 0x0a, 0x0a, 0x72, 0x65, 0x73,
+	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x74, 0x69, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x75, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x49, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0xc1, 0x02, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x37, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x54, 0x0a, 0x08, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x1a, 0x58, 0x0a, 0x0d,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb8, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x26, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x4d, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x6f,
+	0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0b,
+	0xba, 0x48, 0x08, 0x82, 0x01, 0x05, 0x10, 0x01, 0x22, 0x01, 0x00, 0x52, 0x0a, 0x72, 0x65, 0x73,
+	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0b, 0x62, 0x61, 0x6e, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48,
+	0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x0a, 0x62, 0x61, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x22, 0x34, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x22, 0xc0, 0x01, 0x0a, 0x09, 0x45, 0x6d, 0x6f, 0x6a,
+	0x69, 0x50, 0x61, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x8f, 0x02, 0x0a, 0x09, 0x45,
+	0x6d, 0x6f, 0x6a, 0x69, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x63, 0x6b,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x42, 0x0a, 0x15,
+	0x4c, 0x69, 0x73, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x50, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x22, 0x49, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x50, 0x61, 0x63,
+	0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x70, 0x61,
+	0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x6f, 0x6a, 0x69,
+	0x50, 0x61, 0x63, 0x6b, 0x52, 0x05, 0x70, 0x61, 0x63, 0x6b, 0x73, 0x22, 0x5d, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x76, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x70, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x04, 0x70, 0x61, 0x63,
+	0x6b, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x22, 0x3f, 0x0a, 0x19, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x45, 0x6d,
+	0x6f, 0x6a, 0x69, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x22, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42,
+	0x0a, 0xba, 0x48, 0x07, 0x92, 0x01, 0x04, 0x08, 0x01, 0x10, 0x64, 0x52, 0x06, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x22, 0xbe, 0x01, 0x0a, 0x1a, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74,
+	0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x35, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69,
+	0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x1a,
+	0x53, 0x0a, 0x0a, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x89, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x45,
+	0x6d, 0x6f, 0x6a, 0x69, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba,
+	0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72,
+	0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x22, 0x48, 0x0a, 0x17, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x50,
+	0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x70,
+	0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x6f, 0x6a, 0x69,
+	0x50, 0x61, 0x63, 0x6b, 0x52, 0x04, 0x70, 0x61, 0x63, 0x6b, 0x22, 0xfc, 0x01, 0x0a, 0x16, 0x55,
+	0x70, 0x73, 0x65, 0x72, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x70, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x70, 0x61,
+	0x63, 0x6b, 0x12, 0x1b, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1d,
+	0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xba,
+	0x48, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x1f, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xba,
+	0x48, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f,
+	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x48, 0x0a, 0x17, 0x55, 0x70, 0x73,
+	0x65, 0x72, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69,
+	0x74, 0x65, 0x6d, 0x22, 0x94, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12, 0x2f, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4d,
+	0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x77,
+	0x6f, 0x72, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x35, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x03, 0x6f, 0x69,
+	0x64, 0x22, 0x55, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38,
+	0x0a, 0x07, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x07, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x22, 0xa8, 0x01, 0x0a, 0x1b, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x03,
+	0x6f, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x08,
+	0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x33,
+	0x0a, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x92, 0x01, 0x02,
+	0x10, 0x32, 0x52, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x22, 0x58, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x6a, 0x0a,
+	0x16, 0x4d, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x03, 0x75,
+	0x69, 0x64, 0x12, 0x35, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x75, 0x74,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x0a, 0x10, 0x4d, 0x75, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65,
+	0x22, 0x4c, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x75, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x93,
+	0x01, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x75, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x36, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x75, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e,
+	0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73,
+	0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73,
+	0x4e, 0x65, 0x78, 0x74, 0x22, 0x62, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x65, 0x6c, 0x64,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x19, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48,
+	0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8f, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73,
+	0x74, 0x48, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12,
+	0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x22, 0x64, 0x0a, 0x1a, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x48, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x03,
+	0x6f, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x92, 0x01, 0x04,
+	0x08, 0x01, 0x10, 0x32, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73,
+	0x22, 0x72, 0x0a, 0x1b, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x48, 0x65, 0x6c, 0x64, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x08, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x2a, 0x3f, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x44, 0x4f, 0x10, 0x00, 0x12, 0x15,
+	0x0a, 0x11, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x44, 0x4f, 0x10, 0x01, 0x2a, 0x39, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x45, 0x58, 0x54, 0x10, 0x00, 0x12, 0x0e,
+	0x0a, 0x0a, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x10, 0x01, 0x12, 0x10,
+	0x0a, 0x0c, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x45, 0x4d, 0x4f, 0x4a, 0x49, 0x10, 0x02,
+	0x2a, 0xaa, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x53, 0x50, 0x41, 0x4d, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x50, 0x4f,
+	0x52, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x41, 0x42, 0x55, 0x53, 0x45, 0x10,
+	0x02, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x50, 0x4f, 0x52, 0x4e, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x50,
+	0x4f, 0x52, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4c, 0x4c, 0x45, 0x47,
+	0x41, 0x4c, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0x05, 0x2a, 0x8d, 0x01,
+	0x0a, 0x10, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x45, 0x53,
+	0x4f, 0x4c, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10,
+	0x00, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x4f,
+	0x4c, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x45, 0x45, 0x50, 0x10, 0x01, 0x12, 0x1c, 0x0a,
+	0x18, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x55, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x52,
+	0x45, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x55, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x42, 0x41, 0x4e, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x10, 0x03, 0x2a, 0x23, 0x0a,
+	0x08, 0x53, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x79, 0x54,
+	0x69, 0x6d, 0x65, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x79, 0x54, 0x72, 0x65, 0x6e, 0x64,
+	0x10, 0x01, 0x2a, 0x59, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f, 0x4d, 0x4d,
+	0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x52, 0x53, 0x10, 0x02, 0x32, 0x9b, 0x14,
+	0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x53, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0a, 0x4c, 0x69,
+	0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6b,
+	0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5c, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x24, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a,
+	0x0d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0a, 0x50,
+	0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69,
+	0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x67, 0x0a, 0x0e, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12, 0x70, 0x0a, 0x11, 0x50, 0x61, 0x67,
+	0x65, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x28,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65,
+	0x74, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12, 0x6e, 0x0a, 0x13, 0x50,
+	0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x56, 0x32, 0x12, 0x2a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x56, 0x32, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x16, 0x50,
+	0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12, 0x7d, 0x0a, 0x18,
+	0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x12, 0x2f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65,
+	0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x56, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x47,
+	0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x56, 0x32, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e,
+	0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12, 0x61, 0x0a, 0x0c, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12, 0x70, 0x0a,
+	0x11, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12,
+	0x76, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69,
+	0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12, 0x7f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x2d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x73,
+	0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x6c,
+	0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12, 0x70, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x28, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73,
+	0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12, 0x7f, 0x0a, 0x16, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x2d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55,
+	0x73, 0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12, 0x80, 0x01, 0x0a, 0x19,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69,
+	0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77,
+	0x0a, 0x16, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x12, 0x2d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x25,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a,
+	0x0b, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64,
+	0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x45, 0x64, 0x69, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2c,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x64, 0x69, 0x74, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x64, 0x69, 0x74, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xe8, 0x01, 0x0a, 0x18,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6b, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x29,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x9e, 0x04, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x67,
+	0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x50, 0x61, 0x63, 0x6b, 0x73,
+	0x12, 0x25, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x50, 0x61, 0x63, 0x6b, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6d, 0x6f,
+	0x6a, 0x69, 0x50, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12, 0x61, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x45, 0x6d,
+	0x6f, 0x6a, 0x69, 0x50, 0x61, 0x63, 0x6b, 0x12, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x6f, 0x6a,
+	0x69, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12, 0x73, 0x0a, 0x12, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x49, 0x74, 0x65, 0x6d, 0x73,
+	0x12, 0x29, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x49,
+	0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12,
+	0x62, 0x0a, 0x0f, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x50, 0x61,
+	0x63, 0x6b, 0x12, 0x26, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x50,
+	0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73, 0x65,
+	0x72, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0f, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x45, 0x6d, 0x6f,
+	0x6a, 0x69, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x26, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x45, 0x6d,
+	0x6f, 0x6a, 0x69, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xa5, 0x05, 0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x68, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x14, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x2b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62,
+	0x0a, 0x0f, 0x4d, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x26, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x74, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x74, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x75, 0x74, 0x65, 0x64, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x2c, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x75, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x75, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
+	0x48, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x48, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x65, 0x6c, 0x64, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x6e, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x48, 0x65, 0x6c, 0x64, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x48,
+	0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x48, 0x65, 0x6c, 0x64, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
+	0xb7, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x72, 0x79, 0x61, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x62, 0x6f, 0x6f, 0x6b, 0x73,
+	0x2f, 0x77, 0x68, 0x69, 0x6d, 0x65, 0x72, 0x2f, 0x69, 0x64, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f,
+	0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0xa2, 0x02, 0x03, 0x43, 0x41, 0x58, 0xaa, 0x02, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x41, 0x70, 0x69, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_comment_api_v1_comment_proto_rawDescOnce sync.Once
+	file_comment_api_v1_comment_proto_rawDescData = file_comment_api_v1_comment_proto_rawDesc
+)
+
+func file_comment_api_v1_comment_proto_rawDescGZIP() []byte {
+	file_comment_api_v1_comment_proto_rawDescOnce.Do(func() {
+		file_comment_api_v1_comment_proto_rawDescData = protoimpl.X.CompressGZIP(file_comment_api_v1_comment_proto_rawDescData)
+	})
+	return file_comment_api_v1_comment_proto_rawDescData
+}
+
+var file_comment_api_v1_comment_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_comment_api_v1_comment_proto_msgTypes = make([]protoimpl.MessageInfo, 104)
+var file_comment_api_v1_comment_proto_goTypes = []any{
+	(CommentAction)(0),                            // 0: comment.api.v1.CommentAction
+	(CommentType)(0),                              // 1: comment.api.v1.CommentType
+	(ReportReason)(0),                             // 2: comment.api.v1.ReportReason
+	(ReportResolution)(0),                         // 3: comment.api.v1.ReportResolution
+	(SortType)(0),                                 // 4: comment.api.v1.SortType
+	(CommentMode)(0),                              // 5: comment.api.v1.CommentMode
+	(*CommentAtUser)(nil),                         // 6: comment.api.v1.CommentAtUser
+	(*AddCommentRequest)(nil),                     // 7: comment.api.v1.AddCommentRequest
+	(*CommentReqImage)(nil),                       // 8: comment.api.v1.CommentReqImage
+	(*AddCommentResponse)(nil),                    // 9: comment.api.v1.AddCommentResponse
+	(*DelCommentRequest)(nil),                     // 10: comment.api.v1.DelCommentRequest
+	(*DelCommentResponse)(nil),                    // 11: comment.api.v1.DelCommentResponse
+	(*LikeActionRequest)(nil),                     // 12: comment.api.v1.LikeActionRequest
+	(*LikeActionResponse)(nil),                    // 13: comment.api.v1.LikeActionResponse
+	(*DislikeActionRequest)(nil),                  // 14: comment.api.v1.DislikeActionRequest
+	(*DislikeActionResponse)(nil),                 // 15: comment.api.v1.DislikeActionResponse
+	(*ReportCommentRequest)(nil),                  // 16: comment.api.v1.ReportCommentRequest
+	(*ReportCommentResponse)(nil),                 // 17: comment.api.v1.ReportCommentResponse
+	(*PinCommentRequest)(nil),                     // 18: comment.api.v1.PinCommentRequest
+	(*PinCommentResponse)(nil),                    // 19: comment.api.v1.PinCommentResponse
+	(*PageGetCommentRequest)(nil),                 // 20: comment.api.v1.PageGetCommentRequest
+	(*CommentItem)(nil),                           // 21: comment.api.v1.CommentItem
+	(*CommentEmoji)(nil),                          // 22: comment.api.v1.CommentEmoji
+	(*CommentItemImageMeta)(nil),                  // 23: comment.api.v1.CommentItemImageMeta
+	(*CommentItemImage)(nil),                      // 24: comment.api.v1.CommentItemImage
+	(*PageGetCommentResponse)(nil),                // 25: comment.api.v1.PageGetCommentResponse
+	(*PageGetSubCommentRequest)(nil),              // 26: comment.api.v1.PageGetSubCommentRequest
+	(*PageGetSubCommentResponse)(nil),             // 27: comment.api.v1.PageGetSubCommentResponse
+	(*PageGetSubCommentV2Request)(nil),            // 28: comment.api.v1.PageGetSubCommentV2Request
+	(*PageGetSubCommentV2Response)(nil),           // 29: comment.api.v1.PageGetSubCommentV2Response
+	(*DetailedSubComment)(nil),                    // 30: comment.api.v1.DetailedSubComment
+	(*DetailedCommentItem)(nil),                   // 31: comment.api.v1.DetailedCommentItem
+	(*PageGetDetailedCommentRequest)(nil),         // 32: comment.api.v1.PageGetDetailedCommentRequest
+	(*PageGetDetailedCommentResponse)(nil),        // 33: comment.api.v1.PageGetDetailedCommentResponse
+	(*PageGetDetailedCommentV2Request)(nil),       // 34: comment.api.v1.PageGetDetailedCommentV2Request
+	(*DetailedSubCommentV2)(nil),                  // 35: comment.api.v1.DetailedSubCommentV2
+	(*DetailedCommentItemV2)(nil),                 // 36: comment.api.v1.DetailedCommentItemV2
+	(*PageGetDetailedCommentV2Response)(nil),      // 37: comment.api.v1.PageGetDetailedCommentV2Response
+	(*GetPinnedCommentRequest)(nil),               // 38: comment.api.v1.GetPinnedCommentRequest
+	(*GetPinnedCommentResponse)(nil),              // 39: comment.api.v1.GetPinnedCommentResponse
+	(*CountCommentRequest)(nil),                   // 40: comment.api.v1.CountCommentRequest
+	(*CountCommentResponse)(nil),                  // 41: comment.api.v1.CountCommentResponse
+	(*BatchCountCommentRequest)(nil),              // 42: comment.api.v1.BatchCountCommentRequest
+	(*BatchCountCommentResponse)(nil),             // 43: comment.api.v1.BatchCountCommentResponse
+	(*GetCommentLikeCountRequest)(nil),            // 44: comment.api.v1.GetCommentLikeCountRequest
+	(*GetCommentLikeCountResponse)(nil),           // 45: comment.api.v1.GetCommentLikeCountResponse
+	(*GetCommentDislikeCountRequest)(nil),         // 46: comment.api.v1.GetCommentDislikeCountRequest
+	(*GetCommentDislikeCountResponse)(nil),        // 47: comment.api.v1.GetCommentDislikeCountResponse
+	(*CheckUserOnObjectRequest)(nil),              // 48: comment.api.v1.CheckUserOnObjectRequest
+	(*CheckUserOnObjectResponse)(nil),             // 49: comment.api.v1.CheckUserOnObjectResponse
+	(*BatchCheckUserOnObjectRequest)(nil),         // 50: comment.api.v1.BatchCheckUserOnObjectRequest
+	(*OidCommented)(nil),                          // 51: comment.api.v1.OidCommented
+	(*OidCommentedList)(nil),                      // 52: comment.api.v1.OidCommentedList
+	(*BatchCheckUserOnObjectResponse)(nil),        // 53: comment.api.v1.BatchCheckUserOnObjectResponse
+	(*BatchCheckUserLikeCommentRequest)(nil),      // 54: comment.api.v1.BatchCheckUserLikeCommentRequest
+	(*CommentLiked)(nil),                          // 55: comment.api.v1.CommentLiked
+	(*BatchCheckUserLikeCommentResponse)(nil),     // 56: comment.api.v1.BatchCheckUserLikeCommentResponse
+	(*BatchCheckCommentExistRequest)(nil),         // 57: comment.api.v1.BatchCheckCommentExistRequest
+	(*BatchCheckCommentExistResponse)(nil),        // 58: comment.api.v1.BatchCheckCommentExistResponse
+	(*GetCommentRequest)(nil),                     // 59: comment.api.v1.GetCommentRequest
+	(*GetCommentResponse)(nil),                    // 60: comment.api.v1.GetCommentResponse
+	(*GetCommentUserRequest)(nil),                 // 61: comment.api.v1.GetCommentUserRequest
+	(*GetCommentUserResponse)(nil),                // 62: comment.api.v1.GetCommentUserResponse
+	(*EditCommentRequest)(nil),                    // 63: comment.api.v1.EditCommentRequest
+	(*EditCommentResponse)(nil),                   // 64: comment.api.v1.EditCommentResponse
+	(*CommentEditVersion)(nil),                    // 65: comment.api.v1.CommentEditVersion
+	(*GetCommentEditHistoryRequest)(nil),          // 66: comment.api.v1.GetCommentEditHistoryRequest
+	(*GetCommentEditHistoryResponse)(nil),         // 67: comment.api.v1.GetCommentEditHistoryResponse
+	(*CommentReport)(nil),                         // 68: comment.api.v1.CommentReport
+	(*ListPendingReportsRequest)(nil),             // 69: comment.api.v1.ListPendingReportsRequest
+	(*ListPendingReportsResponse)(nil),            // 70: comment.api.v1.ListPendingReportsResponse
+	(*ResolveReportsRequest)(nil),                 // 71: comment.api.v1.ResolveReportsRequest
+	(*ResolveReportsResponse)(nil),                // 72: comment.api.v1.ResolveReportsResponse
+	(*EmojiPack)(nil),                             // 73: comment.api.v1.EmojiPack
+	(*EmojiItem)(nil),                             // 74: comment.api.v1.EmojiItem
+	(*ListEmojiPacksRequest)(nil),                 // 75: comment.api.v1.ListEmojiPacksRequest
+	(*ListEmojiPacksResponse)(nil),                // 76: comment.api.v1.ListEmojiPacksResponse
+	(*GetEmojiPackRequest)(nil),                   // 77: comment.api.v1.GetEmojiPackRequest
+	(*GetEmojiPackResponse)(nil),                  // 78: comment.api.v1.GetEmojiPackResponse
+	(*BatchGetEmojiItemsRequest)(nil),             // 79: comment.api.v1.BatchGetEmojiItemsRequest
+	(*BatchGetEmojiItemsResponse)(nil),            // 80: comment.api.v1.BatchGetEmojiItemsResponse
+	(*UpsertEmojiPackRequest)(nil),                // 81: comment.api.v1.UpsertEmojiPackRequest
+	(*UpsertEmojiPackResponse)(nil),               // 82: comment.api.v1.UpsertEmojiPackResponse
+	(*UpsertEmojiItemRequest)(nil),                // 83: comment.api.v1.UpsertEmojiItemRequest
+	(*UpsertEmojiItemResponse)(nil),               // 84: comment.api.v1.UpsertEmojiItemResponse
+	(*CommentSetting)(nil),                        // 85: comment.api.v1.CommentSetting
+	(*GetCommentSettingRequest)(nil),              // 86: comment.api.v1.GetCommentSettingRequest
+	(*GetCommentSettingResponse)(nil),             // 87: comment.api.v1.GetCommentSettingResponse
+	(*UpdateCommentSettingRequest)(nil),           // 88: comment.api.v1.UpdateCommentSettingRequest
+	(*UpdateCommentSettingResponse)(nil),          // 89: comment.api.v1.UpdateCommentSettingResponse
+	(*MuteCommentUserRequest)(nil),                // 90: comment.api.v1.MuteCommentUserRequest
+	(*MuteCommentUserResponse)(nil),               // 91: comment.api.v1.MuteCommentUserResponse
+	(*MutedCommentUser)(nil),                      // 92: comment.api.v1.MutedCommentUser
+	(*ListMutedCommentUsersRequest)(nil),          // 93: comment.api.v1.ListMutedCommentUsersRequest
+	(*ListMutedCommentUsersResponse)(nil),         // 94: comment.api.v1.ListMutedCommentUsersResponse
+	(*ListHeldCommentsRequest)(nil),               // 95: comment.api.v1.ListHeldCommentsRequest
+	(*ListHeldCommentsResponse)(nil),              // 96: comment.api.v1.ListHeldCommentsResponse
+	(*ApproveHeldCommentsRequest)(nil),            // 97: comment.api.v1.ApproveHeldCommentsRequest
+	(*ApproveHeldCommentsResponse)(nil),           // 98: comment.api.v1.ApproveHeldCommentsResponse
+	nil,                                           // 99: comment.api.v1.BatchCountCommentResponse.NumbersEntry
+	(*BatchCheckUserOnObjectRequest_Objects)(nil), // 100: comment.api.v1.BatchCheckUserOnObjectRequest.Objects
+	nil, // 101: comment.api.v1.BatchCheckUserOnObjectRequest.MappingsEntry
+	nil, // 102: comment.api.v1.BatchCheckUserOnObjectResponse.ResultsEntry
+	(*BatchCheckUserLikeCommentRequest_CommentIdList)(nil), // 103: comment.api.v1.BatchCheckUserLikeCommentRequest.CommentIdList
+	nil, // 104: comment.api.v1.BatchCheckUserLikeCommentRequest.MappingsEntry
+	(*BatchCheckUserLikeCommentResponse_CommentLikedList)(nil), // 105: comment.api.v1.BatchCheckUserLikeCommentResponse.CommentLikedList
+	nil, // 106: comment.api.v1.BatchCheckUserLikeCommentResponse.ResultsEntry
+	nil, // 107: comment.api.v1.BatchCheckCommentExistResponse.ExistenceEntry
+	nil, // 108: comment.api.v1.ListPendingReportsResponse.CommentsEntry
+	nil, // 109: comment.api.v1.BatchGetEmojiItemsResponse.ItemsEntry
+}
+var file_comment_api_v1_comment_proto_depIdxs = []int32{
+	1,   // 0: comment.api.v1.AddCommentRequest.type:type_name -> comment.api.v1.CommentType
+	8,   // 1: comment.api.v1.AddCommentRequest.images:type_name -> comment.api.v1.CommentReqImage
+	6,   // 2: comment.api.v1.AddCommentRequest.at_users:type_name -> comment.api.v1.CommentAtUser
+	0,   // 3: comment.api.v1.LikeActionRequest.action:type_name -> comment.api.v1.CommentAction
+	0,   // 4: comment.api.v1.DislikeActionRequest.action:type_name -> comment.api.v1.CommentAction
+	2,   // 5: comment.api.v1.ReportCommentRequest.reason:type_name -> comment.api.v1.ReportReason
+	0,   // 6: comment.api.v1.PinCommentRequest.action:type_name -> comment.api.v1.CommentAction
+	4,   // 7: comment.api.v1.PageGetCommentRequest.sort_by:type_name -> comment.api.v1.SortType
+	1,   // 8: comment.api.v1.CommentItem.type:type_name -> comment.api.v1.CommentType
+	24,  // 9: comment.api.v1.CommentItem.images:type_name -> comment.api.v1.CommentItemImage
+	6,   // 10: comment.api.v1.CommentItem.at_users:type_name -> comment.api.v1.CommentAtUser
+	22,  // 11: comment.api.v1.CommentItem.emojis:type_name -> comment.api.v1.CommentEmoji
+	23,  // 12: comment.api.v1.CommentItemImage.meta:type_name -> comment.api.v1.CommentItemImageMeta
+	21,  // 13: comment.api.v1.PageGetCommentResponse.comments:type_name -> comment.api.v1.CommentItem
+	21,  // 14: comment.api.v1.PageGetSubCommentResponse.comments:type_name -> comment.api.v1.CommentItem
+	21,  // 15: comment.api.v1.PageGetSubCommentV2Response.comments:type_name -> comment.api.v1.CommentItem
+	21,  // 16: comment.api.v1.DetailedSubComment.items:type_name -> comment.api.v1.CommentItem
+	21,  // 17: comment.api.v1.DetailedCommentItem.root:type_name -> comment.api.v1.CommentItem
+	30,  // 18: comment.api.v1.DetailedCommentItem.sub_comments:type_name -> comment.api.v1.DetailedSubComment
+	4,   // 19: comment.api.v1.PageGetDetailedCommentRequest.sort_by:type_name -> comment.api.v1.SortType
+	31,  // 20: comment.api.v1.PageGetDetailedCommentResponse.comments:type_name -> comment.api.v1.DetailedCommentItem
+	4,   // 21: comment.api.v1.PageGetDetailedCommentV2Request.sort_by:type_name -> comment.api.v1.SortType
+	21,  // 22: comment.api.v1.DetailedSubCommentV2.items:type_name -> comment.api.v1.CommentItem
+	21,  // 23: comment.api.v1.DetailedCommentItemV2.root:type_name -> comment.api.v1.CommentItem
+	35,  // 24: comment.api.v1.DetailedCommentItemV2.sub_comments:type_name -> comment.api.v1.DetailedSubCommentV2
+	36,  // 25: comment.api.v1.PageGetDetailedCommentV2Response.root_comments:type_name -> comment.api.v1.DetailedCommentItemV2
+	31,  // 26: comment.api.v1.GetPinnedCommentResponse.item:type_name -> comment.api.v1.DetailedCommentItem
+	99,  // 27: comment.api.v1.BatchCountCommentResponse.numbers:type_name -> comment.api.v1.BatchCountCommentResponse.NumbersEntry
+	51,  // 28: comment.api.v1.CheckUserOnObjectResponse.result:type_name -> comment.api.v1.OidCommented
+	101, // 29: comment.api.v1.BatchCheckUserOnObjectRequest.mappings:type_name -> comment.api.v1.BatchCheckUserOnObjectRequest.MappingsEntry
+	51,  // 30: comment.api.v1.OidCommentedList.list:type_name -> comment.api.v1.OidCommented
+	102, // 31: comment.api.v1.BatchCheckUserOnObjectResponse.results:type_name -> comment.api.v1.BatchCheckUserOnObjectResponse.ResultsEntry
+	104, // 32: comment.api.v1.BatchCheckUserLikeCommentRequest.mappings:type_name -> comment.api.v1.BatchCheckUserLikeCommentRequest.MappingsEntry
+	106, // 33: comment.api.v1.BatchCheckUserLikeCommentResponse.results:type_name -> comment.api.v1.BatchCheckUserLikeCommentResponse.ResultsEntry
+	107, // 34: comment.api.v1.BatchCheckCommentExistResponse.existence:type_name -> comment.api.v1.BatchCheckCommentExistResponse.ExistenceEntry
+	21,  // 35: comment.api.v1.GetCommentResponse.item:type_name -> comment.api.v1.CommentItem
+	8,   // 36: comment.api.v1.EditCommentRequest.images:type_name -> comment.api.v1.CommentReqImage
+	6,   // 37: comment.api.v1.EditCommentRequest.at_users:type_name -> comment.api.v1.CommentAtUser
+	6,   // 38: comment.api.v1.EditCommentResponse.added_at_users:type_name -> comment.api.v1.CommentAtUser
+	6,   // 39: comment.api.v1.EditCommentResponse.removed_at_users:type_name -> comment.api.v1.CommentAtUser
+	1,   // 40: comment.api.v1.CommentEditVersion.type:type_name -> comment.api.v1.CommentType
+	24,  // 41: comment.api.v1.CommentEditVersion.images:type_name -> comment.api.v1.CommentItemImage
+	6,   // 42: comment.api.v1.CommentEditVersion.at_users:type_name -> comment.api.v1.CommentAtUser
+	65,  // 43: comment.api.v1.GetCommentEditHistoryResponse.versions:type_name -> comment.api.v1.CommentEditVersion
+	2,   // 44: comment.api.v1.CommentReport.reason:type_name -> comment.api.v1.ReportReason
+	3,   // 45: comment.api.v1.CommentReport.resolution:type_name -> comment.api.v1.ReportResolution
+	68,  // 46: comment.api.v1.ListPendingReportsResponse.reports:type_name -> comment.api.v1.CommentReport
+	108, // 47: comment.api.v1.ListPendingReportsResponse.comments:type_name -> comment.api.v1.ListPendingReportsResponse.CommentsEntry
+	3,   // 48: comment.api.v1.ResolveReportsRequest.resolution:type_name -> comment.api.v1.ReportResolution
+	73,  // 49: comment.api.v1.ListEmojiPacksResponse.packs:type_name -> comment.api.v1.EmojiPack
+	73,  // 50: comment.api.v1.GetEmojiPackResponse.pack:type_name -> comment.api.v1.EmojiPack
+	74,  // 51: comment.api.v1.GetEmojiPackResponse.items:type_name -> comment.api.v1.EmojiItem
+	109, // 52: comment.api.v1.BatchGetEmojiItemsResponse.items:type_name -> comment.api.v1.BatchGetEmojiItemsResponse.ItemsEntry
+	73,  // 53: comment.api.v1.UpsertEmojiPackResponse.pack:type_name -> comment.api.v1.EmojiPack
+	74,  // 54: comment.api.v1.UpsertEmojiItemResponse.item:type_name -> comment.api.v1.EmojiItem
+	5,   // 55: comment.api.v1.CommentSetting.mode:type_name -> comment.api.v1.CommentMode
+	85,  // 56: comment.api.v1.GetCommentSettingResponse.setting:type_name -> comment.api.v1.CommentSetting
+	5,   // 57: comment.api.v1.UpdateCommentSettingRequest.mode:type_name -> comment.api.v1.CommentMode
+	85,  // 58: comment.api.v1.UpdateCommentSettingResponse.setting:type_name -> comment.api.v1.CommentSetting
+	0,   // 59: comment.api.v1.MuteCommentUserRequest.action:type_name -> comment.api.v1.CommentAction
+	92,  // 60: comment.api.v1.ListMutedCommentUsersResponse.users:type_name -> comment.api.v1.MutedCommentUser
+	21,  // 61: comment.api.v1.ListHeldCommentsResponse.comments:type_name -> comment.api.v1.CommentItem
+	21,  // 62: comment.api.v1.ApproveHeldCommentsResponse.comments:type_name -> comment.api.v1.CommentItem
+	100, // 63: comment.api.v1.BatchCheckUserOnObjectRequest.MappingsEntry.value:type_name -> comment.api.v1.BatchCheckUserOnObjectRequest.Objects
+	52,  // 64: comment.api.v1.BatchCheckUserOnObjectResponse.ResultsEntry.value:type_name -> comment.api.v1.OidCommentedList
+	103, // 65: comment.api.v1.BatchCheckUserLikeCommentRequest.MappingsEntry.value:type_name -> comment.api.v1.BatchCheckUserLikeCommentRequest.CommentIdList
+	55,  // 66: comment.api.v1.BatchCheckUserLikeCommentResponse.CommentLikedList.list:type_name -> comment.api.v1.CommentLiked
+	105, // 67: comment.api.v1.BatchCheckUserLikeCommentResponse.ResultsEntry.value:type_name -> comment.api.v1.BatchCheckUserLikeCommentResponse.CommentLikedList
+	21,  // 68: comment.api.v1.ListPendingReportsResponse.CommentsEntry.value:type_name -> comment.api.v1.CommentItem
+	74,  // 69: comment.api.v1.BatchGetEmojiItemsResponse.ItemsEntry.value:type_name -> comment.api.v1.EmojiItem
+	7,   // 70: comment.api.v1.CommentService.AddComment:input_type -> comment.api.v1.AddCommentRequest
+	10,  // 71: comment.api.v1.CommentService.DelComment:input_type -> comment.api.v1.DelCommentRequest
+	12,  // 72: comment.api.v1.CommentService.LikeAction:input_type -> comment.api.v1.LikeActionRequest
+	14,  // 73: comment.api.v1.CommentService.DislikeAction:input_type -> comment.api.v1.DislikeActionRequest
+	16,  // 74: comment.api.v1.CommentService.ReportComment:input_type -> comment.api.v1.ReportCommentRequest
+	18,  // 75: comment.api.v1.CommentService.PinComment:input_type -> comment.api.v1.PinCommentRequest
+	20,  // 76: comment.api.v1.CommentService.PageGetComment:input_type -> comment.api.v1.PageGetCommentRequest
+	26,  // 77: comment.api.v1.CommentService.PageGetSubComment:input_type -> comment.api.v1.PageGetSubCommentRequest
+	28,  // 78: comment.api.v1.CommentService.PageGetSubCommentV2:input_type -> comment.api.v1.PageGetSubCommentV2Request
+	32,  // 79: comment.api.v1.CommentService.PageGetDetailedComment:input_type -> comment.api.v1.PageGetDetailedCommentRequest
+	34,  // 80: comment.api.v1.CommentService.PageGetDetailedCommentV2:input_type -> comment.api.v1.PageGetDetailedCommentV2Request
+	38,  // 81: comment.api.v1.CommentService.GetPinnedComment:input_type -> comment.api.v1.GetPinnedCommentRequest
+	40,  // 82: comment.api.v1.CommentService.CountComment:input_type -> comment.api.v1.CountCommentRequest
+	42,  // 83: comment.api.v1.CommentService.BatchCountComment:input_type -> comment.api.v1.BatchCountCommentRequest
+	44,  // 84: comment.api.v1.CommentService.GetCommentLikeCount:input_type -> comment.api.v1.GetCommentLikeCountRequest
+	46,  // 85: comment.api.v1.CommentService.GetCommentDislikeCount:input_type -> comment.api.v1.GetCommentDislikeCountRequest
+	48,  // 86: comment.api.v1.CommentService.CheckUserOnObject:input_type -> comment.api.v1.CheckUserOnObjectRequest
+	50,  // 87: comment.api.v1.CommentService.BatchCheckUserOnObject:input_type -> comment.api.v1.BatchCheckUserOnObjectRequest
+	54,  // 88: comment.api.v1.CommentService.BatchCheckUserLikeComment:input_type -> comment.api.v1.BatchCheckUserLikeCommentRequest
+	57,  // 89: comment.api.v1.CommentService.BatchCheckCommentExist:input_type -> comment.api.v1.BatchCheckCommentExistRequest
+	59,  // 90: comment.api.v1.CommentService.GetComment:input_type -> comment.api.v1.GetCommentRequest
+	61,  // 91: comment.api.v1.CommentService.GetCommentUser:input_type -> comment.api.v1.GetCommentUserRequest
+	63,  // 92: comment.api.v1.CommentService.EditComment:input_type -> comment.api.v1.EditCommentRequest
+	66,  // 93: comment.api.v1.CommentService.GetCommentEditHistory:input_type -> comment.api.v1.GetCommentEditHistoryRequest
+	69,  // 94: comment.api.v1.CommentModerationService.ListPendingReports:input_type -> comment.api.v1.ListPendingReportsRequest
+	71,  // 95: comment.api.v1.CommentModerationService.ResolveReports:input_type -> comment.api.v1.ResolveReportsRequest
+	75,  // 96: comment.api.v1.CommentEmojiService.ListEmojiPacks:input_type -> comment.api.v1.ListEmojiPacksRequest
+	77,  // 97: comment.api.v1.CommentEmojiService.GetEmojiPack:input_type -> comment.api.v1.GetEmojiPackRequest
+	79,  // 98: comment.api.v1.CommentEmojiService.BatchGetEmojiItems:input_type -> comment.api.v1.BatchGetEmojiItemsRequest
+	81,  // 99: comment.api.v1.CommentEmojiService.UpsertEmojiPack:input_type -> comment.api.v1.UpsertEmojiPackRequest
+	83,  // 100: comment.api.v1.CommentEmojiService.UpsertEmojiItem:input_type -> comment.api.v1.UpsertEmojiItemRequest
+	86,  // 101: comment.api.v1.CommentSettingService.GetCommentSetting:input_type -> comment.api.v1.GetCommentSettingRequest
+	88,  // 102: comment.api.v1.CommentSettingService.UpdateCommentSetting:input_type -> comment.api.v1.UpdateCommentSettingRequest
+	90,  // 103: comment.api.v1.CommentSettingService.MuteCommentUser:input_type -> comment.api.v1.MuteCommentUserRequest
+	93,  // 104: comment.api.v1.CommentSettingService.ListMutedCommentUsers:input_type -> comment.api.v1.ListMutedCommentUsersRequest
+	95,  // 105: comment.api.v1.CommentSettingService.ListHeldComments:input_type -> comment.api.v1.ListHeldCommentsRequest
+	97,  // 106: comment.api.v1.CommentSettingService.ApproveHeldComments:input_type -> comment.api.v1.ApproveHeldCommentsRequest
+	9,   // 107: comment.api.v1.CommentService.AddComment:output_type -> comment.api.v1.AddCommentResponse
+	11,  // 108: comment.api.v1.CommentService.DelComment:output_type -> comment.api.v1.DelCommentResponse
+	13,  // 109: comment.api.v1.CommentService.LikeAction:output_type -> comment.api.v1.LikeActionResponse
+	15,  // 110: comment.api.v1.CommentService.DislikeAction:output_type -> comment.api.v1.DislikeActionResponse
+	17,  // 111: comment.api.v1.CommentService.ReportComment:output_type -> comment.api.v1.ReportCommentResponse
+	19,  // 112: comment.api.v1.CommentService.PinComment:output_type -> comment.api.v1.PinCommentResponse
+	25,  // 113: comment.api.v1.CommentService.PageGetComment:output_type -> comment.api.v1.PageGetCommentResponse
+	27,  // 114: comment.api.v1.CommentService.PageGetSubComment:output_type -> comment.api.v1.PageGetSubCommentResponse
+	29,  // 115: comment.api.v1.CommentService.PageGetSubCommentV2:output_type -> comment.api.v1.PageGetSubCommentV2Response
+	33,  // 116: comment.api.v1.CommentService.PageGetDetailedComment:output_type -> comment.api.v1.PageGetDetailedCommentResponse
+	37,  // 117: comment.api.v1.CommentService.PageGetDetailedCommentV2:output_type -> comment.api.v1.PageGetDetailedCommentV2Response
+	39,  // 118: comment.api.v1.CommentService.GetPinnedComment:output_type -> comment.api.v1.GetPinnedCommentResponse
+	41,  // 119: comment.api.v1.CommentService.CountComment:output_type -> comment.api.v1.CountCommentResponse
+	43,  // 120: comment.api.v1.CommentService.BatchCountComment:output_type -> comment.api.v1.BatchCountCommentResponse
+	45,  // 121: comment.api.v1.CommentService.GetCommentLikeCount:output_type -> comment.api.v1.GetCommentLikeCountResponse
+	47,  // 122: comment.api.v1.CommentService.GetCommentDislikeCount:output_type -> comment.api.v1.GetCommentDislikeCountResponse
+	49,  // 123: comment.api.v1.CommentService.CheckUserOnObject:output_type -> comment.api.v1.CheckUserOnObjectResponse
+	53,  // 124: comment.api.v1.CommentService.BatchCheckUserOnObject:output_type -> comment.api.v1.BatchCheckUserOnObjectResponse
+	56,  // 125: comment.api.v1.CommentService.BatchCheckUserLikeComment:output_type -> comment.api.v1.BatchCheckUserLikeCommentResponse
+	58,  // 126: comment.api.v1.CommentService.BatchCheckCommentExist:output_type -> comment.api.v1.BatchCheckCommentExistResponse
+	60,  // 127: comment.api.v1.CommentService.GetComment:output_type -> comment.api.v1.GetCommentResponse
+	62,  // 128: comment.api.v1.CommentService.GetCommentUser:output_type -> comment.api.v1.GetCommentUserResponse
+	64,  // 129: comment.api.v1.CommentService.EditComment:output_type -> comment.api.v1.EditCommentResponse
+	67,  // 130: comment.api.v1.CommentService.GetCommentEditHistory:output_type -> comment.api.v1.GetCommentEditHistoryResponse
+	70,  // 131: comment.api.v1.CommentModerationService.ListPendingReports:output_type -> comment.api.v1.ListPendingReportsResponse
+	72,  // 132: comment.api.v1.CommentModerationService.ResolveReports:output_type -> comment.api.v1.ResolveReportsResponse
+	76,  // 133: comment.api.v1.CommentEmojiService.ListEmojiPacks:output_type -> comment.api.v1.ListEmojiPacksResponse
+	78,  // 134: comment.api.v1.CommentEmojiService.GetEmojiPack:output_type -> comment.api.v1.GetEmojiPackResponse
+	80,  // 135: comment.api.v1.CommentEmojiService.BatchGetEmojiItems:output_type -> comment.api.v1.BatchGetEmojiItemsResponse
+	82,  // 136: comment.api.v1.CommentEmojiService.UpsertEmojiPack:output_type -> comment.api.v1.UpsertEmojiPackResponse
+	84,  // 137: comment.api.v1.CommentEmojiService.UpsertEmojiItem:output_type -> comment.api.v1.UpsertEmojiItemResponse
+	87,  // 138: comment.api.v1.CommentSettingService.GetCommentSetting:output_type -> comment.api.v1.GetCommentSettingResponse
+	89,  // 139: comment.api.v1.CommentSettingService.UpdateCommentSetting:output_type -> comment.api.v1.UpdateCommentSettingResponse
+	91,  // 140: comment.api.v1.CommentSettingService.MuteCommentUser:output_type -> comment.api.v1.MuteCommentUserResponse
+	94,  // 141: comment.api.v1.CommentSettingService.ListMutedCommentUsers:output_type -> comment.api.v1.ListMutedCommentUsersResponse
+	96,  // 142: comment.api.v1.CommentSettingService.ListHeldComments:output_type -> comment.api.v1.ListHeldCommentsResponse
+	98,  // 143: comment.api.v1.CommentSettingService.ApproveHeldComments:output_type -> comment.api.v1.ApproveHeldCommentsResponse
+	107, // [107:144] is the sub-list for method output_type
+	70,  // [70:107] is the sub-list for method input_type
+	70,  // [70:70] is the sub-list for extension type_name
+	70,  // [70:70] is the sub-list for extension extendee
+	0,   // [0:70] is the sub-list for field type_name
+}
+
+func init() { file_comment_api_v1_comment_proto_init() }
+func file_comment_api_v1_comment_proto_init() {
+	if File_comment_api_v1_comment_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_comment_api_v1_comment_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*CommentAtUser); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*AddCommentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*CommentReqImage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*AddCommentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*DelCommentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*DelCommentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*LikeActionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*LikeActionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*DislikeActionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*DislikeActionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*ReportCommentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*ReportCommentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*PinCommentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*PinCommentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*PageGetCommentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*CommentItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*CommentEmoji); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*CommentItemImageMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*CommentItemImage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*PageGetCommentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*PageGetSubCommentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*PageGetSubCommentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*PageGetSubCommentV2Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*PageGetSubCommentV2Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*DetailedSubComment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*DetailedCommentItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*PageGetDetailedCommentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*PageGetDetailedCommentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*PageGetDetailedCommentV2Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*DetailedSubCommentV2); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4212,8 +7765,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*AddCommentRequest); i {
+		file_comment_api_v1_comment_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*DetailedCommentItemV2); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4224,8 +7777,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*CommentReqImage); i {
+		file_comment_api_v1_comment_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*PageGetDetailedCommentV2Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4236,8 +7789,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*AddCommentResponse); i {
+		file_comment_api_v1_comment_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPinnedCommentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4248,8 +7801,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*DelCommentRequest); i {
+		file_comment_api_v1_comment_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPinnedCommentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4260,8 +7813,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*DelCommentResponse); i {
+		file_comment_api_v1_comment_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*CountCommentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4272,8 +7825,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*LikeActionRequest); i {
+		file_comment_api_v1_comment_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*CountCommentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4284,8 +7837,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*LikeActionResponse); i {
+		file_comment_api_v1_comment_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchCountCommentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4296,8 +7849,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*DislikeActionRequest); i {
+		file_comment_api_v1_comment_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchCountCommentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4308,8 +7861,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*DislikeActionResponse); i {
+		file_comment_api_v1_comment_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*GetCommentLikeCountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4320,8 +7873,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*ReportCommentRequest); i {
+		file_comment_api_v1_comment_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*GetCommentLikeCountResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4332,8 +7885,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*ReportCommentResponse); i {
+		file_comment_api_v1_comment_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*GetCommentDislikeCountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4344,8 +7897,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*PinCommentRequest); i {
+		file_comment_api_v1_comment_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*GetCommentDislikeCountResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4356,8 +7909,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*PinCommentResponse); i {
+		file_comment_api_v1_comment_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*CheckUserOnObjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4368,8 +7921,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*PageGetCommentRequest); i {
+		file_comment_api_v1_comment_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*CheckUserOnObjectResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4380,8 +7933,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*CommentItem); i {
+		file_comment_api_v1_comment_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchCheckUserOnObjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4392,8 +7945,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*CommentItemImageMeta); i {
+		file_comment_api_v1_comment_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*OidCommented); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4404,8 +7957,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*CommentItemImage); i {
+		file_comment_api_v1_comment_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*OidCommentedList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4416,8 +7969,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*PageGetCommentResponse); i {
+		file_comment_api_v1_comment_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchCheckUserOnObjectResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4428,8 +7981,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*PageGetSubCommentRequest); i {
+		file_comment_api_v1_comment_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchCheckUserLikeCommentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4440,8 +7993,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[20].Exporter = func(v any, i int) any {
-			switch v := v.(*PageGetSubCommentResponse); i {
+		file_comment_api_v1_comment_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*CommentLiked); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4452,8 +8005,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[21].Exporter = func(v any, i int) any {
-			switch v := v.(*PageGetSubCommentV2Request); i {
+		file_comment_api_v1_comment_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchCheckUserLikeCommentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4464,8 +8017,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[22].Exporter = func(v any, i int) any {
-			switch v := v.(*PageGetSubCommentV2Response); i {
+		file_comment_api_v1_comment_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchCheckCommentExistRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4476,8 +8029,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[23].Exporter = func(v any, i int) any {
-			switch v := v.(*DetailedSubComment); i {
+		file_comment_api_v1_comment_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchCheckCommentExistResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4488,8 +8041,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[24].Exporter = func(v any, i int) any {
-			switch v := v.(*DetailedCommentItem); i {
+		file_comment_api_v1_comment_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*GetCommentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4500,8 +8053,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[25].Exporter = func(v any, i int) any {
-			switch v := v.(*PageGetDetailedCommentRequest); i {
+		file_comment_api_v1_comment_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*GetCommentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4512,8 +8065,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[26].Exporter = func(v any, i int) any {
-			switch v := v.(*PageGetDetailedCommentResponse); i {
+		file_comment_api_v1_comment_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*GetCommentUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4524,8 +8077,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[27].Exporter = func(v any, i int) any {
-			switch v := v.(*PageGetDetailedCommentV2Request); i {
+		file_comment_api_v1_comment_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*GetCommentUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4536,8 +8089,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[28].Exporter = func(v any, i int) any {
-			switch v := v.(*DetailedSubCommentV2); i {
+		file_comment_api_v1_comment_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*EditCommentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4548,8 +8101,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[29].Exporter = func(v any, i int) any {
-			switch v := v.(*DetailedCommentItemV2); i {
+		file_comment_api_v1_comment_proto_msgTypes[58].Exporter = func(v any, i int) any {
+			switch v := v.(*EditCommentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4560,8 +8113,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[30].Exporter = func(v any, i int) any {
-			switch v := v.(*PageGetDetailedCommentV2Response); i {
+		file_comment_api_v1_comment_proto_msgTypes[59].Exporter = func(v any, i int) any {
+			switch v := v.(*CommentEditVersion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4572,8 +8125,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[31].Exporter = func(v any, i int) any {
-			switch v := v.(*GetPinnedCommentRequest); i {
+		file_comment_api_v1_comment_proto_msgTypes[60].Exporter = func(v any, i int) any {
+			switch v := v.(*GetCommentEditHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4584,8 +8137,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[32].Exporter = func(v any, i int) any {
-			switch v := v.(*GetPinnedCommentResponse); i {
+		file_comment_api_v1_comment_proto_msgTypes[61].Exporter = func(v any, i int) any {
+			switch v := v.(*GetCommentEditHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4596,8 +8149,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[33].Exporter = func(v any, i int) any {
-			switch v := v.(*CountCommentRequest); i {
+		file_comment_api_v1_comment_proto_msgTypes[62].Exporter = func(v any, i int) any {
+			switch v := v.(*CommentReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4608,8 +8161,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[34].Exporter = func(v any, i int) any {
-			switch v := v.(*CountCommentResponse); i {
+		file_comment_api_v1_comment_proto_msgTypes[63].Exporter = func(v any, i int) any {
+			switch v := v.(*ListPendingReportsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4620,8 +8173,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[35].Exporter = func(v any, i int) any {
-			switch v := v.(*BatchCountCommentRequest); i {
+		file_comment_api_v1_comment_proto_msgTypes[64].Exporter = func(v any, i int) any {
+			switch v := v.(*ListPendingReportsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4632,8 +8185,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[36].Exporter = func(v any, i int) any {
-			switch v := v.(*BatchCountCommentResponse); i {
+		file_comment_api_v1_comment_proto_msgTypes[65].Exporter = func(v any, i int) any {
+			switch v := v.(*ResolveReportsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4644,8 +8197,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[37].Exporter = func(v any, i int) any {
-			switch v := v.(*GetCommentLikeCountRequest); i {
+		file_comment_api_v1_comment_proto_msgTypes[66].Exporter = func(v any, i int) any {
+			switch v := v.(*ResolveReportsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4656,8 +8209,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[38].Exporter = func(v any, i int) any {
-			switch v := v.(*GetCommentLikeCountResponse); i {
+		file_comment_api_v1_comment_proto_msgTypes[67].Exporter = func(v any, i int) any {
+			switch v := v.(*EmojiPack); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4668,8 +8221,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[39].Exporter = func(v any, i int) any {
-			switch v := v.(*GetCommentDislikeCountRequest); i {
+		file_comment_api_v1_comment_proto_msgTypes[68].Exporter = func(v any, i int) any {
+			switch v := v.(*EmojiItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4680,8 +8233,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[40].Exporter = func(v any, i int) any {
-			switch v := v.(*GetCommentDislikeCountResponse); i {
+		file_comment_api_v1_comment_proto_msgTypes[69].Exporter = func(v any, i int) any {
+			switch v := v.(*ListEmojiPacksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4692,8 +8245,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[41].Exporter = func(v any, i int) any {
-			switch v := v.(*CheckUserOnObjectRequest); i {
+		file_comment_api_v1_comment_proto_msgTypes[70].Exporter = func(v any, i int) any {
+			switch v := v.(*ListEmojiPacksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4704,8 +8257,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[42].Exporter = func(v any, i int) any {
-			switch v := v.(*CheckUserOnObjectResponse); i {
+		file_comment_api_v1_comment_proto_msgTypes[71].Exporter = func(v any, i int) any {
+			switch v := v.(*GetEmojiPackRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4716,8 +8269,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[43].Exporter = func(v any, i int) any {
-			switch v := v.(*BatchCheckUserOnObjectRequest); i {
+		file_comment_api_v1_comment_proto_msgTypes[72].Exporter = func(v any, i int) any {
+			switch v := v.(*GetEmojiPackResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4728,8 +8281,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[44].Exporter = func(v any, i int) any {
-			switch v := v.(*OidCommented); i {
+		file_comment_api_v1_comment_proto_msgTypes[73].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchGetEmojiItemsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4740,8 +8293,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[45].Exporter = func(v any, i int) any {
-			switch v := v.(*OidCommentedList); i {
+		file_comment_api_v1_comment_proto_msgTypes[74].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchGetEmojiItemsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4752,8 +8305,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[46].Exporter = func(v any, i int) any {
-			switch v := v.(*BatchCheckUserOnObjectResponse); i {
+		file_comment_api_v1_comment_proto_msgTypes[75].Exporter = func(v any, i int) any {
+			switch v := v.(*UpsertEmojiPackRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4764,8 +8317,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[47].Exporter = func(v any, i int) any {
-			switch v := v.(*BatchCheckUserLikeCommentRequest); i {
+		file_comment_api_v1_comment_proto_msgTypes[76].Exporter = func(v any, i int) any {
+			switch v := v.(*UpsertEmojiPackResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4776,8 +8329,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[48].Exporter = func(v any, i int) any {
-			switch v := v.(*CommentLiked); i {
+		file_comment_api_v1_comment_proto_msgTypes[77].Exporter = func(v any, i int) any {
+			switch v := v.(*UpsertEmojiItemRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4788,8 +8341,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[49].Exporter = func(v any, i int) any {
-			switch v := v.(*BatchCheckUserLikeCommentResponse); i {
+		file_comment_api_v1_comment_proto_msgTypes[78].Exporter = func(v any, i int) any {
+			switch v := v.(*UpsertEmojiItemResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4800,8 +8353,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[50].Exporter = func(v any, i int) any {
-			switch v := v.(*BatchCheckCommentExistRequest); i {
+		file_comment_api_v1_comment_proto_msgTypes[79].Exporter = func(v any, i int) any {
+			switch v := v.(*CommentSetting); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4812,8 +8365,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[51].Exporter = func(v any, i int) any {
-			switch v := v.(*BatchCheckCommentExistResponse); i {
+		file_comment_api_v1_comment_proto_msgTypes[80].Exporter = func(v any, i int) any {
+			switch v := v.(*GetCommentSettingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4824,8 +8377,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[52].Exporter = func(v any, i int) any {
-			switch v := v.(*GetCommentRequest); i {
+		file_comment_api_v1_comment_proto_msgTypes[81].Exporter = func(v any, i int) any {
+			switch v := v.(*GetCommentSettingResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4836,8 +8389,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[53].Exporter = func(v any, i int) any {
-			switch v := v.(*GetCommentResponse); i {
+		file_comment_api_v1_comment_proto_msgTypes[82].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateCommentSettingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4848,8 +8401,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[54].Exporter = func(v any, i int) any {
-			switch v := v.(*GetCommentUserRequest); i {
+		file_comment_api_v1_comment_proto_msgTypes[83].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateCommentSettingResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4860,8 +8413,8 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[55].Exporter = func(v any, i int) any {
-			switch v := v.(*GetCommentUserResponse); i {
+		file_comment_api_v1_comment_proto_msgTypes[84].Exporter = func(v any, i int) any {
+			switch v := v.(*MuteCommentUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4872,7 +8425,103 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[57].Exporter = func(v any, i int) any {
+		file_comment_api_v1_comment_proto_msgTypes[85].Exporter = func(v any, i int) any {
+			switch v := v.(*MuteCommentUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[86].Exporter = func(v any, i int) any {
+			switch v := v.(*MutedCommentUser); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[87].Exporter = func(v any, i int) any {
+			switch v := v.(*ListMutedCommentUsersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[88].Exporter = func(v any, i int) any {
+			switch v := v.(*ListMutedCommentUsersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[89].Exporter = func(v any, i int) any {
+			switch v := v.(*ListHeldCommentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[90].Exporter = func(v any, i int) any {
+			switch v := v.(*ListHeldCommentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[91].Exporter = func(v any, i int) any {
+			switch v := v.(*ApproveHeldCommentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[92].Exporter = func(v any, i int) any {
+			switch v := v.(*ApproveHeldCommentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comment_api_v1_comment_proto_msgTypes[94].Exporter = func(v any, i int) any {
 			switch v := v.(*BatchCheckUserOnObjectRequest_Objects); i {
 			case 0:
 				return &v.state
@@ -4884,7 +8533,7 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[60].Exporter = func(v any, i int) any {
+		file_comment_api_v1_comment_proto_msgTypes[97].Exporter = func(v any, i int) any {
 			switch v := v.(*BatchCheckUserLikeCommentRequest_CommentIdList); i {
 			case 0:
 				return &v.state
@@ -4896,7 +8545,7 @@ func file_comment_api_v1_comment_proto_init() {
 				return nil
 			}
 		}
-		file_comment_api_v1_comment_proto_msgTypes[62].Exporter = func(v any, i int) any {
+		file_comment_api_v1_comment_proto_msgTypes[99].Exporter = func(v any, i int) any {
 			switch v := v.(*BatchCheckUserLikeCommentResponse_CommentLikedList); i {
 			case 0:
 				return &v.state
@@ -4914,10 +8563,10 @@ func file_comment_api_v1_comment_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_comment_api_v1_comment_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   65,
+			NumEnums:      6,
+			NumMessages:   104,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   4,
 		},
 		GoTypes:           file_comment_api_v1_comment_proto_goTypes,
 		DependencyIndexes: file_comment_api_v1_comment_proto_depIdxs,
@@ -41,6 +41,8 @@ const (
 	CommentService_BatchCheckCommentExist_FullMethodName    = "/comment.api.v1.CommentService/BatchCheckCommentExist"
 	CommentService_GetComment_FullMethodName                = "/comment.api.v1.CommentService/GetComment"
 	CommentService_GetCommentUser_FullMethodName            = "/comment.api.v1.CommentService/GetCommentUser"
+	CommentService_EditComment_FullMethodName               = "/comment.api.v1.CommentService/EditComment"
+	CommentService_GetCommentEditHistory_FullMethodName     = "/comment.api.v1.CommentService/GetCommentEditHistory"
 )
 
 // CommentServiceClient is the client API for CommentService service.
@@ -90,6 +92,10 @@ type CommentServiceClient interface {
 	GetComment(ctx context.Context, in *GetCommentRequest, opts ...grpc.CallOption) (*GetCommentResponse, error)
 	// 获取评论作者
 	GetCommentUser(ctx context.Context, in *GetCommentUserRequest, opts ...grpc.CallOption) (*GetCommentUserResponse, error)
+	// 编辑评论
+	EditComment(ctx context.Context, in *EditCommentRequest, opts ...grpc.CallOption) (*EditCommentResponse, error)
+	// 获取评论的编辑历史
+	GetCommentEditHistory(ctx context.Context, in *GetCommentEditHistoryRequest, opts ...grpc.CallOption) (*GetCommentEditHistoryResponse, error)
 }
 
 type commentServiceClient struct {
@@ -320,6 +326,26 @@ func (c *commentServiceClient) GetCommentUser(ctx context.Context, in *GetCommen
 	return out, nil
 }
 
+func (c *commentServiceClient) EditComment(ctx context.Context, in *EditCommentRequest, opts ...grpc.CallOption) (*EditCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditCommentResponse)
+	err := c.cc.Invoke(ctx, CommentService_EditComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) GetCommentEditHistory(ctx context.Context, in *GetCommentEditHistoryRequest, opts ...grpc.CallOption) (*GetCommentEditHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommentEditHistoryResponse)
+	err := c.cc.Invoke(ctx, CommentService_GetCommentEditHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CommentServiceServer is the server API for CommentService service.
 // All implementations must embed UnimplementedCommentServiceServer
 // for forward compatibility.
@@ -367,6 +393,10 @@ type CommentServiceServer interface {
 	GetComment(context.Context, *GetCommentRequest) (*GetCommentResponse, error)
 	// 获取评论作者
 	GetCommentUser(context.Context, *GetCommentUserRequest) (*GetCommentUserResponse, error)
+	// 编辑评论
+	EditComment(context.Context, *EditCommentRequest) (*EditCommentResponse, error)
+	// 获取评论的编辑历史
+	GetCommentEditHistory(context.Context, *GetCommentEditHistoryRequest) (*GetCommentEditHistoryResponse, error)
 	mustEmbedUnimplementedCommentServiceServer()
 }
 
@@ -443,6 +473,12 @@ func (UnimplementedCommentServiceServer) GetComment(context.Context, *GetComment
 func (UnimplementedCommentServiceServer) GetCommentUser(context.Context, *GetCommentUserRequest) (*GetCommentUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCommentUser not implemented")
 }
+func (UnimplementedCommentServiceServer) EditComment(context.Context, *EditCommentRequest) (*EditCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditComment not implemented")
+}
+func (UnimplementedCommentServiceServer) GetCommentEditHistory(context.Context, *GetCommentEditHistoryRequest) (*GetCommentEditHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommentEditHistory not implemented")
+}
 func (UnimplementedCommentServiceServer) mustEmbedUnimplementedCommentServiceServer() {}
 func (UnimplementedCommentServiceServer) testEmbeddedByValue()                        {}
 
@@ -860,6 +896,42 @@ func _CommentService_GetCommentUser_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CommentService_EditComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).EditComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_EditComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).EditComment(ctx, req.(*EditCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_GetCommentEditHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentEditHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).GetCommentEditHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_GetCommentEditHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).GetCommentEditHistory(ctx, req.(*GetCommentEditHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CommentService_ServiceDesc is the grpc.ServiceDesc for CommentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -955,6 +1027,739 @@ var CommentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCommentUser",
 			Handler:    _CommentService_GetCommentUser_Handler,
 		},
+		{
+			MethodName: "EditComment",
+			Handler:    _CommentService_EditComment_Handler,
+		},
+		{
+			MethodName: "GetCommentEditHistory",
+			Handler:    _CommentService_GetCommentEditHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "comment/api/v1/comment.proto",
+}
+
+const (
+	CommentModerationService_ListPendingReports_FullMethodName = "/comment.api.v1.CommentModerationService/ListPendingReports"
+	CommentModerationService_ResolveReports_FullMethodName     = "/comment.api.v1.CommentModerationService/ResolveReports"
+)
+
+// CommentModerationServiceClient is the client API for CommentModerationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 评论举报审核
+type CommentModerationServiceClient interface {
+	// 获取待处理的举报
+	ListPendingReports(ctx context.Context, in *ListPendingReportsRequest, opts ...grpc.CallOption) (*ListPendingReportsResponse, error)
+	// 处理举报
+	ResolveReports(ctx context.Context, in *ResolveReportsRequest, opts ...grpc.CallOption) (*ResolveReportsResponse, error)
+}
+
+type commentModerationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCommentModerationServiceClient(cc grpc.ClientConnInterface) CommentModerationServiceClient {
+	return &commentModerationServiceClient{cc}
+}
+
+func (c *commentModerationServiceClient) ListPendingReports(ctx context.Context, in *ListPendingReportsRequest, opts ...grpc.CallOption) (*ListPendingReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPendingReportsResponse)
+	err := c.cc.Invoke(ctx, CommentModerationService_ListPendingReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentModerationServiceClient) ResolveReports(ctx context.Context, in *ResolveReportsRequest, opts ...grpc.CallOption) (*ResolveReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveReportsResponse)
+	err := c.cc.Invoke(ctx, CommentModerationService_ResolveReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CommentModerationServiceServer is the server API for CommentModerationService service.
+// All implementations must embed UnimplementedCommentModerationServiceServer
+// for forward compatibility.
+//
+// 评论举报审核
+type CommentModerationServiceServer interface {
+	// 获取待处理的举报
+	ListPendingReports(context.Context, *ListPendingReportsRequest) (*ListPendingReportsResponse, error)
+	// 处理举报
+	ResolveReports(context.Context, *ResolveReportsRequest) (*ResolveReportsResponse, error)
+	mustEmbedUnimplementedCommentModerationServiceServer()
+}
+
+// UnimplementedCommentModerationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCommentModerationServiceServer struct{}
+
+func (UnimplementedCommentModerationServiceServer) ListPendingReports(context.Context, *ListPendingReportsRequest) (*ListPendingReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingReports not implemented")
+}
+func (UnimplementedCommentModerationServiceServer) ResolveReports(context.Context, *ResolveReportsRequest) (*ResolveReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveReports not implemented")
+}
+func (UnimplementedCommentModerationServiceServer) mustEmbedUnimplementedCommentModerationServiceServer() {
+}
+func (UnimplementedCommentModerationServiceServer) testEmbeddedByValue() {}
+
+// UnsafeCommentModerationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CommentModerationServiceServer will
+// result in compilation errors.
+type UnsafeCommentModerationServiceServer interface {
+	mustEmbedUnimplementedCommentModerationServiceServer()
+}
+
+func RegisterCommentModerationServiceServer(s grpc.ServiceRegistrar, srv CommentModerationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCommentModerationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CommentModerationService_ServiceDesc, srv)
+}
+
+func _CommentModerationService_ListPendingReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentModerationServiceServer).ListPendingReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentModerationService_ListPendingReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentModerationServiceServer).ListPendingReports(ctx, req.(*ListPendingReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentModerationService_ResolveReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentModerationServiceServer).ResolveReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentModerationService_ResolveReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentModerationServiceServer).ResolveReports(ctx, req.(*ResolveReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CommentModerationService_ServiceDesc is the grpc.ServiceDesc for CommentModerationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CommentModerationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "comment.api.v1.CommentModerationService",
+	HandlerType: (*CommentModerationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListPendingReports",
+			Handler:    _CommentModerationService_ListPendingReports_Handler,
+		},
+		{
+			MethodName: "ResolveReports",
+			Handler:    _CommentModerationService_ResolveReports_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "comment/api/v1/comment.proto",
+}
+
+const (
+	CommentEmojiService_ListEmojiPacks_FullMethodName     = "/comment.api.v1.CommentEmojiService/ListEmojiPacks"
+	CommentEmojiService_GetEmojiPack_FullMethodName       = "/comment.api.v1.CommentEmojiService/GetEmojiPack"
+	CommentEmojiService_BatchGetEmojiItems_FullMethodName = "/comment.api.v1.CommentEmojiService/BatchGetEmojiItems"
+	CommentEmojiService_UpsertEmojiPack_FullMethodName    = "/comment.api.v1.CommentEmojiService/UpsertEmojiPack"
+	CommentEmojiService_UpsertEmojiItem_FullMethodName    = "/comment.api.v1.CommentEmojiService/UpsertEmojiItem"
+)
+
+// CommentEmojiServiceClient is the client API for CommentEmojiService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 评论表情包
+type CommentEmojiServiceClient interface {
+	// 列出表情包
+	ListEmojiPacks(ctx context.Context, in *ListEmojiPacksRequest, opts ...grpc.CallOption) (*ListEmojiPacksResponse, error)
+	// 获取表情包及其表情
+	GetEmojiPack(ctx context.Context, in *GetEmojiPackRequest, opts ...grpc.CallOption) (*GetEmojiPackResponse, error)
+	// 批量查找表情
+	BatchGetEmojiItems(ctx context.Context, in *BatchGetEmojiItemsRequest, opts ...grpc.CallOption) (*BatchGetEmojiItemsResponse, error)
+	// 新增或者更新表情包
+	UpsertEmojiPack(ctx context.Context, in *UpsertEmojiPackRequest, opts ...grpc.CallOption) (*UpsertEmojiPackResponse, error)
+	// 新增或者更新表情包中的表情
+	UpsertEmojiItem(ctx context.Context, in *UpsertEmojiItemRequest, opts ...grpc.CallOption) (*UpsertEmojiItemResponse, error)
+}
+
+type commentEmojiServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCommentEmojiServiceClient(cc grpc.ClientConnInterface) CommentEmojiServiceClient {
+	return &commentEmojiServiceClient{cc}
+}
+
+func (c *commentEmojiServiceClient) ListEmojiPacks(ctx context.Context, in *ListEmojiPacksRequest, opts ...grpc.CallOption) (*ListEmojiPacksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEmojiPacksResponse)
+	err := c.cc.Invoke(ctx, CommentEmojiService_ListEmojiPacks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentEmojiServiceClient) GetEmojiPack(ctx context.Context, in *GetEmojiPackRequest, opts ...grpc.CallOption) (*GetEmojiPackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEmojiPackResponse)
+	err := c.cc.Invoke(ctx, CommentEmojiService_GetEmojiPack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentEmojiServiceClient) BatchGetEmojiItems(ctx context.Context, in *BatchGetEmojiItemsRequest, opts ...grpc.CallOption) (*BatchGetEmojiItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetEmojiItemsResponse)
+	err := c.cc.Invoke(ctx, CommentEmojiService_BatchGetEmojiItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentEmojiServiceClient) UpsertEmojiPack(ctx context.Context, in *UpsertEmojiPackRequest, opts ...grpc.CallOption) (*UpsertEmojiPackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpsertEmojiPackResponse)
+	err := c.cc.Invoke(ctx, CommentEmojiService_UpsertEmojiPack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentEmojiServiceClient) UpsertEmojiItem(ctx context.Context, in *UpsertEmojiItemRequest, opts ...grpc.CallOption) (*UpsertEmojiItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpsertEmojiItemResponse)
+	err := c.cc.Invoke(ctx, CommentEmojiService_UpsertEmojiItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CommentEmojiServiceServer is the server API for CommentEmojiService service.
+// All implementations must embed UnimplementedCommentEmojiServiceServer
+// for forward compatibility.
+//
+// 评论表情包
+type CommentEmojiServiceServer interface {
+	// 列出表情包
+	ListEmojiPacks(context.Context, *ListEmojiPacksRequest) (*ListEmojiPacksResponse, error)
+	// 获取表情包及其表情
+	GetEmojiPack(context.Context, *GetEmojiPackRequest) (*GetEmojiPackResponse, error)
+	// 批量查找表情
+	BatchGetEmojiItems(context.Context, *BatchGetEmojiItemsRequest) (*BatchGetEmojiItemsResponse, error)
+	// 新增或者更新表情包
+	UpsertEmojiPack(context.Context, *UpsertEmojiPackRequest) (*UpsertEmojiPackResponse, error)
+	// 新增或者更新表情包中的表情
+	UpsertEmojiItem(context.Context, *UpsertEmojiItemRequest) (*UpsertEmojiItemResponse, error)
+	mustEmbedUnimplementedCommentEmojiServiceServer()
+}
+
+// UnimplementedCommentEmojiServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCommentEmojiServiceServer struct{}
+
+func (UnimplementedCommentEmojiServiceServer) ListEmojiPacks(context.Context, *ListEmojiPacksRequest) (*ListEmojiPacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEmojiPacks not implemented")
+}
+func (UnimplementedCommentEmojiServiceServer) GetEmojiPack(context.Context, *GetEmojiPackRequest) (*GetEmojiPackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmojiPack not implemented")
+}
+func (UnimplementedCommentEmojiServiceServer) BatchGetEmojiItems(context.Context, *BatchGetEmojiItemsRequest) (*BatchGetEmojiItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetEmojiItems not implemented")
+}
+func (UnimplementedCommentEmojiServiceServer) UpsertEmojiPack(context.Context, *UpsertEmojiPackRequest) (*UpsertEmojiPackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertEmojiPack not implemented")
+}
+func (UnimplementedCommentEmojiServiceServer) UpsertEmojiItem(context.Context, *UpsertEmojiItemRequest) (*UpsertEmojiItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertEmojiItem not implemented")
+}
+func (UnimplementedCommentEmojiServiceServer) mustEmbedUnimplementedCommentEmojiServiceServer() {}
+func (UnimplementedCommentEmojiServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeCommentEmojiServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CommentEmojiServiceServer will
+// result in compilation errors.
+type UnsafeCommentEmojiServiceServer interface {
+	mustEmbedUnimplementedCommentEmojiServiceServer()
+}
+
+func RegisterCommentEmojiServiceServer(s grpc.ServiceRegistrar, srv CommentEmojiServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCommentEmojiServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CommentEmojiService_ServiceDesc, srv)
+}
+
+func _CommentEmojiService_ListEmojiPacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEmojiPacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentEmojiServiceServer).ListEmojiPacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentEmojiService_ListEmojiPacks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentEmojiServiceServer).ListEmojiPacks(ctx, req.(*ListEmojiPacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentEmojiService_GetEmojiPack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmojiPackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentEmojiServiceServer).GetEmojiPack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentEmojiService_GetEmojiPack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentEmojiServiceServer).GetEmojiPack(ctx, req.(*GetEmojiPackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentEmojiService_BatchGetEmojiItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetEmojiItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentEmojiServiceServer).BatchGetEmojiItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentEmojiService_BatchGetEmojiItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentEmojiServiceServer).BatchGetEmojiItems(ctx, req.(*BatchGetEmojiItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentEmojiService_UpsertEmojiPack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertEmojiPackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentEmojiServiceServer).UpsertEmojiPack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentEmojiService_UpsertEmojiPack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentEmojiServiceServer).UpsertEmojiPack(ctx, req.(*UpsertEmojiPackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentEmojiService_UpsertEmojiItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertEmojiItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentEmojiServiceServer).UpsertEmojiItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentEmojiService_UpsertEmojiItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentEmojiServiceServer).UpsertEmojiItem(ctx, req.(*UpsertEmojiItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CommentEmojiService_ServiceDesc is the grpc.ServiceDesc for CommentEmojiService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CommentEmojiService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "comment.api.v1.CommentEmojiService",
+	HandlerType: (*CommentEmojiServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListEmojiPacks",
+			Handler:    _CommentEmojiService_ListEmojiPacks_Handler,
+		},
+		{
+			MethodName: "GetEmojiPack",
+			Handler:    _CommentEmojiService_GetEmojiPack_Handler,
+		},
+		{
+			MethodName: "BatchGetEmojiItems",
+			Handler:    _CommentEmojiService_BatchGetEmojiItems_Handler,
+		},
+		{
+			MethodName: "UpsertEmojiPack",
+			Handler:    _CommentEmojiService_UpsertEmojiPack_Handler,
+		},
+		{
+			MethodName: "UpsertEmojiItem",
+			Handler:    _CommentEmojiService_UpsertEmojiItem_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "comment/api/v1/comment.proto",
+}
+
+const (
+	CommentSettingService_GetCommentSetting_FullMethodName     = "/comment.api.v1.CommentSettingService/GetCommentSetting"
+	CommentSettingService_UpdateCommentSetting_FullMethodName  = "/comment.api.v1.CommentSettingService/UpdateCommentSetting"
+	CommentSettingService_MuteCommentUser_FullMethodName       = "/comment.api.v1.CommentSettingService/MuteCommentUser"
+	CommentSettingService_ListMutedCommentUsers_FullMethodName = "/comment.api.v1.CommentSettingService/ListMutedCommentUsers"
+	CommentSettingService_ListHeldComments_FullMethodName      = "/comment.api.v1.CommentSettingService/ListHeldComments"
+	CommentSettingService_ApproveHeldComments_FullMethodName   = "/comment.api.v1.CommentSettingService/ApproveHeldComments"
+)
+
+// CommentSettingServiceClient is the client API for CommentSettingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 作者的评论管理
+type CommentSettingServiceClient interface {
+	// 获取对象的评论设置
+	GetCommentSetting(ctx context.Context, in *GetCommentSettingRequest, opts ...grpc.CallOption) (*GetCommentSettingResponse, error)
+	// 更新对象的评论设置
+	UpdateCommentSetting(ctx context.Context, in *UpdateCommentSettingRequest, opts ...grpc.CallOption) (*UpdateCommentSettingResponse, error)
+	// 禁止/解除禁止用户评论
+	MuteCommentUser(ctx context.Context, in *MuteCommentUserRequest, opts ...grpc.CallOption) (*MuteCommentUserResponse, error)
+	// 获取禁止评论的用户
+	ListMutedCommentUsers(ctx context.Context, in *ListMutedCommentUsersRequest, opts ...grpc.CallOption) (*ListMutedCommentUsersResponse, error)
+	// 获取等待审核的评论
+	ListHeldComments(ctx context.Context, in *ListHeldCommentsRequest, opts ...grpc.CallOption) (*ListHeldCommentsResponse, error)
+	// 通过等待审核的评论
+	ApproveHeldComments(ctx context.Context, in *ApproveHeldCommentsRequest, opts ...grpc.CallOption) (*ApproveHeldCommentsResponse, error)
+}
+
+type commentSettingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCommentSettingServiceClient(cc grpc.ClientConnInterface) CommentSettingServiceClient {
+	return &commentSettingServiceClient{cc}
+}
+
+func (c *commentSettingServiceClient) GetCommentSetting(ctx context.Context, in *GetCommentSettingRequest, opts ...grpc.CallOption) (*GetCommentSettingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommentSettingResponse)
+	err := c.cc.Invoke(ctx, CommentSettingService_GetCommentSetting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentSettingServiceClient) UpdateCommentSetting(ctx context.Context, in *UpdateCommentSettingRequest, opts ...grpc.CallOption) (*UpdateCommentSettingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCommentSettingResponse)
+	err := c.cc.Invoke(ctx, CommentSettingService_UpdateCommentSetting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentSettingServiceClient) MuteCommentUser(ctx context.Context, in *MuteCommentUserRequest, opts ...grpc.CallOption) (*MuteCommentUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MuteCommentUserResponse)
+	err := c.cc.Invoke(ctx, CommentSettingService_MuteCommentUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentSettingServiceClient) ListMutedCommentUsers(ctx context.Context, in *ListMutedCommentUsersRequest, opts ...grpc.CallOption) (*ListMutedCommentUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMutedCommentUsersResponse)
+	err := c.cc.Invoke(ctx, CommentSettingService_ListMutedCommentUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentSettingServiceClient) ListHeldComments(ctx context.Context, in *ListHeldCommentsRequest, opts ...grpc.CallOption) (*ListHeldCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHeldCommentsResponse)
+	err := c.cc.Invoke(ctx, CommentSettingService_ListHeldComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentSettingServiceClient) ApproveHeldComments(ctx context.Context, in *ApproveHeldCommentsRequest, opts ...grpc.CallOption) (*ApproveHeldCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveHeldCommentsResponse)
+	err := c.cc.Invoke(ctx, CommentSettingService_ApproveHeldComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CommentSettingServiceServer is the server API for CommentSettingService service.
+// All implementations must embed UnimplementedCommentSettingServiceServer
+// for forward compatibility.
+//
+// 作者的评论管理
+type CommentSettingServiceServer interface {
+	// 获取对象的评论设置
+	GetCommentSetting(context.Context, *GetCommentSettingRequest) (*GetCommentSettingResponse, error)
+	// 更新对象的评论设置
+	UpdateCommentSetting(context.Context, *UpdateCommentSettingRequest) (*UpdateCommentSettingResponse, error)
+	// 禁止/解除禁止用户评论
+	MuteCommentUser(context.Context, *MuteCommentUserRequest) (*MuteCommentUserResponse, error)
+	// 获取禁止评论的用户
+	ListMutedCommentUsers(context.Context, *ListMutedCommentUsersRequest) (*ListMutedCommentUsersResponse, error)
+	// 获取等待审核的评论
+	ListHeldComments(context.Context, *ListHeldCommentsRequest) (*ListHeldCommentsResponse, error)
+	// 通过等待审核的评论
+	ApproveHeldComments(context.Context, *ApproveHeldCommentsRequest) (*ApproveHeldCommentsResponse, error)
+	mustEmbedUnimplementedCommentSettingServiceServer()
+}
+
+// UnimplementedCommentSettingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCommentSettingServiceServer struct{}
+
+func (UnimplementedCommentSettingServiceServer) GetCommentSetting(context.Context, *GetCommentSettingRequest) (*GetCommentSettingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommentSetting not implemented")
+}
+func (UnimplementedCommentSettingServiceServer) UpdateCommentSetting(context.Context, *UpdateCommentSettingRequest) (*UpdateCommentSettingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCommentSetting not implemented")
+}
+func (UnimplementedCommentSettingServiceServer) MuteCommentUser(context.Context, *MuteCommentUserRequest) (*MuteCommentUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteCommentUser not implemented")
+}
+func (UnimplementedCommentSettingServiceServer) ListMutedCommentUsers(context.Context, *ListMutedCommentUsersRequest) (*ListMutedCommentUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMutedCommentUsers not implemented")
+}
+func (UnimplementedCommentSettingServiceServer) ListHeldComments(context.Context, *ListHeldCommentsRequest) (*ListHeldCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHeldComments not implemented")
+}
+func (UnimplementedCommentSettingServiceServer) ApproveHeldComments(context.Context, *ApproveHeldCommentsRequest) (*ApproveHeldCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveHeldComments not implemented")
+}
+func (UnimplementedCommentSettingServiceServer) mustEmbedUnimplementedCommentSettingServiceServer() {}
+func (UnimplementedCommentSettingServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafeCommentSettingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CommentSettingServiceServer will
+// result in compilation errors.
+type UnsafeCommentSettingServiceServer interface {
+	mustEmbedUnimplementedCommentSettingServiceServer()
+}
+
+func RegisterCommentSettingServiceServer(s grpc.ServiceRegistrar, srv CommentSettingServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCommentSettingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CommentSettingService_ServiceDesc, srv)
+}
+
+func _CommentSettingService_GetCommentSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentSettingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentSettingServiceServer).GetCommentSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentSettingService_GetCommentSetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentSettingServiceServer).GetCommentSetting(ctx, req.(*GetCommentSettingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentSettingService_UpdateCommentSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCommentSettingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentSettingServiceServer).UpdateCommentSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentSettingService_UpdateCommentSetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentSettingServiceServer).UpdateCommentSetting(ctx, req.(*UpdateCommentSettingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentSettingService_MuteCommentUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteCommentUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentSettingServiceServer).MuteCommentUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentSettingService_MuteCommentUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentSettingServiceServer).MuteCommentUser(ctx, req.(*MuteCommentUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentSettingService_ListMutedCommentUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMutedCommentUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentSettingServiceServer).ListMutedCommentUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentSettingService_ListMutedCommentUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentSettingServiceServer).ListMutedCommentUsers(ctx, req.(*ListMutedCommentUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentSettingService_ListHeldComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHeldCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentSettingServiceServer).ListHeldComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentSettingService_ListHeldComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentSettingServiceServer).ListHeldComments(ctx, req.(*ListHeldCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentSettingService_ApproveHeldComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveHeldCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentSettingServiceServer).ApproveHeldComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentSettingService_ApproveHeldComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentSettingServiceServer).ApproveHeldComments(ctx, req.(*ApproveHeldCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CommentSettingService_ServiceDesc is the grpc.ServiceDesc for CommentSettingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CommentSettingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "comment.api.v1.CommentSettingService",
+	HandlerType: (*CommentSettingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCommentSetting",
+			Handler:    _CommentSettingService_GetCommentSetting_Handler,
+		},
+		{
+			MethodName: "UpdateCommentSetting",
+			Handler:    _CommentSettingService_UpdateCommentSetting_Handler,
+		},
+		{
+			MethodName: "MuteCommentUser",
+			Handler:    _CommentSettingService_MuteCommentUser_Handler,
+		},
+		{
+			MethodName: "ListMutedCommentUsers",
+			Handler:    _CommentSettingService_ListMutedCommentUsers_Handler,
+		},
+		{
+			MethodName: "ListHeldComments",
+			Handler:    _CommentSettingService_ListHeldComments_Handler,
+		},
+		{
+			MethodName: "ApproveHeldComments",
+			Handler:    _CommentSettingService_ApproveHeldComments_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "comment/api/v1/comment.proto",
@@ -0,0 +1,226 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: conductor/api/callback/v1/callback.proto
+
+package callback
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type OnTaskCompletedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 回调内容 与 HTTP 回调的请求体相同的 json 签名基于该字段计算
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *OnTaskCompletedRequest) Reset() {
+	*x = OnTaskCompletedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conductor_api_callback_v1_callback_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OnTaskCompletedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnTaskCompletedRequest) ProtoMessage() {}
+
+func (x *OnTaskCompletedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_conductor_api_callback_v1_callback_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnTaskCompletedRequest.ProtoReflect.Descriptor instead.
+func (*OnTaskCompletedRequest) Descriptor() ([]byte, []int) {
+	return file_conductor_api_callback_v1_callback_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OnTaskCompletedRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type OnTaskCompletedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *OnTaskCompletedResponse) Reset() {
+	*x = OnTaskCompletedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conductor_api_callback_v1_callback_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OnTaskCompletedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnTaskCompletedResponse) ProtoMessage() {}
+
+func (x *OnTaskCompletedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_conductor_api_callback_v1_callback_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnTaskCompletedResponse.ProtoReflect.Descriptor instead.
+func (*OnTaskCompletedResponse) Descriptor() ([]byte, []int) {
+	return file_conductor_api_callback_v1_callback_proto_rawDescGZIP(), []int{1}
+}
+
+var File_conductor_api_callback_v1_callback_proto protoreflect.FileDescriptor
+
+var file_conductor_api_callback_v1_callback_proto_rawDesc = []byte{
+	0x0a, 0x28, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x19, 0x63, 0x6f, 0x6e, 0x64,
+	0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x2e, 0x76, 0x31, 0x22, 0x32, 0x0a, 0x16, 0x4f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x4f, 0x6e, 0x54,
+	0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x32, 0x8b, 0x01, 0x0a, 0x0f, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x78, 0x0a, 0x0f, 0x4f, 0x6e, 0x54, 0x61,
+	0x73, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x31, 0x2e, 0x63, 0x6f,
+	0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32,
+	0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x6e, 0x54, 0x61, 0x73,
+	0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x84, 0x02, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x2e, 0x76, 0x31, 0x42, 0x0d, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x72, 0x79, 0x61, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f,
+	0x77, 0x68, 0x69, 0x6d, 0x65, 0x72, 0x2f, 0x69, 0x64, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67,
+	0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0xa2, 0x02, 0x03, 0x43, 0x41, 0x43, 0xaa, 0x02, 0x19, 0x43, 0x6f, 0x6e,
+	0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x41, 0x70, 0x69, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x19, 0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74,
+	0x6f, 0x72, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5c,
+	0x56, 0x31, 0xe2, 0x02, 0x25, 0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x5c, 0x41,
+	0x70, 0x69, 0x5c, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1c, 0x43, 0x6f, 0x6e,
+	0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a, 0x3a, 0x43, 0x61, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_conductor_api_callback_v1_callback_proto_rawDescOnce sync.Once
+	file_conductor_api_callback_v1_callback_proto_rawDescData = file_conductor_api_callback_v1_callback_proto_rawDesc
+)
+
+func file_conductor_api_callback_v1_callback_proto_rawDescGZIP() []byte {
+	file_conductor_api_callback_v1_callback_proto_rawDescOnce.Do(func() {
+		file_conductor_api_callback_v1_callback_proto_rawDescData = protoimpl.X.CompressGZIP(file_conductor_api_callback_v1_callback_proto_rawDescData)
+	})
+	return file_conductor_api_callback_v1_callback_proto_rawDescData
+}
+
+var file_conductor_api_callback_v1_callback_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_conductor_api_callback_v1_callback_proto_goTypes = []any{
+	(*OnTaskCompletedRequest)(nil),  // 0: conductor.api.callback.v1.OnTaskCompletedRequest
+	(*OnTaskCompletedResponse)(nil), // 1: conductor.api.callback.v1.OnTaskCompletedResponse
+}
+var file_conductor_api_callback_v1_callback_proto_depIdxs = []int32{
+	0, // 0: conductor.api.callback.v1.CallbackService.OnTaskCompleted:input_type -> conductor.api.callback.v1.OnTaskCompletedRequest
+	1, // 1: conductor.api.callback.v1.CallbackService.OnTaskCompleted:output_type -> conductor.api.callback.v1.OnTaskCompletedResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_conductor_api_callback_v1_callback_proto_init() }
+func file_conductor_api_callback_v1_callback_proto_init() {
+	if File_conductor_api_callback_v1_callback_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_conductor_api_callback_v1_callback_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*OnTaskCompletedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conductor_api_callback_v1_callback_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*OnTaskCompletedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_conductor_api_callback_v1_callback_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_conductor_api_callback_v1_callback_proto_goTypes,
+		DependencyIndexes: file_conductor_api_callback_v1_callback_proto_depIdxs,
+		MessageInfos:      file_conductor_api_callback_v1_callback_proto_msgTypes,
+	}.Build()
+	File_conductor_api_callback_v1_callback_proto = out.File
+	file_conductor_api_callback_v1_callback_proto_rawDesc = nil
+	file_conductor_api_callback_v1_callback_proto_goTypes = nil
+	file_conductor_api_callback_v1_callback_proto_depIdxs = nil
+}
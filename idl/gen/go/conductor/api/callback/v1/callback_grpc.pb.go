@@ -0,0 +1,133 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: conductor/api/callback/v1/callback.proto
+
+package callback
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CallbackService_OnTaskCompleted_FullMethodName = "/conductor.api.callback.v1.CallbackService/OnTaskCompleted"
+)
+
+// CallbackServiceClient is the client API for CallbackService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 任务回调服务 由回调方实现
+//
+// 签名信息放在 metadata 中 与 HTTP 回调的请求头相同（小写）
+// x-conductor-callback-id, x-conductor-attempt, x-conductor-timestamp, x-conductor-signature
+type CallbackServiceClient interface {
+	// 任务到达终态（成功或最终失败）时回调
+	OnTaskCompleted(ctx context.Context, in *OnTaskCompletedRequest, opts ...grpc.CallOption) (*OnTaskCompletedResponse, error)
+}
+
+type callbackServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCallbackServiceClient(cc grpc.ClientConnInterface) CallbackServiceClient {
+	return &callbackServiceClient{cc}
+}
+
+func (c *callbackServiceClient) OnTaskCompleted(ctx context.Context, in *OnTaskCompletedRequest, opts ...grpc.CallOption) (*OnTaskCompletedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OnTaskCompletedResponse)
+	err := c.cc.Invoke(ctx, CallbackService_OnTaskCompleted_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CallbackServiceServer is the server API for CallbackService service.
+// All implementations must embed UnimplementedCallbackServiceServer
+// for forward compatibility.
+//
+// 任务回调服务 由回调方实现
+//
+// 签名信息放在 metadata 中 与 HTTP 回调的请求头相同（小写）
+// x-conductor-callback-id, x-conductor-attempt, x-conductor-timestamp, x-conductor-signature
+type CallbackServiceServer interface {
+	// 任务到达终态（成功或最终失败）时回调
+	OnTaskCompleted(context.Context, *OnTaskCompletedRequest) (*OnTaskCompletedResponse, error)
+	mustEmbedUnimplementedCallbackServiceServer()
+}
+
+// UnimplementedCallbackServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCallbackServiceServer struct{}
+
+func (UnimplementedCallbackServiceServer) OnTaskCompleted(context.Context, *OnTaskCompletedRequest) (*OnTaskCompletedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnTaskCompleted not implemented")
+}
+func (UnimplementedCallbackServiceServer) mustEmbedUnimplementedCallbackServiceServer() {}
+func (UnimplementedCallbackServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeCallbackServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CallbackServiceServer will
+// result in compilation errors.
+type UnsafeCallbackServiceServer interface {
+	mustEmbedUnimplementedCallbackServiceServer()
+}
+
+func RegisterCallbackServiceServer(s grpc.ServiceRegistrar, srv CallbackServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCallbackServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CallbackService_ServiceDesc, srv)
+}
+
+func _CallbackService_OnTaskCompleted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnTaskCompletedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallbackServiceServer).OnTaskCompleted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CallbackService_OnTaskCompleted_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallbackServiceServer).OnTaskCompleted(ctx, req.(*OnTaskCompletedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CallbackService_ServiceDesc is the grpc.ServiceDesc for CallbackService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CallbackService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "conductor.api.callback.v1.CallbackService",
+	HandlerType: (*CallbackServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "OnTaskCompleted",
+			Handler:    _CallbackService_OnTaskCompleted_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "conductor/api/callback/v1/callback.proto",
+}
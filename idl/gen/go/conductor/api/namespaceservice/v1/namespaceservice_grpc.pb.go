@@ -19,8 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	NamespaceService_CreateNamespace_FullMethodName = "/conductor.api.namespaceservice.v1.NamespaceService/CreateNamespace"
-	NamespaceService_ListNamespace_FullMethodName   = "/conductor.api.namespaceservice.v1.NamespaceService/ListNamespace"
+	NamespaceService_CreateNamespace_FullMethodName     = "/conductor.api.namespaceservice.v1.NamespaceService/CreateNamespace"
+	NamespaceService_ListNamespace_FullMethodName       = "/conductor.api.namespaceservice.v1.NamespaceService/ListNamespace"
+	NamespaceService_UpdateNamespace_FullMethodName     = "/conductor.api.namespaceservice.v1.NamespaceService/UpdateNamespace"
+	NamespaceService_ResetCallbackSecret_FullMethodName = "/conductor.api.namespaceservice.v1.NamespaceService/ResetCallbackSecret"
 )
 
 // NamespaceServiceClient is the client API for NamespaceService service.
@@ -31,6 +33,10 @@ type NamespaceServiceClient interface {
 	CreateNamespace(ctx context.Context, in *CreateNamespaceRequest, opts ...grpc.CallOption) (*CreateNamespaceResponse, error)
 	// 分页列出命名空间
 	ListNamespace(ctx context.Context, in *ListNamespaceRequest, opts ...grpc.CallOption) (*ListNamespaceResponse, error)
+	// 更新命名空间的分发权重和配额
+	UpdateNamespace(ctx context.Context, in *UpdateNamespaceRequest, opts ...grpc.CallOption) (*UpdateNamespaceResponse, error)
+	// 重置回调签名密钥 旧密钥立即失效
+	ResetCallbackSecret(ctx context.Context, in *ResetCallbackSecretRequest, opts ...grpc.CallOption) (*ResetCallbackSecretResponse, error)
 }
 
 type namespaceServiceClient struct {
@@ -61,6 +67,26 @@ func (c *namespaceServiceClient) ListNamespace(ctx context.Context, in *ListName
 	return out, nil
 }
 
+func (c *namespaceServiceClient) UpdateNamespace(ctx context.Context, in *UpdateNamespaceRequest, opts ...grpc.CallOption) (*UpdateNamespaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateNamespaceResponse)
+	err := c.cc.Invoke(ctx, NamespaceService_UpdateNamespace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namespaceServiceClient) ResetCallbackSecret(ctx context.Context, in *ResetCallbackSecretRequest, opts ...grpc.CallOption) (*ResetCallbackSecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetCallbackSecretResponse)
+	err := c.cc.Invoke(ctx, NamespaceService_ResetCallbackSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NamespaceServiceServer is the server API for NamespaceService service.
 // All implementations must embed UnimplementedNamespaceServiceServer
 // for forward compatibility.
@@ -69,6 +95,10 @@ type NamespaceServiceServer interface {
 	CreateNamespace(context.Context, *CreateNamespaceRequest) (*CreateNamespaceResponse, error)
 	// 分页列出命名空间
 	ListNamespace(context.Context, *ListNamespaceRequest) (*ListNamespaceResponse, error)
+	// 更新命名空间的分发权重和配额
+	UpdateNamespace(context.Context, *UpdateNamespaceRequest) (*UpdateNamespaceResponse, error)
+	// 重置回调签名密钥 旧密钥立即失效
+	ResetCallbackSecret(context.Context, *ResetCallbackSecretRequest) (*ResetCallbackSecretResponse, error)
 	mustEmbedUnimplementedNamespaceServiceServer()
 }
 
@@ -85,6 +115,12 @@ func (UnimplementedNamespaceServiceServer) CreateNamespace(context.Context, *Cre
 func (UnimplementedNamespaceServiceServer) ListNamespace(context.Context, *ListNamespaceRequest) (*ListNamespaceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListNamespace not implemented")
 }
+func (UnimplementedNamespaceServiceServer) UpdateNamespace(context.Context, *UpdateNamespaceRequest) (*UpdateNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNamespace not implemented")
+}
+func (UnimplementedNamespaceServiceServer) ResetCallbackSecret(context.Context, *ResetCallbackSecretRequest) (*ResetCallbackSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetCallbackSecret not implemented")
+}
 func (UnimplementedNamespaceServiceServer) mustEmbedUnimplementedNamespaceServiceServer() {}
 func (UnimplementedNamespaceServiceServer) testEmbeddedByValue()                          {}
 
@@ -142,6 +178,42 @@ func _NamespaceService_ListNamespace_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NamespaceService_UpdateNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServiceServer).UpdateNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NamespaceService_UpdateNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServiceServer).UpdateNamespace(ctx, req.(*UpdateNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NamespaceService_ResetCallbackSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetCallbackSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServiceServer).ResetCallbackSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NamespaceService_ResetCallbackSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServiceServer).ResetCallbackSecret(ctx, req.(*ResetCallbackSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NamespaceService_ServiceDesc is the grpc.ServiceDesc for NamespaceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -157,6 +229,14 @@ var NamespaceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListNamespace",
 			Handler:    _NamespaceService_ListNamespace_Handler,
 		},
+		{
+			MethodName: "UpdateNamespace",
+			Handler:    _NamespaceService_UpdateNamespace_Handler,
+		},
+		{
+			MethodName: "ResetCallbackSecret",
+			Handler:    _NamespaceService_ResetCallbackSecret_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "conductor/api/namespaceservice/v1/namespaceservice.proto",
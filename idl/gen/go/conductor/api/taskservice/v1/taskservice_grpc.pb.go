@@ -8,6 +8,7 @@ package taskservice
 
 import (
 	context "context"
+	v1 "github.com/ryanreadbooks/whimer/idl/gen/go/conductor/api/task/v1"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -19,9 +20,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TaskService_RegisterTask_FullMethodName = "/conductor.api.taskservice.v1.TaskService/RegisterTask"
-	TaskService_GetTask_FullMethodName      = "/conductor.api.taskservice.v1.TaskService/GetTask"
-	TaskService_AbortTask_FullMethodName    = "/conductor.api.taskservice.v1.TaskService/AbortTask"
+	TaskService_RegisterTask_FullMethodName     = "/conductor.api.taskservice.v1.TaskService/RegisterTask"
+	TaskService_GetTask_FullMethodName          = "/conductor.api.taskservice.v1.TaskService/GetTask"
+	TaskService_AbortTask_FullMethodName        = "/conductor.api.taskservice.v1.TaskService/AbortTask"
+	TaskService_RegisterWorkflow_FullMethodName = "/conductor.api.taskservice.v1.TaskService/RegisterWorkflow"
+	TaskService_GetWorkflow_FullMethodName      = "/conductor.api.taskservice.v1.TaskService/GetWorkflow"
+	TaskService_ListTasks_FullMethodName        = "/conductor.api.taskservice.v1.TaskService/ListTasks"
+	TaskService_RetryTask_FullMethodName        = "/conductor.api.taskservice.v1.TaskService/RetryTask"
+	TaskService_BatchAbortTask_FullMethodName   = "/conductor.api.taskservice.v1.TaskService/BatchAbortTask"
+	TaskService_GetTaskHistory_FullMethodName   = "/conductor.api.taskservice.v1.TaskService/GetTaskHistory"
+	TaskService_GetTaskCallbacks_FullMethodName = "/conductor.api.taskservice.v1.TaskService/GetTaskCallbacks"
+	TaskService_WatchTask_FullMethodName        = "/conductor.api.taskservice.v1.TaskService/WatchTask"
+	TaskService_WatchNamespace_FullMethodName   = "/conductor.api.taskservice.v1.TaskService/WatchNamespace"
 )
 
 // TaskServiceClient is the client API for TaskService service.
@@ -34,6 +44,24 @@ type TaskServiceClient interface {
 	GetTask(ctx context.Context, in *GetTaskRequest, opts ...grpc.CallOption) (*GetTaskResponse, error)
 	// 终止任务
 	AbortTask(ctx context.Context, in *AbortTaskRequest, opts ...grpc.CallOption) (*AbortTaskResponse, error)
+	// 注册工作流 一次提交一组有依赖关系的任务
+	RegisterWorkflow(ctx context.Context, in *RegisterWorkflowRequest, opts ...grpc.CallOption) (*RegisterWorkflowResponse, error)
+	// 获取工作流
+	GetWorkflow(ctx context.Context, in *GetWorkflowRequest, opts ...grpc.CallOption) (*GetWorkflowResponse, error)
+	// 按条件分页列出任务
+	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error)
+	// 人工重试 将失败或过期的任务重新放回待重试队列
+	RetryTask(ctx context.Context, in *RetryTaskRequest, opts ...grpc.CallOption) (*RetryTaskResponse, error)
+	// 批量终止任务
+	BatchAbortTask(ctx context.Context, in *BatchAbortTaskRequest, opts ...grpc.CallOption) (*BatchAbortTaskResponse, error)
+	// 获取任务状态变更记录
+	GetTaskHistory(ctx context.Context, in *GetTaskHistoryRequest, opts ...grpc.CallOption) (*GetTaskHistoryResponse, error)
+	// 获取任务的回调投递记录
+	GetTaskCallbacks(ctx context.Context, in *GetTaskCallbacksRequest, opts ...grpc.CallOption) (*GetTaskCallbacksResponse, error)
+	// 订阅单个任务的状态变更和进度 先返回一次当前状态 任务进入终态后结束
+	WatchTask(ctx context.Context, in *WatchTaskRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[v1.TaskEvent], error)
+	// 订阅命名空间下所有任务的状态变更和进度 直到客户端取消
+	WatchNamespace(ctx context.Context, in *WatchNamespaceRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[v1.TaskEvent], error)
 }
 
 type taskServiceClient struct {
@@ -74,6 +102,114 @@ func (c *taskServiceClient) AbortTask(ctx context.Context, in *AbortTaskRequest,
 	return out, nil
 }
 
+func (c *taskServiceClient) RegisterWorkflow(ctx context.Context, in *RegisterWorkflowRequest, opts ...grpc.CallOption) (*RegisterWorkflowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterWorkflowResponse)
+	err := c.cc.Invoke(ctx, TaskService_RegisterWorkflow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetWorkflow(ctx context.Context, in *GetWorkflowRequest, opts ...grpc.CallOption) (*GetWorkflowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkflowResponse)
+	err := c.cc.Invoke(ctx, TaskService_GetWorkflow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) RetryTask(ctx context.Context, in *RetryTaskRequest, opts ...grpc.CallOption) (*RetryTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RetryTaskResponse)
+	err := c.cc.Invoke(ctx, TaskService_RetryTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) BatchAbortTask(ctx context.Context, in *BatchAbortTaskRequest, opts ...grpc.CallOption) (*BatchAbortTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchAbortTaskResponse)
+	err := c.cc.Invoke(ctx, TaskService_BatchAbortTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetTaskHistory(ctx context.Context, in *GetTaskHistoryRequest, opts ...grpc.CallOption) (*GetTaskHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTaskHistoryResponse)
+	err := c.cc.Invoke(ctx, TaskService_GetTaskHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetTaskCallbacks(ctx context.Context, in *GetTaskCallbacksRequest, opts ...grpc.CallOption) (*GetTaskCallbacksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTaskCallbacksResponse)
+	err := c.cc.Invoke(ctx, TaskService_GetTaskCallbacks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) WatchTask(ctx context.Context, in *WatchTaskRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[v1.TaskEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TaskService_ServiceDesc.Streams[0], TaskService_WatchTask_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchTaskRequest, v1.TaskEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TaskService_WatchTaskClient = grpc.ServerStreamingClient[v1.TaskEvent]
+
+func (c *taskServiceClient) WatchNamespace(ctx context.Context, in *WatchNamespaceRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[v1.TaskEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TaskService_ServiceDesc.Streams[1], TaskService_WatchNamespace_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchNamespaceRequest, v1.TaskEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TaskService_WatchNamespaceClient = grpc.ServerStreamingClient[v1.TaskEvent]
+
 // TaskServiceServer is the server API for TaskService service.
 // All implementations must embed UnimplementedTaskServiceServer
 // for forward compatibility.
@@ -84,6 +220,24 @@ type TaskServiceServer interface {
 	GetTask(context.Context, *GetTaskRequest) (*GetTaskResponse, error)
 	// 终止任务
 	AbortTask(context.Context, *AbortTaskRequest) (*AbortTaskResponse, error)
+	// 注册工作流 一次提交一组有依赖关系的任务
+	RegisterWorkflow(context.Context, *RegisterWorkflowRequest) (*RegisterWorkflowResponse, error)
+	// 获取工作流
+	GetWorkflow(context.Context, *GetWorkflowRequest) (*GetWorkflowResponse, error)
+	// 按条件分页列出任务
+	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
+	// 人工重试 将失败或过期的任务重新放回待重试队列
+	RetryTask(context.Context, *RetryTaskRequest) (*RetryTaskResponse, error)
+	// 批量终止任务
+	BatchAbortTask(context.Context, *BatchAbortTaskRequest) (*BatchAbortTaskResponse, error)
+	// 获取任务状态变更记录
+	GetTaskHistory(context.Context, *GetTaskHistoryRequest) (*GetTaskHistoryResponse, error)
+	// 获取任务的回调投递记录
+	GetTaskCallbacks(context.Context, *GetTaskCallbacksRequest) (*GetTaskCallbacksResponse, error)
+	// 订阅单个任务的状态变更和进度 先返回一次当前状态 任务进入终态后结束
+	WatchTask(*WatchTaskRequest, grpc.ServerStreamingServer[v1.TaskEvent]) error
+	// 订阅命名空间下所有任务的状态变更和进度 直到客户端取消
+	WatchNamespace(*WatchNamespaceRequest, grpc.ServerStreamingServer[v1.TaskEvent]) error
 	mustEmbedUnimplementedTaskServiceServer()
 }
 
@@ -103,6 +257,33 @@ func (UnimplementedTaskServiceServer) GetTask(context.Context, *GetTaskRequest)
 func (UnimplementedTaskServiceServer) AbortTask(context.Context, *AbortTaskRequest) (*AbortTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AbortTask not implemented")
 }
+func (UnimplementedTaskServiceServer) RegisterWorkflow(context.Context, *RegisterWorkflowRequest) (*RegisterWorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterWorkflow not implemented")
+}
+func (UnimplementedTaskServiceServer) GetWorkflow(context.Context, *GetWorkflowRequest) (*GetWorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflow not implemented")
+}
+func (UnimplementedTaskServiceServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) RetryTask(context.Context, *RetryTaskRequest) (*RetryTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryTask not implemented")
+}
+func (UnimplementedTaskServiceServer) BatchAbortTask(context.Context, *BatchAbortTaskRequest) (*BatchAbortTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchAbortTask not implemented")
+}
+func (UnimplementedTaskServiceServer) GetTaskHistory(context.Context, *GetTaskHistoryRequest) (*GetTaskHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskHistory not implemented")
+}
+func (UnimplementedTaskServiceServer) GetTaskCallbacks(context.Context, *GetTaskCallbacksRequest) (*GetTaskCallbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskCallbacks not implemented")
+}
+func (UnimplementedTaskServiceServer) WatchTask(*WatchTaskRequest, grpc.ServerStreamingServer[v1.TaskEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchTask not implemented")
+}
+func (UnimplementedTaskServiceServer) WatchNamespace(*WatchNamespaceRequest, grpc.ServerStreamingServer[v1.TaskEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchNamespace not implemented")
+}
 func (UnimplementedTaskServiceServer) mustEmbedUnimplementedTaskServiceServer() {}
 func (UnimplementedTaskServiceServer) testEmbeddedByValue()                     {}
 
@@ -178,6 +359,154 @@ func _TaskService_AbortTask_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaskService_RegisterWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).RegisterWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_RegisterWorkflow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).RegisterWorkflow(ctx, req.(*RegisterWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_GetWorkflow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetWorkflow(ctx, req.(*GetWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListTasks(ctx, req.(*ListTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_RetryTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).RetryTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_RetryTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).RetryTask(ctx, req.(*RetryTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_BatchAbortTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchAbortTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).BatchAbortTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_BatchAbortTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).BatchAbortTask(ctx, req.(*BatchAbortTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetTaskHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetTaskHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_GetTaskHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetTaskHistory(ctx, req.(*GetTaskHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetTaskCallbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskCallbacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetTaskCallbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_GetTaskCallbacks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetTaskCallbacks(ctx, req.(*GetTaskCallbacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_WatchTask_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTaskRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TaskServiceServer).WatchTask(m, &grpc.GenericServerStream[WatchTaskRequest, v1.TaskEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TaskService_WatchTaskServer = grpc.ServerStreamingServer[v1.TaskEvent]
+
+func _TaskService_WatchNamespace_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchNamespaceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TaskServiceServer).WatchNamespace(m, &grpc.GenericServerStream[WatchNamespaceRequest, v1.TaskEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TaskService_WatchNamespaceServer = grpc.ServerStreamingServer[v1.TaskEvent]
+
 // TaskService_ServiceDesc is the grpc.ServiceDesc for TaskService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -197,7 +526,46 @@ var TaskService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AbortTask",
 			Handler:    _TaskService_AbortTask_Handler,
 		},
+		{
+			MethodName: "RegisterWorkflow",
+			Handler:    _TaskService_RegisterWorkflow_Handler,
+		},
+		{
+			MethodName: "GetWorkflow",
+			Handler:    _TaskService_GetWorkflow_Handler,
+		},
+		{
+			MethodName: "ListTasks",
+			Handler:    _TaskService_ListTasks_Handler,
+		},
+		{
+			MethodName: "RetryTask",
+			Handler:    _TaskService_RetryTask_Handler,
+		},
+		{
+			MethodName: "BatchAbortTask",
+			Handler:    _TaskService_BatchAbortTask_Handler,
+		},
+		{
+			MethodName: "GetTaskHistory",
+			Handler:    _TaskService_GetTaskHistory_Handler,
+		},
+		{
+			MethodName: "GetTaskCallbacks",
+			Handler:    _TaskService_GetTaskCallbacks_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchTask",
+			Handler:       _TaskService_WatchTask_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchNamespace",
+			Handler:       _TaskService_WatchNamespace_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "conductor/api/taskservice/v1/taskservice.proto",
 }
@@ -22,16 +22,81 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SystemNoticeContent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetUid int64  `protobuf:"varint,1,opt,name=target_uid,json=targetUid,proto3" json:"target_uid,omitempty"` // 被通知的人
+	Title     string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`                           // 通知标题
+	Content   string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`                       // 通知内容
+}
+
+func (x *SystemNoticeContent) Reset() {
+	*x = SystemNoticeContent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msger_api_system_v1_notify_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemNoticeContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemNoticeContent) ProtoMessage() {}
+
+func (x *SystemNoticeContent) ProtoReflect() protoreflect.Message {
+	mi := &file_msger_api_system_v1_notify_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemNoticeContent.ProtoReflect.Descriptor instead.
+func (*SystemNoticeContent) Descriptor() ([]byte, []int) {
+	return file_msger_api_system_v1_notify_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SystemNoticeContent) GetTargetUid() int64 {
+	if x != nil {
+		return x.TargetUid
+	}
+	return 0
+}
+
+func (x *SystemNoticeContent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SystemNoticeContent) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
 type NotifySystemNoticeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Contents []*SystemNoticeContent `protobuf:"bytes,1,rep,name=contents,proto3" json:"contents,omitempty"`
 }
 
 func (x *NotifySystemNoticeRequest) Reset() {
 	*x = NotifySystemNoticeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_msger_api_system_v1_notify_proto_msgTypes[0]
+		mi := &file_msger_api_system_v1_notify_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -44,7 +109,7 @@ func (x *NotifySystemNoticeRequest) String() string {
 func (*NotifySystemNoticeRequest) ProtoMessage() {}
 
 func (x *NotifySystemNoticeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_msger_api_system_v1_notify_proto_msgTypes[0]
+	mi := &file_msger_api_system_v1_notify_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -57,19 +122,29 @@ func (x *NotifySystemNoticeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotifySystemNoticeRequest.ProtoReflect.Descriptor instead.
 func (*NotifySystemNoticeRequest) Descriptor() ([]byte, []int) {
-	return file_msger_api_system_v1_notify_proto_rawDescGZIP(), []int{0}
+	return file_msger_api_system_v1_notify_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NotifySystemNoticeRequest) GetContents() []*SystemNoticeContent {
+	if x != nil {
+		return x.Contents
+	}
+	return nil
 }
 
 type NotifySystemNoticeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// uid -> msgIds
+	MsgIds map[int64]*msg.StringList `protobuf:"bytes,1,rep,name=msg_ids,json=msgIds,proto3" json:"msg_ids,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *NotifySystemNoticeResponse) Reset() {
 	*x = NotifySystemNoticeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_msger_api_system_v1_notify_proto_msgTypes[1]
+		mi := &file_msger_api_system_v1_notify_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -82,7 +157,7 @@ func (x *NotifySystemNoticeResponse) String() string {
 func (*NotifySystemNoticeResponse) ProtoMessage() {}
 
 func (x *NotifySystemNoticeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_msger_api_system_v1_notify_proto_msgTypes[1]
+	mi := &file_msger_api_system_v1_notify_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -95,7 +170,14 @@ func (x *NotifySystemNoticeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotifySystemNoticeResponse.ProtoReflect.Descriptor instead.
 func (*NotifySystemNoticeResponse) Descriptor() ([]byte, []int) {
-	return file_msger_api_system_v1_notify_proto_rawDescGZIP(), []int{1}
+	return file_msger_api_system_v1_notify_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NotifySystemNoticeResponse) GetMsgIds() map[int64]*msg.StringList {
+	if x != nil {
+		return x.MsgIds
+	}
+	return nil
 }
 
 type ReplyMsgContent struct {
@@ -111,7 +193,7 @@ type ReplyMsgContent struct {
 func (x *ReplyMsgContent) Reset() {
 	*x = ReplyMsgContent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_msger_api_system_v1_notify_proto_msgTypes[2]
+		mi := &file_msger_api_system_v1_notify_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -124,7 +206,7 @@ func (x *ReplyMsgContent) String() string {
 func (*ReplyMsgContent) ProtoMessage() {}
 
 func (x *ReplyMsgContent) ProtoReflect() protoreflect.Message {
-	mi := &file_msger_api_system_v1_notify_proto_msgTypes[2]
+	mi := &file_msger_api_system_v1_notify_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -137,7 +219,7 @@ func (x *ReplyMsgContent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReplyMsgContent.ProtoReflect.Descriptor instead.
 func (*ReplyMsgContent) Descriptor() ([]byte, []int) {
-	return file_msger_api_system_v1_notify_proto_rawDescGZIP(), []int{2}
+	return file_msger_api_system_v1_notify_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ReplyMsgContent) GetUid() int64 {
@@ -172,7 +254,7 @@ type NotifyReplyMsgRequest struct {
 func (x *NotifyReplyMsgRequest) Reset() {
 	*x = NotifyReplyMsgRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_msger_api_system_v1_notify_proto_msgTypes[3]
+		mi := &file_msger_api_system_v1_notify_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -185,7 +267,7 @@ func (x *NotifyReplyMsgRequest) String() string {
 func (*NotifyReplyMsgRequest) ProtoMessage() {}
 
 func (x *NotifyReplyMsgRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_msger_api_system_v1_notify_proto_msgTypes[3]
+	mi := &file_msger_api_system_v1_notify_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -198,7 +280,7 @@ func (x *NotifyReplyMsgRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotifyReplyMsgRequest.ProtoReflect.Descriptor instead.
 func (*NotifyReplyMsgRequest) Descriptor() ([]byte, []int) {
-	return file_msger_api_system_v1_notify_proto_rawDescGZIP(), []int{3}
+	return file_msger_api_system_v1_notify_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *NotifyReplyMsgRequest) GetContents() []*ReplyMsgContent {
@@ -219,7 +301,7 @@ type NotifyReplyMsgResponse struct {
 func (x *NotifyReplyMsgResponse) Reset() {
 	*x = NotifyReplyMsgResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_msger_api_system_v1_notify_proto_msgTypes[4]
+		mi := &file_msger_api_system_v1_notify_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -232,7 +314,7 @@ func (x *NotifyReplyMsgResponse) String() string {
 func (*NotifyReplyMsgResponse) ProtoMessage() {}
 
 func (x *NotifyReplyMsgResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_msger_api_system_v1_notify_proto_msgTypes[4]
+	mi := &file_msger_api_system_v1_notify_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -245,7 +327,7 @@ func (x *NotifyReplyMsgResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotifyReplyMsgResponse.ProtoReflect.Descriptor instead.
 func (*NotifyReplyMsgResponse) Descriptor() ([]byte, []int) {
-	return file_msger_api_system_v1_notify_proto_rawDescGZIP(), []int{4}
+	return file_msger_api_system_v1_notify_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *NotifyReplyMsgResponse) GetMsgIds() map[int64]*msg.StringList {
@@ -268,7 +350,7 @@ type MentionMsgContent struct {
 func (x *MentionMsgContent) Reset() {
 	*x = MentionMsgContent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_msger_api_system_v1_notify_proto_msgTypes[5]
+		mi := &file_msger_api_system_v1_notify_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -281,7 +363,7 @@ func (x *MentionMsgContent) String() string {
 func (*MentionMsgContent) ProtoMessage() {}
 
 func (x *MentionMsgContent) ProtoReflect() protoreflect.Message {
-	mi := &file_msger_api_system_v1_notify_proto_msgTypes[5]
+	mi := &file_msger_api_system_v1_notify_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -294,7 +376,7 @@ func (x *MentionMsgContent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MentionMsgContent.ProtoReflect.Descriptor instead.
 func (*MentionMsgContent) Descriptor() ([]byte, []int) {
-	return file_msger_api_system_v1_notify_proto_rawDescGZIP(), []int{5}
+	return file_msger_api_system_v1_notify_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *MentionMsgContent) GetUid() int64 {
@@ -329,7 +411,7 @@ type NotifyMentionMsgRequest struct {
 func (x *NotifyMentionMsgRequest) Reset() {
 	*x = NotifyMentionMsgRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_msger_api_system_v1_notify_proto_msgTypes[6]
+		mi := &file_msger_api_system_v1_notify_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -342,7 +424,7 @@ func (x *NotifyMentionMsgRequest) String() string {
 func (*NotifyMentionMsgRequest) ProtoMessage() {}
 
 func (x *NotifyMentionMsgRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_msger_api_system_v1_notify_proto_msgTypes[6]
+	mi := &file_msger_api_system_v1_notify_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -355,7 +437,7 @@ func (x *NotifyMentionMsgRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotifyMentionMsgRequest.ProtoReflect.Descriptor instead.
 func (*NotifyMentionMsgRequest) Descriptor() ([]byte, []int) {
-	return file_msger_api_system_v1_notify_proto_rawDescGZIP(), []int{6}
+	return file_msger_api_system_v1_notify_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *NotifyMentionMsgRequest) GetMentions() []*MentionMsgContent {
@@ -377,7 +459,7 @@ type NotifyMentionMsgResponse struct {
 func (x *NotifyMentionMsgResponse) Reset() {
 	*x = NotifyMentionMsgResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_msger_api_system_v1_notify_proto_msgTypes[7]
+		mi := &file_msger_api_system_v1_notify_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -390,7 +472,7 @@ func (x *NotifyMentionMsgResponse) String() string {
 func (*NotifyMentionMsgResponse) ProtoMessage() {}
 
 func (x *NotifyMentionMsgResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_msger_api_system_v1_notify_proto_msgTypes[7]
+	mi := &file_msger_api_system_v1_notify_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -403,7 +485,7 @@ func (x *NotifyMentionMsgResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotifyMentionMsgResponse.ProtoReflect.Descriptor instead.
 func (*NotifyMentionMsgResponse) Descriptor() ([]byte, []int) {
-	return file_msger_api_system_v1_notify_proto_rawDescGZIP(), []int{7}
+	return file_msger_api_system_v1_notify_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *NotifyMentionMsgResponse) GetMsgIds() map[int64]*msg.StringList {
@@ -426,7 +508,7 @@ type LikeMsgContent struct {
 func (x *LikeMsgContent) Reset() {
 	*x = LikeMsgContent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_msger_api_system_v1_notify_proto_msgTypes[8]
+		mi := &file_msger_api_system_v1_notify_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -439,7 +521,7 @@ func (x *LikeMsgContent) String() string {
 func (*LikeMsgContent) ProtoMessage() {}
 
 func (x *LikeMsgContent) ProtoReflect() protoreflect.Message {
-	mi := &file_msger_api_system_v1_notify_proto_msgTypes[8]
+	mi := &file_msger_api_system_v1_notify_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -452,7 +534,7 @@ func (x *LikeMsgContent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LikeMsgContent.ProtoReflect.Descriptor instead.
 func (*LikeMsgContent) Descriptor() ([]byte, []int) {
-	return file_msger_api_system_v1_notify_proto_rawDescGZIP(), []int{8}
+	return file_msger_api_system_v1_notify_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *LikeMsgContent) GetUid() int64 {
@@ -487,7 +569,7 @@ type NotifyLikesMsgRequest struct {
 func (x *NotifyLikesMsgRequest) Reset() {
 	*x = NotifyLikesMsgRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_msger_api_system_v1_notify_proto_msgTypes[9]
+		mi := &file_msger_api_system_v1_notify_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -500,7 +582,7 @@ func (x *NotifyLikesMsgRequest) String() string {
 func (*NotifyLikesMsgRequest) ProtoMessage() {}
 
 func (x *NotifyLikesMsgRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_msger_api_system_v1_notify_proto_msgTypes[9]
+	mi := &file_msger_api_system_v1_notify_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -513,7 +595,7 @@ func (x *NotifyLikesMsgRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotifyLikesMsgRequest.ProtoReflect.Descriptor instead.
 func (*NotifyLikesMsgRequest) Descriptor() ([]byte, []int) {
-	return file_msger_api_system_v1_notify_proto_rawDescGZIP(), []int{9}
+	return file_msger_api_system_v1_notify_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *NotifyLikesMsgRequest) GetContents() []*LikeMsgContent {
@@ -535,7 +617,7 @@ type NotifyLikesMsgResponse struct {
 func (x *NotifyLikesMsgResponse) Reset() {
 	*x = NotifyLikesMsgResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_msger_api_system_v1_notify_proto_msgTypes[10]
+		mi := &file_msger_api_system_v1_notify_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -548,7 +630,7 @@ func (x *NotifyLikesMsgResponse) String() string {
 func (*NotifyLikesMsgResponse) ProtoMessage() {}
 
 func (x *NotifyLikesMsgResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_msger_api_system_v1_notify_proto_msgTypes[10]
+	mi := &file_msger_api_system_v1_notify_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -561,7 +643,7 @@ func (x *NotifyLikesMsgResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotifyLikesMsgResponse.ProtoReflect.Descriptor instead.
 func (*NotifyLikesMsgResponse) Descriptor() ([]byte, []int) {
-	return file_msger_api_system_v1_notify_proto_rawDescGZIP(), []int{10}
+	return file_msger_api_system_v1_notify_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *NotifyLikesMsgResponse) GetMsgIds() map[int64]*msg.StringList {
@@ -580,126 +662,148 @@ var file_msger_api_system_v1_notify_proto_rawDesc = []byte{
 	0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x1a, 0x18, 0x65, 0x78, 0x74, 0x2f, 0x6f, 0x70, 0x74,
 	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x17, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x73, 0x67,
-	0x2f, 0x6d, 0x73, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x1b, 0x0a, 0x19, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x79, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1c, 0x0a, 0x1a, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x79, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5c, 0x0a, 0x0f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x4d, 0x73,
-	0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x22, 0x59, 0x0a, 0x15, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
-	0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xc0,
-	0x01, 0x0a, 0x16, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x4d, 0x73,
-	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x07, 0x6d, 0x73, 0x67,
-	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x6d, 0x73, 0x67,
-	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31,
-	0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x4d, 0x73, 0x67, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x73, 0x1a, 0x54, 0x0a, 0x0b, 0x4d,
-	0x73, 0x67, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x73,
-	0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0x5e, 0x0a, 0x11, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x55, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x22, 0x5d, 0x0a, 0x17, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x6e, 0x74, 0x69,
-	0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x08,
-	0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26,
-	0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x22, 0xc4, 0x01, 0x0a, 0x18, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x6e, 0x74, 0x69,
-	0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a,
-	0x07, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39,
-	0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x6e, 0x74, 0x69,
-	0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x73,
-	0x67, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x49, 0x64,
-	0x73, 0x1a, 0x54, 0x0a, 0x0b, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x73,
-	0x67, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5b, 0x0a, 0x0e, 0x4c, 0x69, 0x6b, 0x65, 0x4d,
-	0x73, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x22, 0x58, 0x0a, 0x15, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4c, 0x69,
-	0x6b, 0x65, 0x73, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a,
+	0x2f, 0x6d, 0x73, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x64, 0x0a, 0x13, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x69, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x22, 0x61, 0x0a, 0x19, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a,
 	0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x23, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xc0,
-	0x01, 0x0a, 0x16, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x4d, 0x73,
-	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x07, 0x6d, 0x73, 0x67,
-	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x6d, 0x73, 0x67,
-	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31,
-	0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x4d, 0x73, 0x67, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x73, 0x1a, 0x54, 0x0a, 0x0b, 0x4d,
-	0x73, 0x67, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x73,
-	0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x32, 0xdb, 0x03, 0x0a, 0x13, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x75, 0x0a, 0x12, 0x4e, 0x6f, 0x74,
-	0x69, 0x66, 0x79, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x12,
-	0x2e, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2f, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x69, 0x0a, 0x0e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x4d,
-	0x73, 0x67, 0x12, 0x2a, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b,
+	0x28, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69,
+	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0xc8, 0x01, 0x0a, 0x1a, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x54, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x06, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x73, 0x1a, 0x54, 0x0a, 0x0b, 0x4d, 0x73, 0x67, 0x49,
+	0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5c,
+	0x0a, 0x0f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+	0x75, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55,
+	0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x59, 0x0a, 0x15,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x4d, 0x73, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x16, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x50, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6d, 0x73,
+	0x67, 0x49, 0x64, 0x73, 0x1a, 0x54, 0x0a, 0x0b, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5e, 0x0a, 0x11, 0x4d, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x69, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x5d, 0x0a, 0x17, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x08, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52,
+	0x08, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xc4, 0x01, 0x0a, 0x18, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x73, 0x1a, 0x54, 0x0a, 0x0b, 0x4d, 0x73,
+	0x67, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x73, 0x67,
+	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x5b, 0x0a, 0x0e, 0x4c, 0x69, 0x6b, 0x65, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x03, 0x75, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x55, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x58, 0x0a,
+	0x15, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x6b, 0x65, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x16, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x79, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x50, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79,
+	0x4c, 0x69, 0x6b, 0x65, 0x73, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6d, 0x73,
+	0x67, 0x49, 0x64, 0x73, 0x1a, 0x54, 0x0a, 0x0b, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0xdb, 0x03, 0x0a, 0x13, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x75, 0x0a, 0x12, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x12, 0x2e, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x0e, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x4d, 0x73, 0x67, 0x12, 0x2a, 0x2e, 0x6d, 0x73,
+	0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76,
+	0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x10, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4d, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x12, 0x2c, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x0e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4c,
+	0x69, 0x6b, 0x65, 0x73, 0x4d, 0x73, 0x67, 0x12, 0x2a, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x79, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79,
+	0x4c, 0x69, 0x6b, 0x65, 0x73, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x1a, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x42, 0xd5, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d,
 	0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x10, 0x4e,
-	0x6f, 0x74, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x12,
-	0x2c, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x6e, 0x74,
-	0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e,
-	0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f,
-	0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x0e,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x4d, 0x73, 0x67, 0x12, 0x2a,
-	0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4c, 0x69, 0x6b, 0x65, 0x73,
-	0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6d, 0x73, 0x67,
-	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31,
-	0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x4d, 0x73, 0x67, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x42,
-	0xd5, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4e, 0x6f, 0x74,
-	0x69, 0x66, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x79, 0x61, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x62,
-	0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x77, 0x68, 0x69, 0x6d, 0x65, 0x72, 0x2f, 0x69, 0x64, 0x6c, 0x2f,
-	0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x41, 0x53,
-	0xaa, 0x02, 0x13, 0x4d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x41, 0x70, 0x69, 0x2e, 0x53, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x4d, 0x73, 0x67, 0x65, 0x72, 0x5c, 0x41,
-	0x70, 0x69, 0x5c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x4d,
-	0x73, 0x67, 0x65, 0x72, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5c,
-	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x16, 0x4d, 0x73, 0x67, 0x65, 0x72, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a, 0x3a, 0x53, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6d, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x72, 0x79, 0x61, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x77, 0x68,
+	0x69, 0x6d, 0x65, 0x72, 0x2f, 0x69, 0x64, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f,
+	0x6d, 0x73, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x2f, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x41, 0x53, 0xaa, 0x02, 0x13, 0x4d, 0x73, 0x67, 0x65,
+	0x72, 0x2e, 0x41, 0x70, 0x69, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x56, 0x31, 0xca,
+	0x02, 0x13, 0x4d, 0x73, 0x67, 0x65, 0x72, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x4d, 0x73, 0x67, 0x65, 0x72, 0x5c, 0x41, 0x70,
+	0x69, 0x5c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x4d, 0x73, 0x67, 0x65, 0x72, 0x3a,
+	0x3a, 0x41, 0x70, 0x69, 0x3a, 0x3a, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x3a, 0x3a, 0x56, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -714,47 +818,52 @@ func file_msger_api_system_v1_notify_proto_rawDescGZIP() []byte {
 	return file_msger_api_system_v1_notify_proto_rawDescData
 }
 
-var file_msger_api_system_v1_notify_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_msger_api_system_v1_notify_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_msger_api_system_v1_notify_proto_goTypes = []any{
-	(*NotifySystemNoticeRequest)(nil),  // 0: msger.api.system.v1.NotifySystemNoticeRequest
-	(*NotifySystemNoticeResponse)(nil), // 1: msger.api.system.v1.NotifySystemNoticeResponse
-	(*ReplyMsgContent)(nil),            // 2: msger.api.system.v1.ReplyMsgContent
-	(*NotifyReplyMsgRequest)(nil),      // 3: msger.api.system.v1.NotifyReplyMsgRequest
-	(*NotifyReplyMsgResponse)(nil),     // 4: msger.api.system.v1.NotifyReplyMsgResponse
-	(*MentionMsgContent)(nil),          // 5: msger.api.system.v1.MentionMsgContent
-	(*NotifyMentionMsgRequest)(nil),    // 6: msger.api.system.v1.NotifyMentionMsgRequest
-	(*NotifyMentionMsgResponse)(nil),   // 7: msger.api.system.v1.NotifyMentionMsgResponse
-	(*LikeMsgContent)(nil),             // 8: msger.api.system.v1.LikeMsgContent
-	(*NotifyLikesMsgRequest)(nil),      // 9: msger.api.system.v1.NotifyLikesMsgRequest
-	(*NotifyLikesMsgResponse)(nil),     // 10: msger.api.system.v1.NotifyLikesMsgResponse
-	nil,                                // 11: msger.api.system.v1.NotifyReplyMsgResponse.MsgIdsEntry
-	nil,                                // 12: msger.api.system.v1.NotifyMentionMsgResponse.MsgIdsEntry
-	nil,                                // 13: msger.api.system.v1.NotifyLikesMsgResponse.MsgIdsEntry
-	(*msg.StringList)(nil),             // 14: msger.api.msg.StringList
+	(*SystemNoticeContent)(nil),        // 0: msger.api.system.v1.SystemNoticeContent
+	(*NotifySystemNoticeRequest)(nil),  // 1: msger.api.system.v1.NotifySystemNoticeRequest
+	(*NotifySystemNoticeResponse)(nil), // 2: msger.api.system.v1.NotifySystemNoticeResponse
+	(*ReplyMsgContent)(nil),            // 3: msger.api.system.v1.ReplyMsgContent
+	(*NotifyReplyMsgRequest)(nil),      // 4: msger.api.system.v1.NotifyReplyMsgRequest
+	(*NotifyReplyMsgResponse)(nil),     // 5: msger.api.system.v1.NotifyReplyMsgResponse
+	(*MentionMsgContent)(nil),          // 6: msger.api.system.v1.MentionMsgContent
+	(*NotifyMentionMsgRequest)(nil),    // 7: msger.api.system.v1.NotifyMentionMsgRequest
+	(*NotifyMentionMsgResponse)(nil),   // 8: msger.api.system.v1.NotifyMentionMsgResponse
+	(*LikeMsgContent)(nil),             // 9: msger.api.system.v1.LikeMsgContent
+	(*NotifyLikesMsgRequest)(nil),      // 10: msger.api.system.v1.NotifyLikesMsgRequest
+	(*NotifyLikesMsgResponse)(nil),     // 11: msger.api.system.v1.NotifyLikesMsgResponse
+	nil,                                // 12: msger.api.system.v1.NotifySystemNoticeResponse.MsgIdsEntry
+	nil,                                // 13: msger.api.system.v1.NotifyReplyMsgResponse.MsgIdsEntry
+	nil,                                // 14: msger.api.system.v1.NotifyMentionMsgResponse.MsgIdsEntry
+	nil,                                // 15: msger.api.system.v1.NotifyLikesMsgResponse.MsgIdsEntry
+	(*msg.StringList)(nil),             // 16: msger.api.msg.StringList
 }
 var file_msger_api_system_v1_notify_proto_depIdxs = []int32{
-	2,  // 0: msger.api.system.v1.NotifyReplyMsgRequest.contents:type_name -> msger.api.system.v1.ReplyMsgContent
-	11, // 1: msger.api.system.v1.NotifyReplyMsgResponse.msg_ids:type_name -> msger.api.system.v1.NotifyReplyMsgResponse.MsgIdsEntry
-	5,  // 2: msger.api.system.v1.NotifyMentionMsgRequest.mentions:type_name -> msger.api.system.v1.MentionMsgContent
-	12, // 3: msger.api.system.v1.NotifyMentionMsgResponse.msg_ids:type_name -> msger.api.system.v1.NotifyMentionMsgResponse.MsgIdsEntry
-	8,  // 4: msger.api.system.v1.NotifyLikesMsgRequest.contents:type_name -> msger.api.system.v1.LikeMsgContent
-	13, // 5: msger.api.system.v1.NotifyLikesMsgResponse.msg_ids:type_name -> msger.api.system.v1.NotifyLikesMsgResponse.MsgIdsEntry
-	14, // 6: msger.api.system.v1.NotifyReplyMsgResponse.MsgIdsEntry.value:type_name -> msger.api.msg.StringList
-	14, // 7: msger.api.system.v1.NotifyMentionMsgResponse.MsgIdsEntry.value:type_name -> msger.api.msg.StringList
-	14, // 8: msger.api.system.v1.NotifyLikesMsgResponse.MsgIdsEntry.value:type_name -> msger.api.msg.StringList
-	0,  // 9: msger.api.system.v1.NotificationService.NotifySystemNotice:input_type -> msger.api.system.v1.NotifySystemNoticeRequest
-	3,  // 10: msger.api.system.v1.NotificationService.NotifyReplyMsg:input_type -> msger.api.system.v1.NotifyReplyMsgRequest
-	6,  // 11: msger.api.system.v1.NotificationService.NotifyMentionMsg:input_type -> msger.api.system.v1.NotifyMentionMsgRequest
-	9,  // 12: msger.api.system.v1.NotificationService.NotifyLikesMsg:input_type -> msger.api.system.v1.NotifyLikesMsgRequest
-	1,  // 13: msger.api.system.v1.NotificationService.NotifySystemNotice:output_type -> msger.api.system.v1.NotifySystemNoticeResponse
-	4,  // 14: msger.api.system.v1.NotificationService.NotifyReplyMsg:output_type -> msger.api.system.v1.NotifyReplyMsgResponse
-	7,  // 15: msger.api.system.v1.NotificationService.NotifyMentionMsg:output_type -> msger.api.system.v1.NotifyMentionMsgResponse
-	10, // 16: msger.api.system.v1.NotificationService.NotifyLikesMsg:output_type -> msger.api.system.v1.NotifyLikesMsgResponse
-	13, // [13:17] is the sub-list for method output_type
-	9,  // [9:13] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	0,  // 0: msger.api.system.v1.NotifySystemNoticeRequest.contents:type_name -> msger.api.system.v1.SystemNoticeContent
+	12, // 1: msger.api.system.v1.NotifySystemNoticeResponse.msg_ids:type_name -> msger.api.system.v1.NotifySystemNoticeResponse.MsgIdsEntry
+	3,  // 2: msger.api.system.v1.NotifyReplyMsgRequest.contents:type_name -> msger.api.system.v1.ReplyMsgContent
+	13, // 3: msger.api.system.v1.NotifyReplyMsgResponse.msg_ids:type_name -> msger.api.system.v1.NotifyReplyMsgResponse.MsgIdsEntry
+	6,  // 4: msger.api.system.v1.NotifyMentionMsgRequest.mentions:type_name -> msger.api.system.v1.MentionMsgContent
+	14, // 5: msger.api.system.v1.NotifyMentionMsgResponse.msg_ids:type_name -> msger.api.system.v1.NotifyMentionMsgResponse.MsgIdsEntry
+	9,  // 6: msger.api.system.v1.NotifyLikesMsgRequest.contents:type_name -> msger.api.system.v1.LikeMsgContent
+	15, // 7: msger.api.system.v1.NotifyLikesMsgResponse.msg_ids:type_name -> msger.api.system.v1.NotifyLikesMsgResponse.MsgIdsEntry
+	16, // 8: msger.api.system.v1.NotifySystemNoticeResponse.MsgIdsEntry.value:type_name -> msger.api.msg.StringList
+	16, // 9: msger.api.system.v1.NotifyReplyMsgResponse.MsgIdsEntry.value:type_name -> msger.api.msg.StringList
+	16, // 10: msger.api.system.v1.NotifyMentionMsgResponse.MsgIdsEntry.value:type_name -> msger.api.msg.StringList
+	16, // 11: msger.api.system.v1.NotifyLikesMsgResponse.MsgIdsEntry.value:type_name -> msger.api.msg.StringList
+	1,  // 12: msger.api.system.v1.NotificationService.NotifySystemNotice:input_type -> msger.api.system.v1.NotifySystemNoticeRequest
+	4,  // 13: msger.api.system.v1.NotificationService.NotifyReplyMsg:input_type -> msger.api.system.v1.NotifyReplyMsgRequest
+	7,  // 14: msger.api.system.v1.NotificationService.NotifyMentionMsg:input_type -> msger.api.system.v1.NotifyMentionMsgRequest
+	10, // 15: msger.api.system.v1.NotificationService.NotifyLikesMsg:input_type -> msger.api.system.v1.NotifyLikesMsgRequest
+	2,  // 16: msger.api.system.v1.NotificationService.NotifySystemNotice:output_type -> msger.api.system.v1.NotifySystemNoticeResponse
+	5,  // 17: msger.api.system.v1.NotificationService.NotifyReplyMsg:output_type -> msger.api.system.v1.NotifyReplyMsgResponse
+	8,  // 18: msger.api.system.v1.NotificationService.NotifyMentionMsg:output_type -> msger.api.system.v1.NotifyMentionMsgResponse
+	11, // 19: msger.api.system.v1.NotificationService.NotifyLikesMsg:output_type -> msger.api.system.v1.NotifyLikesMsgResponse
+	16, // [16:20] is the sub-list for method output_type
+	12, // [12:16] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_msger_api_system_v1_notify_proto_init() }
@@ -764,7 +873,7 @@ func file_msger_api_system_v1_notify_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_msger_api_system_v1_notify_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*NotifySystemNoticeRequest); i {
+			switch v := v.(*SystemNoticeContent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -776,7 +885,7 @@ func file_msger_api_system_v1_notify_proto_init() {
 			}
 		}
 		file_msger_api_system_v1_notify_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*NotifySystemNoticeResponse); i {
+			switch v := v.(*NotifySystemNoticeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -788,7 +897,7 @@ func file_msger_api_system_v1_notify_proto_init() {
 			}
 		}
 		file_msger_api_system_v1_notify_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*ReplyMsgContent); i {
+			switch v := v.(*NotifySystemNoticeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -800,7 +909,7 @@ func file_msger_api_system_v1_notify_proto_init() {
 			}
 		}
 		file_msger_api_system_v1_notify_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*NotifyReplyMsgRequest); i {
+			switch v := v.(*ReplyMsgContent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -812,7 +921,7 @@ func file_msger_api_system_v1_notify_proto_init() {
 			}
 		}
 		file_msger_api_system_v1_notify_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*NotifyReplyMsgResponse); i {
+			switch v := v.(*NotifyReplyMsgRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -824,7 +933,7 @@ func file_msger_api_system_v1_notify_proto_init() {
 			}
 		}
 		file_msger_api_system_v1_notify_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*MentionMsgContent); i {
+			switch v := v.(*NotifyReplyMsgResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -836,7 +945,7 @@ func file_msger_api_system_v1_notify_proto_init() {
 			}
 		}
 		file_msger_api_system_v1_notify_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*NotifyMentionMsgRequest); i {
+			switch v := v.(*MentionMsgContent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -848,7 +957,7 @@ func file_msger_api_system_v1_notify_proto_init() {
 			}
 		}
 		file_msger_api_system_v1_notify_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*NotifyMentionMsgResponse); i {
+			switch v := v.(*NotifyMentionMsgRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -860,7 +969,7 @@ func file_msger_api_system_v1_notify_proto_init() {
 			}
 		}
 		file_msger_api_system_v1_notify_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*LikeMsgContent); i {
+			switch v := v.(*NotifyMentionMsgResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -872,7 +981,7 @@ func file_msger_api_system_v1_notify_proto_init() {
 			}
 		}
 		file_msger_api_system_v1_notify_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*NotifyLikesMsgRequest); i {
+			switch v := v.(*LikeMsgContent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -884,6 +993,18 @@ func file_msger_api_system_v1_notify_proto_init() {
 			}
 		}
 		file_msger_api_system_v1_notify_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*NotifyLikesMsgRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msger_api_system_v1_notify_proto_msgTypes[11].Exporter = func(v any, i int) any {
 			switch v := v.(*NotifyLikesMsgResponse); i {
 			case 0:
 				return &v.state
@@ -902,7 +1023,7 @@ func file_msger_api_system_v1_notify_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_msger_api_system_v1_notify_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
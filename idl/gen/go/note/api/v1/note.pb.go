@@ -26,6 +26,9 @@ const (
 	NotePrivacy_NOTE_PRIVACY_UNSPECIFIED NotePrivacy = 0
 	NotePrivacy_PUBLIC                   NotePrivacy = 1
 	NotePrivacy_PRIVATE                  NotePrivacy = 2
+	NotePrivacy_FOLLOWERS                NotePrivacy = 3 // 仅粉丝可见
+	NotePrivacy_MUTUAL_FOLLOWERS         NotePrivacy = 4 // 仅互关好友可见
+	NotePrivacy_CUSTOM                   NotePrivacy = 5 // 仅指定用户可见
 )
 
 // Enum value maps for NotePrivacy.
@@ -34,11 +37,17 @@ var (
 		0: "NOTE_PRIVACY_UNSPECIFIED",
 		1: "PUBLIC",
 		2: "PRIVATE",
+		3: "FOLLOWERS",
+		4: "MUTUAL_FOLLOWERS",
+		5: "CUSTOM",
 	}
 	NotePrivacy_value = map[string]int32{
 		"NOTE_PRIVACY_UNSPECIFIED": 0,
 		"PUBLIC":                   1,
 		"PRIVATE":                  2,
+		"FOLLOWERS":                3,
+		"MUTUAL_FOLLOWERS":         4,
+		"CUSTOM":                   5,
 	}
 )
 
@@ -131,6 +140,12 @@ const (
 	NoteLifeCycleState_LIFE_CYCLE_STATE_REJECTED NoteLifeCycleState = 3
 	// 被锁定
 	NoteLifeCycleState_LIFE_CYCLE_STATE_BANNED NoteLifeCycleState = 4
+	// 草稿
+	NoteLifeCycleState_LIFE_CYCLE_STATE_DRAFT NoteLifeCycleState = 5
+	// 等待定时发布
+	NoteLifeCycleState_LIFE_CYCLE_STATE_SCHEDULED NoteLifeCycleState = 6
+	// 在回收站中
+	NoteLifeCycleState_LIFE_CYCLE_STATE_RECYCLED NoteLifeCycleState = 7
 )
 
 // Enum value maps for NoteLifeCycleState.
@@ -141,6 +156,9 @@ var (
 		2: "LIFE_CYCLE_STATE_AUDITING",
 		3: "LIFE_CYCLE_STATE_REJECTED",
 		4: "LIFE_CYCLE_STATE_BANNED",
+		5: "LIFE_CYCLE_STATE_DRAFT",
+		6: "LIFE_CYCLE_STATE_SCHEDULED",
+		7: "LIFE_CYCLE_STATE_RECYCLED",
 	}
 	NoteLifeCycleState_value = map[string]int32{
 		"NOTE_LIFE_CYCLE_STATE_UNSPECIFIED": 0,
@@ -148,6 +166,9 @@ var (
 		"LIFE_CYCLE_STATE_AUDITING":         2,
 		"LIFE_CYCLE_STATE_REJECTED":         3,
 		"LIFE_CYCLE_STATE_BANNED":           4,
+		"LIFE_CYCLE_STATE_DRAFT":            5,
+		"LIFE_CYCLE_STATE_SCHEDULED":        6,
+		"LIFE_CYCLE_STATE_RECYCLED":         7,
 	}
 )
 
@@ -185,6 +206,10 @@ type NoteState int32
 
 const (
 	NoteState_NOTE_STATE_UNSPECIFIED NoteState = 0
+	// 草稿
+	NoteState_DRAFT NoteState = 1
+	// 等待定时发布
+	NoteState_SCHEDULED NoteState = 2
 	// 资源处理中
 	NoteState_PROCESSING NoteState = 10
 	// 资源处理完成
@@ -197,35 +222,47 @@ const (
 	NoteState_REJECTED NoteState = 21
 	// 审核通过
 	NoteState_AUDIT_PASSED NoteState = 22
+	// 人工复审中
+	NoteState_AUDIT_REVIEWING NoteState = 23
 	// 已发布
 	NoteState_PUBLISHED NoteState = 100
 	// 被封禁
 	NoteState_BANNED NoteState = 101
+	// 已删除 在回收站中等待彻底删除
+	NoteState_RECYCLED NoteState = 110
 )
 
 // Enum value maps for NoteState.
 var (
 	NoteState_name = map[int32]string{
 		0:   "NOTE_STATE_UNSPECIFIED",
+		1:   "DRAFT",
+		2:   "SCHEDULED",
 		10:  "PROCESSING",
 		11:  "PROCESSED",
 		12:  "PROCESS_FAILED",
 		20:  "AUDITING",
 		21:  "REJECTED",
 		22:  "AUDIT_PASSED",
+		23:  "AUDIT_REVIEWING",
 		100: "PUBLISHED",
 		101: "BANNED",
+		110: "RECYCLED",
 	}
 	NoteState_value = map[string]int32{
 		"NOTE_STATE_UNSPECIFIED": 0,
+		"DRAFT":                  1,
+		"SCHEDULED":              2,
 		"PROCESSING":             10,
 		"PROCESSED":              11,
 		"PROCESS_FAILED":         12,
 		"AUDITING":               20,
 		"REJECTED":               21,
 		"AUDIT_PASSED":           22,
+		"AUDIT_REVIEWING":        23,
 		"PUBLISHED":              100,
 		"BANNED":                 101,
+		"RECYCLED":               110,
 	}
 )
 
@@ -380,23 +417,26 @@ type NoteItem struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NoteId         int64              `protobuf:"varint,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
-	Title          string             `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Desc           string             `protobuf:"bytes,3,opt,name=desc,proto3" json:"desc,omitempty"`
-	Privacy        int32              `protobuf:"varint,4,opt,name=privacy,proto3" json:"privacy,omitempty"`
-	CreateAt       int64              `protobuf:"varint,5,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
-	UpdateAt       int64              `protobuf:"varint,6,opt,name=update_at,json=updateAt,proto3" json:"update_at,omitempty"`
-	Images         []*NoteImage       `protobuf:"bytes,7,rep,name=images,proto3" json:"images,omitempty"`
-	Likes          int64              `protobuf:"varint,8,opt,name=likes,proto3" json:"likes,omitempty"`     // 点赞数量
-	Replies        int64              `protobuf:"varint,9,opt,name=replies,proto3" json:"replies,omitempty"` //评论数量
-	Tags           []*NoteTag         `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`       // 笔记的标签
-	Owner          int64              `protobuf:"varint,11,opt,name=owner,proto3" json:"owner,omitempty"`
-	Ip             string             `protobuf:"bytes,12,opt,name=ip,proto3" json:"ip,omitempty"`
-	AtUsers        []*NoteAtUser      `protobuf:"bytes,13,rep,name=at_users,json=atUsers,proto3" json:"at_users,omitempty"`                                    // @人
-	NoteType       NoteAssetType      `protobuf:"varint,14,opt,name=note_type,json=noteType,proto3,enum=note.api.v1.NoteAssetType" json:"note_type,omitempty"` // 笔记类型 图片笔记或者视频笔记
-	State          NoteState          `protobuf:"varint,15,opt,name=state,proto3,enum=note.api.v1.NoteState" json:"state,omitempty"`                           // 笔记状态(内部状态)
-	Videos         []*NoteVideo       `protobuf:"bytes,16,rep,name=videos,proto3" json:"videos,omitempty"`
-	LifeCycleState NoteLifeCycleState `protobuf:"varint,17,opt,name=life_cycle_state,json=lifeCycleState,proto3,enum=note.api.v1.NoteLifeCycleState" json:"life_cycle_state,omitempty"` // 笔记生命周期状态(对外状态)
+	NoteId         int64               `protobuf:"varint,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	Title          string              `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Desc           string              `protobuf:"bytes,3,opt,name=desc,proto3" json:"desc,omitempty"`
+	Privacy        int32               `protobuf:"varint,4,opt,name=privacy,proto3" json:"privacy,omitempty"`
+	CreateAt       int64               `protobuf:"varint,5,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
+	UpdateAt       int64               `protobuf:"varint,6,opt,name=update_at,json=updateAt,proto3" json:"update_at,omitempty"`
+	Images         []*NoteImage        `protobuf:"bytes,7,rep,name=images,proto3" json:"images,omitempty"`
+	Likes          int64               `protobuf:"varint,8,opt,name=likes,proto3" json:"likes,omitempty"`     // 点赞数量
+	Replies        int64               `protobuf:"varint,9,opt,name=replies,proto3" json:"replies,omitempty"` //评论数量
+	Tags           []*NoteTag          `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`       // 笔记的标签
+	Owner          int64               `protobuf:"varint,11,opt,name=owner,proto3" json:"owner,omitempty"`
+	Ip             string              `protobuf:"bytes,12,opt,name=ip,proto3" json:"ip,omitempty"`
+	AtUsers        []*NoteAtUser       `protobuf:"bytes,13,rep,name=at_users,json=atUsers,proto3" json:"at_users,omitempty"`                                    // @人
+	NoteType       NoteAssetType       `protobuf:"varint,14,opt,name=note_type,json=noteType,proto3,enum=note.api.v1.NoteAssetType" json:"note_type,omitempty"` // 笔记类型 图片笔记或者视频笔记
+	State          NoteState           `protobuf:"varint,15,opt,name=state,proto3,enum=note.api.v1.NoteState" json:"state,omitempty"`                           // 笔记状态(内部状态)
+	Videos         []*NoteVideo        `protobuf:"bytes,16,rep,name=videos,proto3" json:"videos,omitempty"`
+	LifeCycleState NoteLifeCycleState  `protobuf:"varint,17,opt,name=life_cycle_state,json=lifeCycleState,proto3,enum=note.api.v1.NoteLifeCycleState" json:"life_cycle_state,omitempty"` // 笔记生命周期状态(对外状态)
+	Audience       []int64             `protobuf:"varint,18,rep,packed,name=audience,proto3" json:"audience,omitempty"`                                                                  // 指定可见的用户 仅privacy为CUSTOM时有效
+	Views          int64               `protobuf:"varint,19,opt,name=views,proto3" json:"views,omitempty"`                                                                               // 浏览量
+	Collection     *NoteCollectionLink `protobuf:"bytes,20,opt,name=collection,proto3" json:"collection,omitempty"`                                                                      // 笔记所属的合集
 }
 
 func (x *NoteItem) Reset() {
@@ -550,6 +590,27 @@ func (x *NoteItem) GetLifeCycleState() NoteLifeCycleState {
 	return NoteLifeCycleState_NOTE_LIFE_CYCLE_STATE_UNSPECIFIED
 }
 
+func (x *NoteItem) GetAudience() []int64 {
+	if x != nil {
+		return x.Audience
+	}
+	return nil
+}
+
+func (x *NoteItem) GetViews() int64 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *NoteItem) GetCollection() *NoteCollectionLink {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
 type NoteImageMeta struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -983,8 +1044,9 @@ type FeedNoteItemExt struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tags    []*NoteTag    `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
-	AtUsers []*NoteAtUser `protobuf:"bytes,2,rep,name=at_users,json=atUsers,proto3" json:"at_users,omitempty"`
+	Tags       []*NoteTag          `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	AtUsers    []*NoteAtUser       `protobuf:"bytes,2,rep,name=at_users,json=atUsers,proto3" json:"at_users,omitempty"`
+	Collection *NoteCollectionLink `protobuf:"bytes,3,opt,name=collection,proto3" json:"collection,omitempty"` // 笔记所属的合集
 }
 
 func (x *FeedNoteItemExt) Reset() {
@@ -1033,6 +1095,205 @@ func (x *FeedNoteItemExt) GetAtUsers() []*NoteAtUser {
 	return nil
 }
 
+func (x *FeedNoteItemExt) GetCollection() *NoteCollectionLink {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+// 笔记合集 由作者将自己的笔记按顺序组织
+type NoteCollection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId int64   `protobuf:"varint,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Owner        int64   `protobuf:"varint,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Title        string  `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Desc         string  `protobuf:"bytes,4,opt,name=desc,proto3" json:"desc,omitempty"`
+	Privacy      int32   `protobuf:"varint,5,opt,name=privacy,proto3" json:"privacy,omitempty"`                              // 仅支持PUBLIC和PRIVATE
+	CoverNoteId  int64   `protobuf:"varint,6,opt,name=cover_note_id,json=coverNoteId,proto3" json:"cover_note_id,omitempty"` // 作为封面的笔记
+	NoteCount    int64   `protobuf:"varint,7,opt,name=note_count,json=noteCount,proto3" json:"note_count,omitempty"`
+	Ctime        int64   `protobuf:"varint,8,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	Utime        int64   `protobuf:"varint,9,opt,name=utime,proto3" json:"utime,omitempty"`
+	NoteIds      []int64 `protobuf:"varint,10,rep,packed,name=note_ids,json=noteIds,proto3" json:"note_ids,omitempty"` // 合集中按顺序排列的笔记 仅获取合集详情时返回
+}
+
+func (x *NoteCollection) Reset() {
+	*x = NoteCollection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_note_api_v1_note_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NoteCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteCollection) ProtoMessage() {}
+
+func (x *NoteCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_note_api_v1_note_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteCollection.ProtoReflect.Descriptor instead.
+func (*NoteCollection) Descriptor() ([]byte, []int) {
+	return file_note_api_v1_note_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *NoteCollection) GetCollectionId() int64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *NoteCollection) GetOwner() int64 {
+	if x != nil {
+		return x.Owner
+	}
+	return 0
+}
+
+func (x *NoteCollection) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *NoteCollection) GetDesc() string {
+	if x != nil {
+		return x.Desc
+	}
+	return ""
+}
+
+func (x *NoteCollection) GetPrivacy() int32 {
+	if x != nil {
+		return x.Privacy
+	}
+	return 0
+}
+
+func (x *NoteCollection) GetCoverNoteId() int64 {
+	if x != nil {
+		return x.CoverNoteId
+	}
+	return 0
+}
+
+func (x *NoteCollection) GetNoteCount() int64 {
+	if x != nil {
+		return x.NoteCount
+	}
+	return 0
+}
+
+func (x *NoteCollection) GetCtime() int64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+func (x *NoteCollection) GetUtime() int64 {
+	if x != nil {
+		return x.Utime
+	}
+	return 0
+}
+
+func (x *NoteCollection) GetNoteIds() []int64 {
+	if x != nil {
+		return x.NoteIds
+	}
+	return nil
+}
+
+// 笔记在合集中的位置
+type NoteCollectionLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId int64  `protobuf:"varint,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Title        string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	PrevNoteId   int64  `protobuf:"varint,3,opt,name=prev_note_id,json=prevNoteId,proto3" json:"prev_note_id,omitempty"` // 上一篇笔记 为0时表示没有
+	NextNoteId   int64  `protobuf:"varint,4,opt,name=next_note_id,json=nextNoteId,proto3" json:"next_note_id,omitempty"` // 下一篇笔记 为0时表示没有
+}
+
+func (x *NoteCollectionLink) Reset() {
+	*x = NoteCollectionLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_note_api_v1_note_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NoteCollectionLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteCollectionLink) ProtoMessage() {}
+
+func (x *NoteCollectionLink) ProtoReflect() protoreflect.Message {
+	mi := &file_note_api_v1_note_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteCollectionLink.ProtoReflect.Descriptor instead.
+func (*NoteCollectionLink) Descriptor() ([]byte, []int) {
+	return file_note_api_v1_note_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *NoteCollectionLink) GetCollectionId() int64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *NoteCollectionLink) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *NoteCollectionLink) GetPrevNoteId() int64 {
+	if x != nil {
+		return x.PrevNoteId
+	}
+	return 0
+}
+
+func (x *NoteCollectionLink) GetNextNoteId() int64 {
+	if x != nil {
+		return x.NextNoteId
+	}
+	return 0
+}
+
 var File_note_api_v1_note_proto protoreflect.FileDescriptor
 
 var file_note_api_v1_note_proto_rawDesc = []byte{
@@ -1046,7 +1307,7 @@ var file_note_api_v1_note_proto_rawDesc = []byte{
 	0x74, 0x65, 0x41, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b,
 	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b,
 	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0xe7, 0x04, 0x0a, 0x08, 0x4e, 0x6f, 0x74, 0x65, 0x49,
+	0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0xda, 0x05, 0x0a, 0x08, 0x4e, 0x6f, 0x74, 0x65, 0x49,
 	0x74, 0x65, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
 	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
@@ -1085,101 +1346,152 @@ var file_note_api_v1_note_proto_rawDesc = []byte{
 	0x1f, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f,
 	0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
 	0x52, 0x0e, 0x6c, 0x69, 0x66, 0x65, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x22, 0x55, 0x0a, 0x0d, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74,
-	0x61, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x61, 0x0a, 0x09, 0x4e, 0x6f, 0x74, 0x65, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x6d, 0x65,
-	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0x61, 0x0a, 0x09, 0x4e, 0x6f,
-	0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x12, 0x20, 0x03,
+	0x28, 0x03, 0x52, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x69, 0x65,
+	0x77, 0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x55, 0x0a, 0x0d, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x4d, 0x65, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x61, 0x0a, 0x09, 0x4e, 0x6f,
+	0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
 	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a,
 	0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e, 0x6f,
-	0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x56, 0x69,
-	0x64, 0x65, 0x6f, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0xe0, 0x01,
-	0x0a, 0x0d, 0x4e, 0x6f, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x4d, 0x65, 0x74, 0x61, 0x12,
-	0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
-	0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
-	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x07, 0x62, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63,
-	0x6f, 0x64, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65,
-	0x63, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12,
-	0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x63,
-	0x22, 0x80, 0x03, 0x0a, 0x0c, 0x46, 0x65, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x74, 0x65,
-	0x6d, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
-	0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x64, 0x65, 0x73, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61,
-	0x67, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x37, 0x0a, 0x09, 0x6e, 0x6f,
-	0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e,
-	0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65,
-	0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x18, 0x0c, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x06, 0x76, 0x69, 0x64,
-	0x65, 0x6f, 0x73, 0x22, 0x6f, 0x0a, 0x0f, 0x46, 0x65, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x49,
-	0x74, 0x65, 0x6d, 0x45, 0x78, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
-	0x12, 0x32, 0x0a, 0x08, 0x61, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x41, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x07, 0x61, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x73, 0x2a, 0x44, 0x0a, 0x0b, 0x4e, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76,
-	0x61, 0x63, 0x79, 0x12, 0x1c, 0x0a, 0x18, 0x4e, 0x4f, 0x54, 0x45, 0x5f, 0x50, 0x52, 0x49, 0x56,
-	0x41, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x43, 0x10, 0x01, 0x12, 0x0b, 0x0a,
-	0x07, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x10, 0x02, 0x2a, 0x46, 0x0a, 0x0d, 0x4e, 0x6f,
-	0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x4e,
-	0x4f, 0x54, 0x45, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
-	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
-	0x49, 0x4d, 0x41, 0x47, 0x45, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x56, 0x49, 0x44, 0x45, 0x4f,
-	0x10, 0x02, 0x2a, 0xb6, 0x01, 0x0a, 0x12, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x43,
-	0x79, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x21, 0x4e, 0x4f, 0x54,
-	0x45, 0x5f, 0x4c, 0x49, 0x46, 0x45, 0x5f, 0x43, 0x59, 0x43, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x1e, 0x0a, 0x1a, 0x4c, 0x49, 0x46, 0x45, 0x5f, 0x43, 0x59, 0x43, 0x4c, 0x45, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x01,
-	0x12, 0x1d, 0x0a, 0x19, 0x4c, 0x49, 0x46, 0x45, 0x5f, 0x43, 0x59, 0x43, 0x4c, 0x45, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x55, 0x44, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12,
+	0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0x61, 0x0a,
+	0x09, 0x4e, 0x6f, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x2e, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74,
+	0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61,
+	0x22, 0xe0, 0x01, 0x0a, 0x0d, 0x4e, 0x6f, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x4d, 0x65,
+	0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63,
+	0x6f, 0x64, 0x65, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x43, 0x6f,
+	0x64, 0x65, 0x63, 0x22, 0x80, 0x03, 0x0a, 0x0c, 0x46, 0x65, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65,
+	0x49, 0x74, 0x65, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x06,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x37, 0x0a,
+	0x09, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1a, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e,
+	0x6f, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6e, 0x6f,
+	0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73,
+	0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x06,
+	0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x22, 0xb0, 0x01, 0x0a, 0x0f, 0x46, 0x65, 0x65, 0x64, 0x4e,
+	0x6f, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x45, 0x78, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x61, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x41, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x07, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e,
+	0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x0a, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x99, 0x02, 0x0a, 0x0e, 0x4e, 0x6f,
+	0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x65, 0x73,
+	0x63, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x12, 0x22, 0x0a, 0x0d, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x75, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f,
+	0x74, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x6e, 0x6f,
+	0x74, 0x65, 0x49, 0x64, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x12, 0x4e, 0x6f, 0x74, 0x65, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x5f,
+	0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70,
+	0x72, 0x65, 0x76, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x6e, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x2a, 0x75, 0x0a, 0x0b, 0x4e,
+	0x6f, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x12, 0x1c, 0x0a, 0x18, 0x4e, 0x4f,
+	0x54, 0x45, 0x5f, 0x50, 0x52, 0x49, 0x56, 0x41, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x55, 0x42, 0x4c,
+	0x49, 0x43, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x10,
+	0x02, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x52, 0x53, 0x10, 0x03,
+	0x12, 0x14, 0x0a, 0x10, 0x4d, 0x55, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x46, 0x4f, 0x4c, 0x4c, 0x4f,
+	0x57, 0x45, 0x52, 0x53, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d,
+	0x10, 0x05, 0x2a, 0x46, 0x0a, 0x0d, 0x4e, 0x6f, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x4e, 0x4f, 0x54, 0x45, 0x5f, 0x41, 0x53, 0x53, 0x45,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x10, 0x01, 0x12,
+	0x09, 0x0a, 0x05, 0x56, 0x49, 0x44, 0x45, 0x4f, 0x10, 0x02, 0x2a, 0x91, 0x02, 0x0a, 0x12, 0x4e,
+	0x6f, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x25, 0x0a, 0x21, 0x4e, 0x4f, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x46, 0x45, 0x5f, 0x43,
+	0x59, 0x43, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x4c, 0x49, 0x46, 0x45,
+	0x5f, 0x43, 0x59, 0x43, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x55, 0x42,
+	0x4c, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x4c, 0x49, 0x46, 0x45,
+	0x5f, 0x43, 0x59, 0x43, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x55, 0x44,
+	0x49, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x4c, 0x49, 0x46, 0x45, 0x5f,
+	0x43, 0x59, 0x43, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x4a, 0x45,
+	0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x4c, 0x49, 0x46, 0x45, 0x5f, 0x43,
+	0x59, 0x43, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x41, 0x4e, 0x4e, 0x45,
+	0x44, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x4c, 0x49, 0x46, 0x45, 0x5f, 0x43, 0x59, 0x43, 0x4c,
+	0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x52, 0x41, 0x46, 0x54, 0x10, 0x05, 0x12,
+	0x1e, 0x0a, 0x1a, 0x4c, 0x49, 0x46, 0x45, 0x5f, 0x43, 0x59, 0x43, 0x4c, 0x45, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x10, 0x06, 0x12,
 	0x1d, 0x0a, 0x19, 0x4c, 0x49, 0x46, 0x45, 0x5f, 0x43, 0x59, 0x43, 0x4c, 0x45, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1b,
-	0x0a, 0x17, 0x4c, 0x49, 0x46, 0x45, 0x5f, 0x43, 0x59, 0x43, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x45, 0x5f, 0x42, 0x41, 0x4e, 0x4e, 0x45, 0x44, 0x10, 0x04, 0x2a, 0xa3, 0x01, 0x0a, 0x09,
-	0x4e, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x4e, 0x4f, 0x54,
-	0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53,
-	0x49, 0x4e, 0x47, 0x10, 0x0a, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53,
-	0x45, 0x44, 0x10, 0x0b, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f,
-	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x0c, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x55, 0x44, 0x49,
-	0x54, 0x49, 0x4e, 0x47, 0x10, 0x14, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54,
-	0x45, 0x44, 0x10, 0x15, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x50, 0x41,
-	0x53, 0x53, 0x45, 0x44, 0x10, 0x16, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x53,
-	0x48, 0x45, 0x44, 0x10, 0x64, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x41, 0x4e, 0x4e, 0x45, 0x44, 0x10,
-	0x65, 0x42, 0xa2, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61,
+	0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x59, 0x43, 0x4c, 0x45, 0x44, 0x10, 0x07, 0x2a, 0xe0,
+	0x01, 0x0a, 0x09, 0x4e, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x16,
+	0x4e, 0x4f, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x52, 0x41, 0x46,
+	0x54, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x49, 0x4e, 0x47,
+	0x10, 0x0a, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x45, 0x44, 0x10,
+	0x0b, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x46, 0x41, 0x49,
+	0x4c, 0x45, 0x44, 0x10, 0x0c, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x55, 0x44, 0x49, 0x54, 0x49, 0x4e,
+	0x47, 0x10, 0x14, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10,
+	0x15, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x45,
+	0x44, 0x10, 0x16, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x52, 0x45, 0x56,
+	0x49, 0x45, 0x57, 0x49, 0x4e, 0x47, 0x10, 0x17, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x55, 0x42, 0x4c,
+	0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x64, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x41, 0x4e, 0x4e, 0x45,
+	0x44, 0x10, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x43, 0x59, 0x43, 0x4c, 0x45, 0x44, 0x10,
+	0x6e, 0x42, 0xa2, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61,
 	0x70, 0x69, 0x2e, 0x76, 0x31, 0x42, 0x09, 0x4e, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f,
 	0x50, 0x01, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72,
 	0x79, 0x61, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x77, 0x68, 0x69,
@@ -1205,21 +1517,23 @@ func file_note_api_v1_note_proto_rawDescGZIP() []byte {
 }
 
 var file_note_api_v1_note_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_note_api_v1_note_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_note_api_v1_note_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_note_api_v1_note_proto_goTypes = []any{
-	(NotePrivacy)(0),        // 0: note.api.v1.NotePrivacy
-	(NoteAssetType)(0),      // 1: note.api.v1.NoteAssetType
-	(NoteLifeCycleState)(0), // 2: note.api.v1.NoteLifeCycleState
-	(NoteState)(0),          // 3: note.api.v1.NoteState
-	(*NoteTag)(nil),         // 4: note.api.v1.NoteTag
-	(*NoteAtUser)(nil),      // 5: note.api.v1.NoteAtUser
-	(*NoteItem)(nil),        // 6: note.api.v1.NoteItem
-	(*NoteImageMeta)(nil),   // 7: note.api.v1.NoteImageMeta
-	(*NoteImage)(nil),       // 8: note.api.v1.NoteImage
-	(*NoteVideo)(nil),       // 9: note.api.v1.NoteVideo
-	(*NoteVideoMeta)(nil),   // 10: note.api.v1.NoteVideoMeta
-	(*FeedNoteItem)(nil),    // 11: note.api.v1.FeedNoteItem
-	(*FeedNoteItemExt)(nil), // 12: note.api.v1.FeedNoteItemExt
+	(NotePrivacy)(0),           // 0: note.api.v1.NotePrivacy
+	(NoteAssetType)(0),         // 1: note.api.v1.NoteAssetType
+	(NoteLifeCycleState)(0),    // 2: note.api.v1.NoteLifeCycleState
+	(NoteState)(0),             // 3: note.api.v1.NoteState
+	(*NoteTag)(nil),            // 4: note.api.v1.NoteTag
+	(*NoteAtUser)(nil),         // 5: note.api.v1.NoteAtUser
+	(*NoteItem)(nil),           // 6: note.api.v1.NoteItem
+	(*NoteImageMeta)(nil),      // 7: note.api.v1.NoteImageMeta
+	(*NoteImage)(nil),          // 8: note.api.v1.NoteImage
+	(*NoteVideo)(nil),          // 9: note.api.v1.NoteVideo
+	(*NoteVideoMeta)(nil),      // 10: note.api.v1.NoteVideoMeta
+	(*FeedNoteItem)(nil),       // 11: note.api.v1.FeedNoteItem
+	(*FeedNoteItemExt)(nil),    // 12: note.api.v1.FeedNoteItemExt
+	(*NoteCollection)(nil),     // 13: note.api.v1.NoteCollection
+	(*NoteCollectionLink)(nil), // 14: note.api.v1.NoteCollectionLink
 }
 var file_note_api_v1_note_proto_depIdxs = []int32{
 	8,  // 0: note.api.v1.NoteItem.images:type_name -> note.api.v1.NoteImage
@@ -1229,18 +1543,20 @@ var file_note_api_v1_note_proto_depIdxs = []int32{
 	3,  // 4: note.api.v1.NoteItem.state:type_name -> note.api.v1.NoteState
 	9,  // 5: note.api.v1.NoteItem.videos:type_name -> note.api.v1.NoteVideo
 	2,  // 6: note.api.v1.NoteItem.life_cycle_state:type_name -> note.api.v1.NoteLifeCycleState
-	7,  // 7: note.api.v1.NoteImage.meta:type_name -> note.api.v1.NoteImageMeta
-	10, // 8: note.api.v1.NoteVideo.meta:type_name -> note.api.v1.NoteVideoMeta
-	8,  // 9: note.api.v1.FeedNoteItem.images:type_name -> note.api.v1.NoteImage
-	1,  // 10: note.api.v1.FeedNoteItem.note_type:type_name -> note.api.v1.NoteAssetType
-	9,  // 11: note.api.v1.FeedNoteItem.videos:type_name -> note.api.v1.NoteVideo
-	4,  // 12: note.api.v1.FeedNoteItemExt.tags:type_name -> note.api.v1.NoteTag
-	5,  // 13: note.api.v1.FeedNoteItemExt.at_users:type_name -> note.api.v1.NoteAtUser
-	14, // [14:14] is the sub-list for method output_type
-	14, // [14:14] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	14, // 7: note.api.v1.NoteItem.collection:type_name -> note.api.v1.NoteCollectionLink
+	7,  // 8: note.api.v1.NoteImage.meta:type_name -> note.api.v1.NoteImageMeta
+	10, // 9: note.api.v1.NoteVideo.meta:type_name -> note.api.v1.NoteVideoMeta
+	8,  // 10: note.api.v1.FeedNoteItem.images:type_name -> note.api.v1.NoteImage
+	1,  // 11: note.api.v1.FeedNoteItem.note_type:type_name -> note.api.v1.NoteAssetType
+	9,  // 12: note.api.v1.FeedNoteItem.videos:type_name -> note.api.v1.NoteVideo
+	4,  // 13: note.api.v1.FeedNoteItemExt.tags:type_name -> note.api.v1.NoteTag
+	5,  // 14: note.api.v1.FeedNoteItemExt.at_users:type_name -> note.api.v1.NoteAtUser
+	14, // 15: note.api.v1.FeedNoteItemExt.collection:type_name -> note.api.v1.NoteCollectionLink
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_note_api_v1_note_proto_init() }
@@ -1357,6 +1673,30 @@ func file_note_api_v1_note_proto_init() {
 				return nil
 			}
 		}
+		file_note_api_v1_note_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*NoteCollection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_note_api_v1_note_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*NoteCollectionLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1364,7 +1704,7 @@ func file_note_api_v1_note_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_note_api_v1_note_proto_rawDesc,
 			NumEnums:      4,
-			NumMessages:   9,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
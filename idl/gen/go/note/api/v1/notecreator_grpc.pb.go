@@ -19,16 +19,31 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	NoteCreatorService_IsUserOwnNote_FullMethodName  = "/note.api.v1.NoteCreatorService/IsUserOwnNote"
-	NoteCreatorService_IsNoteExist_FullMethodName    = "/note.api.v1.NoteCreatorService/IsNoteExist"
-	NoteCreatorService_CreateNote_FullMethodName     = "/note.api.v1.NoteCreatorService/CreateNote"
-	NoteCreatorService_UpdateNote_FullMethodName     = "/note.api.v1.NoteCreatorService/UpdateNote"
-	NoteCreatorService_DeleteNote_FullMethodName     = "/note.api.v1.NoteCreatorService/DeleteNote"
-	NoteCreatorService_GetNote_FullMethodName        = "/note.api.v1.NoteCreatorService/GetNote"
-	NoteCreatorService_ListNote_FullMethodName       = "/note.api.v1.NoteCreatorService/ListNote"
-	NoteCreatorService_PageListNote_FullMethodName   = "/note.api.v1.NoteCreatorService/PageListNote"
-	NoteCreatorService_GetPostedCount_FullMethodName = "/note.api.v1.NoteCreatorService/GetPostedCount"
-	NoteCreatorService_AddTag_FullMethodName         = "/note.api.v1.NoteCreatorService/AddTag"
+	NoteCreatorService_IsUserOwnNote_FullMethodName         = "/note.api.v1.NoteCreatorService/IsUserOwnNote"
+	NoteCreatorService_IsNoteExist_FullMethodName           = "/note.api.v1.NoteCreatorService/IsNoteExist"
+	NoteCreatorService_CreateNote_FullMethodName            = "/note.api.v1.NoteCreatorService/CreateNote"
+	NoteCreatorService_UpdateNote_FullMethodName            = "/note.api.v1.NoteCreatorService/UpdateNote"
+	NoteCreatorService_DeleteNote_FullMethodName            = "/note.api.v1.NoteCreatorService/DeleteNote"
+	NoteCreatorService_GetNote_FullMethodName               = "/note.api.v1.NoteCreatorService/GetNote"
+	NoteCreatorService_ListNote_FullMethodName              = "/note.api.v1.NoteCreatorService/ListNote"
+	NoteCreatorService_PageListNote_FullMethodName          = "/note.api.v1.NoteCreatorService/PageListNote"
+	NoteCreatorService_GetPostedCount_FullMethodName        = "/note.api.v1.NoteCreatorService/GetPostedCount"
+	NoteCreatorService_AddTag_FullMethodName                = "/note.api.v1.NoteCreatorService/AddTag"
+	NoteCreatorService_ListDrafts_FullMethodName            = "/note.api.v1.NoteCreatorService/ListDrafts"
+	NoteCreatorService_PublishDraft_FullMethodName          = "/note.api.v1.NoteCreatorService/PublishDraft"
+	NoteCreatorService_ListNoteRevisions_FullMethodName     = "/note.api.v1.NoteCreatorService/ListNoteRevisions"
+	NoteCreatorService_GetNoteRevision_FullMethodName       = "/note.api.v1.NoteCreatorService/GetNoteRevision"
+	NoteCreatorService_RestoreNoteRevision_FullMethodName   = "/note.api.v1.NoteCreatorService/RestoreNoteRevision"
+	NoteCreatorService_ListDeletedNotes_FullMethodName      = "/note.api.v1.NoteCreatorService/ListDeletedNotes"
+	NoteCreatorService_RestoreNote_FullMethodName           = "/note.api.v1.NoteCreatorService/RestoreNote"
+	NoteCreatorService_CreateCollection_FullMethodName      = "/note.api.v1.NoteCreatorService/CreateCollection"
+	NoteCreatorService_UpdateCollection_FullMethodName      = "/note.api.v1.NoteCreatorService/UpdateCollection"
+	NoteCreatorService_DeleteCollection_FullMethodName      = "/note.api.v1.NoteCreatorService/DeleteCollection"
+	NoteCreatorService_GetCollection_FullMethodName         = "/note.api.v1.NoteCreatorService/GetCollection"
+	NoteCreatorService_ListCollections_FullMethodName       = "/note.api.v1.NoteCreatorService/ListCollections"
+	NoteCreatorService_AddCollectionNotes_FullMethodName    = "/note.api.v1.NoteCreatorService/AddCollectionNotes"
+	NoteCreatorService_RemoveCollectionNotes_FullMethodName = "/note.api.v1.NoteCreatorService/RemoveCollectionNotes"
+	NoteCreatorService_SortCollectionNotes_FullMethodName   = "/note.api.v1.NoteCreatorService/SortCollectionNotes"
 )
 
 // NoteCreatorServiceClient is the client API for NoteCreatorService service.
@@ -46,7 +61,7 @@ type NoteCreatorServiceClient interface {
 	CreateNote(ctx context.Context, in *CreateNoteRequest, opts ...grpc.CallOption) (*CreateNoteResponse, error)
 	// 更新笔记
 	UpdateNote(ctx context.Context, in *UpdateNoteRequest, opts ...grpc.CallOption) (*UpdateNoteResponse, error)
-	// 删除笔记
+	// 删除笔记 删除后的笔记进入回收站 超过保留时间后彻底删除
 	DeleteNote(ctx context.Context, in *DeleteNoteRequest, opts ...grpc.CallOption) (*DeleteNoteResponse, error)
 	// 获取笔记的信息
 	GetNote(ctx context.Context, in *GetNoteRequest, opts ...grpc.CallOption) (*GetNoteResponse, error)
@@ -58,6 +73,36 @@ type NoteCreatorServiceClient interface {
 	GetPostedCount(ctx context.Context, in *GetPostedCountRequest, opts ...grpc.CallOption) (*GetPostedCountResponse, error)
 	// 新增标签
 	AddTag(ctx context.Context, in *AddTagRequest, opts ...grpc.CallOption) (*AddTagResponse, error)
+	// 列出草稿
+	ListDrafts(ctx context.Context, in *ListDraftsRequest, opts ...grpc.CallOption) (*ListDraftsResponse, error)
+	// 发布草稿 也可用于修改定时发布笔记的发布时间
+	PublishDraft(ctx context.Context, in *PublishDraftRequest, opts ...grpc.CallOption) (*PublishDraftResponse, error)
+	// 列出笔记的修订版本
+	ListNoteRevisions(ctx context.Context, in *ListNoteRevisionsRequest, opts ...grpc.CallOption) (*ListNoteRevisionsResponse, error)
+	// 获取笔记的某个修订版本
+	GetNoteRevision(ctx context.Context, in *GetNoteRevisionRequest, opts ...grpc.CallOption) (*GetNoteRevisionResponse, error)
+	// 将笔记恢复到某个修订版本 恢复操作会生成新的版本
+	RestoreNoteRevision(ctx context.Context, in *RestoreNoteRevisionRequest, opts ...grpc.CallOption) (*RestoreNoteRevisionResponse, error)
+	// 列出回收站中的笔记
+	ListDeletedNotes(ctx context.Context, in *ListDeletedNotesRequest, opts ...grpc.CallOption) (*ListDeletedNotesResponse, error)
+	// 从回收站中恢复笔记
+	RestoreNote(ctx context.Context, in *RestoreNoteRequest, opts ...grpc.CallOption) (*RestoreNoteResponse, error)
+	// 新建合集
+	CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CreateCollectionResponse, error)
+	// 修改合集信息
+	UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*UpdateCollectionResponse, error)
+	// 删除合集 合集中的笔记不受影响
+	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error)
+	// 获取合集详情
+	GetCollection(ctx context.Context, in *GetCollectionRequest, opts ...grpc.CallOption) (*GetCollectionResponse, error)
+	// 列出自己的全部合集
+	ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsResponse, error)
+	// 添加笔记到合集
+	AddCollectionNotes(ctx context.Context, in *AddCollectionNotesRequest, opts ...grpc.CallOption) (*AddCollectionNotesResponse, error)
+	// 从合集中移除笔记
+	RemoveCollectionNotes(ctx context.Context, in *RemoveCollectionNotesRequest, opts ...grpc.CallOption) (*RemoveCollectionNotesResponse, error)
+	// 调整合集中笔记的顺序
+	SortCollectionNotes(ctx context.Context, in *SortCollectionNotesRequest, opts ...grpc.CallOption) (*SortCollectionNotesResponse, error)
 }
 
 type noteCreatorServiceClient struct {
@@ -168,6 +213,156 @@ func (c *noteCreatorServiceClient) AddTag(ctx context.Context, in *AddTagRequest
 	return out, nil
 }
 
+func (c *noteCreatorServiceClient) ListDrafts(ctx context.Context, in *ListDraftsRequest, opts ...grpc.CallOption) (*ListDraftsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDraftsResponse)
+	err := c.cc.Invoke(ctx, NoteCreatorService_ListDrafts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteCreatorServiceClient) PublishDraft(ctx context.Context, in *PublishDraftRequest, opts ...grpc.CallOption) (*PublishDraftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishDraftResponse)
+	err := c.cc.Invoke(ctx, NoteCreatorService_PublishDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteCreatorServiceClient) ListNoteRevisions(ctx context.Context, in *ListNoteRevisionsRequest, opts ...grpc.CallOption) (*ListNoteRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNoteRevisionsResponse)
+	err := c.cc.Invoke(ctx, NoteCreatorService_ListNoteRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteCreatorServiceClient) GetNoteRevision(ctx context.Context, in *GetNoteRevisionRequest, opts ...grpc.CallOption) (*GetNoteRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNoteRevisionResponse)
+	err := c.cc.Invoke(ctx, NoteCreatorService_GetNoteRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteCreatorServiceClient) RestoreNoteRevision(ctx context.Context, in *RestoreNoteRevisionRequest, opts ...grpc.CallOption) (*RestoreNoteRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreNoteRevisionResponse)
+	err := c.cc.Invoke(ctx, NoteCreatorService_RestoreNoteRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteCreatorServiceClient) ListDeletedNotes(ctx context.Context, in *ListDeletedNotesRequest, opts ...grpc.CallOption) (*ListDeletedNotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedNotesResponse)
+	err := c.cc.Invoke(ctx, NoteCreatorService_ListDeletedNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteCreatorServiceClient) RestoreNote(ctx context.Context, in *RestoreNoteRequest, opts ...grpc.CallOption) (*RestoreNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreNoteResponse)
+	err := c.cc.Invoke(ctx, NoteCreatorService_RestoreNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteCreatorServiceClient) CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CreateCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCollectionResponse)
+	err := c.cc.Invoke(ctx, NoteCreatorService_CreateCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteCreatorServiceClient) UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*UpdateCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCollectionResponse)
+	err := c.cc.Invoke(ctx, NoteCreatorService_UpdateCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteCreatorServiceClient) DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCollectionResponse)
+	err := c.cc.Invoke(ctx, NoteCreatorService_DeleteCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteCreatorServiceClient) GetCollection(ctx context.Context, in *GetCollectionRequest, opts ...grpc.CallOption) (*GetCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCollectionResponse)
+	err := c.cc.Invoke(ctx, NoteCreatorService_GetCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteCreatorServiceClient) ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollectionsResponse)
+	err := c.cc.Invoke(ctx, NoteCreatorService_ListCollections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteCreatorServiceClient) AddCollectionNotes(ctx context.Context, in *AddCollectionNotesRequest, opts ...grpc.CallOption) (*AddCollectionNotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddCollectionNotesResponse)
+	err := c.cc.Invoke(ctx, NoteCreatorService_AddCollectionNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteCreatorServiceClient) RemoveCollectionNotes(ctx context.Context, in *RemoveCollectionNotesRequest, opts ...grpc.CallOption) (*RemoveCollectionNotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveCollectionNotesResponse)
+	err := c.cc.Invoke(ctx, NoteCreatorService_RemoveCollectionNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteCreatorServiceClient) SortCollectionNotes(ctx context.Context, in *SortCollectionNotesRequest, opts ...grpc.CallOption) (*SortCollectionNotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SortCollectionNotesResponse)
+	err := c.cc.Invoke(ctx, NoteCreatorService_SortCollectionNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NoteCreatorServiceServer is the server API for NoteCreatorService service.
 // All implementations must embed UnimplementedNoteCreatorServiceServer
 // for forward compatibility.
@@ -183,7 +378,7 @@ type NoteCreatorServiceServer interface {
 	CreateNote(context.Context, *CreateNoteRequest) (*CreateNoteResponse, error)
 	// 更新笔记
 	UpdateNote(context.Context, *UpdateNoteRequest) (*UpdateNoteResponse, error)
-	// 删除笔记
+	// 删除笔记 删除后的笔记进入回收站 超过保留时间后彻底删除
 	DeleteNote(context.Context, *DeleteNoteRequest) (*DeleteNoteResponse, error)
 	// 获取笔记的信息
 	GetNote(context.Context, *GetNoteRequest) (*GetNoteResponse, error)
@@ -195,6 +390,36 @@ type NoteCreatorServiceServer interface {
 	GetPostedCount(context.Context, *GetPostedCountRequest) (*GetPostedCountResponse, error)
 	// 新增标签
 	AddTag(context.Context, *AddTagRequest) (*AddTagResponse, error)
+	// 列出草稿
+	ListDrafts(context.Context, *ListDraftsRequest) (*ListDraftsResponse, error)
+	// 发布草稿 也可用于修改定时发布笔记的发布时间
+	PublishDraft(context.Context, *PublishDraftRequest) (*PublishDraftResponse, error)
+	// 列出笔记的修订版本
+	ListNoteRevisions(context.Context, *ListNoteRevisionsRequest) (*ListNoteRevisionsResponse, error)
+	// 获取笔记的某个修订版本
+	GetNoteRevision(context.Context, *GetNoteRevisionRequest) (*GetNoteRevisionResponse, error)
+	// 将笔记恢复到某个修订版本 恢复操作会生成新的版本
+	RestoreNoteRevision(context.Context, *RestoreNoteRevisionRequest) (*RestoreNoteRevisionResponse, error)
+	// 列出回收站中的笔记
+	ListDeletedNotes(context.Context, *ListDeletedNotesRequest) (*ListDeletedNotesResponse, error)
+	// 从回收站中恢复笔记
+	RestoreNote(context.Context, *RestoreNoteRequest) (*RestoreNoteResponse, error)
+	// 新建合集
+	CreateCollection(context.Context, *CreateCollectionRequest) (*CreateCollectionResponse, error)
+	// 修改合集信息
+	UpdateCollection(context.Context, *UpdateCollectionRequest) (*UpdateCollectionResponse, error)
+	// 删除合集 合集中的笔记不受影响
+	DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error)
+	// 获取合集详情
+	GetCollection(context.Context, *GetCollectionRequest) (*GetCollectionResponse, error)
+	// 列出自己的全部合集
+	ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsResponse, error)
+	// 添加笔记到合集
+	AddCollectionNotes(context.Context, *AddCollectionNotesRequest) (*AddCollectionNotesResponse, error)
+	// 从合集中移除笔记
+	RemoveCollectionNotes(context.Context, *RemoveCollectionNotesRequest) (*RemoveCollectionNotesResponse, error)
+	// 调整合集中笔记的顺序
+	SortCollectionNotes(context.Context, *SortCollectionNotesRequest) (*SortCollectionNotesResponse, error)
 	mustEmbedUnimplementedNoteCreatorServiceServer()
 }
 
@@ -235,6 +460,51 @@ func (UnimplementedNoteCreatorServiceServer) GetPostedCount(context.Context, *Ge
 func (UnimplementedNoteCreatorServiceServer) AddTag(context.Context, *AddTagRequest) (*AddTagResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddTag not implemented")
 }
+func (UnimplementedNoteCreatorServiceServer) ListDrafts(context.Context, *ListDraftsRequest) (*ListDraftsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDrafts not implemented")
+}
+func (UnimplementedNoteCreatorServiceServer) PublishDraft(context.Context, *PublishDraftRequest) (*PublishDraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishDraft not implemented")
+}
+func (UnimplementedNoteCreatorServiceServer) ListNoteRevisions(context.Context, *ListNoteRevisionsRequest) (*ListNoteRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNoteRevisions not implemented")
+}
+func (UnimplementedNoteCreatorServiceServer) GetNoteRevision(context.Context, *GetNoteRevisionRequest) (*GetNoteRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNoteRevision not implemented")
+}
+func (UnimplementedNoteCreatorServiceServer) RestoreNoteRevision(context.Context, *RestoreNoteRevisionRequest) (*RestoreNoteRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreNoteRevision not implemented")
+}
+func (UnimplementedNoteCreatorServiceServer) ListDeletedNotes(context.Context, *ListDeletedNotesRequest) (*ListDeletedNotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedNotes not implemented")
+}
+func (UnimplementedNoteCreatorServiceServer) RestoreNote(context.Context, *RestoreNoteRequest) (*RestoreNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreNote not implemented")
+}
+func (UnimplementedNoteCreatorServiceServer) CreateCollection(context.Context, *CreateCollectionRequest) (*CreateCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCollection not implemented")
+}
+func (UnimplementedNoteCreatorServiceServer) UpdateCollection(context.Context, *UpdateCollectionRequest) (*UpdateCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCollection not implemented")
+}
+func (UnimplementedNoteCreatorServiceServer) DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCollection not implemented")
+}
+func (UnimplementedNoteCreatorServiceServer) GetCollection(context.Context, *GetCollectionRequest) (*GetCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollection not implemented")
+}
+func (UnimplementedNoteCreatorServiceServer) ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollections not implemented")
+}
+func (UnimplementedNoteCreatorServiceServer) AddCollectionNotes(context.Context, *AddCollectionNotesRequest) (*AddCollectionNotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCollectionNotes not implemented")
+}
+func (UnimplementedNoteCreatorServiceServer) RemoveCollectionNotes(context.Context, *RemoveCollectionNotesRequest) (*RemoveCollectionNotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCollectionNotes not implemented")
+}
+func (UnimplementedNoteCreatorServiceServer) SortCollectionNotes(context.Context, *SortCollectionNotesRequest) (*SortCollectionNotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SortCollectionNotes not implemented")
+}
 func (UnimplementedNoteCreatorServiceServer) mustEmbedUnimplementedNoteCreatorServiceServer() {}
 func (UnimplementedNoteCreatorServiceServer) testEmbeddedByValue()                            {}
 
@@ -436,6 +706,276 @@ func _NoteCreatorService_AddTag_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NoteCreatorService_ListDrafts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDraftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteCreatorServiceServer).ListDrafts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NoteCreatorService_ListDrafts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteCreatorServiceServer).ListDrafts(ctx, req.(*ListDraftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteCreatorService_PublishDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteCreatorServiceServer).PublishDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NoteCreatorService_PublishDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteCreatorServiceServer).PublishDraft(ctx, req.(*PublishDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteCreatorService_ListNoteRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNoteRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteCreatorServiceServer).ListNoteRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NoteCreatorService_ListNoteRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteCreatorServiceServer).ListNoteRevisions(ctx, req.(*ListNoteRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteCreatorService_GetNoteRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNoteRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteCreatorServiceServer).GetNoteRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NoteCreatorService_GetNoteRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteCreatorServiceServer).GetNoteRevision(ctx, req.(*GetNoteRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteCreatorService_RestoreNoteRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreNoteRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteCreatorServiceServer).RestoreNoteRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NoteCreatorService_RestoreNoteRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteCreatorServiceServer).RestoreNoteRevision(ctx, req.(*RestoreNoteRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteCreatorService_ListDeletedNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteCreatorServiceServer).ListDeletedNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NoteCreatorService_ListDeletedNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteCreatorServiceServer).ListDeletedNotes(ctx, req.(*ListDeletedNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteCreatorService_RestoreNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteCreatorServiceServer).RestoreNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NoteCreatorService_RestoreNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteCreatorServiceServer).RestoreNote(ctx, req.(*RestoreNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteCreatorService_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteCreatorServiceServer).CreateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NoteCreatorService_CreateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteCreatorServiceServer).CreateCollection(ctx, req.(*CreateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteCreatorService_UpdateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteCreatorServiceServer).UpdateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NoteCreatorService_UpdateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteCreatorServiceServer).UpdateCollection(ctx, req.(*UpdateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteCreatorService_DeleteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteCreatorServiceServer).DeleteCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NoteCreatorService_DeleteCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteCreatorServiceServer).DeleteCollection(ctx, req.(*DeleteCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteCreatorService_GetCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteCreatorServiceServer).GetCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NoteCreatorService_GetCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteCreatorServiceServer).GetCollection(ctx, req.(*GetCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteCreatorService_ListCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteCreatorServiceServer).ListCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NoteCreatorService_ListCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteCreatorServiceServer).ListCollections(ctx, req.(*ListCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteCreatorService_AddCollectionNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCollectionNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteCreatorServiceServer).AddCollectionNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NoteCreatorService_AddCollectionNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteCreatorServiceServer).AddCollectionNotes(ctx, req.(*AddCollectionNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteCreatorService_RemoveCollectionNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCollectionNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteCreatorServiceServer).RemoveCollectionNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NoteCreatorService_RemoveCollectionNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteCreatorServiceServer).RemoveCollectionNotes(ctx, req.(*RemoveCollectionNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteCreatorService_SortCollectionNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SortCollectionNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteCreatorServiceServer).SortCollectionNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NoteCreatorService_SortCollectionNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteCreatorServiceServer).SortCollectionNotes(ctx, req.(*SortCollectionNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NoteCreatorService_ServiceDesc is the grpc.ServiceDesc for NoteCreatorService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -483,6 +1023,66 @@ var NoteCreatorService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddTag",
 			Handler:    _NoteCreatorService_AddTag_Handler,
 		},
+		{
+			MethodName: "ListDrafts",
+			Handler:    _NoteCreatorService_ListDrafts_Handler,
+		},
+		{
+			MethodName: "PublishDraft",
+			Handler:    _NoteCreatorService_PublishDraft_Handler,
+		},
+		{
+			MethodName: "ListNoteRevisions",
+			Handler:    _NoteCreatorService_ListNoteRevisions_Handler,
+		},
+		{
+			MethodName: "GetNoteRevision",
+			Handler:    _NoteCreatorService_GetNoteRevision_Handler,
+		},
+		{
+			MethodName: "RestoreNoteRevision",
+			Handler:    _NoteCreatorService_RestoreNoteRevision_Handler,
+		},
+		{
+			MethodName: "ListDeletedNotes",
+			Handler:    _NoteCreatorService_ListDeletedNotes_Handler,
+		},
+		{
+			MethodName: "RestoreNote",
+			Handler:    _NoteCreatorService_RestoreNote_Handler,
+		},
+		{
+			MethodName: "CreateCollection",
+			Handler:    _NoteCreatorService_CreateCollection_Handler,
+		},
+		{
+			MethodName: "UpdateCollection",
+			Handler:    _NoteCreatorService_UpdateCollection_Handler,
+		},
+		{
+			MethodName: "DeleteCollection",
+			Handler:    _NoteCreatorService_DeleteCollection_Handler,
+		},
+		{
+			MethodName: "GetCollection",
+			Handler:    _NoteCreatorService_GetCollection_Handler,
+		},
+		{
+			MethodName: "ListCollections",
+			Handler:    _NoteCreatorService_ListCollections_Handler,
+		},
+		{
+			MethodName: "AddCollectionNotes",
+			Handler:    _NoteCreatorService_AddCollectionNotes_Handler,
+		},
+		{
+			MethodName: "RemoveCollectionNotes",
+			Handler:    _NoteCreatorService_RemoveCollectionNotes_Handler,
+		},
+		{
+			MethodName: "SortCollectionNotes",
+			Handler:    _NoteCreatorService_SortCollectionNotes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "note/api/v1/notecreator.proto",
@@ -29,6 +29,8 @@ const (
 	NoteFeedService_GetTagInfo_FullMethodName              = "/note.api.v1.NoteFeedService/GetTagInfo"
 	NoteFeedService_GetPublicPostedCount_FullMethodName    = "/note.api.v1.NoteFeedService/GetPublicPostedCount"
 	NoteFeedService_BatchCheckFeedNoteExist_FullMethodName = "/note.api.v1.NoteFeedService/BatchCheckFeedNoteExist"
+	NoteFeedService_ListUserCollections_FullMethodName     = "/note.api.v1.NoteFeedService/ListUserCollections"
+	NoteFeedService_ListCollectionFeed_FullMethodName      = "/note.api.v1.NoteFeedService/ListCollectionFeed"
 )
 
 // NoteFeedServiceClient is the client API for NoteFeedService service.
@@ -57,6 +59,10 @@ type NoteFeedServiceClient interface {
 	GetPublicPostedCount(ctx context.Context, in *GetPublicPostedCountRequest, opts ...grpc.CallOption) (*GetPublicPostedCountResponse, error)
 	// 检查笔记是否存在（存在且公开）
 	BatchCheckFeedNoteExist(ctx context.Context, in *BatchCheckFeedNoteExistRequest, opts ...grpc.CallOption) (*BatchCheckFeedNoteExistResponse, error)
+	// 列出用户公开的合集
+	ListUserCollections(ctx context.Context, in *ListUserCollectionsRequest, opts ...grpc.CallOption) (*ListUserCollectionsResponse, error)
+	// 按顺序列出合集中公开的笔记
+	ListCollectionFeed(ctx context.Context, in *ListCollectionFeedRequest, opts ...grpc.CallOption) (*ListCollectionFeedResponse, error)
 }
 
 type noteFeedServiceClient struct {
@@ -167,6 +173,26 @@ func (c *noteFeedServiceClient) BatchCheckFeedNoteExist(ctx context.Context, in
 	return out, nil
 }
 
+func (c *noteFeedServiceClient) ListUserCollections(ctx context.Context, in *ListUserCollectionsRequest, opts ...grpc.CallOption) (*ListUserCollectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserCollectionsResponse)
+	err := c.cc.Invoke(ctx, NoteFeedService_ListUserCollections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteFeedServiceClient) ListCollectionFeed(ctx context.Context, in *ListCollectionFeedRequest, opts ...grpc.CallOption) (*ListCollectionFeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollectionFeedResponse)
+	err := c.cc.Invoke(ctx, NoteFeedService_ListCollectionFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NoteFeedServiceServer is the server API for NoteFeedService service.
 // All implementations must embed UnimplementedNoteFeedServiceServer
 // for forward compatibility.
@@ -193,6 +219,10 @@ type NoteFeedServiceServer interface {
 	GetPublicPostedCount(context.Context, *GetPublicPostedCountRequest) (*GetPublicPostedCountResponse, error)
 	// 检查笔记是否存在（存在且公开）
 	BatchCheckFeedNoteExist(context.Context, *BatchCheckFeedNoteExistRequest) (*BatchCheckFeedNoteExistResponse, error)
+	// 列出用户公开的合集
+	ListUserCollections(context.Context, *ListUserCollectionsRequest) (*ListUserCollectionsResponse, error)
+	// 按顺序列出合集中公开的笔记
+	ListCollectionFeed(context.Context, *ListCollectionFeedRequest) (*ListCollectionFeedResponse, error)
 	mustEmbedUnimplementedNoteFeedServiceServer()
 }
 
@@ -233,6 +263,12 @@ func (UnimplementedNoteFeedServiceServer) GetPublicPostedCount(context.Context,
 func (UnimplementedNoteFeedServiceServer) BatchCheckFeedNoteExist(context.Context, *BatchCheckFeedNoteExistRequest) (*BatchCheckFeedNoteExistResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchCheckFeedNoteExist not implemented")
 }
+func (UnimplementedNoteFeedServiceServer) ListUserCollections(context.Context, *ListUserCollectionsRequest) (*ListUserCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserCollections not implemented")
+}
+func (UnimplementedNoteFeedServiceServer) ListCollectionFeed(context.Context, *ListCollectionFeedRequest) (*ListCollectionFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollectionFeed not implemented")
+}
 func (UnimplementedNoteFeedServiceServer) mustEmbedUnimplementedNoteFeedServiceServer() {}
 func (UnimplementedNoteFeedServiceServer) testEmbeddedByValue()                         {}
 
@@ -434,6 +470,42 @@ func _NoteFeedService_BatchCheckFeedNoteExist_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NoteFeedService_ListUserCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteFeedServiceServer).ListUserCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NoteFeedService_ListUserCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteFeedServiceServer).ListUserCollections(ctx, req.(*ListUserCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteFeedService_ListCollectionFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteFeedServiceServer).ListCollectionFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NoteFeedService_ListCollectionFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteFeedServiceServer).ListCollectionFeed(ctx, req.(*ListCollectionFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NoteFeedService_ServiceDesc is the grpc.ServiceDesc for NoteFeedService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -481,6 +553,14 @@ var NoteFeedService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchCheckFeedNoteExist",
 			Handler:    _NoteFeedService_BatchCheckFeedNoteExist_Handler,
 		},
+		{
+			MethodName: "ListUserCollections",
+			Handler:    _NoteFeedService_ListUserCollections_Handler,
+		},
+		{
+			MethodName: "ListCollectionFeed",
+			Handler:    _NoteFeedService_ListCollectionFeed_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "note/api/v1/notefeed.proto",
@@ -25,6 +25,7 @@ const (
 	NoteInteractService_BatchCheckUserLikeStatus_FullMethodName = "/note.api.v1.NoteInteractService/BatchCheckUserLikeStatus"
 	NoteInteractService_GetNoteInteraction_FullMethodName       = "/note.api.v1.NoteInteractService/GetNoteInteraction"
 	NoteInteractService_PageListUserLikedNote_FullMethodName    = "/note.api.v1.NoteInteractService/PageListUserLikedNote"
+	NoteInteractService_ReportNoteViews_FullMethodName          = "/note.api.v1.NoteInteractService/ReportNoteViews"
 )
 
 // NoteInteractServiceClient is the client API for NoteInteractService service.
@@ -45,6 +46,8 @@ type NoteInteractServiceClient interface {
 	GetNoteInteraction(ctx context.Context, in *GetNoteInteractionRequest, opts ...grpc.CallOption) (*GetNoteInteractionResponse, error)
 	// 获取用户点赞过的笔记
 	PageListUserLikedNote(ctx context.Context, in *PageListUserLikedNoteRequest, opts ...grpc.CallOption) (*PageListUserLikedNoteResponse, error)
+	// 批量上报笔记浏览
+	ReportNoteViews(ctx context.Context, in *ReportNoteViewsRequest, opts ...grpc.CallOption) (*ReportNoteViewsResponse, error)
 }
 
 type noteInteractServiceClient struct {
@@ -115,6 +118,16 @@ func (c *noteInteractServiceClient) PageListUserLikedNote(ctx context.Context, i
 	return out, nil
 }
 
+func (c *noteInteractServiceClient) ReportNoteViews(ctx context.Context, in *ReportNoteViewsRequest, opts ...grpc.CallOption) (*ReportNoteViewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportNoteViewsResponse)
+	err := c.cc.Invoke(ctx, NoteInteractService_ReportNoteViews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NoteInteractServiceServer is the server API for NoteInteractService service.
 // All implementations must embed UnimplementedNoteInteractServiceServer
 // for forward compatibility.
@@ -133,6 +146,8 @@ type NoteInteractServiceServer interface {
 	GetNoteInteraction(context.Context, *GetNoteInteractionRequest) (*GetNoteInteractionResponse, error)
 	// 获取用户点赞过的笔记
 	PageListUserLikedNote(context.Context, *PageListUserLikedNoteRequest) (*PageListUserLikedNoteResponse, error)
+	// 批量上报笔记浏览
+	ReportNoteViews(context.Context, *ReportNoteViewsRequest) (*ReportNoteViewsResponse, error)
 	mustEmbedUnimplementedNoteInteractServiceServer()
 }
 
@@ -161,6 +176,9 @@ func (UnimplementedNoteInteractServiceServer) GetNoteInteraction(context.Context
 func (UnimplementedNoteInteractServiceServer) PageListUserLikedNote(context.Context, *PageListUserLikedNoteRequest) (*PageListUserLikedNoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PageListUserLikedNote not implemented")
 }
+func (UnimplementedNoteInteractServiceServer) ReportNoteViews(context.Context, *ReportNoteViewsRequest) (*ReportNoteViewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportNoteViews not implemented")
+}
 func (UnimplementedNoteInteractServiceServer) mustEmbedUnimplementedNoteInteractServiceServer() {}
 func (UnimplementedNoteInteractServiceServer) testEmbeddedByValue()                             {}
 
@@ -290,6 +308,24 @@ func _NoteInteractService_PageListUserLikedNote_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NoteInteractService_ReportNoteViews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportNoteViewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteInteractServiceServer).ReportNoteViews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NoteInteractService_ReportNoteViews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteInteractServiceServer).ReportNoteViews(ctx, req.(*ReportNoteViewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NoteInteractService_ServiceDesc is the grpc.ServiceDesc for NoteInteractService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -321,6 +357,10 @@ var NoteInteractService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PageListUserLikedNote",
 			Handler:    _NoteInteractService_PageListUserLikedNote_Handler,
 		},
+		{
+			MethodName: "ReportNoteViews",
+			Handler:    _NoteInteractService_ReportNoteViews_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "note/api/v1/noteinteract.proto",
@@ -448,6 +448,220 @@ func (*BatchUpdateNoteCommentCountResponse) Descriptor() ([]byte, []int) {
 	return file_search_api_v1_document_proto_rawDescGZIP(), []int{9}
 }
 
+type BatchGetNoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *BatchGetNoteRequest) Reset() {
+	*x = BatchGetNoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_search_api_v1_document_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetNoteRequest) ProtoMessage() {}
+
+func (x *BatchGetNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_search_api_v1_document_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetNoteRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetNoteRequest) Descriptor() ([]byte, []int) {
+	return file_search_api_v1_document_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BatchGetNoteRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type BatchGetNoteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// note_id => note 不存在的文档不返回
+	Notes map[string]*Note `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *BatchGetNoteResponse) Reset() {
+	*x = BatchGetNoteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_search_api_v1_document_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetNoteResponse) ProtoMessage() {}
+
+func (x *BatchGetNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_search_api_v1_document_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetNoteResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetNoteResponse) Descriptor() ([]byte, []int) {
+	return file_search_api_v1_document_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BatchGetNoteResponse) GetNotes() map[string]*Note {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+type ListNoteIdsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 上一页最后一个note_id 为空时从头开始
+	Cursor string `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Count  int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ListNoteIdsRequest) Reset() {
+	*x = ListNoteIdsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_search_api_v1_document_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListNoteIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNoteIdsRequest) ProtoMessage() {}
+
+func (x *ListNoteIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_search_api_v1_document_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNoteIdsRequest.ProtoReflect.Descriptor instead.
+func (*ListNoteIdsRequest) Descriptor() ([]byte, []int) {
+	return file_search_api_v1_document_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListNoteIdsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListNoteIdsRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ListNoteIdsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids        []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	NextCursor string   `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasNext    bool     `protobuf:"varint,3,opt,name=has_next,json=hasNext,proto3" json:"has_next,omitempty"`
+}
+
+func (x *ListNoteIdsResponse) Reset() {
+	*x = ListNoteIdsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_search_api_v1_document_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListNoteIdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNoteIdsResponse) ProtoMessage() {}
+
+func (x *ListNoteIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_search_api_v1_document_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNoteIdsResponse.ProtoReflect.Descriptor instead.
+func (*ListNoteIdsResponse) Descriptor() ([]byte, []int) {
+	return file_search_api_v1_document_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListNoteIdsResponse) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *ListNoteIdsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListNoteIdsResponse) GetHasNext() bool {
+	if x != nil {
+		return x.HasNext
+	}
+	return false
+}
+
 var File_search_api_v1_document_proto protoreflect.FileDescriptor
 
 var file_search_api_v1_document_proto_rawDesc = []byte{
@@ -501,7 +715,31 @@ var file_search_api_v1_document_proto_rawDesc = []byte{
 	0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x25, 0x0a,
 	0x23, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65,
 	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x32, 0xba, 0x04, 0x0a, 0x0f, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74,
+	0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0xab, 0x01,
+	0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4e, 0x6f,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x1a, 0x4d, 0x0a, 0x0a,
+	0x4e, 0x6f, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x42, 0x0a, 0x12, 0x4c,
+	0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x63, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e,
+	0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73,
+	0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73,
+	0x4e, 0x65, 0x78, 0x74, 0x32, 0xe9, 0x05, 0x0a, 0x0f, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
 	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x60, 0x0a, 0x0f, 0x42, 0x61, 0x74, 0x63,
 	0x68, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x67, 0x12, 0x25, 0x2e, 0x73, 0x65,
 	0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63,
@@ -536,19 +774,30 @@ var file_search_api_v1_document_proto_rawDesc = []byte{
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61,
 	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74,
 	0x65, 0x4e, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x08,
-	0x01, 0x42, 0xb2, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x42, 0x0d, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
-	0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x79, 0x61, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x62, 0x6f, 0x6f,
-	0x6b, 0x73, 0x2f, 0x77, 0x68, 0x69, 0x6d, 0x65, 0x72, 0x2f, 0x69, 0x64, 0x6c, 0x2f, 0x67, 0x65,
-	0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x41, 0x58, 0xaa, 0x02, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x2e, 0x41, 0x70, 0x69, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x3a, 0x3a, 0x41,
-	0x70, 0x69, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0c, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47,
+	0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x54, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x64,
+	0x73, 0x12, 0x21, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x08, 0x01,
+	0x42, 0xb2, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x42, 0x0d, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x79, 0x61, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x62, 0x6f, 0x6f, 0x6b,
+	0x73, 0x2f, 0x77, 0x68, 0x69, 0x6d, 0x65, 0x72, 0x2f, 0x69, 0x64, 0x6c, 0x2f, 0x67, 0x65, 0x6e,
+	0x2f, 0x67, 0x6f, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0xa2, 0x02, 0x03, 0x53, 0x41, 0x58, 0xaa, 0x02, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x2e, 0x41, 0x70, 0x69, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x3a, 0x3a, 0x41, 0x70,
+	0x69, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -563,7 +812,7 @@ func file_search_api_v1_document_proto_rawDescGZIP() []byte {
 	return file_search_api_v1_document_proto_rawDescData
 }
 
-var file_search_api_v1_document_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_search_api_v1_document_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_search_api_v1_document_proto_goTypes = []any{
 	(*BatchAddNoteTagRequest)(nil),              // 0: search.api.v1.BatchAddNoteTagRequest
 	(*BatchAddNoteTagResponse)(nil),             // 1: search.api.v1.BatchAddNoteTagResponse
@@ -575,31 +824,42 @@ var file_search_api_v1_document_proto_goTypes = []any{
 	(*BatchUpdateNoteLikeCountResponse)(nil),    // 7: search.api.v1.BatchUpdateNoteLikeCountResponse
 	(*BatchUpdateNoteCommentCountRequest)(nil),  // 8: search.api.v1.BatchUpdateNoteCommentCountRequest
 	(*BatchUpdateNoteCommentCountResponse)(nil), // 9: search.api.v1.BatchUpdateNoteCommentCountResponse
-	nil,             // 10: search.api.v1.BatchUpdateNoteLikeCountRequest.CountsEntry
-	nil,             // 11: search.api.v1.BatchUpdateNoteCommentCountRequest.CountsEntry
-	(*NoteTag)(nil), // 12: search.api.v1.NoteTag
-	(*Note)(nil),    // 13: search.api.v1.Note
+	(*BatchGetNoteRequest)(nil),                 // 10: search.api.v1.BatchGetNoteRequest
+	(*BatchGetNoteResponse)(nil),                // 11: search.api.v1.BatchGetNoteResponse
+	(*ListNoteIdsRequest)(nil),                  // 12: search.api.v1.ListNoteIdsRequest
+	(*ListNoteIdsResponse)(nil),                 // 13: search.api.v1.ListNoteIdsResponse
+	nil,                                         // 14: search.api.v1.BatchUpdateNoteLikeCountRequest.CountsEntry
+	nil,                                         // 15: search.api.v1.BatchUpdateNoteCommentCountRequest.CountsEntry
+	nil,                                         // 16: search.api.v1.BatchGetNoteResponse.NotesEntry
+	(*NoteTag)(nil),                             // 17: search.api.v1.NoteTag
+	(*Note)(nil),                                // 18: search.api.v1.Note
 }
 var file_search_api_v1_document_proto_depIdxs = []int32{
-	12, // 0: search.api.v1.BatchAddNoteTagRequest.note_tags:type_name -> search.api.v1.NoteTag
-	13, // 1: search.api.v1.BatchAddNoteRequest.notes:type_name -> search.api.v1.Note
-	10, // 2: search.api.v1.BatchUpdateNoteLikeCountRequest.counts:type_name -> search.api.v1.BatchUpdateNoteLikeCountRequest.CountsEntry
-	11, // 3: search.api.v1.BatchUpdateNoteCommentCountRequest.counts:type_name -> search.api.v1.BatchUpdateNoteCommentCountRequest.CountsEntry
-	0,  // 4: search.api.v1.DocumentService.BatchAddNoteTag:input_type -> search.api.v1.BatchAddNoteTagRequest
-	2,  // 5: search.api.v1.DocumentService.BatchAddNote:input_type -> search.api.v1.BatchAddNoteRequest
-	4,  // 6: search.api.v1.DocumentService.BatchDeleteNote:input_type -> search.api.v1.BatchDeleteNoteRequest
-	6,  // 7: search.api.v1.DocumentService.BatchUpdateNoteLikeCount:input_type -> search.api.v1.BatchUpdateNoteLikeCountRequest
-	8,  // 8: search.api.v1.DocumentService.BatchUpdateNoteCommentCount:input_type -> search.api.v1.BatchUpdateNoteCommentCountRequest
-	1,  // 9: search.api.v1.DocumentService.BatchAddNoteTag:output_type -> search.api.v1.BatchAddNoteTagResponse
-	3,  // 10: search.api.v1.DocumentService.BatchAddNote:output_type -> search.api.v1.BatchAddNoteResponse
-	5,  // 11: search.api.v1.DocumentService.BatchDeleteNote:output_type -> search.api.v1.BatchDeleteNoteResponse
-	7,  // 12: search.api.v1.DocumentService.BatchUpdateNoteLikeCount:output_type -> search.api.v1.BatchUpdateNoteLikeCountResponse
-	9,  // 13: search.api.v1.DocumentService.BatchUpdateNoteCommentCount:output_type -> search.api.v1.BatchUpdateNoteCommentCountResponse
-	9,  // [9:14] is the sub-list for method output_type
-	4,  // [4:9] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	17, // 0: search.api.v1.BatchAddNoteTagRequest.note_tags:type_name -> search.api.v1.NoteTag
+	18, // 1: search.api.v1.BatchAddNoteRequest.notes:type_name -> search.api.v1.Note
+	14, // 2: search.api.v1.BatchUpdateNoteLikeCountRequest.counts:type_name -> search.api.v1.BatchUpdateNoteLikeCountRequest.CountsEntry
+	15, // 3: search.api.v1.BatchUpdateNoteCommentCountRequest.counts:type_name -> search.api.v1.BatchUpdateNoteCommentCountRequest.CountsEntry
+	16, // 4: search.api.v1.BatchGetNoteResponse.notes:type_name -> search.api.v1.BatchGetNoteResponse.NotesEntry
+	18, // 5: search.api.v1.BatchGetNoteResponse.NotesEntry.value:type_name -> search.api.v1.Note
+	0,  // 6: search.api.v1.DocumentService.BatchAddNoteTag:input_type -> search.api.v1.BatchAddNoteTagRequest
+	2,  // 7: search.api.v1.DocumentService.BatchAddNote:input_type -> search.api.v1.BatchAddNoteRequest
+	4,  // 8: search.api.v1.DocumentService.BatchDeleteNote:input_type -> search.api.v1.BatchDeleteNoteRequest
+	6,  // 9: search.api.v1.DocumentService.BatchUpdateNoteLikeCount:input_type -> search.api.v1.BatchUpdateNoteLikeCountRequest
+	8,  // 10: search.api.v1.DocumentService.BatchUpdateNoteCommentCount:input_type -> search.api.v1.BatchUpdateNoteCommentCountRequest
+	10, // 11: search.api.v1.DocumentService.BatchGetNote:input_type -> search.api.v1.BatchGetNoteRequest
+	12, // 12: search.api.v1.DocumentService.ListNoteIds:input_type -> search.api.v1.ListNoteIdsRequest
+	1,  // 13: search.api.v1.DocumentService.BatchAddNoteTag:output_type -> search.api.v1.BatchAddNoteTagResponse
+	3,  // 14: search.api.v1.DocumentService.BatchAddNote:output_type -> search.api.v1.BatchAddNoteResponse
+	5,  // 15: search.api.v1.DocumentService.BatchDeleteNote:output_type -> search.api.v1.BatchDeleteNoteResponse
+	7,  // 16: search.api.v1.DocumentService.BatchUpdateNoteLikeCount:output_type -> search.api.v1.BatchUpdateNoteLikeCountResponse
+	9,  // 17: search.api.v1.DocumentService.BatchUpdateNoteCommentCount:output_type -> search.api.v1.BatchUpdateNoteCommentCountResponse
+	11, // 18: search.api.v1.DocumentService.BatchGetNote:output_type -> search.api.v1.BatchGetNoteResponse
+	13, // 19: search.api.v1.DocumentService.ListNoteIds:output_type -> search.api.v1.ListNoteIdsResponse
+	13, // [13:20] is the sub-list for method output_type
+	6,  // [6:13] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_search_api_v1_document_proto_init() }
@@ -729,6 +989,54 @@ func file_search_api_v1_document_proto_init() {
 				return nil
 			}
 		}
+		file_search_api_v1_document_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchGetNoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_search_api_v1_document_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchGetNoteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_search_api_v1_document_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*ListNoteIdsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_search_api_v1_document_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*ListNoteIdsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -736,7 +1044,7 @@ func file_search_api_v1_document_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_search_api_v1_document_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,6 +24,8 @@ const (
 	DocumentService_BatchDeleteNote_FullMethodName             = "/search.api.v1.DocumentService/BatchDeleteNote"
 	DocumentService_BatchUpdateNoteLikeCount_FullMethodName    = "/search.api.v1.DocumentService/BatchUpdateNoteLikeCount"
 	DocumentService_BatchUpdateNoteCommentCount_FullMethodName = "/search.api.v1.DocumentService/BatchUpdateNoteCommentCount"
+	DocumentService_BatchGetNote_FullMethodName                = "/search.api.v1.DocumentService/BatchGetNote"
+	DocumentService_ListNoteIds_FullMethodName                 = "/search.api.v1.DocumentService/ListNoteIds"
 )
 
 // DocumentServiceClient is the client API for DocumentService service.
@@ -42,6 +44,10 @@ type DocumentServiceClient interface {
 	BatchUpdateNoteLikeCount(ctx context.Context, in *BatchUpdateNoteLikeCountRequest, opts ...grpc.CallOption) (*BatchUpdateNoteLikeCountResponse, error)
 	// 更新笔记评论数量
 	BatchUpdateNoteCommentCount(ctx context.Context, in *BatchUpdateNoteCommentCountRequest, opts ...grpc.CallOption) (*BatchUpdateNoteCommentCountResponse, error)
+	// 批量获取笔记文档 用于和数据源核对
+	BatchGetNote(ctx context.Context, in *BatchGetNoteRequest, opts ...grpc.CallOption) (*BatchGetNoteResponse, error)
+	// 按笔记id顺序分页获取全部笔记文档id 用于清理数据源中已经不存在的文档
+	ListNoteIds(ctx context.Context, in *ListNoteIdsRequest, opts ...grpc.CallOption) (*ListNoteIdsResponse, error)
 }
 
 type documentServiceClient struct {
@@ -102,6 +108,26 @@ func (c *documentServiceClient) BatchUpdateNoteCommentCount(ctx context.Context,
 	return out, nil
 }
 
+func (c *documentServiceClient) BatchGetNote(ctx context.Context, in *BatchGetNoteRequest, opts ...grpc.CallOption) (*BatchGetNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetNoteResponse)
+	err := c.cc.Invoke(ctx, DocumentService_BatchGetNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) ListNoteIds(ctx context.Context, in *ListNoteIdsRequest, opts ...grpc.CallOption) (*ListNoteIdsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNoteIdsResponse)
+	err := c.cc.Invoke(ctx, DocumentService_ListNoteIds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DocumentServiceServer is the server API for DocumentService service.
 // All implementations must embed UnimplementedDocumentServiceServer
 // for forward compatibility.
@@ -118,6 +144,10 @@ type DocumentServiceServer interface {
 	BatchUpdateNoteLikeCount(context.Context, *BatchUpdateNoteLikeCountRequest) (*BatchUpdateNoteLikeCountResponse, error)
 	// 更新笔记评论数量
 	BatchUpdateNoteCommentCount(context.Context, *BatchUpdateNoteCommentCountRequest) (*BatchUpdateNoteCommentCountResponse, error)
+	// 批量获取笔记文档 用于和数据源核对
+	BatchGetNote(context.Context, *BatchGetNoteRequest) (*BatchGetNoteResponse, error)
+	// 按笔记id顺序分页获取全部笔记文档id 用于清理数据源中已经不存在的文档
+	ListNoteIds(context.Context, *ListNoteIdsRequest) (*ListNoteIdsResponse, error)
 	mustEmbedUnimplementedDocumentServiceServer()
 }
 
@@ -143,6 +173,12 @@ func (UnimplementedDocumentServiceServer) BatchUpdateNoteLikeCount(context.Conte
 func (UnimplementedDocumentServiceServer) BatchUpdateNoteCommentCount(context.Context, *BatchUpdateNoteCommentCountRequest) (*BatchUpdateNoteCommentCountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateNoteCommentCount not implemented")
 }
+func (UnimplementedDocumentServiceServer) BatchGetNote(context.Context, *BatchGetNoteRequest) (*BatchGetNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetNote not implemented")
+}
+func (UnimplementedDocumentServiceServer) ListNoteIds(context.Context, *ListNoteIdsRequest) (*ListNoteIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNoteIds not implemented")
+}
 func (UnimplementedDocumentServiceServer) mustEmbedUnimplementedDocumentServiceServer() {}
 func (UnimplementedDocumentServiceServer) testEmbeddedByValue()                         {}
 
@@ -254,6 +290,42 @@ func _DocumentService_BatchUpdateNoteCommentCount_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DocumentService_BatchGetNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).BatchGetNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentService_BatchGetNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).BatchGetNote(ctx, req.(*BatchGetNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_ListNoteIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNoteIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).ListNoteIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentService_ListNoteIds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).ListNoteIds(ctx, req.(*ListNoteIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DocumentService_ServiceDesc is the grpc.ServiceDesc for DocumentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -281,6 +353,14 @@ var DocumentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchUpdateNoteCommentCount",
 			Handler:    _DocumentService_BatchUpdateNoteCommentCount_Handler,
 		},
+		{
+			MethodName: "BatchGetNote",
+			Handler:    _DocumentService_BatchGetNote_Handler,
+		},
+		{
+			MethodName: "ListNoteIds",
+			Handler:    _DocumentService_ListNoteIds_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "search/api/v1/document.proto",
@@ -52,6 +52,9 @@ pub struct AddCommentResponse {
     /// 评论id
     #[prost(int64, tag = "1")]
     pub comment_id: i64,
+    /// 评论命中作者设置的屏蔽词 等待作者审核
+    #[prost(bool, tag = "2")]
+    pub held: bool,
 }
 /// 删除评论
 #[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
@@ -93,8 +96,16 @@ pub struct DislikeActionRequest {
 pub struct DislikeActionResponse {
 }
 /// 举报
-#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
 pub struct ReportCommentRequest {
+    /// 被举报的评论id
+    #[prost(int64, tag = "1")]
+    pub comment_id: i64,
+    #[prost(enumeration = "ReportReason", tag = "2")]
+    pub reason: i32,
+    /// 补充说明
+    #[prost(string, tag = "3")]
+    pub detail: ::prost::alloc::string::String,
 }
 #[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
 pub struct ReportCommentResponse {
@@ -115,7 +126,7 @@ pub struct PinCommentRequest {
 pub struct PinCommentResponse {
 }
 /// 获取评论
-#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
 pub struct PageGetCommentRequest {
     #[prost(int64, tag = "1")]
     pub oid: i64,
@@ -125,6 +136,9 @@ pub struct PageGetCommentRequest {
     /// 排序方式
     #[prost(enumeration = "SortType", tag = "3")]
     pub sort_by: i32,
+    /// 按热度排序时的起始游标
+    #[prost(string, tag = "4")]
+    pub trend_cursor: ::prost::alloc::string::String,
 }
 /// 评论实体
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -180,6 +194,40 @@ pub struct CommentItem {
     /// @用户列表
     #[prost(message, repeated, tag = "17")]
     pub at_users: ::prost::alloc::vec::Vec<CommentAtUser>,
+    /// 是否被编辑过
+    #[prost(bool, tag = "18")]
+    pub is_edited: bool,
+    /// 最后编辑时间
+    #[prost(int64, tag = "19")]
+    pub etime: i64,
+    /// 内容中引用的表情
+    #[prost(message, repeated, tag = "20")]
+    pub emojis: ::prost::alloc::vec::Vec<CommentEmoji>,
+}
+/// 评论内容中以\[pack:item\]形式引用的表情 发布时解析并固定版本
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct CommentEmoji {
+    /// 内容中的引用文本 如\[doge:smile\]
+    #[prost(string, tag = "1")]
+    pub token: ::prost::alloc::string::String,
+    /// 表情包代码
+    #[prost(string, tag = "2")]
+    pub pack: ::prost::alloc::string::String,
+    /// 表情代码
+    #[prost(string, tag = "3")]
+    pub code: ::prost::alloc::string::String,
+    /// 表情资源的存储key
+    #[prost(string, tag = "4")]
+    pub key: ::prost::alloc::string::String,
+    #[prost(uint32, tag = "5")]
+    pub width: u32,
+    #[prost(uint32, tag = "6")]
+    pub height: u32,
+    #[prost(string, tag = "7")]
+    pub format: ::prost::alloc::string::String,
+    /// 引用时的表情版本
+    #[prost(int64, tag = "8")]
+    pub version: i64,
 }
 #[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
 pub struct CommentItemImageMeta {
@@ -210,6 +258,9 @@ pub struct PageGetCommentResponse {
     /// 是否还有数据
     #[prost(bool, tag = "3")]
     pub has_next: bool,
+    /// 按热度排序时下一次请求的游标
+    #[prost(string, tag = "4")]
+    pub next_trend_cursor: ::prost::alloc::string::String,
 }
 /// 获取子评论
 #[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
@@ -274,7 +325,7 @@ pub struct DetailedCommentItem {
     #[prost(message, optional, tag = "2")]
     pub sub_comments: ::core::option::Option<DetailedSubComment>,
 }
-#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
 pub struct PageGetDetailedCommentRequest {
     #[prost(int64, tag = "1")]
     pub oid: i64,
@@ -284,6 +335,9 @@ pub struct PageGetDetailedCommentRequest {
     /// 排序方式
     #[prost(enumeration = "SortType", tag = "3")]
     pub sort_by: i32,
+    /// 按热度排序时的起始游标
+    #[prost(string, tag = "4")]
+    pub trend_cursor: ::prost::alloc::string::String,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct PageGetDetailedCommentResponse {
@@ -294,8 +348,11 @@ pub struct PageGetDetailedCommentResponse {
     pub next_cursor: i64,
     #[prost(bool, tag = "3")]
     pub has_next: bool,
+    /// 按热度排序时下一次请求的游标
+    #[prost(string, tag = "4")]
+    pub next_trend_cursor: ::prost::alloc::string::String,
 }
-#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
 pub struct PageGetDetailedCommentV2Request {
     #[prost(int64, tag = "1")]
     pub oid: i64,
@@ -305,6 +362,9 @@ pub struct PageGetDetailedCommentV2Request {
     /// 排序方式
     #[prost(enumeration = "SortType", tag = "3")]
     pub sort_by: i32,
+    /// 按热度排序时的起始游标
+    #[prost(string, tag = "4")]
+    pub trend_cursor: ::prost::alloc::string::String,
 }
 /// 代表主评论的子评论信息
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -335,6 +395,9 @@ pub struct PageGetDetailedCommentV2Response {
     pub next_cursor: i64,
     #[prost(bool, tag = "3")]
     pub has_next: bool,
+    /// 按热度排序时下一次请求的游标
+    #[prost(string, tag = "4")]
+    pub next_trend_cursor: ::prost::alloc::string::String,
 }
 /// 获取置顶评论
 #[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
@@ -511,6 +574,369 @@ pub struct GetCommentUserResponse {
     #[prost(int64, tag = "1")]
     pub uid: i64,
 }
+/// 编辑评论 只有评论作者可以在发布后的一段时间内编辑
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EditCommentRequest {
+    #[prost(int64, tag = "1")]
+    pub comment_id: i64,
+    /// 新的评论内容
+    #[prost(string, tag = "2")]
+    pub content: ::prost::alloc::string::String,
+    /// 新的图片资源 为空时评论变为纯文本
+    #[prost(message, repeated, tag = "3")]
+    pub images: ::prost::alloc::vec::Vec<CommentReqImage>,
+    /// 新的@用户列表
+    #[prost(message, repeated, tag = "4")]
+    pub at_users: ::prost::alloc::vec::Vec<CommentAtUser>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EditCommentResponse {
+    /// 编辑时间
+    #[prost(int64, tag = "1")]
+    pub etime: i64,
+    /// 本次编辑新增的@用户
+    #[prost(message, repeated, tag = "2")]
+    pub added_at_users: ::prost::alloc::vec::Vec<CommentAtUser>,
+    /// 本次编辑移除的@用户
+    #[prost(message, repeated, tag = "3")]
+    pub removed_at_users: ::prost::alloc::vec::Vec<CommentAtUser>,
+    /// 编辑后命中作者设置的屏蔽词 等待作者审核
+    #[prost(bool, tag = "4")]
+    pub held: bool,
+}
+/// 评论的一个历史版本
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct CommentEditVersion {
+    #[prost(enumeration = "CommentType", tag = "1")]
+    pub r#type: i32,
+    #[prost(string, tag = "2")]
+    pub content: ::prost::alloc::string::String,
+    #[prost(message, repeated, tag = "3")]
+    pub images: ::prost::alloc::vec::Vec<CommentItemImage>,
+    #[prost(message, repeated, tag = "4")]
+    pub at_users: ::prost::alloc::vec::Vec<CommentAtUser>,
+    /// 该版本被替换的时间
+    #[prost(int64, tag = "5")]
+    pub ctime: i64,
+}
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct GetCommentEditHistoryRequest {
+    #[prost(int64, tag = "1")]
+    pub comment_id: i64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetCommentEditHistoryResponse {
+    /// 按照编辑时间从新到旧排列
+    #[prost(message, repeated, tag = "1")]
+    pub versions: ::prost::alloc::vec::Vec<CommentEditVersion>,
+}
+/// 一条举报记录
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct CommentReport {
+    #[prost(int64, tag = "1")]
+    pub id: i64,
+    /// 被举报的评论
+    #[prost(int64, tag = "2")]
+    pub comment_id: i64,
+    /// 被评论对象id
+    #[prost(int64, tag = "3")]
+    pub oid: i64,
+    /// 举报人
+    #[prost(int64, tag = "4")]
+    pub reporter: i64,
+    #[prost(enumeration = "ReportReason", tag = "5")]
+    pub reason: i32,
+    #[prost(string, tag = "6")]
+    pub detail: ::prost::alloc::string::String,
+    #[prost(enumeration = "ReportResolution", tag = "7")]
+    pub resolution: i32,
+    #[prost(int64, tag = "8")]
+    pub ctime: i64,
+    #[prost(int64, tag = "9")]
+    pub utime: i64,
+}
+/// 获取待处理的举报 按照举报时间从早到晚排列
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct ListPendingReportsRequest {
+    /// 起始游标
+    #[prost(int64, tag = "1")]
+    pub cursor: i64,
+    #[prost(int32, tag = "2")]
+    pub count: i32,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ListPendingReportsResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub reports: ::prost::alloc::vec::Vec<CommentReport>,
+    /// 被举报的评论 comment_id -> comment
+    #[prost(map = "int64, message", tag = "2")]
+    pub comments: ::std::collections::HashMap<i64, CommentItem>,
+    #[prost(int64, tag = "3")]
+    pub next_cursor: i64,
+    #[prost(bool, tag = "4")]
+    pub has_next: bool,
+}
+/// 处理评论的全部待处理举报
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct ResolveReportsRequest {
+    #[prost(int64, tag = "1")]
+    pub comment_id: i64,
+    #[prost(enumeration = "ReportResolution", tag = "2")]
+    pub resolution: i32,
+    /// 禁止评论的时长 0表示永久
+    #[prost(int64, tag = "3")]
+    pub ban_seconds: i64,
+}
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct ResolveReportsResponse {
+    /// 处理的举报数量
+    #[prost(int64, tag = "1")]
+    pub resolved: i64,
+}
+/// 表情包
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct EmojiPack {
+    #[prost(int64, tag = "1")]
+    pub id: i64,
+    /// 表情包代码 全局唯一
+    #[prost(string, tag = "2")]
+    pub code: ::prost::alloc::string::String,
+    #[prost(string, tag = "3")]
+    pub name: ::prost::alloc::string::String,
+    /// 封面的存储key
+    #[prost(string, tag = "4")]
+    pub cover_key: ::prost::alloc::string::String,
+    /// 表情包及其表情每次变更时递增 客户端据此判断是否需要更新
+    #[prost(int64, tag = "5")]
+    pub version: i64,
+    #[prost(bool, tag = "6")]
+    pub enabled: bool,
+    #[prost(int64, tag = "7")]
+    pub ctime: i64,
+    #[prost(int64, tag = "8")]
+    pub mtime: i64,
+}
+/// 表情包中的一个表情
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct EmojiItem {
+    #[prost(int64, tag = "1")]
+    pub id: i64,
+    /// 所属表情包代码
+    #[prost(string, tag = "2")]
+    pub pack: ::prost::alloc::string::String,
+    /// 表情代码 表情包内唯一
+    #[prost(string, tag = "3")]
+    pub code: ::prost::alloc::string::String,
+    #[prost(string, tag = "4")]
+    pub name: ::prost::alloc::string::String,
+    /// 表情资源的存储key
+    #[prost(string, tag = "5")]
+    pub key: ::prost::alloc::string::String,
+    #[prost(uint32, tag = "6")]
+    pub width: u32,
+    #[prost(uint32, tag = "7")]
+    pub height: u32,
+    #[prost(string, tag = "8")]
+    pub format: ::prost::alloc::string::String,
+    /// 表情最后一次变更时的表情包版本
+    #[prost(int64, tag = "9")]
+    pub version: i64,
+    #[prost(bool, tag = "10")]
+    pub enabled: bool,
+    #[prost(int64, tag = "11")]
+    pub ctime: i64,
+    #[prost(int64, tag = "12")]
+    pub mtime: i64,
+}
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct ListEmojiPacksRequest {
+    /// 是否包含已下架的表情包
+    #[prost(bool, tag = "1")]
+    pub include_disabled: bool,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ListEmojiPacksResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub packs: ::prost::alloc::vec::Vec<EmojiPack>,
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct GetEmojiPackRequest {
+    #[prost(string, tag = "1")]
+    pub code: ::prost::alloc::string::String,
+    /// 是否包含已下架的表情
+    #[prost(bool, tag = "2")]
+    pub include_disabled: bool,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetEmojiPackResponse {
+    #[prost(message, optional, tag = "1")]
+    pub pack: ::core::option::Option<EmojiPack>,
+    #[prost(message, repeated, tag = "2")]
+    pub items: ::prost::alloc::vec::Vec<EmojiItem>,
+}
+/// 按照\[pack:item\]批量查找表情
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct BatchGetEmojiItemsRequest {
+    #[prost(string, repeated, tag = "1")]
+    pub tokens: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BatchGetEmojiItemsResponse {
+    /// token -> item 不存在或已下架的不返回
+    #[prost(map = "string, message", tag = "1")]
+    pub items: ::std::collections::HashMap<::prost::alloc::string::String, EmojiItem>,
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct UpsertEmojiPackRequest {
+    #[prost(string, tag = "1")]
+    pub code: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub name: ::prost::alloc::string::String,
+    #[prost(string, tag = "3")]
+    pub cover_key: ::prost::alloc::string::String,
+    #[prost(bool, tag = "4")]
+    pub enabled: bool,
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct UpsertEmojiPackResponse {
+    #[prost(message, optional, tag = "1")]
+    pub pack: ::core::option::Option<EmojiPack>,
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct UpsertEmojiItemRequest {
+    #[prost(string, tag = "1")]
+    pub pack: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub code: ::prost::alloc::string::String,
+    #[prost(string, tag = "3")]
+    pub name: ::prost::alloc::string::String,
+    #[prost(string, tag = "4")]
+    pub key: ::prost::alloc::string::String,
+    #[prost(uint32, tag = "5")]
+    pub width: u32,
+    #[prost(uint32, tag = "6")]
+    pub height: u32,
+    #[prost(string, tag = "7")]
+    pub format: ::prost::alloc::string::String,
+    #[prost(bool, tag = "8")]
+    pub enabled: bool,
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct UpsertEmojiItemResponse {
+    #[prost(message, optional, tag = "1")]
+    pub item: ::core::option::Option<EmojiItem>,
+}
+/// 作者对评论对象的管理设置
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct CommentSetting {
+    #[prost(int64, tag = "1")]
+    pub oid: i64,
+    #[prost(enumeration = "CommentMode", tag = "2")]
+    pub mode: i32,
+    /// 命中的评论需要作者审核后才展示
+    #[prost(string, repeated, tag = "3")]
+    pub blocked_keywords: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    #[prost(int64, tag = "4")]
+    pub mtime: i64,
+}
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct GetCommentSettingRequest {
+    #[prost(int64, tag = "1")]
+    pub oid: i64,
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct GetCommentSettingResponse {
+    #[prost(message, optional, tag = "1")]
+    pub setting: ::core::option::Option<CommentSetting>,
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct UpdateCommentSettingRequest {
+    #[prost(int64, tag = "1")]
+    pub oid: i64,
+    #[prost(enumeration = "CommentMode", tag = "2")]
+    pub mode: i32,
+    #[prost(string, repeated, tag = "3")]
+    pub blocked_keywords: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct UpdateCommentSettingResponse {
+    #[prost(message, optional, tag = "1")]
+    pub setting: ::core::option::Option<CommentSetting>,
+}
+/// 禁止/解除禁止用户在作者的所有对象下评论
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct MuteCommentUserRequest {
+    #[prost(int64, tag = "1")]
+    pub uid: i64,
+    #[prost(enumeration = "CommentAction", tag = "2")]
+    pub action: i32,
+}
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct MuteCommentUserResponse {
+}
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct MutedCommentUser {
+    #[prost(int64, tag = "1")]
+    pub uid: i64,
+    /// 禁止的时间
+    #[prost(int64, tag = "2")]
+    pub ctime: i64,
+}
+/// 获取作者禁止评论的用户 按照禁止时间从晚到早排列
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct ListMutedCommentUsersRequest {
+    /// 起始游标
+    #[prost(int64, tag = "1")]
+    pub cursor: i64,
+    #[prost(int32, tag = "2")]
+    pub count: i32,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ListMutedCommentUsersResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub users: ::prost::alloc::vec::Vec<MutedCommentUser>,
+    #[prost(int64, tag = "2")]
+    pub next_cursor: i64,
+    #[prost(bool, tag = "3")]
+    pub has_next: bool,
+}
+/// 获取对象下等待作者审核的评论 按照发布时间从早到晚排列
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct ListHeldCommentsRequest {
+    #[prost(int64, tag = "1")]
+    pub oid: i64,
+    /// 起始游标
+    #[prost(int64, tag = "2")]
+    pub cursor: i64,
+    #[prost(int32, tag = "3")]
+    pub count: i32,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ListHeldCommentsResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub comments: ::prost::alloc::vec::Vec<CommentItem>,
+    #[prost(int64, tag = "2")]
+    pub next_cursor: i64,
+    #[prost(bool, tag = "3")]
+    pub has_next: bool,
+}
+/// 通过等待审核的评论
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct ApproveHeldCommentsRequest {
+    #[prost(int64, tag = "1")]
+    pub oid: i64,
+    #[prost(int64, repeated, packed = "false", tag = "2")]
+    pub comment_ids: ::prost::alloc::vec::Vec<i64>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ApproveHeldCommentsResponse {
+    /// 通过的评论数量
+    #[prost(int64, tag = "1")]
+    pub approved: i64,
+    /// 通过的评论 包含@的用户 用于通过后通知被@的用户
+    #[prost(message, repeated, tag = "2")]
+    pub comments: ::prost::alloc::vec::Vec<CommentItem>,
+}
 #[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
 #[repr(i32)]
 pub enum CommentAction {
@@ -569,6 +995,87 @@ impl CommentType {
         }
     }
 }
+/// 举报原因
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum ReportReason {
+    Unspecified = 0,
+    /// 垃圾广告
+    Spam = 1,
+    /// 辱骂攻击
+    Abuse = 2,
+    /// 色情低俗
+    Porn = 3,
+    /// 违法违规
+    Illegal = 4,
+    /// 其它
+    Other = 5,
+}
+impl ReportReason {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            Self::Unspecified => "REPORT_REASON_UNSPECIFIED",
+            Self::Spam => "REPORT_REASON_SPAM",
+            Self::Abuse => "REPORT_REASON_ABUSE",
+            Self::Porn => "REPORT_REASON_PORN",
+            Self::Illegal => "REPORT_REASON_ILLEGAL",
+            Self::Other => "REPORT_REASON_OTHER",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "REPORT_REASON_UNSPECIFIED" => Some(Self::Unspecified),
+            "REPORT_REASON_SPAM" => Some(Self::Spam),
+            "REPORT_REASON_ABUSE" => Some(Self::Abuse),
+            "REPORT_REASON_PORN" => Some(Self::Porn),
+            "REPORT_REASON_ILLEGAL" => Some(Self::Illegal),
+            "REPORT_REASON_OTHER" => Some(Self::Other),
+            _ => None,
+        }
+    }
+}
+/// 举报处理结果
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum ReportResolution {
+    /// 待处理
+    Pending = 0,
+    /// 保留评论
+    Keep = 1,
+    /// 删除评论
+    Delete = 2,
+    /// 删除评论并禁止作者评论
+    BanAuthor = 3,
+}
+impl ReportResolution {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            Self::Pending => "REPORT_RESOLUTION_PENDING",
+            Self::Keep => "REPORT_RESOLUTION_KEEP",
+            Self::Delete => "REPORT_RESOLUTION_DELETE",
+            Self::BanAuthor => "REPORT_RESOLUTION_BAN_AUTHOR",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "REPORT_RESOLUTION_PENDING" => Some(Self::Pending),
+            "REPORT_RESOLUTION_KEEP" => Some(Self::Keep),
+            "REPORT_RESOLUTION_DELETE" => Some(Self::Delete),
+            "REPORT_RESOLUTION_BAN_AUTHOR" => Some(Self::BanAuthor),
+            _ => None,
+        }
+    }
+}
 #[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
 #[repr(i32)]
 pub enum SortType {
@@ -597,9 +1104,42 @@ impl SortType {
         }
     }
 }
+/// 对象的评论模式
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum CommentMode {
+    /// 所有人可评论
+    Open = 0,
+    /// 关闭评论
+    Closed = 1,
+    /// 仅关注作者的用户可评论
+    Followers = 2,
+}
+impl CommentMode {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            Self::Open => "COMMENT_MODE_OPEN",
+            Self::Closed => "COMMENT_MODE_CLOSED",
+            Self::Followers => "COMMENT_MODE_FOLLOWERS",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "COMMENT_MODE_OPEN" => Some(Self::Open),
+            "COMMENT_MODE_CLOSED" => Some(Self::Closed),
+            "COMMENT_MODE_FOLLOWERS" => Some(Self::Followers),
+            _ => None,
+        }
+    }
+}
 /// Encoded file descriptor set for the `comment.api.v1` package
 pub const FILE_DESCRIPTOR_SET: &[u8] = &[
-    0x0a, 0xec, 0xb6, 0x01, 0x0a, 0x1c, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70,
+    0x0a, 0xfb, 0xe3, 0x02, 0x0a, 0x1c, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70,
     0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
     0x74, 0x6f, 0x12, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
     0x76, 0x31, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
@@ -636,48 +1176,58 @@ pub const FILE_DESCRIPTOR_SET: &[u8] = &[
     0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
     0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
     0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-    0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x33, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x43, 0x6f,
+    0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x47, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x43, 0x6f,
     0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a,
     0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-    0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x56, 0x0a, 0x11,
-    0x44, 0x65, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+    0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+    0x68, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x68, 0x65, 0x6c, 0x64,
+    0x22, 0x56, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+    0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+    0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02,
+    0x20, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a,
+    0x03, 0x6f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22,
+    0x02, 0x20, 0x00, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x43,
+    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x72,
+    0x0a, 0x11, 0x4c, 0x69, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+    0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+    0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00,
+    0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x06, 0x61,
+    0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x63, 0x6f,
+    0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d,
+    0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
+    0x6f, 0x6e, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+    0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x75, 0x0a, 0x14, 0x44, 0x69, 0x73, 0x6c,
+    0x69, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+    0x12, 0x26, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+    0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x63,
+    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69,
+    0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+    0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+    0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+    0x17, 0x0a, 0x15, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+    0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa0, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x70,
+    0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
     0x74, 0x12, 0x26, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
     0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09,
-    0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x03, 0x6f, 0x69, 0x64,
-    0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52,
-    0x03, 0x6f, 0x69, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-    0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x72, 0x0a, 0x11, 0x4c, 0x69,
-    0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-    0x26, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-    0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x63, 0x6f,
-    0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
-    0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-    0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-    0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x14,
-    0x0a, 0x12, 0x4c, 0x69, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-    0x6f, 0x6e, 0x73, 0x65, 0x22, 0x75, 0x0a, 0x14, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x41,
-    0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0a,
-    0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-    0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-    0x6e, 0x74, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-    0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61,
-    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x74,
-    0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x17, 0x0a, 0x15, 0x44,
-    0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-    0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f,
-    0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x17, 0x0a, 0x15,
-    0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-    0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x11, 0x50, 0x69, 0x6e, 0x43, 0x6f, 0x6d,
-    0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x6f,
-    0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20,
-    0x00, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-    0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22,
-    0x02, 0x20, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x35,
-    0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d,
-    0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-    0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61,
-    0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x14, 0x0a, 0x12, 0x50, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d,
-    0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7d, 0x0a, 0x15, 0x50,
+    0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x06, 0x72, 0x65, 0x61,
+    0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+    0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72,
+    0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10,
+    0x01, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x06, 0x64, 0x65, 0x74,
+    0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03,
+    0x18, 0xc8, 0x01, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x17, 0x0a, 0x15, 0x52,
+    0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+    0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x11, 0x50, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d,
+    0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x6f, 0x69,
+    0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00,
+    0x52, 0x03, 0x6f, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+    0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02,
+    0x20, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x35, 0x0a,
+    0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e,
+    0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63,
+    0x74, 0x69, 0x6f, 0x6e, 0x22, 0x14, 0x0a, 0x12, 0x50, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+    0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa0, 0x01, 0x0a, 0x15, 0x50,
     0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
     0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
     0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12,
@@ -685,333 +1235,668 @@ pub const FILE_DESCRIPTOR_SET: &[u8] = &[
     0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f,
     0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
     0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x54, 0x79,
-    0x70, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x22, 0xfa, 0x03, 0x0a, 0x0b, 0x43,
-    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-    0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x69,
-    0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12, 0x2f, 0x0a, 0x04,
-    0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d,
-    0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-    0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a,
-    0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-    0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x05,
-    0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f,
-    0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x74,
-    0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
-    0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
-    0x12, 0x0a, 0x04, 0x72, 0x75, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x72,
-    0x75, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6b, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
-    0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75,
-    0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-    0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x68, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e,
-    0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
-    0x52, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65,
-    0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x0e, 0x0a,
-    0x02, 0x69, 0x70, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x15, 0x0a,
-    0x06, 0x69, 0x73, 0x5f, 0x70, 0x69, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69,
-    0x73, 0x50, 0x69, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x5f, 0x63, 0x6f, 0x75,
-    0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x43, 0x6f,
-    0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x10, 0x20,
-    0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70,
-    0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d,
-    0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x38, 0x0a,
-    0x08, 0x61, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32,
-    0x1d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-    0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x07,
-    0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x22, 0x70, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-    0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x12,
-    0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
-    0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
-    0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a,
-    0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
-    0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
-    0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x5e, 0x0a, 0x10, 0x43, 0x6f, 0x6d,
-    0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a,
-    0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-    0x38, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+    0x70, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72,
+    0x65, 0x6e, 0x64, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+    0x52, 0x0b, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xe3, 0x04,
+    0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a,
+    0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a,
+    0x03, 0x6f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12,
+    0x2f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
     0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4d,
-    0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0x8d, 0x01, 0x0a, 0x16, 0x50, 0x61,
-    0x67, 0x65, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-    0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-    0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+    0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+    0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69,
+    0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07,
+    0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72,
+    0x6f, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+    0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+    0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+    0x52, 0x04, 0x72, 0x75, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6b, 0x65, 0x5f, 0x63,
+    0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x69, 0x6b, 0x65,
+    0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f,
+    0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x68, 0x61, 0x74, 0x65, 0x43,
+    0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20,
+    0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x74,
+    0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65,
+    0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70,
+    0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x70, 0x69, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08,
+    0x52, 0x05, 0x69, 0x73, 0x50, 0x69, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x5f,
+    0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x75, 0x62,
+    0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73,
+    0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
     0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49,
-    0x74, 0x65, 0x6d, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a,
-    0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
-    0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x19,
-    0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-    0x52, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x22, 0x6f, 0x0a, 0x18, 0x50, 0x61, 0x67,
-    0x65, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+    0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73,
+    0x12, 0x38, 0x0a, 0x08, 0x61, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x11, 0x20, 0x03,
+    0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x55, 0x73, 0x65,
+    0x72, 0x52, 0x07, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73,
+    0x5f, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69,
+    0x73, 0x45, 0x64, 0x69, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x74, 0x69, 0x6d, 0x65,
+    0x18, 0x13, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x34, 0x0a,
+    0x06, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+    0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x52, 0x06, 0x65, 0x6d, 0x6f,
+    0x6a, 0x69, 0x73, 0x22, 0xbe, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x45,
+    0x6d, 0x6f, 0x6a, 0x69, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+    0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+    0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x63, 0x6b, 0x12, 0x12,
+    0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f,
+    0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+    0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x05, 0x20,
+    0x01, 0x28, 0x0d, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
+    0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
+    0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01,
+    0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+    0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72,
+    0x73, 0x69, 0x6f, 0x6e, 0x22, 0x70, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+    0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05,
+    0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x77, 0x69, 0x64,
+    0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01,
+    0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f,
+    0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+    0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+    0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x5e, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+    0x74, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+    0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x04,
+    0x6d, 0x65, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6d,
+    0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+    0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61,
+    0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0xb9, 0x01, 0x0a, 0x16, 0x50, 0x61, 0x67, 0x65, 0x47,
+    0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+    0x65, 0x12, 0x37, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+    0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70,
+    0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d,
+    0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65,
+    0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+    0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x68,
+    0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68,
+    0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x74,
+    0x72, 0x65, 0x6e, 0x64, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+    0x09, 0x52, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x43, 0x75, 0x72, 0x73,
+    0x6f, 0x72, 0x22, 0x6f, 0x0a, 0x18, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62,
+    0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
+    0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04,
+    0x22, 0x02, 0x20, 0x00, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x07, 0x72, 0x6f, 0x6f,
+    0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22,
+    0x02, 0x20, 0x00, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63,
+    0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72,
+    0x73, 0x6f, 0x72, 0x22, 0x90, 0x01, 0x0a, 0x19, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x53,
+    0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+    0x65, 0x12, 0x37, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+    0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70,
+    0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d,
+    0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65,
+    0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+    0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x68,
+    0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68,
+    0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x22, 0x83, 0x01, 0x0a, 0x1a, 0x50, 0x61, 0x67, 0x65, 0x47,
+    0x65, 0x74, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x52, 0x65,
     0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
     0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x03, 0x6f, 0x69, 0x64,
     0x12, 0x20, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
     0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x74,
-    0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
-    0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x90, 0x01, 0x0a, 0x19, 0x50,
-    0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-    0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d,
-    0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d,
-    0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-    0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-    0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
-    0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73,
-    0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03,
-    0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x22, 0x83, 0x01,
-    0x0a, 0x1a, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d,
-    0x65, 0x6e, 0x74, 0x56, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03,
-    0x6f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02,
-    0x20, 0x00, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x74, 0x5f,
-    0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20,
-    0x00, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
-    0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a,
-    0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f,
-    0x75, 0x6e, 0x74, 0x22, 0x6c, 0x0a, 0x1b, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x53, 0x75,
-    0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-    0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
-    0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61,
-    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65,
-    0x6d, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74,
-    0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
-    0x6c, 0x22, 0x83, 0x01, 0x0a, 0x12, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x75,
-    0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
-    0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-    0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-    0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e,
-    0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-    0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08,
-    0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-    0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x22, 0x8d, 0x01, 0x0a, 0x13, 0x44, 0x65, 0x74, 0x61,
-    0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12,
-    0x2f, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+    0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+    0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+    0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x6c, 0x0a, 0x1b,
+    0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+    0x74, 0x56, 0x32, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x63,
+    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
     0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74,
-    0x12, 0x45, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-    0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64,
-    0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x43,
-    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x1d, 0x50, 0x61, 0x67, 0x65,
-    0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-    0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x6f, 0x69, 0x64,
-    0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52,
-    0x03, 0x6f, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02,
-    0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x07,
-    0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e,
-    0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-    0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x22,
-    0x9d, 0x01, 0x0a, 0x1e, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69,
-    0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-    0x73, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
-    0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61,
-    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f,
-    0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-    0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73,
-    0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75,
-    0x72, 0x73, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74,
-    0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x22,
-    0x87, 0x01, 0x0a, 0x1f, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69,
-    0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x52, 0x65, 0x71, 0x75,
-    0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-    0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12, 0x16,
-    0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-    0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62,
-    0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-    0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70,
-    0x65, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x22, 0x5f, 0x0a, 0x14, 0x44, 0x65, 0x74,
-    0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56,
-    0x32, 0x12, 0x31, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d,
+    0x65, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20,
+    0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x83, 0x01, 0x0a, 0x12, 0x44,
+    0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+    0x74, 0x12, 0x31, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
     0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
     0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69,
-    0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20,
-    0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x91, 0x01, 0x0a, 0x15, 0x44,
-    0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74,
-    0x65, 0x6d, 0x56, 0x32, 0x12, 0x2f, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01,
-    0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
-    0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52,
-    0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x47, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x5f, 0x63, 0x6f, 0x6d,
-    0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f,
-    0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74,
-    0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56,
-    0x32, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xaa,
-    0x01, 0x0a, 0x20, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
-    0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x52, 0x65, 0x73, 0x70, 0x6f,
-    0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d,
-    0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x6d,
-    0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x61,
-    0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x56,
-    0x32, 0x52, 0x0c, 0x72, 0x6f, 0x6f, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+    0x74, 0x65, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72,
+    0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43,
+    0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78,
+    0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74,
+    0x22, 0x8d, 0x01, 0x0a, 0x13, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d,
+    0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x2f, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74,
+    0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+    0x74, 0x65, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x45, 0x0a, 0x0c, 0x73, 0x75, 0x62,
+    0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+    0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+    0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d,
+    0x65, 0x6e, 0x74, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+    0x22, 0xa8, 0x01, 0x0a, 0x1d, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61,
+    0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+    0x73, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
+    0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12, 0x16, 0x0a,
+    0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63,
+    0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79,
+    0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65,
+    0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x65, 0x6e,
+    0x64, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+    0x74, 0x72, 0x65, 0x6e, 0x64, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xc9, 0x01, 0x0a, 0x1e,
+    0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43,
+    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f,
+    0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+    0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+    0x31, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+    0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
     0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02,
     0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
     0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01,
-    0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x22, 0x34, 0x0a, 0x17, 0x47,
-    0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-    0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x01, 0x20,
-    0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x03, 0x6f, 0x69,
-    0x64, 0x22, 0x53, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x6f,
-    0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
-    0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f,
-    0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74,
-    0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d,
-    0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x30, 0x0a, 0x13, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43,
+    0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6e,
+    0x65, 0x78, 0x74, 0x5f, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+    0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x54, 0x72, 0x65, 0x6e,
+    0x64, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xaa, 0x01, 0x0a, 0x1f, 0x50, 0x61, 0x67, 0x65,
+    0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+    0x6e, 0x74, 0x56, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x6f,
+    0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20,
+    0x00, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+    0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x31,
+    0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+    0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+    0x2e, 0x53, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42,
+    0x79, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f,
+    0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x43, 0x75,
+    0x72, 0x73, 0x6f, 0x72, 0x22, 0x5f, 0x0a, 0x14, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64,
+    0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x12, 0x31, 0x0a, 0x05,
+    0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f,
+    0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d,
+    0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12,
+    0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+    0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x91, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+    0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x56, 0x32, 0x12,
+    0x2f, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+    0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74,
+    0x12, 0x47, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+    0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64,
+    0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x52, 0x0b, 0x73, 0x75,
+    0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xd6, 0x01, 0x0a, 0x20, 0x50, 0x61,
+    0x67, 0x65, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d,
+    0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a,
+    0x0a, 0x0d, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+    0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+    0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43,
+    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x56, 0x32, 0x52, 0x0c, 0x72, 0x6f,
+    0x6f, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65,
+    0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+    0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x68,
+    0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68,
+    0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x74,
+    0x72, 0x65, 0x6e, 0x64, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+    0x09, 0x52, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x43, 0x75, 0x72, 0x73,
+    0x6f, 0x72, 0x22, 0x34, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43,
     0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
     0x03, 0x6f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22,
-    0x02, 0x20, 0x00, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x22, 0x2c, 0x0a, 0x14, 0x43, 0x6f, 0x75, 0x6e,
-    0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-    0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-    0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2e, 0x0a, 0x18, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43,
-    0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-    0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03,
-    0x52, 0x04, 0x6f, 0x69, 0x64, 0x73, 0x22, 0xa9, 0x01, 0x0a, 0x19, 0x42, 0x61, 0x74, 0x63, 0x68,
-    0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-    0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x07, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18,
-    0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-    0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e,
-    0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-    0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6e,
-    0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-    0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-    0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-    0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-    0x38, 0x01, 0x22, 0x44, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-    0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-    0x12, 0x26, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
-    0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x63,
-    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x52, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43,
-    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-    0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-    0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d,
-    0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-    0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x47, 0x0a, 0x1d,
+    0x02, 0x20, 0x00, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x22, 0x53, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50,
+    0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+    0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01,
+    0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d,
+    0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x30, 0x0a,
+    0x13, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+    0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+    0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x22,
+    0x2c, 0x0a, 0x14, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+    0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+    0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2e, 0x0a,
+    0x18, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+    0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6f, 0x69, 0x64,
+    0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x04, 0x6f, 0x69, 0x64, 0x73, 0x22, 0xa9, 0x01,
+    0x0a, 0x19, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+    0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x07, 0x6e,
+    0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x63,
+    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61,
+    0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+    0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x45,
+    0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x1a, 0x3a, 0x0a,
+    0x0c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+    0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+    0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+    0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x44, 0x0a, 0x1a, 0x47, 0x65, 0x74,
+    0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+    0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+    0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04,
+    0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22,
+    0x52, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b,
+    0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d,
+    0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+    0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a,
+    0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f,
+    0x75, 0x6e, 0x74, 0x22, 0x47, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+    0x74, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
+    0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+    0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20,
+    0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x55, 0x0a, 0x1e,
     0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b,
-    0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
-    0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-    0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d,
-    0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x55, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
-    0x65, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-    0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-    0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d,
-    0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-    0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x47, 0x0a, 0x18,
+    0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d,
+    0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+    0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a,
+    0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f,
+    0x75, 0x6e, 0x74, 0x22, 0x47, 0x0a, 0x18, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72,
+    0x4f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+    0x19, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48,
+    0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69,
+    0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x51, 0x0a, 0x19,
     0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-    0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18,
-    0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x03,
-    0x6f, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-    0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x51, 0x0a, 0x19, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73,
-    0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-    0x73, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01,
-    0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
-    0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x69, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64,
-    0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xa3, 0x02, 0x0a, 0x1d, 0x42, 0x61, 0x74,
-    0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62, 0x6a,
-    0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x63, 0x0a, 0x08, 0x6d, 0x61,
-    0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x63,
-    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61,
-    0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62,
-    0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x61, 0x70, 0x70,
-    0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x9a, 0x01,
-    0x04, 0x08, 0x01, 0x10, 0x32, 0x52, 0x08, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x1a,
-    0x29, 0x0a, 0x07, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x04, 0x6f, 0x69,
-    0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x92, 0x01, 0x04,
-    0x08, 0x01, 0x10, 0x32, 0x52, 0x04, 0x6f, 0x69, 0x64, 0x73, 0x1a, 0x72, 0x0a, 0x0d, 0x4d, 0x61,
-    0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-    0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x4b, 0x0a,
-    0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x63,
-    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61,
-    0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62,
-    0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4f, 0x62, 0x6a, 0x65,
-    0x63, 0x74, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3e,
-    0x0a, 0x0c, 0x4f, 0x69, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x10,
-    0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6f, 0x69, 0x64,
-    0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20,
-    0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x22, 0x44,
-    0x0a, 0x10, 0x4f, 0x69, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x4c, 0x69,
-    0x73, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-    0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-    0x31, 0x2e, 0x4f, 0x69, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x52, 0x04,
-    0x6c, 0x69, 0x73, 0x74, 0x22, 0xd5, 0x01, 0x0a, 0x1e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68,
-    0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
-    0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
-    0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-    0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43,
-    0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-    0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
-    0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x5c,
-    0x0a, 0x0c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-    0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79,
-    0x12, 0x36, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-    0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-    0x2e, 0x4f, 0x69, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x73,
-    0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9e, 0x02, 0x0a,
-    0x20, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c,
-    0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-    0x74, 0x12, 0x5a, 0x0a, 0x08, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20,
-    0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70,
+    0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x72, 0x65, 0x73,
+    0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+    0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x69, 0x64, 0x43, 0x6f,
+    0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+    0xa3, 0x02, 0x0a, 0x1d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73,
+    0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+    0x74, 0x12, 0x63, 0x0a, 0x08, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20,
+    0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70,
     0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55,
-    0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-    0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e,
-    0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x21, 0x0a,
-    0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x10,
-    0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x69, 0x64, 0x73,
-    0x1a, 0x7b, 0x0a, 0x0d, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72,
-    0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
-    0x6b, 0x65, 0x79, 0x12, 0x54, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-    0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
-    0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73,
-    0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-    0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x4c, 0x69,
-    0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x43, 0x0a,
-    0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x12, 0x1d, 0x0a,
-    0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-    0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
-    0x6c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x69, 0x6b,
-    0x65, 0x64, 0x22, 0xc3, 0x02, 0x0a, 0x21, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63,
-    0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-    0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75,
-    0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-    0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
-    0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d,
-    0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73,
-    0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
-    0x74, 0x73, 0x1a, 0x44, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b,
-    0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01,
-    0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61,
-    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b,
-    0x65, 0x64, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x1a, 0x7e, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x75,
-    0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-    0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x58, 0x0a, 0x05, 0x76, 0x61,
-    0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+    0x73, 0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+    0x73, 0x74, 0x2e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+    0x42, 0x0a, 0xba, 0x48, 0x07, 0x9a, 0x01, 0x04, 0x08, 0x01, 0x10, 0x32, 0x52, 0x08, 0x6d, 0x61,
+    0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x29, 0x0a, 0x07, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+    0x73, 0x12, 0x1e, 0x0a, 0x04, 0x6f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x42,
+    0x0a, 0xba, 0x48, 0x07, 0x92, 0x01, 0x04, 0x08, 0x01, 0x10, 0x32, 0x52, 0x04, 0x6f, 0x69, 0x64,
+    0x73, 0x1a, 0x72, 0x0a, 0x0d, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74,
+    0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+    0x03, 0x6b, 0x65, 0x79, 0x12, 0x4b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+    0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70,
+    0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55,
+    0x73, 0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+    0x73, 0x74, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+    0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3e, 0x0a, 0x0c, 0x4f, 0x69, 0x64, 0x43, 0x6f, 0x6d, 0x6d,
+    0x65, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+    0x28, 0x03, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+    0x6e, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d,
+    0x65, 0x6e, 0x74, 0x65, 0x64, 0x22, 0x44, 0x0a, 0x10, 0x4f, 0x69, 0x64, 0x43, 0x6f, 0x6d, 0x6d,
+    0x65, 0x6e, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x6c, 0x69, 0x73,
+    0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+    0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x69, 0x64, 0x43, 0x6f, 0x6d, 0x6d,
+    0x65, 0x6e, 0x74, 0x65, 0x64, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0xd5, 0x01, 0x0a, 0x1e,
+    0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e,
+    0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55,
+    0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+    0x3b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+    0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f,
+    0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+    0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x72, 0x65,
+    0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x5c, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+    0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+    0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+    0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x69, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+    0x6e, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+    0x02, 0x38, 0x01, 0x22, 0x9e, 0x02, 0x0a, 0x20, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65,
+    0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+    0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5a, 0x0a, 0x08, 0x6d, 0x61, 0x70, 0x70,
+    0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x63, 0x6f, 0x6d,
+    0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63,
+    0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f,
+    0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x61, 0x70,
+    0x70, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x61, 0x70, 0x70,
+    0x69, 0x6e, 0x67, 0x73, 0x1a, 0x21, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+    0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+    0x28, 0x03, 0x52, 0x03, 0x69, 0x64, 0x73, 0x1a, 0x7b, 0x0a, 0x0d, 0x4d, 0x61, 0x70, 0x70, 0x69,
+    0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+    0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x54, 0x0a, 0x05, 0x76, 0x61,
+    0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
     0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
     0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d,
-    0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6d,
-    0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x76,
-    0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x31, 0x0a, 0x1d, 0x42, 0x61, 0x74, 0x63,
-    0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x69,
-    0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73,
-    0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0xbb, 0x01, 0x0a, 0x1e,
-    0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-    0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b,
-    0x0a, 0x09, 0x65, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28,
-    0x0b, 0x32, 0x3d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-    0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6d,
-    0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-    0x65, 0x2e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
-    0x52, 0x09, 0x65, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0x3c, 0x0a, 0x0e, 0x45,
-    0x78, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-    0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-    0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-    0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x32, 0x0a, 0x11, 0x47, 0x65, 0x74,
-    0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
-    0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-    0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x45, 0x0a,
-    0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-    0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+    0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+    0x65, 0x6e, 0x74, 0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+    0x3a, 0x02, 0x38, 0x01, 0x22, 0x43, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c,
+    0x69, 0x6b, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+    0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+    0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+    0x28, 0x08, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x22, 0xc3, 0x02, 0x0a, 0x21, 0x42, 0x61,
+    0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65,
+    0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+    0x58, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+    0x32, 0x3e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+    0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72,
+    0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+    0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+    0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x44, 0x0a, 0x10, 0x43, 0x6f, 0x6d,
+    0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x30, 0x0a,
+    0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f,
+    0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d,
+    0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x1a,
+    0x7e, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+    0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65,
+    0x79, 0x12, 0x58, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+    0x32, 0x42, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+    0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72,
+    0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+    0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64,
+    0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+    0x31, 0x0a, 0x1d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6d,
+    0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+    0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x69,
+    0x64, 0x73, 0x22, 0xbb, 0x01, 0x0a, 0x1e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63,
+    0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+    0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e,
+    0x63, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+    0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43,
+    0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74,
+    0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e,
+    0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x65, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e,
+    0x63, 0x65, 0x1a, 0x3c, 0x0a, 0x0e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x45,
+    0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+    0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+    0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+    0x22, 0x32, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+    0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+    0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+    0x6e, 0x74, 0x49, 0x64, 0x22, 0x45, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+    0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x69, 0x74,
+    0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+    0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+    0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x36, 0x0a, 0x15, 0x47,
+    0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+    0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+    0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+    0x74, 0x49, 0x64, 0x22, 0x2a, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+    0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
+    0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22,
+    0xc9, 0x01, 0x0a, 0x12, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+    0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+    0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22,
+    0x02, 0x20, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18,
+    0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+    0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67,
+    0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+    0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+    0x74, 0x52, 0x65, 0x71, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65,
+    0x73, 0x12, 0x38, 0x0a, 0x08, 0x61, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20,
+    0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70,
+    0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x55, 0x73,
+    0x65, 0x72, 0x52, 0x07, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x22, 0xcd, 0x01, 0x0a, 0x13,
+    0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+    0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+    0x28, 0x03, 0x52, 0x05, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0e, 0x61, 0x64, 0x64,
+    0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+    0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+    0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x55, 0x73, 0x65, 0x72,
+    0x52, 0x0c, 0x61, 0x64, 0x64, 0x65, 0x64, 0x41, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x47,
+    0x0a, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x75, 0x73, 0x65,
+    0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+    0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+    0x74, 0x41, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+    0x41, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x65, 0x6c, 0x64, 0x18,
+    0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x68, 0x65, 0x6c, 0x64, 0x22, 0xe9, 0x01, 0x0a, 0x12,
+    0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x64, 0x69, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
+    0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+    0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+    0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+    0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02,
+    0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x38, 0x0a,
+    0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+    0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52,
+    0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x61, 0x74, 0x5f, 0x75, 0x73,
+    0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+    0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+    0x6e, 0x74, 0x41, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x07, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72,
+    0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+    0x52, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x46, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x6f,
+    0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x64, 0x69, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+    0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+    0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04,
+    0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22,
+    0x5f, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x64, 0x69,
+    0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+    0x12, 0x3e, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+    0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x64, 0x69, 0x74, 0x56,
+    0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+    0x22, 0xa8, 0x02, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f,
+    0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+    0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+    0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+    0x64, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+    0x6f, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x18,
+    0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12,
+    0x34, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
+    0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+    0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72,
+    0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18,
+    0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x40, 0x0a,
+    0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+    0x0e, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+    0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74,
+    0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+    0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+    0x63, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09,
+    0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x75, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x49, 0x0a, 0x19, 0x4c,
+    0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+    0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73,
+    0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+    0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+    0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xc1, 0x02, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x50,
+    0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73,
+    0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73,
+    0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+    0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x54,
+    0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+    0x32, 0x38, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+    0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70,
+    0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6d,
+    0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d,
+    0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72,
+    0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43,
+    0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78,
+    0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74,
+    0x1a, 0x58, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
+    0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+    0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+    0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52,
+    0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb8, 0x01, 0x0a, 0x15, 0x52,
+    0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71,
+    0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+    0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20,
+    0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x4d, 0x0a, 0x0a,
+    0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+    0x32, 0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+    0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69,
+    0x6f, 0x6e, 0x42, 0x0b, 0xba, 0x48, 0x08, 0x82, 0x01, 0x05, 0x10, 0x01, 0x22, 0x01, 0x00, 0x52,
+    0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0b, 0x62,
+    0x61, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+    0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x0a, 0x62, 0x61, 0x6e, 0x53, 0x65,
+    0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x34, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+    0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+    0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+    0x03, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x22, 0xc0, 0x01, 0x0a, 0x09,
+    0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x50, 0x61, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+    0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+    0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a,
+    0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+    0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04,
+    0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x18,
+    0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+    0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62,
+    0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+    0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+    0x03, 0x52, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x74, 0x69, 0x6d,
+    0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x8f,
+    0x02, 0x0a, 0x09, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02,
+    0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+    0x70, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x63, 0x6b,
+    0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+    0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+    0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+    0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69,
+    0x64, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68,
+    0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
+    0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+    0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+    0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28,
+    0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e,
+    0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61,
+    0x62, 0x6c, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20,
+    0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x74,
+    0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65,
+    0x22, 0x42, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x50, 0x61, 0x63,
+    0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63,
+    0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20,
+    0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x69, 0x73, 0x61,
+    0x62, 0x6c, 0x65, 0x64, 0x22, 0x49, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6d, 0x6f, 0x6a,
+    0x69, 0x50, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f,
+    0x0a, 0x05, 0x70, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+    0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+    0x6d, 0x6f, 0x6a, 0x69, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x05, 0x70, 0x61, 0x63, 0x6b, 0x73, 0x22,
+    0x5d, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x50, 0x61, 0x63, 0x6b, 0x52,
+    0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+    0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x63,
+    0x6f, 0x64, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64,
+    0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69,
+    0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x76,
+    0x0a, 0x14, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x65,
+    0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x70, 0x61, 0x63, 0x6b, 0x18, 0x01,
+    0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x50, 0x61, 0x63, 0x6b, 0x52,
+    0x04, 0x70, 0x61, 0x63, 0x6b, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02,
+    0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x49, 0x74, 0x65, 0x6d, 0x52,
+    0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3f, 0x0a, 0x19, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47,
+    0x65, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
+    0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20,
+    0x03, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x92, 0x01, 0x04, 0x08, 0x01, 0x10, 0x64, 0x52,
+    0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0xbe, 0x01, 0x0a, 0x1a, 0x42, 0x61, 0x74, 0x63,
+    0x68, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65,
+    0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+    0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+    0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x45,
+    0x6d, 0x6f, 0x6a, 0x69, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+    0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x69, 0x74,
+    0x65, 0x6d, 0x73, 0x1a, 0x53, 0x0a, 0x0a, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72,
+    0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+    0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+    0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x76,
+    0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x89, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x73,
+    0x65, 0x72, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+    0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+    0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+    0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+    0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
+    0x09, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+    0x52, 0x08, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e,
+    0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61,
+    0x62, 0x6c, 0x65, 0x64, 0x22, 0x48, 0x0a, 0x17, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x45, 0x6d,
+    0x6f, 0x6a, 0x69, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+    0x2d, 0x0a, 0x04, 0x70, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+    0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+    0x6d, 0x6f, 0x6a, 0x69, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x04, 0x70, 0x61, 0x63, 0x6b, 0x22, 0xfc,
+    0x01, 0x0a, 0x16, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x49, 0x74,
+    0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x70, 0x61, 0x63,
+    0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01,
+    0x52, 0x04, 0x70, 0x61, 0x63, 0x6b, 0x12, 0x1b, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
+    0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x63,
+    0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+    0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x04,
+    0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x03, 0x6b,
+    0x65, 0x79, 0x12, 0x1d, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28,
+    0x0d, 0x42, 0x07, 0xba, 0x48, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74,
+    0x68, 0x12, 0x1f, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+    0x0d, 0x42, 0x07, 0xba, 0x48, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
+    0x68, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01,
+    0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x66, 0x6f, 0x72,
+    0x6d, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x08,
+    0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x48, 0x0a,
+    0x17, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x49, 0x74, 0x65, 0x6d,
+    0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d,
+    0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x49, 0x74, 0x65,
+    0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x94, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d,
+    0x65, 0x6e, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x69,
+    0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12, 0x2f, 0x0a, 0x04,
+    0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d,
+    0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+    0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x29, 0x0a,
+    0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64,
+    0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+    0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x74, 0x69, 0x6d,
+    0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x35,
+    0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x74, 0x74,
+    0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x6f, 0x69,
+    0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00,
+    0x52, 0x03, 0x6f, 0x69, 0x64, 0x22, 0x55, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+    0x65, 0x6e, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+    0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20,
+    0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70,
+    0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x74, 0x74,
+    0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x22, 0xa8, 0x01, 0x0a,
+    0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65,
+    0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03,
+    0x6f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02,
+    0x20, 0x00, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+    0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+    0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x6f,
+    0x64, 0x65, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x6f,
+    0x64, 0x65, 0x12, 0x33, 0x0a, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x6b, 0x65,
+    0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48,
+    0x05, 0x92, 0x01, 0x02, 0x10, 0x32, 0x52, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x4b,
+    0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x58, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74,
+    0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52,
+    0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x73, 0x65, 0x74, 0x74, 0x69,
+    0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+    0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+    0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
+    0x67, 0x22, 0x6a, 0x0a, 0x16, 0x4d, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+    0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x75,
+    0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20,
+    0x00, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+    0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41,
+    0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x19, 0x0a,
+    0x17, 0x4d, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72,
+    0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x0a, 0x10, 0x4d, 0x75, 0x74, 0x65,
+    0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03,
+    0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x14,
+    0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63,
+    0x74, 0x69, 0x6d, 0x65, 0x22, 0x4c, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x75, 0x74, 0x65,
+    0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+    0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01,
+    0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
+    0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75,
+    0x6e, 0x74, 0x22, 0x93, 0x01, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x75, 0x74, 0x65, 0x64,
+    0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+    0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
+    0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70,
+    0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+    0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+    0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+    0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x19, 0x0a,
+    0x08, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+    0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x22, 0x62, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74,
+    0x48, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+    0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+    0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12, 0x16,
+    0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+    0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+    0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8f, 0x01, 0x0a,
+    0x18, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+    0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x63, 0x6f, 0x6d,
+    0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f,
+    0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d,
+    0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+    0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f,
+    0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72,
+    0x73, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18,
+    0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x22, 0x64,
+    0x0a, 0x1a, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x48, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6d,
+    0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03,
+    0x6f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02,
+    0x20, 0x00, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+    0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48,
+    0x07, 0x92, 0x01, 0x04, 0x08, 0x01, 0x10, 0x32, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+    0x74, 0x49, 0x64, 0x73, 0x22, 0x72, 0x0a, 0x1b, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x48,
+    0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+    0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18,
+    0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12,
+    0x37, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
     0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-    0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04,
-    0x69, 0x74, 0x65, 0x6d, 0x22, 0x36, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-    0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
-    0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-    0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x2a, 0x0a, 0x16,
-    0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-    0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
-    0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x2a, 0x3f, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d,
+    0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x08,
+    0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2a, 0x3f, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d,
     0x65, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f, 0x4d,
     0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x44, 0x4f,
     0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x43,
@@ -1019,1049 +1904,2089 @@ pub const FILE_DESCRIPTOR_SET: &[u8] = &[
     0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x45, 0x58, 0x54,
     0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x45, 0x58, 0x54,
     0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x45, 0x4d, 0x4f,
-    0x4a, 0x49, 0x10, 0x02, 0x2a, 0x23, 0x0a, 0x08, 0x53, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65,
-    0x12, 0x0a, 0x0a, 0x06, 0x42, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
-    0x42, 0x79, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x10, 0x01, 0x32, 0xcd, 0x12, 0x0a, 0x0e, 0x43, 0x6f,
-    0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x0a,
-    0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x6d,
-    0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x43,
-    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
-    0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41,
-    0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-    0x65, 0x12, 0x53, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12,
-    0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-    0x2e, 0x44, 0x65, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-    0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
-    0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-    0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0a, 0x4c, 0x69, 0x6b, 0x65, 0x41, 0x63,
-    0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61,
-    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-    0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-    0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x41, 0x63, 0x74,
-    0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x44,
-    0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x63,
-    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69,
-    0x73, 0x6c, 0x69, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-    0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
-    0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f,
-    0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x52, 0x65, 0x70,
-    0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x6d,
-    0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f,
-    0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-    0x1a, 0x25, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-    0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-    0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0a, 0x50, 0x69, 0x6e, 0x43, 0x6f,
-    0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-    0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-    0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-    0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x43, 0x6f, 0x6d,
-    0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x0e,
-    0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x25,
-    0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-    0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-    0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-    0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x43, 0x6f,
-    0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0x82,
-    0xb5, 0x18, 0x02, 0x08, 0x01, 0x12, 0x70, 0x0a, 0x11, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74,
-    0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x6d,
-    0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
-    0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-    0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61,
-    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62,
-    0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-    0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12, 0x6e, 0x0a, 0x13, 0x50, 0x61, 0x67, 0x65, 0x47,
-    0x65, 0x74, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x12, 0x2a,
-    0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-    0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-    0x74, 0x56, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x63, 0x6f, 0x6d,
-    0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
-    0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x52,
-    0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x16, 0x50, 0x61, 0x67, 0x65, 0x47,
+    0x4a, 0x49, 0x10, 0x02, 0x2a, 0xaa, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
+    0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+    0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+    0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52,
+    0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x50, 0x41, 0x4d, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13,
+    0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x41, 0x42,
+    0x55, 0x53, 0x45, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+    0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x50, 0x4f, 0x52, 0x4e, 0x10, 0x03, 0x12, 0x19, 0x0a,
+    0x15, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49,
+    0x4c, 0x4c, 0x45, 0x47, 0x41, 0x4c, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x50, 0x4f,
+    0x52, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10,
+    0x05, 0x2a, 0x8d, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x6f,
+    0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54,
+    0x5f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x45, 0x4e, 0x44,
+    0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+    0x52, 0x45, 0x53, 0x4f, 0x4c, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x45, 0x45, 0x50, 0x10,
+    0x01, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x4f,
+    0x4c, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x02, 0x12,
+    0x20, 0x0a, 0x1c, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x55,
+    0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x41, 0x4e, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x10,
+    0x03, 0x2a, 0x23, 0x0a, 0x08, 0x53, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a,
+    0x06, 0x42, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x79, 0x54,
+    0x72, 0x65, 0x6e, 0x64, 0x10, 0x01, 0x2a, 0x59, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+    0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54,
+    0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13,
+    0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x4c, 0x4f,
+    0x53, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54,
+    0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x52, 0x53, 0x10,
+    0x02, 0x32, 0x9b, 0x14, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72,
+    0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+    0x6e, 0x74, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+    0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+    0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+    0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0a, 0x44, 0x65, 0x6c,
+    0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+    0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x43, 0x6f, 0x6d, 0x6d,
+    0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x6d,
+    0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x43,
+    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53,
+    0x0a, 0x0a, 0x4c, 0x69, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x63,
+    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+    0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+    0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+    0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+    0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x41, 0x63,
+    0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x41, 0x63, 0x74,
+    0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x6f, 0x6d,
+    0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6c,
+    0x69, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+    0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+    0x6e, 0x74, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+    0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+    0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+    0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+    0x53, 0x0a, 0x0a, 0x50, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e,
+    0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+    0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+    0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+    0x31, 0x2e, 0x50, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+    0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x0e, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x43,
+    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x43,
+    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+    0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+    0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+    0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12, 0x70, 0x0a,
+    0x11, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+    0x6e, 0x74, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x43, 0x6f,
+    0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63,
+    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
+    0x67, 0x65, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+    0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12,
+    0x6e, 0x0a, 0x13, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d,
+    0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x12, 0x2a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x53,
+    0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65,
+    0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x43, 0x6f,
+    0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+    0x7f, 0x0a, 0x16, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+    0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+    0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x47,
     0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-    0x74, 0x12, 0x2d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-    0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
-    0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-    0x1a, 0x2e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-    0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65,
-    0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-    0x22, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12, 0x7d, 0x0a, 0x18, 0x50, 0x61, 0x67, 0x65,
-    0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-    0x6e, 0x74, 0x56, 0x32, 0x12, 0x2f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61,
-    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74,
-    0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x52, 0x65,
-    0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-    0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x44, 0x65,
-    0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x52,
-    0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x69,
-    0x6e, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x2e, 0x63, 0x6f,
-    0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-    0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-    0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61,
-    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43,
-    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06,
-    0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12, 0x61, 0x0a, 0x0c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43,
-    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d,
-    0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x6f,
-    0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75,
-    0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-    0x65, 0x22, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12, 0x70, 0x0a, 0x11, 0x42, 0x61, 0x74,
-    0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x28,
-    0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-    0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-    0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-    0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43,
-    0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-    0x6e, 0x73, 0x65, 0x22, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12, 0x76, 0x0a, 0x13, 0x47,
-    0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75,
-    0x6e, 0x74, 0x12, 0x2a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
-    0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69,
-    0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b,
+    0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+    0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65,
+    0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+    0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01,
+    0x12, 0x7d, 0x0a, 0x18, 0x50, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69,
+    0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x12, 0x2f, 0x2e, 0x63,
+    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
+    0x67, 0x65, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d,
+    0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e,
+    0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+    0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f,
+    0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+    0x6d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d,
+    0x65, 0x6e, 0x74, 0x12, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70,
+    0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x6f,
+    0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63,
+    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+    0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+    0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12, 0x61,
+    0x0a, 0x0c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x23,
     0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-    0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f,
-    0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0x82, 0xb5, 0x18,
-    0x02, 0x08, 0x01, 0x12, 0x7f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-    0x74, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x2e,
-    0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+    0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+    0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70,
+    0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+    0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x08,
+    0x01, 0x12, 0x70, 0x0a, 0x11, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43,
+    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x75,
+    0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+    0x1a, 0x29, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+    0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+    0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0x82, 0xb5, 0x18,
+    0x02, 0x08, 0x01, 0x12, 0x76, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+    0x74, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x2e, 0x63, 0x6f, 0x6d,
+    0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43,
+    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+    0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+    0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+    0x6e, 0x73, 0x65, 0x22, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12, 0x7f, 0x0a, 0x16, 0x47,
     0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65,
-    0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x63,
-    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-    0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x43,
-    0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0x82, 0xb5,
-    0x18, 0x02, 0x08, 0x01, 0x12, 0x70, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65,
-    0x72, 0x4f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-    0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
-    0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
-    0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70,
-    0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e,
-    0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06,
-    0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12, 0x7f, 0x0a, 0x16, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43,
+    0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+    0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+    0x74, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
+    0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+    0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+    0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12, 0x70, 0x0a, 0x11,
+    0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+    0x74, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+    0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62,
+    0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x6f,
+    0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65,
+    0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+    0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12, 0x7f,
+    0x0a, 0x16, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72,
+    0x4f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x2d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+    0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43,
     0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-    0x12, 0x2d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-    0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72,
-    0x4f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-    0x2e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-    0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f,
-    0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-    0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12, 0x80, 0x01, 0x0a, 0x19, 0x42, 0x61, 0x74, 0x63,
-    0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f,
-    0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-    0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63,
-    0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-    0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+    0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
     0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68,
-    0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-    0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x16, 0x42, 0x61,
+    0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
+    0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12,
+    0x80, 0x01, 0x0a, 0x19, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73,
+    0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x2e,
+    0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+    0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b,
+    0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+    0x31, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+    0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c,
+    0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+    0x73, 0x65, 0x12, 0x77, 0x0a, 0x16, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b,
+    0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x12, 0x2d, 0x2e, 0x63,
+    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61,
     0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x45,
-    0x78, 0x69, 0x73, 0x74, 0x12, 0x2d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61,
-    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b,
-    0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-    0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70,
-    0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43,
-    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-    0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-    0x74, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-    0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-    0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61,
-    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-    0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43,
-    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x6d,
+    0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x63, 0x6f,
+    0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74,
+    0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78,
+    0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0a, 0x47,
+    0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+    0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+    0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63,
+    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+    0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+    0x12, 0x5f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73,
+    0x65, 0x72, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73,
+    0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+    0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+    0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+    0x65, 0x12, 0x56, 0x0a, 0x0b, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+    0x12, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+    0x31, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+    0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+    0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x15, 0x47, 0x65, 0x74,
+    0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x64, 0x69, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f,
+    0x72, 0x79, 0x12, 0x2c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x64,
+    0x69, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+    0x1a, 0x2d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+    0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x64, 0x69, 0x74,
+    0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
+    0xe8, 0x01, 0x0a, 0x18, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72,
+    0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6b, 0x0a, 0x12,
+    0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72,
+    0x74, 0x73, 0x12, 0x29, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52,
+    0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+    0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+    0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+    0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0e, 0x52, 0x65, 0x73,
+    0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x63, 0x6f,
+    0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
+    0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+    0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72,
+    0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x9e, 0x04, 0x0a, 0x13, 0x43,
+    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x53, 0x65, 0x72, 0x76, 0x69,
+    0x63, 0x65, 0x12, 0x67, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x50,
+    0x61, 0x63, 0x6b, 0x73, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x50,
+    0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f,
+    0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+    0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x50, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+    0x6e, 0x73, 0x65, 0x22, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12, 0x61, 0x0a, 0x0c, 0x47,
+    0x65, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x50, 0x61, 0x63, 0x6b, 0x12, 0x23, 0x2e, 0x63, 0x6f,
+    0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+    0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+    0x1a, 0x24, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+    0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x65,
+    0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x12, 0x73,
+    0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x49,
+    0x74, 0x65, 0x6d, 0x73, 0x12, 0x29, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x45, 0x6d,
+    0x6f, 0x6a, 0x69, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+    0x2a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+    0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x49, 0x74,
+    0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0x82, 0xb5, 0x18,
+    0x02, 0x08, 0x01, 0x12, 0x62, 0x0a, 0x0f, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x45, 0x6d, 0x6f,
+    0x6a, 0x69, 0x50, 0x61, 0x63, 0x6b, 0x12, 0x26, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x45, 0x6d,
+    0x6f, 0x6a, 0x69, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+    0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+    0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x50, 0x61, 0x63, 0x6b, 0x52,
+    0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0f, 0x55, 0x70, 0x73, 0x65, 0x72,
+    0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x26, 0x2e, 0x63, 0x6f, 0x6d,
+    0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73, 0x65,
+    0x72, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+    0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x49,
+    0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xa5, 0x05, 0x0a, 0x15,
+    0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x65,
+    0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x68, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+    0x65, 0x6e, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x6d,
     0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43,
-    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-    0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-    0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65,
-    0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xb7, 0x01, 0x0a, 0x12, 0x63, 0x6f,
-    0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-    0x42, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-    0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x79, 0x61,
-    0x6e, 0x72, 0x65, 0x61, 0x64, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x77, 0x68, 0x69, 0x6d, 0x65,
-    0x72, 0x2f, 0x69, 0x64, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6d,
-    0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x41,
-    0x58, 0xaa, 0x02, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x70, 0x69, 0x2e,
-    0x56, 0x31, 0xca, 0x02, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5c, 0x41, 0x70, 0x69,
-    0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5c, 0x41, 0x70,
-    0x69, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-    0xea, 0x02, 0x10, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a,
-    0x3a, 0x56, 0x31, 0x4a, 0xf2, 0x6d, 0x0a, 0x07, 0x12, 0x05, 0x00, 0x00, 0x9f, 0x03, 0x01, 0x0a,
-    0x08, 0x0a, 0x01, 0x0c, 0x12, 0x03, 0x00, 0x00, 0x12, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x00, 0x12,
-    0x03, 0x02, 0x00, 0x25, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x01, 0x12, 0x03, 0x03, 0x00, 0x22, 0x0a,
-    0x08, 0x0a, 0x01, 0x02, 0x12, 0x03, 0x05, 0x00, 0x17, 0x0a, 0x08, 0x0a, 0x01, 0x08, 0x12, 0x03,
-    0x07, 0x00, 0x50, 0x0a, 0x09, 0x0a, 0x02, 0x08, 0x0b, 0x12, 0x03, 0x07, 0x00, 0x50, 0x0a, 0x0a,
-    0x0a, 0x02, 0x05, 0x00, 0x12, 0x04, 0x09, 0x00, 0x0c, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x05, 0x00,
-    0x01, 0x12, 0x03, 0x09, 0x05, 0x12, 0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x00, 0x02, 0x00, 0x12, 0x03,
-    0x0a, 0x02, 0x1a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x0a, 0x02,
-    0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x00, 0x02, 0x12, 0x03, 0x0a, 0x18, 0x19, 0x0a,
-    0x0b, 0x0a, 0x04, 0x05, 0x00, 0x02, 0x01, 0x12, 0x03, 0x0b, 0x02, 0x1a, 0x0a, 0x0c, 0x0a, 0x05,
-    0x05, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x0b, 0x02, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00,
-    0x02, 0x01, 0x02, 0x12, 0x03, 0x0b, 0x18, 0x19, 0x0a, 0x0a, 0x0a, 0x02, 0x05, 0x01, 0x12, 0x04,
-    0x0e, 0x00, 0x12, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x05, 0x01, 0x01, 0x12, 0x03, 0x0e, 0x05, 0x10,
-    0x0a, 0x20, 0x0a, 0x04, 0x05, 0x01, 0x02, 0x00, 0x12, 0x03, 0x0f, 0x02, 0x13, 0x22, 0x13, 0x20,
-    0xe7, 0xba, 0xaf, 0xe6, 0x96, 0x87, 0xe6, 0x9c, 0xac, 0x28, 0xe9, 0xbb, 0x98, 0xe8, 0xae, 0xa4,
-    0x29, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x00, 0x01, 0x12, 0x03, 0x0f, 0x02, 0x06,
-    0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x00, 0x02, 0x12, 0x03, 0x0f, 0x11, 0x12, 0x0a, 0x15,
-    0x0a, 0x04, 0x05, 0x01, 0x02, 0x01, 0x12, 0x03, 0x10, 0x02, 0x13, 0x22, 0x08, 0x20, 0xe5, 0x9b,
-    0xbe, 0xe6, 0x96, 0x87, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x01, 0x01, 0x12, 0x03,
-    0x10, 0x02, 0x0c, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x01, 0x02, 0x12, 0x03, 0x10, 0x11,
-    0x12, 0x0a, 0x1e, 0x0a, 0x04, 0x05, 0x01, 0x02, 0x02, 0x12, 0x03, 0x11, 0x02, 0x13, 0x22, 0x11,
-    0x20, 0xe8, 0x87, 0xaa, 0xe5, 0xae, 0x9a, 0xe4, 0xb9, 0x89, 0xe8, 0xa1, 0xa8, 0xe6, 0x83, 0x85,
-    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x02, 0x01, 0x12, 0x03, 0x11, 0x02, 0x0e, 0x0a,
-    0x0c, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x02, 0x02, 0x12, 0x03, 0x11, 0x11, 0x12, 0x0a, 0x0a, 0x0a,
-    0x02, 0x04, 0x00, 0x12, 0x04, 0x14, 0x00, 0x17, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x00, 0x01,
-    0x12, 0x03, 0x14, 0x08, 0x15, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x00, 0x12, 0x03, 0x15,
-    0x02, 0x16, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x05, 0x12, 0x03, 0x15, 0x02, 0x08,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x15, 0x09, 0x11, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x15, 0x14, 0x15, 0x0a, 0x0b, 0x0a, 0x04,
-    0x04, 0x00, 0x02, 0x01, 0x12, 0x03, 0x16, 0x02, 0x16, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02,
-    0x01, 0x05, 0x12, 0x03, 0x16, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x01,
-    0x12, 0x03, 0x16, 0x09, 0x0c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x03, 0x12, 0x03,
-    0x16, 0x14, 0x15, 0x0a, 0x1a, 0x0a, 0x02, 0x04, 0x01, 0x12, 0x04, 0x1a, 0x00, 0x23, 0x01, 0x1a,
-    0x0e, 0x20, 0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a,
-    0x0a, 0x0a, 0x03, 0x04, 0x01, 0x01, 0x12, 0x03, 0x1a, 0x08, 0x19, 0x0a, 0x30, 0x0a, 0x04, 0x04,
-    0x01, 0x02, 0x00, 0x12, 0x03, 0x1b, 0x02, 0x29, 0x22, 0x23, 0x20, 0xe8, 0xaf, 0x84, 0xe8, 0xae,
-    0xba, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x20, 0x30, 0x2d, 0xe7, 0xba, 0xaf, 0xe6, 0x96, 0x87,
-    0xe6, 0x9c, 0xac, 0x20, 0x31, 0x2d, 0xe5, 0x9b, 0xbe, 0xe6, 0x96, 0x87, 0x0a, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x01, 0x02, 0x00, 0x06, 0x12, 0x03, 0x1b, 0x02, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x01, 0x02, 0x00, 0x01, 0x12, 0x03, 0x1b, 0x1b, 0x1f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02,
-    0x00, 0x03, 0x12, 0x03, 0x1b, 0x27, 0x28, 0x0a, 0x20, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x01, 0x12,
-    0x03, 0x1c, 0x02, 0x29, 0x22, 0x13, 0x20, 0xe8, 0xa2, 0xab, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba,
-    0xe5, 0xaf, 0xb9, 0xe8, 0xb1, 0xa1, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02,
-    0x01, 0x05, 0x12, 0x03, 0x1c, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x01,
-    0x12, 0x03, 0x1c, 0x1b, 0x1e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x03, 0x12, 0x03,
-    0x1c, 0x27, 0x28, 0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x02, 0x12, 0x03, 0x1d, 0x02, 0x29,
-    0x22, 0x0e, 0x20, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe5, 0x86, 0x85, 0xe5, 0xae, 0xb9, 0x0a,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x02, 0x05, 0x12, 0x03, 0x1d, 0x02, 0x08, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x01, 0x02, 0x02, 0x01, 0x12, 0x03, 0x1d, 0x1b, 0x22, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x01, 0x02, 0x02, 0x03, 0x12, 0x03, 0x1d, 0x27, 0x28, 0x0a, 0x1a, 0x0a, 0x04, 0x04, 0x01,
-    0x02, 0x03, 0x12, 0x03, 0x1e, 0x02, 0x29, 0x22, 0x0d, 0x20, 0xe6, 0xa0, 0xb9, 0xe8, 0xaf, 0x84,
-    0xe8, 0xae, 0xba, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x03, 0x05, 0x12,
-    0x03, 0x1e, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x03, 0x01, 0x12, 0x03, 0x1e,
-    0x1b, 0x22, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x03, 0x03, 0x12, 0x03, 0x1e, 0x27, 0x28,
-    0x0a, 0x23, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x04, 0x12, 0x03, 0x1f, 0x02, 0x29, 0x22, 0x16, 0x20,
-    0xe8, 0xa2, 0xab, 0xe5, 0x9b, 0x9e, 0xe5, 0xa4, 0x8d, 0xe7, 0x9a, 0x84, 0xe8, 0xaf, 0x84, 0xe8,
-    0xae, 0xba, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x04, 0x05, 0x12, 0x03,
-    0x1f, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x04, 0x01, 0x12, 0x03, 0x1f, 0x1b,
-    0x24, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x04, 0x03, 0x12, 0x03, 0x1f, 0x27, 0x28, 0x0a,
-    0x23, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x05, 0x12, 0x03, 0x20, 0x02, 0x29, 0x22, 0x16, 0x20, 0xe8,
-    0xa2, 0xab, 0xe5, 0x9b, 0x9e, 0xe5, 0xa4, 0x8d, 0xe7, 0x9a, 0x84, 0xe7, 0x94, 0xa8, 0xe6, 0x88,
-    0xb7, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x05, 0x05, 0x12, 0x03, 0x20,
-    0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x05, 0x01, 0x12, 0x03, 0x20, 0x1b, 0x24,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x05, 0x03, 0x12, 0x03, 0x20, 0x27, 0x28, 0x0a, 0x21,
-    0x0a, 0x04, 0x04, 0x01, 0x02, 0x06, 0x12, 0x03, 0x21, 0x02, 0x29, 0x22, 0x14, 0x20, 0xe5, 0x9b,
-    0xbe, 0xe7, 0x89, 0x87, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90,
-    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x06, 0x04, 0x12, 0x03, 0x21, 0x02, 0x0a, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x06, 0x06, 0x12, 0x03, 0x21, 0x0b, 0x1a, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x01, 0x02, 0x06, 0x01, 0x12, 0x03, 0x21, 0x1b, 0x21, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x01, 0x02, 0x06, 0x03, 0x12, 0x03, 0x21, 0x27, 0x28, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x01, 0x02,
-    0x07, 0x12, 0x03, 0x22, 0x02, 0x29, 0x22, 0x0f, 0x20, 0x40, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7,
-    0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x07, 0x04,
-    0x12, 0x03, 0x22, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x07, 0x06, 0x12, 0x03,
-    0x22, 0x0b, 0x18, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x07, 0x01, 0x12, 0x03, 0x22, 0x1b,
-    0x23, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x07, 0x03, 0x12, 0x03, 0x22, 0x27, 0x28, 0x0a,
-    0x1a, 0x0a, 0x02, 0x04, 0x02, 0x12, 0x04, 0x26, 0x00, 0x2b, 0x01, 0x1a, 0x0e, 0x20, 0xe5, 0x9b,
-    0xbe, 0xe7, 0x89, 0x87, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x04,
-    0x02, 0x01, 0x12, 0x03, 0x26, 0x08, 0x17, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x00, 0x12,
-    0x03, 0x27, 0x02, 0x17, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x05, 0x12, 0x03, 0x27,
-    0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x01, 0x12, 0x03, 0x27, 0x09, 0x12,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x03, 0x12, 0x03, 0x27, 0x15, 0x16, 0x0a, 0x0b,
-    0x0a, 0x04, 0x04, 0x02, 0x02, 0x01, 0x12, 0x03, 0x28, 0x02, 0x17, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x02, 0x02, 0x01, 0x05, 0x12, 0x03, 0x28, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02,
-    0x01, 0x01, 0x12, 0x03, 0x28, 0x09, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01, 0x03,
-    0x12, 0x03, 0x28, 0x15, 0x16, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x02, 0x12, 0x03, 0x29,
-    0x02, 0x17, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x02, 0x05, 0x12, 0x03, 0x29, 0x02, 0x08,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x02, 0x01, 0x12, 0x03, 0x29, 0x09, 0x0f, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x02, 0x02, 0x02, 0x03, 0x12, 0x03, 0x29, 0x15, 0x16, 0x0a, 0x0b, 0x0a, 0x04,
-    0x04, 0x02, 0x02, 0x03, 0x12, 0x03, 0x2a, 0x02, 0x17, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02,
-    0x03, 0x05, 0x12, 0x03, 0x2a, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x03, 0x01,
-    0x12, 0x03, 0x2a, 0x09, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x03, 0x03, 0x12, 0x03,
-    0x2a, 0x15, 0x16, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x03, 0x12, 0x04, 0x2d, 0x00, 0x2f, 0x01, 0x0a,
-    0x0a, 0x0a, 0x03, 0x04, 0x03, 0x01, 0x12, 0x03, 0x2d, 0x08, 0x1a, 0x0a, 0x17, 0x0a, 0x04, 0x04,
-    0x03, 0x02, 0x00, 0x12, 0x03, 0x2e, 0x02, 0x17, 0x22, 0x0a, 0x20, 0xe8, 0xaf, 0x84, 0xe8, 0xae,
-    0xba, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x05, 0x12, 0x03, 0x2e,
-    0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x01, 0x12, 0x03, 0x2e, 0x08, 0x12,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x03, 0x12, 0x03, 0x2e, 0x15, 0x16, 0x0a, 0x1a,
-    0x0a, 0x02, 0x04, 0x04, 0x12, 0x04, 0x32, 0x00, 0x35, 0x01, 0x1a, 0x0e, 0x20, 0xe5, 0x88, 0xa0,
-    0xe9, 0x99, 0xa4, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x04,
-    0x01, 0x12, 0x03, 0x32, 0x08, 0x19, 0x0a, 0x17, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x00, 0x12, 0x03,
-    0x33, 0x02, 0x3b, 0x22, 0x0a, 0x20, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x69, 0x64, 0x0a, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x05, 0x12, 0x03, 0x33, 0x02, 0x07, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x04, 0x02, 0x00, 0x01, 0x12, 0x03, 0x33, 0x08, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x04, 0x02, 0x00, 0x03, 0x12, 0x03, 0x33, 0x15, 0x16, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02,
-    0x00, 0x08, 0x12, 0x03, 0x33, 0x17, 0x3a, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x04, 0x02, 0x00, 0x08,
-    0x87, 0x09, 0x04, 0x04, 0x12, 0x03, 0x33, 0x18, 0x39, 0x0a, 0x1d, 0x0a, 0x04, 0x04, 0x04, 0x02,
-    0x01, 0x12, 0x03, 0x34, 0x02, 0x3b, 0x22, 0x10, 0x20, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe5,
-    0xaf, 0xb9, 0xe8, 0xb1, 0xa1, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x01,
-    0x05, 0x12, 0x03, 0x34, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x01, 0x01, 0x12,
-    0x03, 0x34, 0x08, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x01, 0x03, 0x12, 0x03, 0x34,
-    0x15, 0x16, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x01, 0x08, 0x12, 0x03, 0x34, 0x17, 0x3a,
-    0x0a, 0x10, 0x0a, 0x09, 0x04, 0x04, 0x02, 0x01, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x03, 0x34,
-    0x18, 0x39, 0x0a, 0x09, 0x0a, 0x02, 0x04, 0x05, 0x12, 0x03, 0x37, 0x00, 0x1d, 0x0a, 0x0a, 0x0a,
-    0x03, 0x04, 0x05, 0x01, 0x12, 0x03, 0x37, 0x08, 0x1a, 0x0a, 0x21, 0x0a, 0x02, 0x04, 0x06, 0x12,
-    0x04, 0x3a, 0x00, 0x3d, 0x01, 0x1a, 0x15, 0x20, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0x2f, 0xe5,
-    0x8f, 0x96, 0xe6, 0xb6, 0x88, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0x0a, 0x0a, 0x0a, 0x0a, 0x03,
-    0x04, 0x06, 0x01, 0x12, 0x03, 0x3a, 0x08, 0x19, 0x0a, 0x17, 0x0a, 0x04, 0x04, 0x06, 0x02, 0x00,
-    0x12, 0x03, 0x3b, 0x02, 0x43, 0x22, 0x0a, 0x20, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x69, 0x64,
-    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x05, 0x12, 0x03, 0x3b, 0x02, 0x07, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x01, 0x12, 0x03, 0x3b, 0x10, 0x1a, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x06, 0x02, 0x00, 0x03, 0x12, 0x03, 0x3b, 0x1d, 0x1e, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x06, 0x02, 0x00, 0x08, 0x12, 0x03, 0x3b, 0x1f, 0x42, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x06, 0x02,
-    0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x03, 0x3b, 0x20, 0x41, 0x0a, 0x27, 0x0a, 0x04, 0x04,
-    0x06, 0x02, 0x01, 0x12, 0x03, 0x3c, 0x02, 0x1f, 0x22, 0x1a, 0x20, 0xe5, 0x8f, 0x96, 0xe6, 0xb6,
-    0x88, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0xe8, 0xbf, 0x98, 0xe6, 0x98, 0xaf, 0xe7, 0x82, 0xb9,
-    0xe8, 0xb5, 0x9e, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x01, 0x06, 0x12, 0x03, 0x3c,
-    0x02, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x01, 0x01, 0x12, 0x03, 0x3c, 0x10, 0x16,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x01, 0x03, 0x12, 0x03, 0x3c, 0x1d, 0x1e, 0x0a, 0x09,
-    0x0a, 0x02, 0x04, 0x07, 0x12, 0x03, 0x3f, 0x00, 0x1d, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x07, 0x01,
-    0x12, 0x03, 0x3f, 0x08, 0x1a, 0x0a, 0x21, 0x0a, 0x02, 0x04, 0x08, 0x12, 0x04, 0x42, 0x00, 0x45,
-    0x01, 0x1a, 0x15, 0x20, 0xe7, 0x82, 0xb9, 0xe8, 0xb8, 0xa9, 0x2f, 0xe5, 0x8f, 0x96, 0xe6, 0xb6,
-    0x88, 0xe7, 0x82, 0xb9, 0xe8, 0xb8, 0xa9, 0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x08, 0x01, 0x12,
-    0x03, 0x42, 0x08, 0x1c, 0x0a, 0x17, 0x0a, 0x04, 0x04, 0x08, 0x02, 0x00, 0x12, 0x03, 0x43, 0x02,
-    0x43, 0x22, 0x0a, 0x20, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x08, 0x02, 0x00, 0x05, 0x12, 0x03, 0x43, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x08, 0x02, 0x00, 0x01, 0x12, 0x03, 0x43, 0x10, 0x1a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02,
-    0x00, 0x03, 0x12, 0x03, 0x43, 0x1d, 0x1e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x08,
-    0x12, 0x03, 0x43, 0x1f, 0x42, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x08, 0x02, 0x00, 0x08, 0x87, 0x09,
-    0x04, 0x04, 0x12, 0x03, 0x43, 0x20, 0x41, 0x0a, 0x27, 0x0a, 0x04, 0x04, 0x08, 0x02, 0x01, 0x12,
-    0x03, 0x44, 0x02, 0x1f, 0x22, 0x1a, 0x20, 0xe5, 0x8f, 0x96, 0xe6, 0xb6, 0x88, 0xe7, 0x82, 0xb9,
-    0xe8, 0xb8, 0xa9, 0xe8, 0xbf, 0x98, 0xe6, 0x98, 0xaf, 0xe7, 0x82, 0xb9, 0xe8, 0xb8, 0xa9, 0x0a,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x01, 0x06, 0x12, 0x03, 0x44, 0x02, 0x0f, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x08, 0x02, 0x01, 0x01, 0x12, 0x03, 0x44, 0x10, 0x16, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x08, 0x02, 0x01, 0x03, 0x12, 0x03, 0x44, 0x1d, 0x1e, 0x0a, 0x09, 0x0a, 0x02, 0x04, 0x09,
-    0x12, 0x03, 0x47, 0x00, 0x20, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x09, 0x01, 0x12, 0x03, 0x47, 0x08,
-    0x1d, 0x0a, 0x13, 0x0a, 0x02, 0x04, 0x0a, 0x12, 0x03, 0x4a, 0x00, 0x1f, 0x1a, 0x08, 0x20, 0xe4,
-    0xb8, 0xbe, 0xe6, 0x8a, 0xa5, 0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0a, 0x01, 0x12, 0x03, 0x4a,
-    0x08, 0x1c, 0x0a, 0x09, 0x0a, 0x02, 0x04, 0x0b, 0x12, 0x03, 0x4c, 0x00, 0x20, 0x0a, 0x0a, 0x0a,
-    0x03, 0x04, 0x0b, 0x01, 0x12, 0x03, 0x4c, 0x08, 0x1d, 0x0a, 0x14, 0x0a, 0x02, 0x04, 0x0c, 0x12,
-    0x04, 0x4f, 0x00, 0x53, 0x01, 0x1a, 0x08, 0x20, 0xe7, 0xbd, 0xae, 0xe9, 0xa1, 0xb6, 0x0a, 0x0a,
-    0x0a, 0x0a, 0x03, 0x04, 0x0c, 0x01, 0x12, 0x03, 0x4f, 0x08, 0x19, 0x0a, 0x20, 0x0a, 0x04, 0x04,
-    0x0c, 0x02, 0x00, 0x12, 0x03, 0x50, 0x02, 0x43, 0x22, 0x13, 0x20, 0xe8, 0xa2, 0xab, 0xe8, 0xaf,
-    0x84, 0xe8, 0xae, 0xba, 0xe5, 0xaf, 0xb9, 0xe8, 0xb1, 0xa1, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x0c, 0x02, 0x00, 0x05, 0x12, 0x03, 0x50, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x0c, 0x02, 0x00, 0x01, 0x12, 0x03, 0x50, 0x10, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02,
-    0x00, 0x03, 0x12, 0x03, 0x50, 0x1d, 0x1e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x00, 0x08,
-    0x12, 0x03, 0x50, 0x1f, 0x42, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x0c, 0x02, 0x00, 0x08, 0x87, 0x09,
-    0x04, 0x04, 0x12, 0x03, 0x50, 0x20, 0x41, 0x0a, 0x17, 0x0a, 0x04, 0x04, 0x0c, 0x02, 0x01, 0x12,
-    0x03, 0x51, 0x02, 0x43, 0x22, 0x0a, 0x20, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x69, 0x64, 0x0a,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x01, 0x05, 0x12, 0x03, 0x51, 0x02, 0x07, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x0c, 0x02, 0x01, 0x01, 0x12, 0x03, 0x51, 0x10, 0x1a, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x0c, 0x02, 0x01, 0x03, 0x12, 0x03, 0x51, 0x1d, 0x1e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c,
-    0x02, 0x01, 0x08, 0x12, 0x03, 0x51, 0x1f, 0x42, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x0c, 0x02, 0x01,
-    0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x03, 0x51, 0x20, 0x41, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0c,
-    0x02, 0x02, 0x12, 0x03, 0x52, 0x02, 0x1f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x02, 0x06,
-    0x12, 0x03, 0x52, 0x02, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x02, 0x01, 0x12, 0x03,
-    0x52, 0x10, 0x16, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x02, 0x03, 0x12, 0x03, 0x52, 0x1d,
-    0x1e, 0x0a, 0x09, 0x0a, 0x02, 0x04, 0x0d, 0x12, 0x03, 0x55, 0x00, 0x1d, 0x0a, 0x0a, 0x0a, 0x03,
-    0x04, 0x0d, 0x01, 0x12, 0x03, 0x55, 0x08, 0x1a, 0x0a, 0x0a, 0x0a, 0x02, 0x05, 0x02, 0x12, 0x04,
-    0x57, 0x00, 0x5a, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x05, 0x02, 0x01, 0x12, 0x03, 0x57, 0x05, 0x0d,
-    0x0a, 0x27, 0x0a, 0x04, 0x05, 0x02, 0x02, 0x00, 0x12, 0x03, 0x58, 0x02, 0x0e, 0x22, 0x1a, 0x20,
-    0xe6, 0x8c, 0x89, 0xe7, 0x85, 0xa7, 0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83, 0xe6, 0x97, 0xb6, 0xe9,
-    0x97, 0xb4, 0xe6, 0x8e, 0x92, 0xe5, 0xba, 0x8f, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x02, 0x02,
-    0x00, 0x01, 0x12, 0x03, 0x58, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x02, 0x02, 0x00, 0x02,
-    0x12, 0x03, 0x58, 0x0c, 0x0d, 0x0a, 0x21, 0x0a, 0x04, 0x05, 0x02, 0x02, 0x01, 0x12, 0x03, 0x59,
-    0x02, 0x0e, 0x22, 0x14, 0x20, 0xe6, 0x8c, 0x89, 0xe7, 0x85, 0xa7, 0xe7, 0x83, 0xad, 0xe5, 0xba,
-    0xa6, 0xe6, 0x8e, 0x92, 0xe5, 0xba, 0x8f, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x02, 0x02, 0x01,
-    0x01, 0x12, 0x03, 0x59, 0x02, 0x09, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x02, 0x02, 0x01, 0x02, 0x12,
-    0x03, 0x59, 0x0c, 0x0d, 0x0a, 0x1a, 0x0a, 0x02, 0x04, 0x0e, 0x12, 0x04, 0x5d, 0x00, 0x61, 0x01,
-    0x1a, 0x0e, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a,
-    0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0e, 0x01, 0x12, 0x03, 0x5d, 0x08, 0x1d, 0x0a, 0x0b, 0x0a, 0x04,
-    0x04, 0x0e, 0x02, 0x00, 0x12, 0x03, 0x5e, 0x02, 0x3b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02,
-    0x00, 0x05, 0x12, 0x03, 0x5e, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x00, 0x01,
-    0x12, 0x03, 0x5e, 0x0b, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x00, 0x03, 0x12, 0x03,
-    0x5e, 0x15, 0x16, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x00, 0x08, 0x12, 0x03, 0x5e, 0x17,
-    0x3a, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x0e, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x03,
-    0x5e, 0x18, 0x39, 0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x0e, 0x02, 0x01, 0x12, 0x03, 0x5f, 0x02, 0x17,
-    0x22, 0x0e, 0x20, 0xe8, 0xb5, 0xb7, 0xe5, 0xa7, 0x8b, 0xe6, 0xb8, 0xb8, 0xe6, 0xa0, 0x87, 0x0a,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x01, 0x05, 0x12, 0x03, 0x5f, 0x02, 0x07, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x0e, 0x02, 0x01, 0x01, 0x12, 0x03, 0x5f, 0x0b, 0x11, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x0e, 0x02, 0x01, 0x03, 0x12, 0x03, 0x5f, 0x15, 0x16, 0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x0e,
-    0x02, 0x02, 0x12, 0x03, 0x60, 0x02, 0x17, 0x22, 0x0e, 0x20, 0xe6, 0x8e, 0x92, 0xe5, 0xba, 0x8f,
-    0xe6, 0x96, 0xb9, 0xe5, 0xbc, 0x8f, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x02, 0x06,
-    0x12, 0x03, 0x60, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x02, 0x01, 0x12, 0x03,
-    0x60, 0x0b, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x02, 0x03, 0x12, 0x03, 0x60, 0x15,
-    0x16, 0x0a, 0x1a, 0x0a, 0x02, 0x04, 0x0f, 0x12, 0x04, 0x64, 0x00, 0x76, 0x01, 0x1a, 0x0e, 0x20,
-    0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x0a, 0x0a, 0x0a, 0x0a,
-    0x03, 0x04, 0x0f, 0x01, 0x12, 0x03, 0x64, 0x08, 0x13, 0x0a, 0x17, 0x0a, 0x04, 0x04, 0x0f, 0x02,
-    0x00, 0x12, 0x03, 0x65, 0x02, 0x2b, 0x22, 0x0a, 0x20, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x69,
-    0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x00, 0x05, 0x12, 0x03, 0x65, 0x02, 0x07,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x00, 0x01, 0x12, 0x03, 0x65, 0x1c, 0x1e, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x0f, 0x02, 0x00, 0x03, 0x12, 0x03, 0x65, 0x29, 0x2a, 0x0a, 0x20, 0x0a, 0x04,
-    0x04, 0x0f, 0x02, 0x01, 0x12, 0x03, 0x66, 0x02, 0x2b, 0x22, 0x13, 0x20, 0xe8, 0xa2, 0xab, 0xe8,
-    0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe5, 0xaf, 0xb9, 0xe8, 0xb1, 0xa1, 0x69, 0x64, 0x0a, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x0f, 0x02, 0x01, 0x05, 0x12, 0x03, 0x66, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x0f, 0x02, 0x01, 0x01, 0x12, 0x03, 0x66, 0x1c, 0x1f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f,
-    0x02, 0x01, 0x03, 0x12, 0x03, 0x66, 0x29, 0x2a, 0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x0f, 0x02, 0x02,
-    0x12, 0x03, 0x67, 0x02, 0x2b, 0x22, 0x0e, 0x20, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe7, 0xb1,
-    0xbb, 0xe5, 0x9e, 0x8b, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x02, 0x06, 0x12, 0x03,
-    0x67, 0x02, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x02, 0x01, 0x12, 0x03, 0x67, 0x1c,
-    0x20, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x02, 0x03, 0x12, 0x03, 0x67, 0x29, 0x2a, 0x0a,
-    0x1b, 0x0a, 0x04, 0x04, 0x0f, 0x02, 0x03, 0x12, 0x03, 0x68, 0x02, 0x2b, 0x22, 0x0e, 0x20, 0xe8,
-    0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe5, 0x86, 0x85, 0xe5, 0xae, 0xb9, 0x0a, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x0f, 0x02, 0x03, 0x05, 0x12, 0x03, 0x68, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f,
-    0x02, 0x03, 0x01, 0x12, 0x03, 0x68, 0x1c, 0x23, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x03,
-    0x03, 0x12, 0x03, 0x68, 0x29, 0x2a, 0x0a, 0x24, 0x0a, 0x04, 0x04, 0x0f, 0x02, 0x04, 0x12, 0x03,
-    0x69, 0x02, 0x2b, 0x22, 0x17, 0x20, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe5, 0x8f, 0x91, 0xe8,
-    0xa1, 0xa8, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x75, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x0f, 0x02, 0x04, 0x05, 0x12, 0x03, 0x69, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f,
-    0x02, 0x04, 0x01, 0x12, 0x03, 0x69, 0x1c, 0x1f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x04,
-    0x03, 0x12, 0x03, 0x69, 0x29, 0x2a, 0x0a, 0x1a, 0x0a, 0x04, 0x04, 0x0f, 0x02, 0x05, 0x12, 0x03,
-    0x6a, 0x02, 0x2b, 0x22, 0x0d, 0x20, 0xe6, 0xa0, 0xb9, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x69,
-    0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x05, 0x05, 0x12, 0x03, 0x6a, 0x02, 0x07,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x05, 0x01, 0x12, 0x03, 0x6a, 0x1c, 0x23, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x0f, 0x02, 0x05, 0x03, 0x12, 0x03, 0x6a, 0x29, 0x2a, 0x0a, 0x1a, 0x0a, 0x04,
-    0x04, 0x0f, 0x02, 0x06, 0x12, 0x03, 0x6b, 0x02, 0x2b, 0x22, 0x0d, 0x20, 0xe7, 0x88, 0xb6, 0xe8,
-    0xaf, 0x84, 0xe8, 0xae, 0xba, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x06,
-    0x05, 0x12, 0x03, 0x6b, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x06, 0x01, 0x12,
-    0x03, 0x6b, 0x1c, 0x25, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x06, 0x03, 0x12, 0x03, 0x6b,
-    0x29, 0x2a, 0x0a, 0x23, 0x0a, 0x04, 0x04, 0x0f, 0x02, 0x07, 0x12, 0x03, 0x6c, 0x02, 0x2b, 0x22,
-    0x16, 0x20, 0xe8, 0xa2, 0xab, 0xe5, 0x9b, 0x9e, 0xe5, 0xa4, 0x8d, 0xe7, 0x9a, 0x84, 0xe7, 0x94,
-    0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x07, 0x05,
-    0x12, 0x03, 0x6c, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x07, 0x01, 0x12, 0x03,
-    0x6c, 0x1c, 0x20, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x07, 0x03, 0x12, 0x03, 0x6c, 0x29,
-    0x2a, 0x0a, 0x18, 0x0a, 0x04, 0x04, 0x0f, 0x02, 0x08, 0x12, 0x03, 0x6d, 0x02, 0x2b, 0x22, 0x0b,
-    0x20, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0xe6, 0x95, 0xb0, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x0f, 0x02, 0x08, 0x05, 0x12, 0x03, 0x6d, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02,
-    0x08, 0x01, 0x12, 0x03, 0x6d, 0x1c, 0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x08, 0x03,
-    0x12, 0x03, 0x6d, 0x29, 0x2a, 0x0a, 0x18, 0x0a, 0x04, 0x04, 0x0f, 0x02, 0x09, 0x12, 0x03, 0x6e,
-    0x02, 0x2c, 0x22, 0x0b, 0x20, 0xe7, 0x82, 0xb9, 0xe8, 0xb8, 0xa9, 0xe6, 0x95, 0xb0, 0x0a, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x09, 0x05, 0x12, 0x03, 0x6e, 0x02, 0x07, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x0f, 0x02, 0x09, 0x01, 0x12, 0x03, 0x6e, 0x1c, 0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x0f, 0x02, 0x09, 0x03, 0x12, 0x03, 0x6e, 0x29, 0x2b, 0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x0f, 0x02,
-    0x0a, 0x12, 0x03, 0x6f, 0x02, 0x2c, 0x22, 0x0e, 0x20, 0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83, 0xe6,
-    0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x0a, 0x05, 0x12,
-    0x03, 0x6f, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x0a, 0x01, 0x12, 0x03, 0x6f,
-    0x1c, 0x21, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x0a, 0x03, 0x12, 0x03, 0x6f, 0x29, 0x2b,
-    0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x0f, 0x02, 0x0b, 0x12, 0x03, 0x70, 0x02, 0x2c, 0x22, 0x0e, 0x20,
-    0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x0a, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x0f, 0x02, 0x0b, 0x05, 0x12, 0x03, 0x70, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x0f, 0x02, 0x0b, 0x01, 0x12, 0x03, 0x70, 0x1c, 0x21, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02,
-    0x0b, 0x03, 0x12, 0x03, 0x70, 0x29, 0x2b, 0x0a, 0x20, 0x0a, 0x04, 0x04, 0x0f, 0x02, 0x0c, 0x12,
-    0x03, 0x71, 0x02, 0x2c, 0x22, 0x13, 0x20, 0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83, 0xe6, 0x97, 0xb6,
-    0x69, 0x70, 0xe5, 0x9c, 0xb0, 0xe5, 0x9d, 0x80, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02,
-    0x0c, 0x05, 0x12, 0x03, 0x71, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x0c, 0x01,
-    0x12, 0x03, 0x71, 0x1c, 0x1e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x0c, 0x03, 0x12, 0x03,
-    0x71, 0x29, 0x2b, 0x0a, 0x24, 0x0a, 0x04, 0x04, 0x0f, 0x02, 0x0d, 0x12, 0x03, 0x72, 0x02, 0x2c,
-    0x22, 0x17, 0x20, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe4, 0xb8, 0xba, 0xe7, 0xbd, 0xae, 0xe9,
-    0xa1, 0xb6, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02,
-    0x0d, 0x05, 0x12, 0x03, 0x72, 0x02, 0x06, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x0d, 0x01,
-    0x12, 0x03, 0x72, 0x1c, 0x22, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x0d, 0x03, 0x12, 0x03,
-    0x72, 0x29, 0x2b, 0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x0f, 0x02, 0x0e, 0x12, 0x03, 0x73, 0x02, 0x2c,
-    0x22, 0x0e, 0x20, 0xe5, 0xad, 0x90, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe6, 0x95, 0xb0, 0x0a,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x0e, 0x05, 0x12, 0x03, 0x73, 0x02, 0x07, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x0f, 0x02, 0x0e, 0x01, 0x12, 0x03, 0x73, 0x1c, 0x26, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x0f, 0x02, 0x0e, 0x03, 0x12, 0x03, 0x73, 0x29, 0x2b, 0x0a, 0x21, 0x0a, 0x04, 0x04, 0x0f,
-    0x02, 0x0f, 0x12, 0x03, 0x74, 0x02, 0x2c, 0x22, 0x14, 0x20, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba,
-    0xe5, 0x9b, 0xbe, 0xe7, 0x89, 0x87, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0x0a, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x0f, 0x02, 0x0f, 0x04, 0x12, 0x03, 0x74, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x0f, 0x02, 0x0f, 0x06, 0x12, 0x03, 0x74, 0x0b, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02,
-    0x0f, 0x01, 0x12, 0x03, 0x74, 0x1c, 0x22, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x0f, 0x03,
-    0x12, 0x03, 0x74, 0x29, 0x2b, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x0f, 0x02, 0x10, 0x12, 0x03, 0x75,
-    0x02, 0x2c, 0x22, 0x0f, 0x20, 0x40, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe5, 0x88, 0x97, 0xe8,
-    0xa1, 0xa8, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x10, 0x04, 0x12, 0x03, 0x75, 0x02,
-    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x10, 0x06, 0x12, 0x03, 0x75, 0x0b, 0x18, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x10, 0x01, 0x12, 0x03, 0x75, 0x1c, 0x24, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x0f, 0x02, 0x10, 0x03, 0x12, 0x03, 0x75, 0x29, 0x2b, 0x0a, 0x0a, 0x0a, 0x02, 0x04,
-    0x10, 0x12, 0x04, 0x78, 0x00, 0x7d, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x10, 0x01, 0x12, 0x03,
-    0x78, 0x08, 0x1c, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x10, 0x02, 0x00, 0x12, 0x03, 0x79, 0x02, 0x14,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x00, 0x05, 0x12, 0x03, 0x79, 0x02, 0x08, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x10, 0x02, 0x00, 0x01, 0x12, 0x03, 0x79, 0x09, 0x0e, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x10, 0x02, 0x00, 0x03, 0x12, 0x03, 0x79, 0x12, 0x13, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x10,
-    0x02, 0x01, 0x12, 0x03, 0x7a, 0x02, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x01, 0x05,
-    0x12, 0x03, 0x7a, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x01, 0x01, 0x12, 0x03,
-    0x7a, 0x09, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x01, 0x03, 0x12, 0x03, 0x7a, 0x12,
-    0x13, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x10, 0x02, 0x02, 0x12, 0x03, 0x7b, 0x02, 0x14, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x10, 0x02, 0x02, 0x05, 0x12, 0x03, 0x7b, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x10, 0x02, 0x02, 0x01, 0x12, 0x03, 0x7b, 0x09, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x10,
-    0x02, 0x02, 0x03, 0x12, 0x03, 0x7b, 0x12, 0x13, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x10, 0x02, 0x03,
-    0x12, 0x03, 0x7c, 0x02, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x03, 0x05, 0x12, 0x03,
-    0x7c, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x03, 0x01, 0x12, 0x03, 0x7c, 0x09,
-    0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x03, 0x03, 0x12, 0x03, 0x7c, 0x12, 0x13, 0x0a,
-    0x0b, 0x0a, 0x02, 0x04, 0x11, 0x12, 0x05, 0x7f, 0x00, 0x82, 0x01, 0x01, 0x0a, 0x0a, 0x0a, 0x03,
-    0x04, 0x11, 0x01, 0x12, 0x03, 0x7f, 0x08, 0x18, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x11, 0x02, 0x00,
-    0x12, 0x04, 0x80, 0x01, 0x02, 0x20, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x11, 0x02, 0x00, 0x05, 0x12,
-    0x04, 0x80, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x11, 0x02, 0x00, 0x01, 0x12, 0x04,
-    0x80, 0x01, 0x17, 0x1a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x11, 0x02, 0x00, 0x03, 0x12, 0x04, 0x80,
-    0x01, 0x1e, 0x1f, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x11, 0x02, 0x01, 0x12, 0x04, 0x81, 0x01, 0x02,
-    0x20, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x11, 0x02, 0x01, 0x06, 0x12, 0x04, 0x81, 0x01, 0x02, 0x16,
-    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x11, 0x02, 0x01, 0x01, 0x12, 0x04, 0x81, 0x01, 0x17, 0x1b, 0x0a,
-    0x0d, 0x0a, 0x05, 0x04, 0x11, 0x02, 0x01, 0x03, 0x12, 0x04, 0x81, 0x01, 0x1e, 0x1f, 0x0a, 0x0c,
-    0x0a, 0x02, 0x04, 0x12, 0x12, 0x06, 0x84, 0x01, 0x00, 0x88, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03,
-    0x04, 0x12, 0x01, 0x12, 0x04, 0x84, 0x01, 0x08, 0x1e, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x12, 0x02,
-    0x00, 0x12, 0x04, 0x85, 0x01, 0x02, 0x27, 0x22, 0x0e, 0x20, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba,
-    0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x12, 0x02, 0x00, 0x04,
-    0x12, 0x04, 0x85, 0x01, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x12, 0x02, 0x00, 0x06, 0x12,
-    0x04, 0x85, 0x01, 0x0b, 0x16, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x12, 0x02, 0x00, 0x01, 0x12, 0x04,
-    0x85, 0x01, 0x17, 0x1f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x12, 0x02, 0x00, 0x03, 0x12, 0x04, 0x85,
-    0x01, 0x25, 0x26, 0x0a, 0x34, 0x0a, 0x04, 0x04, 0x12, 0x02, 0x01, 0x12, 0x04, 0x86, 0x01, 0x02,
-    0x27, 0x22, 0x26, 0x20, 0xe5, 0x88, 0x86, 0xe9, 0xa1, 0xb5, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96,
-    0xe4, 0xb8, 0x8b, 0xe4, 0xb8, 0x80, 0xe6, 0xac, 0xa1, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xe7,
-    0x9a, 0x84, 0xe6, 0xb8, 0xb8, 0xe6, 0xa0, 0x87, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x12, 0x02,
-    0x01, 0x05, 0x12, 0x04, 0x86, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x12, 0x02, 0x01,
-    0x01, 0x12, 0x04, 0x86, 0x01, 0x17, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x12, 0x02, 0x01, 0x03,
-    0x12, 0x04, 0x86, 0x01, 0x25, 0x26, 0x0a, 0x22, 0x0a, 0x04, 0x04, 0x12, 0x02, 0x02, 0x12, 0x04,
-    0x87, 0x01, 0x02, 0x27, 0x22, 0x14, 0x20, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe8, 0xbf, 0x98,
-    0xe6, 0x9c, 0x89, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x12,
-    0x02, 0x02, 0x05, 0x12, 0x04, 0x87, 0x01, 0x02, 0x06, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x12, 0x02,
-    0x02, 0x01, 0x12, 0x04, 0x87, 0x01, 0x17, 0x1f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x12, 0x02, 0x02,
-    0x03, 0x12, 0x04, 0x87, 0x01, 0x25, 0x26, 0x0a, 0x1f, 0x0a, 0x02, 0x04, 0x13, 0x12, 0x06, 0x8b,
-    0x01, 0x00, 0x8f, 0x01, 0x01, 0x1a, 0x11, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe5, 0xad,
-    0x90, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x13, 0x01, 0x12,
-    0x04, 0x8b, 0x01, 0x08, 0x20, 0x0a, 0x21, 0x0a, 0x04, 0x04, 0x13, 0x02, 0x00, 0x12, 0x04, 0x8c,
-    0x01, 0x02, 0x38, 0x22, 0x13, 0x20, 0xe8, 0xa2, 0xab, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe5,
-    0xaf, 0xb9, 0xe8, 0xb1, 0xa1, 0x69, 0x64, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x13, 0x02, 0x00,
-    0x05, 0x12, 0x04, 0x8c, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x13, 0x02, 0x00, 0x01,
-    0x12, 0x04, 0x8c, 0x01, 0x08, 0x0b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x13, 0x02, 0x00, 0x03, 0x12,
-    0x04, 0x8c, 0x01, 0x12, 0x13, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x13, 0x02, 0x00, 0x08, 0x12, 0x04,
-    0x8c, 0x01, 0x14, 0x37, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x13, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04,
-    0x04, 0x12, 0x04, 0x8c, 0x01, 0x15, 0x36, 0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x13, 0x02, 0x01, 0x12,
-    0x04, 0x8d, 0x01, 0x02, 0x38, 0x22, 0x0d, 0x20, 0xe4, 0xb8, 0xbb, 0xe8, 0xaf, 0x84, 0xe8, 0xae,
-    0xba, 0x69, 0x64, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x13, 0x02, 0x01, 0x05, 0x12, 0x04, 0x8d,
-    0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x13, 0x02, 0x01, 0x01, 0x12, 0x04, 0x8d, 0x01,
-    0x08, 0x0f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x13, 0x02, 0x01, 0x03, 0x12, 0x04, 0x8d, 0x01, 0x12,
-    0x13, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x13, 0x02, 0x01, 0x08, 0x12, 0x04, 0x8d, 0x01, 0x14, 0x37,
-    0x0a, 0x11, 0x0a, 0x09, 0x04, 0x13, 0x02, 0x01, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x04, 0x8d,
-    0x01, 0x15, 0x36, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x13, 0x02, 0x02, 0x12, 0x04, 0x8e, 0x01, 0x02,
-    0x14, 0x22, 0x0e, 0x20, 0xe8, 0xb5, 0xb7, 0xe5, 0xa7, 0x8b, 0xe6, 0xb8, 0xb8, 0xe6, 0xa0, 0x87,
-    0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x13, 0x02, 0x02, 0x05, 0x12, 0x04, 0x8e, 0x01, 0x02, 0x07,
-    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x13, 0x02, 0x02, 0x01, 0x12, 0x04, 0x8e, 0x01, 0x08, 0x0e, 0x0a,
-    0x0d, 0x0a, 0x05, 0x04, 0x13, 0x02, 0x02, 0x03, 0x12, 0x04, 0x8e, 0x01, 0x12, 0x13, 0x0a, 0x0c,
-    0x0a, 0x02, 0x04, 0x14, 0x12, 0x06, 0x91, 0x01, 0x00, 0x95, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03,
-    0x04, 0x14, 0x01, 0x12, 0x04, 0x91, 0x01, 0x08, 0x21, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x14, 0x02,
-    0x00, 0x12, 0x04, 0x92, 0x01, 0x02, 0x27, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x14, 0x02, 0x00, 0x04,
-    0x12, 0x04, 0x92, 0x01, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x14, 0x02, 0x00, 0x06, 0x12,
-    0x04, 0x92, 0x01, 0x0b, 0x16, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x14, 0x02, 0x00, 0x01, 0x12, 0x04,
-    0x92, 0x01, 0x17, 0x1f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x14, 0x02, 0x00, 0x03, 0x12, 0x04, 0x92,
-    0x01, 0x25, 0x26, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x14, 0x02, 0x01, 0x12, 0x04, 0x93, 0x01, 0x02,
-    0x27, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x14, 0x02, 0x01, 0x05, 0x12, 0x04, 0x93, 0x01, 0x02, 0x07,
-    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x14, 0x02, 0x01, 0x01, 0x12, 0x04, 0x93, 0x01, 0x17, 0x22, 0x0a,
-    0x0d, 0x0a, 0x05, 0x04, 0x14, 0x02, 0x01, 0x03, 0x12, 0x04, 0x93, 0x01, 0x25, 0x26, 0x0a, 0x0c,
-    0x0a, 0x04, 0x04, 0x14, 0x02, 0x02, 0x12, 0x04, 0x94, 0x01, 0x02, 0x27, 0x0a, 0x0d, 0x0a, 0x05,
-    0x04, 0x14, 0x02, 0x02, 0x05, 0x12, 0x04, 0x94, 0x01, 0x02, 0x06, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
-    0x14, 0x02, 0x02, 0x01, 0x12, 0x04, 0x94, 0x01, 0x17, 0x1f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x14,
-    0x02, 0x02, 0x03, 0x12, 0x04, 0x94, 0x01, 0x25, 0x26, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x15, 0x12,
-    0x06, 0x97, 0x01, 0x00, 0x9c, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x15, 0x01, 0x12, 0x04,
-    0x97, 0x01, 0x08, 0x22, 0x0a, 0x21, 0x0a, 0x04, 0x04, 0x15, 0x02, 0x00, 0x12, 0x04, 0x98, 0x01,
-    0x02, 0x38, 0x22, 0x13, 0x20, 0xe8, 0xa2, 0xab, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe5, 0xaf,
-    0xb9, 0xe8, 0xb1, 0xa1, 0x69, 0x64, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x15, 0x02, 0x00, 0x05,
-    0x12, 0x04, 0x98, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x15, 0x02, 0x00, 0x01, 0x12,
-    0x04, 0x98, 0x01, 0x08, 0x0b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x15, 0x02, 0x00, 0x03, 0x12, 0x04,
-    0x98, 0x01, 0x12, 0x13, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x15, 0x02, 0x00, 0x08, 0x12, 0x04, 0x98,
-    0x01, 0x14, 0x37, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x15, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04,
-    0x12, 0x04, 0x98, 0x01, 0x15, 0x36, 0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x15, 0x02, 0x01, 0x12, 0x04,
-    0x99, 0x01, 0x02, 0x38, 0x22, 0x0d, 0x20, 0xe4, 0xb8, 0xbb, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba,
-    0x69, 0x64, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x15, 0x02, 0x01, 0x05, 0x12, 0x04, 0x99, 0x01,
-    0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x15, 0x02, 0x01, 0x01, 0x12, 0x04, 0x99, 0x01, 0x08,
-    0x0f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x15, 0x02, 0x01, 0x03, 0x12, 0x04, 0x99, 0x01, 0x12, 0x13,
-    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x15, 0x02, 0x01, 0x08, 0x12, 0x04, 0x99, 0x01, 0x14, 0x37, 0x0a,
-    0x11, 0x0a, 0x09, 0x04, 0x15, 0x02, 0x01, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x04, 0x99, 0x01,
-    0x15, 0x36, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x15, 0x02, 0x02, 0x12, 0x04, 0x9a, 0x01, 0x02, 0x14,
-    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x15, 0x02, 0x02, 0x05, 0x12, 0x04, 0x9a, 0x01, 0x02, 0x07, 0x0a,
-    0x0d, 0x0a, 0x05, 0x04, 0x15, 0x02, 0x02, 0x01, 0x12, 0x04, 0x9a, 0x01, 0x08, 0x0c, 0x0a, 0x0d,
-    0x0a, 0x05, 0x04, 0x15, 0x02, 0x02, 0x03, 0x12, 0x04, 0x9a, 0x01, 0x12, 0x13, 0x0a, 0x0c, 0x0a,
-    0x04, 0x04, 0x15, 0x02, 0x03, 0x12, 0x04, 0x9b, 0x01, 0x02, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
-    0x15, 0x02, 0x03, 0x05, 0x12, 0x04, 0x9b, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x15,
-    0x02, 0x03, 0x01, 0x12, 0x04, 0x9b, 0x01, 0x08, 0x0d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x15, 0x02,
-    0x03, 0x03, 0x12, 0x04, 0x9b, 0x01, 0x12, 0x13, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x16, 0x12, 0x06,
-    0x9e, 0x01, 0x00, 0xa1, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x16, 0x01, 0x12, 0x04, 0x9e,
-    0x01, 0x08, 0x23, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x16, 0x02, 0x00, 0x12, 0x04, 0x9f, 0x01, 0x02,
-    0x24, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x16, 0x02, 0x00, 0x04, 0x12, 0x04, 0x9f, 0x01, 0x02, 0x0a,
-    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x16, 0x02, 0x00, 0x06, 0x12, 0x04, 0x9f, 0x01, 0x0b, 0x16, 0x0a,
-    0x0d, 0x0a, 0x05, 0x04, 0x16, 0x02, 0x00, 0x01, 0x12, 0x04, 0x9f, 0x01, 0x17, 0x1f, 0x0a, 0x0d,
-    0x0a, 0x05, 0x04, 0x16, 0x02, 0x00, 0x03, 0x12, 0x04, 0x9f, 0x01, 0x22, 0x23, 0x0a, 0x0c, 0x0a,
-    0x04, 0x04, 0x16, 0x02, 0x01, 0x12, 0x04, 0xa0, 0x01, 0x02, 0x24, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
-    0x16, 0x02, 0x01, 0x05, 0x12, 0x04, 0xa0, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x16,
-    0x02, 0x01, 0x01, 0x12, 0x04, 0xa0, 0x01, 0x17, 0x1c, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x16, 0x02,
-    0x01, 0x03, 0x12, 0x04, 0xa0, 0x01, 0x22, 0x23, 0x0a, 0x31, 0x0a, 0x02, 0x04, 0x17, 0x12, 0x06,
-    0xa4, 0x01, 0x00, 0xa8, 0x01, 0x01, 0x1a, 0x23, 0x20, 0xe4, 0xbb, 0xa3, 0xe8, 0xa1, 0xa8, 0xe4,
-    0xb8, 0xbb, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe7, 0x9a, 0x84, 0xe5, 0xad, 0x90, 0xe8, 0xaf,
-    0x84, 0xe8, 0xae, 0xba, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x0a, 0x0a, 0x0b, 0x0a, 0x03, 0x04,
-    0x17, 0x01, 0x12, 0x04, 0xa4, 0x01, 0x08, 0x1a, 0x0a, 0x22, 0x0a, 0x04, 0x04, 0x17, 0x02, 0x00,
-    0x12, 0x04, 0xa5, 0x01, 0x02, 0x27, 0x22, 0x14, 0x20, 0xe5, 0xad, 0x90, 0xe8, 0xaf, 0x84, 0xe8,
-    0xae, 0xba, 0xe7, 0x9a, 0x84, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x0a, 0x0a, 0x0d, 0x0a, 0x05,
-    0x04, 0x17, 0x02, 0x00, 0x04, 0x12, 0x04, 0xa5, 0x01, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
-    0x17, 0x02, 0x00, 0x06, 0x12, 0x04, 0xa5, 0x01, 0x0b, 0x16, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x17,
-    0x02, 0x00, 0x01, 0x12, 0x04, 0xa5, 0x01, 0x17, 0x1c, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x17, 0x02,
-    0x00, 0x03, 0x12, 0x04, 0xa5, 0x01, 0x25, 0x26, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x17, 0x02, 0x01,
-    0x12, 0x04, 0xa6, 0x01, 0x02, 0x27, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x17, 0x02, 0x01, 0x05, 0x12,
-    0x04, 0xa6, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x17, 0x02, 0x01, 0x01, 0x12, 0x04,
-    0xa6, 0x01, 0x17, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x17, 0x02, 0x01, 0x03, 0x12, 0x04, 0xa6,
-    0x01, 0x25, 0x26, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x17, 0x02, 0x02, 0x12, 0x04, 0xa7, 0x01, 0x02,
-    0x27, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x17, 0x02, 0x02, 0x05, 0x12, 0x04, 0xa7, 0x01, 0x02, 0x06,
-    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x17, 0x02, 0x02, 0x01, 0x12, 0x04, 0xa7, 0x01, 0x17, 0x1f, 0x0a,
-    0x0d, 0x0a, 0x05, 0x04, 0x17, 0x02, 0x02, 0x03, 0x12, 0x04, 0xa7, 0x01, 0x25, 0x26, 0x0a, 0x46,
-    0x0a, 0x02, 0x04, 0x18, 0x12, 0x06, 0xab, 0x01, 0x00, 0xae, 0x01, 0x01, 0x1a, 0x38, 0x20, 0xe8,
-    0xaf, 0xa6, 0xe7, 0xbb, 0x86, 0xe7, 0x9a, 0x84, 0xe4, 0xb8, 0xbb, 0xe8, 0xaf, 0x84, 0xe8, 0xae,
-    0xba, 0xe7, 0x9a, 0x84, 0xe8, 0xaf, 0xa6, 0xe7, 0xbb, 0x86, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf,
-    0xef, 0xbc, 0x8c, 0xe4, 0xbc, 0x9a, 0xe5, 0x8c, 0x85, 0xe5, 0x90, 0xab, 0xe5, 0xad, 0x90, 0xe8,
-    0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x18, 0x01, 0x12, 0x04, 0xab,
-    0x01, 0x08, 0x1b, 0x0a, 0x28, 0x0a, 0x04, 0x04, 0x18, 0x02, 0x00, 0x12, 0x04, 0xac, 0x01, 0x02,
-    0x26, 0x22, 0x1a, 0x20, 0xe4, 0xb8, 0xbb, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe6, 0x9c, 0xac,
-    0xe8, 0xba, 0xab, 0xe7, 0x9a, 0x84, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x0a, 0x0a, 0x0d, 0x0a,
-    0x05, 0x04, 0x18, 0x02, 0x00, 0x06, 0x12, 0x04, 0xac, 0x01, 0x02, 0x0d, 0x0a, 0x0d, 0x0a, 0x05,
-    0x04, 0x18, 0x02, 0x00, 0x01, 0x12, 0x04, 0xac, 0x01, 0x15, 0x19, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
-    0x18, 0x02, 0x00, 0x03, 0x12, 0x04, 0xac, 0x01, 0x24, 0x25, 0x0a, 0x22, 0x0a, 0x04, 0x04, 0x18,
-    0x02, 0x01, 0x12, 0x04, 0xad, 0x01, 0x02, 0x26, 0x22, 0x14, 0x20, 0xe5, 0xad, 0x90, 0xe8, 0xaf,
-    0x84, 0xe8, 0xae, 0xba, 0xe7, 0x9a, 0x84, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x0a, 0x0a, 0x0d,
-    0x0a, 0x05, 0x04, 0x18, 0x02, 0x01, 0x06, 0x12, 0x04, 0xad, 0x01, 0x02, 0x14, 0x0a, 0x0d, 0x0a,
-    0x05, 0x04, 0x18, 0x02, 0x01, 0x01, 0x12, 0x04, 0xad, 0x01, 0x15, 0x21, 0x0a, 0x0d, 0x0a, 0x05,
-    0x04, 0x18, 0x02, 0x01, 0x03, 0x12, 0x04, 0xad, 0x01, 0x24, 0x25, 0x0a, 0x0c, 0x0a, 0x02, 0x04,
-    0x19, 0x12, 0x06, 0xb0, 0x01, 0x00, 0xb4, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x19, 0x01,
-    0x12, 0x04, 0xb0, 0x01, 0x08, 0x25, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x19, 0x02, 0x00, 0x12, 0x04,
-    0xb1, 0x01, 0x02, 0x3b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x19, 0x02, 0x00, 0x05, 0x12, 0x04, 0xb1,
-    0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x19, 0x02, 0x00, 0x01, 0x12, 0x04, 0xb1, 0x01,
-    0x0b, 0x0e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x19, 0x02, 0x00, 0x03, 0x12, 0x04, 0xb1, 0x01, 0x15,
-    0x16, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x19, 0x02, 0x00, 0x08, 0x12, 0x04, 0xb1, 0x01, 0x17, 0x3a,
-    0x0a, 0x11, 0x0a, 0x09, 0x04, 0x19, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x04, 0xb1,
-    0x01, 0x18, 0x39, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x19, 0x02, 0x01, 0x12, 0x04, 0xb2, 0x01, 0x02,
-    0x17, 0x22, 0x0e, 0x20, 0xe8, 0xb5, 0xb7, 0xe5, 0xa7, 0x8b, 0xe6, 0xb8, 0xb8, 0xe6, 0xa0, 0x87,
-    0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x19, 0x02, 0x01, 0x05, 0x12, 0x04, 0xb2, 0x01, 0x02, 0x07,
-    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x19, 0x02, 0x01, 0x01, 0x12, 0x04, 0xb2, 0x01, 0x0b, 0x11, 0x0a,
-    0x0d, 0x0a, 0x05, 0x04, 0x19, 0x02, 0x01, 0x03, 0x12, 0x04, 0xb2, 0x01, 0x15, 0x16, 0x0a, 0x1c,
-    0x0a, 0x04, 0x04, 0x19, 0x02, 0x02, 0x12, 0x04, 0xb3, 0x01, 0x02, 0x17, 0x22, 0x0e, 0x20, 0xe6,
-    0x8e, 0x92, 0xe5, 0xba, 0x8f, 0xe6, 0x96, 0xb9, 0xe5, 0xbc, 0x8f, 0x0a, 0x0a, 0x0d, 0x0a, 0x05,
-    0x04, 0x19, 0x02, 0x02, 0x06, 0x12, 0x04, 0xb3, 0x01, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
-    0x19, 0x02, 0x02, 0x01, 0x12, 0x04, 0xb3, 0x01, 0x0b, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x19,
-    0x02, 0x02, 0x03, 0x12, 0x04, 0xb3, 0x01, 0x15, 0x16, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x1a, 0x12,
-    0x06, 0xb6, 0x01, 0x00, 0xba, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x1a, 0x01, 0x12, 0x04,
-    0xb6, 0x01, 0x08, 0x26, 0x0a, 0x19, 0x0a, 0x04, 0x04, 0x1a, 0x02, 0x00, 0x12, 0x04, 0xb7, 0x01,
-    0x02, 0x2f, 0x22, 0x0b, 0x20, 0xe4, 0xb8, 0xbb, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a,
-    0x0d, 0x0a, 0x05, 0x04, 0x1a, 0x02, 0x00, 0x04, 0x12, 0x04, 0xb7, 0x01, 0x02, 0x0a, 0x0a, 0x0d,
-    0x0a, 0x05, 0x04, 0x1a, 0x02, 0x00, 0x06, 0x12, 0x04, 0xb7, 0x01, 0x0b, 0x1e, 0x0a, 0x0d, 0x0a,
-    0x05, 0x04, 0x1a, 0x02, 0x00, 0x01, 0x12, 0x04, 0xb7, 0x01, 0x1f, 0x27, 0x0a, 0x0d, 0x0a, 0x05,
-    0x04, 0x1a, 0x02, 0x00, 0x03, 0x12, 0x04, 0xb7, 0x01, 0x2d, 0x2e, 0x0a, 0x0c, 0x0a, 0x04, 0x04,
-    0x1a, 0x02, 0x01, 0x12, 0x04, 0xb8, 0x01, 0x02, 0x2f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1a, 0x02,
-    0x01, 0x05, 0x12, 0x04, 0xb8, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1a, 0x02, 0x01,
-    0x01, 0x12, 0x04, 0xb8, 0x01, 0x1f, 0x2a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1a, 0x02, 0x01, 0x03,
-    0x12, 0x04, 0xb8, 0x01, 0x2d, 0x2e, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x1a, 0x02, 0x02, 0x12, 0x04,
-    0xb9, 0x01, 0x02, 0x2f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1a, 0x02, 0x02, 0x05, 0x12, 0x04, 0xb9,
-    0x01, 0x02, 0x06, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1a, 0x02, 0x02, 0x01, 0x12, 0x04, 0xb9, 0x01,
-    0x1f, 0x27, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1a, 0x02, 0x02, 0x03, 0x12, 0x04, 0xb9, 0x01, 0x2d,
-    0x2e, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x1b, 0x12, 0x06, 0xbc, 0x01, 0x00, 0xc0, 0x01, 0x01, 0x0a,
-    0x0b, 0x0a, 0x03, 0x04, 0x1b, 0x01, 0x12, 0x04, 0xbc, 0x01, 0x08, 0x27, 0x0a, 0x0c, 0x0a, 0x04,
-    0x04, 0x1b, 0x02, 0x00, 0x12, 0x04, 0xbd, 0x01, 0x02, 0x3b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b,
-    0x02, 0x00, 0x05, 0x12, 0x04, 0xbd, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02,
-    0x00, 0x01, 0x12, 0x04, 0xbd, 0x01, 0x0b, 0x0e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02, 0x00,
-    0x03, 0x12, 0x04, 0xbd, 0x01, 0x15, 0x16, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02, 0x00, 0x08,
-    0x12, 0x04, 0xbd, 0x01, 0x17, 0x3a, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x1b, 0x02, 0x00, 0x08, 0x87,
-    0x09, 0x04, 0x04, 0x12, 0x04, 0xbd, 0x01, 0x18, 0x39, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x1b, 0x02,
-    0x01, 0x12, 0x04, 0xbe, 0x01, 0x02, 0x17, 0x22, 0x0e, 0x20, 0xe8, 0xb5, 0xb7, 0xe5, 0xa7, 0x8b,
-    0xe6, 0xb8, 0xb8, 0xe6, 0xa0, 0x87, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02, 0x01, 0x05,
-    0x12, 0x04, 0xbe, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02, 0x01, 0x01, 0x12,
-    0x04, 0xbe, 0x01, 0x0b, 0x11, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02, 0x01, 0x03, 0x12, 0x04,
-    0xbe, 0x01, 0x15, 0x16, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x1b, 0x02, 0x02, 0x12, 0x04, 0xbf, 0x01,
-    0x02, 0x17, 0x22, 0x0e, 0x20, 0xe6, 0x8e, 0x92, 0xe5, 0xba, 0x8f, 0xe6, 0x96, 0xb9, 0xe5, 0xbc,
-    0x8f, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02, 0x02, 0x06, 0x12, 0x04, 0xbf, 0x01, 0x02,
-    0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02, 0x02, 0x01, 0x12, 0x04, 0xbf, 0x01, 0x0b, 0x12,
-    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02, 0x02, 0x03, 0x12, 0x04, 0xbf, 0x01, 0x15, 0x16, 0x0a,
-    0x31, 0x0a, 0x02, 0x04, 0x1c, 0x12, 0x06, 0xc3, 0x01, 0x00, 0xc6, 0x01, 0x01, 0x1a, 0x23, 0x20,
-    0xe4, 0xbb, 0xa3, 0xe8, 0xa1, 0xa8, 0xe4, 0xb8, 0xbb, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe7,
-    0x9a, 0x84, 0xe5, 0xad, 0x90, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe4, 0xbf, 0xa1, 0xe6, 0x81,
-    0xaf, 0x0a, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x1c, 0x01, 0x12, 0x04, 0xc3, 0x01, 0x08, 0x1c, 0x0a,
-    0x22, 0x0a, 0x04, 0x04, 0x1c, 0x02, 0x00, 0x12, 0x04, 0xc4, 0x01, 0x02, 0x21, 0x22, 0x14, 0x20,
-    0xe5, 0xad, 0x90, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe7, 0x9a, 0x84, 0xe4, 0xbf, 0xa1, 0xe6,
-    0x81, 0xaf, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1c, 0x02, 0x00, 0x04, 0x12, 0x04, 0xc4, 0x01,
-    0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1c, 0x02, 0x00, 0x06, 0x12, 0x04, 0xc4, 0x01, 0x0b,
-    0x16, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1c, 0x02, 0x00, 0x01, 0x12, 0x04, 0xc4, 0x01, 0x17, 0x1c,
-    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1c, 0x02, 0x00, 0x03, 0x12, 0x04, 0xc4, 0x01, 0x1f, 0x20, 0x0a,
-    0x22, 0x0a, 0x04, 0x04, 0x1c, 0x02, 0x01, 0x12, 0x04, 0xc5, 0x01, 0x02, 0x21, 0x22, 0x14, 0x20,
-    0xe5, 0xad, 0x90, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe6, 0x80, 0xbb, 0xe6, 0x95, 0xb0, 0xe9,
-    0x87, 0x8f, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1c, 0x02, 0x01, 0x05, 0x12, 0x04, 0xc5, 0x01,
-    0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1c, 0x02, 0x01, 0x01, 0x12, 0x04, 0xc5, 0x01, 0x17,
-    0x1c, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1c, 0x02, 0x01, 0x03, 0x12, 0x04, 0xc5, 0x01, 0x1f, 0x20,
-    0x0a, 0x46, 0x0a, 0x02, 0x04, 0x1d, 0x12, 0x06, 0xc9, 0x01, 0x00, 0xcc, 0x01, 0x01, 0x1a, 0x38,
-    0x20, 0xe8, 0xaf, 0xa6, 0xe7, 0xbb, 0x86, 0xe7, 0x9a, 0x84, 0xe4, 0xb8, 0xbb, 0xe8, 0xaf, 0x84,
-    0xe8, 0xae, 0xba, 0xe7, 0x9a, 0x84, 0xe8, 0xaf, 0xa6, 0xe7, 0xbb, 0x86, 0xe4, 0xbf, 0xa1, 0xe6,
-    0x81, 0xaf, 0xef, 0xbc, 0x8c, 0xe4, 0xbc, 0x9a, 0xe5, 0x8c, 0x85, 0xe5, 0x90, 0xab, 0xe5, 0xad,
-    0x90, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x1d, 0x01, 0x12,
-    0x04, 0xc9, 0x01, 0x08, 0x1d, 0x0a, 0x28, 0x0a, 0x04, 0x04, 0x1d, 0x02, 0x00, 0x12, 0x04, 0xca,
-    0x01, 0x02, 0x28, 0x22, 0x1a, 0x20, 0xe4, 0xb8, 0xbb, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe6,
-    0x9c, 0xac, 0xe8, 0xba, 0xab, 0xe7, 0x9a, 0x84, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x0a, 0x0a,
-    0x0d, 0x0a, 0x05, 0x04, 0x1d, 0x02, 0x00, 0x06, 0x12, 0x04, 0xca, 0x01, 0x02, 0x0d, 0x0a, 0x0d,
-    0x0a, 0x05, 0x04, 0x1d, 0x02, 0x00, 0x01, 0x12, 0x04, 0xca, 0x01, 0x17, 0x1b, 0x0a, 0x0d, 0x0a,
-    0x05, 0x04, 0x1d, 0x02, 0x00, 0x03, 0x12, 0x04, 0xca, 0x01, 0x26, 0x27, 0x0a, 0x22, 0x0a, 0x04,
-    0x04, 0x1d, 0x02, 0x01, 0x12, 0x04, 0xcb, 0x01, 0x02, 0x28, 0x22, 0x14, 0x20, 0xe5, 0xad, 0x90,
-    0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe7, 0x9a, 0x84, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x0a,
-    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1d, 0x02, 0x01, 0x06, 0x12, 0x04, 0xcb, 0x01, 0x02, 0x16, 0x0a,
-    0x0d, 0x0a, 0x05, 0x04, 0x1d, 0x02, 0x01, 0x01, 0x12, 0x04, 0xcb, 0x01, 0x17, 0x23, 0x0a, 0x0d,
-    0x0a, 0x05, 0x04, 0x1d, 0x02, 0x01, 0x03, 0x12, 0x04, 0xcb, 0x01, 0x26, 0x27, 0x0a, 0x0c, 0x0a,
-    0x02, 0x04, 0x1e, 0x12, 0x06, 0xce, 0x01, 0x00, 0xd2, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04,
-    0x1e, 0x01, 0x12, 0x04, 0xce, 0x01, 0x08, 0x28, 0x0a, 0x19, 0x0a, 0x04, 0x04, 0x1e, 0x02, 0x00,
-    0x12, 0x04, 0xcf, 0x01, 0x02, 0x33, 0x22, 0x0b, 0x20, 0xe4, 0xb8, 0xbb, 0xe8, 0xaf, 0x84, 0xe8,
-    0xae, 0xba, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1e, 0x02, 0x00, 0x04, 0x12, 0x04, 0xcf, 0x01,
-    0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1e, 0x02, 0x00, 0x06, 0x12, 0x04, 0xcf, 0x01, 0x0b,
-    0x20, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1e, 0x02, 0x00, 0x01, 0x12, 0x04, 0xcf, 0x01, 0x21, 0x2e,
-    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1e, 0x02, 0x00, 0x03, 0x12, 0x04, 0xcf, 0x01, 0x31, 0x32, 0x0a,
-    0x0c, 0x0a, 0x04, 0x04, 0x1e, 0x02, 0x01, 0x12, 0x04, 0xd0, 0x01, 0x02, 0x33, 0x0a, 0x0d, 0x0a,
-    0x05, 0x04, 0x1e, 0x02, 0x01, 0x05, 0x12, 0x04, 0xd0, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05,
-    0x04, 0x1e, 0x02, 0x01, 0x01, 0x12, 0x04, 0xd0, 0x01, 0x21, 0x2c, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
-    0x1e, 0x02, 0x01, 0x03, 0x12, 0x04, 0xd0, 0x01, 0x31, 0x32, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x1e,
-    0x02, 0x02, 0x12, 0x04, 0xd1, 0x01, 0x02, 0x33, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1e, 0x02, 0x02,
-    0x05, 0x12, 0x04, 0xd1, 0x01, 0x02, 0x06, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1e, 0x02, 0x02, 0x01,
-    0x12, 0x04, 0xd1, 0x01, 0x21, 0x29, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1e, 0x02, 0x02, 0x03, 0x12,
-    0x04, 0xd1, 0x01, 0x31, 0x32, 0x0a, 0x22, 0x0a, 0x02, 0x04, 0x1f, 0x12, 0x06, 0xd5, 0x01, 0x00,
-    0xd7, 0x01, 0x01, 0x1a, 0x14, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe7, 0xbd, 0xae, 0xe9,
-    0xa1, 0xb6, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x1f, 0x01,
-    0x12, 0x04, 0xd5, 0x01, 0x08, 0x1f, 0x0a, 0x21, 0x0a, 0x04, 0x04, 0x1f, 0x02, 0x00, 0x12, 0x04,
-    0xd6, 0x01, 0x02, 0x34, 0x22, 0x13, 0x20, 0xe8, 0xa2, 0xab, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba,
-    0xe5, 0xaf, 0xb9, 0xe8, 0xb1, 0xa1, 0x69, 0x64, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1f, 0x02,
-    0x00, 0x05, 0x12, 0x04, 0xd6, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1f, 0x02, 0x00,
-    0x01, 0x12, 0x04, 0xd6, 0x01, 0x08, 0x0b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1f, 0x02, 0x00, 0x03,
-    0x12, 0x04, 0xd6, 0x01, 0x0e, 0x0f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1f, 0x02, 0x00, 0x08, 0x12,
-    0x04, 0xd6, 0x01, 0x10, 0x33, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x1f, 0x02, 0x00, 0x08, 0x87, 0x09,
-    0x04, 0x04, 0x12, 0x04, 0xd6, 0x01, 0x11, 0x32, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x20, 0x12, 0x06,
-    0xd9, 0x01, 0x00, 0xdb, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x20, 0x01, 0x12, 0x04, 0xd9,
-    0x01, 0x08, 0x20, 0x0a, 0x22, 0x0a, 0x04, 0x04, 0x20, 0x02, 0x00, 0x12, 0x04, 0xda, 0x01, 0x02,
-    0x1f, 0x22, 0x14, 0x20, 0xe7, 0xbd, 0xae, 0xe9, 0xa1, 0xb6, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba,
-    0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x20, 0x02, 0x00, 0x06,
-    0x12, 0x04, 0xda, 0x01, 0x02, 0x15, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x20, 0x02, 0x00, 0x01, 0x12,
-    0x04, 0xda, 0x01, 0x16, 0x1a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x20, 0x02, 0x00, 0x03, 0x12, 0x04,
-    0xda, 0x01, 0x1d, 0x1e, 0x0a, 0x34, 0x0a, 0x02, 0x04, 0x21, 0x12, 0x06, 0xde, 0x01, 0x00, 0xe0,
-    0x01, 0x01, 0x1a, 0x26, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe8, 0xa2, 0xab, 0xe8, 0xaf,
-    0x84, 0xe8, 0xae, 0xba, 0xe5, 0xaf, 0xb9, 0xe8, 0xb1, 0xa1, 0xe7, 0x9a, 0x84, 0xe8, 0xaf, 0x84,
-    0xe8, 0xae, 0xba, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x0a, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x21,
-    0x01, 0x12, 0x04, 0xde, 0x01, 0x08, 0x1b, 0x0a, 0x21, 0x0a, 0x04, 0x04, 0x21, 0x02, 0x00, 0x12,
-    0x04, 0xdf, 0x01, 0x02, 0x34, 0x22, 0x13, 0x20, 0xe8, 0xa2, 0xab, 0xe8, 0xaf, 0x84, 0xe8, 0xae,
-    0xba, 0xe5, 0xaf, 0xb9, 0xe8, 0xb1, 0xa1, 0x69, 0x64, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x21,
-    0x02, 0x00, 0x05, 0x12, 0x04, 0xdf, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x21, 0x02,
-    0x00, 0x01, 0x12, 0x04, 0xdf, 0x01, 0x08, 0x0b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x21, 0x02, 0x00,
-    0x03, 0x12, 0x04, 0xdf, 0x01, 0x0e, 0x0f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x21, 0x02, 0x00, 0x08,
-    0x12, 0x04, 0xdf, 0x01, 0x10, 0x33, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x21, 0x02, 0x00, 0x08, 0x87,
-    0x09, 0x04, 0x04, 0x12, 0x04, 0xdf, 0x01, 0x11, 0x32, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x22, 0x12,
-    0x06, 0xe2, 0x01, 0x00, 0xe4, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x22, 0x01, 0x12, 0x04,
-    0xe2, 0x01, 0x08, 0x1c, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x22, 0x02, 0x00, 0x12, 0x04, 0xe3, 0x01,
-    0x02, 0x12, 0x22, 0x0e, 0x20, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe6, 0x95, 0xb0, 0xe9, 0x87,
-    0x8f, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x22, 0x02, 0x00, 0x05, 0x12, 0x04, 0xe3, 0x01, 0x02,
-    0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x22, 0x02, 0x00, 0x01, 0x12, 0x04, 0xe3, 0x01, 0x08, 0x0d,
-    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x22, 0x02, 0x00, 0x03, 0x12, 0x04, 0xe3, 0x01, 0x10, 0x11, 0x0a,
-    0x0c, 0x0a, 0x02, 0x04, 0x23, 0x12, 0x06, 0xe6, 0x01, 0x00, 0xe8, 0x01, 0x01, 0x0a, 0x0b, 0x0a,
-    0x03, 0x04, 0x23, 0x01, 0x12, 0x04, 0xe6, 0x01, 0x08, 0x20, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x23,
-    0x02, 0x00, 0x12, 0x04, 0xe7, 0x01, 0x02, 0x1a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x23, 0x02, 0x00,
-    0x04, 0x12, 0x04, 0xe7, 0x01, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x23, 0x02, 0x00, 0x05,
-    0x12, 0x04, 0xe7, 0x01, 0x0b, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x23, 0x02, 0x00, 0x01, 0x12,
-    0x04, 0xe7, 0x01, 0x11, 0x15, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x23, 0x02, 0x00, 0x03, 0x12, 0x04,
-    0xe7, 0x01, 0x18, 0x19, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x24, 0x12, 0x06, 0xea, 0x01, 0x00, 0xec,
-    0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x24, 0x01, 0x12, 0x04, 0xea, 0x01, 0x08, 0x21, 0x0a,
-    0x0c, 0x0a, 0x04, 0x04, 0x24, 0x02, 0x00, 0x12, 0x04, 0xeb, 0x01, 0x02, 0x20, 0x0a, 0x0d, 0x0a,
-    0x05, 0x04, 0x24, 0x02, 0x00, 0x06, 0x12, 0x04, 0xeb, 0x01, 0x02, 0x13, 0x0a, 0x0d, 0x0a, 0x05,
-    0x04, 0x24, 0x02, 0x00, 0x01, 0x12, 0x04, 0xeb, 0x01, 0x14, 0x1b, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
-    0x24, 0x02, 0x00, 0x03, 0x12, 0x04, 0xeb, 0x01, 0x1e, 0x1f, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x25,
-    0x12, 0x06, 0xee, 0x01, 0x00, 0xf0, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x25, 0x01, 0x12,
-    0x04, 0xee, 0x01, 0x08, 0x22, 0x0a, 0x18, 0x0a, 0x04, 0x04, 0x25, 0x02, 0x00, 0x12, 0x04, 0xef,
-    0x01, 0x02, 0x3b, 0x22, 0x0a, 0x20, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x69, 0x64, 0x0a, 0x0a,
-    0x0d, 0x0a, 0x05, 0x04, 0x25, 0x02, 0x00, 0x05, 0x12, 0x04, 0xef, 0x01, 0x02, 0x07, 0x0a, 0x0d,
-    0x0a, 0x05, 0x04, 0x25, 0x02, 0x00, 0x01, 0x12, 0x04, 0xef, 0x01, 0x08, 0x12, 0x0a, 0x0d, 0x0a,
-    0x05, 0x04, 0x25, 0x02, 0x00, 0x03, 0x12, 0x04, 0xef, 0x01, 0x15, 0x16, 0x0a, 0x0d, 0x0a, 0x05,
-    0x04, 0x25, 0x02, 0x00, 0x08, 0x12, 0x04, 0xef, 0x01, 0x17, 0x3a, 0x0a, 0x11, 0x0a, 0x09, 0x04,
-    0x25, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x04, 0xef, 0x01, 0x18, 0x39, 0x0a, 0x0c,
-    0x0a, 0x02, 0x04, 0x26, 0x12, 0x06, 0xf2, 0x01, 0x00, 0xf5, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03,
-    0x04, 0x26, 0x01, 0x12, 0x04, 0xf2, 0x01, 0x08, 0x23, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x26, 0x02,
-    0x00, 0x12, 0x04, 0xf3, 0x01, 0x02, 0x17, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x26, 0x02, 0x00, 0x05,
-    0x12, 0x04, 0xf3, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x26, 0x02, 0x00, 0x01, 0x12,
-    0x04, 0xf3, 0x01, 0x08, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x26, 0x02, 0x00, 0x03, 0x12, 0x04,
-    0xf3, 0x01, 0x15, 0x16, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x26, 0x02, 0x01, 0x12, 0x04, 0xf4, 0x01,
-    0x02, 0x17, 0x22, 0x0e, 0x20, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0xe6, 0x95, 0xb0, 0xe9, 0x87,
-    0x8f, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x26, 0x02, 0x01, 0x05, 0x12, 0x04, 0xf4, 0x01, 0x02,
-    0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x26, 0x02, 0x01, 0x01, 0x12, 0x04, 0xf4, 0x01, 0x08, 0x0d,
-    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x26, 0x02, 0x01, 0x03, 0x12, 0x04, 0xf4, 0x01, 0x15, 0x16, 0x0a,
-    0x0c, 0x0a, 0x02, 0x04, 0x27, 0x12, 0x06, 0xf7, 0x01, 0x00, 0xf9, 0x01, 0x01, 0x0a, 0x0b, 0x0a,
-    0x03, 0x04, 0x27, 0x01, 0x12, 0x04, 0xf7, 0x01, 0x08, 0x25, 0x0a, 0x18, 0x0a, 0x04, 0x04, 0x27,
-    0x02, 0x00, 0x12, 0x04, 0xf8, 0x01, 0x02, 0x3b, 0x22, 0x0a, 0x20, 0xe8, 0xaf, 0x84, 0xe8, 0xae,
-    0xba, 0x69, 0x64, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x27, 0x02, 0x00, 0x05, 0x12, 0x04, 0xf8,
-    0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x27, 0x02, 0x00, 0x01, 0x12, 0x04, 0xf8, 0x01,
-    0x08, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x27, 0x02, 0x00, 0x03, 0x12, 0x04, 0xf8, 0x01, 0x15,
-    0x16, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x27, 0x02, 0x00, 0x08, 0x12, 0x04, 0xf8, 0x01, 0x17, 0x3a,
-    0x0a, 0x11, 0x0a, 0x09, 0x04, 0x27, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x04, 0xf8,
-    0x01, 0x18, 0x39, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x28, 0x12, 0x06, 0xfb, 0x01, 0x00, 0xfe, 0x01,
-    0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x28, 0x01, 0x12, 0x04, 0xfb, 0x01, 0x08, 0x26, 0x0a, 0x0c,
-    0x0a, 0x04, 0x04, 0x28, 0x02, 0x00, 0x12, 0x04, 0xfc, 0x01, 0x02, 0x17, 0x0a, 0x0d, 0x0a, 0x05,
-    0x04, 0x28, 0x02, 0x00, 0x05, 0x12, 0x04, 0xfc, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
-    0x28, 0x02, 0x00, 0x01, 0x12, 0x04, 0xfc, 0x01, 0x08, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x28,
-    0x02, 0x00, 0x03, 0x12, 0x04, 0xfc, 0x01, 0x15, 0x16, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x28, 0x02,
-    0x01, 0x12, 0x04, 0xfd, 0x01, 0x02, 0x17, 0x22, 0x0e, 0x20, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e,
-    0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x28, 0x02, 0x01, 0x05,
-    0x12, 0x04, 0xfd, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x28, 0x02, 0x01, 0x01, 0x12,
-    0x04, 0xfd, 0x01, 0x08, 0x0d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x28, 0x02, 0x01, 0x03, 0x12, 0x04,
-    0xfd, 0x01, 0x15, 0x16, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x29, 0x12, 0x06, 0x80, 0x02, 0x00, 0x83,
-    0x02, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x29, 0x01, 0x12, 0x04, 0x80, 0x02, 0x08, 0x20, 0x0a,
-    0x21, 0x0a, 0x04, 0x04, 0x29, 0x02, 0x00, 0x12, 0x04, 0x81, 0x02, 0x02, 0x34, 0x22, 0x13, 0x20,
+    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+    0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+    0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+    0x71, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+    0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x2b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+    0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+    0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+    0x65, 0x6e, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+    0x73, 0x65, 0x12, 0x62, 0x0a, 0x0f, 0x4d, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+    0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x26, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+    0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+    0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+    0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+    0x75, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+    0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x75,
+    0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12,
+    0x2c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+    0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x75, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+    0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e,
+    0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+    0x69, 0x73, 0x74, 0x4d, 0x75, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55,
+    0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x10,
+    0x4c, 0x69, 0x73, 0x74, 0x48, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+    0x12, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+    0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+    0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+    0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48,
+    0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+    0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x48, 0x65,
+    0x6c, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2a, 0x2e, 0x63, 0x6f, 0x6d,
+    0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x72,
+    0x6f, 0x76, 0x65, 0x48, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+    0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x48,
+    0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+    0x6e, 0x73, 0x65, 0x42, 0xb7, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+    0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x43, 0x6f, 0x6d, 0x6d,
+    0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68,
+    0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x79, 0x61, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x62,
+    0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x77, 0x68, 0x69, 0x6d, 0x65, 0x72, 0x2f, 0x69, 0x64, 0x6c, 0x2f,
+    0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x61,
+    0x70, 0x69, 0x2f, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x41, 0x58, 0xaa, 0x02, 0x0e, 0x43, 0x6f,
+    0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x70, 0x69, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x43,
+    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a,
+    0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+    0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x43, 0x6f, 0x6d,
+    0x6d, 0x65, 0x6e, 0x74, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a, 0x3a, 0x56, 0x31, 0x4a, 0xd6, 0xdf,
+    0x01, 0x0a, 0x07, 0x12, 0x05, 0x00, 0x00, 0xef, 0x05, 0x01, 0x0a, 0x08, 0x0a, 0x01, 0x0c, 0x12,
+    0x03, 0x00, 0x00, 0x12, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x00, 0x12, 0x03, 0x02, 0x00, 0x25, 0x0a,
+    0x09, 0x0a, 0x02, 0x03, 0x01, 0x12, 0x03, 0x03, 0x00, 0x22, 0x0a, 0x08, 0x0a, 0x01, 0x02, 0x12,
+    0x03, 0x05, 0x00, 0x17, 0x0a, 0x08, 0x0a, 0x01, 0x08, 0x12, 0x03, 0x07, 0x00, 0x50, 0x0a, 0x09,
+    0x0a, 0x02, 0x08, 0x0b, 0x12, 0x03, 0x07, 0x00, 0x50, 0x0a, 0x0a, 0x0a, 0x02, 0x05, 0x00, 0x12,
+    0x04, 0x09, 0x00, 0x0c, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x05, 0x00, 0x01, 0x12, 0x03, 0x09, 0x05,
+    0x12, 0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x00, 0x02, 0x00, 0x12, 0x03, 0x0a, 0x02, 0x1a, 0x0a, 0x0c,
+    0x0a, 0x05, 0x05, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x0a, 0x02, 0x15, 0x0a, 0x0c, 0x0a, 0x05,
+    0x05, 0x00, 0x02, 0x00, 0x02, 0x12, 0x03, 0x0a, 0x18, 0x19, 0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x00,
+    0x02, 0x01, 0x12, 0x03, 0x0b, 0x02, 0x1a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x01, 0x01,
+    0x12, 0x03, 0x0b, 0x02, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x01, 0x02, 0x12, 0x03,
+    0x0b, 0x18, 0x19, 0x0a, 0x0a, 0x0a, 0x02, 0x05, 0x01, 0x12, 0x04, 0x0e, 0x00, 0x12, 0x01, 0x0a,
+    0x0a, 0x0a, 0x03, 0x05, 0x01, 0x01, 0x12, 0x03, 0x0e, 0x05, 0x10, 0x0a, 0x20, 0x0a, 0x04, 0x05,
+    0x01, 0x02, 0x00, 0x12, 0x03, 0x0f, 0x02, 0x13, 0x22, 0x13, 0x20, 0xe7, 0xba, 0xaf, 0xe6, 0x96,
+    0x87, 0xe6, 0x9c, 0xac, 0x28, 0xe9, 0xbb, 0x98, 0xe8, 0xae, 0xa4, 0x29, 0x0a, 0x0a, 0x0c, 0x0a,
+    0x05, 0x05, 0x01, 0x02, 0x00, 0x01, 0x12, 0x03, 0x0f, 0x02, 0x06, 0x0a, 0x0c, 0x0a, 0x05, 0x05,
+    0x01, 0x02, 0x00, 0x02, 0x12, 0x03, 0x0f, 0x11, 0x12, 0x0a, 0x15, 0x0a, 0x04, 0x05, 0x01, 0x02,
+    0x01, 0x12, 0x03, 0x10, 0x02, 0x13, 0x22, 0x08, 0x20, 0xe5, 0x9b, 0xbe, 0xe6, 0x96, 0x87, 0x0a,
+    0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x01, 0x01, 0x12, 0x03, 0x10, 0x02, 0x0c, 0x0a, 0x0c,
+    0x0a, 0x05, 0x05, 0x01, 0x02, 0x01, 0x02, 0x12, 0x03, 0x10, 0x11, 0x12, 0x0a, 0x1e, 0x0a, 0x04,
+    0x05, 0x01, 0x02, 0x02, 0x12, 0x03, 0x11, 0x02, 0x13, 0x22, 0x11, 0x20, 0xe8, 0x87, 0xaa, 0xe5,
+    0xae, 0x9a, 0xe4, 0xb9, 0x89, 0xe8, 0xa1, 0xa8, 0xe6, 0x83, 0x85, 0x0a, 0x0a, 0x0c, 0x0a, 0x05,
+    0x05, 0x01, 0x02, 0x02, 0x01, 0x12, 0x03, 0x11, 0x02, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x01,
+    0x02, 0x02, 0x02, 0x12, 0x03, 0x11, 0x11, 0x12, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x00, 0x12, 0x04,
+    0x14, 0x00, 0x17, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x00, 0x01, 0x12, 0x03, 0x14, 0x08, 0x15,
+    0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x00, 0x12, 0x03, 0x15, 0x02, 0x16, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x00, 0x02, 0x00, 0x05, 0x12, 0x03, 0x15, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x15, 0x09, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02,
+    0x00, 0x03, 0x12, 0x03, 0x15, 0x14, 0x15, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x01, 0x12,
+    0x03, 0x16, 0x02, 0x16, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x05, 0x12, 0x03, 0x16,
+    0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x16, 0x09, 0x0c,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x03, 0x12, 0x03, 0x16, 0x14, 0x15, 0x0a, 0x1a,
+    0x0a, 0x02, 0x04, 0x01, 0x12, 0x04, 0x1a, 0x00, 0x23, 0x01, 0x1a, 0x0e, 0x20, 0xe5, 0x8f, 0x91,
+    0xe5, 0xb8, 0x83, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x01,
+    0x01, 0x12, 0x03, 0x1a, 0x08, 0x19, 0x0a, 0x30, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x00, 0x12, 0x03,
+    0x1b, 0x02, 0x29, 0x22, 0x23, 0x20, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe7, 0xb1, 0xbb, 0xe5,
+    0x9e, 0x8b, 0x20, 0x30, 0x2d, 0xe7, 0xba, 0xaf, 0xe6, 0x96, 0x87, 0xe6, 0x9c, 0xac, 0x20, 0x31,
+    0x2d, 0xe5, 0x9b, 0xbe, 0xe6, 0x96, 0x87, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00,
+    0x06, 0x12, 0x03, 0x1b, 0x02, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x01, 0x12,
+    0x03, 0x1b, 0x1b, 0x1f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x03, 0x12, 0x03, 0x1b,
+    0x27, 0x28, 0x0a, 0x20, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x01, 0x12, 0x03, 0x1c, 0x02, 0x29, 0x22,
+    0x13, 0x20, 0xe8, 0xa2, 0xab, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe5, 0xaf, 0xb9, 0xe8, 0xb1,
+    0xa1, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x05, 0x12, 0x03, 0x1c,
+    0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x01, 0x12, 0x03, 0x1c, 0x1b, 0x1e,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x03, 0x12, 0x03, 0x1c, 0x27, 0x28, 0x0a, 0x1b,
+    0x0a, 0x04, 0x04, 0x01, 0x02, 0x02, 0x12, 0x03, 0x1d, 0x02, 0x29, 0x22, 0x0e, 0x20, 0xe8, 0xaf,
+    0x84, 0xe8, 0xae, 0xba, 0xe5, 0x86, 0x85, 0xe5, 0xae, 0xb9, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x01, 0x02, 0x02, 0x05, 0x12, 0x03, 0x1d, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02,
+    0x02, 0x01, 0x12, 0x03, 0x1d, 0x1b, 0x22, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x02, 0x03,
+    0x12, 0x03, 0x1d, 0x27, 0x28, 0x0a, 0x1a, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x03, 0x12, 0x03, 0x1e,
+    0x02, 0x29, 0x22, 0x0d, 0x20, 0xe6, 0xa0, 0xb9, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x69, 0x64,
+    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x03, 0x05, 0x12, 0x03, 0x1e, 0x02, 0x07, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x03, 0x01, 0x12, 0x03, 0x1e, 0x1b, 0x22, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x01, 0x02, 0x03, 0x03, 0x12, 0x03, 0x1e, 0x27, 0x28, 0x0a, 0x23, 0x0a, 0x04, 0x04,
+    0x01, 0x02, 0x04, 0x12, 0x03, 0x1f, 0x02, 0x29, 0x22, 0x16, 0x20, 0xe8, 0xa2, 0xab, 0xe5, 0x9b,
+    0x9e, 0xe5, 0xa4, 0x8d, 0xe7, 0x9a, 0x84, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x69, 0x64, 0x0a,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x04, 0x05, 0x12, 0x03, 0x1f, 0x02, 0x07, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x01, 0x02, 0x04, 0x01, 0x12, 0x03, 0x1f, 0x1b, 0x24, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x01, 0x02, 0x04, 0x03, 0x12, 0x03, 0x1f, 0x27, 0x28, 0x0a, 0x23, 0x0a, 0x04, 0x04, 0x01,
+    0x02, 0x05, 0x12, 0x03, 0x20, 0x02, 0x29, 0x22, 0x16, 0x20, 0xe8, 0xa2, 0xab, 0xe5, 0x9b, 0x9e,
+    0xe5, 0xa4, 0x8d, 0xe7, 0x9a, 0x84, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64, 0x0a, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x05, 0x05, 0x12, 0x03, 0x20, 0x02, 0x07, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x01, 0x02, 0x05, 0x01, 0x12, 0x03, 0x20, 0x1b, 0x24, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x01, 0x02, 0x05, 0x03, 0x12, 0x03, 0x20, 0x27, 0x28, 0x0a, 0x21, 0x0a, 0x04, 0x04, 0x01, 0x02,
+    0x06, 0x12, 0x03, 0x21, 0x02, 0x29, 0x22, 0x14, 0x20, 0xe5, 0x9b, 0xbe, 0xe7, 0x89, 0x87, 0xe8,
+    0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0x0a, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x01, 0x02, 0x06, 0x04, 0x12, 0x03, 0x21, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01,
+    0x02, 0x06, 0x06, 0x12, 0x03, 0x21, 0x0b, 0x1a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x06,
+    0x01, 0x12, 0x03, 0x21, 0x1b, 0x21, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x06, 0x03, 0x12,
+    0x03, 0x21, 0x27, 0x28, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x07, 0x12, 0x03, 0x22, 0x02,
+    0x29, 0x22, 0x0f, 0x20, 0x40, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe5, 0x88, 0x97, 0xe8, 0xa1,
+    0xa8, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x07, 0x04, 0x12, 0x03, 0x22, 0x02, 0x0a,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x07, 0x06, 0x12, 0x03, 0x22, 0x0b, 0x18, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x01, 0x02, 0x07, 0x01, 0x12, 0x03, 0x22, 0x1b, 0x23, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x01, 0x02, 0x07, 0x03, 0x12, 0x03, 0x22, 0x27, 0x28, 0x0a, 0x1a, 0x0a, 0x02, 0x04, 0x02,
+    0x12, 0x04, 0x26, 0x00, 0x2b, 0x01, 0x1a, 0x0e, 0x20, 0xe5, 0x9b, 0xbe, 0xe7, 0x89, 0x87, 0xe8,
+    0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x02, 0x01, 0x12, 0x03, 0x26,
+    0x08, 0x17, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x00, 0x12, 0x03, 0x27, 0x02, 0x17, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x05, 0x12, 0x03, 0x27, 0x02, 0x08, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x02, 0x02, 0x00, 0x01, 0x12, 0x03, 0x27, 0x09, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x02, 0x02, 0x00, 0x03, 0x12, 0x03, 0x27, 0x15, 0x16, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x02, 0x02,
+    0x01, 0x12, 0x03, 0x28, 0x02, 0x17, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01, 0x05, 0x12,
+    0x03, 0x28, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01, 0x01, 0x12, 0x03, 0x28,
+    0x09, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01, 0x03, 0x12, 0x03, 0x28, 0x15, 0x16,
+    0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x02, 0x12, 0x03, 0x29, 0x02, 0x17, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x02, 0x02, 0x02, 0x05, 0x12, 0x03, 0x29, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x02, 0x02, 0x02, 0x01, 0x12, 0x03, 0x29, 0x09, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02,
+    0x02, 0x03, 0x12, 0x03, 0x29, 0x15, 0x16, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x03, 0x12,
+    0x03, 0x2a, 0x02, 0x17, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x03, 0x05, 0x12, 0x03, 0x2a,
+    0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x03, 0x01, 0x12, 0x03, 0x2a, 0x09, 0x0f,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x03, 0x03, 0x12, 0x03, 0x2a, 0x15, 0x16, 0x0a, 0x0a,
+    0x0a, 0x02, 0x04, 0x03, 0x12, 0x04, 0x2d, 0x00, 0x30, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x03,
+    0x01, 0x12, 0x03, 0x2d, 0x08, 0x1a, 0x0a, 0x17, 0x0a, 0x04, 0x04, 0x03, 0x02, 0x00, 0x12, 0x03,
+    0x2e, 0x02, 0x17, 0x22, 0x0a, 0x20, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x69, 0x64, 0x0a, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x05, 0x12, 0x03, 0x2e, 0x02, 0x07, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x03, 0x02, 0x00, 0x01, 0x12, 0x03, 0x2e, 0x08, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x03, 0x02, 0x00, 0x03, 0x12, 0x03, 0x2e, 0x15, 0x16, 0x0a, 0x46, 0x0a, 0x04, 0x04, 0x03, 0x02,
+    0x01, 0x12, 0x03, 0x2f, 0x02, 0x17, 0x22, 0x39, 0x20, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe5,
+    0x91, 0xbd, 0xe4, 0xb8, 0xad, 0xe4, 0xbd, 0x9c, 0xe8, 0x80, 0x85, 0xe8, 0xae, 0xbe, 0xe7, 0xbd,
+    0xae, 0xe7, 0x9a, 0x84, 0xe5, 0xb1, 0x8f, 0xe8, 0x94, 0xbd, 0xe8, 0xaf, 0x8d, 0x20, 0xe7, 0xad,
+    0x89, 0xe5, 0xbe, 0x85, 0xe4, 0xbd, 0x9c, 0xe8, 0x80, 0x85, 0xe5, 0xae, 0xa1, 0xe6, 0xa0, 0xb8,
+    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01, 0x05, 0x12, 0x03, 0x2f, 0x02, 0x06, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01, 0x01, 0x12, 0x03, 0x2f, 0x08, 0x0c, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x03, 0x02, 0x01, 0x03, 0x12, 0x03, 0x2f, 0x15, 0x16, 0x0a, 0x1a, 0x0a, 0x02, 0x04,
+    0x04, 0x12, 0x04, 0x33, 0x00, 0x36, 0x01, 0x1a, 0x0e, 0x20, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4,
+    0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x04, 0x01, 0x12, 0x03,
+    0x33, 0x08, 0x19, 0x0a, 0x17, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x00, 0x12, 0x03, 0x34, 0x02, 0x3b,
+    0x22, 0x0a, 0x20, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x04, 0x02, 0x00, 0x05, 0x12, 0x03, 0x34, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04,
+    0x02, 0x00, 0x01, 0x12, 0x03, 0x34, 0x08, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00,
+    0x03, 0x12, 0x03, 0x34, 0x15, 0x16, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x08, 0x12,
+    0x03, 0x34, 0x17, 0x3a, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x04, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04,
+    0x04, 0x12, 0x03, 0x34, 0x18, 0x39, 0x0a, 0x1d, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x01, 0x12, 0x03,
+    0x35, 0x02, 0x3b, 0x22, 0x10, 0x20, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe5, 0xaf, 0xb9, 0xe8,
+    0xb1, 0xa1, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x01, 0x05, 0x12, 0x03,
+    0x35, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x01, 0x01, 0x12, 0x03, 0x35, 0x08,
+    0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x01, 0x03, 0x12, 0x03, 0x35, 0x15, 0x16, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x01, 0x08, 0x12, 0x03, 0x35, 0x17, 0x3a, 0x0a, 0x10, 0x0a,
+    0x09, 0x04, 0x04, 0x02, 0x01, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x03, 0x35, 0x18, 0x39, 0x0a,
+    0x09, 0x0a, 0x02, 0x04, 0x05, 0x12, 0x03, 0x38, 0x00, 0x1d, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x05,
+    0x01, 0x12, 0x03, 0x38, 0x08, 0x1a, 0x0a, 0x21, 0x0a, 0x02, 0x04, 0x06, 0x12, 0x04, 0x3b, 0x00,
+    0x3e, 0x01, 0x1a, 0x15, 0x20, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0x2f, 0xe5, 0x8f, 0x96, 0xe6,
+    0xb6, 0x88, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x06, 0x01,
+    0x12, 0x03, 0x3b, 0x08, 0x19, 0x0a, 0x17, 0x0a, 0x04, 0x04, 0x06, 0x02, 0x00, 0x12, 0x03, 0x3c,
+    0x02, 0x43, 0x22, 0x0a, 0x20, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x69, 0x64, 0x0a, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x05, 0x12, 0x03, 0x3c, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x06, 0x02, 0x00, 0x01, 0x12, 0x03, 0x3c, 0x10, 0x1a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06,
+    0x02, 0x00, 0x03, 0x12, 0x03, 0x3c, 0x1d, 0x1e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00,
+    0x08, 0x12, 0x03, 0x3c, 0x1f, 0x42, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x06, 0x02, 0x00, 0x08, 0x87,
+    0x09, 0x04, 0x04, 0x12, 0x03, 0x3c, 0x20, 0x41, 0x0a, 0x27, 0x0a, 0x04, 0x04, 0x06, 0x02, 0x01,
+    0x12, 0x03, 0x3d, 0x02, 0x1f, 0x22, 0x1a, 0x20, 0xe5, 0x8f, 0x96, 0xe6, 0xb6, 0x88, 0xe7, 0x82,
+    0xb9, 0xe8, 0xb5, 0x9e, 0xe8, 0xbf, 0x98, 0xe6, 0x98, 0xaf, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e,
+    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x01, 0x06, 0x12, 0x03, 0x3d, 0x02, 0x0f, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x01, 0x01, 0x12, 0x03, 0x3d, 0x10, 0x16, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x06, 0x02, 0x01, 0x03, 0x12, 0x03, 0x3d, 0x1d, 0x1e, 0x0a, 0x09, 0x0a, 0x02, 0x04,
+    0x07, 0x12, 0x03, 0x40, 0x00, 0x1d, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x07, 0x01, 0x12, 0x03, 0x40,
+    0x08, 0x1a, 0x0a, 0x21, 0x0a, 0x02, 0x04, 0x08, 0x12, 0x04, 0x43, 0x00, 0x46, 0x01, 0x1a, 0x15,
+    0x20, 0xe7, 0x82, 0xb9, 0xe8, 0xb8, 0xa9, 0x2f, 0xe5, 0x8f, 0x96, 0xe6, 0xb6, 0x88, 0xe7, 0x82,
+    0xb9, 0xe8, 0xb8, 0xa9, 0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x08, 0x01, 0x12, 0x03, 0x43, 0x08,
+    0x1c, 0x0a, 0x17, 0x0a, 0x04, 0x04, 0x08, 0x02, 0x00, 0x12, 0x03, 0x44, 0x02, 0x43, 0x22, 0x0a,
+    0x20, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08,
+    0x02, 0x00, 0x05, 0x12, 0x03, 0x44, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00,
+    0x01, 0x12, 0x03, 0x44, 0x10, 0x1a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x03, 0x12,
+    0x03, 0x44, 0x1d, 0x1e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x08, 0x12, 0x03, 0x44,
+    0x1f, 0x42, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x08, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12,
+    0x03, 0x44, 0x20, 0x41, 0x0a, 0x27, 0x0a, 0x04, 0x04, 0x08, 0x02, 0x01, 0x12, 0x03, 0x45, 0x02,
+    0x1f, 0x22, 0x1a, 0x20, 0xe5, 0x8f, 0x96, 0xe6, 0xb6, 0x88, 0xe7, 0x82, 0xb9, 0xe8, 0xb8, 0xa9,
+    0xe8, 0xbf, 0x98, 0xe6, 0x98, 0xaf, 0xe7, 0x82, 0xb9, 0xe8, 0xb8, 0xa9, 0x0a, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x08, 0x02, 0x01, 0x06, 0x12, 0x03, 0x45, 0x02, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x08, 0x02, 0x01, 0x01, 0x12, 0x03, 0x45, 0x10, 0x16, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02,
+    0x01, 0x03, 0x12, 0x03, 0x45, 0x1d, 0x1e, 0x0a, 0x09, 0x0a, 0x02, 0x04, 0x09, 0x12, 0x03, 0x48,
+    0x00, 0x20, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x09, 0x01, 0x12, 0x03, 0x48, 0x08, 0x1d, 0x0a, 0x1a,
+    0x0a, 0x02, 0x05, 0x02, 0x12, 0x04, 0x4b, 0x00, 0x52, 0x01, 0x1a, 0x0e, 0x20, 0xe4, 0xb8, 0xbe,
+    0xe6, 0x8a, 0xa5, 0xe5, 0x8e, 0x9f, 0xe5, 0x9b, 0xa0, 0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x05, 0x02,
+    0x01, 0x12, 0x03, 0x4b, 0x05, 0x11, 0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x02, 0x02, 0x00, 0x12, 0x03,
+    0x4c, 0x02, 0x20, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x02, 0x02, 0x00, 0x01, 0x12, 0x03, 0x4c, 0x02,
+    0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x02, 0x02, 0x00, 0x02, 0x12, 0x03, 0x4c, 0x1e, 0x1f, 0x0a,
+    0x1b, 0x0a, 0x04, 0x05, 0x02, 0x02, 0x01, 0x12, 0x03, 0x4d, 0x02, 0x20, 0x22, 0x0e, 0x20, 0xe5,
+    0x9e, 0x83, 0xe5, 0x9c, 0xbe, 0xe5, 0xb9, 0xbf, 0xe5, 0x91, 0x8a, 0x0a, 0x0a, 0x0c, 0x0a, 0x05,
+    0x05, 0x02, 0x02, 0x01, 0x01, 0x12, 0x03, 0x4d, 0x02, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x02,
+    0x02, 0x01, 0x02, 0x12, 0x03, 0x4d, 0x1e, 0x1f, 0x0a, 0x1b, 0x0a, 0x04, 0x05, 0x02, 0x02, 0x02,
+    0x12, 0x03, 0x4e, 0x02, 0x20, 0x22, 0x0e, 0x20, 0xe8, 0xbe, 0xb1, 0xe9, 0xaa, 0x82, 0xe6, 0x94,
+    0xbb, 0xe5, 0x87, 0xbb, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x02, 0x02, 0x02, 0x01, 0x12, 0x03,
+    0x4e, 0x02, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x02, 0x02, 0x02, 0x02, 0x12, 0x03, 0x4e, 0x1e,
+    0x1f, 0x0a, 0x1b, 0x0a, 0x04, 0x05, 0x02, 0x02, 0x03, 0x12, 0x03, 0x4f, 0x02, 0x20, 0x22, 0x0e,
+    0x20, 0xe8, 0x89, 0xb2, 0xe6, 0x83, 0x85, 0xe4, 0xbd, 0x8e, 0xe4, 0xbf, 0x97, 0x0a, 0x0a, 0x0c,
+    0x0a, 0x05, 0x05, 0x02, 0x02, 0x03, 0x01, 0x12, 0x03, 0x4f, 0x02, 0x14, 0x0a, 0x0c, 0x0a, 0x05,
+    0x05, 0x02, 0x02, 0x03, 0x02, 0x12, 0x03, 0x4f, 0x1e, 0x1f, 0x0a, 0x1b, 0x0a, 0x04, 0x05, 0x02,
+    0x02, 0x04, 0x12, 0x03, 0x50, 0x02, 0x20, 0x22, 0x0e, 0x20, 0xe8, 0xbf, 0x9d, 0xe6, 0xb3, 0x95,
+    0xe8, 0xbf, 0x9d, 0xe8, 0xa7, 0x84, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x02, 0x02, 0x04, 0x01,
+    0x12, 0x03, 0x50, 0x02, 0x17, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x02, 0x02, 0x04, 0x02, 0x12, 0x03,
+    0x50, 0x1e, 0x1f, 0x0a, 0x15, 0x0a, 0x04, 0x05, 0x02, 0x02, 0x05, 0x12, 0x03, 0x51, 0x02, 0x20,
+    0x22, 0x08, 0x20, 0xe5, 0x85, 0xb6, 0xe5, 0xae, 0x83, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x02,
+    0x02, 0x05, 0x01, 0x12, 0x03, 0x51, 0x02, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x02, 0x02, 0x05,
+    0x02, 0x12, 0x03, 0x51, 0x1e, 0x1f, 0x0a, 0x20, 0x0a, 0x02, 0x05, 0x03, 0x12, 0x04, 0x55, 0x00,
+    0x5a, 0x01, 0x1a, 0x14, 0x20, 0xe4, 0xb8, 0xbe, 0xe6, 0x8a, 0xa5, 0xe5, 0xa4, 0x84, 0xe7, 0x90,
+    0x86, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x05, 0x03, 0x01, 0x12,
+    0x03, 0x55, 0x05, 0x15, 0x0a, 0x18, 0x0a, 0x04, 0x05, 0x03, 0x02, 0x00, 0x12, 0x03, 0x56, 0x02,
+    0x23, 0x22, 0x0b, 0x20, 0xe5, 0xbe, 0x85, 0xe5, 0xa4, 0x84, 0xe7, 0x90, 0x86, 0x0a, 0x0a, 0x0c,
+    0x0a, 0x05, 0x05, 0x03, 0x02, 0x00, 0x01, 0x12, 0x03, 0x56, 0x02, 0x1b, 0x0a, 0x0c, 0x0a, 0x05,
+    0x05, 0x03, 0x02, 0x00, 0x02, 0x12, 0x03, 0x56, 0x21, 0x22, 0x0a, 0x1b, 0x0a, 0x04, 0x05, 0x03,
+    0x02, 0x01, 0x12, 0x03, 0x57, 0x02, 0x23, 0x22, 0x0e, 0x20, 0xe4, 0xbf, 0x9d, 0xe7, 0x95, 0x99,
+    0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x03, 0x02, 0x01, 0x01,
+    0x12, 0x03, 0x57, 0x02, 0x18, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x03, 0x02, 0x01, 0x02, 0x12, 0x03,
+    0x57, 0x21, 0x22, 0x0a, 0x1b, 0x0a, 0x04, 0x05, 0x03, 0x02, 0x02, 0x12, 0x03, 0x58, 0x02, 0x23,
+    0x22, 0x0e, 0x20, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a,
+    0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x03, 0x02, 0x02, 0x01, 0x12, 0x03, 0x58, 0x02, 0x1a, 0x0a, 0x0c,
+    0x0a, 0x05, 0x05, 0x03, 0x02, 0x02, 0x02, 0x12, 0x03, 0x58, 0x21, 0x22, 0x0a, 0x30, 0x0a, 0x04,
+    0x05, 0x03, 0x02, 0x03, 0x12, 0x03, 0x59, 0x02, 0x23, 0x22, 0x23, 0x20, 0xe5, 0x88, 0xa0, 0xe9,
+    0x99, 0xa4, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe5, 0xb9, 0xb6, 0xe7, 0xa6, 0x81, 0xe6, 0xad,
+    0xa2, 0xe4, 0xbd, 0x9c, 0xe8, 0x80, 0x85, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0c,
+    0x0a, 0x05, 0x05, 0x03, 0x02, 0x03, 0x01, 0x12, 0x03, 0x59, 0x02, 0x1e, 0x0a, 0x0c, 0x0a, 0x05,
+    0x05, 0x03, 0x02, 0x03, 0x02, 0x12, 0x03, 0x59, 0x21, 0x22, 0x0a, 0x14, 0x0a, 0x02, 0x04, 0x0a,
+    0x12, 0x04, 0x5d, 0x00, 0x61, 0x01, 0x1a, 0x08, 0x20, 0xe4, 0xb8, 0xbe, 0xe6, 0x8a, 0xa5, 0x0a,
+    0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0a, 0x01, 0x12, 0x03, 0x5d, 0x08, 0x1c, 0x0a, 0x23, 0x0a, 0x04,
+    0x04, 0x0a, 0x02, 0x00, 0x12, 0x03, 0x5e, 0x02, 0x42, 0x22, 0x16, 0x20, 0xe8, 0xa2, 0xab, 0xe4,
+    0xb8, 0xbe, 0xe6, 0x8a, 0xa5, 0xe7, 0x9a, 0x84, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x69, 0x64,
+    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x00, 0x05, 0x12, 0x03, 0x5e, 0x02, 0x07, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x00, 0x01, 0x12, 0x03, 0x5e, 0x0f, 0x19, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x0a, 0x02, 0x00, 0x03, 0x12, 0x03, 0x5e, 0x1c, 0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x0a, 0x02, 0x00, 0x08, 0x12, 0x03, 0x5e, 0x1e, 0x41, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x0a, 0x02,
+    0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x03, 0x5e, 0x1f, 0x40, 0x0a, 0x0b, 0x0a, 0x04, 0x04,
+    0x0a, 0x02, 0x01, 0x12, 0x03, 0x5f, 0x02, 0x4e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x01,
+    0x06, 0x12, 0x03, 0x5f, 0x02, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x01, 0x01, 0x12,
+    0x03, 0x5f, 0x0f, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x01, 0x03, 0x12, 0x03, 0x5f,
+    0x1c, 0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x01, 0x08, 0x12, 0x03, 0x5f, 0x1e, 0x4d,
+    0x0a, 0x10, 0x0a, 0x09, 0x04, 0x0a, 0x02, 0x01, 0x08, 0x87, 0x09, 0x10, 0x02, 0x12, 0x03, 0x5f,
+    0x1f, 0x4c, 0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x0a, 0x02, 0x02, 0x12, 0x03, 0x60, 0x02, 0x4a, 0x22,
+    0x0e, 0x20, 0xe8, 0xa1, 0xa5, 0xe5, 0x85, 0x85, 0xe8, 0xaf, 0xb4, 0xe6, 0x98, 0x8e, 0x0a, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x02, 0x05, 0x12, 0x03, 0x60, 0x02, 0x08, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x0a, 0x02, 0x02, 0x01, 0x12, 0x03, 0x60, 0x0f, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x0a, 0x02, 0x02, 0x03, 0x12, 0x03, 0x60, 0x1c, 0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02,
+    0x02, 0x08, 0x12, 0x03, 0x60, 0x1e, 0x49, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x0a, 0x02, 0x02, 0x08,
+    0x87, 0x09, 0x0e, 0x03, 0x12, 0x03, 0x60, 0x1f, 0x48, 0x0a, 0x09, 0x0a, 0x02, 0x04, 0x0b, 0x12,
+    0x03, 0x63, 0x00, 0x20, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0b, 0x01, 0x12, 0x03, 0x63, 0x08, 0x1d,
+    0x0a, 0x14, 0x0a, 0x02, 0x04, 0x0c, 0x12, 0x04, 0x66, 0x00, 0x6a, 0x01, 0x1a, 0x08, 0x20, 0xe7,
+    0xbd, 0xae, 0xe9, 0xa1, 0xb6, 0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0c, 0x01, 0x12, 0x03, 0x66,
+    0x08, 0x19, 0x0a, 0x20, 0x0a, 0x04, 0x04, 0x0c, 0x02, 0x00, 0x12, 0x03, 0x67, 0x02, 0x43, 0x22,
+    0x13, 0x20, 0xe8, 0xa2, 0xab, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe5, 0xaf, 0xb9, 0xe8, 0xb1,
+    0xa1, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x00, 0x05, 0x12, 0x03, 0x67,
+    0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x00, 0x01, 0x12, 0x03, 0x67, 0x10, 0x13,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x00, 0x03, 0x12, 0x03, 0x67, 0x1d, 0x1e, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x0c, 0x02, 0x00, 0x08, 0x12, 0x03, 0x67, 0x1f, 0x42, 0x0a, 0x10, 0x0a, 0x09,
+    0x04, 0x0c, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x03, 0x67, 0x20, 0x41, 0x0a, 0x17,
+    0x0a, 0x04, 0x04, 0x0c, 0x02, 0x01, 0x12, 0x03, 0x68, 0x02, 0x43, 0x22, 0x0a, 0x20, 0xe8, 0xaf,
+    0x84, 0xe8, 0xae, 0xba, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x01, 0x05,
+    0x12, 0x03, 0x68, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x01, 0x01, 0x12, 0x03,
+    0x68, 0x10, 0x1a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x01, 0x03, 0x12, 0x03, 0x68, 0x1d,
+    0x1e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x01, 0x08, 0x12, 0x03, 0x68, 0x1f, 0x42, 0x0a,
+    0x10, 0x0a, 0x09, 0x04, 0x0c, 0x02, 0x01, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x03, 0x68, 0x20,
+    0x41, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0c, 0x02, 0x02, 0x12, 0x03, 0x69, 0x02, 0x1f, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x0c, 0x02, 0x02, 0x06, 0x12, 0x03, 0x69, 0x02, 0x0f, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x0c, 0x02, 0x02, 0x01, 0x12, 0x03, 0x69, 0x10, 0x16, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c,
+    0x02, 0x02, 0x03, 0x12, 0x03, 0x69, 0x1d, 0x1e, 0x0a, 0x09, 0x0a, 0x02, 0x04, 0x0d, 0x12, 0x03,
+    0x6c, 0x00, 0x1d, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0d, 0x01, 0x12, 0x03, 0x6c, 0x08, 0x1a, 0x0a,
+    0x0a, 0x0a, 0x02, 0x05, 0x04, 0x12, 0x04, 0x6e, 0x00, 0x71, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x05,
+    0x04, 0x01, 0x12, 0x03, 0x6e, 0x05, 0x0d, 0x0a, 0x27, 0x0a, 0x04, 0x05, 0x04, 0x02, 0x00, 0x12,
+    0x03, 0x6f, 0x02, 0x0e, 0x22, 0x1a, 0x20, 0xe6, 0x8c, 0x89, 0xe7, 0x85, 0xa7, 0xe5, 0x8f, 0x91,
+    0xe5, 0xb8, 0x83, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe6, 0x8e, 0x92, 0xe5, 0xba, 0x8f, 0x0a,
+    0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x04, 0x02, 0x00, 0x01, 0x12, 0x03, 0x6f, 0x02, 0x08, 0x0a, 0x0c,
+    0x0a, 0x05, 0x05, 0x04, 0x02, 0x00, 0x02, 0x12, 0x03, 0x6f, 0x0c, 0x0d, 0x0a, 0x21, 0x0a, 0x04,
+    0x05, 0x04, 0x02, 0x01, 0x12, 0x03, 0x70, 0x02, 0x0e, 0x22, 0x14, 0x20, 0xe6, 0x8c, 0x89, 0xe7,
+    0x85, 0xa7, 0xe7, 0x83, 0xad, 0xe5, 0xba, 0xa6, 0xe6, 0x8e, 0x92, 0xe5, 0xba, 0x8f, 0x0a, 0x0a,
+    0x0c, 0x0a, 0x05, 0x05, 0x04, 0x02, 0x01, 0x01, 0x12, 0x03, 0x70, 0x02, 0x09, 0x0a, 0x0c, 0x0a,
+    0x05, 0x05, 0x04, 0x02, 0x01, 0x02, 0x12, 0x03, 0x70, 0x0c, 0x0d, 0x0a, 0x1a, 0x0a, 0x02, 0x04,
+    0x0e, 0x12, 0x04, 0x74, 0x00, 0x79, 0x01, 0x1a, 0x0e, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96,
+    0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0e, 0x01, 0x12, 0x03,
+    0x74, 0x08, 0x1d, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0e, 0x02, 0x00, 0x12, 0x03, 0x75, 0x02, 0x40,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x00, 0x05, 0x12, 0x03, 0x75, 0x02, 0x07, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x0e, 0x02, 0x00, 0x01, 0x12, 0x03, 0x75, 0x0b, 0x0e, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x0e, 0x02, 0x00, 0x03, 0x12, 0x03, 0x75, 0x1a, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e,
+    0x02, 0x00, 0x08, 0x12, 0x03, 0x75, 0x1c, 0x3f, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x0e, 0x02, 0x00,
+    0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x03, 0x75, 0x1d, 0x3e, 0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x0e,
+    0x02, 0x01, 0x12, 0x03, 0x76, 0x02, 0x1c, 0x22, 0x0e, 0x20, 0xe8, 0xb5, 0xb7, 0xe5, 0xa7, 0x8b,
+    0xe6, 0xb8, 0xb8, 0xe6, 0xa0, 0x87, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x01, 0x05,
+    0x12, 0x03, 0x76, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x01, 0x01, 0x12, 0x03,
+    0x76, 0x0b, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x01, 0x03, 0x12, 0x03, 0x76, 0x1a,
+    0x1b, 0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x0e, 0x02, 0x02, 0x12, 0x03, 0x77, 0x02, 0x1c, 0x22, 0x0e,
+    0x20, 0xe6, 0x8e, 0x92, 0xe5, 0xba, 0x8f, 0xe6, 0x96, 0xb9, 0xe5, 0xbc, 0x8f, 0x0a, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x0e, 0x02, 0x02, 0x06, 0x12, 0x03, 0x77, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x0e, 0x02, 0x02, 0x01, 0x12, 0x03, 0x77, 0x0b, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e,
+    0x02, 0x02, 0x03, 0x12, 0x03, 0x77, 0x1a, 0x1b, 0x0a, 0x30, 0x0a, 0x04, 0x04, 0x0e, 0x02, 0x03,
+    0x12, 0x03, 0x78, 0x02, 0x1c, 0x22, 0x23, 0x20, 0xe6, 0x8c, 0x89, 0xe7, 0x83, 0xad, 0xe5, 0xba,
+    0xa6, 0xe6, 0x8e, 0x92, 0xe5, 0xba, 0x8f, 0xe6, 0x97, 0xb6, 0xe7, 0x9a, 0x84, 0xe8, 0xb5, 0xb7,
+    0xe5, 0xa7, 0x8b, 0xe6, 0xb8, 0xb8, 0xe6, 0xa0, 0x87, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e,
+    0x02, 0x03, 0x05, 0x12, 0x03, 0x78, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x03,
+    0x01, 0x12, 0x03, 0x78, 0x0b, 0x17, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x03, 0x03, 0x12,
+    0x03, 0x78, 0x1a, 0x1b, 0x0a, 0x1b, 0x0a, 0x02, 0x04, 0x0f, 0x12, 0x05, 0x7c, 0x00, 0x91, 0x01,
+    0x01, 0x1a, 0x0e, 0x20, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93,
+    0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0f, 0x01, 0x12, 0x03, 0x7c, 0x08, 0x13, 0x0a, 0x17, 0x0a,
+    0x04, 0x04, 0x0f, 0x02, 0x00, 0x12, 0x03, 0x7d, 0x02, 0x2b, 0x22, 0x0a, 0x20, 0xe8, 0xaf, 0x84,
+    0xe8, 0xae, 0xba, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x00, 0x05, 0x12,
+    0x03, 0x7d, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x00, 0x01, 0x12, 0x03, 0x7d,
+    0x1c, 0x1e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x00, 0x03, 0x12, 0x03, 0x7d, 0x29, 0x2a,
+    0x0a, 0x20, 0x0a, 0x04, 0x04, 0x0f, 0x02, 0x01, 0x12, 0x03, 0x7e, 0x02, 0x2b, 0x22, 0x13, 0x20,
     0xe8, 0xa2, 0xab, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe5, 0xaf, 0xb9, 0xe8, 0xb1, 0xa1, 0x69,
-    0x64, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x29, 0x02, 0x00, 0x05, 0x12, 0x04, 0x81, 0x02, 0x02,
-    0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x29, 0x02, 0x00, 0x01, 0x12, 0x04, 0x81, 0x02, 0x08, 0x0b,
-    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x29, 0x02, 0x00, 0x03, 0x12, 0x04, 0x81, 0x02, 0x0e, 0x0f, 0x0a,
-    0x0d, 0x0a, 0x05, 0x04, 0x29, 0x02, 0x00, 0x08, 0x12, 0x04, 0x81, 0x02, 0x10, 0x33, 0x0a, 0x11,
-    0x0a, 0x09, 0x04, 0x29, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x04, 0x81, 0x02, 0x11,
-    0x32, 0x0a, 0x18, 0x0a, 0x04, 0x04, 0x29, 0x02, 0x01, 0x12, 0x04, 0x82, 0x02, 0x02, 0x10, 0x22,
-    0x0a, 0x20, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
-    0x29, 0x02, 0x01, 0x05, 0x12, 0x04, 0x82, 0x02, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x29,
-    0x02, 0x01, 0x01, 0x12, 0x04, 0x82, 0x02, 0x08, 0x0b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x29, 0x02,
-    0x01, 0x03, 0x12, 0x04, 0x82, 0x02, 0x0e, 0x0f, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x2a, 0x12, 0x06,
-    0x85, 0x02, 0x00, 0x87, 0x02, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x2a, 0x01, 0x12, 0x04, 0x85,
-    0x02, 0x08, 0x21, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x2a, 0x02, 0x00, 0x12, 0x04, 0x86, 0x02, 0x02,
-    0x1a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2a, 0x02, 0x00, 0x06, 0x12, 0x04, 0x86, 0x02, 0x02, 0x0e,
-    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2a, 0x02, 0x00, 0x01, 0x12, 0x04, 0x86, 0x02, 0x0f, 0x15, 0x0a,
-    0x0d, 0x0a, 0x05, 0x04, 0x2a, 0x02, 0x00, 0x03, 0x12, 0x04, 0x86, 0x02, 0x18, 0x19, 0x0a, 0x0c,
-    0x0a, 0x02, 0x04, 0x2b, 0x12, 0x06, 0x89, 0x02, 0x00, 0x8f, 0x02, 0x01, 0x0a, 0x0b, 0x0a, 0x03,
-    0x04, 0x2b, 0x01, 0x12, 0x04, 0x89, 0x02, 0x08, 0x25, 0x0a, 0x0e, 0x0a, 0x04, 0x04, 0x2b, 0x03,
-    0x00, 0x12, 0x06, 0x8a, 0x02, 0x02, 0x8d, 0x02, 0x03, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2b, 0x03,
-    0x00, 0x01, 0x12, 0x04, 0x8a, 0x02, 0x0a, 0x11, 0x0a, 0x10, 0x0a, 0x06, 0x04, 0x2b, 0x03, 0x00,
-    0x02, 0x00, 0x12, 0x06, 0x8b, 0x02, 0x04, 0x8c, 0x02, 0x66, 0x0a, 0x0f, 0x0a, 0x07, 0x04, 0x2b,
-    0x03, 0x00, 0x02, 0x00, 0x04, 0x12, 0x04, 0x8b, 0x02, 0x04, 0x0c, 0x0a, 0x0f, 0x0a, 0x07, 0x04,
-    0x2b, 0x03, 0x00, 0x02, 0x00, 0x05, 0x12, 0x04, 0x8b, 0x02, 0x0d, 0x12, 0x0a, 0x0f, 0x0a, 0x07,
-    0x04, 0x2b, 0x03, 0x00, 0x02, 0x00, 0x01, 0x12, 0x04, 0x8b, 0x02, 0x13, 0x17, 0x0a, 0x0f, 0x0a,
-    0x07, 0x04, 0x2b, 0x03, 0x00, 0x02, 0x00, 0x03, 0x12, 0x04, 0x8b, 0x02, 0x1a, 0x1b, 0x0a, 0x0f,
-    0x0a, 0x07, 0x04, 0x2b, 0x03, 0x00, 0x02, 0x00, 0x08, 0x12, 0x04, 0x8c, 0x02, 0x08, 0x65, 0x0a,
-    0x13, 0x0a, 0x0b, 0x04, 0x2b, 0x03, 0x00, 0x02, 0x00, 0x08, 0x87, 0x09, 0x12, 0x01, 0x12, 0x04,
-    0x8c, 0x02, 0x09, 0x35, 0x0a, 0x13, 0x0a, 0x0b, 0x04, 0x2b, 0x03, 0x00, 0x02, 0x00, 0x08, 0x87,
-    0x09, 0x12, 0x02, 0x12, 0x04, 0x8c, 0x02, 0x37, 0x64, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x2b, 0x02,
-    0x00, 0x12, 0x04, 0x8e, 0x02, 0x02, 0x75, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2b, 0x02, 0x00, 0x06,
-    0x12, 0x04, 0x8e, 0x02, 0x02, 0x15, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2b, 0x02, 0x00, 0x01, 0x12,
-    0x04, 0x8e, 0x02, 0x16, 0x1e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2b, 0x02, 0x00, 0x03, 0x12, 0x04,
-    0x8e, 0x02, 0x21, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2b, 0x02, 0x00, 0x08, 0x12, 0x04, 0x8e,
-    0x02, 0x23, 0x74, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x2b, 0x02, 0x00, 0x08, 0x87, 0x09, 0x13, 0x01,
-    0x12, 0x04, 0x8e, 0x02, 0x24, 0x4a, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x2b, 0x02, 0x00, 0x08, 0x87,
-    0x09, 0x13, 0x02, 0x12, 0x04, 0x8e, 0x02, 0x4c, 0x73, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x2c, 0x12,
-    0x06, 0x91, 0x02, 0x00, 0x94, 0x02, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x2c, 0x01, 0x12, 0x04,
-    0x91, 0x02, 0x08, 0x14, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x2c, 0x02, 0x00, 0x12, 0x04, 0x92, 0x02,
-    0x02, 0x16, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2c, 0x02, 0x00, 0x05, 0x12, 0x04, 0x92, 0x02, 0x02,
-    0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2c, 0x02, 0x00, 0x01, 0x12, 0x04, 0x92, 0x02, 0x08, 0x0b,
-    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2c, 0x02, 0x00, 0x03, 0x12, 0x04, 0x92, 0x02, 0x14, 0x15, 0x0a,
-    0x0c, 0x0a, 0x04, 0x04, 0x2c, 0x02, 0x01, 0x12, 0x04, 0x93, 0x02, 0x02, 0x16, 0x0a, 0x0d, 0x0a,
-    0x05, 0x04, 0x2c, 0x02, 0x01, 0x05, 0x12, 0x04, 0x93, 0x02, 0x02, 0x06, 0x0a, 0x0d, 0x0a, 0x05,
-    0x04, 0x2c, 0x02, 0x01, 0x01, 0x12, 0x04, 0x93, 0x02, 0x08, 0x11, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
-    0x2c, 0x02, 0x01, 0x03, 0x12, 0x04, 0x93, 0x02, 0x14, 0x15, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x2d,
-    0x12, 0x06, 0x96, 0x02, 0x00, 0x98, 0x02, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x2d, 0x01, 0x12,
-    0x04, 0x96, 0x02, 0x08, 0x18, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x2d, 0x02, 0x00, 0x12, 0x04, 0x97,
-    0x02, 0x02, 0x21, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2d, 0x02, 0x00, 0x04, 0x12, 0x04, 0x97, 0x02,
-    0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2d, 0x02, 0x00, 0x06, 0x12, 0x04, 0x97, 0x02, 0x0b,
-    0x17, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2d, 0x02, 0x00, 0x01, 0x12, 0x04, 0x97, 0x02, 0x18, 0x1c,
-    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2d, 0x02, 0x00, 0x03, 0x12, 0x04, 0x97, 0x02, 0x1f, 0x20, 0x0a,
-    0x0c, 0x0a, 0x02, 0x04, 0x2e, 0x12, 0x06, 0x9a, 0x02, 0x00, 0x9c, 0x02, 0x01, 0x0a, 0x0b, 0x0a,
-    0x03, 0x04, 0x2e, 0x01, 0x12, 0x04, 0x9a, 0x02, 0x08, 0x26, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x2e,
-    0x02, 0x00, 0x12, 0x04, 0x9b, 0x02, 0x02, 0x2b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2e, 0x02, 0x00,
-    0x06, 0x12, 0x04, 0x9b, 0x02, 0x02, 0x1e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2e, 0x02, 0x00, 0x01,
-    0x12, 0x04, 0x9b, 0x02, 0x1f, 0x26, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2e, 0x02, 0x00, 0x03, 0x12,
-    0x04, 0x9b, 0x02, 0x29, 0x2a, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x2f, 0x12, 0x06, 0x9e, 0x02, 0x00,
-    0xa3, 0x02, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x2f, 0x01, 0x12, 0x04, 0x9e, 0x02, 0x08, 0x28,
-    0x0a, 0x3a, 0x0a, 0x04, 0x04, 0x2f, 0x03, 0x00, 0x12, 0x04, 0xa0, 0x02, 0x02, 0x33, 0x1a, 0x2c,
-    0x20, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x3d, 0x3e, 0x20, 0x7b, 0x75, 0x69,
-    0x64, 0x3a, 0x20, 0x5b, 0x72, 0x69, 0x64, 0x31, 0x2c, 0x20, 0x72, 0x69, 0x64, 0x32, 0x2c, 0x20,
-    0x2e, 0x2e, 0x2e, 0x2c, 0x20, 0x72, 0x69, 0x64, 0x4e, 0x5d, 0x7d, 0x0a, 0x0a, 0x0d, 0x0a, 0x05,
-    0x04, 0x2f, 0x03, 0x00, 0x01, 0x12, 0x04, 0xa0, 0x02, 0x0a, 0x17, 0x0a, 0x0e, 0x0a, 0x06, 0x04,
-    0x2f, 0x03, 0x00, 0x02, 0x00, 0x12, 0x04, 0xa0, 0x02, 0x1a, 0x31, 0x0a, 0x0f, 0x0a, 0x07, 0x04,
-    0x2f, 0x03, 0x00, 0x02, 0x00, 0x04, 0x12, 0x04, 0xa0, 0x02, 0x1a, 0x22, 0x0a, 0x0f, 0x0a, 0x07,
-    0x04, 0x2f, 0x03, 0x00, 0x02, 0x00, 0x05, 0x12, 0x04, 0xa0, 0x02, 0x23, 0x28, 0x0a, 0x0f, 0x0a,
-    0x07, 0x04, 0x2f, 0x03, 0x00, 0x02, 0x00, 0x01, 0x12, 0x04, 0xa0, 0x02, 0x29, 0x2c, 0x0a, 0x0f,
-    0x0a, 0x07, 0x04, 0x2f, 0x03, 0x00, 0x02, 0x00, 0x03, 0x12, 0x04, 0xa0, 0x02, 0x2f, 0x30, 0x0a,
-    0x0c, 0x0a, 0x04, 0x04, 0x2f, 0x02, 0x00, 0x12, 0x04, 0xa2, 0x02, 0x02, 0x29, 0x0a, 0x0d, 0x0a,
-    0x05, 0x04, 0x2f, 0x02, 0x00, 0x06, 0x12, 0x04, 0xa2, 0x02, 0x02, 0x1b, 0x0a, 0x0d, 0x0a, 0x05,
-    0x04, 0x2f, 0x02, 0x00, 0x01, 0x12, 0x04, 0xa2, 0x02, 0x1c, 0x24, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
-    0x2f, 0x02, 0x00, 0x03, 0x12, 0x04, 0xa2, 0x02, 0x27, 0x28, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x30,
-    0x12, 0x06, 0xa5, 0x02, 0x00, 0xa8, 0x02, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x30, 0x01, 0x12,
-    0x04, 0xa5, 0x02, 0x08, 0x14, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x30, 0x02, 0x00, 0x12, 0x04, 0xa6,
-    0x02, 0x02, 0x17, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x30, 0x02, 0x00, 0x05, 0x12, 0x04, 0xa6, 0x02,
-    0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x30, 0x02, 0x00, 0x01, 0x12, 0x04, 0xa6, 0x02, 0x08,
-    0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x30, 0x02, 0x00, 0x03, 0x12, 0x04, 0xa6, 0x02, 0x15, 0x16,
-    0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x30, 0x02, 0x01, 0x12, 0x04, 0xa7, 0x02, 0x02, 0x17, 0x0a, 0x0d,
-    0x0a, 0x05, 0x04, 0x30, 0x02, 0x01, 0x05, 0x12, 0x04, 0xa7, 0x02, 0x02, 0x06, 0x0a, 0x0d, 0x0a,
-    0x05, 0x04, 0x30, 0x02, 0x01, 0x01, 0x12, 0x04, 0xa7, 0x02, 0x08, 0x0d, 0x0a, 0x0d, 0x0a, 0x05,
-    0x04, 0x30, 0x02, 0x01, 0x03, 0x12, 0x04, 0xa7, 0x02, 0x15, 0x16, 0x0a, 0x0c, 0x0a, 0x02, 0x04,
-    0x31, 0x12, 0x06, 0xaa, 0x02, 0x00, 0xaf, 0x02, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x31, 0x01,
-    0x12, 0x04, 0xaa, 0x02, 0x08, 0x29, 0x0a, 0x67, 0x0a, 0x04, 0x04, 0x31, 0x03, 0x00, 0x12, 0x04,
-    0xad, 0x02, 0x02, 0x53, 0x1a, 0x59, 0x20, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x20, 0x3d,
-    0x3e, 0x20, 0x7b, 0x75, 0x69, 0x64, 0x3a, 0x20, 0x5b, 0x7b, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-    0x74, 0x5f, 0x69, 0x64, 0x2c, 0x20, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x7d, 0x2c, 0x20, 0x7b, 0x63,
+    0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x01, 0x05, 0x12, 0x03, 0x7e, 0x02, 0x07,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x01, 0x01, 0x12, 0x03, 0x7e, 0x1c, 0x1f, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x0f, 0x02, 0x01, 0x03, 0x12, 0x03, 0x7e, 0x29, 0x2a, 0x0a, 0x1b, 0x0a, 0x04,
+    0x04, 0x0f, 0x02, 0x02, 0x12, 0x03, 0x7f, 0x02, 0x2b, 0x22, 0x0e, 0x20, 0xe8, 0xaf, 0x84, 0xe8,
+    0xae, 0xba, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02,
+    0x02, 0x06, 0x12, 0x03, 0x7f, 0x02, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x02, 0x01,
+    0x12, 0x03, 0x7f, 0x1c, 0x20, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x02, 0x03, 0x12, 0x03,
+    0x7f, 0x29, 0x2a, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x0f, 0x02, 0x03, 0x12, 0x04, 0x80, 0x01, 0x02,
+    0x2b, 0x22, 0x0e, 0x20, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe5, 0x86, 0x85, 0xe5, 0xae, 0xb9,
+    0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x03, 0x05, 0x12, 0x04, 0x80, 0x01, 0x02, 0x08,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x03, 0x01, 0x12, 0x04, 0x80, 0x01, 0x1c, 0x23, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x03, 0x03, 0x12, 0x04, 0x80, 0x01, 0x29, 0x2a, 0x0a, 0x25,
+    0x0a, 0x04, 0x04, 0x0f, 0x02, 0x04, 0x12, 0x04, 0x81, 0x01, 0x02, 0x2b, 0x22, 0x17, 0x20, 0xe8,
+    0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe5, 0x8f, 0x91, 0xe8, 0xa1, 0xa8, 0xe7, 0x94, 0xa8, 0xe6, 0x88,
+    0xb7, 0x75, 0x69, 0x64, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x04, 0x05, 0x12, 0x04,
+    0x81, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x04, 0x01, 0x12, 0x04, 0x81,
+    0x01, 0x1c, 0x1f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x04, 0x03, 0x12, 0x04, 0x81, 0x01,
+    0x29, 0x2a, 0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x0f, 0x02, 0x05, 0x12, 0x04, 0x82, 0x01, 0x02, 0x2b,
+    0x22, 0x0d, 0x20, 0xe6, 0xa0, 0xb9, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x69, 0x64, 0x0a, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x05, 0x05, 0x12, 0x04, 0x82, 0x01, 0x02, 0x07, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x0f, 0x02, 0x05, 0x01, 0x12, 0x04, 0x82, 0x01, 0x1c, 0x23, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x0f, 0x02, 0x05, 0x03, 0x12, 0x04, 0x82, 0x01, 0x29, 0x2a, 0x0a, 0x1b, 0x0a, 0x04,
+    0x04, 0x0f, 0x02, 0x06, 0x12, 0x04, 0x83, 0x01, 0x02, 0x2b, 0x22, 0x0d, 0x20, 0xe7, 0x88, 0xb6,
+    0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x69, 0x64, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02,
+    0x06, 0x05, 0x12, 0x04, 0x83, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x06,
+    0x01, 0x12, 0x04, 0x83, 0x01, 0x1c, 0x25, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x06, 0x03,
+    0x12, 0x04, 0x83, 0x01, 0x29, 0x2a, 0x0a, 0x24, 0x0a, 0x04, 0x04, 0x0f, 0x02, 0x07, 0x12, 0x04,
+    0x84, 0x01, 0x02, 0x2b, 0x22, 0x16, 0x20, 0xe8, 0xa2, 0xab, 0xe5, 0x9b, 0x9e, 0xe5, 0xa4, 0x8d,
+    0xe7, 0x9a, 0x84, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64, 0x0a, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x0f, 0x02, 0x07, 0x05, 0x12, 0x04, 0x84, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x0f, 0x02, 0x07, 0x01, 0x12, 0x04, 0x84, 0x01, 0x1c, 0x20, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f,
+    0x02, 0x07, 0x03, 0x12, 0x04, 0x84, 0x01, 0x29, 0x2a, 0x0a, 0x19, 0x0a, 0x04, 0x04, 0x0f, 0x02,
+    0x08, 0x12, 0x04, 0x85, 0x01, 0x02, 0x2b, 0x22, 0x0b, 0x20, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e,
+    0xe6, 0x95, 0xb0, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x08, 0x05, 0x12, 0x04, 0x85,
+    0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x08, 0x01, 0x12, 0x04, 0x85, 0x01,
+    0x1c, 0x26, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x08, 0x03, 0x12, 0x04, 0x85, 0x01, 0x29,
+    0x2a, 0x0a, 0x19, 0x0a, 0x04, 0x04, 0x0f, 0x02, 0x09, 0x12, 0x04, 0x86, 0x01, 0x02, 0x2c, 0x22,
+    0x0b, 0x20, 0xe7, 0x82, 0xb9, 0xe8, 0xb8, 0xa9, 0xe6, 0x95, 0xb0, 0x0a, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x0f, 0x02, 0x09, 0x05, 0x12, 0x04, 0x86, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x0f, 0x02, 0x09, 0x01, 0x12, 0x04, 0x86, 0x01, 0x1c, 0x26, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f,
+    0x02, 0x09, 0x03, 0x12, 0x04, 0x86, 0x01, 0x29, 0x2b, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x0f, 0x02,
+    0x0a, 0x12, 0x04, 0x87, 0x01, 0x02, 0x2c, 0x22, 0x0e, 0x20, 0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83,
+    0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x0a, 0x05,
+    0x12, 0x04, 0x87, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x0a, 0x01, 0x12,
+    0x04, 0x87, 0x01, 0x1c, 0x21, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x0a, 0x03, 0x12, 0x04,
+    0x87, 0x01, 0x29, 0x2b, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x0f, 0x02, 0x0b, 0x12, 0x04, 0x88, 0x01,
+    0x02, 0x2c, 0x22, 0x0e, 0x20, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe6, 0x97, 0xb6, 0xe9, 0x97,
+    0xb4, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x0b, 0x05, 0x12, 0x04, 0x88, 0x01, 0x02,
+    0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x0b, 0x01, 0x12, 0x04, 0x88, 0x01, 0x1c, 0x21,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x0b, 0x03, 0x12, 0x04, 0x88, 0x01, 0x29, 0x2b, 0x0a,
+    0x21, 0x0a, 0x04, 0x04, 0x0f, 0x02, 0x0c, 0x12, 0x04, 0x89, 0x01, 0x02, 0x2c, 0x22, 0x13, 0x20,
+    0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83, 0xe6, 0x97, 0xb6, 0x69, 0x70, 0xe5, 0x9c, 0xb0, 0xe5, 0x9d,
+    0x80, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x0c, 0x05, 0x12, 0x04, 0x89, 0x01, 0x02,
+    0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x0c, 0x01, 0x12, 0x04, 0x89, 0x01, 0x1c, 0x1e,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x0c, 0x03, 0x12, 0x04, 0x89, 0x01, 0x29, 0x2b, 0x0a,
+    0x25, 0x0a, 0x04, 0x04, 0x0f, 0x02, 0x0d, 0x12, 0x04, 0x8a, 0x01, 0x02, 0x2c, 0x22, 0x17, 0x20,
+    0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe4, 0xb8, 0xba, 0xe7, 0xbd, 0xae, 0xe9, 0xa1, 0xb6, 0xe8,
+    0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x0d, 0x05, 0x12,
+    0x04, 0x8a, 0x01, 0x02, 0x06, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x0d, 0x01, 0x12, 0x04,
+    0x8a, 0x01, 0x1c, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x0d, 0x03, 0x12, 0x04, 0x8a,
+    0x01, 0x29, 0x2b, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x0f, 0x02, 0x0e, 0x12, 0x04, 0x8b, 0x01, 0x02,
+    0x2c, 0x22, 0x0e, 0x20, 0xe5, 0xad, 0x90, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe6, 0x95, 0xb0,
+    0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x0e, 0x05, 0x12, 0x04, 0x8b, 0x01, 0x02, 0x07,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x0e, 0x01, 0x12, 0x04, 0x8b, 0x01, 0x1c, 0x26, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x0e, 0x03, 0x12, 0x04, 0x8b, 0x01, 0x29, 0x2b, 0x0a, 0x22,
+    0x0a, 0x04, 0x04, 0x0f, 0x02, 0x0f, 0x12, 0x04, 0x8c, 0x01, 0x02, 0x2c, 0x22, 0x14, 0x20, 0xe8,
+    0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe5, 0x9b, 0xbe, 0xe7, 0x89, 0x87, 0xe8, 0xb5, 0x84, 0xe6, 0xba,
+    0x90, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x0f, 0x04, 0x12, 0x04, 0x8c, 0x01, 0x02,
+    0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x0f, 0x06, 0x12, 0x04, 0x8c, 0x01, 0x0b, 0x1b,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x0f, 0x01, 0x12, 0x04, 0x8c, 0x01, 0x1c, 0x22, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x0f, 0x03, 0x12, 0x04, 0x8c, 0x01, 0x29, 0x2b, 0x0a, 0x1d,
+    0x0a, 0x04, 0x04, 0x0f, 0x02, 0x10, 0x12, 0x04, 0x8d, 0x01, 0x02, 0x2c, 0x22, 0x0f, 0x20, 0x40,
+    0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x0a, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x0f, 0x02, 0x10, 0x04, 0x12, 0x04, 0x8d, 0x01, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x0f, 0x02, 0x10, 0x06, 0x12, 0x04, 0x8d, 0x01, 0x0b, 0x18, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x0f, 0x02, 0x10, 0x01, 0x12, 0x04, 0x8d, 0x01, 0x1c, 0x24, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f,
+    0x02, 0x10, 0x03, 0x12, 0x04, 0x8d, 0x01, 0x29, 0x2b, 0x0a, 0x22, 0x0a, 0x04, 0x04, 0x0f, 0x02,
+    0x11, 0x12, 0x04, 0x8e, 0x01, 0x02, 0x2c, 0x22, 0x14, 0x20, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6,
+    0xe8, 0xa2, 0xab, 0xe7, 0xbc, 0x96, 0xe8, 0xbe, 0x91, 0xe8, 0xbf, 0x87, 0x0a, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x0f, 0x02, 0x11, 0x05, 0x12, 0x04, 0x8e, 0x01, 0x02, 0x06, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x0f, 0x02, 0x11, 0x01, 0x12, 0x04, 0x8e, 0x01, 0x1c, 0x25, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x0f, 0x02, 0x11, 0x03, 0x12, 0x04, 0x8e, 0x01, 0x29, 0x2b, 0x0a, 0x22, 0x0a, 0x04, 0x04, 0x0f,
+    0x02, 0x12, 0x12, 0x04, 0x8f, 0x01, 0x02, 0x2c, 0x22, 0x14, 0x20, 0xe6, 0x9c, 0x80, 0xe5, 0x90,
+    0x8e, 0xe7, 0xbc, 0x96, 0xe8, 0xbe, 0x91, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x0a, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x0f, 0x02, 0x12, 0x05, 0x12, 0x04, 0x8f, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x0f, 0x02, 0x12, 0x01, 0x12, 0x04, 0x8f, 0x01, 0x1c, 0x21, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x0f, 0x02, 0x12, 0x03, 0x12, 0x04, 0x8f, 0x01, 0x29, 0x2b, 0x0a, 0x28, 0x0a, 0x04, 0x04,
+    0x0f, 0x02, 0x13, 0x12, 0x04, 0x90, 0x01, 0x02, 0x2c, 0x22, 0x1a, 0x20, 0xe5, 0x86, 0x85, 0xe5,
+    0xae, 0xb9, 0xe4, 0xb8, 0xad, 0xe5, 0xbc, 0x95, 0xe7, 0x94, 0xa8, 0xe7, 0x9a, 0x84, 0xe8, 0xa1,
+    0xa8, 0xe6, 0x83, 0x85, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x13, 0x04, 0x12, 0x04,
+    0x90, 0x01, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x13, 0x06, 0x12, 0x04, 0x90,
+    0x01, 0x0b, 0x17, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x13, 0x01, 0x12, 0x04, 0x90, 0x01,
+    0x1c, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x13, 0x03, 0x12, 0x04, 0x90, 0x01, 0x29,
+    0x2b, 0x0a, 0x61, 0x0a, 0x02, 0x04, 0x10, 0x12, 0x06, 0x94, 0x01, 0x00, 0x9d, 0x01, 0x01, 0x1a,
+    0x53, 0x20, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe5, 0x86, 0x85, 0xe5, 0xae, 0xb9, 0xe4, 0xb8,
+    0xad, 0xe4, 0xbb, 0xa5, 0x5b, 0x70, 0x61, 0x63, 0x6b, 0x3a, 0x69, 0x74, 0x65, 0x6d, 0x5d, 0xe5,
+    0xbd, 0xa2, 0xe5, 0xbc, 0x8f, 0xe5, 0xbc, 0x95, 0xe7, 0x94, 0xa8, 0xe7, 0x9a, 0x84, 0xe8, 0xa1,
+    0xa8, 0xe6, 0x83, 0x85, 0x20, 0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83, 0xe6, 0x97, 0xb6, 0xe8, 0xa7,
+    0xa3, 0xe6, 0x9e, 0x90, 0xe5, 0xb9, 0xb6, 0xe5, 0x9b, 0xba, 0xe5, 0xae, 0x9a, 0xe7, 0x89, 0x88,
+    0xe6, 0x9c, 0xac, 0x0a, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x10, 0x01, 0x12, 0x04, 0x94, 0x01, 0x08,
+    0x14, 0x0a, 0x38, 0x0a, 0x04, 0x04, 0x10, 0x02, 0x00, 0x12, 0x04, 0x95, 0x01, 0x02, 0x15, 0x22,
+    0x2a, 0x20, 0xe5, 0x86, 0x85, 0xe5, 0xae, 0xb9, 0xe4, 0xb8, 0xad, 0xe7, 0x9a, 0x84, 0xe5, 0xbc,
+    0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x96, 0x87, 0xe6, 0x9c, 0xac, 0x20, 0xe5, 0xa6, 0x82, 0x5b, 0x64,
+    0x6f, 0x67, 0x65, 0x3a, 0x73, 0x6d, 0x69, 0x6c, 0x65, 0x5d, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x10, 0x02, 0x00, 0x05, 0x12, 0x04, 0x95, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x10,
+    0x02, 0x00, 0x01, 0x12, 0x04, 0x95, 0x01, 0x09, 0x0e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x10, 0x02,
+    0x00, 0x03, 0x12, 0x04, 0x95, 0x01, 0x13, 0x14, 0x0a, 0x1f, 0x0a, 0x04, 0x04, 0x10, 0x02, 0x01,
+    0x12, 0x04, 0x96, 0x01, 0x02, 0x15, 0x22, 0x11, 0x20, 0xe8, 0xa1, 0xa8, 0xe6, 0x83, 0x85, 0xe5,
+    0x8c, 0x85, 0xe4, 0xbb, 0xa3, 0xe7, 0xa0, 0x81, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x10, 0x02,
+    0x01, 0x05, 0x12, 0x04, 0x96, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x01,
+    0x01, 0x12, 0x04, 0x96, 0x01, 0x09, 0x0d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x01, 0x03,
+    0x12, 0x04, 0x96, 0x01, 0x13, 0x14, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x10, 0x02, 0x02, 0x12, 0x04,
+    0x97, 0x01, 0x02, 0x15, 0x22, 0x0e, 0x20, 0xe8, 0xa1, 0xa8, 0xe6, 0x83, 0x85, 0xe4, 0xbb, 0xa3,
+    0xe7, 0xa0, 0x81, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x02, 0x05, 0x12, 0x04, 0x97,
+    0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x02, 0x01, 0x12, 0x04, 0x97, 0x01,
+    0x09, 0x0d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x02, 0x03, 0x12, 0x04, 0x97, 0x01, 0x13,
+    0x14, 0x0a, 0x28, 0x0a, 0x04, 0x04, 0x10, 0x02, 0x03, 0x12, 0x04, 0x98, 0x01, 0x02, 0x15, 0x22,
+    0x1a, 0x20, 0xe8, 0xa1, 0xa8, 0xe6, 0x83, 0x85, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0x9a,
+    0x84, 0xe5, 0xad, 0x98, 0xe5, 0x82, 0xa8, 0x6b, 0x65, 0x79, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x10, 0x02, 0x03, 0x05, 0x12, 0x04, 0x98, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x10,
+    0x02, 0x03, 0x01, 0x12, 0x04, 0x98, 0x01, 0x09, 0x0c, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x10, 0x02,
+    0x03, 0x03, 0x12, 0x04, 0x98, 0x01, 0x13, 0x14, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x10, 0x02, 0x04,
+    0x12, 0x04, 0x99, 0x01, 0x02, 0x15, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x04, 0x05, 0x12,
+    0x04, 0x99, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x04, 0x01, 0x12, 0x04,
+    0x99, 0x01, 0x09, 0x0e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x04, 0x03, 0x12, 0x04, 0x99,
+    0x01, 0x13, 0x14, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x10, 0x02, 0x05, 0x12, 0x04, 0x9a, 0x01, 0x02,
+    0x15, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x05, 0x05, 0x12, 0x04, 0x9a, 0x01, 0x02, 0x08,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x05, 0x01, 0x12, 0x04, 0x9a, 0x01, 0x09, 0x0f, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x05, 0x03, 0x12, 0x04, 0x9a, 0x01, 0x13, 0x14, 0x0a, 0x0c,
+    0x0a, 0x04, 0x04, 0x10, 0x02, 0x06, 0x12, 0x04, 0x9b, 0x01, 0x02, 0x15, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x10, 0x02, 0x06, 0x05, 0x12, 0x04, 0x9b, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x10, 0x02, 0x06, 0x01, 0x12, 0x04, 0x9b, 0x01, 0x09, 0x0f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x10,
+    0x02, 0x06, 0x03, 0x12, 0x04, 0x9b, 0x01, 0x13, 0x14, 0x0a, 0x28, 0x0a, 0x04, 0x04, 0x10, 0x02,
+    0x07, 0x12, 0x04, 0x9c, 0x01, 0x02, 0x15, 0x22, 0x1a, 0x20, 0xe5, 0xbc, 0x95, 0xe7, 0x94, 0xa8,
+    0xe6, 0x97, 0xb6, 0xe7, 0x9a, 0x84, 0xe8, 0xa1, 0xa8, 0xe6, 0x83, 0x85, 0xe7, 0x89, 0x88, 0xe6,
+    0x9c, 0xac, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x07, 0x05, 0x12, 0x04, 0x9c, 0x01,
+    0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x07, 0x01, 0x12, 0x04, 0x9c, 0x01, 0x09,
+    0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x07, 0x03, 0x12, 0x04, 0x9c, 0x01, 0x13, 0x14,
+    0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x11, 0x12, 0x06, 0x9f, 0x01, 0x00, 0xa4, 0x01, 0x01, 0x0a, 0x0b,
+    0x0a, 0x03, 0x04, 0x11, 0x01, 0x12, 0x04, 0x9f, 0x01, 0x08, 0x1c, 0x0a, 0x0c, 0x0a, 0x04, 0x04,
+    0x11, 0x02, 0x00, 0x12, 0x04, 0xa0, 0x01, 0x02, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x11, 0x02,
+    0x00, 0x05, 0x12, 0x04, 0xa0, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x11, 0x02, 0x00,
+    0x01, 0x12, 0x04, 0xa0, 0x01, 0x09, 0x0e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x11, 0x02, 0x00, 0x03,
+    0x12, 0x04, 0xa0, 0x01, 0x12, 0x13, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x11, 0x02, 0x01, 0x12, 0x04,
+    0xa1, 0x01, 0x02, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x11, 0x02, 0x01, 0x05, 0x12, 0x04, 0xa1,
+    0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x11, 0x02, 0x01, 0x01, 0x12, 0x04, 0xa1, 0x01,
+    0x09, 0x0f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x11, 0x02, 0x01, 0x03, 0x12, 0x04, 0xa1, 0x01, 0x12,
+    0x13, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x11, 0x02, 0x02, 0x12, 0x04, 0xa2, 0x01, 0x02, 0x14, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x11, 0x02, 0x02, 0x05, 0x12, 0x04, 0xa2, 0x01, 0x02, 0x08, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x11, 0x02, 0x02, 0x01, 0x12, 0x04, 0xa2, 0x01, 0x09, 0x0f, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x11, 0x02, 0x02, 0x03, 0x12, 0x04, 0xa2, 0x01, 0x12, 0x13, 0x0a, 0x0c, 0x0a, 0x04,
+    0x04, 0x11, 0x02, 0x03, 0x12, 0x04, 0xa3, 0x01, 0x02, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x11,
+    0x02, 0x03, 0x05, 0x12, 0x04, 0xa3, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x11, 0x02,
+    0x03, 0x01, 0x12, 0x04, 0xa3, 0x01, 0x09, 0x0d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x11, 0x02, 0x03,
+    0x03, 0x12, 0x04, 0xa3, 0x01, 0x12, 0x13, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x12, 0x12, 0x06, 0xa6,
+    0x01, 0x00, 0xa9, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x12, 0x01, 0x12, 0x04, 0xa6, 0x01,
+    0x08, 0x18, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x12, 0x02, 0x00, 0x12, 0x04, 0xa7, 0x01, 0x02, 0x20,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x12, 0x02, 0x00, 0x05, 0x12, 0x04, 0xa7, 0x01, 0x02, 0x08, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x12, 0x02, 0x00, 0x01, 0x12, 0x04, 0xa7, 0x01, 0x17, 0x1a, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x12, 0x02, 0x00, 0x03, 0x12, 0x04, 0xa7, 0x01, 0x1e, 0x1f, 0x0a, 0x0c, 0x0a,
+    0x04, 0x04, 0x12, 0x02, 0x01, 0x12, 0x04, 0xa8, 0x01, 0x02, 0x20, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x12, 0x02, 0x01, 0x06, 0x12, 0x04, 0xa8, 0x01, 0x02, 0x16, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x12,
+    0x02, 0x01, 0x01, 0x12, 0x04, 0xa8, 0x01, 0x17, 0x1b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x12, 0x02,
+    0x01, 0x03, 0x12, 0x04, 0xa8, 0x01, 0x1e, 0x1f, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x13, 0x12, 0x06,
+    0xab, 0x01, 0x00, 0xb0, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x13, 0x01, 0x12, 0x04, 0xab,
+    0x01, 0x08, 0x1e, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x13, 0x02, 0x00, 0x12, 0x04, 0xac, 0x01, 0x02,
+    0x2d, 0x22, 0x0e, 0x20, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae,
+    0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x13, 0x02, 0x00, 0x04, 0x12, 0x04, 0xac, 0x01, 0x02, 0x0a,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x13, 0x02, 0x00, 0x06, 0x12, 0x04, 0xac, 0x01, 0x0b, 0x16, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x13, 0x02, 0x00, 0x01, 0x12, 0x04, 0xac, 0x01, 0x17, 0x1f, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x13, 0x02, 0x00, 0x03, 0x12, 0x04, 0xac, 0x01, 0x2b, 0x2c, 0x0a, 0x34, 0x0a,
+    0x04, 0x04, 0x13, 0x02, 0x01, 0x12, 0x04, 0xad, 0x01, 0x02, 0x2d, 0x22, 0x26, 0x20, 0xe5, 0x88,
+    0x86, 0xe9, 0xa1, 0xb5, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe4, 0xb8, 0x8b, 0xe4, 0xb8, 0x80,
+    0xe6, 0xac, 0xa1, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xe7, 0x9a, 0x84, 0xe6, 0xb8, 0xb8, 0xe6,
+    0xa0, 0x87, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x13, 0x02, 0x01, 0x05, 0x12, 0x04, 0xad, 0x01,
+    0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x13, 0x02, 0x01, 0x01, 0x12, 0x04, 0xad, 0x01, 0x17,
+    0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x13, 0x02, 0x01, 0x03, 0x12, 0x04, 0xad, 0x01, 0x2b, 0x2c,
+    0x0a, 0x22, 0x0a, 0x04, 0x04, 0x13, 0x02, 0x02, 0x12, 0x04, 0xae, 0x01, 0x02, 0x2d, 0x22, 0x14,
+    0x20, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe8, 0xbf, 0x98, 0xe6, 0x9c, 0x89, 0xe6, 0x95, 0xb0,
+    0xe6, 0x8d, 0xae, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x13, 0x02, 0x02, 0x05, 0x12, 0x04, 0xae,
+    0x01, 0x02, 0x06, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x13, 0x02, 0x02, 0x01, 0x12, 0x04, 0xae, 0x01,
+    0x17, 0x1f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x13, 0x02, 0x02, 0x03, 0x12, 0x04, 0xae, 0x01, 0x2b,
+    0x2c, 0x0a, 0x3a, 0x0a, 0x04, 0x04, 0x13, 0x02, 0x03, 0x12, 0x04, 0xaf, 0x01, 0x02, 0x2d, 0x22,
+    0x2c, 0x20, 0xe6, 0x8c, 0x89, 0xe7, 0x83, 0xad, 0xe5, 0xba, 0xa6, 0xe6, 0x8e, 0x92, 0xe5, 0xba,
+    0x8f, 0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0x8b, 0xe4, 0xb8, 0x80, 0xe6, 0xac, 0xa1, 0xe8, 0xaf, 0xb7,
+    0xe6, 0xb1, 0x82, 0xe7, 0x9a, 0x84, 0xe6, 0xb8, 0xb8, 0xe6, 0xa0, 0x87, 0x0a, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x13, 0x02, 0x03, 0x05, 0x12, 0x04, 0xaf, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x13, 0x02, 0x03, 0x01, 0x12, 0x04, 0xaf, 0x01, 0x17, 0x28, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x13, 0x02, 0x03, 0x03, 0x12, 0x04, 0xaf, 0x01, 0x2b, 0x2c, 0x0a, 0x1f, 0x0a, 0x02, 0x04, 0x14,
+    0x12, 0x06, 0xb3, 0x01, 0x00, 0xb7, 0x01, 0x01, 0x1a, 0x11, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f,
+    0x96, 0xe5, 0xad, 0x90, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0b, 0x0a, 0x03, 0x04,
+    0x14, 0x01, 0x12, 0x04, 0xb3, 0x01, 0x08, 0x20, 0x0a, 0x21, 0x0a, 0x04, 0x04, 0x14, 0x02, 0x00,
+    0x12, 0x04, 0xb4, 0x01, 0x02, 0x38, 0x22, 0x13, 0x20, 0xe8, 0xa2, 0xab, 0xe8, 0xaf, 0x84, 0xe8,
+    0xae, 0xba, 0xe5, 0xaf, 0xb9, 0xe8, 0xb1, 0xa1, 0x69, 0x64, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x14, 0x02, 0x00, 0x05, 0x12, 0x04, 0xb4, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x14,
+    0x02, 0x00, 0x01, 0x12, 0x04, 0xb4, 0x01, 0x08, 0x0b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x14, 0x02,
+    0x00, 0x03, 0x12, 0x04, 0xb4, 0x01, 0x12, 0x13, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x14, 0x02, 0x00,
+    0x08, 0x12, 0x04, 0xb4, 0x01, 0x14, 0x37, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x14, 0x02, 0x00, 0x08,
+    0x87, 0x09, 0x04, 0x04, 0x12, 0x04, 0xb4, 0x01, 0x15, 0x36, 0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x14,
+    0x02, 0x01, 0x12, 0x04, 0xb5, 0x01, 0x02, 0x38, 0x22, 0x0d, 0x20, 0xe4, 0xb8, 0xbb, 0xe8, 0xaf,
+    0x84, 0xe8, 0xae, 0xba, 0x69, 0x64, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x14, 0x02, 0x01, 0x05,
+    0x12, 0x04, 0xb5, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x14, 0x02, 0x01, 0x01, 0x12,
+    0x04, 0xb5, 0x01, 0x08, 0x0f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x14, 0x02, 0x01, 0x03, 0x12, 0x04,
+    0xb5, 0x01, 0x12, 0x13, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x14, 0x02, 0x01, 0x08, 0x12, 0x04, 0xb5,
+    0x01, 0x14, 0x37, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x14, 0x02, 0x01, 0x08, 0x87, 0x09, 0x04, 0x04,
+    0x12, 0x04, 0xb5, 0x01, 0x15, 0x36, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x14, 0x02, 0x02, 0x12, 0x04,
+    0xb6, 0x01, 0x02, 0x14, 0x22, 0x0e, 0x20, 0xe8, 0xb5, 0xb7, 0xe5, 0xa7, 0x8b, 0xe6, 0xb8, 0xb8,
+    0xe6, 0xa0, 0x87, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x14, 0x02, 0x02, 0x05, 0x12, 0x04, 0xb6,
+    0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x14, 0x02, 0x02, 0x01, 0x12, 0x04, 0xb6, 0x01,
+    0x08, 0x0e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x14, 0x02, 0x02, 0x03, 0x12, 0x04, 0xb6, 0x01, 0x12,
+    0x13, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x15, 0x12, 0x06, 0xb9, 0x01, 0x00, 0xbd, 0x01, 0x01, 0x0a,
+    0x0b, 0x0a, 0x03, 0x04, 0x15, 0x01, 0x12, 0x04, 0xb9, 0x01, 0x08, 0x21, 0x0a, 0x0c, 0x0a, 0x04,
+    0x04, 0x15, 0x02, 0x00, 0x12, 0x04, 0xba, 0x01, 0x02, 0x27, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x15,
+    0x02, 0x00, 0x04, 0x12, 0x04, 0xba, 0x01, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x15, 0x02,
+    0x00, 0x06, 0x12, 0x04, 0xba, 0x01, 0x0b, 0x16, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x15, 0x02, 0x00,
+    0x01, 0x12, 0x04, 0xba, 0x01, 0x17, 0x1f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x15, 0x02, 0x00, 0x03,
+    0x12, 0x04, 0xba, 0x01, 0x25, 0x26, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x15, 0x02, 0x01, 0x12, 0x04,
+    0xbb, 0x01, 0x02, 0x27, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x15, 0x02, 0x01, 0x05, 0x12, 0x04, 0xbb,
+    0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x15, 0x02, 0x01, 0x01, 0x12, 0x04, 0xbb, 0x01,
+    0x17, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x15, 0x02, 0x01, 0x03, 0x12, 0x04, 0xbb, 0x01, 0x25,
+    0x26, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x15, 0x02, 0x02, 0x12, 0x04, 0xbc, 0x01, 0x02, 0x27, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x15, 0x02, 0x02, 0x05, 0x12, 0x04, 0xbc, 0x01, 0x02, 0x06, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x15, 0x02, 0x02, 0x01, 0x12, 0x04, 0xbc, 0x01, 0x17, 0x1f, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x15, 0x02, 0x02, 0x03, 0x12, 0x04, 0xbc, 0x01, 0x25, 0x26, 0x0a, 0x0c, 0x0a, 0x02,
+    0x04, 0x16, 0x12, 0x06, 0xbf, 0x01, 0x00, 0xc4, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x16,
+    0x01, 0x12, 0x04, 0xbf, 0x01, 0x08, 0x22, 0x0a, 0x21, 0x0a, 0x04, 0x04, 0x16, 0x02, 0x00, 0x12,
+    0x04, 0xc0, 0x01, 0x02, 0x38, 0x22, 0x13, 0x20, 0xe8, 0xa2, 0xab, 0xe8, 0xaf, 0x84, 0xe8, 0xae,
+    0xba, 0xe5, 0xaf, 0xb9, 0xe8, 0xb1, 0xa1, 0x69, 0x64, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x16,
+    0x02, 0x00, 0x05, 0x12, 0x04, 0xc0, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x16, 0x02,
+    0x00, 0x01, 0x12, 0x04, 0xc0, 0x01, 0x08, 0x0b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x16, 0x02, 0x00,
+    0x03, 0x12, 0x04, 0xc0, 0x01, 0x12, 0x13, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x16, 0x02, 0x00, 0x08,
+    0x12, 0x04, 0xc0, 0x01, 0x14, 0x37, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x16, 0x02, 0x00, 0x08, 0x87,
+    0x09, 0x04, 0x04, 0x12, 0x04, 0xc0, 0x01, 0x15, 0x36, 0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x16, 0x02,
+    0x01, 0x12, 0x04, 0xc1, 0x01, 0x02, 0x38, 0x22, 0x0d, 0x20, 0xe4, 0xb8, 0xbb, 0xe8, 0xaf, 0x84,
+    0xe8, 0xae, 0xba, 0x69, 0x64, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x16, 0x02, 0x01, 0x05, 0x12,
+    0x04, 0xc1, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x16, 0x02, 0x01, 0x01, 0x12, 0x04,
+    0xc1, 0x01, 0x08, 0x0f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x16, 0x02, 0x01, 0x03, 0x12, 0x04, 0xc1,
+    0x01, 0x12, 0x13, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x16, 0x02, 0x01, 0x08, 0x12, 0x04, 0xc1, 0x01,
+    0x14, 0x37, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x16, 0x02, 0x01, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12,
+    0x04, 0xc1, 0x01, 0x15, 0x36, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x16, 0x02, 0x02, 0x12, 0x04, 0xc2,
+    0x01, 0x02, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x16, 0x02, 0x02, 0x05, 0x12, 0x04, 0xc2, 0x01,
+    0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x16, 0x02, 0x02, 0x01, 0x12, 0x04, 0xc2, 0x01, 0x08,
+    0x0c, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x16, 0x02, 0x02, 0x03, 0x12, 0x04, 0xc2, 0x01, 0x12, 0x13,
+    0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x16, 0x02, 0x03, 0x12, 0x04, 0xc3, 0x01, 0x02, 0x14, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x16, 0x02, 0x03, 0x05, 0x12, 0x04, 0xc3, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x16, 0x02, 0x03, 0x01, 0x12, 0x04, 0xc3, 0x01, 0x08, 0x0d, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x16, 0x02, 0x03, 0x03, 0x12, 0x04, 0xc3, 0x01, 0x12, 0x13, 0x0a, 0x0c, 0x0a, 0x02, 0x04,
+    0x17, 0x12, 0x06, 0xc6, 0x01, 0x00, 0xc9, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x17, 0x01,
+    0x12, 0x04, 0xc6, 0x01, 0x08, 0x23, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x17, 0x02, 0x00, 0x12, 0x04,
+    0xc7, 0x01, 0x02, 0x24, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x17, 0x02, 0x00, 0x04, 0x12, 0x04, 0xc7,
+    0x01, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x17, 0x02, 0x00, 0x06, 0x12, 0x04, 0xc7, 0x01,
+    0x0b, 0x16, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x17, 0x02, 0x00, 0x01, 0x12, 0x04, 0xc7, 0x01, 0x17,
+    0x1f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x17, 0x02, 0x00, 0x03, 0x12, 0x04, 0xc7, 0x01, 0x22, 0x23,
+    0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x17, 0x02, 0x01, 0x12, 0x04, 0xc8, 0x01, 0x02, 0x24, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x17, 0x02, 0x01, 0x05, 0x12, 0x04, 0xc8, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x17, 0x02, 0x01, 0x01, 0x12, 0x04, 0xc8, 0x01, 0x17, 0x1c, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x17, 0x02, 0x01, 0x03, 0x12, 0x04, 0xc8, 0x01, 0x22, 0x23, 0x0a, 0x31, 0x0a, 0x02, 0x04,
+    0x18, 0x12, 0x06, 0xcc, 0x01, 0x00, 0xd0, 0x01, 0x01, 0x1a, 0x23, 0x20, 0xe4, 0xbb, 0xa3, 0xe8,
+    0xa1, 0xa8, 0xe4, 0xb8, 0xbb, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe7, 0x9a, 0x84, 0xe5, 0xad,
+    0x90, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x0a, 0x0a, 0x0b,
+    0x0a, 0x03, 0x04, 0x18, 0x01, 0x12, 0x04, 0xcc, 0x01, 0x08, 0x1a, 0x0a, 0x22, 0x0a, 0x04, 0x04,
+    0x18, 0x02, 0x00, 0x12, 0x04, 0xcd, 0x01, 0x02, 0x27, 0x22, 0x14, 0x20, 0xe5, 0xad, 0x90, 0xe8,
+    0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe7, 0x9a, 0x84, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x0a, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x18, 0x02, 0x00, 0x04, 0x12, 0x04, 0xcd, 0x01, 0x02, 0x0a, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x18, 0x02, 0x00, 0x06, 0x12, 0x04, 0xcd, 0x01, 0x0b, 0x16, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x18, 0x02, 0x00, 0x01, 0x12, 0x04, 0xcd, 0x01, 0x17, 0x1c, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x18, 0x02, 0x00, 0x03, 0x12, 0x04, 0xcd, 0x01, 0x25, 0x26, 0x0a, 0x0c, 0x0a, 0x04, 0x04,
+    0x18, 0x02, 0x01, 0x12, 0x04, 0xce, 0x01, 0x02, 0x27, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x18, 0x02,
+    0x01, 0x05, 0x12, 0x04, 0xce, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x18, 0x02, 0x01,
+    0x01, 0x12, 0x04, 0xce, 0x01, 0x17, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x18, 0x02, 0x01, 0x03,
+    0x12, 0x04, 0xce, 0x01, 0x25, 0x26, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x18, 0x02, 0x02, 0x12, 0x04,
+    0xcf, 0x01, 0x02, 0x27, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x18, 0x02, 0x02, 0x05, 0x12, 0x04, 0xcf,
+    0x01, 0x02, 0x06, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x18, 0x02, 0x02, 0x01, 0x12, 0x04, 0xcf, 0x01,
+    0x17, 0x1f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x18, 0x02, 0x02, 0x03, 0x12, 0x04, 0xcf, 0x01, 0x25,
+    0x26, 0x0a, 0x46, 0x0a, 0x02, 0x04, 0x19, 0x12, 0x06, 0xd3, 0x01, 0x00, 0xd6, 0x01, 0x01, 0x1a,
+    0x38, 0x20, 0xe8, 0xaf, 0xa6, 0xe7, 0xbb, 0x86, 0xe7, 0x9a, 0x84, 0xe4, 0xb8, 0xbb, 0xe8, 0xaf,
+    0x84, 0xe8, 0xae, 0xba, 0xe7, 0x9a, 0x84, 0xe8, 0xaf, 0xa6, 0xe7, 0xbb, 0x86, 0xe4, 0xbf, 0xa1,
+    0xe6, 0x81, 0xaf, 0xef, 0xbc, 0x8c, 0xe4, 0xbc, 0x9a, 0xe5, 0x8c, 0x85, 0xe5, 0x90, 0xab, 0xe5,
+    0xad, 0x90, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x19, 0x01,
+    0x12, 0x04, 0xd3, 0x01, 0x08, 0x1b, 0x0a, 0x28, 0x0a, 0x04, 0x04, 0x19, 0x02, 0x00, 0x12, 0x04,
+    0xd4, 0x01, 0x02, 0x26, 0x22, 0x1a, 0x20, 0xe4, 0xb8, 0xbb, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba,
+    0xe6, 0x9c, 0xac, 0xe8, 0xba, 0xab, 0xe7, 0x9a, 0x84, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x0a,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x19, 0x02, 0x00, 0x06, 0x12, 0x04, 0xd4, 0x01, 0x02, 0x0d, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x19, 0x02, 0x00, 0x01, 0x12, 0x04, 0xd4, 0x01, 0x15, 0x19, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x19, 0x02, 0x00, 0x03, 0x12, 0x04, 0xd4, 0x01, 0x24, 0x25, 0x0a, 0x22, 0x0a,
+    0x04, 0x04, 0x19, 0x02, 0x01, 0x12, 0x04, 0xd5, 0x01, 0x02, 0x26, 0x22, 0x14, 0x20, 0xe5, 0xad,
+    0x90, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe7, 0x9a, 0x84, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf,
+    0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x19, 0x02, 0x01, 0x06, 0x12, 0x04, 0xd5, 0x01, 0x02, 0x14,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x19, 0x02, 0x01, 0x01, 0x12, 0x04, 0xd5, 0x01, 0x15, 0x21, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x19, 0x02, 0x01, 0x03, 0x12, 0x04, 0xd5, 0x01, 0x24, 0x25, 0x0a, 0x0c,
+    0x0a, 0x02, 0x04, 0x1a, 0x12, 0x06, 0xd8, 0x01, 0x00, 0xdd, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03,
+    0x04, 0x1a, 0x01, 0x12, 0x04, 0xd8, 0x01, 0x08, 0x25, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x1a, 0x02,
+    0x00, 0x12, 0x04, 0xd9, 0x01, 0x02, 0x40, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1a, 0x02, 0x00, 0x05,
+    0x12, 0x04, 0xd9, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1a, 0x02, 0x00, 0x01, 0x12,
+    0x04, 0xd9, 0x01, 0x0b, 0x0e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1a, 0x02, 0x00, 0x03, 0x12, 0x04,
+    0xd9, 0x01, 0x1a, 0x1b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1a, 0x02, 0x00, 0x08, 0x12, 0x04, 0xd9,
+    0x01, 0x1c, 0x3f, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x1a, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04,
+    0x12, 0x04, 0xd9, 0x01, 0x1d, 0x3e, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x1a, 0x02, 0x01, 0x12, 0x04,
+    0xda, 0x01, 0x02, 0x1c, 0x22, 0x0e, 0x20, 0xe8, 0xb5, 0xb7, 0xe5, 0xa7, 0x8b, 0xe6, 0xb8, 0xb8,
+    0xe6, 0xa0, 0x87, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1a, 0x02, 0x01, 0x05, 0x12, 0x04, 0xda,
+    0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1a, 0x02, 0x01, 0x01, 0x12, 0x04, 0xda, 0x01,
+    0x0b, 0x11, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1a, 0x02, 0x01, 0x03, 0x12, 0x04, 0xda, 0x01, 0x1a,
+    0x1b, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x1a, 0x02, 0x02, 0x12, 0x04, 0xdb, 0x01, 0x02, 0x1c, 0x22,
+    0x0e, 0x20, 0xe6, 0x8e, 0x92, 0xe5, 0xba, 0x8f, 0xe6, 0x96, 0xb9, 0xe5, 0xbc, 0x8f, 0x0a, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x1a, 0x02, 0x02, 0x06, 0x12, 0x04, 0xdb, 0x01, 0x02, 0x0a, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x1a, 0x02, 0x02, 0x01, 0x12, 0x04, 0xdb, 0x01, 0x0b, 0x12, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x1a, 0x02, 0x02, 0x03, 0x12, 0x04, 0xdb, 0x01, 0x1a, 0x1b, 0x0a, 0x31, 0x0a, 0x04,
+    0x04, 0x1a, 0x02, 0x03, 0x12, 0x04, 0xdc, 0x01, 0x02, 0x1c, 0x22, 0x23, 0x20, 0xe6, 0x8c, 0x89,
+    0xe7, 0x83, 0xad, 0xe5, 0xba, 0xa6, 0xe6, 0x8e, 0x92, 0xe5, 0xba, 0x8f, 0xe6, 0x97, 0xb6, 0xe7,
+    0x9a, 0x84, 0xe8, 0xb5, 0xb7, 0xe5, 0xa7, 0x8b, 0xe6, 0xb8, 0xb8, 0xe6, 0xa0, 0x87, 0x0a, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x1a, 0x02, 0x03, 0x05, 0x12, 0x04, 0xdc, 0x01, 0x02, 0x08, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x1a, 0x02, 0x03, 0x01, 0x12, 0x04, 0xdc, 0x01, 0x0b, 0x17, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x1a, 0x02, 0x03, 0x03, 0x12, 0x04, 0xdc, 0x01, 0x1a, 0x1b, 0x0a, 0x0c, 0x0a, 0x02,
+    0x04, 0x1b, 0x12, 0x06, 0xdf, 0x01, 0x00, 0xe4, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x1b,
+    0x01, 0x12, 0x04, 0xdf, 0x01, 0x08, 0x26, 0x0a, 0x19, 0x0a, 0x04, 0x04, 0x1b, 0x02, 0x00, 0x12,
+    0x04, 0xe0, 0x01, 0x02, 0x35, 0x22, 0x0b, 0x20, 0xe4, 0xb8, 0xbb, 0xe8, 0xaf, 0x84, 0xe8, 0xae,
+    0xba, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02, 0x00, 0x04, 0x12, 0x04, 0xe0, 0x01, 0x02,
+    0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02, 0x00, 0x06, 0x12, 0x04, 0xe0, 0x01, 0x0b, 0x1e,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02, 0x00, 0x01, 0x12, 0x04, 0xe0, 0x01, 0x1f, 0x27, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02, 0x00, 0x03, 0x12, 0x04, 0xe0, 0x01, 0x33, 0x34, 0x0a, 0x0c,
+    0x0a, 0x04, 0x04, 0x1b, 0x02, 0x01, 0x12, 0x04, 0xe1, 0x01, 0x02, 0x35, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x1b, 0x02, 0x01, 0x05, 0x12, 0x04, 0xe1, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x1b, 0x02, 0x01, 0x01, 0x12, 0x04, 0xe1, 0x01, 0x1f, 0x2a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b,
+    0x02, 0x01, 0x03, 0x12, 0x04, 0xe1, 0x01, 0x33, 0x34, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x1b, 0x02,
+    0x02, 0x12, 0x04, 0xe2, 0x01, 0x02, 0x35, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02, 0x02, 0x05,
+    0x12, 0x04, 0xe2, 0x01, 0x02, 0x06, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02, 0x02, 0x01, 0x12,
+    0x04, 0xe2, 0x01, 0x1f, 0x27, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02, 0x02, 0x03, 0x12, 0x04,
+    0xe2, 0x01, 0x33, 0x34, 0x0a, 0x3a, 0x0a, 0x04, 0x04, 0x1b, 0x02, 0x03, 0x12, 0x04, 0xe3, 0x01,
+    0x02, 0x35, 0x22, 0x2c, 0x20, 0xe6, 0x8c, 0x89, 0xe7, 0x83, 0xad, 0xe5, 0xba, 0xa6, 0xe6, 0x8e,
+    0x92, 0xe5, 0xba, 0x8f, 0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0x8b, 0xe4, 0xb8, 0x80, 0xe6, 0xac, 0xa1,
+    0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xe7, 0x9a, 0x84, 0xe6, 0xb8, 0xb8, 0xe6, 0xa0, 0x87, 0x0a,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02, 0x03, 0x05, 0x12, 0x04, 0xe3, 0x01, 0x02, 0x08, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02, 0x03, 0x01, 0x12, 0x04, 0xe3, 0x01, 0x1f, 0x30, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x1b, 0x02, 0x03, 0x03, 0x12, 0x04, 0xe3, 0x01, 0x33, 0x34, 0x0a, 0x0c, 0x0a,
+    0x02, 0x04, 0x1c, 0x12, 0x06, 0xe6, 0x01, 0x00, 0xeb, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04,
+    0x1c, 0x01, 0x12, 0x04, 0xe6, 0x01, 0x08, 0x27, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x1c, 0x02, 0x00,
+    0x12, 0x04, 0xe7, 0x01, 0x02, 0x40, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1c, 0x02, 0x00, 0x05, 0x12,
+    0x04, 0xe7, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1c, 0x02, 0x00, 0x01, 0x12, 0x04,
+    0xe7, 0x01, 0x0b, 0x0e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1c, 0x02, 0x00, 0x03, 0x12, 0x04, 0xe7,
+    0x01, 0x1a, 0x1b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1c, 0x02, 0x00, 0x08, 0x12, 0x04, 0xe7, 0x01,
+    0x1c, 0x3f, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x1c, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12,
+    0x04, 0xe7, 0x01, 0x1d, 0x3e, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x1c, 0x02, 0x01, 0x12, 0x04, 0xe8,
+    0x01, 0x02, 0x1c, 0x22, 0x0e, 0x20, 0xe8, 0xb5, 0xb7, 0xe5, 0xa7, 0x8b, 0xe6, 0xb8, 0xb8, 0xe6,
+    0xa0, 0x87, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1c, 0x02, 0x01, 0x05, 0x12, 0x04, 0xe8, 0x01,
+    0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1c, 0x02, 0x01, 0x01, 0x12, 0x04, 0xe8, 0x01, 0x0b,
+    0x11, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1c, 0x02, 0x01, 0x03, 0x12, 0x04, 0xe8, 0x01, 0x1a, 0x1b,
+    0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x1c, 0x02, 0x02, 0x12, 0x04, 0xe9, 0x01, 0x02, 0x1c, 0x22, 0x0e,
+    0x20, 0xe6, 0x8e, 0x92, 0xe5, 0xba, 0x8f, 0xe6, 0x96, 0xb9, 0xe5, 0xbc, 0x8f, 0x0a, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x1c, 0x02, 0x02, 0x06, 0x12, 0x04, 0xe9, 0x01, 0x02, 0x0a, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x1c, 0x02, 0x02, 0x01, 0x12, 0x04, 0xe9, 0x01, 0x0b, 0x12, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x1c, 0x02, 0x02, 0x03, 0x12, 0x04, 0xe9, 0x01, 0x1a, 0x1b, 0x0a, 0x31, 0x0a, 0x04, 0x04,
+    0x1c, 0x02, 0x03, 0x12, 0x04, 0xea, 0x01, 0x02, 0x1c, 0x22, 0x23, 0x20, 0xe6, 0x8c, 0x89, 0xe7,
+    0x83, 0xad, 0xe5, 0xba, 0xa6, 0xe6, 0x8e, 0x92, 0xe5, 0xba, 0x8f, 0xe6, 0x97, 0xb6, 0xe7, 0x9a,
+    0x84, 0xe8, 0xb5, 0xb7, 0xe5, 0xa7, 0x8b, 0xe6, 0xb8, 0xb8, 0xe6, 0xa0, 0x87, 0x0a, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x1c, 0x02, 0x03, 0x05, 0x12, 0x04, 0xea, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x1c, 0x02, 0x03, 0x01, 0x12, 0x04, 0xea, 0x01, 0x0b, 0x17, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x1c, 0x02, 0x03, 0x03, 0x12, 0x04, 0xea, 0x01, 0x1a, 0x1b, 0x0a, 0x31, 0x0a, 0x02, 0x04,
+    0x1d, 0x12, 0x06, 0xee, 0x01, 0x00, 0xf1, 0x01, 0x01, 0x1a, 0x23, 0x20, 0xe4, 0xbb, 0xa3, 0xe8,
+    0xa1, 0xa8, 0xe4, 0xb8, 0xbb, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe7, 0x9a, 0x84, 0xe5, 0xad,
+    0x90, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x0a, 0x0a, 0x0b,
+    0x0a, 0x03, 0x04, 0x1d, 0x01, 0x12, 0x04, 0xee, 0x01, 0x08, 0x1c, 0x0a, 0x22, 0x0a, 0x04, 0x04,
+    0x1d, 0x02, 0x00, 0x12, 0x04, 0xef, 0x01, 0x02, 0x21, 0x22, 0x14, 0x20, 0xe5, 0xad, 0x90, 0xe8,
+    0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe7, 0x9a, 0x84, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x0a, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x1d, 0x02, 0x00, 0x04, 0x12, 0x04, 0xef, 0x01, 0x02, 0x0a, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x1d, 0x02, 0x00, 0x06, 0x12, 0x04, 0xef, 0x01, 0x0b, 0x16, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x1d, 0x02, 0x00, 0x01, 0x12, 0x04, 0xef, 0x01, 0x17, 0x1c, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x1d, 0x02, 0x00, 0x03, 0x12, 0x04, 0xef, 0x01, 0x1f, 0x20, 0x0a, 0x22, 0x0a, 0x04, 0x04,
+    0x1d, 0x02, 0x01, 0x12, 0x04, 0xf0, 0x01, 0x02, 0x21, 0x22, 0x14, 0x20, 0xe5, 0xad, 0x90, 0xe8,
+    0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe6, 0x80, 0xbb, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x0a, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x1d, 0x02, 0x01, 0x05, 0x12, 0x04, 0xf0, 0x01, 0x02, 0x07, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x1d, 0x02, 0x01, 0x01, 0x12, 0x04, 0xf0, 0x01, 0x17, 0x1c, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x1d, 0x02, 0x01, 0x03, 0x12, 0x04, 0xf0, 0x01, 0x1f, 0x20, 0x0a, 0x46, 0x0a, 0x02,
+    0x04, 0x1e, 0x12, 0x06, 0xf4, 0x01, 0x00, 0xf7, 0x01, 0x01, 0x1a, 0x38, 0x20, 0xe8, 0xaf, 0xa6,
+    0xe7, 0xbb, 0x86, 0xe7, 0x9a, 0x84, 0xe4, 0xb8, 0xbb, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe7,
+    0x9a, 0x84, 0xe8, 0xaf, 0xa6, 0xe7, 0xbb, 0x86, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0xef, 0xbc,
+    0x8c, 0xe4, 0xbc, 0x9a, 0xe5, 0x8c, 0x85, 0xe5, 0x90, 0xab, 0xe5, 0xad, 0x90, 0xe8, 0xaf, 0x84,
+    0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x1e, 0x01, 0x12, 0x04, 0xf4, 0x01, 0x08,
+    0x1d, 0x0a, 0x28, 0x0a, 0x04, 0x04, 0x1e, 0x02, 0x00, 0x12, 0x04, 0xf5, 0x01, 0x02, 0x28, 0x22,
+    0x1a, 0x20, 0xe4, 0xb8, 0xbb, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe6, 0x9c, 0xac, 0xe8, 0xba,
+    0xab, 0xe7, 0x9a, 0x84, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x1e, 0x02, 0x00, 0x06, 0x12, 0x04, 0xf5, 0x01, 0x02, 0x0d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1e,
+    0x02, 0x00, 0x01, 0x12, 0x04, 0xf5, 0x01, 0x17, 0x1b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1e, 0x02,
+    0x00, 0x03, 0x12, 0x04, 0xf5, 0x01, 0x26, 0x27, 0x0a, 0x22, 0x0a, 0x04, 0x04, 0x1e, 0x02, 0x01,
+    0x12, 0x04, 0xf6, 0x01, 0x02, 0x28, 0x22, 0x14, 0x20, 0xe5, 0xad, 0x90, 0xe8, 0xaf, 0x84, 0xe8,
+    0xae, 0xba, 0xe7, 0x9a, 0x84, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x0a, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x1e, 0x02, 0x01, 0x06, 0x12, 0x04, 0xf6, 0x01, 0x02, 0x16, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x1e, 0x02, 0x01, 0x01, 0x12, 0x04, 0xf6, 0x01, 0x17, 0x23, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1e,
+    0x02, 0x01, 0x03, 0x12, 0x04, 0xf6, 0x01, 0x26, 0x27, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x1f, 0x12,
+    0x06, 0xf9, 0x01, 0x00, 0xfe, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x1f, 0x01, 0x12, 0x04,
+    0xf9, 0x01, 0x08, 0x28, 0x0a, 0x19, 0x0a, 0x04, 0x04, 0x1f, 0x02, 0x00, 0x12, 0x04, 0xfa, 0x01,
+    0x02, 0x37, 0x22, 0x0b, 0x20, 0xe4, 0xb8, 0xbb, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x1f, 0x02, 0x00, 0x04, 0x12, 0x04, 0xfa, 0x01, 0x02, 0x0a, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x1f, 0x02, 0x00, 0x06, 0x12, 0x04, 0xfa, 0x01, 0x0b, 0x20, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x1f, 0x02, 0x00, 0x01, 0x12, 0x04, 0xfa, 0x01, 0x21, 0x2e, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x1f, 0x02, 0x00, 0x03, 0x12, 0x04, 0xfa, 0x01, 0x35, 0x36, 0x0a, 0x0c, 0x0a, 0x04, 0x04,
+    0x1f, 0x02, 0x01, 0x12, 0x04, 0xfb, 0x01, 0x02, 0x37, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1f, 0x02,
+    0x01, 0x05, 0x12, 0x04, 0xfb, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1f, 0x02, 0x01,
+    0x01, 0x12, 0x04, 0xfb, 0x01, 0x21, 0x2c, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1f, 0x02, 0x01, 0x03,
+    0x12, 0x04, 0xfb, 0x01, 0x35, 0x36, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x1f, 0x02, 0x02, 0x12, 0x04,
+    0xfc, 0x01, 0x02, 0x37, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1f, 0x02, 0x02, 0x05, 0x12, 0x04, 0xfc,
+    0x01, 0x02, 0x06, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1f, 0x02, 0x02, 0x01, 0x12, 0x04, 0xfc, 0x01,
+    0x21, 0x29, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1f, 0x02, 0x02, 0x03, 0x12, 0x04, 0xfc, 0x01, 0x35,
+    0x36, 0x0a, 0x3a, 0x0a, 0x04, 0x04, 0x1f, 0x02, 0x03, 0x12, 0x04, 0xfd, 0x01, 0x02, 0x37, 0x22,
+    0x2c, 0x20, 0xe6, 0x8c, 0x89, 0xe7, 0x83, 0xad, 0xe5, 0xba, 0xa6, 0xe6, 0x8e, 0x92, 0xe5, 0xba,
+    0x8f, 0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0x8b, 0xe4, 0xb8, 0x80, 0xe6, 0xac, 0xa1, 0xe8, 0xaf, 0xb7,
+    0xe6, 0xb1, 0x82, 0xe7, 0x9a, 0x84, 0xe6, 0xb8, 0xb8, 0xe6, 0xa0, 0x87, 0x0a, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x1f, 0x02, 0x03, 0x05, 0x12, 0x04, 0xfd, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x1f, 0x02, 0x03, 0x01, 0x12, 0x04, 0xfd, 0x01, 0x21, 0x32, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x1f, 0x02, 0x03, 0x03, 0x12, 0x04, 0xfd, 0x01, 0x35, 0x36, 0x0a, 0x22, 0x0a, 0x02, 0x04, 0x20,
+    0x12, 0x06, 0x81, 0x02, 0x00, 0x83, 0x02, 0x01, 0x1a, 0x14, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f,
+    0x96, 0xe7, 0xbd, 0xae, 0xe9, 0xa1, 0xb6, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0b,
+    0x0a, 0x03, 0x04, 0x20, 0x01, 0x12, 0x04, 0x81, 0x02, 0x08, 0x1f, 0x0a, 0x21, 0x0a, 0x04, 0x04,
+    0x20, 0x02, 0x00, 0x12, 0x04, 0x82, 0x02, 0x02, 0x34, 0x22, 0x13, 0x20, 0xe8, 0xa2, 0xab, 0xe8,
+    0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe5, 0xaf, 0xb9, 0xe8, 0xb1, 0xa1, 0x69, 0x64, 0x0a, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x20, 0x02, 0x00, 0x05, 0x12, 0x04, 0x82, 0x02, 0x02, 0x07, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x20, 0x02, 0x00, 0x01, 0x12, 0x04, 0x82, 0x02, 0x08, 0x0b, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x20, 0x02, 0x00, 0x03, 0x12, 0x04, 0x82, 0x02, 0x0e, 0x0f, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x20, 0x02, 0x00, 0x08, 0x12, 0x04, 0x82, 0x02, 0x10, 0x33, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x20,
+    0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x04, 0x82, 0x02, 0x11, 0x32, 0x0a, 0x0c, 0x0a,
+    0x02, 0x04, 0x21, 0x12, 0x06, 0x85, 0x02, 0x00, 0x87, 0x02, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04,
+    0x21, 0x01, 0x12, 0x04, 0x85, 0x02, 0x08, 0x20, 0x0a, 0x22, 0x0a, 0x04, 0x04, 0x21, 0x02, 0x00,
+    0x12, 0x04, 0x86, 0x02, 0x02, 0x1f, 0x22, 0x14, 0x20, 0xe7, 0xbd, 0xae, 0xe9, 0xa1, 0xb6, 0xe8,
+    0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x0a, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x21, 0x02, 0x00, 0x06, 0x12, 0x04, 0x86, 0x02, 0x02, 0x15, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x21, 0x02, 0x00, 0x01, 0x12, 0x04, 0x86, 0x02, 0x16, 0x1a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x21,
+    0x02, 0x00, 0x03, 0x12, 0x04, 0x86, 0x02, 0x1d, 0x1e, 0x0a, 0x34, 0x0a, 0x02, 0x04, 0x22, 0x12,
+    0x06, 0x8a, 0x02, 0x00, 0x8c, 0x02, 0x01, 0x1a, 0x26, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96,
+    0xe8, 0xa2, 0xab, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe5, 0xaf, 0xb9, 0xe8, 0xb1, 0xa1, 0xe7,
+    0x9a, 0x84, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x0a, 0x0a,
+    0x0b, 0x0a, 0x03, 0x04, 0x22, 0x01, 0x12, 0x04, 0x8a, 0x02, 0x08, 0x1b, 0x0a, 0x21, 0x0a, 0x04,
+    0x04, 0x22, 0x02, 0x00, 0x12, 0x04, 0x8b, 0x02, 0x02, 0x34, 0x22, 0x13, 0x20, 0xe8, 0xa2, 0xab,
+    0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe5, 0xaf, 0xb9, 0xe8, 0xb1, 0xa1, 0x69, 0x64, 0x0a, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x22, 0x02, 0x00, 0x05, 0x12, 0x04, 0x8b, 0x02, 0x02, 0x07, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x22, 0x02, 0x00, 0x01, 0x12, 0x04, 0x8b, 0x02, 0x08, 0x0b, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x22, 0x02, 0x00, 0x03, 0x12, 0x04, 0x8b, 0x02, 0x0e, 0x0f, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x22, 0x02, 0x00, 0x08, 0x12, 0x04, 0x8b, 0x02, 0x10, 0x33, 0x0a, 0x11, 0x0a, 0x09, 0x04,
+    0x22, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x04, 0x8b, 0x02, 0x11, 0x32, 0x0a, 0x0c,
+    0x0a, 0x02, 0x04, 0x23, 0x12, 0x06, 0x8e, 0x02, 0x00, 0x90, 0x02, 0x01, 0x0a, 0x0b, 0x0a, 0x03,
+    0x04, 0x23, 0x01, 0x12, 0x04, 0x8e, 0x02, 0x08, 0x1c, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x23, 0x02,
+    0x00, 0x12, 0x04, 0x8f, 0x02, 0x02, 0x12, 0x22, 0x0e, 0x20, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba,
+    0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x23, 0x02, 0x00, 0x05,
+    0x12, 0x04, 0x8f, 0x02, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x23, 0x02, 0x00, 0x01, 0x12,
+    0x04, 0x8f, 0x02, 0x08, 0x0d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x23, 0x02, 0x00, 0x03, 0x12, 0x04,
+    0x8f, 0x02, 0x10, 0x11, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x24, 0x12, 0x06, 0x92, 0x02, 0x00, 0x94,
+    0x02, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x24, 0x01, 0x12, 0x04, 0x92, 0x02, 0x08, 0x20, 0x0a,
+    0x0c, 0x0a, 0x04, 0x04, 0x24, 0x02, 0x00, 0x12, 0x04, 0x93, 0x02, 0x02, 0x1a, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x24, 0x02, 0x00, 0x04, 0x12, 0x04, 0x93, 0x02, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x24, 0x02, 0x00, 0x05, 0x12, 0x04, 0x93, 0x02, 0x0b, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x24, 0x02, 0x00, 0x01, 0x12, 0x04, 0x93, 0x02, 0x11, 0x15, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x24,
+    0x02, 0x00, 0x03, 0x12, 0x04, 0x93, 0x02, 0x18, 0x19, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x25, 0x12,
+    0x06, 0x96, 0x02, 0x00, 0x98, 0x02, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x25, 0x01, 0x12, 0x04,
+    0x96, 0x02, 0x08, 0x21, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x25, 0x02, 0x00, 0x12, 0x04, 0x97, 0x02,
+    0x02, 0x20, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x25, 0x02, 0x00, 0x06, 0x12, 0x04, 0x97, 0x02, 0x02,
+    0x13, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x25, 0x02, 0x00, 0x01, 0x12, 0x04, 0x97, 0x02, 0x14, 0x1b,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x25, 0x02, 0x00, 0x03, 0x12, 0x04, 0x97, 0x02, 0x1e, 0x1f, 0x0a,
+    0x0c, 0x0a, 0x02, 0x04, 0x26, 0x12, 0x06, 0x9a, 0x02, 0x00, 0x9c, 0x02, 0x01, 0x0a, 0x0b, 0x0a,
+    0x03, 0x04, 0x26, 0x01, 0x12, 0x04, 0x9a, 0x02, 0x08, 0x22, 0x0a, 0x18, 0x0a, 0x04, 0x04, 0x26,
+    0x02, 0x00, 0x12, 0x04, 0x9b, 0x02, 0x02, 0x3b, 0x22, 0x0a, 0x20, 0xe8, 0xaf, 0x84, 0xe8, 0xae,
+    0xba, 0x69, 0x64, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x26, 0x02, 0x00, 0x05, 0x12, 0x04, 0x9b,
+    0x02, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x26, 0x02, 0x00, 0x01, 0x12, 0x04, 0x9b, 0x02,
+    0x08, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x26, 0x02, 0x00, 0x03, 0x12, 0x04, 0x9b, 0x02, 0x15,
+    0x16, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x26, 0x02, 0x00, 0x08, 0x12, 0x04, 0x9b, 0x02, 0x17, 0x3a,
+    0x0a, 0x11, 0x0a, 0x09, 0x04, 0x26, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x04, 0x9b,
+    0x02, 0x18, 0x39, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x27, 0x12, 0x06, 0x9e, 0x02, 0x00, 0xa1, 0x02,
+    0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x27, 0x01, 0x12, 0x04, 0x9e, 0x02, 0x08, 0x23, 0x0a, 0x0c,
+    0x0a, 0x04, 0x04, 0x27, 0x02, 0x00, 0x12, 0x04, 0x9f, 0x02, 0x02, 0x17, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x27, 0x02, 0x00, 0x05, 0x12, 0x04, 0x9f, 0x02, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x27, 0x02, 0x00, 0x01, 0x12, 0x04, 0x9f, 0x02, 0x08, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x27,
+    0x02, 0x00, 0x03, 0x12, 0x04, 0x9f, 0x02, 0x15, 0x16, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x27, 0x02,
+    0x01, 0x12, 0x04, 0xa0, 0x02, 0x02, 0x17, 0x22, 0x0e, 0x20, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e,
+    0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x27, 0x02, 0x01, 0x05,
+    0x12, 0x04, 0xa0, 0x02, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x27, 0x02, 0x01, 0x01, 0x12,
+    0x04, 0xa0, 0x02, 0x08, 0x0d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x27, 0x02, 0x01, 0x03, 0x12, 0x04,
+    0xa0, 0x02, 0x15, 0x16, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x28, 0x12, 0x06, 0xa3, 0x02, 0x00, 0xa5,
+    0x02, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x28, 0x01, 0x12, 0x04, 0xa3, 0x02, 0x08, 0x25, 0x0a,
+    0x18, 0x0a, 0x04, 0x04, 0x28, 0x02, 0x00, 0x12, 0x04, 0xa4, 0x02, 0x02, 0x3b, 0x22, 0x0a, 0x20,
+    0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x69, 0x64, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x28, 0x02,
+    0x00, 0x05, 0x12, 0x04, 0xa4, 0x02, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x28, 0x02, 0x00,
+    0x01, 0x12, 0x04, 0xa4, 0x02, 0x08, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x28, 0x02, 0x00, 0x03,
+    0x12, 0x04, 0xa4, 0x02, 0x15, 0x16, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x28, 0x02, 0x00, 0x08, 0x12,
+    0x04, 0xa4, 0x02, 0x17, 0x3a, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x28, 0x02, 0x00, 0x08, 0x87, 0x09,
+    0x04, 0x04, 0x12, 0x04, 0xa4, 0x02, 0x18, 0x39, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x29, 0x12, 0x06,
+    0xa7, 0x02, 0x00, 0xaa, 0x02, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x29, 0x01, 0x12, 0x04, 0xa7,
+    0x02, 0x08, 0x26, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x29, 0x02, 0x00, 0x12, 0x04, 0xa8, 0x02, 0x02,
+    0x17, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x29, 0x02, 0x00, 0x05, 0x12, 0x04, 0xa8, 0x02, 0x02, 0x07,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x29, 0x02, 0x00, 0x01, 0x12, 0x04, 0xa8, 0x02, 0x08, 0x12, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x29, 0x02, 0x00, 0x03, 0x12, 0x04, 0xa8, 0x02, 0x15, 0x16, 0x0a, 0x1c,
+    0x0a, 0x04, 0x04, 0x29, 0x02, 0x01, 0x12, 0x04, 0xa9, 0x02, 0x02, 0x17, 0x22, 0x0e, 0x20, 0xe7,
+    0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x0a, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x29, 0x02, 0x01, 0x05, 0x12, 0x04, 0xa9, 0x02, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x29, 0x02, 0x01, 0x01, 0x12, 0x04, 0xa9, 0x02, 0x08, 0x0d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x29,
+    0x02, 0x01, 0x03, 0x12, 0x04, 0xa9, 0x02, 0x15, 0x16, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x2a, 0x12,
+    0x06, 0xac, 0x02, 0x00, 0xaf, 0x02, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x2a, 0x01, 0x12, 0x04,
+    0xac, 0x02, 0x08, 0x20, 0x0a, 0x21, 0x0a, 0x04, 0x04, 0x2a, 0x02, 0x00, 0x12, 0x04, 0xad, 0x02,
+    0x02, 0x34, 0x22, 0x13, 0x20, 0xe8, 0xa2, 0xab, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe5, 0xaf,
+    0xb9, 0xe8, 0xb1, 0xa1, 0x69, 0x64, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2a, 0x02, 0x00, 0x05,
+    0x12, 0x04, 0xad, 0x02, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2a, 0x02, 0x00, 0x01, 0x12,
+    0x04, 0xad, 0x02, 0x08, 0x0b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2a, 0x02, 0x00, 0x03, 0x12, 0x04,
+    0xad, 0x02, 0x0e, 0x0f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2a, 0x02, 0x00, 0x08, 0x12, 0x04, 0xad,
+    0x02, 0x10, 0x33, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x2a, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04,
+    0x12, 0x04, 0xad, 0x02, 0x11, 0x32, 0x0a, 0x18, 0x0a, 0x04, 0x04, 0x2a, 0x02, 0x01, 0x12, 0x04,
+    0xae, 0x02, 0x02, 0x10, 0x22, 0x0a, 0x20, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64, 0x0a,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2a, 0x02, 0x01, 0x05, 0x12, 0x04, 0xae, 0x02, 0x02, 0x07, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x2a, 0x02, 0x01, 0x01, 0x12, 0x04, 0xae, 0x02, 0x08, 0x0b, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x2a, 0x02, 0x01, 0x03, 0x12, 0x04, 0xae, 0x02, 0x0e, 0x0f, 0x0a, 0x0c, 0x0a,
+    0x02, 0x04, 0x2b, 0x12, 0x06, 0xb1, 0x02, 0x00, 0xb3, 0x02, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04,
+    0x2b, 0x01, 0x12, 0x04, 0xb1, 0x02, 0x08, 0x21, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x2b, 0x02, 0x00,
+    0x12, 0x04, 0xb2, 0x02, 0x02, 0x1a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2b, 0x02, 0x00, 0x06, 0x12,
+    0x04, 0xb2, 0x02, 0x02, 0x0e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2b, 0x02, 0x00, 0x01, 0x12, 0x04,
+    0xb2, 0x02, 0x0f, 0x15, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2b, 0x02, 0x00, 0x03, 0x12, 0x04, 0xb2,
+    0x02, 0x18, 0x19, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x2c, 0x12, 0x06, 0xb5, 0x02, 0x00, 0xbb, 0x02,
+    0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x2c, 0x01, 0x12, 0x04, 0xb5, 0x02, 0x08, 0x25, 0x0a, 0x0e,
+    0x0a, 0x04, 0x04, 0x2c, 0x03, 0x00, 0x12, 0x06, 0xb6, 0x02, 0x02, 0xb9, 0x02, 0x03, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x2c, 0x03, 0x00, 0x01, 0x12, 0x04, 0xb6, 0x02, 0x0a, 0x11, 0x0a, 0x10, 0x0a,
+    0x06, 0x04, 0x2c, 0x03, 0x00, 0x02, 0x00, 0x12, 0x06, 0xb7, 0x02, 0x04, 0xb8, 0x02, 0x66, 0x0a,
+    0x0f, 0x0a, 0x07, 0x04, 0x2c, 0x03, 0x00, 0x02, 0x00, 0x04, 0x12, 0x04, 0xb7, 0x02, 0x04, 0x0c,
+    0x0a, 0x0f, 0x0a, 0x07, 0x04, 0x2c, 0x03, 0x00, 0x02, 0x00, 0x05, 0x12, 0x04, 0xb7, 0x02, 0x0d,
+    0x12, 0x0a, 0x0f, 0x0a, 0x07, 0x04, 0x2c, 0x03, 0x00, 0x02, 0x00, 0x01, 0x12, 0x04, 0xb7, 0x02,
+    0x13, 0x17, 0x0a, 0x0f, 0x0a, 0x07, 0x04, 0x2c, 0x03, 0x00, 0x02, 0x00, 0x03, 0x12, 0x04, 0xb7,
+    0x02, 0x1a, 0x1b, 0x0a, 0x0f, 0x0a, 0x07, 0x04, 0x2c, 0x03, 0x00, 0x02, 0x00, 0x08, 0x12, 0x04,
+    0xb8, 0x02, 0x08, 0x65, 0x0a, 0x13, 0x0a, 0x0b, 0x04, 0x2c, 0x03, 0x00, 0x02, 0x00, 0x08, 0x87,
+    0x09, 0x12, 0x01, 0x12, 0x04, 0xb8, 0x02, 0x09, 0x35, 0x0a, 0x13, 0x0a, 0x0b, 0x04, 0x2c, 0x03,
+    0x00, 0x02, 0x00, 0x08, 0x87, 0x09, 0x12, 0x02, 0x12, 0x04, 0xb8, 0x02, 0x37, 0x64, 0x0a, 0x0c,
+    0x0a, 0x04, 0x04, 0x2c, 0x02, 0x00, 0x12, 0x04, 0xba, 0x02, 0x02, 0x75, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x2c, 0x02, 0x00, 0x06, 0x12, 0x04, 0xba, 0x02, 0x02, 0x15, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x2c, 0x02, 0x00, 0x01, 0x12, 0x04, 0xba, 0x02, 0x16, 0x1e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2c,
+    0x02, 0x00, 0x03, 0x12, 0x04, 0xba, 0x02, 0x21, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2c, 0x02,
+    0x00, 0x08, 0x12, 0x04, 0xba, 0x02, 0x23, 0x74, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x2c, 0x02, 0x00,
+    0x08, 0x87, 0x09, 0x13, 0x01, 0x12, 0x04, 0xba, 0x02, 0x24, 0x4a, 0x0a, 0x11, 0x0a, 0x09, 0x04,
+    0x2c, 0x02, 0x00, 0x08, 0x87, 0x09, 0x13, 0x02, 0x12, 0x04, 0xba, 0x02, 0x4c, 0x73, 0x0a, 0x0c,
+    0x0a, 0x02, 0x04, 0x2d, 0x12, 0x06, 0xbd, 0x02, 0x00, 0xc0, 0x02, 0x01, 0x0a, 0x0b, 0x0a, 0x03,
+    0x04, 0x2d, 0x01, 0x12, 0x04, 0xbd, 0x02, 0x08, 0x14, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x2d, 0x02,
+    0x00, 0x12, 0x04, 0xbe, 0x02, 0x02, 0x16, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2d, 0x02, 0x00, 0x05,
+    0x12, 0x04, 0xbe, 0x02, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2d, 0x02, 0x00, 0x01, 0x12,
+    0x04, 0xbe, 0x02, 0x08, 0x0b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2d, 0x02, 0x00, 0x03, 0x12, 0x04,
+    0xbe, 0x02, 0x14, 0x15, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x2d, 0x02, 0x01, 0x12, 0x04, 0xbf, 0x02,
+    0x02, 0x16, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2d, 0x02, 0x01, 0x05, 0x12, 0x04, 0xbf, 0x02, 0x02,
+    0x06, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2d, 0x02, 0x01, 0x01, 0x12, 0x04, 0xbf, 0x02, 0x08, 0x11,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2d, 0x02, 0x01, 0x03, 0x12, 0x04, 0xbf, 0x02, 0x14, 0x15, 0x0a,
+    0x0c, 0x0a, 0x02, 0x04, 0x2e, 0x12, 0x06, 0xc2, 0x02, 0x00, 0xc4, 0x02, 0x01, 0x0a, 0x0b, 0x0a,
+    0x03, 0x04, 0x2e, 0x01, 0x12, 0x04, 0xc2, 0x02, 0x08, 0x18, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x2e,
+    0x02, 0x00, 0x12, 0x04, 0xc3, 0x02, 0x02, 0x21, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2e, 0x02, 0x00,
+    0x04, 0x12, 0x04, 0xc3, 0x02, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2e, 0x02, 0x00, 0x06,
+    0x12, 0x04, 0xc3, 0x02, 0x0b, 0x17, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2e, 0x02, 0x00, 0x01, 0x12,
+    0x04, 0xc3, 0x02, 0x18, 0x1c, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2e, 0x02, 0x00, 0x03, 0x12, 0x04,
+    0xc3, 0x02, 0x1f, 0x20, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x2f, 0x12, 0x06, 0xc6, 0x02, 0x00, 0xc8,
+    0x02, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x2f, 0x01, 0x12, 0x04, 0xc6, 0x02, 0x08, 0x26, 0x0a,
+    0x0c, 0x0a, 0x04, 0x04, 0x2f, 0x02, 0x00, 0x12, 0x04, 0xc7, 0x02, 0x02, 0x2b, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x2f, 0x02, 0x00, 0x06, 0x12, 0x04, 0xc7, 0x02, 0x02, 0x1e, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x2f, 0x02, 0x00, 0x01, 0x12, 0x04, 0xc7, 0x02, 0x1f, 0x26, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x2f, 0x02, 0x00, 0x03, 0x12, 0x04, 0xc7, 0x02, 0x29, 0x2a, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x30,
+    0x12, 0x06, 0xca, 0x02, 0x00, 0xcf, 0x02, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x30, 0x01, 0x12,
+    0x04, 0xca, 0x02, 0x08, 0x28, 0x0a, 0x3a, 0x0a, 0x04, 0x04, 0x30, 0x03, 0x00, 0x12, 0x04, 0xcc,
+    0x02, 0x02, 0x33, 0x1a, 0x2c, 0x20, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x3d,
+    0x3e, 0x20, 0x7b, 0x75, 0x69, 0x64, 0x3a, 0x20, 0x5b, 0x72, 0x69, 0x64, 0x31, 0x2c, 0x20, 0x72,
+    0x69, 0x64, 0x32, 0x2c, 0x20, 0x2e, 0x2e, 0x2e, 0x2c, 0x20, 0x72, 0x69, 0x64, 0x4e, 0x5d, 0x7d,
+    0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x30, 0x03, 0x00, 0x01, 0x12, 0x04, 0xcc, 0x02, 0x0a, 0x17,
+    0x0a, 0x0e, 0x0a, 0x06, 0x04, 0x30, 0x03, 0x00, 0x02, 0x00, 0x12, 0x04, 0xcc, 0x02, 0x1a, 0x31,
+    0x0a, 0x0f, 0x0a, 0x07, 0x04, 0x30, 0x03, 0x00, 0x02, 0x00, 0x04, 0x12, 0x04, 0xcc, 0x02, 0x1a,
+    0x22, 0x0a, 0x0f, 0x0a, 0x07, 0x04, 0x30, 0x03, 0x00, 0x02, 0x00, 0x05, 0x12, 0x04, 0xcc, 0x02,
+    0x23, 0x28, 0x0a, 0x0f, 0x0a, 0x07, 0x04, 0x30, 0x03, 0x00, 0x02, 0x00, 0x01, 0x12, 0x04, 0xcc,
+    0x02, 0x29, 0x2c, 0x0a, 0x0f, 0x0a, 0x07, 0x04, 0x30, 0x03, 0x00, 0x02, 0x00, 0x03, 0x12, 0x04,
+    0xcc, 0x02, 0x2f, 0x30, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x30, 0x02, 0x00, 0x12, 0x04, 0xce, 0x02,
+    0x02, 0x29, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x30, 0x02, 0x00, 0x06, 0x12, 0x04, 0xce, 0x02, 0x02,
+    0x1b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x30, 0x02, 0x00, 0x01, 0x12, 0x04, 0xce, 0x02, 0x1c, 0x24,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x30, 0x02, 0x00, 0x03, 0x12, 0x04, 0xce, 0x02, 0x27, 0x28, 0x0a,
+    0x0c, 0x0a, 0x02, 0x04, 0x31, 0x12, 0x06, 0xd1, 0x02, 0x00, 0xd4, 0x02, 0x01, 0x0a, 0x0b, 0x0a,
+    0x03, 0x04, 0x31, 0x01, 0x12, 0x04, 0xd1, 0x02, 0x08, 0x14, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x31,
+    0x02, 0x00, 0x12, 0x04, 0xd2, 0x02, 0x02, 0x17, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x31, 0x02, 0x00,
+    0x05, 0x12, 0x04, 0xd2, 0x02, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x31, 0x02, 0x00, 0x01,
+    0x12, 0x04, 0xd2, 0x02, 0x08, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x31, 0x02, 0x00, 0x03, 0x12,
+    0x04, 0xd2, 0x02, 0x15, 0x16, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x31, 0x02, 0x01, 0x12, 0x04, 0xd3,
+    0x02, 0x02, 0x17, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x31, 0x02, 0x01, 0x05, 0x12, 0x04, 0xd3, 0x02,
+    0x02, 0x06, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x31, 0x02, 0x01, 0x01, 0x12, 0x04, 0xd3, 0x02, 0x08,
+    0x0d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x31, 0x02, 0x01, 0x03, 0x12, 0x04, 0xd3, 0x02, 0x15, 0x16,
+    0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x32, 0x12, 0x06, 0xd6, 0x02, 0x00, 0xdb, 0x02, 0x01, 0x0a, 0x0b,
+    0x0a, 0x03, 0x04, 0x32, 0x01, 0x12, 0x04, 0xd6, 0x02, 0x08, 0x29, 0x0a, 0x67, 0x0a, 0x04, 0x04,
+    0x32, 0x03, 0x00, 0x12, 0x04, 0xd9, 0x02, 0x02, 0x53, 0x1a, 0x59, 0x20, 0x72, 0x65, 0x73, 0x75,
+    0x6c, 0x74, 0x73, 0x20, 0x3d, 0x3e, 0x20, 0x7b, 0x75, 0x69, 0x64, 0x3a, 0x20, 0x5b, 0x7b, 0x63,
+    0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x2c, 0x20, 0x6c, 0x69, 0x6b, 0x65, 0x64,
+    0x7d, 0x2c, 0x20, 0x7b, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x2c, 0x20,
+    0x6c, 0x69, 0x6b, 0x65, 0x64, 0x7d, 0x2c, 0x20, 0x2e, 0x2e, 0x2e, 0x2c, 0x0a, 0x20, 0x7b, 0x63,
     0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x2c, 0x20, 0x6c, 0x69, 0x6b, 0x65, 0x64,
-    0x7d, 0x2c, 0x20, 0x2e, 0x2e, 0x2e, 0x2c, 0x0a, 0x20, 0x7b, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-    0x74, 0x5f, 0x69, 0x64, 0x2c, 0x20, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x7d, 0x5d, 0x7d, 0x0a, 0x0a,
-    0x0d, 0x0a, 0x05, 0x04, 0x31, 0x03, 0x00, 0x01, 0x12, 0x04, 0xad, 0x02, 0x1f, 0x2f, 0x0a, 0x0e,
-    0x0a, 0x06, 0x04, 0x31, 0x03, 0x00, 0x02, 0x00, 0x12, 0x04, 0xad, 0x02, 0x32, 0x51, 0x0a, 0x0f,
-    0x0a, 0x07, 0x04, 0x31, 0x03, 0x00, 0x02, 0x00, 0x04, 0x12, 0x04, 0xad, 0x02, 0x32, 0x3a, 0x0a,
-    0x0f, 0x0a, 0x07, 0x04, 0x31, 0x03, 0x00, 0x02, 0x00, 0x06, 0x12, 0x04, 0xad, 0x02, 0x3b, 0x47,
-    0x0a, 0x0f, 0x0a, 0x07, 0x04, 0x31, 0x03, 0x00, 0x02, 0x00, 0x01, 0x12, 0x04, 0xad, 0x02, 0x48,
-    0x4c, 0x0a, 0x0f, 0x0a, 0x07, 0x04, 0x31, 0x03, 0x00, 0x02, 0x00, 0x03, 0x12, 0x04, 0xad, 0x02,
-    0x4f, 0x50, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x31, 0x02, 0x00, 0x12, 0x04, 0xae, 0x02, 0x02, 0x2b,
-    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x31, 0x02, 0x00, 0x06, 0x12, 0x04, 0xae, 0x02, 0x02, 0x1e, 0x0a,
-    0x0d, 0x0a, 0x05, 0x04, 0x31, 0x02, 0x00, 0x01, 0x12, 0x04, 0xae, 0x02, 0x1f, 0x26, 0x0a, 0x0d,
-    0x0a, 0x05, 0x04, 0x31, 0x02, 0x00, 0x03, 0x12, 0x04, 0xae, 0x02, 0x29, 0x2a, 0x0a, 0x0c, 0x0a,
-    0x02, 0x04, 0x32, 0x12, 0x06, 0xb1, 0x02, 0x00, 0xb3, 0x02, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04,
-    0x32, 0x01, 0x12, 0x04, 0xb1, 0x02, 0x08, 0x25, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x32, 0x02, 0x00,
-    0x12, 0x04, 0xb2, 0x02, 0x02, 0x19, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x32, 0x02, 0x00, 0x04, 0x12,
-    0x04, 0xb2, 0x02, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x32, 0x02, 0x00, 0x05, 0x12, 0x04,
-    0xb2, 0x02, 0x0b, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x32, 0x02, 0x00, 0x01, 0x12, 0x04, 0xb2,
-    0x02, 0x11, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x32, 0x02, 0x00, 0x03, 0x12, 0x04, 0xb2, 0x02,
-    0x17, 0x18, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x33, 0x12, 0x06, 0xb5, 0x02, 0x00, 0xb7, 0x02, 0x01,
-    0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x33, 0x01, 0x12, 0x04, 0xb5, 0x02, 0x08, 0x26, 0x0a, 0x0c, 0x0a,
-    0x04, 0x04, 0x33, 0x02, 0x00, 0x12, 0x04, 0xb6, 0x02, 0x02, 0x21, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
-    0x33, 0x02, 0x00, 0x06, 0x12, 0x04, 0xb6, 0x02, 0x02, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x33,
-    0x02, 0x00, 0x01, 0x12, 0x04, 0xb6, 0x02, 0x13, 0x1c, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x33, 0x02,
-    0x00, 0x03, 0x12, 0x04, 0xb6, 0x02, 0x1f, 0x20, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x34, 0x12, 0x06,
-    0xb9, 0x02, 0x00, 0xbb, 0x02, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x34, 0x01, 0x12, 0x04, 0xb9,
-    0x02, 0x08, 0x19, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x34, 0x02, 0x00, 0x12, 0x04, 0xba, 0x02, 0x02,
-    0x17, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x34, 0x02, 0x00, 0x05, 0x12, 0x04, 0xba, 0x02, 0x02, 0x07,
-    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x34, 0x02, 0x00, 0x01, 0x12, 0x04, 0xba, 0x02, 0x08, 0x12, 0x0a,
-    0x0d, 0x0a, 0x05, 0x04, 0x34, 0x02, 0x00, 0x03, 0x12, 0x04, 0xba, 0x02, 0x15, 0x16, 0x0a, 0x0c,
-    0x0a, 0x02, 0x04, 0x35, 0x12, 0x06, 0xbd, 0x02, 0x00, 0xc0, 0x02, 0x01, 0x0a, 0x0b, 0x0a, 0x03,
-    0x04, 0x35, 0x01, 0x12, 0x04, 0xbd, 0x02, 0x08, 0x1a, 0x0a, 0x5b, 0x0a, 0x04, 0x04, 0x35, 0x02,
-    0x00, 0x12, 0x04, 0xbf, 0x02, 0x02, 0x17, 0x1a, 0x4d, 0x20, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e,
-    0xe7, 0x9a, 0x84, 0xe5, 0x8f, 0xaf, 0xe8, 0x83, 0xbd, 0xe6, 0x98, 0xaf, 0xe4, 0xb8, 0xbb, 0xe8,
-    0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe4, 0xb9, 0x9f, 0xe5, 0x8f, 0xaf, 0xe8, 0x83, 0xbd, 0xe6, 0x98,
-    0xaf, 0xe5, 0xad, 0x90, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe5, 0x8f, 0x96, 0xe5, 0x86, 0xb3,
-    0xe4, 0xba, 0x8e, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xe7, 0x9a, 0x84, 0x63, 0x6f, 0x6d, 0x6d,
-    0x65, 0x6e, 0x74, 0x49, 0x64, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x35, 0x02, 0x00, 0x06, 0x12,
-    0x04, 0xbf, 0x02, 0x02, 0x0d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x35, 0x02, 0x00, 0x01, 0x12, 0x04,
-    0xbf, 0x02, 0x0e, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x35, 0x02, 0x00, 0x03, 0x12, 0x04, 0xbf,
-    0x02, 0x15, 0x16, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x36, 0x12, 0x06, 0xc2, 0x02, 0x00, 0xc4, 0x02,
-    0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x36, 0x01, 0x12, 0x04, 0xc2, 0x02, 0x08, 0x1d, 0x0a, 0x0c,
-    0x0a, 0x04, 0x04, 0x36, 0x02, 0x00, 0x12, 0x04, 0xc3, 0x02, 0x02, 0x17, 0x0a, 0x0d, 0x0a, 0x05,
-    0x04, 0x36, 0x02, 0x00, 0x05, 0x12, 0x04, 0xc3, 0x02, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
-    0x36, 0x02, 0x00, 0x01, 0x12, 0x04, 0xc3, 0x02, 0x08, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x36,
-    0x02, 0x00, 0x03, 0x12, 0x04, 0xc3, 0x02, 0x15, 0x16, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x37, 0x12,
-    0x06, 0xc6, 0x02, 0x00, 0xc8, 0x02, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x37, 0x01, 0x12, 0x04,
-    0xc6, 0x02, 0x08, 0x1e, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x37, 0x02, 0x00, 0x12, 0x04, 0xc7, 0x02,
-    0x02, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x37, 0x02, 0x00, 0x05, 0x12, 0x04, 0xc7, 0x02, 0x02,
-    0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x37, 0x02, 0x00, 0x01, 0x12, 0x04, 0xc7, 0x02, 0x08, 0x0b,
-    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x37, 0x02, 0x00, 0x03, 0x12, 0x04, 0xc7, 0x02, 0x0e, 0x0f, 0x0a,
-    0x0c, 0x0a, 0x02, 0x06, 0x00, 0x12, 0x06, 0xca, 0x02, 0x00, 0x9f, 0x03, 0x01, 0x0a, 0x0b, 0x0a,
-    0x03, 0x06, 0x00, 0x01, 0x12, 0x04, 0xca, 0x02, 0x08, 0x16, 0x0a, 0x1c, 0x0a, 0x04, 0x06, 0x00,
-    0x02, 0x00, 0x12, 0x04, 0xcc, 0x02, 0x02, 0x41, 0x1a, 0x0e, 0x20, 0xe5, 0x8f, 0x91, 0xe8, 0xa1,
-    0xa8, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00,
-    0x01, 0x12, 0x04, 0xcc, 0x02, 0x06, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x02,
-    0x12, 0x04, 0xcc, 0x02, 0x11, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x03, 0x12,
-    0x04, 0xcc, 0x02, 0x2d, 0x3f, 0x0a, 0x1c, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x01, 0x12, 0x04, 0xcf,
-    0x02, 0x02, 0x41, 0x1a, 0x0e, 0x20, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe8, 0xaf, 0x84, 0xe8,
-    0xae, 0xba, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x01, 0x12, 0x04, 0xcf, 0x02,
-    0x06, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x02, 0x12, 0x04, 0xcf, 0x02, 0x11,
-    0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x03, 0x12, 0x04, 0xcf, 0x02, 0x2d, 0x3f,
-    0x0a, 0x13, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x02, 0x12, 0x04, 0xd2, 0x02, 0x02, 0x41, 0x1a, 0x05,
-    0x20, 0xe8, 0xb5, 0x9e, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x01, 0x12, 0x04,
-    0xd2, 0x02, 0x06, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x02, 0x12, 0x04, 0xd2,
-    0x02, 0x11, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x03, 0x12, 0x04, 0xd2, 0x02,
-    0x2d, 0x3f, 0x0a, 0x13, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x03, 0x12, 0x04, 0xd5, 0x02, 0x02, 0x4a,
-    0x1a, 0x05, 0x20, 0xe8, 0xb8, 0xa9, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x03, 0x01,
-    0x12, 0x04, 0xd5, 0x02, 0x06, 0x13, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x03, 0x02, 0x12,
-    0x04, 0xd5, 0x02, 0x14, 0x28, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x03, 0x03, 0x12, 0x04,
-    0xd5, 0x02, 0x33, 0x48, 0x0a, 0x16, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x04, 0x12, 0x04, 0xd8, 0x02,
-    0x02, 0x4a, 0x1a, 0x08, 0x20, 0xe4, 0xb8, 0xbe, 0xe6, 0x8a, 0xa5, 0x0a, 0x0a, 0x0d, 0x0a, 0x05,
-    0x06, 0x00, 0x02, 0x04, 0x01, 0x12, 0x04, 0xd8, 0x02, 0x06, 0x13, 0x0a, 0x0d, 0x0a, 0x05, 0x06,
-    0x00, 0x02, 0x04, 0x02, 0x12, 0x04, 0xd8, 0x02, 0x14, 0x28, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00,
-    0x02, 0x04, 0x03, 0x12, 0x04, 0xd8, 0x02, 0x33, 0x48, 0x0a, 0x1c, 0x0a, 0x04, 0x06, 0x00, 0x02,
-    0x05, 0x12, 0x04, 0xdb, 0x02, 0x02, 0x41, 0x1a, 0x0e, 0x20, 0xe7, 0xbd, 0xae, 0xe9, 0xa1, 0xb6,
-    0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x05, 0x01,
-    0x12, 0x04, 0xdb, 0x02, 0x06, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x05, 0x02, 0x12,
-    0x04, 0xdb, 0x02, 0x11, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x05, 0x03, 0x12, 0x04,
-    0xdb, 0x02, 0x2d, 0x3f, 0x0a, 0x27, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x06, 0x12, 0x06, 0xde, 0x02,
-    0x02, 0xe0, 0x02, 0x03, 0x1a, 0x17, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe4, 0xb8, 0xbb,
-    0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x0a, 0x0a, 0x0d, 0x0a,
-    0x05, 0x06, 0x00, 0x02, 0x06, 0x01, 0x12, 0x04, 0xde, 0x02, 0x06, 0x14, 0x0a, 0x0d, 0x0a, 0x05,
-    0x06, 0x00, 0x02, 0x06, 0x02, 0x12, 0x04, 0xde, 0x02, 0x15, 0x2a, 0x0a, 0x0d, 0x0a, 0x05, 0x06,
-    0x00, 0x02, 0x06, 0x03, 0x12, 0x04, 0xde, 0x02, 0x35, 0x4b, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00,
-    0x02, 0x06, 0x04, 0x12, 0x04, 0xdf, 0x02, 0x04, 0x3f, 0x0a, 0x11, 0x0a, 0x09, 0x06, 0x00, 0x02,
-    0x06, 0x04, 0xd0, 0x86, 0x03, 0x01, 0x12, 0x04, 0xdf, 0x02, 0x04, 0x3f, 0x0a, 0x27, 0x0a, 0x04,
-    0x06, 0x00, 0x02, 0x07, 0x12, 0x06, 0xe3, 0x02, 0x02, 0xe5, 0x02, 0x03, 0x1a, 0x17, 0x20, 0xe8,
-    0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe5, 0xad, 0x90, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe4, 0xbf,
-    0xa1, 0xe6, 0x81, 0xaf, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x07, 0x01, 0x12, 0x04,
-    0xe3, 0x02, 0x06, 0x17, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x07, 0x02, 0x12, 0x04, 0xe3,
-    0x02, 0x18, 0x30, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x07, 0x03, 0x12, 0x04, 0xe3, 0x02,
-    0x3b, 0x54, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x07, 0x04, 0x12, 0x04, 0xe4, 0x02, 0x04,
-    0x3f, 0x0a, 0x11, 0x0a, 0x09, 0x06, 0x00, 0x02, 0x07, 0x04, 0xd0, 0x86, 0x03, 0x01, 0x12, 0x04,
-    0xe4, 0x02, 0x04, 0x3f, 0x0a, 0x2b, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x08, 0x12, 0x04, 0xe8, 0x02,
-    0x02, 0x5c, 0x1a, 0x1d, 0x20, 0xe5, 0x88, 0x86, 0xe9, 0xa1, 0xb5, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f,
-    0x96, 0xe5, 0xad, 0x90, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf,
-    0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x08, 0x01, 0x12, 0x04, 0xe8, 0x02, 0x06, 0x19,
-    0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x08, 0x02, 0x12, 0x04, 0xe8, 0x02, 0x1a, 0x34, 0x0a,
-    0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x08, 0x03, 0x12, 0x04, 0xe8, 0x02, 0x3f, 0x5a, 0x0a, 0x2d,
-    0x0a, 0x04, 0x06, 0x00, 0x02, 0x09, 0x12, 0x06, 0xeb, 0x02, 0x02, 0xed, 0x02, 0x03, 0x1a, 0x1d,
-    0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe4, 0xb8, 0xbb, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba,
-    0xe8, 0xaf, 0xa6, 0xe7, 0xbb, 0x86, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x0a, 0x0a, 0x0d, 0x0a,
-    0x05, 0x06, 0x00, 0x02, 0x09, 0x01, 0x12, 0x04, 0xeb, 0x02, 0x06, 0x1c, 0x0a, 0x0d, 0x0a, 0x05,
-    0x06, 0x00, 0x02, 0x09, 0x02, 0x12, 0x04, 0xeb, 0x02, 0x1d, 0x3a, 0x0a, 0x0d, 0x0a, 0x05, 0x06,
-    0x00, 0x02, 0x09, 0x03, 0x12, 0x04, 0xeb, 0x02, 0x45, 0x63, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00,
-    0x02, 0x09, 0x04, 0x12, 0x04, 0xec, 0x02, 0x04, 0x3f, 0x0a, 0x11, 0x0a, 0x09, 0x06, 0x00, 0x02,
-    0x09, 0x04, 0xd0, 0x86, 0x03, 0x01, 0x12, 0x04, 0xec, 0x02, 0x04, 0x3f, 0x0a, 0x0c, 0x0a, 0x04,
-    0x06, 0x00, 0x02, 0x0a, 0x12, 0x04, 0xef, 0x02, 0x02, 0x6b, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00,
-    0x02, 0x0a, 0x01, 0x12, 0x04, 0xef, 0x02, 0x06, 0x1e, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02,
-    0x0a, 0x02, 0x12, 0x04, 0xef, 0x02, 0x1f, 0x3e, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0a,
-    0x03, 0x12, 0x04, 0xef, 0x02, 0x49, 0x69, 0x0a, 0x24, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x0b, 0x12,
-    0x06, 0xf2, 0x02, 0x02, 0xf4, 0x02, 0x03, 0x1a, 0x14, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96,
-    0xe7, 0xbd, 0xae, 0xe9, 0xa1, 0xb6, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0d, 0x0a,
-    0x05, 0x06, 0x00, 0x02, 0x0b, 0x01, 0x12, 0x04, 0xf2, 0x02, 0x06, 0x16, 0x0a, 0x0d, 0x0a, 0x05,
-    0x06, 0x00, 0x02, 0x0b, 0x02, 0x12, 0x04, 0xf2, 0x02, 0x17, 0x2e, 0x0a, 0x0d, 0x0a, 0x05, 0x06,
-    0x00, 0x02, 0x0b, 0x03, 0x12, 0x04, 0xf2, 0x02, 0x39, 0x51, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00,
-    0x02, 0x0b, 0x04, 0x12, 0x04, 0xf3, 0x02, 0x04, 0x3f, 0x0a, 0x11, 0x0a, 0x09, 0x06, 0x00, 0x02,
-    0x0b, 0x04, 0xd0, 0x86, 0x03, 0x01, 0x12, 0x04, 0xf3, 0x02, 0x04, 0x3f, 0x0a, 0x36, 0x0a, 0x04,
-    0x06, 0x00, 0x02, 0x0c, 0x12, 0x06, 0xf7, 0x02, 0x02, 0xf9, 0x02, 0x03, 0x1a, 0x26, 0x20, 0xe8,
-    0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe6, 0x9f, 0x90, 0xe4, 0xb8, 0xaa, 0xe8, 0xa2, 0xab, 0xe8, 0xaf,
-    0x84, 0xe5, 0xaf, 0xb9, 0xe8, 0xb1, 0xa1, 0xe7, 0x9a, 0x84, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba,
-    0xe6, 0x95, 0xb0, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0c, 0x01, 0x12, 0x04, 0xf7,
-    0x02, 0x06, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0c, 0x02, 0x12, 0x04, 0xf7, 0x02,
-    0x13, 0x26, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0c, 0x03, 0x12, 0x04, 0xf7, 0x02, 0x31,
-    0x45, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0c, 0x04, 0x12, 0x04, 0xf8, 0x02, 0x04, 0x3f,
-    0x0a, 0x11, 0x0a, 0x09, 0x06, 0x00, 0x02, 0x0c, 0x04, 0xd0, 0x86, 0x03, 0x01, 0x12, 0x04, 0xf8,
-    0x02, 0x04, 0x3f, 0x0a, 0x39, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x0d, 0x12, 0x06, 0xfc, 0x02, 0x02,
-    0xfe, 0x02, 0x03, 0x1a, 0x29, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe5, 0xa4, 0x9a, 0xe4,
-    0xb8, 0xaa, 0xe8, 0xa2, 0xab, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe5, 0xaf, 0xb9, 0xe8, 0xb1,
-    0xa1, 0xe7, 0x9a, 0x84, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe6, 0x95, 0xb0, 0x0a, 0x0a, 0x0d,
-    0x0a, 0x05, 0x06, 0x00, 0x02, 0x0d, 0x01, 0x12, 0x04, 0xfc, 0x02, 0x06, 0x17, 0x0a, 0x0d, 0x0a,
-    0x05, 0x06, 0x00, 0x02, 0x0d, 0x02, 0x12, 0x04, 0xfc, 0x02, 0x18, 0x30, 0x0a, 0x0d, 0x0a, 0x05,
-    0x06, 0x00, 0x02, 0x0d, 0x03, 0x12, 0x04, 0xfc, 0x02, 0x3b, 0x54, 0x0a, 0x0d, 0x0a, 0x05, 0x06,
-    0x00, 0x02, 0x0d, 0x04, 0x12, 0x04, 0xfd, 0x02, 0x04, 0x3f, 0x0a, 0x11, 0x0a, 0x09, 0x06, 0x00,
-    0x02, 0x0d, 0x04, 0xd0, 0x86, 0x03, 0x01, 0x12, 0x04, 0xfd, 0x02, 0x04, 0x3f, 0x0a, 0x30, 0x0a,
-    0x04, 0x06, 0x00, 0x02, 0x0e, 0x12, 0x06, 0x81, 0x03, 0x02, 0x83, 0x03, 0x03, 0x1a, 0x20, 0x20,
-    0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe6, 0x9f, 0x90, 0xe6, 0x9d, 0xa1, 0xe8, 0xaf, 0x84, 0xe8,
-    0xae, 0xba, 0xe7, 0x9a, 0x84, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0xe6, 0x95, 0xb0, 0x0a, 0x0a,
-    0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0e, 0x01, 0x12, 0x04, 0x81, 0x03, 0x06, 0x19, 0x0a, 0x0d,
-    0x0a, 0x05, 0x06, 0x00, 0x02, 0x0e, 0x02, 0x12, 0x04, 0x81, 0x03, 0x1a, 0x34, 0x0a, 0x0d, 0x0a,
-    0x05, 0x06, 0x00, 0x02, 0x0e, 0x03, 0x12, 0x04, 0x81, 0x03, 0x3f, 0x5a, 0x0a, 0x0d, 0x0a, 0x05,
-    0x06, 0x00, 0x02, 0x0e, 0x04, 0x12, 0x04, 0x82, 0x03, 0x04, 0x3f, 0x0a, 0x11, 0x0a, 0x09, 0x06,
-    0x00, 0x02, 0x0e, 0x04, 0xd0, 0x86, 0x03, 0x01, 0x12, 0x04, 0x82, 0x03, 0x04, 0x3f, 0x0a, 0x30,
-    0x0a, 0x04, 0x06, 0x00, 0x02, 0x0f, 0x12, 0x06, 0x86, 0x03, 0x02, 0x88, 0x03, 0x03, 0x1a, 0x20,
+    0x7d, 0x5d, 0x7d, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x32, 0x03, 0x00, 0x01, 0x12, 0x04, 0xd9,
+    0x02, 0x1f, 0x2f, 0x0a, 0x0e, 0x0a, 0x06, 0x04, 0x32, 0x03, 0x00, 0x02, 0x00, 0x12, 0x04, 0xd9,
+    0x02, 0x32, 0x51, 0x0a, 0x0f, 0x0a, 0x07, 0x04, 0x32, 0x03, 0x00, 0x02, 0x00, 0x04, 0x12, 0x04,
+    0xd9, 0x02, 0x32, 0x3a, 0x0a, 0x0f, 0x0a, 0x07, 0x04, 0x32, 0x03, 0x00, 0x02, 0x00, 0x06, 0x12,
+    0x04, 0xd9, 0x02, 0x3b, 0x47, 0x0a, 0x0f, 0x0a, 0x07, 0x04, 0x32, 0x03, 0x00, 0x02, 0x00, 0x01,
+    0x12, 0x04, 0xd9, 0x02, 0x48, 0x4c, 0x0a, 0x0f, 0x0a, 0x07, 0x04, 0x32, 0x03, 0x00, 0x02, 0x00,
+    0x03, 0x12, 0x04, 0xd9, 0x02, 0x4f, 0x50, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x32, 0x02, 0x00, 0x12,
+    0x04, 0xda, 0x02, 0x02, 0x2b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x32, 0x02, 0x00, 0x06, 0x12, 0x04,
+    0xda, 0x02, 0x02, 0x1e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x32, 0x02, 0x00, 0x01, 0x12, 0x04, 0xda,
+    0x02, 0x1f, 0x26, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x32, 0x02, 0x00, 0x03, 0x12, 0x04, 0xda, 0x02,
+    0x29, 0x2a, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x33, 0x12, 0x06, 0xdd, 0x02, 0x00, 0xdf, 0x02, 0x01,
+    0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x33, 0x01, 0x12, 0x04, 0xdd, 0x02, 0x08, 0x25, 0x0a, 0x0c, 0x0a,
+    0x04, 0x04, 0x33, 0x02, 0x00, 0x12, 0x04, 0xde, 0x02, 0x02, 0x19, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x33, 0x02, 0x00, 0x04, 0x12, 0x04, 0xde, 0x02, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x33,
+    0x02, 0x00, 0x05, 0x12, 0x04, 0xde, 0x02, 0x0b, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x33, 0x02,
+    0x00, 0x01, 0x12, 0x04, 0xde, 0x02, 0x11, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x33, 0x02, 0x00,
+    0x03, 0x12, 0x04, 0xde, 0x02, 0x17, 0x18, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x34, 0x12, 0x06, 0xe1,
+    0x02, 0x00, 0xe3, 0x02, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x34, 0x01, 0x12, 0x04, 0xe1, 0x02,
+    0x08, 0x26, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x34, 0x02, 0x00, 0x12, 0x04, 0xe2, 0x02, 0x02, 0x21,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x34, 0x02, 0x00, 0x06, 0x12, 0x04, 0xe2, 0x02, 0x02, 0x12, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x34, 0x02, 0x00, 0x01, 0x12, 0x04, 0xe2, 0x02, 0x13, 0x1c, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x34, 0x02, 0x00, 0x03, 0x12, 0x04, 0xe2, 0x02, 0x1f, 0x20, 0x0a, 0x0c, 0x0a,
+    0x02, 0x04, 0x35, 0x12, 0x06, 0xe5, 0x02, 0x00, 0xe7, 0x02, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04,
+    0x35, 0x01, 0x12, 0x04, 0xe5, 0x02, 0x08, 0x19, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x35, 0x02, 0x00,
+    0x12, 0x04, 0xe6, 0x02, 0x02, 0x17, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x35, 0x02, 0x00, 0x05, 0x12,
+    0x04, 0xe6, 0x02, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x35, 0x02, 0x00, 0x01, 0x12, 0x04,
+    0xe6, 0x02, 0x08, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x35, 0x02, 0x00, 0x03, 0x12, 0x04, 0xe6,
+    0x02, 0x15, 0x16, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x36, 0x12, 0x06, 0xe9, 0x02, 0x00, 0xec, 0x02,
+    0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x36, 0x01, 0x12, 0x04, 0xe9, 0x02, 0x08, 0x1a, 0x0a, 0x5b,
+    0x0a, 0x04, 0x04, 0x36, 0x02, 0x00, 0x12, 0x04, 0xeb, 0x02, 0x02, 0x17, 0x1a, 0x4d, 0x20, 0xe8,
+    0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0x9a, 0x84, 0xe5, 0x8f, 0xaf, 0xe8, 0x83, 0xbd, 0xe6, 0x98,
+    0xaf, 0xe4, 0xb8, 0xbb, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe4, 0xb9, 0x9f, 0xe5, 0x8f, 0xaf,
+    0xe8, 0x83, 0xbd, 0xe6, 0x98, 0xaf, 0xe5, 0xad, 0x90, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe5,
+    0x8f, 0x96, 0xe5, 0x86, 0xb3, 0xe4, 0xba, 0x8e, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xe7, 0x9a,
+    0x84, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x36, 0x02, 0x00, 0x06, 0x12, 0x04, 0xeb, 0x02, 0x02, 0x0d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x36,
+    0x02, 0x00, 0x01, 0x12, 0x04, 0xeb, 0x02, 0x0e, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x36, 0x02,
+    0x00, 0x03, 0x12, 0x04, 0xeb, 0x02, 0x15, 0x16, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x37, 0x12, 0x06,
+    0xee, 0x02, 0x00, 0xf0, 0x02, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x37, 0x01, 0x12, 0x04, 0xee,
+    0x02, 0x08, 0x1d, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x37, 0x02, 0x00, 0x12, 0x04, 0xef, 0x02, 0x02,
+    0x17, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x37, 0x02, 0x00, 0x05, 0x12, 0x04, 0xef, 0x02, 0x02, 0x07,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x37, 0x02, 0x00, 0x01, 0x12, 0x04, 0xef, 0x02, 0x08, 0x12, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x37, 0x02, 0x00, 0x03, 0x12, 0x04, 0xef, 0x02, 0x15, 0x16, 0x0a, 0x0c,
+    0x0a, 0x02, 0x04, 0x38, 0x12, 0x06, 0xf2, 0x02, 0x00, 0xf4, 0x02, 0x01, 0x0a, 0x0b, 0x0a, 0x03,
+    0x04, 0x38, 0x01, 0x12, 0x04, 0xf2, 0x02, 0x08, 0x1e, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x38, 0x02,
+    0x00, 0x12, 0x04, 0xf3, 0x02, 0x02, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x38, 0x02, 0x00, 0x05,
+    0x12, 0x04, 0xf3, 0x02, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x38, 0x02, 0x00, 0x01, 0x12,
+    0x04, 0xf3, 0x02, 0x08, 0x0b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x38, 0x02, 0x00, 0x03, 0x12, 0x04,
+    0xf3, 0x02, 0x0e, 0x0f, 0x0a, 0x59, 0x0a, 0x02, 0x04, 0x39, 0x12, 0x06, 0xf7, 0x02, 0x00, 0xfc,
+    0x02, 0x01, 0x1a, 0x4b, 0x20, 0xe7, 0xbc, 0x96, 0xe8, 0xbe, 0x91, 0xe8, 0xaf, 0x84, 0xe8, 0xae,
+    0xba, 0x20, 0xe5, 0x8f, 0xaa, 0xe6, 0x9c, 0x89, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe4, 0xbd,
+    0x9c, 0xe8, 0x80, 0x85, 0xe5, 0x8f, 0xaf, 0xe4, 0xbb, 0xa5, 0xe5, 0x9c, 0xa8, 0xe5, 0x8f, 0x91,
+    0xe5, 0xb8, 0x83, 0xe5, 0x90, 0x8e, 0xe7, 0x9a, 0x84, 0xe4, 0xb8, 0x80, 0xe6, 0xae, 0xb5, 0xe6,
+    0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe5, 0x86, 0x85, 0xe7, 0xbc, 0x96, 0xe8, 0xbe, 0x91, 0x0a, 0x0a,
+    0x0b, 0x0a, 0x03, 0x04, 0x39, 0x01, 0x12, 0x04, 0xf7, 0x02, 0x08, 0x1a, 0x0a, 0x0c, 0x0a, 0x04,
+    0x04, 0x39, 0x02, 0x00, 0x12, 0x04, 0xf8, 0x02, 0x02, 0x4e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x39,
+    0x02, 0x00, 0x05, 0x12, 0x04, 0xf8, 0x02, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x39, 0x02,
+    0x00, 0x01, 0x12, 0x04, 0xf8, 0x02, 0x1b, 0x25, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x39, 0x02, 0x00,
+    0x03, 0x12, 0x04, 0xf8, 0x02, 0x28, 0x29, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x39, 0x02, 0x00, 0x08,
+    0x12, 0x04, 0xf8, 0x02, 0x2a, 0x4d, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x39, 0x02, 0x00, 0x08, 0x87,
+    0x09, 0x04, 0x04, 0x12, 0x04, 0xf8, 0x02, 0x2b, 0x4c, 0x0a, 0x22, 0x0a, 0x04, 0x04, 0x39, 0x02,
+    0x01, 0x12, 0x04, 0xf9, 0x02, 0x02, 0x2a, 0x22, 0x14, 0x20, 0xe6, 0x96, 0xb0, 0xe7, 0x9a, 0x84,
+    0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe5, 0x86, 0x85, 0xe5, 0xae, 0xb9, 0x0a, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x39, 0x02, 0x01, 0x05, 0x12, 0x04, 0xf9, 0x02, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x39, 0x02, 0x01, 0x01, 0x12, 0x04, 0xf9, 0x02, 0x1b, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x39, 0x02, 0x01, 0x03, 0x12, 0x04, 0xf9, 0x02, 0x28, 0x29, 0x0a, 0x41, 0x0a, 0x04, 0x04, 0x39,
+    0x02, 0x02, 0x12, 0x04, 0xfa, 0x02, 0x02, 0x2a, 0x22, 0x33, 0x20, 0xe6, 0x96, 0xb0, 0xe7, 0x9a,
+    0x84, 0xe5, 0x9b, 0xbe, 0xe7, 0x89, 0x87, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0x20, 0xe4, 0xb8,
+    0xba, 0xe7, 0xa9, 0xba, 0xe6, 0x97, 0xb6, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe5, 0x8f, 0x98,
+    0xe4, 0xb8, 0xba, 0xe7, 0xba, 0xaf, 0xe6, 0x96, 0x87, 0xe6, 0x9c, 0xac, 0x0a, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x39, 0x02, 0x02, 0x04, 0x12, 0x04, 0xfa, 0x02, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x39, 0x02, 0x02, 0x06, 0x12, 0x04, 0xfa, 0x02, 0x0b, 0x1a, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x39, 0x02, 0x02, 0x01, 0x12, 0x04, 0xfa, 0x02, 0x1b, 0x21, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x39,
+    0x02, 0x02, 0x03, 0x12, 0x04, 0xfa, 0x02, 0x28, 0x29, 0x0a, 0x23, 0x0a, 0x04, 0x04, 0x39, 0x02,
+    0x03, 0x12, 0x04, 0xfb, 0x02, 0x02, 0x2a, 0x22, 0x15, 0x20, 0xe6, 0x96, 0xb0, 0xe7, 0x9a, 0x84,
+    0x40, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x0a, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x39, 0x02, 0x03, 0x04, 0x12, 0x04, 0xfb, 0x02, 0x02, 0x0a, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x39, 0x02, 0x03, 0x06, 0x12, 0x04, 0xfb, 0x02, 0x0b, 0x18, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x39, 0x02, 0x03, 0x01, 0x12, 0x04, 0xfb, 0x02, 0x1b, 0x23, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x39, 0x02, 0x03, 0x03, 0x12, 0x04, 0xfb, 0x02, 0x28, 0x29, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x3a,
+    0x12, 0x06, 0xfe, 0x02, 0x00, 0x83, 0x03, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x3a, 0x01, 0x12,
+    0x04, 0xfe, 0x02, 0x08, 0x1b, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x3a, 0x02, 0x00, 0x12, 0x04, 0xff,
+    0x02, 0x02, 0x2d, 0x22, 0x0e, 0x20, 0xe7, 0xbc, 0x96, 0xe8, 0xbe, 0x91, 0xe6, 0x97, 0xb6, 0xe9,
+    0x97, 0xb4, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3a, 0x02, 0x00, 0x05, 0x12, 0x04, 0xff, 0x02,
+    0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3a, 0x02, 0x00, 0x01, 0x12, 0x04, 0xff, 0x02, 0x19,
+    0x1e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3a, 0x02, 0x00, 0x03, 0x12, 0x04, 0xff, 0x02, 0x2b, 0x2c,
+    0x0a, 0x2c, 0x0a, 0x04, 0x04, 0x3a, 0x02, 0x01, 0x12, 0x04, 0x80, 0x03, 0x02, 0x2e, 0x22, 0x1e,
+    0x20, 0xe6, 0x9c, 0xac, 0xe6, 0xac, 0xa1, 0xe7, 0xbc, 0x96, 0xe8, 0xbe, 0x91, 0xe6, 0x96, 0xb0,
+    0xe5, 0xa2, 0x9e, 0xe7, 0x9a, 0x84, 0x40, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x0a, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x3a, 0x02, 0x01, 0x04, 0x12, 0x04, 0x80, 0x03, 0x02, 0x0a, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x3a, 0x02, 0x01, 0x06, 0x12, 0x04, 0x80, 0x03, 0x0b, 0x18, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x3a, 0x02, 0x01, 0x01, 0x12, 0x04, 0x80, 0x03, 0x19, 0x27, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x3a, 0x02, 0x01, 0x03, 0x12, 0x04, 0x80, 0x03, 0x2c, 0x2d, 0x0a, 0x2c, 0x0a, 0x04, 0x04, 0x3a,
+    0x02, 0x02, 0x12, 0x04, 0x81, 0x03, 0x02, 0x2e, 0x22, 0x1e, 0x20, 0xe6, 0x9c, 0xac, 0xe6, 0xac,
+    0xa1, 0xe7, 0xbc, 0x96, 0xe8, 0xbe, 0x91, 0xe7, 0xa7, 0xbb, 0xe9, 0x99, 0xa4, 0xe7, 0x9a, 0x84,
+    0x40, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3a, 0x02, 0x02,
+    0x04, 0x12, 0x04, 0x81, 0x03, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3a, 0x02, 0x02, 0x06,
+    0x12, 0x04, 0x81, 0x03, 0x0b, 0x18, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3a, 0x02, 0x02, 0x01, 0x12,
+    0x04, 0x81, 0x03, 0x19, 0x29, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3a, 0x02, 0x02, 0x03, 0x12, 0x04,
+    0x81, 0x03, 0x2c, 0x2d, 0x0a, 0x4a, 0x0a, 0x04, 0x04, 0x3a, 0x02, 0x03, 0x12, 0x04, 0x82, 0x03,
+    0x02, 0x2e, 0x22, 0x3c, 0x20, 0xe7, 0xbc, 0x96, 0xe8, 0xbe, 0x91, 0xe5, 0x90, 0x8e, 0xe5, 0x91,
+    0xbd, 0xe4, 0xb8, 0xad, 0xe4, 0xbd, 0x9c, 0xe8, 0x80, 0x85, 0xe8, 0xae, 0xbe, 0xe7, 0xbd, 0xae,
+    0xe7, 0x9a, 0x84, 0xe5, 0xb1, 0x8f, 0xe8, 0x94, 0xbd, 0xe8, 0xaf, 0x8d, 0x20, 0xe7, 0xad, 0x89,
+    0xe5, 0xbe, 0x85, 0xe4, 0xbd, 0x9c, 0xe8, 0x80, 0x85, 0xe5, 0xae, 0xa1, 0xe6, 0xa0, 0xb8, 0x0a,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3a, 0x02, 0x03, 0x05, 0x12, 0x04, 0x82, 0x03, 0x02, 0x06, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x3a, 0x02, 0x03, 0x01, 0x12, 0x04, 0x82, 0x03, 0x19, 0x1d, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x3a, 0x02, 0x03, 0x03, 0x12, 0x04, 0x82, 0x03, 0x2c, 0x2d, 0x0a, 0x2b, 0x0a,
+    0x02, 0x04, 0x3b, 0x12, 0x06, 0x86, 0x03, 0x00, 0x8c, 0x03, 0x01, 0x1a, 0x1d, 0x20, 0xe8, 0xaf,
+    0x84, 0xe8, 0xae, 0xba, 0xe7, 0x9a, 0x84, 0xe4, 0xb8, 0x80, 0xe4, 0xb8, 0xaa, 0xe5, 0x8e, 0x86,
+    0xe5, 0x8f, 0xb2, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x0a, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x3b,
+    0x01, 0x12, 0x04, 0x86, 0x03, 0x08, 0x1a, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x3b, 0x02, 0x00, 0x12,
+    0x04, 0x87, 0x03, 0x02, 0x29, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3b, 0x02, 0x00, 0x06, 0x12, 0x04,
+    0x87, 0x03, 0x02, 0x0d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3b, 0x02, 0x00, 0x01, 0x12, 0x04, 0x87,
+    0x03, 0x1c, 0x20, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3b, 0x02, 0x00, 0x03, 0x12, 0x04, 0x87, 0x03,
+    0x27, 0x28, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x3b, 0x02, 0x01, 0x12, 0x04, 0x88, 0x03, 0x02, 0x29,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3b, 0x02, 0x01, 0x05, 0x12, 0x04, 0x88, 0x03, 0x02, 0x08, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x3b, 0x02, 0x01, 0x01, 0x12, 0x04, 0x88, 0x03, 0x1c, 0x23, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x3b, 0x02, 0x01, 0x03, 0x12, 0x04, 0x88, 0x03, 0x27, 0x28, 0x0a, 0x0c, 0x0a,
+    0x04, 0x04, 0x3b, 0x02, 0x02, 0x12, 0x04, 0x89, 0x03, 0x02, 0x29, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x3b, 0x02, 0x02, 0x04, 0x12, 0x04, 0x89, 0x03, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3b,
+    0x02, 0x02, 0x06, 0x12, 0x04, 0x89, 0x03, 0x0b, 0x1b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3b, 0x02,
+    0x02, 0x01, 0x12, 0x04, 0x89, 0x03, 0x1c, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3b, 0x02, 0x02,
+    0x03, 0x12, 0x04, 0x89, 0x03, 0x27, 0x28, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x3b, 0x02, 0x03, 0x12,
+    0x04, 0x8a, 0x03, 0x02, 0x29, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3b, 0x02, 0x03, 0x04, 0x12, 0x04,
+    0x8a, 0x03, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3b, 0x02, 0x03, 0x06, 0x12, 0x04, 0x8a,
+    0x03, 0x0b, 0x18, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3b, 0x02, 0x03, 0x01, 0x12, 0x04, 0x8a, 0x03,
+    0x1c, 0x24, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3b, 0x02, 0x03, 0x03, 0x12, 0x04, 0x8a, 0x03, 0x27,
+    0x28, 0x0a, 0x2b, 0x0a, 0x04, 0x04, 0x3b, 0x02, 0x04, 0x12, 0x04, 0x8b, 0x03, 0x02, 0x29, 0x22,
+    0x1d, 0x20, 0xe8, 0xaf, 0xa5, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe8, 0xa2, 0xab, 0xe6, 0x9b,
+    0xbf, 0xe6, 0x8d, 0xa2, 0xe7, 0x9a, 0x84, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x0a, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x3b, 0x02, 0x04, 0x05, 0x12, 0x04, 0x8b, 0x03, 0x02, 0x07, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x3b, 0x02, 0x04, 0x01, 0x12, 0x04, 0x8b, 0x03, 0x1c, 0x21, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x3b, 0x02, 0x04, 0x03, 0x12, 0x04, 0x8b, 0x03, 0x27, 0x28, 0x0a, 0x0c, 0x0a, 0x02, 0x04,
+    0x3c, 0x12, 0x06, 0x8e, 0x03, 0x00, 0x90, 0x03, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x3c, 0x01,
+    0x12, 0x04, 0x8e, 0x03, 0x08, 0x24, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x3c, 0x02, 0x00, 0x12, 0x04,
+    0x8f, 0x03, 0x02, 0x3b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3c, 0x02, 0x00, 0x05, 0x12, 0x04, 0x8f,
+    0x03, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3c, 0x02, 0x00, 0x01, 0x12, 0x04, 0x8f, 0x03,
+    0x08, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3c, 0x02, 0x00, 0x03, 0x12, 0x04, 0x8f, 0x03, 0x15,
+    0x16, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3c, 0x02, 0x00, 0x08, 0x12, 0x04, 0x8f, 0x03, 0x17, 0x3a,
+    0x0a, 0x11, 0x0a, 0x09, 0x04, 0x3c, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x04, 0x8f,
+    0x03, 0x18, 0x39, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x3d, 0x12, 0x06, 0x92, 0x03, 0x00, 0x94, 0x03,
+    0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x3d, 0x01, 0x12, 0x04, 0x92, 0x03, 0x08, 0x25, 0x0a, 0x34,
+    0x0a, 0x04, 0x04, 0x3d, 0x02, 0x00, 0x12, 0x04, 0x93, 0x03, 0x02, 0x2b, 0x22, 0x26, 0x20, 0xe6,
+    0x8c, 0x89, 0xe7, 0x85, 0xa7, 0xe7, 0xbc, 0x96, 0xe8, 0xbe, 0x91, 0xe6, 0x97, 0xb6, 0xe9, 0x97,
+    0xb4, 0xe4, 0xbb, 0x8e, 0xe6, 0x96, 0xb0, 0xe5, 0x88, 0xb0, 0xe6, 0x97, 0xa7, 0xe6, 0x8e, 0x92,
+    0xe5, 0x88, 0x97, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3d, 0x02, 0x00, 0x04, 0x12, 0x04, 0x93,
+    0x03, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3d, 0x02, 0x00, 0x06, 0x12, 0x04, 0x93, 0x03,
+    0x0b, 0x1d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3d, 0x02, 0x00, 0x01, 0x12, 0x04, 0x93, 0x03, 0x1e,
+    0x26, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3d, 0x02, 0x00, 0x03, 0x12, 0x04, 0x93, 0x03, 0x29, 0x2a,
+    0x0a, 0x0c, 0x0a, 0x02, 0x06, 0x00, 0x12, 0x06, 0x96, 0x03, 0x00, 0xf1, 0x03, 0x01, 0x0a, 0x0b,
+    0x0a, 0x03, 0x06, 0x00, 0x01, 0x12, 0x04, 0x96, 0x03, 0x08, 0x16, 0x0a, 0x1c, 0x0a, 0x04, 0x06,
+    0x00, 0x02, 0x00, 0x12, 0x04, 0x98, 0x03, 0x02, 0x41, 0x1a, 0x0e, 0x20, 0xe5, 0x8f, 0x91, 0xe8,
+    0xa1, 0xa8, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02,
+    0x00, 0x01, 0x12, 0x04, 0x98, 0x03, 0x06, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00,
+    0x02, 0x12, 0x04, 0x98, 0x03, 0x11, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x03,
+    0x12, 0x04, 0x98, 0x03, 0x2d, 0x3f, 0x0a, 0x1c, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x01, 0x12, 0x04,
+    0x9b, 0x03, 0x02, 0x41, 0x1a, 0x0e, 0x20, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe8, 0xaf, 0x84,
+    0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x01, 0x12, 0x04, 0x9b,
+    0x03, 0x06, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x02, 0x12, 0x04, 0x9b, 0x03,
+    0x11, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x03, 0x12, 0x04, 0x9b, 0x03, 0x2d,
+    0x3f, 0x0a, 0x13, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x02, 0x12, 0x04, 0x9e, 0x03, 0x02, 0x41, 0x1a,
+    0x05, 0x20, 0xe8, 0xb5, 0x9e, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x01, 0x12,
+    0x04, 0x9e, 0x03, 0x06, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x02, 0x12, 0x04,
+    0x9e, 0x03, 0x11, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x03, 0x12, 0x04, 0x9e,
+    0x03, 0x2d, 0x3f, 0x0a, 0x13, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x03, 0x12, 0x04, 0xa1, 0x03, 0x02,
+    0x4a, 0x1a, 0x05, 0x20, 0xe8, 0xb8, 0xa9, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x03,
+    0x01, 0x12, 0x04, 0xa1, 0x03, 0x06, 0x13, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x03, 0x02,
+    0x12, 0x04, 0xa1, 0x03, 0x14, 0x28, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x03, 0x03, 0x12,
+    0x04, 0xa1, 0x03, 0x33, 0x48, 0x0a, 0x16, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x04, 0x12, 0x04, 0xa4,
+    0x03, 0x02, 0x4a, 0x1a, 0x08, 0x20, 0xe4, 0xb8, 0xbe, 0xe6, 0x8a, 0xa5, 0x0a, 0x0a, 0x0d, 0x0a,
+    0x05, 0x06, 0x00, 0x02, 0x04, 0x01, 0x12, 0x04, 0xa4, 0x03, 0x06, 0x13, 0x0a, 0x0d, 0x0a, 0x05,
+    0x06, 0x00, 0x02, 0x04, 0x02, 0x12, 0x04, 0xa4, 0x03, 0x14, 0x28, 0x0a, 0x0d, 0x0a, 0x05, 0x06,
+    0x00, 0x02, 0x04, 0x03, 0x12, 0x04, 0xa4, 0x03, 0x33, 0x48, 0x0a, 0x1c, 0x0a, 0x04, 0x06, 0x00,
+    0x02, 0x05, 0x12, 0x04, 0xa7, 0x03, 0x02, 0x41, 0x1a, 0x0e, 0x20, 0xe7, 0xbd, 0xae, 0xe9, 0xa1,
+    0xb6, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x05,
+    0x01, 0x12, 0x04, 0xa7, 0x03, 0x06, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x05, 0x02,
+    0x12, 0x04, 0xa7, 0x03, 0x11, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x05, 0x03, 0x12,
+    0x04, 0xa7, 0x03, 0x2d, 0x3f, 0x0a, 0x27, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x06, 0x12, 0x06, 0xaa,
+    0x03, 0x02, 0xac, 0x03, 0x03, 0x1a, 0x17, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe4, 0xb8,
+    0xbb, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x0a, 0x0a, 0x0d,
+    0x0a, 0x05, 0x06, 0x00, 0x02, 0x06, 0x01, 0x12, 0x04, 0xaa, 0x03, 0x06, 0x14, 0x0a, 0x0d, 0x0a,
+    0x05, 0x06, 0x00, 0x02, 0x06, 0x02, 0x12, 0x04, 0xaa, 0x03, 0x15, 0x2a, 0x0a, 0x0d, 0x0a, 0x05,
+    0x06, 0x00, 0x02, 0x06, 0x03, 0x12, 0x04, 0xaa, 0x03, 0x35, 0x4b, 0x0a, 0x0d, 0x0a, 0x05, 0x06,
+    0x00, 0x02, 0x06, 0x04, 0x12, 0x04, 0xab, 0x03, 0x04, 0x3f, 0x0a, 0x11, 0x0a, 0x09, 0x06, 0x00,
+    0x02, 0x06, 0x04, 0xd0, 0x86, 0x03, 0x01, 0x12, 0x04, 0xab, 0x03, 0x04, 0x3f, 0x0a, 0x27, 0x0a,
+    0x04, 0x06, 0x00, 0x02, 0x07, 0x12, 0x06, 0xaf, 0x03, 0x02, 0xb1, 0x03, 0x03, 0x1a, 0x17, 0x20,
+    0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe5, 0xad, 0x90, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe4,
+    0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x07, 0x01, 0x12,
+    0x04, 0xaf, 0x03, 0x06, 0x17, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x07, 0x02, 0x12, 0x04,
+    0xaf, 0x03, 0x18, 0x30, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x07, 0x03, 0x12, 0x04, 0xaf,
+    0x03, 0x3b, 0x54, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x07, 0x04, 0x12, 0x04, 0xb0, 0x03,
+    0x04, 0x3f, 0x0a, 0x11, 0x0a, 0x09, 0x06, 0x00, 0x02, 0x07, 0x04, 0xd0, 0x86, 0x03, 0x01, 0x12,
+    0x04, 0xb0, 0x03, 0x04, 0x3f, 0x0a, 0x2b, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x08, 0x12, 0x04, 0xb4,
+    0x03, 0x02, 0x5c, 0x1a, 0x1d, 0x20, 0xe5, 0x88, 0x86, 0xe9, 0xa1, 0xb5, 0xe8, 0x8e, 0xb7, 0xe5,
+    0x8f, 0x96, 0xe5, 0xad, 0x90, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe4, 0xbf, 0xa1, 0xe6, 0x81,
+    0xaf, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x08, 0x01, 0x12, 0x04, 0xb4, 0x03, 0x06,
+    0x19, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x08, 0x02, 0x12, 0x04, 0xb4, 0x03, 0x1a, 0x34,
+    0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x08, 0x03, 0x12, 0x04, 0xb4, 0x03, 0x3f, 0x5a, 0x0a,
+    0x2d, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x09, 0x12, 0x06, 0xb7, 0x03, 0x02, 0xb9, 0x03, 0x03, 0x1a,
+    0x1d, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe4, 0xb8, 0xbb, 0xe8, 0xaf, 0x84, 0xe8, 0xae,
+    0xba, 0xe8, 0xaf, 0xa6, 0xe7, 0xbb, 0x86, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x0a, 0x0a, 0x0d,
+    0x0a, 0x05, 0x06, 0x00, 0x02, 0x09, 0x01, 0x12, 0x04, 0xb7, 0x03, 0x06, 0x1c, 0x0a, 0x0d, 0x0a,
+    0x05, 0x06, 0x00, 0x02, 0x09, 0x02, 0x12, 0x04, 0xb7, 0x03, 0x1d, 0x3a, 0x0a, 0x0d, 0x0a, 0x05,
+    0x06, 0x00, 0x02, 0x09, 0x03, 0x12, 0x04, 0xb7, 0x03, 0x45, 0x63, 0x0a, 0x0d, 0x0a, 0x05, 0x06,
+    0x00, 0x02, 0x09, 0x04, 0x12, 0x04, 0xb8, 0x03, 0x04, 0x3f, 0x0a, 0x11, 0x0a, 0x09, 0x06, 0x00,
+    0x02, 0x09, 0x04, 0xd0, 0x86, 0x03, 0x01, 0x12, 0x04, 0xb8, 0x03, 0x04, 0x3f, 0x0a, 0x0c, 0x0a,
+    0x04, 0x06, 0x00, 0x02, 0x0a, 0x12, 0x04, 0xbb, 0x03, 0x02, 0x6b, 0x0a, 0x0d, 0x0a, 0x05, 0x06,
+    0x00, 0x02, 0x0a, 0x01, 0x12, 0x04, 0xbb, 0x03, 0x06, 0x1e, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00,
+    0x02, 0x0a, 0x02, 0x12, 0x04, 0xbb, 0x03, 0x1f, 0x3e, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02,
+    0x0a, 0x03, 0x12, 0x04, 0xbb, 0x03, 0x49, 0x69, 0x0a, 0x24, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x0b,
+    0x12, 0x06, 0xbe, 0x03, 0x02, 0xc0, 0x03, 0x03, 0x1a, 0x14, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f,
+    0x96, 0xe7, 0xbd, 0xae, 0xe9, 0xa1, 0xb6, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0d,
+    0x0a, 0x05, 0x06, 0x00, 0x02, 0x0b, 0x01, 0x12, 0x04, 0xbe, 0x03, 0x06, 0x16, 0x0a, 0x0d, 0x0a,
+    0x05, 0x06, 0x00, 0x02, 0x0b, 0x02, 0x12, 0x04, 0xbe, 0x03, 0x17, 0x2e, 0x0a, 0x0d, 0x0a, 0x05,
+    0x06, 0x00, 0x02, 0x0b, 0x03, 0x12, 0x04, 0xbe, 0x03, 0x39, 0x51, 0x0a, 0x0d, 0x0a, 0x05, 0x06,
+    0x00, 0x02, 0x0b, 0x04, 0x12, 0x04, 0xbf, 0x03, 0x04, 0x3f, 0x0a, 0x11, 0x0a, 0x09, 0x06, 0x00,
+    0x02, 0x0b, 0x04, 0xd0, 0x86, 0x03, 0x01, 0x12, 0x04, 0xbf, 0x03, 0x04, 0x3f, 0x0a, 0x36, 0x0a,
+    0x04, 0x06, 0x00, 0x02, 0x0c, 0x12, 0x06, 0xc3, 0x03, 0x02, 0xc5, 0x03, 0x03, 0x1a, 0x26, 0x20,
+    0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe6, 0x9f, 0x90, 0xe4, 0xb8, 0xaa, 0xe8, 0xa2, 0xab, 0xe8,
+    0xaf, 0x84, 0xe5, 0xaf, 0xb9, 0xe8, 0xb1, 0xa1, 0xe7, 0x9a, 0x84, 0xe8, 0xaf, 0x84, 0xe8, 0xae,
+    0xba, 0xe6, 0x95, 0xb0, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0c, 0x01, 0x12, 0x04,
+    0xc3, 0x03, 0x06, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0c, 0x02, 0x12, 0x04, 0xc3,
+    0x03, 0x13, 0x26, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0c, 0x03, 0x12, 0x04, 0xc3, 0x03,
+    0x31, 0x45, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0c, 0x04, 0x12, 0x04, 0xc4, 0x03, 0x04,
+    0x3f, 0x0a, 0x11, 0x0a, 0x09, 0x06, 0x00, 0x02, 0x0c, 0x04, 0xd0, 0x86, 0x03, 0x01, 0x12, 0x04,
+    0xc4, 0x03, 0x04, 0x3f, 0x0a, 0x39, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x0d, 0x12, 0x06, 0xc8, 0x03,
+    0x02, 0xca, 0x03, 0x03, 0x1a, 0x29, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe5, 0xa4, 0x9a,
+    0xe4, 0xb8, 0xaa, 0xe8, 0xa2, 0xab, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe5, 0xaf, 0xb9, 0xe8,
+    0xb1, 0xa1, 0xe7, 0x9a, 0x84, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe6, 0x95, 0xb0, 0x0a, 0x0a,
+    0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0d, 0x01, 0x12, 0x04, 0xc8, 0x03, 0x06, 0x17, 0x0a, 0x0d,
+    0x0a, 0x05, 0x06, 0x00, 0x02, 0x0d, 0x02, 0x12, 0x04, 0xc8, 0x03, 0x18, 0x30, 0x0a, 0x0d, 0x0a,
+    0x05, 0x06, 0x00, 0x02, 0x0d, 0x03, 0x12, 0x04, 0xc8, 0x03, 0x3b, 0x54, 0x0a, 0x0d, 0x0a, 0x05,
+    0x06, 0x00, 0x02, 0x0d, 0x04, 0x12, 0x04, 0xc9, 0x03, 0x04, 0x3f, 0x0a, 0x11, 0x0a, 0x09, 0x06,
+    0x00, 0x02, 0x0d, 0x04, 0xd0, 0x86, 0x03, 0x01, 0x12, 0x04, 0xc9, 0x03, 0x04, 0x3f, 0x0a, 0x30,
+    0x0a, 0x04, 0x06, 0x00, 0x02, 0x0e, 0x12, 0x06, 0xcd, 0x03, 0x02, 0xcf, 0x03, 0x03, 0x1a, 0x20,
     0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe6, 0x9f, 0x90, 0xe6, 0x9d, 0xa1, 0xe8, 0xaf, 0x84,
-    0xe8, 0xae, 0xba, 0xe7, 0x9a, 0x84, 0xe7, 0x82, 0xb9, 0xe8, 0xb8, 0xa9, 0xe6, 0x95, 0xb0, 0x0a,
-    0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0f, 0x01, 0x12, 0x04, 0x86, 0x03, 0x06, 0x1c, 0x0a,
-    0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0f, 0x02, 0x12, 0x04, 0x86, 0x03, 0x1d, 0x3a, 0x0a, 0x0d,
-    0x0a, 0x05, 0x06, 0x00, 0x02, 0x0f, 0x03, 0x12, 0x04, 0x86, 0x03, 0x45, 0x63, 0x0a, 0x0d, 0x0a,
-    0x05, 0x06, 0x00, 0x02, 0x0f, 0x04, 0x12, 0x04, 0x87, 0x03, 0x04, 0x3f, 0x0a, 0x11, 0x0a, 0x09,
-    0x06, 0x00, 0x02, 0x0f, 0x04, 0xd0, 0x86, 0x03, 0x01, 0x12, 0x04, 0x87, 0x03, 0x04, 0x3f, 0x0a,
-    0x3f, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x10, 0x12, 0x06, 0x8b, 0x03, 0x02, 0x8d, 0x03, 0x03, 0x1a,
-    0x2f, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe6, 0x9f, 0x90, 0xe4, 0xb8, 0xaa, 0xe7, 0x94,
-    0xa8, 0xe6, 0x88, 0xb7, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba,
-    0xe4, 0xba, 0x86, 0xe6, 0x9f, 0x90, 0xe4, 0xb8, 0xaa, 0xe5, 0xaf, 0xb9, 0xe8, 0xb1, 0xa1, 0x0a,
-    0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x10, 0x01, 0x12, 0x04, 0x8b, 0x03, 0x06, 0x17, 0x0a,
-    0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x10, 0x02, 0x12, 0x04, 0x8b, 0x03, 0x18, 0x30, 0x0a, 0x0d,
-    0x0a, 0x05, 0x06, 0x00, 0x02, 0x10, 0x03, 0x12, 0x04, 0x8b, 0x03, 0x3b, 0x54, 0x0a, 0x0d, 0x0a,
-    0x05, 0x06, 0x00, 0x02, 0x10, 0x04, 0x12, 0x04, 0x8c, 0x03, 0x04, 0x3f, 0x0a, 0x11, 0x0a, 0x09,
-    0x06, 0x00, 0x02, 0x10, 0x04, 0xd0, 0x86, 0x03, 0x01, 0x12, 0x04, 0x8c, 0x03, 0x04, 0x3f, 0x0a,
-    0x45, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x11, 0x12, 0x06, 0x90, 0x03, 0x02, 0x92, 0x03, 0x03, 0x1a,
-    0x35, 0x20, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe6, 0xa3, 0x80, 0xe6, 0x9f, 0xa5, 0xe6, 0x9f,
-    0x90, 0xe4, 0xb8, 0xaa, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6,
-    0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe4, 0xba, 0x86, 0xe6, 0x9f, 0x90, 0xe4, 0xb8, 0xaa, 0xe5,
-    0xaf, 0xb9, 0xe8, 0xb1, 0xa1, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x11, 0x01, 0x12,
-    0x04, 0x90, 0x03, 0x06, 0x1c, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x11, 0x02, 0x12, 0x04,
-    0x90, 0x03, 0x1d, 0x3a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x11, 0x03, 0x12, 0x04, 0x90,
-    0x03, 0x45, 0x63, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x11, 0x04, 0x12, 0x04, 0x91, 0x03,
-    0x04, 0x3f, 0x0a, 0x11, 0x0a, 0x09, 0x06, 0x00, 0x02, 0x11, 0x04, 0xd0, 0x86, 0x03, 0x01, 0x12,
-    0x04, 0x91, 0x03, 0x04, 0x3f, 0x0a, 0x43, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x12, 0x12, 0x04, 0x95,
-    0x03, 0x02, 0x6e, 0x1a, 0x35, 0x20, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe6, 0xa3, 0x80, 0xe6,
-    0x9f, 0xa5, 0xe6, 0x9f, 0x90, 0xe4, 0xb8, 0xaa, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe6, 0x98,
-    0xaf, 0xe5, 0x90, 0xa6, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0xe4, 0xba, 0x86, 0xe6, 0x9f, 0x90,
-    0xe4, 0xba, 0x9b, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00,
-    0x02, 0x12, 0x01, 0x12, 0x04, 0x95, 0x03, 0x06, 0x1f, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02,
-    0x12, 0x02, 0x12, 0x04, 0x95, 0x03, 0x20, 0x40, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x12,
-    0x03, 0x12, 0x04, 0x95, 0x03, 0x4b, 0x6c, 0x0a, 0x2e, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x13, 0x12,
-    0x04, 0x98, 0x03, 0x02, 0x65, 0x1a, 0x20, 0x20, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe6, 0xa3,
-    0x80, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6,
-    0xe5, 0xad, 0x98, 0xe5, 0x9c, 0xa8, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x13, 0x01,
-    0x12, 0x04, 0x98, 0x03, 0x06, 0x1c, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x13, 0x02, 0x12,
-    0x04, 0x98, 0x03, 0x1d, 0x3a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x13, 0x03, 0x12, 0x04,
-    0x98, 0x03, 0x45, 0x63, 0x0a, 0x24, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x14, 0x12, 0x04, 0x9b, 0x03,
-    0x02, 0x41, 0x1a, 0x16, 0x20, 0xe6, 0x8c, 0x89, 0xe7, 0x85, 0xa7, 0x69, 0x64, 0xe8, 0x8e, 0xb7,
-    0xe5, 0x8f, 0x96, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00,
-    0x02, 0x14, 0x01, 0x12, 0x04, 0x9b, 0x03, 0x06, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02,
-    0x14, 0x02, 0x12, 0x04, 0x9b, 0x03, 0x11, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x14,
-    0x03, 0x12, 0x04, 0x9b, 0x03, 0x2d, 0x3f, 0x0a, 0x22, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x15, 0x12,
-    0x04, 0x9e, 0x03, 0x02, 0x4d, 0x1a, 0x14, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe8, 0xaf,
-    0x84, 0xe8, 0xae, 0xba, 0xe4, 0xbd, 0x9c, 0xe8, 0x80, 0x85, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06,
-    0x00, 0x02, 0x15, 0x01, 0x12, 0x04, 0x9e, 0x03, 0x06, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00,
-    0x02, 0x15, 0x02, 0x12, 0x04, 0x9e, 0x03, 0x15, 0x2a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02,
-    0x15, 0x03, 0x12, 0x04, 0x9e, 0x03, 0x35, 0x4b, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+    0xe8, 0xae, 0xba, 0xe7, 0x9a, 0x84, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0xe6, 0x95, 0xb0, 0x0a,
+    0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0e, 0x01, 0x12, 0x04, 0xcd, 0x03, 0x06, 0x19, 0x0a,
+    0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0e, 0x02, 0x12, 0x04, 0xcd, 0x03, 0x1a, 0x34, 0x0a, 0x0d,
+    0x0a, 0x05, 0x06, 0x00, 0x02, 0x0e, 0x03, 0x12, 0x04, 0xcd, 0x03, 0x3f, 0x5a, 0x0a, 0x0d, 0x0a,
+    0x05, 0x06, 0x00, 0x02, 0x0e, 0x04, 0x12, 0x04, 0xce, 0x03, 0x04, 0x3f, 0x0a, 0x11, 0x0a, 0x09,
+    0x06, 0x00, 0x02, 0x0e, 0x04, 0xd0, 0x86, 0x03, 0x01, 0x12, 0x04, 0xce, 0x03, 0x04, 0x3f, 0x0a,
+    0x30, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x0f, 0x12, 0x06, 0xd2, 0x03, 0x02, 0xd4, 0x03, 0x03, 0x1a,
+    0x20, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe6, 0x9f, 0x90, 0xe6, 0x9d, 0xa1, 0xe8, 0xaf,
+    0x84, 0xe8, 0xae, 0xba, 0xe7, 0x9a, 0x84, 0xe7, 0x82, 0xb9, 0xe8, 0xb8, 0xa9, 0xe6, 0x95, 0xb0,
+    0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0f, 0x01, 0x12, 0x04, 0xd2, 0x03, 0x06, 0x1c,
+    0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0f, 0x02, 0x12, 0x04, 0xd2, 0x03, 0x1d, 0x3a, 0x0a,
+    0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0f, 0x03, 0x12, 0x04, 0xd2, 0x03, 0x45, 0x63, 0x0a, 0x0d,
+    0x0a, 0x05, 0x06, 0x00, 0x02, 0x0f, 0x04, 0x12, 0x04, 0xd3, 0x03, 0x04, 0x3f, 0x0a, 0x11, 0x0a,
+    0x09, 0x06, 0x00, 0x02, 0x0f, 0x04, 0xd0, 0x86, 0x03, 0x01, 0x12, 0x04, 0xd3, 0x03, 0x04, 0x3f,
+    0x0a, 0x3f, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x10, 0x12, 0x06, 0xd7, 0x03, 0x02, 0xd9, 0x03, 0x03,
+    0x1a, 0x2f, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe6, 0x9f, 0x90, 0xe4, 0xb8, 0xaa, 0xe7,
+    0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe8, 0xaf, 0x84, 0xe8, 0xae,
+    0xba, 0xe4, 0xba, 0x86, 0xe6, 0x9f, 0x90, 0xe4, 0xb8, 0xaa, 0xe5, 0xaf, 0xb9, 0xe8, 0xb1, 0xa1,
+    0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x10, 0x01, 0x12, 0x04, 0xd7, 0x03, 0x06, 0x17,
+    0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x10, 0x02, 0x12, 0x04, 0xd7, 0x03, 0x18, 0x30, 0x0a,
+    0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x10, 0x03, 0x12, 0x04, 0xd7, 0x03, 0x3b, 0x54, 0x0a, 0x0d,
+    0x0a, 0x05, 0x06, 0x00, 0x02, 0x10, 0x04, 0x12, 0x04, 0xd8, 0x03, 0x04, 0x3f, 0x0a, 0x11, 0x0a,
+    0x09, 0x06, 0x00, 0x02, 0x10, 0x04, 0xd0, 0x86, 0x03, 0x01, 0x12, 0x04, 0xd8, 0x03, 0x04, 0x3f,
+    0x0a, 0x45, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x11, 0x12, 0x06, 0xdc, 0x03, 0x02, 0xde, 0x03, 0x03,
+    0x1a, 0x35, 0x20, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe6, 0xa3, 0x80, 0xe6, 0x9f, 0xa5, 0xe6,
+    0x9f, 0x90, 0xe4, 0xb8, 0xaa, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe6, 0x98, 0xaf, 0xe5, 0x90,
+    0xa6, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe4, 0xba, 0x86, 0xe6, 0x9f, 0x90, 0xe4, 0xb8, 0xaa,
+    0xe5, 0xaf, 0xb9, 0xe8, 0xb1, 0xa1, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x11, 0x01,
+    0x12, 0x04, 0xdc, 0x03, 0x06, 0x1c, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x11, 0x02, 0x12,
+    0x04, 0xdc, 0x03, 0x1d, 0x3a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x11, 0x03, 0x12, 0x04,
+    0xdc, 0x03, 0x45, 0x63, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x11, 0x04, 0x12, 0x04, 0xdd,
+    0x03, 0x04, 0x3f, 0x0a, 0x11, 0x0a, 0x09, 0x06, 0x00, 0x02, 0x11, 0x04, 0xd0, 0x86, 0x03, 0x01,
+    0x12, 0x04, 0xdd, 0x03, 0x04, 0x3f, 0x0a, 0x43, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x12, 0x12, 0x04,
+    0xe1, 0x03, 0x02, 0x6e, 0x1a, 0x35, 0x20, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe6, 0xa3, 0x80,
+    0xe6, 0x9f, 0xa5, 0xe6, 0x9f, 0x90, 0xe4, 0xb8, 0xaa, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe6,
+    0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0xe4, 0xba, 0x86, 0xe6, 0x9f,
+    0x90, 0xe4, 0xba, 0x9b, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06,
+    0x00, 0x02, 0x12, 0x01, 0x12, 0x04, 0xe1, 0x03, 0x06, 0x1f, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00,
+    0x02, 0x12, 0x02, 0x12, 0x04, 0xe1, 0x03, 0x20, 0x40, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02,
+    0x12, 0x03, 0x12, 0x04, 0xe1, 0x03, 0x4b, 0x6c, 0x0a, 0x2e, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x13,
+    0x12, 0x04, 0xe4, 0x03, 0x02, 0x65, 0x1a, 0x20, 0x20, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe6,
+    0xa3, 0x80, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe6, 0x98, 0xaf, 0xe5, 0x90,
+    0xa6, 0xe5, 0xad, 0x98, 0xe5, 0x9c, 0xa8, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x13,
+    0x01, 0x12, 0x04, 0xe4, 0x03, 0x06, 0x1c, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x13, 0x02,
+    0x12, 0x04, 0xe4, 0x03, 0x1d, 0x3a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x13, 0x03, 0x12,
+    0x04, 0xe4, 0x03, 0x45, 0x63, 0x0a, 0x24, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x14, 0x12, 0x04, 0xe7,
+    0x03, 0x02, 0x41, 0x1a, 0x16, 0x20, 0xe6, 0x8c, 0x89, 0xe7, 0x85, 0xa7, 0x69, 0x64, 0xe8, 0x8e,
+    0xb7, 0xe5, 0x8f, 0x96, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06,
+    0x00, 0x02, 0x14, 0x01, 0x12, 0x04, 0xe7, 0x03, 0x06, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00,
+    0x02, 0x14, 0x02, 0x12, 0x04, 0xe7, 0x03, 0x11, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02,
+    0x14, 0x03, 0x12, 0x04, 0xe7, 0x03, 0x2d, 0x3f, 0x0a, 0x22, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x15,
+    0x12, 0x04, 0xea, 0x03, 0x02, 0x4d, 0x1a, 0x14, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe8,
+    0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe4, 0xbd, 0x9c, 0xe8, 0x80, 0x85, 0x0a, 0x0a, 0x0d, 0x0a, 0x05,
+    0x06, 0x00, 0x02, 0x15, 0x01, 0x12, 0x04, 0xea, 0x03, 0x06, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x06,
+    0x00, 0x02, 0x15, 0x02, 0x12, 0x04, 0xea, 0x03, 0x15, 0x2a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00,
+    0x02, 0x15, 0x03, 0x12, 0x04, 0xea, 0x03, 0x35, 0x4b, 0x0a, 0x1c, 0x0a, 0x04, 0x06, 0x00, 0x02,
+    0x16, 0x12, 0x04, 0xed, 0x03, 0x02, 0x44, 0x1a, 0x0e, 0x20, 0xe7, 0xbc, 0x96, 0xe8, 0xbe, 0x91,
+    0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x16, 0x01,
+    0x12, 0x04, 0xed, 0x03, 0x06, 0x11, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x16, 0x02, 0x12,
+    0x04, 0xed, 0x03, 0x12, 0x24, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x16, 0x03, 0x12, 0x04,
+    0xed, 0x03, 0x2f, 0x42, 0x0a, 0x2b, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x17, 0x12, 0x04, 0xf0, 0x03,
+    0x02, 0x62, 0x1a, 0x1d, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe8, 0xaf, 0x84, 0xe8, 0xae,
+    0xba, 0xe7, 0x9a, 0x84, 0xe7, 0xbc, 0x96, 0xe8, 0xbe, 0x91, 0xe5, 0x8e, 0x86, 0xe5, 0x8f, 0xb2,
+    0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x17, 0x01, 0x12, 0x04, 0xf0, 0x03, 0x06, 0x1b,
+    0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x17, 0x02, 0x12, 0x04, 0xf0, 0x03, 0x1c, 0x38, 0x0a,
+    0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x17, 0x03, 0x12, 0x04, 0xf0, 0x03, 0x43, 0x60, 0x0a, 0x22,
+    0x0a, 0x02, 0x04, 0x3e, 0x12, 0x06, 0xf4, 0x03, 0x00, 0xfe, 0x03, 0x01, 0x1a, 0x14, 0x20, 0xe4,
+    0xb8, 0x80, 0xe6, 0x9d, 0xa1, 0xe4, 0xb8, 0xbe, 0xe6, 0x8a, 0xa5, 0xe8, 0xae, 0xb0, 0xe5, 0xbd,
+    0x95, 0x0a, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x3e, 0x01, 0x12, 0x04, 0xf4, 0x03, 0x08, 0x15, 0x0a,
+    0x0c, 0x0a, 0x04, 0x04, 0x3e, 0x02, 0x00, 0x12, 0x04, 0xf5, 0x03, 0x02, 0x22, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x3e, 0x02, 0x00, 0x05, 0x12, 0x04, 0xf5, 0x03, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x3e, 0x02, 0x00, 0x01, 0x12, 0x04, 0xf5, 0x03, 0x13, 0x15, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x3e, 0x02, 0x00, 0x03, 0x12, 0x04, 0xf5, 0x03, 0x20, 0x21, 0x0a, 0x22, 0x0a, 0x04, 0x04, 0x3e,
+    0x02, 0x01, 0x12, 0x04, 0xf6, 0x03, 0x02, 0x22, 0x22, 0x14, 0x20, 0xe8, 0xa2, 0xab, 0xe4, 0xb8,
+    0xbe, 0xe6, 0x8a, 0xa5, 0xe7, 0x9a, 0x84, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x3e, 0x02, 0x01, 0x05, 0x12, 0x04, 0xf6, 0x03, 0x02, 0x07, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x3e, 0x02, 0x01, 0x01, 0x12, 0x04, 0xf6, 0x03, 0x13, 0x1d, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x3e, 0x02, 0x01, 0x03, 0x12, 0x04, 0xf6, 0x03, 0x20, 0x21, 0x0a, 0x21, 0x0a, 0x04, 0x04,
+    0x3e, 0x02, 0x02, 0x12, 0x04, 0xf7, 0x03, 0x02, 0x22, 0x22, 0x13, 0x20, 0xe8, 0xa2, 0xab, 0xe8,
+    0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe5, 0xaf, 0xb9, 0xe8, 0xb1, 0xa1, 0x69, 0x64, 0x0a, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x3e, 0x02, 0x02, 0x05, 0x12, 0x04, 0xf7, 0x03, 0x02, 0x07, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x3e, 0x02, 0x02, 0x01, 0x12, 0x04, 0xf7, 0x03, 0x13, 0x16, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x3e, 0x02, 0x02, 0x03, 0x12, 0x04, 0xf7, 0x03, 0x20, 0x21, 0x0a, 0x19, 0x0a, 0x04, 0x04,
+    0x3e, 0x02, 0x03, 0x12, 0x04, 0xf8, 0x03, 0x02, 0x22, 0x22, 0x0b, 0x20, 0xe4, 0xb8, 0xbe, 0xe6,
+    0x8a, 0xa5, 0xe4, 0xba, 0xba, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3e, 0x02, 0x03, 0x05, 0x12,
+    0x04, 0xf8, 0x03, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3e, 0x02, 0x03, 0x01, 0x12, 0x04,
+    0xf8, 0x03, 0x13, 0x1b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3e, 0x02, 0x03, 0x03, 0x12, 0x04, 0xf8,
+    0x03, 0x20, 0x21, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x3e, 0x02, 0x04, 0x12, 0x04, 0xf9, 0x03, 0x02,
+    0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3e, 0x02, 0x04, 0x06, 0x12, 0x04, 0xf9, 0x03, 0x02, 0x0e,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3e, 0x02, 0x04, 0x01, 0x12, 0x04, 0xf9, 0x03, 0x13, 0x19, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x3e, 0x02, 0x04, 0x03, 0x12, 0x04, 0xf9, 0x03, 0x20, 0x21, 0x0a, 0x0c,
+    0x0a, 0x04, 0x04, 0x3e, 0x02, 0x05, 0x12, 0x04, 0xfa, 0x03, 0x02, 0x22, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x3e, 0x02, 0x05, 0x05, 0x12, 0x04, 0xfa, 0x03, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x3e, 0x02, 0x05, 0x01, 0x12, 0x04, 0xfa, 0x03, 0x13, 0x19, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3e,
+    0x02, 0x05, 0x03, 0x12, 0x04, 0xfa, 0x03, 0x20, 0x21, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x3e, 0x02,
+    0x06, 0x12, 0x04, 0xfb, 0x03, 0x02, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3e, 0x02, 0x06, 0x06,
+    0x12, 0x04, 0xfb, 0x03, 0x02, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3e, 0x02, 0x06, 0x01, 0x12,
+    0x04, 0xfb, 0x03, 0x13, 0x1d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3e, 0x02, 0x06, 0x03, 0x12, 0x04,
+    0xfb, 0x03, 0x20, 0x21, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x3e, 0x02, 0x07, 0x12, 0x04, 0xfc, 0x03,
+    0x02, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3e, 0x02, 0x07, 0x05, 0x12, 0x04, 0xfc, 0x03, 0x02,
+    0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3e, 0x02, 0x07, 0x01, 0x12, 0x04, 0xfc, 0x03, 0x13, 0x18,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3e, 0x02, 0x07, 0x03, 0x12, 0x04, 0xfc, 0x03, 0x20, 0x21, 0x0a,
+    0x0c, 0x0a, 0x04, 0x04, 0x3e, 0x02, 0x08, 0x12, 0x04, 0xfd, 0x03, 0x02, 0x22, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x3e, 0x02, 0x08, 0x05, 0x12, 0x04, 0xfd, 0x03, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x3e, 0x02, 0x08, 0x01, 0x12, 0x04, 0xfd, 0x03, 0x13, 0x18, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x3e, 0x02, 0x08, 0x03, 0x12, 0x04, 0xfd, 0x03, 0x20, 0x21, 0x0a, 0x4d, 0x0a, 0x02, 0x04, 0x3f,
+    0x12, 0x06, 0x81, 0x04, 0x00, 0x84, 0x04, 0x01, 0x1a, 0x3f, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f,
+    0x96, 0xe5, 0xbe, 0x85, 0xe5, 0xa4, 0x84, 0xe7, 0x90, 0x86, 0xe7, 0x9a, 0x84, 0xe4, 0xb8, 0xbe,
+    0xe6, 0x8a, 0xa5, 0x20, 0xe6, 0x8c, 0x89, 0xe7, 0x85, 0xa7, 0xe4, 0xb8, 0xbe, 0xe6, 0x8a, 0xa5,
+    0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe4, 0xbb, 0x8e, 0xe6, 0x97, 0xa9, 0xe5, 0x88, 0xb0, 0xe6,
+    0x99, 0x9a, 0xe6, 0x8e, 0x92, 0xe5, 0x88, 0x97, 0x0a, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x3f, 0x01,
+    0x12, 0x04, 0x81, 0x04, 0x08, 0x21, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x3f, 0x02, 0x00, 0x12, 0x04,
+    0x82, 0x04, 0x02, 0x13, 0x22, 0x0e, 0x20, 0xe8, 0xb5, 0xb7, 0xe5, 0xa7, 0x8b, 0xe6, 0xb8, 0xb8,
+    0xe6, 0xa0, 0x87, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3f, 0x02, 0x00, 0x05, 0x12, 0x04, 0x82,
+    0x04, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3f, 0x02, 0x00, 0x01, 0x12, 0x04, 0x82, 0x04,
+    0x08, 0x0e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x3f, 0x02, 0x00, 0x03, 0x12, 0x04, 0x82, 0x04, 0x11,
+    0x12, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x3f, 0x02, 0x01, 0x12, 0x04, 0x83, 0x04, 0x02, 0x13, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x3f, 0x02, 0x01, 0x05, 0x12, 0x04, 0x83, 0x04, 0x02, 0x07, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x3f, 0x02, 0x01, 0x01, 0x12, 0x04, 0x83, 0x04, 0x08, 0x0d, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x3f, 0x02, 0x01, 0x03, 0x12, 0x04, 0x83, 0x04, 0x11, 0x12, 0x0a, 0x0c, 0x0a, 0x02,
+    0x04, 0x40, 0x12, 0x06, 0x86, 0x04, 0x00, 0x8b, 0x04, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x40,
+    0x01, 0x12, 0x04, 0x86, 0x04, 0x08, 0x22, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x40, 0x02, 0x00, 0x12,
+    0x04, 0x87, 0x04, 0x02, 0x2b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x40, 0x02, 0x00, 0x04, 0x12, 0x04,
+    0x87, 0x04, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x40, 0x02, 0x00, 0x06, 0x12, 0x04, 0x87,
+    0x04, 0x0b, 0x18, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x40, 0x02, 0x00, 0x01, 0x12, 0x04, 0x87, 0x04,
+    0x1b, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x40, 0x02, 0x00, 0x03, 0x12, 0x04, 0x87, 0x04, 0x29,
+    0x2a, 0x0a, 0x38, 0x0a, 0x04, 0x04, 0x40, 0x02, 0x01, 0x12, 0x04, 0x88, 0x04, 0x02, 0x2a, 0x22,
+    0x2a, 0x20, 0xe8, 0xa2, 0xab, 0xe4, 0xb8, 0xbe, 0xe6, 0x8a, 0xa5, 0xe7, 0x9a, 0x84, 0xe8, 0xaf,
+    0x84, 0xe8, 0xae, 0xba, 0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x20,
+    0x2d, 0x3e, 0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x40, 0x02, 0x01, 0x06, 0x12, 0x04, 0x88, 0x04, 0x02, 0x19, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x40,
+    0x02, 0x01, 0x01, 0x12, 0x04, 0x88, 0x04, 0x1a, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x40, 0x02,
+    0x01, 0x03, 0x12, 0x04, 0x88, 0x04, 0x28, 0x29, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x40, 0x02, 0x02,
+    0x12, 0x04, 0x89, 0x04, 0x02, 0x2b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x40, 0x02, 0x02, 0x05, 0x12,
+    0x04, 0x89, 0x04, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x40, 0x02, 0x02, 0x01, 0x12, 0x04,
+    0x89, 0x04, 0x1b, 0x26, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x40, 0x02, 0x02, 0x03, 0x12, 0x04, 0x89,
+    0x04, 0x29, 0x2a, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x40, 0x02, 0x03, 0x12, 0x04, 0x8a, 0x04, 0x02,
+    0x2b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x40, 0x02, 0x03, 0x05, 0x12, 0x04, 0x8a, 0x04, 0x02, 0x06,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x40, 0x02, 0x03, 0x01, 0x12, 0x04, 0x8a, 0x04, 0x1b, 0x23, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x40, 0x02, 0x03, 0x03, 0x12, 0x04, 0x8a, 0x04, 0x29, 0x2a, 0x0a, 0x34,
+    0x0a, 0x02, 0x04, 0x41, 0x12, 0x06, 0x8e, 0x04, 0x00, 0x92, 0x04, 0x01, 0x1a, 0x26, 0x20, 0xe5,
+    0xa4, 0x84, 0xe7, 0x90, 0x86, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe7, 0x9a, 0x84, 0xe5, 0x85,
+    0xa8, 0xe9, 0x83, 0xa8, 0xe5, 0xbe, 0x85, 0xe5, 0xa4, 0x84, 0xe7, 0x90, 0x86, 0xe4, 0xb8, 0xbe,
+    0xe6, 0x8a, 0xa5, 0x0a, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x41, 0x01, 0x12, 0x04, 0x8e, 0x04, 0x08,
+    0x1d, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x41, 0x02, 0x00, 0x12, 0x04, 0x8f, 0x04, 0x02, 0x47, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x41, 0x02, 0x00, 0x05, 0x12, 0x04, 0x8f, 0x04, 0x02, 0x07, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x41, 0x02, 0x00, 0x01, 0x12, 0x04, 0x8f, 0x04, 0x13, 0x1d, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x41, 0x02, 0x00, 0x03, 0x12, 0x04, 0x8f, 0x04, 0x21, 0x22, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x41, 0x02, 0x00, 0x08, 0x12, 0x04, 0x8f, 0x04, 0x23, 0x46, 0x0a, 0x11, 0x0a, 0x09, 0x04,
+    0x41, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x04, 0x8f, 0x04, 0x24, 0x45, 0x0a, 0x0c,
+    0x0a, 0x04, 0x04, 0x41, 0x02, 0x01, 0x12, 0x04, 0x90, 0x04, 0x02, 0x63, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x41, 0x02, 0x01, 0x06, 0x12, 0x04, 0x90, 0x04, 0x02, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x41, 0x02, 0x01, 0x01, 0x12, 0x04, 0x90, 0x04, 0x13, 0x1d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x41,
+    0x02, 0x01, 0x03, 0x12, 0x04, 0x90, 0x04, 0x21, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x41, 0x02,
+    0x01, 0x08, 0x12, 0x04, 0x90, 0x04, 0x23, 0x62, 0x0a, 0x10, 0x0a, 0x08, 0x04, 0x41, 0x02, 0x01,
+    0x08, 0x87, 0x09, 0x10, 0x12, 0x04, 0x90, 0x04, 0x24, 0x61, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x41,
+    0x02, 0x01, 0x08, 0x87, 0x09, 0x10, 0x02, 0x12, 0x04, 0x90, 0x04, 0x41, 0x53, 0x0a, 0x12, 0x0a,
+    0x0a, 0x04, 0x41, 0x02, 0x01, 0x08, 0x87, 0x09, 0x10, 0x04, 0x00, 0x12, 0x04, 0x90, 0x04, 0x5e,
+    0x5f, 0x0a, 0x33, 0x0a, 0x04, 0x04, 0x41, 0x02, 0x02, 0x12, 0x04, 0x91, 0x04, 0x02, 0x48, 0x22,
+    0x25, 0x20, 0xe7, 0xa6, 0x81, 0xe6, 0xad, 0xa2, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe7, 0x9a,
+    0x84, 0xe6, 0x97, 0xb6, 0xe9, 0x95, 0xbf, 0x20, 0x30, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe6,
+    0xb0, 0xb8, 0xe4, 0xb9, 0x85, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x41, 0x02, 0x02, 0x05, 0x12,
+    0x04, 0x91, 0x04, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x41, 0x02, 0x02, 0x01, 0x12, 0x04,
+    0x91, 0x04, 0x13, 0x1e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x41, 0x02, 0x02, 0x03, 0x12, 0x04, 0x91,
+    0x04, 0x21, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x41, 0x02, 0x02, 0x08, 0x12, 0x04, 0x91, 0x04,
+    0x23, 0x47, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x41, 0x02, 0x02, 0x08, 0x87, 0x09, 0x04, 0x05, 0x12,
+    0x04, 0x91, 0x04, 0x24, 0x46, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x42, 0x12, 0x06, 0x94, 0x04, 0x00,
+    0x96, 0x04, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x42, 0x01, 0x12, 0x04, 0x94, 0x04, 0x08, 0x1e,
+    0x0a, 0x25, 0x0a, 0x04, 0x04, 0x42, 0x02, 0x00, 0x12, 0x04, 0x95, 0x04, 0x02, 0x15, 0x22, 0x17,
+    0x20, 0xe5, 0xa4, 0x84, 0xe7, 0x90, 0x86, 0xe7, 0x9a, 0x84, 0xe4, 0xb8, 0xbe, 0xe6, 0x8a, 0xa5,
+    0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x42, 0x02, 0x00, 0x05,
+    0x12, 0x04, 0x95, 0x04, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x42, 0x02, 0x00, 0x01, 0x12,
+    0x04, 0x95, 0x04, 0x08, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x42, 0x02, 0x00, 0x03, 0x12, 0x04,
+    0x95, 0x04, 0x13, 0x14, 0x0a, 0x22, 0x0a, 0x02, 0x06, 0x01, 0x12, 0x06, 0x99, 0x04, 0x00, 0x9f,
+    0x04, 0x01, 0x1a, 0x14, 0x20, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe4, 0xb8, 0xbe, 0xe6, 0x8a,
+    0xa5, 0xe5, 0xae, 0xa1, 0xe6, 0xa0, 0xb8, 0x0a, 0x0a, 0x0b, 0x0a, 0x03, 0x06, 0x01, 0x01, 0x12,
+    0x04, 0x99, 0x04, 0x08, 0x20, 0x0a, 0x28, 0x0a, 0x04, 0x06, 0x01, 0x02, 0x00, 0x12, 0x04, 0x9b,
+    0x04, 0x02, 0x59, 0x1a, 0x1a, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe5, 0xbe, 0x85, 0xe5,
+    0xa4, 0x84, 0xe7, 0x90, 0x86, 0xe7, 0x9a, 0x84, 0xe4, 0xb8, 0xbe, 0xe6, 0x8a, 0xa5, 0x0a, 0x0a,
+    0x0d, 0x0a, 0x05, 0x06, 0x01, 0x02, 0x00, 0x01, 0x12, 0x04, 0x9b, 0x04, 0x06, 0x18, 0x0a, 0x0d,
+    0x0a, 0x05, 0x06, 0x01, 0x02, 0x00, 0x02, 0x12, 0x04, 0x9b, 0x04, 0x19, 0x32, 0x0a, 0x0d, 0x0a,
+    0x05, 0x06, 0x01, 0x02, 0x00, 0x03, 0x12, 0x04, 0x9b, 0x04, 0x3d, 0x57, 0x0a, 0x1c, 0x0a, 0x04,
+    0x06, 0x01, 0x02, 0x01, 0x12, 0x04, 0x9e, 0x04, 0x02, 0x4d, 0x1a, 0x0e, 0x20, 0xe5, 0xa4, 0x84,
+    0xe7, 0x90, 0x86, 0xe4, 0xb8, 0xbe, 0xe6, 0x8a, 0xa5, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x01,
+    0x02, 0x01, 0x01, 0x12, 0x04, 0x9e, 0x04, 0x06, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x01, 0x02,
+    0x01, 0x02, 0x12, 0x04, 0x9e, 0x04, 0x15, 0x2a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x01, 0x02, 0x01,
+    0x03, 0x12, 0x04, 0x9e, 0x04, 0x35, 0x4b, 0x0a, 0x19, 0x0a, 0x02, 0x04, 0x43, 0x12, 0x06, 0xa2,
+    0x04, 0x00, 0xab, 0x04, 0x01, 0x1a, 0x0b, 0x20, 0xe8, 0xa1, 0xa8, 0xe6, 0x83, 0x85, 0xe5, 0x8c,
+    0x85, 0x0a, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x43, 0x01, 0x12, 0x04, 0xa2, 0x04, 0x08, 0x11, 0x0a,
+    0x0c, 0x0a, 0x04, 0x04, 0x43, 0x02, 0x00, 0x12, 0x04, 0xa3, 0x04, 0x02, 0x17, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x43, 0x02, 0x00, 0x05, 0x12, 0x04, 0xa3, 0x04, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x43, 0x02, 0x00, 0x01, 0x12, 0x04, 0xa3, 0x04, 0x09, 0x0b, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x43, 0x02, 0x00, 0x03, 0x12, 0x04, 0xa3, 0x04, 0x15, 0x16, 0x0a, 0x2c, 0x0a, 0x04, 0x04, 0x43,
+    0x02, 0x01, 0x12, 0x04, 0xa4, 0x04, 0x02, 0x17, 0x22, 0x1e, 0x20, 0xe8, 0xa1, 0xa8, 0xe6, 0x83,
+    0x85, 0xe5, 0x8c, 0x85, 0xe4, 0xbb, 0xa3, 0xe7, 0xa0, 0x81, 0x20, 0xe5, 0x85, 0xa8, 0xe5, 0xb1,
+    0x80, 0xe5, 0x94, 0xaf, 0xe4, 0xb8, 0x80, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x43, 0x02, 0x01,
+    0x05, 0x12, 0x04, 0xa4, 0x04, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x43, 0x02, 0x01, 0x01,
+    0x12, 0x04, 0xa4, 0x04, 0x09, 0x0d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x43, 0x02, 0x01, 0x03, 0x12,
+    0x04, 0xa4, 0x04, 0x15, 0x16, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x43, 0x02, 0x02, 0x12, 0x04, 0xa5,
+    0x04, 0x02, 0x17, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x43, 0x02, 0x02, 0x05, 0x12, 0x04, 0xa5, 0x04,
+    0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x43, 0x02, 0x02, 0x01, 0x12, 0x04, 0xa5, 0x04, 0x09,
+    0x0d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x43, 0x02, 0x02, 0x03, 0x12, 0x04, 0xa5, 0x04, 0x15, 0x16,
+    0x0a, 0x22, 0x0a, 0x04, 0x04, 0x43, 0x02, 0x03, 0x12, 0x04, 0xa6, 0x04, 0x02, 0x17, 0x22, 0x14,
+    0x20, 0xe5, 0xb0, 0x81, 0xe9, 0x9d, 0xa2, 0xe7, 0x9a, 0x84, 0xe5, 0xad, 0x98, 0xe5, 0x82, 0xa8,
+    0x6b, 0x65, 0x79, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x43, 0x02, 0x03, 0x05, 0x12, 0x04, 0xa6,
+    0x04, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x43, 0x02, 0x03, 0x01, 0x12, 0x04, 0xa6, 0x04,
+    0x09, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x43, 0x02, 0x03, 0x03, 0x12, 0x04, 0xa6, 0x04, 0x15,
+    0x16, 0x0a, 0x62, 0x0a, 0x04, 0x04, 0x43, 0x02, 0x04, 0x12, 0x04, 0xa7, 0x04, 0x02, 0x17, 0x22,
+    0x54, 0x20, 0xe8, 0xa1, 0xa8, 0xe6, 0x83, 0x85, 0xe5, 0x8c, 0x85, 0xe5, 0x8f, 0x8a, 0xe5, 0x85,
+    0xb6, 0xe8, 0xa1, 0xa8, 0xe6, 0x83, 0x85, 0xe6, 0xaf, 0x8f, 0xe6, 0xac, 0xa1, 0xe5, 0x8f, 0x98,
+    0xe6, 0x9b, 0xb4, 0xe6, 0x97, 0xb6, 0xe9, 0x80, 0x92, 0xe5, 0xa2, 0x9e, 0x20, 0xe5, 0xae, 0xa2,
+    0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe6, 0x8d, 0xae, 0xe6, 0xad, 0xa4, 0xe5, 0x88, 0xa4, 0xe6,
+    0x96, 0xad, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe9, 0x9c, 0x80, 0xe8, 0xa6, 0x81, 0xe6, 0x9b,
+    0xb4, 0xe6, 0x96, 0xb0, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x43, 0x02, 0x04, 0x05, 0x12, 0x04,
+    0xa7, 0x04, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x43, 0x02, 0x04, 0x01, 0x12, 0x04, 0xa7,
+    0x04, 0x09, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x43, 0x02, 0x04, 0x03, 0x12, 0x04, 0xa7, 0x04,
+    0x15, 0x16, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x43, 0x02, 0x05, 0x12, 0x04, 0xa8, 0x04, 0x02, 0x17,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x43, 0x02, 0x05, 0x05, 0x12, 0x04, 0xa8, 0x04, 0x02, 0x06, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x43, 0x02, 0x05, 0x01, 0x12, 0x04, 0xa8, 0x04, 0x09, 0x10, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x43, 0x02, 0x05, 0x03, 0x12, 0x04, 0xa8, 0x04, 0x15, 0x16, 0x0a, 0x0c, 0x0a,
+    0x04, 0x04, 0x43, 0x02, 0x06, 0x12, 0x04, 0xa9, 0x04, 0x02, 0x17, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x43, 0x02, 0x06, 0x05, 0x12, 0x04, 0xa9, 0x04, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x43,
+    0x02, 0x06, 0x01, 0x12, 0x04, 0xa9, 0x04, 0x09, 0x0e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x43, 0x02,
+    0x06, 0x03, 0x12, 0x04, 0xa9, 0x04, 0x15, 0x16, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x43, 0x02, 0x07,
+    0x12, 0x04, 0xaa, 0x04, 0x02, 0x17, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x43, 0x02, 0x07, 0x05, 0x12,
+    0x04, 0xaa, 0x04, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x43, 0x02, 0x07, 0x01, 0x12, 0x04,
+    0xaa, 0x04, 0x09, 0x0e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x43, 0x02, 0x07, 0x03, 0x12, 0x04, 0xaa,
+    0x04, 0x15, 0x16, 0x0a, 0x2b, 0x0a, 0x02, 0x04, 0x44, 0x12, 0x06, 0xae, 0x04, 0x00, 0xbb, 0x04,
+    0x01, 0x1a, 0x1d, 0x20, 0xe8, 0xa1, 0xa8, 0xe6, 0x83, 0x85, 0xe5, 0x8c, 0x85, 0xe4, 0xb8, 0xad,
+    0xe7, 0x9a, 0x84, 0xe4, 0xb8, 0x80, 0xe4, 0xb8, 0xaa, 0xe8, 0xa1, 0xa8, 0xe6, 0x83, 0x85, 0x0a,
+    0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x44, 0x01, 0x12, 0x04, 0xae, 0x04, 0x08, 0x11, 0x0a, 0x0c, 0x0a,
+    0x04, 0x04, 0x44, 0x02, 0x00, 0x12, 0x04, 0xaf, 0x04, 0x02, 0x15, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x44, 0x02, 0x00, 0x05, 0x12, 0x04, 0xaf, 0x04, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x44,
+    0x02, 0x00, 0x01, 0x12, 0x04, 0xaf, 0x04, 0x09, 0x0b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x44, 0x02,
+    0x00, 0x03, 0x12, 0x04, 0xaf, 0x04, 0x13, 0x14, 0x0a, 0x25, 0x0a, 0x04, 0x04, 0x44, 0x02, 0x01,
+    0x12, 0x04, 0xb0, 0x04, 0x02, 0x15, 0x22, 0x17, 0x20, 0xe6, 0x89, 0x80, 0xe5, 0xb1, 0x9e, 0xe8,
+    0xa1, 0xa8, 0xe6, 0x83, 0x85, 0xe5, 0x8c, 0x85, 0xe4, 0xbb, 0xa3, 0xe7, 0xa0, 0x81, 0x0a, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x44, 0x02, 0x01, 0x05, 0x12, 0x04, 0xb0, 0x04, 0x02, 0x08, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x44, 0x02, 0x01, 0x01, 0x12, 0x04, 0xb0, 0x04, 0x09, 0x0d, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x44, 0x02, 0x01, 0x03, 0x12, 0x04, 0xb0, 0x04, 0x13, 0x14, 0x0a, 0x2f, 0x0a, 0x04,
+    0x04, 0x44, 0x02, 0x02, 0x12, 0x04, 0xb1, 0x04, 0x02, 0x15, 0x22, 0x21, 0x20, 0xe8, 0xa1, 0xa8,
+    0xe6, 0x83, 0x85, 0xe4, 0xbb, 0xa3, 0xe7, 0xa0, 0x81, 0x20, 0xe8, 0xa1, 0xa8, 0xe6, 0x83, 0x85,
+    0xe5, 0x8c, 0x85, 0xe5, 0x86, 0x85, 0xe5, 0x94, 0xaf, 0xe4, 0xb8, 0x80, 0x0a, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x44, 0x02, 0x02, 0x05, 0x12, 0x04, 0xb1, 0x04, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x44, 0x02, 0x02, 0x01, 0x12, 0x04, 0xb1, 0x04, 0x09, 0x0d, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x44, 0x02, 0x02, 0x03, 0x12, 0x04, 0xb1, 0x04, 0x13, 0x14, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x44,
+    0x02, 0x03, 0x12, 0x04, 0xb2, 0x04, 0x02, 0x15, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x44, 0x02, 0x03,
+    0x05, 0x12, 0x04, 0xb2, 0x04, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x44, 0x02, 0x03, 0x01,
+    0x12, 0x04, 0xb2, 0x04, 0x09, 0x0d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x44, 0x02, 0x03, 0x03, 0x12,
+    0x04, 0xb2, 0x04, 0x13, 0x14, 0x0a, 0x28, 0x0a, 0x04, 0x04, 0x44, 0x02, 0x04, 0x12, 0x04, 0xb3,
+    0x04, 0x02, 0x15, 0x22, 0x1a, 0x20, 0xe8, 0xa1, 0xa8, 0xe6, 0x83, 0x85, 0xe8, 0xb5, 0x84, 0xe6,
+    0xba, 0x90, 0xe7, 0x9a, 0x84, 0xe5, 0xad, 0x98, 0xe5, 0x82, 0xa8, 0x6b, 0x65, 0x79, 0x0a, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x44, 0x02, 0x04, 0x05, 0x12, 0x04, 0xb3, 0x04, 0x02, 0x08, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x44, 0x02, 0x04, 0x01, 0x12, 0x04, 0xb3, 0x04, 0x09, 0x0c, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x44, 0x02, 0x04, 0x03, 0x12, 0x04, 0xb3, 0x04, 0x13, 0x14, 0x0a, 0x0c, 0x0a, 0x04,
+    0x04, 0x44, 0x02, 0x05, 0x12, 0x04, 0xb4, 0x04, 0x02, 0x15, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x44,
+    0x02, 0x05, 0x05, 0x12, 0x04, 0xb4, 0x04, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x44, 0x02,
+    0x05, 0x01, 0x12, 0x04, 0xb4, 0x04, 0x09, 0x0e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x44, 0x02, 0x05,
+    0x03, 0x12, 0x04, 0xb4, 0x04, 0x13, 0x14, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x44, 0x02, 0x06, 0x12,
+    0x04, 0xb5, 0x04, 0x02, 0x15, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x44, 0x02, 0x06, 0x05, 0x12, 0x04,
+    0xb5, 0x04, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x44, 0x02, 0x06, 0x01, 0x12, 0x04, 0xb5,
+    0x04, 0x09, 0x0f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x44, 0x02, 0x06, 0x03, 0x12, 0x04, 0xb5, 0x04,
+    0x13, 0x14, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x44, 0x02, 0x07, 0x12, 0x04, 0xb6, 0x04, 0x02, 0x15,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x44, 0x02, 0x07, 0x05, 0x12, 0x04, 0xb6, 0x04, 0x02, 0x08, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x44, 0x02, 0x07, 0x01, 0x12, 0x04, 0xb6, 0x04, 0x09, 0x0f, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x44, 0x02, 0x07, 0x03, 0x12, 0x04, 0xb6, 0x04, 0x13, 0x14, 0x0a, 0x3d, 0x0a,
+    0x04, 0x04, 0x44, 0x02, 0x08, 0x12, 0x04, 0xb7, 0x04, 0x02, 0x15, 0x22, 0x2f, 0x20, 0xe8, 0xa1,
+    0xa8, 0xe6, 0x83, 0x85, 0xe6, 0x9c, 0x80, 0xe5, 0x90, 0x8e, 0xe4, 0xb8, 0x80, 0xe6, 0xac, 0xa1,
+    0xe5, 0x8f, 0x98, 0xe6, 0x9b, 0xb4, 0xe6, 0x97, 0xb6, 0xe7, 0x9a, 0x84, 0xe8, 0xa1, 0xa8, 0xe6,
+    0x83, 0x85, 0xe5, 0x8c, 0x85, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x0a, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x44, 0x02, 0x08, 0x05, 0x12, 0x04, 0xb7, 0x04, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x44, 0x02, 0x08, 0x01, 0x12, 0x04, 0xb7, 0x04, 0x09, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x44,
+    0x02, 0x08, 0x03, 0x12, 0x04, 0xb7, 0x04, 0x13, 0x14, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x44, 0x02,
+    0x09, 0x12, 0x04, 0xb8, 0x04, 0x02, 0x16, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x44, 0x02, 0x09, 0x05,
+    0x12, 0x04, 0xb8, 0x04, 0x02, 0x06, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x44, 0x02, 0x09, 0x01, 0x12,
+    0x04, 0xb8, 0x04, 0x09, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x44, 0x02, 0x09, 0x03, 0x12, 0x04,
+    0xb8, 0x04, 0x13, 0x15, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x44, 0x02, 0x0a, 0x12, 0x04, 0xb9, 0x04,
+    0x02, 0x16, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x44, 0x02, 0x0a, 0x05, 0x12, 0x04, 0xb9, 0x04, 0x02,
+    0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x44, 0x02, 0x0a, 0x01, 0x12, 0x04, 0xb9, 0x04, 0x09, 0x0e,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x44, 0x02, 0x0a, 0x03, 0x12, 0x04, 0xb9, 0x04, 0x13, 0x15, 0x0a,
+    0x0c, 0x0a, 0x04, 0x04, 0x44, 0x02, 0x0b, 0x12, 0x04, 0xba, 0x04, 0x02, 0x16, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x44, 0x02, 0x0b, 0x05, 0x12, 0x04, 0xba, 0x04, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x44, 0x02, 0x0b, 0x01, 0x12, 0x04, 0xba, 0x04, 0x09, 0x0e, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x44, 0x02, 0x0b, 0x03, 0x12, 0x04, 0xba, 0x04, 0x13, 0x15, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x45,
+    0x12, 0x06, 0xbd, 0x04, 0x00, 0xbf, 0x04, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x45, 0x01, 0x12,
+    0x04, 0xbd, 0x04, 0x08, 0x1d, 0x0a, 0x31, 0x0a, 0x04, 0x04, 0x45, 0x02, 0x00, 0x12, 0x04, 0xbe,
+    0x04, 0x02, 0x1c, 0x22, 0x23, 0x20, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x8c, 0x85, 0xe5,
+    0x90, 0xab, 0xe5, 0xb7, 0xb2, 0xe4, 0xb8, 0x8b, 0xe6, 0x9e, 0xb6, 0xe7, 0x9a, 0x84, 0xe8, 0xa1,
+    0xa8, 0xe6, 0x83, 0x85, 0xe5, 0x8c, 0x85, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x45, 0x02, 0x00,
+    0x05, 0x12, 0x04, 0xbe, 0x04, 0x02, 0x06, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x45, 0x02, 0x00, 0x01,
+    0x12, 0x04, 0xbe, 0x04, 0x07, 0x17, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x45, 0x02, 0x00, 0x03, 0x12,
+    0x04, 0xbe, 0x04, 0x1a, 0x1b, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x46, 0x12, 0x06, 0xc1, 0x04, 0x00,
+    0xc3, 0x04, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x46, 0x01, 0x12, 0x04, 0xc1, 0x04, 0x08, 0x1e,
+    0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x46, 0x02, 0x00, 0x12, 0x04, 0xc2, 0x04, 0x02, 0x1f, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x46, 0x02, 0x00, 0x04, 0x12, 0x04, 0xc2, 0x04, 0x02, 0x0a, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x46, 0x02, 0x00, 0x06, 0x12, 0x04, 0xc2, 0x04, 0x0b, 0x14, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x46, 0x02, 0x00, 0x01, 0x12, 0x04, 0xc2, 0x04, 0x15, 0x1a, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x46, 0x02, 0x00, 0x03, 0x12, 0x04, 0xc2, 0x04, 0x1d, 0x1e, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x47,
+    0x12, 0x06, 0xc5, 0x04, 0x00, 0xc8, 0x04, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x47, 0x01, 0x12,
+    0x04, 0xc5, 0x04, 0x08, 0x1b, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x47, 0x02, 0x00, 0x12, 0x04, 0xc6,
+    0x04, 0x02, 0x48, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x47, 0x02, 0x00, 0x05, 0x12, 0x04, 0xc6, 0x04,
+    0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x47, 0x02, 0x00, 0x01, 0x12, 0x04, 0xc6, 0x04, 0x09,
+    0x0d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x47, 0x02, 0x00, 0x03, 0x12, 0x04, 0xc6, 0x04, 0x1c, 0x1d,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x47, 0x02, 0x00, 0x08, 0x12, 0x04, 0xc6, 0x04, 0x1e, 0x47, 0x0a,
+    0x11, 0x0a, 0x09, 0x04, 0x47, 0x02, 0x00, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x04, 0xc6, 0x04,
+    0x1f, 0x46, 0x0a, 0x2e, 0x0a, 0x04, 0x04, 0x47, 0x02, 0x01, 0x12, 0x04, 0xc7, 0x04, 0x02, 0x1e,
+    0x22, 0x20, 0x20, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x8c, 0x85, 0xe5, 0x90, 0xab, 0xe5,
+    0xb7, 0xb2, 0xe4, 0xb8, 0x8b, 0xe6, 0x9e, 0xb6, 0xe7, 0x9a, 0x84, 0xe8, 0xa1, 0xa8, 0xe6, 0x83,
+    0x85, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x47, 0x02, 0x01, 0x05, 0x12, 0x04, 0xc7, 0x04, 0x02,
+    0x06, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x47, 0x02, 0x01, 0x01, 0x12, 0x04, 0xc7, 0x04, 0x09, 0x19,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x47, 0x02, 0x01, 0x03, 0x12, 0x04, 0xc7, 0x04, 0x1c, 0x1d, 0x0a,
+    0x0c, 0x0a, 0x02, 0x04, 0x48, 0x12, 0x06, 0xca, 0x04, 0x00, 0xcd, 0x04, 0x01, 0x0a, 0x0b, 0x0a,
+    0x03, 0x04, 0x48, 0x01, 0x12, 0x04, 0xca, 0x04, 0x08, 0x1c, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x48,
+    0x02, 0x00, 0x12, 0x04, 0xcb, 0x04, 0x02, 0x1f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x48, 0x02, 0x00,
+    0x06, 0x12, 0x04, 0xcb, 0x04, 0x02, 0x0b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x48, 0x02, 0x00, 0x01,
+    0x12, 0x04, 0xcb, 0x04, 0x15, 0x19, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x48, 0x02, 0x00, 0x03, 0x12,
+    0x04, 0xcb, 0x04, 0x1d, 0x1e, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x48, 0x02, 0x01, 0x12, 0x04, 0xcc,
+    0x04, 0x02, 0x1f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x48, 0x02, 0x01, 0x04, 0x12, 0x04, 0xcc, 0x04,
+    0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x48, 0x02, 0x01, 0x06, 0x12, 0x04, 0xcc, 0x04, 0x0b,
+    0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x48, 0x02, 0x01, 0x01, 0x12, 0x04, 0xcc, 0x04, 0x15, 0x1a,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x48, 0x02, 0x01, 0x03, 0x12, 0x04, 0xcc, 0x04, 0x1d, 0x1e, 0x0a,
+    0x33, 0x0a, 0x02, 0x04, 0x49, 0x12, 0x06, 0xd0, 0x04, 0x00, 0xd2, 0x04, 0x01, 0x1a, 0x25, 0x20,
+    0xe6, 0x8c, 0x89, 0xe7, 0x85, 0xa7, 0x5b, 0x70, 0x61, 0x63, 0x6b, 0x3a, 0x69, 0x74, 0x65, 0x6d,
+    0x5d, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe6, 0x9f, 0xa5, 0xe6, 0x89, 0xbe, 0xe8, 0xa1, 0xa8,
+    0xe6, 0x83, 0x85, 0x0a, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x49, 0x01, 0x12, 0x04, 0xd0, 0x04, 0x08,
+    0x21, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x49, 0x02, 0x00, 0x12, 0x04, 0xd1, 0x04, 0x02, 0x5e, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x49, 0x02, 0x00, 0x04, 0x12, 0x04, 0xd1, 0x04, 0x02, 0x0a, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x49, 0x02, 0x00, 0x05, 0x12, 0x04, 0xd1, 0x04, 0x0b, 0x11, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x49, 0x02, 0x00, 0x01, 0x12, 0x04, 0xd1, 0x04, 0x12, 0x18, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x49, 0x02, 0x00, 0x03, 0x12, 0x04, 0xd1, 0x04, 0x1b, 0x1c, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x49, 0x02, 0x00, 0x08, 0x12, 0x04, 0xd1, 0x04, 0x1d, 0x5d, 0x0a, 0x10, 0x0a, 0x08, 0x04, 0x49,
+    0x02, 0x00, 0x08, 0x87, 0x09, 0x12, 0x12, 0x04, 0xd1, 0x04, 0x1e, 0x5c, 0x0a, 0x11, 0x0a, 0x09,
+    0x04, 0x49, 0x02, 0x00, 0x08, 0x87, 0x09, 0x12, 0x01, 0x12, 0x04, 0xd1, 0x04, 0x3f, 0x4b, 0x0a,
+    0x11, 0x0a, 0x09, 0x04, 0x49, 0x02, 0x00, 0x08, 0x87, 0x09, 0x12, 0x02, 0x12, 0x04, 0xd1, 0x04,
+    0x4d, 0x5b, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x4a, 0x12, 0x06, 0xd4, 0x04, 0x00, 0xd6, 0x04, 0x01,
+    0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x4a, 0x01, 0x12, 0x04, 0xd4, 0x04, 0x08, 0x22, 0x0a, 0x3f, 0x0a,
+    0x04, 0x04, 0x4a, 0x02, 0x00, 0x12, 0x04, 0xd5, 0x04, 0x02, 0x23, 0x22, 0x31, 0x20, 0x74, 0x6f,
+    0x6b, 0x65, 0x6e, 0x20, 0x2d, 0x3e, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x20, 0xe4, 0xb8, 0x8d, 0xe5,
+    0xad, 0x98, 0xe5, 0x9c, 0xa8, 0xe6, 0x88, 0x96, 0xe5, 0xb7, 0xb2, 0xe4, 0xb8, 0x8b, 0xe6, 0x9e,
+    0xb6, 0xe7, 0x9a, 0x84, 0xe4, 0xb8, 0x8d, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x0a, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x4a, 0x02, 0x00, 0x06, 0x12, 0x04, 0xd5, 0x04, 0x02, 0x18, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x4a, 0x02, 0x00, 0x01, 0x12, 0x04, 0xd5, 0x04, 0x19, 0x1e, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x4a, 0x02, 0x00, 0x03, 0x12, 0x04, 0xd5, 0x04, 0x21, 0x22, 0x0a, 0x0c, 0x0a, 0x02, 0x04,
+    0x4b, 0x12, 0x06, 0xd8, 0x04, 0x00, 0xdd, 0x04, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x4b, 0x01,
+    0x12, 0x04, 0xd8, 0x04, 0x08, 0x1e, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x4b, 0x02, 0x00, 0x12, 0x04,
+    0xd9, 0x04, 0x02, 0x41, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4b, 0x02, 0x00, 0x05, 0x12, 0x04, 0xd9,
+    0x04, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4b, 0x02, 0x00, 0x01, 0x12, 0x04, 0xd9, 0x04,
+    0x09, 0x0d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4b, 0x02, 0x00, 0x03, 0x12, 0x04, 0xd9, 0x04, 0x15,
+    0x16, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4b, 0x02, 0x00, 0x08, 0x12, 0x04, 0xd9, 0x04, 0x17, 0x40,
+    0x0a, 0x11, 0x0a, 0x09, 0x04, 0x4b, 0x02, 0x00, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x04, 0xd9,
+    0x04, 0x18, 0x3f, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x4b, 0x02, 0x01, 0x12, 0x04, 0xda, 0x04, 0x02,
+    0x41, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4b, 0x02, 0x01, 0x05, 0x12, 0x04, 0xda, 0x04, 0x02, 0x08,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4b, 0x02, 0x01, 0x01, 0x12, 0x04, 0xda, 0x04, 0x09, 0x0d, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x4b, 0x02, 0x01, 0x03, 0x12, 0x04, 0xda, 0x04, 0x15, 0x16, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x4b, 0x02, 0x01, 0x08, 0x12, 0x04, 0xda, 0x04, 0x17, 0x40, 0x0a, 0x11, 0x0a,
+    0x09, 0x04, 0x4b, 0x02, 0x01, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x04, 0xda, 0x04, 0x18, 0x3f,
+    0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x4b, 0x02, 0x02, 0x12, 0x04, 0xdb, 0x04, 0x02, 0x17, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x4b, 0x02, 0x02, 0x05, 0x12, 0x04, 0xdb, 0x04, 0x02, 0x08, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x4b, 0x02, 0x02, 0x01, 0x12, 0x04, 0xdb, 0x04, 0x09, 0x12, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x4b, 0x02, 0x02, 0x03, 0x12, 0x04, 0xdb, 0x04, 0x15, 0x16, 0x0a, 0x0c, 0x0a, 0x04, 0x04,
+    0x4b, 0x02, 0x03, 0x12, 0x04, 0xdc, 0x04, 0x02, 0x17, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4b, 0x02,
+    0x03, 0x05, 0x12, 0x04, 0xdc, 0x04, 0x02, 0x06, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4b, 0x02, 0x03,
+    0x01, 0x12, 0x04, 0xdc, 0x04, 0x09, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4b, 0x02, 0x03, 0x03,
+    0x12, 0x04, 0xdc, 0x04, 0x15, 0x16, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x4c, 0x12, 0x06, 0xdf, 0x04,
+    0x00, 0xe1, 0x04, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x4c, 0x01, 0x12, 0x04, 0xdf, 0x04, 0x08,
+    0x1f, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x4c, 0x02, 0x00, 0x12, 0x04, 0xe0, 0x04, 0x02, 0x15, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x4c, 0x02, 0x00, 0x06, 0x12, 0x04, 0xe0, 0x04, 0x02, 0x0b, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x4c, 0x02, 0x00, 0x01, 0x12, 0x04, 0xe0, 0x04, 0x0c, 0x10, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x4c, 0x02, 0x00, 0x03, 0x12, 0x04, 0xe0, 0x04, 0x13, 0x14, 0x0a, 0x0c, 0x0a, 0x02,
+    0x04, 0x4d, 0x12, 0x06, 0xe3, 0x04, 0x00, 0xec, 0x04, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x4d,
+    0x01, 0x12, 0x04, 0xe3, 0x04, 0x08, 0x1e, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x4d, 0x02, 0x00, 0x12,
+    0x04, 0xe4, 0x04, 0x02, 0x3f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4d, 0x02, 0x00, 0x05, 0x12, 0x04,
+    0xe4, 0x04, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4d, 0x02, 0x00, 0x01, 0x12, 0x04, 0xe4,
+    0x04, 0x09, 0x0d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4d, 0x02, 0x00, 0x03, 0x12, 0x04, 0xe4, 0x04,
+    0x13, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4d, 0x02, 0x00, 0x08, 0x12, 0x04, 0xe4, 0x04, 0x15,
+    0x3e, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x4d, 0x02, 0x00, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x04,
+    0xe4, 0x04, 0x16, 0x3d, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x4d, 0x02, 0x01, 0x12, 0x04, 0xe5, 0x04,
+    0x02, 0x3f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4d, 0x02, 0x01, 0x05, 0x12, 0x04, 0xe5, 0x04, 0x02,
+    0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4d, 0x02, 0x01, 0x01, 0x12, 0x04, 0xe5, 0x04, 0x09, 0x0d,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4d, 0x02, 0x01, 0x03, 0x12, 0x04, 0xe5, 0x04, 0x13, 0x14, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x4d, 0x02, 0x01, 0x08, 0x12, 0x04, 0xe5, 0x04, 0x15, 0x3e, 0x0a, 0x11,
+    0x0a, 0x09, 0x04, 0x4d, 0x02, 0x01, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x04, 0xe5, 0x04, 0x16,
+    0x3d, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x4d, 0x02, 0x02, 0x12, 0x04, 0xe6, 0x04, 0x02, 0x15, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x4d, 0x02, 0x02, 0x05, 0x12, 0x04, 0xe6, 0x04, 0x02, 0x08, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x4d, 0x02, 0x02, 0x01, 0x12, 0x04, 0xe6, 0x04, 0x09, 0x0d, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x4d, 0x02, 0x02, 0x03, 0x12, 0x04, 0xe6, 0x04, 0x13, 0x14, 0x0a, 0x0c, 0x0a, 0x04,
+    0x04, 0x4d, 0x02, 0x03, 0x12, 0x04, 0xe7, 0x04, 0x02, 0x3f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4d,
+    0x02, 0x03, 0x05, 0x12, 0x04, 0xe7, 0x04, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4d, 0x02,
+    0x03, 0x01, 0x12, 0x04, 0xe7, 0x04, 0x09, 0x0c, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4d, 0x02, 0x03,
+    0x03, 0x12, 0x04, 0xe7, 0x04, 0x13, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4d, 0x02, 0x03, 0x08,
+    0x12, 0x04, 0xe7, 0x04, 0x15, 0x3e, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x4d, 0x02, 0x03, 0x08, 0x87,
+    0x09, 0x0e, 0x02, 0x12, 0x04, 0xe7, 0x04, 0x16, 0x3d, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x4d, 0x02,
+    0x04, 0x12, 0x04, 0xe8, 0x04, 0x02, 0x3a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4d, 0x02, 0x04, 0x05,
+    0x12, 0x04, 0xe8, 0x04, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4d, 0x02, 0x04, 0x01, 0x12,
+    0x04, 0xe8, 0x04, 0x09, 0x0e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4d, 0x02, 0x04, 0x03, 0x12, 0x04,
+    0xe8, 0x04, 0x13, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4d, 0x02, 0x04, 0x08, 0x12, 0x04, 0xe8,
+    0x04, 0x15, 0x39, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x4d, 0x02, 0x04, 0x08, 0x87, 0x09, 0x05, 0x04,
+    0x12, 0x04, 0xe8, 0x04, 0x16, 0x38, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x4d, 0x02, 0x05, 0x12, 0x04,
+    0xe9, 0x04, 0x02, 0x3a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4d, 0x02, 0x05, 0x05, 0x12, 0x04, 0xe9,
+    0x04, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4d, 0x02, 0x05, 0x01, 0x12, 0x04, 0xe9, 0x04,
+    0x09, 0x0f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4d, 0x02, 0x05, 0x03, 0x12, 0x04, 0xe9, 0x04, 0x13,
+    0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4d, 0x02, 0x05, 0x08, 0x12, 0x04, 0xe9, 0x04, 0x15, 0x39,
+    0x0a, 0x11, 0x0a, 0x09, 0x04, 0x4d, 0x02, 0x05, 0x08, 0x87, 0x09, 0x05, 0x04, 0x12, 0x04, 0xe9,
+    0x04, 0x16, 0x38, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x4d, 0x02, 0x06, 0x12, 0x04, 0xea, 0x04, 0x02,
+    0x3f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4d, 0x02, 0x06, 0x05, 0x12, 0x04, 0xea, 0x04, 0x02, 0x08,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4d, 0x02, 0x06, 0x01, 0x12, 0x04, 0xea, 0x04, 0x09, 0x0f, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x4d, 0x02, 0x06, 0x03, 0x12, 0x04, 0xea, 0x04, 0x13, 0x14, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x4d, 0x02, 0x06, 0x08, 0x12, 0x04, 0xea, 0x04, 0x15, 0x3e, 0x0a, 0x11, 0x0a,
+    0x09, 0x04, 0x4d, 0x02, 0x06, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x04, 0xea, 0x04, 0x16, 0x3d,
+    0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x4d, 0x02, 0x07, 0x12, 0x04, 0xeb, 0x04, 0x02, 0x15, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x4d, 0x02, 0x07, 0x05, 0x12, 0x04, 0xeb, 0x04, 0x02, 0x06, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x4d, 0x02, 0x07, 0x01, 0x12, 0x04, 0xeb, 0x04, 0x09, 0x10, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x4d, 0x02, 0x07, 0x03, 0x12, 0x04, 0xeb, 0x04, 0x13, 0x14, 0x0a, 0x0c, 0x0a, 0x02, 0x04,
+    0x4e, 0x12, 0x06, 0xee, 0x04, 0x00, 0xf0, 0x04, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x4e, 0x01,
+    0x12, 0x04, 0xee, 0x04, 0x08, 0x1f, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x4e, 0x02, 0x00, 0x12, 0x04,
+    0xef, 0x04, 0x02, 0x15, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4e, 0x02, 0x00, 0x06, 0x12, 0x04, 0xef,
+    0x04, 0x02, 0x0b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4e, 0x02, 0x00, 0x01, 0x12, 0x04, 0xef, 0x04,
+    0x0c, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4e, 0x02, 0x00, 0x03, 0x12, 0x04, 0xef, 0x04, 0x13,
+    0x14, 0x0a, 0x1f, 0x0a, 0x02, 0x06, 0x02, 0x12, 0x06, 0xf3, 0x04, 0x00, 0x88, 0x05, 0x01, 0x1a,
+    0x11, 0x20, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe8, 0xa1, 0xa8, 0xe6, 0x83, 0x85, 0xe5, 0x8c,
+    0x85, 0x0a, 0x0a, 0x0b, 0x0a, 0x03, 0x06, 0x02, 0x01, 0x12, 0x04, 0xf3, 0x04, 0x08, 0x1b, 0x0a,
+    0x21, 0x0a, 0x04, 0x06, 0x02, 0x02, 0x00, 0x12, 0x06, 0xf5, 0x04, 0x02, 0xf7, 0x04, 0x03, 0x1a,
+    0x11, 0x20, 0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba, 0xe8, 0xa1, 0xa8, 0xe6, 0x83, 0x85, 0xe5, 0x8c,
+    0x85, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x02, 0x02, 0x00, 0x01, 0x12, 0x04, 0xf5, 0x04, 0x06,
+    0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x02, 0x02, 0x00, 0x02, 0x12, 0x04, 0xf5, 0x04, 0x15, 0x2a,
+    0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x02, 0x02, 0x00, 0x03, 0x12, 0x04, 0xf5, 0x04, 0x35, 0x4b, 0x0a,
+    0x0d, 0x0a, 0x05, 0x06, 0x02, 0x02, 0x00, 0x04, 0x12, 0x04, 0xf6, 0x04, 0x04, 0x3f, 0x0a, 0x11,
+    0x0a, 0x09, 0x06, 0x02, 0x02, 0x00, 0x04, 0xd0, 0x86, 0x03, 0x01, 0x12, 0x04, 0xf6, 0x04, 0x04,
+    0x3f, 0x0a, 0x2d, 0x0a, 0x04, 0x06, 0x02, 0x02, 0x01, 0x12, 0x06, 0xfa, 0x04, 0x02, 0xfc, 0x04,
+    0x03, 0x1a, 0x1d, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe8, 0xa1, 0xa8, 0xe6, 0x83, 0x85,
+    0xe5, 0x8c, 0x85, 0xe5, 0x8f, 0x8a, 0xe5, 0x85, 0xb6, 0xe8, 0xa1, 0xa8, 0xe6, 0x83, 0x85, 0x0a,
+    0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x02, 0x02, 0x01, 0x01, 0x12, 0x04, 0xfa, 0x04, 0x06, 0x12, 0x0a,
+    0x0d, 0x0a, 0x05, 0x06, 0x02, 0x02, 0x01, 0x02, 0x12, 0x04, 0xfa, 0x04, 0x13, 0x26, 0x0a, 0x0d,
+    0x0a, 0x05, 0x06, 0x02, 0x02, 0x01, 0x03, 0x12, 0x04, 0xfa, 0x04, 0x31, 0x45, 0x0a, 0x0d, 0x0a,
+    0x05, 0x06, 0x02, 0x02, 0x01, 0x04, 0x12, 0x04, 0xfb, 0x04, 0x04, 0x3f, 0x0a, 0x11, 0x0a, 0x09,
+    0x06, 0x02, 0x02, 0x01, 0x04, 0xd0, 0x86, 0x03, 0x01, 0x12, 0x04, 0xfb, 0x04, 0x04, 0x3f, 0x0a,
+    0x24, 0x0a, 0x04, 0x06, 0x02, 0x02, 0x02, 0x12, 0x06, 0xff, 0x04, 0x02, 0x81, 0x05, 0x03, 0x1a,
+    0x14, 0x20, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe6, 0x9f, 0xa5, 0xe6, 0x89, 0xbe, 0xe8, 0xa1,
+    0xa8, 0xe6, 0x83, 0x85, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x02, 0x02, 0x02, 0x01, 0x12, 0x04,
+    0xff, 0x04, 0x06, 0x18, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x02, 0x02, 0x02, 0x02, 0x12, 0x04, 0xff,
+    0x04, 0x19, 0x32, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x02, 0x02, 0x02, 0x03, 0x12, 0x04, 0xff, 0x04,
+    0x3d, 0x57, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x02, 0x02, 0x02, 0x04, 0x12, 0x04, 0x80, 0x05, 0x04,
+    0x3f, 0x0a, 0x11, 0x0a, 0x09, 0x06, 0x02, 0x02, 0x02, 0x04, 0xd0, 0x86, 0x03, 0x01, 0x12, 0x04,
+    0x80, 0x05, 0x04, 0x3f, 0x0a, 0x2b, 0x0a, 0x04, 0x06, 0x02, 0x02, 0x03, 0x12, 0x04, 0x84, 0x05,
+    0x02, 0x50, 0x1a, 0x1d, 0x20, 0xe6, 0x96, 0xb0, 0xe5, 0xa2, 0x9e, 0xe6, 0x88, 0x96, 0xe8, 0x80,
+    0x85, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe8, 0xa1, 0xa8, 0xe6, 0x83, 0x85, 0xe5, 0x8c, 0x85,
+    0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x02, 0x02, 0x03, 0x01, 0x12, 0x04, 0x84, 0x05, 0x06, 0x15,
+    0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x02, 0x02, 0x03, 0x02, 0x12, 0x04, 0x84, 0x05, 0x16, 0x2c, 0x0a,
+    0x0d, 0x0a, 0x05, 0x06, 0x02, 0x02, 0x03, 0x03, 0x12, 0x04, 0x84, 0x05, 0x37, 0x4e, 0x0a, 0x37,
+    0x0a, 0x04, 0x06, 0x02, 0x02, 0x04, 0x12, 0x04, 0x87, 0x05, 0x02, 0x50, 0x1a, 0x29, 0x20, 0xe6,
+    0x96, 0xb0, 0xe5, 0xa2, 0x9e, 0xe6, 0x88, 0x96, 0xe8, 0x80, 0x85, 0xe6, 0x9b, 0xb4, 0xe6, 0x96,
+    0xb0, 0xe8, 0xa1, 0xa8, 0xe6, 0x83, 0x85, 0xe5, 0x8c, 0x85, 0xe4, 0xb8, 0xad, 0xe7, 0x9a, 0x84,
+    0xe8, 0xa1, 0xa8, 0xe6, 0x83, 0x85, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x02, 0x02, 0x04, 0x01,
+    0x12, 0x04, 0x87, 0x05, 0x06, 0x15, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x02, 0x02, 0x04, 0x02, 0x12,
+    0x04, 0x87, 0x05, 0x16, 0x2c, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x02, 0x02, 0x04, 0x03, 0x12, 0x04,
+    0x87, 0x05, 0x37, 0x4e, 0x0a, 0x25, 0x0a, 0x02, 0x05, 0x05, 0x12, 0x06, 0x8b, 0x05, 0x00, 0x8f,
+    0x05, 0x01, 0x1a, 0x17, 0x20, 0xe5, 0xaf, 0xb9, 0xe8, 0xb1, 0xa1, 0xe7, 0x9a, 0x84, 0xe8, 0xaf,
+    0x84, 0xe8, 0xae, 0xba, 0xe6, 0xa8, 0xa1, 0xe5, 0xbc, 0x8f, 0x0a, 0x0a, 0x0b, 0x0a, 0x03, 0x05,
+    0x05, 0x01, 0x12, 0x04, 0x8b, 0x05, 0x05, 0x10, 0x0a, 0x22, 0x0a, 0x04, 0x05, 0x05, 0x02, 0x00,
+    0x12, 0x04, 0x8c, 0x05, 0x02, 0x1d, 0x22, 0x14, 0x20, 0xe6, 0x89, 0x80, 0xe6, 0x9c, 0x89, 0xe4,
+    0xba, 0xba, 0xe5, 0x8f, 0xaf, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0d, 0x0a, 0x05,
+    0x05, 0x05, 0x02, 0x00, 0x01, 0x12, 0x04, 0x8c, 0x05, 0x02, 0x13, 0x0a, 0x0d, 0x0a, 0x05, 0x05,
+    0x05, 0x02, 0x00, 0x02, 0x12, 0x04, 0x8c, 0x05, 0x1b, 0x1c, 0x0a, 0x1c, 0x0a, 0x04, 0x05, 0x05,
+    0x02, 0x01, 0x12, 0x04, 0x8d, 0x05, 0x02, 0x1d, 0x22, 0x0e, 0x20, 0xe5, 0x85, 0xb3, 0xe9, 0x97,
+    0xad, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x05, 0x02, 0x01,
+    0x01, 0x12, 0x04, 0x8d, 0x05, 0x02, 0x15, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x05, 0x02, 0x01, 0x02,
+    0x12, 0x04, 0x8d, 0x05, 0x1b, 0x1c, 0x0a, 0x31, 0x0a, 0x04, 0x05, 0x05, 0x02, 0x02, 0x12, 0x04,
+    0x8e, 0x05, 0x02, 0x1d, 0x22, 0x23, 0x20, 0xe4, 0xbb, 0x85, 0xe5, 0x85, 0xb3, 0xe6, 0xb3, 0xa8,
+    0xe4, 0xbd, 0x9c, 0xe8, 0x80, 0x85, 0xe7, 0x9a, 0x84, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe5,
+    0x8f, 0xaf, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x05, 0x02,
+    0x02, 0x01, 0x12, 0x04, 0x8e, 0x05, 0x02, 0x18, 0x0a, 0x0d, 0x0a, 0x05, 0x05, 0x05, 0x02, 0x02,
+    0x02, 0x12, 0x04, 0x8e, 0x05, 0x1b, 0x1c, 0x0a, 0x34, 0x0a, 0x02, 0x04, 0x4f, 0x12, 0x06, 0x92,
+    0x05, 0x00, 0x97, 0x05, 0x01, 0x1a, 0x26, 0x20, 0xe4, 0xbd, 0x9c, 0xe8, 0x80, 0x85, 0xe5, 0xaf,
+    0xb9, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe5, 0xaf, 0xb9, 0xe8, 0xb1, 0xa1, 0xe7, 0x9a, 0x84,
+    0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0xe8, 0xae, 0xbe, 0xe7, 0xbd, 0xae, 0x0a, 0x0a, 0x0b, 0x0a,
+    0x03, 0x04, 0x4f, 0x01, 0x12, 0x04, 0x92, 0x05, 0x08, 0x16, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x4f,
+    0x02, 0x00, 0x12, 0x04, 0x93, 0x05, 0x02, 0x27, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4f, 0x02, 0x00,
+    0x05, 0x12, 0x04, 0x93, 0x05, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4f, 0x02, 0x00, 0x01,
+    0x12, 0x04, 0x93, 0x05, 0x12, 0x15, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4f, 0x02, 0x00, 0x03, 0x12,
+    0x04, 0x93, 0x05, 0x25, 0x26, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x4f, 0x02, 0x01, 0x12, 0x04, 0x94,
+    0x05, 0x02, 0x27, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4f, 0x02, 0x01, 0x06, 0x12, 0x04, 0x94, 0x05,
+    0x02, 0x0d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4f, 0x02, 0x01, 0x01, 0x12, 0x04, 0x94, 0x05, 0x12,
+    0x16, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4f, 0x02, 0x01, 0x03, 0x12, 0x04, 0x94, 0x05, 0x25, 0x26,
+    0x0a, 0x3d, 0x0a, 0x04, 0x04, 0x4f, 0x02, 0x02, 0x12, 0x04, 0x95, 0x05, 0x02, 0x27, 0x22, 0x2f,
+    0x20, 0xe5, 0x91, 0xbd, 0xe4, 0xb8, 0xad, 0xe7, 0x9a, 0x84, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba,
+    0xe9, 0x9c, 0x80, 0xe8, 0xa6, 0x81, 0xe4, 0xbd, 0x9c, 0xe8, 0x80, 0x85, 0xe5, 0xae, 0xa1, 0xe6,
+    0xa0, 0xb8, 0xe5, 0x90, 0x8e, 0xe6, 0x89, 0x8d, 0xe5, 0xb1, 0x95, 0xe7, 0xa4, 0xba, 0x0a, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x4f, 0x02, 0x02, 0x04, 0x12, 0x04, 0x95, 0x05, 0x02, 0x0a, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x4f, 0x02, 0x02, 0x05, 0x12, 0x04, 0x95, 0x05, 0x0b, 0x11, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x4f, 0x02, 0x02, 0x01, 0x12, 0x04, 0x95, 0x05, 0x12, 0x22, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x4f, 0x02, 0x02, 0x03, 0x12, 0x04, 0x95, 0x05, 0x25, 0x26, 0x0a, 0x0c, 0x0a, 0x04, 0x04,
+    0x4f, 0x02, 0x03, 0x12, 0x04, 0x96, 0x05, 0x02, 0x27, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4f, 0x02,
+    0x03, 0x05, 0x12, 0x04, 0x96, 0x05, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4f, 0x02, 0x03,
+    0x01, 0x12, 0x04, 0x96, 0x05, 0x12, 0x17, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x4f, 0x02, 0x03, 0x03,
+    0x12, 0x04, 0x96, 0x05, 0x25, 0x26, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x50, 0x12, 0x06, 0x99, 0x05,
+    0x00, 0x9b, 0x05, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x50, 0x01, 0x12, 0x04, 0x99, 0x05, 0x08,
+    0x20, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x50, 0x02, 0x00, 0x12, 0x04, 0x9a, 0x05, 0x02, 0x34, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x50, 0x02, 0x00, 0x05, 0x12, 0x04, 0x9a, 0x05, 0x02, 0x07, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x50, 0x02, 0x00, 0x01, 0x12, 0x04, 0x9a, 0x05, 0x08, 0x0b, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x50, 0x02, 0x00, 0x03, 0x12, 0x04, 0x9a, 0x05, 0x0e, 0x0f, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x50, 0x02, 0x00, 0x08, 0x12, 0x04, 0x9a, 0x05, 0x10, 0x33, 0x0a, 0x11, 0x0a, 0x09, 0x04,
+    0x50, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x04, 0x9a, 0x05, 0x11, 0x32, 0x0a, 0x0c,
+    0x0a, 0x02, 0x04, 0x51, 0x12, 0x06, 0x9d, 0x05, 0x00, 0x9f, 0x05, 0x01, 0x0a, 0x0b, 0x0a, 0x03,
+    0x04, 0x51, 0x01, 0x12, 0x04, 0x9d, 0x05, 0x08, 0x21, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x51, 0x02,
+    0x00, 0x12, 0x04, 0x9e, 0x05, 0x02, 0x1d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x51, 0x02, 0x00, 0x06,
+    0x12, 0x04, 0x9e, 0x05, 0x02, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x51, 0x02, 0x00, 0x01, 0x12,
+    0x04, 0x9e, 0x05, 0x11, 0x18, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x51, 0x02, 0x00, 0x03, 0x12, 0x04,
+    0x9e, 0x05, 0x1b, 0x1c, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x52, 0x12, 0x06, 0xa1, 0x05, 0x00, 0xa5,
+    0x05, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x52, 0x01, 0x12, 0x04, 0xa1, 0x05, 0x08, 0x23, 0x0a,
+    0x0c, 0x0a, 0x04, 0x04, 0x52, 0x02, 0x00, 0x12, 0x04, 0xa2, 0x05, 0x02, 0x4b, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x52, 0x02, 0x00, 0x05, 0x12, 0x04, 0xa2, 0x05, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x52, 0x02, 0x00, 0x01, 0x12, 0x04, 0xa2, 0x05, 0x12, 0x15, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x52, 0x02, 0x00, 0x03, 0x12, 0x04, 0xa2, 0x05, 0x25, 0x26, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x52,
+    0x02, 0x00, 0x08, 0x12, 0x04, 0xa2, 0x05, 0x27, 0x4a, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x52, 0x02,
+    0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x04, 0xa2, 0x05, 0x28, 0x49, 0x0a, 0x0c, 0x0a, 0x04,
+    0x04, 0x52, 0x02, 0x01, 0x12, 0x04, 0xa3, 0x05, 0x02, 0x57, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x52,
+    0x02, 0x01, 0x06, 0x12, 0x04, 0xa3, 0x05, 0x02, 0x0d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x52, 0x02,
+    0x01, 0x01, 0x12, 0x04, 0xa3, 0x05, 0x12, 0x16, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x52, 0x02, 0x01,
+    0x03, 0x12, 0x04, 0xa3, 0x05, 0x25, 0x26, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x52, 0x02, 0x01, 0x08,
+    0x12, 0x04, 0xa3, 0x05, 0x27, 0x56, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x52, 0x02, 0x01, 0x08, 0x87,
+    0x09, 0x10, 0x02, 0x12, 0x04, 0xa3, 0x05, 0x28, 0x55, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x52, 0x02,
+    0x02, 0x12, 0x04, 0xa4, 0x05, 0x02, 0x56, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x52, 0x02, 0x02, 0x04,
+    0x12, 0x04, 0xa4, 0x05, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x52, 0x02, 0x02, 0x05, 0x12,
+    0x04, 0xa4, 0x05, 0x0b, 0x11, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x52, 0x02, 0x02, 0x01, 0x12, 0x04,
+    0xa4, 0x05, 0x12, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x52, 0x02, 0x02, 0x03, 0x12, 0x04, 0xa4,
+    0x05, 0x25, 0x26, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x52, 0x02, 0x02, 0x08, 0x12, 0x04, 0xa4, 0x05,
+    0x27, 0x55, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x52, 0x02, 0x02, 0x08, 0x87, 0x09, 0x12, 0x02, 0x12,
+    0x04, 0xa4, 0x05, 0x28, 0x54, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x53, 0x12, 0x06, 0xa7, 0x05, 0x00,
+    0xa9, 0x05, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x53, 0x01, 0x12, 0x04, 0xa7, 0x05, 0x08, 0x24,
+    0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x53, 0x02, 0x00, 0x12, 0x04, 0xa8, 0x05, 0x02, 0x1d, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x53, 0x02, 0x00, 0x06, 0x12, 0x04, 0xa8, 0x05, 0x02, 0x10, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x53, 0x02, 0x00, 0x01, 0x12, 0x04, 0xa8, 0x05, 0x11, 0x18, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x53, 0x02, 0x00, 0x03, 0x12, 0x04, 0xa8, 0x05, 0x1b, 0x1c, 0x0a, 0x4a, 0x0a, 0x02, 0x04,
+    0x54, 0x12, 0x06, 0xac, 0x05, 0x00, 0xaf, 0x05, 0x01, 0x1a, 0x3c, 0x20, 0xe7, 0xa6, 0x81, 0xe6,
+    0xad, 0xa2, 0x2f, 0xe8, 0xa7, 0xa3, 0xe9, 0x99, 0xa4, 0xe7, 0xa6, 0x81, 0xe6, 0xad, 0xa2, 0xe7,
+    0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe5, 0x9c, 0xa8, 0xe4, 0xbd, 0x9c, 0xe8, 0x80, 0x85, 0xe7, 0x9a,
+    0x84, 0xe6, 0x89, 0x80, 0xe6, 0x9c, 0x89, 0xe5, 0xaf, 0xb9, 0xe8, 0xb1, 0xa1, 0xe4, 0xb8, 0x8b,
+    0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x54, 0x01, 0x12, 0x04,
+    0xac, 0x05, 0x08, 0x1e, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x54, 0x02, 0x00, 0x12, 0x04, 0xad, 0x05,
+    0x02, 0x3f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x54, 0x02, 0x00, 0x05, 0x12, 0x04, 0xad, 0x05, 0x02,
+    0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x54, 0x02, 0x00, 0x01, 0x12, 0x04, 0xad, 0x05, 0x10, 0x13,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x54, 0x02, 0x00, 0x03, 0x12, 0x04, 0xad, 0x05, 0x19, 0x1a, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x54, 0x02, 0x00, 0x08, 0x12, 0x04, 0xad, 0x05, 0x1b, 0x3e, 0x0a, 0x11,
+    0x0a, 0x09, 0x04, 0x54, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x04, 0xad, 0x05, 0x1c,
+    0x3d, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x54, 0x02, 0x01, 0x12, 0x04, 0xae, 0x05, 0x02, 0x1b, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x54, 0x02, 0x01, 0x06, 0x12, 0x04, 0xae, 0x05, 0x02, 0x0f, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x54, 0x02, 0x01, 0x01, 0x12, 0x04, 0xae, 0x05, 0x10, 0x16, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x54, 0x02, 0x01, 0x03, 0x12, 0x04, 0xae, 0x05, 0x19, 0x1a, 0x0a, 0x0a, 0x0a, 0x02,
+    0x04, 0x55, 0x12, 0x04, 0xb1, 0x05, 0x00, 0x22, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x55, 0x01, 0x12,
+    0x04, 0xb1, 0x05, 0x08, 0x1f, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x56, 0x12, 0x06, 0xb3, 0x05, 0x00,
+    0xb6, 0x05, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x56, 0x01, 0x12, 0x04, 0xb3, 0x05, 0x08, 0x18,
+    0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x56, 0x02, 0x00, 0x12, 0x04, 0xb4, 0x05, 0x02, 0x12, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x56, 0x02, 0x00, 0x05, 0x12, 0x04, 0xb4, 0x05, 0x02, 0x07, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x56, 0x02, 0x00, 0x01, 0x12, 0x04, 0xb4, 0x05, 0x08, 0x0b, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x56, 0x02, 0x00, 0x03, 0x12, 0x04, 0xb4, 0x05, 0x10, 0x11, 0x0a, 0x1f, 0x0a, 0x04, 0x04,
+    0x56, 0x02, 0x01, 0x12, 0x04, 0xb5, 0x05, 0x02, 0x12, 0x22, 0x11, 0x20, 0xe7, 0xa6, 0x81, 0xe6,
+    0xad, 0xa2, 0xe7, 0x9a, 0x84, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x0a, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x56, 0x02, 0x01, 0x05, 0x12, 0x04, 0xb5, 0x05, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x56, 0x02, 0x01, 0x01, 0x12, 0x04, 0xb5, 0x05, 0x08, 0x0d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x56,
+    0x02, 0x01, 0x03, 0x12, 0x04, 0xb5, 0x05, 0x10, 0x11, 0x0a, 0x56, 0x0a, 0x02, 0x04, 0x57, 0x12,
+    0x06, 0xb9, 0x05, 0x00, 0xbc, 0x05, 0x01, 0x1a, 0x48, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96,
+    0xe4, 0xbd, 0x9c, 0xe8, 0x80, 0x85, 0xe7, 0xa6, 0x81, 0xe6, 0xad, 0xa2, 0xe8, 0xaf, 0x84, 0xe8,
+    0xae, 0xba, 0xe7, 0x9a, 0x84, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x20, 0xe6, 0x8c, 0x89, 0xe7,
+    0x85, 0xa7, 0xe7, 0xa6, 0x81, 0xe6, 0xad, 0xa2, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe4, 0xbb,
+    0x8e, 0xe6, 0x99, 0x9a, 0xe5, 0x88, 0xb0, 0xe6, 0x97, 0xa9, 0xe6, 0x8e, 0x92, 0xe5, 0x88, 0x97,
+    0x0a, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x57, 0x01, 0x12, 0x04, 0xb9, 0x05, 0x08, 0x24, 0x0a, 0x1c,
+    0x0a, 0x04, 0x04, 0x57, 0x02, 0x00, 0x12, 0x04, 0xba, 0x05, 0x02, 0x13, 0x22, 0x0e, 0x20, 0xe8,
+    0xb5, 0xb7, 0xe5, 0xa7, 0x8b, 0xe6, 0xb8, 0xb8, 0xe6, 0xa0, 0x87, 0x0a, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x57, 0x02, 0x00, 0x05, 0x12, 0x04, 0xba, 0x05, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x57, 0x02, 0x00, 0x01, 0x12, 0x04, 0xba, 0x05, 0x08, 0x0e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x57,
+    0x02, 0x00, 0x03, 0x12, 0x04, 0xba, 0x05, 0x11, 0x12, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x57, 0x02,
+    0x01, 0x12, 0x04, 0xbb, 0x05, 0x02, 0x13, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x57, 0x02, 0x01, 0x05,
+    0x12, 0x04, 0xbb, 0x05, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x57, 0x02, 0x01, 0x01, 0x12,
+    0x04, 0xbb, 0x05, 0x08, 0x0d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x57, 0x02, 0x01, 0x03, 0x12, 0x04,
+    0xbb, 0x05, 0x11, 0x12, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x58, 0x12, 0x06, 0xbe, 0x05, 0x00, 0xc2,
+    0x05, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x58, 0x01, 0x12, 0x04, 0xbe, 0x05, 0x08, 0x25, 0x0a,
+    0x0c, 0x0a, 0x04, 0x04, 0x58, 0x02, 0x00, 0x12, 0x04, 0xbf, 0x05, 0x02, 0x2c, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x58, 0x02, 0x00, 0x04, 0x12, 0x04, 0xbf, 0x05, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x58, 0x02, 0x00, 0x06, 0x12, 0x04, 0xbf, 0x05, 0x0b, 0x1b, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x58, 0x02, 0x00, 0x01, 0x12, 0x04, 0xbf, 0x05, 0x1c, 0x21, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x58,
+    0x02, 0x00, 0x03, 0x12, 0x04, 0xbf, 0x05, 0x2a, 0x2b, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x58, 0x02,
+    0x01, 0x12, 0x04, 0xc0, 0x05, 0x02, 0x2c, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x58, 0x02, 0x01, 0x05,
+    0x12, 0x04, 0xc0, 0x05, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x58, 0x02, 0x01, 0x01, 0x12,
+    0x04, 0xc0, 0x05, 0x1c, 0x27, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x58, 0x02, 0x01, 0x03, 0x12, 0x04,
+    0xc0, 0x05, 0x2a, 0x2b, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x58, 0x02, 0x02, 0x12, 0x04, 0xc1, 0x05,
+    0x02, 0x2c, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x58, 0x02, 0x02, 0x05, 0x12, 0x04, 0xc1, 0x05, 0x02,
+    0x06, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x58, 0x02, 0x02, 0x01, 0x12, 0x04, 0xc1, 0x05, 0x1c, 0x24,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x58, 0x02, 0x02, 0x03, 0x12, 0x04, 0xc1, 0x05, 0x2a, 0x2b, 0x0a,
+    0x5f, 0x0a, 0x02, 0x04, 0x59, 0x12, 0x06, 0xc5, 0x05, 0x00, 0xc9, 0x05, 0x01, 0x1a, 0x51, 0x20,
+    0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe5, 0xaf, 0xb9, 0xe8, 0xb1, 0xa1, 0xe4, 0xb8, 0x8b, 0xe7,
+    0xad, 0x89, 0xe5, 0xbe, 0x85, 0xe4, 0xbd, 0x9c, 0xe8, 0x80, 0x85, 0xe5, 0xae, 0xa1, 0xe6, 0xa0,
+    0xb8, 0xe7, 0x9a, 0x84, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x20, 0xe6, 0x8c, 0x89, 0xe7, 0x85,
+    0xa7, 0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe4, 0xbb, 0x8e,
+    0xe6, 0x97, 0xa9, 0xe5, 0x88, 0xb0, 0xe6, 0x99, 0x9a, 0xe6, 0x8e, 0x92, 0xe5, 0x88, 0x97, 0x0a,
+    0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x59, 0x01, 0x12, 0x04, 0xc5, 0x05, 0x08, 0x1f, 0x0a, 0x0c, 0x0a,
+    0x04, 0x04, 0x59, 0x02, 0x00, 0x12, 0x04, 0xc6, 0x05, 0x02, 0x37, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x59, 0x02, 0x00, 0x05, 0x12, 0x04, 0xc6, 0x05, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x59,
+    0x02, 0x00, 0x01, 0x12, 0x04, 0xc6, 0x05, 0x08, 0x0b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x59, 0x02,
+    0x00, 0x03, 0x12, 0x04, 0xc6, 0x05, 0x11, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x59, 0x02, 0x00,
+    0x08, 0x12, 0x04, 0xc6, 0x05, 0x13, 0x36, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x59, 0x02, 0x00, 0x08,
+    0x87, 0x09, 0x04, 0x04, 0x12, 0x04, 0xc6, 0x05, 0x14, 0x35, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x59,
+    0x02, 0x01, 0x12, 0x04, 0xc7, 0x05, 0x02, 0x13, 0x22, 0x0e, 0x20, 0xe8, 0xb5, 0xb7, 0xe5, 0xa7,
+    0x8b, 0xe6, 0xb8, 0xb8, 0xe6, 0xa0, 0x87, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x59, 0x02, 0x01,
+    0x05, 0x12, 0x04, 0xc7, 0x05, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x59, 0x02, 0x01, 0x01,
+    0x12, 0x04, 0xc7, 0x05, 0x08, 0x0e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x59, 0x02, 0x01, 0x03, 0x12,
+    0x04, 0xc7, 0x05, 0x11, 0x12, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x59, 0x02, 0x02, 0x12, 0x04, 0xc8,
+    0x05, 0x02, 0x13, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x59, 0x02, 0x02, 0x05, 0x12, 0x04, 0xc8, 0x05,
+    0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x59, 0x02, 0x02, 0x01, 0x12, 0x04, 0xc8, 0x05, 0x08,
+    0x0d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x59, 0x02, 0x02, 0x03, 0x12, 0x04, 0xc8, 0x05, 0x11, 0x12,
+    0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x5a, 0x12, 0x06, 0xcb, 0x05, 0x00, 0xcf, 0x05, 0x01, 0x0a, 0x0b,
+    0x0a, 0x03, 0x04, 0x5a, 0x01, 0x12, 0x04, 0xcb, 0x05, 0x08, 0x20, 0x0a, 0x0c, 0x0a, 0x04, 0x04,
+    0x5a, 0x02, 0x00, 0x12, 0x04, 0xcc, 0x05, 0x02, 0x27, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x5a, 0x02,
+    0x00, 0x04, 0x12, 0x04, 0xcc, 0x05, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x5a, 0x02, 0x00,
+    0x06, 0x12, 0x04, 0xcc, 0x05, 0x0b, 0x16, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x5a, 0x02, 0x00, 0x01,
+    0x12, 0x04, 0xcc, 0x05, 0x17, 0x1f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x5a, 0x02, 0x00, 0x03, 0x12,
+    0x04, 0xcc, 0x05, 0x25, 0x26, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x5a, 0x02, 0x01, 0x12, 0x04, 0xcd,
+    0x05, 0x02, 0x27, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x5a, 0x02, 0x01, 0x05, 0x12, 0x04, 0xcd, 0x05,
+    0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x5a, 0x02, 0x01, 0x01, 0x12, 0x04, 0xcd, 0x05, 0x17,
+    0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x5a, 0x02, 0x01, 0x03, 0x12, 0x04, 0xcd, 0x05, 0x25, 0x26,
+    0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x5a, 0x02, 0x02, 0x12, 0x04, 0xce, 0x05, 0x02, 0x27, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x5a, 0x02, 0x02, 0x05, 0x12, 0x04, 0xce, 0x05, 0x02, 0x06, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x5a, 0x02, 0x02, 0x01, 0x12, 0x04, 0xce, 0x05, 0x17, 0x1f, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x5a, 0x02, 0x02, 0x03, 0x12, 0x04, 0xce, 0x05, 0x25, 0x26, 0x0a, 0x2b, 0x0a, 0x02, 0x04,
+    0x5b, 0x12, 0x06, 0xd2, 0x05, 0x00, 0xd5, 0x05, 0x01, 0x1a, 0x1d, 0x20, 0xe9, 0x80, 0x9a, 0xe8,
+    0xbf, 0x87, 0xe7, 0xad, 0x89, 0xe5, 0xbe, 0x85, 0xe5, 0xae, 0xa1, 0xe6, 0xa0, 0xb8, 0xe7, 0x9a,
+    0x84, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x5b, 0x01, 0x12,
+    0x04, 0xd2, 0x05, 0x08, 0x22, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x5b, 0x02, 0x00, 0x12, 0x04, 0xd3,
+    0x05, 0x02, 0x45, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x5b, 0x02, 0x00, 0x05, 0x12, 0x04, 0xd3, 0x05,
+    0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x5b, 0x02, 0x00, 0x01, 0x12, 0x04, 0xd3, 0x05, 0x11,
+    0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x5b, 0x02, 0x00, 0x03, 0x12, 0x04, 0xd3, 0x05, 0x1f, 0x20,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x5b, 0x02, 0x00, 0x08, 0x12, 0x04, 0xd3, 0x05, 0x21, 0x44, 0x0a,
+    0x11, 0x0a, 0x09, 0x04, 0x5b, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x04, 0xd3, 0x05,
+    0x22, 0x43, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x5b, 0x02, 0x01, 0x12, 0x04, 0xd4, 0x05, 0x02, 0x61,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x5b, 0x02, 0x01, 0x04, 0x12, 0x04, 0xd4, 0x05, 0x02, 0x0a, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x5b, 0x02, 0x01, 0x05, 0x12, 0x04, 0xd4, 0x05, 0x0b, 0x10, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x5b, 0x02, 0x01, 0x01, 0x12, 0x04, 0xd4, 0x05, 0x11, 0x1c, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x5b, 0x02, 0x01, 0x03, 0x12, 0x04, 0xd4, 0x05, 0x1f, 0x20, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x5b, 0x02, 0x01, 0x08, 0x12, 0x04, 0xd4, 0x05, 0x21, 0x60, 0x0a, 0x10, 0x0a, 0x08, 0x04,
+    0x5b, 0x02, 0x01, 0x08, 0x87, 0x09, 0x12, 0x12, 0x04, 0xd4, 0x05, 0x22, 0x5f, 0x0a, 0x11, 0x0a,
+    0x09, 0x04, 0x5b, 0x02, 0x01, 0x08, 0x87, 0x09, 0x12, 0x01, 0x12, 0x04, 0xd4, 0x05, 0x43, 0x4f,
+    0x0a, 0x11, 0x0a, 0x09, 0x04, 0x5b, 0x02, 0x01, 0x08, 0x87, 0x09, 0x12, 0x02, 0x12, 0x04, 0xd4,
+    0x05, 0x51, 0x5e, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x5c, 0x12, 0x06, 0xd7, 0x05, 0x00, 0xda, 0x05,
+    0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x5c, 0x01, 0x12, 0x04, 0xd7, 0x05, 0x08, 0x23, 0x0a, 0x25,
+    0x0a, 0x04, 0x04, 0x5c, 0x02, 0x00, 0x12, 0x04, 0xd8, 0x05, 0x02, 0x24, 0x22, 0x17, 0x20, 0xe9,
+    0x80, 0x9a, 0xe8, 0xbf, 0x87, 0xe7, 0x9a, 0x84, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe6, 0x95,
+    0xb0, 0xe9, 0x87, 0x8f, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x5c, 0x02, 0x00, 0x05, 0x12, 0x04,
+    0xd8, 0x05, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x5c, 0x02, 0x00, 0x01, 0x12, 0x04, 0xd8,
+    0x05, 0x17, 0x1f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x5c, 0x02, 0x00, 0x03, 0x12, 0x04, 0xd8, 0x05,
+    0x22, 0x23, 0x0a, 0x53, 0x0a, 0x04, 0x04, 0x5c, 0x02, 0x01, 0x12, 0x04, 0xd9, 0x05, 0x02, 0x24,
+    0x22, 0x45, 0x20, 0xe9, 0x80, 0x9a, 0xe8, 0xbf, 0x87, 0xe7, 0x9a, 0x84, 0xe8, 0xaf, 0x84, 0xe8,
+    0xae, 0xba, 0x20, 0xe5, 0x8c, 0x85, 0xe5, 0x90, 0xab, 0x40, 0xe7, 0x9a, 0x84, 0xe7, 0x94, 0xa8,
+    0xe6, 0x88, 0xb7, 0x20, 0xe7, 0x94, 0xa8, 0xe4, 0xba, 0x8e, 0xe9, 0x80, 0x9a, 0xe8, 0xbf, 0x87,
+    0xe5, 0x90, 0x8e, 0xe9, 0x80, 0x9a, 0xe7, 0x9f, 0xa5, 0xe8, 0xa2, 0xab, 0x40, 0xe7, 0x9a, 0x84,
+    0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x5c, 0x02, 0x01, 0x04,
+    0x12, 0x04, 0xd9, 0x05, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x5c, 0x02, 0x01, 0x06, 0x12,
+    0x04, 0xd9, 0x05, 0x0b, 0x16, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x5c, 0x02, 0x01, 0x01, 0x12, 0x04,
+    0xd9, 0x05, 0x17, 0x1f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x5c, 0x02, 0x01, 0x03, 0x12, 0x04, 0xd9,
+    0x05, 0x22, 0x23, 0x0a, 0x25, 0x0a, 0x02, 0x06, 0x03, 0x12, 0x06, 0xdd, 0x05, 0x00, 0xef, 0x05,
+    0x01, 0x1a, 0x17, 0x20, 0xe4, 0xbd, 0x9c, 0xe8, 0x80, 0x85, 0xe7, 0x9a, 0x84, 0xe8, 0xaf, 0x84,
+    0xe8, 0xae, 0xba, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x0a, 0x0a, 0x0b, 0x0a, 0x03, 0x06, 0x03,
+    0x01, 0x12, 0x04, 0xdd, 0x05, 0x08, 0x1d, 0x0a, 0x2b, 0x0a, 0x04, 0x06, 0x03, 0x02, 0x00, 0x12,
+    0x04, 0xdf, 0x05, 0x02, 0x56, 0x1a, 0x1d, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe5, 0xaf,
+    0xb9, 0xe8, 0xb1, 0xa1, 0xe7, 0x9a, 0x84, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe8, 0xae, 0xbe,
+    0xe7, 0xbd, 0xae, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x03, 0x02, 0x00, 0x01, 0x12, 0x04, 0xdf,
+    0x05, 0x06, 0x17, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x03, 0x02, 0x00, 0x02, 0x12, 0x04, 0xdf, 0x05,
+    0x18, 0x30, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x03, 0x02, 0x00, 0x03, 0x12, 0x04, 0xdf, 0x05, 0x3b,
+    0x54, 0x0a, 0x2b, 0x0a, 0x04, 0x06, 0x03, 0x02, 0x01, 0x12, 0x04, 0xe2, 0x05, 0x02, 0x5f, 0x1a,
+    0x1d, 0x20, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe5, 0xaf, 0xb9, 0xe8, 0xb1, 0xa1, 0xe7, 0x9a,
+    0x84, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe8, 0xae, 0xbe, 0xe7, 0xbd, 0xae, 0x0a, 0x0a, 0x0d,
+    0x0a, 0x05, 0x06, 0x03, 0x02, 0x01, 0x01, 0x12, 0x04, 0xe2, 0x05, 0x06, 0x1a, 0x0a, 0x0d, 0x0a,
+    0x05, 0x06, 0x03, 0x02, 0x01, 0x02, 0x12, 0x04, 0xe2, 0x05, 0x1b, 0x36, 0x0a, 0x0d, 0x0a, 0x05,
+    0x06, 0x03, 0x02, 0x01, 0x03, 0x12, 0x04, 0xe2, 0x05, 0x41, 0x5d, 0x0a, 0x2f, 0x0a, 0x04, 0x06,
+    0x03, 0x02, 0x02, 0x12, 0x04, 0xe5, 0x05, 0x02, 0x50, 0x1a, 0x21, 0x20, 0xe7, 0xa6, 0x81, 0xe6,
+    0xad, 0xa2, 0x2f, 0xe8, 0xa7, 0xa3, 0xe9, 0x99, 0xa4, 0xe7, 0xa6, 0x81, 0xe6, 0xad, 0xa2, 0xe7,
+    0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0d, 0x0a, 0x05,
+    0x06, 0x03, 0x02, 0x02, 0x01, 0x12, 0x04, 0xe5, 0x05, 0x06, 0x15, 0x0a, 0x0d, 0x0a, 0x05, 0x06,
+    0x03, 0x02, 0x02, 0x02, 0x12, 0x04, 0xe5, 0x05, 0x16, 0x2c, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x03,
+    0x02, 0x02, 0x03, 0x12, 0x04, 0xe5, 0x05, 0x37, 0x4e, 0x0a, 0x2b, 0x0a, 0x04, 0x06, 0x03, 0x02,
+    0x03, 0x12, 0x04, 0xe8, 0x05, 0x02, 0x62, 0x1a, 0x1d, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96,
+    0xe7, 0xa6, 0x81, 0xe6, 0xad, 0xa2, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe7, 0x9a, 0x84, 0xe7,
+    0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x03, 0x02, 0x03, 0x01, 0x12,
+    0x04, 0xe8, 0x05, 0x06, 0x1b, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x03, 0x02, 0x03, 0x02, 0x12, 0x04,
+    0xe8, 0x05, 0x1c, 0x38, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x03, 0x02, 0x03, 0x03, 0x12, 0x04, 0xe8,
+    0x05, 0x43, 0x60, 0x0a, 0x2b, 0x0a, 0x04, 0x06, 0x03, 0x02, 0x04, 0x12, 0x04, 0xeb, 0x05, 0x02,
+    0x53, 0x1a, 0x1d, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe7, 0xad, 0x89, 0xe5, 0xbe, 0x85,
+    0xe5, 0xae, 0xa1, 0xe6, 0xa0, 0xb8, 0xe7, 0x9a, 0x84, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a,
+    0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x03, 0x02, 0x04, 0x01, 0x12, 0x04, 0xeb, 0x05, 0x06, 0x16, 0x0a,
+    0x0d, 0x0a, 0x05, 0x06, 0x03, 0x02, 0x04, 0x02, 0x12, 0x04, 0xeb, 0x05, 0x17, 0x2e, 0x0a, 0x0d,
+    0x0a, 0x05, 0x06, 0x03, 0x02, 0x04, 0x03, 0x12, 0x04, 0xeb, 0x05, 0x39, 0x51, 0x0a, 0x2b, 0x0a,
+    0x04, 0x06, 0x03, 0x02, 0x05, 0x12, 0x04, 0xee, 0x05, 0x02, 0x5c, 0x1a, 0x1d, 0x20, 0xe9, 0x80,
+    0x9a, 0xe8, 0xbf, 0x87, 0xe7, 0xad, 0x89, 0xe5, 0xbe, 0x85, 0xe5, 0xae, 0xa1, 0xe6, 0xa0, 0xb8,
+    0xe7, 0x9a, 0x84, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x03,
+    0x02, 0x05, 0x01, 0x12, 0x04, 0xee, 0x05, 0x06, 0x19, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x03, 0x02,
+    0x05, 0x02, 0x12, 0x04, 0xee, 0x05, 0x1a, 0x34, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x03, 0x02, 0x05,
+    0x03, 0x12, 0x04, 0xee, 0x05, 0x3f, 0x5a, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 ];
 include!("comment.api.v1.tonic.rs");
 // @@protoc_insertion_point(module)
\ No newline at end of file
@@ -10,7 +10,6 @@ pub mod comment_service_client {
     )]
     use tonic::codegen::*;
     use tonic::codegen::http::Uri;
-    ///
     #[derive(Debug, Clone)]
     pub struct CommentServiceClient<T> {
         inner: tonic::client::Grpc<T>,
@@ -91,8 +90,6 @@ pub mod comment_service_client {
             self.inner = self.inner.max_encoding_message_size(limit);
             self
         }
-        /** 发表评论
-*/
         pub async fn add_comment(
             &mut self,
             request: impl tonic::IntoRequest<super::AddCommentRequest>,
@@ -117,8 +114,6 @@ pub mod comment_service_client {
                 .insert(GrpcMethod::new("comment.api.v1.CommentService", "AddComment"));
             self.inner.unary(req, path, codec).await
         }
-        /** 删除评论
-*/
         pub async fn del_comment(
             &mut self,
             request: impl tonic::IntoRequest<super::DelCommentRequest>,
@@ -143,8 +138,6 @@ pub mod comment_service_client {
                 .insert(GrpcMethod::new("comment.api.v1.CommentService", "DelComment"));
             self.inner.unary(req, path, codec).await
         }
-        /** 赞
-*/
         pub async fn like_action(
             &mut self,
             request: impl tonic::IntoRequest<super::LikeActionRequest>,
@@ -169,8 +162,6 @@ pub mod comment_service_client {
                 .insert(GrpcMethod::new("comment.api.v1.CommentService", "LikeAction"));
             self.inner.unary(req, path, codec).await
         }
-        /** 踩
-*/
         pub async fn dislike_action(
             &mut self,
             request: impl tonic::IntoRequest<super::DislikeActionRequest>,
@@ -197,8 +188,6 @@ pub mod comment_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
-        /** 举报
-*/
         pub async fn report_comment(
             &mut self,
             request: impl tonic::IntoRequest<super::ReportCommentRequest>,
@@ -225,8 +214,6 @@ pub mod comment_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
-        /** 置顶评论
-*/
         pub async fn pin_comment(
             &mut self,
             request: impl tonic::IntoRequest<super::PinCommentRequest>,
@@ -251,8 +238,6 @@ pub mod comment_service_client {
                 .insert(GrpcMethod::new("comment.api.v1.CommentService", "PinComment"));
             self.inner.unary(req, path, codec).await
         }
-        /** 获取主评论信息
-*/
         pub async fn page_get_comment(
             &mut self,
             request: impl tonic::IntoRequest<super::PageGetCommentRequest>,
@@ -279,8 +264,6 @@ pub mod comment_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
-        /** 获取子评论信息
-*/
         pub async fn page_get_sub_comment(
             &mut self,
             request: impl tonic::IntoRequest<super::PageGetSubCommentRequest>,
@@ -307,8 +290,6 @@ pub mod comment_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
-        /** 分页获取子评论信息
-*/
         pub async fn page_get_sub_comment_v2(
             &mut self,
             request: impl tonic::IntoRequest<super::PageGetSubCommentV2Request>,
@@ -338,8 +319,6 @@ pub mod comment_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
-        /** 获取主评论详细信息
-*/
         pub async fn page_get_detailed_comment(
             &mut self,
             request: impl tonic::IntoRequest<super::PageGetDetailedCommentRequest>,
@@ -369,7 +348,6 @@ pub mod comment_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
-        ///
         pub async fn page_get_detailed_comment_v2(
             &mut self,
             request: impl tonic::IntoRequest<super::PageGetDetailedCommentV2Request>,
@@ -399,8 +377,6 @@ pub mod comment_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
-        /** 获取置顶评论
-*/
         pub async fn get_pinned_comment(
             &mut self,
             request: impl tonic::IntoRequest<super::GetPinnedCommentRequest>,
@@ -427,8 +403,6 @@ pub mod comment_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
-        /** 获取某个被评对象的评论数
-*/
         pub async fn count_comment(
             &mut self,
             request: impl tonic::IntoRequest<super::CountCommentRequest>,
@@ -455,8 +429,6 @@ pub mod comment_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
-        /** 获取多个被评论对象的评论数
-*/
         pub async fn batch_count_comment(
             &mut self,
             request: impl tonic::IntoRequest<super::BatchCountCommentRequest>,
@@ -483,8 +455,6 @@ pub mod comment_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
-        /** 获取某条评论的点赞数
-*/
         pub async fn get_comment_like_count(
             &mut self,
             request: impl tonic::IntoRequest<super::GetCommentLikeCountRequest>,
@@ -514,8 +484,6 @@ pub mod comment_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
-        /** 获取某条评论的点踩数
-*/
         pub async fn get_comment_dislike_count(
             &mut self,
             request: impl tonic::IntoRequest<super::GetCommentDislikeCountRequest>,
@@ -545,8 +513,6 @@ pub mod comment_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
-        /** 获取某个用户是否评论了某个对象
-*/
         pub async fn check_user_on_object(
             &mut self,
             request: impl tonic::IntoRequest<super::CheckUserOnObjectRequest>,
@@ -573,8 +539,6 @@ pub mod comment_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
-        /** 批量检查某个用户是否评论了某个对象
-*/
         pub async fn batch_check_user_on_object(
             &mut self,
             request: impl tonic::IntoRequest<super::BatchCheckUserOnObjectRequest>,
@@ -604,8 +568,6 @@ pub mod comment_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
-        /** 批量检查某个用户是否点赞了某些评论
-*/
         pub async fn batch_check_user_like_comment(
             &mut self,
             request: impl tonic::IntoRequest<super::BatchCheckUserLikeCommentRequest>,
@@ -635,8 +597,6 @@ pub mod comment_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
-        /** 批量检查评论是否存在
-*/
         pub async fn batch_check_comment_exist(
             &mut self,
             request: impl tonic::IntoRequest<super::BatchCheckCommentExistRequest>,
@@ -666,8 +626,6 @@ pub mod comment_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
-        /** 按照id获取评论
-*/
         pub async fn get_comment(
             &mut self,
             request: impl tonic::IntoRequest<super::GetCommentRequest>,
@@ -692,8 +650,6 @@ pub mod comment_service_client {
                 .insert(GrpcMethod::new("comment.api.v1.CommentService", "GetComment"));
             self.inner.unary(req, path, codec).await
         }
-        /** 获取评论作者
-*/
         pub async fn get_comment_user(
             &mut self,
             request: impl tonic::IntoRequest<super::GetCommentUserRequest>,
@@ -720,6 +676,59 @@ pub mod comment_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
+        pub async fn edit_comment(
+            &mut self,
+            request: impl tonic::IntoRequest<super::EditCommentRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::EditCommentResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/comment.api.v1.CommentService/EditComment",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("comment.api.v1.CommentService", "EditComment"));
+            self.inner.unary(req, path, codec).await
+        }
+        pub async fn get_comment_edit_history(
+            &mut self,
+            request: impl tonic::IntoRequest<super::GetCommentEditHistoryRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetCommentEditHistoryResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/comment.api.v1.CommentService/GetCommentEditHistory",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "comment.api.v1.CommentService",
+                        "GetCommentEditHistory",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
     }
 }
 /// Generated server implementations.
@@ -735,8 +744,6 @@ pub mod comment_service_server {
     /// Generated trait containing gRPC methods that should be implemented for use with CommentServiceServer.
     #[async_trait]
     pub trait CommentService: std::marker::Send + std::marker::Sync + 'static {
-        /** 发表评论
-*/
         async fn add_comment(
             &self,
             request: tonic::Request<super::AddCommentRequest>,
@@ -744,8 +751,6 @@ pub mod comment_service_server {
             tonic::Response<super::AddCommentResponse>,
             tonic::Status,
         >;
-        /** 删除评论
-*/
         async fn del_comment(
             &self,
             request: tonic::Request<super::DelCommentRequest>,
@@ -753,8 +758,6 @@ pub mod comment_service_server {
             tonic::Response<super::DelCommentResponse>,
             tonic::Status,
         >;
-        /** 赞
-*/
         async fn like_action(
             &self,
             request: tonic::Request<super::LikeActionRequest>,
@@ -762,8 +765,6 @@ pub mod comment_service_server {
             tonic::Response<super::LikeActionResponse>,
             tonic::Status,
         >;
-        /** 踩
-*/
         async fn dislike_action(
             &self,
             request: tonic::Request<super::DislikeActionRequest>,
@@ -771,8 +772,6 @@ pub mod comment_service_server {
             tonic::Response<super::DislikeActionResponse>,
             tonic::Status,
         >;
-        /** 举报
-*/
         async fn report_comment(
             &self,
             request: tonic::Request<super::ReportCommentRequest>,
@@ -780,8 +779,6 @@ pub mod comment_service_server {
             tonic::Response<super::ReportCommentResponse>,
             tonic::Status,
         >;
-        /** 置顶评论
-*/
         async fn pin_comment(
             &self,
             request: tonic::Request<super::PinCommentRequest>,
@@ -789,8 +786,6 @@ pub mod comment_service_server {
             tonic::Response<super::PinCommentResponse>,
             tonic::Status,
         >;
-        /** 获取主评论信息
-*/
         async fn page_get_comment(
             &self,
             request: tonic::Request<super::PageGetCommentRequest>,
@@ -798,8 +793,6 @@ pub mod comment_service_server {
             tonic::Response<super::PageGetCommentResponse>,
             tonic::Status,
         >;
-        /** 获取子评论信息
-*/
         async fn page_get_sub_comment(
             &self,
             request: tonic::Request<super::PageGetSubCommentRequest>,
@@ -807,8 +800,6 @@ pub mod comment_service_server {
             tonic::Response<super::PageGetSubCommentResponse>,
             tonic::Status,
         >;
-        /** 分页获取子评论信息
-*/
         async fn page_get_sub_comment_v2(
             &self,
             request: tonic::Request<super::PageGetSubCommentV2Request>,
@@ -816,8 +807,6 @@ pub mod comment_service_server {
             tonic::Response<super::PageGetSubCommentV2Response>,
             tonic::Status,
         >;
-        /** 获取主评论详细信息
-*/
         async fn page_get_detailed_comment(
             &self,
             request: tonic::Request<super::PageGetDetailedCommentRequest>,
@@ -825,7 +814,6 @@ pub mod comment_service_server {
             tonic::Response<super::PageGetDetailedCommentResponse>,
             tonic::Status,
         >;
-        ///
         async fn page_get_detailed_comment_v2(
             &self,
             request: tonic::Request<super::PageGetDetailedCommentV2Request>,
@@ -833,8 +821,6 @@ pub mod comment_service_server {
             tonic::Response<super::PageGetDetailedCommentV2Response>,
             tonic::Status,
         >;
-        /** 获取置顶评论
-*/
         async fn get_pinned_comment(
             &self,
             request: tonic::Request<super::GetPinnedCommentRequest>,
@@ -842,8 +828,6 @@ pub mod comment_service_server {
             tonic::Response<super::GetPinnedCommentResponse>,
             tonic::Status,
         >;
-        /** 获取某个被评对象的评论数
-*/
         async fn count_comment(
             &self,
             request: tonic::Request<super::CountCommentRequest>,
@@ -851,8 +835,6 @@ pub mod comment_service_server {
             tonic::Response<super::CountCommentResponse>,
             tonic::Status,
         >;
-        /** 获取多个被评论对象的评论数
-*/
         async fn batch_count_comment(
             &self,
             request: tonic::Request<super::BatchCountCommentRequest>,
@@ -860,8 +842,6 @@ pub mod comment_service_server {
             tonic::Response<super::BatchCountCommentResponse>,
             tonic::Status,
         >;
-        /** 获取某条评论的点赞数
-*/
         async fn get_comment_like_count(
             &self,
             request: tonic::Request<super::GetCommentLikeCountRequest>,
@@ -869,8 +849,6 @@ pub mod comment_service_server {
             tonic::Response<super::GetCommentLikeCountResponse>,
             tonic::Status,
         >;
-        /** 获取某条评论的点踩数
-*/
         async fn get_comment_dislike_count(
             &self,
             request: tonic::Request<super::GetCommentDislikeCountRequest>,
@@ -878,8 +856,6 @@ pub mod comment_service_server {
             tonic::Response<super::GetCommentDislikeCountResponse>,
             tonic::Status,
         >;
-        /** 获取某个用户是否评论了某个对象
-*/
         async fn check_user_on_object(
             &self,
             request: tonic::Request<super::CheckUserOnObjectRequest>,
@@ -887,8 +863,6 @@ pub mod comment_service_server {
             tonic::Response<super::CheckUserOnObjectResponse>,
             tonic::Status,
         >;
-        /** 批量检查某个用户是否评论了某个对象
-*/
         async fn batch_check_user_on_object(
             &self,
             request: tonic::Request<super::BatchCheckUserOnObjectRequest>,
@@ -896,8 +870,6 @@ pub mod comment_service_server {
             tonic::Response<super::BatchCheckUserOnObjectResponse>,
             tonic::Status,
         >;
-        /** 批量检查某个用户是否点赞了某些评论
-*/
         async fn batch_check_user_like_comment(
             &self,
             request: tonic::Request<super::BatchCheckUserLikeCommentRequest>,
@@ -905,8 +877,6 @@ pub mod comment_service_server {
             tonic::Response<super::BatchCheckUserLikeCommentResponse>,
             tonic::Status,
         >;
-        /** 批量检查评论是否存在
-*/
         async fn batch_check_comment_exist(
             &self,
             request: tonic::Request<super::BatchCheckCommentExistRequest>,
@@ -914,8 +884,6 @@ pub mod comment_service_server {
             tonic::Response<super::BatchCheckCommentExistResponse>,
             tonic::Status,
         >;
-        /** 按照id获取评论
-*/
         async fn get_comment(
             &self,
             request: tonic::Request<super::GetCommentRequest>,
@@ -923,8 +891,6 @@ pub mod comment_service_server {
             tonic::Response<super::GetCommentResponse>,
             tonic::Status,
         >;
-        /** 获取评论作者
-*/
         async fn get_comment_user(
             &self,
             request: tonic::Request<super::GetCommentUserRequest>,
@@ -932,8 +898,21 @@ pub mod comment_service_server {
             tonic::Response<super::GetCommentUserResponse>,
             tonic::Status,
         >;
+        async fn edit_comment(
+            &self,
+            request: tonic::Request<super::EditCommentRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::EditCommentResponse>,
+            tonic::Status,
+        >;
+        async fn get_comment_edit_history(
+            &self,
+            request: tonic::Request<super::GetCommentEditHistoryRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetCommentEditHistoryResponse>,
+            tonic::Status,
+        >;
     }
-    ///
     #[derive(Debug)]
     pub struct CommentServiceServer<T> {
         inner: Arc<T>,
@@ -2043,6 +2022,100 @@ pub mod comment_service_server {
                     };
                     Box::pin(fut)
                 }
+                "/comment.api.v1.CommentService/EditComment" => {
+                    #[allow(non_camel_case_types)]
+                    struct EditCommentSvc<T: CommentService>(pub Arc<T>);
+                    impl<
+                        T: CommentService,
+                    > tonic::server::UnaryService<super::EditCommentRequest>
+                    for EditCommentSvc<T> {
+                        type Response = super::EditCommentResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::EditCommentRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CommentService>::edit_comment(&inner, request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = EditCommentSvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/comment.api.v1.CommentService/GetCommentEditHistory" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetCommentEditHistorySvc<T: CommentService>(pub Arc<T>);
+                    impl<
+                        T: CommentService,
+                    > tonic::server::UnaryService<super::GetCommentEditHistoryRequest>
+                    for GetCommentEditHistorySvc<T> {
+                        type Response = super::GetCommentEditHistoryResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::GetCommentEditHistoryRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CommentService>::get_comment_edit_history(
+                                        &inner,
+                                        request,
+                                    )
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = GetCommentEditHistorySvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 _ => {
                     Box::pin(async move {
                         let mut response = http::Response::new(
@@ -2083,3 +2156,1805 @@ pub mod comment_service_server {
         const NAME: &'static str = SERVICE_NAME;
     }
 }
+/// Generated client implementations.
+pub mod comment_moderation_service_client {
+    #![allow(
+        unused_variables,
+        dead_code,
+        missing_docs,
+        clippy::wildcard_imports,
+        clippy::let_unit_value,
+    )]
+    use tonic::codegen::*;
+    use tonic::codegen::http::Uri;
+    #[derive(Debug, Clone)]
+    pub struct CommentModerationServiceClient<T> {
+        inner: tonic::client::Grpc<T>,
+    }
+    impl CommentModerationServiceClient<tonic::transport::Channel> {
+        /// Attempt to create a new client by connecting to a given endpoint.
+        pub async fn connect<D>(dst: D) -> Result<Self, tonic::transport::Error>
+        where
+            D: TryInto<tonic::transport::Endpoint>,
+            D::Error: Into<StdError>,
+        {
+            let conn = tonic::transport::Endpoint::new(dst)?.connect().await?;
+            Ok(Self::new(conn))
+        }
+    }
+    impl<T> CommentModerationServiceClient<T>
+    where
+        T: tonic::client::GrpcService<tonic::body::Body>,
+        T::Error: Into<StdError>,
+        T::ResponseBody: Body<Data = Bytes> + std::marker::Send + 'static,
+        <T::ResponseBody as Body>::Error: Into<StdError> + std::marker::Send,
+    {
+        pub fn new(inner: T) -> Self {
+            let inner = tonic::client::Grpc::new(inner);
+            Self { inner }
+        }
+        pub fn with_origin(inner: T, origin: Uri) -> Self {
+            let inner = tonic::client::Grpc::with_origin(inner, origin);
+            Self { inner }
+        }
+        pub fn with_interceptor<F>(
+            inner: T,
+            interceptor: F,
+        ) -> CommentModerationServiceClient<InterceptedService<T, F>>
+        where
+            F: tonic::service::Interceptor,
+            T::ResponseBody: Default,
+            T: tonic::codegen::Service<
+                http::Request<tonic::body::Body>,
+                Response = http::Response<
+                    <T as tonic::client::GrpcService<tonic::body::Body>>::ResponseBody,
+                >,
+            >,
+            <T as tonic::codegen::Service<
+                http::Request<tonic::body::Body>,
+            >>::Error: Into<StdError> + std::marker::Send + std::marker::Sync,
+        {
+            CommentModerationServiceClient::new(InterceptedService::new(inner, interceptor))
+        }
+        /// Compress requests with the given encoding.
+        ///
+        /// This requires the server to support it otherwise it might respond with an
+        /// error.
+        #[must_use]
+        pub fn send_compressed(mut self, encoding: CompressionEncoding) -> Self {
+            self.inner = self.inner.send_compressed(encoding);
+            self
+        }
+        /// Enable decompressing responses.
+        #[must_use]
+        pub fn accept_compressed(mut self, encoding: CompressionEncoding) -> Self {
+            self.inner = self.inner.accept_compressed(encoding);
+            self
+        }
+        /// Limits the maximum size of a decoded message.
+        ///
+        /// Default: `4MB`
+        #[must_use]
+        pub fn max_decoding_message_size(mut self, limit: usize) -> Self {
+            self.inner = self.inner.max_decoding_message_size(limit);
+            self
+        }
+        /// Limits the maximum size of an encoded message.
+        ///
+        /// Default: `usize::MAX`
+        #[must_use]
+        pub fn max_encoding_message_size(mut self, limit: usize) -> Self {
+            self.inner = self.inner.max_encoding_message_size(limit);
+            self
+        }
+        pub async fn list_pending_reports(
+            &mut self,
+            request: impl tonic::IntoRequest<super::ListPendingReportsRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::ListPendingReportsResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/comment.api.v1.CommentModerationService/ListPendingReports",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "comment.api.v1.CommentModerationService",
+                        "ListPendingReports",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        pub async fn resolve_reports(
+            &mut self,
+            request: impl tonic::IntoRequest<super::ResolveReportsRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::ResolveReportsResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/comment.api.v1.CommentModerationService/ResolveReports",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "comment.api.v1.CommentModerationService",
+                        "ResolveReports",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+    }
+}
+/// Generated server implementations.
+pub mod comment_moderation_service_server {
+    #![allow(
+        unused_variables,
+        dead_code,
+        missing_docs,
+        clippy::wildcard_imports,
+        clippy::let_unit_value,
+    )]
+    use tonic::codegen::*;
+    /// Generated trait containing gRPC methods that should be implemented for use with CommentModerationServiceServer.
+    #[async_trait]
+    pub trait CommentModerationService: std::marker::Send + std::marker::Sync + 'static {
+        async fn list_pending_reports(
+            &self,
+            request: tonic::Request<super::ListPendingReportsRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::ListPendingReportsResponse>,
+            tonic::Status,
+        >;
+        async fn resolve_reports(
+            &self,
+            request: tonic::Request<super::ResolveReportsRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::ResolveReportsResponse>,
+            tonic::Status,
+        >;
+    }
+    #[derive(Debug)]
+    pub struct CommentModerationServiceServer<T> {
+        inner: Arc<T>,
+        accept_compression_encodings: EnabledCompressionEncodings,
+        send_compression_encodings: EnabledCompressionEncodings,
+        max_decoding_message_size: Option<usize>,
+        max_encoding_message_size: Option<usize>,
+    }
+    impl<T> CommentModerationServiceServer<T> {
+        pub fn new(inner: T) -> Self {
+            Self::from_arc(Arc::new(inner))
+        }
+        pub fn from_arc(inner: Arc<T>) -> Self {
+            Self {
+                inner,
+                accept_compression_encodings: Default::default(),
+                send_compression_encodings: Default::default(),
+                max_decoding_message_size: None,
+                max_encoding_message_size: None,
+            }
+        }
+        pub fn with_interceptor<F>(
+            inner: T,
+            interceptor: F,
+        ) -> InterceptedService<Self, F>
+        where
+            F: tonic::service::Interceptor,
+        {
+            InterceptedService::new(Self::new(inner), interceptor)
+        }
+        /// Enable decompressing requests with the given encoding.
+        #[must_use]
+        pub fn accept_compressed(mut self, encoding: CompressionEncoding) -> Self {
+            self.accept_compression_encodings.enable(encoding);
+            self
+        }
+        /// Compress responses with the given encoding, if the client supports it.
+        #[must_use]
+        pub fn send_compressed(mut self, encoding: CompressionEncoding) -> Self {
+            self.send_compression_encodings.enable(encoding);
+            self
+        }
+        /// Limits the maximum size of a decoded message.
+        ///
+        /// Default: `4MB`
+        #[must_use]
+        pub fn max_decoding_message_size(mut self, limit: usize) -> Self {
+            self.max_decoding_message_size = Some(limit);
+            self
+        }
+        /// Limits the maximum size of an encoded message.
+        ///
+        /// Default: `usize::MAX`
+        #[must_use]
+        pub fn max_encoding_message_size(mut self, limit: usize) -> Self {
+            self.max_encoding_message_size = Some(limit);
+            self
+        }
+    }
+    impl<T, B> tonic::codegen::Service<http::Request<B>>
+    for CommentModerationServiceServer<T>
+    where
+        T: CommentModerationService,
+        B: Body + std::marker::Send + 'static,
+        B::Error: Into<StdError> + std::marker::Send + 'static,
+    {
+        type Response = http::Response<tonic::body::Body>;
+        type Error = std::convert::Infallible;
+        type Future = BoxFuture<Self::Response, Self::Error>;
+        fn poll_ready(
+            &mut self,
+            _cx: &mut Context<'_>,
+        ) -> Poll<std::result::Result<(), Self::Error>> {
+            Poll::Ready(Ok(()))
+        }
+        fn call(&mut self, req: http::Request<B>) -> Self::Future {
+            match req.uri().path() {
+                "/comment.api.v1.CommentModerationService/ListPendingReports" => {
+                    #[allow(non_camel_case_types)]
+                    struct ListPendingReportsSvc<T: CommentModerationService>(
+                        pub Arc<T>,
+                    );
+                    impl<
+                        T: CommentModerationService,
+                    > tonic::server::UnaryService<super::ListPendingReportsRequest>
+                    for ListPendingReportsSvc<T> {
+                        type Response = super::ListPendingReportsResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::ListPendingReportsRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CommentModerationService>::list_pending_reports(
+                                        &inner,
+                                        request,
+                                    )
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = ListPendingReportsSvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/comment.api.v1.CommentModerationService/ResolveReports" => {
+                    #[allow(non_camel_case_types)]
+                    struct ResolveReportsSvc<T: CommentModerationService>(pub Arc<T>);
+                    impl<
+                        T: CommentModerationService,
+                    > tonic::server::UnaryService<super::ResolveReportsRequest>
+                    for ResolveReportsSvc<T> {
+                        type Response = super::ResolveReportsResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::ResolveReportsRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CommentModerationService>::resolve_reports(
+                                        &inner,
+                                        request,
+                                    )
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = ResolveReportsSvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                _ => {
+                    Box::pin(async move {
+                        let mut response = http::Response::new(
+                            tonic::body::Body::default(),
+                        );
+                        let headers = response.headers_mut();
+                        headers
+                            .insert(
+                                tonic::Status::GRPC_STATUS,
+                                (tonic::Code::Unimplemented as i32).into(),
+                            );
+                        headers
+                            .insert(
+                                http::header::CONTENT_TYPE,
+                                tonic::metadata::GRPC_CONTENT_TYPE,
+                            );
+                        Ok(response)
+                    })
+                }
+            }
+        }
+    }
+    impl<T> Clone for CommentModerationServiceServer<T> {
+        fn clone(&self) -> Self {
+            let inner = self.inner.clone();
+            Self {
+                inner,
+                accept_compression_encodings: self.accept_compression_encodings,
+                send_compression_encodings: self.send_compression_encodings,
+                max_decoding_message_size: self.max_decoding_message_size,
+                max_encoding_message_size: self.max_encoding_message_size,
+            }
+        }
+    }
+    /// Generated gRPC service name
+    pub const SERVICE_NAME: &str = "comment.api.v1.CommentModerationService";
+    impl<T> tonic::server::NamedService for CommentModerationServiceServer<T> {
+        const NAME: &'static str = SERVICE_NAME;
+    }
+}
+/// Generated client implementations.
+pub mod comment_emoji_service_client {
+    #![allow(
+        unused_variables,
+        dead_code,
+        missing_docs,
+        clippy::wildcard_imports,
+        clippy::let_unit_value,
+    )]
+    use tonic::codegen::*;
+    use tonic::codegen::http::Uri;
+    #[derive(Debug, Clone)]
+    pub struct CommentEmojiServiceClient<T> {
+        inner: tonic::client::Grpc<T>,
+    }
+    impl CommentEmojiServiceClient<tonic::transport::Channel> {
+        /// Attempt to create a new client by connecting to a given endpoint.
+        pub async fn connect<D>(dst: D) -> Result<Self, tonic::transport::Error>
+        where
+            D: TryInto<tonic::transport::Endpoint>,
+            D::Error: Into<StdError>,
+        {
+            let conn = tonic::transport::Endpoint::new(dst)?.connect().await?;
+            Ok(Self::new(conn))
+        }
+    }
+    impl<T> CommentEmojiServiceClient<T>
+    where
+        T: tonic::client::GrpcService<tonic::body::Body>,
+        T::Error: Into<StdError>,
+        T::ResponseBody: Body<Data = Bytes> + std::marker::Send + 'static,
+        <T::ResponseBody as Body>::Error: Into<StdError> + std::marker::Send,
+    {
+        pub fn new(inner: T) -> Self {
+            let inner = tonic::client::Grpc::new(inner);
+            Self { inner }
+        }
+        pub fn with_origin(inner: T, origin: Uri) -> Self {
+            let inner = tonic::client::Grpc::with_origin(inner, origin);
+            Self { inner }
+        }
+        pub fn with_interceptor<F>(
+            inner: T,
+            interceptor: F,
+        ) -> CommentEmojiServiceClient<InterceptedService<T, F>>
+        where
+            F: tonic::service::Interceptor,
+            T::ResponseBody: Default,
+            T: tonic::codegen::Service<
+                http::Request<tonic::body::Body>,
+                Response = http::Response<
+                    <T as tonic::client::GrpcService<tonic::body::Body>>::ResponseBody,
+                >,
+            >,
+            <T as tonic::codegen::Service<
+                http::Request<tonic::body::Body>,
+            >>::Error: Into<StdError> + std::marker::Send + std::marker::Sync,
+        {
+            CommentEmojiServiceClient::new(InterceptedService::new(inner, interceptor))
+        }
+        /// Compress requests with the given encoding.
+        ///
+        /// This requires the server to support it otherwise it might respond with an
+        /// error.
+        #[must_use]
+        pub fn send_compressed(mut self, encoding: CompressionEncoding) -> Self {
+            self.inner = self.inner.send_compressed(encoding);
+            self
+        }
+        /// Enable decompressing responses.
+        #[must_use]
+        pub fn accept_compressed(mut self, encoding: CompressionEncoding) -> Self {
+            self.inner = self.inner.accept_compressed(encoding);
+            self
+        }
+        /// Limits the maximum size of a decoded message.
+        ///
+        /// Default: `4MB`
+        #[must_use]
+        pub fn max_decoding_message_size(mut self, limit: usize) -> Self {
+            self.inner = self.inner.max_decoding_message_size(limit);
+            self
+        }
+        /// Limits the maximum size of an encoded message.
+        ///
+        /// Default: `usize::MAX`
+        #[must_use]
+        pub fn max_encoding_message_size(mut self, limit: usize) -> Self {
+            self.inner = self.inner.max_encoding_message_size(limit);
+            self
+        }
+        pub async fn list_emoji_packs(
+            &mut self,
+            request: impl tonic::IntoRequest<super::ListEmojiPacksRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::ListEmojiPacksResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/comment.api.v1.CommentEmojiService/ListEmojiPacks",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "comment.api.v1.CommentEmojiService",
+                        "ListEmojiPacks",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        pub async fn get_emoji_pack(
+            &mut self,
+            request: impl tonic::IntoRequest<super::GetEmojiPackRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetEmojiPackResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/comment.api.v1.CommentEmojiService/GetEmojiPack",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("comment.api.v1.CommentEmojiService", "GetEmojiPack"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        pub async fn batch_get_emoji_items(
+            &mut self,
+            request: impl tonic::IntoRequest<super::BatchGetEmojiItemsRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::BatchGetEmojiItemsResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/comment.api.v1.CommentEmojiService/BatchGetEmojiItems",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "comment.api.v1.CommentEmojiService",
+                        "BatchGetEmojiItems",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        pub async fn upsert_emoji_pack(
+            &mut self,
+            request: impl tonic::IntoRequest<super::UpsertEmojiPackRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::UpsertEmojiPackResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/comment.api.v1.CommentEmojiService/UpsertEmojiPack",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "comment.api.v1.CommentEmojiService",
+                        "UpsertEmojiPack",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        pub async fn upsert_emoji_item(
+            &mut self,
+            request: impl tonic::IntoRequest<super::UpsertEmojiItemRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::UpsertEmojiItemResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/comment.api.v1.CommentEmojiService/UpsertEmojiItem",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "comment.api.v1.CommentEmojiService",
+                        "UpsertEmojiItem",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+    }
+}
+/// Generated server implementations.
+pub mod comment_emoji_service_server {
+    #![allow(
+        unused_variables,
+        dead_code,
+        missing_docs,
+        clippy::wildcard_imports,
+        clippy::let_unit_value,
+    )]
+    use tonic::codegen::*;
+    /// Generated trait containing gRPC methods that should be implemented for use with CommentEmojiServiceServer.
+    #[async_trait]
+    pub trait CommentEmojiService: std::marker::Send + std::marker::Sync + 'static {
+        async fn list_emoji_packs(
+            &self,
+            request: tonic::Request<super::ListEmojiPacksRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::ListEmojiPacksResponse>,
+            tonic::Status,
+        >;
+        async fn get_emoji_pack(
+            &self,
+            request: tonic::Request<super::GetEmojiPackRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetEmojiPackResponse>,
+            tonic::Status,
+        >;
+        async fn batch_get_emoji_items(
+            &self,
+            request: tonic::Request<super::BatchGetEmojiItemsRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::BatchGetEmojiItemsResponse>,
+            tonic::Status,
+        >;
+        async fn upsert_emoji_pack(
+            &self,
+            request: tonic::Request<super::UpsertEmojiPackRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::UpsertEmojiPackResponse>,
+            tonic::Status,
+        >;
+        async fn upsert_emoji_item(
+            &self,
+            request: tonic::Request<super::UpsertEmojiItemRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::UpsertEmojiItemResponse>,
+            tonic::Status,
+        >;
+    }
+    #[derive(Debug)]
+    pub struct CommentEmojiServiceServer<T> {
+        inner: Arc<T>,
+        accept_compression_encodings: EnabledCompressionEncodings,
+        send_compression_encodings: EnabledCompressionEncodings,
+        max_decoding_message_size: Option<usize>,
+        max_encoding_message_size: Option<usize>,
+    }
+    impl<T> CommentEmojiServiceServer<T> {
+        pub fn new(inner: T) -> Self {
+            Self::from_arc(Arc::new(inner))
+        }
+        pub fn from_arc(inner: Arc<T>) -> Self {
+            Self {
+                inner,
+                accept_compression_encodings: Default::default(),
+                send_compression_encodings: Default::default(),
+                max_decoding_message_size: None,
+                max_encoding_message_size: None,
+            }
+        }
+        pub fn with_interceptor<F>(
+            inner: T,
+            interceptor: F,
+        ) -> InterceptedService<Self, F>
+        where
+            F: tonic::service::Interceptor,
+        {
+            InterceptedService::new(Self::new(inner), interceptor)
+        }
+        /// Enable decompressing requests with the given encoding.
+        #[must_use]
+        pub fn accept_compressed(mut self, encoding: CompressionEncoding) -> Self {
+            self.accept_compression_encodings.enable(encoding);
+            self
+        }
+        /// Compress responses with the given encoding, if the client supports it.
+        #[must_use]
+        pub fn send_compressed(mut self, encoding: CompressionEncoding) -> Self {
+            self.send_compression_encodings.enable(encoding);
+            self
+        }
+        /// Limits the maximum size of a decoded message.
+        ///
+        /// Default: `4MB`
+        #[must_use]
+        pub fn max_decoding_message_size(mut self, limit: usize) -> Self {
+            self.max_decoding_message_size = Some(limit);
+            self
+        }
+        /// Limits the maximum size of an encoded message.
+        ///
+        /// Default: `usize::MAX`
+        #[must_use]
+        pub fn max_encoding_message_size(mut self, limit: usize) -> Self {
+            self.max_encoding_message_size = Some(limit);
+            self
+        }
+    }
+    impl<T, B> tonic::codegen::Service<http::Request<B>> for CommentEmojiServiceServer<T>
+    where
+        T: CommentEmojiService,
+        B: Body + std::marker::Send + 'static,
+        B::Error: Into<StdError> + std::marker::Send + 'static,
+    {
+        type Response = http::Response<tonic::body::Body>;
+        type Error = std::convert::Infallible;
+        type Future = BoxFuture<Self::Response, Self::Error>;
+        fn poll_ready(
+            &mut self,
+            _cx: &mut Context<'_>,
+        ) -> Poll<std::result::Result<(), Self::Error>> {
+            Poll::Ready(Ok(()))
+        }
+        fn call(&mut self, req: http::Request<B>) -> Self::Future {
+            match req.uri().path() {
+                "/comment.api.v1.CommentEmojiService/ListEmojiPacks" => {
+                    #[allow(non_camel_case_types)]
+                    struct ListEmojiPacksSvc<T: CommentEmojiService>(pub Arc<T>);
+                    impl<
+                        T: CommentEmojiService,
+                    > tonic::server::UnaryService<super::ListEmojiPacksRequest>
+                    for ListEmojiPacksSvc<T> {
+                        type Response = super::ListEmojiPacksResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::ListEmojiPacksRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CommentEmojiService>::list_emoji_packs(
+                                        &inner,
+                                        request,
+                                    )
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = ListEmojiPacksSvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/comment.api.v1.CommentEmojiService/GetEmojiPack" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetEmojiPackSvc<T: CommentEmojiService>(pub Arc<T>);
+                    impl<
+                        T: CommentEmojiService,
+                    > tonic::server::UnaryService<super::GetEmojiPackRequest>
+                    for GetEmojiPackSvc<T> {
+                        type Response = super::GetEmojiPackResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::GetEmojiPackRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CommentEmojiService>::get_emoji_pack(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = GetEmojiPackSvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/comment.api.v1.CommentEmojiService/BatchGetEmojiItems" => {
+                    #[allow(non_camel_case_types)]
+                    struct BatchGetEmojiItemsSvc<T: CommentEmojiService>(pub Arc<T>);
+                    impl<
+                        T: CommentEmojiService,
+                    > tonic::server::UnaryService<super::BatchGetEmojiItemsRequest>
+                    for BatchGetEmojiItemsSvc<T> {
+                        type Response = super::BatchGetEmojiItemsResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::BatchGetEmojiItemsRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CommentEmojiService>::batch_get_emoji_items(
+                                        &inner,
+                                        request,
+                                    )
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = BatchGetEmojiItemsSvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/comment.api.v1.CommentEmojiService/UpsertEmojiPack" => {
+                    #[allow(non_camel_case_types)]
+                    struct UpsertEmojiPackSvc<T: CommentEmojiService>(pub Arc<T>);
+                    impl<
+                        T: CommentEmojiService,
+                    > tonic::server::UnaryService<super::UpsertEmojiPackRequest>
+                    for UpsertEmojiPackSvc<T> {
+                        type Response = super::UpsertEmojiPackResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::UpsertEmojiPackRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CommentEmojiService>::upsert_emoji_pack(
+                                        &inner,
+                                        request,
+                                    )
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = UpsertEmojiPackSvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/comment.api.v1.CommentEmojiService/UpsertEmojiItem" => {
+                    #[allow(non_camel_case_types)]
+                    struct UpsertEmojiItemSvc<T: CommentEmojiService>(pub Arc<T>);
+                    impl<
+                        T: CommentEmojiService,
+                    > tonic::server::UnaryService<super::UpsertEmojiItemRequest>
+                    for UpsertEmojiItemSvc<T> {
+                        type Response = super::UpsertEmojiItemResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::UpsertEmojiItemRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CommentEmojiService>::upsert_emoji_item(
+                                        &inner,
+                                        request,
+                                    )
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = UpsertEmojiItemSvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                _ => {
+                    Box::pin(async move {
+                        let mut response = http::Response::new(
+                            tonic::body::Body::default(),
+                        );
+                        let headers = response.headers_mut();
+                        headers
+                            .insert(
+                                tonic::Status::GRPC_STATUS,
+                                (tonic::Code::Unimplemented as i32).into(),
+                            );
+                        headers
+                            .insert(
+                                http::header::CONTENT_TYPE,
+                                tonic::metadata::GRPC_CONTENT_TYPE,
+                            );
+                        Ok(response)
+                    })
+                }
+            }
+        }
+    }
+    impl<T> Clone for CommentEmojiServiceServer<T> {
+        fn clone(&self) -> Self {
+            let inner = self.inner.clone();
+            Self {
+                inner,
+                accept_compression_encodings: self.accept_compression_encodings,
+                send_compression_encodings: self.send_compression_encodings,
+                max_decoding_message_size: self.max_decoding_message_size,
+                max_encoding_message_size: self.max_encoding_message_size,
+            }
+        }
+    }
+    /// Generated gRPC service name
+    pub const SERVICE_NAME: &str = "comment.api.v1.CommentEmojiService";
+    impl<T> tonic::server::NamedService for CommentEmojiServiceServer<T> {
+        const NAME: &'static str = SERVICE_NAME;
+    }
+}
+/// Generated client implementations.
+pub mod comment_setting_service_client {
+    #![allow(
+        unused_variables,
+        dead_code,
+        missing_docs,
+        clippy::wildcard_imports,
+        clippy::let_unit_value,
+    )]
+    use tonic::codegen::*;
+    use tonic::codegen::http::Uri;
+    /** 作者的评论管理
+*/
+    #[derive(Debug, Clone)]
+    pub struct CommentSettingServiceClient<T> {
+        inner: tonic::client::Grpc<T>,
+    }
+    impl CommentSettingServiceClient<tonic::transport::Channel> {
+        /// Attempt to create a new client by connecting to a given endpoint.
+        pub async fn connect<D>(dst: D) -> Result<Self, tonic::transport::Error>
+        where
+            D: TryInto<tonic::transport::Endpoint>,
+            D::Error: Into<StdError>,
+        {
+            let conn = tonic::transport::Endpoint::new(dst)?.connect().await?;
+            Ok(Self::new(conn))
+        }
+    }
+    impl<T> CommentSettingServiceClient<T>
+    where
+        T: tonic::client::GrpcService<tonic::body::Body>,
+        T::Error: Into<StdError>,
+        T::ResponseBody: Body<Data = Bytes> + std::marker::Send + 'static,
+        <T::ResponseBody as Body>::Error: Into<StdError> + std::marker::Send,
+    {
+        pub fn new(inner: T) -> Self {
+            let inner = tonic::client::Grpc::new(inner);
+            Self { inner }
+        }
+        pub fn with_origin(inner: T, origin: Uri) -> Self {
+            let inner = tonic::client::Grpc::with_origin(inner, origin);
+            Self { inner }
+        }
+        pub fn with_interceptor<F>(
+            inner: T,
+            interceptor: F,
+        ) -> CommentSettingServiceClient<InterceptedService<T, F>>
+        where
+            F: tonic::service::Interceptor,
+            T::ResponseBody: Default,
+            T: tonic::codegen::Service<
+                http::Request<tonic::body::Body>,
+                Response = http::Response<
+                    <T as tonic::client::GrpcService<tonic::body::Body>>::ResponseBody,
+                >,
+            >,
+            <T as tonic::codegen::Service<
+                http::Request<tonic::body::Body>,
+            >>::Error: Into<StdError> + std::marker::Send + std::marker::Sync,
+        {
+            CommentSettingServiceClient::new(InterceptedService::new(inner, interceptor))
+        }
+        /// Compress requests with the given encoding.
+        ///
+        /// This requires the server to support it otherwise it might respond with an
+        /// error.
+        #[must_use]
+        pub fn send_compressed(mut self, encoding: CompressionEncoding) -> Self {
+            self.inner = self.inner.send_compressed(encoding);
+            self
+        }
+        /// Enable decompressing responses.
+        #[must_use]
+        pub fn accept_compressed(mut self, encoding: CompressionEncoding) -> Self {
+            self.inner = self.inner.accept_compressed(encoding);
+            self
+        }
+        /// Limits the maximum size of a decoded message.
+        ///
+        /// Default: `4MB`
+        #[must_use]
+        pub fn max_decoding_message_size(mut self, limit: usize) -> Self {
+            self.inner = self.inner.max_decoding_message_size(limit);
+            self
+        }
+        /// Limits the maximum size of an encoded message.
+        ///
+        /// Default: `usize::MAX`
+        #[must_use]
+        pub fn max_encoding_message_size(mut self, limit: usize) -> Self {
+            self.inner = self.inner.max_encoding_message_size(limit);
+            self
+        }
+        /** 获取对象的评论设置
+*/
+        pub async fn get_comment_setting(
+            &mut self,
+            request: impl tonic::IntoRequest<super::GetCommentSettingRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetCommentSettingResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/comment.api.v1.CommentSettingService/GetCommentSetting",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "comment.api.v1.CommentSettingService",
+                        "GetCommentSetting",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /** 更新对象的评论设置
+*/
+        pub async fn update_comment_setting(
+            &mut self,
+            request: impl tonic::IntoRequest<super::UpdateCommentSettingRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::UpdateCommentSettingResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/comment.api.v1.CommentSettingService/UpdateCommentSetting",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "comment.api.v1.CommentSettingService",
+                        "UpdateCommentSetting",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /** 禁止/解除禁止用户评论
+*/
+        pub async fn mute_comment_user(
+            &mut self,
+            request: impl tonic::IntoRequest<super::MuteCommentUserRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::MuteCommentUserResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/comment.api.v1.CommentSettingService/MuteCommentUser",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "comment.api.v1.CommentSettingService",
+                        "MuteCommentUser",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /** 获取禁止评论的用户
+*/
+        pub async fn list_muted_comment_users(
+            &mut self,
+            request: impl tonic::IntoRequest<super::ListMutedCommentUsersRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::ListMutedCommentUsersResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/comment.api.v1.CommentSettingService/ListMutedCommentUsers",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "comment.api.v1.CommentSettingService",
+                        "ListMutedCommentUsers",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /** 获取等待审核的评论
+*/
+        pub async fn list_held_comments(
+            &mut self,
+            request: impl tonic::IntoRequest<super::ListHeldCommentsRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::ListHeldCommentsResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/comment.api.v1.CommentSettingService/ListHeldComments",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "comment.api.v1.CommentSettingService",
+                        "ListHeldComments",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /** 通过等待审核的评论
+*/
+        pub async fn approve_held_comments(
+            &mut self,
+            request: impl tonic::IntoRequest<super::ApproveHeldCommentsRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::ApproveHeldCommentsResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/comment.api.v1.CommentSettingService/ApproveHeldComments",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "comment.api.v1.CommentSettingService",
+                        "ApproveHeldComments",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+    }
+}
+/// Generated server implementations.
+pub mod comment_setting_service_server {
+    #![allow(
+        unused_variables,
+        dead_code,
+        missing_docs,
+        clippy::wildcard_imports,
+        clippy::let_unit_value,
+    )]
+    use tonic::codegen::*;
+    /// Generated trait containing gRPC methods that should be implemented for use with CommentSettingServiceServer.
+    #[async_trait]
+    pub trait CommentSettingService: std::marker::Send + std::marker::Sync + 'static {
+        /** 获取对象的评论设置
+*/
+        async fn get_comment_setting(
+            &self,
+            request: tonic::Request<super::GetCommentSettingRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetCommentSettingResponse>,
+            tonic::Status,
+        >;
+        /** 更新对象的评论设置
+*/
+        async fn update_comment_setting(
+            &self,
+            request: tonic::Request<super::UpdateCommentSettingRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::UpdateCommentSettingResponse>,
+            tonic::Status,
+        >;
+        /** 禁止/解除禁止用户评论
+*/
+        async fn mute_comment_user(
+            &self,
+            request: tonic::Request<super::MuteCommentUserRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::MuteCommentUserResponse>,
+            tonic::Status,
+        >;
+        /** 获取禁止评论的用户
+*/
+        async fn list_muted_comment_users(
+            &self,
+            request: tonic::Request<super::ListMutedCommentUsersRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::ListMutedCommentUsersResponse>,
+            tonic::Status,
+        >;
+        /** 获取等待审核的评论
+*/
+        async fn list_held_comments(
+            &self,
+            request: tonic::Request<super::ListHeldCommentsRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::ListHeldCommentsResponse>,
+            tonic::Status,
+        >;
+        /** 通过等待审核的评论
+*/
+        async fn approve_held_comments(
+            &self,
+            request: tonic::Request<super::ApproveHeldCommentsRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::ApproveHeldCommentsResponse>,
+            tonic::Status,
+        >;
+    }
+    /** 作者的评论管理
+*/
+    #[derive(Debug)]
+    pub struct CommentSettingServiceServer<T> {
+        inner: Arc<T>,
+        accept_compression_encodings: EnabledCompressionEncodings,
+        send_compression_encodings: EnabledCompressionEncodings,
+        max_decoding_message_size: Option<usize>,
+        max_encoding_message_size: Option<usize>,
+    }
+    impl<T> CommentSettingServiceServer<T> {
+        pub fn new(inner: T) -> Self {
+            Self::from_arc(Arc::new(inner))
+        }
+        pub fn from_arc(inner: Arc<T>) -> Self {
+            Self {
+                inner,
+                accept_compression_encodings: Default::default(),
+                send_compression_encodings: Default::default(),
+                max_decoding_message_size: None,
+                max_encoding_message_size: None,
+            }
+        }
+        pub fn with_interceptor<F>(
+            inner: T,
+            interceptor: F,
+        ) -> InterceptedService<Self, F>
+        where
+            F: tonic::service::Interceptor,
+        {
+            InterceptedService::new(Self::new(inner), interceptor)
+        }
+        /// Enable decompressing requests with the given encoding.
+        #[must_use]
+        pub fn accept_compressed(mut self, encoding: CompressionEncoding) -> Self {
+            self.accept_compression_encodings.enable(encoding);
+            self
+        }
+        /// Compress responses with the given encoding, if the client supports it.
+        #[must_use]
+        pub fn send_compressed(mut self, encoding: CompressionEncoding) -> Self {
+            self.send_compression_encodings.enable(encoding);
+            self
+        }
+        /// Limits the maximum size of a decoded message.
+        ///
+        /// Default: `4MB`
+        #[must_use]
+        pub fn max_decoding_message_size(mut self, limit: usize) -> Self {
+            self.max_decoding_message_size = Some(limit);
+            self
+        }
+        /// Limits the maximum size of an encoded message.
+        ///
+        /// Default: `usize::MAX`
+        #[must_use]
+        pub fn max_encoding_message_size(mut self, limit: usize) -> Self {
+            self.max_encoding_message_size = Some(limit);
+            self
+        }
+    }
+    impl<T, B> tonic::codegen::Service<http::Request<B>>
+    for CommentSettingServiceServer<T>
+    where
+        T: CommentSettingService,
+        B: Body + std::marker::Send + 'static,
+        B::Error: Into<StdError> + std::marker::Send + 'static,
+    {
+        type Response = http::Response<tonic::body::Body>;
+        type Error = std::convert::Infallible;
+        type Future = BoxFuture<Self::Response, Self::Error>;
+        fn poll_ready(
+            &mut self,
+            _cx: &mut Context<'_>,
+        ) -> Poll<std::result::Result<(), Self::Error>> {
+            Poll::Ready(Ok(()))
+        }
+        fn call(&mut self, req: http::Request<B>) -> Self::Future {
+            match req.uri().path() {
+                "/comment.api.v1.CommentSettingService/GetCommentSetting" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetCommentSettingSvc<T: CommentSettingService>(pub Arc<T>);
+                    impl<
+                        T: CommentSettingService,
+                    > tonic::server::UnaryService<super::GetCommentSettingRequest>
+                    for GetCommentSettingSvc<T> {
+                        type Response = super::GetCommentSettingResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::GetCommentSettingRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CommentSettingService>::get_comment_setting(
+                                        &inner,
+                                        request,
+                                    )
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = GetCommentSettingSvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/comment.api.v1.CommentSettingService/UpdateCommentSetting" => {
+                    #[allow(non_camel_case_types)]
+                    struct UpdateCommentSettingSvc<T: CommentSettingService>(pub Arc<T>);
+                    impl<
+                        T: CommentSettingService,
+                    > tonic::server::UnaryService<super::UpdateCommentSettingRequest>
+                    for UpdateCommentSettingSvc<T> {
+                        type Response = super::UpdateCommentSettingResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::UpdateCommentSettingRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CommentSettingService>::update_comment_setting(
+                                        &inner,
+                                        request,
+                                    )
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = UpdateCommentSettingSvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/comment.api.v1.CommentSettingService/MuteCommentUser" => {
+                    #[allow(non_camel_case_types)]
+                    struct MuteCommentUserSvc<T: CommentSettingService>(pub Arc<T>);
+                    impl<
+                        T: CommentSettingService,
+                    > tonic::server::UnaryService<super::MuteCommentUserRequest>
+                    for MuteCommentUserSvc<T> {
+                        type Response = super::MuteCommentUserResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::MuteCommentUserRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CommentSettingService>::mute_comment_user(
+                                        &inner,
+                                        request,
+                                    )
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = MuteCommentUserSvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/comment.api.v1.CommentSettingService/ListMutedCommentUsers" => {
+                    #[allow(non_camel_case_types)]
+                    struct ListMutedCommentUsersSvc<T: CommentSettingService>(
+                        pub Arc<T>,
+                    );
+                    impl<
+                        T: CommentSettingService,
+                    > tonic::server::UnaryService<super::ListMutedCommentUsersRequest>
+                    for ListMutedCommentUsersSvc<T> {
+                        type Response = super::ListMutedCommentUsersResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::ListMutedCommentUsersRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CommentSettingService>::list_muted_comment_users(
+                                        &inner,
+                                        request,
+                                    )
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = ListMutedCommentUsersSvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/comment.api.v1.CommentSettingService/ListHeldComments" => {
+                    #[allow(non_camel_case_types)]
+                    struct ListHeldCommentsSvc<T: CommentSettingService>(pub Arc<T>);
+                    impl<
+                        T: CommentSettingService,
+                    > tonic::server::UnaryService<super::ListHeldCommentsRequest>
+                    for ListHeldCommentsSvc<T> {
+                        type Response = super::ListHeldCommentsResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::ListHeldCommentsRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CommentSettingService>::list_held_comments(
+                                        &inner,
+                                        request,
+                                    )
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = ListHeldCommentsSvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/comment.api.v1.CommentSettingService/ApproveHeldComments" => {
+                    #[allow(non_camel_case_types)]
+                    struct ApproveHeldCommentsSvc<T: CommentSettingService>(pub Arc<T>);
+                    impl<
+                        T: CommentSettingService,
+                    > tonic::server::UnaryService<super::ApproveHeldCommentsRequest>
+                    for ApproveHeldCommentsSvc<T> {
+                        type Response = super::ApproveHeldCommentsResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::ApproveHeldCommentsRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as CommentSettingService>::approve_held_comments(
+                                        &inner,
+                                        request,
+                                    )
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = ApproveHeldCommentsSvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                _ => {
+                    Box::pin(async move {
+                        let mut response = http::Response::new(
+                            tonic::body::Body::default(),
+                        );
+                        let headers = response.headers_mut();
+                        headers
+                            .insert(
+                                tonic::Status::GRPC_STATUS,
+                                (tonic::Code::Unimplemented as i32).into(),
+                            );
+                        headers
+                            .insert(
+                                http::header::CONTENT_TYPE,
+                                tonic::metadata::GRPC_CONTENT_TYPE,
+                            );
+                        Ok(response)
+                    })
+                }
+            }
+        }
+    }
+    impl<T> Clone for CommentSettingServiceServer<T> {
+        fn clone(&self) -> Self {
+            let inner = self.inner.clone();
+            Self {
+                inner,
+                accept_compression_encodings: self.accept_compression_encodings,
+                send_compression_encodings: self.send_compression_encodings,
+                max_decoding_message_size: self.max_decoding_message_size,
+                max_encoding_message_size: self.max_encoding_message_size,
+            }
+        }
+    }
+    /// Generated gRPC service name
+    pub const SERVICE_NAME: &str = "comment.api.v1.CommentSettingService";
+    impl<T> tonic::server::NamedService for CommentSettingServiceServer<T> {
+        const NAME: &'static str = SERVICE_NAME;
+    }
+}
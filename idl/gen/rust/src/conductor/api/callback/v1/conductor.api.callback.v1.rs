@@ -0,0 +1,89 @@
+// @generated
+// This file is @generated by prost-build.
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct OnTaskCompletedRequest {
+    /// 回调内容 与 HTTP 回调的请求体相同的 json 签名基于该字段计算
+    #[prost(bytes = "bytes", tag = "1")]
+    pub payload: ::prost::bytes::Bytes,
+}
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct OnTaskCompletedResponse {
+}
+/// Encoded file descriptor set for the `conductor.api.callback.v1` package
+pub const FILE_DESCRIPTOR_SET: &[u8] = &[
+    0x0a, 0x82, 0x09, 0x0a, 0x28, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x61,
+    0x70, 0x69, 0x2f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+    0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x19, 0x63,
+    0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x61, 0x6c,
+    0x6c, 0x62, 0x61, 0x63, 0x6b, 0x2e, 0x76, 0x31, 0x22, 0x32, 0x0a, 0x16, 0x4f, 0x6e, 0x54, 0x61,
+    0x73, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+    0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20,
+    0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x19, 0x0a, 0x17,
+    0x4f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52,
+    0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x8b, 0x01, 0x0a, 0x0f, 0x43, 0x61, 0x6c, 0x6c,
+    0x62, 0x61, 0x63, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x78, 0x0a, 0x0f, 0x4f,
+    0x6e, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x31,
+    0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63,
+    0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x6e, 0x54, 0x61, 0x73,
+    0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+    0x74, 0x1a, 0x32, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70,
+    0x69, 0x2e, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x6e,
+    0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73,
+    0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x84, 0x02, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f,
+    0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x61, 0x6c, 0x6c,
+    0x62, 0x61, 0x63, 0x6b, 0x2e, 0x76, 0x31, 0x42, 0x0d, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+    0x6b, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+    0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x79, 0x61, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x62, 0x6f, 0x6f,
+    0x6b, 0x73, 0x2f, 0x77, 0x68, 0x69, 0x6d, 0x65, 0x72, 0x2f, 0x69, 0x64, 0x6c, 0x2f, 0x67, 0x65,
+    0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x61,
+    0x70, 0x69, 0x2f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x2f, 0x76, 0x31, 0x3b, 0x63,
+    0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0xa2, 0x02, 0x03, 0x43, 0x41, 0x43, 0xaa, 0x02, 0x19,
+    0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x41, 0x70, 0x69, 0x2e, 0x43, 0x61,
+    0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x19, 0x43, 0x6f, 0x6e, 0x64,
+    0x75, 0x63, 0x74, 0x6f, 0x72, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+    0x63, 0x6b, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x25, 0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f,
+    0x72, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5c, 0x56,
+    0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1c,
+    0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a, 0x3a,
+    0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x3a, 0x3a, 0x56, 0x31, 0x4a, 0xce, 0x04, 0x0a,
+    0x06, 0x12, 0x04, 0x00, 0x00, 0x15, 0x01, 0x0a, 0x08, 0x0a, 0x01, 0x0c, 0x12, 0x03, 0x00, 0x00,
+    0x12, 0x0a, 0x08, 0x0a, 0x01, 0x02, 0x12, 0x03, 0x02, 0x00, 0x22, 0x0a, 0x08, 0x0a, 0x01, 0x08,
+    0x12, 0x03, 0x04, 0x00, 0x64, 0x0a, 0x09, 0x0a, 0x02, 0x08, 0x0b, 0x12, 0x03, 0x04, 0x00, 0x64,
+    0x0a, 0xe0, 0x01, 0x0a, 0x02, 0x06, 0x00, 0x12, 0x04, 0x0a, 0x00, 0x0d, 0x01, 0x1a, 0xd3, 0x01,
+    0x20, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe5, 0x9b, 0x9e, 0xe8, 0xb0, 0x83, 0xe6, 0x9c, 0x8d,
+    0xe5, 0x8a, 0xa1, 0x20, 0xe7, 0x94, 0xb1, 0xe5, 0x9b, 0x9e, 0xe8, 0xb0, 0x83, 0xe6, 0x96, 0xb9,
+    0xe5, 0xae, 0x9e, 0xe7, 0x8e, 0xb0, 0x0a, 0x0a, 0x20, 0xe7, 0xad, 0xbe, 0xe5, 0x90, 0x8d, 0xe4,
+    0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0xe6, 0x94, 0xbe, 0xe5, 0x9c, 0xa8, 0x20, 0x6d, 0x65, 0x74, 0x61,
+    0x64, 0x61, 0x74, 0x61, 0x20, 0xe4, 0xb8, 0xad, 0x20, 0xe4, 0xb8, 0x8e, 0x20, 0x48, 0x54, 0x54,
+    0x50, 0x20, 0xe5, 0x9b, 0x9e, 0xe8, 0xb0, 0x83, 0xe7, 0x9a, 0x84, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1,
+    0x82, 0xe5, 0xa4, 0xb4, 0xe7, 0x9b, 0xb8, 0xe5, 0x90, 0x8c, 0xef, 0xbc, 0x88, 0xe5, 0xb0, 0x8f,
+    0xe5, 0x86, 0x99, 0xef, 0xbc, 0x89, 0x0a, 0x20, 0x78, 0x2d, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63,
+    0x74, 0x6f, 0x72, 0x2d, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x2d, 0x69, 0x64, 0x2c,
+    0x20, 0x78, 0x2d, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2d, 0x61, 0x74, 0x74,
+    0x65, 0x6d, 0x70, 0x74, 0x2c, 0x20, 0x78, 0x2d, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f,
+    0x72, 0x2d, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2c, 0x20, 0x78, 0x2d, 0x63,
+    0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2d, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+    0x72, 0x65, 0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x06, 0x00, 0x01, 0x12, 0x03, 0x0a, 0x08, 0x17, 0x0a,
+    0x45, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x00, 0x12, 0x03, 0x0c, 0x02, 0x50, 0x1a, 0x38, 0x20, 0xe4,
+    0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe5, 0x88, 0xb0, 0xe8, 0xbe, 0xbe, 0xe7, 0xbb, 0x88, 0xe6, 0x80,
+    0x81, 0xef, 0xbc, 0x88, 0xe6, 0x88, 0x90, 0xe5, 0x8a, 0x9f, 0xe6, 0x88, 0x96, 0xe6, 0x9c, 0x80,
+    0xe7, 0xbb, 0x88, 0xe5, 0xa4, 0xb1, 0xe8, 0xb4, 0xa5, 0xef, 0xbc, 0x89, 0xe6, 0x97, 0xb6, 0xe5,
+    0x9b, 0x9e, 0xe8, 0xb0, 0x83, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x01, 0x12,
+    0x03, 0x0c, 0x06, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x02, 0x12, 0x03, 0x0c,
+    0x16, 0x2c, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x0c, 0x37, 0x4e,
+    0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x00, 0x12, 0x04, 0x0f, 0x00, 0x12, 0x01, 0x0a, 0x0a, 0x0a, 0x03,
+    0x04, 0x00, 0x01, 0x12, 0x03, 0x0f, 0x08, 0x1e, 0x0a, 0x61, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x00,
+    0x12, 0x03, 0x11, 0x02, 0x14, 0x1a, 0x54, 0x20, 0xe5, 0x9b, 0x9e, 0xe8, 0xb0, 0x83, 0xe5, 0x86,
+    0x85, 0xe5, 0xae, 0xb9, 0x20, 0xe4, 0xb8, 0x8e, 0x20, 0x48, 0x54, 0x54, 0x50, 0x20, 0xe5, 0x9b,
+    0x9e, 0xe8, 0xb0, 0x83, 0xe7, 0x9a, 0x84, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xe4, 0xbd, 0x93,
+    0xe7, 0x9b, 0xb8, 0xe5, 0x90, 0x8c, 0xe7, 0x9a, 0x84, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x20, 0xe7,
+    0xad, 0xbe, 0xe5, 0x90, 0x8d, 0xe5, 0x9f, 0xba, 0xe4, 0xba, 0x8e, 0xe8, 0xaf, 0xa5, 0xe5, 0xad,
+    0x97, 0xe6, 0xae, 0xb5, 0xe8, 0xae, 0xa1, 0xe7, 0xae, 0x97, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x00, 0x02, 0x00, 0x05, 0x12, 0x03, 0x11, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02,
+    0x00, 0x01, 0x12, 0x03, 0x11, 0x08, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x03,
+    0x12, 0x03, 0x11, 0x12, 0x13, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x01, 0x12, 0x04, 0x14, 0x00, 0x15,
+    0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x01, 0x01, 0x12, 0x03, 0x14, 0x08, 0x1f, 0x62, 0x06, 0x70,
+    0x72, 0x6f, 0x74, 0x6f, 0x33,
+];
+include!("conductor.api.callback.v1.tonic.rs");
+// @@protoc_insertion_point(module)
\ No newline at end of file
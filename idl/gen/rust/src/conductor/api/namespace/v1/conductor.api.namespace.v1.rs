@@ -8,48 +8,86 @@ pub struct Namespace {
     /// 命名空间名称
     #[prost(string, tag = "2")]
     pub name: ::prost::alloc::string::String,
+    /// 分发权重 工作者不足时各命名空间按权重分享
+    #[prost(int32, tag = "3")]
+    pub weight: i32,
+    /// 同时在执行（已分发和执行中）的任务数上限 0表示不限制
+    #[prost(int64, tag = "4")]
+    pub max_inflight: i64,
+    /// 回调签名密钥 仅在创建和重置密钥时返回
+    #[prost(string, tag = "5")]
+    pub callback_secret: ::prost::alloc::string::String,
 }
 /// Encoded file descriptor set for the `conductor.api.namespace.v1` package
 pub const FILE_DESCRIPTOR_SET: &[u8] = &[
-    0x0a, 0xf4, 0x04, 0x0a, 0x2a, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x61,
+    0x0a, 0xcb, 0x08, 0x0a, 0x2a, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x61,
     0x70, 0x69, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f,
     0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
     0x1a, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6e,
-    0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x22, 0x2f, 0x0a, 0x09, 0x4e,
-    0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-    0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-    0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x8e, 0x02, 0x0a,
-    0x1e, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61,
-    0x70, 0x69, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x42,
-    0x0e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-    0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x79,
-    0x61, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x77, 0x68, 0x69, 0x6d,
-    0x65, 0x72, 0x2f, 0x69, 0x64, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f,
-    0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x61, 0x6d, 0x65,
-    0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
-    0x63, 0x65, 0xa2, 0x02, 0x03, 0x43, 0x41, 0x4e, 0xaa, 0x02, 0x1a, 0x43, 0x6f, 0x6e, 0x64, 0x75,
-    0x63, 0x74, 0x6f, 0x72, 0x2e, 0x41, 0x70, 0x69, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
-    0x63, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1b, 0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f,
-    0x72, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f,
-    0x5c, 0x56, 0x31, 0xe2, 0x02, 0x27, 0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x5c,
-    0x41, 0x70, 0x69, 0x5c, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x5c, 0x56,
-    0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1d,
-    0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a, 0x3a,
-    0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x4a, 0xdf, 0x01,
-    0x0a, 0x06, 0x12, 0x04, 0x00, 0x00, 0x0c, 0x01, 0x0a, 0x08, 0x0a, 0x01, 0x0c, 0x12, 0x03, 0x00,
-    0x00, 0x12, 0x0a, 0x08, 0x0a, 0x01, 0x02, 0x12, 0x03, 0x02, 0x00, 0x23, 0x0a, 0x08, 0x0a, 0x01,
-    0x08, 0x12, 0x03, 0x04, 0x00, 0x66, 0x0a, 0x09, 0x0a, 0x02, 0x08, 0x0b, 0x12, 0x03, 0x04, 0x00,
-    0x66, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x00, 0x12, 0x04, 0x06, 0x00, 0x0c, 0x01, 0x0a, 0x0a, 0x0a,
-    0x03, 0x04, 0x00, 0x01, 0x12, 0x03, 0x06, 0x08, 0x11, 0x0a, 0x1d, 0x0a, 0x04, 0x04, 0x00, 0x02,
-    0x00, 0x12, 0x03, 0x08, 0x02, 0x10, 0x1a, 0x10, 0x20, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7,
-    0xa9, 0xba, 0xe9, 0x97, 0xb4, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00,
-    0x05, 0x12, 0x03, 0x08, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x01, 0x12,
-    0x03, 0x08, 0x09, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x08,
-    0x0e, 0x0f, 0x0a, 0x21, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x01, 0x12, 0x03, 0x0b, 0x02, 0x12, 0x1a,
-    0x14, 0x20, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xe5, 0x90,
-    0x8d, 0xe7, 0xa7, 0xb0, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x05, 0x12, 0x03,
-    0x0b, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x0b, 0x09,
-    0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x03, 0x12, 0x03, 0x0b, 0x10, 0x11, 0x62,
-    0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+    0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x22, 0x93, 0x01, 0x0a, 0x09,
+    0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+    0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+    0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+    0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x77,
+    0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x66,
+    0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x78,
+    0x49, 0x6e, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x61, 0x6c, 0x6c,
+    0x62, 0x61, 0x63, 0x6b, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+    0x09, 0x52, 0x0e, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x65, 0x63, 0x72, 0x65,
+    0x74, 0x42, 0x8e, 0x02, 0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63,
+    0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+    0x65, 0x2e, 0x76, 0x31, 0x42, 0x0e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50,
+    0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+    0x6f, 0x6d, 0x2f, 0x72, 0x79, 0x61, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x62, 0x6f, 0x6f, 0x6b, 0x73,
+    0x2f, 0x77, 0x68, 0x69, 0x6d, 0x65, 0x72, 0x2f, 0x69, 0x64, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f,
+    0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x61, 0x70, 0x69,
+    0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6e, 0x61,
+    0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0xa2, 0x02, 0x03, 0x43, 0x41, 0x4e, 0xaa, 0x02, 0x1a,
+    0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x41, 0x70, 0x69, 0x2e, 0x4e, 0x61,
+    0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1b, 0x43, 0x6f, 0x6e,
+    0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+    0x70, 0x61, 0x63, 0x65, 0x5f, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x27, 0x43, 0x6f, 0x6e, 0x64, 0x75,
+    0x63, 0x74, 0x6f, 0x72, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+    0x63, 0x65, 0x5f, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+    0x74, 0x61, 0xea, 0x02, 0x1d, 0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x3a, 0x3a,
+    0x41, 0x70, 0x69, 0x3a, 0x3a, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x3a, 0x3a,
+    0x56, 0x31, 0x4a, 0xd1, 0x04, 0x0a, 0x06, 0x12, 0x04, 0x00, 0x00, 0x15, 0x01, 0x0a, 0x08, 0x0a,
+    0x01, 0x0c, 0x12, 0x03, 0x00, 0x00, 0x12, 0x0a, 0x08, 0x0a, 0x01, 0x02, 0x12, 0x03, 0x02, 0x00,
+    0x23, 0x0a, 0x08, 0x0a, 0x01, 0x08, 0x12, 0x03, 0x04, 0x00, 0x66, 0x0a, 0x09, 0x0a, 0x02, 0x08,
+    0x0b, 0x12, 0x03, 0x04, 0x00, 0x66, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x00, 0x12, 0x04, 0x06, 0x00,
+    0x15, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x00, 0x01, 0x12, 0x03, 0x06, 0x08, 0x11, 0x0a, 0x1d,
+    0x0a, 0x04, 0x04, 0x00, 0x02, 0x00, 0x12, 0x03, 0x08, 0x02, 0x10, 0x1a, 0x10, 0x20, 0xe5, 0x91,
+    0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x00, 0x02, 0x00, 0x05, 0x12, 0x03, 0x08, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x08, 0x09, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02,
+    0x00, 0x03, 0x12, 0x03, 0x08, 0x0e, 0x0f, 0x0a, 0x21, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x01, 0x12,
+    0x03, 0x0b, 0x02, 0x12, 0x1a, 0x14, 0x20, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba,
+    0xe9, 0x97, 0xb4, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00,
+    0x02, 0x01, 0x05, 0x12, 0x03, 0x0b, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01,
+    0x01, 0x12, 0x03, 0x0b, 0x09, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x03, 0x12,
+    0x03, 0x0b, 0x10, 0x11, 0x0a, 0x4c, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x02, 0x12, 0x03, 0x0e, 0x02,
+    0x13, 0x1a, 0x3f, 0x20, 0xe5, 0x88, 0x86, 0xe5, 0x8f, 0x91, 0xe6, 0x9d, 0x83, 0xe9, 0x87, 0x8d,
+    0x20, 0xe5, 0xb7, 0xa5, 0xe4, 0xbd, 0x9c, 0xe8, 0x80, 0x85, 0xe4, 0xb8, 0x8d, 0xe8, 0xb6, 0xb3,
+    0xe6, 0x97, 0xb6, 0xe5, 0x90, 0x84, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9,
+    0x97, 0xb4, 0xe6, 0x8c, 0x89, 0xe6, 0x9d, 0x83, 0xe9, 0x87, 0x8d, 0xe5, 0x88, 0x86, 0xe4, 0xba,
+    0xab, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x05, 0x12, 0x03, 0x0e, 0x02, 0x07,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x01, 0x12, 0x03, 0x0e, 0x08, 0x0e, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x03, 0x12, 0x03, 0x0e, 0x11, 0x12, 0x0a, 0x5c, 0x0a, 0x04,
+    0x04, 0x00, 0x02, 0x03, 0x12, 0x03, 0x11, 0x02, 0x19, 0x1a, 0x4f, 0x20, 0xe5, 0x90, 0x8c, 0xe6,
+    0x97, 0xb6, 0xe5, 0x9c, 0xa8, 0xe6, 0x89, 0xa7, 0xe8, 0xa1, 0x8c, 0xef, 0xbc, 0x88, 0xe5, 0xb7,
+    0xb2, 0xe5, 0x88, 0x86, 0xe5, 0x8f, 0x91, 0xe5, 0x92, 0x8c, 0xe6, 0x89, 0xa7, 0xe8, 0xa1, 0x8c,
+    0xe4, 0xb8, 0xad, 0xef, 0xbc, 0x89, 0xe7, 0x9a, 0x84, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe6,
+    0x95, 0xb0, 0xe4, 0xb8, 0x8a, 0xe9, 0x99, 0x90, 0x20, 0x30, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba,
+    0xe4, 0xb8, 0x8d, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00,
+    0x02, 0x03, 0x05, 0x12, 0x03, 0x11, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x03,
+    0x01, 0x12, 0x03, 0x11, 0x08, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x03, 0x03, 0x12,
+    0x03, 0x11, 0x17, 0x18, 0x0a, 0x46, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x04, 0x12, 0x03, 0x14, 0x02,
+    0x1d, 0x1a, 0x39, 0x20, 0xe5, 0x9b, 0x9e, 0xe8, 0xb0, 0x83, 0xe7, 0xad, 0xbe, 0xe5, 0x90, 0x8d,
+    0xe5, 0xaf, 0x86, 0xe9, 0x92, 0xa5, 0x20, 0xe4, 0xbb, 0x85, 0xe5, 0x9c, 0xa8, 0xe5, 0x88, 0x9b,
+    0xe5, 0xbb, 0xba, 0xe5, 0x92, 0x8c, 0xe9, 0x87, 0x8d, 0xe7, 0xbd, 0xae, 0xe5, 0xaf, 0x86, 0xe9,
+    0x92, 0xa5, 0xe6, 0x97, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x0a, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x00, 0x02, 0x04, 0x05, 0x12, 0x03, 0x14, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00,
+    0x02, 0x04, 0x01, 0x12, 0x03, 0x14, 0x09, 0x18, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x04,
+    0x03, 0x12, 0x03, 0x14, 0x1b, 0x1c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 ];
 // @@protoc_insertion_point(module)
\ No newline at end of file
@@ -5,6 +5,12 @@ pub struct CreateNamespaceRequest {
     /// 命名空间名称
     #[prost(string, tag = "1")]
     pub name: ::prost::alloc::string::String,
+    /// 分发权重 \[1, 100\] 不设置则为1
+    #[prost(int32, tag = "2")]
+    pub weight: i32,
+    /// 同时在执行的任务数上限 0表示不限制
+    #[prost(int64, tag = "3")]
+    pub max_inflight: i64,
 }
 #[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
 pub struct CreateNamespaceResponse {
@@ -28,9 +34,37 @@ pub struct ListNamespaceResponse {
     #[prost(int64, tag = "2")]
     pub total: i64,
 }
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct UpdateNamespaceRequest {
+    /// 命名空间名称
+    #[prost(string, tag = "1")]
+    pub name: ::prost::alloc::string::String,
+    /// 分发权重 \[1, 100\]
+    #[prost(int32, tag = "2")]
+    pub weight: i32,
+    /// 同时在执行的任务数上限 0表示不限制
+    #[prost(int64, tag = "3")]
+    pub max_inflight: i64,
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct UpdateNamespaceResponse {
+    #[prost(message, optional, tag = "1")]
+    pub namespace: ::core::option::Option<super::super::namespace::v1::Namespace>,
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct ResetCallbackSecretRequest {
+    /// 命名空间名称
+    #[prost(string, tag = "1")]
+    pub name: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct ResetCallbackSecretResponse {
+    #[prost(message, optional, tag = "1")]
+    pub namespace: ::core::option::Option<super::super::namespace::v1::Namespace>,
+}
 /// Encoded file descriptor set for the `conductor.api.namespaceservice.v1` package
 pub const FILE_DESCRIPTOR_SET: &[u8] = &[
-    0x0a, 0x83, 0x10, 0x0a, 0x38, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x61,
+    0x0a, 0xc2, 0x1f, 0x0a, 0x38, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x61,
     0x70, 0x69, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x65, 0x72, 0x76,
     0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
     0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x21, 0x63,
@@ -40,10 +74,15 @@ pub const FILE_DESCRIPTOR_SET: &[u8] = &[
     0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x63,
     0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x61, 0x6d,
     0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-    0x61, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x35, 0x0a, 0x16, 0x43, 0x72, 0x65,
-    0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-    0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-    0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+    0x61, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x84, 0x01, 0x0a, 0x16, 0x43, 0x72,
+    0x65, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71,
+    0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+    0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+    0x65, 0x12, 0x21, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+    0x05, 0x42, 0x09, 0xba, 0x48, 0x06, 0x1a, 0x04, 0x18, 0x64, 0x28, 0x00, 0x52, 0x06, 0x77, 0x65,
+    0x69, 0x67, 0x68, 0x74, 0x12, 0x2a, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x66, 0x6c,
+    0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22,
+    0x02, 0x28, 0x00, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74,
     0x22, 0x5e, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
     0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x09, 0x6e,
     0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
@@ -62,103 +101,222 @@ pub const FILE_DESCRIPTOR_SET: &[u8] = &[
     0x61, 0x70, 0x69, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31,
     0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65,
     0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
-    0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x32, 0xa2, 0x02, 0x0a,
-    0x10, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-    0x65, 0x12, 0x88, 0x01, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-    0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x39, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f,
-    0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73,
-    0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-    0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-    0x1a, 0x3a, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69,
-    0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-    0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73,
-    0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x82, 0x01, 0x0a,
-    0x0d, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x37,
-    0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6e,
-    0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-    0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-    0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63,
-    0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-    0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-    0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-    0x65, 0x42, 0xc4, 0x02, 0x0a, 0x25, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63,
-    0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-    0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x15, 0x4e, 0x61, 0x6d,
-    0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f,
-    0x74, 0x6f, 0x50, 0x01, 0x5a, 0x5d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-    0x2f, 0x72, 0x79, 0x61, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x77,
-    0x68, 0x69, 0x6d, 0x65, 0x72, 0x2f, 0x69, 0x64, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f,
-    0x2f, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e,
-    0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f,
-    0x76, 0x31, 0x3b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x65, 0x72, 0x76,
-    0x69, 0x63, 0x65, 0xa2, 0x02, 0x03, 0x43, 0x41, 0x4e, 0xaa, 0x02, 0x21, 0x43, 0x6f, 0x6e, 0x64,
-    0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x41, 0x70, 0x69, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-    0x61, 0x63, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x21,
-    0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x4e, 0x61,
-    0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5c, 0x56,
-    0x31, 0xe2, 0x02, 0x2d, 0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x5c, 0x41, 0x70,
-    0x69, 0x5c, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69,
-    0x63, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-    0x61, 0xea, 0x02, 0x24, 0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x3a, 0x3a, 0x41,
-    0x70, 0x69, 0x3a, 0x3a, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x65, 0x72,
-    0x76, 0x69, 0x63, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x4a, 0x83, 0x07, 0x0a, 0x06, 0x12, 0x04, 0x00,
-    0x00, 0x25, 0x01, 0x0a, 0x08, 0x0a, 0x01, 0x0c, 0x12, 0x03, 0x00, 0x00, 0x12, 0x0a, 0x09, 0x0a,
-    0x02, 0x03, 0x00, 0x12, 0x03, 0x02, 0x00, 0x25, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x01, 0x12, 0x03,
-    0x03, 0x00, 0x34, 0x0a, 0x08, 0x0a, 0x01, 0x02, 0x12, 0x03, 0x05, 0x00, 0x2a, 0x0a, 0x08, 0x0a,
-    0x01, 0x08, 0x12, 0x03, 0x07, 0x00, 0x74, 0x0a, 0x09, 0x0a, 0x02, 0x08, 0x0b, 0x12, 0x03, 0x07,
-    0x00, 0x74, 0x0a, 0x0a, 0x0a, 0x02, 0x06, 0x00, 0x12, 0x04, 0x09, 0x00, 0x0f, 0x01, 0x0a, 0x0a,
-    0x0a, 0x03, 0x06, 0x00, 0x01, 0x12, 0x03, 0x09, 0x08, 0x18, 0x0a, 0x21, 0x0a, 0x04, 0x06, 0x00,
-    0x02, 0x00, 0x12, 0x03, 0x0b, 0x02, 0x50, 0x1a, 0x14, 0x20, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba,
-    0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0x0a, 0x0a, 0x0c, 0x0a,
-    0x05, 0x06, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x0b, 0x06, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x06,
-    0x00, 0x02, 0x00, 0x02, 0x12, 0x03, 0x0b, 0x16, 0x2c, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02,
-    0x00, 0x03, 0x12, 0x03, 0x0b, 0x37, 0x4e, 0x0a, 0x27, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x01, 0x12,
-    0x03, 0x0e, 0x02, 0x4a, 0x1a, 0x1a, 0x20, 0xe5, 0x88, 0x86, 0xe9, 0xa1, 0xb5, 0xe5, 0x88, 0x97,
-    0xe5, 0x87, 0xba, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0x0a,
-    0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x0e, 0x06, 0x13, 0x0a, 0x0c,
-    0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x02, 0x12, 0x03, 0x0e, 0x14, 0x28, 0x0a, 0x0c, 0x0a, 0x05,
-    0x06, 0x00, 0x02, 0x01, 0x03, 0x12, 0x03, 0x0e, 0x33, 0x48, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x00,
-    0x12, 0x04, 0x11, 0x00, 0x14, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x00, 0x01, 0x12, 0x03, 0x11,
-    0x08, 0x1e, 0x0a, 0x21, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x00, 0x12, 0x03, 0x13, 0x02, 0x3c, 0x1a,
-    0x14, 0x20, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xe5, 0x90,
-    0x8d, 0xe7, 0xa7, 0xb0, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x05, 0x12, 0x03,
-    0x13, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x13, 0x09,
-    0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x13, 0x10, 0x11, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x08, 0x12, 0x03, 0x13, 0x12, 0x3b, 0x0a, 0x10, 0x0a,
-    0x09, 0x04, 0x00, 0x02, 0x00, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x03, 0x13, 0x13, 0x3a, 0x0a,
-    0x0a, 0x0a, 0x02, 0x04, 0x01, 0x12, 0x04, 0x16, 0x00, 0x18, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04,
-    0x01, 0x01, 0x12, 0x03, 0x16, 0x08, 0x1f, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x00, 0x12,
-    0x03, 0x17, 0x02, 0x35, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x06, 0x12, 0x03, 0x17,
-    0x02, 0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x01, 0x12, 0x03, 0x17, 0x27, 0x30,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x03, 0x12, 0x03, 0x17, 0x33, 0x34, 0x0a, 0x0a,
-    0x0a, 0x02, 0x04, 0x02, 0x12, 0x04, 0x1a, 0x00, 0x1f, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x02,
-    0x01, 0x12, 0x03, 0x1a, 0x08, 0x1c, 0x0a, 0x24, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x00, 0x12, 0x03,
-    0x1c, 0x02, 0x36, 0x1a, 0x17, 0x20, 0xe9, 0xa1, 0xb5, 0xe7, 0xa0, 0x81, 0xef, 0xbc, 0x8c, 0xe4,
-    0xbb, 0x8e, 0x20, 0x31, 0x20, 0xe5, 0xbc, 0x80, 0xe5, 0xa7, 0x8b, 0x0a, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x02, 0x02, 0x00, 0x05, 0x12, 0x03, 0x1c, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02,
-    0x02, 0x00, 0x01, 0x12, 0x03, 0x1c, 0x08, 0x0c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00,
-    0x03, 0x12, 0x03, 0x1c, 0x0f, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x08, 0x12,
-    0x03, 0x1c, 0x11, 0x35, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x02, 0x02, 0x00, 0x08, 0x87, 0x09, 0x03,
-    0x05, 0x12, 0x03, 0x1c, 0x12, 0x34, 0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x01, 0x12, 0x03,
-    0x1e, 0x02, 0x44, 0x1a, 0x0e, 0x20, 0xe6, 0xaf, 0x8f, 0xe9, 0xa1, 0xb5, 0xe6, 0x95, 0xb0, 0xe9,
-    0x87, 0x8f, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01, 0x05, 0x12, 0x03, 0x1e, 0x02,
-    0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01, 0x01, 0x12, 0x03, 0x1e, 0x08, 0x0d, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01, 0x03, 0x12, 0x03, 0x1e, 0x10, 0x11, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x02, 0x02, 0x01, 0x08, 0x12, 0x03, 0x1e, 0x12, 0x43, 0x0a, 0x0f, 0x0a, 0x08, 0x04,
-    0x02, 0x02, 0x01, 0x08, 0x87, 0x09, 0x03, 0x12, 0x03, 0x1e, 0x13, 0x42, 0x0a, 0x10, 0x0a, 0x09,
-    0x04, 0x02, 0x02, 0x01, 0x08, 0x87, 0x09, 0x03, 0x05, 0x12, 0x03, 0x1e, 0x31, 0x37, 0x0a, 0x10,
-    0x0a, 0x09, 0x04, 0x02, 0x02, 0x01, 0x08, 0x87, 0x09, 0x03, 0x03, 0x12, 0x03, 0x1e, 0x39, 0x41,
-    0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x03, 0x12, 0x04, 0x21, 0x00, 0x25, 0x01, 0x0a, 0x0a, 0x0a, 0x03,
-    0x04, 0x03, 0x01, 0x12, 0x03, 0x21, 0x08, 0x1d, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x03, 0x02, 0x00,
-    0x12, 0x03, 0x22, 0x02, 0x3f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x04, 0x12, 0x03,
-    0x22, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x06, 0x12, 0x03, 0x22, 0x0b,
-    0x2f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x01, 0x12, 0x03, 0x22, 0x30, 0x3a, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x03, 0x12, 0x03, 0x22, 0x3d, 0x3e, 0x0a, 0x15, 0x0a,
-    0x04, 0x04, 0x03, 0x02, 0x01, 0x12, 0x03, 0x24, 0x02, 0x12, 0x1a, 0x08, 0x20, 0xe6, 0x80, 0xbb,
-    0xe6, 0x95, 0xb0, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01, 0x05, 0x12, 0x03, 0x24,
-    0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01, 0x01, 0x12, 0x03, 0x24, 0x08, 0x0d,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01, 0x03, 0x12, 0x03, 0x24, 0x10, 0x11, 0x62, 0x06,
-    0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+    0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x84, 0x01, 0x0a,
+    0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+    0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+    0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04,
+    0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
+    0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xba, 0x48, 0x06, 0x1a, 0x04, 0x18, 0x64, 0x28, 0x01, 0x52,
+    0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2a, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x69,
+    0x6e, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba,
+    0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x66, 0x6c, 0x69,
+    0x67, 0x68, 0x74, 0x22, 0x5e, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d,
+    0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43,
+    0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+    0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70,
+    0x69, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e,
+    0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+    0x61, 0x63, 0x65, 0x22, 0x39, 0x0a, 0x1a, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c,
+    0x62, 0x61, 0x63, 0x6b, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+    0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+    0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x62,
+    0x0a, 0x1b, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53,
+    0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a,
+    0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+    0x32, 0x25, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61,
+    0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+    0x63, 0x65, 0x32, 0xc4, 0x04, 0x0a, 0x10, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+    0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61,
+    0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x39, 0x2e, 0x63, 0x6f,
+    0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x61, 0x6d, 0x65,
+    0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+    0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52,
+    0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74,
+    0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+    0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+    0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+    0x73, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+    0x70, 0x61, 0x63, 0x65, 0x12, 0x37, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x65,
+    0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d,
+    0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e,
+    0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x61,
+    0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76,
+    0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52,
+    0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61,
+    0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x39, 0x2e, 0x63, 0x6f,
+    0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x61, 0x6d, 0x65,
+    0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+    0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52,
+    0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74,
+    0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+    0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+    0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+    0x73, 0x65, 0x12, 0x94, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c,
+    0x62, 0x61, 0x63, 0x6b, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x3d, 0x2e, 0x63, 0x6f, 0x6e,
+    0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+    0x70, 0x61, 0x63, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+    0x65, 0x73, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x65, 0x63, 0x72,
+    0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x63, 0x6f, 0x6e, 0x64,
+    0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+    0x61, 0x63, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+    0x73, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x65, 0x63, 0x72, 0x65,
+    0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xc4, 0x02, 0x0a, 0x25, 0x63, 0x6f,
+    0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+    0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+    0x2e, 0x76, 0x31, 0x42, 0x15, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x65,
+    0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x5d, 0x67, 0x69,
+    0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x79, 0x61, 0x6e, 0x72, 0x65, 0x61,
+    0x64, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x77, 0x68, 0x69, 0x6d, 0x65, 0x72, 0x2f, 0x69, 0x64,
+    0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74,
+    0x6f, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+    0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+    0x70, 0x61, 0x63, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0xa2, 0x02, 0x03, 0x43, 0x41,
+    0x4e, 0xaa, 0x02, 0x21, 0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x41, 0x70,
+    0x69, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69,
+    0x63, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x21, 0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f,
+    0x72, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73,
+    0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x2d, 0x43, 0x6f, 0x6e, 0x64,
+    0x75, 0x63, 0x74, 0x6f, 0x72, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+    0x61, 0x63, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
+    0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x24, 0x43, 0x6f, 0x6e, 0x64,
+    0x75, 0x63, 0x74, 0x6f, 0x72, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a, 0x3a, 0x4e, 0x61, 0x6d, 0x65,
+    0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x3a, 0x3a, 0x56, 0x31,
+    0x4a, 0xca, 0x10, 0x0a, 0x06, 0x12, 0x04, 0x00, 0x00, 0x4a, 0x01, 0x0a, 0x08, 0x0a, 0x01, 0x0c,
+    0x12, 0x03, 0x00, 0x00, 0x12, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x00, 0x12, 0x03, 0x02, 0x00, 0x25,
+    0x0a, 0x09, 0x0a, 0x02, 0x03, 0x01, 0x12, 0x03, 0x03, 0x00, 0x34, 0x0a, 0x08, 0x0a, 0x01, 0x02,
+    0x12, 0x03, 0x05, 0x00, 0x2a, 0x0a, 0x08, 0x0a, 0x01, 0x08, 0x12, 0x03, 0x07, 0x00, 0x74, 0x0a,
+    0x09, 0x0a, 0x02, 0x08, 0x0b, 0x12, 0x03, 0x07, 0x00, 0x74, 0x0a, 0x0a, 0x0a, 0x02, 0x06, 0x00,
+    0x12, 0x04, 0x09, 0x00, 0x15, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x06, 0x00, 0x01, 0x12, 0x03, 0x09,
+    0x08, 0x18, 0x0a, 0x21, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x00, 0x12, 0x03, 0x0b, 0x02, 0x50, 0x1a,
+    0x14, 0x20, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9,
+    0xba, 0xe9, 0x97, 0xb4, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03,
+    0x0b, 0x06, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x02, 0x12, 0x03, 0x0b, 0x16,
+    0x2c, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x0b, 0x37, 0x4e, 0x0a,
+    0x27, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x01, 0x12, 0x03, 0x0e, 0x02, 0x4a, 0x1a, 0x1a, 0x20, 0xe5,
+    0x88, 0x86, 0xe9, 0xa1, 0xb5, 0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba, 0xe5, 0x91, 0xbd, 0xe5, 0x90,
+    0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01,
+    0x01, 0x12, 0x03, 0x0e, 0x06, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x02, 0x12,
+    0x03, 0x0e, 0x14, 0x28, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x03, 0x12, 0x03, 0x0e,
+    0x33, 0x48, 0x0a, 0x39, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x02, 0x12, 0x03, 0x11, 0x02, 0x50, 0x1a,
+    0x2c, 0x20, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9,
+    0xba, 0xe9, 0x97, 0xb4, 0xe7, 0x9a, 0x84, 0xe5, 0x88, 0x86, 0xe5, 0x8f, 0x91, 0xe6, 0x9d, 0x83,
+    0xe9, 0x87, 0x8d, 0xe5, 0x92, 0x8c, 0xe9, 0x85, 0x8d, 0xe9, 0xa2, 0x9d, 0x0a, 0x0a, 0x0c, 0x0a,
+    0x05, 0x06, 0x00, 0x02, 0x02, 0x01, 0x12, 0x03, 0x11, 0x06, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x06,
+    0x00, 0x02, 0x02, 0x02, 0x12, 0x03, 0x11, 0x16, 0x2c, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02,
+    0x02, 0x03, 0x12, 0x03, 0x11, 0x37, 0x4e, 0x0a, 0x3d, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x03, 0x12,
+    0x03, 0x14, 0x02, 0x5c, 0x1a, 0x30, 0x20, 0xe9, 0x87, 0x8d, 0xe7, 0xbd, 0xae, 0xe5, 0x9b, 0x9e,
+    0xe8, 0xb0, 0x83, 0xe7, 0xad, 0xbe, 0xe5, 0x90, 0x8d, 0xe5, 0xaf, 0x86, 0xe9, 0x92, 0xa5, 0x20,
+    0xe6, 0x97, 0xa7, 0xe5, 0xaf, 0x86, 0xe9, 0x92, 0xa5, 0xe7, 0xab, 0x8b, 0xe5, 0x8d, 0xb3, 0xe5,
+    0xa4, 0xb1, 0xe6, 0x95, 0x88, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x03, 0x01, 0x12,
+    0x03, 0x14, 0x06, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x03, 0x02, 0x12, 0x03, 0x14,
+    0x1a, 0x34, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x03, 0x03, 0x12, 0x03, 0x14, 0x3f, 0x5a,
+    0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x00, 0x12, 0x04, 0x17, 0x00, 0x20, 0x01, 0x0a, 0x0a, 0x0a, 0x03,
+    0x04, 0x00, 0x01, 0x12, 0x03, 0x17, 0x08, 0x1e, 0x0a, 0x21, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x00,
+    0x12, 0x03, 0x19, 0x02, 0x3c, 0x1a, 0x14, 0x20, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9,
+    0xba, 0xe9, 0x97, 0xb4, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x00, 0x02, 0x00, 0x05, 0x12, 0x03, 0x19, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02,
+    0x00, 0x01, 0x12, 0x03, 0x19, 0x09, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x03,
+    0x12, 0x03, 0x19, 0x10, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x08, 0x12, 0x03,
+    0x19, 0x12, 0x3b, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x00, 0x02, 0x00, 0x08, 0x87, 0x09, 0x0e, 0x02,
+    0x12, 0x03, 0x19, 0x13, 0x3a, 0x0a, 0x35, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x01, 0x12, 0x03, 0x1c,
+    0x02, 0x45, 0x1a, 0x28, 0x20, 0xe5, 0x88, 0x86, 0xe5, 0x8f, 0x91, 0xe6, 0x9d, 0x83, 0xe9, 0x87,
+    0x8d, 0x20, 0x5b, 0x31, 0x2c, 0x20, 0x31, 0x30, 0x30, 0x5d, 0x20, 0xe4, 0xb8, 0x8d, 0xe8, 0xae,
+    0xbe, 0xe7, 0xbd, 0xae, 0xe5, 0x88, 0x99, 0xe4, 0xb8, 0xba, 0x31, 0x0a, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x00, 0x02, 0x01, 0x05, 0x12, 0x03, 0x1c, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00,
+    0x02, 0x01, 0x01, 0x12, 0x03, 0x1c, 0x08, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01,
+    0x03, 0x12, 0x03, 0x1c, 0x11, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x08, 0x12,
+    0x03, 0x1c, 0x13, 0x44, 0x0a, 0x0f, 0x0a, 0x08, 0x04, 0x00, 0x02, 0x01, 0x08, 0x87, 0x09, 0x03,
+    0x12, 0x03, 0x1c, 0x14, 0x43, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x00, 0x02, 0x01, 0x08, 0x87, 0x09,
+    0x03, 0x05, 0x12, 0x03, 0x1c, 0x32, 0x38, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x00, 0x02, 0x01, 0x08,
+    0x87, 0x09, 0x03, 0x03, 0x12, 0x03, 0x1c, 0x3a, 0x42, 0x0a, 0x41, 0x0a, 0x04, 0x04, 0x00, 0x02,
+    0x02, 0x12, 0x03, 0x1f, 0x02, 0x3e, 0x1a, 0x34, 0x20, 0xe5, 0x90, 0x8c, 0xe6, 0x97, 0xb6, 0xe5,
+    0x9c, 0xa8, 0xe6, 0x89, 0xa7, 0xe8, 0xa1, 0x8c, 0xe7, 0x9a, 0x84, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a,
+    0xa1, 0xe6, 0x95, 0xb0, 0xe4, 0xb8, 0x8a, 0xe9, 0x99, 0x90, 0x20, 0x30, 0xe8, 0xa1, 0xa8, 0xe7,
+    0xa4, 0xba, 0xe4, 0xb8, 0x8d, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0x0a, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x00, 0x02, 0x02, 0x05, 0x12, 0x03, 0x1f, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00,
+    0x02, 0x02, 0x01, 0x12, 0x03, 0x1f, 0x08, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02,
+    0x03, 0x12, 0x03, 0x1f, 0x17, 0x18, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x08, 0x12,
+    0x03, 0x1f, 0x19, 0x3d, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x00, 0x02, 0x02, 0x08, 0x87, 0x09, 0x04,
+    0x05, 0x12, 0x03, 0x1f, 0x1a, 0x3c, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x01, 0x12, 0x04, 0x22, 0x00,
+    0x24, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x01, 0x01, 0x12, 0x03, 0x22, 0x08, 0x1f, 0x0a, 0x0b,
+    0x0a, 0x04, 0x04, 0x01, 0x02, 0x00, 0x12, 0x03, 0x23, 0x02, 0x35, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x01, 0x02, 0x00, 0x06, 0x12, 0x03, 0x23, 0x02, 0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02,
+    0x00, 0x01, 0x12, 0x03, 0x23, 0x27, 0x30, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x03,
+    0x12, 0x03, 0x23, 0x33, 0x34, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x02, 0x12, 0x04, 0x26, 0x00, 0x2b,
+    0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x02, 0x01, 0x12, 0x03, 0x26, 0x08, 0x1c, 0x0a, 0x24, 0x0a,
+    0x04, 0x04, 0x02, 0x02, 0x00, 0x12, 0x03, 0x28, 0x02, 0x36, 0x1a, 0x17, 0x20, 0xe9, 0xa1, 0xb5,
+    0xe7, 0xa0, 0x81, 0xef, 0xbc, 0x8c, 0xe4, 0xbb, 0x8e, 0x20, 0x31, 0x20, 0xe5, 0xbc, 0x80, 0xe5,
+    0xa7, 0x8b, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x05, 0x12, 0x03, 0x28, 0x02,
+    0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x01, 0x12, 0x03, 0x28, 0x08, 0x0c, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x03, 0x12, 0x03, 0x28, 0x0f, 0x10, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x02, 0x02, 0x00, 0x08, 0x12, 0x03, 0x28, 0x11, 0x35, 0x0a, 0x10, 0x0a, 0x09, 0x04,
+    0x02, 0x02, 0x00, 0x08, 0x87, 0x09, 0x03, 0x05, 0x12, 0x03, 0x28, 0x12, 0x34, 0x0a, 0x1b, 0x0a,
+    0x04, 0x04, 0x02, 0x02, 0x01, 0x12, 0x03, 0x2a, 0x02, 0x44, 0x1a, 0x0e, 0x20, 0xe6, 0xaf, 0x8f,
+    0xe9, 0xa1, 0xb5, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02,
+    0x02, 0x01, 0x05, 0x12, 0x03, 0x2a, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01,
+    0x01, 0x12, 0x03, 0x2a, 0x08, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01, 0x03, 0x12,
+    0x03, 0x2a, 0x10, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01, 0x08, 0x12, 0x03, 0x2a,
+    0x12, 0x43, 0x0a, 0x0f, 0x0a, 0x08, 0x04, 0x02, 0x02, 0x01, 0x08, 0x87, 0x09, 0x03, 0x12, 0x03,
+    0x2a, 0x13, 0x42, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x02, 0x02, 0x01, 0x08, 0x87, 0x09, 0x03, 0x05,
+    0x12, 0x03, 0x2a, 0x31, 0x37, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x02, 0x02, 0x01, 0x08, 0x87, 0x09,
+    0x03, 0x03, 0x12, 0x03, 0x2a, 0x39, 0x41, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x03, 0x12, 0x04, 0x2d,
+    0x00, 0x31, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x03, 0x01, 0x12, 0x03, 0x2d, 0x08, 0x1d, 0x0a,
+    0x0b, 0x0a, 0x04, 0x04, 0x03, 0x02, 0x00, 0x12, 0x03, 0x2e, 0x02, 0x3f, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x03, 0x02, 0x00, 0x04, 0x12, 0x03, 0x2e, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03,
+    0x02, 0x00, 0x06, 0x12, 0x03, 0x2e, 0x0b, 0x2f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00,
+    0x01, 0x12, 0x03, 0x2e, 0x30, 0x3a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x03, 0x12,
+    0x03, 0x2e, 0x3d, 0x3e, 0x0a, 0x15, 0x0a, 0x04, 0x04, 0x03, 0x02, 0x01, 0x12, 0x03, 0x30, 0x02,
+    0x12, 0x1a, 0x08, 0x20, 0xe6, 0x80, 0xbb, 0xe6, 0x95, 0xb0, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x03, 0x02, 0x01, 0x05, 0x12, 0x03, 0x30, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02,
+    0x01, 0x01, 0x12, 0x03, 0x30, 0x08, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01, 0x03,
+    0x12, 0x03, 0x30, 0x10, 0x11, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x04, 0x12, 0x04, 0x33, 0x00, 0x3c,
+    0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x04, 0x01, 0x12, 0x03, 0x33, 0x08, 0x1e, 0x0a, 0x21, 0x0a,
+    0x04, 0x04, 0x04, 0x02, 0x00, 0x12, 0x03, 0x35, 0x02, 0x3c, 0x1a, 0x14, 0x20, 0xe5, 0x91, 0xbd,
+    0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x0a,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x05, 0x12, 0x03, 0x35, 0x02, 0x08, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x01, 0x12, 0x03, 0x35, 0x09, 0x0d, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x04, 0x02, 0x00, 0x03, 0x12, 0x03, 0x35, 0x10, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04,
+    0x02, 0x00, 0x08, 0x12, 0x03, 0x35, 0x12, 0x3b, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x04, 0x02, 0x00,
+    0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x03, 0x35, 0x13, 0x3a, 0x0a, 0x24, 0x0a, 0x04, 0x04, 0x04,
+    0x02, 0x01, 0x12, 0x03, 0x38, 0x02, 0x45, 0x1a, 0x17, 0x20, 0xe5, 0x88, 0x86, 0xe5, 0x8f, 0x91,
+    0xe6, 0x9d, 0x83, 0xe9, 0x87, 0x8d, 0x20, 0x5b, 0x31, 0x2c, 0x20, 0x31, 0x30, 0x30, 0x5d, 0x0a,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x01, 0x05, 0x12, 0x03, 0x38, 0x02, 0x07, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x04, 0x02, 0x01, 0x01, 0x12, 0x03, 0x38, 0x08, 0x0e, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x04, 0x02, 0x01, 0x03, 0x12, 0x03, 0x38, 0x11, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04,
+    0x02, 0x01, 0x08, 0x12, 0x03, 0x38, 0x13, 0x44, 0x0a, 0x0f, 0x0a, 0x08, 0x04, 0x04, 0x02, 0x01,
+    0x08, 0x87, 0x09, 0x03, 0x12, 0x03, 0x38, 0x14, 0x43, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x04, 0x02,
+    0x01, 0x08, 0x87, 0x09, 0x03, 0x05, 0x12, 0x03, 0x38, 0x32, 0x38, 0x0a, 0x10, 0x0a, 0x09, 0x04,
+    0x04, 0x02, 0x01, 0x08, 0x87, 0x09, 0x03, 0x03, 0x12, 0x03, 0x38, 0x3a, 0x42, 0x0a, 0x41, 0x0a,
+    0x04, 0x04, 0x04, 0x02, 0x02, 0x12, 0x03, 0x3b, 0x02, 0x3e, 0x1a, 0x34, 0x20, 0xe5, 0x90, 0x8c,
+    0xe6, 0x97, 0xb6, 0xe5, 0x9c, 0xa8, 0xe6, 0x89, 0xa7, 0xe8, 0xa1, 0x8c, 0xe7, 0x9a, 0x84, 0xe4,
+    0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe6, 0x95, 0xb0, 0xe4, 0xb8, 0x8a, 0xe9, 0x99, 0x90, 0x20, 0x30,
+    0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe4, 0xb8, 0x8d, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0x0a,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x02, 0x05, 0x12, 0x03, 0x3b, 0x02, 0x07, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x04, 0x02, 0x02, 0x01, 0x12, 0x03, 0x3b, 0x08, 0x14, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x04, 0x02, 0x02, 0x03, 0x12, 0x03, 0x3b, 0x17, 0x18, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04,
+    0x02, 0x02, 0x08, 0x12, 0x03, 0x3b, 0x19, 0x3d, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x04, 0x02, 0x02,
+    0x08, 0x87, 0x09, 0x04, 0x05, 0x12, 0x03, 0x3b, 0x1a, 0x3c, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x05,
+    0x12, 0x04, 0x3e, 0x00, 0x40, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x05, 0x01, 0x12, 0x03, 0x3e,
+    0x08, 0x1f, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x05, 0x02, 0x00, 0x12, 0x03, 0x3f, 0x02, 0x35, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x00, 0x06, 0x12, 0x03, 0x3f, 0x02, 0x26, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x05, 0x02, 0x00, 0x01, 0x12, 0x03, 0x3f, 0x27, 0x30, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x05, 0x02, 0x00, 0x03, 0x12, 0x03, 0x3f, 0x33, 0x34, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x06, 0x12,
+    0x04, 0x43, 0x00, 0x46, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x06, 0x01, 0x12, 0x03, 0x43, 0x08,
+    0x22, 0x0a, 0x21, 0x0a, 0x04, 0x04, 0x06, 0x02, 0x00, 0x12, 0x03, 0x45, 0x02, 0x3c, 0x1a, 0x14,
+    0x20, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xe5, 0x90, 0x8d,
+    0xe7, 0xa7, 0xb0, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x05, 0x12, 0x03, 0x45,
+    0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x01, 0x12, 0x03, 0x45, 0x09, 0x0d,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x03, 0x12, 0x03, 0x45, 0x10, 0x11, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x08, 0x12, 0x03, 0x45, 0x12, 0x3b, 0x0a, 0x10, 0x0a, 0x09,
+    0x04, 0x06, 0x02, 0x00, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x03, 0x45, 0x13, 0x3a, 0x0a, 0x0a,
+    0x0a, 0x02, 0x04, 0x07, 0x12, 0x04, 0x48, 0x00, 0x4a, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x07,
+    0x01, 0x12, 0x03, 0x48, 0x08, 0x23, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x07, 0x02, 0x00, 0x12, 0x03,
+    0x49, 0x02, 0x35, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x06, 0x12, 0x03, 0x49, 0x02,
+    0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x01, 0x12, 0x03, 0x49, 0x27, 0x30, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x03, 0x12, 0x03, 0x49, 0x33, 0x34, 0x62, 0x06, 0x70,
+    0x72, 0x6f, 0x74, 0x6f, 0x33,
 ];
 include!("conductor.api.namespaceservice.v1.tonic.rs");
 // @@protoc_insertion_point(module)
\ No newline at end of file
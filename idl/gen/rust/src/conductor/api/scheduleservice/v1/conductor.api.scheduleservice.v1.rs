@@ -0,0 +1,453 @@
+// @generated
+// This file is @generated by prost-build.
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct UpsertScheduleRequest {
+    /// 所属命名空间
+    #[prost(string, tag = "1")]
+    pub namespace: ::prost::alloc::string::String,
+    /// 定时任务名称 同一命名空间下唯一
+    #[prost(string, tag = "2")]
+    pub name: ::prost::alloc::string::String,
+    /// 每次触发时生成的任务类型
+    #[prost(string, tag = "3")]
+    pub task_type: ::prost::alloc::string::String,
+    /// cron表达式 支持标准5段式以及@every, @daily等描述符
+    #[prost(string, tag = "4")]
+    pub cron_expr: ::prost::alloc::string::String,
+    /// 输入参数模板 支持text/template语法 可引用.Name, .FireTime
+    #[prost(bytes = "bytes", tag = "5")]
+    pub input_args: ::prost::bytes::Bytes,
+    /// 回调url
+    #[prost(string, tag = "6")]
+    pub callback_url: ::prost::alloc::string::String,
+    /// 生成任务的最大重试次数 -1表示无限重试直到超时, 0表示不重试
+    #[prost(int64, tag = "7")]
+    pub max_retry_cnt: i64,
+    /// 生成任务的存活时长 ms 0表示不过期
+    #[prost(int64, tag = "8")]
+    pub expire_after: i64,
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct UpsertScheduleResponse {
+    #[prost(message, optional, tag = "1")]
+    pub schedule: ::core::option::Option<super::super::schedule::v1::Schedule>,
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct GetScheduleRequest {
+    #[prost(string, tag = "1")]
+    pub namespace: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub name: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct GetScheduleResponse {
+    #[prost(message, optional, tag = "1")]
+    pub schedule: ::core::option::Option<super::super::schedule::v1::Schedule>,
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct ListScheduleRequest {
+    #[prost(string, tag = "1")]
+    pub namespace: ::prost::alloc::string::String,
+    /// 页码，从 1 开始
+    #[prost(int32, tag = "2")]
+    pub page: i32,
+    /// 每页数量
+    #[prost(int32, tag = "3")]
+    pub count: i32,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ListScheduleResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub schedules: ::prost::alloc::vec::Vec<super::super::schedule::v1::Schedule>,
+    /// 总数
+    #[prost(int64, tag = "2")]
+    pub total: i64,
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct PauseScheduleRequest {
+    #[prost(string, tag = "1")]
+    pub namespace: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub name: ::prost::alloc::string::String,
+}
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct PauseScheduleResponse {
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct ResumeScheduleRequest {
+    #[prost(string, tag = "1")]
+    pub namespace: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub name: ::prost::alloc::string::String,
+}
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct ResumeScheduleResponse {
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct DeleteScheduleRequest {
+    #[prost(string, tag = "1")]
+    pub namespace: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub name: ::prost::alloc::string::String,
+}
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct DeleteScheduleResponse {
+}
+/// Encoded file descriptor set for the `conductor.api.scheduleservice.v1` package
+pub const FILE_DESCRIPTOR_SET: &[u8] = &[
+    0x0a, 0x82, 0x2c, 0x0a, 0x36, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x61,
+    0x70, 0x69, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69,
+    0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x65,
+    0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x20, 0x63, 0x6f, 0x6e,
+    0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+    0x75, 0x6c, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x62,
+    0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69,
+    0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x28, 0x63, 0x6f, 0x6e, 0x64,
+    0x75, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+    0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70,
+    0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb9, 0x02, 0x0a, 0x15, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x53,
+    0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25,
+    0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+    0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+    0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+    0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61,
+    0x6d, 0x65, 0x12, 0x24, 0x0a, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+    0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08,
+    0x74, 0x61, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x09, 0x63, 0x72, 0x6f, 0x6e,
+    0x5f, 0x65, 0x78, 0x70, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04,
+    0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x63, 0x72, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x12, 0x1d,
+    0x0a, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01,
+    0x28, 0x0c, 0x52, 0x09, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x72, 0x67, 0x73, 0x12, 0x21, 0x0a,
+    0x0c, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20,
+    0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x55, 0x72, 0x6c,
+    0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6e,
+    0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72,
+    0x79, 0x43, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x61,
+    0x66, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22,
+    0x02, 0x28, 0x00, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72,
+    0x22, 0x59, 0x0a, 0x16, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+    0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x73, 0x63,
+    0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63,
+    0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x63, 0x68,
+    0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+    0x65, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x58, 0x0a, 0x12, 0x47,
+    0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+    0x74, 0x12, 0x25, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01,
+    0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x6e,
+    0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+    0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+    0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x56, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65,
+    0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x08,
+    0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
+    0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
+    0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64,
+    0x75, 0x6c, 0x65, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x7a, 0x0a,
+    0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71,
+    0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+    0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01,
+    0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x70,
+    0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02,
+    0x28, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+    0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xba, 0x48, 0x06, 0x1a, 0x04, 0x18, 0x64,
+    0x28, 0x01, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x6f, 0x0a, 0x14, 0x4c, 0x69, 0x73,
+    0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+    0x65, 0x12, 0x41, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01,
+    0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+    0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64,
+    0x75, 0x6c, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20,
+    0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x5a, 0x0a, 0x14, 0x50, 0x61,
+    0x75, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+    0x73, 0x74, 0x12, 0x25, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
+    0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09,
+    0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+    0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01,
+    0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x50, 0x61, 0x75, 0x73, 0x65, 0x53,
+    0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+    0x5b, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+    0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+    0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04,
+    0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
+    0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba,
+    0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x18, 0x0a, 0x16,
+    0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65,
+    0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5b, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+    0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+    0x25, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+    0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x6e, 0x61, 0x6d,
+    0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+    0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e,
+    0x61, 0x6d, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x63, 0x68,
+    0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xa1, 0x06,
+    0x0a, 0x0f, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+    0x65, 0x12, 0x83, 0x01, 0x0a, 0x0e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x53, 0x63, 0x68, 0x65,
+    0x64, 0x75, 0x6c, 0x65, 0x12, 0x37, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x72,
+    0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x53, 0x63,
+    0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e,
+    0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x63,
+    0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31,
+    0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52,
+    0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x63,
+    0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x34, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74,
+    0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73,
+    0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68,
+    0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x63,
+    0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x63, 0x68,
+    0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+    0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+    0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64,
+    0x75, 0x6c, 0x65, 0x12, 0x35, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e,
+    0x61, 0x70, 0x69, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x72, 0x76,
+    0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64,
+    0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x63, 0x6f, 0x6e,
+    0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+    0x75, 0x6c, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+    0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+    0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x0d, 0x50, 0x61, 0x75, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65,
+    0x64, 0x75, 0x6c, 0x65, 0x12, 0x36, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x72,
+    0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x53, 0x63, 0x68,
+    0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x63,
+    0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x63, 0x68,
+    0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+    0x50, 0x61, 0x75, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73,
+    0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x83, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65,
+    0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x37, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75,
+    0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+    0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75,
+    0x6d, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+    0x74, 0x1a, 0x38, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70,
+    0x69, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+    0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64,
+    0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x83, 0x01, 0x0a, 0x0e,
+    0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x37,
+    0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
+    0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76,
+    0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+    0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63,
+    0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+    0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+    0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+    0x65, 0x42, 0xbc, 0x02, 0x0a, 0x24, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63,
+    0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+    0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x14, 0x53, 0x63, 0x68, 0x65,
+    0x64, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+    0x50, 0x01, 0x5a, 0x5b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72,
+    0x79, 0x61, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x77, 0x68, 0x69,
+    0x6d, 0x65, 0x72, 0x2f, 0x69, 0x64, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x63,
+    0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x63, 0x68,
+    0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x3b,
+    0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0xa2,
+    0x02, 0x03, 0x43, 0x41, 0x53, 0xaa, 0x02, 0x20, 0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f,
+    0x72, 0x2e, 0x41, 0x70, 0x69, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x65,
+    0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x20, 0x43, 0x6f, 0x6e, 0x64, 0x75,
+    0x63, 0x74, 0x6f, 0x72, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+    0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x2c, 0x43, 0x6f,
+    0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x53, 0x63, 0x68, 0x65,
+    0x64, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+    0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x23, 0x43, 0x6f, 0x6e,
+    0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a, 0x3a, 0x53, 0x63, 0x68,
+    0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x3a, 0x3a, 0x56, 0x31,
+    0x4a, 0xda, 0x18, 0x0a, 0x06, 0x12, 0x04, 0x00, 0x00, 0x68, 0x01, 0x0a, 0x08, 0x0a, 0x01, 0x0c,
+    0x12, 0x03, 0x00, 0x00, 0x12, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x00, 0x12, 0x03, 0x02, 0x00, 0x25,
+    0x0a, 0x09, 0x0a, 0x02, 0x03, 0x01, 0x12, 0x03, 0x03, 0x00, 0x32, 0x0a, 0x08, 0x0a, 0x01, 0x02,
+    0x12, 0x03, 0x05, 0x00, 0x29, 0x0a, 0x08, 0x0a, 0x01, 0x08, 0x12, 0x03, 0x07, 0x00, 0x72, 0x0a,
+    0x09, 0x0a, 0x02, 0x08, 0x0b, 0x12, 0x03, 0x07, 0x00, 0x72, 0x0a, 0x0a, 0x0a, 0x02, 0x06, 0x00,
+    0x12, 0x04, 0x09, 0x00, 0x1b, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x06, 0x00, 0x01, 0x12, 0x03, 0x09,
+    0x08, 0x17, 0x0a, 0x2a, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x00, 0x12, 0x03, 0x0b, 0x02, 0x4d, 0x1a,
+    0x1d, 0x20, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x88, 0x96, 0xe6, 0x9b, 0xb4, 0xe6, 0x96,
+    0xb0, 0xe5, 0xae, 0x9a, 0xe6, 0x97, 0xb6, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0x0a, 0x0a, 0x0c,
+    0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x0b, 0x06, 0x14, 0x0a, 0x0c, 0x0a, 0x05,
+    0x06, 0x00, 0x02, 0x00, 0x02, 0x12, 0x03, 0x0b, 0x15, 0x2a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00,
+    0x02, 0x00, 0x03, 0x12, 0x03, 0x0b, 0x35, 0x4b, 0x0a, 0x21, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x01,
+    0x12, 0x03, 0x0e, 0x02, 0x44, 0x1a, 0x14, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe5, 0xae,
+    0x9a, 0xe6, 0x97, 0xb6, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06,
+    0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x0e, 0x06, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02,
+    0x01, 0x02, 0x12, 0x03, 0x0e, 0x12, 0x24, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x03,
+    0x12, 0x03, 0x0e, 0x2f, 0x42, 0x0a, 0x39, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x02, 0x12, 0x03, 0x11,
+    0x02, 0x47, 0x1a, 0x2c, 0x20, 0xe5, 0x88, 0x86, 0xe9, 0xa1, 0xb5, 0xe5, 0x88, 0x97, 0xe5, 0x87,
+    0xba, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xe4, 0xb8, 0x8b,
+    0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x9a, 0xe6, 0x97, 0xb6, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0x0a,
+    0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x01, 0x12, 0x03, 0x11, 0x06, 0x12, 0x0a, 0x0c,
+    0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x02, 0x12, 0x03, 0x11, 0x13, 0x26, 0x0a, 0x0c, 0x0a, 0x05,
+    0x06, 0x00, 0x02, 0x02, 0x03, 0x12, 0x03, 0x11, 0x31, 0x45, 0x0a, 0x21, 0x0a, 0x04, 0x06, 0x00,
+    0x02, 0x03, 0x12, 0x03, 0x14, 0x02, 0x4a, 0x1a, 0x14, 0x20, 0xe6, 0x9a, 0x82, 0xe5, 0x81, 0x9c,
+    0xe5, 0xae, 0x9a, 0xe6, 0x97, 0xb6, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0x0a, 0x0a, 0x0c, 0x0a,
+    0x05, 0x06, 0x00, 0x02, 0x03, 0x01, 0x12, 0x03, 0x14, 0x06, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x06,
+    0x00, 0x02, 0x03, 0x02, 0x12, 0x03, 0x14, 0x14, 0x28, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02,
+    0x03, 0x03, 0x12, 0x03, 0x14, 0x33, 0x48, 0x0a, 0x21, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x04, 0x12,
+    0x03, 0x17, 0x02, 0x4d, 0x1a, 0x14, 0x20, 0xe6, 0x81, 0xa2, 0xe5, 0xa4, 0x8d, 0xe5, 0xae, 0x9a,
+    0xe6, 0x97, 0xb6, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00,
+    0x02, 0x04, 0x01, 0x12, 0x03, 0x17, 0x06, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x04,
+    0x02, 0x12, 0x03, 0x17, 0x15, 0x2a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x04, 0x03, 0x12,
+    0x03, 0x17, 0x35, 0x4b, 0x0a, 0x21, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x05, 0x12, 0x03, 0x1a, 0x02,
+    0x4d, 0x1a, 0x14, 0x20, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe5, 0xae, 0x9a, 0xe6, 0x97, 0xb6,
+    0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x05, 0x01,
+    0x12, 0x03, 0x1a, 0x06, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x05, 0x02, 0x12, 0x03,
+    0x1a, 0x15, 0x2a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x05, 0x03, 0x12, 0x03, 0x1a, 0x35,
+    0x4b, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x00, 0x12, 0x04, 0x1d, 0x00, 0x35, 0x01, 0x0a, 0x0a, 0x0a,
+    0x03, 0x04, 0x00, 0x01, 0x12, 0x03, 0x1d, 0x08, 0x1d, 0x0a, 0x21, 0x0a, 0x04, 0x04, 0x00, 0x02,
+    0x00, 0x12, 0x03, 0x1f, 0x02, 0x41, 0x1a, 0x14, 0x20, 0xe6, 0x89, 0x80, 0xe5, 0xb1, 0x9e, 0xe5,
+    0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0x0a, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x00, 0x02, 0x00, 0x05, 0x12, 0x03, 0x1f, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00,
+    0x02, 0x00, 0x01, 0x12, 0x03, 0x1f, 0x09, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00,
+    0x03, 0x12, 0x03, 0x1f, 0x15, 0x16, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x08, 0x12,
+    0x03, 0x1f, 0x17, 0x40, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x00, 0x02, 0x00, 0x08, 0x87, 0x09, 0x0e,
+    0x02, 0x12, 0x03, 0x1f, 0x18, 0x3f, 0x0a, 0x3d, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x01, 0x12, 0x03,
+    0x22, 0x02, 0x3c, 0x1a, 0x30, 0x20, 0xe5, 0xae, 0x9a, 0xe6, 0x97, 0xb6, 0xe4, 0xbb, 0xbb, 0xe5,
+    0x8a, 0xa1, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x20, 0xe5, 0x90, 0x8c, 0xe4, 0xb8, 0x80, 0xe5,
+    0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xe4, 0xb8, 0x8b, 0xe5, 0x94,
+    0xaf, 0xe4, 0xb8, 0x80, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x05, 0x12, 0x03,
+    0x22, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x22, 0x09,
+    0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x03, 0x12, 0x03, 0x22, 0x10, 0x11, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x08, 0x12, 0x03, 0x22, 0x12, 0x3b, 0x0a, 0x10, 0x0a,
+    0x09, 0x04, 0x00, 0x02, 0x01, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x03, 0x22, 0x13, 0x3a, 0x0a,
+    0x33, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x02, 0x12, 0x03, 0x25, 0x02, 0x41, 0x1a, 0x26, 0x20, 0xe6,
+    0xaf, 0x8f, 0xe6, 0xac, 0xa1, 0xe8, 0xa7, 0xa6, 0xe5, 0x8f, 0x91, 0xe6, 0x97, 0xb6, 0xe7, 0x94,
+    0x9f, 0xe6, 0x88, 0x90, 0xe7, 0x9a, 0x84, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe7, 0xb1, 0xbb,
+    0xe5, 0x9e, 0x8b, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x05, 0x12, 0x03, 0x25,
+    0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x01, 0x12, 0x03, 0x25, 0x09, 0x12,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x03, 0x12, 0x03, 0x25, 0x15, 0x16, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x08, 0x12, 0x03, 0x25, 0x17, 0x40, 0x0a, 0x10, 0x0a, 0x09,
+    0x04, 0x00, 0x02, 0x02, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x03, 0x25, 0x18, 0x3f, 0x0a, 0x50,
+    0x0a, 0x04, 0x04, 0x00, 0x02, 0x03, 0x12, 0x03, 0x28, 0x02, 0x41, 0x1a, 0x43, 0x20, 0x63, 0x72,
+    0x6f, 0x6e, 0xe8, 0xa1, 0xa8, 0xe8, 0xbe, 0xbe, 0xe5, 0xbc, 0x8f, 0x20, 0xe6, 0x94, 0xaf, 0xe6,
+    0x8c, 0x81, 0xe6, 0xa0, 0x87, 0xe5, 0x87, 0x86, 0x35, 0xe6, 0xae, 0xb5, 0xe5, 0xbc, 0x8f, 0xe4,
+    0xbb, 0xa5, 0xe5, 0x8f, 0x8a, 0x40, 0x65, 0x76, 0x65, 0x72, 0x79, 0x2c, 0x20, 0x40, 0x64, 0x61,
+    0x69, 0x6c, 0x79, 0xe7, 0xad, 0x89, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0xe7, 0xac, 0xa6, 0x0a,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x03, 0x05, 0x12, 0x03, 0x28, 0x02, 0x08, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x00, 0x02, 0x03, 0x01, 0x12, 0x03, 0x28, 0x09, 0x12, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x00, 0x02, 0x03, 0x03, 0x12, 0x03, 0x28, 0x15, 0x16, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00,
+    0x02, 0x03, 0x08, 0x12, 0x03, 0x28, 0x17, 0x40, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x00, 0x02, 0x03,
+    0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x03, 0x28, 0x18, 0x3f, 0x0a, 0x55, 0x0a, 0x04, 0x04, 0x00,
+    0x02, 0x04, 0x12, 0x03, 0x2b, 0x02, 0x17, 0x1a, 0x48, 0x20, 0xe8, 0xbe, 0x93, 0xe5, 0x85, 0xa5,
+    0xe5, 0x8f, 0x82, 0xe6, 0x95, 0xb0, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf, 0x20, 0xe6, 0x94, 0xaf,
+    0xe6, 0x8c, 0x81, 0x74, 0x65, 0x78, 0x74, 0x2f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+    0xe8, 0xaf, 0xad, 0xe6, 0xb3, 0x95, 0x20, 0xe5, 0x8f, 0xaf, 0xe5, 0xbc, 0x95, 0xe7, 0x94, 0xa8,
+    0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x2e, 0x46, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65,
+    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x04, 0x05, 0x12, 0x03, 0x2b, 0x02, 0x07, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x04, 0x01, 0x12, 0x03, 0x2b, 0x08, 0x12, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x00, 0x02, 0x04, 0x03, 0x12, 0x03, 0x2b, 0x15, 0x16, 0x0a, 0x18, 0x0a, 0x04, 0x04,
+    0x00, 0x02, 0x05, 0x12, 0x03, 0x2e, 0x02, 0x1a, 0x1a, 0x0b, 0x20, 0xe5, 0x9b, 0x9e, 0xe8, 0xb0,
+    0x83, 0x75, 0x72, 0x6c, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x05, 0x05, 0x12, 0x03,
+    0x2e, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x05, 0x01, 0x12, 0x03, 0x2e, 0x09,
+    0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x05, 0x03, 0x12, 0x03, 0x2e, 0x18, 0x19, 0x0a,
+    0x63, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x06, 0x12, 0x03, 0x31, 0x02, 0x1a, 0x1a, 0x56, 0x20, 0xe7,
+    0x94, 0x9f, 0xe6, 0x88, 0x90, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe7, 0x9a, 0x84, 0xe6, 0x9c,
+    0x80, 0xe5, 0xa4, 0xa7, 0xe9, 0x87, 0x8d, 0xe8, 0xaf, 0x95, 0xe6, 0xac, 0xa1, 0xe6, 0x95, 0xb0,
+    0x20, 0x2d, 0x31, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe6, 0x97, 0xa0, 0xe9, 0x99, 0x90, 0xe9,
+    0x87, 0x8d, 0xe8, 0xaf, 0x95, 0xe7, 0x9b, 0xb4, 0xe5, 0x88, 0xb0, 0xe8, 0xb6, 0x85, 0xe6, 0x97,
+    0xb6, 0x2c, 0x20, 0x30, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe4, 0xb8, 0x8d, 0xe9, 0x87, 0x8d,
+    0xe8, 0xaf, 0x95, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x06, 0x05, 0x12, 0x03, 0x31,
+    0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x06, 0x01, 0x12, 0x03, 0x31, 0x08, 0x15,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x06, 0x03, 0x12, 0x03, 0x31, 0x18, 0x19, 0x0a, 0x3e,
+    0x0a, 0x04, 0x04, 0x00, 0x02, 0x07, 0x12, 0x03, 0x34, 0x02, 0x3e, 0x1a, 0x31, 0x20, 0xe7, 0x94,
+    0x9f, 0xe6, 0x88, 0x90, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe7, 0x9a, 0x84, 0xe5, 0xad, 0x98,
+    0xe6, 0xb4, 0xbb, 0xe6, 0x97, 0xb6, 0xe9, 0x95, 0xbf, 0x20, 0x6d, 0x73, 0x20, 0x30, 0xe8, 0xa1,
+    0xa8, 0xe7, 0xa4, 0xba, 0xe4, 0xb8, 0x8d, 0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0x9f, 0x0a, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x00, 0x02, 0x07, 0x05, 0x12, 0x03, 0x34, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x00, 0x02, 0x07, 0x01, 0x12, 0x03, 0x34, 0x08, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00,
+    0x02, 0x07, 0x03, 0x12, 0x03, 0x34, 0x17, 0x18, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x07,
+    0x08, 0x12, 0x03, 0x34, 0x19, 0x3d, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x00, 0x02, 0x07, 0x08, 0x87,
+    0x09, 0x04, 0x05, 0x12, 0x03, 0x34, 0x1a, 0x3c, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x01, 0x12, 0x04,
+    0x37, 0x00, 0x39, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x01, 0x01, 0x12, 0x03, 0x37, 0x08, 0x1e,
+    0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x00, 0x12, 0x03, 0x38, 0x02, 0x32, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x01, 0x02, 0x00, 0x06, 0x12, 0x03, 0x38, 0x02, 0x24, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x01, 0x02, 0x00, 0x01, 0x12, 0x03, 0x38, 0x25, 0x2d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02,
+    0x00, 0x03, 0x12, 0x03, 0x38, 0x30, 0x31, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x02, 0x12, 0x04, 0x3b,
+    0x00, 0x3e, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x02, 0x01, 0x12, 0x03, 0x3b, 0x08, 0x1a, 0x0a,
+    0x0b, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x00, 0x12, 0x03, 0x3c, 0x02, 0x41, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x02, 0x02, 0x00, 0x05, 0x12, 0x03, 0x3c, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02,
+    0x02, 0x00, 0x01, 0x12, 0x03, 0x3c, 0x09, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00,
+    0x03, 0x12, 0x03, 0x3c, 0x15, 0x16, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x08, 0x12,
+    0x03, 0x3c, 0x17, 0x40, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x02, 0x02, 0x00, 0x08, 0x87, 0x09, 0x0e,
+    0x02, 0x12, 0x03, 0x3c, 0x18, 0x3f, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x01, 0x12, 0x03,
+    0x3d, 0x02, 0x3c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01, 0x05, 0x12, 0x03, 0x3d, 0x02,
+    0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01, 0x01, 0x12, 0x03, 0x3d, 0x09, 0x0d, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01, 0x03, 0x12, 0x03, 0x3d, 0x10, 0x11, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x02, 0x02, 0x01, 0x08, 0x12, 0x03, 0x3d, 0x12, 0x3b, 0x0a, 0x10, 0x0a, 0x09, 0x04,
+    0x02, 0x02, 0x01, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x03, 0x3d, 0x13, 0x3a, 0x0a, 0x0a, 0x0a,
+    0x02, 0x04, 0x03, 0x12, 0x04, 0x40, 0x00, 0x42, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x03, 0x01,
+    0x12, 0x03, 0x40, 0x08, 0x1b, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x03, 0x02, 0x00, 0x12, 0x03, 0x41,
+    0x02, 0x32, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x06, 0x12, 0x03, 0x41, 0x02, 0x24,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x01, 0x12, 0x03, 0x41, 0x25, 0x2d, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x03, 0x12, 0x03, 0x41, 0x30, 0x31, 0x0a, 0x0a, 0x0a, 0x02,
+    0x04, 0x04, 0x12, 0x04, 0x44, 0x00, 0x4a, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x04, 0x01, 0x12,
+    0x03, 0x44, 0x08, 0x1b, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x00, 0x12, 0x03, 0x45, 0x02,
+    0x41, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x05, 0x12, 0x03, 0x45, 0x02, 0x08, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x01, 0x12, 0x03, 0x45, 0x09, 0x12, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x04, 0x02, 0x00, 0x03, 0x12, 0x03, 0x45, 0x15, 0x16, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x04, 0x02, 0x00, 0x08, 0x12, 0x03, 0x45, 0x17, 0x40, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x04, 0x02,
+    0x00, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x03, 0x45, 0x18, 0x3f, 0x0a, 0x24, 0x0a, 0x04, 0x04,
+    0x04, 0x02, 0x01, 0x12, 0x03, 0x47, 0x02, 0x36, 0x1a, 0x17, 0x20, 0xe9, 0xa1, 0xb5, 0xe7, 0xa0,
+    0x81, 0xef, 0xbc, 0x8c, 0xe4, 0xbb, 0x8e, 0x20, 0x31, 0x20, 0xe5, 0xbc, 0x80, 0xe5, 0xa7, 0x8b,
+    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x01, 0x05, 0x12, 0x03, 0x47, 0x02, 0x07, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x01, 0x01, 0x12, 0x03, 0x47, 0x08, 0x0c, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x04, 0x02, 0x01, 0x03, 0x12, 0x03, 0x47, 0x0f, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x04, 0x02, 0x01, 0x08, 0x12, 0x03, 0x47, 0x11, 0x35, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x04, 0x02,
+    0x01, 0x08, 0x87, 0x09, 0x03, 0x05, 0x12, 0x03, 0x47, 0x12, 0x34, 0x0a, 0x1b, 0x0a, 0x04, 0x04,
+    0x04, 0x02, 0x02, 0x12, 0x03, 0x49, 0x02, 0x44, 0x1a, 0x0e, 0x20, 0xe6, 0xaf, 0x8f, 0xe9, 0xa1,
+    0xb5, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x02,
+    0x05, 0x12, 0x03, 0x49, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x02, 0x01, 0x12,
+    0x03, 0x49, 0x08, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x02, 0x03, 0x12, 0x03, 0x49,
+    0x10, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x02, 0x08, 0x12, 0x03, 0x49, 0x12, 0x43,
+    0x0a, 0x0f, 0x0a, 0x08, 0x04, 0x04, 0x02, 0x02, 0x08, 0x87, 0x09, 0x03, 0x12, 0x03, 0x49, 0x13,
+    0x42, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x04, 0x02, 0x02, 0x08, 0x87, 0x09, 0x03, 0x05, 0x12, 0x03,
+    0x49, 0x31, 0x37, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x04, 0x02, 0x02, 0x08, 0x87, 0x09, 0x03, 0x03,
+    0x12, 0x03, 0x49, 0x39, 0x41, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x05, 0x12, 0x04, 0x4c, 0x00, 0x50,
+    0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x05, 0x01, 0x12, 0x03, 0x4c, 0x08, 0x1c, 0x0a, 0x0b, 0x0a,
+    0x04, 0x04, 0x05, 0x02, 0x00, 0x12, 0x03, 0x4d, 0x02, 0x3c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05,
+    0x02, 0x00, 0x04, 0x12, 0x03, 0x4d, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x00,
+    0x06, 0x12, 0x03, 0x4d, 0x0b, 0x2d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x00, 0x01, 0x12,
+    0x03, 0x4d, 0x2e, 0x37, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x00, 0x03, 0x12, 0x03, 0x4d,
+    0x3a, 0x3b, 0x0a, 0x15, 0x0a, 0x04, 0x04, 0x05, 0x02, 0x01, 0x12, 0x03, 0x4f, 0x02, 0x12, 0x1a,
+    0x08, 0x20, 0xe6, 0x80, 0xbb, 0xe6, 0x95, 0xb0, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02,
+    0x01, 0x05, 0x12, 0x03, 0x4f, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x01, 0x01,
+    0x12, 0x03, 0x4f, 0x08, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x01, 0x03, 0x12, 0x03,
+    0x4f, 0x10, 0x11, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x06, 0x12, 0x04, 0x52, 0x00, 0x55, 0x01, 0x0a,
+    0x0a, 0x0a, 0x03, 0x04, 0x06, 0x01, 0x12, 0x03, 0x52, 0x08, 0x1c, 0x0a, 0x0b, 0x0a, 0x04, 0x04,
+    0x06, 0x02, 0x00, 0x12, 0x03, 0x53, 0x02, 0x41, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00,
+    0x05, 0x12, 0x03, 0x53, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x01, 0x12,
+    0x03, 0x53, 0x09, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x03, 0x12, 0x03, 0x53,
+    0x15, 0x16, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x08, 0x12, 0x03, 0x53, 0x17, 0x40,
+    0x0a, 0x10, 0x0a, 0x09, 0x04, 0x06, 0x02, 0x00, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x03, 0x53,
+    0x18, 0x3f, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x06, 0x02, 0x01, 0x12, 0x03, 0x54, 0x02, 0x3c, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x01, 0x05, 0x12, 0x03, 0x54, 0x02, 0x08, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x06, 0x02, 0x01, 0x01, 0x12, 0x03, 0x54, 0x09, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x06, 0x02, 0x01, 0x03, 0x12, 0x03, 0x54, 0x10, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02,
+    0x01, 0x08, 0x12, 0x03, 0x54, 0x12, 0x3b, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x06, 0x02, 0x01, 0x08,
+    0x87, 0x09, 0x0e, 0x02, 0x12, 0x03, 0x54, 0x13, 0x3a, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x07, 0x12,
+    0x04, 0x57, 0x00, 0x58, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x07, 0x01, 0x12, 0x03, 0x57, 0x08,
+    0x1d, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x08, 0x12, 0x04, 0x5a, 0x00, 0x5d, 0x01, 0x0a, 0x0a, 0x0a,
+    0x03, 0x04, 0x08, 0x01, 0x12, 0x03, 0x5a, 0x08, 0x1d, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x08, 0x02,
+    0x00, 0x12, 0x03, 0x5b, 0x02, 0x41, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x05, 0x12,
+    0x03, 0x5b, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x01, 0x12, 0x03, 0x5b,
+    0x09, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x03, 0x12, 0x03, 0x5b, 0x15, 0x16,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x08, 0x12, 0x03, 0x5b, 0x17, 0x40, 0x0a, 0x10,
+    0x0a, 0x09, 0x04, 0x08, 0x02, 0x00, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x03, 0x5b, 0x18, 0x3f,
+    0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x08, 0x02, 0x01, 0x12, 0x03, 0x5c, 0x02, 0x3c, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x08, 0x02, 0x01, 0x05, 0x12, 0x03, 0x5c, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x08, 0x02, 0x01, 0x01, 0x12, 0x03, 0x5c, 0x09, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02,
+    0x01, 0x03, 0x12, 0x03, 0x5c, 0x10, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x01, 0x08,
+    0x12, 0x03, 0x5c, 0x12, 0x3b, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x08, 0x02, 0x01, 0x08, 0x87, 0x09,
+    0x0e, 0x02, 0x12, 0x03, 0x5c, 0x13, 0x3a, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x09, 0x12, 0x04, 0x5f,
+    0x00, 0x60, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x09, 0x01, 0x12, 0x03, 0x5f, 0x08, 0x1e, 0x0a,
+    0x0a, 0x0a, 0x02, 0x04, 0x0a, 0x12, 0x04, 0x62, 0x00, 0x65, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04,
+    0x0a, 0x01, 0x12, 0x03, 0x62, 0x08, 0x1d, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0a, 0x02, 0x00, 0x12,
+    0x03, 0x63, 0x02, 0x41, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x00, 0x05, 0x12, 0x03, 0x63,
+    0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x00, 0x01, 0x12, 0x03, 0x63, 0x09, 0x12,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x00, 0x03, 0x12, 0x03, 0x63, 0x15, 0x16, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x0a, 0x02, 0x00, 0x08, 0x12, 0x03, 0x63, 0x17, 0x40, 0x0a, 0x10, 0x0a, 0x09,
+    0x04, 0x0a, 0x02, 0x00, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x03, 0x63, 0x18, 0x3f, 0x0a, 0x0b,
+    0x0a, 0x04, 0x04, 0x0a, 0x02, 0x01, 0x12, 0x03, 0x64, 0x02, 0x3c, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x0a, 0x02, 0x01, 0x05, 0x12, 0x03, 0x64, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02,
+    0x01, 0x01, 0x12, 0x03, 0x64, 0x09, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x01, 0x03,
+    0x12, 0x03, 0x64, 0x10, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x01, 0x08, 0x12, 0x03,
+    0x64, 0x12, 0x3b, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x0a, 0x02, 0x01, 0x08, 0x87, 0x09, 0x0e, 0x02,
+    0x12, 0x03, 0x64, 0x13, 0x3a, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0b, 0x12, 0x04, 0x67, 0x00, 0x68,
+    0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0b, 0x01, 0x12, 0x03, 0x67, 0x08, 0x1e, 0x62, 0x06, 0x70,
+    0x72, 0x6f, 0x74, 0x6f, 0x33,
+];
+include!("conductor.api.scheduleservice.v1.tonic.rs");
+// @@protoc_insertion_point(module)
\ No newline at end of file
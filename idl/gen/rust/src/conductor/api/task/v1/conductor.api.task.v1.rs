@@ -1,6 +1,6 @@
 // @generated
 // This file is @generated by prost-build.
-#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+#[derive(Clone, PartialEq, ::prost::Message)]
 pub struct Task {
     /// 任务id
     #[prost(string, tag = "1")]
@@ -38,17 +38,146 @@ pub struct Task {
     /// trace id
     #[prost(string, tag = "12")]
     pub trace_id: ::prost::alloc::string::String,
+    /// 任务最早执行时间 unix ms
+    #[prost(int64, tag = "13")]
+    pub not_before: i64,
+    /// 所属工作流id 不属于工作流则为空
+    #[prost(string, tag = "14")]
+    pub workflow_id: ::prost::alloc::string::String,
+    /// 在工作流中的节点名
+    #[prost(string, tag = "15")]
+    pub workflow_node: ::prost::alloc::string::String,
+    /// 上游节点的输出参数 key为上游节点名 仅在分发给worker时返回
+    #[prost(map = "string, bytes", tag = "16")]
+    pub parent_outputs: ::std::collections::HashMap<::prost::alloc::string::String, ::prost::bytes::Bytes>,
+    /// 内部状态 比 state 更细 blocked, inited, pending_retry, dispatched, running, success, failure, aborted, expired
+    #[prost(string, tag = "17")]
+    pub internal_state: ::prost::alloc::string::String,
+    /// 当前已重试次数
+    #[prost(int64, tag = "18")]
+    pub cur_retry_cnt: i64,
+    /// 优先级 \[0, 9\] 越大越优先分发
+    #[prost(int32, tag = "19")]
+    pub priority: i32,
+    /// gRPC 回调目标
+    #[prost(string, tag = "20")]
+    pub callback_grpc_target: ::prost::alloc::string::String,
+    /// worker标签选择器
+    #[prost(map = "string, string", tag = "21")]
+    pub worker_selector: ::std::collections::HashMap<::prost::alloc::string::String, ::prost::alloc::string::String>,
+    /// 最近一次分发到的worker id
+    #[prost(string, tag = "22")]
+    pub worker_id: ::prost::alloc::string::String,
+}
+/// 任务状态变更记录
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct TaskHistory {
+    /// 变更后的内部状态
+    #[prost(string, tag = "1")]
+    pub state: ::prost::alloc::string::String,
+    /// 变更时间 unix ms
+    #[prost(int64, tag = "2")]
+    pub ctime: i64,
+}
+/// 任务回调投递记录
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct TaskCallback {
+    /// 回调id
+    #[prost(string, tag = "1")]
+    pub id: ::prost::alloc::string::String,
+    /// 回调目标 http url 或 gRPC 目标地址
+    #[prost(string, tag = "2")]
+    pub target: ::prost::alloc::string::String,
+    /// 回调目标类型 http, grpc
+    #[prost(string, tag = "3")]
+    pub target_type: ::prost::alloc::string::String,
+    /// 投递状态 pending, success, failure
+    #[prost(string, tag = "4")]
+    pub state: ::prost::alloc::string::String,
+    /// 已投递次数
+    #[prost(int32, tag = "5")]
+    pub attempts: i32,
+    /// 最大投递次数
+    #[prost(int32, tag = "6")]
+    pub max_attempts: i32,
+    /// 下次投递时间 unix ms
+    #[prost(int64, tag = "7")]
+    pub next_retry_time: i64,
+    /// 最近一次投递失败的原因
+    #[prost(string, tag = "8")]
+    pub last_error: ::prost::alloc::string::String,
+    /// 创建时间 unix ms
+    #[prost(int64, tag = "9")]
+    pub ctime: i64,
+    /// 更新时间 unix ms
+    #[prost(int64, tag = "10")]
+    pub utime: i64,
+}
+/// 任务事件
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct TaskEvent {
+    #[prost(enumeration = "TaskEventType", tag = "1")]
+    pub r#type: i32,
+    #[prost(string, tag = "2")]
+    pub task_id: ::prost::alloc::string::String,
+    #[prost(string, tag = "3")]
+    pub namespace: ::prost::alloc::string::String,
+    #[prost(string, tag = "4")]
+    pub task_type: ::prost::alloc::string::String,
+    /// 事件发生时的状态 与 Task.state 含义相同
+    #[prost(string, tag = "5")]
+    pub state: ::prost::alloc::string::String,
+    /// 事件发生时的内部状态
+    #[prost(string, tag = "6")]
+    pub internal_state: ::prost::alloc::string::String,
+    /// worker上报的进度 仅 TASK_EVENT_TYPE_PROGRESS 有效
+    #[prost(int64, tag = "7")]
+    pub progress: i64,
+    /// 事件时间 unix ms
+    #[prost(int64, tag = "8")]
+    pub time: i64,
+}
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum TaskEventType {
+    Unspecified = 0,
+    /// 状态变更
+    State = 1,
+    /// worker上报进度
+    Progress = 2,
+}
+impl TaskEventType {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            Self::Unspecified => "TASK_EVENT_TYPE_UNSPECIFIED",
+            Self::State => "TASK_EVENT_TYPE_STATE",
+            Self::Progress => "TASK_EVENT_TYPE_PROGRESS",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "TASK_EVENT_TYPE_UNSPECIFIED" => Some(Self::Unspecified),
+            "TASK_EVENT_TYPE_STATE" => Some(Self::State),
+            "TASK_EVENT_TYPE_PROGRESS" => Some(Self::Progress),
+            _ => None,
+        }
+    }
 }
 /// Encoded file descriptor set for the `conductor.api.task.v1` package
 pub const FILE_DESCRIPTOR_SET: &[u8] = &[
-    0x0a, 0x8c, 0x0e, 0x0a, 0x20, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x61,
+    0x0a, 0xea, 0x2f, 0x0a, 0x20, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x61,
     0x70, 0x69, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2e,
     0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72,
     0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x62, 0x75,
     0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64,
     0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x65, 0x78, 0x74, 0x2f, 0x6f,
     0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
-    0x6f, 0x74, 0x6f, 0x22, 0xf4, 0x02, 0x0a, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x0e, 0x0a, 0x02,
+    0x6f, 0x74, 0x6f, 0x22, 0xc5, 0x07, 0x0a, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x0e, 0x0a, 0x02,
     0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09,
     0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
     0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x09, 0x74, 0x61,
@@ -70,89 +199,359 @@ pub const FILE_DESCRIPTOR_SET: &[u8] = &[
     0x28, 0x03, 0x52, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x74, 0x69,
     0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x75, 0x74, 0x69, 0x6d, 0x65, 0x12,
     0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28,
-    0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x65, 0x49, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6f,
-    0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x42, 0xe4, 0x01, 0x0a, 0x19, 0x63,
-    0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69,
-    0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x42, 0x09, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x72,
-    0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-    0x6d, 0x2f, 0x72, 0x79, 0x61, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f,
-    0x77, 0x68, 0x69, 0x6d, 0x65, 0x72, 0x2f, 0x69, 0x64, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67,
-    0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-    0x74, 0x61, 0x73, 0x6b, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x61, 0x73, 0x6b, 0xa2, 0x02, 0x03, 0x43,
-    0x41, 0x54, 0xaa, 0x02, 0x15, 0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x41,
-    0x70, 0x69, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x15, 0x43, 0x6f, 0x6e,
-    0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x54, 0x61, 0x73, 0x6b, 0x5c,
-    0x56, 0x31, 0xe2, 0x02, 0x21, 0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x5c, 0x41,
-    0x70, 0x69, 0x5c, 0x54, 0x61, 0x73, 0x6b, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-    0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74,
-    0x6f, 0x72, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a, 0x3a, 0x54, 0x61, 0x73, 0x6b, 0x3a, 0x3a, 0x56,
-    0x31, 0x4a, 0xb3, 0x08, 0x0a, 0x06, 0x12, 0x04, 0x00, 0x00, 0x2d, 0x01, 0x0a, 0x08, 0x0a, 0x01,
-    0x0c, 0x12, 0x03, 0x00, 0x00, 0x12, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x00, 0x12, 0x03, 0x02, 0x00,
-    0x25, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x01, 0x12, 0x03, 0x03, 0x00, 0x22, 0x0a, 0x08, 0x0a, 0x01,
-    0x02, 0x12, 0x03, 0x05, 0x00, 0x1e, 0x0a, 0x08, 0x0a, 0x01, 0x08, 0x12, 0x03, 0x07, 0x00, 0x5c,
-    0x0a, 0x09, 0x0a, 0x02, 0x08, 0x0b, 0x12, 0x03, 0x07, 0x00, 0x5c, 0x0a, 0x0a, 0x0a, 0x02, 0x04,
-    0x00, 0x12, 0x04, 0x09, 0x00, 0x2d, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x00, 0x01, 0x12, 0x03,
-    0x09, 0x08, 0x0c, 0x0a, 0x17, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x00, 0x12, 0x03, 0x0b, 0x02, 0x10,
-    0x1a, 0x0a, 0x20, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x00, 0x02, 0x00, 0x05, 0x12, 0x03, 0x0b, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00,
-    0x02, 0x00, 0x01, 0x12, 0x03, 0x0b, 0x09, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00,
-    0x03, 0x12, 0x03, 0x0b, 0x0e, 0x0f, 0x0a, 0x21, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x01, 0x12, 0x03,
-    0x0e, 0x02, 0x17, 0x1a, 0x14, 0x20, 0xe6, 0x89, 0x80, 0xe5, 0xb1, 0x9e, 0xe5, 0x91, 0xbd, 0xe5,
-    0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02,
-    0x01, 0x05, 0x12, 0x03, 0x0e, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x01,
-    0x12, 0x03, 0x0e, 0x09, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x03, 0x12, 0x03,
-    0x0e, 0x15, 0x16, 0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x02, 0x12, 0x03, 0x11, 0x02, 0x41,
-    0x1a, 0x0e, 0x20, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x0a,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x05, 0x12, 0x03, 0x11, 0x02, 0x08, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x01, 0x12, 0x03, 0x11, 0x09, 0x12, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x00, 0x02, 0x02, 0x03, 0x12, 0x03, 0x11, 0x15, 0x16, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00,
-    0x02, 0x02, 0x08, 0x12, 0x03, 0x11, 0x17, 0x40, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x00, 0x02, 0x02,
-    0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x03, 0x11, 0x18, 0x3f, 0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x00,
-    0x02, 0x03, 0x12, 0x03, 0x14, 0x02, 0x17, 0x1a, 0x0e, 0x20, 0xe8, 0xbe, 0x93, 0xe5, 0x85, 0xa5,
-    0xe5, 0x8f, 0x82, 0xe6, 0x95, 0xb0, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x03, 0x05,
-    0x12, 0x03, 0x14, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x03, 0x01, 0x12, 0x03,
-    0x14, 0x08, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x03, 0x03, 0x12, 0x03, 0x14, 0x15,
-    0x16, 0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x04, 0x12, 0x03, 0x17, 0x02, 0x21, 0x1a, 0x0e,
-    0x20, 0xe8, 0xbe, 0x93, 0xe5, 0x87, 0xba, 0xe5, 0x8f, 0x82, 0xe6, 0x95, 0xb0, 0x0a, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x00, 0x02, 0x04, 0x04, 0x12, 0x03, 0x17, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x00, 0x02, 0x04, 0x05, 0x12, 0x03, 0x17, 0x0b, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00,
-    0x02, 0x04, 0x01, 0x12, 0x03, 0x17, 0x11, 0x1c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x04,
-    0x03, 0x12, 0x03, 0x17, 0x1f, 0x20, 0x0a, 0x18, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x05, 0x12, 0x03,
-    0x1a, 0x02, 0x1a, 0x1a, 0x0b, 0x20, 0xe5, 0x9b, 0x9e, 0xe8, 0xb0, 0x83, 0x75, 0x72, 0x6c, 0x0a,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x05, 0x05, 0x12, 0x03, 0x1a, 0x02, 0x08, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x00, 0x02, 0x05, 0x01, 0x12, 0x03, 0x1a, 0x09, 0x15, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x00, 0x02, 0x05, 0x03, 0x12, 0x03, 0x1a, 0x18, 0x19, 0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x00,
-    0x02, 0x06, 0x12, 0x03, 0x1d, 0x02, 0x13, 0x1a, 0x0e, 0x20, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1,
-    0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x06, 0x05,
-    0x12, 0x03, 0x1d, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x06, 0x01, 0x12, 0x03,
-    0x1d, 0x09, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x06, 0x03, 0x12, 0x03, 0x1d, 0x11,
-    0x12, 0x0a, 0x54, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x07, 0x12, 0x03, 0x20, 0x02, 0x1a, 0x1a, 0x47,
-    0x20, 0xe6, 0x9c, 0x80, 0xe5, 0xa4, 0xa7, 0xe9, 0x87, 0x8d, 0xe8, 0xaf, 0x95, 0xe6, 0xac, 0xa1,
-    0xe6, 0x95, 0xb0, 0x20, 0x2d, 0x31, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe6, 0x97, 0xa0, 0xe9,
-    0x99, 0x90, 0xe9, 0x87, 0x8d, 0xe8, 0xaf, 0x95, 0xe7, 0x9b, 0xb4, 0xe5, 0x88, 0xb0, 0xe8, 0xb6,
-    0x85, 0xe6, 0x97, 0xb6, 0x2c, 0x20, 0x30, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe4, 0xb8, 0x8d,
-    0xe9, 0x87, 0x8d, 0xe8, 0xaf, 0x95, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x07, 0x05,
-    0x12, 0x03, 0x20, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x07, 0x01, 0x12, 0x03,
-    0x20, 0x08, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x07, 0x03, 0x12, 0x03, 0x20, 0x18,
-    0x19, 0x0a, 0x29, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x08, 0x12, 0x03, 0x23, 0x02, 0x18, 0x1a, 0x1c,
-    0x20, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0x9f, 0xe6, 0x97, 0xb6,
-    0xe9, 0x97, 0xb4, 0x20, 0x75, 0x6e, 0x69, 0x78, 0x20, 0x6d, 0x73, 0x0a, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x00, 0x02, 0x08, 0x05, 0x12, 0x03, 0x23, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00,
-    0x02, 0x08, 0x01, 0x12, 0x03, 0x23, 0x08, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x08,
-    0x03, 0x12, 0x03, 0x23, 0x16, 0x17, 0x0a, 0x23, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x09, 0x12, 0x03,
-    0x26, 0x02, 0x13, 0x1a, 0x16, 0x20, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9,
+    0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x6f,
+    0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+    0x6e, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x6f, 0x72,
+    0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+    0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x6f,
+    0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28,
+    0x09, 0x52, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x4e, 0x6f, 0x64, 0x65, 0x12,
+    0x55, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+    0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63,
+    0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e,
+    0x54, 0x61, 0x73, 0x6b, 0x2e, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75,
+    0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x4f,
+    0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+    0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+    0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a,
+    0x0d, 0x63, 0x75, 0x72, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x12,
+    0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x52, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6e,
+    0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x13, 0x20,
+    0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x30, 0x0a,
+    0x14, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x74,
+    0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x61, 0x6c,
+    0x6c, 0x62, 0x61, 0x63, 0x6b, 0x47, 0x72, 0x70, 0x63, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12,
+    0x58, 0x0a, 0x0f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+    0x6f, 0x72, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75,
+    0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31,
+    0x2e, 0x54, 0x61, 0x73, 0x6b, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65,
+    0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x77, 0x6f, 0x72, 0x6b, 0x65,
+    0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x6f, 0x72,
+    0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x6f,
+    0x72, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x1a, 0x40, 0x0a, 0x12, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74,
+    0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+    0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+    0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76,
+    0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x57, 0x6f, 0x72, 0x6b,
+    0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+    0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+    0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+    0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+    0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x22, 0x39, 0x0a, 0x0b, 0x54,
+    0x61, 0x73, 0x6b, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+    0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+    0x12, 0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+    0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x9f, 0x02, 0x0a, 0x0c, 0x54, 0x61, 0x73, 0x6b, 0x43,
+    0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+    0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
+    0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12,
+    0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
+    0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65,
+    0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+    0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+    0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+    0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+    0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74,
+    0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x65,
+    0x74, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+    0x6e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a,
+    0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28,
+    0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
+    0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x74, 0x69,
+    0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+    0x03, 0x52, 0x05, 0x75, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x86, 0x02, 0x0a, 0x09, 0x54, 0x61, 0x73,
+    0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+    0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73,
+    0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+    0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+    0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d,
+    0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61,
+    0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x5f,
+    0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x73, 0x6b,
+    0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
+    0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e,
+    0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01,
+    0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74,
+    0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20,
+    0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a,
+    0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d,
+    0x65, 0x2a, 0x69, 0x0a, 0x0d, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
+    0x70, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+    0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+    0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+    0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x1c,
+    0x0a, 0x18, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50,
+    0x45, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x02, 0x42, 0xe4, 0x01, 0x0a,
+    0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x42, 0x09, 0x54, 0x61, 0x73, 0x6b,
+    0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+    0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x79, 0x61, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x62, 0x6f, 0x6f, 0x6b,
+    0x73, 0x2f, 0x77, 0x68, 0x69, 0x6d, 0x65, 0x72, 0x2f, 0x69, 0x64, 0x6c, 0x2f, 0x67, 0x65, 0x6e,
+    0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x61, 0x70,
+    0x69, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x61, 0x73, 0x6b, 0xa2, 0x02,
+    0x03, 0x43, 0x41, 0x54, 0xaa, 0x02, 0x15, 0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72,
+    0x2e, 0x41, 0x70, 0x69, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x15, 0x43,
+    0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x54, 0x61, 0x73,
+    0x6b, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x21, 0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72,
+    0x5c, 0x41, 0x70, 0x69, 0x5c, 0x54, 0x61, 0x73, 0x6b, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
+    0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x43, 0x6f, 0x6e, 0x64, 0x75,
+    0x63, 0x74, 0x6f, 0x72, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a, 0x3a, 0x54, 0x61, 0x73, 0x6b, 0x3a,
+    0x3a, 0x56, 0x31, 0x4a, 0xef, 0x1f, 0x0a, 0x07, 0x12, 0x05, 0x00, 0x00, 0x94, 0x01, 0x01, 0x0a,
+    0x08, 0x0a, 0x01, 0x0c, 0x12, 0x03, 0x00, 0x00, 0x12, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x00, 0x12,
+    0x03, 0x02, 0x00, 0x25, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x01, 0x12, 0x03, 0x03, 0x00, 0x22, 0x0a,
+    0x08, 0x0a, 0x01, 0x02, 0x12, 0x03, 0x05, 0x00, 0x1e, 0x0a, 0x08, 0x0a, 0x01, 0x08, 0x12, 0x03,
+    0x07, 0x00, 0x5c, 0x0a, 0x09, 0x0a, 0x02, 0x08, 0x0b, 0x12, 0x03, 0x07, 0x00, 0x5c, 0x0a, 0x0a,
+    0x0a, 0x02, 0x04, 0x00, 0x12, 0x04, 0x09, 0x00, 0x4b, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x00,
+    0x01, 0x12, 0x03, 0x09, 0x08, 0x0c, 0x0a, 0x17, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x00, 0x12, 0x03,
+    0x0b, 0x02, 0x10, 0x1a, 0x0a, 0x20, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0x69, 0x64, 0x0a, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x05, 0x12, 0x03, 0x0b, 0x02, 0x08, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x0b, 0x09, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x0b, 0x0e, 0x0f, 0x0a, 0x21, 0x0a, 0x04, 0x04, 0x00, 0x02,
+    0x01, 0x12, 0x03, 0x0e, 0x02, 0x17, 0x1a, 0x14, 0x20, 0xe6, 0x89, 0x80, 0xe5, 0xb1, 0x9e, 0xe5,
+    0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0x0a, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x00, 0x02, 0x01, 0x05, 0x12, 0x03, 0x0e, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00,
+    0x02, 0x01, 0x01, 0x12, 0x03, 0x0e, 0x09, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01,
+    0x03, 0x12, 0x03, 0x0e, 0x15, 0x16, 0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x02, 0x12, 0x03,
+    0x11, 0x02, 0x41, 0x1a, 0x0e, 0x20, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe7, 0xb1, 0xbb, 0xe5,
+    0x9e, 0x8b, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x05, 0x12, 0x03, 0x11, 0x02,
+    0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x01, 0x12, 0x03, 0x11, 0x09, 0x12, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x03, 0x12, 0x03, 0x11, 0x15, 0x16, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x00, 0x02, 0x02, 0x08, 0x12, 0x03, 0x11, 0x17, 0x40, 0x0a, 0x10, 0x0a, 0x09, 0x04,
+    0x00, 0x02, 0x02, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x03, 0x11, 0x18, 0x3f, 0x0a, 0x1b, 0x0a,
+    0x04, 0x04, 0x00, 0x02, 0x03, 0x12, 0x03, 0x14, 0x02, 0x17, 0x1a, 0x0e, 0x20, 0xe8, 0xbe, 0x93,
+    0xe5, 0x85, 0xa5, 0xe5, 0x8f, 0x82, 0xe6, 0x95, 0xb0, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00,
+    0x02, 0x03, 0x05, 0x12, 0x03, 0x14, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x03,
+    0x01, 0x12, 0x03, 0x14, 0x08, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x03, 0x03, 0x12,
+    0x03, 0x14, 0x15, 0x16, 0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x04, 0x12, 0x03, 0x17, 0x02,
+    0x21, 0x1a, 0x0e, 0x20, 0xe8, 0xbe, 0x93, 0xe5, 0x87, 0xba, 0xe5, 0x8f, 0x82, 0xe6, 0x95, 0xb0,
+    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x04, 0x04, 0x12, 0x03, 0x17, 0x02, 0x0a, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x04, 0x05, 0x12, 0x03, 0x17, 0x0b, 0x10, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x00, 0x02, 0x04, 0x01, 0x12, 0x03, 0x17, 0x11, 0x1c, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x00, 0x02, 0x04, 0x03, 0x12, 0x03, 0x17, 0x1f, 0x20, 0x0a, 0x18, 0x0a, 0x04, 0x04, 0x00, 0x02,
+    0x05, 0x12, 0x03, 0x1a, 0x02, 0x1a, 0x1a, 0x0b, 0x20, 0xe5, 0x9b, 0x9e, 0xe8, 0xb0, 0x83, 0x75,
+    0x72, 0x6c, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x05, 0x05, 0x12, 0x03, 0x1a, 0x02,
+    0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x05, 0x01, 0x12, 0x03, 0x1a, 0x09, 0x15, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x05, 0x03, 0x12, 0x03, 0x1a, 0x18, 0x19, 0x0a, 0x1b, 0x0a,
+    0x04, 0x04, 0x00, 0x02, 0x06, 0x12, 0x03, 0x1d, 0x02, 0x13, 0x1a, 0x0e, 0x20, 0xe4, 0xbb, 0xbb,
+    0xe5, 0x8a, 0xa1, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00,
+    0x02, 0x06, 0x05, 0x12, 0x03, 0x1d, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x06,
+    0x01, 0x12, 0x03, 0x1d, 0x09, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x06, 0x03, 0x12,
+    0x03, 0x1d, 0x11, 0x12, 0x0a, 0x54, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x07, 0x12, 0x03, 0x20, 0x02,
+    0x1a, 0x1a, 0x47, 0x20, 0xe6, 0x9c, 0x80, 0xe5, 0xa4, 0xa7, 0xe9, 0x87, 0x8d, 0xe8, 0xaf, 0x95,
+    0xe6, 0xac, 0xa1, 0xe6, 0x95, 0xb0, 0x20, 0x2d, 0x31, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe6,
+    0x97, 0xa0, 0xe9, 0x99, 0x90, 0xe9, 0x87, 0x8d, 0xe8, 0xaf, 0x95, 0xe7, 0x9b, 0xb4, 0xe5, 0x88,
+    0xb0, 0xe8, 0xb6, 0x85, 0xe6, 0x97, 0xb6, 0x2c, 0x20, 0x30, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba,
+    0xe4, 0xb8, 0x8d, 0xe9, 0x87, 0x8d, 0xe8, 0xaf, 0x95, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00,
+    0x02, 0x07, 0x05, 0x12, 0x03, 0x20, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x07,
+    0x01, 0x12, 0x03, 0x20, 0x08, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x07, 0x03, 0x12,
+    0x03, 0x20, 0x18, 0x19, 0x0a, 0x29, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x08, 0x12, 0x03, 0x23, 0x02,
+    0x18, 0x1a, 0x1c, 0x20, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0x9f,
+    0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x20, 0x75, 0x6e, 0x69, 0x78, 0x20, 0x6d, 0x73, 0x0a, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x08, 0x05, 0x12, 0x03, 0x23, 0x02, 0x07, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x00, 0x02, 0x08, 0x01, 0x12, 0x03, 0x23, 0x08, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x00, 0x02, 0x08, 0x03, 0x12, 0x03, 0x23, 0x16, 0x17, 0x0a, 0x23, 0x0a, 0x04, 0x04, 0x00, 0x02,
+    0x09, 0x12, 0x03, 0x26, 0x02, 0x13, 0x1a, 0x16, 0x20, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6,
+    0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x20, 0x75, 0x6e, 0x69, 0x78, 0x20, 0x6d, 0x73, 0x0a, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x00, 0x02, 0x09, 0x05, 0x12, 0x03, 0x26, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x00, 0x02, 0x09, 0x01, 0x12, 0x03, 0x26, 0x08, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00,
+    0x02, 0x09, 0x03, 0x12, 0x03, 0x26, 0x10, 0x12, 0x0a, 0x23, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x0a,
+    0x12, 0x03, 0x29, 0x02, 0x13, 0x1a, 0x16, 0x20, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe6, 0x97,
+    0xb6, 0xe9, 0x97, 0xb4, 0x20, 0x75, 0x6e, 0x69, 0x78, 0x20, 0x6d, 0x73, 0x0a, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x00, 0x02, 0x0a, 0x05, 0x12, 0x03, 0x29, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x00, 0x02, 0x0a, 0x01, 0x12, 0x03, 0x29, 0x08, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02,
+    0x0a, 0x03, 0x12, 0x03, 0x29, 0x10, 0x12, 0x0a, 0x17, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x0b, 0x12,
+    0x03, 0x2c, 0x02, 0x17, 0x1a, 0x0a, 0x20, 0x74, 0x72, 0x61, 0x63, 0x65, 0x20, 0x69, 0x64, 0x0a,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x0b, 0x05, 0x12, 0x03, 0x2c, 0x02, 0x08, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x00, 0x02, 0x0b, 0x01, 0x12, 0x03, 0x2c, 0x09, 0x11, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x00, 0x02, 0x0b, 0x03, 0x12, 0x03, 0x2c, 0x14, 0x16, 0x0a, 0x2f, 0x0a, 0x04, 0x04, 0x00,
+    0x02, 0x0c, 0x12, 0x03, 0x2f, 0x02, 0x18, 0x1a, 0x22, 0x20, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1,
+    0xe6, 0x9c, 0x80, 0xe6, 0x97, 0xa9, 0xe6, 0x89, 0xa7, 0xe8, 0xa1, 0x8c, 0xe6, 0x97, 0xb6, 0xe9,
     0x97, 0xb4, 0x20, 0x75, 0x6e, 0x69, 0x78, 0x20, 0x6d, 0x73, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x00, 0x02, 0x09, 0x05, 0x12, 0x03, 0x26, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02,
-    0x09, 0x01, 0x12, 0x03, 0x26, 0x08, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x09, 0x03,
-    0x12, 0x03, 0x26, 0x10, 0x12, 0x0a, 0x23, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x0a, 0x12, 0x03, 0x29,
-    0x02, 0x13, 0x1a, 0x16, 0x20, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe6, 0x97, 0xb6, 0xe9, 0x97,
-    0xb4, 0x20, 0x75, 0x6e, 0x69, 0x78, 0x20, 0x6d, 0x73, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00,
-    0x02, 0x0a, 0x05, 0x12, 0x03, 0x29, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x0a,
-    0x01, 0x12, 0x03, 0x29, 0x08, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x0a, 0x03, 0x12,
-    0x03, 0x29, 0x10, 0x12, 0x0a, 0x17, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x0b, 0x12, 0x03, 0x2c, 0x02,
-    0x17, 0x1a, 0x0a, 0x20, 0x74, 0x72, 0x61, 0x63, 0x65, 0x20, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x00, 0x02, 0x0b, 0x05, 0x12, 0x03, 0x2c, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x00, 0x02, 0x0b, 0x01, 0x12, 0x03, 0x2c, 0x09, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02,
-    0x0b, 0x03, 0x12, 0x03, 0x2c, 0x14, 0x16, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+    0x00, 0x02, 0x0c, 0x05, 0x12, 0x03, 0x2f, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02,
+    0x0c, 0x01, 0x12, 0x03, 0x2f, 0x08, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x0c, 0x03,
+    0x12, 0x03, 0x2f, 0x15, 0x17, 0x0a, 0x3c, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x0d, 0x12, 0x03, 0x32,
+    0x02, 0x1a, 0x1a, 0x2f, 0x20, 0xe6, 0x89, 0x80, 0xe5, 0xb1, 0x9e, 0xe5, 0xb7, 0xa5, 0xe4, 0xbd,
+    0x9c, 0xe6, 0xb5, 0x81, 0x69, 0x64, 0x20, 0xe4, 0xb8, 0x8d, 0xe5, 0xb1, 0x9e, 0xe4, 0xba, 0x8e,
+    0xe5, 0xb7, 0xa5, 0xe4, 0xbd, 0x9c, 0xe6, 0xb5, 0x81, 0xe5, 0x88, 0x99, 0xe4, 0xb8, 0xba, 0xe7,
+    0xa9, 0xba, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x0d, 0x05, 0x12, 0x03, 0x32, 0x02,
+    0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x0d, 0x01, 0x12, 0x03, 0x32, 0x09, 0x14, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x0d, 0x03, 0x12, 0x03, 0x32, 0x17, 0x19, 0x0a, 0x2a, 0x0a,
+    0x04, 0x04, 0x00, 0x02, 0x0e, 0x12, 0x03, 0x35, 0x02, 0x1c, 0x1a, 0x1d, 0x20, 0xe5, 0x9c, 0xa8,
+    0xe5, 0xb7, 0xa5, 0xe4, 0xbd, 0x9c, 0xe6, 0xb5, 0x81, 0xe4, 0xb8, 0xad, 0xe7, 0x9a, 0x84, 0xe8,
+    0x8a, 0x82, 0xe7, 0x82, 0xb9, 0xe5, 0x90, 0x8d, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02,
+    0x0e, 0x05, 0x12, 0x03, 0x35, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x0e, 0x01,
+    0x12, 0x03, 0x35, 0x09, 0x16, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x0e, 0x03, 0x12, 0x03,
+    0x35, 0x19, 0x1b, 0x0a, 0x5f, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x0f, 0x12, 0x03, 0x38, 0x02, 0x29,
+    0x1a, 0x52, 0x20, 0xe4, 0xb8, 0x8a, 0xe6, 0xb8, 0xb8, 0xe8, 0x8a, 0x82, 0xe7, 0x82, 0xb9, 0xe7,
+    0x9a, 0x84, 0xe8, 0xbe, 0x93, 0xe5, 0x87, 0xba, 0xe5, 0x8f, 0x82, 0xe6, 0x95, 0xb0, 0x20, 0x6b,
+    0x65, 0x79, 0xe4, 0xb8, 0xba, 0xe4, 0xb8, 0x8a, 0xe6, 0xb8, 0xb8, 0xe8, 0x8a, 0x82, 0xe7, 0x82,
+    0xb9, 0xe5, 0x90, 0x8d, 0x20, 0xe4, 0xbb, 0x85, 0xe5, 0x9c, 0xa8, 0xe5, 0x88, 0x86, 0xe5, 0x8f,
+    0x91, 0xe7, 0xbb, 0x99, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0xe6, 0x97, 0xb6, 0xe8, 0xbf, 0x94,
+    0xe5, 0x9b, 0x9e, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x0f, 0x06, 0x12, 0x03, 0x38,
+    0x02, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x0f, 0x01, 0x12, 0x03, 0x38, 0x15, 0x23,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x0f, 0x03, 0x12, 0x03, 0x38, 0x26, 0x28, 0x0a, 0x84,
+    0x01, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x10, 0x12, 0x03, 0x3b, 0x02, 0x1d, 0x1a, 0x77, 0x20, 0xe5,
+    0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x20, 0xe6, 0xaf, 0x94, 0x20,
+    0x73, 0x74, 0x61, 0x74, 0x65, 0x20, 0xe6, 0x9b, 0xb4, 0xe7, 0xbb, 0x86, 0x20, 0x62, 0x6c, 0x6f,
+    0x63, 0x6b, 0x65, 0x64, 0x2c, 0x20, 0x69, 0x6e, 0x69, 0x74, 0x65, 0x64, 0x2c, 0x20, 0x70, 0x65,
+    0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x2c, 0x20, 0x64, 0x69, 0x73,
+    0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x2c, 0x20, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+    0x2c, 0x20, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2c, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x75,
+    0x72, 0x65, 0x2c, 0x20, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x2c, 0x20, 0x65, 0x78, 0x70,
+    0x69, 0x72, 0x65, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x10, 0x05, 0x12, 0x03,
+    0x3b, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x10, 0x01, 0x12, 0x03, 0x3b, 0x09,
+    0x17, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x10, 0x03, 0x12, 0x03, 0x3b, 0x1a, 0x1c, 0x0a,
+    0x24, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x11, 0x12, 0x03, 0x3e, 0x02, 0x1b, 0x1a, 0x17, 0x20, 0xe5,
+    0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe5, 0xb7, 0xb2, 0xe9, 0x87, 0x8d, 0xe8, 0xaf, 0x95, 0xe6, 0xac,
+    0xa1, 0xe6, 0x95, 0xb0, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x11, 0x05, 0x12, 0x03,
+    0x3e, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x11, 0x01, 0x12, 0x03, 0x3e, 0x08,
+    0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x11, 0x03, 0x12, 0x03, 0x3e, 0x18, 0x1a, 0x0a,
+    0x35, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x12, 0x12, 0x03, 0x41, 0x02, 0x16, 0x1a, 0x28, 0x20, 0xe4,
+    0xbc, 0x98, 0xe5, 0x85, 0x88, 0xe7, 0xba, 0xa7, 0x20, 0x5b, 0x30, 0x2c, 0x20, 0x39, 0x5d, 0x20,
+    0xe8, 0xb6, 0x8a, 0xe5, 0xa4, 0xa7, 0xe8, 0xb6, 0x8a, 0xe4, 0xbc, 0x98, 0xe5, 0x85, 0x88, 0xe5,
+    0x88, 0x86, 0xe5, 0x8f, 0x91, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x12, 0x05, 0x12,
+    0x03, 0x41, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x12, 0x01, 0x12, 0x03, 0x41,
+    0x08, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x12, 0x03, 0x12, 0x03, 0x41, 0x13, 0x15,
+    0x0a, 0x20, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x13, 0x12, 0x03, 0x44, 0x02, 0x23, 0x1a, 0x13, 0x20,
+    0x67, 0x52, 0x50, 0x43, 0x20, 0xe5, 0x9b, 0x9e, 0xe8, 0xb0, 0x83, 0xe7, 0x9b, 0xae, 0xe6, 0xa0,
+    0x87, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x13, 0x05, 0x12, 0x03, 0x44, 0x02, 0x08,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x13, 0x01, 0x12, 0x03, 0x44, 0x09, 0x1d, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x00, 0x02, 0x13, 0x03, 0x12, 0x03, 0x44, 0x20, 0x22, 0x0a, 0x24, 0x0a, 0x04,
+    0x04, 0x00, 0x02, 0x14, 0x12, 0x03, 0x47, 0x02, 0x2b, 0x1a, 0x17, 0x20, 0x77, 0x6f, 0x72, 0x6b,
+    0x65, 0x72, 0xe6, 0xa0, 0x87, 0xe7, 0xad, 0xbe, 0xe9, 0x80, 0x89, 0xe6, 0x8b, 0xa9, 0xe5, 0x99,
+    0xa8, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x14, 0x06, 0x12, 0x03, 0x47, 0x02, 0x15,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x14, 0x01, 0x12, 0x03, 0x47, 0x16, 0x25, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x00, 0x02, 0x14, 0x03, 0x12, 0x03, 0x47, 0x28, 0x2a, 0x0a, 0x30, 0x0a, 0x04,
+    0x04, 0x00, 0x02, 0x15, 0x12, 0x03, 0x4a, 0x02, 0x18, 0x1a, 0x23, 0x20, 0xe6, 0x9c, 0x80, 0xe8,
+    0xbf, 0x91, 0xe4, 0xb8, 0x80, 0xe6, 0xac, 0xa1, 0xe5, 0x88, 0x86, 0xe5, 0x8f, 0x91, 0xe5, 0x88,
+    0xb0, 0xe7, 0x9a, 0x84, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x20, 0x69, 0x64, 0x0a, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x00, 0x02, 0x15, 0x05, 0x12, 0x03, 0x4a, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x00, 0x02, 0x15, 0x01, 0x12, 0x03, 0x4a, 0x09, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00,
+    0x02, 0x15, 0x03, 0x12, 0x03, 0x4a, 0x15, 0x17, 0x0a, 0x26, 0x0a, 0x02, 0x04, 0x01, 0x12, 0x04,
+    0x4e, 0x00, 0x54, 0x01, 0x1a, 0x1a, 0x20, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe7, 0x8a, 0xb6,
+    0xe6, 0x80, 0x81, 0xe5, 0x8f, 0x98, 0xe6, 0x9b, 0xb4, 0xe8, 0xae, 0xb0, 0xe5, 0xbd, 0x95, 0x0a,
+    0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x01, 0x01, 0x12, 0x03, 0x4e, 0x08, 0x13, 0x0a, 0x27, 0x0a, 0x04,
+    0x04, 0x01, 0x02, 0x00, 0x12, 0x03, 0x50, 0x02, 0x13, 0x1a, 0x1a, 0x20, 0xe5, 0x8f, 0x98, 0xe6,
+    0x9b, 0xb4, 0xe5, 0x90, 0x8e, 0xe7, 0x9a, 0x84, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe7, 0x8a,
+    0xb6, 0xe6, 0x80, 0x81, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x05, 0x12, 0x03,
+    0x50, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x01, 0x12, 0x03, 0x50, 0x09,
+    0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x03, 0x12, 0x03, 0x50, 0x11, 0x12, 0x0a,
+    0x23, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x01, 0x12, 0x03, 0x53, 0x02, 0x12, 0x1a, 0x16, 0x20, 0xe5,
+    0x8f, 0x98, 0xe6, 0x9b, 0xb4, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x20, 0x75, 0x6e, 0x69, 0x78,
+    0x20, 0x6d, 0x73, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x05, 0x12, 0x03, 0x53,
+    0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x01, 0x12, 0x03, 0x53, 0x08, 0x0d,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x03, 0x12, 0x03, 0x53, 0x10, 0x11, 0x0a, 0x26,
+    0x0a, 0x02, 0x04, 0x02, 0x12, 0x04, 0x57, 0x00, 0x75, 0x01, 0x1a, 0x1a, 0x20, 0xe4, 0xbb, 0xbb,
+    0xe5, 0x8a, 0xa1, 0xe5, 0x9b, 0x9e, 0xe8, 0xb0, 0x83, 0xe6, 0x8a, 0x95, 0xe9, 0x80, 0x92, 0xe8,
+    0xae, 0xb0, 0xe5, 0xbd, 0x95, 0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x02, 0x01, 0x12, 0x03, 0x57,
+    0x08, 0x14, 0x0a, 0x17, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x00, 0x12, 0x03, 0x59, 0x02, 0x10, 0x1a,
+    0x0a, 0x20, 0xe5, 0x9b, 0x9e, 0xe8, 0xb0, 0x83, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x02, 0x02, 0x00, 0x05, 0x12, 0x03, 0x59, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02,
+    0x00, 0x01, 0x12, 0x03, 0x59, 0x09, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x03,
+    0x12, 0x03, 0x59, 0x0e, 0x0f, 0x0a, 0x3a, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x01, 0x12, 0x03, 0x5c,
+    0x02, 0x14, 0x1a, 0x2d, 0x20, 0xe5, 0x9b, 0x9e, 0xe8, 0xb0, 0x83, 0xe7, 0x9b, 0xae, 0xe6, 0xa0,
+    0x87, 0x20, 0x68, 0x74, 0x74, 0x70, 0x20, 0x75, 0x72, 0x6c, 0x20, 0xe6, 0x88, 0x96, 0x20, 0x67,
+    0x52, 0x50, 0x43, 0x20, 0xe7, 0x9b, 0xae, 0xe6, 0xa0, 0x87, 0xe5, 0x9c, 0xb0, 0xe5, 0x9d, 0x80,
+    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01, 0x05, 0x12, 0x03, 0x5c, 0x02, 0x08, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01, 0x01, 0x12, 0x03, 0x5c, 0x09, 0x0f, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x02, 0x02, 0x01, 0x03, 0x12, 0x03, 0x5c, 0x12, 0x13, 0x0a, 0x2c, 0x0a, 0x04, 0x04,
+    0x02, 0x02, 0x02, 0x12, 0x03, 0x5f, 0x02, 0x19, 0x1a, 0x1f, 0x20, 0xe5, 0x9b, 0x9e, 0xe8, 0xb0,
+    0x83, 0xe7, 0x9b, 0xae, 0xe6, 0xa0, 0x87, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x20, 0x68, 0x74,
+    0x74, 0x70, 0x2c, 0x20, 0x67, 0x72, 0x70, 0x63, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02,
+    0x02, 0x05, 0x12, 0x03, 0x5f, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x02, 0x01,
+    0x12, 0x03, 0x5f, 0x09, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x02, 0x03, 0x12, 0x03,
+    0x5f, 0x17, 0x18, 0x0a, 0x35, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x03, 0x12, 0x03, 0x62, 0x02, 0x13,
+    0x1a, 0x28, 0x20, 0xe6, 0x8a, 0x95, 0xe9, 0x80, 0x92, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x20,
+    0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+    0x2c, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02,
+    0x02, 0x03, 0x05, 0x12, 0x03, 0x62, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x03,
+    0x01, 0x12, 0x03, 0x62, 0x09, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x03, 0x03, 0x12,
+    0x03, 0x62, 0x11, 0x12, 0x0a, 0x1e, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x04, 0x12, 0x03, 0x65, 0x02,
+    0x15, 0x1a, 0x11, 0x20, 0xe5, 0xb7, 0xb2, 0xe6, 0x8a, 0x95, 0xe9, 0x80, 0x92, 0xe6, 0xac, 0xa1,
+    0xe6, 0x95, 0xb0, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x04, 0x05, 0x12, 0x03, 0x65,
+    0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x04, 0x01, 0x12, 0x03, 0x65, 0x08, 0x10,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x04, 0x03, 0x12, 0x03, 0x65, 0x13, 0x14, 0x0a, 0x21,
+    0x0a, 0x04, 0x04, 0x02, 0x02, 0x05, 0x12, 0x03, 0x68, 0x02, 0x19, 0x1a, 0x14, 0x20, 0xe6, 0x9c,
+    0x80, 0xe5, 0xa4, 0xa7, 0xe6, 0x8a, 0x95, 0xe9, 0x80, 0x92, 0xe6, 0xac, 0xa1, 0xe6, 0x95, 0xb0,
+    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x05, 0x05, 0x12, 0x03, 0x68, 0x02, 0x07, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x05, 0x01, 0x12, 0x03, 0x68, 0x08, 0x14, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x02, 0x02, 0x05, 0x03, 0x12, 0x03, 0x68, 0x17, 0x18, 0x0a, 0x29, 0x0a, 0x04, 0x04,
+    0x02, 0x02, 0x06, 0x12, 0x03, 0x6b, 0x02, 0x1c, 0x1a, 0x1c, 0x20, 0xe4, 0xb8, 0x8b, 0xe6, 0xac,
+    0xa1, 0xe6, 0x8a, 0x95, 0xe9, 0x80, 0x92, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x20, 0x75, 0x6e,
+    0x69, 0x78, 0x20, 0x6d, 0x73, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x06, 0x05, 0x12,
+    0x03, 0x6b, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x06, 0x01, 0x12, 0x03, 0x6b,
+    0x08, 0x17, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x06, 0x03, 0x12, 0x03, 0x6b, 0x1a, 0x1b,
+    0x0a, 0x30, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x07, 0x12, 0x03, 0x6e, 0x02, 0x18, 0x1a, 0x23, 0x20,
+    0xe6, 0x9c, 0x80, 0xe8, 0xbf, 0x91, 0xe4, 0xb8, 0x80, 0xe6, 0xac, 0xa1, 0xe6, 0x8a, 0x95, 0xe9,
+    0x80, 0x92, 0xe5, 0xa4, 0xb1, 0xe8, 0xb4, 0xa5, 0xe7, 0x9a, 0x84, 0xe5, 0x8e, 0x9f, 0xe5, 0x9b,
+    0xa0, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x07, 0x05, 0x12, 0x03, 0x6e, 0x02, 0x08,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x07, 0x01, 0x12, 0x03, 0x6e, 0x09, 0x13, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x02, 0x02, 0x07, 0x03, 0x12, 0x03, 0x6e, 0x16, 0x17, 0x0a, 0x23, 0x0a, 0x04,
+    0x04, 0x02, 0x02, 0x08, 0x12, 0x03, 0x71, 0x02, 0x12, 0x1a, 0x16, 0x20, 0xe5, 0x88, 0x9b, 0xe5,
+    0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x20, 0x75, 0x6e, 0x69, 0x78, 0x20, 0x6d, 0x73,
+    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x08, 0x05, 0x12, 0x03, 0x71, 0x02, 0x07, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x08, 0x01, 0x12, 0x03, 0x71, 0x08, 0x0d, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x02, 0x02, 0x08, 0x03, 0x12, 0x03, 0x71, 0x10, 0x11, 0x0a, 0x23, 0x0a, 0x04, 0x04,
+    0x02, 0x02, 0x09, 0x12, 0x03, 0x74, 0x02, 0x13, 0x1a, 0x16, 0x20, 0xe6, 0x9b, 0xb4, 0xe6, 0x96,
+    0xb0, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x20, 0x75, 0x6e, 0x69, 0x78, 0x20, 0x6d, 0x73, 0x0a,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x09, 0x05, 0x12, 0x03, 0x74, 0x02, 0x07, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x02, 0x02, 0x09, 0x01, 0x12, 0x03, 0x74, 0x08, 0x0d, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x02, 0x02, 0x09, 0x03, 0x12, 0x03, 0x74, 0x10, 0x12, 0x0a, 0x0a, 0x0a, 0x02, 0x05, 0x00,
+    0x12, 0x04, 0x77, 0x00, 0x7d, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x05, 0x00, 0x01, 0x12, 0x03, 0x77,
+    0x05, 0x12, 0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x00, 0x02, 0x00, 0x12, 0x03, 0x78, 0x02, 0x22, 0x0a,
+    0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x78, 0x02, 0x1d, 0x0a, 0x0c, 0x0a,
+    0x05, 0x05, 0x00, 0x02, 0x00, 0x02, 0x12, 0x03, 0x78, 0x20, 0x21, 0x0a, 0x1b, 0x0a, 0x04, 0x05,
+    0x00, 0x02, 0x01, 0x12, 0x03, 0x7a, 0x02, 0x1c, 0x1a, 0x0e, 0x20, 0xe7, 0x8a, 0xb6, 0xe6, 0x80,
+    0x81, 0xe5, 0x8f, 0x98, 0xe6, 0x9b, 0xb4, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x01,
+    0x01, 0x12, 0x03, 0x7a, 0x02, 0x17, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x01, 0x02, 0x12,
+    0x03, 0x7a, 0x1a, 0x1b, 0x0a, 0x21, 0x0a, 0x04, 0x05, 0x00, 0x02, 0x02, 0x12, 0x03, 0x7c, 0x02,
+    0x1f, 0x1a, 0x14, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0xe4, 0xb8, 0x8a, 0xe6, 0x8a, 0xa5,
+    0xe8, 0xbf, 0x9b, 0xe5, 0xba, 0xa6, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x02, 0x01,
+    0x12, 0x03, 0x7c, 0x02, 0x1a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x02, 0x02, 0x12, 0x03,
+    0x7c, 0x1d, 0x1e, 0x0a, 0x1c, 0x0a, 0x02, 0x04, 0x03, 0x12, 0x06, 0x80, 0x01, 0x00, 0x94, 0x01,
+    0x01, 0x1a, 0x0e, 0x20, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6,
+    0x0a, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x03, 0x01, 0x12, 0x04, 0x80, 0x01, 0x08, 0x11, 0x0a, 0x0c,
+    0x0a, 0x04, 0x04, 0x03, 0x02, 0x00, 0x12, 0x04, 0x81, 0x01, 0x02, 0x19, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x03, 0x02, 0x00, 0x06, 0x12, 0x04, 0x81, 0x01, 0x02, 0x0f, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x03, 0x02, 0x00, 0x01, 0x12, 0x04, 0x81, 0x01, 0x10, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x03,
+    0x02, 0x00, 0x03, 0x12, 0x04, 0x81, 0x01, 0x17, 0x18, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x03, 0x02,
+    0x01, 0x12, 0x04, 0x83, 0x01, 0x02, 0x15, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01, 0x05,
+    0x12, 0x04, 0x83, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01, 0x01, 0x12,
+    0x04, 0x83, 0x01, 0x09, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01, 0x03, 0x12, 0x04,
+    0x83, 0x01, 0x13, 0x14, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x03, 0x02, 0x02, 0x12, 0x04, 0x85, 0x01,
+    0x02, 0x17, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x02, 0x05, 0x12, 0x04, 0x85, 0x01, 0x02,
+    0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x02, 0x01, 0x12, 0x04, 0x85, 0x01, 0x09, 0x12,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x02, 0x03, 0x12, 0x04, 0x85, 0x01, 0x15, 0x16, 0x0a,
+    0x0c, 0x0a, 0x04, 0x04, 0x03, 0x02, 0x03, 0x12, 0x04, 0x87, 0x01, 0x02, 0x17, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x03, 0x02, 0x03, 0x05, 0x12, 0x04, 0x87, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x03, 0x02, 0x03, 0x01, 0x12, 0x04, 0x87, 0x01, 0x09, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x03, 0x02, 0x03, 0x03, 0x12, 0x04, 0x87, 0x01, 0x15, 0x16, 0x0a, 0x44, 0x0a, 0x04, 0x04, 0x03,
+    0x02, 0x04, 0x12, 0x04, 0x8a, 0x01, 0x02, 0x13, 0x1a, 0x36, 0x20, 0xe4, 0xba, 0x8b, 0xe4, 0xbb,
+    0xb6, 0xe5, 0x8f, 0x91, 0xe7, 0x94, 0x9f, 0xe6, 0x97, 0xb6, 0xe7, 0x9a, 0x84, 0xe7, 0x8a, 0xb6,
+    0xe6, 0x80, 0x81, 0x20, 0xe4, 0xb8, 0x8e, 0x20, 0x54, 0x61, 0x73, 0x6b, 0x2e, 0x73, 0x74, 0x61,
+    0x74, 0x65, 0x20, 0xe5, 0x90, 0xab, 0xe4, 0xb9, 0x89, 0xe7, 0x9b, 0xb8, 0xe5, 0x90, 0x8c, 0x0a,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x04, 0x05, 0x12, 0x04, 0x8a, 0x01, 0x02, 0x08, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x04, 0x01, 0x12, 0x04, 0x8a, 0x01, 0x09, 0x0e, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x03, 0x02, 0x04, 0x03, 0x12, 0x04, 0x8a, 0x01, 0x11, 0x12, 0x0a, 0x2e, 0x0a,
+    0x04, 0x04, 0x03, 0x02, 0x05, 0x12, 0x04, 0x8d, 0x01, 0x02, 0x1c, 0x1a, 0x20, 0x20, 0xe4, 0xba,
+    0x8b, 0xe4, 0xbb, 0xb6, 0xe5, 0x8f, 0x91, 0xe7, 0x94, 0x9f, 0xe6, 0x97, 0xb6, 0xe7, 0x9a, 0x84,
+    0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x0a, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x03, 0x02, 0x05, 0x05, 0x12, 0x04, 0x8d, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x03, 0x02, 0x05, 0x01, 0x12, 0x04, 0x8d, 0x01, 0x09, 0x17, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x03, 0x02, 0x05, 0x03, 0x12, 0x04, 0x8d, 0x01, 0x1a, 0x1b, 0x0a, 0x49, 0x0a, 0x04, 0x04, 0x03,
+    0x02, 0x06, 0x12, 0x04, 0x90, 0x01, 0x02, 0x15, 0x1a, 0x3b, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x65,
+    0x72, 0xe4, 0xb8, 0x8a, 0xe6, 0x8a, 0xa5, 0xe7, 0x9a, 0x84, 0xe8, 0xbf, 0x9b, 0xe5, 0xba, 0xa6,
+    0x20, 0xe4, 0xbb, 0x85, 0x20, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+    0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x20, 0xe6, 0x9c,
+    0x89, 0xe6, 0x95, 0x88, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x06, 0x05, 0x12, 0x04,
+    0x90, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x06, 0x01, 0x12, 0x04, 0x90,
+    0x01, 0x08, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x06, 0x03, 0x12, 0x04, 0x90, 0x01,
+    0x13, 0x14, 0x0a, 0x24, 0x0a, 0x04, 0x04, 0x03, 0x02, 0x07, 0x12, 0x04, 0x93, 0x01, 0x02, 0x11,
+    0x1a, 0x16, 0x20, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x20,
+    0x75, 0x6e, 0x69, 0x78, 0x20, 0x6d, 0x73, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x07,
+    0x05, 0x12, 0x04, 0x93, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x07, 0x01,
+    0x12, 0x04, 0x93, 0x01, 0x08, 0x0c, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x07, 0x03, 0x12,
+    0x04, 0x93, 0x01, 0x0f, 0x10, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 ];
 // @@protoc_insertion_point(module)
\ No newline at end of file
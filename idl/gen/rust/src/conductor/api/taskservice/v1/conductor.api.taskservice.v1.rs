@@ -1,6 +1,6 @@
 // @generated
 // This file is @generated by prost-build.
-#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+#[derive(Clone, PartialEq, ::prost::Message)]
 pub struct RegisterTaskRequest {
     /// 任务类型
     #[prost(string, tag = "1")]
@@ -20,18 +20,40 @@ pub struct RegisterTaskRequest {
     /// 任务过期时间
     #[prost(message, optional, tag = "6")]
     pub expire_time: ::core::option::Option<::prost_types::Timestamp>,
+    /// 任务最早执行时间 不设置则立即执行
+    #[prost(message, optional, tag = "7")]
+    pub not_before: ::core::option::Option<::prost_types::Timestamp>,
+    /// 幂等键 可选
+    /// 同一命名空间下相同幂等键在去重窗口内重复注册时 直接返回已有的任务id
+    #[prost(string, tag = "8")]
+    pub idempotency_key: ::prost::alloc::string::String,
+    /// 优先级 \[0, 9\] 越大越优先分发 默认为0
+    #[prost(int32, tag = "9")]
+    pub priority: i32,
+    /// gRPC 回调目标 与 callback_url 二选一
+    /// 支持 grpc 标准 target 格式 如 dns:///host:port 以及 etcd://hosts/key
+    /// 回调方需实现 conductor.api.callback.v1.CallbackService
+    #[prost(string, tag = "10")]
+    pub callback_grpc_target: ::prost::alloc::string::String,
+    /// worker标签选择器 只分发给标签全部匹配的worker 为空则不限制
+    /// 如 {"ffmpeg": "av1"} 只分发给带有 ffmpeg=av1 标签的worker
+    #[prost(map = "string, string", tag = "11")]
+    pub worker_selector: ::std::collections::HashMap<::prost::alloc::string::String, ::prost::alloc::string::String>,
 }
 #[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
 pub struct RegisterTaskResponse {
     #[prost(string, tag = "1")]
     pub task_id: ::prost::alloc::string::String,
+    /// 是否命中幂等键 返回的是已有任务
+    #[prost(bool, tag = "2")]
+    pub duplicated: bool,
 }
 #[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
 pub struct GetTaskRequest {
     #[prost(string, tag = "1")]
     pub task_id: ::prost::alloc::string::String,
 }
-#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+#[derive(Clone, PartialEq, ::prost::Message)]
 pub struct GetTaskResponse {
     #[prost(message, optional, tag = "1")]
     pub task: ::core::option::Option<super::super::task::v1::Task>,
@@ -44,9 +66,167 @@ pub struct AbortTaskRequest {
 #[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
 pub struct AbortTaskResponse {
 }
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct WorkflowNode {
+    /// 节点名 同一工作流内唯一
+    #[prost(string, tag = "1")]
+    pub name: ::prost::alloc::string::String,
+    /// 任务类型
+    #[prost(string, tag = "2")]
+    pub task_type: ::prost::alloc::string::String,
+    /// 输入参数
+    #[prost(bytes = "bytes", tag = "3")]
+    pub input_args: ::prost::bytes::Bytes,
+    /// 回调url
+    #[prost(string, tag = "4")]
+    pub callback_url: ::prost::alloc::string::String,
+    /// 最大重试次数 -1表示无限重试直到超时, 0表示不重试
+    #[prost(int64, tag = "5")]
+    pub max_retry_cnt: i64,
+    /// 任务过期时长 ms 从节点被放行时开始计算 0表示不过期
+    #[prost(int64, tag = "6")]
+    pub expire_after: i64,
+    /// 依赖的上游节点名 所有上游节点成功后才会放行
+    #[prost(string, repeated, tag = "7")]
+    pub depends_on: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    /// gRPC 回调目标 与 callback_url 二选一
+    #[prost(string, tag = "8")]
+    pub callback_grpc_target: ::prost::alloc::string::String,
+    /// worker标签选择器 只分发给标签全部匹配的worker
+    #[prost(map = "string, string", tag = "9")]
+    pub worker_selector: ::std::collections::HashMap<::prost::alloc::string::String, ::prost::alloc::string::String>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct RegisterWorkflowRequest {
+    /// 所属命名空间
+    #[prost(string, tag = "1")]
+    pub namespace: ::prost::alloc::string::String,
+    /// 工作流节点 不能有环
+    #[prost(message, repeated, tag = "2")]
+    pub nodes: ::prost::alloc::vec::Vec<WorkflowNode>,
+    /// 工作流内所有任务的优先级 \[0, 9\]
+    #[prost(int32, tag = "3")]
+    pub priority: i32,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct RegisterWorkflowResponse {
+    #[prost(string, tag = "1")]
+    pub workflow_id: ::prost::alloc::string::String,
+    /// 节点名 -> 任务id
+    #[prost(map = "string, string", tag = "2")]
+    pub task_ids: ::std::collections::HashMap<::prost::alloc::string::String, ::prost::alloc::string::String>,
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct GetWorkflowRequest {
+    #[prost(string, tag = "1")]
+    pub workflow_id: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetWorkflowResponse {
+    #[prost(string, tag = "1")]
+    pub workflow_id: ::prost::alloc::string::String,
+    /// 工作流状态 success, failure, running
+    #[prost(string, tag = "2")]
+    pub state: ::prost::alloc::string::String,
+    /// 工作流中的所有任务
+    #[prost(message, repeated, tag = "3")]
+    pub tasks: ::prost::alloc::vec::Vec<super::super::task::v1::Task>,
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct ListTasksRequest {
+    /// 所属命名空间
+    #[prost(string, tag = "1")]
+    pub namespace: ::prost::alloc::string::String,
+    /// 任务类型 为空则不过滤
+    #[prost(string, tag = "2")]
+    pub task_type: ::prost::alloc::string::String,
+    /// 内部状态 为空则不过滤
+    #[prost(string, tag = "3")]
+    pub state: ::prost::alloc::string::String,
+    /// 创建时间范围 [ctime_start, ctime_end) 不设置则不限制
+    #[prost(message, optional, tag = "4")]
+    pub ctime_start: ::core::option::Option<::prost_types::Timestamp>,
+    #[prost(message, optional, tag = "5")]
+    pub ctime_end: ::core::option::Option<::prost_types::Timestamp>,
+    /// 分页游标 首页为空 后续传入上一页返回的 next_cursor
+    #[prost(string, tag = "6")]
+    pub cursor: ::prost::alloc::string::String,
+    /// 每页数量
+    #[prost(int32, tag = "7")]
+    pub count: i32,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ListTasksResponse {
+    /// 按创建时间倒序
+    #[prost(message, repeated, tag = "1")]
+    pub tasks: ::prost::alloc::vec::Vec<super::super::task::v1::Task>,
+    #[prost(string, tag = "2")]
+    pub next_cursor: ::prost::alloc::string::String,
+    #[prost(bool, tag = "3")]
+    pub has_more: bool,
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct RetryTaskRequest {
+    #[prost(string, tag = "1")]
+    pub task_id: ::prost::alloc::string::String,
+    /// 重试后的过期时间 不设置时如果原过期时间已过则不再过期
+    #[prost(message, optional, tag = "2")]
+    pub expire_time: ::core::option::Option<::prost_types::Timestamp>,
+}
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct RetryTaskResponse {
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct BatchAbortTaskRequest {
+    #[prost(string, repeated, tag = "1")]
+    pub task_ids: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BatchAbortTaskResponse {
+    /// 成功终止的任务id
+    #[prost(string, repeated, tag = "1")]
+    pub aborted_task_ids: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    /// 终止失败的任务 任务id -> 失败原因
+    #[prost(map = "string, string", tag = "2")]
+    pub failed_task_ids: ::std::collections::HashMap<::prost::alloc::string::String, ::prost::alloc::string::String>,
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct GetTaskHistoryRequest {
+    #[prost(string, tag = "1")]
+    pub task_id: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetTaskHistoryResponse {
+    /// 按时间正序
+    #[prost(message, repeated, tag = "1")]
+    pub histories: ::prost::alloc::vec::Vec<super::super::task::v1::TaskHistory>,
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct GetTaskCallbacksRequest {
+    #[prost(string, tag = "1")]
+    pub task_id: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetTaskCallbacksResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub callbacks: ::prost::alloc::vec::Vec<super::super::task::v1::TaskCallback>,
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct WatchTaskRequest {
+    #[prost(string, tag = "1")]
+    pub task_id: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct WatchNamespaceRequest {
+    #[prost(string, tag = "1")]
+    pub namespace: ::prost::alloc::string::String,
+    /// 只订阅指定类型的任务 为空则订阅全部
+    #[prost(string, repeated, tag = "2")]
+    pub task_types: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+}
 /// Encoded file descriptor set for the `conductor.api.taskservice.v1` package
 pub const FILE_DESCRIPTOR_SET: &[u8] = &[
-    0x0a, 0xed, 0x14, 0x0a, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x61,
+    0x0a, 0xb5, 0x67, 0x0a, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x61,
     0x70, 0x69, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76,
     0x31, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72,
     0x6f, 0x74, 0x6f, 0x12, 0x1c, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61,
@@ -58,7 +238,7 @@ pub const FILE_DESCRIPTOR_SET: &[u8] = &[
     0x74, 0x6f, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x76, 0x31, 0x2f,
     0x74, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
     0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
-    0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x85, 0x02, 0x0a, 0x13,
+    0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xff, 0x04, 0x0a, 0x13,
     0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75,
     0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65,
     0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
@@ -75,144 +255,805 @@ pub const FILE_DESCRIPTOR_SET: &[u8] = &[
     0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
     0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
     0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54,
-    0x69, 0x6d, 0x65, 0x22, 0x2f, 0x0a, 0x14, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54,
-    0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74,
-    0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61,
-    0x73, 0x6b, 0x49, 0x64, 0x22, 0x32, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52,
-    0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69,
-    0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01,
-    0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x22, 0x42, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54,
-    0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x74,
-    0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6e, 0x64,
-    0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76,
-    0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x22, 0x34, 0x0a, 0x10,
-    0x41, 0x62, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+    0x69, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72,
+    0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+    0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+    0x61, 0x6d, 0x70, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x31,
+    0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65,
+    0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0x80,
+    0x01, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65,
+    0x79, 0x12, 0x25, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20,
+    0x01, 0x28, 0x05, 0x42, 0x09, 0xba, 0x48, 0x06, 0x1a, 0x04, 0x18, 0x09, 0x28, 0x00, 0x52, 0x08,
+    0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x61, 0x6c, 0x6c,
+    0x62, 0x61, 0x63, 0x6b, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+    0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+    0x47, 0x72, 0x70, 0x63, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x6e, 0x0a, 0x0f, 0x77, 0x6f,
+    0x72, 0x6b, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x0b, 0x20,
+    0x03, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e,
+    0x61, 0x70, 0x69, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+    0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x52,
+    0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x65, 0x6c,
+    0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x77, 0x6f, 0x72, 0x6b,
+    0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x41, 0x0a, 0x13, 0x57, 0x6f,
+    0x72, 0x6b, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72,
+    0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+    0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+    0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4f, 0x0a,
+    0x14, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73,
+    0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64,
+    0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1e,
+    0x0a, 0x0a, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+    0x28, 0x08, 0x52, 0x0a, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0x32,
+    0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
     0x12, 0x20, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
     0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b,
-    0x49, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52,
-    0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xda, 0x02, 0x0a, 0x0b, 0x54, 0x61, 0x73, 0x6b,
-    0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x75, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73,
-    0x74, 0x65, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63,
-    0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76,
-    0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54,
-    0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x63, 0x6f, 0x6e,
+    0x49, 0x64, 0x22, 0x42, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73,
+    0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20,
+    0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e,
+    0x61, 0x70, 0x69, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b,
+    0x52, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x22, 0x34, 0x0a, 0x10, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x54,
+    0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x74, 0x61,
+    0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04,
+    0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x22, 0x13, 0x0a, 0x11,
+    0x41, 0x62, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+    0x65, 0x22, 0xe0, 0x03, 0x0a, 0x0c, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x4e, 0x6f,
+    0x64, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+    0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+    0x24, 0x0a, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+    0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x74, 0x61, 0x73,
+    0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x61,
+    0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x69, 0x6e, 0x70, 0x75, 0x74,
+    0x41, 0x72, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+    0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x6c,
+    0x62, 0x61, 0x63, 0x6b, 0x55, 0x72, 0x6c, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x72,
+    0x65, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+    0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x0c, 0x65,
+    0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
+    0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x69,
+    0x72, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x65, 0x6e,
+    0x64, 0x73, 0x5f, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x70,
+    0x65, 0x6e, 0x64, 0x73, 0x4f, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+    0x63, 0x6b, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x08,
+    0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x47, 0x72,
+    0x70, 0x63, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x67, 0x0a, 0x0f, 0x77, 0x6f, 0x72, 0x6b,
+    0x65, 0x72, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x03, 0x28,
+    0x0b, 0x32, 0x3e, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70,
+    0x69, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31,
+    0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x57, 0x6f,
+    0x72, 0x6b, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72,
+    0x79, 0x52, 0x0e, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+    0x72, 0x1a, 0x41, 0x0a, 0x13, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63,
+    0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+    0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+    0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+    0x3a, 0x02, 0x38, 0x01, 0x22, 0xb3, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+    0x72, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+    0x12, 0x25, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20,
+    0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x6e, 0x61,
+    0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73,
+    0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74,
+    0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x69,
+    0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x4e, 0x6f,
+    0x64, 0x65, 0x42, 0x08, 0xba, 0x48, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x05, 0x6e, 0x6f,
+    0x64, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
+    0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xba, 0x48, 0x06, 0x1a, 0x04, 0x18, 0x09, 0x28, 0x00,
+    0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0xd7, 0x01, 0x0a, 0x18, 0x52,
+    0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52,
+    0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x66,
+    0x6c, 0x6f, 0x77, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x6f,
+    0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x49, 0x64, 0x12, 0x5e, 0x0a, 0x08, 0x74, 0x61, 0x73, 0x6b,
+    0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x63, 0x6f, 0x6e,
     0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73,
     0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-    0x65, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66,
-    0x0a, 0x07, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x2c, 0x2e, 0x63, 0x6f, 0x6e, 0x64,
-    0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x65,
-    0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b,
-    0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63,
-    0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76,
-    0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65,
-    0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x09, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x54,
-    0x61, 0x73, 0x6b, 0x12, 0x2e, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e,
+    0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+    0x73, 0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+    0x07, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x54, 0x61, 0x73, 0x6b,
+    0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+    0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+    0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+    0x3a, 0x02, 0x38, 0x01, 0x22, 0x3e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66,
+    0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0b, 0x77, 0x6f,
+    0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+    0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+    0x6f, 0x77, 0x49, 0x64, 0x22, 0x7f, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66,
+    0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x77,
+    0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+    0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+    0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61,
+    0x74, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+    0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70,
+    0x69, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05,
+    0x74, 0x61, 0x73, 0x6b, 0x73, 0x22, 0x9b, 0x02, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61,
+    0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x09, 0x6e, 0x61,
+    0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba,
+    0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+    0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+    0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14,
+    0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
+    0x74, 0x61, 0x74, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x74,
+    0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+    0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+    0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72,
+    0x74, 0x12, 0x37, 0x0a, 0x09, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x05,
+    0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+    0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+    0x52, 0x08, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75,
+    0x72, 0x73, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73,
+    0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+    0x05, 0x42, 0x09, 0xba, 0x48, 0x06, 0x1a, 0x04, 0x18, 0x64, 0x28, 0x01, 0x52, 0x05, 0x63, 0x6f,
+    0x75, 0x6e, 0x74, 0x22, 0x82, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b,
+    0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x74, 0x61, 0x73,
+    0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75,
+    0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31,
+    0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+    0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+    0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x19, 0x0a,
+    0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+    0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x22, 0x71, 0x0a, 0x10, 0x52, 0x65, 0x74, 0x72,
+    0x79, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07,
+    0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba,
+    0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x3b,
+    0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
+    0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+    0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+    0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x52,
+    0x65, 0x74, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+    0x22, 0x3e, 0x0a, 0x15, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x54, 0x61,
+    0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x08, 0x74, 0x61, 0x73,
+    0x6b, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07,
+    0x92, 0x01, 0x04, 0x08, 0x01, 0x10, 0x64, 0x52, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x73,
+    0x22, 0xf5, 0x01, 0x0a, 0x16, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x54,
+    0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x61,
+    0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x73, 0x18,
+    0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x54, 0x61,
+    0x73, 0x6b, 0x49, 0x64, 0x73, 0x12, 0x6f, 0x0a, 0x0f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f,
+    0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x47,
+    0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74,
+    0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61,
+    0x74, 0x63, 0x68, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70,
+    0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x49,
+    0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x54,
+    0x61, 0x73, 0x6b, 0x49, 0x64, 0x73, 0x1a, 0x40, 0x0a, 0x12, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+    0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+    0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+    0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+    0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x39, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54,
+    0x61, 0x73, 0x6b, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+    0x74, 0x12, 0x20, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+    0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x74, 0x61, 0x73,
+    0x6b, 0x49, 0x64, 0x22, 0x5a, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x69,
+    0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a,
+    0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+    0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x69, 0x73,
+    0x74, 0x6f, 0x72, 0x79, 0x52, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22,
+    0x3b, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+    0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x74, 0x61,
+    0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04,
+    0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x22, 0x5d, 0x0a, 0x18,
+    0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73,
+    0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c,
+    0x62, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f,
+    0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x61, 0x73, 0x6b,
+    0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+    0x52, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x22, 0x34, 0x0a, 0x10, 0x57,
+    0x61, 0x74, 0x63, 0x68, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+    0x20, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+    0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49,
+    0x64, 0x22, 0x5d, 0x0a, 0x15, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+    0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x09, 0x6e, 0x61,
+    0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba,
+    0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+    0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18,
+    0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x73,
+    0x32, 0xf8, 0x0a, 0x0a, 0x0b, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+    0x12, 0x75, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x61, 0x73, 0x6b,
+    0x12, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+    0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75,
+    0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e,
     0x61, 0x70, 0x69, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-    0x76, 0x31, 0x2e, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75,
-    0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e,
+    0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x52,
+    0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x54, 0x61,
+    0x73, 0x6b, 0x12, 0x2c, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76,
+    0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+    0x1a, 0x2d, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+    0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+    0x6c, 0x0a, 0x09, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x2e, 0x2e, 0x63,
+    0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x61, 0x73,
+    0x6b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x62, 0x6f, 0x72,
+    0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x63,
+    0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x61, 0x73,
+    0x6b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x62, 0x6f, 0x72,
+    0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x81, 0x01,
+    0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+    0x6f, 0x77, 0x12, 0x35, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76,
+    0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+    0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x63, 0x6f, 0x6e, 0x64,
+    0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x65,
+    0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+    0x72, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+    0x65, 0x12, 0x72, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+    0x12, 0x30, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+    0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
+    0x73, 0x74, 0x1a, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76,
+    0x31, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73,
+    0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73,
+    0x6b, 0x73, 0x12, 0x2e, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76,
+    0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+    0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76,
+    0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+    0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x09, 0x52, 0x65, 0x74, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b,
+    0x12, 0x2e, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+    0x52, 0x65, 0x74, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+    0x1a, 0x2f, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+    0x52, 0x65, 0x74, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+    0x65, 0x12, 0x7b, 0x0a, 0x0e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x54,
+    0x61, 0x73, 0x6b, 0x12, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e,
     0x61, 0x70, 0x69, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-    0x76, 0x31, 0x2e, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70,
-    0x6f, 0x6e, 0x73, 0x65, 0x42, 0x9c, 0x02, 0x0a, 0x20, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x6e,
-    0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73,
-    0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x10, 0x54, 0x61, 0x73, 0x6b, 0x73,
-    0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x53, 0x67,
-    0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x79, 0x61, 0x6e, 0x72, 0x65,
-    0x61, 0x64, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x77, 0x68, 0x69, 0x6d, 0x65, 0x72, 0x2f, 0x69,
-    0x64, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63,
-    0x74, 0x6f, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76,
-    0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x69,
-    0x63, 0x65, 0xa2, 0x02, 0x03, 0x43, 0x41, 0x54, 0xaa, 0x02, 0x1c, 0x43, 0x6f, 0x6e, 0x64, 0x75,
-    0x63, 0x74, 0x6f, 0x72, 0x2e, 0x41, 0x70, 0x69, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x65, 0x72,
-    0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1c, 0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63,
-    0x74, 0x6f, 0x72, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76,
-    0x69, 0x63, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x28, 0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74,
-    0x6f, 0x72, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x69,
-    0x63, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-    0x61, 0xea, 0x02, 0x1f, 0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x3a, 0x3a, 0x41,
-    0x70, 0x69, 0x3a, 0x3a, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x3a,
-    0x3a, 0x56, 0x31, 0x4a, 0xa2, 0x0a, 0x0a, 0x06, 0x12, 0x04, 0x00, 0x00, 0x3b, 0x01, 0x0a, 0x08,
-    0x0a, 0x01, 0x0c, 0x12, 0x03, 0x00, 0x00, 0x12, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x00, 0x12, 0x03,
-    0x02, 0x00, 0x25, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x01, 0x12, 0x03, 0x03, 0x00, 0x22, 0x0a, 0x09,
-    0x0a, 0x02, 0x03, 0x02, 0x12, 0x03, 0x04, 0x00, 0x2a, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x03, 0x12,
-    0x03, 0x05, 0x00, 0x29, 0x0a, 0x08, 0x0a, 0x01, 0x02, 0x12, 0x03, 0x07, 0x00, 0x25, 0x0a, 0x08,
-    0x0a, 0x01, 0x08, 0x12, 0x03, 0x09, 0x00, 0x6a, 0x0a, 0x09, 0x0a, 0x02, 0x08, 0x0b, 0x12, 0x03,
-    0x09, 0x00, 0x6a, 0x0a, 0x0a, 0x0a, 0x02, 0x06, 0x00, 0x12, 0x04, 0x0b, 0x00, 0x14, 0x01, 0x0a,
-    0x0a, 0x0a, 0x03, 0x06, 0x00, 0x01, 0x12, 0x03, 0x0b, 0x08, 0x13, 0x0a, 0x1b, 0x0a, 0x04, 0x06,
-    0x00, 0x02, 0x00, 0x12, 0x03, 0x0d, 0x02, 0x47, 0x1a, 0x0e, 0x20, 0xe6, 0xb3, 0xa8, 0xe5, 0x86,
-    0x8c, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00,
-    0x01, 0x12, 0x03, 0x0d, 0x06, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x02, 0x12,
-    0x03, 0x0d, 0x13, 0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x0d,
-    0x31, 0x45, 0x0a, 0x1b, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x01, 0x12, 0x03, 0x10, 0x02, 0x38, 0x1a,
-    0x0e, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0x0a, 0x0a,
-    0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x10, 0x06, 0x0d, 0x0a, 0x0c, 0x0a,
-    0x05, 0x06, 0x00, 0x02, 0x01, 0x02, 0x12, 0x03, 0x10, 0x0e, 0x1c, 0x0a, 0x0c, 0x0a, 0x05, 0x06,
-    0x00, 0x02, 0x01, 0x03, 0x12, 0x03, 0x10, 0x27, 0x36, 0x0a, 0x1b, 0x0a, 0x04, 0x06, 0x00, 0x02,
-    0x02, 0x12, 0x03, 0x13, 0x02, 0x3e, 0x1a, 0x0e, 0x20, 0xe7, 0xbb, 0x88, 0xe6, 0xad, 0xa2, 0xe4,
-    0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x01, 0x12,
-    0x03, 0x13, 0x06, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x02, 0x12, 0x03, 0x13,
-    0x10, 0x20, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x03, 0x12, 0x03, 0x13, 0x2b, 0x3c,
-    0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x00, 0x12, 0x04, 0x16, 0x00, 0x28, 0x01, 0x0a, 0x0a, 0x0a, 0x03,
-    0x04, 0x00, 0x01, 0x12, 0x03, 0x16, 0x08, 0x1b, 0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x00,
-    0x12, 0x03, 0x18, 0x02, 0x41, 0x1a, 0x0e, 0x20, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe7, 0xb1,
+    0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x73,
+    0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75,
+    0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x65, 0x72,
+    0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x62, 0x6f,
+    0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7b,
+    0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+    0x12, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+    0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+    0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f,
+    0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+    0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x69, 0x73, 0x74,
+    0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x10,
+    0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73,
+    0x12, 0x35, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+    0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73,
+    0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63,
+    0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76,
+    0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x61,
+    0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+    0x5f, 0x0a, 0x09, 0x57, 0x61, 0x74, 0x63, 0x68, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x2e, 0x2e, 0x63,
+    0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x61, 0x73,
+    0x6b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63,
+    0x68, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63,
+    0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x61, 0x73,
+    0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01,
+    0x12, 0x69, 0x0a, 0x0e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+    0x63, 0x65, 0x12, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76,
+    0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+    0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63,
+    0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e,
+    0x54, 0x61, 0x73, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x42, 0x9c, 0x02, 0x0a, 0x20,
+    0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70,
+    0x69, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31,
+    0x42, 0x10, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f,
+    0x74, 0x6f, 0x50, 0x01, 0x5a, 0x53, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+    0x2f, 0x72, 0x79, 0x61, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x77,
+    0x68, 0x69, 0x6d, 0x65, 0x72, 0x2f, 0x69, 0x64, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f,
+    0x2f, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74,
+    0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x61,
+    0x73, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0xa2, 0x02, 0x03, 0x43, 0x41, 0x54, 0xaa,
+    0x02, 0x1c, 0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x41, 0x70, 0x69, 0x2e,
+    0x54, 0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02,
+    0x1c, 0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x54,
+    0x61, 0x73, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x28,
+    0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x54, 0x61,
+    0x73, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
+    0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1f, 0x43, 0x6f, 0x6e, 0x64, 0x75,
+    0x63, 0x74, 0x6f, 0x72, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a, 0x3a, 0x54, 0x61, 0x73, 0x6b, 0x73,
+    0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x4a, 0xd3, 0x3e, 0x0a, 0x07, 0x12,
+    0x05, 0x00, 0x00, 0xf9, 0x01, 0x01, 0x0a, 0x08, 0x0a, 0x01, 0x0c, 0x12, 0x03, 0x00, 0x00, 0x12,
+    0x0a, 0x09, 0x0a, 0x02, 0x03, 0x00, 0x12, 0x03, 0x02, 0x00, 0x25, 0x0a, 0x09, 0x0a, 0x02, 0x03,
+    0x01, 0x12, 0x03, 0x03, 0x00, 0x22, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x02, 0x12, 0x03, 0x04, 0x00,
+    0x2a, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x03, 0x12, 0x03, 0x05, 0x00, 0x29, 0x0a, 0x08, 0x0a, 0x01,
+    0x02, 0x12, 0x03, 0x07, 0x00, 0x25, 0x0a, 0x08, 0x0a, 0x01, 0x08, 0x12, 0x03, 0x09, 0x00, 0x6a,
+    0x0a, 0x09, 0x0a, 0x02, 0x08, 0x0b, 0x12, 0x03, 0x09, 0x00, 0x6a, 0x0a, 0x0a, 0x0a, 0x02, 0x06,
+    0x00, 0x12, 0x04, 0x0b, 0x00, 0x2f, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x06, 0x00, 0x01, 0x12, 0x03,
+    0x0b, 0x08, 0x13, 0x0a, 0x1b, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x00, 0x12, 0x03, 0x0d, 0x02, 0x47,
+    0x1a, 0x0e, 0x20, 0xe6, 0xb3, 0xa8, 0xe5, 0x86, 0x8c, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0x0a,
+    0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x0d, 0x06, 0x12, 0x0a, 0x0c,
+    0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x02, 0x12, 0x03, 0x0d, 0x13, 0x26, 0x0a, 0x0c, 0x0a, 0x05,
+    0x06, 0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x0d, 0x31, 0x45, 0x0a, 0x1b, 0x0a, 0x04, 0x06, 0x00,
+    0x02, 0x01, 0x12, 0x03, 0x10, 0x02, 0x38, 0x1a, 0x0e, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96,
+    0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x01,
+    0x12, 0x03, 0x10, 0x06, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x02, 0x12, 0x03,
+    0x10, 0x0e, 0x1c, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x03, 0x12, 0x03, 0x10, 0x27,
+    0x36, 0x0a, 0x1b, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x02, 0x12, 0x03, 0x13, 0x02, 0x3e, 0x1a, 0x0e,
+    0x20, 0xe7, 0xbb, 0x88, 0xe6, 0xad, 0xa2, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0x0a, 0x0a, 0x0c,
+    0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x01, 0x12, 0x03, 0x13, 0x06, 0x0f, 0x0a, 0x0c, 0x0a, 0x05,
+    0x06, 0x00, 0x02, 0x02, 0x02, 0x12, 0x03, 0x13, 0x10, 0x20, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00,
+    0x02, 0x02, 0x03, 0x12, 0x03, 0x13, 0x2b, 0x3c, 0x0a, 0x49, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x03,
+    0x12, 0x03, 0x16, 0x02, 0x53, 0x1a, 0x3c, 0x20, 0xe6, 0xb3, 0xa8, 0xe5, 0x86, 0x8c, 0xe5, 0xb7,
+    0xa5, 0xe4, 0xbd, 0x9c, 0xe6, 0xb5, 0x81, 0x20, 0xe4, 0xb8, 0x80, 0xe6, 0xac, 0xa1, 0xe6, 0x8f,
+    0x90, 0xe4, 0xba, 0xa4, 0xe4, 0xb8, 0x80, 0xe7, 0xbb, 0x84, 0xe6, 0x9c, 0x89, 0xe4, 0xbe, 0x9d,
+    0xe8, 0xb5, 0x96, 0xe5, 0x85, 0xb3, 0xe7, 0xb3, 0xbb, 0xe7, 0x9a, 0x84, 0xe4, 0xbb, 0xbb, 0xe5,
+    0x8a, 0xa1, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x03, 0x01, 0x12, 0x03, 0x16, 0x06,
+    0x16, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x03, 0x02, 0x12, 0x03, 0x16, 0x17, 0x2e, 0x0a,
+    0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x03, 0x03, 0x12, 0x03, 0x16, 0x39, 0x51, 0x0a, 0x1e, 0x0a,
+    0x04, 0x06, 0x00, 0x02, 0x04, 0x12, 0x03, 0x19, 0x02, 0x44, 0x1a, 0x11, 0x20, 0xe8, 0x8e, 0xb7,
+    0xe5, 0x8f, 0x96, 0xe5, 0xb7, 0xa5, 0xe4, 0xbd, 0x9c, 0xe6, 0xb5, 0x81, 0x0a, 0x0a, 0x0c, 0x0a,
+    0x05, 0x06, 0x00, 0x02, 0x04, 0x01, 0x12, 0x03, 0x19, 0x06, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x06,
+    0x00, 0x02, 0x04, 0x02, 0x12, 0x03, 0x19, 0x12, 0x24, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02,
+    0x04, 0x03, 0x12, 0x03, 0x19, 0x2f, 0x42, 0x0a, 0x2a, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x05, 0x12,
+    0x03, 0x1c, 0x02, 0x3e, 0x1a, 0x1d, 0x20, 0xe6, 0x8c, 0x89, 0xe6, 0x9d, 0xa1, 0xe4, 0xbb, 0xb6,
+    0xe5, 0x88, 0x86, 0xe9, 0xa1, 0xb5, 0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba, 0xe4, 0xbb, 0xbb, 0xe5,
+    0x8a, 0xa1, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x05, 0x01, 0x12, 0x03, 0x1c, 0x06,
+    0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x05, 0x02, 0x12, 0x03, 0x1c, 0x10, 0x20, 0x0a,
+    0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x05, 0x03, 0x12, 0x03, 0x1c, 0x2b, 0x3c, 0x0a, 0x52, 0x0a,
+    0x04, 0x06, 0x00, 0x02, 0x06, 0x12, 0x03, 0x1f, 0x02, 0x3e, 0x1a, 0x45, 0x20, 0xe4, 0xba, 0xba,
+    0xe5, 0xb7, 0xa5, 0xe9, 0x87, 0x8d, 0xe8, 0xaf, 0x95, 0x20, 0xe5, 0xb0, 0x86, 0xe5, 0xa4, 0xb1,
+    0xe8, 0xb4, 0xa5, 0xe6, 0x88, 0x96, 0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0x9f, 0xe7, 0x9a, 0x84, 0xe4,
+    0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe9, 0x87, 0x8d, 0xe6, 0x96, 0xb0, 0xe6, 0x94, 0xbe, 0xe5, 0x9b,
+    0x9e, 0xe5, 0xbe, 0x85, 0xe9, 0x87, 0x8d, 0xe8, 0xaf, 0x95, 0xe9, 0x98, 0x9f, 0xe5, 0x88, 0x97,
+    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x06, 0x01, 0x12, 0x03, 0x1f, 0x06, 0x0f, 0x0a,
+    0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x06, 0x02, 0x12, 0x03, 0x1f, 0x10, 0x20, 0x0a, 0x0c, 0x0a,
+    0x05, 0x06, 0x00, 0x02, 0x06, 0x03, 0x12, 0x03, 0x1f, 0x2b, 0x3c, 0x0a, 0x21, 0x0a, 0x04, 0x06,
+    0x00, 0x02, 0x07, 0x12, 0x03, 0x22, 0x02, 0x4d, 0x1a, 0x14, 0x20, 0xe6, 0x89, 0xb9, 0xe9, 0x87,
+    0x8f, 0xe7, 0xbb, 0x88, 0xe6, 0xad, 0xa2, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0x0a, 0x0a, 0x0c,
+    0x0a, 0x05, 0x06, 0x00, 0x02, 0x07, 0x01, 0x12, 0x03, 0x22, 0x06, 0x14, 0x0a, 0x0c, 0x0a, 0x05,
+    0x06, 0x00, 0x02, 0x07, 0x02, 0x12, 0x03, 0x22, 0x15, 0x2a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00,
+    0x02, 0x07, 0x03, 0x12, 0x03, 0x22, 0x35, 0x4b, 0x0a, 0x2d, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x08,
+    0x12, 0x03, 0x25, 0x02, 0x4d, 0x1a, 0x20, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe4, 0xbb,
+    0xbb, 0xe5, 0x8a, 0xa1, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0xe5, 0x8f, 0x98, 0xe6, 0x9b, 0xb4,
+    0xe8, 0xae, 0xb0, 0xe5, 0xbd, 0x95, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x08, 0x01,
+    0x12, 0x03, 0x25, 0x06, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x08, 0x02, 0x12, 0x03,
+    0x25, 0x15, 0x2a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x08, 0x03, 0x12, 0x03, 0x25, 0x35,
+    0x4b, 0x0a, 0x30, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x09, 0x12, 0x03, 0x28, 0x02, 0x53, 0x1a, 0x23,
+    0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe7, 0x9a, 0x84,
+    0xe5, 0x9b, 0x9e, 0xe8, 0xb0, 0x83, 0xe6, 0x8a, 0x95, 0xe9, 0x80, 0x92, 0xe8, 0xae, 0xb0, 0xe5,
+    0xbd, 0x95, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x09, 0x01, 0x12, 0x03, 0x28, 0x06,
+    0x16, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x09, 0x02, 0x12, 0x03, 0x28, 0x17, 0x2e, 0x0a,
+    0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x09, 0x03, 0x12, 0x03, 0x28, 0x39, 0x51, 0x0a, 0x71, 0x0a,
+    0x04, 0x06, 0x00, 0x02, 0x0a, 0x12, 0x03, 0x2b, 0x02, 0x53, 0x1a, 0x64, 0x20, 0xe8, 0xae, 0xa2,
+    0xe9, 0x98, 0x85, 0xe5, 0x8d, 0x95, 0xe4, 0xb8, 0xaa, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe7,
+    0x9a, 0x84, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0xe5, 0x8f, 0x98, 0xe6, 0x9b, 0xb4, 0xe5, 0x92,
+    0x8c, 0xe8, 0xbf, 0x9b, 0xe5, 0xba, 0xa6, 0x20, 0xe5, 0x85, 0x88, 0xe8, 0xbf, 0x94, 0xe5, 0x9b,
+    0x9e, 0xe4, 0xb8, 0x80, 0xe6, 0xac, 0xa1, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe7, 0x8a, 0xb6,
+    0xe6, 0x80, 0x81, 0x20, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe8, 0xbf, 0x9b, 0xe5, 0x85, 0xa5,
+    0xe7, 0xbb, 0x88, 0xe6, 0x80, 0x81, 0xe5, 0x90, 0x8e, 0xe7, 0xbb, 0x93, 0xe6, 0x9d, 0x9f, 0x0a,
+    0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0a, 0x01, 0x12, 0x03, 0x2b, 0x06, 0x0f, 0x0a, 0x0c,
+    0x0a, 0x05, 0x06, 0x00, 0x02, 0x0a, 0x02, 0x12, 0x03, 0x2b, 0x10, 0x20, 0x0a, 0x0c, 0x0a, 0x05,
+    0x06, 0x00, 0x02, 0x0a, 0x06, 0x12, 0x03, 0x2b, 0x2b, 0x31, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00,
+    0x02, 0x0a, 0x03, 0x12, 0x03, 0x2b, 0x32, 0x51, 0x0a, 0x5e, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x0b,
+    0x12, 0x03, 0x2e, 0x02, 0x5d, 0x1a, 0x51, 0x20, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe5, 0x91,
+    0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xe4, 0xb8, 0x8b, 0xe6, 0x89, 0x80,
+    0xe6, 0x9c, 0x89, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe7, 0x9a, 0x84, 0xe7, 0x8a, 0xb6, 0xe6,
+    0x80, 0x81, 0xe5, 0x8f, 0x98, 0xe6, 0x9b, 0xb4, 0xe5, 0x92, 0x8c, 0xe8, 0xbf, 0x9b, 0xe5, 0xba,
+    0xa6, 0x20, 0xe7, 0x9b, 0xb4, 0xe5, 0x88, 0xb0, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab,
+    0xaf, 0xe5, 0x8f, 0x96, 0xe6, 0xb6, 0x88, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0b,
+    0x01, 0x12, 0x03, 0x2e, 0x06, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0b, 0x02, 0x12,
+    0x03, 0x2e, 0x15, 0x2a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0b, 0x06, 0x12, 0x03, 0x2e,
+    0x35, 0x3b, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0b, 0x03, 0x12, 0x03, 0x2e, 0x3c, 0x5b,
+    0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x00, 0x12, 0x04, 0x31, 0x00, 0x56, 0x01, 0x0a, 0x0a, 0x0a, 0x03,
+    0x04, 0x00, 0x01, 0x12, 0x03, 0x31, 0x08, 0x1b, 0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x00,
+    0x12, 0x03, 0x33, 0x02, 0x41, 0x1a, 0x0e, 0x20, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe7, 0xb1,
     0xbb, 0xe5, 0x9e, 0x8b, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x05, 0x12, 0x03,
-    0x18, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x18, 0x09,
-    0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x18, 0x15, 0x16, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x08, 0x12, 0x03, 0x18, 0x17, 0x40, 0x0a, 0x10, 0x0a,
-    0x09, 0x04, 0x00, 0x02, 0x00, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x03, 0x18, 0x18, 0x3f, 0x0a,
-    0x21, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x01, 0x12, 0x03, 0x1b, 0x02, 0x41, 0x1a, 0x14, 0x20, 0xe6,
+    0x33, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x33, 0x09,
+    0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x33, 0x15, 0x16, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x08, 0x12, 0x03, 0x33, 0x17, 0x40, 0x0a, 0x10, 0x0a,
+    0x09, 0x04, 0x00, 0x02, 0x00, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x03, 0x33, 0x18, 0x3f, 0x0a,
+    0x21, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x01, 0x12, 0x03, 0x36, 0x02, 0x41, 0x1a, 0x14, 0x20, 0xe6,
     0x89, 0x80, 0xe5, 0xb1, 0x9e, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97,
-    0xb4, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x05, 0x12, 0x03, 0x1b, 0x02, 0x08,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x1b, 0x09, 0x12, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x03, 0x12, 0x03, 0x1b, 0x15, 0x16, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x00, 0x02, 0x01, 0x08, 0x12, 0x03, 0x1b, 0x17, 0x40, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x00,
-    0x02, 0x01, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x03, 0x1b, 0x18, 0x3f, 0x0a, 0x1b, 0x0a, 0x04,
-    0x04, 0x00, 0x02, 0x02, 0x12, 0x03, 0x1e, 0x02, 0x17, 0x1a, 0x0e, 0x20, 0xe8, 0xbe, 0x93, 0xe5,
+    0xb4, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x05, 0x12, 0x03, 0x36, 0x02, 0x08,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x36, 0x09, 0x12, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x03, 0x12, 0x03, 0x36, 0x15, 0x16, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x00, 0x02, 0x01, 0x08, 0x12, 0x03, 0x36, 0x17, 0x40, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x00,
+    0x02, 0x01, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x03, 0x36, 0x18, 0x3f, 0x0a, 0x1b, 0x0a, 0x04,
+    0x04, 0x00, 0x02, 0x02, 0x12, 0x03, 0x39, 0x02, 0x17, 0x1a, 0x0e, 0x20, 0xe8, 0xbe, 0x93, 0xe5,
     0x85, 0xa5, 0xe5, 0x8f, 0x82, 0xe6, 0x95, 0xb0, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02,
-    0x02, 0x05, 0x12, 0x03, 0x1e, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x01,
-    0x12, 0x03, 0x1e, 0x08, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x03, 0x12, 0x03,
-    0x1e, 0x15, 0x16, 0x0a, 0x18, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x03, 0x12, 0x03, 0x21, 0x02, 0x1a,
+    0x02, 0x05, 0x12, 0x03, 0x39, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x01,
+    0x12, 0x03, 0x39, 0x08, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x03, 0x12, 0x03,
+    0x39, 0x15, 0x16, 0x0a, 0x18, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x03, 0x12, 0x03, 0x3c, 0x02, 0x1a,
     0x1a, 0x0b, 0x20, 0xe5, 0x9b, 0x9e, 0xe8, 0xb0, 0x83, 0x75, 0x72, 0x6c, 0x0a, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x00, 0x02, 0x03, 0x05, 0x12, 0x03, 0x21, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x00, 0x02, 0x03, 0x01, 0x12, 0x03, 0x21, 0x09, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02,
-    0x03, 0x03, 0x12, 0x03, 0x21, 0x18, 0x19, 0x0a, 0x54, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x04, 0x12,
-    0x03, 0x24, 0x02, 0x1a, 0x1a, 0x47, 0x20, 0xe6, 0x9c, 0x80, 0xe5, 0xa4, 0xa7, 0xe9, 0x87, 0x8d,
+    0x05, 0x04, 0x00, 0x02, 0x03, 0x05, 0x12, 0x03, 0x3c, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x00, 0x02, 0x03, 0x01, 0x12, 0x03, 0x3c, 0x09, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02,
+    0x03, 0x03, 0x12, 0x03, 0x3c, 0x18, 0x19, 0x0a, 0x54, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x04, 0x12,
+    0x03, 0x3f, 0x02, 0x1a, 0x1a, 0x47, 0x20, 0xe6, 0x9c, 0x80, 0xe5, 0xa4, 0xa7, 0xe9, 0x87, 0x8d,
     0xe8, 0xaf, 0x95, 0xe6, 0xac, 0xa1, 0xe6, 0x95, 0xb0, 0x20, 0x2d, 0x31, 0xe8, 0xa1, 0xa8, 0xe7,
     0xa4, 0xba, 0xe6, 0x97, 0xa0, 0xe9, 0x99, 0x90, 0xe9, 0x87, 0x8d, 0xe8, 0xaf, 0x95, 0xe7, 0x9b,
     0xb4, 0xe5, 0x88, 0xb0, 0xe8, 0xb6, 0x85, 0xe6, 0x97, 0xb6, 0x2c, 0x20, 0x30, 0xe8, 0xa1, 0xa8,
     0xe7, 0xa4, 0xba, 0xe4, 0xb8, 0x8d, 0xe9, 0x87, 0x8d, 0xe8, 0xaf, 0x95, 0x0a, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x00, 0x02, 0x04, 0x05, 0x12, 0x03, 0x24, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x00, 0x02, 0x04, 0x01, 0x12, 0x03, 0x24, 0x08, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02,
-    0x04, 0x03, 0x12, 0x03, 0x24, 0x18, 0x19, 0x0a, 0x21, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x05, 0x12,
-    0x03, 0x27, 0x02, 0x2c, 0x1a, 0x14, 0x20, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe8, 0xbf, 0x87,
+    0x05, 0x04, 0x00, 0x02, 0x04, 0x05, 0x12, 0x03, 0x3f, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x00, 0x02, 0x04, 0x01, 0x12, 0x03, 0x3f, 0x08, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02,
+    0x04, 0x03, 0x12, 0x03, 0x3f, 0x18, 0x19, 0x0a, 0x21, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x05, 0x12,
+    0x03, 0x42, 0x02, 0x2c, 0x1a, 0x14, 0x20, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe8, 0xbf, 0x87,
     0xe6, 0x9c, 0x9f, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00,
-    0x02, 0x05, 0x06, 0x12, 0x03, 0x27, 0x02, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x05,
-    0x01, 0x12, 0x03, 0x27, 0x1c, 0x27, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x05, 0x03, 0x12,
-    0x03, 0x27, 0x2a, 0x2b, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x01, 0x12, 0x04, 0x2a, 0x00, 0x2c, 0x01,
-    0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x01, 0x01, 0x12, 0x03, 0x2a, 0x08, 0x1c, 0x0a, 0x0b, 0x0a, 0x04,
-    0x04, 0x01, 0x02, 0x00, 0x12, 0x03, 0x2b, 0x02, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02,
-    0x00, 0x05, 0x12, 0x03, 0x2b, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x01,
-    0x12, 0x03, 0x2b, 0x09, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x03, 0x12, 0x03,
-    0x2b, 0x13, 0x14, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x02, 0x12, 0x04, 0x2e, 0x00, 0x30, 0x01, 0x0a,
-    0x0a, 0x0a, 0x03, 0x04, 0x02, 0x01, 0x12, 0x03, 0x2e, 0x08, 0x16, 0x0a, 0x0b, 0x0a, 0x04, 0x04,
-    0x02, 0x02, 0x00, 0x12, 0x03, 0x2f, 0x02, 0x3f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00,
-    0x05, 0x12, 0x03, 0x2f, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x01, 0x12,
-    0x03, 0x2f, 0x09, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x03, 0x12, 0x03, 0x2f,
-    0x13, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x08, 0x12, 0x03, 0x2f, 0x15, 0x3e,
-    0x0a, 0x10, 0x0a, 0x09, 0x04, 0x02, 0x02, 0x00, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x03, 0x2f,
-    0x16, 0x3d, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x03, 0x12, 0x04, 0x32, 0x00, 0x34, 0x01, 0x0a, 0x0a,
-    0x0a, 0x03, 0x04, 0x03, 0x01, 0x12, 0x03, 0x32, 0x08, 0x17, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x03,
-    0x02, 0x00, 0x12, 0x03, 0x33, 0x02, 0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x06,
-    0x12, 0x03, 0x33, 0x02, 0x1c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x01, 0x12, 0x03,
-    0x33, 0x1d, 0x21, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x03, 0x12, 0x03, 0x33, 0x24,
-    0x25, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x04, 0x12, 0x04, 0x36, 0x00, 0x38, 0x01, 0x0a, 0x0a, 0x0a,
-    0x03, 0x04, 0x04, 0x01, 0x12, 0x03, 0x36, 0x08, 0x18, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x04, 0x02,
-    0x00, 0x12, 0x03, 0x37, 0x02, 0x3f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x05, 0x12,
-    0x03, 0x37, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x01, 0x12, 0x03, 0x37,
-    0x09, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x03, 0x12, 0x03, 0x37, 0x13, 0x14,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x08, 0x12, 0x03, 0x37, 0x15, 0x3e, 0x0a, 0x10,
-    0x0a, 0x09, 0x04, 0x04, 0x02, 0x00, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x03, 0x37, 0x16, 0x3d,
-    0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x05, 0x12, 0x04, 0x3a, 0x00, 0x3b, 0x01, 0x0a, 0x0a, 0x0a, 0x03,
-    0x04, 0x05, 0x01, 0x12, 0x03, 0x3a, 0x08, 0x19, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+    0x02, 0x05, 0x06, 0x12, 0x03, 0x42, 0x02, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x05,
+    0x01, 0x12, 0x03, 0x42, 0x1c, 0x27, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x05, 0x03, 0x12,
+    0x03, 0x42, 0x2a, 0x2b, 0x0a, 0x40, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x06, 0x12, 0x03, 0x45, 0x02,
+    0x2b, 0x1a, 0x33, 0x20, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe6, 0x9c, 0x80, 0xe6, 0x97, 0xa9,
+    0xe6, 0x89, 0xa7, 0xe8, 0xa1, 0x8c, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x20, 0xe4, 0xb8, 0x8d,
+    0xe8, 0xae, 0xbe, 0xe7, 0xbd, 0xae, 0xe5, 0x88, 0x99, 0xe7, 0xab, 0x8b, 0xe5, 0x8d, 0xb3, 0xe6,
+    0x89, 0xa7, 0xe8, 0xa1, 0x8c, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x06, 0x06, 0x12,
+    0x03, 0x45, 0x02, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x06, 0x01, 0x12, 0x03, 0x45,
+    0x1c, 0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x06, 0x03, 0x12, 0x03, 0x45, 0x29, 0x2a,
+    0x0a, 0x84, 0x01, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x07, 0x12, 0x03, 0x49, 0x02, 0x49, 0x1a, 0x77,
+    0x20, 0xe5, 0xb9, 0x82, 0xe7, 0xad, 0x89, 0xe9, 0x94, 0xae, 0x20, 0xe5, 0x8f, 0xaf, 0xe9, 0x80,
+    0x89, 0x0a, 0x20, 0xe5, 0x90, 0x8c, 0xe4, 0xb8, 0x80, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7,
+    0xa9, 0xba, 0xe9, 0x97, 0xb4, 0xe4, 0xb8, 0x8b, 0xe7, 0x9b, 0xb8, 0xe5, 0x90, 0x8c, 0xe5, 0xb9,
+    0x82, 0xe7, 0xad, 0x89, 0xe9, 0x94, 0xae, 0xe5, 0x9c, 0xa8, 0xe5, 0x8e, 0xbb, 0xe9, 0x87, 0x8d,
+    0xe7, 0xaa, 0x97, 0xe5, 0x8f, 0xa3, 0xe5, 0x86, 0x85, 0xe9, 0x87, 0x8d, 0xe5, 0xa4, 0x8d, 0xe6,
+    0xb3, 0xa8, 0xe5, 0x86, 0x8c, 0xe6, 0x97, 0xb6, 0x20, 0xe7, 0x9b, 0xb4, 0xe6, 0x8e, 0xa5, 0xe8,
+    0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe5, 0xb7, 0xb2, 0xe6, 0x9c, 0x89, 0xe7, 0x9a, 0x84, 0xe4, 0xbb,
+    0xbb, 0xe5, 0x8a, 0xa1, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x07, 0x05,
+    0x12, 0x03, 0x49, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x07, 0x01, 0x12, 0x03,
+    0x49, 0x09, 0x18, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x07, 0x03, 0x12, 0x03, 0x49, 0x1b,
+    0x1c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x07, 0x08, 0x12, 0x03, 0x49, 0x1d, 0x48, 0x0a,
+    0x10, 0x0a, 0x09, 0x04, 0x00, 0x02, 0x07, 0x08, 0x87, 0x09, 0x0e, 0x03, 0x12, 0x03, 0x49, 0x1e,
+    0x47, 0x0a, 0x40, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x08, 0x12, 0x03, 0x4c, 0x02, 0x45, 0x1a, 0x33,
+    0x20, 0xe4, 0xbc, 0x98, 0xe5, 0x85, 0x88, 0xe7, 0xba, 0xa7, 0x20, 0x5b, 0x30, 0x2c, 0x20, 0x39,
+    0x5d, 0x20, 0xe8, 0xb6, 0x8a, 0xe5, 0xa4, 0xa7, 0xe8, 0xb6, 0x8a, 0xe4, 0xbc, 0x98, 0xe5, 0x85,
+    0x88, 0xe5, 0x88, 0x86, 0xe5, 0x8f, 0x91, 0x20, 0xe9, 0xbb, 0x98, 0xe8, 0xae, 0xa4, 0xe4, 0xb8,
+    0xba, 0x30, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x08, 0x05, 0x12, 0x03, 0x4c, 0x02,
+    0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x08, 0x01, 0x12, 0x03, 0x4c, 0x08, 0x10, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x08, 0x03, 0x12, 0x03, 0x4c, 0x13, 0x14, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x00, 0x02, 0x08, 0x08, 0x12, 0x03, 0x4c, 0x15, 0x44, 0x0a, 0x0f, 0x0a, 0x08, 0x04,
+    0x00, 0x02, 0x08, 0x08, 0x87, 0x09, 0x03, 0x12, 0x03, 0x4c, 0x16, 0x43, 0x0a, 0x10, 0x0a, 0x09,
+    0x04, 0x00, 0x02, 0x08, 0x08, 0x87, 0x09, 0x03, 0x05, 0x12, 0x03, 0x4c, 0x34, 0x3a, 0x0a, 0x10,
+    0x0a, 0x09, 0x04, 0x00, 0x02, 0x08, 0x08, 0x87, 0x09, 0x03, 0x03, 0x12, 0x03, 0x4c, 0x3c, 0x42,
+    0x0a, 0xc9, 0x01, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x09, 0x12, 0x03, 0x51, 0x02, 0x23, 0x1a, 0xbb,
+    0x01, 0x20, 0x67, 0x52, 0x50, 0x43, 0x20, 0xe5, 0x9b, 0x9e, 0xe8, 0xb0, 0x83, 0xe7, 0x9b, 0xae,
+    0xe6, 0xa0, 0x87, 0x20, 0xe4, 0xb8, 0x8e, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+    0x5f, 0x75, 0x72, 0x6c, 0x20, 0xe4, 0xba, 0x8c, 0xe9, 0x80, 0x89, 0xe4, 0xb8, 0x80, 0x0a, 0x20,
+    0xe6, 0x94, 0xaf, 0xe6, 0x8c, 0x81, 0x20, 0x67, 0x72, 0x70, 0x63, 0x20, 0xe6, 0xa0, 0x87, 0xe5,
+    0x87, 0x86, 0x20, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x20, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f,
+    0x20, 0xe5, 0xa6, 0x82, 0x20, 0x64, 0x6e, 0x73, 0x3a, 0x2f, 0x2f, 0x2f, 0x68, 0x6f, 0x73, 0x74,
+    0x3a, 0x70, 0x6f, 0x72, 0x74, 0x20, 0xe4, 0xbb, 0xa5, 0xe5, 0x8f, 0x8a, 0x20, 0x65, 0x74, 0x63,
+    0x64, 0x3a, 0x2f, 0x2f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x6b, 0x65, 0x79, 0x0a, 0x20, 0xe5,
+    0x9b, 0x9e, 0xe8, 0xb0, 0x83, 0xe6, 0x96, 0xb9, 0xe9, 0x9c, 0x80, 0xe5, 0xae, 0x9e, 0xe7, 0x8e,
+    0xb0, 0x20, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+    0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x6c,
+    0x62, 0x61, 0x63, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x0a, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x00, 0x02, 0x09, 0x05, 0x12, 0x03, 0x51, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00,
+    0x02, 0x09, 0x01, 0x12, 0x03, 0x51, 0x09, 0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x09,
+    0x03, 0x12, 0x03, 0x51, 0x20, 0x22, 0x0a, 0xa5, 0x01, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x0a, 0x12,
+    0x03, 0x55, 0x02, 0x2b, 0x1a, 0x97, 0x01, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0xe6, 0xa0,
+    0x87, 0xe7, 0xad, 0xbe, 0xe9, 0x80, 0x89, 0xe6, 0x8b, 0xa9, 0xe5, 0x99, 0xa8, 0x20, 0xe5, 0x8f,
+    0xaa, 0xe5, 0x88, 0x86, 0xe5, 0x8f, 0x91, 0xe7, 0xbb, 0x99, 0xe6, 0xa0, 0x87, 0xe7, 0xad, 0xbe,
+    0xe5, 0x85, 0xa8, 0xe9, 0x83, 0xa8, 0xe5, 0x8c, 0xb9, 0xe9, 0x85, 0x8d, 0xe7, 0x9a, 0x84, 0x77,
+    0x6f, 0x72, 0x6b, 0x65, 0x72, 0x20, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe5, 0x88, 0x99, 0xe4,
+    0xb8, 0x8d, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0x0a, 0x20, 0xe5, 0xa6, 0x82, 0x20, 0x7b, 0x22,
+    0x66, 0x66, 0x6d, 0x70, 0x65, 0x67, 0x22, 0x3a, 0x20, 0x22, 0x61, 0x76, 0x31, 0x22, 0x7d, 0x20,
+    0xe5, 0x8f, 0xaa, 0xe5, 0x88, 0x86, 0xe5, 0x8f, 0x91, 0xe7, 0xbb, 0x99, 0xe5, 0xb8, 0xa6, 0xe6,
+    0x9c, 0x89, 0x20, 0x66, 0x66, 0x6d, 0x70, 0x65, 0x67, 0x3d, 0x61, 0x76, 0x31, 0x20, 0xe6, 0xa0,
+    0x87, 0xe7, 0xad, 0xbe, 0xe7, 0x9a, 0x84, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x0a, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x00, 0x02, 0x0a, 0x06, 0x12, 0x03, 0x55, 0x02, 0x15, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x00, 0x02, 0x0a, 0x01, 0x12, 0x03, 0x55, 0x16, 0x25, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00,
+    0x02, 0x0a, 0x03, 0x12, 0x03, 0x55, 0x28, 0x2a, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x01, 0x12, 0x04,
+    0x58, 0x00, 0x5d, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x01, 0x01, 0x12, 0x03, 0x58, 0x08, 0x1c,
+    0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x00, 0x12, 0x03, 0x59, 0x02, 0x15, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x01, 0x02, 0x00, 0x05, 0x12, 0x03, 0x59, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x01, 0x02, 0x00, 0x01, 0x12, 0x03, 0x59, 0x09, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02,
+    0x00, 0x03, 0x12, 0x03, 0x59, 0x13, 0x14, 0x0a, 0x3d, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x01, 0x12,
+    0x03, 0x5c, 0x02, 0x16, 0x1a, 0x30, 0x20, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x91, 0xbd,
+    0xe4, 0xb8, 0xad, 0xe5, 0xb9, 0x82, 0xe7, 0xad, 0x89, 0xe9, 0x94, 0xae, 0x20, 0xe8, 0xbf, 0x94,
+    0xe5, 0x9b, 0x9e, 0xe7, 0x9a, 0x84, 0xe6, 0x98, 0xaf, 0xe5, 0xb7, 0xb2, 0xe6, 0x9c, 0x89, 0xe4,
+    0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x05, 0x12,
+    0x03, 0x5c, 0x02, 0x06, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x01, 0x12, 0x03, 0x5c,
+    0x07, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x03, 0x12, 0x03, 0x5c, 0x14, 0x15,
+    0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x02, 0x12, 0x04, 0x5f, 0x00, 0x61, 0x01, 0x0a, 0x0a, 0x0a, 0x03,
+    0x04, 0x02, 0x01, 0x12, 0x03, 0x5f, 0x08, 0x16, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x00,
+    0x12, 0x03, 0x60, 0x02, 0x3f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x05, 0x12, 0x03,
+    0x60, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x01, 0x12, 0x03, 0x60, 0x09,
+    0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x03, 0x12, 0x03, 0x60, 0x13, 0x14, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x08, 0x12, 0x03, 0x60, 0x15, 0x3e, 0x0a, 0x10, 0x0a,
+    0x09, 0x04, 0x02, 0x02, 0x00, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x03, 0x60, 0x16, 0x3d, 0x0a,
+    0x0a, 0x0a, 0x02, 0x04, 0x03, 0x12, 0x04, 0x63, 0x00, 0x65, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04,
+    0x03, 0x01, 0x12, 0x03, 0x63, 0x08, 0x17, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x03, 0x02, 0x00, 0x12,
+    0x03, 0x64, 0x02, 0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x06, 0x12, 0x03, 0x64,
+    0x02, 0x1c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x01, 0x12, 0x03, 0x64, 0x1d, 0x21,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x03, 0x12, 0x03, 0x64, 0x24, 0x25, 0x0a, 0x0a,
+    0x0a, 0x02, 0x04, 0x04, 0x12, 0x04, 0x67, 0x00, 0x69, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x04,
+    0x01, 0x12, 0x03, 0x67, 0x08, 0x18, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x00, 0x12, 0x03,
+    0x68, 0x02, 0x3f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x05, 0x12, 0x03, 0x68, 0x02,
+    0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x01, 0x12, 0x03, 0x68, 0x09, 0x10, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x03, 0x12, 0x03, 0x68, 0x13, 0x14, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x04, 0x02, 0x00, 0x08, 0x12, 0x03, 0x68, 0x15, 0x3e, 0x0a, 0x10, 0x0a, 0x09, 0x04,
+    0x04, 0x02, 0x00, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x03, 0x68, 0x16, 0x3d, 0x0a, 0x0a, 0x0a,
+    0x02, 0x04, 0x05, 0x12, 0x04, 0x6b, 0x00, 0x6c, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x05, 0x01,
+    0x12, 0x03, 0x6b, 0x08, 0x19, 0x0a, 0x0b, 0x0a, 0x02, 0x04, 0x06, 0x12, 0x05, 0x6e, 0x00, 0x89,
+    0x01, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x06, 0x01, 0x12, 0x03, 0x6e, 0x08, 0x14, 0x0a, 0x31,
+    0x0a, 0x04, 0x04, 0x06, 0x02, 0x00, 0x12, 0x03, 0x70, 0x02, 0x3c, 0x1a, 0x24, 0x20, 0xe8, 0x8a,
+    0x82, 0xe7, 0x82, 0xb9, 0xe5, 0x90, 0x8d, 0x20, 0xe5, 0x90, 0x8c, 0xe4, 0xb8, 0x80, 0xe5, 0xb7,
+    0xa5, 0xe4, 0xbd, 0x9c, 0xe6, 0xb5, 0x81, 0xe5, 0x86, 0x85, 0xe5, 0x94, 0xaf, 0xe4, 0xb8, 0x80,
+    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x05, 0x12, 0x03, 0x70, 0x02, 0x08, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x01, 0x12, 0x03, 0x70, 0x09, 0x0d, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x06, 0x02, 0x00, 0x03, 0x12, 0x03, 0x70, 0x10, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x06, 0x02, 0x00, 0x08, 0x12, 0x03, 0x70, 0x12, 0x3b, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x06, 0x02,
+    0x00, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x03, 0x70, 0x13, 0x3a, 0x0a, 0x1b, 0x0a, 0x04, 0x04,
+    0x06, 0x02, 0x01, 0x12, 0x03, 0x73, 0x02, 0x41, 0x1a, 0x0e, 0x20, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a,
+    0xa1, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x01,
+    0x05, 0x12, 0x03, 0x73, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x01, 0x01, 0x12,
+    0x03, 0x73, 0x09, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x01, 0x03, 0x12, 0x03, 0x73,
+    0x15, 0x16, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x01, 0x08, 0x12, 0x03, 0x73, 0x17, 0x40,
+    0x0a, 0x10, 0x0a, 0x09, 0x04, 0x06, 0x02, 0x01, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x03, 0x73,
+    0x18, 0x3f, 0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x06, 0x02, 0x02, 0x12, 0x03, 0x76, 0x02, 0x17, 0x1a,
+    0x0e, 0x20, 0xe8, 0xbe, 0x93, 0xe5, 0x85, 0xa5, 0xe5, 0x8f, 0x82, 0xe6, 0x95, 0xb0, 0x0a, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x02, 0x05, 0x12, 0x03, 0x76, 0x02, 0x07, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x06, 0x02, 0x02, 0x01, 0x12, 0x03, 0x76, 0x08, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x06, 0x02, 0x02, 0x03, 0x12, 0x03, 0x76, 0x15, 0x16, 0x0a, 0x18, 0x0a, 0x04, 0x04, 0x06, 0x02,
+    0x03, 0x12, 0x03, 0x79, 0x02, 0x1a, 0x1a, 0x0b, 0x20, 0xe5, 0x9b, 0x9e, 0xe8, 0xb0, 0x83, 0x75,
+    0x72, 0x6c, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x03, 0x05, 0x12, 0x03, 0x79, 0x02,
+    0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x03, 0x01, 0x12, 0x03, 0x79, 0x09, 0x15, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x03, 0x03, 0x12, 0x03, 0x79, 0x18, 0x19, 0x0a, 0x54, 0x0a,
+    0x04, 0x04, 0x06, 0x02, 0x04, 0x12, 0x03, 0x7c, 0x02, 0x1a, 0x1a, 0x47, 0x20, 0xe6, 0x9c, 0x80,
+    0xe5, 0xa4, 0xa7, 0xe9, 0x87, 0x8d, 0xe8, 0xaf, 0x95, 0xe6, 0xac, 0xa1, 0xe6, 0x95, 0xb0, 0x20,
+    0x2d, 0x31, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe6, 0x97, 0xa0, 0xe9, 0x99, 0x90, 0xe9, 0x87,
+    0x8d, 0xe8, 0xaf, 0x95, 0xe7, 0x9b, 0xb4, 0xe5, 0x88, 0xb0, 0xe8, 0xb6, 0x85, 0xe6, 0x97, 0xb6,
+    0x2c, 0x20, 0x30, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe4, 0xb8, 0x8d, 0xe9, 0x87, 0x8d, 0xe8,
+    0xaf, 0x95, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x04, 0x05, 0x12, 0x03, 0x7c, 0x02,
+    0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x04, 0x01, 0x12, 0x03, 0x7c, 0x08, 0x15, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x04, 0x03, 0x12, 0x03, 0x7c, 0x18, 0x19, 0x0a, 0x57, 0x0a,
+    0x04, 0x04, 0x06, 0x02, 0x05, 0x12, 0x03, 0x7f, 0x02, 0x3e, 0x1a, 0x4a, 0x20, 0xe4, 0xbb, 0xbb,
+    0xe5, 0x8a, 0xa1, 0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0x9f, 0xe6, 0x97, 0xb6, 0xe9, 0x95, 0xbf, 0x20,
+    0x6d, 0x73, 0x20, 0xe4, 0xbb, 0x8e, 0xe8, 0x8a, 0x82, 0xe7, 0x82, 0xb9, 0xe8, 0xa2, 0xab, 0xe6,
+    0x94, 0xbe, 0xe8, 0xa1, 0x8c, 0xe6, 0x97, 0xb6, 0xe5, 0xbc, 0x80, 0xe5, 0xa7, 0x8b, 0xe8, 0xae,
+    0xa1, 0xe7, 0xae, 0x97, 0x20, 0x30, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe4, 0xb8, 0x8d, 0xe8,
+    0xbf, 0x87, 0xe6, 0x9c, 0x9f, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x05, 0x05, 0x12,
+    0x03, 0x7f, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x05, 0x01, 0x12, 0x03, 0x7f,
+    0x08, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x05, 0x03, 0x12, 0x03, 0x7f, 0x17, 0x18,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x05, 0x08, 0x12, 0x03, 0x7f, 0x19, 0x3d, 0x0a, 0x10,
+    0x0a, 0x09, 0x04, 0x06, 0x02, 0x05, 0x08, 0x87, 0x09, 0x04, 0x05, 0x12, 0x03, 0x7f, 0x1a, 0x3c,
+    0x0a, 0x50, 0x0a, 0x04, 0x04, 0x06, 0x02, 0x06, 0x12, 0x04, 0x82, 0x01, 0x02, 0x21, 0x1a, 0x42,
+    0x20, 0xe4, 0xbe, 0x9d, 0xe8, 0xb5, 0x96, 0xe7, 0x9a, 0x84, 0xe4, 0xb8, 0x8a, 0xe6, 0xb8, 0xb8,
+    0xe8, 0x8a, 0x82, 0xe7, 0x82, 0xb9, 0xe5, 0x90, 0x8d, 0x20, 0xe6, 0x89, 0x80, 0xe6, 0x9c, 0x89,
+    0xe4, 0xb8, 0x8a, 0xe6, 0xb8, 0xb8, 0xe8, 0x8a, 0x82, 0xe7, 0x82, 0xb9, 0xe6, 0x88, 0x90, 0xe5,
+    0x8a, 0x9f, 0xe5, 0x90, 0x8e, 0xe6, 0x89, 0x8d, 0xe4, 0xbc, 0x9a, 0xe6, 0x94, 0xbe, 0xe8, 0xa1,
+    0x8c, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x06, 0x04, 0x12, 0x04, 0x82, 0x01, 0x02,
+    0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x06, 0x05, 0x12, 0x04, 0x82, 0x01, 0x0b, 0x11,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x06, 0x01, 0x12, 0x04, 0x82, 0x01, 0x12, 0x1c, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x06, 0x03, 0x12, 0x04, 0x82, 0x01, 0x1f, 0x20, 0x0a, 0x3c,
+    0x0a, 0x04, 0x04, 0x06, 0x02, 0x07, 0x12, 0x04, 0x85, 0x01, 0x02, 0x22, 0x1a, 0x2e, 0x20, 0x67,
+    0x52, 0x50, 0x43, 0x20, 0xe5, 0x9b, 0x9e, 0xe8, 0xb0, 0x83, 0xe7, 0x9b, 0xae, 0xe6, 0xa0, 0x87,
+    0x20, 0xe4, 0xb8, 0x8e, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x75, 0x72,
+    0x6c, 0x20, 0xe4, 0xba, 0x8c, 0xe9, 0x80, 0x89, 0xe4, 0xb8, 0x80, 0x0a, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x06, 0x02, 0x07, 0x05, 0x12, 0x04, 0x85, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x06, 0x02, 0x07, 0x01, 0x12, 0x04, 0x85, 0x01, 0x09, 0x1d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x06,
+    0x02, 0x07, 0x03, 0x12, 0x04, 0x85, 0x01, 0x20, 0x21, 0x0a, 0x4d, 0x0a, 0x04, 0x04, 0x06, 0x02,
+    0x08, 0x12, 0x04, 0x88, 0x01, 0x02, 0x2a, 0x1a, 0x3f, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+    0xe6, 0xa0, 0x87, 0xe7, 0xad, 0xbe, 0xe9, 0x80, 0x89, 0xe6, 0x8b, 0xa9, 0xe5, 0x99, 0xa8, 0x20,
+    0xe5, 0x8f, 0xaa, 0xe5, 0x88, 0x86, 0xe5, 0x8f, 0x91, 0xe7, 0xbb, 0x99, 0xe6, 0xa0, 0x87, 0xe7,
+    0xad, 0xbe, 0xe5, 0x85, 0xa8, 0xe9, 0x83, 0xa8, 0xe5, 0x8c, 0xb9, 0xe9, 0x85, 0x8d, 0xe7, 0x9a,
+    0x84, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x08,
+    0x06, 0x12, 0x04, 0x88, 0x01, 0x02, 0x15, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x08, 0x01,
+    0x12, 0x04, 0x88, 0x01, 0x16, 0x25, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x08, 0x03, 0x12,
+    0x04, 0x88, 0x01, 0x28, 0x29, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x07, 0x12, 0x06, 0x8b, 0x01, 0x00,
+    0x94, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x07, 0x01, 0x12, 0x04, 0x8b, 0x01, 0x08, 0x1f,
+    0x0a, 0x22, 0x0a, 0x04, 0x04, 0x07, 0x02, 0x00, 0x12, 0x04, 0x8d, 0x01, 0x02, 0x41, 0x1a, 0x14,
+    0x20, 0xe6, 0x89, 0x80, 0xe5, 0xb1, 0x9e, 0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0xa9, 0xba,
+    0xe9, 0x97, 0xb4, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x05, 0x12, 0x04, 0x8d,
+    0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x01, 0x12, 0x04, 0x8d, 0x01,
+    0x09, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x03, 0x12, 0x04, 0x8d, 0x01, 0x15,
+    0x16, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x08, 0x12, 0x04, 0x8d, 0x01, 0x17, 0x40,
+    0x0a, 0x11, 0x0a, 0x09, 0x04, 0x07, 0x02, 0x00, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x04, 0x8d,
+    0x01, 0x18, 0x3f, 0x0a, 0x2c, 0x0a, 0x04, 0x04, 0x07, 0x02, 0x01, 0x12, 0x04, 0x90, 0x01, 0x02,
+    0x50, 0x1a, 0x1e, 0x20, 0xe5, 0xb7, 0xa5, 0xe4, 0xbd, 0x9c, 0xe6, 0xb5, 0x81, 0xe8, 0x8a, 0x82,
+    0xe7, 0x82, 0xb9, 0x20, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe6, 0x9c, 0x89, 0xe7, 0x8e, 0xaf,
+    0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x01, 0x04, 0x12, 0x04, 0x90, 0x01, 0x02, 0x0a,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x01, 0x06, 0x12, 0x04, 0x90, 0x01, 0x0b, 0x17, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x01, 0x01, 0x12, 0x04, 0x90, 0x01, 0x18, 0x1d, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x07, 0x02, 0x01, 0x03, 0x12, 0x04, 0x90, 0x01, 0x20, 0x21, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x07, 0x02, 0x01, 0x08, 0x12, 0x04, 0x90, 0x01, 0x22, 0x4f, 0x0a, 0x11, 0x0a, 0x09,
+    0x04, 0x07, 0x02, 0x01, 0x08, 0x87, 0x09, 0x12, 0x01, 0x12, 0x04, 0x90, 0x01, 0x23, 0x4e, 0x0a,
+    0x3b, 0x0a, 0x04, 0x04, 0x07, 0x02, 0x02, 0x12, 0x04, 0x93, 0x01, 0x02, 0x45, 0x1a, 0x2d, 0x20,
+    0xe5, 0xb7, 0xa5, 0xe4, 0xbd, 0x9c, 0xe6, 0xb5, 0x81, 0xe5, 0x86, 0x85, 0xe6, 0x89, 0x80, 0xe6,
+    0x9c, 0x89, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe7, 0x9a, 0x84, 0xe4, 0xbc, 0x98, 0xe5, 0x85,
+    0x88, 0xe7, 0xba, 0xa7, 0x20, 0x5b, 0x30, 0x2c, 0x20, 0x39, 0x5d, 0x0a, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x07, 0x02, 0x02, 0x05, 0x12, 0x04, 0x93, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x07, 0x02, 0x02, 0x01, 0x12, 0x04, 0x93, 0x01, 0x08, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07,
+    0x02, 0x02, 0x03, 0x12, 0x04, 0x93, 0x01, 0x13, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02,
+    0x02, 0x08, 0x12, 0x04, 0x93, 0x01, 0x15, 0x44, 0x0a, 0x10, 0x0a, 0x08, 0x04, 0x07, 0x02, 0x02,
+    0x08, 0x87, 0x09, 0x03, 0x12, 0x04, 0x93, 0x01, 0x16, 0x43, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x07,
+    0x02, 0x02, 0x08, 0x87, 0x09, 0x03, 0x05, 0x12, 0x04, 0x93, 0x01, 0x34, 0x3a, 0x0a, 0x11, 0x0a,
+    0x09, 0x04, 0x07, 0x02, 0x02, 0x08, 0x87, 0x09, 0x03, 0x03, 0x12, 0x04, 0x93, 0x01, 0x3c, 0x42,
+    0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x08, 0x12, 0x06, 0x96, 0x01, 0x00, 0x9b, 0x01, 0x01, 0x0a, 0x0b,
+    0x0a, 0x03, 0x04, 0x08, 0x01, 0x12, 0x04, 0x96, 0x01, 0x08, 0x20, 0x0a, 0x0c, 0x0a, 0x04, 0x04,
+    0x08, 0x02, 0x00, 0x12, 0x04, 0x97, 0x01, 0x02, 0x19, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x08, 0x02,
+    0x00, 0x05, 0x12, 0x04, 0x97, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00,
+    0x01, 0x12, 0x04, 0x97, 0x01, 0x09, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x03,
+    0x12, 0x04, 0x97, 0x01, 0x17, 0x18, 0x0a, 0x25, 0x0a, 0x04, 0x04, 0x08, 0x02, 0x01, 0x12, 0x04,
+    0x9a, 0x01, 0x02, 0x23, 0x1a, 0x17, 0x20, 0xe8, 0x8a, 0x82, 0xe7, 0x82, 0xb9, 0xe5, 0x90, 0x8d,
+    0x20, 0x2d, 0x3e, 0x20, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0x69, 0x64, 0x0a, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x08, 0x02, 0x01, 0x06, 0x12, 0x04, 0x9a, 0x01, 0x02, 0x15, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x08, 0x02, 0x01, 0x01, 0x12, 0x04, 0x9a, 0x01, 0x16, 0x1e, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x08, 0x02, 0x01, 0x03, 0x12, 0x04, 0x9a, 0x01, 0x21, 0x22, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x09,
+    0x12, 0x06, 0x9d, 0x01, 0x00, 0x9f, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x09, 0x01, 0x12,
+    0x04, 0x9d, 0x01, 0x08, 0x1a, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x09, 0x02, 0x00, 0x12, 0x04, 0x9e,
+    0x01, 0x02, 0x43, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x00, 0x05, 0x12, 0x04, 0x9e, 0x01,
+    0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x00, 0x01, 0x12, 0x04, 0x9e, 0x01, 0x09,
+    0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x00, 0x03, 0x12, 0x04, 0x9e, 0x01, 0x17, 0x18,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x00, 0x08, 0x12, 0x04, 0x9e, 0x01, 0x19, 0x42, 0x0a,
+    0x11, 0x0a, 0x09, 0x04, 0x09, 0x02, 0x00, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x04, 0x9e, 0x01,
+    0x1a, 0x41, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x0a, 0x12, 0x06, 0xa1, 0x01, 0x00, 0xa9, 0x01, 0x01,
+    0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x0a, 0x01, 0x12, 0x04, 0xa1, 0x01, 0x08, 0x1b, 0x0a, 0x0c, 0x0a,
+    0x04, 0x04, 0x0a, 0x02, 0x00, 0x12, 0x04, 0xa2, 0x01, 0x02, 0x19, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x0a, 0x02, 0x00, 0x05, 0x12, 0x04, 0xa2, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0a,
+    0x02, 0x00, 0x01, 0x12, 0x04, 0xa2, 0x01, 0x09, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0a, 0x02,
+    0x00, 0x03, 0x12, 0x04, 0xa2, 0x01, 0x17, 0x18, 0x0a, 0x39, 0x0a, 0x04, 0x04, 0x0a, 0x02, 0x01,
+    0x12, 0x04, 0xa5, 0x01, 0x02, 0x13, 0x1a, 0x2b, 0x20, 0xe5, 0xb7, 0xa5, 0xe4, 0xbd, 0x9c, 0xe6,
+    0xb5, 0x81, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x20, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+    0x2c, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x2c, 0x20, 0x72, 0x75, 0x6e, 0x6e, 0x69,
+    0x6e, 0x67, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x01, 0x05, 0x12, 0x04, 0xa5, 0x01,
+    0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x01, 0x01, 0x12, 0x04, 0xa5, 0x01, 0x09,
+    0x0e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x01, 0x03, 0x12, 0x04, 0xa5, 0x01, 0x11, 0x12,
+    0x0a, 0x2b, 0x0a, 0x04, 0x04, 0x0a, 0x02, 0x02, 0x12, 0x04, 0xa8, 0x01, 0x02, 0x30, 0x1a, 0x1d,
+    0x20, 0xe5, 0xb7, 0xa5, 0xe4, 0xbd, 0x9c, 0xe6, 0xb5, 0x81, 0xe4, 0xb8, 0xad, 0xe7, 0x9a, 0x84,
+    0xe6, 0x89, 0x80, 0xe6, 0x9c, 0x89, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0x0a, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x0a, 0x02, 0x02, 0x04, 0x12, 0x04, 0xa8, 0x01, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x0a, 0x02, 0x02, 0x06, 0x12, 0x04, 0xa8, 0x01, 0x0b, 0x25, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x0a, 0x02, 0x02, 0x01, 0x12, 0x04, 0xa8, 0x01, 0x26, 0x2b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0a,
+    0x02, 0x02, 0x03, 0x12, 0x04, 0xa8, 0x01, 0x2e, 0x2f, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x0b, 0x12,
+    0x06, 0xab, 0x01, 0x00, 0xbe, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x0b, 0x01, 0x12, 0x04,
+    0xab, 0x01, 0x08, 0x18, 0x0a, 0x22, 0x0a, 0x04, 0x04, 0x0b, 0x02, 0x00, 0x12, 0x04, 0xad, 0x01,
+    0x02, 0x41, 0x1a, 0x14, 0x20, 0xe6, 0x89, 0x80, 0xe5, 0xb1, 0x9e, 0xe5, 0x91, 0xbd, 0xe5, 0x90,
+    0x8d, 0xe7, 0xa9, 0xba, 0xe9, 0x97, 0xb4, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x00,
+    0x05, 0x12, 0x04, 0xad, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x00, 0x01,
+    0x12, 0x04, 0xad, 0x01, 0x09, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x00, 0x03, 0x12,
+    0x04, 0xad, 0x01, 0x15, 0x16, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x00, 0x08, 0x12, 0x04,
+    0xad, 0x01, 0x17, 0x40, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x0b, 0x02, 0x00, 0x08, 0x87, 0x09, 0x0e,
+    0x02, 0x12, 0x04, 0xad, 0x01, 0x18, 0x3f, 0x0a, 0x2f, 0x0a, 0x04, 0x04, 0x0b, 0x02, 0x01, 0x12,
+    0x04, 0xb0, 0x01, 0x02, 0x17, 0x1a, 0x21, 0x20, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe7, 0xb1,
+    0xbb, 0xe5, 0x9e, 0x8b, 0x20, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe5, 0x88, 0x99, 0xe4, 0xb8,
+    0x8d, 0xe8, 0xbf, 0x87, 0xe6, 0xbb, 0xa4, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x01,
+    0x05, 0x12, 0x04, 0xb0, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x01, 0x01,
+    0x12, 0x04, 0xb0, 0x01, 0x09, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x01, 0x03, 0x12,
+    0x04, 0xb0, 0x01, 0x15, 0x16, 0x0a, 0x2f, 0x0a, 0x04, 0x04, 0x0b, 0x02, 0x02, 0x12, 0x04, 0xb3,
+    0x01, 0x02, 0x13, 0x1a, 0x21, 0x20, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe7, 0x8a, 0xb6, 0xe6,
+    0x80, 0x81, 0x20, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe5, 0x88, 0x99, 0xe4, 0xb8, 0x8d, 0xe8,
+    0xbf, 0x87, 0xe6, 0xbb, 0xa4, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x02, 0x05, 0x12,
+    0x04, 0xb3, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x02, 0x01, 0x12, 0x04,
+    0xb3, 0x01, 0x09, 0x0e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x02, 0x03, 0x12, 0x04, 0xb3,
+    0x01, 0x11, 0x12, 0x0a, 0x51, 0x0a, 0x04, 0x04, 0x0b, 0x02, 0x03, 0x12, 0x04, 0xb6, 0x01, 0x02,
+    0x2c, 0x1a, 0x43, 0x20, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4,
+    0xe8, 0x8c, 0x83, 0xe5, 0x9b, 0xb4, 0x20, 0x5b, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x74,
+    0x61, 0x72, 0x74, 0x2c, 0x20, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x29, 0x20,
+    0xe4, 0xb8, 0x8d, 0xe8, 0xae, 0xbe, 0xe7, 0xbd, 0xae, 0xe5, 0x88, 0x99, 0xe4, 0xb8, 0x8d, 0xe9,
+    0x99, 0x90, 0xe5, 0x88, 0xb6, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x03, 0x06, 0x12,
+    0x04, 0xb6, 0x01, 0x02, 0x1b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x03, 0x01, 0x12, 0x04,
+    0xb6, 0x01, 0x1c, 0x27, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x03, 0x03, 0x12, 0x04, 0xb6,
+    0x01, 0x2a, 0x2b, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x0b, 0x02, 0x04, 0x12, 0x04, 0xb7, 0x01, 0x02,
+    0x2a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x04, 0x06, 0x12, 0x04, 0xb7, 0x01, 0x02, 0x1b,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x04, 0x01, 0x12, 0x04, 0xb7, 0x01, 0x1c, 0x25, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x04, 0x03, 0x12, 0x04, 0xb7, 0x01, 0x28, 0x29, 0x0a, 0x54,
+    0x0a, 0x04, 0x04, 0x0b, 0x02, 0x05, 0x12, 0x04, 0xba, 0x01, 0x02, 0x14, 0x1a, 0x46, 0x20, 0xe5,
+    0x88, 0x86, 0xe9, 0xa1, 0xb5, 0xe6, 0xb8, 0xb8, 0xe6, 0xa0, 0x87, 0x20, 0xe9, 0xa6, 0x96, 0xe9,
+    0xa1, 0xb5, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0x20, 0xe5, 0x90, 0x8e, 0xe7, 0xbb, 0xad, 0xe4,
+    0xbc, 0xa0, 0xe5, 0x85, 0xa5, 0xe4, 0xb8, 0x8a, 0xe4, 0xb8, 0x80, 0xe9, 0xa1, 0xb5, 0xe8, 0xbf,
+    0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0x9a, 0x84, 0x20, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72,
+    0x73, 0x6f, 0x72, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x05, 0x05, 0x12, 0x04, 0xba,
+    0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x05, 0x01, 0x12, 0x04, 0xba, 0x01,
+    0x09, 0x0f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x05, 0x03, 0x12, 0x04, 0xba, 0x01, 0x12,
+    0x13, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x0b, 0x02, 0x06, 0x12, 0x04, 0xbd, 0x01, 0x02, 0x44, 0x1a,
+    0x0e, 0x20, 0xe6, 0xaf, 0x8f, 0xe9, 0xa1, 0xb5, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x0a, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x06, 0x05, 0x12, 0x04, 0xbd, 0x01, 0x02, 0x07, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x0b, 0x02, 0x06, 0x01, 0x12, 0x04, 0xbd, 0x01, 0x08, 0x0d, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x0b, 0x02, 0x06, 0x03, 0x12, 0x04, 0xbd, 0x01, 0x10, 0x11, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x0b, 0x02, 0x06, 0x08, 0x12, 0x04, 0xbd, 0x01, 0x12, 0x43, 0x0a, 0x10, 0x0a, 0x08, 0x04,
+    0x0b, 0x02, 0x06, 0x08, 0x87, 0x09, 0x03, 0x12, 0x04, 0xbd, 0x01, 0x13, 0x42, 0x0a, 0x11, 0x0a,
+    0x09, 0x04, 0x0b, 0x02, 0x06, 0x08, 0x87, 0x09, 0x03, 0x05, 0x12, 0x04, 0xbd, 0x01, 0x31, 0x37,
+    0x0a, 0x11, 0x0a, 0x09, 0x04, 0x0b, 0x02, 0x06, 0x08, 0x87, 0x09, 0x03, 0x03, 0x12, 0x04, 0xbd,
+    0x01, 0x39, 0x41, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x0c, 0x12, 0x06, 0xc0, 0x01, 0x00, 0xc7, 0x01,
+    0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x0c, 0x01, 0x12, 0x04, 0xc0, 0x01, 0x08, 0x19, 0x0a, 0x25,
+    0x0a, 0x04, 0x04, 0x0c, 0x02, 0x00, 0x12, 0x04, 0xc2, 0x01, 0x02, 0x30, 0x1a, 0x17, 0x20, 0xe6,
+    0x8c, 0x89, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe5, 0x80,
+    0x92, 0xe5, 0xba, 0x8f, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x00, 0x04, 0x12, 0x04,
+    0xc2, 0x01, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x00, 0x06, 0x12, 0x04, 0xc2,
+    0x01, 0x0b, 0x25, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x00, 0x01, 0x12, 0x04, 0xc2, 0x01,
+    0x26, 0x2b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x00, 0x03, 0x12, 0x04, 0xc2, 0x01, 0x2e,
+    0x2f, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x0c, 0x02, 0x01, 0x12, 0x04, 0xc4, 0x01, 0x02, 0x19, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x01, 0x05, 0x12, 0x04, 0xc4, 0x01, 0x02, 0x08, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x0c, 0x02, 0x01, 0x01, 0x12, 0x04, 0xc4, 0x01, 0x09, 0x14, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x0c, 0x02, 0x01, 0x03, 0x12, 0x04, 0xc4, 0x01, 0x17, 0x18, 0x0a, 0x0c, 0x0a, 0x04,
+    0x04, 0x0c, 0x02, 0x02, 0x12, 0x04, 0xc6, 0x01, 0x02, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0c,
+    0x02, 0x02, 0x05, 0x12, 0x04, 0xc6, 0x01, 0x02, 0x06, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0c, 0x02,
+    0x02, 0x01, 0x12, 0x04, 0xc6, 0x01, 0x07, 0x0f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x02,
+    0x03, 0x12, 0x04, 0xc6, 0x01, 0x12, 0x13, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x0d, 0x12, 0x06, 0xc9,
+    0x01, 0x00, 0xce, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x0d, 0x01, 0x12, 0x04, 0xc9, 0x01,
+    0x08, 0x18, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x0d, 0x02, 0x00, 0x12, 0x04, 0xca, 0x01, 0x02, 0x3f,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0d, 0x02, 0x00, 0x05, 0x12, 0x04, 0xca, 0x01, 0x02, 0x08, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x0d, 0x02, 0x00, 0x01, 0x12, 0x04, 0xca, 0x01, 0x09, 0x10, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x0d, 0x02, 0x00, 0x03, 0x12, 0x04, 0xca, 0x01, 0x13, 0x14, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x0d, 0x02, 0x00, 0x08, 0x12, 0x04, 0xca, 0x01, 0x15, 0x3e, 0x0a, 0x11, 0x0a, 0x09,
+    0x04, 0x0d, 0x02, 0x00, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x04, 0xca, 0x01, 0x16, 0x3d, 0x0a,
+    0x5f, 0x0a, 0x04, 0x04, 0x0d, 0x02, 0x01, 0x12, 0x04, 0xcd, 0x01, 0x02, 0x2c, 0x1a, 0x51, 0x20,
+    0xe9, 0x87, 0x8d, 0xe8, 0xaf, 0x95, 0xe5, 0x90, 0x8e, 0xe7, 0x9a, 0x84, 0xe8, 0xbf, 0x87, 0xe6,
+    0x9c, 0x9f, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x20, 0xe4, 0xb8, 0x8d, 0xe8, 0xae, 0xbe, 0xe7,
+    0xbd, 0xae, 0xe6, 0x97, 0xb6, 0xe5, 0xa6, 0x82, 0xe6, 0x9e, 0x9c, 0xe5, 0x8e, 0x9f, 0xe8, 0xbf,
+    0x87, 0xe6, 0x9c, 0x9f, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe5, 0xb7, 0xb2, 0xe8, 0xbf, 0x87,
+    0xe5, 0x88, 0x99, 0xe4, 0xb8, 0x8d, 0xe5, 0x86, 0x8d, 0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0x9f, 0x0a,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0d, 0x02, 0x01, 0x06, 0x12, 0x04, 0xcd, 0x01, 0x02, 0x1b, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x0d, 0x02, 0x01, 0x01, 0x12, 0x04, 0xcd, 0x01, 0x1c, 0x27, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x0d, 0x02, 0x01, 0x03, 0x12, 0x04, 0xcd, 0x01, 0x2a, 0x2b, 0x0a, 0x0c, 0x0a,
+    0x02, 0x04, 0x0e, 0x12, 0x06, 0xd0, 0x01, 0x00, 0xd1, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04,
+    0x0e, 0x01, 0x12, 0x04, 0xd0, 0x01, 0x08, 0x19, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x0f, 0x12, 0x06,
+    0xd3, 0x01, 0x00, 0xd5, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x0f, 0x01, 0x12, 0x04, 0xd3,
+    0x01, 0x08, 0x1d, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x0f, 0x02, 0x00, 0x12, 0x04, 0xd4, 0x01, 0x02,
+    0x60, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x00, 0x04, 0x12, 0x04, 0xd4, 0x01, 0x02, 0x0a,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x00, 0x05, 0x12, 0x04, 0xd4, 0x01, 0x0b, 0x11, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x00, 0x01, 0x12, 0x04, 0xd4, 0x01, 0x12, 0x1a, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x0f, 0x02, 0x00, 0x03, 0x12, 0x04, 0xd4, 0x01, 0x1d, 0x1e, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x0f, 0x02, 0x00, 0x08, 0x12, 0x04, 0xd4, 0x01, 0x1f, 0x5f, 0x0a, 0x10, 0x0a, 0x08,
+    0x04, 0x0f, 0x02, 0x00, 0x08, 0x87, 0x09, 0x12, 0x12, 0x04, 0xd4, 0x01, 0x20, 0x5e, 0x0a, 0x11,
+    0x0a, 0x09, 0x04, 0x0f, 0x02, 0x00, 0x08, 0x87, 0x09, 0x12, 0x01, 0x12, 0x04, 0xd4, 0x01, 0x41,
+    0x4d, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x0f, 0x02, 0x00, 0x08, 0x87, 0x09, 0x12, 0x02, 0x12, 0x04,
+    0xd4, 0x01, 0x4f, 0x5d, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x10, 0x12, 0x06, 0xd7, 0x01, 0x00, 0xdd,
+    0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x10, 0x01, 0x12, 0x04, 0xd7, 0x01, 0x08, 0x1e, 0x0a,
+    0x27, 0x0a, 0x04, 0x04, 0x10, 0x02, 0x00, 0x12, 0x04, 0xd9, 0x01, 0x02, 0x27, 0x1a, 0x19, 0x20,
+    0xe6, 0x88, 0x90, 0xe5, 0x8a, 0x9f, 0xe7, 0xbb, 0x88, 0xe6, 0xad, 0xa2, 0xe7, 0x9a, 0x84, 0xe4,
+    0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0x69, 0x64, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x00,
+    0x04, 0x12, 0x04, 0xd9, 0x01, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x00, 0x05,
+    0x12, 0x04, 0xd9, 0x01, 0x0b, 0x11, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x00, 0x01, 0x12,
+    0x04, 0xd9, 0x01, 0x12, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x00, 0x03, 0x12, 0x04,
+    0xd9, 0x01, 0x25, 0x26, 0x0a, 0x3e, 0x0a, 0x04, 0x04, 0x10, 0x02, 0x01, 0x12, 0x04, 0xdc, 0x01,
+    0x02, 0x2a, 0x1a, 0x30, 0x20, 0xe7, 0xbb, 0x88, 0xe6, 0xad, 0xa2, 0xe5, 0xa4, 0xb1, 0xe8, 0xb4,
+    0xa5, 0xe7, 0x9a, 0x84, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0x20, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a,
+    0xa1, 0x69, 0x64, 0x20, 0x2d, 0x3e, 0x20, 0xe5, 0xa4, 0xb1, 0xe8, 0xb4, 0xa5, 0xe5, 0x8e, 0x9f,
+    0xe5, 0x9b, 0xa0, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x01, 0x06, 0x12, 0x04, 0xdc,
+    0x01, 0x02, 0x15, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x01, 0x01, 0x12, 0x04, 0xdc, 0x01,
+    0x16, 0x25, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x01, 0x03, 0x12, 0x04, 0xdc, 0x01, 0x28,
+    0x29, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x11, 0x12, 0x06, 0xdf, 0x01, 0x00, 0xe1, 0x01, 0x01, 0x0a,
+    0x0b, 0x0a, 0x03, 0x04, 0x11, 0x01, 0x12, 0x04, 0xdf, 0x01, 0x08, 0x1d, 0x0a, 0x0c, 0x0a, 0x04,
+    0x04, 0x11, 0x02, 0x00, 0x12, 0x04, 0xe0, 0x01, 0x02, 0x3f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x11,
+    0x02, 0x00, 0x05, 0x12, 0x04, 0xe0, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x11, 0x02,
+    0x00, 0x01, 0x12, 0x04, 0xe0, 0x01, 0x09, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x11, 0x02, 0x00,
+    0x03, 0x12, 0x04, 0xe0, 0x01, 0x13, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x11, 0x02, 0x00, 0x08,
+    0x12, 0x04, 0xe0, 0x01, 0x15, 0x3e, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x11, 0x02, 0x00, 0x08, 0x87,
+    0x09, 0x0e, 0x02, 0x12, 0x04, 0xe0, 0x01, 0x16, 0x3d, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x12, 0x12,
+    0x06, 0xe3, 0x01, 0x00, 0xe6, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x12, 0x01, 0x12, 0x04,
+    0xe3, 0x01, 0x08, 0x1e, 0x0a, 0x1f, 0x0a, 0x04, 0x04, 0x12, 0x02, 0x00, 0x12, 0x04, 0xe5, 0x01,
+    0x02, 0x3b, 0x1a, 0x11, 0x20, 0xe6, 0x8c, 0x89, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe6, 0xad,
+    0xa3, 0xe5, 0xba, 0x8f, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x12, 0x02, 0x00, 0x04, 0x12, 0x04,
+    0xe5, 0x01, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x12, 0x02, 0x00, 0x06, 0x12, 0x04, 0xe5,
+    0x01, 0x0b, 0x2c, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x12, 0x02, 0x00, 0x01, 0x12, 0x04, 0xe5, 0x01,
+    0x2d, 0x36, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x12, 0x02, 0x00, 0x03, 0x12, 0x04, 0xe5, 0x01, 0x39,
+    0x3a, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x13, 0x12, 0x06, 0xe8, 0x01, 0x00, 0xea, 0x01, 0x01, 0x0a,
+    0x0b, 0x0a, 0x03, 0x04, 0x13, 0x01, 0x12, 0x04, 0xe8, 0x01, 0x08, 0x1f, 0x0a, 0x0c, 0x0a, 0x04,
+    0x04, 0x13, 0x02, 0x00, 0x12, 0x04, 0xe9, 0x01, 0x02, 0x3f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x13,
+    0x02, 0x00, 0x05, 0x12, 0x04, 0xe9, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x13, 0x02,
+    0x00, 0x01, 0x12, 0x04, 0xe9, 0x01, 0x09, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x13, 0x02, 0x00,
+    0x03, 0x12, 0x04, 0xe9, 0x01, 0x13, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x13, 0x02, 0x00, 0x08,
+    0x12, 0x04, 0xe9, 0x01, 0x15, 0x3e, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x13, 0x02, 0x00, 0x08, 0x87,
+    0x09, 0x0e, 0x02, 0x12, 0x04, 0xe9, 0x01, 0x16, 0x3d, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x14, 0x12,
+    0x06, 0xec, 0x01, 0x00, 0xee, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x14, 0x01, 0x12, 0x04,
+    0xec, 0x01, 0x08, 0x20, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x14, 0x02, 0x00, 0x12, 0x04, 0xed, 0x01,
+    0x02, 0x3c, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x14, 0x02, 0x00, 0x04, 0x12, 0x04, 0xed, 0x01, 0x02,
+    0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x14, 0x02, 0x00, 0x06, 0x12, 0x04, 0xed, 0x01, 0x0b, 0x2d,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x14, 0x02, 0x00, 0x01, 0x12, 0x04, 0xed, 0x01, 0x2e, 0x37, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x14, 0x02, 0x00, 0x03, 0x12, 0x04, 0xed, 0x01, 0x3a, 0x3b, 0x0a, 0x0c,
+    0x0a, 0x02, 0x04, 0x15, 0x12, 0x06, 0xf0, 0x01, 0x00, 0xf2, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03,
+    0x04, 0x15, 0x01, 0x12, 0x04, 0xf0, 0x01, 0x08, 0x18, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x15, 0x02,
+    0x00, 0x12, 0x04, 0xf1, 0x01, 0x02, 0x3f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x15, 0x02, 0x00, 0x05,
+    0x12, 0x04, 0xf1, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x15, 0x02, 0x00, 0x01, 0x12,
+    0x04, 0xf1, 0x01, 0x09, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x15, 0x02, 0x00, 0x03, 0x12, 0x04,
+    0xf1, 0x01, 0x13, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x15, 0x02, 0x00, 0x08, 0x12, 0x04, 0xf1,
+    0x01, 0x15, 0x3e, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x15, 0x02, 0x00, 0x08, 0x87, 0x09, 0x0e, 0x02,
+    0x12, 0x04, 0xf1, 0x01, 0x16, 0x3d, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x16, 0x12, 0x06, 0xf4, 0x01,
+    0x00, 0xf9, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x16, 0x01, 0x12, 0x04, 0xf4, 0x01, 0x08,
+    0x1d, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x16, 0x02, 0x00, 0x12, 0x04, 0xf5, 0x01, 0x02, 0x41, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x16, 0x02, 0x00, 0x05, 0x12, 0x04, 0xf5, 0x01, 0x02, 0x08, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x16, 0x02, 0x00, 0x01, 0x12, 0x04, 0xf5, 0x01, 0x09, 0x12, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x16, 0x02, 0x00, 0x03, 0x12, 0x04, 0xf5, 0x01, 0x15, 0x16, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x16, 0x02, 0x00, 0x08, 0x12, 0x04, 0xf5, 0x01, 0x17, 0x40, 0x0a, 0x11, 0x0a, 0x09, 0x04,
+    0x16, 0x02, 0x00, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x04, 0xf5, 0x01, 0x18, 0x3f, 0x0a, 0x44,
+    0x0a, 0x04, 0x04, 0x16, 0x02, 0x01, 0x12, 0x04, 0xf8, 0x01, 0x02, 0x21, 0x1a, 0x36, 0x20, 0xe5,
+    0x8f, 0xaa, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe6, 0x8c, 0x87, 0xe5, 0xae, 0x9a, 0xe7, 0xb1,
+    0xbb, 0xe5, 0x9e, 0x8b, 0xe7, 0x9a, 0x84, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0x20, 0xe4, 0xb8,
+    0xba, 0xe7, 0xa9, 0xba, 0xe5, 0x88, 0x99, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe5, 0x85, 0xa8,
+    0xe9, 0x83, 0xa8, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x16, 0x02, 0x01, 0x04, 0x12, 0x04, 0xf8,
+    0x01, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x16, 0x02, 0x01, 0x05, 0x12, 0x04, 0xf8, 0x01,
+    0x0b, 0x11, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x16, 0x02, 0x01, 0x01, 0x12, 0x04, 0xf8, 0x01, 0x12,
+    0x1c, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x16, 0x02, 0x01, 0x03, 0x12, 0x04, 0xf8, 0x01, 0x1f, 0x20,
+    0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 ];
 include!("conductor.api.taskservice.v1.tonic.rs");
 // @@protoc_insertion_point(module)
\ No newline at end of file
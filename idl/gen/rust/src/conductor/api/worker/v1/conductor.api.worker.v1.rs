@@ -13,6 +13,12 @@ pub struct Worker {
     pub metadata: ::core::option::Option<WorkerMetadata>,
     #[prost(enumeration = "WorkerState", tag = "4")]
     pub state: i32,
+    /// worker标签 如 ffmpeg=av1 cpu=16 任务可以按标签选择worker
+    #[prost(map = "string, string", tag = "5")]
+    pub labels: ::std::collections::HashMap<::prost::alloc::string::String, ::prost::alloc::string::String>,
+    /// worker同时处理的任务数上限
+    #[prost(int32, tag = "6")]
+    pub concurrency: i32,
 }
 #[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
 pub struct WorkerAbility {
@@ -35,6 +41,36 @@ pub struct WorkerMetadata {
     #[prost(float, tag = "3")]
     pub mem_usage: f32,
 }
+/// 已注册的worker信息
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct WorkerInfo {
+    /// worker id
+    #[prost(string, tag = "1")]
+    pub id: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub ip: ::prost::alloc::string::String,
+    /// worker提供的服务类型名
+    #[prost(string, repeated, tag = "3")]
+    pub task_types: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    /// worker标签
+    #[prost(map = "string, string", tag = "4")]
+    pub labels: ::std::collections::HashMap<::prost::alloc::string::String, ::prost::alloc::string::String>,
+    /// worker同时处理的任务数上限
+    #[prost(int32, tag = "5")]
+    pub concurrency: i32,
+    /// 注册时间 unix ms
+    #[prost(int64, tag = "6")]
+    pub register_time: i64,
+    /// 最近一次心跳时间 unix ms
+    #[prost(int64, tag = "7")]
+    pub last_heartbeat: i64,
+    /// 是否在线 心跳超时则视为离线
+    #[prost(bool, tag = "8")]
+    pub alive: bool,
+    /// 当前分配给该worker的任务id（已分发和执行中）
+    #[prost(string, repeated, tag = "9")]
+    pub running_task_ids: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+}
 #[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
 #[repr(i32)]
 pub enum WorkerState {
@@ -69,14 +105,14 @@ impl WorkerState {
 }
 /// Encoded file descriptor set for the `conductor.api.worker.v1` package
 pub const FILE_DESCRIPTOR_SET: &[u8] = &[
-    0x0a, 0xfa, 0x0e, 0x0a, 0x24, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x61,
+    0x0a, 0xca, 0x1b, 0x0a, 0x24, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x61,
     0x70, 0x69, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72,
     0x6b, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x63, 0x6f, 0x6e, 0x64, 0x75,
     0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2e,
     0x76, 0x31, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
     0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
     0x18, 0x65, 0x78, 0x74, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x6f, 0x70, 0x74,
-    0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdb, 0x01, 0x0a, 0x06, 0x57, 0x6f,
+    0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfd, 0x02, 0x0a, 0x06, 0x57, 0x6f,
     0x72, 0x6b, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
     0x52, 0x02, 0x69, 0x64, 0x12, 0x40, 0x0a, 0x07, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18,
     0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f,
@@ -90,104 +126,205 @@ pub const FILE_DESCRIPTOR_SET: &[u8] = &[
     0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6e,
     0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x65,
     0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
-    0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x57, 0x0a, 0x0d, 0x57, 0x6f, 0x72, 0x6b, 0x65,
-    0x72, 0x41, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x73, 0x6b,
-    0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x73,
-    0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x64, 0x65,
-    0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-    0x0f, 0x74, 0x61, 0x73, 0x6b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-    0x22, 0x5a, 0x0a, 0x0e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-    0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-    0x69, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x70, 0x75, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18,
-    0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x63, 0x70, 0x75, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12,
-    0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
-    0x28, 0x02, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x55, 0x73, 0x61, 0x67, 0x65, 0x2a, 0x6e, 0x0a, 0x0b,
-    0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x57,
-    0x4f, 0x52, 0x4b, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e,
-    0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x5f,
-    0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x01, 0x12, 0x15, 0x0a,
-    0x11, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x55,
-    0x53, 0x59, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x5f, 0x53,
-    0x54, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x42, 0xf4, 0x01, 0x0a,
-    0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61,
-    0x70, 0x69, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x57, 0x6f,
-    0x72, 0x6b, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x49, 0x67, 0x69, 0x74,
-    0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x79, 0x61, 0x6e, 0x72, 0x65, 0x61, 0x64,
-    0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x77, 0x68, 0x69, 0x6d, 0x65, 0x72, 0x2f, 0x69, 0x64, 0x6c,
-    0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f,
-    0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x3b,
-    0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0xa2, 0x02, 0x03, 0x43, 0x41, 0x57, 0xaa, 0x02, 0x17, 0x43,
-    0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x41, 0x70, 0x69, 0x2e, 0x57, 0x6f, 0x72,
-    0x6b, 0x65, 0x72, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x17, 0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74,
-    0x6f, 0x72, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5c, 0x56, 0x31,
-    0xe2, 0x02, 0x23, 0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x5c, 0x41, 0x70, 0x69,
-    0x5c, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-    0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1a, 0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74,
-    0x6f, 0x72, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a, 0x3a, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x3a,
-    0x3a, 0x56, 0x31, 0x4a, 0xff, 0x07, 0x0a, 0x06, 0x12, 0x04, 0x00, 0x00, 0x2e, 0x01, 0x0a, 0x08,
-    0x0a, 0x01, 0x0c, 0x12, 0x03, 0x00, 0x00, 0x12, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x00, 0x12, 0x03,
-    0x02, 0x00, 0x25, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x01, 0x12, 0x03, 0x03, 0x00, 0x22, 0x0a, 0x08,
-    0x0a, 0x01, 0x02, 0x12, 0x03, 0x05, 0x00, 0x20, 0x0a, 0x08, 0x0a, 0x01, 0x08, 0x12, 0x03, 0x07,
-    0x00, 0x60, 0x0a, 0x09, 0x0a, 0x02, 0x08, 0x0b, 0x12, 0x03, 0x07, 0x00, 0x60, 0x0a, 0x0a, 0x0a,
-    0x02, 0x05, 0x00, 0x12, 0x04, 0x09, 0x00, 0x0e, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x05, 0x00, 0x01,
-    0x12, 0x03, 0x09, 0x05, 0x10, 0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x00, 0x02, 0x00, 0x12, 0x03, 0x0a,
-    0x02, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x0a, 0x02, 0x16,
-    0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x00, 0x02, 0x12, 0x03, 0x0a, 0x19, 0x1a, 0x0a, 0x0b,
-    0x0a, 0x04, 0x05, 0x00, 0x02, 0x01, 0x12, 0x03, 0x0b, 0x02, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x05,
-    0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x0b, 0x02, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02,
-    0x01, 0x02, 0x12, 0x03, 0x0b, 0x19, 0x1a, 0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x00, 0x02, 0x02, 0x12,
-    0x03, 0x0c, 0x02, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x02, 0x01, 0x12, 0x03, 0x0c,
-    0x02, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x02, 0x02, 0x12, 0x03, 0x0c, 0x19, 0x1a,
-    0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x00, 0x02, 0x03, 0x12, 0x03, 0x0d, 0x02, 0x1b, 0x0a, 0x0c, 0x0a,
-    0x05, 0x05, 0x00, 0x02, 0x03, 0x01, 0x12, 0x03, 0x0d, 0x02, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x05,
-    0x00, 0x02, 0x03, 0x02, 0x12, 0x03, 0x0d, 0x19, 0x1a, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x00, 0x12,
-    0x04, 0x10, 0x00, 0x1b, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x00, 0x01, 0x12, 0x03, 0x10, 0x08,
-    0x0e, 0x0a, 0x1f, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x00, 0x12, 0x03, 0x12, 0x02, 0x10, 0x1a, 0x12,
-    0x20, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x20, 0x69, 0x64, 0x20, 0xe5, 0x8f, 0xaf, 0xe9, 0x80,
-    0x89, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x05, 0x12, 0x03, 0x12, 0x02, 0x08,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x12, 0x09, 0x0b, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x12, 0x0e, 0x0f, 0x0a, 0x21, 0x0a, 0x04,
-    0x04, 0x00, 0x02, 0x01, 0x12, 0x03, 0x15, 0x02, 0x1c, 0x1a, 0x14, 0x20, 0x77, 0x6f, 0x72, 0x6b,
-    0x65, 0x72, 0xe8, 0x83, 0xbd, 0xe5, 0x8a, 0x9b, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x0a, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x06, 0x12, 0x03, 0x15, 0x02, 0x0f, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x15, 0x10, 0x17, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x00, 0x02, 0x01, 0x03, 0x12, 0x03, 0x15, 0x1a, 0x1b, 0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x00, 0x02,
-    0x02, 0x12, 0x03, 0x18, 0x02, 0x1e, 0x1a, 0x0e, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0xe7,
-    0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x06, 0x12,
-    0x03, 0x18, 0x02, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x01, 0x12, 0x03, 0x18,
-    0x11, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x03, 0x12, 0x03, 0x18, 0x1c, 0x1d,
-    0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x03, 0x12, 0x03, 0x1a, 0x02, 0x18, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x00, 0x02, 0x03, 0x06, 0x12, 0x03, 0x1a, 0x02, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x00, 0x02, 0x03, 0x01, 0x12, 0x03, 0x1a, 0x0e, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02,
-    0x03, 0x03, 0x12, 0x03, 0x1a, 0x16, 0x17, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x01, 0x12, 0x04, 0x1d,
-    0x00, 0x23, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x01, 0x01, 0x12, 0x03, 0x1d, 0x08, 0x15, 0x0a,
-    0x2d, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x00, 0x12, 0x03, 0x1f, 0x02, 0x17, 0x1a, 0x20, 0x20, 0x77,
+    0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+    0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63,
+    0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2e, 0x76,
+    0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45,
+    0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+    0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+    0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x1a, 0x39,
+    0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+    0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+    0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+    0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x57, 0x0a, 0x0d, 0x57, 0x6f, 0x72,
+    0x6b, 0x65, 0x72, 0x41, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61,
+    0x73, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+    0x61, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x61, 0x73, 0x6b, 0x5f,
+    0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+    0x09, 0x52, 0x0f, 0x74, 0x61, 0x73, 0x6b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+    0x6f, 0x6e, 0x22, 0x5a, 0x0a, 0x0e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61,
+    0x64, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+    0x52, 0x02, 0x69, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x70, 0x75, 0x5f, 0x75, 0x73, 0x61, 0x67,
+    0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x63, 0x70, 0x75, 0x55, 0x73, 0x61, 0x67,
+    0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03,
+    0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x55, 0x73, 0x61, 0x67, 0x65, 0x22, 0xfd,
+    0x02, 0x0a, 0x0a, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a,
+    0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x0e, 0x0a,
+    0x02, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x1d, 0x0a,
+    0x0a, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+    0x09, 0x52, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x06,
+    0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x63,
+    0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x77, 0x6f, 0x72,
+    0x6b, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x6e, 0x66,
+    0x6f, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c,
+    0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72,
+    0x65, 0x6e, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x63,
+    0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x67, 0x69, 0x73,
+    0x74, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+    0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+    0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x18, 0x07,
+    0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62,
+    0x65, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x08, 0x20, 0x01,
+    0x28, 0x08, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x75, 0x6e,
+    0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x09, 0x20,
+    0x03, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b,
+    0x49, 0x64, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74,
+    0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+    0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+    0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x2a, 0x6e,
+    0x0a, 0x0b, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a,
+    0x14, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e,
+    0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x57, 0x4f, 0x52, 0x4b, 0x45,
+    0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x01, 0x12,
+    0x15, 0x0a, 0x11, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+    0x42, 0x55, 0x53, 0x59, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52,
+    0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x42, 0xf4,
+    0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x0b,
+    0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x49, 0x67,
+    0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x79, 0x61, 0x6e, 0x72, 0x65,
+    0x61, 0x64, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x77, 0x68, 0x69, 0x6d, 0x65, 0x72, 0x2f, 0x69,
+    0x64, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63,
+    0x74, 0x6f, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2f, 0x76,
+    0x31, 0x3b, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0xa2, 0x02, 0x03, 0x43, 0x41, 0x57, 0xaa, 0x02,
+    0x17, 0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x41, 0x70, 0x69, 0x2e, 0x57,
+    0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x17, 0x43, 0x6f, 0x6e, 0x64, 0x75,
+    0x63, 0x74, 0x6f, 0x72, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5c,
+    0x56, 0x31, 0xe2, 0x02, 0x23, 0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x5c, 0x41,
+    0x70, 0x69, 0x5c, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
+    0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1a, 0x43, 0x6f, 0x6e, 0x64, 0x75,
+    0x63, 0x74, 0x6f, 0x72, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a, 0x3a, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+    0x72, 0x3a, 0x3a, 0x56, 0x31, 0x4a, 0xad, 0x10, 0x0a, 0x06, 0x12, 0x04, 0x00, 0x00, 0x51, 0x01,
+    0x0a, 0x08, 0x0a, 0x01, 0x0c, 0x12, 0x03, 0x00, 0x00, 0x12, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x00,
+    0x12, 0x03, 0x02, 0x00, 0x25, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x01, 0x12, 0x03, 0x03, 0x00, 0x22,
+    0x0a, 0x08, 0x0a, 0x01, 0x02, 0x12, 0x03, 0x05, 0x00, 0x20, 0x0a, 0x08, 0x0a, 0x01, 0x08, 0x12,
+    0x03, 0x07, 0x00, 0x60, 0x0a, 0x09, 0x0a, 0x02, 0x08, 0x0b, 0x12, 0x03, 0x07, 0x00, 0x60, 0x0a,
+    0x0a, 0x0a, 0x02, 0x05, 0x00, 0x12, 0x04, 0x09, 0x00, 0x0e, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x05,
+    0x00, 0x01, 0x12, 0x03, 0x09, 0x05, 0x10, 0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x00, 0x02, 0x00, 0x12,
+    0x03, 0x0a, 0x02, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x0a,
+    0x02, 0x16, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x00, 0x02, 0x12, 0x03, 0x0a, 0x19, 0x1a,
+    0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x00, 0x02, 0x01, 0x12, 0x03, 0x0b, 0x02, 0x1b, 0x0a, 0x0c, 0x0a,
+    0x05, 0x05, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x0b, 0x02, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x05,
+    0x00, 0x02, 0x01, 0x02, 0x12, 0x03, 0x0b, 0x19, 0x1a, 0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x00, 0x02,
+    0x02, 0x12, 0x03, 0x0c, 0x02, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x02, 0x01, 0x12,
+    0x03, 0x0c, 0x02, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x02, 0x02, 0x12, 0x03, 0x0c,
+    0x19, 0x1a, 0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x00, 0x02, 0x03, 0x12, 0x03, 0x0d, 0x02, 0x1b, 0x0a,
+    0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x03, 0x01, 0x12, 0x03, 0x0d, 0x02, 0x14, 0x0a, 0x0c, 0x0a,
+    0x05, 0x05, 0x00, 0x02, 0x03, 0x02, 0x12, 0x03, 0x0d, 0x19, 0x1a, 0x0a, 0x0a, 0x0a, 0x02, 0x04,
+    0x00, 0x12, 0x04, 0x10, 0x00, 0x21, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x00, 0x01, 0x12, 0x03,
+    0x10, 0x08, 0x0e, 0x0a, 0x1f, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x00, 0x12, 0x03, 0x12, 0x02, 0x10,
+    0x1a, 0x12, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x20, 0x69, 0x64, 0x20, 0xe5, 0x8f, 0xaf,
+    0xe9, 0x80, 0x89, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x05, 0x12, 0x03, 0x12,
+    0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x12, 0x09, 0x0b,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x12, 0x0e, 0x0f, 0x0a, 0x21,
+    0x0a, 0x04, 0x04, 0x00, 0x02, 0x01, 0x12, 0x03, 0x15, 0x02, 0x1c, 0x1a, 0x14, 0x20, 0x77, 0x6f,
+    0x72, 0x6b, 0x65, 0x72, 0xe8, 0x83, 0xbd, 0xe5, 0x8a, 0x9b, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0,
+    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x06, 0x12, 0x03, 0x15, 0x02, 0x0f, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x15, 0x10, 0x17, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x00, 0x02, 0x01, 0x03, 0x12, 0x03, 0x15, 0x1a, 0x1b, 0x0a, 0x1b, 0x0a, 0x04, 0x04,
+    0x00, 0x02, 0x02, 0x12, 0x03, 0x18, 0x02, 0x1e, 0x1a, 0x0e, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x65,
+    0x72, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02,
+    0x06, 0x12, 0x03, 0x18, 0x02, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x01, 0x12,
+    0x03, 0x18, 0x11, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x03, 0x12, 0x03, 0x18,
+    0x1c, 0x1d, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x03, 0x12, 0x03, 0x1a, 0x02, 0x18, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x03, 0x06, 0x12, 0x03, 0x1a, 0x02, 0x0d, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x00, 0x02, 0x03, 0x01, 0x12, 0x03, 0x1a, 0x0e, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x00, 0x02, 0x03, 0x03, 0x12, 0x03, 0x1a, 0x16, 0x17, 0x0a, 0x53, 0x0a, 0x04, 0x04, 0x00, 0x02,
+    0x04, 0x12, 0x03, 0x1d, 0x02, 0x21, 0x1a, 0x46, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0xe6,
+    0xa0, 0x87, 0xe7, 0xad, 0xbe, 0x20, 0xe5, 0xa6, 0x82, 0x20, 0x66, 0x66, 0x6d, 0x70, 0x65, 0x67,
+    0x3d, 0x61, 0x76, 0x31, 0x20, 0x63, 0x70, 0x75, 0x3d, 0x31, 0x36, 0x20, 0xe4, 0xbb, 0xbb, 0xe5,
+    0x8a, 0xa1, 0xe5, 0x8f, 0xaf, 0xe4, 0xbb, 0xa5, 0xe6, 0x8c, 0x89, 0xe6, 0xa0, 0x87, 0xe7, 0xad,
+    0xbe, 0xe9, 0x80, 0x89, 0xe6, 0x8b, 0xa9, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x0a, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x00, 0x02, 0x04, 0x06, 0x12, 0x03, 0x1d, 0x02, 0x15, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x00, 0x02, 0x04, 0x01, 0x12, 0x03, 0x1d, 0x16, 0x1c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00,
+    0x02, 0x04, 0x03, 0x12, 0x03, 0x1d, 0x1f, 0x20, 0x0a, 0x33, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x05,
+    0x12, 0x03, 0x20, 0x02, 0x18, 0x1a, 0x26, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0xe5, 0x90,
+    0x8c, 0xe6, 0x97, 0xb6, 0xe5, 0xa4, 0x84, 0xe7, 0x90, 0x86, 0xe7, 0x9a, 0x84, 0xe4, 0xbb, 0xbb,
+    0xe5, 0x8a, 0xa1, 0xe6, 0x95, 0xb0, 0xe4, 0xb8, 0x8a, 0xe9, 0x99, 0x90, 0x0a, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x00, 0x02, 0x05, 0x05, 0x12, 0x03, 0x20, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x00, 0x02, 0x05, 0x01, 0x12, 0x03, 0x20, 0x08, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02,
+    0x05, 0x03, 0x12, 0x03, 0x20, 0x16, 0x17, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x01, 0x12, 0x04, 0x23,
+    0x00, 0x29, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x01, 0x01, 0x12, 0x03, 0x23, 0x08, 0x15, 0x0a,
+    0x2d, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x00, 0x12, 0x03, 0x25, 0x02, 0x17, 0x1a, 0x20, 0x20, 0x77,
     0x6f, 0x72, 0x6b, 0x65, 0x72, 0xe6, 0x8f, 0x90, 0xe4, 0xbe, 0x9b, 0xe7, 0x9a, 0x84, 0xe6, 0x9c,
     0x8d, 0xe5, 0x8a, 0xa1, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xe5, 0x90, 0x8d, 0x0a, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x05, 0x12, 0x03, 0x1f, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x01, 0x02, 0x00, 0x01, 0x12, 0x03, 0x1f, 0x09, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01,
-    0x02, 0x00, 0x03, 0x12, 0x03, 0x1f, 0x15, 0x16, 0x0a, 0x31, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x01,
-    0x12, 0x03, 0x22, 0x02, 0x1e, 0x1a, 0x24, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0xe6, 0x8f,
+    0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x05, 0x12, 0x03, 0x25, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x01, 0x02, 0x00, 0x01, 0x12, 0x03, 0x25, 0x09, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01,
+    0x02, 0x00, 0x03, 0x12, 0x03, 0x25, 0x15, 0x16, 0x0a, 0x31, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x01,
+    0x12, 0x03, 0x28, 0x02, 0x1e, 0x1a, 0x24, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0xe6, 0x8f,
     0x90, 0xe4, 0xbe, 0x9b, 0xe7, 0x9a, 0x84, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe6, 0x8f, 0x8f,
     0xe8, 0xbf, 0xb0, 0x20, 0xe5, 0x8f, 0xaf, 0xe9, 0x80, 0x89, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x01, 0x02, 0x01, 0x05, 0x12, 0x03, 0x22, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02,
-    0x01, 0x01, 0x12, 0x03, 0x22, 0x09, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x03,
-    0x12, 0x03, 0x22, 0x1c, 0x1d, 0x0a, 0x1e, 0x0a, 0x02, 0x04, 0x02, 0x12, 0x04, 0x26, 0x00, 0x2e,
+    0x01, 0x02, 0x01, 0x05, 0x12, 0x03, 0x28, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02,
+    0x01, 0x01, 0x12, 0x03, 0x28, 0x09, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x03,
+    0x12, 0x03, 0x28, 0x1c, 0x1d, 0x0a, 0x1e, 0x0a, 0x02, 0x04, 0x02, 0x12, 0x04, 0x2c, 0x00, 0x34,
     0x01, 0x1a, 0x12, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x20, 0xe5, 0x85, 0x83, 0xe6, 0x95,
-    0xb0, 0xe6, 0x8d, 0xae, 0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x02, 0x01, 0x12, 0x03, 0x26, 0x08,
-    0x16, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x00, 0x12, 0x03, 0x27, 0x02, 0x10, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x05, 0x12, 0x03, 0x27, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x02, 0x02, 0x00, 0x01, 0x12, 0x03, 0x27, 0x09, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02,
-    0x02, 0x00, 0x03, 0x12, 0x03, 0x27, 0x0e, 0x0f, 0x0a, 0x21, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x01,
-    0x12, 0x03, 0x2a, 0x02, 0x16, 0x1a, 0x14, 0x20, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0x63, 0x70,
+    0xb0, 0xe6, 0x8d, 0xae, 0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x02, 0x01, 0x12, 0x03, 0x2c, 0x08,
+    0x16, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x00, 0x12, 0x03, 0x2d, 0x02, 0x10, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x05, 0x12, 0x03, 0x2d, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x02, 0x02, 0x00, 0x01, 0x12, 0x03, 0x2d, 0x09, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02,
+    0x02, 0x00, 0x03, 0x12, 0x03, 0x2d, 0x0e, 0x0f, 0x0a, 0x21, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x01,
+    0x12, 0x03, 0x30, 0x02, 0x16, 0x1a, 0x14, 0x20, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0x63, 0x70,
     0x75, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe7, 0x8e, 0x87, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x02, 0x02, 0x01, 0x05, 0x12, 0x03, 0x2a, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02,
-    0x01, 0x01, 0x12, 0x03, 0x2a, 0x08, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01, 0x03,
-    0x12, 0x03, 0x2a, 0x14, 0x15, 0x0a, 0x24, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x02, 0x12, 0x03, 0x2d,
+    0x02, 0x02, 0x01, 0x05, 0x12, 0x03, 0x30, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02,
+    0x01, 0x01, 0x12, 0x03, 0x30, 0x08, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01, 0x03,
+    0x12, 0x03, 0x30, 0x14, 0x15, 0x0a, 0x24, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x02, 0x12, 0x03, 0x33,
     0x02, 0x16, 0x1a, 0x17, 0x20, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe5, 0x86, 0x85, 0xe5, 0xad,
     0x98, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe7, 0x8e, 0x87, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x02, 0x02, 0x02, 0x05, 0x12, 0x03, 0x2d, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02,
-    0x02, 0x01, 0x12, 0x03, 0x2d, 0x08, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x02, 0x03,
-    0x12, 0x03, 0x2d, 0x14, 0x15, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+    0x02, 0x02, 0x02, 0x05, 0x12, 0x03, 0x33, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02,
+    0x02, 0x01, 0x12, 0x03, 0x33, 0x08, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x02, 0x03,
+    0x12, 0x03, 0x33, 0x14, 0x15, 0x0a, 0x26, 0x0a, 0x02, 0x04, 0x03, 0x12, 0x04, 0x37, 0x00, 0x51,
+    0x01, 0x1a, 0x1a, 0x20, 0xe5, 0xb7, 0xb2, 0xe6, 0xb3, 0xa8, 0xe5, 0x86, 0x8c, 0xe7, 0x9a, 0x84,
+    0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x0a, 0x0a, 0x0a, 0x0a,
+    0x03, 0x04, 0x03, 0x01, 0x12, 0x03, 0x37, 0x08, 0x12, 0x0a, 0x18, 0x0a, 0x04, 0x04, 0x03, 0x02,
+    0x00, 0x12, 0x03, 0x39, 0x02, 0x10, 0x1a, 0x0b, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x20,
+    0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x05, 0x12, 0x03, 0x39, 0x02,
+    0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x01, 0x12, 0x03, 0x39, 0x09, 0x0b, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x03, 0x12, 0x03, 0x39, 0x0e, 0x0f, 0x0a, 0x0b, 0x0a,
+    0x04, 0x04, 0x03, 0x02, 0x01, 0x12, 0x03, 0x3b, 0x02, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03,
+    0x02, 0x01, 0x05, 0x12, 0x03, 0x3b, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01,
+    0x01, 0x12, 0x03, 0x3b, 0x09, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01, 0x03, 0x12,
+    0x03, 0x3b, 0x0e, 0x0f, 0x0a, 0x2d, 0x0a, 0x04, 0x04, 0x03, 0x02, 0x02, 0x12, 0x03, 0x3e, 0x02,
+    0x21, 0x1a, 0x20, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0xe6, 0x8f, 0x90, 0xe4, 0xbe, 0x9b,
+    0xe7, 0x9a, 0x84, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xe5,
+    0x90, 0x8d, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x02, 0x04, 0x12, 0x03, 0x3e, 0x02,
+    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x02, 0x05, 0x12, 0x03, 0x3e, 0x0b, 0x11, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x02, 0x01, 0x12, 0x03, 0x3e, 0x12, 0x1c, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x03, 0x02, 0x02, 0x03, 0x12, 0x03, 0x3e, 0x1f, 0x20, 0x0a, 0x1b, 0x0a, 0x04, 0x04,
+    0x03, 0x02, 0x03, 0x12, 0x03, 0x41, 0x02, 0x21, 0x1a, 0x0e, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x65,
+    0x72, 0xe6, 0xa0, 0x87, 0xe7, 0xad, 0xbe, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x03,
+    0x06, 0x12, 0x03, 0x41, 0x02, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x03, 0x01, 0x12,
+    0x03, 0x41, 0x16, 0x1c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x03, 0x03, 0x12, 0x03, 0x41,
+    0x1f, 0x20, 0x0a, 0x33, 0x0a, 0x04, 0x04, 0x03, 0x02, 0x04, 0x12, 0x03, 0x44, 0x02, 0x18, 0x1a,
+    0x26, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0xe5, 0x90, 0x8c, 0xe6, 0x97, 0xb6, 0xe5, 0xa4,
+    0x84, 0xe7, 0x90, 0x86, 0xe7, 0x9a, 0x84, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe6, 0x95, 0xb0,
+    0xe4, 0xb8, 0x8a, 0xe9, 0x99, 0x90, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x04, 0x05,
+    0x12, 0x03, 0x44, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x04, 0x01, 0x12, 0x03,
+    0x44, 0x08, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x04, 0x03, 0x12, 0x03, 0x44, 0x16,
+    0x17, 0x0a, 0x23, 0x0a, 0x04, 0x04, 0x03, 0x02, 0x05, 0x12, 0x03, 0x47, 0x02, 0x1a, 0x1a, 0x16,
+    0x20, 0xe6, 0xb3, 0xa8, 0xe5, 0x86, 0x8c, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x20, 0x75, 0x6e,
+    0x69, 0x78, 0x20, 0x6d, 0x73, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x05, 0x05, 0x12,
+    0x03, 0x47, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x05, 0x01, 0x12, 0x03, 0x47,
+    0x08, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x05, 0x03, 0x12, 0x03, 0x47, 0x18, 0x19,
+    0x0a, 0x2f, 0x0a, 0x04, 0x04, 0x03, 0x02, 0x06, 0x12, 0x03, 0x4a, 0x02, 0x1b, 0x1a, 0x22, 0x20,
+    0xe6, 0x9c, 0x80, 0xe8, 0xbf, 0x91, 0xe4, 0xb8, 0x80, 0xe6, 0xac, 0xa1, 0xe5, 0xbf, 0x83, 0xe8,
+    0xb7, 0xb3, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x20, 0x75, 0x6e, 0x69, 0x78, 0x20, 0x6d, 0x73,
+    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x06, 0x05, 0x12, 0x03, 0x4a, 0x02, 0x07, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x06, 0x01, 0x12, 0x03, 0x4a, 0x08, 0x16, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x03, 0x02, 0x06, 0x03, 0x12, 0x03, 0x4a, 0x19, 0x1a, 0x0a, 0x37, 0x0a, 0x04, 0x04,
+    0x03, 0x02, 0x07, 0x12, 0x03, 0x4d, 0x02, 0x11, 0x1a, 0x2a, 0x20, 0xe6, 0x98, 0xaf, 0xe5, 0x90,
+    0xa6, 0xe5, 0x9c, 0xa8, 0xe7, 0xba, 0xbf, 0x20, 0xe5, 0xbf, 0x83, 0xe8, 0xb7, 0xb3, 0xe8, 0xb6,
+    0x85, 0xe6, 0x97, 0xb6, 0xe5, 0x88, 0x99, 0xe8, 0xa7, 0x86, 0xe4, 0xb8, 0xba, 0xe7, 0xa6, 0xbb,
+    0xe7, 0xba, 0xbf, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x07, 0x05, 0x12, 0x03, 0x4d,
+    0x02, 0x06, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x07, 0x01, 0x12, 0x03, 0x4d, 0x07, 0x0c,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x07, 0x03, 0x12, 0x03, 0x4d, 0x0f, 0x10, 0x0a, 0x4d,
+    0x0a, 0x04, 0x04, 0x03, 0x02, 0x08, 0x12, 0x03, 0x50, 0x02, 0x27, 0x1a, 0x40, 0x20, 0xe5, 0xbd,
+    0x93, 0xe5, 0x89, 0x8d, 0xe5, 0x88, 0x86, 0xe9, 0x85, 0x8d, 0xe7, 0xbb, 0x99, 0xe8, 0xaf, 0xa5,
+    0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0xe7, 0x9a, 0x84, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0x69,
+    0x64, 0xef, 0xbc, 0x88, 0xe5, 0xb7, 0xb2, 0xe5, 0x88, 0x86, 0xe5, 0x8f, 0x91, 0xe5, 0x92, 0x8c,
+    0xe6, 0x89, 0xa7, 0xe8, 0xa1, 0x8c, 0xe4, 0xb8, 0xad, 0xef, 0xbc, 0x89, 0x0a, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x03, 0x02, 0x08, 0x04, 0x12, 0x03, 0x50, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x03, 0x02, 0x08, 0x05, 0x12, 0x03, 0x50, 0x0b, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02,
+    0x08, 0x01, 0x12, 0x03, 0x50, 0x12, 0x22, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x08, 0x03,
+    0x12, 0x03, 0x50, 0x25, 0x26, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 ];
 // @@protoc_insertion_point(module)
\ No newline at end of file
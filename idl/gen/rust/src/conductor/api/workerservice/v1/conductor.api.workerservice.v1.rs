@@ -6,7 +6,7 @@ pub struct LongPollRequest {
     #[prost(message, optional, tag = "1")]
     pub worker: ::core::option::Option<super::super::worker::v1::Worker>,
 }
-#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+#[derive(Clone, PartialEq, ::prost::Message)]
 pub struct LongPollResponse {
     #[prost(message, optional, tag = "1")]
     pub task: ::core::option::Option<super::super::task::v1::Task>,
@@ -15,6 +15,9 @@ pub struct LongPollResponse {
 pub struct AcceptTaskRequest {
     #[prost(string, tag = "1")]
     pub task_id: ::prost::alloc::string::String,
+    /// 接受任务的worker id 之后只接受该worker的进度和结果上报
+    #[prost(string, tag = "2")]
+    pub worker_id: ::prost::alloc::string::String,
 }
 #[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
 pub struct AcceptTaskResponse {
@@ -41,6 +44,9 @@ pub struct CompleteTaskRequest {
     /// false: 不可重试的错误（如参数错误、资源不存在）
     #[prost(bool, tag = "5")]
     pub retryable: bool,
+    /// 上报结果的worker id 任务已被回收并分发给其它worker时忽略结果
+    #[prost(string, tag = "6")]
+    pub worker_id: ::prost::alloc::string::String,
 }
 #[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
 pub struct CompleteTaskResponse {
@@ -51,19 +57,68 @@ pub struct ReportTaskRequest {
     pub task_id: ::prost::alloc::string::String,
     #[prost(int64, tag = "2")]
     pub progress: i64,
+    /// 上报进度的worker id 同时用于续租
+    #[prost(string, tag = "3")]
+    pub worker_id: ::prost::alloc::string::String,
 }
 #[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
 pub struct ReportTaskResponse {
     /// worker上报任务状态时 下发是否需要终止当前流程
+    /// 任务被取消 执行超时或租约过期被回收时为 true
     #[prost(bool, tag = "1")]
     pub aborted: bool,
     /// 建议下次上报时间
     #[prost(message, optional, tag = "2")]
     pub next_report_time: ::core::option::Option<::prost_types::Timestamp>,
 }
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct RegisterWorkerRequest {
+    /// worker id
+    #[prost(string, tag = "1")]
+    pub id: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub ip: ::prost::alloc::string::String,
+    /// worker提供的服务类型名
+    #[prost(string, repeated, tag = "3")]
+    pub task_types: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    /// worker标签 如 ffmpeg=av1 cpu=16
+    #[prost(map = "string, string", tag = "4")]
+    pub labels: ::std::collections::HashMap<::prost::alloc::string::String, ::prost::alloc::string::String>,
+    /// worker同时处理的任务数上限
+    #[prost(int32, tag = "5")]
+    pub concurrency: i32,
+}
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct RegisterWorkerResponse {
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct UnregisterWorkerRequest {
+    #[prost(string, tag = "1")]
+    pub id: ::prost::alloc::string::String,
+}
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct UnregisterWorkerResponse {
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ListWorkersRequest {
+    /// 服务类型名 为空则不过滤
+    #[prost(string, tag = "1")]
+    pub task_type: ::prost::alloc::string::String,
+    /// 标签选择器 只返回标签全部匹配的worker
+    #[prost(map = "string, string", tag = "2")]
+    pub selector: ::std::collections::HashMap<::prost::alloc::string::String, ::prost::alloc::string::String>,
+    /// 是否包含已离线的worker
+    #[prost(bool, tag = "3")]
+    pub include_offline: bool,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ListWorkersResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub workers: ::prost::alloc::vec::Vec<super::super::worker::v1::WorkerInfo>,
+}
 /// Encoded file descriptor set for the `conductor.api.workerservice.v1` package
 pub const FILE_DESCRIPTOR_SET: &[u8] = &[
-    0x0a, 0x9c, 0x1b, 0x0a, 0x32, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x61,
+    0x0a, 0xb8, 0x33, 0x0a, 0x32, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x61,
     0x70, 0x69, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
     0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
     0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74,
@@ -87,69 +142,145 @@ pub const FILE_DESCRIPTOR_SET: &[u8] = &[
     0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x18,
     0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f,
     0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61,
-    0x73, 0x6b, 0x52, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x22, 0x2c, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x65,
+    0x73, 0x6b, 0x52, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x22, 0x49, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x65,
     0x70, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
     0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-    0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x22, 0x5a, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
-    0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x10,
-    0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-    0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-    0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-    0x6d, 0x70, 0x52, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x69,
-    0x6d, 0x65, 0x22, 0xa4, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x54,
-    0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61,
-    0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73,
-    0x6b, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x61, 0x72,
-    0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
-    0x41, 0x72, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
-    0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1b,
-    0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
-    0x0c, 0x52, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x72,
-    0x65, 0x74, 0x72, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-    0x72, 0x65, 0x74, 0x72, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x43, 0x6f, 0x6d,
+    0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+    0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x65,
+    0x72, 0x49, 0x64, 0x22, 0x5a, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x54, 0x61, 0x73,
+    0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x10, 0x6e, 0x65, 0x78,
+    0x74, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
+    0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+    0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+    0x0e, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22,
+    0xc1, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b,
+    0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f,
+    0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64,
+    0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18,
+    0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x41, 0x72, 0x67,
+    0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01,
+    0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x65,
+    0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+    0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x72,
+    0x79, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x74,
+    0x72, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+    0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x65,
+    0x72, 0x49, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x54,
+    0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x65, 0x0a, 0x11, 0x52,
+    0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+    0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+    0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+    0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x72, 0x6f,
+    0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f,
+    0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+    0x49, 0x64, 0x22, 0x74, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b,
+    0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x62, 0x6f, 0x72,
+    0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x62, 0x6f, 0x72, 0x74,
+    0x65, 0x64, 0x12, 0x44, 0x0a, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72,
+    0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+    0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+    0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x65,
+    0x70, 0x6f, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xad, 0x02, 0x0a, 0x15, 0x52, 0x65, 0x67,
+    0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+    0x73, 0x74, 0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a,
+    0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x0e,
+    0x0a, 0x02, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x27,
+    0x0a, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+    0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x09, 0x74, 0x61,
+    0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x59, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+    0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63,
+    0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x65,
+    0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+    0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c,
+    0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65,
+    0x6c, 0x73, 0x12, 0x29, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+    0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x28, 0x00,
+    0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x1a, 0x39, 0x0a,
+    0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+    0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+    0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+    0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x18, 0x0a, 0x16, 0x52, 0x65, 0x67, 0x69,
+    0x73, 0x74, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+    0x73, 0x65, 0x22, 0x32, 0x0a, 0x17, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+    0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+    0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02,
+    0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69,
+    0x73, 0x74, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+    0x73, 0x65, 0x22, 0xf5, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+    0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x73,
+    0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61,
+    0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x5c, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+    0x6f, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75,
+    0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73,
+    0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f,
+    0x72, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6c,
+    0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65,
+    0x63, 0x74, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
+    0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69,
+    0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x1a, 0x3b, 0x0a,
+    0x0d, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+    0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+    0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+    0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x54, 0x0a, 0x13, 0x4c, 0x69,
+    0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+    0x65, 0x12, 0x3d, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+    0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72,
+    0x6b, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73,
+    0x32, 0xe4, 0x06, 0x0a, 0x0d, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69,
+    0x63, 0x65, 0x12, 0x6d, 0x0a, 0x08, 0x4c, 0x6f, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x2f,
+    0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x77,
+    0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+    0x4c, 0x6f, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+    0x30, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+    0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31,
+    0x2e, 0x4c, 0x6f, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+    0x65, 0x12, 0x73, 0x0a, 0x0a, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12,
+    0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+    0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31,
+    0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+    0x73, 0x74, 0x1a, 0x32, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+    0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65,
+    0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+    0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74,
+    0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x65, 0x72,
+    0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+    0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x63, 0x6f,
+    0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x77, 0x6f, 0x72, 0x6b,
+    0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d,
     0x70, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-    0x65, 0x22, 0x48, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52,
-    0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69,
-    0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12,
-    0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-    0x03, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x74, 0x0a, 0x12, 0x52,
-    0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-    0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
-    0x28, 0x08, 0x52, 0x07, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x44, 0x0a, 0x10, 0x6e,
-    0x65, 0x78, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-    0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-    0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-    0x70, 0x52, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x69, 0x6d,
-    0x65, 0x32, 0xe3, 0x03, 0x0a, 0x0d, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76,
-    0x69, 0x63, 0x65, 0x12, 0x6d, 0x0a, 0x08, 0x4c, 0x6f, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x6c, 0x12,
-    0x2f, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+    0x65, 0x12, 0x73, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12,
+    0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
     0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31,
-    0x2e, 0x4c, 0x6f, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-    0x1a, 0x30, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69,
-    0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76,
-    0x31, 0x2e, 0x4c, 0x6f, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-    0x73, 0x65, 0x12, 0x73, 0x0a, 0x0a, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x54, 0x61, 0x73, 0x6b,
-    0x12, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69,
-    0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76,
-    0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75,
-    0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e,
-    0x61, 0x70, 0x69, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-    0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52,
-    0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
-    0x65, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63,
-    0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x65,
-    0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
-    0x65, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x63,
+    0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+    0x73, 0x74, 0x1a, 0x32, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+    0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65,
+    0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x0e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+    0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x35, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75,
+    0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73,
+    0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+    0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+    0x36, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+    0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31,
+    0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52,
+    0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x10, 0x55, 0x6e, 0x72, 0x65,
+    0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x37, 0x2e, 0x63,
     0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x77, 0x6f, 0x72,
-    0x6b, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
-    0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-    0x73, 0x65, 0x12, 0x73, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b,
-    0x12, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69,
-    0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76,
-    0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75,
-    0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e,
-    0x61, 0x70, 0x69, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-    0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52,
+    0x6b, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e,
+    0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65,
+    0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f,
+    0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76,
+    0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+    0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+    0x76, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x32,
+    0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x77,
+    0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+    0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+    0x73, 0x74, 0x1a, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+    0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x52,
     0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xac, 0x02, 0x0a, 0x22, 0x63, 0x6f, 0x6d, 0x2e,
     0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x77, 0x6f,
     0x72, 0x6b, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x12,
@@ -169,118 +300,236 @@ pub const FILE_DESCRIPTOR_SET: &[u8] = &[
     0x69, 0x63, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
     0x74, 0x61, 0xea, 0x02, 0x21, 0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x3a, 0x3a,
     0x41, 0x70, 0x69, 0x3a, 0x3a, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69,
-    0x63, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x4a, 0xee, 0x0d, 0x0a, 0x06, 0x12, 0x04, 0x00, 0x00, 0x4c,
-    0x01, 0x0a, 0x08, 0x0a, 0x01, 0x0c, 0x12, 0x03, 0x00, 0x00, 0x12, 0x0a, 0x09, 0x0a, 0x02, 0x03,
-    0x00, 0x12, 0x03, 0x02, 0x00, 0x25, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x01, 0x12, 0x03, 0x03, 0x00,
-    0x22, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x02, 0x12, 0x03, 0x04, 0x00, 0x2a, 0x0a, 0x09, 0x0a, 0x02,
-    0x03, 0x03, 0x12, 0x03, 0x05, 0x00, 0x2e, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x04, 0x12, 0x03, 0x06,
-    0x00, 0x29, 0x0a, 0x08, 0x0a, 0x01, 0x02, 0x12, 0x03, 0x08, 0x00, 0x27, 0x0a, 0x08, 0x0a, 0x01,
-    0x08, 0x12, 0x03, 0x0a, 0x00, 0x6e, 0x0a, 0x09, 0x0a, 0x02, 0x08, 0x0b, 0x12, 0x03, 0x0a, 0x00,
-    0x6e, 0x0a, 0x0a, 0x0a, 0x02, 0x06, 0x00, 0x12, 0x04, 0x0c, 0x00, 0x18, 0x01, 0x0a, 0x0a, 0x0a,
-    0x03, 0x06, 0x00, 0x01, 0x12, 0x03, 0x0c, 0x08, 0x15, 0x0a, 0x2a, 0x0a, 0x04, 0x06, 0x00, 0x02,
-    0x00, 0x12, 0x03, 0x0e, 0x02, 0x3b, 0x1a, 0x1d, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0xe9,
-    0x95, 0xbf, 0xe8, 0xbd, 0xae, 0xe8, 0xaf, 0xa2, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe4, 0xbb,
-    0xbb, 0xe5, 0x8a, 0xa1, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03,
-    0x0e, 0x06, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x02, 0x12, 0x03, 0x0e, 0x0f,
-    0x1e, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x0e, 0x29, 0x39, 0x0a,
-    0x21, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x01, 0x12, 0x03, 0x11, 0x02, 0x41, 0x1a, 0x14, 0x20, 0x77,
-    0x6f, 0x72, 0x6b, 0x65, 0x72, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0x97, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a,
-    0xa1, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x11, 0x06, 0x10,
-    0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x02, 0x12, 0x03, 0x11, 0x11, 0x22, 0x0a, 0x0c,
-    0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x03, 0x12, 0x03, 0x11, 0x2d, 0x3f, 0x0a, 0x27, 0x0a, 0x04,
-    0x06, 0x00, 0x02, 0x02, 0x12, 0x03, 0x14, 0x02, 0x47, 0x1a, 0x1a, 0x20, 0x77, 0x6f, 0x72, 0x6b,
-    0x65, 0x72, 0xe5, 0xae, 0x8c, 0xe6, 0x88, 0x90, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe4, 0xb8,
-    0x8a, 0xe6, 0x8a, 0xa5, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x01, 0x12, 0x03,
-    0x14, 0x06, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x02, 0x12, 0x03, 0x14, 0x13,
-    0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x03, 0x12, 0x03, 0x14, 0x31, 0x45, 0x0a,
-    0x2d, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x03, 0x12, 0x03, 0x17, 0x02, 0x41, 0x1a, 0x20, 0x20, 0x77,
-    0x6f, 0x72, 0x6b, 0x65, 0x72, 0xe4, 0xb8, 0x8a, 0xe6, 0x8a, 0xa5, 0xe5, 0xbd, 0x93, 0xe5, 0x89,
-    0x8d, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe8, 0xbf, 0x9b, 0xe5, 0xba, 0xa6, 0x0a, 0x0a, 0x0c,
-    0x0a, 0x05, 0x06, 0x00, 0x02, 0x03, 0x01, 0x12, 0x03, 0x17, 0x06, 0x10, 0x0a, 0x0c, 0x0a, 0x05,
-    0x06, 0x00, 0x02, 0x03, 0x02, 0x12, 0x03, 0x17, 0x11, 0x22, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00,
-    0x02, 0x03, 0x03, 0x12, 0x03, 0x17, 0x2d, 0x3f, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x00, 0x12, 0x04,
-    0x1a, 0x00, 0x1d, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x00, 0x01, 0x12, 0x03, 0x1a, 0x08, 0x17,
-    0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x00, 0x12, 0x03, 0x1c, 0x02, 0x2c, 0x1a, 0x0e, 0x20,
-    0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x0a, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x00, 0x02, 0x00, 0x06, 0x12, 0x03, 0x1c, 0x02, 0x20, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x1c, 0x21, 0x27, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02,
-    0x00, 0x03, 0x12, 0x03, 0x1c, 0x2a, 0x2b, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x01, 0x12, 0x04, 0x1f,
-    0x00, 0x21, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x01, 0x01, 0x12, 0x03, 0x1f, 0x08, 0x18, 0x0a,
-    0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x00, 0x12, 0x03, 0x20, 0x02, 0x26, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x01, 0x02, 0x00, 0x06, 0x12, 0x03, 0x20, 0x02, 0x1c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01,
-    0x02, 0x00, 0x01, 0x12, 0x03, 0x20, 0x1d, 0x21, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00,
-    0x03, 0x12, 0x03, 0x20, 0x24, 0x25, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x02, 0x12, 0x04, 0x23, 0x00,
-    0x25, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x02, 0x01, 0x12, 0x03, 0x23, 0x08, 0x19, 0x0a, 0x0b,
-    0x0a, 0x04, 0x04, 0x02, 0x02, 0x00, 0x12, 0x03, 0x24, 0x02, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x02, 0x02, 0x00, 0x05, 0x12, 0x03, 0x24, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02,
-    0x00, 0x01, 0x12, 0x03, 0x24, 0x09, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x03,
-    0x12, 0x03, 0x24, 0x13, 0x14, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x03, 0x12, 0x04, 0x27, 0x00, 0x2a,
-    0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x03, 0x01, 0x12, 0x03, 0x27, 0x08, 0x1a, 0x0a, 0x2d, 0x0a,
-    0x04, 0x04, 0x03, 0x02, 0x00, 0x12, 0x03, 0x29, 0x02, 0x31, 0x1a, 0x20, 0x20, 0xe5, 0xbb, 0xba,
-    0xe8, 0xae, 0xae, 0xe4, 0xb8, 0x8b, 0xe6, 0xac, 0xa1, 0xe4, 0xb8, 0x8a, 0xe6, 0x8a, 0xa5, 0xe8,
-    0xbf, 0x9b, 0xe5, 0xba, 0xa6, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x0a, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x03, 0x02, 0x00, 0x06, 0x12, 0x03, 0x29, 0x02, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03,
-    0x02, 0x00, 0x01, 0x12, 0x03, 0x29, 0x1c, 0x2c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00,
-    0x03, 0x12, 0x03, 0x29, 0x2f, 0x30, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x04, 0x12, 0x04, 0x2c, 0x00,
-    0x3d, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x04, 0x01, 0x12, 0x03, 0x2c, 0x08, 0x1b, 0x0a, 0x17,
-    0x0a, 0x04, 0x04, 0x04, 0x02, 0x00, 0x12, 0x03, 0x2e, 0x02, 0x15, 0x1a, 0x0a, 0x20, 0xe4, 0xbb,
-    0xbb, 0xe5, 0x8a, 0xa1, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x05,
-    0x12, 0x03, 0x2e, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x01, 0x12, 0x03,
-    0x2e, 0x09, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x03, 0x12, 0x03, 0x2e, 0x13,
-    0x14, 0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x01, 0x12, 0x03, 0x31, 0x02, 0x18, 0x1a, 0x0e,
-    0x20, 0xe8, 0xbe, 0x93, 0xe5, 0x87, 0xba, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x0a, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x04, 0x02, 0x01, 0x05, 0x12, 0x03, 0x31, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x04, 0x02, 0x01, 0x01, 0x12, 0x03, 0x31, 0x08, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04,
-    0x02, 0x01, 0x03, 0x12, 0x03, 0x31, 0x16, 0x17, 0x0a, 0x27, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x02,
-    0x12, 0x03, 0x34, 0x02, 0x13, 0x1a, 0x1a, 0x20, 0xe5, 0xa4, 0x84, 0xe7, 0x90, 0x86, 0xe6, 0x88,
-    0x90, 0xe5, 0x8a, 0x9f, 0xe8, 0xbf, 0x98, 0xe6, 0x98, 0xaf, 0xe5, 0xa4, 0xb1, 0xe8, 0xb4, 0xa5,
-    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x02, 0x05, 0x12, 0x03, 0x34, 0x02, 0x06, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x02, 0x01, 0x12, 0x03, 0x34, 0x07, 0x0e, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x04, 0x02, 0x02, 0x03, 0x12, 0x03, 0x34, 0x11, 0x12, 0x0a, 0x1b, 0x0a, 0x04, 0x04,
-    0x04, 0x02, 0x03, 0x12, 0x03, 0x37, 0x02, 0x16, 0x1a, 0x0e, 0x20, 0xe5, 0xa4, 0xb1, 0xe8, 0xb4,
-    0xa5, 0xe6, 0x97, 0xa5, 0xe5, 0xbf, 0x97, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x03,
-    0x05, 0x12, 0x03, 0x37, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x03, 0x01, 0x12,
-    0x03, 0x37, 0x08, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x03, 0x03, 0x12, 0x03, 0x37,
-    0x14, 0x15, 0x0a, 0xc5, 0x01, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x04, 0x12, 0x03, 0x3c, 0x02, 0x15,
-    0x1a, 0xb7, 0x01, 0x20, 0xe5, 0xa4, 0xb1, 0xe8, 0xb4, 0xa5, 0xe6, 0x97, 0xb6, 0xe6, 0x98, 0xaf,
-    0xe5, 0x90, 0xa6, 0xe5, 0x8f, 0xaf, 0xe9, 0x87, 0x8d, 0xe8, 0xaf, 0x95, 0xef, 0xbc, 0x88, 0xe7,
-    0x94, 0xb1, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x20, 0xe6, 0x8c, 0x87, 0xe5, 0xae, 0x9a,
-    0xef, 0xbc, 0x89, 0x0a, 0x20, 0x74, 0x72, 0x75, 0x65, 0x3a, 0x20, 0xe5, 0x8f, 0xaf, 0xe9, 0x87,
-    0x8d, 0xe8, 0xaf, 0x95, 0xe7, 0x9a, 0x84, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xef, 0xbc, 0x88,
-    0xe5, 0xa6, 0x82, 0xe8, 0xb6, 0x85, 0xe6, 0x97, 0xb6, 0xe3, 0x80, 0x81, 0xe8, 0xb5, 0x84, 0xe6,
-    0xba, 0x90, 0xe6, 0x9a, 0x82, 0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0x8d, 0xe5, 0x8f, 0xaf, 0xe7, 0x94,
-    0xa8, 0xef, 0xbc, 0x89, 0x0a, 0x20, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x3a, 0x20, 0xe4, 0xb8, 0x8d,
-    0xe5, 0x8f, 0xaf, 0xe9, 0x87, 0x8d, 0xe8, 0xaf, 0x95, 0xe7, 0x9a, 0x84, 0xe9, 0x94, 0x99, 0xe8,
-    0xaf, 0xaf, 0xef, 0xbc, 0x88, 0xe5, 0xa6, 0x82, 0xe5, 0x8f, 0x82, 0xe6, 0x95, 0xb0, 0xe9, 0x94,
-    0x99, 0xe8, 0xaf, 0xaf, 0xe3, 0x80, 0x81, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe4, 0xb8, 0x8d,
-    0xe5, 0xad, 0x98, 0xe5, 0x9c, 0xa8, 0xef, 0xbc, 0x89, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04,
-    0x02, 0x04, 0x05, 0x12, 0x03, 0x3c, 0x02, 0x06, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x04,
-    0x01, 0x12, 0x03, 0x3c, 0x07, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x04, 0x03, 0x12,
-    0x03, 0x3c, 0x13, 0x14, 0x0a, 0x09, 0x0a, 0x02, 0x04, 0x05, 0x12, 0x03, 0x3f, 0x00, 0x1f, 0x0a,
-    0x0a, 0x0a, 0x03, 0x04, 0x05, 0x01, 0x12, 0x03, 0x3f, 0x08, 0x1c, 0x0a, 0x0a, 0x0a, 0x02, 0x04,
-    0x06, 0x12, 0x04, 0x41, 0x00, 0x44, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x06, 0x01, 0x12, 0x03,
-    0x41, 0x08, 0x19, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x06, 0x02, 0x00, 0x12, 0x03, 0x42, 0x02, 0x16,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x05, 0x12, 0x03, 0x42, 0x02, 0x08, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x01, 0x12, 0x03, 0x42, 0x09, 0x10, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x06, 0x02, 0x00, 0x03, 0x12, 0x03, 0x42, 0x14, 0x15, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x06,
-    0x02, 0x01, 0x12, 0x03, 0x43, 0x02, 0x16, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x01, 0x05,
-    0x12, 0x03, 0x43, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x01, 0x01, 0x12, 0x03,
-    0x43, 0x09, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x01, 0x03, 0x12, 0x03, 0x43, 0x14,
-    0x15, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x07, 0x12, 0x04, 0x46, 0x00, 0x4c, 0x01, 0x0a, 0x0a, 0x0a,
-    0x03, 0x04, 0x07, 0x01, 0x12, 0x03, 0x46, 0x08, 0x1a, 0x0a, 0x4f, 0x0a, 0x04, 0x04, 0x07, 0x02,
-    0x00, 0x12, 0x03, 0x48, 0x02, 0x13, 0x1a, 0x42, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0xe4,
-    0xb8, 0x8a, 0xe6, 0x8a, 0xa5, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe7, 0x8a, 0xb6, 0xe6, 0x80,
-    0x81, 0xe6, 0x97, 0xb6, 0x20, 0xe4, 0xb8, 0x8b, 0xe5, 0x8f, 0x91, 0xe6, 0x98, 0xaf, 0xe5, 0x90,
-    0xa6, 0xe9, 0x9c, 0x80, 0xe8, 0xa6, 0x81, 0xe7, 0xbb, 0x88, 0xe6, 0xad, 0xa2, 0xe5, 0xbd, 0x93,
-    0xe5, 0x89, 0x8d, 0xe6, 0xb5, 0x81, 0xe7, 0xa8, 0x8b, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07,
-    0x02, 0x00, 0x05, 0x12, 0x03, 0x48, 0x02, 0x06, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00,
-    0x01, 0x12, 0x03, 0x48, 0x07, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x03, 0x12,
-    0x03, 0x48, 0x11, 0x12, 0x0a, 0x27, 0x0a, 0x04, 0x04, 0x07, 0x02, 0x01, 0x12, 0x03, 0x4b, 0x02,
-    0x31, 0x1a, 0x1a, 0x20, 0xe5, 0xbb, 0xba, 0xe8, 0xae, 0xae, 0xe4, 0xb8, 0x8b, 0xe6, 0xac, 0xa1,
-    0xe4, 0xb8, 0x8a, 0xe6, 0x8a, 0xa5, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x0a, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x07, 0x02, 0x01, 0x06, 0x12, 0x03, 0x4b, 0x02, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x07, 0x02, 0x01, 0x01, 0x12, 0x03, 0x4b, 0x1c, 0x2c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02,
-    0x01, 0x03, 0x12, 0x03, 0x4b, 0x2f, 0x30, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+    0x63, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x4a, 0xca, 0x1c, 0x0a, 0x07, 0x12, 0x05, 0x00, 0x00, 0x86,
+    0x01, 0x01, 0x0a, 0x08, 0x0a, 0x01, 0x0c, 0x12, 0x03, 0x00, 0x00, 0x12, 0x0a, 0x09, 0x0a, 0x02,
+    0x03, 0x00, 0x12, 0x03, 0x02, 0x00, 0x25, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x01, 0x12, 0x03, 0x03,
+    0x00, 0x22, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x02, 0x12, 0x03, 0x04, 0x00, 0x2a, 0x0a, 0x09, 0x0a,
+    0x02, 0x03, 0x03, 0x12, 0x03, 0x05, 0x00, 0x2e, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x04, 0x12, 0x03,
+    0x06, 0x00, 0x29, 0x0a, 0x08, 0x0a, 0x01, 0x02, 0x12, 0x03, 0x08, 0x00, 0x27, 0x0a, 0x08, 0x0a,
+    0x01, 0x08, 0x12, 0x03, 0x0a, 0x00, 0x6e, 0x0a, 0x09, 0x0a, 0x02, 0x08, 0x0b, 0x12, 0x03, 0x0a,
+    0x00, 0x6e, 0x0a, 0x0a, 0x0a, 0x02, 0x06, 0x00, 0x12, 0x04, 0x0c, 0x00, 0x21, 0x01, 0x0a, 0x0a,
+    0x0a, 0x03, 0x06, 0x00, 0x01, 0x12, 0x03, 0x0c, 0x08, 0x15, 0x0a, 0x2a, 0x0a, 0x04, 0x06, 0x00,
+    0x02, 0x00, 0x12, 0x03, 0x0e, 0x02, 0x3b, 0x1a, 0x1d, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+    0xe9, 0x95, 0xbf, 0xe8, 0xbd, 0xae, 0xe8, 0xaf, 0xa2, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe4,
+    0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x01, 0x12,
+    0x03, 0x0e, 0x06, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x02, 0x12, 0x03, 0x0e,
+    0x0f, 0x1e, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x0e, 0x29, 0x39,
+    0x0a, 0x21, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x01, 0x12, 0x03, 0x11, 0x02, 0x41, 0x1a, 0x14, 0x20,
+    0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0x97, 0xe4, 0xbb, 0xbb, 0xe5,
+    0x8a, 0xa1, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x11, 0x06,
+    0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x02, 0x12, 0x03, 0x11, 0x11, 0x22, 0x0a,
+    0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x03, 0x12, 0x03, 0x11, 0x2d, 0x3f, 0x0a, 0x27, 0x0a,
+    0x04, 0x06, 0x00, 0x02, 0x02, 0x12, 0x03, 0x14, 0x02, 0x47, 0x1a, 0x1a, 0x20, 0x77, 0x6f, 0x72,
+    0x6b, 0x65, 0x72, 0xe5, 0xae, 0x8c, 0xe6, 0x88, 0x90, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe4,
+    0xb8, 0x8a, 0xe6, 0x8a, 0xa5, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x01, 0x12,
+    0x03, 0x14, 0x06, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x02, 0x12, 0x03, 0x14,
+    0x13, 0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x03, 0x12, 0x03, 0x14, 0x31, 0x45,
+    0x0a, 0x2d, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x03, 0x12, 0x03, 0x17, 0x02, 0x41, 0x1a, 0x20, 0x20,
+    0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0xe4, 0xb8, 0x8a, 0xe6, 0x8a, 0xa5, 0xe5, 0xbd, 0x93, 0xe5,
+    0x89, 0x8d, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe8, 0xbf, 0x9b, 0xe5, 0xba, 0xa6, 0x0a, 0x0a,
+    0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x03, 0x01, 0x12, 0x03, 0x17, 0x06, 0x10, 0x0a, 0x0c, 0x0a,
+    0x05, 0x06, 0x00, 0x02, 0x03, 0x02, 0x12, 0x03, 0x17, 0x11, 0x22, 0x0a, 0x0c, 0x0a, 0x05, 0x06,
+    0x00, 0x02, 0x03, 0x03, 0x12, 0x03, 0x17, 0x2d, 0x3f, 0x0a, 0x3a, 0x0a, 0x04, 0x06, 0x00, 0x02,
+    0x04, 0x12, 0x03, 0x1a, 0x02, 0x4d, 0x1a, 0x2d, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0xe5,
+    0x90, 0xaf, 0xe5, 0x8a, 0xa8, 0xe6, 0x97, 0xb6, 0xe6, 0xb3, 0xa8, 0xe5, 0x86, 0x8c, 0x20, 0xe4,
+    0xb8, 0x8a, 0xe6, 0x8a, 0xa5, 0xe8, 0x83, 0xbd, 0xe5, 0x8a, 0x9b, 0xe5, 0x92, 0x8c, 0xe6, 0xa0,
+    0x87, 0xe7, 0xad, 0xbe, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x04, 0x01, 0x12, 0x03,
+    0x1a, 0x06, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x04, 0x02, 0x12, 0x03, 0x1a, 0x15,
+    0x2a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x04, 0x03, 0x12, 0x03, 0x1a, 0x35, 0x4b, 0x0a,
+    0x24, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x05, 0x12, 0x03, 0x1d, 0x02, 0x53, 0x1a, 0x17, 0x20, 0x77,
+    0x6f, 0x72, 0x6b, 0x65, 0x72, 0xe9, 0x80, 0x80, 0xe5, 0x87, 0xba, 0xe6, 0x97, 0xb6, 0xe6, 0xb3,
+    0xa8, 0xe9, 0x94, 0x80, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x05, 0x01, 0x12, 0x03,
+    0x1d, 0x06, 0x16, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x05, 0x02, 0x12, 0x03, 0x1d, 0x17,
+    0x2e, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x05, 0x03, 0x12, 0x03, 0x1d, 0x39, 0x51, 0x0a,
+    0x42, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x06, 0x12, 0x03, 0x20, 0x02, 0x44, 0x1a, 0x35, 0x20, 0xe5,
+    0x88, 0x97, 0xe5, 0x87, 0xba, 0xe5, 0xb7, 0xb2, 0xe6, 0xb3, 0xa8, 0xe5, 0x86, 0x8c, 0xe7, 0x9a,
+    0x84, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0xe5, 0x8f, 0x8a, 0xe5, 0x85, 0xb6, 0xe5, 0xbd, 0x93,
+    0xe5, 0x89, 0x8d, 0xe6, 0x89, 0xa7, 0xe8, 0xa1, 0x8c, 0xe7, 0x9a, 0x84, 0xe4, 0xbb, 0xbb, 0xe5,
+    0x8a, 0xa1, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x06, 0x01, 0x12, 0x03, 0x20, 0x06,
+    0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x06, 0x02, 0x12, 0x03, 0x20, 0x12, 0x24, 0x0a,
+    0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x06, 0x03, 0x12, 0x03, 0x20, 0x2f, 0x42, 0x0a, 0x0a, 0x0a,
+    0x02, 0x04, 0x00, 0x12, 0x04, 0x23, 0x00, 0x26, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x00, 0x01,
+    0x12, 0x03, 0x23, 0x08, 0x17, 0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x00, 0x12, 0x03, 0x25,
+    0x02, 0x2c, 0x1a, 0x0e, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0xe4, 0xbf, 0xa1, 0xe6, 0x81,
+    0xaf, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x06, 0x12, 0x03, 0x25, 0x02, 0x20,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x25, 0x21, 0x27, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x25, 0x2a, 0x2b, 0x0a, 0x0a, 0x0a, 0x02,
+    0x04, 0x01, 0x12, 0x04, 0x28, 0x00, 0x2a, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x01, 0x01, 0x12,
+    0x03, 0x28, 0x08, 0x18, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x00, 0x12, 0x03, 0x29, 0x02,
+    0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x06, 0x12, 0x03, 0x29, 0x02, 0x1c, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x01, 0x12, 0x03, 0x29, 0x1d, 0x21, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x01, 0x02, 0x00, 0x03, 0x12, 0x03, 0x29, 0x24, 0x25, 0x0a, 0x0a, 0x0a, 0x02, 0x04,
+    0x02, 0x12, 0x04, 0x2c, 0x00, 0x31, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x02, 0x01, 0x12, 0x03,
+    0x2c, 0x08, 0x19, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x00, 0x12, 0x03, 0x2d, 0x02, 0x15,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x05, 0x12, 0x03, 0x2d, 0x02, 0x08, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x01, 0x12, 0x03, 0x2d, 0x09, 0x10, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x02, 0x02, 0x00, 0x03, 0x12, 0x03, 0x2d, 0x13, 0x14, 0x0a, 0x58, 0x0a, 0x04, 0x04, 0x02,
+    0x02, 0x01, 0x12, 0x03, 0x30, 0x02, 0x17, 0x1a, 0x4b, 0x20, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0x97,
+    0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe7, 0x9a, 0x84, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x20,
+    0x69, 0x64, 0x20, 0xe4, 0xb9, 0x8b, 0xe5, 0x90, 0x8e, 0xe5, 0x8f, 0xaa, 0xe6, 0x8e, 0xa5, 0xe5,
+    0x8f, 0x97, 0xe8, 0xaf, 0xa5, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0xe7, 0x9a, 0x84, 0xe8, 0xbf,
+    0x9b, 0xe5, 0xba, 0xa6, 0xe5, 0x92, 0x8c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xe4, 0xb8, 0x8a,
+    0xe6, 0x8a, 0xa5, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01, 0x05, 0x12, 0x03, 0x30,
+    0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01, 0x01, 0x12, 0x03, 0x30, 0x09, 0x12,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01, 0x03, 0x12, 0x03, 0x30, 0x15, 0x16, 0x0a, 0x0a,
+    0x0a, 0x02, 0x04, 0x03, 0x12, 0x04, 0x33, 0x00, 0x36, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x03,
+    0x01, 0x12, 0x03, 0x33, 0x08, 0x1a, 0x0a, 0x2d, 0x0a, 0x04, 0x04, 0x03, 0x02, 0x00, 0x12, 0x03,
+    0x35, 0x02, 0x31, 0x1a, 0x20, 0x20, 0xe5, 0xbb, 0xba, 0xe8, 0xae, 0xae, 0xe4, 0xb8, 0x8b, 0xe6,
+    0xac, 0xa1, 0xe4, 0xb8, 0x8a, 0xe6, 0x8a, 0xa5, 0xe8, 0xbf, 0x9b, 0xe5, 0xba, 0xa6, 0xe6, 0x97,
+    0xb6, 0xe9, 0x97, 0xb4, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x06, 0x12, 0x03,
+    0x35, 0x02, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x01, 0x12, 0x03, 0x35, 0x1c,
+    0x2c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x03, 0x12, 0x03, 0x35, 0x2f, 0x30, 0x0a,
+    0x0a, 0x0a, 0x02, 0x04, 0x04, 0x12, 0x04, 0x38, 0x00, 0x4c, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04,
+    0x04, 0x01, 0x12, 0x03, 0x38, 0x08, 0x1b, 0x0a, 0x17, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x00, 0x12,
+    0x03, 0x3a, 0x02, 0x15, 0x1a, 0x0a, 0x20, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0x69, 0x64, 0x0a,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x05, 0x12, 0x03, 0x3a, 0x02, 0x08, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x01, 0x12, 0x03, 0x3a, 0x09, 0x10, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x04, 0x02, 0x00, 0x03, 0x12, 0x03, 0x3a, 0x13, 0x14, 0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x04,
+    0x02, 0x01, 0x12, 0x03, 0x3d, 0x02, 0x18, 0x1a, 0x0e, 0x20, 0xe8, 0xbe, 0x93, 0xe5, 0x87, 0xba,
+    0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x01, 0x05,
+    0x12, 0x03, 0x3d, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x01, 0x01, 0x12, 0x03,
+    0x3d, 0x08, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x01, 0x03, 0x12, 0x03, 0x3d, 0x16,
+    0x17, 0x0a, 0x27, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x02, 0x12, 0x03, 0x40, 0x02, 0x13, 0x1a, 0x1a,
+    0x20, 0xe5, 0xa4, 0x84, 0xe7, 0x90, 0x86, 0xe6, 0x88, 0x90, 0xe5, 0x8a, 0x9f, 0xe8, 0xbf, 0x98,
+    0xe6, 0x98, 0xaf, 0xe5, 0xa4, 0xb1, 0xe8, 0xb4, 0xa5, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04,
+    0x02, 0x02, 0x05, 0x12, 0x03, 0x40, 0x02, 0x06, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x02,
+    0x01, 0x12, 0x03, 0x40, 0x07, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x02, 0x03, 0x12,
+    0x03, 0x40, 0x11, 0x12, 0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x03, 0x12, 0x03, 0x43, 0x02,
+    0x16, 0x1a, 0x0e, 0x20, 0xe5, 0xa4, 0xb1, 0xe8, 0xb4, 0xa5, 0xe6, 0x97, 0xa5, 0xe5, 0xbf, 0x97,
+    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x03, 0x05, 0x12, 0x03, 0x43, 0x02, 0x07, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x03, 0x01, 0x12, 0x03, 0x43, 0x08, 0x11, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x04, 0x02, 0x03, 0x03, 0x12, 0x03, 0x43, 0x14, 0x15, 0x0a, 0xc5, 0x01, 0x0a, 0x04,
+    0x04, 0x04, 0x02, 0x04, 0x12, 0x03, 0x48, 0x02, 0x15, 0x1a, 0xb7, 0x01, 0x20, 0xe5, 0xa4, 0xb1,
+    0xe8, 0xb4, 0xa5, 0xe6, 0x97, 0xb6, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x8f, 0xaf, 0xe9,
+    0x87, 0x8d, 0xe8, 0xaf, 0x95, 0xef, 0xbc, 0x88, 0xe7, 0x94, 0xb1, 0x20, 0x77, 0x6f, 0x72, 0x6b,
+    0x65, 0x72, 0x20, 0xe6, 0x8c, 0x87, 0xe5, 0xae, 0x9a, 0xef, 0xbc, 0x89, 0x0a, 0x20, 0x74, 0x72,
+    0x75, 0x65, 0x3a, 0x20, 0xe5, 0x8f, 0xaf, 0xe9, 0x87, 0x8d, 0xe8, 0xaf, 0x95, 0xe7, 0x9a, 0x84,
+    0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xef, 0xbc, 0x88, 0xe5, 0xa6, 0x82, 0xe8, 0xb6, 0x85, 0xe6,
+    0x97, 0xb6, 0xe3, 0x80, 0x81, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe6, 0x9a, 0x82, 0xe6, 0x97,
+    0xb6, 0xe4, 0xb8, 0x8d, 0xe5, 0x8f, 0xaf, 0xe7, 0x94, 0xa8, 0xef, 0xbc, 0x89, 0x0a, 0x20, 0x66,
+    0x61, 0x6c, 0x73, 0x65, 0x3a, 0x20, 0xe4, 0xb8, 0x8d, 0xe5, 0x8f, 0xaf, 0xe9, 0x87, 0x8d, 0xe8,
+    0xaf, 0x95, 0xe7, 0x9a, 0x84, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xef, 0xbc, 0x88, 0xe5, 0xa6,
+    0x82, 0xe5, 0x8f, 0x82, 0xe6, 0x95, 0xb0, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe3, 0x80, 0x81,
+    0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe4, 0xb8, 0x8d, 0xe5, 0xad, 0x98, 0xe5, 0x9c, 0xa8, 0xef,
+    0xbc, 0x89, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x04, 0x05, 0x12, 0x03, 0x48, 0x02,
+    0x06, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x04, 0x01, 0x12, 0x03, 0x48, 0x07, 0x10, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x04, 0x03, 0x12, 0x03, 0x48, 0x13, 0x14, 0x0a, 0x61, 0x0a,
+    0x04, 0x04, 0x04, 0x02, 0x05, 0x12, 0x03, 0x4b, 0x02, 0x17, 0x1a, 0x54, 0x20, 0xe4, 0xb8, 0x8a,
+    0xe6, 0x8a, 0xa5, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xe7, 0x9a, 0x84, 0x77, 0x6f, 0x72, 0x6b,
+    0x65, 0x72, 0x20, 0x69, 0x64, 0x20, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe5, 0xb7, 0xb2, 0xe8,
+    0xa2, 0xab, 0xe5, 0x9b, 0x9e, 0xe6, 0x94, 0xb6, 0xe5, 0xb9, 0xb6, 0xe5, 0x88, 0x86, 0xe5, 0x8f,
+    0x91, 0xe7, 0xbb, 0x99, 0xe5, 0x85, 0xb6, 0xe5, 0xae, 0x83, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+    0xe6, 0x97, 0xb6, 0xe5, 0xbf, 0xbd, 0xe7, 0x95, 0xa5, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x0a,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x05, 0x05, 0x12, 0x03, 0x4b, 0x02, 0x08, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x04, 0x02, 0x05, 0x01, 0x12, 0x03, 0x4b, 0x09, 0x12, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x04, 0x02, 0x05, 0x03, 0x12, 0x03, 0x4b, 0x15, 0x16, 0x0a, 0x09, 0x0a, 0x02, 0x04, 0x05,
+    0x12, 0x03, 0x4e, 0x00, 0x1f, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x05, 0x01, 0x12, 0x03, 0x4e, 0x08,
+    0x1c, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x06, 0x12, 0x04, 0x50, 0x00, 0x56, 0x01, 0x0a, 0x0a, 0x0a,
+    0x03, 0x04, 0x06, 0x01, 0x12, 0x03, 0x50, 0x08, 0x19, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x06, 0x02,
+    0x00, 0x12, 0x03, 0x51, 0x02, 0x16, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x05, 0x12,
+    0x03, 0x51, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x01, 0x12, 0x03, 0x51,
+    0x09, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x03, 0x12, 0x03, 0x51, 0x14, 0x15,
+    0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x06, 0x02, 0x01, 0x12, 0x03, 0x52, 0x02, 0x16, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x06, 0x02, 0x01, 0x05, 0x12, 0x03, 0x52, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x06, 0x02, 0x01, 0x01, 0x12, 0x03, 0x52, 0x09, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02,
+    0x01, 0x03, 0x12, 0x03, 0x52, 0x14, 0x15, 0x0a, 0x3a, 0x0a, 0x04, 0x04, 0x06, 0x02, 0x02, 0x12,
+    0x03, 0x55, 0x02, 0x17, 0x1a, 0x2d, 0x20, 0xe4, 0xb8, 0x8a, 0xe6, 0x8a, 0xa5, 0xe8, 0xbf, 0x9b,
+    0xe5, 0xba, 0xa6, 0xe7, 0x9a, 0x84, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x20, 0x69, 0x64, 0x20,
+    0xe5, 0x90, 0x8c, 0xe6, 0x97, 0xb6, 0xe7, 0x94, 0xa8, 0xe4, 0xba, 0x8e, 0xe7, 0xbb, 0xad, 0xe7,
+    0xa7, 0x9f, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x02, 0x05, 0x12, 0x03, 0x55, 0x02,
+    0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x02, 0x01, 0x12, 0x03, 0x55, 0x09, 0x12, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x02, 0x03, 0x12, 0x03, 0x55, 0x15, 0x16, 0x0a, 0x0a, 0x0a,
+    0x02, 0x04, 0x07, 0x12, 0x04, 0x58, 0x00, 0x5f, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x07, 0x01,
+    0x12, 0x03, 0x58, 0x08, 0x1a, 0x0a, 0x91, 0x01, 0x0a, 0x04, 0x04, 0x07, 0x02, 0x00, 0x12, 0x03,
+    0x5b, 0x02, 0x13, 0x1a, 0x83, 0x01, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0xe4, 0xb8, 0x8a,
+    0xe6, 0x8a, 0xa5, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0xe6,
+    0x97, 0xb6, 0x20, 0xe4, 0xb8, 0x8b, 0xe5, 0x8f, 0x91, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe9,
+    0x9c, 0x80, 0xe8, 0xa6, 0x81, 0xe7, 0xbb, 0x88, 0xe6, 0xad, 0xa2, 0xe5, 0xbd, 0x93, 0xe5, 0x89,
+    0x8d, 0xe6, 0xb5, 0x81, 0xe7, 0xa8, 0x8b, 0x0a, 0x20, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe8,
+    0xa2, 0xab, 0xe5, 0x8f, 0x96, 0xe6, 0xb6, 0x88, 0x20, 0xe6, 0x89, 0xa7, 0xe8, 0xa1, 0x8c, 0xe8,
+    0xb6, 0x85, 0xe6, 0x97, 0xb6, 0xe6, 0x88, 0x96, 0xe7, 0xa7, 0x9f, 0xe7, 0xba, 0xa6, 0xe8, 0xbf,
+    0x87, 0xe6, 0x9c, 0x9f, 0xe8, 0xa2, 0xab, 0xe5, 0x9b, 0x9e, 0xe6, 0x94, 0xb6, 0xe6, 0x97, 0xb6,
+    0xe4, 0xb8, 0xba, 0x20, 0x74, 0x72, 0x75, 0x65, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02,
+    0x00, 0x05, 0x12, 0x03, 0x5b, 0x02, 0x06, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x01,
+    0x12, 0x03, 0x5b, 0x07, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x03, 0x12, 0x03,
+    0x5b, 0x11, 0x12, 0x0a, 0x27, 0x0a, 0x04, 0x04, 0x07, 0x02, 0x01, 0x12, 0x03, 0x5e, 0x02, 0x31,
+    0x1a, 0x1a, 0x20, 0xe5, 0xbb, 0xba, 0xe8, 0xae, 0xae, 0xe4, 0xb8, 0x8b, 0xe6, 0xac, 0xa1, 0xe4,
+    0xb8, 0x8a, 0xe6, 0x8a, 0xa5, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x0a, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x07, 0x02, 0x01, 0x06, 0x12, 0x03, 0x5e, 0x02, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07,
+    0x02, 0x01, 0x01, 0x12, 0x03, 0x5e, 0x1c, 0x2c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x01,
+    0x03, 0x12, 0x03, 0x5e, 0x2f, 0x30, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x08, 0x12, 0x04, 0x61, 0x00,
+    0x6f, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x08, 0x01, 0x12, 0x03, 0x61, 0x08, 0x1d, 0x0a, 0x18,
+    0x0a, 0x04, 0x04, 0x08, 0x02, 0x00, 0x12, 0x03, 0x63, 0x02, 0x4b, 0x1a, 0x0b, 0x20, 0x77, 0x6f,
+    0x72, 0x6b, 0x65, 0x72, 0x20, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00,
+    0x05, 0x12, 0x03, 0x63, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x01, 0x12,
+    0x03, 0x63, 0x09, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x03, 0x12, 0x03, 0x63,
+    0x0e, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x08, 0x12, 0x03, 0x63, 0x10, 0x4a,
+    0x0a, 0x0f, 0x0a, 0x08, 0x04, 0x08, 0x02, 0x00, 0x08, 0x87, 0x09, 0x0e, 0x12, 0x03, 0x63, 0x11,
+    0x49, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x08, 0x02, 0x00, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x03,
+    0x63, 0x30, 0x3a, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x08, 0x02, 0x00, 0x08, 0x87, 0x09, 0x0e, 0x03,
+    0x12, 0x03, 0x63, 0x3c, 0x48, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x08, 0x02, 0x01, 0x12, 0x03, 0x65,
+    0x02, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x01, 0x05, 0x12, 0x03, 0x65, 0x02, 0x08,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x01, 0x01, 0x12, 0x03, 0x65, 0x09, 0x0b, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x08, 0x02, 0x01, 0x03, 0x12, 0x03, 0x65, 0x0e, 0x0f, 0x0a, 0x2d, 0x0a, 0x04,
+    0x04, 0x08, 0x02, 0x02, 0x12, 0x03, 0x68, 0x02, 0x4f, 0x1a, 0x20, 0x20, 0x77, 0x6f, 0x72, 0x6b,
+    0x65, 0x72, 0xe6, 0x8f, 0x90, 0xe4, 0xbe, 0x9b, 0xe7, 0x9a, 0x84, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a,
+    0xa1, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xe5, 0x90, 0x8d, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x08, 0x02, 0x02, 0x04, 0x12, 0x03, 0x68, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02,
+    0x02, 0x05, 0x12, 0x03, 0x68, 0x0b, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x02, 0x01,
+    0x12, 0x03, 0x68, 0x12, 0x1c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x02, 0x03, 0x12, 0x03,
+    0x68, 0x1f, 0x20, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x02, 0x08, 0x12, 0x03, 0x68, 0x21,
+    0x4e, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x08, 0x02, 0x02, 0x08, 0x87, 0x09, 0x12, 0x01, 0x12, 0x03,
+    0x68, 0x22, 0x4d, 0x0a, 0x31, 0x0a, 0x04, 0x04, 0x08, 0x02, 0x03, 0x12, 0x03, 0x6b, 0x02, 0x21,
+    0x1a, 0x24, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0xe6, 0xa0, 0x87, 0xe7, 0xad, 0xbe, 0x20,
+    0xe5, 0xa6, 0x82, 0x20, 0x66, 0x66, 0x6d, 0x70, 0x65, 0x67, 0x3d, 0x61, 0x76, 0x31, 0x20, 0x63,
+    0x70, 0x75, 0x3d, 0x31, 0x36, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x03, 0x06, 0x12,
+    0x03, 0x6b, 0x02, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x03, 0x01, 0x12, 0x03, 0x6b,
+    0x16, 0x1c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x03, 0x03, 0x12, 0x03, 0x6b, 0x1f, 0x20,
+    0x0a, 0x33, 0x0a, 0x04, 0x04, 0x08, 0x02, 0x04, 0x12, 0x03, 0x6e, 0x02, 0x3d, 0x1a, 0x26, 0x20,
+    0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0xe5, 0x90, 0x8c, 0xe6, 0x97, 0xb6, 0xe5, 0xa4, 0x84, 0xe7,
+    0x90, 0x86, 0xe7, 0x9a, 0x84, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0xe6, 0x95, 0xb0, 0xe4, 0xb8,
+    0x8a, 0xe9, 0x99, 0x90, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x04, 0x05, 0x12, 0x03,
+    0x6e, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x04, 0x01, 0x12, 0x03, 0x6e, 0x08,
+    0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x04, 0x03, 0x12, 0x03, 0x6e, 0x16, 0x17, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x04, 0x08, 0x12, 0x03, 0x6e, 0x18, 0x3c, 0x0a, 0x10, 0x0a,
+    0x09, 0x04, 0x08, 0x02, 0x04, 0x08, 0x87, 0x09, 0x03, 0x05, 0x12, 0x03, 0x6e, 0x19, 0x3b, 0x0a,
+    0x09, 0x0a, 0x02, 0x04, 0x09, 0x12, 0x03, 0x71, 0x00, 0x21, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x09,
+    0x01, 0x12, 0x03, 0x71, 0x08, 0x1e, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0a, 0x12, 0x04, 0x73, 0x00,
+    0x75, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0a, 0x01, 0x12, 0x03, 0x73, 0x08, 0x1f, 0x0a, 0x0b,
+    0x0a, 0x04, 0x04, 0x0a, 0x02, 0x00, 0x12, 0x03, 0x74, 0x02, 0x3a, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x0a, 0x02, 0x00, 0x05, 0x12, 0x03, 0x74, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02,
+    0x00, 0x01, 0x12, 0x03, 0x74, 0x09, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x00, 0x03,
+    0x12, 0x03, 0x74, 0x0e, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x00, 0x08, 0x12, 0x03,
+    0x74, 0x10, 0x39, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x0a, 0x02, 0x00, 0x08, 0x87, 0x09, 0x0e, 0x02,
+    0x12, 0x03, 0x74, 0x11, 0x38, 0x0a, 0x09, 0x0a, 0x02, 0x04, 0x0b, 0x12, 0x03, 0x77, 0x00, 0x23,
+    0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0b, 0x01, 0x12, 0x03, 0x77, 0x08, 0x20, 0x0a, 0x0b, 0x0a, 0x02,
+    0x04, 0x0c, 0x12, 0x05, 0x79, 0x00, 0x82, 0x01, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0c, 0x01,
+    0x12, 0x03, 0x79, 0x08, 0x1a, 0x0a, 0x31, 0x0a, 0x04, 0x04, 0x0c, 0x02, 0x00, 0x12, 0x03, 0x7b,
+    0x02, 0x17, 0x1a, 0x24, 0x20, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e,
+    0x8b, 0xe5, 0x90, 0x8d, 0x20, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe5, 0x88, 0x99, 0xe4, 0xb8,
+    0x8d, 0xe8, 0xbf, 0x87, 0xe6, 0xbb, 0xa4, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x00,
+    0x05, 0x12, 0x03, 0x7b, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x00, 0x01, 0x12,
+    0x03, 0x7b, 0x09, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x00, 0x03, 0x12, 0x03, 0x7b,
+    0x15, 0x16, 0x0a, 0x43, 0x0a, 0x04, 0x04, 0x0c, 0x02, 0x01, 0x12, 0x03, 0x7e, 0x02, 0x23, 0x1a,
+    0x36, 0x20, 0xe6, 0xa0, 0x87, 0xe7, 0xad, 0xbe, 0xe9, 0x80, 0x89, 0xe6, 0x8b, 0xa9, 0xe5, 0x99,
+    0xa8, 0x20, 0xe5, 0x8f, 0xaa, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0xa0, 0x87, 0xe7, 0xad,
+    0xbe, 0xe5, 0x85, 0xa8, 0xe9, 0x83, 0xa8, 0xe5, 0x8c, 0xb9, 0xe9, 0x85, 0x8d, 0xe7, 0x9a, 0x84,
+    0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x01, 0x06,
+    0x12, 0x03, 0x7e, 0x02, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x01, 0x01, 0x12, 0x03,
+    0x7e, 0x16, 0x1e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x01, 0x03, 0x12, 0x03, 0x7e, 0x21,
+    0x22, 0x0a, 0x2e, 0x0a, 0x04, 0x04, 0x0c, 0x02, 0x02, 0x12, 0x04, 0x81, 0x01, 0x02, 0x1b, 0x1a,
+    0x20, 0x20, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x8c, 0x85, 0xe5, 0x90, 0xab, 0xe5, 0xb7,
+    0xb2, 0xe7, 0xa6, 0xbb, 0xe7, 0xba, 0xbf, 0xe7, 0x9a, 0x84, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+    0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x02, 0x05, 0x12, 0x04, 0x81, 0x01, 0x02, 0x06,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x02, 0x01, 0x12, 0x04, 0x81, 0x01, 0x07, 0x16, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x02, 0x03, 0x12, 0x04, 0x81, 0x01, 0x19, 0x1a, 0x0a, 0x0c,
+    0x0a, 0x02, 0x04, 0x0d, 0x12, 0x06, 0x84, 0x01, 0x00, 0x86, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03,
+    0x04, 0x0d, 0x01, 0x12, 0x04, 0x84, 0x01, 0x08, 0x1b, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x0d, 0x02,
+    0x00, 0x12, 0x04, 0x85, 0x01, 0x02, 0x3a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0d, 0x02, 0x00, 0x04,
+    0x12, 0x04, 0x85, 0x01, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0d, 0x02, 0x00, 0x06, 0x12,
+    0x04, 0x85, 0x01, 0x0b, 0x2d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0d, 0x02, 0x00, 0x01, 0x12, 0x04,
+    0x85, 0x01, 0x2e, 0x35, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0d, 0x02, 0x00, 0x03, 0x12, 0x04, 0x85,
+    0x01, 0x38, 0x39, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 ];
 include!("conductor.api.workerservice.v1.tonic.rs");
 // @@protoc_insertion_point(module)
\ No newline at end of file
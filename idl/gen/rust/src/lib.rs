@@ -11,6 +11,14 @@ pub mod comment {
 }
 pub mod conductor {
     pub mod api {
+        pub mod callback {
+            #[cfg(feature = "conductor-api-callback-v1")]
+            // @@protoc_insertion_point(attribute:conductor.api.callback.v1)
+            pub mod v1 {
+                include!("conductor/api/callback/v1/conductor.api.callback.v1.rs");
+                // @@protoc_insertion_point(conductor.api.callback.v1)
+            }
+        }
         pub mod namespace {
             #[cfg(feature = "conductor-api-namespace-v1")]
             // @@protoc_insertion_point(attribute:conductor.api.namespace.v1)
@@ -27,6 +35,22 @@ pub mod conductor {
                 // @@protoc_insertion_point(conductor.api.namespaceservice.v1)
             }
         }
+        pub mod schedule {
+            #[cfg(feature = "conductor-api-schedule-v1")]
+            // @@protoc_insertion_point(attribute:conductor.api.schedule.v1)
+            pub mod v1 {
+                include!("conductor/api/schedule/v1/conductor.api.schedule.v1.rs");
+                // @@protoc_insertion_point(conductor.api.schedule.v1)
+            }
+        }
+        pub mod scheduleservice {
+            #[cfg(feature = "conductor-api-scheduleservice-v1")]
+            // @@protoc_insertion_point(attribute:conductor.api.scheduleservice.v1)
+            pub mod v1 {
+                include!("conductor/api/scheduleservice/v1/conductor.api.scheduleservice.v1.rs");
+                // @@protoc_insertion_point(conductor.api.scheduleservice.v1)
+            }
+        }
         pub mod task {
             #[cfg(feature = "conductor-api-task-v1")]
             // @@protoc_insertion_point(attribute:conductor.api.task.v1)
@@ -188,11 +188,28 @@ impl SystemMsgStatus {
         }
     }
 }
-#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct SystemNoticeContent {
+    /// 被通知的人
+    #[prost(int64, tag = "1")]
+    pub target_uid: i64,
+    /// 通知标题
+    #[prost(string, tag = "2")]
+    pub title: ::prost::alloc::string::String,
+    /// 通知内容
+    #[prost(string, tag = "3")]
+    pub content: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
 pub struct NotifySystemNoticeRequest {
+    #[prost(message, repeated, tag = "1")]
+    pub contents: ::prost::alloc::vec::Vec<SystemNoticeContent>,
 }
-#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+#[derive(Clone, PartialEq, ::prost::Message)]
 pub struct NotifySystemNoticeResponse {
+    /// uid -> msgIds
+    #[prost(map = "int64, message", tag = "1")]
+    pub msg_ids: ::std::collections::HashMap<i64, super::super::msg::StringList>,
 }
 #[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
 pub struct ReplyMsgContent {
@@ -710,240 +727,286 @@ pub const FILE_DESCRIPTOR_SET: &[u8] = &[
     0x04, 0x0d, 0x02, 0x01, 0x01, 0x12, 0x03, 0x7f, 0x08, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0d,
     0x02, 0x01, 0x03, 0x12, 0x03, 0x7f, 0x0e, 0x0f, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0e, 0x12, 0x04,
     0x82, 0x01, 0x00, 0x1c, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x0e, 0x01, 0x12, 0x04, 0x82, 0x01, 0x08,
-    0x19, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33, 0x0a, 0x8f, 0x1d, 0x0a, 0x20, 0x6d, 0x73,
+    0x19, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33, 0x0a, 0xe6, 0x22, 0x0a, 0x20, 0x6d, 0x73,
     0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x76,
     0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13,
     0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
     0x2e, 0x76, 0x31, 0x1a, 0x18, 0x65, 0x78, 0x74, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
     0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x6d,
     0x73, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x73, 0x67, 0x2f, 0x6d, 0x73, 0x67,
-    0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x1b, 0x0a, 0x19, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79,
-    0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-    0x65, 0x73, 0x74, 0x22, 0x1c, 0x0a, 0x1a, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x79, 0x73,
-    0x74, 0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-    0x65, 0x22, 0x5c, 0x0a, 0x0f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e,
-    0x74, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-    0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-    0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67,
-    0x65, 0x74, 0x55, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-    0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22,
-    0x59, 0x0a, 0x15, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x4d, 0x73,
-    0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74,
-    0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x73, 0x67,
-    0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31,
-    0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-    0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x16, 0x4e,
-    0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73,
-    0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x73,
-    0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61,
-    0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74,
-    0x69, 0x66, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
-    0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-    0x06, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x73, 0x1a, 0x54, 0x0a, 0x0b, 0x4d, 0x73, 0x67, 0x49, 0x64,
-    0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-    0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-    0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e,
-    0x61, 0x70, 0x69, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69,
-    0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5e, 0x0a,
-    0x11, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-    0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-    0x03, 0x75, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75,
-    0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-    0x55, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03,
-    0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x5d, 0x0a,
-    0x17, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73,
-    0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x08, 0x6d, 0x65, 0x6e, 0x74,
-    0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x73, 0x67,
-    0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31,
-    0x2e, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-    0x6e, 0x74, 0x52, 0x08, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xc4, 0x01, 0x0a,
-    0x18, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73,
-    0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x07, 0x6d, 0x73, 0x67,
-    0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6d, 0x73, 0x67,
-    0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31,
-    0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73,
-    0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x73,
-    0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x73, 0x1a, 0x54, 0x0a,
-    0x0b, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-    0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f,
-    0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-    0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x74,
-    0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-    0x02, 0x38, 0x01, 0x22, 0x5b, 0x0a, 0x0e, 0x4c, 0x69, 0x6b, 0x65, 0x4d, 0x73, 0x67, 0x43, 0x6f,
-    0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-    0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65,
-    0x74, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x61, 0x72,
-    0x67, 0x65, 0x74, 0x55, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-    0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-    0x22, 0x58, 0x0a, 0x15, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x4d,
-    0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x08, 0x63, 0x6f, 0x6e,
-    0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x73,
-    0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76,
-    0x31, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-    0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x16, 0x4e,
-    0x6f, 0x74, 0x69, 0x66, 0x79, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73,
-    0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x73,
-    0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61,
-    0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74,
-    0x69, 0x66, 0x79, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
-    0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-    0x06, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x73, 0x1a, 0x54, 0x0a, 0x0b, 0x4d, 0x73, 0x67, 0x49, 0x64,
-    0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-    0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-    0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e,
-    0x61, 0x70, 0x69, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69,
-    0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0xdb, 0x03,
-    0x0a, 0x13, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
-    0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x75, 0x0a, 0x12, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53,
-    0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x12, 0x2e, 0x2e, 0x6d, 0x73,
-    0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76,
-    0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x6f,
-    0x74, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6d, 0x73,
+    0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x64, 0x0a, 0x13, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+    0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a,
+    0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+    0x03, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
+    0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+    0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+    0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x61, 0x0a, 0x19,
+    0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69,
+    0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x08, 0x63, 0x6f, 0x6e,
+    0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x73,
     0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76,
-    0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x6f,
-    0x74, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x0e,
-    0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x4d, 0x73, 0x67, 0x12, 0x2a,
-    0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65,
-    0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79,
-    0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6d, 0x73, 0x67,
-    0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31,
-    0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x4d, 0x73, 0x67, 0x52,
-    0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x10, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-    0x79, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x12, 0x2c, 0x2e, 0x6d, 0x73,
-    0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76,
-    0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
-    0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6d, 0x73, 0x67, 0x65,
-    0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
-    0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67,
-    0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x0e, 0x4e, 0x6f, 0x74, 0x69,
-    0x66, 0x79, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x4d, 0x73, 0x67, 0x12, 0x2a, 0x2e, 0x6d, 0x73, 0x67,
+    0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x43, 0x6f,
+    0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x22,
+    0xc8, 0x01, 0x0a, 0x1a, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+    0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54,
+    0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+    0x3b, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74,
+    0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x79, 0x73, 0x74,
+    0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+    0x2e, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6d, 0x73,
+    0x67, 0x49, 0x64, 0x73, 0x1a, 0x54, 0x0a, 0x0b, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x73, 0x45, 0x6e,
+    0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+    0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+    0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52,
+    0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5c, 0x0a, 0x0f, 0x52, 0x65,
+    0x70, 0x6c, 0x79, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a,
+    0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12,
+    0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
+    0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x69, 0x64, 0x12, 0x18,
+    0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+    0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x59, 0x0a, 0x15, 0x4e, 0x6f, 0x74, 0x69,
+    0x66, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+    0x74, 0x12, 0x40, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+    0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+    0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x4d,
+    0x73, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+    0x6e, 0x74, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x16, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65,
+    0x70, 0x6c, 0x79, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50,
+    0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+    0x37, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74,
+    0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x70, 0x6c,
+    0x79, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x73, 0x67,
+    0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x73,
+    0x1a, 0x54, 0x0a, 0x0b, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+    0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65,
+    0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+    0x32, 0x19, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x73, 0x67,
+    0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c,
+    0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5e, 0x0a, 0x11, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+    0x6e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75,
+    0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x1d, 0x0a,
+    0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+    0x03, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07,
+    0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63,
+    0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x5d, 0x0a, 0x17, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79,
+    0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+    0x74, 0x12, 0x42, 0x0a, 0x08, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+    0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+    0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+    0x6e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x6d, 0x65, 0x6e,
+    0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xc4, 0x01, 0x0a, 0x18, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79,
+    0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+    0x73, 0x65, 0x12, 0x52, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+    0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+    0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79,
+    0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+    0x73, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
+    0x6d, 0x73, 0x67, 0x49, 0x64, 0x73, 0x1a, 0x54, 0x0a, 0x0b, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x73,
+    0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+    0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+    0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73,
+    0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5b, 0x0a, 0x0e,
+    0x4c, 0x69, 0x6b, 0x65, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x10,
+    0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64,
+    0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02,
+    0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x69, 0x64, 0x12,
+    0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+    0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x58, 0x0a, 0x15, 0x4e, 0x6f, 0x74,
+    0x69, 0x66, 0x79, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+    0x73, 0x74, 0x12, 0x3f, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
+    0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x4d,
+    0x73, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+    0x6e, 0x74, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x16, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4c, 0x69,
+    0x6b, 0x65, 0x73, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50,
+    0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+    0x37, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74,
+    0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4c, 0x69, 0x6b, 0x65,
+    0x73, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x73, 0x67,
+    0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x73,
+    0x1a, 0x54, 0x0a, 0x0b, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+    0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65,
+    0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+    0x32, 0x19, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x73, 0x67,
+    0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c,
+    0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0xdb, 0x03, 0x0a, 0x13, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+    0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x75,
+    0x0a, 0x12, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x6f,
+    0x74, 0x69, 0x63, 0x65, 0x12, 0x2e, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+    0x79, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71,
+    0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+    0x79, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73,
+    0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x0e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52,
+    0x65, 0x70, 0x6c, 0x79, 0x4d, 0x73, 0x67, 0x12, 0x2a, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e,
+    0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f,
+    0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75,
+    0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+    0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79,
+    0x52, 0x65, 0x70, 0x6c, 0x79, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+    0x12, 0x6f, 0x0a, 0x10, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+    0x6e, 0x4d, 0x73, 0x67, 0x12, 0x2c, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+    0x79, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+    0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
+    0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4d,
+    0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+    0x65, 0x12, 0x69, 0x0a, 0x0e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4c, 0x69, 0x6b, 0x65, 0x73,
+    0x4d, 0x73, 0x67, 0x12, 0x2a, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+    0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79,
+    0x4c, 0x69, 0x6b, 0x65, 0x73, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+    0x2b, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74,
+    0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4c, 0x69, 0x6b, 0x65,
+    0x73, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x06, 0x8a, 0xb5,
+    0x18, 0x02, 0x08, 0x01, 0x42, 0xd5, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x73, 0x67,
     0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31,
-    0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x4d, 0x73, 0x67, 0x52,
-    0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61,
-    0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74,
-    0x69, 0x66, 0x79, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
-    0x6e, 0x73, 0x65, 0x1a, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x42, 0xd5, 0x01, 0x0a, 0x17,
-    0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79,
-    0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x50,
-    0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-    0x6f, 0x6d, 0x2f, 0x72, 0x79, 0x61, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x62, 0x6f, 0x6f, 0x6b, 0x73,
-    0x2f, 0x77, 0x68, 0x69, 0x6d, 0x65, 0x72, 0x2f, 0x69, 0x64, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f,
-    0x67, 0x6f, 0x2f, 0x6d, 0x73, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x79, 0x73,
-    0x74, 0x65, 0x6d, 0x2f, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x41, 0x53, 0xaa, 0x02, 0x13, 0x4d,
-    0x73, 0x67, 0x65, 0x72, 0x2e, 0x41, 0x70, 0x69, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e,
-    0x56, 0x31, 0xca, 0x02, 0x13, 0x4d, 0x73, 0x67, 0x65, 0x72, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x53,
-    0x79, 0x73, 0x74, 0x65, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x4d, 0x73, 0x67, 0x65, 0x72,
-    0x5c, 0x41, 0x70, 0x69, 0x5c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47,
-    0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x4d, 0x73, 0x67,
-    0x65, 0x72, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a, 0x3a, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x3a,
-    0x3a, 0x56, 0x31, 0x4a, 0xad, 0x0d, 0x0a, 0x06, 0x12, 0x04, 0x00, 0x00, 0x3f, 0x01, 0x0a, 0x08,
-    0x0a, 0x01, 0x0c, 0x12, 0x03, 0x00, 0x00, 0x12, 0x0a, 0x08, 0x0a, 0x01, 0x02, 0x12, 0x03, 0x02,
-    0x00, 0x1c, 0x0a, 0x08, 0x0a, 0x01, 0x08, 0x12, 0x03, 0x04, 0x00, 0x55, 0x0a, 0x09, 0x0a, 0x02,
-    0x08, 0x0b, 0x12, 0x03, 0x04, 0x00, 0x55, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x00, 0x12, 0x03, 0x06,
-    0x00, 0x22, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x01, 0x12, 0x03, 0x07, 0x00, 0x21, 0x0a, 0x0a, 0x0a,
-    0x02, 0x06, 0x00, 0x12, 0x04, 0x09, 0x00, 0x15, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x06, 0x00, 0x01,
-    0x12, 0x03, 0x09, 0x08, 0x1b, 0x0a, 0x0a, 0x0a, 0x03, 0x06, 0x00, 0x03, 0x12, 0x03, 0x0a, 0x02,
-    0x3e, 0x0a, 0x0e, 0x0a, 0x07, 0x06, 0x00, 0x03, 0xd1, 0x86, 0x03, 0x01, 0x12, 0x03, 0x0a, 0x02,
-    0x3e, 0x0a, 0x1c, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x00, 0x12, 0x04, 0x0c, 0x02, 0x0d, 0x2b, 0x1a,
-    0x0e, 0x20, 0xe7, 0xb3, 0xbb, 0xe7, 0xbb, 0x9f, 0xe9, 0x80, 0x9a, 0xe7, 0x9f, 0xa5, 0x0a, 0x0a,
-    0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x0c, 0x06, 0x18, 0x0a, 0x0c, 0x0a,
-    0x05, 0x06, 0x00, 0x02, 0x00, 0x02, 0x12, 0x03, 0x0c, 0x19, 0x32, 0x0a, 0x0c, 0x0a, 0x05, 0x06,
-    0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x0d, 0x0f, 0x29, 0x0a, 0x1b, 0x0a, 0x04, 0x06, 0x00, 0x02,
-    0x01, 0x12, 0x03, 0x0f, 0x02, 0x4d, 0x1a, 0x0e, 0x20, 0xe5, 0x9b, 0x9e, 0xe5, 0xa4, 0x8d, 0xe6,
-    0x88, 0x91, 0xe7, 0x9a, 0x84, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x01, 0x12,
-    0x03, 0x0f, 0x06, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x02, 0x12, 0x03, 0x0f,
-    0x15, 0x2a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x03, 0x12, 0x03, 0x0f, 0x35, 0x4b,
-    0x0a, 0x17, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x02, 0x12, 0x04, 0x11, 0x02, 0x12, 0x29, 0x1a, 0x09,
-    0x20, 0x40, 0xe6, 0x88, 0x91, 0xe7, 0x9a, 0x84, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02,
-    0x02, 0x01, 0x12, 0x03, 0x11, 0x06, 0x16, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x02,
-    0x12, 0x03, 0x11, 0x17, 0x2e, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x03, 0x12, 0x03,
-    0x12, 0x0f, 0x27, 0x0a, 0x1b, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x03, 0x12, 0x03, 0x14, 0x02, 0x4d,
-    0x1a, 0x0e, 0x20, 0xe6, 0x94, 0xb6, 0xe5, 0x88, 0xb0, 0xe7, 0x9a, 0x84, 0xe8, 0xb5, 0x9e, 0x0a,
-    0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x03, 0x01, 0x12, 0x03, 0x14, 0x06, 0x14, 0x0a, 0x0c,
-    0x0a, 0x05, 0x06, 0x00, 0x02, 0x03, 0x02, 0x12, 0x03, 0x14, 0x15, 0x2a, 0x0a, 0x0c, 0x0a, 0x05,
-    0x06, 0x00, 0x02, 0x03, 0x03, 0x12, 0x03, 0x14, 0x35, 0x4b, 0x0a, 0x09, 0x0a, 0x02, 0x04, 0x00,
-    0x12, 0x03, 0x17, 0x00, 0x24, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x00, 0x01, 0x12, 0x03, 0x17, 0x08,
-    0x21, 0x0a, 0x09, 0x0a, 0x02, 0x04, 0x01, 0x12, 0x03, 0x19, 0x00, 0x25, 0x0a, 0x0a, 0x0a, 0x03,
-    0x04, 0x01, 0x01, 0x12, 0x03, 0x19, 0x08, 0x22, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x02, 0x12, 0x04,
-    0x1b, 0x00, 0x1f, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x02, 0x01, 0x12, 0x03, 0x1b, 0x08, 0x17,
-    0x0a, 0x18, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x00, 0x12, 0x03, 0x1c, 0x02, 0x10, 0x22, 0x0b, 0x20,
-    0xe5, 0x8f, 0x91, 0xe8, 0xb5, 0xb7, 0xe8, 0x80, 0x85, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02,
-    0x02, 0x00, 0x05, 0x12, 0x03, 0x1c, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00,
-    0x01, 0x12, 0x03, 0x1c, 0x08, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x03, 0x12,
-    0x03, 0x1c, 0x0e, 0x0f, 0x0a, 0x1e, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x01, 0x12, 0x03, 0x1d, 0x02,
-    0x17, 0x22, 0x11, 0x20, 0xe8, 0xa2, 0xab, 0xe5, 0x9b, 0x9e, 0xe5, 0xa4, 0x8d, 0xe7, 0x9a, 0x84,
-    0xe4, 0xba, 0xba, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01, 0x05, 0x12, 0x03, 0x1d,
-    0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01, 0x01, 0x12, 0x03, 0x1d, 0x08, 0x12,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01, 0x03, 0x12, 0x03, 0x1d, 0x15, 0x16, 0x0a, 0x1e,
-    0x0a, 0x04, 0x04, 0x02, 0x02, 0x02, 0x12, 0x03, 0x1e, 0x02, 0x14, 0x22, 0x11, 0x20, 0xe8, 0x87,
-    0xaa, 0xe5, 0xae, 0x9a, 0xe4, 0xb9, 0x89, 0xe5, 0x86, 0x85, 0xe5, 0xae, 0xb9, 0x0a, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x02, 0x02, 0x02, 0x05, 0x12, 0x03, 0x1e, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x02, 0x02, 0x02, 0x01, 0x12, 0x03, 0x1e, 0x08, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02,
-    0x02, 0x02, 0x03, 0x12, 0x03, 0x1e, 0x12, 0x13, 0x0a, 0x09, 0x0a, 0x02, 0x04, 0x03, 0x12, 0x03,
-    0x21, 0x00, 0x48, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x03, 0x01, 0x12, 0x03, 0x21, 0x08, 0x1d, 0x0a,
-    0x0b, 0x0a, 0x04, 0x04, 0x03, 0x02, 0x00, 0x12, 0x03, 0x21, 0x20, 0x46, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x03, 0x02, 0x00, 0x04, 0x12, 0x03, 0x21, 0x20, 0x28, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03,
-    0x02, 0x00, 0x06, 0x12, 0x03, 0x21, 0x29, 0x38, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00,
-    0x01, 0x12, 0x03, 0x21, 0x39, 0x41, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x03, 0x12,
-    0x03, 0x21, 0x44, 0x45, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x04, 0x12, 0x04, 0x23, 0x00, 0x25, 0x01,
-    0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x04, 0x01, 0x12, 0x03, 0x23, 0x08, 0x1e, 0x0a, 0x0b, 0x0a, 0x04,
-    0x04, 0x04, 0x02, 0x00, 0x12, 0x03, 0x24, 0x02, 0x33, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02,
-    0x00, 0x06, 0x12, 0x03, 0x24, 0x02, 0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x01,
-    0x12, 0x03, 0x24, 0x27, 0x2e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x03, 0x12, 0x03,
-    0x24, 0x31, 0x32, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x05, 0x12, 0x04, 0x27, 0x00, 0x2b, 0x01, 0x0a,
-    0x0a, 0x0a, 0x03, 0x04, 0x05, 0x01, 0x12, 0x03, 0x27, 0x08, 0x19, 0x0a, 0x18, 0x0a, 0x04, 0x04,
-    0x05, 0x02, 0x00, 0x12, 0x03, 0x28, 0x02, 0x10, 0x22, 0x0b, 0x20, 0xe5, 0x8f, 0x91, 0xe8, 0xb5,
-    0xb7, 0xe8, 0x80, 0x85, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x00, 0x05, 0x12, 0x03,
-    0x28, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x00, 0x01, 0x12, 0x03, 0x28, 0x08,
-    0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x00, 0x03, 0x12, 0x03, 0x28, 0x0e, 0x0f, 0x0a,
-    0x19, 0x0a, 0x04, 0x04, 0x05, 0x02, 0x01, 0x12, 0x03, 0x29, 0x02, 0x17, 0x22, 0x0c, 0x20, 0xe8,
-    0xa2, 0xab, 0x40, 0xe7, 0x9a, 0x84, 0xe4, 0xba, 0xba, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05,
-    0x02, 0x01, 0x05, 0x12, 0x03, 0x29, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x01,
-    0x01, 0x12, 0x03, 0x29, 0x08, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x01, 0x03, 0x12,
-    0x03, 0x29, 0x15, 0x16, 0x0a, 0x1e, 0x0a, 0x04, 0x04, 0x05, 0x02, 0x02, 0x12, 0x03, 0x2a, 0x02,
+    0x42, 0x0b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+    0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x79, 0x61, 0x6e,
+    0x72, 0x65, 0x61, 0x64, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x77, 0x68, 0x69, 0x6d, 0x65, 0x72,
+    0x2f, 0x69, 0x64, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x6d, 0x73, 0x67, 0x65,
+    0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x76, 0x31, 0xa2,
+    0x02, 0x03, 0x4d, 0x41, 0x53, 0xaa, 0x02, 0x13, 0x4d, 0x73, 0x67, 0x65, 0x72, 0x2e, 0x41, 0x70,
+    0x69, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x4d, 0x73,
+    0x67, 0x65, 0x72, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5c, 0x56,
+    0x31, 0xe2, 0x02, 0x1f, 0x4d, 0x73, 0x67, 0x65, 0x72, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x53, 0x79,
+    0x73, 0x74, 0x65, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+    0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x4d, 0x73, 0x67, 0x65, 0x72, 0x3a, 0x3a, 0x41, 0x70, 0x69,
+    0x3a, 0x3a, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x4a, 0xab, 0x10, 0x0a,
+    0x06, 0x12, 0x04, 0x00, 0x00, 0x48, 0x01, 0x0a, 0x08, 0x0a, 0x01, 0x0c, 0x12, 0x03, 0x00, 0x00,
+    0x12, 0x0a, 0x08, 0x0a, 0x01, 0x02, 0x12, 0x03, 0x02, 0x00, 0x1c, 0x0a, 0x08, 0x0a, 0x01, 0x08,
+    0x12, 0x03, 0x04, 0x00, 0x55, 0x0a, 0x09, 0x0a, 0x02, 0x08, 0x0b, 0x12, 0x03, 0x04, 0x00, 0x55,
+    0x0a, 0x09, 0x0a, 0x02, 0x03, 0x00, 0x12, 0x03, 0x06, 0x00, 0x22, 0x0a, 0x09, 0x0a, 0x02, 0x03,
+    0x01, 0x12, 0x03, 0x07, 0x00, 0x21, 0x0a, 0x0a, 0x0a, 0x02, 0x06, 0x00, 0x12, 0x04, 0x09, 0x00,
+    0x15, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x06, 0x00, 0x01, 0x12, 0x03, 0x09, 0x08, 0x1b, 0x0a, 0x0a,
+    0x0a, 0x03, 0x06, 0x00, 0x03, 0x12, 0x03, 0x0a, 0x02, 0x3e, 0x0a, 0x0e, 0x0a, 0x07, 0x06, 0x00,
+    0x03, 0xd1, 0x86, 0x03, 0x01, 0x12, 0x03, 0x0a, 0x02, 0x3e, 0x0a, 0x1c, 0x0a, 0x04, 0x06, 0x00,
+    0x02, 0x00, 0x12, 0x04, 0x0c, 0x02, 0x0d, 0x2b, 0x1a, 0x0e, 0x20, 0xe7, 0xb3, 0xbb, 0xe7, 0xbb,
+    0x9f, 0xe9, 0x80, 0x9a, 0xe7, 0x9f, 0xa5, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00,
+    0x01, 0x12, 0x03, 0x0c, 0x06, 0x18, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x02, 0x12,
+    0x03, 0x0c, 0x19, 0x32, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x0d,
+    0x0f, 0x29, 0x0a, 0x1b, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x01, 0x12, 0x03, 0x0f, 0x02, 0x4d, 0x1a,
+    0x0e, 0x20, 0xe5, 0x9b, 0x9e, 0xe5, 0xa4, 0x8d, 0xe6, 0x88, 0x91, 0xe7, 0x9a, 0x84, 0x0a, 0x0a,
+    0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x0f, 0x06, 0x14, 0x0a, 0x0c, 0x0a,
+    0x05, 0x06, 0x00, 0x02, 0x01, 0x02, 0x12, 0x03, 0x0f, 0x15, 0x2a, 0x0a, 0x0c, 0x0a, 0x05, 0x06,
+    0x00, 0x02, 0x01, 0x03, 0x12, 0x03, 0x0f, 0x35, 0x4b, 0x0a, 0x17, 0x0a, 0x04, 0x06, 0x00, 0x02,
+    0x02, 0x12, 0x04, 0x11, 0x02, 0x12, 0x29, 0x1a, 0x09, 0x20, 0x40, 0xe6, 0x88, 0x91, 0xe7, 0x9a,
+    0x84, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x01, 0x12, 0x03, 0x11, 0x06, 0x16,
+    0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x02, 0x12, 0x03, 0x11, 0x17, 0x2e, 0x0a, 0x0c,
+    0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x03, 0x12, 0x03, 0x12, 0x0f, 0x27, 0x0a, 0x1b, 0x0a, 0x04,
+    0x06, 0x00, 0x02, 0x03, 0x12, 0x03, 0x14, 0x02, 0x4d, 0x1a, 0x0e, 0x20, 0xe6, 0x94, 0xb6, 0xe5,
+    0x88, 0xb0, 0xe7, 0x9a, 0x84, 0xe8, 0xb5, 0x9e, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02,
+    0x03, 0x01, 0x12, 0x03, 0x14, 0x06, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x03, 0x02,
+    0x12, 0x03, 0x14, 0x15, 0x2a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x03, 0x03, 0x12, 0x03,
+    0x14, 0x35, 0x4b, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x00, 0x12, 0x04, 0x17, 0x00, 0x1b, 0x01, 0x0a,
+    0x0a, 0x0a, 0x03, 0x04, 0x00, 0x01, 0x12, 0x03, 0x17, 0x08, 0x1b, 0x0a, 0x1e, 0x0a, 0x04, 0x04,
+    0x00, 0x02, 0x00, 0x12, 0x03, 0x18, 0x02, 0x17, 0x22, 0x11, 0x20, 0xe8, 0xa2, 0xab, 0xe9, 0x80,
+    0x9a, 0xe7, 0x9f, 0xa5, 0xe7, 0x9a, 0x84, 0xe4, 0xba, 0xba, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x00, 0x02, 0x00, 0x05, 0x12, 0x03, 0x18, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02,
+    0x00, 0x01, 0x12, 0x03, 0x18, 0x08, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x03,
+    0x12, 0x03, 0x18, 0x15, 0x16, 0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x01, 0x12, 0x03, 0x19,
+    0x02, 0x13, 0x22, 0x0e, 0x20, 0xe9, 0x80, 0x9a, 0xe7, 0x9f, 0xa5, 0xe6, 0xa0, 0x87, 0xe9, 0xa2,
+    0x98, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x05, 0x12, 0x03, 0x19, 0x02, 0x08,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x19, 0x09, 0x0e, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x03, 0x12, 0x03, 0x19, 0x11, 0x12, 0x0a, 0x1b, 0x0a, 0x04,
+    0x04, 0x00, 0x02, 0x02, 0x12, 0x03, 0x1a, 0x02, 0x15, 0x22, 0x0e, 0x20, 0xe9, 0x80, 0x9a, 0xe7,
+    0x9f, 0xa5, 0xe5, 0x86, 0x85, 0xe5, 0xae, 0xb9, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02,
+    0x02, 0x05, 0x12, 0x03, 0x1a, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x01,
+    0x12, 0x03, 0x1a, 0x09, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x03, 0x12, 0x03,
+    0x1a, 0x13, 0x14, 0x0a, 0x09, 0x0a, 0x02, 0x04, 0x01, 0x12, 0x03, 0x1d, 0x00, 0x50, 0x0a, 0x0a,
+    0x0a, 0x03, 0x04, 0x01, 0x01, 0x12, 0x03, 0x1d, 0x08, 0x21, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01,
+    0x02, 0x00, 0x12, 0x03, 0x1d, 0x24, 0x4e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x04,
+    0x12, 0x03, 0x1d, 0x24, 0x2c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x06, 0x12, 0x03,
+    0x1d, 0x2d, 0x40, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x01, 0x12, 0x03, 0x1d, 0x41,
+    0x49, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x03, 0x12, 0x03, 0x1d, 0x4c, 0x4d, 0x0a,
+    0x0a, 0x0a, 0x02, 0x04, 0x02, 0x12, 0x04, 0x1f, 0x00, 0x22, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04,
+    0x02, 0x01, 0x12, 0x03, 0x1f, 0x08, 0x22, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x00, 0x12,
+    0x03, 0x21, 0x02, 0x33, 0x1a, 0x0f, 0x20, 0x75, 0x69, 0x64, 0x20, 0x2d, 0x3e, 0x20, 0x6d, 0x73,
+    0x67, 0x49, 0x64, 0x73, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x06, 0x12, 0x03,
+    0x21, 0x02, 0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x01, 0x12, 0x03, 0x21, 0x27,
+    0x2e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x03, 0x12, 0x03, 0x21, 0x31, 0x32, 0x0a,
+    0x0a, 0x0a, 0x02, 0x04, 0x03, 0x12, 0x04, 0x24, 0x00, 0x28, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04,
+    0x03, 0x01, 0x12, 0x03, 0x24, 0x08, 0x17, 0x0a, 0x18, 0x0a, 0x04, 0x04, 0x03, 0x02, 0x00, 0x12,
+    0x03, 0x25, 0x02, 0x10, 0x22, 0x0b, 0x20, 0xe5, 0x8f, 0x91, 0xe8, 0xb5, 0xb7, 0xe8, 0x80, 0x85,
+    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x05, 0x12, 0x03, 0x25, 0x02, 0x07, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x01, 0x12, 0x03, 0x25, 0x08, 0x0b, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x03, 0x02, 0x00, 0x03, 0x12, 0x03, 0x25, 0x0e, 0x0f, 0x0a, 0x1e, 0x0a, 0x04, 0x04,
+    0x03, 0x02, 0x01, 0x12, 0x03, 0x26, 0x02, 0x17, 0x22, 0x11, 0x20, 0xe8, 0xa2, 0xab, 0xe5, 0x9b,
+    0x9e, 0xe5, 0xa4, 0x8d, 0xe7, 0x9a, 0x84, 0xe4, 0xba, 0xba, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x03, 0x02, 0x01, 0x05, 0x12, 0x03, 0x26, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02,
+    0x01, 0x01, 0x12, 0x03, 0x26, 0x08, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01, 0x03,
+    0x12, 0x03, 0x26, 0x15, 0x16, 0x0a, 0x1e, 0x0a, 0x04, 0x04, 0x03, 0x02, 0x02, 0x12, 0x03, 0x27,
+    0x02, 0x14, 0x22, 0x11, 0x20, 0xe8, 0x87, 0xaa, 0xe5, 0xae, 0x9a, 0xe4, 0xb9, 0x89, 0xe5, 0x86,
+    0x85, 0xe5, 0xae, 0xb9, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x02, 0x05, 0x12, 0x03,
+    0x27, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x02, 0x01, 0x12, 0x03, 0x27, 0x08,
+    0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x02, 0x03, 0x12, 0x03, 0x27, 0x12, 0x13, 0x0a,
+    0x09, 0x0a, 0x02, 0x04, 0x04, 0x12, 0x03, 0x2a, 0x00, 0x48, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x04,
+    0x01, 0x12, 0x03, 0x2a, 0x08, 0x1d, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x00, 0x12, 0x03,
+    0x2a, 0x20, 0x46, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x04, 0x12, 0x03, 0x2a, 0x20,
+    0x28, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x06, 0x12, 0x03, 0x2a, 0x29, 0x38, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x01, 0x12, 0x03, 0x2a, 0x39, 0x41, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x04, 0x02, 0x00, 0x03, 0x12, 0x03, 0x2a, 0x44, 0x45, 0x0a, 0x0a, 0x0a, 0x02, 0x04,
+    0x05, 0x12, 0x04, 0x2c, 0x00, 0x2e, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x05, 0x01, 0x12, 0x03,
+    0x2c, 0x08, 0x1e, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x05, 0x02, 0x00, 0x12, 0x03, 0x2d, 0x02, 0x33,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x00, 0x06, 0x12, 0x03, 0x2d, 0x02, 0x26, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x05, 0x02, 0x00, 0x01, 0x12, 0x03, 0x2d, 0x27, 0x2e, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x05, 0x02, 0x00, 0x03, 0x12, 0x03, 0x2d, 0x31, 0x32, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x06,
+    0x12, 0x04, 0x30, 0x00, 0x34, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x06, 0x01, 0x12, 0x03, 0x30,
+    0x08, 0x19, 0x0a, 0x18, 0x0a, 0x04, 0x04, 0x06, 0x02, 0x00, 0x12, 0x03, 0x31, 0x02, 0x10, 0x22,
+    0x0b, 0x20, 0xe5, 0x8f, 0x91, 0xe8, 0xb5, 0xb7, 0xe8, 0x80, 0x85, 0x0a, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x06, 0x02, 0x00, 0x05, 0x12, 0x03, 0x31, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06,
+    0x02, 0x00, 0x01, 0x12, 0x03, 0x31, 0x08, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00,
+    0x03, 0x12, 0x03, 0x31, 0x0e, 0x0f, 0x0a, 0x19, 0x0a, 0x04, 0x04, 0x06, 0x02, 0x01, 0x12, 0x03,
+    0x32, 0x02, 0x17, 0x22, 0x0c, 0x20, 0xe8, 0xa2, 0xab, 0x40, 0xe7, 0x9a, 0x84, 0xe4, 0xba, 0xba,
+    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x01, 0x05, 0x12, 0x03, 0x32, 0x02, 0x07, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x01, 0x01, 0x12, 0x03, 0x32, 0x08, 0x12, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x06, 0x02, 0x01, 0x03, 0x12, 0x03, 0x32, 0x15, 0x16, 0x0a, 0x1e, 0x0a, 0x04, 0x04,
+    0x06, 0x02, 0x02, 0x12, 0x03, 0x33, 0x02, 0x14, 0x22, 0x11, 0x20, 0xe8, 0x87, 0xaa, 0xe5, 0xae,
+    0x9a, 0xe4, 0xb9, 0x89, 0xe5, 0x86, 0x85, 0xe5, 0xae, 0xb9, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x06, 0x02, 0x02, 0x05, 0x12, 0x03, 0x33, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02,
+    0x02, 0x01, 0x12, 0x03, 0x33, 0x08, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x02, 0x03,
+    0x12, 0x03, 0x33, 0x12, 0x13, 0x0a, 0x09, 0x0a, 0x02, 0x04, 0x07, 0x12, 0x03, 0x36, 0x00, 0x4c,
+    0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x07, 0x01, 0x12, 0x03, 0x36, 0x08, 0x1f, 0x0a, 0x0b, 0x0a, 0x04,
+    0x04, 0x07, 0x02, 0x00, 0x12, 0x03, 0x36, 0x22, 0x4a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02,
+    0x00, 0x04, 0x12, 0x03, 0x36, 0x22, 0x2a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x06,
+    0x12, 0x03, 0x36, 0x2b, 0x3c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x01, 0x12, 0x03,
+    0x36, 0x3d, 0x45, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x03, 0x12, 0x03, 0x36, 0x48,
+    0x49, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x08, 0x12, 0x04, 0x38, 0x00, 0x3b, 0x01, 0x0a, 0x0a, 0x0a,
+    0x03, 0x04, 0x08, 0x01, 0x12, 0x03, 0x38, 0x08, 0x20, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x08, 0x02,
+    0x00, 0x12, 0x03, 0x3a, 0x02, 0x33, 0x1a, 0x0f, 0x20, 0x75, 0x69, 0x64, 0x20, 0x2d, 0x3e, 0x20,
+    0x6d, 0x73, 0x67, 0x49, 0x64, 0x73, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x06,
+    0x12, 0x03, 0x3a, 0x02, 0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x01, 0x12, 0x03,
+    0x3a, 0x27, 0x2e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x03, 0x12, 0x03, 0x3a, 0x31,
+    0x32, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x09, 0x12, 0x04, 0x3d, 0x00, 0x41, 0x01, 0x0a, 0x0a, 0x0a,
+    0x03, 0x04, 0x09, 0x01, 0x12, 0x03, 0x3d, 0x08, 0x16, 0x0a, 0x18, 0x0a, 0x04, 0x04, 0x09, 0x02,
+    0x00, 0x12, 0x03, 0x3e, 0x02, 0x10, 0x22, 0x0b, 0x20, 0xe5, 0x8f, 0x91, 0xe8, 0xb5, 0xb7, 0xe8,
+    0x80, 0x85, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x00, 0x05, 0x12, 0x03, 0x3e, 0x02,
+    0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x00, 0x01, 0x12, 0x03, 0x3e, 0x08, 0x0b, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x00, 0x03, 0x12, 0x03, 0x3e, 0x0e, 0x0f, 0x0a, 0x1b, 0x0a,
+    0x04, 0x04, 0x09, 0x02, 0x01, 0x12, 0x03, 0x3f, 0x02, 0x17, 0x22, 0x0e, 0x20, 0xe8, 0xa2, 0xab,
+    0xe9, 0x80, 0x9a, 0xe7, 0x9f, 0xa5, 0xe7, 0x9a, 0x84, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09,
+    0x02, 0x01, 0x05, 0x12, 0x03, 0x3f, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x01,
+    0x01, 0x12, 0x03, 0x3f, 0x08, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x01, 0x03, 0x12,
+    0x03, 0x3f, 0x15, 0x16, 0x0a, 0x1e, 0x0a, 0x04, 0x04, 0x09, 0x02, 0x02, 0x12, 0x03, 0x40, 0x02,
     0x14, 0x22, 0x11, 0x20, 0xe8, 0x87, 0xaa, 0xe5, 0xae, 0x9a, 0xe4, 0xb9, 0x89, 0xe5, 0x86, 0x85,
-    0xe5, 0xae, 0xb9, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x02, 0x05, 0x12, 0x03, 0x2a,
-    0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x02, 0x01, 0x12, 0x03, 0x2a, 0x08, 0x0f,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x02, 0x03, 0x12, 0x03, 0x2a, 0x12, 0x13, 0x0a, 0x09,
-    0x0a, 0x02, 0x04, 0x06, 0x12, 0x03, 0x2d, 0x00, 0x4c, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x06, 0x01,
-    0x12, 0x03, 0x2d, 0x08, 0x1f, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x06, 0x02, 0x00, 0x12, 0x03, 0x2d,
-    0x22, 0x4a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x04, 0x12, 0x03, 0x2d, 0x22, 0x2a,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x06, 0x12, 0x03, 0x2d, 0x2b, 0x3c, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x01, 0x12, 0x03, 0x2d, 0x3d, 0x45, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x06, 0x02, 0x00, 0x03, 0x12, 0x03, 0x2d, 0x48, 0x49, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x07,
-    0x12, 0x04, 0x2f, 0x00, 0x32, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x07, 0x01, 0x12, 0x03, 0x2f,
-    0x08, 0x20, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x07, 0x02, 0x00, 0x12, 0x03, 0x31, 0x02, 0x33, 0x1a,
+    0xe5, 0xae, 0xb9, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x02, 0x05, 0x12, 0x03, 0x40,
+    0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x02, 0x01, 0x12, 0x03, 0x40, 0x08, 0x0f,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x02, 0x03, 0x12, 0x03, 0x40, 0x12, 0x13, 0x0a, 0x09,
+    0x0a, 0x02, 0x04, 0x0a, 0x12, 0x03, 0x43, 0x00, 0x47, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0a, 0x01,
+    0x12, 0x03, 0x43, 0x08, 0x1d, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0a, 0x02, 0x00, 0x12, 0x03, 0x43,
+    0x20, 0x45, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x00, 0x04, 0x12, 0x03, 0x43, 0x20, 0x28,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x00, 0x06, 0x12, 0x03, 0x43, 0x29, 0x37, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x0a, 0x02, 0x00, 0x01, 0x12, 0x03, 0x43, 0x38, 0x40, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x0a, 0x02, 0x00, 0x03, 0x12, 0x03, 0x43, 0x43, 0x44, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0b,
+    0x12, 0x04, 0x45, 0x00, 0x48, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0b, 0x01, 0x12, 0x03, 0x45,
+    0x08, 0x1e, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x0b, 0x02, 0x00, 0x12, 0x03, 0x47, 0x02, 0x33, 0x1a,
     0x0f, 0x20, 0x75, 0x69, 0x64, 0x20, 0x2d, 0x3e, 0x20, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x73, 0x0a,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x06, 0x12, 0x03, 0x31, 0x02, 0x26, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x01, 0x12, 0x03, 0x31, 0x27, 0x2e, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x07, 0x02, 0x00, 0x03, 0x12, 0x03, 0x31, 0x31, 0x32, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x08,
-    0x12, 0x04, 0x34, 0x00, 0x38, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x08, 0x01, 0x12, 0x03, 0x34,
-    0x08, 0x16, 0x0a, 0x18, 0x0a, 0x04, 0x04, 0x08, 0x02, 0x00, 0x12, 0x03, 0x35, 0x02, 0x10, 0x22,
-    0x0b, 0x20, 0xe5, 0x8f, 0x91, 0xe8, 0xb5, 0xb7, 0xe8, 0x80, 0x85, 0x0a, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x08, 0x02, 0x00, 0x05, 0x12, 0x03, 0x35, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08,
-    0x02, 0x00, 0x01, 0x12, 0x03, 0x35, 0x08, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00,
-    0x03, 0x12, 0x03, 0x35, 0x0e, 0x0f, 0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x08, 0x02, 0x01, 0x12, 0x03,
-    0x36, 0x02, 0x17, 0x22, 0x0e, 0x20, 0xe8, 0xa2, 0xab, 0xe9, 0x80, 0x9a, 0xe7, 0x9f, 0xa5, 0xe7,
-    0x9a, 0x84, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x01, 0x05, 0x12, 0x03, 0x36, 0x02,
-    0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x01, 0x01, 0x12, 0x03, 0x36, 0x08, 0x12, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x01, 0x03, 0x12, 0x03, 0x36, 0x15, 0x16, 0x0a, 0x1e, 0x0a,
-    0x04, 0x04, 0x08, 0x02, 0x02, 0x12, 0x03, 0x37, 0x02, 0x14, 0x22, 0x11, 0x20, 0xe8, 0x87, 0xaa,
-    0xe5, 0xae, 0x9a, 0xe4, 0xb9, 0x89, 0xe5, 0x86, 0x85, 0xe5, 0xae, 0xb9, 0x0a, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x08, 0x02, 0x02, 0x05, 0x12, 0x03, 0x37, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x08, 0x02, 0x02, 0x01, 0x12, 0x03, 0x37, 0x08, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02,
-    0x02, 0x03, 0x12, 0x03, 0x37, 0x12, 0x13, 0x0a, 0x09, 0x0a, 0x02, 0x04, 0x09, 0x12, 0x03, 0x3a,
-    0x00, 0x47, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x09, 0x01, 0x12, 0x03, 0x3a, 0x08, 0x1d, 0x0a, 0x0b,
-    0x0a, 0x04, 0x04, 0x09, 0x02, 0x00, 0x12, 0x03, 0x3a, 0x20, 0x45, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x09, 0x02, 0x00, 0x04, 0x12, 0x03, 0x3a, 0x20, 0x28, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02,
-    0x00, 0x06, 0x12, 0x03, 0x3a, 0x29, 0x37, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x00, 0x01,
-    0x12, 0x03, 0x3a, 0x38, 0x40, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x00, 0x03, 0x12, 0x03,
-    0x3a, 0x43, 0x44, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0a, 0x12, 0x04, 0x3c, 0x00, 0x3f, 0x01, 0x0a,
-    0x0a, 0x0a, 0x03, 0x04, 0x0a, 0x01, 0x12, 0x03, 0x3c, 0x08, 0x1e, 0x0a, 0x1c, 0x0a, 0x04, 0x04,
-    0x0a, 0x02, 0x00, 0x12, 0x03, 0x3e, 0x02, 0x33, 0x1a, 0x0f, 0x20, 0x75, 0x69, 0x64, 0x20, 0x2d,
-    0x3e, 0x20, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x73, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02,
-    0x00, 0x06, 0x12, 0x03, 0x3e, 0x02, 0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x00, 0x01,
-    0x12, 0x03, 0x3e, 0x27, 0x2e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x00, 0x03, 0x12, 0x03,
-    0x3e, 0x31, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x00, 0x06, 0x12, 0x03, 0x47, 0x02, 0x26, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x0b, 0x02, 0x00, 0x01, 0x12, 0x03, 0x47, 0x27, 0x2e, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x0b, 0x02, 0x00, 0x03, 0x12, 0x03, 0x47, 0x31, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+    0x6f, 0x33,
 ];
 include!("msger.api.system.v1.tonic.rs");
 // @@protoc_insertion_point(module)
\ No newline at end of file
@@ -60,6 +60,15 @@ pub struct NoteItem {
     /// 笔记生命周期状态(对外状态)
     #[prost(enumeration = "NoteLifeCycleState", tag = "17")]
     pub life_cycle_state: i32,
+    /// 指定可见的用户 仅privacy为CUSTOM时有效
+    #[prost(int64, repeated, tag = "18")]
+    pub audience: ::prost::alloc::vec::Vec<i64>,
+    /// 浏览量
+    #[prost(int64, tag = "19")]
+    pub views: i64,
+    /// 笔记所属的合集
+    #[prost(message, optional, tag = "20")]
+    pub collection: ::core::option::Option<NoteCollectionLink>,
 }
 #[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
 pub struct NoteImageMeta {
@@ -146,6 +155,50 @@ pub struct FeedNoteItemExt {
     pub tags: ::prost::alloc::vec::Vec<NoteTag>,
     #[prost(message, repeated, tag = "2")]
     pub at_users: ::prost::alloc::vec::Vec<NoteAtUser>,
+    /// 笔记所属的合集
+    #[prost(message, optional, tag = "3")]
+    pub collection: ::core::option::Option<NoteCollectionLink>,
+}
+/// 笔记合集 由作者将自己的笔记按顺序组织
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct NoteCollection {
+    #[prost(int64, tag = "1")]
+    pub collection_id: i64,
+    #[prost(int64, tag = "2")]
+    pub owner: i64,
+    #[prost(string, tag = "3")]
+    pub title: ::prost::alloc::string::String,
+    #[prost(string, tag = "4")]
+    pub desc: ::prost::alloc::string::String,
+    /// 仅支持PUBLIC和PRIVATE
+    #[prost(int32, tag = "5")]
+    pub privacy: i32,
+    /// 作为封面的笔记
+    #[prost(int64, tag = "6")]
+    pub cover_note_id: i64,
+    #[prost(int64, tag = "7")]
+    pub note_count: i64,
+    #[prost(int64, tag = "8")]
+    pub ctime: i64,
+    #[prost(int64, tag = "9")]
+    pub utime: i64,
+    /// 合集中按顺序排列的笔记 仅获取合集详情时返回
+    #[prost(int64, repeated, tag = "10")]
+    pub note_ids: ::prost::alloc::vec::Vec<i64>,
+}
+/// 笔记在合集中的位置
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct NoteCollectionLink {
+    #[prost(int64, tag = "1")]
+    pub collection_id: i64,
+    #[prost(string, tag = "2")]
+    pub title: ::prost::alloc::string::String,
+    /// 上一篇笔记 为0时表示没有
+    #[prost(int64, tag = "3")]
+    pub prev_note_id: i64,
+    /// 下一篇笔记 为0时表示没有
+    #[prost(int64, tag = "4")]
+    pub next_note_id: i64,
 }
 #[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
 #[repr(i32)]
@@ -153,6 +206,12 @@ pub enum NotePrivacy {
     Unspecified = 0,
     Public = 1,
     Private = 2,
+    /// 仅粉丝可见
+    Followers = 3,
+    /// 仅互关好友可见
+    MutualFollowers = 4,
+    /// 仅指定用户可见
+    Custom = 5,
 }
 impl NotePrivacy {
     /// String value of the enum field names used in the ProtoBuf definition.
@@ -164,6 +223,9 @@ impl NotePrivacy {
             Self::Unspecified => "NOTE_PRIVACY_UNSPECIFIED",
             Self::Public => "PUBLIC",
             Self::Private => "PRIVATE",
+            Self::Followers => "FOLLOWERS",
+            Self::MutualFollowers => "MUTUAL_FOLLOWERS",
+            Self::Custom => "CUSTOM",
         }
     }
     /// Creates an enum from field names used in the ProtoBuf definition.
@@ -172,6 +234,9 @@ impl NotePrivacy {
             "NOTE_PRIVACY_UNSPECIFIED" => Some(Self::Unspecified),
             "PUBLIC" => Some(Self::Public),
             "PRIVATE" => Some(Self::Private),
+            "FOLLOWERS" => Some(Self::Followers),
+            "MUTUAL_FOLLOWERS" => Some(Self::MutualFollowers),
+            "CUSTOM" => Some(Self::Custom),
             _ => None,
         }
     }
@@ -218,6 +283,12 @@ pub enum NoteLifeCycleState {
     LifeCycleStateRejected = 3,
     /// 被锁定
     LifeCycleStateBanned = 4,
+    /// 草稿
+    LifeCycleStateDraft = 5,
+    /// 等待定时发布
+    LifeCycleStateScheduled = 6,
+    /// 在回收站中
+    LifeCycleStateRecycled = 7,
 }
 impl NoteLifeCycleState {
     /// String value of the enum field names used in the ProtoBuf definition.
@@ -231,6 +302,9 @@ impl NoteLifeCycleState {
             Self::LifeCycleStateAuditing => "LIFE_CYCLE_STATE_AUDITING",
             Self::LifeCycleStateRejected => "LIFE_CYCLE_STATE_REJECTED",
             Self::LifeCycleStateBanned => "LIFE_CYCLE_STATE_BANNED",
+            Self::LifeCycleStateDraft => "LIFE_CYCLE_STATE_DRAFT",
+            Self::LifeCycleStateScheduled => "LIFE_CYCLE_STATE_SCHEDULED",
+            Self::LifeCycleStateRecycled => "LIFE_CYCLE_STATE_RECYCLED",
         }
     }
     /// Creates an enum from field names used in the ProtoBuf definition.
@@ -241,6 +315,9 @@ impl NoteLifeCycleState {
             "LIFE_CYCLE_STATE_AUDITING" => Some(Self::LifeCycleStateAuditing),
             "LIFE_CYCLE_STATE_REJECTED" => Some(Self::LifeCycleStateRejected),
             "LIFE_CYCLE_STATE_BANNED" => Some(Self::LifeCycleStateBanned),
+            "LIFE_CYCLE_STATE_DRAFT" => Some(Self::LifeCycleStateDraft),
+            "LIFE_CYCLE_STATE_SCHEDULED" => Some(Self::LifeCycleStateScheduled),
+            "LIFE_CYCLE_STATE_RECYCLED" => Some(Self::LifeCycleStateRecycled),
             _ => None,
         }
     }
@@ -252,6 +329,10 @@ impl NoteLifeCycleState {
 #[repr(i32)]
 pub enum NoteState {
     Unspecified = 0,
+    /// 草稿
+    Draft = 1,
+    /// 等待定时发布
+    Scheduled = 2,
     /// 资源处理中
     Processing = 10,
     /// 资源处理完成
@@ -264,10 +345,14 @@ pub enum NoteState {
     Rejected = 21,
     /// 审核通过
     AuditPassed = 22,
+    /// 人工复审中
+    AuditReviewing = 23,
     /// 已发布
     Published = 100,
     /// 被封禁
     Banned = 101,
+    /// 已删除 在回收站中等待彻底删除
+    Recycled = 110,
 }
 impl NoteState {
     /// String value of the enum field names used in the ProtoBuf definition.
@@ -277,28 +362,36 @@ impl NoteState {
     pub fn as_str_name(&self) -> &'static str {
         match self {
             Self::Unspecified => "NOTE_STATE_UNSPECIFIED",
+            Self::Draft => "DRAFT",
+            Self::Scheduled => "SCHEDULED",
             Self::Processing => "PROCESSING",
             Self::Processed => "PROCESSED",
             Self::ProcessFailed => "PROCESS_FAILED",
             Self::Auditing => "AUDITING",
             Self::Rejected => "REJECTED",
             Self::AuditPassed => "AUDIT_PASSED",
+            Self::AuditReviewing => "AUDIT_REVIEWING",
             Self::Published => "PUBLISHED",
             Self::Banned => "BANNED",
+            Self::Recycled => "RECYCLED",
         }
     }
     /// Creates an enum from field names used in the ProtoBuf definition.
     pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
         match value {
             "NOTE_STATE_UNSPECIFIED" => Some(Self::Unspecified),
+            "DRAFT" => Some(Self::Draft),
+            "SCHEDULED" => Some(Self::Scheduled),
             "PROCESSING" => Some(Self::Processing),
             "PROCESSED" => Some(Self::Processed),
             "PROCESS_FAILED" => Some(Self::ProcessFailed),
             "AUDITING" => Some(Self::Auditing),
             "REJECTED" => Some(Self::Rejected),
             "AUDIT_PASSED" => Some(Self::AuditPassed),
+            "AUDIT_REVIEWING" => Some(Self::AuditReviewing),
             "PUBLISHED" => Some(Self::Published),
             "BANNED" => Some(Self::Banned),
+            "RECYCLED" => Some(Self::Recycled),
             _ => None,
         }
     }
@@ -340,6 +433,9 @@ pub struct CreateReqBasic {
     pub privacy: i32,
     #[prost(enumeration = "NoteAssetType", tag = "4")]
     pub asset_type: i32,
+    /// 指定可见的用户 仅privacy为CUSTOM时有效
+    #[prost(int64, repeated, tag = "5")]
+    pub audience: ::prost::alloc::vec::Vec<i64>,
 }
 #[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
 pub struct CreateReqImage {
@@ -382,6 +478,12 @@ pub struct CreateNoteRequest {
     pub at_users: ::prost::alloc::vec::Vec<NoteAtUser>,
     #[prost(message, optional, tag = "5")]
     pub video: ::core::option::Option<CreateReqVideo>,
+    /// 保存为草稿 草稿不会进入发布流程
+    #[prost(bool, tag = "6")]
+    pub draft: bool,
+    /// 定时发布时间 unix秒 为0表示立即发布
+    #[prost(int64, tag = "7")]
+    pub publish_at: i64,
 }
 #[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
 pub struct CreateNoteResponse {
@@ -461,6 +563,257 @@ pub struct PageListNoteResponse {
     #[prost(message, repeated, tag = "2")]
     pub items: ::prost::alloc::vec::Vec<NoteItem>,
 }
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct ListDraftsRequest {
+    #[prost(int64, tag = "1")]
+    pub cursor: i64,
+    #[prost(int32, tag = "2")]
+    pub count: i32,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ListDraftsResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub items: ::prost::alloc::vec::Vec<NoteItem>,
+    #[prost(int64, tag = "2")]
+    pub next_cursor: i64,
+    #[prost(bool, tag = "3")]
+    pub has_next: bool,
+}
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct PublishDraftRequest {
+    #[prost(int64, tag = "1")]
+    pub note_id: i64,
+    /// 定时发布时间 unix秒 为0表示立即发布
+    #[prost(int64, tag = "2")]
+    pub publish_at: i64,
+}
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct PublishDraftResponse {
+}
+/// 笔记某个版本的完整内容
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct NoteRevisionContent {
+    #[prost(string, tag = "1")]
+    pub title: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub desc: ::prost::alloc::string::String,
+    #[prost(int32, tag = "3")]
+    pub privacy: i32,
+    #[prost(message, repeated, tag = "4")]
+    pub images: ::prost::alloc::vec::Vec<CreateReqImage>,
+    /// 只有通过更新上传了视频时才会记录
+    #[prost(message, optional, tag = "5")]
+    pub video: ::core::option::Option<CreateReqVideo>,
+    /// 笔记的全部资源key
+    #[prost(string, repeated, tag = "6")]
+    pub asset_keys: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    #[prost(int64, repeated, tag = "7")]
+    pub tag_ids: ::prost::alloc::vec::Vec<i64>,
+    #[prost(message, repeated, tag = "8")]
+    pub at_users: ::prost::alloc::vec::Vec<NoteAtUser>,
+}
+/// 和上一个版本相比的变更
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct NoteRevisionDiff {
+    #[prost(bool, tag = "1")]
+    pub title_changed: bool,
+    #[prost(bool, tag = "2")]
+    pub desc_changed: bool,
+    #[prost(bool, tag = "3")]
+    pub privacy_changed: bool,
+    #[prost(string, repeated, tag = "4")]
+    pub added_asset_keys: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    #[prost(string, repeated, tag = "5")]
+    pub removed_asset_keys: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    #[prost(int64, repeated, tag = "6")]
+    pub added_tag_ids: ::prost::alloc::vec::Vec<i64>,
+    #[prost(int64, repeated, tag = "7")]
+    pub removed_tag_ids: ::prost::alloc::vec::Vec<i64>,
+}
+/// 笔记的修订版本 每次更新笔记时生成
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct NoteRevision {
+    #[prost(int64, tag = "1")]
+    pub note_id: i64,
+    #[prost(int64, tag = "2")]
+    pub version: i64,
+    #[prost(message, optional, tag = "3")]
+    pub content: ::core::option::Option<NoteRevisionContent>,
+    #[prost(message, optional, tag = "4")]
+    pub diff: ::core::option::Option<NoteRevisionDiff>,
+    /// 是否为发布后的编辑
+    #[prost(bool, tag = "5")]
+    pub edit_after_publish: bool,
+    #[prost(int64, tag = "6")]
+    pub ctime: i64,
+}
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct ListNoteRevisionsRequest {
+    #[prost(int64, tag = "1")]
+    pub note_id: i64,
+    /// 版本号 为0时从最新版本开始
+    #[prost(int64, tag = "2")]
+    pub cursor: i64,
+    #[prost(int32, tag = "3")]
+    pub count: i32,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ListNoteRevisionsResponse {
+    /// 按版本号倒序
+    #[prost(message, repeated, tag = "1")]
+    pub items: ::prost::alloc::vec::Vec<NoteRevision>,
+    #[prost(int64, tag = "2")]
+    pub next_cursor: i64,
+    #[prost(bool, tag = "3")]
+    pub has_next: bool,
+}
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct GetNoteRevisionRequest {
+    #[prost(int64, tag = "1")]
+    pub note_id: i64,
+    #[prost(int64, tag = "2")]
+    pub version: i64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetNoteRevisionResponse {
+    #[prost(message, optional, tag = "1")]
+    pub revision: ::core::option::Option<NoteRevision>,
+}
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct RestoreNoteRevisionRequest {
+    #[prost(int64, tag = "1")]
+    pub note_id: i64,
+    #[prost(int64, tag = "2")]
+    pub version: i64,
+}
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct RestoreNoteRevisionResponse {
+}
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct ListDeletedNotesRequest {
+    #[prost(int64, tag = "1")]
+    pub cursor: i64,
+    #[prost(int32, tag = "2")]
+    pub count: i32,
+}
+/// 回收站中的笔记
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct DeletedNoteItem {
+    #[prost(message, optional, tag = "1")]
+    pub note: ::core::option::Option<NoteItem>,
+    /// 删除时间
+    #[prost(int64, tag = "2")]
+    pub delete_at: i64,
+    /// 超过该时间后彻底删除 不能再恢复
+    #[prost(int64, tag = "3")]
+    pub expire_at: i64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ListDeletedNotesResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub items: ::prost::alloc::vec::Vec<DeletedNoteItem>,
+    #[prost(int64, tag = "2")]
+    pub next_cursor: i64,
+    #[prost(bool, tag = "3")]
+    pub has_next: bool,
+}
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct RestoreNoteRequest {
+    #[prost(int64, tag = "1")]
+    pub note_id: i64,
+}
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct RestoreNoteResponse {
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct CreateCollectionRequest {
+    #[prost(string, tag = "1")]
+    pub title: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub desc: ::prost::alloc::string::String,
+    #[prost(int32, tag = "3")]
+    pub privacy: i32,
+}
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct CreateCollectionResponse {
+    #[prost(int64, tag = "1")]
+    pub collection_id: i64,
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct UpdateCollectionRequest {
+    #[prost(int64, tag = "1")]
+    pub collection_id: i64,
+    #[prost(string, tag = "2")]
+    pub title: ::prost::alloc::string::String,
+    #[prost(string, tag = "3")]
+    pub desc: ::prost::alloc::string::String,
+    #[prost(int32, tag = "4")]
+    pub privacy: i32,
+    /// 必须是合集中的笔记 为0时使用合集中的第一篇笔记
+    #[prost(int64, tag = "5")]
+    pub cover_note_id: i64,
+}
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct UpdateCollectionResponse {
+}
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct DeleteCollectionRequest {
+    #[prost(int64, tag = "1")]
+    pub collection_id: i64,
+}
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct DeleteCollectionResponse {
+}
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct GetCollectionRequest {
+    #[prost(int64, tag = "1")]
+    pub collection_id: i64,
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct GetCollectionResponse {
+    #[prost(message, optional, tag = "1")]
+    pub collection: ::core::option::Option<NoteCollection>,
+}
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct ListCollectionsRequest {
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ListCollectionsResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub items: ::prost::alloc::vec::Vec<NoteCollection>,
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct AddCollectionNotesRequest {
+    #[prost(int64, tag = "1")]
+    pub collection_id: i64,
+    /// 按顺序追加到合集末尾
+    #[prost(int64, repeated, packed = "false", tag = "2")]
+    pub note_ids: ::prost::alloc::vec::Vec<i64>,
+}
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct AddCollectionNotesResponse {
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct RemoveCollectionNotesRequest {
+    #[prost(int64, tag = "1")]
+    pub collection_id: i64,
+    #[prost(int64, repeated, packed = "false", tag = "2")]
+    pub note_ids: ::prost::alloc::vec::Vec<i64>,
+}
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct RemoveCollectionNotesResponse {
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct SortCollectionNotesRequest {
+    #[prost(int64, tag = "1")]
+    pub collection_id: i64,
+    /// 合集中全部笔记的新顺序
+    #[prost(int64, repeated, tag = "2")]
+    pub note_ids: ::prost::alloc::vec::Vec<i64>,
+}
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct SortCollectionNotesResponse {
+}
 #[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
 pub struct AddTagRequest {
     #[prost(string, tag = "1")]
@@ -555,6 +908,9 @@ pub struct NoteInteraction {
     /// 用户是否评论
     #[prost(bool, tag = "2")]
     pub commented: bool,
+    /// 笔记浏览量
+    #[prost(int64, tag = "3")]
+    pub views: i64,
 }
 #[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
 pub struct NoteIdList {
@@ -617,6 +973,15 @@ pub struct PageListUserLikedNoteResponse {
     #[prost(string, tag = "3")]
     pub next_cursor: ::prost::alloc::string::String,
 }
+/// 上报笔记浏览 同一用户在去重窗口内多次浏览同一笔记只计一次
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct ReportNoteViewsRequest {
+    #[prost(int64, repeated, packed = "false", tag = "1")]
+    pub note_ids: ::prost::alloc::vec::Vec<i64>,
+}
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct ReportNoteViewsResponse {
+}
 #[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
 pub struct RandomGetRequest {
     #[prost(int32, tag = "1")]
@@ -642,7 +1007,7 @@ pub struct GetFeedNoteResponse {
     #[prost(message, optional, tag = "2")]
     pub ext: ::core::option::Option<FeedNoteItemExt>,
 }
-#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
 pub struct RecommendGetRequest {
     /// 用户id
     #[prost(int64, tag = "1")]
@@ -650,9 +1015,18 @@ pub struct RecommendGetRequest {
     /// 推荐条数
     #[prost(int32, tag = "2")]
     pub need_num: i32,
+    /// 翻页游标 首次请求为空 之后传入上一次返回的next_cursor
+    #[prost(string, tag = "3")]
+    pub cursor: ::prost::alloc::string::String,
 }
-#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+#[derive(Clone, PartialEq, ::prost::Message)]
 pub struct RecommendGetResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub items: ::prost::alloc::vec::Vec<FeedNoteItem>,
+    #[prost(string, tag = "2")]
+    pub next_cursor: ::prost::alloc::string::String,
+    #[prost(bool, tag = "3")]
+    pub has_next: bool,
 }
 #[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
 pub struct GetUserRecentPostRequest {
@@ -735,9 +1109,40 @@ pub struct GetNoteAuthorResponse {
     #[prost(int64, tag = "1")]
     pub author: i64,
 }
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct ListUserCollectionsRequest {
+    #[prost(int64, tag = "1")]
+    pub uid: i64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ListUserCollectionsResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub items: ::prost::alloc::vec::Vec<NoteCollection>,
+}
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct ListCollectionFeedRequest {
+    #[prost(int64, tag = "1")]
+    pub collection_id: i64,
+    /// 为0时从合集开头开始
+    #[prost(int64, tag = "2")]
+    pub cursor: i64,
+    #[prost(int32, tag = "3")]
+    pub count: i32,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ListCollectionFeedResponse {
+    #[prost(message, optional, tag = "1")]
+    pub collection: ::core::option::Option<NoteCollection>,
+    #[prost(message, repeated, tag = "2")]
+    pub items: ::prost::alloc::vec::Vec<FeedNoteItem>,
+    #[prost(int64, tag = "3")]
+    pub next_cursor: i64,
+    #[prost(bool, tag = "4")]
+    pub has_next: bool,
+}
 /// Encoded file descriptor set for the `note.api.v1` package
 pub const FILE_DESCRIPTOR_SET: &[u8] = &[
-    0x0a, 0xdf, 0x3a, 0x0a, 0x16, 0x6e, 0x6f, 0x74, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+    0x0a, 0xcd, 0x52, 0x0a, 0x16, 0x6e, 0x6f, 0x74, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
     0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x6e, 0x6f, 0x74,
     0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x22, 0x43, 0x0a, 0x07, 0x4e, 0x6f, 0x74, 0x65,
     0x54, 0x61, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
@@ -747,7 +1152,7 @@ pub const FILE_DESCRIPTOR_SET: &[u8] = &[
     0x0a, 0x4e, 0x6f, 0x74, 0x65, 0x41, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6e,
     0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e,
     0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02,
-    0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0xe7, 0x04, 0x0a, 0x08, 0x4e, 0x6f,
+    0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0xda, 0x05, 0x0a, 0x08, 0x4e, 0x6f,
     0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69,
     0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12,
     0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
@@ -786,101 +1191,152 @@ pub const FILE_DESCRIPTOR_SET: &[u8] = &[
     0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
     0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x53, 0x74,
     0x61, 0x74, 0x65, 0x52, 0x0e, 0x6c, 0x69, 0x66, 0x65, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x53, 0x74,
-    0x61, 0x74, 0x65, 0x22, 0x55, 0x0a, 0x0d, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65,
-    0x4d, 0x65, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20,
-    0x01, 0x28, 0x0d, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
-    0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
-    0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
-    0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x61, 0x0a, 0x09, 0x4e, 0x6f,
-    0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-    0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
-    0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a,
-    0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e, 0x6f,
-    0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x6d,
-    0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0x61, 0x0a,
-    0x09, 0x4e, 0x6f, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+    0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18,
+    0x12, 0x20, 0x03, 0x28, 0x03, 0x52, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12,
+    0x14, 0x0a, 0x05, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+    0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+    0x69, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x6f, 0x74, 0x65,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c,
+    0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c,
+    0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x55, 0x0a, 0x0d, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x6d,
+    0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68,
+    0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a,
+    0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68,
+    0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
+    0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x61, 0x0a,
+    0x09, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
     0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04,
     0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
     0x12, 0x2e, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
     0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74,
-    0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61,
-    0x22, 0xe0, 0x01, 0x0a, 0x0d, 0x4e, 0x6f, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x4d, 0x65,
-    0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-    0x0d, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
-    0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
-    0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-    0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61,
-    0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61,
-    0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x18,
-    0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x12, 0x14,
-    0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63,
-    0x6f, 0x64, 0x65, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x74,
-    0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x72, 0x61,
-    0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x65,
-    0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x43, 0x6f,
-    0x64, 0x65, 0x63, 0x22, 0x80, 0x03, 0x0a, 0x0c, 0x46, 0x65, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65,
-    0x49, 0x74, 0x65, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18,
-    0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a,
-    0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
-    0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28,
-    0x09, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
-    0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
-    0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73,
-    0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70,
-    0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x06,
-    0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18,
-    0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06,
-    0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x75,
-    0x74, 0x68, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x18,
-    0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x1d,
-    0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01,
-    0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x0e, 0x0a,
-    0x02, 0x69, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x37, 0x0a,
-    0x09, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e,
-    0x32, 0x1a, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e,
-    0x6f, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6e, 0x6f,
-    0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73,
-    0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70,
-    0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x06,
-    0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x22, 0x6f, 0x0a, 0x0f, 0x46, 0x65, 0x65, 0x64, 0x4e, 0x6f,
-    0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x45, 0x78, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x61, 0x67,
-    0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61,
-    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x04, 0x74,
-    0x61, 0x67, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x61, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18,
-    0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69,
-    0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x41, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x07,
-    0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x2a, 0x44, 0x0a, 0x0b, 0x4e, 0x6f, 0x74, 0x65, 0x50,
-    0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x12, 0x1c, 0x0a, 0x18, 0x4e, 0x4f, 0x54, 0x45, 0x5f, 0x50,
-    0x52, 0x49, 0x56, 0x41, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-    0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x43, 0x10, 0x01,
-    0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x10, 0x02, 0x2a, 0x46, 0x0a,
-    0x0d, 0x4e, 0x6f, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f,
-    0x0a, 0x1b, 0x4e, 0x4f, 0x54, 0x45, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50,
-    0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
-    0x09, 0x0a, 0x05, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x56, 0x49,
-    0x44, 0x45, 0x4f, 0x10, 0x02, 0x2a, 0xb6, 0x01, 0x0a, 0x12, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69,
-    0x66, 0x65, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x21,
-    0x4e, 0x4f, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x46, 0x45, 0x5f, 0x43, 0x59, 0x43, 0x4c, 0x45, 0x5f,
-    0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
-    0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x4c, 0x49, 0x46, 0x45, 0x5f, 0x43, 0x59, 0x43, 0x4c,
-    0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x45,
-    0x44, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x4c, 0x49, 0x46, 0x45, 0x5f, 0x43, 0x59, 0x43, 0x4c,
-    0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x55, 0x44, 0x49, 0x54, 0x49, 0x4e, 0x47,
-    0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x4c, 0x49, 0x46, 0x45, 0x5f, 0x43, 0x59, 0x43, 0x4c, 0x45,
-    0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10,
-    0x03, 0x12, 0x1b, 0x0a, 0x17, 0x4c, 0x49, 0x46, 0x45, 0x5f, 0x43, 0x59, 0x43, 0x4c, 0x45, 0x5f,
-    0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x41, 0x4e, 0x4e, 0x45, 0x44, 0x10, 0x04, 0x2a, 0xa3,
-    0x01, 0x0a, 0x09, 0x4e, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x16,
-    0x4e, 0x4f, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
-    0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x52, 0x4f, 0x43,
-    0x45, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x0a, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x52, 0x4f, 0x43,
-    0x45, 0x53, 0x53, 0x45, 0x44, 0x10, 0x0b, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x52, 0x4f, 0x43, 0x45,
-    0x53, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x0c, 0x12, 0x0c, 0x0a, 0x08, 0x41,
-    0x55, 0x44, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x14, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x4a,
-    0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x15, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x55, 0x44, 0x49, 0x54,
-    0x5f, 0x50, 0x41, 0x53, 0x53, 0x45, 0x44, 0x10, 0x16, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x55, 0x42,
-    0x4c, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x64, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x41, 0x4e, 0x4e,
-    0x45, 0x44, 0x10, 0x65, 0x42, 0xa2, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x74,
+    0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61,
+    0x22, 0x61, 0x0a, 0x09, 0x4e, 0x6f, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x10, 0x0a,
+    0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+    0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74,
+    0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+    0x0b, 0x32, 0x1a, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+    0x4e, 0x6f, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d,
+    0x65, 0x74, 0x61, 0x22, 0xe0, 0x01, 0x0a, 0x0d, 0x4e, 0x6f, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65,
+    0x6f, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01,
+    0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68,
+    0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69,
+    0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20,
+    0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+    0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64,
+    0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x69, 0x74, 0x72, 0x61,
+    0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x69, 0x74, 0x72, 0x61, 0x74,
+    0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+    0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72, 0x61, 0x6d, 0x65,
+    0x72, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x66, 0x72, 0x61, 0x6d,
+    0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f, 0x63,
+    0x6f, 0x64, 0x65, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x64, 0x69,
+    0x6f, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x22, 0x80, 0x03, 0x0a, 0x0c, 0x46, 0x65, 0x65, 0x64, 0x4e,
+    0x6f, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x74, 0x65, 0x5f,
+    0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64,
+    0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+    0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x03,
+    0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
+    0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+    0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x69, 0x6d, 0x61,
+    0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x6f, 0x74, 0x65,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67,
+    0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b,
+    0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x12,
+    0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+    0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69,
+    0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65,
+    0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+    0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+    0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70,
+    0x12, 0x37, 0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0b, 0x20,
+    0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+    0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
+    0x08, 0x6e, 0x6f, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x76, 0x69, 0x64,
+    0x65, 0x6f, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x6f, 0x74, 0x65,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65,
+    0x6f, 0x52, 0x06, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x22, 0xb0, 0x01, 0x0a, 0x0f, 0x46, 0x65,
+    0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x45, 0x78, 0x74, 0x12, 0x28, 0x0a,
+    0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x6f,
+    0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x54, 0x61,
+    0x67, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x61, 0x74, 0x5f, 0x75, 0x73,
+    0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x6f, 0x74, 0x65,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x41, 0x74, 0x55, 0x73,
+    0x65, 0x72, 0x52, 0x07, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x63,
+    0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+    0x1f, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f,
+    0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x6b,
+    0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x99, 0x02, 0x0a,
+    0x0e, 0x4e, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+    0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+    0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+    0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20,
+    0x01, 0x28, 0x03, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+    0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+    0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+    0x64, 0x65, 0x73, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x18,
+    0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x12, 0x22,
+    0x0a, 0x0d, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18,
+    0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x65,
+    0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+    0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+    0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+    0x52, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x74, 0x69, 0x6d, 0x65,
+    0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x75, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a,
+    0x08, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x03, 0x52,
+    0x07, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x12, 0x4e, 0x6f, 0x74,
+    0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x12,
+    0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+    0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+    0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20,
+    0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x70, 0x72,
+    0x65, 0x76, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+    0x52, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c,
+    0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+    0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x2a, 0x75,
+    0x0a, 0x0b, 0x4e, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x12, 0x1c, 0x0a,
+    0x18, 0x4e, 0x4f, 0x54, 0x45, 0x5f, 0x50, 0x52, 0x49, 0x56, 0x41, 0x43, 0x59, 0x5f, 0x55, 0x4e,
+    0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x50,
+    0x55, 0x42, 0x4c, 0x49, 0x43, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52, 0x49, 0x56, 0x41,
+    0x54, 0x45, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x52,
+    0x53, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x55, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x46, 0x4f,
+    0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x52, 0x53, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x55, 0x53,
+    0x54, 0x4f, 0x4d, 0x10, 0x05, 0x2a, 0x46, 0x0a, 0x0d, 0x4e, 0x6f, 0x74, 0x65, 0x41, 0x73, 0x73,
+    0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x4e, 0x4f, 0x54, 0x45, 0x5f, 0x41,
+    0x53, 0x53, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+    0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4d, 0x41, 0x47, 0x45,
+    0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x56, 0x49, 0x44, 0x45, 0x4f, 0x10, 0x02, 0x2a, 0x91, 0x02,
+    0x0a, 0x12, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x53,
+    0x74, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x21, 0x4e, 0x4f, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x46,
+    0x45, 0x5f, 0x43, 0x59, 0x43, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e,
+    0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x4c,
+    0x49, 0x46, 0x45, 0x5f, 0x43, 0x59, 0x43, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+    0x50, 0x55, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x4c,
+    0x49, 0x46, 0x45, 0x5f, 0x43, 0x59, 0x43, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+    0x41, 0x55, 0x44, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x4c, 0x49,
+    0x46, 0x45, 0x5f, 0x43, 0x59, 0x43, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52,
+    0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x4c, 0x49, 0x46,
+    0x45, 0x5f, 0x43, 0x59, 0x43, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x41,
+    0x4e, 0x4e, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x4c, 0x49, 0x46, 0x45, 0x5f, 0x43,
+    0x59, 0x43, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x52, 0x41, 0x46, 0x54,
+    0x10, 0x05, 0x12, 0x1e, 0x0a, 0x1a, 0x4c, 0x49, 0x46, 0x45, 0x5f, 0x43, 0x59, 0x43, 0x4c, 0x45,
+    0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44,
+    0x10, 0x06, 0x12, 0x1d, 0x0a, 0x19, 0x4c, 0x49, 0x46, 0x45, 0x5f, 0x43, 0x59, 0x43, 0x4c, 0x45,
+    0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x59, 0x43, 0x4c, 0x45, 0x44, 0x10,
+    0x07, 0x2a, 0xe0, 0x01, 0x0a, 0x09, 0x4e, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+    0x1a, 0x0a, 0x16, 0x4e, 0x4f, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e,
+    0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x44,
+    0x52, 0x41, 0x46, 0x54, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55,
+    0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53,
+    0x49, 0x4e, 0x47, 0x10, 0x0a, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53,
+    0x45, 0x44, 0x10, 0x0b, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f,
+    0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x0c, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x55, 0x44, 0x49,
+    0x54, 0x49, 0x4e, 0x47, 0x10, 0x14, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54,
+    0x45, 0x44, 0x10, 0x15, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x50, 0x41,
+    0x53, 0x53, 0x45, 0x44, 0x10, 0x16, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f,
+    0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x49, 0x4e, 0x47, 0x10, 0x17, 0x12, 0x0d, 0x0a, 0x09, 0x50,
+    0x55, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x64, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x41,
+    0x4e, 0x4e, 0x45, 0x44, 0x10, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x43, 0x59, 0x43, 0x4c,
+    0x45, 0x44, 0x10, 0x6e, 0x42, 0xa2, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x74,
     0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x42, 0x09, 0x4e, 0x6f, 0x74, 0x65, 0x50, 0x72,
     0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
     0x6d, 0x2f, 0x72, 0x79, 0x61, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f,
@@ -890,11 +1346,11 @@ pub const FILE_DESCRIPTOR_SET: &[u8] = &[
     0x31, 0xca, 0x02, 0x0b, 0x4e, 0x6f, 0x74, 0x65, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0xe2,
     0x02, 0x17, 0x4e, 0x6f, 0x74, 0x65, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
     0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x4e, 0x6f, 0x74, 0x65,
-    0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a, 0x3a, 0x56, 0x31, 0x4a, 0xbe, 0x27, 0x0a, 0x07, 0x12, 0x05,
-    0x00, 0x00, 0x92, 0x01, 0x01, 0x0a, 0x08, 0x0a, 0x01, 0x0c, 0x12, 0x03, 0x00, 0x00, 0x12, 0x0a,
+    0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a, 0x3a, 0x56, 0x31, 0x4a, 0xfc, 0x38, 0x0a, 0x07, 0x12, 0x05,
+    0x00, 0x00, 0xc4, 0x01, 0x01, 0x0a, 0x08, 0x0a, 0x01, 0x0c, 0x12, 0x03, 0x00, 0x00, 0x12, 0x0a,
     0x08, 0x0a, 0x01, 0x02, 0x12, 0x03, 0x02, 0x00, 0x14, 0x0a, 0x08, 0x0a, 0x01, 0x08, 0x12, 0x03,
     0x04, 0x00, 0x4d, 0x0a, 0x09, 0x0a, 0x02, 0x08, 0x0b, 0x12, 0x03, 0x04, 0x00, 0x4d, 0x0a, 0x0a,
-    0x0a, 0x02, 0x05, 0x00, 0x12, 0x04, 0x06, 0x00, 0x0a, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x05, 0x00,
+    0x0a, 0x02, 0x05, 0x00, 0x12, 0x04, 0x06, 0x00, 0x0d, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x05, 0x00,
     0x01, 0x12, 0x03, 0x06, 0x05, 0x10, 0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x00, 0x02, 0x00, 0x12, 0x03,
     0x07, 0x02, 0x1f, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x07, 0x02,
     0x1a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x00, 0x02, 0x12, 0x03, 0x07, 0x1d, 0x1e, 0x0a,
@@ -903,1537 +1359,2666 @@ pub const FILE_DESCRIPTOR_SET: &[u8] = &[
     0x02, 0x01, 0x02, 0x12, 0x03, 0x08, 0x1d, 0x1e, 0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x00, 0x02, 0x02,
     0x12, 0x03, 0x09, 0x02, 0x1f, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x02, 0x01, 0x12, 0x03,
     0x09, 0x02, 0x09, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x02, 0x02, 0x12, 0x03, 0x09, 0x1d,
-    0x1e, 0x0a, 0x0a, 0x0a, 0x02, 0x05, 0x01, 0x12, 0x04, 0x0c, 0x00, 0x10, 0x01, 0x0a, 0x0a, 0x0a,
-    0x03, 0x05, 0x01, 0x01, 0x12, 0x03, 0x0c, 0x05, 0x12, 0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x01, 0x02,
-    0x00, 0x12, 0x03, 0x0d, 0x02, 0x22, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x00, 0x01, 0x12,
-    0x03, 0x0d, 0x02, 0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x00, 0x02, 0x12, 0x03, 0x0d,
-    0x20, 0x21, 0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x01, 0x02, 0x01, 0x12, 0x03, 0x0e, 0x02, 0x22, 0x0a,
-    0x0c, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x01, 0x01, 0x12, 0x03, 0x0e, 0x02, 0x07, 0x0a, 0x0c, 0x0a,
-    0x05, 0x05, 0x01, 0x02, 0x01, 0x02, 0x12, 0x03, 0x0e, 0x20, 0x21, 0x0a, 0x0b, 0x0a, 0x04, 0x05,
-    0x01, 0x02, 0x02, 0x12, 0x03, 0x0f, 0x02, 0x22, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x02,
-    0x01, 0x12, 0x03, 0x0f, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x02, 0x02, 0x12,
-    0x03, 0x0f, 0x20, 0x21, 0x0a, 0x26, 0x0a, 0x02, 0x05, 0x02, 0x12, 0x04, 0x13, 0x00, 0x21, 0x01,
+    0x1e, 0x0a, 0x1e, 0x0a, 0x04, 0x05, 0x00, 0x02, 0x03, 0x12, 0x03, 0x0a, 0x02, 0x1f, 0x22, 0x11,
+    0x20, 0xe4, 0xbb, 0x85, 0xe7, 0xb2, 0x89, 0xe4, 0xb8, 0x9d, 0xe5, 0x8f, 0xaf, 0xe8, 0xa7, 0x81,
+    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x03, 0x01, 0x12, 0x03, 0x0a, 0x02, 0x0b, 0x0a,
+    0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x03, 0x02, 0x12, 0x03, 0x0a, 0x1d, 0x1e, 0x0a, 0x24, 0x0a,
+    0x04, 0x05, 0x00, 0x02, 0x04, 0x12, 0x03, 0x0b, 0x02, 0x1f, 0x22, 0x17, 0x20, 0xe4, 0xbb, 0x85,
+    0xe4, 0xba, 0x92, 0xe5, 0x85, 0xb3, 0xe5, 0xa5, 0xbd, 0xe5, 0x8f, 0x8b, 0xe5, 0x8f, 0xaf, 0xe8,
+    0xa7, 0x81, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x04, 0x01, 0x12, 0x03, 0x0b, 0x02,
+    0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x04, 0x02, 0x12, 0x03, 0x0b, 0x1d, 0x1e, 0x0a,
+    0x24, 0x0a, 0x04, 0x05, 0x00, 0x02, 0x05, 0x12, 0x03, 0x0c, 0x02, 0x1f, 0x22, 0x17, 0x20, 0xe4,
+    0xbb, 0x85, 0xe6, 0x8c, 0x87, 0xe5, 0xae, 0x9a, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe5, 0x8f,
+    0xaf, 0xe8, 0xa7, 0x81, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x05, 0x01, 0x12, 0x03,
+    0x0c, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x05, 0x02, 0x12, 0x03, 0x0c, 0x1d,
+    0x1e, 0x0a, 0x0a, 0x0a, 0x02, 0x05, 0x01, 0x12, 0x04, 0x0f, 0x00, 0x13, 0x01, 0x0a, 0x0a, 0x0a,
+    0x03, 0x05, 0x01, 0x01, 0x12, 0x03, 0x0f, 0x05, 0x12, 0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x01, 0x02,
+    0x00, 0x12, 0x03, 0x10, 0x02, 0x22, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x00, 0x01, 0x12,
+    0x03, 0x10, 0x02, 0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x00, 0x02, 0x12, 0x03, 0x10,
+    0x20, 0x21, 0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x01, 0x02, 0x01, 0x12, 0x03, 0x11, 0x02, 0x22, 0x0a,
+    0x0c, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x01, 0x01, 0x12, 0x03, 0x11, 0x02, 0x07, 0x0a, 0x0c, 0x0a,
+    0x05, 0x05, 0x01, 0x02, 0x01, 0x02, 0x12, 0x03, 0x11, 0x20, 0x21, 0x0a, 0x0b, 0x0a, 0x04, 0x05,
+    0x01, 0x02, 0x02, 0x12, 0x03, 0x12, 0x02, 0x22, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x02,
+    0x01, 0x12, 0x03, 0x12, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x01, 0x02, 0x02, 0x02, 0x12,
+    0x03, 0x12, 0x20, 0x21, 0x0a, 0x26, 0x0a, 0x02, 0x05, 0x02, 0x12, 0x04, 0x16, 0x00, 0x2d, 0x01,
     0x1a, 0x1a, 0x20, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe7, 0x94, 0x9f, 0xe5, 0x91, 0xbd, 0xe5,
     0x91, 0xa8, 0xe6, 0x9c, 0x9f, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x0a, 0x0a, 0x0a, 0x0a, 0x03,
-    0x05, 0x02, 0x01, 0x12, 0x03, 0x13, 0x05, 0x17, 0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x02, 0x02, 0x00,
-    0x12, 0x03, 0x14, 0x02, 0x28, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x02, 0x02, 0x00, 0x01, 0x12, 0x03,
-    0x14, 0x02, 0x23, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x02, 0x02, 0x00, 0x02, 0x12, 0x03, 0x14, 0x26,
-    0x27, 0x0a, 0x18, 0x0a, 0x04, 0x05, 0x02, 0x02, 0x01, 0x12, 0x03, 0x17, 0x02, 0x21, 0x1a, 0x0b,
+    0x05, 0x02, 0x01, 0x12, 0x03, 0x16, 0x05, 0x17, 0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x02, 0x02, 0x00,
+    0x12, 0x03, 0x17, 0x02, 0x28, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x02, 0x02, 0x00, 0x01, 0x12, 0x03,
+    0x17, 0x02, 0x23, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x02, 0x02, 0x00, 0x02, 0x12, 0x03, 0x17, 0x26,
+    0x27, 0x0a, 0x18, 0x0a, 0x04, 0x05, 0x02, 0x02, 0x01, 0x12, 0x03, 0x1a, 0x02, 0x21, 0x1a, 0x0b,
     0x20, 0xe5, 0xb7, 0xb2, 0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05,
-    0x02, 0x02, 0x01, 0x01, 0x12, 0x03, 0x17, 0x02, 0x1c, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x02, 0x02,
-    0x01, 0x02, 0x12, 0x03, 0x17, 0x1f, 0x20, 0x0a, 0x18, 0x0a, 0x04, 0x05, 0x02, 0x02, 0x02, 0x12,
-    0x03, 0x1a, 0x02, 0x20, 0x1a, 0x0b, 0x20, 0xe5, 0xae, 0xa1, 0xe6, 0xa0, 0xb8, 0xe4, 0xb8, 0xad,
-    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x02, 0x02, 0x02, 0x01, 0x12, 0x03, 0x1a, 0x02, 0x1b, 0x0a,
-    0x0c, 0x0a, 0x05, 0x05, 0x02, 0x02, 0x02, 0x02, 0x12, 0x03, 0x1a, 0x1e, 0x1f, 0x0a, 0x1e, 0x0a,
-    0x04, 0x05, 0x02, 0x02, 0x03, 0x12, 0x03, 0x1d, 0x02, 0x20, 0x1a, 0x11, 0x20, 0xe5, 0xae, 0xa1,
+    0x02, 0x02, 0x01, 0x01, 0x12, 0x03, 0x1a, 0x02, 0x1c, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x02, 0x02,
+    0x01, 0x02, 0x12, 0x03, 0x1a, 0x1f, 0x20, 0x0a, 0x18, 0x0a, 0x04, 0x05, 0x02, 0x02, 0x02, 0x12,
+    0x03, 0x1d, 0x02, 0x20, 0x1a, 0x0b, 0x20, 0xe5, 0xae, 0xa1, 0xe6, 0xa0, 0xb8, 0xe4, 0xb8, 0xad,
+    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x02, 0x02, 0x02, 0x01, 0x12, 0x03, 0x1d, 0x02, 0x1b, 0x0a,
+    0x0c, 0x0a, 0x05, 0x05, 0x02, 0x02, 0x02, 0x02, 0x12, 0x03, 0x1d, 0x1e, 0x1f, 0x0a, 0x1e, 0x0a,
+    0x04, 0x05, 0x02, 0x02, 0x03, 0x12, 0x03, 0x20, 0x02, 0x20, 0x1a, 0x11, 0x20, 0xe5, 0xae, 0xa1,
     0xe6, 0xa0, 0xb8, 0xe6, 0x9c, 0xaa, 0xe9, 0x80, 0x9a, 0xe8, 0xbf, 0x87, 0x0a, 0x0a, 0x0c, 0x0a,
-    0x05, 0x05, 0x02, 0x02, 0x03, 0x01, 0x12, 0x03, 0x1d, 0x02, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x05,
-    0x02, 0x02, 0x03, 0x02, 0x12, 0x03, 0x1d, 0x1e, 0x1f, 0x0a, 0x18, 0x0a, 0x04, 0x05, 0x02, 0x02,
-    0x04, 0x12, 0x03, 0x20, 0x02, 0x1e, 0x1a, 0x0b, 0x20, 0xe8, 0xa2, 0xab, 0xe9, 0x94, 0x81, 0xe5,
-    0xae, 0x9a, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x02, 0x02, 0x04, 0x01, 0x12, 0x03, 0x20, 0x02,
-    0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x02, 0x02, 0x04, 0x02, 0x12, 0x03, 0x20, 0x1c, 0x1d, 0x0a,
-    0x98, 0x01, 0x0a, 0x02, 0x05, 0x03, 0x12, 0x04, 0x26, 0x00, 0x40, 0x01, 0x1a, 0x8b, 0x01, 0x20,
-    0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0xe7, 0x9a, 0x84, 0xe5,
-    0x80, 0xbc, 0xe7, 0x9a, 0x84, 0xe9, 0xa1, 0xba, 0xe5, 0xba, 0x8f, 0xe6, 0x9c, 0x89, 0xe7, 0x89,
-    0xb9, 0xe6, 0xae, 0x8a, 0xe5, 0x90, 0xab, 0xe4, 0xb9, 0x89, 0x20, 0xe4, 0xb8, 0x8d, 0xe8, 0x83,
-    0xbd, 0xe6, 0x94, 0xb9, 0xe5, 0x8f, 0x98, 0x20, 0xe5, 0x90, 0xa6, 0xe5, 0x88, 0x99, 0xe4, 0xbc,
-    0x9a, 0xe5, 0xbd, 0xb1, 0xe5, 0x93, 0x8d, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0xe5, 0x8d, 0x87,
-    0xe7, 0xba, 0xa7, 0xe7, 0x9a, 0x84, 0xe9, 0x80, 0xbb, 0xe8, 0xbe, 0x91, 0x0a, 0x0a, 0x20, 0xe6,
-    0x9e, 0x9a, 0xe4, 0xb8, 0xbe, 0xe5, 0x80, 0xbc, 0xe5, 0xb0, 0x8f, 0xe7, 0x9a, 0x84, 0xe7, 0x8a,
-    0xb6, 0xe6, 0x80, 0x81, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81,
-    0xe6, 0x9b, 0xb4, 0xe9, 0x9d, 0xa0, 0xe5, 0x89, 0x8d, 0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x05, 0x03,
-    0x01, 0x12, 0x03, 0x26, 0x05, 0x0e, 0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x03, 0x02, 0x00, 0x12, 0x03,
-    0x27, 0x02, 0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x03, 0x02, 0x00, 0x01, 0x12, 0x03, 0x27, 0x02,
-    0x18, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x03, 0x02, 0x00, 0x02, 0x12, 0x03, 0x27, 0x1b, 0x1c, 0x0a,
-    0x1e, 0x0a, 0x04, 0x05, 0x03, 0x02, 0x01, 0x12, 0x03, 0x2a, 0x02, 0x12, 0x1a, 0x11, 0x20, 0xe8,
+    0x05, 0x05, 0x02, 0x02, 0x03, 0x01, 0x12, 0x03, 0x20, 0x02, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x05,
+    0x02, 0x02, 0x03, 0x02, 0x12, 0x03, 0x20, 0x1e, 0x1f, 0x0a, 0x18, 0x0a, 0x04, 0x05, 0x02, 0x02,
+    0x04, 0x12, 0x03, 0x23, 0x02, 0x1e, 0x1a, 0x0b, 0x20, 0xe8, 0xa2, 0xab, 0xe9, 0x94, 0x81, 0xe5,
+    0xae, 0x9a, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x02, 0x02, 0x04, 0x01, 0x12, 0x03, 0x23, 0x02,
+    0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x02, 0x02, 0x04, 0x02, 0x12, 0x03, 0x23, 0x1c, 0x1d, 0x0a,
+    0x15, 0x0a, 0x04, 0x05, 0x02, 0x02, 0x05, 0x12, 0x03, 0x26, 0x02, 0x1d, 0x1a, 0x08, 0x20, 0xe8,
+    0x8d, 0x89, 0xe7, 0xa8, 0xbf, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x02, 0x02, 0x05, 0x01, 0x12,
+    0x03, 0x26, 0x02, 0x18, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x02, 0x02, 0x05, 0x02, 0x12, 0x03, 0x26,
+    0x1b, 0x1c, 0x0a, 0x21, 0x0a, 0x04, 0x05, 0x02, 0x02, 0x06, 0x12, 0x03, 0x29, 0x02, 0x21, 0x1a,
+    0x14, 0x20, 0xe7, 0xad, 0x89, 0xe5, 0xbe, 0x85, 0xe5, 0xae, 0x9a, 0xe6, 0x97, 0xb6, 0xe5, 0x8f,
+    0x91, 0xe5, 0xb8, 0x83, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x02, 0x02, 0x06, 0x01, 0x12, 0x03,
+    0x29, 0x02, 0x1c, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x02, 0x02, 0x06, 0x02, 0x12, 0x03, 0x29, 0x1f,
+    0x20, 0x0a, 0x1e, 0x0a, 0x04, 0x05, 0x02, 0x02, 0x07, 0x12, 0x03, 0x2c, 0x02, 0x20, 0x1a, 0x11,
+    0x20, 0xe5, 0x9c, 0xa8, 0xe5, 0x9b, 0x9e, 0xe6, 0x94, 0xb6, 0xe7, 0xab, 0x99, 0xe4, 0xb8, 0xad,
+    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x02, 0x02, 0x07, 0x01, 0x12, 0x03, 0x2c, 0x02, 0x1b, 0x0a,
+    0x0c, 0x0a, 0x05, 0x05, 0x02, 0x02, 0x07, 0x02, 0x12, 0x03, 0x2c, 0x1e, 0x1f, 0x0a, 0x98, 0x01,
+    0x0a, 0x02, 0x05, 0x03, 0x12, 0x04, 0x32, 0x00, 0x58, 0x01, 0x1a, 0x8b, 0x01, 0x20, 0xe7, 0xac,
+    0x94, 0xe8, 0xae, 0xb0, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0xe7, 0x9a, 0x84, 0xe5, 0x80, 0xbc,
+    0xe7, 0x9a, 0x84, 0xe9, 0xa1, 0xba, 0xe5, 0xba, 0x8f, 0xe6, 0x9c, 0x89, 0xe7, 0x89, 0xb9, 0xe6,
+    0xae, 0x8a, 0xe5, 0x90, 0xab, 0xe4, 0xb9, 0x89, 0x20, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe6,
+    0x94, 0xb9, 0xe5, 0x8f, 0x98, 0x20, 0xe5, 0x90, 0xa6, 0xe5, 0x88, 0x99, 0xe4, 0xbc, 0x9a, 0xe5,
+    0xbd, 0xb1, 0xe5, 0x93, 0x8d, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0xe5, 0x8d, 0x87, 0xe7, 0xba,
+    0xa7, 0xe7, 0x9a, 0x84, 0xe9, 0x80, 0xbb, 0xe8, 0xbe, 0x91, 0x0a, 0x0a, 0x20, 0xe6, 0x9e, 0x9a,
+    0xe4, 0xb8, 0xbe, 0xe5, 0x80, 0xbc, 0xe5, 0xb0, 0x8f, 0xe7, 0x9a, 0x84, 0xe7, 0x8a, 0xb6, 0xe6,
+    0x80, 0x81, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0xe6, 0x9b,
+    0xb4, 0xe9, 0x9d, 0xa0, 0xe5, 0x89, 0x8d, 0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x05, 0x03, 0x01, 0x12,
+    0x03, 0x32, 0x05, 0x0e, 0x0a, 0x0b, 0x0a, 0x04, 0x05, 0x03, 0x02, 0x00, 0x12, 0x03, 0x33, 0x02,
+    0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x03, 0x02, 0x00, 0x01, 0x12, 0x03, 0x33, 0x02, 0x18, 0x0a,
+    0x0c, 0x0a, 0x05, 0x05, 0x03, 0x02, 0x00, 0x02, 0x12, 0x03, 0x33, 0x1b, 0x1c, 0x0a, 0x15, 0x0a,
+    0x04, 0x05, 0x03, 0x02, 0x01, 0x12, 0x03, 0x36, 0x02, 0x0c, 0x1a, 0x08, 0x20, 0xe8, 0x8d, 0x89,
+    0xe7, 0xa8, 0xbf, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x03, 0x02, 0x01, 0x01, 0x12, 0x03, 0x36,
+    0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x03, 0x02, 0x01, 0x02, 0x12, 0x03, 0x36, 0x0a, 0x0b,
+    0x0a, 0x21, 0x0a, 0x04, 0x05, 0x03, 0x02, 0x02, 0x12, 0x03, 0x39, 0x02, 0x10, 0x1a, 0x14, 0x20,
+    0xe7, 0xad, 0x89, 0xe5, 0xbe, 0x85, 0xe5, 0xae, 0x9a, 0xe6, 0x97, 0xb6, 0xe5, 0x8f, 0x91, 0xe5,
+    0xb8, 0x83, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x03, 0x02, 0x02, 0x01, 0x12, 0x03, 0x39, 0x02,
+    0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x03, 0x02, 0x02, 0x02, 0x12, 0x03, 0x39, 0x0e, 0x0f, 0x0a,
+    0x1e, 0x0a, 0x04, 0x05, 0x03, 0x02, 0x03, 0x12, 0x03, 0x3c, 0x02, 0x12, 0x1a, 0x11, 0x20, 0xe8,
     0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe5, 0xa4, 0x84, 0xe7, 0x90, 0x86, 0xe4, 0xb8, 0xad, 0x0a, 0x0a,
-    0x0c, 0x0a, 0x05, 0x05, 0x03, 0x02, 0x01, 0x01, 0x12, 0x03, 0x2a, 0x02, 0x0c, 0x0a, 0x0c, 0x0a,
-    0x05, 0x05, 0x03, 0x02, 0x01, 0x02, 0x12, 0x03, 0x2a, 0x0f, 0x11, 0x0a, 0x21, 0x0a, 0x04, 0x05,
-    0x03, 0x02, 0x02, 0x12, 0x03, 0x2d, 0x02, 0x11, 0x1a, 0x14, 0x20, 0xe8, 0xb5, 0x84, 0xe6, 0xba,
+    0x0c, 0x0a, 0x05, 0x05, 0x03, 0x02, 0x03, 0x01, 0x12, 0x03, 0x3c, 0x02, 0x0c, 0x0a, 0x0c, 0x0a,
+    0x05, 0x05, 0x03, 0x02, 0x03, 0x02, 0x12, 0x03, 0x3c, 0x0f, 0x11, 0x0a, 0x21, 0x0a, 0x04, 0x05,
+    0x03, 0x02, 0x04, 0x12, 0x03, 0x3f, 0x02, 0x11, 0x1a, 0x14, 0x20, 0xe8, 0xb5, 0x84, 0xe6, 0xba,
     0x90, 0xe5, 0xa4, 0x84, 0xe7, 0x90, 0x86, 0xe5, 0xae, 0x8c, 0xe6, 0x88, 0x90, 0x0a, 0x0a, 0x0c,
-    0x0a, 0x05, 0x05, 0x03, 0x02, 0x02, 0x01, 0x12, 0x03, 0x2d, 0x02, 0x0b, 0x0a, 0x0c, 0x0a, 0x05,
-    0x05, 0x03, 0x02, 0x02, 0x02, 0x12, 0x03, 0x2d, 0x0e, 0x10, 0x0a, 0x21, 0x0a, 0x04, 0x05, 0x03,
-    0x02, 0x03, 0x12, 0x03, 0x30, 0x02, 0x16, 0x1a, 0x14, 0x20, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90,
+    0x0a, 0x05, 0x05, 0x03, 0x02, 0x04, 0x01, 0x12, 0x03, 0x3f, 0x02, 0x0b, 0x0a, 0x0c, 0x0a, 0x05,
+    0x05, 0x03, 0x02, 0x04, 0x02, 0x12, 0x03, 0x3f, 0x0e, 0x10, 0x0a, 0x21, 0x0a, 0x04, 0x05, 0x03,
+    0x02, 0x05, 0x12, 0x03, 0x42, 0x02, 0x16, 0x1a, 0x14, 0x20, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90,
     0xe5, 0xa4, 0x84, 0xe7, 0x90, 0x86, 0xe5, 0xa4, 0xb1, 0xe8, 0xb4, 0xa5, 0x0a, 0x0a, 0x0c, 0x0a,
-    0x05, 0x05, 0x03, 0x02, 0x03, 0x01, 0x12, 0x03, 0x30, 0x02, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x05,
-    0x03, 0x02, 0x03, 0x02, 0x12, 0x03, 0x30, 0x13, 0x15, 0x0a, 0x18, 0x0a, 0x04, 0x05, 0x03, 0x02,
-    0x04, 0x12, 0x03, 0x33, 0x02, 0x10, 0x1a, 0x0b, 0x20, 0xe5, 0xae, 0xa1, 0xe6, 0xa0, 0xb8, 0xe4,
-    0xb8, 0xad, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x03, 0x02, 0x04, 0x01, 0x12, 0x03, 0x33, 0x02,
-    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x03, 0x02, 0x04, 0x02, 0x12, 0x03, 0x33, 0x0d, 0x0f, 0x0a,
-    0x1e, 0x0a, 0x04, 0x05, 0x03, 0x02, 0x05, 0x12, 0x03, 0x36, 0x02, 0x10, 0x1a, 0x11, 0x20, 0xe5,
+    0x05, 0x05, 0x03, 0x02, 0x05, 0x01, 0x12, 0x03, 0x42, 0x02, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x05,
+    0x03, 0x02, 0x05, 0x02, 0x12, 0x03, 0x42, 0x13, 0x15, 0x0a, 0x18, 0x0a, 0x04, 0x05, 0x03, 0x02,
+    0x06, 0x12, 0x03, 0x45, 0x02, 0x10, 0x1a, 0x0b, 0x20, 0xe5, 0xae, 0xa1, 0xe6, 0xa0, 0xb8, 0xe4,
+    0xb8, 0xad, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x03, 0x02, 0x06, 0x01, 0x12, 0x03, 0x45, 0x02,
+    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x03, 0x02, 0x06, 0x02, 0x12, 0x03, 0x45, 0x0d, 0x0f, 0x0a,
+    0x1e, 0x0a, 0x04, 0x05, 0x03, 0x02, 0x07, 0x12, 0x03, 0x48, 0x02, 0x10, 0x1a, 0x11, 0x20, 0xe5,
     0xae, 0xa1, 0xe6, 0xa0, 0xb8, 0xe4, 0xb8, 0x8d, 0xe9, 0x80, 0x9a, 0xe8, 0xbf, 0x87, 0x0a, 0x0a,
-    0x0c, 0x0a, 0x05, 0x05, 0x03, 0x02, 0x05, 0x01, 0x12, 0x03, 0x36, 0x02, 0x0a, 0x0a, 0x0c, 0x0a,
-    0x05, 0x05, 0x03, 0x02, 0x05, 0x02, 0x12, 0x03, 0x36, 0x0d, 0x0f, 0x0a, 0x1b, 0x0a, 0x04, 0x05,
-    0x03, 0x02, 0x06, 0x12, 0x03, 0x39, 0x02, 0x14, 0x1a, 0x0e, 0x20, 0xe5, 0xae, 0xa1, 0xe6, 0xa0,
-    0xb8, 0xe9, 0x80, 0x9a, 0xe8, 0xbf, 0x87, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x03, 0x02, 0x06,
-    0x01, 0x12, 0x03, 0x39, 0x02, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x03, 0x02, 0x06, 0x02, 0x12,
-    0x03, 0x39, 0x11, 0x13, 0x0a, 0x18, 0x0a, 0x04, 0x05, 0x03, 0x02, 0x07, 0x12, 0x03, 0x3c, 0x02,
-    0x12, 0x1a, 0x0b, 0x20, 0xe5, 0xb7, 0xb2, 0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83, 0x0a, 0x0a, 0x0c,
-    0x0a, 0x05, 0x05, 0x03, 0x02, 0x07, 0x01, 0x12, 0x03, 0x3c, 0x02, 0x0b, 0x0a, 0x0c, 0x0a, 0x05,
-    0x05, 0x03, 0x02, 0x07, 0x02, 0x12, 0x03, 0x3c, 0x0e, 0x11, 0x0a, 0x18, 0x0a, 0x04, 0x05, 0x03,
-    0x02, 0x08, 0x12, 0x03, 0x3f, 0x02, 0x0f, 0x1a, 0x0b, 0x20, 0xe8, 0xa2, 0xab, 0xe5, 0xb0, 0x81,
-    0xe7, 0xa6, 0x81, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x03, 0x02, 0x08, 0x01, 0x12, 0x03, 0x3f,
-    0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x03, 0x02, 0x08, 0x02, 0x12, 0x03, 0x3f, 0x0b, 0x0e,
-    0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x00, 0x12, 0x04, 0x42, 0x00, 0x46, 0x01, 0x0a, 0x0a, 0x0a, 0x03,
-    0x04, 0x00, 0x01, 0x12, 0x03, 0x42, 0x08, 0x0f, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x00,
-    0x12, 0x03, 0x43, 0x02, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x05, 0x12, 0x03,
-    0x43, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x43, 0x09,
-    0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x43, 0x11, 0x12, 0x0a,
-    0x0b, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x01, 0x12, 0x03, 0x44, 0x02, 0x13, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x00, 0x02, 0x01, 0x05, 0x12, 0x03, 0x44, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00,
-    0x02, 0x01, 0x01, 0x12, 0x03, 0x44, 0x09, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01,
-    0x03, 0x12, 0x03, 0x44, 0x11, 0x12, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x02, 0x12, 0x03,
-    0x45, 0x02, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x05, 0x12, 0x03, 0x45, 0x02,
-    0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x01, 0x12, 0x03, 0x45, 0x09, 0x0e, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x03, 0x12, 0x03, 0x45, 0x11, 0x12, 0x0a, 0x0a, 0x0a,
-    0x02, 0x04, 0x01, 0x12, 0x04, 0x48, 0x00, 0x4b, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x01, 0x01,
-    0x12, 0x03, 0x48, 0x08, 0x12, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x00, 0x12, 0x03, 0x49,
-    0x02, 0x16, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x05, 0x12, 0x03, 0x49, 0x02, 0x08,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x01, 0x12, 0x03, 0x49, 0x09, 0x11, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x03, 0x12, 0x03, 0x49, 0x14, 0x15, 0x0a, 0x0b, 0x0a, 0x04,
-    0x04, 0x01, 0x02, 0x01, 0x12, 0x03, 0x4a, 0x02, 0x16, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02,
-    0x01, 0x05, 0x12, 0x03, 0x4a, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x01,
-    0x12, 0x03, 0x4a, 0x09, 0x0c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x03, 0x12, 0x03,
-    0x4a, 0x14, 0x15, 0x0a, 0x20, 0x0a, 0x02, 0x04, 0x02, 0x12, 0x04, 0x4e, 0x00, 0x60, 0x01, 0x1a,
-    0x14, 0x20, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe5, 0x85, 0xa8, 0xe9, 0x87, 0x8f, 0xe4, 0xbf,
-    0xa1, 0xe6, 0x81, 0xaf, 0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x02, 0x01, 0x12, 0x03, 0x4e, 0x08,
-    0x10, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x00, 0x12, 0x03, 0x4f, 0x02, 0x2b, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x05, 0x12, 0x03, 0x4f, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x02, 0x02, 0x00, 0x01, 0x12, 0x03, 0x4f, 0x16, 0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02,
-    0x02, 0x00, 0x03, 0x12, 0x03, 0x4f, 0x29, 0x2a, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x01,
-    0x12, 0x03, 0x50, 0x02, 0x2b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01, 0x05, 0x12, 0x03,
-    0x50, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01, 0x01, 0x12, 0x03, 0x50, 0x16,
-    0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01, 0x03, 0x12, 0x03, 0x50, 0x29, 0x2a, 0x0a,
-    0x0b, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x02, 0x12, 0x03, 0x51, 0x02, 0x2b, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x02, 0x02, 0x02, 0x05, 0x12, 0x03, 0x51, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02,
-    0x02, 0x02, 0x01, 0x12, 0x03, 0x51, 0x16, 0x1a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x02,
-    0x03, 0x12, 0x03, 0x51, 0x29, 0x2a, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x03, 0x12, 0x03,
-    0x52, 0x02, 0x2b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x03, 0x05, 0x12, 0x03, 0x52, 0x02,
-    0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x03, 0x01, 0x12, 0x03, 0x52, 0x16, 0x1d, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x03, 0x03, 0x12, 0x03, 0x52, 0x29, 0x2a, 0x0a, 0x0b, 0x0a,
-    0x04, 0x04, 0x02, 0x02, 0x04, 0x12, 0x03, 0x53, 0x02, 0x2b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02,
-    0x02, 0x04, 0x05, 0x12, 0x03, 0x53, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x04,
-    0x01, 0x12, 0x03, 0x53, 0x16, 0x1f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x04, 0x03, 0x12,
-    0x03, 0x53, 0x29, 0x2a, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x05, 0x12, 0x03, 0x54, 0x02,
-    0x2b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x05, 0x05, 0x12, 0x03, 0x54, 0x02, 0x07, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x05, 0x01, 0x12, 0x03, 0x54, 0x16, 0x1f, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x02, 0x02, 0x05, 0x03, 0x12, 0x03, 0x54, 0x29, 0x2a, 0x0a, 0x0b, 0x0a, 0x04, 0x04,
-    0x02, 0x02, 0x06, 0x12, 0x03, 0x55, 0x02, 0x2b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x06,
-    0x04, 0x12, 0x03, 0x55, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x06, 0x06, 0x12,
-    0x03, 0x55, 0x0b, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x06, 0x01, 0x12, 0x03, 0x55,
-    0x16, 0x1c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x06, 0x03, 0x12, 0x03, 0x55, 0x29, 0x2a,
-    0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x07, 0x12, 0x03, 0x56, 0x02, 0x2b, 0x22, 0x0e, 0x20,
-    0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x0a, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x02, 0x02, 0x07, 0x05, 0x12, 0x03, 0x56, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x02, 0x02, 0x07, 0x01, 0x12, 0x03, 0x56, 0x16, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02,
-    0x07, 0x03, 0x12, 0x03, 0x56, 0x29, 0x2a, 0x0a, 0x1a, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x08, 0x12,
-    0x03, 0x57, 0x02, 0x2b, 0x22, 0x0d, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe6, 0x95, 0xb0, 0xe9,
-    0x87, 0x8f, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x08, 0x05, 0x12, 0x03, 0x57, 0x02,
-    0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x08, 0x01, 0x12, 0x03, 0x57, 0x16, 0x1d, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x08, 0x03, 0x12, 0x03, 0x57, 0x29, 0x2a, 0x0a, 0x1e, 0x0a,
-    0x04, 0x04, 0x02, 0x02, 0x09, 0x12, 0x03, 0x58, 0x02, 0x2c, 0x22, 0x11, 0x20, 0xe7, 0xac, 0x94,
-    0xe8, 0xae, 0xb0, 0xe7, 0x9a, 0x84, 0xe6, 0xa0, 0x87, 0xe7, 0xad, 0xbe, 0x0a, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x02, 0x02, 0x09, 0x04, 0x12, 0x03, 0x58, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x02, 0x02, 0x09, 0x06, 0x12, 0x03, 0x58, 0x0b, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02,
-    0x09, 0x01, 0x12, 0x03, 0x58, 0x16, 0x1a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x09, 0x03,
-    0x12, 0x03, 0x58, 0x29, 0x2b, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x0a, 0x12, 0x03, 0x59,
-    0x02, 0x2c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x0a, 0x05, 0x12, 0x03, 0x59, 0x02, 0x07,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x0a, 0x01, 0x12, 0x03, 0x59, 0x16, 0x1b, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x02, 0x02, 0x0a, 0x03, 0x12, 0x03, 0x59, 0x29, 0x2b, 0x0a, 0x0b, 0x0a, 0x04,
-    0x04, 0x02, 0x02, 0x0b, 0x12, 0x03, 0x5a, 0x02, 0x2c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02,
-    0x0b, 0x05, 0x12, 0x03, 0x5a, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x0b, 0x01,
-    0x12, 0x03, 0x5a, 0x16, 0x18, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x0b, 0x03, 0x12, 0x03,
-    0x5a, 0x29, 0x2b, 0x0a, 0x13, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x0c, 0x12, 0x03, 0x5b, 0x02, 0x2c,
-    0x22, 0x06, 0x20, 0x40, 0xe4, 0xba, 0xba, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x0c,
-    0x04, 0x12, 0x03, 0x5b, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x0c, 0x06, 0x12,
-    0x03, 0x5b, 0x0b, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x0c, 0x01, 0x12, 0x03, 0x5b,
-    0x16, 0x1e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x0c, 0x03, 0x12, 0x03, 0x5b, 0x29, 0x2b,
-    0x0a, 0x3a, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x0d, 0x12, 0x03, 0x5c, 0x02, 0x2c, 0x22, 0x2d, 0x20,
-    0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x20, 0xe5, 0x9b, 0xbe,
-    0xe7, 0x89, 0x87, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe6, 0x88, 0x96, 0xe8, 0x80, 0x85, 0xe8,
-    0xa7, 0x86, 0xe9, 0xa2, 0x91, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x0a, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x02, 0x02, 0x0d, 0x06, 0x12, 0x03, 0x5c, 0x02, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02,
-    0x02, 0x0d, 0x01, 0x12, 0x03, 0x5c, 0x16, 0x1f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x0d,
-    0x03, 0x12, 0x03, 0x5c, 0x29, 0x2b, 0x0a, 0x29, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x0e, 0x12, 0x03,
-    0x5d, 0x02, 0x2c, 0x22, 0x1c, 0x20, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe7, 0x8a, 0xb6, 0xe6,
-    0x80, 0x81, 0x28, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x29,
-    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x0e, 0x06, 0x12, 0x03, 0x5d, 0x02, 0x0b, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x0e, 0x01, 0x12, 0x03, 0x5d, 0x16, 0x1b, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x02, 0x02, 0x0e, 0x03, 0x12, 0x03, 0x5d, 0x29, 0x2b, 0x0a, 0x0b, 0x0a, 0x04, 0x04,
-    0x02, 0x02, 0x0f, 0x12, 0x03, 0x5e, 0x02, 0x2c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x0f,
-    0x04, 0x12, 0x03, 0x5e, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x0f, 0x06, 0x12,
-    0x03, 0x5e, 0x0b, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x0f, 0x01, 0x12, 0x03, 0x5e,
-    0x16, 0x1c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x0f, 0x03, 0x12, 0x03, 0x5e, 0x29, 0x2b,
-    0x0a, 0x35, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x10, 0x12, 0x03, 0x5f, 0x02, 0x2c, 0x22, 0x28, 0x20,
-    0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe7, 0x94, 0x9f, 0xe5, 0x91, 0xbd, 0xe5, 0x91, 0xa8, 0xe6,
-    0x9c, 0x9f, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x28, 0xe5, 0xaf, 0xb9, 0xe5, 0xa4, 0x96, 0xe7,
-    0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x29, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x10, 0x06,
-    0x12, 0x03, 0x5f, 0x02, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x10, 0x01, 0x12, 0x03,
-    0x5f, 0x16, 0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x10, 0x03, 0x12, 0x03, 0x5f, 0x29,
-    0x2b, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x03, 0x12, 0x04, 0x62, 0x00, 0x66, 0x01, 0x0a, 0x0a, 0x0a,
-    0x03, 0x04, 0x03, 0x01, 0x12, 0x03, 0x62, 0x08, 0x15, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x03, 0x02,
-    0x00, 0x12, 0x03, 0x63, 0x02, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x05, 0x12,
-    0x03, 0x63, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x01, 0x12, 0x03, 0x63,
-    0x09, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x03, 0x12, 0x03, 0x63, 0x12, 0x13,
-    0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x03, 0x02, 0x01, 0x12, 0x03, 0x64, 0x02, 0x14, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x03, 0x02, 0x01, 0x05, 0x12, 0x03, 0x64, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x03, 0x02, 0x01, 0x01, 0x12, 0x03, 0x64, 0x09, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02,
-    0x01, 0x03, 0x12, 0x03, 0x64, 0x12, 0x13, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x03, 0x02, 0x02, 0x12,
-    0x03, 0x65, 0x02, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x02, 0x05, 0x12, 0x03, 0x65,
-    0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x02, 0x01, 0x12, 0x03, 0x65, 0x09, 0x0f,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x02, 0x03, 0x12, 0x03, 0x65, 0x12, 0x13, 0x0a, 0x0a,
-    0x0a, 0x02, 0x04, 0x04, 0x12, 0x04, 0x68, 0x00, 0x6c, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x04,
-    0x01, 0x12, 0x03, 0x68, 0x08, 0x11, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x00, 0x12, 0x03,
-    0x69, 0x02, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x05, 0x12, 0x03, 0x69, 0x02,
-    0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x01, 0x12, 0x03, 0x69, 0x10, 0x13, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x03, 0x12, 0x03, 0x69, 0x17, 0x18, 0x0a, 0x0b, 0x0a,
-    0x04, 0x04, 0x04, 0x02, 0x01, 0x12, 0x03, 0x6a, 0x02, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04,
-    0x02, 0x01, 0x05, 0x12, 0x03, 0x6a, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x01,
-    0x01, 0x12, 0x03, 0x6a, 0x10, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x01, 0x03, 0x12,
-    0x03, 0x6a, 0x17, 0x18, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x02, 0x12, 0x03, 0x6b, 0x02,
-    0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x02, 0x06, 0x12, 0x03, 0x6b, 0x02, 0x0f, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x02, 0x01, 0x12, 0x03, 0x6b, 0x10, 0x14, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x04, 0x02, 0x02, 0x03, 0x12, 0x03, 0x6b, 0x17, 0x18, 0x0a, 0x0a, 0x0a, 0x02, 0x04,
-    0x05, 0x12, 0x04, 0x6e, 0x00, 0x72, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x05, 0x01, 0x12, 0x03,
-    0x6e, 0x08, 0x11, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x05, 0x02, 0x00, 0x12, 0x03, 0x6f, 0x02, 0x19,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x00, 0x05, 0x12, 0x03, 0x6f, 0x02, 0x08, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x05, 0x02, 0x00, 0x01, 0x12, 0x03, 0x6f, 0x10, 0x13, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x05, 0x02, 0x00, 0x03, 0x12, 0x03, 0x6f, 0x17, 0x18, 0x0a, 0x27, 0x0a, 0x04, 0x04, 0x05,
-    0x02, 0x01, 0x12, 0x03, 0x70, 0x02, 0x19, 0x22, 0x1a, 0x20, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90,
-    0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x20, 0xe6, 0x81, 0x92, 0xe4, 0xb8, 0xba, 0x56, 0x49, 0x44,
-    0x45, 0x4f, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x01, 0x05, 0x12, 0x03, 0x70, 0x02,
-    0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x01, 0x01, 0x12, 0x03, 0x70, 0x10, 0x14, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x01, 0x03, 0x12, 0x03, 0x70, 0x17, 0x18, 0x0a, 0x0b, 0x0a,
-    0x04, 0x04, 0x05, 0x02, 0x02, 0x12, 0x03, 0x71, 0x02, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05,
-    0x02, 0x02, 0x06, 0x12, 0x03, 0x71, 0x02, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x02,
-    0x01, 0x12, 0x03, 0x71, 0x10, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x02, 0x03, 0x12,
-    0x03, 0x71, 0x17, 0x18, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x06, 0x12, 0x04, 0x74, 0x00, 0x7d, 0x01,
-    0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x06, 0x01, 0x12, 0x03, 0x74, 0x08, 0x15, 0x0a, 0x0b, 0x0a, 0x04,
-    0x04, 0x06, 0x02, 0x00, 0x12, 0x03, 0x75, 0x02, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02,
-    0x00, 0x05, 0x12, 0x03, 0x75, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x01,
-    0x12, 0x03, 0x75, 0x09, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x03, 0x12, 0x03,
-    0x75, 0x17, 0x18, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x06, 0x02, 0x01, 0x12, 0x03, 0x76, 0x02, 0x19,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x01, 0x05, 0x12, 0x03, 0x76, 0x02, 0x08, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x06, 0x02, 0x01, 0x01, 0x12, 0x03, 0x76, 0x09, 0x0f, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x06, 0x02, 0x01, 0x03, 0x12, 0x03, 0x76, 0x17, 0x18, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x06,
-    0x02, 0x02, 0x12, 0x03, 0x77, 0x02, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x02, 0x05,
-    0x12, 0x03, 0x77, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x02, 0x01, 0x12, 0x03,
-    0x77, 0x09, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x02, 0x03, 0x12, 0x03, 0x77, 0x17,
-    0x18, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x06, 0x02, 0x03, 0x12, 0x03, 0x78, 0x02, 0x19, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x06, 0x02, 0x03, 0x05, 0x12, 0x03, 0x78, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x06, 0x02, 0x03, 0x01, 0x12, 0x03, 0x78, 0x09, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06,
-    0x02, 0x03, 0x03, 0x12, 0x03, 0x78, 0x17, 0x18, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x06, 0x02, 0x04,
-    0x12, 0x03, 0x79, 0x02, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x04, 0x05, 0x12, 0x03,
-    0x79, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x04, 0x01, 0x12, 0x03, 0x79, 0x09,
-    0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x04, 0x03, 0x12, 0x03, 0x79, 0x17, 0x18, 0x0a,
-    0x0b, 0x0a, 0x04, 0x04, 0x06, 0x02, 0x05, 0x12, 0x03, 0x7a, 0x02, 0x19, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x06, 0x02, 0x05, 0x05, 0x12, 0x03, 0x7a, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06,
-    0x02, 0x05, 0x01, 0x12, 0x03, 0x7a, 0x09, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x05,
-    0x03, 0x12, 0x03, 0x7a, 0x17, 0x18, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x06, 0x02, 0x06, 0x12, 0x03,
-    0x7b, 0x02, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x06, 0x05, 0x12, 0x03, 0x7b, 0x02,
-    0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x06, 0x01, 0x12, 0x03, 0x7b, 0x09, 0x12, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x06, 0x03, 0x12, 0x03, 0x7b, 0x17, 0x18, 0x0a, 0x0b, 0x0a,
-    0x04, 0x04, 0x06, 0x02, 0x07, 0x12, 0x03, 0x7c, 0x02, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06,
-    0x02, 0x07, 0x05, 0x12, 0x03, 0x7c, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x07,
-    0x01, 0x12, 0x03, 0x7c, 0x09, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x07, 0x03, 0x12,
-    0x03, 0x7c, 0x17, 0x18, 0x0a, 0x67, 0x0a, 0x02, 0x04, 0x07, 0x12, 0x06, 0x80, 0x01, 0x00, 0x8d,
-    0x01, 0x01, 0x1a, 0x59, 0x20, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe4, 0xbf, 0xa1, 0xe6, 0x81,
-    0xaf, 0xe7, 0x9a, 0x84, 0xe6, 0xa6, 0x82, 0xe8, 0xa7, 0x88, 0xef, 0xbc, 0x88, 0xe4, 0xb8, 0x80,
-    0xe8, 0x88, 0xac, 0xe7, 0x94, 0xa8, 0xe4, 0xba, 0x8e, 0xe9, 0xa6, 0x96, 0xe9, 0xa1, 0xb5, 0xef,
-    0xbc, 0x8c, 0xe5, 0x85, 0xb6, 0xe5, 0xae, 0x83, 0xe9, 0x9d, 0x9e, 0xe5, 0x88, 0x9b, 0xe4, 0xbd,
-    0x9c, 0xe8, 0x80, 0x85, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0,
-    0xe7, 0x9a, 0x84, 0xe5, 0x9c, 0xba, 0xe6, 0x99, 0xaf, 0xef, 0xbc, 0x89, 0x0a, 0x0a, 0x0b, 0x0a,
-    0x03, 0x04, 0x07, 0x01, 0x12, 0x04, 0x80, 0x01, 0x08, 0x14, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x07,
-    0x02, 0x00, 0x12, 0x04, 0x81, 0x01, 0x02, 0x24, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00,
-    0x05, 0x12, 0x04, 0x81, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x01,
-    0x12, 0x04, 0x81, 0x01, 0x15, 0x1c, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x03, 0x12,
-    0x04, 0x81, 0x01, 0x22, 0x23, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x07, 0x02, 0x01, 0x12, 0x04, 0x82,
-    0x01, 0x02, 0x24, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x01, 0x05, 0x12, 0x04, 0x82, 0x01,
-    0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x01, 0x01, 0x12, 0x04, 0x82, 0x01, 0x15,
-    0x1a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x01, 0x03, 0x12, 0x04, 0x82, 0x01, 0x22, 0x23,
-    0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x07, 0x02, 0x02, 0x12, 0x04, 0x83, 0x01, 0x02, 0x24, 0x0a, 0x0d,
-    0x0a, 0x05, 0x04, 0x07, 0x02, 0x02, 0x05, 0x12, 0x04, 0x83, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a,
-    0x05, 0x04, 0x07, 0x02, 0x02, 0x01, 0x12, 0x04, 0x83, 0x01, 0x15, 0x19, 0x0a, 0x0d, 0x0a, 0x05,
-    0x04, 0x07, 0x02, 0x02, 0x03, 0x12, 0x04, 0x83, 0x01, 0x22, 0x23, 0x0a, 0x0c, 0x0a, 0x04, 0x04,
-    0x07, 0x02, 0x03, 0x12, 0x04, 0x84, 0x01, 0x02, 0x24, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02,
-    0x03, 0x05, 0x12, 0x04, 0x84, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x03,
-    0x01, 0x12, 0x04, 0x84, 0x01, 0x15, 0x1f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x03, 0x03,
-    0x12, 0x04, 0x84, 0x01, 0x22, 0x23, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x07, 0x02, 0x04, 0x12, 0x04,
-    0x85, 0x01, 0x02, 0x24, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x04, 0x04, 0x12, 0x04, 0x85,
-    0x01, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x04, 0x06, 0x12, 0x04, 0x85, 0x01,
-    0x0b, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x04, 0x01, 0x12, 0x04, 0x85, 0x01, 0x15,
-    0x1b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x04, 0x03, 0x12, 0x04, 0x85, 0x01, 0x22, 0x23,
-    0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x07, 0x02, 0x05, 0x12, 0x04, 0x86, 0x01, 0x02, 0x24, 0x22, 0x0e,
-    0x20, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x0a, 0x0a, 0x0d,
-    0x0a, 0x05, 0x04, 0x07, 0x02, 0x05, 0x05, 0x12, 0x04, 0x86, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a,
-    0x05, 0x04, 0x07, 0x02, 0x05, 0x01, 0x12, 0x04, 0x86, 0x01, 0x15, 0x1a, 0x0a, 0x0d, 0x0a, 0x05,
-    0x04, 0x07, 0x02, 0x05, 0x03, 0x12, 0x04, 0x86, 0x01, 0x22, 0x23, 0x0a, 0x1c, 0x0a, 0x04, 0x04,
-    0x07, 0x02, 0x06, 0x12, 0x04, 0x87, 0x01, 0x02, 0x24, 0x22, 0x0e, 0x20, 0xe7, 0xac, 0x94, 0xe8,
-    0xae, 0xb0, 0xe4, 0xbd, 0x9c, 0xe8, 0x80, 0x85, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02,
-    0x06, 0x05, 0x12, 0x04, 0x87, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x06,
-    0x01, 0x12, 0x04, 0x87, 0x01, 0x15, 0x1b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x06, 0x03,
-    0x12, 0x04, 0x87, 0x01, 0x22, 0x23, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x07, 0x02, 0x07, 0x12, 0x04,
-    0x88, 0x01, 0x02, 0x24, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x07, 0x05, 0x12, 0x04, 0x88,
-    0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x07, 0x01, 0x12, 0x04, 0x88, 0x01,
-    0x15, 0x1c, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x07, 0x03, 0x12, 0x04, 0x88, 0x01, 0x22,
-    0x23, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x07, 0x02, 0x08, 0x12, 0x04, 0x89, 0x01, 0x02, 0x24, 0x0a,
-    0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x08, 0x05, 0x12, 0x04, 0x89, 0x01, 0x02, 0x07, 0x0a, 0x0d,
-    0x0a, 0x05, 0x04, 0x07, 0x02, 0x08, 0x01, 0x12, 0x04, 0x89, 0x01, 0x15, 0x1f, 0x0a, 0x0d, 0x0a,
-    0x05, 0x04, 0x07, 0x02, 0x08, 0x03, 0x12, 0x04, 0x89, 0x01, 0x22, 0x23, 0x0a, 0x0c, 0x0a, 0x04,
-    0x04, 0x07, 0x02, 0x09, 0x12, 0x04, 0x8a, 0x01, 0x02, 0x25, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07,
-    0x02, 0x09, 0x05, 0x12, 0x04, 0x8a, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02,
-    0x09, 0x01, 0x12, 0x04, 0x8a, 0x01, 0x15, 0x17, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x09,
-    0x03, 0x12, 0x04, 0x8a, 0x01, 0x22, 0x24, 0x0a, 0x3b, 0x0a, 0x04, 0x04, 0x07, 0x02, 0x0a, 0x12,
-    0x04, 0x8b, 0x01, 0x02, 0x25, 0x22, 0x2d, 0x20, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe7, 0xb1,
-    0xbb, 0xe5, 0x9e, 0x8b, 0x20, 0xe5, 0x9b, 0xbe, 0xe7, 0x89, 0x87, 0xe7, 0xac, 0x94, 0xe8, 0xae,
-    0xb0, 0xe6, 0x88, 0x96, 0xe8, 0x80, 0x85, 0xe8, 0xa7, 0x86, 0xe9, 0xa2, 0x91, 0xe7, 0xac, 0x94,
-    0xe8, 0xae, 0xb0, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x0a, 0x06, 0x12, 0x04, 0x8b,
-    0x01, 0x02, 0x0f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x0a, 0x01, 0x12, 0x04, 0x8b, 0x01,
-    0x15, 0x1e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x0a, 0x03, 0x12, 0x04, 0x8b, 0x01, 0x22,
-    0x24, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x07, 0x02, 0x0b, 0x12, 0x04, 0x8c, 0x01, 0x02, 0x25, 0x22,
-    0x0e, 0x20, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe8, 0xa7, 0x86, 0xe9, 0xa2, 0x91, 0x0a, 0x0a,
-    0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x0b, 0x04, 0x12, 0x04, 0x8c, 0x01, 0x02, 0x0a, 0x0a, 0x0d,
-    0x0a, 0x05, 0x04, 0x07, 0x02, 0x0b, 0x06, 0x12, 0x04, 0x8c, 0x01, 0x0b, 0x14, 0x0a, 0x0d, 0x0a,
-    0x05, 0x04, 0x07, 0x02, 0x0b, 0x01, 0x12, 0x04, 0x8c, 0x01, 0x15, 0x1b, 0x0a, 0x0d, 0x0a, 0x05,
-    0x04, 0x07, 0x02, 0x0b, 0x03, 0x12, 0x04, 0x8c, 0x01, 0x22, 0x24, 0x0a, 0x0c, 0x0a, 0x02, 0x04,
-    0x08, 0x12, 0x06, 0x8f, 0x01, 0x00, 0x92, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x08, 0x01,
-    0x12, 0x04, 0x8f, 0x01, 0x08, 0x17, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x08, 0x02, 0x00, 0x12, 0x04,
-    0x90, 0x01, 0x02, 0x23, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x04, 0x12, 0x04, 0x90,
-    0x01, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x06, 0x12, 0x04, 0x90, 0x01,
-    0x0b, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x01, 0x12, 0x04, 0x90, 0x01, 0x16,
-    0x1a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x03, 0x12, 0x04, 0x90, 0x01, 0x21, 0x22,
-    0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x08, 0x02, 0x01, 0x12, 0x04, 0x91, 0x01, 0x02, 0x23, 0x0a, 0x0d,
-    0x0a, 0x05, 0x04, 0x08, 0x02, 0x01, 0x04, 0x12, 0x04, 0x91, 0x01, 0x02, 0x0a, 0x0a, 0x0d, 0x0a,
-    0x05, 0x04, 0x08, 0x02, 0x01, 0x06, 0x12, 0x04, 0x91, 0x01, 0x0b, 0x15, 0x0a, 0x0d, 0x0a, 0x05,
-    0x04, 0x08, 0x02, 0x01, 0x01, 0x12, 0x04, 0x91, 0x01, 0x16, 0x1e, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
-    0x08, 0x02, 0x01, 0x03, 0x12, 0x04, 0x91, 0x01, 0x21, 0x22, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-    0x6f, 0x33, 0x0a, 0xf4, 0x3b, 0x0a, 0x1d, 0x6e, 0x6f, 0x74, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-    0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70,
-    0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-    0x31, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f,
-    0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16,
-    0x6e, 0x6f, 0x74, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65,
-    0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4a, 0x0a, 0x14, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72,
-    0x4f, 0x77, 0x6e, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
-    0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64,
-    0x12, 0x20, 0x0a, 0x07, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-    0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x65,
-    0x49, 0x64, 0x22, 0x41, 0x0a, 0x15, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x4e,
-    0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75,
-    0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a,
-    0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72,
-    0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x36, 0x0a, 0x12, 0x49, 0x73, 0x4e, 0x6f, 0x74, 0x65, 0x45,
-    0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x6e,
-    0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48,
-    0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x22, 0x2b, 0x0a,
-    0x13, 0x49, 0x73, 0x4e, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-    0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x78, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20,
-    0x01, 0x28, 0x08, 0x52, 0x05, 0x65, 0x78, 0x69, 0x73, 0x74, 0x22, 0x99, 0x01, 0x0a, 0x0e, 0x43,
-    0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x42, 0x61, 0x73, 0x69, 0x63, 0x12, 0x14, 0x0a,
-    0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
-    0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28,
-    0x09, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61,
-    0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63,
-    0x79, 0x12, 0x43, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-    0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69,
-    0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70,
-    0x65, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x09, 0x61, 0x73, 0x73,
-    0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74,
-    0x65, 0x52, 0x65, 0x71, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x07, 0x66, 0x69, 0x6c,
-    0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72,
-    0x02, 0x10, 0x01, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x05, 0x77,
-    0x69, 0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xba, 0x48, 0x04, 0x2a,
-    0x02, 0x20, 0x00, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x06, 0x68, 0x65,
-    0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xba, 0x48, 0x04, 0x2a,
-    0x02, 0x20, 0x00, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x66,
-    0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04,
-    0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x73, 0x0a, 0x0e,
-    0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x17,
-    0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-    0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65,
-    0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-    0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x22, 0x0a,
-    0x0d, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03,
-    0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x49,
-    0x64, 0x22, 0x29, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x54, 0x61,
-    0x67, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x67, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20,
-    0x03, 0x28, 0x03, 0x52, 0x07, 0x74, 0x61, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x91, 0x02, 0x0a,
-    0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-    0x73, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28,
-    0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-    0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x42, 0x61, 0x73, 0x69, 0x63, 0x52, 0x05,
-    0x62, 0x61, 0x73, 0x69, 0x63, 0x12, 0x33, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18,
-    0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69,
-    0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x49, 0x6d, 0x61,
-    0x67, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x61,
-    0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e,
-    0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
-    0x54, 0x61, 0x67, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x61, 0x74, 0x5f,
-    0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x6f,
-    0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x41, 0x74,
-    0x55, 0x73, 0x65, 0x72, 0x52, 0x07, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x31, 0x0a,
-    0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e,
+    0x0c, 0x0a, 0x05, 0x05, 0x03, 0x02, 0x07, 0x01, 0x12, 0x03, 0x48, 0x02, 0x0a, 0x0a, 0x0c, 0x0a,
+    0x05, 0x05, 0x03, 0x02, 0x07, 0x02, 0x12, 0x03, 0x48, 0x0d, 0x0f, 0x0a, 0x1b, 0x0a, 0x04, 0x05,
+    0x03, 0x02, 0x08, 0x12, 0x03, 0x4b, 0x02, 0x14, 0x1a, 0x0e, 0x20, 0xe5, 0xae, 0xa1, 0xe6, 0xa0,
+    0xb8, 0xe9, 0x80, 0x9a, 0xe8, 0xbf, 0x87, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x03, 0x02, 0x08,
+    0x01, 0x12, 0x03, 0x4b, 0x02, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x03, 0x02, 0x08, 0x02, 0x12,
+    0x03, 0x4b, 0x11, 0x13, 0x0a, 0x1e, 0x0a, 0x04, 0x05, 0x03, 0x02, 0x09, 0x12, 0x03, 0x4e, 0x02,
+    0x17, 0x1a, 0x11, 0x20, 0xe4, 0xba, 0xba, 0xe5, 0xb7, 0xa5, 0xe5, 0xa4, 0x8d, 0xe5, 0xae, 0xa1,
+    0xe4, 0xb8, 0xad, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x03, 0x02, 0x09, 0x01, 0x12, 0x03, 0x4e,
+    0x02, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x03, 0x02, 0x09, 0x02, 0x12, 0x03, 0x4e, 0x14, 0x16,
+    0x0a, 0x18, 0x0a, 0x04, 0x05, 0x03, 0x02, 0x0a, 0x12, 0x03, 0x51, 0x02, 0x12, 0x1a, 0x0b, 0x20,
+    0xe5, 0xb7, 0xb2, 0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x03,
+    0x02, 0x0a, 0x01, 0x12, 0x03, 0x51, 0x02, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x03, 0x02, 0x0a,
+    0x02, 0x12, 0x03, 0x51, 0x0e, 0x11, 0x0a, 0x18, 0x0a, 0x04, 0x05, 0x03, 0x02, 0x0b, 0x12, 0x03,
+    0x54, 0x02, 0x0f, 0x1a, 0x0b, 0x20, 0xe8, 0xa2, 0xab, 0xe5, 0xb0, 0x81, 0xe7, 0xa6, 0x81, 0x0a,
+    0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x03, 0x02, 0x0b, 0x01, 0x12, 0x03, 0x54, 0x02, 0x08, 0x0a, 0x0c,
+    0x0a, 0x05, 0x05, 0x03, 0x02, 0x0b, 0x02, 0x12, 0x03, 0x54, 0x0b, 0x0e, 0x0a, 0x3a, 0x0a, 0x04,
+    0x05, 0x03, 0x02, 0x0c, 0x12, 0x03, 0x57, 0x02, 0x11, 0x1a, 0x2d, 0x20, 0xe5, 0xb7, 0xb2, 0xe5,
+    0x88, 0xa0, 0xe9, 0x99, 0xa4, 0x20, 0xe5, 0x9c, 0xa8, 0xe5, 0x9b, 0x9e, 0xe6, 0x94, 0xb6, 0xe7,
+    0xab, 0x99, 0xe4, 0xb8, 0xad, 0xe7, 0xad, 0x89, 0xe5, 0xbe, 0x85, 0xe5, 0xbd, 0xbb, 0xe5, 0xba,
+    0x95, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x03, 0x02, 0x0c,
+    0x01, 0x12, 0x03, 0x57, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x03, 0x02, 0x0c, 0x02, 0x12,
+    0x03, 0x57, 0x0d, 0x10, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x00, 0x12, 0x04, 0x5a, 0x00, 0x5e, 0x01,
+    0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x00, 0x01, 0x12, 0x03, 0x5a, 0x08, 0x0f, 0x0a, 0x0b, 0x0a, 0x04,
+    0x04, 0x00, 0x02, 0x00, 0x12, 0x03, 0x5b, 0x02, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02,
+    0x00, 0x05, 0x12, 0x03, 0x5b, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x01,
+    0x12, 0x03, 0x5b, 0x09, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x03, 0x12, 0x03,
+    0x5b, 0x11, 0x12, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x01, 0x12, 0x03, 0x5c, 0x02, 0x13,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x05, 0x12, 0x03, 0x5c, 0x02, 0x08, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x5c, 0x09, 0x0d, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x00, 0x02, 0x01, 0x03, 0x12, 0x03, 0x5c, 0x11, 0x12, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x00,
+    0x02, 0x02, 0x12, 0x03, 0x5d, 0x02, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x05,
+    0x12, 0x03, 0x5d, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x01, 0x12, 0x03,
+    0x5d, 0x09, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x03, 0x12, 0x03, 0x5d, 0x11,
+    0x12, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x01, 0x12, 0x04, 0x60, 0x00, 0x63, 0x01, 0x0a, 0x0a, 0x0a,
+    0x03, 0x04, 0x01, 0x01, 0x12, 0x03, 0x60, 0x08, 0x12, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02,
+    0x00, 0x12, 0x03, 0x61, 0x02, 0x16, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x05, 0x12,
+    0x03, 0x61, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x01, 0x12, 0x03, 0x61,
+    0x09, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x03, 0x12, 0x03, 0x61, 0x14, 0x15,
+    0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x01, 0x12, 0x03, 0x62, 0x02, 0x16, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x01, 0x02, 0x01, 0x05, 0x12, 0x03, 0x62, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x01, 0x02, 0x01, 0x01, 0x12, 0x03, 0x62, 0x09, 0x0c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02,
+    0x01, 0x03, 0x12, 0x03, 0x62, 0x14, 0x15, 0x0a, 0x20, 0x0a, 0x02, 0x04, 0x02, 0x12, 0x04, 0x66,
+    0x00, 0x7b, 0x01, 0x1a, 0x14, 0x20, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe5, 0x85, 0xa8, 0xe9,
+    0x87, 0x8f, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x02, 0x01,
+    0x12, 0x03, 0x66, 0x08, 0x10, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x00, 0x12, 0x03, 0x67,
+    0x02, 0x2b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x05, 0x12, 0x03, 0x67, 0x02, 0x07,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x01, 0x12, 0x03, 0x67, 0x16, 0x1d, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x03, 0x12, 0x03, 0x67, 0x29, 0x2a, 0x0a, 0x0b, 0x0a, 0x04,
+    0x04, 0x02, 0x02, 0x01, 0x12, 0x03, 0x68, 0x02, 0x2b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02,
+    0x01, 0x05, 0x12, 0x03, 0x68, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01, 0x01,
+    0x12, 0x03, 0x68, 0x16, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01, 0x03, 0x12, 0x03,
+    0x68, 0x29, 0x2a, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x02, 0x12, 0x03, 0x69, 0x02, 0x2b,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x02, 0x05, 0x12, 0x03, 0x69, 0x02, 0x08, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x02, 0x02, 0x02, 0x01, 0x12, 0x03, 0x69, 0x16, 0x1a, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x02, 0x02, 0x02, 0x03, 0x12, 0x03, 0x69, 0x29, 0x2a, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x02,
+    0x02, 0x03, 0x12, 0x03, 0x6a, 0x02, 0x2b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x03, 0x05,
+    0x12, 0x03, 0x6a, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x03, 0x01, 0x12, 0x03,
+    0x6a, 0x16, 0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x03, 0x03, 0x12, 0x03, 0x6a, 0x29,
+    0x2a, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x04, 0x12, 0x03, 0x6b, 0x02, 0x2b, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x02, 0x02, 0x04, 0x05, 0x12, 0x03, 0x6b, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x02, 0x02, 0x04, 0x01, 0x12, 0x03, 0x6b, 0x16, 0x1f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02,
+    0x02, 0x04, 0x03, 0x12, 0x03, 0x6b, 0x29, 0x2a, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x05,
+    0x12, 0x03, 0x6c, 0x02, 0x2b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x05, 0x05, 0x12, 0x03,
+    0x6c, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x05, 0x01, 0x12, 0x03, 0x6c, 0x16,
+    0x1f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x05, 0x03, 0x12, 0x03, 0x6c, 0x29, 0x2a, 0x0a,
+    0x0b, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x06, 0x12, 0x03, 0x6d, 0x02, 0x2b, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x02, 0x02, 0x06, 0x04, 0x12, 0x03, 0x6d, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02,
+    0x02, 0x06, 0x06, 0x12, 0x03, 0x6d, 0x0b, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x06,
+    0x01, 0x12, 0x03, 0x6d, 0x16, 0x1c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x06, 0x03, 0x12,
+    0x03, 0x6d, 0x29, 0x2a, 0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x07, 0x12, 0x03, 0x6e, 0x02,
+    0x2b, 0x22, 0x0e, 0x20, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f,
+    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x07, 0x05, 0x12, 0x03, 0x6e, 0x02, 0x07, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x07, 0x01, 0x12, 0x03, 0x6e, 0x16, 0x1b, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x02, 0x02, 0x07, 0x03, 0x12, 0x03, 0x6e, 0x29, 0x2a, 0x0a, 0x1a, 0x0a, 0x04, 0x04,
+    0x02, 0x02, 0x08, 0x12, 0x03, 0x6f, 0x02, 0x2b, 0x22, 0x0d, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba,
+    0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x08, 0x05,
+    0x12, 0x03, 0x6f, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x08, 0x01, 0x12, 0x03,
+    0x6f, 0x16, 0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x08, 0x03, 0x12, 0x03, 0x6f, 0x29,
+    0x2a, 0x0a, 0x1e, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x09, 0x12, 0x03, 0x70, 0x02, 0x2c, 0x22, 0x11,
+    0x20, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe7, 0x9a, 0x84, 0xe6, 0xa0, 0x87, 0xe7, 0xad, 0xbe,
+    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x09, 0x04, 0x12, 0x03, 0x70, 0x02, 0x0a, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x09, 0x06, 0x12, 0x03, 0x70, 0x0b, 0x12, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x02, 0x02, 0x09, 0x01, 0x12, 0x03, 0x70, 0x16, 0x1a, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x02, 0x02, 0x09, 0x03, 0x12, 0x03, 0x70, 0x29, 0x2b, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x02, 0x02,
+    0x0a, 0x12, 0x03, 0x71, 0x02, 0x2c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x0a, 0x05, 0x12,
+    0x03, 0x71, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x0a, 0x01, 0x12, 0x03, 0x71,
+    0x16, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x0a, 0x03, 0x12, 0x03, 0x71, 0x29, 0x2b,
+    0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x0b, 0x12, 0x03, 0x72, 0x02, 0x2c, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x02, 0x02, 0x0b, 0x05, 0x12, 0x03, 0x72, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x02, 0x02, 0x0b, 0x01, 0x12, 0x03, 0x72, 0x16, 0x18, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02,
+    0x0b, 0x03, 0x12, 0x03, 0x72, 0x29, 0x2b, 0x0a, 0x13, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x0c, 0x12,
+    0x03, 0x73, 0x02, 0x2c, 0x22, 0x06, 0x20, 0x40, 0xe4, 0xba, 0xba, 0x0a, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x02, 0x02, 0x0c, 0x04, 0x12, 0x03, 0x73, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02,
+    0x02, 0x0c, 0x06, 0x12, 0x03, 0x73, 0x0b, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x0c,
+    0x01, 0x12, 0x03, 0x73, 0x16, 0x1e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x0c, 0x03, 0x12,
+    0x03, 0x73, 0x29, 0x2b, 0x0a, 0x3a, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x0d, 0x12, 0x03, 0x74, 0x02,
+    0x2c, 0x22, 0x2d, 0x20, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b,
+    0x20, 0xe5, 0x9b, 0xbe, 0xe7, 0x89, 0x87, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe6, 0x88, 0x96,
+    0xe8, 0x80, 0x85, 0xe8, 0xa7, 0x86, 0xe9, 0xa2, 0x91, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x0a,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x0d, 0x06, 0x12, 0x03, 0x74, 0x02, 0x0f, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x02, 0x02, 0x0d, 0x01, 0x12, 0x03, 0x74, 0x16, 0x1f, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x02, 0x02, 0x0d, 0x03, 0x12, 0x03, 0x74, 0x29, 0x2b, 0x0a, 0x29, 0x0a, 0x04, 0x04, 0x02,
+    0x02, 0x0e, 0x12, 0x03, 0x75, 0x02, 0x2c, 0x22, 0x1c, 0x20, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0,
+    0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x28, 0xe5, 0x86, 0x85, 0xe9, 0x83, 0xa8, 0xe7, 0x8a, 0xb6,
+    0xe6, 0x80, 0x81, 0x29, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x0e, 0x06, 0x12, 0x03,
+    0x75, 0x02, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x0e, 0x01, 0x12, 0x03, 0x75, 0x16,
+    0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x0e, 0x03, 0x12, 0x03, 0x75, 0x29, 0x2b, 0x0a,
+    0x0b, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x0f, 0x12, 0x03, 0x76, 0x02, 0x2c, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x02, 0x02, 0x0f, 0x04, 0x12, 0x03, 0x76, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02,
+    0x02, 0x0f, 0x06, 0x12, 0x03, 0x76, 0x0b, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x0f,
+    0x01, 0x12, 0x03, 0x76, 0x16, 0x1c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x0f, 0x03, 0x12,
+    0x03, 0x76, 0x29, 0x2b, 0x0a, 0x35, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x10, 0x12, 0x03, 0x77, 0x02,
+    0x2c, 0x22, 0x28, 0x20, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe7, 0x94, 0x9f, 0xe5, 0x91, 0xbd,
+    0xe5, 0x91, 0xa8, 0xe6, 0x9c, 0x9f, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x28, 0xe5, 0xaf, 0xb9,
+    0xe5, 0xa4, 0x96, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x29, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x02, 0x02, 0x10, 0x06, 0x12, 0x03, 0x77, 0x02, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02,
+    0x10, 0x01, 0x12, 0x03, 0x77, 0x16, 0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x10, 0x03,
+    0x12, 0x03, 0x77, 0x29, 0x2b, 0x0a, 0x41, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x11, 0x12, 0x03, 0x78,
+    0x02, 0x2c, 0x22, 0x34, 0x20, 0xe6, 0x8c, 0x87, 0xe5, 0xae, 0x9a, 0xe5, 0x8f, 0xaf, 0xe8, 0xa7,
+    0x81, 0xe7, 0x9a, 0x84, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x20, 0xe4, 0xbb, 0x85, 0x70, 0x72,
+    0x69, 0x76, 0x61, 0x63, 0x79, 0xe4, 0xb8, 0xba, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0xe6, 0x97,
+    0xb6, 0xe6, 0x9c, 0x89, 0xe6, 0x95, 0x88, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x11,
+    0x04, 0x12, 0x03, 0x78, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x11, 0x05, 0x12,
+    0x03, 0x78, 0x0b, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x11, 0x01, 0x12, 0x03, 0x78,
+    0x16, 0x1e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x11, 0x03, 0x12, 0x03, 0x78, 0x29, 0x2b,
+    0x0a, 0x18, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x12, 0x12, 0x03, 0x79, 0x02, 0x2c, 0x22, 0x0b, 0x20,
+    0xe6, 0xb5, 0x8f, 0xe8, 0xa7, 0x88, 0xe9, 0x87, 0x8f, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02,
+    0x02, 0x12, 0x05, 0x12, 0x03, 0x79, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x12,
+    0x01, 0x12, 0x03, 0x79, 0x16, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x12, 0x03, 0x12,
+    0x03, 0x79, 0x29, 0x2b, 0x0a, 0x24, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x13, 0x12, 0x03, 0x7a, 0x02,
+    0x2c, 0x22, 0x17, 0x20, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe6, 0x89, 0x80, 0xe5, 0xb1, 0x9e,
+    0xe7, 0x9a, 0x84, 0xe5, 0x90, 0x88, 0xe9, 0x9b, 0x86, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02,
+    0x02, 0x13, 0x06, 0x12, 0x03, 0x7a, 0x02, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x13,
+    0x01, 0x12, 0x03, 0x7a, 0x16, 0x20, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x13, 0x03, 0x12,
+    0x03, 0x7a, 0x29, 0x2b, 0x0a, 0x0b, 0x0a, 0x02, 0x04, 0x03, 0x12, 0x05, 0x7d, 0x00, 0x81, 0x01,
+    0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x03, 0x01, 0x12, 0x03, 0x7d, 0x08, 0x15, 0x0a, 0x0b, 0x0a,
+    0x04, 0x04, 0x03, 0x02, 0x00, 0x12, 0x03, 0x7e, 0x02, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03,
+    0x02, 0x00, 0x05, 0x12, 0x03, 0x7e, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00,
+    0x01, 0x12, 0x03, 0x7e, 0x09, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x03, 0x12,
+    0x03, 0x7e, 0x12, 0x13, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x03, 0x02, 0x01, 0x12, 0x03, 0x7f, 0x02,
+    0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01, 0x05, 0x12, 0x03, 0x7f, 0x02, 0x08, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01, 0x01, 0x12, 0x03, 0x7f, 0x09, 0x0f, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x03, 0x02, 0x01, 0x03, 0x12, 0x03, 0x7f, 0x12, 0x13, 0x0a, 0x0c, 0x0a, 0x04, 0x04,
+    0x03, 0x02, 0x02, 0x12, 0x04, 0x80, 0x01, 0x02, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x03, 0x02,
+    0x02, 0x05, 0x12, 0x04, 0x80, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x02,
+    0x01, 0x12, 0x04, 0x80, 0x01, 0x09, 0x0f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x02, 0x03,
+    0x12, 0x04, 0x80, 0x01, 0x12, 0x13, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x04, 0x12, 0x06, 0x83, 0x01,
+    0x00, 0x87, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x04, 0x01, 0x12, 0x04, 0x83, 0x01, 0x08,
+    0x11, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x00, 0x12, 0x04, 0x84, 0x01, 0x02, 0x19, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x05, 0x12, 0x04, 0x84, 0x01, 0x02, 0x08, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x01, 0x12, 0x04, 0x84, 0x01, 0x10, 0x13, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x04, 0x02, 0x00, 0x03, 0x12, 0x04, 0x84, 0x01, 0x17, 0x18, 0x0a, 0x0c, 0x0a, 0x04,
+    0x04, 0x04, 0x02, 0x01, 0x12, 0x04, 0x85, 0x01, 0x02, 0x19, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x04,
+    0x02, 0x01, 0x05, 0x12, 0x04, 0x85, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x04, 0x02,
+    0x01, 0x01, 0x12, 0x04, 0x85, 0x01, 0x10, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x01,
+    0x03, 0x12, 0x04, 0x85, 0x01, 0x17, 0x18, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x02, 0x12,
+    0x04, 0x86, 0x01, 0x02, 0x19, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x02, 0x06, 0x12, 0x04,
+    0x86, 0x01, 0x02, 0x0f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x02, 0x01, 0x12, 0x04, 0x86,
+    0x01, 0x10, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x02, 0x03, 0x12, 0x04, 0x86, 0x01,
+    0x17, 0x18, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x05, 0x12, 0x06, 0x89, 0x01, 0x00, 0x8d, 0x01, 0x01,
+    0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x05, 0x01, 0x12, 0x04, 0x89, 0x01, 0x08, 0x11, 0x0a, 0x0c, 0x0a,
+    0x04, 0x04, 0x05, 0x02, 0x00, 0x12, 0x04, 0x8a, 0x01, 0x02, 0x19, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x05, 0x02, 0x00, 0x05, 0x12, 0x04, 0x8a, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x05,
+    0x02, 0x00, 0x01, 0x12, 0x04, 0x8a, 0x01, 0x10, 0x13, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x05, 0x02,
+    0x00, 0x03, 0x12, 0x04, 0x8a, 0x01, 0x17, 0x18, 0x0a, 0x28, 0x0a, 0x04, 0x04, 0x05, 0x02, 0x01,
+    0x12, 0x04, 0x8b, 0x01, 0x02, 0x19, 0x22, 0x1a, 0x20, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7,
+    0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x20, 0xe6, 0x81, 0x92, 0xe4, 0xb8, 0xba, 0x56, 0x49, 0x44, 0x45,
+    0x4f, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x01, 0x05, 0x12, 0x04, 0x8b, 0x01, 0x02,
+    0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x01, 0x01, 0x12, 0x04, 0x8b, 0x01, 0x10, 0x14,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x01, 0x03, 0x12, 0x04, 0x8b, 0x01, 0x17, 0x18, 0x0a,
+    0x0c, 0x0a, 0x04, 0x04, 0x05, 0x02, 0x02, 0x12, 0x04, 0x8c, 0x01, 0x02, 0x19, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x05, 0x02, 0x02, 0x06, 0x12, 0x04, 0x8c, 0x01, 0x02, 0x0f, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x05, 0x02, 0x02, 0x01, 0x12, 0x04, 0x8c, 0x01, 0x10, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x05, 0x02, 0x02, 0x03, 0x12, 0x04, 0x8c, 0x01, 0x17, 0x18, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x06,
+    0x12, 0x06, 0x8f, 0x01, 0x00, 0x98, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x06, 0x01, 0x12,
+    0x04, 0x8f, 0x01, 0x08, 0x15, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x06, 0x02, 0x00, 0x12, 0x04, 0x90,
+    0x01, 0x02, 0x19, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x05, 0x12, 0x04, 0x90, 0x01,
+    0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x01, 0x12, 0x04, 0x90, 0x01, 0x09,
+    0x0e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x03, 0x12, 0x04, 0x90, 0x01, 0x17, 0x18,
+    0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x06, 0x02, 0x01, 0x12, 0x04, 0x91, 0x01, 0x02, 0x19, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x06, 0x02, 0x01, 0x05, 0x12, 0x04, 0x91, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x06, 0x02, 0x01, 0x01, 0x12, 0x04, 0x91, 0x01, 0x09, 0x0f, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x06, 0x02, 0x01, 0x03, 0x12, 0x04, 0x91, 0x01, 0x17, 0x18, 0x0a, 0x0c, 0x0a, 0x04, 0x04,
+    0x06, 0x02, 0x02, 0x12, 0x04, 0x92, 0x01, 0x02, 0x19, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x06, 0x02,
+    0x02, 0x05, 0x12, 0x04, 0x92, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x02,
+    0x01, 0x12, 0x04, 0x92, 0x01, 0x09, 0x0f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x02, 0x03,
+    0x12, 0x04, 0x92, 0x01, 0x17, 0x18, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x06, 0x02, 0x03, 0x12, 0x04,
+    0x93, 0x01, 0x02, 0x19, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x03, 0x05, 0x12, 0x04, 0x93,
+    0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x03, 0x01, 0x12, 0x04, 0x93, 0x01,
+    0x09, 0x11, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x03, 0x03, 0x12, 0x04, 0x93, 0x01, 0x17,
+    0x18, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x06, 0x02, 0x04, 0x12, 0x04, 0x94, 0x01, 0x02, 0x19, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x04, 0x05, 0x12, 0x04, 0x94, 0x01, 0x02, 0x07, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x06, 0x02, 0x04, 0x01, 0x12, 0x04, 0x94, 0x01, 0x09, 0x10, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x06, 0x02, 0x04, 0x03, 0x12, 0x04, 0x94, 0x01, 0x17, 0x18, 0x0a, 0x0c, 0x0a, 0x04,
+    0x04, 0x06, 0x02, 0x05, 0x12, 0x04, 0x95, 0x01, 0x02, 0x19, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x06,
+    0x02, 0x05, 0x05, 0x12, 0x04, 0x95, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x06, 0x02,
+    0x05, 0x01, 0x12, 0x04, 0x95, 0x01, 0x09, 0x0e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x05,
+    0x03, 0x12, 0x04, 0x95, 0x01, 0x17, 0x18, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x06, 0x02, 0x06, 0x12,
+    0x04, 0x96, 0x01, 0x02, 0x19, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x06, 0x05, 0x12, 0x04,
+    0x96, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x06, 0x01, 0x12, 0x04, 0x96,
+    0x01, 0x09, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x06, 0x03, 0x12, 0x04, 0x96, 0x01,
+    0x17, 0x18, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x06, 0x02, 0x07, 0x12, 0x04, 0x97, 0x01, 0x02, 0x19,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x07, 0x05, 0x12, 0x04, 0x97, 0x01, 0x02, 0x08, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x07, 0x01, 0x12, 0x04, 0x97, 0x01, 0x09, 0x14, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x06, 0x02, 0x07, 0x03, 0x12, 0x04, 0x97, 0x01, 0x17, 0x18, 0x0a, 0x67, 0x0a,
+    0x02, 0x04, 0x07, 0x12, 0x06, 0x9b, 0x01, 0x00, 0xa8, 0x01, 0x01, 0x1a, 0x59, 0x20, 0xe7, 0xac,
+    0x94, 0xe8, 0xae, 0xb0, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0xe7, 0x9a, 0x84, 0xe6, 0xa6, 0x82,
+    0xe8, 0xa7, 0x88, 0xef, 0xbc, 0x88, 0xe4, 0xb8, 0x80, 0xe8, 0x88, 0xac, 0xe7, 0x94, 0xa8, 0xe4,
+    0xba, 0x8e, 0xe9, 0xa6, 0x96, 0xe9, 0xa1, 0xb5, 0xef, 0xbc, 0x8c, 0xe5, 0x85, 0xb6, 0xe5, 0xae,
+    0x83, 0xe9, 0x9d, 0x9e, 0xe5, 0x88, 0x9b, 0xe4, 0xbd, 0x9c, 0xe8, 0x80, 0x85, 0xe8, 0x8e, 0xb7,
+    0xe5, 0x8f, 0x96, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe7, 0x9a, 0x84, 0xe5, 0x9c, 0xba, 0xe6,
+    0x99, 0xaf, 0xef, 0xbc, 0x89, 0x0a, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x07, 0x01, 0x12, 0x04, 0x9b,
+    0x01, 0x08, 0x14, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x07, 0x02, 0x00, 0x12, 0x04, 0x9c, 0x01, 0x02,
+    0x24, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x05, 0x12, 0x04, 0x9c, 0x01, 0x02, 0x07,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x01, 0x12, 0x04, 0x9c, 0x01, 0x15, 0x1c, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x03, 0x12, 0x04, 0x9c, 0x01, 0x22, 0x23, 0x0a, 0x0c,
+    0x0a, 0x04, 0x04, 0x07, 0x02, 0x01, 0x12, 0x04, 0x9d, 0x01, 0x02, 0x24, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x07, 0x02, 0x01, 0x05, 0x12, 0x04, 0x9d, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x07, 0x02, 0x01, 0x01, 0x12, 0x04, 0x9d, 0x01, 0x15, 0x1a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07,
+    0x02, 0x01, 0x03, 0x12, 0x04, 0x9d, 0x01, 0x22, 0x23, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x07, 0x02,
+    0x02, 0x12, 0x04, 0x9e, 0x01, 0x02, 0x24, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x02, 0x05,
+    0x12, 0x04, 0x9e, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x02, 0x01, 0x12,
+    0x04, 0x9e, 0x01, 0x15, 0x19, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x02, 0x03, 0x12, 0x04,
+    0x9e, 0x01, 0x22, 0x23, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x07, 0x02, 0x03, 0x12, 0x04, 0x9f, 0x01,
+    0x02, 0x24, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x03, 0x05, 0x12, 0x04, 0x9f, 0x01, 0x02,
+    0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x03, 0x01, 0x12, 0x04, 0x9f, 0x01, 0x15, 0x1f,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x03, 0x03, 0x12, 0x04, 0x9f, 0x01, 0x22, 0x23, 0x0a,
+    0x0c, 0x0a, 0x04, 0x04, 0x07, 0x02, 0x04, 0x12, 0x04, 0xa0, 0x01, 0x02, 0x24, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x07, 0x02, 0x04, 0x04, 0x12, 0x04, 0xa0, 0x01, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x07, 0x02, 0x04, 0x06, 0x12, 0x04, 0xa0, 0x01, 0x0b, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x07, 0x02, 0x04, 0x01, 0x12, 0x04, 0xa0, 0x01, 0x15, 0x1b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07,
+    0x02, 0x04, 0x03, 0x12, 0x04, 0xa0, 0x01, 0x22, 0x23, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x07, 0x02,
+    0x05, 0x12, 0x04, 0xa1, 0x01, 0x02, 0x24, 0x22, 0x0e, 0x20, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e,
+    0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x05, 0x05,
+    0x12, 0x04, 0xa1, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x05, 0x01, 0x12,
+    0x04, 0xa1, 0x01, 0x15, 0x1a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x05, 0x03, 0x12, 0x04,
+    0xa1, 0x01, 0x22, 0x23, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x07, 0x02, 0x06, 0x12, 0x04, 0xa2, 0x01,
+    0x02, 0x24, 0x22, 0x0e, 0x20, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe4, 0xbd, 0x9c, 0xe8, 0x80,
+    0x85, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x06, 0x05, 0x12, 0x04, 0xa2, 0x01, 0x02,
+    0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x06, 0x01, 0x12, 0x04, 0xa2, 0x01, 0x15, 0x1b,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x06, 0x03, 0x12, 0x04, 0xa2, 0x01, 0x22, 0x23, 0x0a,
+    0x0c, 0x0a, 0x04, 0x04, 0x07, 0x02, 0x07, 0x12, 0x04, 0xa3, 0x01, 0x02, 0x24, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x07, 0x02, 0x07, 0x05, 0x12, 0x04, 0xa3, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x07, 0x02, 0x07, 0x01, 0x12, 0x04, 0xa3, 0x01, 0x15, 0x1c, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x07, 0x02, 0x07, 0x03, 0x12, 0x04, 0xa3, 0x01, 0x22, 0x23, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x07,
+    0x02, 0x08, 0x12, 0x04, 0xa4, 0x01, 0x02, 0x24, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x08,
+    0x05, 0x12, 0x04, 0xa4, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x08, 0x01,
+    0x12, 0x04, 0xa4, 0x01, 0x15, 0x1f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x08, 0x03, 0x12,
+    0x04, 0xa4, 0x01, 0x22, 0x23, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x07, 0x02, 0x09, 0x12, 0x04, 0xa5,
+    0x01, 0x02, 0x25, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x09, 0x05, 0x12, 0x04, 0xa5, 0x01,
+    0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x09, 0x01, 0x12, 0x04, 0xa5, 0x01, 0x15,
+    0x17, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x09, 0x03, 0x12, 0x04, 0xa5, 0x01, 0x22, 0x24,
+    0x0a, 0x3b, 0x0a, 0x04, 0x04, 0x07, 0x02, 0x0a, 0x12, 0x04, 0xa6, 0x01, 0x02, 0x25, 0x22, 0x2d,
+    0x20, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x20, 0xe5, 0x9b,
+    0xbe, 0xe7, 0x89, 0x87, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe6, 0x88, 0x96, 0xe8, 0x80, 0x85,
+    0xe8, 0xa7, 0x86, 0xe9, 0xa2, 0x91, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x0a, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x07, 0x02, 0x0a, 0x06, 0x12, 0x04, 0xa6, 0x01, 0x02, 0x0f, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x07, 0x02, 0x0a, 0x01, 0x12, 0x04, 0xa6, 0x01, 0x15, 0x1e, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x07, 0x02, 0x0a, 0x03, 0x12, 0x04, 0xa6, 0x01, 0x22, 0x24, 0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x07,
+    0x02, 0x0b, 0x12, 0x04, 0xa7, 0x01, 0x02, 0x25, 0x22, 0x0e, 0x20, 0xe7, 0xac, 0x94, 0xe8, 0xae,
+    0xb0, 0xe8, 0xa7, 0x86, 0xe9, 0xa2, 0x91, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x0b,
+    0x04, 0x12, 0x04, 0xa7, 0x01, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x0b, 0x06,
+    0x12, 0x04, 0xa7, 0x01, 0x0b, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x0b, 0x01, 0x12,
+    0x04, 0xa7, 0x01, 0x15, 0x1b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x0b, 0x03, 0x12, 0x04,
+    0xa7, 0x01, 0x22, 0x24, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x08, 0x12, 0x06, 0xaa, 0x01, 0x00, 0xae,
+    0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x08, 0x01, 0x12, 0x04, 0xaa, 0x01, 0x08, 0x17, 0x0a,
+    0x0c, 0x0a, 0x04, 0x04, 0x08, 0x02, 0x00, 0x12, 0x04, 0xab, 0x01, 0x02, 0x25, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x08, 0x02, 0x00, 0x04, 0x12, 0x04, 0xab, 0x01, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x08, 0x02, 0x00, 0x06, 0x12, 0x04, 0xab, 0x01, 0x0b, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x08, 0x02, 0x00, 0x01, 0x12, 0x04, 0xab, 0x01, 0x16, 0x1a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x08,
+    0x02, 0x00, 0x03, 0x12, 0x04, 0xab, 0x01, 0x23, 0x24, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x08, 0x02,
+    0x01, 0x12, 0x04, 0xac, 0x01, 0x02, 0x25, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x01, 0x04,
+    0x12, 0x04, 0xac, 0x01, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x01, 0x06, 0x12,
+    0x04, 0xac, 0x01, 0x0b, 0x15, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x01, 0x01, 0x12, 0x04,
+    0xac, 0x01, 0x16, 0x1e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x01, 0x03, 0x12, 0x04, 0xac,
+    0x01, 0x23, 0x24, 0x0a, 0x25, 0x0a, 0x04, 0x04, 0x08, 0x02, 0x02, 0x12, 0x04, 0xad, 0x01, 0x02,
+    0x25, 0x22, 0x17, 0x20, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe6, 0x89, 0x80, 0xe5, 0xb1, 0x9e,
+    0xe7, 0x9a, 0x84, 0xe5, 0x90, 0x88, 0xe9, 0x9b, 0x86, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x08,
+    0x02, 0x02, 0x06, 0x12, 0x04, 0xad, 0x01, 0x02, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x08, 0x02,
+    0x02, 0x01, 0x12, 0x04, 0xad, 0x01, 0x16, 0x20, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x02,
+    0x03, 0x12, 0x04, 0xad, 0x01, 0x23, 0x24, 0x0a, 0x47, 0x0a, 0x02, 0x04, 0x09, 0x12, 0x06, 0xb1,
+    0x01, 0x00, 0xbc, 0x01, 0x01, 0x1a, 0x39, 0x20, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe5, 0x90,
+    0x88, 0xe9, 0x9b, 0x86, 0x20, 0xe7, 0x94, 0xb1, 0xe4, 0xbd, 0x9c, 0xe8, 0x80, 0x85, 0xe5, 0xb0,
+    0x86, 0xe8, 0x87, 0xaa, 0xe5, 0xb7, 0xb1, 0xe7, 0x9a, 0x84, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0,
+    0xe6, 0x8c, 0x89, 0xe9, 0xa1, 0xba, 0xe5, 0xba, 0x8f, 0xe7, 0xbb, 0x84, 0xe7, 0xbb, 0x87, 0x0a,
+    0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x09, 0x01, 0x12, 0x04, 0xb1, 0x01, 0x08, 0x16, 0x0a, 0x0c, 0x0a,
+    0x04, 0x04, 0x09, 0x02, 0x00, 0x12, 0x04, 0xb2, 0x01, 0x02, 0x23, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x09, 0x02, 0x00, 0x05, 0x12, 0x04, 0xb2, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x09,
+    0x02, 0x00, 0x01, 0x12, 0x04, 0xb2, 0x01, 0x11, 0x1e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x09, 0x02,
+    0x00, 0x03, 0x12, 0x04, 0xb2, 0x01, 0x21, 0x22, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x09, 0x02, 0x01,
+    0x12, 0x04, 0xb3, 0x01, 0x02, 0x23, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x01, 0x05, 0x12,
+    0x04, 0xb3, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x01, 0x01, 0x12, 0x04,
+    0xb3, 0x01, 0x11, 0x16, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x01, 0x03, 0x12, 0x04, 0xb3,
+    0x01, 0x21, 0x22, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x09, 0x02, 0x02, 0x12, 0x04, 0xb4, 0x01, 0x02,
+    0x23, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x02, 0x05, 0x12, 0x04, 0xb4, 0x01, 0x02, 0x08,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x02, 0x01, 0x12, 0x04, 0xb4, 0x01, 0x11, 0x16, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x02, 0x03, 0x12, 0x04, 0xb4, 0x01, 0x21, 0x22, 0x0a, 0x0c,
+    0x0a, 0x04, 0x04, 0x09, 0x02, 0x03, 0x12, 0x04, 0xb5, 0x01, 0x02, 0x23, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x09, 0x02, 0x03, 0x05, 0x12, 0x04, 0xb5, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x09, 0x02, 0x03, 0x01, 0x12, 0x04, 0xb5, 0x01, 0x11, 0x15, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x09,
+    0x02, 0x03, 0x03, 0x12, 0x04, 0xb5, 0x01, 0x21, 0x22, 0x0a, 0x29, 0x0a, 0x04, 0x04, 0x09, 0x02,
+    0x04, 0x12, 0x04, 0xb6, 0x01, 0x02, 0x23, 0x22, 0x1b, 0x20, 0xe4, 0xbb, 0x85, 0xe6, 0x94, 0xaf,
+    0xe6, 0x8c, 0x81, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x43, 0xe5, 0x92, 0x8c, 0x50, 0x52, 0x49, 0x56,
+    0x41, 0x54, 0x45, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x04, 0x05, 0x12, 0x04, 0xb6,
+    0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x04, 0x01, 0x12, 0x04, 0xb6, 0x01,
+    0x11, 0x18, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x04, 0x03, 0x12, 0x04, 0xb6, 0x01, 0x21,
+    0x22, 0x0a, 0x25, 0x0a, 0x04, 0x04, 0x09, 0x02, 0x05, 0x12, 0x04, 0xb7, 0x01, 0x02, 0x23, 0x22,
+    0x17, 0x20, 0xe4, 0xbd, 0x9c, 0xe4, 0xb8, 0xba, 0xe5, 0xb0, 0x81, 0xe9, 0x9d, 0xa2, 0xe7, 0x9a,
+    0x84, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x05,
+    0x05, 0x12, 0x04, 0xb7, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x05, 0x01,
+    0x12, 0x04, 0xb7, 0x01, 0x11, 0x1e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x05, 0x03, 0x12,
+    0x04, 0xb7, 0x01, 0x21, 0x22, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x09, 0x02, 0x06, 0x12, 0x04, 0xb8,
+    0x01, 0x02, 0x23, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x06, 0x05, 0x12, 0x04, 0xb8, 0x01,
+    0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x06, 0x01, 0x12, 0x04, 0xb8, 0x01, 0x11,
+    0x1b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x06, 0x03, 0x12, 0x04, 0xb8, 0x01, 0x21, 0x22,
+    0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x09, 0x02, 0x07, 0x12, 0x04, 0xb9, 0x01, 0x02, 0x23, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x09, 0x02, 0x07, 0x05, 0x12, 0x04, 0xb9, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x09, 0x02, 0x07, 0x01, 0x12, 0x04, 0xb9, 0x01, 0x11, 0x16, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x09, 0x02, 0x07, 0x03, 0x12, 0x04, 0xb9, 0x01, 0x21, 0x22, 0x0a, 0x0c, 0x0a, 0x04, 0x04,
+    0x09, 0x02, 0x08, 0x12, 0x04, 0xba, 0x01, 0x02, 0x23, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x09, 0x02,
+    0x08, 0x05, 0x12, 0x04, 0xba, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x08,
+    0x01, 0x12, 0x04, 0xba, 0x01, 0x11, 0x16, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x08, 0x03,
+    0x12, 0x04, 0xba, 0x01, 0x21, 0x22, 0x0a, 0x50, 0x0a, 0x04, 0x04, 0x09, 0x02, 0x09, 0x12, 0x04,
+    0xbb, 0x01, 0x02, 0x24, 0x22, 0x42, 0x20, 0xe5, 0x90, 0x88, 0xe9, 0x9b, 0x86, 0xe4, 0xb8, 0xad,
+    0xe6, 0x8c, 0x89, 0xe9, 0xa1, 0xba, 0xe5, 0xba, 0x8f, 0xe6, 0x8e, 0x92, 0xe5, 0x88, 0x97, 0xe7,
+    0x9a, 0x84, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x20, 0xe4, 0xbb, 0x85, 0xe8, 0x8e, 0xb7, 0xe5,
+    0x8f, 0x96, 0xe5, 0x90, 0x88, 0xe9, 0x9b, 0x86, 0xe8, 0xaf, 0xa6, 0xe6, 0x83, 0x85, 0xe6, 0x97,
+    0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x09,
+    0x04, 0x12, 0x04, 0xbb, 0x01, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x09, 0x05,
+    0x12, 0x04, 0xbb, 0x01, 0x0b, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x09, 0x01, 0x12,
+    0x04, 0xbb, 0x01, 0x11, 0x19, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x09, 0x03, 0x12, 0x04,
+    0xbb, 0x01, 0x21, 0x23, 0x0a, 0x2b, 0x0a, 0x02, 0x04, 0x0a, 0x12, 0x06, 0xbf, 0x01, 0x00, 0xc4,
+    0x01, 0x01, 0x1a, 0x1d, 0x20, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe5, 0x9c, 0xa8, 0xe5, 0x90,
+    0x88, 0xe9, 0x9b, 0x86, 0xe4, 0xb8, 0xad, 0xe7, 0x9a, 0x84, 0xe4, 0xbd, 0x8d, 0xe7, 0xbd, 0xae,
+    0x0a, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x0a, 0x01, 0x12, 0x04, 0xbf, 0x01, 0x08, 0x1a, 0x0a, 0x0c,
+    0x0a, 0x04, 0x04, 0x0a, 0x02, 0x00, 0x12, 0x04, 0xc0, 0x01, 0x02, 0x1b, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x0a, 0x02, 0x00, 0x05, 0x12, 0x04, 0xc0, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x0a, 0x02, 0x00, 0x01, 0x12, 0x04, 0xc0, 0x01, 0x09, 0x16, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0a,
+    0x02, 0x00, 0x03, 0x12, 0x04, 0xc0, 0x01, 0x19, 0x1a, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x0a, 0x02,
+    0x01, 0x12, 0x04, 0xc1, 0x01, 0x02, 0x1b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x01, 0x05,
+    0x12, 0x04, 0xc1, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x01, 0x01, 0x12,
+    0x04, 0xc1, 0x01, 0x09, 0x0e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x01, 0x03, 0x12, 0x04,
+    0xc1, 0x01, 0x19, 0x1a, 0x0a, 0x33, 0x0a, 0x04, 0x04, 0x0a, 0x02, 0x02, 0x12, 0x04, 0xc2, 0x01,
+    0x02, 0x1b, 0x22, 0x25, 0x20, 0xe4, 0xb8, 0x8a, 0xe4, 0xb8, 0x80, 0xe7, 0xaf, 0x87, 0xe7, 0xac,
+    0x94, 0xe8, 0xae, 0xb0, 0x20, 0xe4, 0xb8, 0xba, 0x30, 0xe6, 0x97, 0xb6, 0xe8, 0xa1, 0xa8, 0xe7,
+    0xa4, 0xba, 0xe6, 0xb2, 0xa1, 0xe6, 0x9c, 0x89, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0a, 0x02,
+    0x02, 0x05, 0x12, 0x04, 0xc2, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x02,
+    0x01, 0x12, 0x04, 0xc2, 0x01, 0x09, 0x15, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x02, 0x03,
+    0x12, 0x04, 0xc2, 0x01, 0x19, 0x1a, 0x0a, 0x33, 0x0a, 0x04, 0x04, 0x0a, 0x02, 0x03, 0x12, 0x04,
+    0xc3, 0x01, 0x02, 0x1b, 0x22, 0x25, 0x20, 0xe4, 0xb8, 0x8b, 0xe4, 0xb8, 0x80, 0xe7, 0xaf, 0x87,
+    0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x20, 0xe4, 0xb8, 0xba, 0x30, 0xe6, 0x97, 0xb6, 0xe8, 0xa1,
+    0xa8, 0xe7, 0xa4, 0xba, 0xe6, 0xb2, 0xa1, 0xe6, 0x9c, 0x89, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x0a, 0x02, 0x03, 0x05, 0x12, 0x04, 0xc3, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0a,
+    0x02, 0x03, 0x01, 0x12, 0x04, 0xc3, 0x01, 0x09, 0x15, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x0a, 0x02,
+    0x03, 0x03, 0x12, 0x04, 0xc3, 0x01, 0x19, 0x1a, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+    0x0a, 0xc1, 0xa2, 0x01, 0x0a, 0x1d, 0x6e, 0x6f, 0x74, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+    0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72,
+    0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+    0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76,
+    0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x6e,
+    0x6f, 0x74, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x2e,
+    0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4a, 0x0a, 0x14, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72, 0x4f,
+    0x77, 0x6e, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
+    0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12,
+    0x20, 0x0a, 0x07, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+    0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x65, 0x49,
+    0x64, 0x22, 0x41, 0x0a, 0x15, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x4e, 0x6f,
+    0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69,
+    0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
+    0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65,
+    0x73, 0x75, 0x6c, 0x74, 0x22, 0x36, 0x0a, 0x12, 0x49, 0x73, 0x4e, 0x6f, 0x74, 0x65, 0x45, 0x78,
+    0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x6e, 0x6f,
+    0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04,
+    0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x22, 0x2b, 0x0a, 0x13,
+    0x49, 0x73, 0x4e, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+    0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x78, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
+    0x28, 0x08, 0x52, 0x05, 0x65, 0x78, 0x69, 0x73, 0x74, 0x22, 0xb5, 0x01, 0x0a, 0x0e, 0x43, 0x72,
+    0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x42, 0x61, 0x73, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05,
+    0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+    0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+    0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63,
+    0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79,
+    0x12, 0x43, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04,
+    0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+    0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65,
+    0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65,
+    0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63,
+    0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x52, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63,
+    0x65, 0x22, 0x93, 0x01, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x49,
+    0x6d, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18,
+    0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06,
+    0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18,
+    0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xba, 0x48, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x52, 0x05,
+    0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+    0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xba, 0x48, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x52, 0x06,
+    0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+    0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+    0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x73, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74,
+    0x65, 0x52, 0x65, 0x71, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x69, 0x6c,
+    0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65,
+    0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x66, 0x69, 0x6c,
+    0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67,
+    0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x6f, 0x76, 0x65,
+    0x72, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+    0x0b, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x29, 0x0a, 0x0c,
+    0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x54, 0x61, 0x67, 0x12, 0x19, 0x0a, 0x08,
+    0x74, 0x61, 0x67, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07,
+    0x74, 0x61, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xcf, 0x02, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61,
+    0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a,
+    0x05, 0x62, 0x61, 0x73, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e,
     0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-    0x65, 0x52, 0x65, 0x71, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f,
-    0x22, 0x2d, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65,
+    0x65, 0x52, 0x65, 0x71, 0x42, 0x61, 0x73, 0x69, 0x63, 0x52, 0x05, 0x62, 0x61, 0x73, 0x69, 0x63,
+    0x12, 0x33, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+    0x32, 0x1b, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+    0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x69,
+    0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20,
+    0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+    0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x54, 0x61, 0x67, 0x52, 0x04,
+    0x74, 0x61, 0x67, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x61, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73,
+    0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70,
+    0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x41, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
+    0x07, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x69, 0x64, 0x65,
+    0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x56,
+    0x69, 0x64, 0x65, 0x6f, 0x52, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x64,
+    0x72, 0x61, 0x66, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x72, 0x61, 0x66,
+    0x74, 0x12, 0x26, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x61, 0x74, 0x18,
+    0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x09,
+    0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x74, 0x22, 0x2d, 0x0a, 0x12, 0x43, 0x72, 0x65,
+    0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+    0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+    0x52, 0x06, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x22, 0x35, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65,
+    0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
+    0x07, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07,
+    0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x22,
+    0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73,
+    0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e,
+    0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x6e, 0x6f,
+    0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04,
+    0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x04,
+    0x6e, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x6f, 0x74,
+    0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e,
+    0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65,
+    0x22, 0x2d, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65,
     0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69,
     0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x22,
-    0x35, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71,
-    0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18,
-    0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06,
-    0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-    0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69, 0x0a, 0x11,
-    0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+    0x32, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
     0x74, 0x12, 0x20, 0x0a, 0x07, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
     0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x6e, 0x6f, 0x74,
-    0x65, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-    0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-    0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-    0x74, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x2d, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74,
-    0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a,
-    0x07, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-    0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x22, 0x32, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74,
-    0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x6e, 0x6f, 0x74, 0x65,
-    0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02,
-    0x20, 0x00, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x22, 0x3c, 0x0a, 0x0f, 0x47, 0x65,
-    0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a,
-    0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6e, 0x6f,
-    0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x74,
-    0x65, 0x6d, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x3f, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74,
-    0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63,
-    0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72,
-    0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-    0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x7b, 0x0a, 0x10, 0x4c, 0x69, 0x73,
-    0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a,
-    0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6e,
-    0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x49,
+    0x65, 0x49, 0x64, 0x22, 0x3c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65,
+    0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x01,
+    0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+    0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x6e, 0x6f, 0x74,
+    0x65, 0x22, 0x3f, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71,
+    0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01,
+    0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
+    0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75,
+    0x6e, 0x74, 0x22, 0x7b, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65,
+    0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+    0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74,
+    0x65, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73,
+    0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75,
+    0x72, 0x73, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74,
+    0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x22,
+    0x29, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e,
+    0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18,
+    0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x2e, 0x0a, 0x16, 0x47, 0x65,
+    0x74, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+    0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+    0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8a, 0x01, 0x0a, 0x13, 0x50,
+    0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+    0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+    0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+    0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x49, 0x0a, 0x10,
+    0x6c, 0x69, 0x66, 0x65, 0x5f, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
+    0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70,
+    0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x43, 0x79, 0x63,
+    0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0e, 0x6c, 0x69, 0x66, 0x65, 0x43, 0x79, 0x63,
+    0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x59, 0x0a, 0x14, 0x50, 0x61, 0x67, 0x65, 0x4c,
+    0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+    0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+    0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02,
+    0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+    0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65,
+    0x6d, 0x73, 0x22, 0x41, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73,
+    0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+    0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12,
+    0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+    0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x7d, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x61,
+    0x66, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x69,
+    0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6e, 0x6f, 0x74,
+    0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x74, 0x65,
+    0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74,
+    0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e,
+    0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73,
+    0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73,
+    0x4e, 0x65, 0x78, 0x74, 0x22, 0x5f, 0x0a, 0x13, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x44,
+    0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x6e,
+    0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48,
+    0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a,
+    0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+    0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c,
+    0x69, 0x73, 0x68, 0x41, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+    0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xad, 0x02,
+    0x0a, 0x13, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+    0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01,
+    0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64,
+    0x65, 0x73, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x12,
+    0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+    0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x12, 0x33, 0x0a, 0x06, 0x69, 0x6d, 0x61,
+    0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x6f, 0x74, 0x65,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+    0x71, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x31,
+    0x0a, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+    0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+    0x74, 0x65, 0x52, 0x65, 0x71, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x05, 0x76, 0x69, 0x64, 0x65,
+    0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18,
+    0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x73,
+    0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+    0x03, 0x52, 0x06, 0x74, 0x61, 0x67, 0x49, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x61, 0x74, 0x5f,
+    0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x6f,
+    0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x41, 0x74,
+    0x55, 0x73, 0x65, 0x72, 0x52, 0x07, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x22, 0xa7, 0x02,
+    0x0a, 0x10, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x69,
+    0x66, 0x66, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e,
+    0x67, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x74, 0x69, 0x74, 0x6c, 0x65,
+    0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x63, 0x5f,
+    0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64,
+    0x65, 0x73, 0x63, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72,
+    0x69, 0x76, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x03, 0x20,
+    0x01, 0x28, 0x08, 0x52, 0x0e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x43, 0x68, 0x61, 0x6e,
+    0x67, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x73, 0x73,
+    0x65, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61,
+    0x64, 0x64, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x2c, 0x0a,
+    0x12, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6b,
+    0x65, 0x79, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+    0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x61,
+    0x64, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03,
+    0x28, 0x03, 0x52, 0x0b, 0x61, 0x64, 0x64, 0x65, 0x64, 0x54, 0x61, 0x67, 0x49, 0x64, 0x73, 0x12,
+    0x26, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x67, 0x5f, 0x69,
+    0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+    0x64, 0x54, 0x61, 0x67, 0x49, 0x64, 0x73, 0x22, 0xf4, 0x01, 0x0a, 0x0c, 0x4e, 0x6f, 0x74, 0x65,
+    0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x74, 0x65,
+    0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x65, 0x49,
+    0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+    0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x07, 0x63,
+    0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e,
+    0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x52,
+    0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x07,
+    0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18,
+    0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+    0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x64,
+    0x69, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+    0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x65, 0x64, 0x69, 0x74, 0x41, 0x66, 0x74, 0x65,
+    0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d,
+    0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x6a,
+    0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+    0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x6e, 0x6f,
+    0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04,
+    0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+    0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75,
+    0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+    0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x88, 0x01, 0x0a, 0x19, 0x4c,
+    0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+    0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
+    0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+    0x6f, 0x6e, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78,
+    0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+    0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61,
+    0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61,
+    0x73, 0x4e, 0x65, 0x78, 0x74, 0x22, 0x5d, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65,
+    0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+    0x20, 0x0a, 0x07, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+    0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x65, 0x49,
+    0x64, 0x12, 0x21, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+    0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x07, 0x76, 0x65, 0x72,
+    0x73, 0x69, 0x6f, 0x6e, 0x22, 0x50, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52,
+    0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+    0x35, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+    0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+    0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65,
+    0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x61, 0x0a, 0x1a, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+    0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+    0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18,
+    0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06,
+    0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+    0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00,
+    0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x1d, 0x0a, 0x1b, 0x52, 0x65, 0x73,
+    0x74, 0x6f, 0x72, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+    0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74,
+    0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+    0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20,
+    0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63,
+    0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+    0x74, 0x22, 0x76, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65,
+    0x49, 0x74, 0x65, 0x6d, 0x12, 0x29, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+    0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+    0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12,
+    0x1b, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01,
+    0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+    0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+    0x08, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x22, 0x8a, 0x01, 0x0a, 0x18, 0x4c, 0x69,
+    0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65,
+    0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+    0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x49,
     0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65,
     0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
     0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x68,
     0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68,
-    0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x22, 0x29, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73,
-    0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-    0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69,
-    0x64, 0x22, 0x2e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x43, 0x6f,
-    0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63,
-    0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
-    0x74, 0x22, 0x8a, 0x01, 0x0a, 0x13, 0x50, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f,
-    0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
-    0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a,
-    0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f,
-    0x75, 0x6e, 0x74, 0x12, 0x49, 0x0a, 0x10, 0x6c, 0x69, 0x66, 0x65, 0x5f, 0x63, 0x79, 0x63, 0x6c,
-    0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e,
-    0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65,
-    0x4c, 0x69, 0x66, 0x65, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0e,
-    0x6c, 0x69, 0x66, 0x65, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x59,
-    0x0a, 0x14, 0x50, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65,
-    0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
-    0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x05,
-    0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6e, 0x6f,
-    0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x74,
-    0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x23, 0x0a, 0x0d, 0x41, 0x64, 0x64,
-    0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-    0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x20,
-    0x0a, 0x0e, 0x41, 0x64, 0x64, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-    0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
-    0x32, 0xad, 0x06, 0x0a, 0x12, 0x4e, 0x6f, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
-    0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x56, 0x0a, 0x0d, 0x49, 0x73, 0x55, 0x73, 0x65,
-    0x72, 0x4f, 0x77, 0x6e, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e,
-    0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x77, 0x6e,
-    0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6e, 0x6f,
-    0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72,
-    0x4f, 0x77, 0x6e, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-    0x50, 0x0a, 0x0b, 0x49, 0x73, 0x4e, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x12, 0x1f,
-    0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x4e,
-    0x6f, 0x74, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-    0x20, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73,
-    0x4e, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-    0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x12,
-    0x1e, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
-    0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-    0x1f, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
-    0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-    0x12, 0x4d, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x1e,
-    0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
-    0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
-    0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
-    0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-    0x4d, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x1e, 0x2e,
-    0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-    0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
-    0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-    0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44,
-    0x0a, 0x07, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x6e, 0x6f, 0x74, 0x65,
+    0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x22, 0x36, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+    0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07,
+    0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba,
+    0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x22, 0x15,
+    0x0a, 0x13, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73,
+    0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x72, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+    0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+    0x12, 0x1f, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+    0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x28, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+    0x65, 0x12, 0x1c, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+    0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0xf4, 0x03, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x12,
+    0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+    0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x22, 0x3f, 0x0a, 0x18, 0x43, 0x72, 0x65,
+    0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+    0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+    0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f,
+    0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xc4, 0x01, 0x0a, 0x17, 0x55,
+    0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+    0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+    0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba,
+    0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+    0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20,
+    0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x28, 0x52, 0x05,
+    0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x03, 0x20,
+    0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0xf4, 0x03, 0x52, 0x04, 0x64,
+    0x65, 0x73, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x18, 0x04,
+    0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x12, 0x22, 0x0a,
+    0x0d, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05,
+    0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x65, 0x49,
+    0x64, 0x22, 0x1a, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+    0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x0a,
+    0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+    0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c,
+    0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
+    0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+    0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+    0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+    0x73, 0x65, 0x22, 0x44, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+    0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x0d, 0x63, 0x6f,
+    0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+    0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c,
+    0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x54, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43,
+    0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+    0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+    0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+    0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x18,
+    0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+    0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74,
+    0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+    0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
+    0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+    0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+    0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x70, 0x0a, 0x19, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c,
+    0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+    0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+    0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22,
+    0x02, 0x20, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+    0x64, 0x12, 0x25, 0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20,
+    0x03, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x92, 0x01, 0x04, 0x08, 0x01, 0x10, 0x32, 0x52,
+    0x07, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x73, 0x22, 0x1c, 0x0a, 0x1a, 0x41, 0x64, 0x64, 0x43,
+    0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65,
+    0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x73, 0x0a, 0x1c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+    0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52,
+    0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+    0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba,
+    0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+    0x6f, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x73,
+    0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x92, 0x01, 0x04, 0x08, 0x01,
+    0x10, 0x32, 0x52, 0x07, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x73, 0x22, 0x1f, 0x0a, 0x1d, 0x52,
+    0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e,
+    0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x65, 0x0a, 0x1a,
+    0x53, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f,
+    0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x0d, 0x63, 0x6f,
+    0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+    0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c,
+    0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x65,
+    0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x6e, 0x6f, 0x74, 0x65,
+    0x49, 0x64, 0x73, 0x22, 0x1d, 0x0a, 0x1b, 0x53, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+    0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+    0x73, 0x65, 0x22, 0x23, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75,
+    0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+    0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x20, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x54, 0x61,
+    0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+    0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x32, 0xca, 0x11, 0x0a, 0x12, 0x4e, 0x6f,
+    0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+    0x12, 0x56, 0x0a, 0x0d, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x4e, 0x6f, 0x74,
+    0x65, 0x12, 0x21, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+    0x49, 0x73, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71,
+    0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+    0x76, 0x31, 0x2e, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x4e, 0x6f, 0x74, 0x65,
+    0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x49, 0x73, 0x4e, 0x6f,
+    0x74, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x4e, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x69, 0x73,
+    0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e,
+    0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x4e, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x69,
+    0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x43, 0x72,
+    0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e,
+    0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74,
+    0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e,
+    0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74,
+    0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x55, 0x70, 0x64,
+    0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65,
+    0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65,
+    0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65,
+    0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70,
+    0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52,
+    0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70,
+    0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52,
+    0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4e, 0x6f,
+    0x74, 0x65, 0x12, 0x1b, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+    0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+    0x1c, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+    0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a,
+    0x08, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x6e, 0x6f, 0x74, 0x65,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x65,
+    0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65,
+    0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0c, 0x50, 0x61, 0x67, 0x65, 0x4c, 0x69,
+    0x73, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70,
+    0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74,
+    0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e,
+    0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4e,
+    0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0e, 0x47,
+    0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x2e,
+    0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50,
+    0x6f, 0x73, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+    0x74, 0x1a, 0x23, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+    0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+    0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x41, 0x64, 0x64, 0x54, 0x61, 0x67,
+    0x12, 0x1a, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+    0x64, 0x64, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6e,
+    0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x61,
+    0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x4c, 0x69, 0x73,
+    0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73,
+    0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73,
+    0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0c, 0x50, 0x75, 0x62, 0x6c,
+    0x69, 0x73, 0x68, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x20, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e,
+    0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x44, 0x72,
+    0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6e, 0x6f, 0x74,
+    0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+    0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a,
+    0x11, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+    0x6e, 0x73, 0x12, 0x25, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+    0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+    0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6e, 0x6f, 0x74, 0x65,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x65,
+    0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+    0x65, 0x12, 0x5c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69,
+    0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+    0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+    0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6e, 0x6f, 0x74, 0x65,
     0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52,
-    0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70,
-    0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-    0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x65,
-    0x12, 0x1c, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-    0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
-    0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
-    0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a,
-    0x0c, 0x50, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x20, 0x2e,
-    0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
-    0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-    0x21, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
-    0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-    0x73, 0x65, 0x12, 0x59, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x43,
-    0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-    0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e,
-    0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e,
-    0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x64,
-    0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a,
-    0x06, 0x41, 0x64, 0x64, 0x54, 0x61, 0x67, 0x12, 0x1a, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61,
-    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75,
-    0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-    0x31, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-    0x42, 0xa9, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70,
-    0x69, 0x2e, 0x76, 0x31, 0x42, 0x10, 0x4e, 0x6f, 0x74, 0x65, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
-    0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-    0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x79, 0x61, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x62, 0x6f, 0x6f,
-    0x6b, 0x73, 0x2f, 0x77, 0x68, 0x69, 0x6d, 0x65, 0x72, 0x2f, 0x69, 0x64, 0x6c, 0x2f, 0x67, 0x65,
-    0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-    0xa2, 0x02, 0x03, 0x4e, 0x41, 0x58, 0xaa, 0x02, 0x0b, 0x4e, 0x6f, 0x74, 0x65, 0x2e, 0x41, 0x70,
-    0x69, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0b, 0x4e, 0x6f, 0x74, 0x65, 0x5c, 0x41, 0x70, 0x69, 0x5c,
-    0x56, 0x31, 0xe2, 0x02, 0x17, 0x4e, 0x6f, 0x74, 0x65, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31,
-    0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x4e,
-    0x6f, 0x74, 0x65, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a, 0x3a, 0x56, 0x31, 0x4a, 0x82, 0x24, 0x0a,
-    0x07, 0x12, 0x05, 0x00, 0x00, 0xa2, 0x01, 0x01, 0x0a, 0x08, 0x0a, 0x01, 0x0c, 0x12, 0x03, 0x00,
-    0x00, 0x12, 0x0a, 0x08, 0x0a, 0x01, 0x02, 0x12, 0x03, 0x02, 0x00, 0x14, 0x0a, 0x09, 0x0a, 0x02,
-    0x03, 0x00, 0x12, 0x03, 0x04, 0x00, 0x25, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x01, 0x12, 0x03, 0x05,
-    0x00, 0x20, 0x0a, 0x08, 0x0a, 0x01, 0x08, 0x12, 0x03, 0x07, 0x00, 0x4d, 0x0a, 0x09, 0x0a, 0x02,
-    0x08, 0x0b, 0x12, 0x03, 0x07, 0x00, 0x4d, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x00, 0x12, 0x04, 0x09,
-    0x00, 0x0c, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x00, 0x01, 0x12, 0x03, 0x09, 0x08, 0x1c, 0x0a,
-    0x17, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x00, 0x12, 0x03, 0x0a, 0x02, 0x14, 0x22, 0x0a, 0x20, 0xe7,
-    0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00,
-    0x05, 0x12, 0x03, 0x0a, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x01, 0x12,
-    0x03, 0x0a, 0x08, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x0a,
-    0x12, 0x13, 0x0a, 0x17, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x01, 0x12, 0x03, 0x0b, 0x02, 0x38, 0x22,
-    0x0a, 0x20, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x00, 0x02, 0x01, 0x05, 0x12, 0x03, 0x0b, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02,
-    0x01, 0x01, 0x12, 0x03, 0x0b, 0x08, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x03,
-    0x12, 0x03, 0x0b, 0x12, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x08, 0x12, 0x03,
-    0x0b, 0x14, 0x37, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x00, 0x02, 0x01, 0x08, 0x87, 0x09, 0x04, 0x04,
-    0x12, 0x03, 0x0b, 0x15, 0x36, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x01, 0x12, 0x04, 0x0e, 0x00, 0x11,
-    0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x01, 0x01, 0x12, 0x03, 0x0e, 0x08, 0x1d, 0x0a, 0x0b, 0x0a,
-    0x04, 0x04, 0x01, 0x02, 0x00, 0x12, 0x03, 0x0f, 0x02, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01,
-    0x02, 0x00, 0x05, 0x12, 0x03, 0x0f, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00,
-    0x01, 0x12, 0x03, 0x0f, 0x08, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x03, 0x12,
-    0x03, 0x0f, 0x11, 0x12, 0x0a, 0x15, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x01, 0x12, 0x03, 0x10, 0x02,
-    0x13, 0x22, 0x08, 0x20, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x01, 0x02, 0x01, 0x05, 0x12, 0x03, 0x10, 0x02, 0x06, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02,
-    0x01, 0x01, 0x12, 0x03, 0x10, 0x08, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x03,
-    0x12, 0x03, 0x10, 0x11, 0x12, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x02, 0x12, 0x04, 0x13, 0x00, 0x15,
-    0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x02, 0x01, 0x12, 0x03, 0x13, 0x08, 0x1a, 0x0a, 0x0b, 0x0a,
-    0x04, 0x04, 0x02, 0x02, 0x00, 0x12, 0x03, 0x14, 0x02, 0x38, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02,
-    0x02, 0x00, 0x05, 0x12, 0x03, 0x14, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00,
-    0x01, 0x12, 0x03, 0x14, 0x08, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x03, 0x12,
-    0x03, 0x14, 0x12, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x08, 0x12, 0x03, 0x14,
-    0x14, 0x37, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x02, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12,
-    0x03, 0x14, 0x15, 0x36, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x03, 0x12, 0x04, 0x17, 0x00, 0x19, 0x01,
-    0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x03, 0x01, 0x12, 0x03, 0x17, 0x08, 0x1b, 0x0a, 0x0b, 0x0a, 0x04,
-    0x04, 0x03, 0x02, 0x00, 0x12, 0x03, 0x18, 0x02, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02,
-    0x00, 0x05, 0x12, 0x03, 0x18, 0x02, 0x06, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x01,
-    0x12, 0x03, 0x18, 0x07, 0x0c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x03, 0x12, 0x03,
-    0x18, 0x0f, 0x10, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x04, 0x12, 0x04, 0x1b, 0x00, 0x21, 0x01, 0x0a,
-    0x0a, 0x0a, 0x03, 0x04, 0x04, 0x01, 0x12, 0x03, 0x1b, 0x08, 0x16, 0x0a, 0x0b, 0x0a, 0x04, 0x04,
-    0x04, 0x02, 0x00, 0x12, 0x03, 0x1c, 0x02, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00,
-    0x05, 0x12, 0x03, 0x1c, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x01, 0x12,
-    0x03, 0x1c, 0x09, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x03, 0x12, 0x03, 0x1c,
-    0x13, 0x14, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x01, 0x12, 0x03, 0x1d, 0x02, 0x15, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x01, 0x05, 0x12, 0x03, 0x1d, 0x02, 0x08, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x04, 0x02, 0x01, 0x01, 0x12, 0x03, 0x1d, 0x09, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x04, 0x02, 0x01, 0x03, 0x12, 0x03, 0x1d, 0x13, 0x14, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x04, 0x02,
-    0x02, 0x12, 0x03, 0x1e, 0x02, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x02, 0x05, 0x12,
-    0x03, 0x1e, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x02, 0x01, 0x12, 0x03, 0x1e,
-    0x09, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x02, 0x03, 0x12, 0x03, 0x1e, 0x13, 0x14,
-    0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x03, 0x12, 0x03, 0x20, 0x02, 0x5b, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x04, 0x02, 0x03, 0x06, 0x12, 0x03, 0x20, 0x02, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x04, 0x02, 0x03, 0x01, 0x12, 0x03, 0x20, 0x1c, 0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02,
-    0x03, 0x03, 0x12, 0x03, 0x20, 0x29, 0x2a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x03, 0x08,
-    0x12, 0x03, 0x20, 0x2b, 0x5a, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x04, 0x02, 0x03, 0x08, 0x87, 0x09,
-    0x10, 0x02, 0x12, 0x03, 0x20, 0x2c, 0x59, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x05, 0x12, 0x04, 0x23,
-    0x00, 0x28, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x05, 0x01, 0x12, 0x03, 0x23, 0x08, 0x16, 0x0a,
-    0x32, 0x0a, 0x04, 0x04, 0x05, 0x02, 0x00, 0x12, 0x03, 0x24, 0x02, 0x3f, 0x22, 0x25, 0x20, 0xe5,
-    0xae, 0x8c, 0xe6, 0x95, 0xb4, 0xe7, 0x9a, 0x84, 0xe5, 0x8c, 0x85, 0xe5, 0x90, 0xab, 0x62, 0x75,
-    0x63, 0x6b, 0x65, 0x74, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0xe7, 0x9a, 0x84, 0x66, 0x69, 0x6c, 0x65,
-    0x49, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x00, 0x05, 0x12, 0x03, 0x24, 0x02,
-    0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x00, 0x01, 0x12, 0x03, 0x24, 0x09, 0x10, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x00, 0x03, 0x12, 0x03, 0x24, 0x13, 0x14, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x05, 0x02, 0x00, 0x08, 0x12, 0x03, 0x24, 0x15, 0x3e, 0x0a, 0x10, 0x0a, 0x09, 0x04,
-    0x05, 0x02, 0x00, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x03, 0x24, 0x16, 0x3d, 0x0a, 0x0b, 0x0a,
-    0x04, 0x04, 0x05, 0x02, 0x01, 0x12, 0x03, 0x25, 0x02, 0x3a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05,
-    0x02, 0x01, 0x05, 0x12, 0x03, 0x25, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x01,
-    0x01, 0x12, 0x03, 0x25, 0x09, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x01, 0x03, 0x12,
-    0x03, 0x25, 0x13, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x01, 0x08, 0x12, 0x03, 0x25,
-    0x15, 0x39, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x05, 0x02, 0x01, 0x08, 0x87, 0x09, 0x05, 0x04, 0x12,
-    0x03, 0x25, 0x16, 0x38, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x05, 0x02, 0x02, 0x12, 0x03, 0x26, 0x02,
-    0x3a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x02, 0x05, 0x12, 0x03, 0x26, 0x02, 0x08, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x02, 0x01, 0x12, 0x03, 0x26, 0x09, 0x0f, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x05, 0x02, 0x02, 0x03, 0x12, 0x03, 0x26, 0x13, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x05, 0x02, 0x02, 0x08, 0x12, 0x03, 0x26, 0x15, 0x39, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x05, 0x02,
-    0x02, 0x08, 0x87, 0x09, 0x05, 0x04, 0x12, 0x03, 0x26, 0x16, 0x38, 0x0a, 0x0b, 0x0a, 0x04, 0x04,
-    0x05, 0x02, 0x03, 0x12, 0x03, 0x27, 0x02, 0x3f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x03,
-    0x05, 0x12, 0x03, 0x27, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x03, 0x01, 0x12,
-    0x03, 0x27, 0x09, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x03, 0x03, 0x12, 0x03, 0x27,
-    0x13, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x03, 0x08, 0x12, 0x03, 0x27, 0x15, 0x3e,
-    0x0a, 0x10, 0x0a, 0x09, 0x04, 0x05, 0x02, 0x03, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x03, 0x27,
-    0x16, 0x3d, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x06, 0x12, 0x04, 0x2a, 0x00, 0x33, 0x01, 0x0a, 0x0a,
-    0x0a, 0x03, 0x04, 0x06, 0x01, 0x12, 0x03, 0x2a, 0x08, 0x16, 0x0a, 0x46, 0x0a, 0x04, 0x04, 0x06,
-    0x02, 0x00, 0x12, 0x03, 0x2c, 0x02, 0x15, 0x1a, 0x39, 0x20, 0xe5, 0x8e, 0x9f, 0xe5, 0xa7, 0x8b,
-    0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0xe6, 0x89, 0x80, 0xe5, 0xa4, 0x84, 0xe4, 0xbd, 0x8d, 0xe7,
-    0xbd, 0xae, 0x20, 0xe5, 0xae, 0x8c, 0xe6, 0x95, 0xb4, 0xe7, 0x9a, 0x84, 0xe5, 0x8c, 0x85, 0xe5,
-    0x90, 0xab, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0xe7, 0x9a, 0x84, 0x66, 0x69, 0x6c, 0x65, 0x49,
-    0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x05, 0x12, 0x03, 0x2c, 0x02, 0x08,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x01, 0x12, 0x03, 0x2c, 0x09, 0x10, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x03, 0x12, 0x03, 0x2c, 0x13, 0x14, 0x0a, 0x34, 0x0a, 0x04,
-    0x04, 0x06, 0x02, 0x01, 0x12, 0x03, 0x2f, 0x02, 0x1c, 0x1a, 0x27, 0x20, 0xe7, 0x9b, 0xae, 0xe6,
-    0xa0, 0x87, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0xe6, 0x89, 0x80, 0xe5, 0xa4, 0x84, 0xe4, 0xbd,
-    0x8d, 0xe7, 0xbd, 0xae, 0x20, 0xe5, 0x8c, 0x85, 0xe5, 0x90, 0xab, 0x62, 0x75, 0x63, 0x6b, 0x65,
-    0x74, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x01, 0x05, 0x12, 0x03, 0x2f, 0x02, 0x08,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x01, 0x01, 0x12, 0x03, 0x2f, 0x09, 0x17, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x06, 0x02, 0x01, 0x03, 0x12, 0x03, 0x2f, 0x1a, 0x1b, 0x0a, 0x1b, 0x0a, 0x04,
-    0x04, 0x06, 0x02, 0x02, 0x12, 0x03, 0x32, 0x02, 0x1b, 0x1a, 0x0e, 0x20, 0xe5, 0xb0, 0x81, 0xe9,
-    0x9d, 0xa2, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02,
-    0x02, 0x05, 0x12, 0x03, 0x32, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x02, 0x01,
-    0x12, 0x03, 0x32, 0x09, 0x16, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x02, 0x03, 0x12, 0x03,
-    0x32, 0x19, 0x1a, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x07, 0x12, 0x04, 0x35, 0x00, 0x37, 0x01, 0x0a,
-    0x0a, 0x0a, 0x03, 0x04, 0x07, 0x01, 0x12, 0x03, 0x35, 0x08, 0x14, 0x0a, 0x0b, 0x0a, 0x04, 0x04,
-    0x07, 0x02, 0x00, 0x12, 0x03, 0x36, 0x02, 0x1e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00,
-    0x04, 0x12, 0x03, 0x36, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x05, 0x12,
-    0x03, 0x36, 0x0b, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x01, 0x12, 0x03, 0x36,
-    0x11, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x03, 0x12, 0x03, 0x36, 0x1c, 0x1d,
-    0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x08, 0x12, 0x04, 0x39, 0x00, 0x3f, 0x01, 0x0a, 0x0a, 0x0a, 0x03,
-    0x04, 0x08, 0x01, 0x12, 0x03, 0x39, 0x08, 0x19, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x08, 0x02, 0x00,
-    0x12, 0x03, 0x3a, 0x02, 0x27, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x06, 0x12, 0x03,
-    0x3a, 0x02, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x01, 0x12, 0x03, 0x3a, 0x1a,
-    0x1f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x03, 0x12, 0x03, 0x3a, 0x25, 0x26, 0x0a,
-    0x0b, 0x0a, 0x04, 0x04, 0x08, 0x02, 0x01, 0x12, 0x03, 0x3b, 0x02, 0x27, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x08, 0x02, 0x01, 0x04, 0x12, 0x03, 0x3b, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08,
-    0x02, 0x01, 0x06, 0x12, 0x03, 0x3b, 0x0b, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x01,
-    0x01, 0x12, 0x03, 0x3b, 0x1a, 0x20, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x01, 0x03, 0x12,
-    0x03, 0x3b, 0x25, 0x26, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x08, 0x02, 0x02, 0x12, 0x03, 0x3c, 0x02,
-    0x27, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x02, 0x06, 0x12, 0x03, 0x3c, 0x02, 0x0e, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x02, 0x01, 0x12, 0x03, 0x3c, 0x1a, 0x1e, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x08, 0x02, 0x02, 0x03, 0x12, 0x03, 0x3c, 0x25, 0x26, 0x0a, 0x0b, 0x0a, 0x04, 0x04,
-    0x08, 0x02, 0x03, 0x12, 0x03, 0x3d, 0x02, 0x27, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x03,
-    0x04, 0x12, 0x03, 0x3d, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x03, 0x06, 0x12,
-    0x03, 0x3d, 0x0b, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x03, 0x01, 0x12, 0x03, 0x3d,
-    0x1a, 0x22, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x03, 0x03, 0x12, 0x03, 0x3d, 0x25, 0x26,
-    0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x08, 0x02, 0x04, 0x12, 0x03, 0x3e, 0x02, 0x27, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x08, 0x02, 0x04, 0x06, 0x12, 0x03, 0x3e, 0x02, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x08, 0x02, 0x04, 0x01, 0x12, 0x03, 0x3e, 0x1a, 0x1f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02,
-    0x04, 0x03, 0x12, 0x03, 0x3e, 0x25, 0x26, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x09, 0x12, 0x04, 0x41,
-    0x00, 0x43, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x09, 0x01, 0x12, 0x03, 0x41, 0x08, 0x1a, 0x0a,
-    0x0b, 0x0a, 0x04, 0x04, 0x09, 0x02, 0x00, 0x12, 0x03, 0x42, 0x02, 0x14, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x09, 0x02, 0x00, 0x05, 0x12, 0x03, 0x42, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09,
-    0x02, 0x00, 0x01, 0x12, 0x03, 0x42, 0x08, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x00,
-    0x03, 0x12, 0x03, 0x42, 0x12, 0x13, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0a, 0x12, 0x04, 0x45, 0x00,
-    0x47, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0a, 0x01, 0x12, 0x03, 0x45, 0x08, 0x19, 0x0a, 0x0b,
-    0x0a, 0x04, 0x04, 0x0a, 0x02, 0x00, 0x12, 0x03, 0x46, 0x02, 0x38, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x0a, 0x02, 0x00, 0x05, 0x12, 0x03, 0x46, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02,
-    0x00, 0x01, 0x12, 0x03, 0x46, 0x08, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x00, 0x03,
-    0x12, 0x03, 0x46, 0x12, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x00, 0x08, 0x12, 0x03,
-    0x46, 0x14, 0x37, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x0a, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04,
-    0x12, 0x03, 0x46, 0x15, 0x36, 0x0a, 0x09, 0x0a, 0x02, 0x04, 0x0b, 0x12, 0x03, 0x49, 0x00, 0x1d,
-    0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0b, 0x01, 0x12, 0x03, 0x49, 0x08, 0x1a, 0x0a, 0x0a, 0x0a, 0x02,
-    0x04, 0x0c, 0x12, 0x04, 0x4b, 0x00, 0x4e, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0c, 0x01, 0x12,
-    0x03, 0x4b, 0x08, 0x19, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0c, 0x02, 0x00, 0x12, 0x03, 0x4c, 0x02,
-    0x44, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x00, 0x05, 0x12, 0x03, 0x4c, 0x02, 0x07, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x00, 0x01, 0x12, 0x03, 0x4c, 0x14, 0x1b, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x0c, 0x02, 0x00, 0x03, 0x12, 0x03, 0x4c, 0x1e, 0x1f, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x0c, 0x02, 0x00, 0x08, 0x12, 0x03, 0x4c, 0x20, 0x43, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x0c, 0x02,
-    0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x03, 0x4c, 0x21, 0x42, 0x0a, 0x0b, 0x0a, 0x04, 0x04,
-    0x0c, 0x02, 0x01, 0x12, 0x03, 0x4d, 0x02, 0x20, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x01,
-    0x06, 0x12, 0x03, 0x4d, 0x02, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x01, 0x01, 0x12,
-    0x03, 0x4d, 0x14, 0x18, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x01, 0x03, 0x12, 0x03, 0x4d,
-    0x1e, 0x1f, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0d, 0x12, 0x04, 0x50, 0x00, 0x52, 0x01, 0x0a, 0x0a,
-    0x0a, 0x03, 0x04, 0x0d, 0x01, 0x12, 0x03, 0x50, 0x08, 0x1a, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0d,
-    0x02, 0x00, 0x12, 0x03, 0x51, 0x02, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0d, 0x02, 0x00, 0x05,
-    0x12, 0x03, 0x51, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0d, 0x02, 0x00, 0x01, 0x12, 0x03,
-    0x51, 0x08, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0d, 0x02, 0x00, 0x03, 0x12, 0x03, 0x51, 0x12,
-    0x13, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0e, 0x12, 0x04, 0x54, 0x00, 0x56, 0x01, 0x0a, 0x0a, 0x0a,
-    0x03, 0x04, 0x0e, 0x01, 0x12, 0x03, 0x54, 0x08, 0x16, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0e, 0x02,
-    0x00, 0x12, 0x03, 0x55, 0x02, 0x38, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x00, 0x05, 0x12,
-    0x03, 0x55, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x00, 0x01, 0x12, 0x03, 0x55,
-    0x08, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x00, 0x03, 0x12, 0x03, 0x55, 0x12, 0x13,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x00, 0x08, 0x12, 0x03, 0x55, 0x14, 0x37, 0x0a, 0x10,
-    0x0a, 0x09, 0x04, 0x0e, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x03, 0x55, 0x15, 0x36,
-    0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0f, 0x12, 0x04, 0x58, 0x00, 0x5a, 0x01, 0x0a, 0x0a, 0x0a, 0x03,
-    0x04, 0x0f, 0x01, 0x12, 0x03, 0x58, 0x08, 0x17, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0f, 0x02, 0x00,
-    0x12, 0x03, 0x59, 0x02, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x00, 0x06, 0x12, 0x03,
-    0x59, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x00, 0x01, 0x12, 0x03, 0x59, 0x0b,
-    0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x00, 0x03, 0x12, 0x03, 0x59, 0x12, 0x13, 0x0a,
-    0x0a, 0x0a, 0x02, 0x04, 0x10, 0x12, 0x04, 0x5c, 0x00, 0x5f, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04,
-    0x10, 0x01, 0x12, 0x03, 0x5c, 0x08, 0x17, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x10, 0x02, 0x00, 0x12,
-    0x03, 0x5d, 0x02, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x00, 0x05, 0x12, 0x03, 0x5d,
-    0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x00, 0x01, 0x12, 0x03, 0x5d, 0x08, 0x0e,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x00, 0x03, 0x12, 0x03, 0x5d, 0x11, 0x12, 0x0a, 0x0b,
-    0x0a, 0x04, 0x04, 0x10, 0x02, 0x01, 0x12, 0x03, 0x5e, 0x02, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x10, 0x02, 0x01, 0x05, 0x12, 0x03, 0x5e, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x10, 0x02,
-    0x01, 0x01, 0x12, 0x03, 0x5e, 0x08, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x01, 0x03,
-    0x12, 0x03, 0x5e, 0x11, 0x12, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x11, 0x12, 0x04, 0x61, 0x00, 0x65,
-    0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x11, 0x01, 0x12, 0x03, 0x61, 0x08, 0x18, 0x0a, 0x0b, 0x0a,
-    0x04, 0x04, 0x11, 0x02, 0x00, 0x12, 0x03, 0x62, 0x02, 0x24, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x11,
-    0x02, 0x00, 0x04, 0x12, 0x03, 0x62, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x11, 0x02, 0x00,
-    0x06, 0x12, 0x03, 0x62, 0x0b, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x11, 0x02, 0x00, 0x01, 0x12,
-    0x03, 0x62, 0x14, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x11, 0x02, 0x00, 0x03, 0x12, 0x03, 0x62,
-    0x22, 0x23, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x11, 0x02, 0x01, 0x12, 0x03, 0x63, 0x02, 0x24, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x11, 0x02, 0x01, 0x05, 0x12, 0x03, 0x63, 0x02, 0x07, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x11, 0x02, 0x01, 0x01, 0x12, 0x03, 0x63, 0x14, 0x1f, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x11, 0x02, 0x01, 0x03, 0x12, 0x03, 0x63, 0x22, 0x23, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x11, 0x02,
-    0x02, 0x12, 0x03, 0x64, 0x02, 0x24, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x11, 0x02, 0x02, 0x05, 0x12,
-    0x03, 0x64, 0x02, 0x06, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x11, 0x02, 0x02, 0x01, 0x12, 0x03, 0x64,
-    0x14, 0x1c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x11, 0x02, 0x02, 0x03, 0x12, 0x03, 0x64, 0x22, 0x23,
-    0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x12, 0x12, 0x04, 0x67, 0x00, 0x69, 0x01, 0x0a, 0x0a, 0x0a, 0x03,
-    0x04, 0x12, 0x01, 0x12, 0x03, 0x67, 0x08, 0x1d, 0x0a, 0x17, 0x0a, 0x04, 0x04, 0x12, 0x02, 0x00,
-    0x12, 0x03, 0x68, 0x02, 0x10, 0x22, 0x0a, 0x20, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64,
-    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x12, 0x02, 0x00, 0x05, 0x12, 0x03, 0x68, 0x02, 0x07, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x12, 0x02, 0x00, 0x01, 0x12, 0x03, 0x68, 0x08, 0x0b, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x12, 0x02, 0x00, 0x03, 0x12, 0x03, 0x68, 0x0e, 0x0f, 0x0a, 0x0a, 0x0a, 0x02, 0x04,
-    0x13, 0x12, 0x04, 0x6b, 0x00, 0x6d, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x13, 0x01, 0x12, 0x03,
-    0x6b, 0x08, 0x1e, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x13, 0x02, 0x00, 0x12, 0x03, 0x6c, 0x02, 0x12,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x13, 0x02, 0x00, 0x05, 0x12, 0x03, 0x6c, 0x02, 0x07, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x13, 0x02, 0x00, 0x01, 0x12, 0x03, 0x6c, 0x08, 0x0d, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x13, 0x02, 0x00, 0x03, 0x12, 0x03, 0x6c, 0x10, 0x11, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x14,
-    0x12, 0x04, 0x6f, 0x00, 0x73, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x14, 0x01, 0x12, 0x03, 0x6f,
-    0x08, 0x1b, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x14, 0x02, 0x00, 0x12, 0x03, 0x70, 0x02, 0x2a, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x14, 0x02, 0x00, 0x05, 0x12, 0x03, 0x70, 0x02, 0x07, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x14, 0x02, 0x00, 0x01, 0x12, 0x03, 0x70, 0x15, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x14, 0x02, 0x00, 0x03, 0x12, 0x03, 0x70, 0x28, 0x29, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x14, 0x02,
-    0x01, 0x12, 0x03, 0x71, 0x02, 0x2a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x14, 0x02, 0x01, 0x05, 0x12,
-    0x03, 0x71, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x14, 0x02, 0x01, 0x01, 0x12, 0x03, 0x71,
-    0x15, 0x1a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x14, 0x02, 0x01, 0x03, 0x12, 0x03, 0x71, 0x28, 0x29,
-    0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x14, 0x02, 0x02, 0x12, 0x03, 0x72, 0x02, 0x2a, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x14, 0x02, 0x02, 0x06, 0x12, 0x03, 0x72, 0x02, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x14, 0x02, 0x02, 0x01, 0x12, 0x03, 0x72, 0x15, 0x25, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x14, 0x02,
-    0x02, 0x03, 0x12, 0x03, 0x72, 0x28, 0x29, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x15, 0x12, 0x04, 0x75,
-    0x00, 0x78, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x15, 0x01, 0x12, 0x03, 0x75, 0x08, 0x1c, 0x0a,
-    0x0b, 0x0a, 0x04, 0x04, 0x15, 0x02, 0x00, 0x12, 0x03, 0x76, 0x02, 0x1e, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x15, 0x02, 0x00, 0x05, 0x12, 0x03, 0x76, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x15,
-    0x02, 0x00, 0x01, 0x12, 0x03, 0x76, 0x14, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x15, 0x02, 0x00,
-    0x03, 0x12, 0x03, 0x76, 0x1c, 0x1d, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x15, 0x02, 0x01, 0x12, 0x03,
-    0x77, 0x02, 0x1e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x15, 0x02, 0x01, 0x04, 0x12, 0x03, 0x77, 0x02,
-    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x15, 0x02, 0x01, 0x06, 0x12, 0x03, 0x77, 0x0b, 0x13, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x15, 0x02, 0x01, 0x01, 0x12, 0x03, 0x77, 0x14, 0x19, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x15, 0x02, 0x01, 0x03, 0x12, 0x03, 0x77, 0x1c, 0x1d, 0x0a, 0x0a, 0x0a, 0x02, 0x04,
-    0x16, 0x12, 0x04, 0x7a, 0x00, 0x7c, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x16, 0x01, 0x12, 0x03,
-    0x7a, 0x08, 0x15, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x16, 0x02, 0x00, 0x12, 0x03, 0x7b, 0x02, 0x12,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x16, 0x02, 0x00, 0x05, 0x12, 0x03, 0x7b, 0x02, 0x08, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x16, 0x02, 0x00, 0x01, 0x12, 0x03, 0x7b, 0x09, 0x0d, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x16, 0x02, 0x00, 0x03, 0x12, 0x03, 0x7b, 0x10, 0x11, 0x0a, 0x0b, 0x0a, 0x02, 0x04, 0x17,
-    0x12, 0x05, 0x7e, 0x00, 0x80, 0x01, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x17, 0x01, 0x12, 0x03,
-    0x7e, 0x08, 0x16, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x17, 0x02, 0x00, 0x12, 0x03, 0x7f, 0x02, 0x0f,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x17, 0x02, 0x00, 0x05, 0x12, 0x03, 0x7f, 0x02, 0x07, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x17, 0x02, 0x00, 0x01, 0x12, 0x03, 0x7f, 0x08, 0x0a, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x17, 0x02, 0x00, 0x03, 0x12, 0x03, 0x7f, 0x0d, 0x0e, 0x0a, 0x78, 0x0a, 0x02, 0x06, 0x00,
-    0x12, 0x06, 0x84, 0x01, 0x00, 0xa2, 0x01, 0x01, 0x1a, 0x6a, 0x20, 0xe5, 0x92, 0x8c, 0xe7, 0xac,
-    0x94, 0xe8, 0xae, 0xb0, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0xe7, 0x9b, 0xb8, 0xe5, 0x85, 0xb3,
-    0xe7, 0x9a, 0x84, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x0a, 0x20, 0xe6, 0xaf, 0x94, 0xe5, 0xa6,
-    0x82, 0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xef, 0xbc, 0x8c,
-    0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xef, 0xbc, 0x8c, 0xe5,
-    0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe7, 0xad, 0x89, 0xe7, 0xae,
-    0xa1, 0xe7, 0x90, 0x86, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe7, 0x9a, 0x84, 0xe5, 0x8a, 0x9f,
-    0xe8, 0x83, 0xbd, 0x0a, 0x0a, 0x0b, 0x0a, 0x03, 0x06, 0x00, 0x01, 0x12, 0x04, 0x84, 0x01, 0x08,
-    0x1a, 0x0a, 0x37, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x00, 0x12, 0x04, 0x86, 0x01, 0x02, 0x4a, 0x1a,
-    0x29, 0x20, 0xe6, 0xa3, 0x80, 0xe6, 0x9f, 0xa5, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe6, 0x98,
-    0xaf, 0xe5, 0x90, 0xa6, 0xe6, 0x8b, 0xa5, 0xe6, 0x9c, 0x89, 0xe6, 0x8c, 0x87, 0xe5, 0xae, 0x9a,
-    0xe7, 0x9a, 0x84, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00,
-    0x02, 0x00, 0x01, 0x12, 0x04, 0x86, 0x01, 0x06, 0x13, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02,
-    0x00, 0x02, 0x12, 0x04, 0x86, 0x01, 0x14, 0x28, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00,
-    0x03, 0x12, 0x04, 0x86, 0x01, 0x33, 0x48, 0x0a, 0x28, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x01, 0x12,
-    0x04, 0x89, 0x01, 0x02, 0x44, 0x1a, 0x1a, 0x20, 0xe5, 0x88, 0xa4, 0xe6, 0x96, 0xad, 0xe7, 0xac,
-    0x94, 0xe8, 0xae, 0xb0, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0xad, 0x98, 0xe5, 0x9c, 0xa8,
-    0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x01, 0x12, 0x04, 0x89, 0x01, 0x06, 0x11,
-    0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x02, 0x12, 0x04, 0x89, 0x01, 0x12, 0x24, 0x0a,
-    0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x03, 0x12, 0x04, 0x89, 0x01, 0x2f, 0x42, 0x0a, 0x1c,
-    0x0a, 0x04, 0x06, 0x00, 0x02, 0x02, 0x12, 0x04, 0x8c, 0x01, 0x02, 0x41, 0x1a, 0x0e, 0x20, 0xe5,
-    0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x0a, 0x0a, 0x0d, 0x0a, 0x05,
-    0x06, 0x00, 0x02, 0x02, 0x01, 0x12, 0x04, 0x8c, 0x01, 0x06, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x06,
-    0x00, 0x02, 0x02, 0x02, 0x12, 0x04, 0x8c, 0x01, 0x11, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00,
-    0x02, 0x02, 0x03, 0x12, 0x04, 0x8c, 0x01, 0x2d, 0x3f, 0x0a, 0x1c, 0x0a, 0x04, 0x06, 0x00, 0x02,
-    0x03, 0x12, 0x04, 0x8f, 0x01, 0x02, 0x41, 0x1a, 0x0e, 0x20, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0,
-    0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x03, 0x01,
-    0x12, 0x04, 0x8f, 0x01, 0x06, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x03, 0x02, 0x12,
-    0x04, 0x8f, 0x01, 0x11, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x03, 0x03, 0x12, 0x04,
-    0x8f, 0x01, 0x2d, 0x3f, 0x0a, 0x1c, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x04, 0x12, 0x04, 0x92, 0x01,
-    0x02, 0x41, 0x1a, 0x0e, 0x20, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe7, 0xac, 0x94, 0xe8, 0xae,
-    0xb0, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x04, 0x01, 0x12, 0x04, 0x92, 0x01, 0x06,
-    0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x04, 0x02, 0x12, 0x04, 0x92, 0x01, 0x11, 0x22,
-    0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x04, 0x03, 0x12, 0x04, 0x92, 0x01, 0x2d, 0x3f, 0x0a,
-    0x25, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x05, 0x12, 0x04, 0x95, 0x01, 0x02, 0x38, 0x1a, 0x17, 0x20,
-    0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe7, 0x9a, 0x84, 0xe4,
-    0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x05, 0x01, 0x12,
-    0x04, 0x95, 0x01, 0x06, 0x0d, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x05, 0x02, 0x12, 0x04,
-    0x95, 0x01, 0x0e, 0x1c, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x05, 0x03, 0x12, 0x04, 0x95,
-    0x01, 0x27, 0x36, 0x0a, 0x1c, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x06, 0x12, 0x04, 0x98, 0x01, 0x02,
-    0x3b, 0x1a, 0x0e, 0x20, 0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0,
-    0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x06, 0x01, 0x12, 0x04, 0x98, 0x01, 0x06, 0x0e,
-    0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x06, 0x02, 0x12, 0x04, 0x98, 0x01, 0x0f, 0x1e, 0x0a,
-    0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x06, 0x03, 0x12, 0x04, 0x98, 0x01, 0x29, 0x39, 0x0a, 0x22,
-    0x0a, 0x04, 0x06, 0x00, 0x02, 0x07, 0x12, 0x04, 0x9b, 0x01, 0x02, 0x47, 0x1a, 0x14, 0x20, 0xe5,
-    0x88, 0x86, 0xe9, 0xa1, 0xb5, 0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba, 0xe7, 0xac, 0x94, 0xe8, 0xae,
-    0xb0, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x07, 0x01, 0x12, 0x04, 0x9b, 0x01, 0x06,
-    0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x07, 0x02, 0x12, 0x04, 0x9b, 0x01, 0x13, 0x26,
-    0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x07, 0x03, 0x12, 0x04, 0x9b, 0x01, 0x31, 0x45, 0x0a,
-    0x28, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x08, 0x12, 0x04, 0x9e, 0x01, 0x02, 0x4d, 0x1a, 0x1a, 0x20,
-    0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe6, 0x8a, 0x95, 0xe7,
-    0xa8, 0xbf, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02,
-    0x08, 0x01, 0x12, 0x04, 0x9e, 0x01, 0x06, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x08,
-    0x02, 0x12, 0x04, 0x9e, 0x01, 0x15, 0x2a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x08, 0x03,
-    0x12, 0x04, 0x9e, 0x01, 0x35, 0x4b, 0x0a, 0x1c, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x09, 0x12, 0x04,
-    0xa1, 0x01, 0x02, 0x35, 0x1a, 0x0e, 0x20, 0xe6, 0x96, 0xb0, 0xe5, 0xa2, 0x9e, 0xe6, 0xa0, 0x87,
-    0xe7, 0xad, 0xbe, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x09, 0x01, 0x12, 0x04, 0xa1,
-    0x01, 0x06, 0x0c, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x09, 0x02, 0x12, 0x04, 0xa1, 0x01,
-    0x0d, 0x1a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x09, 0x03, 0x12, 0x04, 0xa1, 0x01, 0x25,
-    0x33, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33, 0x0a, 0xe7, 0x2c, 0x0a, 0x1e, 0x6e, 0x6f,
-    0x74, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x69, 0x6e,
-    0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x6e, 0x6f,
-    0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76,
-    0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
-    0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x6e, 0x6f, 0x74, 0x65, 0x2f, 0x61, 0x70, 0x69,
-    0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc8,
-    0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x6b, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-    0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-    0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x6e, 0x6f,
-    0x74, 0x65, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-    0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x44, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
-    0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x6e, 0x6f, 0x74, 0x65,
-    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x4e, 0x6f, 0x74, 0x65,
-    0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-    0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3b, 0x0a, 0x09,
-    0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x50, 0x45,
-    0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x44, 0x4f, 0x5f, 0x4c, 0x49, 0x4b, 0x45,
-    0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-    0x44, 0x4f, 0x5f, 0x4c, 0x49, 0x4b, 0x45, 0x10, 0x01, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69, 0x6b,
-    0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x0a,
-    0x13, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x71,
-    0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18,
-    0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06,
-    0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x22, 0x45, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74,
-    0x65, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17,
+    0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+    0x68, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65,
+    0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70,
+    0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4e, 0x6f, 0x74, 0x65,
+    0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+    0x28, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+    0x73, 0x74, 0x6f, 0x72, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+    0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+    0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x24, 0x2e,
+    0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+    0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+    0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+    0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x74,
+    0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x52, 0x65,
+    0x73, 0x74, 0x6f, 0x72, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x6e, 0x6f, 0x74, 0x65,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4e,
+    0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6e, 0x6f, 0x74,
+    0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+    0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x10,
+    0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+    0x12, 0x24, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+    0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+    0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70,
+    0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+    0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a,
+    0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+    0x6e, 0x12, 0x24, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+    0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+    0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c,
+    0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f,
+    0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+    0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+    0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+    0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e,
+    0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c,
+    0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+    0x56, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+    0x12, 0x21, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+    0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+    0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+    0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+    0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x43,
+    0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x2e, 0x6e, 0x6f, 0x74,
+    0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c,
+    0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+    0x24, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+    0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+    0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x6c,
+    0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x6e, 0x6f,
+    0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c,
+    0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+    0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+    0x31, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e,
+    0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x15,
+    0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+    0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+    0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+    0x1a, 0x2a, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+    0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e,
+    0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x13,
+    0x53, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f,
+    0x74, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+    0x31, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+    0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6e,
+    0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x43,
+    0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65,
+    0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xa9, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x6e,
+    0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x42, 0x10, 0x4e, 0x6f, 0x74, 0x65,
+    0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x36,
+    0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x79, 0x61, 0x6e, 0x72,
+    0x65, 0x61, 0x64, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x77, 0x68, 0x69, 0x6d, 0x65, 0x72, 0x2f,
+    0x69, 0x64, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x2f,
+    0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x41, 0x58, 0xaa, 0x02, 0x0b, 0x4e,
+    0x6f, 0x74, 0x65, 0x2e, 0x41, 0x70, 0x69, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0b, 0x4e, 0x6f, 0x74,
+    0x65, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x17, 0x4e, 0x6f, 0x74, 0x65, 0x5c,
+    0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+    0x74, 0x61, 0xea, 0x02, 0x0d, 0x4e, 0x6f, 0x74, 0x65, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a, 0x3a,
+    0x56, 0x31, 0x4a, 0xd8, 0x64, 0x0a, 0x07, 0x12, 0x05, 0x00, 0x00, 0xff, 0x02, 0x01, 0x0a, 0x08,
+    0x0a, 0x01, 0x0c, 0x12, 0x03, 0x00, 0x00, 0x12, 0x0a, 0x08, 0x0a, 0x01, 0x02, 0x12, 0x03, 0x02,
+    0x00, 0x14, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x00, 0x12, 0x03, 0x04, 0x00, 0x25, 0x0a, 0x09, 0x0a,
+    0x02, 0x03, 0x01, 0x12, 0x03, 0x05, 0x00, 0x20, 0x0a, 0x08, 0x0a, 0x01, 0x08, 0x12, 0x03, 0x07,
+    0x00, 0x4d, 0x0a, 0x09, 0x0a, 0x02, 0x08, 0x0b, 0x12, 0x03, 0x07, 0x00, 0x4d, 0x0a, 0x0a, 0x0a,
+    0x02, 0x04, 0x00, 0x12, 0x04, 0x09, 0x00, 0x0c, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x00, 0x01,
+    0x12, 0x03, 0x09, 0x08, 0x1c, 0x0a, 0x17, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x00, 0x12, 0x03, 0x0a,
+    0x02, 0x14, 0x22, 0x0a, 0x20, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64, 0x0a, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x05, 0x12, 0x03, 0x0a, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x0a, 0x08, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00,
+    0x02, 0x00, 0x03, 0x12, 0x03, 0x0a, 0x12, 0x13, 0x0a, 0x17, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x01,
+    0x12, 0x03, 0x0b, 0x02, 0x38, 0x22, 0x0a, 0x20, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x69, 0x64,
+    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x05, 0x12, 0x03, 0x0b, 0x02, 0x07, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x0b, 0x08, 0x0f, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x00, 0x02, 0x01, 0x03, 0x12, 0x03, 0x0b, 0x12, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x00, 0x02, 0x01, 0x08, 0x12, 0x03, 0x0b, 0x14, 0x37, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x00, 0x02,
+    0x01, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x03, 0x0b, 0x15, 0x36, 0x0a, 0x0a, 0x0a, 0x02, 0x04,
+    0x01, 0x12, 0x04, 0x0e, 0x00, 0x11, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x01, 0x01, 0x12, 0x03,
+    0x0e, 0x08, 0x1d, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x00, 0x12, 0x03, 0x0f, 0x02, 0x13,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x05, 0x12, 0x03, 0x0f, 0x02, 0x07, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x01, 0x12, 0x03, 0x0f, 0x08, 0x0b, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x01, 0x02, 0x00, 0x03, 0x12, 0x03, 0x0f, 0x11, 0x12, 0x0a, 0x15, 0x0a, 0x04, 0x04, 0x01,
+    0x02, 0x01, 0x12, 0x03, 0x10, 0x02, 0x13, 0x22, 0x08, 0x20, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c,
+    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x05, 0x12, 0x03, 0x10, 0x02, 0x06, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x01, 0x12, 0x03, 0x10, 0x08, 0x0e, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x01, 0x02, 0x01, 0x03, 0x12, 0x03, 0x10, 0x11, 0x12, 0x0a, 0x0a, 0x0a, 0x02, 0x04,
+    0x02, 0x12, 0x04, 0x13, 0x00, 0x15, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x02, 0x01, 0x12, 0x03,
+    0x13, 0x08, 0x1a, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x00, 0x12, 0x03, 0x14, 0x02, 0x38,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x05, 0x12, 0x03, 0x14, 0x02, 0x07, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x01, 0x12, 0x03, 0x14, 0x08, 0x0f, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x02, 0x02, 0x00, 0x03, 0x12, 0x03, 0x14, 0x12, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02,
+    0x02, 0x00, 0x08, 0x12, 0x03, 0x14, 0x14, 0x37, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x02, 0x02, 0x00,
+    0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x03, 0x14, 0x15, 0x36, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x03,
+    0x12, 0x04, 0x17, 0x00, 0x19, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x03, 0x01, 0x12, 0x03, 0x17,
+    0x08, 0x1b, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x03, 0x02, 0x00, 0x12, 0x03, 0x18, 0x02, 0x11, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x05, 0x12, 0x03, 0x18, 0x02, 0x06, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x03, 0x02, 0x00, 0x01, 0x12, 0x03, 0x18, 0x07, 0x0c, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x03, 0x02, 0x00, 0x03, 0x12, 0x03, 0x18, 0x0f, 0x10, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x04, 0x12,
+    0x04, 0x1b, 0x00, 0x22, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x04, 0x01, 0x12, 0x03, 0x1b, 0x08,
+    0x16, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x00, 0x12, 0x03, 0x1c, 0x02, 0x15, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x05, 0x12, 0x03, 0x1c, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x04, 0x02, 0x00, 0x01, 0x12, 0x03, 0x1c, 0x09, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04,
+    0x02, 0x00, 0x03, 0x12, 0x03, 0x1c, 0x13, 0x14, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x01,
+    0x12, 0x03, 0x1d, 0x02, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x01, 0x05, 0x12, 0x03,
+    0x1d, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x01, 0x01, 0x12, 0x03, 0x1d, 0x09,
+    0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x01, 0x03, 0x12, 0x03, 0x1d, 0x13, 0x14, 0x0a,
+    0x0b, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x02, 0x12, 0x03, 0x1e, 0x02, 0x15, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x04, 0x02, 0x02, 0x05, 0x12, 0x03, 0x1e, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04,
+    0x02, 0x02, 0x01, 0x12, 0x03, 0x1e, 0x09, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x02,
+    0x03, 0x12, 0x03, 0x1e, 0x13, 0x14, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x03, 0x12, 0x03,
+    0x20, 0x02, 0x5b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x03, 0x06, 0x12, 0x03, 0x20, 0x02,
+    0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x03, 0x01, 0x12, 0x03, 0x20, 0x1c, 0x26, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x03, 0x03, 0x12, 0x03, 0x20, 0x29, 0x2a, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x04, 0x02, 0x03, 0x08, 0x12, 0x03, 0x20, 0x2b, 0x5a, 0x0a, 0x10, 0x0a, 0x09, 0x04,
+    0x04, 0x02, 0x03, 0x08, 0x87, 0x09, 0x10, 0x02, 0x12, 0x03, 0x20, 0x2c, 0x59, 0x0a, 0x41, 0x0a,
+    0x04, 0x04, 0x04, 0x02, 0x04, 0x12, 0x03, 0x21, 0x02, 0x2b, 0x22, 0x34, 0x20, 0xe6, 0x8c, 0x87,
+    0xe5, 0xae, 0x9a, 0xe5, 0x8f, 0xaf, 0xe8, 0xa7, 0x81, 0xe7, 0x9a, 0x84, 0xe7, 0x94, 0xa8, 0xe6,
+    0x88, 0xb7, 0x20, 0xe4, 0xbb, 0x85, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0xe4, 0xb8, 0xba,
+    0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0xe6, 0x97, 0xb6, 0xe6, 0x9c, 0x89, 0xe6, 0x95, 0x88, 0x0a,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x04, 0x04, 0x12, 0x03, 0x21, 0x02, 0x0a, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x04, 0x02, 0x04, 0x05, 0x12, 0x03, 0x21, 0x0b, 0x10, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x04, 0x02, 0x04, 0x01, 0x12, 0x03, 0x21, 0x1c, 0x24, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04,
+    0x02, 0x04, 0x03, 0x12, 0x03, 0x21, 0x29, 0x2a, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x05, 0x12, 0x04,
+    0x24, 0x00, 0x29, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x05, 0x01, 0x12, 0x03, 0x24, 0x08, 0x16,
+    0x0a, 0x32, 0x0a, 0x04, 0x04, 0x05, 0x02, 0x00, 0x12, 0x03, 0x25, 0x02, 0x3f, 0x22, 0x25, 0x20,
+    0xe5, 0xae, 0x8c, 0xe6, 0x95, 0xb4, 0xe7, 0x9a, 0x84, 0xe5, 0x8c, 0x85, 0xe5, 0x90, 0xab, 0x62,
+    0x75, 0x63, 0x6b, 0x65, 0x74, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0xe7, 0x9a, 0x84, 0x66, 0x69, 0x6c,
+    0x65, 0x49, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x00, 0x05, 0x12, 0x03, 0x25,
+    0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x00, 0x01, 0x12, 0x03, 0x25, 0x09, 0x10,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x00, 0x03, 0x12, 0x03, 0x25, 0x13, 0x14, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x05, 0x02, 0x00, 0x08, 0x12, 0x03, 0x25, 0x15, 0x3e, 0x0a, 0x10, 0x0a, 0x09,
+    0x04, 0x05, 0x02, 0x00, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x03, 0x25, 0x16, 0x3d, 0x0a, 0x0b,
+    0x0a, 0x04, 0x04, 0x05, 0x02, 0x01, 0x12, 0x03, 0x26, 0x02, 0x3a, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x05, 0x02, 0x01, 0x05, 0x12, 0x03, 0x26, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02,
+    0x01, 0x01, 0x12, 0x03, 0x26, 0x09, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x01, 0x03,
+    0x12, 0x03, 0x26, 0x13, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x01, 0x08, 0x12, 0x03,
+    0x26, 0x15, 0x39, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x05, 0x02, 0x01, 0x08, 0x87, 0x09, 0x05, 0x04,
+    0x12, 0x03, 0x26, 0x16, 0x38, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x05, 0x02, 0x02, 0x12, 0x03, 0x27,
+    0x02, 0x3a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x02, 0x05, 0x12, 0x03, 0x27, 0x02, 0x08,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x02, 0x01, 0x12, 0x03, 0x27, 0x09, 0x0f, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x05, 0x02, 0x02, 0x03, 0x12, 0x03, 0x27, 0x13, 0x14, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x05, 0x02, 0x02, 0x08, 0x12, 0x03, 0x27, 0x15, 0x39, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x05,
+    0x02, 0x02, 0x08, 0x87, 0x09, 0x05, 0x04, 0x12, 0x03, 0x27, 0x16, 0x38, 0x0a, 0x0b, 0x0a, 0x04,
+    0x04, 0x05, 0x02, 0x03, 0x12, 0x03, 0x28, 0x02, 0x3f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02,
+    0x03, 0x05, 0x12, 0x03, 0x28, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x03, 0x01,
+    0x12, 0x03, 0x28, 0x09, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x03, 0x03, 0x12, 0x03,
+    0x28, 0x13, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x03, 0x08, 0x12, 0x03, 0x28, 0x15,
+    0x3e, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x05, 0x02, 0x03, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x03,
+    0x28, 0x16, 0x3d, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x06, 0x12, 0x04, 0x2b, 0x00, 0x34, 0x01, 0x0a,
+    0x0a, 0x0a, 0x03, 0x04, 0x06, 0x01, 0x12, 0x03, 0x2b, 0x08, 0x16, 0x0a, 0x46, 0x0a, 0x04, 0x04,
+    0x06, 0x02, 0x00, 0x12, 0x03, 0x2d, 0x02, 0x15, 0x1a, 0x39, 0x20, 0xe5, 0x8e, 0x9f, 0xe5, 0xa7,
+    0x8b, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0xe6, 0x89, 0x80, 0xe5, 0xa4, 0x84, 0xe4, 0xbd, 0x8d,
+    0xe7, 0xbd, 0xae, 0x20, 0xe5, 0xae, 0x8c, 0xe6, 0x95, 0xb4, 0xe7, 0x9a, 0x84, 0xe5, 0x8c, 0x85,
+    0xe5, 0x90, 0xab, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0xe7, 0x9a, 0x84, 0x66, 0x69, 0x6c, 0x65,
+    0x49, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x05, 0x12, 0x03, 0x2d, 0x02,
+    0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x01, 0x12, 0x03, 0x2d, 0x09, 0x10, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x03, 0x12, 0x03, 0x2d, 0x13, 0x14, 0x0a, 0x34, 0x0a,
+    0x04, 0x04, 0x06, 0x02, 0x01, 0x12, 0x03, 0x30, 0x02, 0x1c, 0x1a, 0x27, 0x20, 0xe7, 0x9b, 0xae,
+    0xe6, 0xa0, 0x87, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0xe6, 0x89, 0x80, 0xe5, 0xa4, 0x84, 0xe4,
+    0xbd, 0x8d, 0xe7, 0xbd, 0xae, 0x20, 0xe5, 0x8c, 0x85, 0xe5, 0x90, 0xab, 0x62, 0x75, 0x63, 0x6b,
+    0x65, 0x74, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x01, 0x05, 0x12, 0x03, 0x30, 0x02,
+    0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x01, 0x01, 0x12, 0x03, 0x30, 0x09, 0x17, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x01, 0x03, 0x12, 0x03, 0x30, 0x1a, 0x1b, 0x0a, 0x1b, 0x0a,
+    0x04, 0x04, 0x06, 0x02, 0x02, 0x12, 0x03, 0x33, 0x02, 0x1b, 0x1a, 0x0e, 0x20, 0xe5, 0xb0, 0x81,
+    0xe9, 0x9d, 0xa2, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06,
+    0x02, 0x02, 0x05, 0x12, 0x03, 0x33, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x02,
+    0x01, 0x12, 0x03, 0x33, 0x09, 0x16, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x02, 0x03, 0x12,
+    0x03, 0x33, 0x19, 0x1a, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x07, 0x12, 0x04, 0x36, 0x00, 0x38, 0x01,
+    0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x07, 0x01, 0x12, 0x03, 0x36, 0x08, 0x14, 0x0a, 0x0b, 0x0a, 0x04,
+    0x04, 0x07, 0x02, 0x00, 0x12, 0x03, 0x37, 0x02, 0x1e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02,
+    0x00, 0x04, 0x12, 0x03, 0x37, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x05,
+    0x12, 0x03, 0x37, 0x0b, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x01, 0x12, 0x03,
+    0x37, 0x11, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x03, 0x12, 0x03, 0x37, 0x1c,
+    0x1d, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x08, 0x12, 0x04, 0x3a, 0x00, 0x46, 0x01, 0x0a, 0x0a, 0x0a,
+    0x03, 0x04, 0x08, 0x01, 0x12, 0x03, 0x3a, 0x08, 0x19, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x08, 0x02,
+    0x00, 0x12, 0x03, 0x3b, 0x02, 0x27, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x06, 0x12,
+    0x03, 0x3b, 0x02, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x01, 0x12, 0x03, 0x3b,
+    0x1a, 0x1f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x03, 0x12, 0x03, 0x3b, 0x25, 0x26,
+    0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x08, 0x02, 0x01, 0x12, 0x03, 0x3c, 0x02, 0x27, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x08, 0x02, 0x01, 0x04, 0x12, 0x03, 0x3c, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x08, 0x02, 0x01, 0x06, 0x12, 0x03, 0x3c, 0x0b, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02,
+    0x01, 0x01, 0x12, 0x03, 0x3c, 0x1a, 0x20, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x01, 0x03,
+    0x12, 0x03, 0x3c, 0x25, 0x26, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x08, 0x02, 0x02, 0x12, 0x03, 0x3d,
+    0x02, 0x27, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x02, 0x06, 0x12, 0x03, 0x3d, 0x02, 0x0e,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x02, 0x01, 0x12, 0x03, 0x3d, 0x1a, 0x1e, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x08, 0x02, 0x02, 0x03, 0x12, 0x03, 0x3d, 0x25, 0x26, 0x0a, 0x0b, 0x0a, 0x04,
+    0x04, 0x08, 0x02, 0x03, 0x12, 0x03, 0x3e, 0x02, 0x27, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02,
+    0x03, 0x04, 0x12, 0x03, 0x3e, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x03, 0x06,
+    0x12, 0x03, 0x3e, 0x0b, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x03, 0x01, 0x12, 0x03,
+    0x3e, 0x1a, 0x22, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x03, 0x03, 0x12, 0x03, 0x3e, 0x25,
+    0x26, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x08, 0x02, 0x04, 0x12, 0x03, 0x3f, 0x02, 0x27, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x08, 0x02, 0x04, 0x06, 0x12, 0x03, 0x3f, 0x02, 0x10, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x08, 0x02, 0x04, 0x01, 0x12, 0x03, 0x3f, 0x1a, 0x1f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08,
+    0x02, 0x04, 0x03, 0x12, 0x03, 0x3f, 0x25, 0x26, 0x0a, 0x3d, 0x0a, 0x04, 0x04, 0x08, 0x02, 0x05,
+    0x12, 0x03, 0x42, 0x02, 0x11, 0x1a, 0x30, 0x20, 0xe4, 0xbf, 0x9d, 0xe5, 0xad, 0x98, 0xe4, 0xb8,
+    0xba, 0xe8, 0x8d, 0x89, 0xe7, 0xa8, 0xbf, 0x20, 0xe8, 0x8d, 0x89, 0xe7, 0xa8, 0xbf, 0xe4, 0xb8,
+    0x8d, 0xe4, 0xbc, 0x9a, 0xe8, 0xbf, 0x9b, 0xe5, 0x85, 0xa5, 0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83,
+    0xe6, 0xb5, 0x81, 0xe7, 0xa8, 0x8b, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x05, 0x05,
+    0x12, 0x03, 0x42, 0x02, 0x06, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x05, 0x01, 0x12, 0x03,
+    0x42, 0x07, 0x0c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x05, 0x03, 0x12, 0x03, 0x42, 0x0f,
+    0x10, 0x0a, 0x40, 0x0a, 0x04, 0x04, 0x08, 0x02, 0x06, 0x12, 0x03, 0x45, 0x02, 0x3c, 0x1a, 0x33,
+    0x20, 0xe5, 0xae, 0x9a, 0xe6, 0x97, 0xb6, 0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83, 0xe6, 0x97, 0xb6,
+    0xe9, 0x97, 0xb4, 0x20, 0x75, 0x6e, 0x69, 0x78, 0xe7, 0xa7, 0x92, 0x20, 0xe4, 0xb8, 0xba, 0x30,
+    0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe7, 0xab, 0x8b, 0xe5, 0x8d, 0xb3, 0xe5, 0x8f, 0x91, 0xe5,
+    0xb8, 0x83, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x06, 0x05, 0x12, 0x03, 0x45, 0x02,
+    0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x06, 0x01, 0x12, 0x03, 0x45, 0x08, 0x12, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x06, 0x03, 0x12, 0x03, 0x45, 0x15, 0x16, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x08, 0x02, 0x06, 0x08, 0x12, 0x03, 0x45, 0x17, 0x3b, 0x0a, 0x10, 0x0a, 0x09, 0x04,
+    0x08, 0x02, 0x06, 0x08, 0x87, 0x09, 0x04, 0x05, 0x12, 0x03, 0x45, 0x18, 0x3a, 0x0a, 0x0a, 0x0a,
+    0x02, 0x04, 0x09, 0x12, 0x04, 0x48, 0x00, 0x4a, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x09, 0x01,
+    0x12, 0x03, 0x48, 0x08, 0x1a, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x09, 0x02, 0x00, 0x12, 0x03, 0x49,
+    0x02, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x00, 0x05, 0x12, 0x03, 0x49, 0x02, 0x07,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x00, 0x01, 0x12, 0x03, 0x49, 0x08, 0x0f, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x09, 0x02, 0x00, 0x03, 0x12, 0x03, 0x49, 0x12, 0x13, 0x0a, 0x0a, 0x0a, 0x02,
+    0x04, 0x0a, 0x12, 0x04, 0x4c, 0x00, 0x4e, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0a, 0x01, 0x12,
+    0x03, 0x4c, 0x08, 0x19, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0a, 0x02, 0x00, 0x12, 0x03, 0x4d, 0x02,
+    0x38, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x00, 0x05, 0x12, 0x03, 0x4d, 0x02, 0x07, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x00, 0x01, 0x12, 0x03, 0x4d, 0x08, 0x0f, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x0a, 0x02, 0x00, 0x03, 0x12, 0x03, 0x4d, 0x12, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x0a, 0x02, 0x00, 0x08, 0x12, 0x03, 0x4d, 0x14, 0x37, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x0a, 0x02,
+    0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x03, 0x4d, 0x15, 0x36, 0x0a, 0x09, 0x0a, 0x02, 0x04,
+    0x0b, 0x12, 0x03, 0x50, 0x00, 0x1d, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0b, 0x01, 0x12, 0x03, 0x50,
+    0x08, 0x1a, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0c, 0x12, 0x04, 0x52, 0x00, 0x55, 0x01, 0x0a, 0x0a,
+    0x0a, 0x03, 0x04, 0x0c, 0x01, 0x12, 0x03, 0x52, 0x08, 0x19, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0c,
+    0x02, 0x00, 0x12, 0x03, 0x53, 0x02, 0x44, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x00, 0x05,
+    0x12, 0x03, 0x53, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x00, 0x01, 0x12, 0x03,
+    0x53, 0x14, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x00, 0x03, 0x12, 0x03, 0x53, 0x1e,
+    0x1f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x00, 0x08, 0x12, 0x03, 0x53, 0x20, 0x43, 0x0a,
+    0x10, 0x0a, 0x09, 0x04, 0x0c, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x03, 0x53, 0x21,
+    0x42, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0c, 0x02, 0x01, 0x12, 0x03, 0x54, 0x02, 0x20, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x0c, 0x02, 0x01, 0x06, 0x12, 0x03, 0x54, 0x02, 0x13, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x0c, 0x02, 0x01, 0x01, 0x12, 0x03, 0x54, 0x14, 0x18, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c,
+    0x02, 0x01, 0x03, 0x12, 0x03, 0x54, 0x1e, 0x1f, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0d, 0x12, 0x04,
+    0x57, 0x00, 0x59, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0d, 0x01, 0x12, 0x03, 0x57, 0x08, 0x1a,
+    0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0d, 0x02, 0x00, 0x12, 0x03, 0x58, 0x02, 0x14, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x0d, 0x02, 0x00, 0x05, 0x12, 0x03, 0x58, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x0d, 0x02, 0x00, 0x01, 0x12, 0x03, 0x58, 0x08, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0d, 0x02,
+    0x00, 0x03, 0x12, 0x03, 0x58, 0x12, 0x13, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0e, 0x12, 0x04, 0x5b,
+    0x00, 0x5d, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0e, 0x01, 0x12, 0x03, 0x5b, 0x08, 0x16, 0x0a,
+    0x0b, 0x0a, 0x04, 0x04, 0x0e, 0x02, 0x00, 0x12, 0x03, 0x5c, 0x02, 0x38, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x0e, 0x02, 0x00, 0x05, 0x12, 0x03, 0x5c, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e,
+    0x02, 0x00, 0x01, 0x12, 0x03, 0x5c, 0x08, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x00,
+    0x03, 0x12, 0x03, 0x5c, 0x12, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x00, 0x08, 0x12,
+    0x03, 0x5c, 0x14, 0x37, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x0e, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04,
+    0x04, 0x12, 0x03, 0x5c, 0x15, 0x36, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0f, 0x12, 0x04, 0x5f, 0x00,
+    0x61, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0f, 0x01, 0x12, 0x03, 0x5f, 0x08, 0x17, 0x0a, 0x0b,
+    0x0a, 0x04, 0x04, 0x0f, 0x02, 0x00, 0x12, 0x03, 0x60, 0x02, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x0f, 0x02, 0x00, 0x06, 0x12, 0x03, 0x60, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02,
+    0x00, 0x01, 0x12, 0x03, 0x60, 0x0b, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x00, 0x03,
+    0x12, 0x03, 0x60, 0x12, 0x13, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x10, 0x12, 0x04, 0x63, 0x00, 0x66,
+    0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x10, 0x01, 0x12, 0x03, 0x63, 0x08, 0x17, 0x0a, 0x0b, 0x0a,
+    0x04, 0x04, 0x10, 0x02, 0x00, 0x12, 0x03, 0x64, 0x02, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x10,
+    0x02, 0x00, 0x05, 0x12, 0x03, 0x64, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x00,
+    0x01, 0x12, 0x03, 0x64, 0x08, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x00, 0x03, 0x12,
+    0x03, 0x64, 0x11, 0x12, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x10, 0x02, 0x01, 0x12, 0x03, 0x65, 0x02,
+    0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x01, 0x05, 0x12, 0x03, 0x65, 0x02, 0x07, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x01, 0x01, 0x12, 0x03, 0x65, 0x08, 0x0d, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x10, 0x02, 0x01, 0x03, 0x12, 0x03, 0x65, 0x11, 0x12, 0x0a, 0x0a, 0x0a, 0x02, 0x04,
+    0x11, 0x12, 0x04, 0x68, 0x00, 0x6c, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x11, 0x01, 0x12, 0x03,
+    0x68, 0x08, 0x18, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x11, 0x02, 0x00, 0x12, 0x03, 0x69, 0x02, 0x24,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x11, 0x02, 0x00, 0x04, 0x12, 0x03, 0x69, 0x02, 0x0a, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x11, 0x02, 0x00, 0x06, 0x12, 0x03, 0x69, 0x0b, 0x13, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x11, 0x02, 0x00, 0x01, 0x12, 0x03, 0x69, 0x14, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x11,
+    0x02, 0x00, 0x03, 0x12, 0x03, 0x69, 0x22, 0x23, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x11, 0x02, 0x01,
+    0x12, 0x03, 0x6a, 0x02, 0x24, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x11, 0x02, 0x01, 0x05, 0x12, 0x03,
+    0x6a, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x11, 0x02, 0x01, 0x01, 0x12, 0x03, 0x6a, 0x14,
+    0x1f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x11, 0x02, 0x01, 0x03, 0x12, 0x03, 0x6a, 0x22, 0x23, 0x0a,
+    0x0b, 0x0a, 0x04, 0x04, 0x11, 0x02, 0x02, 0x12, 0x03, 0x6b, 0x02, 0x24, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x11, 0x02, 0x02, 0x05, 0x12, 0x03, 0x6b, 0x02, 0x06, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x11,
+    0x02, 0x02, 0x01, 0x12, 0x03, 0x6b, 0x14, 0x1c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x11, 0x02, 0x02,
+    0x03, 0x12, 0x03, 0x6b, 0x22, 0x23, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x12, 0x12, 0x04, 0x6e, 0x00,
+    0x70, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x12, 0x01, 0x12, 0x03, 0x6e, 0x08, 0x1d, 0x0a, 0x17,
+    0x0a, 0x04, 0x04, 0x12, 0x02, 0x00, 0x12, 0x03, 0x6f, 0x02, 0x10, 0x22, 0x0a, 0x20, 0xe7, 0x94,
+    0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x12, 0x02, 0x00, 0x05,
+    0x12, 0x03, 0x6f, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x12, 0x02, 0x00, 0x01, 0x12, 0x03,
+    0x6f, 0x08, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x12, 0x02, 0x00, 0x03, 0x12, 0x03, 0x6f, 0x0e,
+    0x0f, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x13, 0x12, 0x04, 0x72, 0x00, 0x74, 0x01, 0x0a, 0x0a, 0x0a,
+    0x03, 0x04, 0x13, 0x01, 0x12, 0x03, 0x72, 0x08, 0x1e, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x13, 0x02,
+    0x00, 0x12, 0x03, 0x73, 0x02, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x13, 0x02, 0x00, 0x05, 0x12,
+    0x03, 0x73, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x13, 0x02, 0x00, 0x01, 0x12, 0x03, 0x73,
+    0x08, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x13, 0x02, 0x00, 0x03, 0x12, 0x03, 0x73, 0x10, 0x11,
+    0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x14, 0x12, 0x04, 0x76, 0x00, 0x7a, 0x01, 0x0a, 0x0a, 0x0a, 0x03,
+    0x04, 0x14, 0x01, 0x12, 0x03, 0x76, 0x08, 0x1b, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x14, 0x02, 0x00,
+    0x12, 0x03, 0x77, 0x02, 0x2a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x14, 0x02, 0x00, 0x05, 0x12, 0x03,
+    0x77, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x14, 0x02, 0x00, 0x01, 0x12, 0x03, 0x77, 0x15,
+    0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x14, 0x02, 0x00, 0x03, 0x12, 0x03, 0x77, 0x28, 0x29, 0x0a,
+    0x0b, 0x0a, 0x04, 0x04, 0x14, 0x02, 0x01, 0x12, 0x03, 0x78, 0x02, 0x2a, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x14, 0x02, 0x01, 0x05, 0x12, 0x03, 0x78, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x14,
+    0x02, 0x01, 0x01, 0x12, 0x03, 0x78, 0x15, 0x1a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x14, 0x02, 0x01,
+    0x03, 0x12, 0x03, 0x78, 0x28, 0x29, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x14, 0x02, 0x02, 0x12, 0x03,
+    0x79, 0x02, 0x2a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x14, 0x02, 0x02, 0x06, 0x12, 0x03, 0x79, 0x02,
+    0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x14, 0x02, 0x02, 0x01, 0x12, 0x03, 0x79, 0x15, 0x25, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x14, 0x02, 0x02, 0x03, 0x12, 0x03, 0x79, 0x28, 0x29, 0x0a, 0x0a, 0x0a,
+    0x02, 0x04, 0x15, 0x12, 0x04, 0x7c, 0x00, 0x7f, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x15, 0x01,
+    0x12, 0x03, 0x7c, 0x08, 0x1c, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x15, 0x02, 0x00, 0x12, 0x03, 0x7d,
+    0x02, 0x1e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x15, 0x02, 0x00, 0x05, 0x12, 0x03, 0x7d, 0x02, 0x07,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x15, 0x02, 0x00, 0x01, 0x12, 0x03, 0x7d, 0x14, 0x19, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x15, 0x02, 0x00, 0x03, 0x12, 0x03, 0x7d, 0x1c, 0x1d, 0x0a, 0x0b, 0x0a, 0x04,
+    0x04, 0x15, 0x02, 0x01, 0x12, 0x03, 0x7e, 0x02, 0x1e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x15, 0x02,
+    0x01, 0x04, 0x12, 0x03, 0x7e, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x15, 0x02, 0x01, 0x06,
+    0x12, 0x03, 0x7e, 0x0b, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x15, 0x02, 0x01, 0x01, 0x12, 0x03,
+    0x7e, 0x14, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x15, 0x02, 0x01, 0x03, 0x12, 0x03, 0x7e, 0x1c,
+    0x1d, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x16, 0x12, 0x06, 0x81, 0x01, 0x00, 0x84, 0x01, 0x01, 0x0a,
+    0x0b, 0x0a, 0x03, 0x04, 0x16, 0x01, 0x12, 0x04, 0x81, 0x01, 0x08, 0x19, 0x0a, 0x0c, 0x0a, 0x04,
+    0x04, 0x16, 0x02, 0x00, 0x12, 0x04, 0x82, 0x01, 0x02, 0x13, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x16,
+    0x02, 0x00, 0x05, 0x12, 0x04, 0x82, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x16, 0x02,
+    0x00, 0x01, 0x12, 0x04, 0x82, 0x01, 0x08, 0x0e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x16, 0x02, 0x00,
+    0x03, 0x12, 0x04, 0x82, 0x01, 0x11, 0x12, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x16, 0x02, 0x01, 0x12,
+    0x04, 0x83, 0x01, 0x02, 0x13, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x16, 0x02, 0x01, 0x05, 0x12, 0x04,
+    0x83, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x16, 0x02, 0x01, 0x01, 0x12, 0x04, 0x83,
+    0x01, 0x08, 0x0d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x16, 0x02, 0x01, 0x03, 0x12, 0x04, 0x83, 0x01,
+    0x11, 0x12, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x17, 0x12, 0x06, 0x86, 0x01, 0x00, 0x8a, 0x01, 0x01,
+    0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x17, 0x01, 0x12, 0x04, 0x86, 0x01, 0x08, 0x1a, 0x0a, 0x0c, 0x0a,
+    0x04, 0x04, 0x17, 0x02, 0x00, 0x12, 0x04, 0x87, 0x01, 0x02, 0x24, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x17, 0x02, 0x00, 0x04, 0x12, 0x04, 0x87, 0x01, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x17,
+    0x02, 0x00, 0x06, 0x12, 0x04, 0x87, 0x01, 0x0b, 0x13, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x17, 0x02,
+    0x00, 0x01, 0x12, 0x04, 0x87, 0x01, 0x14, 0x19, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x17, 0x02, 0x00,
+    0x03, 0x12, 0x04, 0x87, 0x01, 0x22, 0x23, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x17, 0x02, 0x01, 0x12,
+    0x04, 0x88, 0x01, 0x02, 0x24, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x17, 0x02, 0x01, 0x05, 0x12, 0x04,
+    0x88, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x17, 0x02, 0x01, 0x01, 0x12, 0x04, 0x88,
+    0x01, 0x14, 0x1f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x17, 0x02, 0x01, 0x03, 0x12, 0x04, 0x88, 0x01,
+    0x22, 0x23, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x17, 0x02, 0x02, 0x12, 0x04, 0x89, 0x01, 0x02, 0x24,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x17, 0x02, 0x02, 0x05, 0x12, 0x04, 0x89, 0x01, 0x02, 0x06, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x17, 0x02, 0x02, 0x01, 0x12, 0x04, 0x89, 0x01, 0x14, 0x1c, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x17, 0x02, 0x02, 0x03, 0x12, 0x04, 0x89, 0x01, 0x22, 0x23, 0x0a, 0x0c, 0x0a,
+    0x02, 0x04, 0x18, 0x12, 0x06, 0x8c, 0x01, 0x00, 0x91, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04,
+    0x18, 0x01, 0x12, 0x04, 0x8c, 0x01, 0x08, 0x1b, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x18, 0x02, 0x00,
+    0x12, 0x04, 0x8d, 0x01, 0x02, 0x38, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x18, 0x02, 0x00, 0x05, 0x12,
+    0x04, 0x8d, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x18, 0x02, 0x00, 0x01, 0x12, 0x04,
+    0x8d, 0x01, 0x08, 0x0f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x18, 0x02, 0x00, 0x03, 0x12, 0x04, 0x8d,
+    0x01, 0x12, 0x13, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x18, 0x02, 0x00, 0x08, 0x12, 0x04, 0x8d, 0x01,
+    0x14, 0x37, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x18, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12,
+    0x04, 0x8d, 0x01, 0x15, 0x36, 0x0a, 0x41, 0x0a, 0x04, 0x04, 0x18, 0x02, 0x01, 0x12, 0x04, 0x90,
+    0x01, 0x02, 0x3c, 0x1a, 0x33, 0x20, 0xe5, 0xae, 0x9a, 0xe6, 0x97, 0xb6, 0xe5, 0x8f, 0x91, 0xe5,
+    0xb8, 0x83, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x20, 0x75, 0x6e, 0x69, 0x78, 0xe7, 0xa7, 0x92,
+    0x20, 0xe4, 0xb8, 0xba, 0x30, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe7, 0xab, 0x8b, 0xe5, 0x8d,
+    0xb3, 0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x18, 0x02, 0x01,
+    0x05, 0x12, 0x04, 0x90, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x18, 0x02, 0x01, 0x01,
+    0x12, 0x04, 0x90, 0x01, 0x08, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x18, 0x02, 0x01, 0x03, 0x12,
+    0x04, 0x90, 0x01, 0x15, 0x16, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x18, 0x02, 0x01, 0x08, 0x12, 0x04,
+    0x90, 0x01, 0x17, 0x3b, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x18, 0x02, 0x01, 0x08, 0x87, 0x09, 0x04,
+    0x05, 0x12, 0x04, 0x90, 0x01, 0x18, 0x3a, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x19, 0x12, 0x04, 0x93,
+    0x01, 0x00, 0x1f, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x19, 0x01, 0x12, 0x04, 0x93, 0x01, 0x08, 0x1c,
+    0x0a, 0x31, 0x0a, 0x02, 0x04, 0x1a, 0x12, 0x06, 0x96, 0x01, 0x00, 0xa0, 0x01, 0x01, 0x1a, 0x23,
+    0x20, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe6, 0x9f, 0x90, 0xe4, 0xb8, 0xaa, 0xe7, 0x89, 0x88,
+    0xe6, 0x9c, 0xac, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x8c, 0xe6, 0x95, 0xb4, 0xe5, 0x86, 0x85, 0xe5,
+    0xae, 0xb9, 0x0a, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x1a, 0x01, 0x12, 0x04, 0x96, 0x01, 0x08, 0x1b,
+    0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x1a, 0x02, 0x00, 0x12, 0x04, 0x97, 0x01, 0x02, 0x29, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x1a, 0x02, 0x00, 0x05, 0x12, 0x04, 0x97, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x1a, 0x02, 0x00, 0x01, 0x12, 0x04, 0x97, 0x01, 0x1a, 0x1f, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x1a, 0x02, 0x00, 0x03, 0x12, 0x04, 0x97, 0x01, 0x27, 0x28, 0x0a, 0x0c, 0x0a, 0x04, 0x04,
+    0x1a, 0x02, 0x01, 0x12, 0x04, 0x98, 0x01, 0x02, 0x29, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1a, 0x02,
+    0x01, 0x05, 0x12, 0x04, 0x98, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1a, 0x02, 0x01,
+    0x01, 0x12, 0x04, 0x98, 0x01, 0x1a, 0x1e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1a, 0x02, 0x01, 0x03,
+    0x12, 0x04, 0x98, 0x01, 0x27, 0x28, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x1a, 0x02, 0x02, 0x12, 0x04,
+    0x99, 0x01, 0x02, 0x29, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1a, 0x02, 0x02, 0x05, 0x12, 0x04, 0x99,
+    0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1a, 0x02, 0x02, 0x01, 0x12, 0x04, 0x99, 0x01,
+    0x1a, 0x21, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1a, 0x02, 0x02, 0x03, 0x12, 0x04, 0x99, 0x01, 0x27,
+    0x28, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x1a, 0x02, 0x03, 0x12, 0x04, 0x9a, 0x01, 0x02, 0x29, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x1a, 0x02, 0x03, 0x04, 0x12, 0x04, 0x9a, 0x01, 0x02, 0x0a, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x1a, 0x02, 0x03, 0x06, 0x12, 0x04, 0x9a, 0x01, 0x0b, 0x19, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x1a, 0x02, 0x03, 0x01, 0x12, 0x04, 0x9a, 0x01, 0x1a, 0x20, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x1a, 0x02, 0x03, 0x03, 0x12, 0x04, 0x9a, 0x01, 0x27, 0x28, 0x0a, 0x40, 0x0a, 0x04, 0x04,
+    0x1a, 0x02, 0x04, 0x12, 0x04, 0x9c, 0x01, 0x02, 0x29, 0x1a, 0x32, 0x20, 0xe5, 0x8f, 0xaa, 0xe6,
+    0x9c, 0x89, 0xe9, 0x80, 0x9a, 0xe8, 0xbf, 0x87, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe4, 0xb8,
+    0x8a, 0xe4, 0xbc, 0xa0, 0xe4, 0xba, 0x86, 0xe8, 0xa7, 0x86, 0xe9, 0xa2, 0x91, 0xe6, 0x97, 0xb6,
+    0xe6, 0x89, 0x8d, 0xe4, 0xbc, 0x9a, 0xe8, 0xae, 0xb0, 0xe5, 0xbd, 0x95, 0x0a, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x1a, 0x02, 0x04, 0x06, 0x12, 0x04, 0x9c, 0x01, 0x02, 0x10, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x1a, 0x02, 0x04, 0x01, 0x12, 0x04, 0x9c, 0x01, 0x1a, 0x1f, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x1a, 0x02, 0x04, 0x03, 0x12, 0x04, 0x9c, 0x01, 0x27, 0x28, 0x0a, 0x28, 0x0a, 0x04, 0x04, 0x1a,
+    0x02, 0x05, 0x12, 0x04, 0x9d, 0x01, 0x02, 0x29, 0x22, 0x1a, 0x20, 0xe7, 0xac, 0x94, 0xe8, 0xae,
+    0xb0, 0xe7, 0x9a, 0x84, 0xe5, 0x85, 0xa8, 0xe9, 0x83, 0xa8, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90,
+    0x6b, 0x65, 0x79, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1a, 0x02, 0x05, 0x04, 0x12, 0x04, 0x9d,
+    0x01, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1a, 0x02, 0x05, 0x05, 0x12, 0x04, 0x9d, 0x01,
+    0x0b, 0x11, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1a, 0x02, 0x05, 0x01, 0x12, 0x04, 0x9d, 0x01, 0x1a,
+    0x24, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1a, 0x02, 0x05, 0x03, 0x12, 0x04, 0x9d, 0x01, 0x27, 0x28,
+    0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x1a, 0x02, 0x06, 0x12, 0x04, 0x9e, 0x01, 0x02, 0x29, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x1a, 0x02, 0x06, 0x04, 0x12, 0x04, 0x9e, 0x01, 0x02, 0x0a, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x1a, 0x02, 0x06, 0x05, 0x12, 0x04, 0x9e, 0x01, 0x0b, 0x10, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x1a, 0x02, 0x06, 0x01, 0x12, 0x04, 0x9e, 0x01, 0x1a, 0x21, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x1a, 0x02, 0x06, 0x03, 0x12, 0x04, 0x9e, 0x01, 0x27, 0x28, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x1a,
+    0x02, 0x07, 0x12, 0x04, 0x9f, 0x01, 0x02, 0x29, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1a, 0x02, 0x07,
+    0x04, 0x12, 0x04, 0x9f, 0x01, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1a, 0x02, 0x07, 0x06,
+    0x12, 0x04, 0x9f, 0x01, 0x0b, 0x15, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1a, 0x02, 0x07, 0x01, 0x12,
+    0x04, 0x9f, 0x01, 0x1a, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1a, 0x02, 0x07, 0x03, 0x12, 0x04,
+    0x9f, 0x01, 0x27, 0x28, 0x0a, 0x31, 0x0a, 0x02, 0x04, 0x1b, 0x12, 0x06, 0xa3, 0x01, 0x00, 0xab,
+    0x01, 0x01, 0x1a, 0x23, 0x20, 0xe5, 0x92, 0x8c, 0xe4, 0xb8, 0x8a, 0xe4, 0xb8, 0x80, 0xe4, 0xb8,
+    0xaa, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe7, 0x9b, 0xb8, 0xe6, 0xaf, 0x94, 0xe7, 0x9a, 0x84,
+    0xe5, 0x8f, 0x98, 0xe6, 0x9b, 0xb4, 0x0a, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x1b, 0x01, 0x12, 0x04,
+    0xa3, 0x01, 0x08, 0x18, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x1b, 0x02, 0x00, 0x12, 0x04, 0xa4, 0x01,
+    0x02, 0x29, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02, 0x00, 0x05, 0x12, 0x04, 0xa4, 0x01, 0x02,
+    0x06, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02, 0x00, 0x01, 0x12, 0x04, 0xa4, 0x01, 0x12, 0x1f,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02, 0x00, 0x03, 0x12, 0x04, 0xa4, 0x01, 0x27, 0x28, 0x0a,
+    0x0c, 0x0a, 0x04, 0x04, 0x1b, 0x02, 0x01, 0x12, 0x04, 0xa5, 0x01, 0x02, 0x29, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x1b, 0x02, 0x01, 0x05, 0x12, 0x04, 0xa5, 0x01, 0x02, 0x06, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x1b, 0x02, 0x01, 0x01, 0x12, 0x04, 0xa5, 0x01, 0x12, 0x1e, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x1b, 0x02, 0x01, 0x03, 0x12, 0x04, 0xa5, 0x01, 0x27, 0x28, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x1b,
+    0x02, 0x02, 0x12, 0x04, 0xa6, 0x01, 0x02, 0x29, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02, 0x02,
+    0x05, 0x12, 0x04, 0xa6, 0x01, 0x02, 0x06, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02, 0x02, 0x01,
+    0x12, 0x04, 0xa6, 0x01, 0x12, 0x21, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02, 0x02, 0x03, 0x12,
+    0x04, 0xa6, 0x01, 0x27, 0x28, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x1b, 0x02, 0x03, 0x12, 0x04, 0xa7,
+    0x01, 0x02, 0x29, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02, 0x03, 0x04, 0x12, 0x04, 0xa7, 0x01,
+    0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02, 0x03, 0x05, 0x12, 0x04, 0xa7, 0x01, 0x0b,
+    0x11, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02, 0x03, 0x01, 0x12, 0x04, 0xa7, 0x01, 0x12, 0x22,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02, 0x03, 0x03, 0x12, 0x04, 0xa7, 0x01, 0x27, 0x28, 0x0a,
+    0x0c, 0x0a, 0x04, 0x04, 0x1b, 0x02, 0x04, 0x12, 0x04, 0xa8, 0x01, 0x02, 0x29, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x1b, 0x02, 0x04, 0x04, 0x12, 0x04, 0xa8, 0x01, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x1b, 0x02, 0x04, 0x05, 0x12, 0x04, 0xa8, 0x01, 0x0b, 0x11, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x1b, 0x02, 0x04, 0x01, 0x12, 0x04, 0xa8, 0x01, 0x12, 0x24, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b,
+    0x02, 0x04, 0x03, 0x12, 0x04, 0xa8, 0x01, 0x27, 0x28, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x1b, 0x02,
+    0x05, 0x12, 0x04, 0xa9, 0x01, 0x02, 0x29, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02, 0x05, 0x04,
+    0x12, 0x04, 0xa9, 0x01, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02, 0x05, 0x05, 0x12,
+    0x04, 0xa9, 0x01, 0x0b, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02, 0x05, 0x01, 0x12, 0x04,
+    0xa9, 0x01, 0x12, 0x1f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02, 0x05, 0x03, 0x12, 0x04, 0xa9,
+    0x01, 0x27, 0x28, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x1b, 0x02, 0x06, 0x12, 0x04, 0xaa, 0x01, 0x02,
+    0x29, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02, 0x06, 0x04, 0x12, 0x04, 0xaa, 0x01, 0x02, 0x0a,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02, 0x06, 0x05, 0x12, 0x04, 0xaa, 0x01, 0x0b, 0x10, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x1b, 0x02, 0x06, 0x01, 0x12, 0x04, 0xaa, 0x01, 0x12, 0x21, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x1b, 0x02, 0x06, 0x03, 0x12, 0x04, 0xaa, 0x01, 0x27, 0x28, 0x0a, 0x41, 0x0a,
+    0x02, 0x04, 0x1c, 0x12, 0x06, 0xae, 0x01, 0x00, 0xb5, 0x01, 0x01, 0x1a, 0x33, 0x20, 0xe7, 0xac,
+    0x94, 0xe8, 0xae, 0xb0, 0xe7, 0x9a, 0x84, 0xe4, 0xbf, 0xae, 0xe8, 0xae, 0xa2, 0xe7, 0x89, 0x88,
+    0xe6, 0x9c, 0xac, 0x20, 0xe6, 0xaf, 0x8f, 0xe6, 0xac, 0xa1, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0,
+    0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe6, 0x97, 0xb6, 0xe7, 0x94, 0x9f, 0xe6, 0x88, 0x90, 0x0a,
+    0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x1c, 0x01, 0x12, 0x04, 0xae, 0x01, 0x08, 0x14, 0x0a, 0x0c, 0x0a,
+    0x04, 0x04, 0x1c, 0x02, 0x00, 0x12, 0x04, 0xaf, 0x01, 0x02, 0x2d, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x1c, 0x02, 0x00, 0x05, 0x12, 0x04, 0xaf, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1c,
+    0x02, 0x00, 0x01, 0x12, 0x04, 0xaf, 0x01, 0x16, 0x1d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1c, 0x02,
+    0x00, 0x03, 0x12, 0x04, 0xaf, 0x01, 0x2b, 0x2c, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x1c, 0x02, 0x01,
+    0x12, 0x04, 0xb0, 0x01, 0x02, 0x2d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1c, 0x02, 0x01, 0x05, 0x12,
+    0x04, 0xb0, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1c, 0x02, 0x01, 0x01, 0x12, 0x04,
+    0xb0, 0x01, 0x16, 0x1d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1c, 0x02, 0x01, 0x03, 0x12, 0x04, 0xb0,
+    0x01, 0x2b, 0x2c, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x1c, 0x02, 0x02, 0x12, 0x04, 0xb1, 0x01, 0x02,
+    0x2d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1c, 0x02, 0x02, 0x06, 0x12, 0x04, 0xb1, 0x01, 0x02, 0x15,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1c, 0x02, 0x02, 0x01, 0x12, 0x04, 0xb1, 0x01, 0x16, 0x1d, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x1c, 0x02, 0x02, 0x03, 0x12, 0x04, 0xb1, 0x01, 0x2b, 0x2c, 0x0a, 0x0c,
+    0x0a, 0x04, 0x04, 0x1c, 0x02, 0x03, 0x12, 0x04, 0xb2, 0x01, 0x02, 0x2d, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x1c, 0x02, 0x03, 0x06, 0x12, 0x04, 0xb2, 0x01, 0x02, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x1c, 0x02, 0x03, 0x01, 0x12, 0x04, 0xb2, 0x01, 0x16, 0x1a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1c,
+    0x02, 0x03, 0x03, 0x12, 0x04, 0xb2, 0x01, 0x2b, 0x2c, 0x0a, 0x2b, 0x0a, 0x04, 0x04, 0x1c, 0x02,
+    0x04, 0x12, 0x04, 0xb3, 0x01, 0x02, 0x2d, 0x22, 0x1d, 0x20, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6,
+    0xe4, 0xb8, 0xba, 0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83, 0xe5, 0x90, 0x8e, 0xe7, 0x9a, 0x84, 0xe7,
+    0xbc, 0x96, 0xe8, 0xbe, 0x91, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1c, 0x02, 0x04, 0x05, 0x12,
+    0x04, 0xb3, 0x01, 0x02, 0x06, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1c, 0x02, 0x04, 0x01, 0x12, 0x04,
+    0xb3, 0x01, 0x16, 0x28, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1c, 0x02, 0x04, 0x03, 0x12, 0x04, 0xb3,
+    0x01, 0x2b, 0x2c, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x1c, 0x02, 0x05, 0x12, 0x04, 0xb4, 0x01, 0x02,
+    0x2d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1c, 0x02, 0x05, 0x05, 0x12, 0x04, 0xb4, 0x01, 0x02, 0x07,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1c, 0x02, 0x05, 0x01, 0x12, 0x04, 0xb4, 0x01, 0x16, 0x1b, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x1c, 0x02, 0x05, 0x03, 0x12, 0x04, 0xb4, 0x01, 0x2b, 0x2c, 0x0a, 0x0c,
+    0x0a, 0x02, 0x04, 0x1d, 0x12, 0x06, 0xb7, 0x01, 0x00, 0xbb, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03,
+    0x04, 0x1d, 0x01, 0x12, 0x04, 0xb7, 0x01, 0x08, 0x20, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x1d, 0x02,
+    0x00, 0x12, 0x04, 0xb8, 0x01, 0x02, 0x38, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1d, 0x02, 0x00, 0x05,
+    0x12, 0x04, 0xb8, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1d, 0x02, 0x00, 0x01, 0x12,
+    0x04, 0xb8, 0x01, 0x08, 0x0f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1d, 0x02, 0x00, 0x03, 0x12, 0x04,
+    0xb8, 0x01, 0x12, 0x13, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1d, 0x02, 0x00, 0x08, 0x12, 0x04, 0xb8,
+    0x01, 0x14, 0x37, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x1d, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04,
+    0x12, 0x04, 0xb8, 0x01, 0x15, 0x36, 0x0a, 0x36, 0x0a, 0x04, 0x04, 0x1d, 0x02, 0x01, 0x12, 0x04,
+    0xb9, 0x01, 0x02, 0x14, 0x22, 0x28, 0x20, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe5, 0x8f, 0xb7,
+    0x20, 0xe4, 0xb8, 0xba, 0x30, 0xe6, 0x97, 0xb6, 0xe4, 0xbb, 0x8e, 0xe6, 0x9c, 0x80, 0xe6, 0x96,
+    0xb0, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe5, 0xbc, 0x80, 0xe5, 0xa7, 0x8b, 0x0a, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x1d, 0x02, 0x01, 0x05, 0x12, 0x04, 0xb9, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x1d, 0x02, 0x01, 0x01, 0x12, 0x04, 0xb9, 0x01, 0x08, 0x0e, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x1d, 0x02, 0x01, 0x03, 0x12, 0x04, 0xb9, 0x01, 0x12, 0x13, 0x0a, 0x0c, 0x0a, 0x04, 0x04,
+    0x1d, 0x02, 0x02, 0x12, 0x04, 0xba, 0x01, 0x02, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1d, 0x02,
+    0x02, 0x05, 0x12, 0x04, 0xba, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1d, 0x02, 0x02,
+    0x01, 0x12, 0x04, 0xba, 0x01, 0x08, 0x0d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1d, 0x02, 0x02, 0x03,
+    0x12, 0x04, 0xba, 0x01, 0x12, 0x13, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x1e, 0x12, 0x06, 0xbd, 0x01,
+    0x00, 0xc1, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x1e, 0x01, 0x12, 0x04, 0xbd, 0x01, 0x08,
+    0x21, 0x0a, 0x22, 0x0a, 0x04, 0x04, 0x1e, 0x02, 0x00, 0x12, 0x04, 0xbe, 0x01, 0x02, 0x28, 0x22,
+    0x14, 0x20, 0xe6, 0x8c, 0x89, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe5, 0x8f, 0xb7, 0xe5, 0x80,
+    0x92, 0xe5, 0xba, 0x8f, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1e, 0x02, 0x00, 0x04, 0x12, 0x04,
+    0xbe, 0x01, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1e, 0x02, 0x00, 0x06, 0x12, 0x04, 0xbe,
+    0x01, 0x0b, 0x17, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1e, 0x02, 0x00, 0x01, 0x12, 0x04, 0xbe, 0x01,
+    0x18, 0x1d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1e, 0x02, 0x00, 0x03, 0x12, 0x04, 0xbe, 0x01, 0x26,
+    0x27, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x1e, 0x02, 0x01, 0x12, 0x04, 0xbf, 0x01, 0x02, 0x28, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x1e, 0x02, 0x01, 0x05, 0x12, 0x04, 0xbf, 0x01, 0x02, 0x07, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x1e, 0x02, 0x01, 0x01, 0x12, 0x04, 0xbf, 0x01, 0x18, 0x23, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x1e, 0x02, 0x01, 0x03, 0x12, 0x04, 0xbf, 0x01, 0x26, 0x27, 0x0a, 0x0c, 0x0a, 0x04,
+    0x04, 0x1e, 0x02, 0x02, 0x12, 0x04, 0xc0, 0x01, 0x02, 0x28, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1e,
+    0x02, 0x02, 0x05, 0x12, 0x04, 0xc0, 0x01, 0x02, 0x06, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1e, 0x02,
+    0x02, 0x01, 0x12, 0x04, 0xc0, 0x01, 0x18, 0x20, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1e, 0x02, 0x02,
+    0x03, 0x12, 0x04, 0xc0, 0x01, 0x26, 0x27, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x1f, 0x12, 0x06, 0xc3,
+    0x01, 0x00, 0xc6, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x1f, 0x01, 0x12, 0x04, 0xc3, 0x01,
+    0x08, 0x1e, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x1f, 0x02, 0x00, 0x12, 0x04, 0xc4, 0x01, 0x02, 0x38,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1f, 0x02, 0x00, 0x05, 0x12, 0x04, 0xc4, 0x01, 0x02, 0x07, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x1f, 0x02, 0x00, 0x01, 0x12, 0x04, 0xc4, 0x01, 0x08, 0x0f, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x1f, 0x02, 0x00, 0x03, 0x12, 0x04, 0xc4, 0x01, 0x12, 0x13, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x1f, 0x02, 0x00, 0x08, 0x12, 0x04, 0xc4, 0x01, 0x14, 0x37, 0x0a, 0x11, 0x0a, 0x09,
+    0x04, 0x1f, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x04, 0xc4, 0x01, 0x15, 0x36, 0x0a,
+    0x0c, 0x0a, 0x04, 0x04, 0x1f, 0x02, 0x01, 0x12, 0x04, 0xc5, 0x01, 0x02, 0x38, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x1f, 0x02, 0x01, 0x05, 0x12, 0x04, 0xc5, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x1f, 0x02, 0x01, 0x01, 0x12, 0x04, 0xc5, 0x01, 0x08, 0x0f, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x1f, 0x02, 0x01, 0x03, 0x12, 0x04, 0xc5, 0x01, 0x12, 0x13, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x1f,
+    0x02, 0x01, 0x08, 0x12, 0x04, 0xc5, 0x01, 0x14, 0x37, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x1f, 0x02,
+    0x01, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x04, 0xc5, 0x01, 0x15, 0x36, 0x0a, 0x0c, 0x0a, 0x02,
+    0x04, 0x20, 0x12, 0x06, 0xc8, 0x01, 0x00, 0xca, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x20,
+    0x01, 0x12, 0x04, 0xc8, 0x01, 0x08, 0x1f, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x20, 0x02, 0x00, 0x12,
+    0x04, 0xc9, 0x01, 0x02, 0x1c, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x20, 0x02, 0x00, 0x06, 0x12, 0x04,
+    0xc9, 0x01, 0x02, 0x0e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x20, 0x02, 0x00, 0x01, 0x12, 0x04, 0xc9,
+    0x01, 0x0f, 0x17, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x20, 0x02, 0x00, 0x03, 0x12, 0x04, 0xc9, 0x01,
+    0x1a, 0x1b, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x21, 0x12, 0x06, 0xcc, 0x01, 0x00, 0xcf, 0x01, 0x01,
+    0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x21, 0x01, 0x12, 0x04, 0xcc, 0x01, 0x08, 0x22, 0x0a, 0x0c, 0x0a,
+    0x04, 0x04, 0x21, 0x02, 0x00, 0x12, 0x04, 0xcd, 0x01, 0x02, 0x38, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x21, 0x02, 0x00, 0x05, 0x12, 0x04, 0xcd, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x21,
+    0x02, 0x00, 0x01, 0x12, 0x04, 0xcd, 0x01, 0x08, 0x0f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x21, 0x02,
+    0x00, 0x03, 0x12, 0x04, 0xcd, 0x01, 0x12, 0x13, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x21, 0x02, 0x00,
+    0x08, 0x12, 0x04, 0xcd, 0x01, 0x14, 0x37, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x21, 0x02, 0x00, 0x08,
+    0x87, 0x09, 0x04, 0x04, 0x12, 0x04, 0xcd, 0x01, 0x15, 0x36, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x21,
+    0x02, 0x01, 0x12, 0x04, 0xce, 0x01, 0x02, 0x38, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x21, 0x02, 0x01,
+    0x05, 0x12, 0x04, 0xce, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x21, 0x02, 0x01, 0x01,
+    0x12, 0x04, 0xce, 0x01, 0x08, 0x0f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x21, 0x02, 0x01, 0x03, 0x12,
+    0x04, 0xce, 0x01, 0x12, 0x13, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x21, 0x02, 0x01, 0x08, 0x12, 0x04,
+    0xce, 0x01, 0x14, 0x37, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x21, 0x02, 0x01, 0x08, 0x87, 0x09, 0x04,
+    0x04, 0x12, 0x04, 0xce, 0x01, 0x15, 0x36, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x22, 0x12, 0x04, 0xd1,
+    0x01, 0x00, 0x26, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x22, 0x01, 0x12, 0x04, 0xd1, 0x01, 0x08, 0x23,
+    0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x23, 0x12, 0x06, 0xd3, 0x01, 0x00, 0xd6, 0x01, 0x01, 0x0a, 0x0b,
+    0x0a, 0x03, 0x04, 0x23, 0x01, 0x12, 0x04, 0xd3, 0x01, 0x08, 0x1f, 0x0a, 0x0c, 0x0a, 0x04, 0x04,
+    0x23, 0x02, 0x00, 0x12, 0x04, 0xd4, 0x01, 0x02, 0x13, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x23, 0x02,
+    0x00, 0x05, 0x12, 0x04, 0xd4, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x23, 0x02, 0x00,
+    0x01, 0x12, 0x04, 0xd4, 0x01, 0x08, 0x0e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x23, 0x02, 0x00, 0x03,
+    0x12, 0x04, 0xd4, 0x01, 0x11, 0x12, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x23, 0x02, 0x01, 0x12, 0x04,
+    0xd5, 0x01, 0x02, 0x13, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x23, 0x02, 0x01, 0x05, 0x12, 0x04, 0xd5,
+    0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x23, 0x02, 0x01, 0x01, 0x12, 0x04, 0xd5, 0x01,
+    0x08, 0x0d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x23, 0x02, 0x01, 0x03, 0x12, 0x04, 0xd5, 0x01, 0x11,
+    0x12, 0x0a, 0x25, 0x0a, 0x02, 0x04, 0x24, 0x12, 0x06, 0xd9, 0x01, 0x00, 0xdd, 0x01, 0x01, 0x1a,
+    0x17, 0x20, 0xe5, 0x9b, 0x9e, 0xe6, 0x94, 0xb6, 0xe7, 0xab, 0x99, 0xe4, 0xb8, 0xad, 0xe7, 0x9a,
+    0x84, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x0a, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x24, 0x01, 0x12,
+    0x04, 0xd9, 0x01, 0x08, 0x17, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x24, 0x02, 0x00, 0x12, 0x04, 0xda,
+    0x01, 0x02, 0x19, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x24, 0x02, 0x00, 0x06, 0x12, 0x04, 0xda, 0x01,
+    0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x24, 0x02, 0x00, 0x01, 0x12, 0x04, 0xda, 0x01, 0x0b,
+    0x0f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x24, 0x02, 0x00, 0x03, 0x12, 0x04, 0xda, 0x01, 0x17, 0x18,
+    0x0a, 0x1c, 0x0a, 0x04, 0x04, 0x24, 0x02, 0x01, 0x12, 0x04, 0xdb, 0x01, 0x02, 0x19, 0x22, 0x0e,
+    0x20, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x0a, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x24, 0x02, 0x01, 0x05, 0x12, 0x04, 0xdb, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x24, 0x02, 0x01, 0x01, 0x12, 0x04, 0xdb, 0x01, 0x0b, 0x14, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x24, 0x02, 0x01, 0x03, 0x12, 0x04, 0xdb, 0x01, 0x17, 0x18, 0x0a, 0x3e, 0x0a, 0x04, 0x04,
+    0x24, 0x02, 0x02, 0x12, 0x04, 0xdc, 0x01, 0x02, 0x19, 0x22, 0x30, 0x20, 0xe8, 0xb6, 0x85, 0xe8,
+    0xbf, 0x87, 0xe8, 0xaf, 0xa5, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe5, 0x90, 0x8e, 0xe5, 0xbd,
+    0xbb, 0xe5, 0xba, 0x95, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0x20, 0xe4, 0xb8, 0x8d, 0xe8, 0x83,
+    0xbd, 0xe5, 0x86, 0x8d, 0xe6, 0x81, 0xa2, 0xe5, 0xa4, 0x8d, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x24, 0x02, 0x02, 0x05, 0x12, 0x04, 0xdc, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x24,
+    0x02, 0x02, 0x01, 0x12, 0x04, 0xdc, 0x01, 0x0b, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x24, 0x02,
+    0x02, 0x03, 0x12, 0x04, 0xdc, 0x01, 0x17, 0x18, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x25, 0x12, 0x06,
+    0xdf, 0x01, 0x00, 0xe3, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x25, 0x01, 0x12, 0x04, 0xdf,
+    0x01, 0x08, 0x20, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x25, 0x02, 0x00, 0x12, 0x04, 0xe0, 0x01, 0x02,
+    0x2b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x25, 0x02, 0x00, 0x04, 0x12, 0x04, 0xe0, 0x01, 0x02, 0x0a,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x25, 0x02, 0x00, 0x06, 0x12, 0x04, 0xe0, 0x01, 0x0b, 0x1a, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x25, 0x02, 0x00, 0x01, 0x12, 0x04, 0xe0, 0x01, 0x1b, 0x20, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x25, 0x02, 0x00, 0x03, 0x12, 0x04, 0xe0, 0x01, 0x29, 0x2a, 0x0a, 0x0c, 0x0a,
+    0x04, 0x04, 0x25, 0x02, 0x01, 0x12, 0x04, 0xe1, 0x01, 0x02, 0x2b, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x25, 0x02, 0x01, 0x05, 0x12, 0x04, 0xe1, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x25,
+    0x02, 0x01, 0x01, 0x12, 0x04, 0xe1, 0x01, 0x1b, 0x26, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x25, 0x02,
+    0x01, 0x03, 0x12, 0x04, 0xe1, 0x01, 0x29, 0x2a, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x25, 0x02, 0x02,
+    0x12, 0x04, 0xe2, 0x01, 0x02, 0x2b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x25, 0x02, 0x02, 0x05, 0x12,
+    0x04, 0xe2, 0x01, 0x02, 0x06, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x25, 0x02, 0x02, 0x01, 0x12, 0x04,
+    0xe2, 0x01, 0x1b, 0x23, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x25, 0x02, 0x02, 0x03, 0x12, 0x04, 0xe2,
+    0x01, 0x29, 0x2a, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x26, 0x12, 0x06, 0xe5, 0x01, 0x00, 0xe7, 0x01,
+    0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x26, 0x01, 0x12, 0x04, 0xe5, 0x01, 0x08, 0x1a, 0x0a, 0x0c,
+    0x0a, 0x04, 0x04, 0x26, 0x02, 0x00, 0x12, 0x04, 0xe6, 0x01, 0x02, 0x38, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x26, 0x02, 0x00, 0x05, 0x12, 0x04, 0xe6, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x26, 0x02, 0x00, 0x01, 0x12, 0x04, 0xe6, 0x01, 0x08, 0x0f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x26,
+    0x02, 0x00, 0x03, 0x12, 0x04, 0xe6, 0x01, 0x12, 0x13, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x26, 0x02,
+    0x00, 0x08, 0x12, 0x04, 0xe6, 0x01, 0x14, 0x37, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x26, 0x02, 0x00,
+    0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x04, 0xe6, 0x01, 0x15, 0x36, 0x0a, 0x0a, 0x0a, 0x02, 0x04,
+    0x27, 0x12, 0x04, 0xe9, 0x01, 0x00, 0x1e, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x27, 0x01, 0x12, 0x04,
+    0xe9, 0x01, 0x08, 0x1b, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x28, 0x12, 0x06, 0xeb, 0x01, 0x00, 0xef,
+    0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x28, 0x01, 0x12, 0x04, 0xeb, 0x01, 0x08, 0x1f, 0x0a,
+    0x0c, 0x0a, 0x04, 0x04, 0x28, 0x02, 0x00, 0x12, 0x04, 0xec, 0x01, 0x02, 0x69, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x28, 0x02, 0x00, 0x05, 0x12, 0x04, 0xec, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x28, 0x02, 0x00, 0x01, 0x12, 0x04, 0xec, 0x01, 0x09, 0x0e, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x28, 0x02, 0x00, 0x03, 0x12, 0x04, 0xec, 0x01, 0x13, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x28,
+    0x02, 0x00, 0x08, 0x12, 0x04, 0xec, 0x01, 0x15, 0x68, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x28, 0x02,
+    0x00, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x04, 0xec, 0x01, 0x16, 0x3d, 0x0a, 0x11, 0x0a, 0x09,
+    0x04, 0x28, 0x02, 0x00, 0x08, 0x87, 0x09, 0x0e, 0x03, 0x12, 0x04, 0xec, 0x01, 0x3f, 0x67, 0x0a,
+    0x0c, 0x0a, 0x04, 0x04, 0x28, 0x02, 0x01, 0x12, 0x04, 0xed, 0x01, 0x02, 0x41, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x28, 0x02, 0x01, 0x05, 0x12, 0x04, 0xed, 0x01, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x28, 0x02, 0x01, 0x01, 0x12, 0x04, 0xed, 0x01, 0x09, 0x0d, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x28, 0x02, 0x01, 0x03, 0x12, 0x04, 0xed, 0x01, 0x13, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x28,
+    0x02, 0x01, 0x08, 0x12, 0x04, 0xed, 0x01, 0x15, 0x40, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x28, 0x02,
+    0x01, 0x08, 0x87, 0x09, 0x0e, 0x03, 0x12, 0x04, 0xed, 0x01, 0x16, 0x3f, 0x0a, 0x0c, 0x0a, 0x04,
+    0x04, 0x28, 0x02, 0x02, 0x12, 0x04, 0xee, 0x01, 0x02, 0x15, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x28,
+    0x02, 0x02, 0x05, 0x12, 0x04, 0xee, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x28, 0x02,
+    0x02, 0x01, 0x12, 0x04, 0xee, 0x01, 0x09, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x28, 0x02, 0x02,
+    0x03, 0x12, 0x04, 0xee, 0x01, 0x13, 0x14, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x29, 0x12, 0x06, 0xf1,
+    0x01, 0x00, 0xf3, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x29, 0x01, 0x12, 0x04, 0xf1, 0x01,
+    0x08, 0x20, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x29, 0x02, 0x00, 0x12, 0x04, 0xf2, 0x01, 0x02, 0x1a,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x29, 0x02, 0x00, 0x05, 0x12, 0x04, 0xf2, 0x01, 0x02, 0x07, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x29, 0x02, 0x00, 0x01, 0x12, 0x04, 0xf2, 0x01, 0x08, 0x15, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x29, 0x02, 0x00, 0x03, 0x12, 0x04, 0xf2, 0x01, 0x18, 0x19, 0x0a, 0x0c, 0x0a,
+    0x02, 0x04, 0x2a, 0x12, 0x06, 0xf5, 0x01, 0x00, 0xfb, 0x01, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04,
+    0x2a, 0x01, 0x12, 0x04, 0xf5, 0x01, 0x08, 0x1f, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x2a, 0x02, 0x00,
+    0x12, 0x04, 0xf6, 0x01, 0x02, 0x3f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2a, 0x02, 0x00, 0x05, 0x12,
+    0x04, 0xf6, 0x01, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2a, 0x02, 0x00, 0x01, 0x12, 0x04,
+    0xf6, 0x01, 0x09, 0x16, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2a, 0x02, 0x00, 0x03, 0x12, 0x04, 0xf6,
+    0x01, 0x19, 0x1a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2a, 0x02, 0x00, 0x08, 0x12, 0x04, 0xf6, 0x01,
+    0x1b, 0x3e, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x2a, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12,
+    0x04, 0xf6, 0x01, 0x1c, 0x3d, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x2a, 0x02, 0x01, 0x12, 0x04, 0xf7,
+    0x01, 0x02, 0x6f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2a, 0x02, 0x01, 0x05, 0x12, 0x04, 0xf7, 0x01,
+    0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2a, 0x02, 0x01, 0x01, 0x12, 0x04, 0xf7, 0x01, 0x09,
+    0x0e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2a, 0x02, 0x01, 0x03, 0x12, 0x04, 0xf7, 0x01, 0x19, 0x1a,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2a, 0x02, 0x01, 0x08, 0x12, 0x04, 0xf7, 0x01, 0x1b, 0x6e, 0x0a,
+    0x11, 0x0a, 0x09, 0x04, 0x2a, 0x02, 0x01, 0x08, 0x87, 0x09, 0x0e, 0x02, 0x12, 0x04, 0xf7, 0x01,
+    0x1c, 0x43, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x2a, 0x02, 0x01, 0x08, 0x87, 0x09, 0x0e, 0x03, 0x12,
+    0x04, 0xf7, 0x01, 0x45, 0x6d, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x2a, 0x02, 0x02, 0x12, 0x04, 0xf8,
+    0x01, 0x02, 0x47, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2a, 0x02, 0x02, 0x05, 0x12, 0x04, 0xf8, 0x01,
+    0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2a, 0x02, 0x02, 0x01, 0x12, 0x04, 0xf8, 0x01, 0x09,
+    0x0d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2a, 0x02, 0x02, 0x03, 0x12, 0x04, 0xf8, 0x01, 0x19, 0x1a,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2a, 0x02, 0x02, 0x08, 0x12, 0x04, 0xf8, 0x01, 0x1b, 0x46, 0x0a,
+    0x11, 0x0a, 0x09, 0x04, 0x2a, 0x02, 0x02, 0x08, 0x87, 0x09, 0x0e, 0x03, 0x12, 0x04, 0xf8, 0x01,
+    0x1c, 0x45, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x2a, 0x02, 0x03, 0x12, 0x04, 0xf9, 0x01, 0x02, 0x1b,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2a, 0x02, 0x03, 0x05, 0x12, 0x04, 0xf9, 0x01, 0x02, 0x07, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x2a, 0x02, 0x03, 0x01, 0x12, 0x04, 0xf9, 0x01, 0x09, 0x10, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x2a, 0x02, 0x03, 0x03, 0x12, 0x04, 0xf9, 0x01, 0x19, 0x1a, 0x0a, 0x54, 0x0a,
+    0x04, 0x04, 0x2a, 0x02, 0x04, 0x12, 0x04, 0xfa, 0x01, 0x02, 0x1b, 0x22, 0x46, 0x20, 0xe5, 0xbf,
+    0x85, 0xe9, 0xa1, 0xbb, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0x88, 0xe9, 0x9b, 0x86, 0xe4, 0xb8, 0xad,
+    0xe7, 0x9a, 0x84, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x20, 0xe4, 0xb8, 0xba, 0x30, 0xe6, 0x97,
+    0xb6, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe5, 0x90, 0x88, 0xe9, 0x9b, 0x86, 0xe4, 0xb8, 0xad,
+    0xe7, 0x9a, 0x84, 0xe7, 0xac, 0xac, 0xe4, 0xb8, 0x80, 0xe7, 0xaf, 0x87, 0xe7, 0xac, 0x94, 0xe8,
+    0xae, 0xb0, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2a, 0x02, 0x04, 0x05, 0x12, 0x04, 0xfa, 0x01,
+    0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2a, 0x02, 0x04, 0x01, 0x12, 0x04, 0xfa, 0x01, 0x09,
+    0x16, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2a, 0x02, 0x04, 0x03, 0x12, 0x04, 0xfa, 0x01, 0x19, 0x1a,
+    0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x2b, 0x12, 0x04, 0xfd, 0x01, 0x00, 0x23, 0x0a, 0x0b, 0x0a, 0x03,
+    0x04, 0x2b, 0x01, 0x12, 0x04, 0xfd, 0x01, 0x08, 0x20, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x2c, 0x12,
+    0x06, 0xff, 0x01, 0x00, 0x81, 0x02, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x2c, 0x01, 0x12, 0x04,
+    0xff, 0x01, 0x08, 0x1f, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x2c, 0x02, 0x00, 0x12, 0x04, 0x80, 0x02,
+    0x02, 0x3e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2c, 0x02, 0x00, 0x05, 0x12, 0x04, 0x80, 0x02, 0x02,
+    0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2c, 0x02, 0x00, 0x01, 0x12, 0x04, 0x80, 0x02, 0x08, 0x15,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2c, 0x02, 0x00, 0x03, 0x12, 0x04, 0x80, 0x02, 0x18, 0x19, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x2c, 0x02, 0x00, 0x08, 0x12, 0x04, 0x80, 0x02, 0x1a, 0x3d, 0x0a, 0x11,
+    0x0a, 0x09, 0x04, 0x2c, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x04, 0x80, 0x02, 0x1b,
+    0x3c, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x2d, 0x12, 0x04, 0x83, 0x02, 0x00, 0x23, 0x0a, 0x0b, 0x0a,
+    0x03, 0x04, 0x2d, 0x01, 0x12, 0x04, 0x83, 0x02, 0x08, 0x20, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x2e,
+    0x12, 0x06, 0x85, 0x02, 0x00, 0x87, 0x02, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x2e, 0x01, 0x12,
+    0x04, 0x85, 0x02, 0x08, 0x1c, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x2e, 0x02, 0x00, 0x12, 0x04, 0x86,
+    0x02, 0x02, 0x3e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2e, 0x02, 0x00, 0x05, 0x12, 0x04, 0x86, 0x02,
+    0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2e, 0x02, 0x00, 0x01, 0x12, 0x04, 0x86, 0x02, 0x08,
+    0x15, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2e, 0x02, 0x00, 0x03, 0x12, 0x04, 0x86, 0x02, 0x18, 0x19,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2e, 0x02, 0x00, 0x08, 0x12, 0x04, 0x86, 0x02, 0x1a, 0x3d, 0x0a,
+    0x11, 0x0a, 0x09, 0x04, 0x2e, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x04, 0x86, 0x02,
+    0x1b, 0x3c, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x2f, 0x12, 0x06, 0x89, 0x02, 0x00, 0x8b, 0x02, 0x01,
+    0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x2f, 0x01, 0x12, 0x04, 0x89, 0x02, 0x08, 0x1d, 0x0a, 0x0c, 0x0a,
+    0x04, 0x04, 0x2f, 0x02, 0x00, 0x12, 0x04, 0x8a, 0x02, 0x02, 0x20, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x2f, 0x02, 0x00, 0x06, 0x12, 0x04, 0x8a, 0x02, 0x02, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2f,
+    0x02, 0x00, 0x01, 0x12, 0x04, 0x8a, 0x02, 0x11, 0x1b, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x2f, 0x02,
+    0x00, 0x03, 0x12, 0x04, 0x8a, 0x02, 0x1e, 0x1f, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x30, 0x12, 0x04,
+    0x8d, 0x02, 0x00, 0x21, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x30, 0x01, 0x12, 0x04, 0x8d, 0x02, 0x08,
+    0x1e, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x31, 0x12, 0x06, 0x8f, 0x02, 0x00, 0x91, 0x02, 0x01, 0x0a,
+    0x0b, 0x0a, 0x03, 0x04, 0x31, 0x01, 0x12, 0x04, 0x8f, 0x02, 0x08, 0x1f, 0x0a, 0x0c, 0x0a, 0x04,
+    0x04, 0x31, 0x02, 0x00, 0x12, 0x04, 0x90, 0x02, 0x02, 0x24, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x31,
+    0x02, 0x00, 0x04, 0x12, 0x04, 0x90, 0x02, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x31, 0x02,
+    0x00, 0x06, 0x12, 0x04, 0x90, 0x02, 0x0b, 0x19, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x31, 0x02, 0x00,
+    0x01, 0x12, 0x04, 0x90, 0x02, 0x1a, 0x1f, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x31, 0x02, 0x00, 0x03,
+    0x12, 0x04, 0x90, 0x02, 0x22, 0x23, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x32, 0x12, 0x06, 0x93, 0x02,
+    0x00, 0x97, 0x02, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x32, 0x01, 0x12, 0x04, 0x93, 0x02, 0x08,
+    0x21, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x32, 0x02, 0x00, 0x12, 0x04, 0x94, 0x02, 0x02, 0x47, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x32, 0x02, 0x00, 0x05, 0x12, 0x04, 0x94, 0x02, 0x02, 0x07, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x32, 0x02, 0x00, 0x01, 0x12, 0x04, 0x94, 0x02, 0x11, 0x1e, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x32, 0x02, 0x00, 0x03, 0x12, 0x04, 0x94, 0x02, 0x21, 0x22, 0x0a, 0x0d, 0x0a, 0x05,
+    0x04, 0x32, 0x02, 0x00, 0x08, 0x12, 0x04, 0x94, 0x02, 0x23, 0x46, 0x0a, 0x11, 0x0a, 0x09, 0x04,
+    0x32, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x04, 0x94, 0x02, 0x24, 0x45, 0x0a, 0x30,
+    0x0a, 0x04, 0x04, 0x32, 0x02, 0x01, 0x12, 0x06, 0x95, 0x02, 0x02, 0x96, 0x02, 0x53, 0x22, 0x20,
+    0x20, 0xe6, 0x8c, 0x89, 0xe9, 0xa1, 0xba, 0xe5, 0xba, 0x8f, 0xe8, 0xbf, 0xbd, 0xe5, 0x8a, 0xa0,
+    0xe5, 0x88, 0xb0, 0xe5, 0x90, 0x88, 0xe9, 0x9b, 0x86, 0xe6, 0x9c, 0xab, 0xe5, 0xb0, 0xbe, 0x0a,
+    0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x32, 0x02, 0x01, 0x04, 0x12, 0x04, 0x95, 0x02, 0x02, 0x0a, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x32, 0x02, 0x01, 0x05, 0x12, 0x04, 0x95, 0x02, 0x0b, 0x10, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x32, 0x02, 0x01, 0x01, 0x12, 0x04, 0x95, 0x02, 0x11, 0x19, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x32, 0x02, 0x01, 0x03, 0x12, 0x04, 0x95, 0x02, 0x21, 0x22, 0x0a, 0x0f, 0x0a, 0x05,
+    0x04, 0x32, 0x02, 0x01, 0x08, 0x12, 0x06, 0x95, 0x02, 0x23, 0x96, 0x02, 0x52, 0x0a, 0x11, 0x0a,
+    0x09, 0x04, 0x32, 0x02, 0x01, 0x08, 0x87, 0x09, 0x12, 0x01, 0x12, 0x04, 0x95, 0x02, 0x24, 0x50,
+    0x0a, 0x11, 0x0a, 0x09, 0x04, 0x32, 0x02, 0x01, 0x08, 0x87, 0x09, 0x12, 0x02, 0x12, 0x04, 0x96,
+    0x02, 0x24, 0x51, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x33, 0x12, 0x04, 0x99, 0x02, 0x00, 0x25, 0x0a,
+    0x0b, 0x0a, 0x03, 0x04, 0x33, 0x01, 0x12, 0x04, 0x99, 0x02, 0x08, 0x22, 0x0a, 0x0c, 0x0a, 0x02,
+    0x04, 0x34, 0x12, 0x06, 0x9b, 0x02, 0x00, 0x9f, 0x02, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x34,
+    0x01, 0x12, 0x04, 0x9b, 0x02, 0x08, 0x24, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x34, 0x02, 0x00, 0x12,
+    0x04, 0x9c, 0x02, 0x02, 0x47, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x34, 0x02, 0x00, 0x05, 0x12, 0x04,
+    0x9c, 0x02, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x34, 0x02, 0x00, 0x01, 0x12, 0x04, 0x9c,
+    0x02, 0x11, 0x1e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x34, 0x02, 0x00, 0x03, 0x12, 0x04, 0x9c, 0x02,
+    0x21, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x34, 0x02, 0x00, 0x08, 0x12, 0x04, 0x9c, 0x02, 0x23,
+    0x46, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x34, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x04,
+    0x9c, 0x02, 0x24, 0x45, 0x0a, 0x0e, 0x0a, 0x04, 0x04, 0x34, 0x02, 0x01, 0x12, 0x06, 0x9d, 0x02,
+    0x02, 0x9e, 0x02, 0x53, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x34, 0x02, 0x01, 0x04, 0x12, 0x04, 0x9d,
+    0x02, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x34, 0x02, 0x01, 0x05, 0x12, 0x04, 0x9d, 0x02,
+    0x0b, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x34, 0x02, 0x01, 0x01, 0x12, 0x04, 0x9d, 0x02, 0x11,
+    0x19, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x34, 0x02, 0x01, 0x03, 0x12, 0x04, 0x9d, 0x02, 0x21, 0x22,
+    0x0a, 0x0f, 0x0a, 0x05, 0x04, 0x34, 0x02, 0x01, 0x08, 0x12, 0x06, 0x9d, 0x02, 0x23, 0x9e, 0x02,
+    0x52, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x34, 0x02, 0x01, 0x08, 0x87, 0x09, 0x12, 0x01, 0x12, 0x04,
+    0x9d, 0x02, 0x24, 0x50, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x34, 0x02, 0x01, 0x08, 0x87, 0x09, 0x12,
+    0x02, 0x12, 0x04, 0x9e, 0x02, 0x24, 0x51, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x35, 0x12, 0x04, 0xa1,
+    0x02, 0x00, 0x28, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x35, 0x01, 0x12, 0x04, 0xa1, 0x02, 0x08, 0x25,
+    0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x36, 0x12, 0x06, 0xa3, 0x02, 0x00, 0xa6, 0x02, 0x01, 0x0a, 0x0b,
+    0x0a, 0x03, 0x04, 0x36, 0x01, 0x12, 0x04, 0xa3, 0x02, 0x08, 0x22, 0x0a, 0x0c, 0x0a, 0x04, 0x04,
+    0x36, 0x02, 0x00, 0x12, 0x04, 0xa4, 0x02, 0x02, 0x47, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x36, 0x02,
+    0x00, 0x05, 0x12, 0x04, 0xa4, 0x02, 0x02, 0x07, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x36, 0x02, 0x00,
+    0x01, 0x12, 0x04, 0xa4, 0x02, 0x11, 0x1e, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x36, 0x02, 0x00, 0x03,
+    0x12, 0x04, 0xa4, 0x02, 0x21, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x36, 0x02, 0x00, 0x08, 0x12,
+    0x04, 0xa4, 0x02, 0x23, 0x46, 0x0a, 0x11, 0x0a, 0x09, 0x04, 0x36, 0x02, 0x00, 0x08, 0x87, 0x09,
+    0x04, 0x04, 0x12, 0x04, 0xa4, 0x02, 0x24, 0x45, 0x0a, 0x31, 0x0a, 0x04, 0x04, 0x36, 0x02, 0x01,
+    0x12, 0x04, 0xa5, 0x02, 0x02, 0x23, 0x22, 0x23, 0x20, 0xe5, 0x90, 0x88, 0xe9, 0x9b, 0x86, 0xe4,
+    0xb8, 0xad, 0xe5, 0x85, 0xa8, 0xe9, 0x83, 0xa8, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe7, 0x9a,
+    0x84, 0xe6, 0x96, 0xb0, 0xe9, 0xa1, 0xba, 0xe5, 0xba, 0x8f, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04,
+    0x36, 0x02, 0x01, 0x04, 0x12, 0x04, 0xa5, 0x02, 0x02, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x36,
+    0x02, 0x01, 0x05, 0x12, 0x04, 0xa5, 0x02, 0x0b, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x36, 0x02,
+    0x01, 0x01, 0x12, 0x04, 0xa5, 0x02, 0x11, 0x19, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x36, 0x02, 0x01,
+    0x03, 0x12, 0x04, 0xa5, 0x02, 0x21, 0x22, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x37, 0x12, 0x04, 0xa8,
+    0x02, 0x00, 0x26, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x37, 0x01, 0x12, 0x04, 0xa8, 0x02, 0x08, 0x23,
+    0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x38, 0x12, 0x06, 0xaa, 0x02, 0x00, 0xac, 0x02, 0x01, 0x0a, 0x0b,
+    0x0a, 0x03, 0x04, 0x38, 0x01, 0x12, 0x04, 0xaa, 0x02, 0x08, 0x15, 0x0a, 0x0c, 0x0a, 0x04, 0x04,
+    0x38, 0x02, 0x00, 0x12, 0x04, 0xab, 0x02, 0x02, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x38, 0x02,
+    0x00, 0x05, 0x12, 0x04, 0xab, 0x02, 0x02, 0x08, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x38, 0x02, 0x00,
+    0x01, 0x12, 0x04, 0xab, 0x02, 0x09, 0x0d, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x38, 0x02, 0x00, 0x03,
+    0x12, 0x04, 0xab, 0x02, 0x10, 0x11, 0x0a, 0x0c, 0x0a, 0x02, 0x04, 0x39, 0x12, 0x06, 0xae, 0x02,
+    0x00, 0xb0, 0x02, 0x01, 0x0a, 0x0b, 0x0a, 0x03, 0x04, 0x39, 0x01, 0x12, 0x04, 0xae, 0x02, 0x08,
+    0x16, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x39, 0x02, 0x00, 0x12, 0x04, 0xaf, 0x02, 0x02, 0x0f, 0x0a,
+    0x0d, 0x0a, 0x05, 0x04, 0x39, 0x02, 0x00, 0x05, 0x12, 0x04, 0xaf, 0x02, 0x02, 0x07, 0x0a, 0x0d,
+    0x0a, 0x05, 0x04, 0x39, 0x02, 0x00, 0x01, 0x12, 0x04, 0xaf, 0x02, 0x08, 0x0a, 0x0a, 0x0d, 0x0a,
+    0x05, 0x04, 0x39, 0x02, 0x00, 0x03, 0x12, 0x04, 0xaf, 0x02, 0x0d, 0x0e, 0x0a, 0x78, 0x0a, 0x02,
+    0x06, 0x00, 0x12, 0x06, 0xb4, 0x02, 0x00, 0xff, 0x02, 0x01, 0x1a, 0x6a, 0x20, 0xe5, 0x92, 0x8c,
+    0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0xe7, 0x9b, 0xb8, 0xe5,
+    0x85, 0xb3, 0xe7, 0x9a, 0x84, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x0a, 0x20, 0xe6, 0xaf, 0x94,
+    0xe5, 0xa6, 0x82, 0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xef,
+    0xbc, 0x8c, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xef, 0xbc,
+    0x8c, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe7, 0xad, 0x89,
+    0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe7, 0x9a, 0x84, 0xe5,
+    0x8a, 0x9f, 0xe8, 0x83, 0xbd, 0x0a, 0x0a, 0x0b, 0x0a, 0x03, 0x06, 0x00, 0x01, 0x12, 0x04, 0xb4,
+    0x02, 0x08, 0x1a, 0x0a, 0x37, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x00, 0x12, 0x04, 0xb6, 0x02, 0x02,
+    0x4a, 0x1a, 0x29, 0x20, 0xe6, 0xa3, 0x80, 0xe6, 0x9f, 0xa5, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7,
+    0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe6, 0x8b, 0xa5, 0xe6, 0x9c, 0x89, 0xe6, 0x8c, 0x87, 0xe5,
+    0xae, 0x9a, 0xe7, 0x9a, 0x84, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x0a, 0x0a, 0x0d, 0x0a, 0x05,
+    0x06, 0x00, 0x02, 0x00, 0x01, 0x12, 0x04, 0xb6, 0x02, 0x06, 0x13, 0x0a, 0x0d, 0x0a, 0x05, 0x06,
+    0x00, 0x02, 0x00, 0x02, 0x12, 0x04, 0xb6, 0x02, 0x14, 0x28, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00,
+    0x02, 0x00, 0x03, 0x12, 0x04, 0xb6, 0x02, 0x33, 0x48, 0x0a, 0x28, 0x0a, 0x04, 0x06, 0x00, 0x02,
+    0x01, 0x12, 0x04, 0xb9, 0x02, 0x02, 0x44, 0x1a, 0x1a, 0x20, 0xe5, 0x88, 0xa4, 0xe6, 0x96, 0xad,
+    0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0xad, 0x98, 0xe5,
+    0x9c, 0xa8, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x01, 0x12, 0x04, 0xb9, 0x02,
+    0x06, 0x11, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x02, 0x12, 0x04, 0xb9, 0x02, 0x12,
+    0x24, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x03, 0x12, 0x04, 0xb9, 0x02, 0x2f, 0x42,
+    0x0a, 0x1c, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x02, 0x12, 0x04, 0xbc, 0x02, 0x02, 0x41, 0x1a, 0x0e,
+    0x20, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x0a, 0x0a, 0x0d,
+    0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x01, 0x12, 0x04, 0xbc, 0x02, 0x06, 0x10, 0x0a, 0x0d, 0x0a,
+    0x05, 0x06, 0x00, 0x02, 0x02, 0x02, 0x12, 0x04, 0xbc, 0x02, 0x11, 0x22, 0x0a, 0x0d, 0x0a, 0x05,
+    0x06, 0x00, 0x02, 0x02, 0x03, 0x12, 0x04, 0xbc, 0x02, 0x2d, 0x3f, 0x0a, 0x1c, 0x0a, 0x04, 0x06,
+    0x00, 0x02, 0x03, 0x12, 0x04, 0xbf, 0x02, 0x02, 0x41, 0x1a, 0x0e, 0x20, 0xe6, 0x9b, 0xb4, 0xe6,
+    0x96, 0xb0, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02,
+    0x03, 0x01, 0x12, 0x04, 0xbf, 0x02, 0x06, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x03,
+    0x02, 0x12, 0x04, 0xbf, 0x02, 0x11, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x03, 0x03,
+    0x12, 0x04, 0xbf, 0x02, 0x2d, 0x3f, 0x0a, 0x60, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x04, 0x12, 0x04,
+    0xc2, 0x02, 0x02, 0x41, 0x1a, 0x52, 0x20, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe7, 0xac, 0x94,
+    0xe8, 0xae, 0xb0, 0x20, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe5, 0x90, 0x8e, 0xe7, 0x9a, 0x84,
+    0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe8, 0xbf, 0x9b, 0xe5, 0x85, 0xa5, 0xe5, 0x9b, 0x9e, 0xe6,
+    0x94, 0xb6, 0xe7, 0xab, 0x99, 0x20, 0xe8, 0xb6, 0x85, 0xe8, 0xbf, 0x87, 0xe4, 0xbf, 0x9d, 0xe7,
+    0x95, 0x99, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe5, 0x90, 0x8e, 0xe5, 0xbd, 0xbb, 0xe5, 0xba,
+    0x95, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x04,
+    0x01, 0x12, 0x04, 0xc2, 0x02, 0x06, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x04, 0x02,
+    0x12, 0x04, 0xc2, 0x02, 0x11, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x04, 0x03, 0x12,
+    0x04, 0xc2, 0x02, 0x2d, 0x3f, 0x0a, 0x25, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x05, 0x12, 0x04, 0xc5,
+    0x02, 0x02, 0x38, 0x1a, 0x17, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe7, 0xac, 0x94, 0xe8,
+    0xae, 0xb0, 0xe7, 0x9a, 0x84, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x0a, 0x0a, 0x0d, 0x0a, 0x05,
+    0x06, 0x00, 0x02, 0x05, 0x01, 0x12, 0x04, 0xc5, 0x02, 0x06, 0x0d, 0x0a, 0x0d, 0x0a, 0x05, 0x06,
+    0x00, 0x02, 0x05, 0x02, 0x12, 0x04, 0xc5, 0x02, 0x0e, 0x1c, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00,
+    0x02, 0x05, 0x03, 0x12, 0x04, 0xc5, 0x02, 0x27, 0x36, 0x0a, 0x1c, 0x0a, 0x04, 0x06, 0x00, 0x02,
+    0x06, 0x12, 0x04, 0xc8, 0x02, 0x02, 0x3b, 0x1a, 0x0e, 0x20, 0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba,
+    0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x06, 0x01,
+    0x12, 0x04, 0xc8, 0x02, 0x06, 0x0e, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x06, 0x02, 0x12,
+    0x04, 0xc8, 0x02, 0x0f, 0x1e, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x06, 0x03, 0x12, 0x04,
+    0xc8, 0x02, 0x29, 0x39, 0x0a, 0x22, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x07, 0x12, 0x04, 0xcb, 0x02,
+    0x02, 0x47, 0x1a, 0x14, 0x20, 0xe5, 0x88, 0x86, 0xe9, 0xa1, 0xb5, 0xe5, 0x88, 0x97, 0xe5, 0x87,
+    0xba, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x07,
+    0x01, 0x12, 0x04, 0xcb, 0x02, 0x06, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x07, 0x02,
+    0x12, 0x04, 0xcb, 0x02, 0x13, 0x26, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x07, 0x03, 0x12,
+    0x04, 0xcb, 0x02, 0x31, 0x45, 0x0a, 0x28, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x08, 0x12, 0x04, 0xce,
+    0x02, 0x02, 0x4d, 0x1a, 0x1a, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe7, 0x94, 0xa8, 0xe6,
+    0x88, 0xb7, 0xe6, 0x8a, 0x95, 0xe7, 0xa8, 0xbf, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x0a, 0x0a,
+    0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x08, 0x01, 0x12, 0x04, 0xce, 0x02, 0x06, 0x14, 0x0a, 0x0d,
+    0x0a, 0x05, 0x06, 0x00, 0x02, 0x08, 0x02, 0x12, 0x04, 0xce, 0x02, 0x15, 0x2a, 0x0a, 0x0d, 0x0a,
+    0x05, 0x06, 0x00, 0x02, 0x08, 0x03, 0x12, 0x04, 0xce, 0x02, 0x35, 0x4b, 0x0a, 0x1c, 0x0a, 0x04,
+    0x06, 0x00, 0x02, 0x09, 0x12, 0x04, 0xd1, 0x02, 0x02, 0x35, 0x1a, 0x0e, 0x20, 0xe6, 0x96, 0xb0,
+    0xe5, 0xa2, 0x9e, 0xe6, 0xa0, 0x87, 0xe7, 0xad, 0xbe, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00,
+    0x02, 0x09, 0x01, 0x12, 0x04, 0xd1, 0x02, 0x06, 0x0c, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02,
+    0x09, 0x02, 0x12, 0x04, 0xd1, 0x02, 0x0d, 0x1a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x09,
+    0x03, 0x12, 0x04, 0xd1, 0x02, 0x25, 0x33, 0x0a, 0x1c, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x0a, 0x12,
+    0x04, 0xd4, 0x02, 0x02, 0x41, 0x1a, 0x0e, 0x20, 0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba, 0xe8, 0x8d,
+    0x89, 0xe7, 0xa8, 0xbf, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0a, 0x01, 0x12, 0x04,
+    0xd4, 0x02, 0x06, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0a, 0x02, 0x12, 0x04, 0xd4,
+    0x02, 0x11, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0a, 0x03, 0x12, 0x04, 0xd4, 0x02,
+    0x2d, 0x3f, 0x0a, 0x50, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x0b, 0x12, 0x04, 0xd7, 0x02, 0x02, 0x47,
+    0x1a, 0x42, 0x20, 0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83, 0xe8, 0x8d, 0x89, 0xe7, 0xa8, 0xbf, 0x20,
+    0xe4, 0xb9, 0x9f, 0xe5, 0x8f, 0xaf, 0xe7, 0x94, 0xa8, 0xe4, 0xba, 0x8e, 0xe4, 0xbf, 0xae, 0xe6,
+    0x94, 0xb9, 0xe5, 0xae, 0x9a, 0xe6, 0x97, 0xb6, 0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83, 0xe7, 0xac,
+    0x94, 0xe8, 0xae, 0xb0, 0xe7, 0x9a, 0x84, 0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83, 0xe6, 0x97, 0xb6,
+    0xe9, 0x97, 0xb4, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0b, 0x01, 0x12, 0x04, 0xd7,
+    0x02, 0x06, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0b, 0x02, 0x12, 0x04, 0xd7, 0x02,
+    0x13, 0x26, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0b, 0x03, 0x12, 0x04, 0xd7, 0x02, 0x31,
+    0x45, 0x0a, 0x2b, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x0c, 0x12, 0x04, 0xda, 0x02, 0x02, 0x56, 0x1a,
+    0x1d, 0x20, 0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe7, 0x9a,
+    0x84, 0xe4, 0xbf, 0xae, 0xe8, 0xae, 0xa2, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x0a, 0x0a, 0x0d,
+    0x0a, 0x05, 0x06, 0x00, 0x02, 0x0c, 0x01, 0x12, 0x04, 0xda, 0x02, 0x06, 0x17, 0x0a, 0x0d, 0x0a,
+    0x05, 0x06, 0x00, 0x02, 0x0c, 0x02, 0x12, 0x04, 0xda, 0x02, 0x18, 0x30, 0x0a, 0x0d, 0x0a, 0x05,
+    0x06, 0x00, 0x02, 0x0c, 0x03, 0x12, 0x04, 0xda, 0x02, 0x3b, 0x54, 0x0a, 0x31, 0x0a, 0x04, 0x06,
+    0x00, 0x02, 0x0d, 0x12, 0x04, 0xdd, 0x02, 0x02, 0x50, 0x1a, 0x23, 0x20, 0xe8, 0x8e, 0xb7, 0xe5,
+    0x8f, 0x96, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe7, 0x9a, 0x84, 0xe6, 0x9f, 0x90, 0xe4, 0xb8,
+    0xaa, 0xe4, 0xbf, 0xae, 0xe8, 0xae, 0xa2, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x0a, 0x0a, 0x0d,
+    0x0a, 0x05, 0x06, 0x00, 0x02, 0x0d, 0x01, 0x12, 0x04, 0xdd, 0x02, 0x06, 0x15, 0x0a, 0x0d, 0x0a,
+    0x05, 0x06, 0x00, 0x02, 0x0d, 0x02, 0x12, 0x04, 0xdd, 0x02, 0x16, 0x2c, 0x0a, 0x0d, 0x0a, 0x05,
+    0x06, 0x00, 0x02, 0x0d, 0x03, 0x12, 0x04, 0xdd, 0x02, 0x37, 0x4e, 0x0a, 0x56, 0x0a, 0x04, 0x06,
+    0x00, 0x02, 0x0e, 0x12, 0x04, 0xe0, 0x02, 0x02, 0x5c, 0x1a, 0x48, 0x20, 0xe5, 0xb0, 0x86, 0xe7,
+    0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe6, 0x81, 0xa2, 0xe5, 0xa4, 0x8d, 0xe5, 0x88, 0xb0, 0xe6, 0x9f,
+    0x90, 0xe4, 0xb8, 0xaa, 0xe4, 0xbf, 0xae, 0xe8, 0xae, 0xa2, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac,
+    0x20, 0xe6, 0x81, 0xa2, 0xe5, 0xa4, 0x8d, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe4, 0xbc, 0x9a,
+    0xe7, 0x94, 0x9f, 0xe6, 0x88, 0x90, 0xe6, 0x96, 0xb0, 0xe7, 0x9a, 0x84, 0xe7, 0x89, 0x88, 0xe6,
+    0x9c, 0xac, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0e, 0x01, 0x12, 0x04, 0xe0, 0x02,
+    0x06, 0x19, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0e, 0x02, 0x12, 0x04, 0xe0, 0x02, 0x1a,
+    0x34, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0e, 0x03, 0x12, 0x04, 0xe0, 0x02, 0x3f, 0x5a,
+    0x0a, 0x2b, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x0f, 0x12, 0x04, 0xe3, 0x02, 0x02, 0x53, 0x1a, 0x1d,
+    0x20, 0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba, 0xe5, 0x9b, 0x9e, 0xe6, 0x94, 0xb6, 0xe7, 0xab, 0x99,
+    0xe4, 0xb8, 0xad, 0xe7, 0x9a, 0x84, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x0a, 0x0a, 0x0d, 0x0a,
+    0x05, 0x06, 0x00, 0x02, 0x0f, 0x01, 0x12, 0x04, 0xe3, 0x02, 0x06, 0x16, 0x0a, 0x0d, 0x0a, 0x05,
+    0x06, 0x00, 0x02, 0x0f, 0x02, 0x12, 0x04, 0xe3, 0x02, 0x17, 0x2e, 0x0a, 0x0d, 0x0a, 0x05, 0x06,
+    0x00, 0x02, 0x0f, 0x03, 0x12, 0x04, 0xe3, 0x02, 0x39, 0x51, 0x0a, 0x2b, 0x0a, 0x04, 0x06, 0x00,
+    0x02, 0x10, 0x12, 0x04, 0xe6, 0x02, 0x02, 0x44, 0x1a, 0x1d, 0x20, 0xe4, 0xbb, 0x8e, 0xe5, 0x9b,
+    0x9e, 0xe6, 0x94, 0xb6, 0xe7, 0xab, 0x99, 0xe4, 0xb8, 0xad, 0xe6, 0x81, 0xa2, 0xe5, 0xa4, 0x8d,
+    0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x10, 0x01,
+    0x12, 0x04, 0xe6, 0x02, 0x06, 0x11, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x10, 0x02, 0x12,
+    0x04, 0xe6, 0x02, 0x12, 0x24, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x10, 0x03, 0x12, 0x04,
+    0xe6, 0x02, 0x2f, 0x42, 0x0a, 0x1c, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x11, 0x12, 0x04, 0xe9, 0x02,
+    0x02, 0x53, 0x1a, 0x0e, 0x20, 0xe6, 0x96, 0xb0, 0xe5, 0xbb, 0xba, 0xe5, 0x90, 0x88, 0xe9, 0x9b,
+    0x86, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x11, 0x01, 0x12, 0x04, 0xe9, 0x02, 0x06,
+    0x16, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x11, 0x02, 0x12, 0x04, 0xe9, 0x02, 0x17, 0x2e,
+    0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x11, 0x03, 0x12, 0x04, 0xe9, 0x02, 0x39, 0x51, 0x0a,
+    0x22, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x12, 0x12, 0x04, 0xec, 0x02, 0x02, 0x53, 0x1a, 0x14, 0x20,
+    0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe5, 0x90, 0x88, 0xe9, 0x9b, 0x86, 0xe4, 0xbf, 0xa1, 0xe6,
+    0x81, 0xaf, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x12, 0x01, 0x12, 0x04, 0xec, 0x02,
+    0x06, 0x16, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x12, 0x02, 0x12, 0x04, 0xec, 0x02, 0x17,
+    0x2e, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x12, 0x03, 0x12, 0x04, 0xec, 0x02, 0x39, 0x51,
+    0x0a, 0x3b, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x13, 0x12, 0x04, 0xef, 0x02, 0x02, 0x53, 0x1a, 0x2d,
+    0x20, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe5, 0x90, 0x88, 0xe9, 0x9b, 0x86, 0x20, 0xe5, 0x90,
+    0x88, 0xe9, 0x9b, 0x86, 0xe4, 0xb8, 0xad, 0xe7, 0x9a, 0x84, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0,
+    0xe4, 0xb8, 0x8d, 0xe5, 0x8f, 0x97, 0xe5, 0xbd, 0xb1, 0xe5, 0x93, 0x8d, 0x0a, 0x0a, 0x0d, 0x0a,
+    0x05, 0x06, 0x00, 0x02, 0x13, 0x01, 0x12, 0x04, 0xef, 0x02, 0x06, 0x16, 0x0a, 0x0d, 0x0a, 0x05,
+    0x06, 0x00, 0x02, 0x13, 0x02, 0x12, 0x04, 0xef, 0x02, 0x17, 0x2e, 0x0a, 0x0d, 0x0a, 0x05, 0x06,
+    0x00, 0x02, 0x13, 0x03, 0x12, 0x04, 0xef, 0x02, 0x39, 0x51, 0x0a, 0x22, 0x0a, 0x04, 0x06, 0x00,
+    0x02, 0x14, 0x12, 0x04, 0xf2, 0x02, 0x02, 0x4a, 0x1a, 0x14, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f,
+    0x96, 0xe5, 0x90, 0x88, 0xe9, 0x9b, 0x86, 0xe8, 0xaf, 0xa6, 0xe6, 0x83, 0x85, 0x0a, 0x0a, 0x0d,
+    0x0a, 0x05, 0x06, 0x00, 0x02, 0x14, 0x01, 0x12, 0x04, 0xf2, 0x02, 0x06, 0x13, 0x0a, 0x0d, 0x0a,
+    0x05, 0x06, 0x00, 0x02, 0x14, 0x02, 0x12, 0x04, 0xf2, 0x02, 0x14, 0x28, 0x0a, 0x0d, 0x0a, 0x05,
+    0x06, 0x00, 0x02, 0x14, 0x03, 0x12, 0x04, 0xf2, 0x02, 0x33, 0x48, 0x0a, 0x2b, 0x0a, 0x04, 0x06,
+    0x00, 0x02, 0x15, 0x12, 0x04, 0xf5, 0x02, 0x02, 0x50, 0x1a, 0x1d, 0x20, 0xe5, 0x88, 0x97, 0xe5,
+    0x87, 0xba, 0xe8, 0x87, 0xaa, 0xe5, 0xb7, 0xb1, 0xe7, 0x9a, 0x84, 0xe5, 0x85, 0xa8, 0xe9, 0x83,
+    0xa8, 0xe5, 0x90, 0x88, 0xe9, 0x9b, 0x86, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x15,
+    0x01, 0x12, 0x04, 0xf5, 0x02, 0x06, 0x15, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x15, 0x02,
+    0x12, 0x04, 0xf5, 0x02, 0x16, 0x2c, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x15, 0x03, 0x12,
+    0x04, 0xf5, 0x02, 0x37, 0x4e, 0x0a, 0x25, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x16, 0x12, 0x04, 0xf8,
+    0x02, 0x02, 0x59, 0x1a, 0x17, 0x20, 0xe6, 0xb7, 0xbb, 0xe5, 0x8a, 0xa0, 0xe7, 0xac, 0x94, 0xe8,
+    0xae, 0xb0, 0xe5, 0x88, 0xb0, 0xe5, 0x90, 0x88, 0xe9, 0x9b, 0x86, 0x0a, 0x0a, 0x0d, 0x0a, 0x05,
+    0x06, 0x00, 0x02, 0x16, 0x01, 0x12, 0x04, 0xf8, 0x02, 0x06, 0x18, 0x0a, 0x0d, 0x0a, 0x05, 0x06,
+    0x00, 0x02, 0x16, 0x02, 0x12, 0x04, 0xf8, 0x02, 0x19, 0x32, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00,
+    0x02, 0x16, 0x03, 0x12, 0x04, 0xf8, 0x02, 0x3d, 0x57, 0x0a, 0x28, 0x0a, 0x04, 0x06, 0x00, 0x02,
+    0x17, 0x12, 0x04, 0xfb, 0x02, 0x02, 0x62, 0x1a, 0x1a, 0x20, 0xe4, 0xbb, 0x8e, 0xe5, 0x90, 0x88,
+    0xe9, 0x9b, 0x86, 0xe4, 0xb8, 0xad, 0xe7, 0xa7, 0xbb, 0xe9, 0x99, 0xa4, 0xe7, 0xac, 0x94, 0xe8,
+    0xae, 0xb0, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x17, 0x01, 0x12, 0x04, 0xfb, 0x02,
+    0x06, 0x1b, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x17, 0x02, 0x12, 0x04, 0xfb, 0x02, 0x1c,
+    0x38, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x17, 0x03, 0x12, 0x04, 0xfb, 0x02, 0x43, 0x60,
+    0x0a, 0x2e, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x18, 0x12, 0x04, 0xfe, 0x02, 0x02, 0x5c, 0x1a, 0x20,
+    0x20, 0xe8, 0xb0, 0x83, 0xe6, 0x95, 0xb4, 0xe5, 0x90, 0x88, 0xe9, 0x9b, 0x86, 0xe4, 0xb8, 0xad,
+    0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe7, 0x9a, 0x84, 0xe9, 0xa1, 0xba, 0xe5, 0xba, 0x8f, 0x0a,
+    0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x18, 0x01, 0x12, 0x04, 0xfe, 0x02, 0x06, 0x19, 0x0a,
+    0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x18, 0x02, 0x12, 0x04, 0xfe, 0x02, 0x1a, 0x34, 0x0a, 0x0d,
+    0x0a, 0x05, 0x06, 0x00, 0x02, 0x18, 0x03, 0x12, 0x04, 0xfe, 0x02, 0x3f, 0x5a, 0x62, 0x06, 0x70,
+    0x72, 0x6f, 0x74, 0x6f, 0x33, 0x0a, 0xd4, 0x31, 0x0a, 0x1e, 0x6e, 0x6f, 0x74, 0x65, 0x2f, 0x61,
+    0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61,
+    0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+    0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+    0x74, 0x6f, 0x1a, 0x16, 0x6e, 0x6f, 0x74, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+    0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc8, 0x01, 0x0a, 0x0f, 0x4c,
+    0x69, 0x6b, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
+    0x0a, 0x07, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
+    0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64,
+    0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75,
+    0x69, 0x64, 0x12, 0x44, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+    0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+    0x65, 0x73, 0x74, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f,
+    0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3b, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72,
+    0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49,
+    0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x44, 0x4f, 0x5f, 0x4c, 0x49, 0x4b, 0x45, 0x10, 0x00, 0x12, 0x15,
+    0x0a, 0x11, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x4f, 0x5f, 0x4c,
+    0x49, 0x4b, 0x45, 0x10, 0x01, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69, 0x6b, 0x65, 0x4e, 0x6f, 0x74,
+    0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x0a, 0x13, 0x47, 0x65, 0x74,
+    0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+    0x12, 0x20, 0x0a, 0x07, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+    0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x65,
+    0x49, 0x64, 0x22, 0x45, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6b,
+    0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f,
+    0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x6f, 0x74,
+    0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+    0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x22, 0x50, 0x0a, 0x1a, 0x43, 0x68, 0x65,
+    0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+    0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x6e, 0x6f, 0x74, 0x65, 0x5f,
+    0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20,
+    0x00, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64,
+    0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x33, 0x0a, 0x1b, 0x43,
+    0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74,
+    0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+    0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x64,
+    0x22, 0x5b, 0x0a, 0x0f, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74,
+    0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+    0x28, 0x08, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d,
+    0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f,
+    0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x69, 0x65, 0x77, 0x73,
+    0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x69, 0x65, 0x77, 0x73, 0x22, 0x33, 0x0a,
+    0x0a, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x08, 0x6e,
+    0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x42, 0x0a, 0xba,
+    0x48, 0x07, 0x92, 0x01, 0x04, 0x08, 0x01, 0x10, 0x32, 0x52, 0x07, 0x6e, 0x6f, 0x74, 0x65, 0x49,
+    0x64, 0x73, 0x22, 0xdb, 0x01, 0x0a, 0x1f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63,
+    0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+    0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x62, 0x0a, 0x08, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+    0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e,
+    0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63,
+    0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+    0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x45,
+    0x6e, 0x74, 0x72, 0x79, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x9a, 0x01, 0x04, 0x08, 0x01, 0x10, 0x32,
+    0x52, 0x08, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x54, 0x0a, 0x0d, 0x4d, 0x61,
+    0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+    0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a,
+    0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e,
+    0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x49,
+    0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+    0x22, 0x3b, 0x0a, 0x0a, 0x4c, 0x69, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17,
     0x0a, 0x07, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-    0x06, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73,
-    0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x22, 0x50, 0x0a,
-    0x1a, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x53, 0x74,
-    0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x6e,
-    0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48,
-    0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x10, 0x0a,
-    0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22,
-    0x33, 0x0a, 0x1b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65,
-    0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
-    0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c,
-    0x69, 0x6b, 0x65, 0x64, 0x22, 0x45, 0x0a, 0x0f, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65,
-    0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x64,
-    0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x12, 0x1c, 0x0a,
-    0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-    0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x22, 0x33, 0x0a, 0x0a, 0x4e,
-    0x6f, 0x74, 0x65, 0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x08, 0x6e, 0x6f, 0x74,
-    0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07,
-    0x92, 0x01, 0x04, 0x08, 0x01, 0x10, 0x32, 0x52, 0x07, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x73,
-    0x22, 0xdb, 0x01, 0x0a, 0x1f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55,
-    0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
-    0x75, 0x65, 0x73, 0x74, 0x12, 0x62, 0x0a, 0x08, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73,
-    0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70,
-    0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55,
-    0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
-    0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74,
-    0x72, 0x79, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x9a, 0x01, 0x04, 0x08, 0x01, 0x10, 0x32, 0x52, 0x08,
-    0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x54, 0x0a, 0x0d, 0x4d, 0x61, 0x70, 0x70,
-    0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-    0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76,
-    0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x6f, 0x74,
-    0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x4c,
-    0x69, 0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3b,
-    0x0a, 0x0a, 0x4c, 0x69, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x07,
-    0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e,
-    0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x02,
-    0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x22, 0x3d, 0x0a, 0x0e, 0x4c,
-    0x69, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x0a,
-    0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x6f,
-    0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x53, 0x74,
-    0x61, 0x74, 0x75, 0x73, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0xd1, 0x01, 0x0a, 0x20, 0x42,
-    0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b,
-    0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-    0x54, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-    0x32, 0x3a, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42,
-    0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b,
-    0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
-    0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x72, 0x65,
-    0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x57, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
-    0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-    0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-    0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70,
-    0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4c,
-    0x69, 0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4f,
-    0x0a, 0x19, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63,
-    0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x6e,
-    0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48,
-    0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x10, 0x0a,
-    0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22,
-    0x5c, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61,
-    0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a,
-    0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-    0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-    0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-    0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5e, 0x0a,
-    0x1c, 0x50, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b,
-    0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
-    0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12,
-    0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-    0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-    0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8c, 0x01,
-    0x0a, 0x1d, 0x50, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69,
-    0x6b, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-    0x2f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
-    0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65,
-    0x64, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
-    0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01,
-    0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e,
-    0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-    0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x32, 0xed, 0x04, 0x0a,
-    0x13, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x53, 0x65, 0x72,
-    0x76, 0x69, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x08, 0x4c, 0x69, 0x6b, 0x65, 0x4e, 0x6f, 0x74, 0x65,
-    0x12, 0x1c, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-    0x69, 0x6b, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+    0x06, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x64,
+    0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x22, 0x3d, 0x0a,
+    0x0e, 0x4c, 0x69, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12,
+    0x2b, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+    0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6b, 0x65,
+    0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0xd1, 0x01, 0x0a,
+    0x20, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c,
+    0x69, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+    0x65, 0x12, 0x54, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+    0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+    0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c,
+    0x69, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+    0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+    0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x57, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x75, 0x6c,
+    0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+    0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+    0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e,
+    0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+    0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+    0x22, 0x4f, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72,
+    0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
+    0x07, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07,
+    0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12,
+    0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69,
+    0x64, 0x22, 0x5c, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65,
+    0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+    0x3e, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+    0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+    0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69,
+    0x6f, 0x6e, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+    0x5e, 0x0a, 0x1c, 0x50, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c,
+    0x69, 0x6b, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+    0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69,
+    0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+    0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+    0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+    0x8c, 0x01, 0x0a, 0x1d, 0x50, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
+    0x4c, 0x69, 0x6b, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+    0x65, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+    0x32, 0x19, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+    0x65, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65,
+    0x6d, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x02,
+    0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x12, 0x1f, 0x0a,
+    0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+    0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x3f,
+    0x0a, 0x16, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x56, 0x69, 0x65, 0x77,
+    0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x65,
+    0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x92,
+    0x01, 0x04, 0x08, 0x01, 0x10, 0x32, 0x52, 0x07, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x73, 0x22,
+    0x19, 0x0a, 0x17, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x56, 0x69, 0x65,
+    0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xcb, 0x05, 0x0a, 0x13, 0x4e,
+    0x6f, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+    0x63, 0x65, 0x12, 0x47, 0x0a, 0x08, 0x4c, 0x69, 0x6b, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x1c,
     0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6b,
-    0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a,
-    0x0c, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x20, 0x2e,
+    0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6e,
+    0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x4e,
+    0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0c, 0x47,
+    0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x6e, 0x6f,
+    0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74,
+    0x65, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
     0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e,
-    0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-    0x21, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-    0x74, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-    0x73, 0x65, 0x12, 0x68, 0x0a, 0x13, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c,
-    0x69, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x2e, 0x6e, 0x6f, 0x74, 0x65,
-    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65,
-    0x72, 0x4c, 0x69, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-    0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-    0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x53, 0x74,
-    0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x18,
-    0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69,
-    0x6b, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e,
-    0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63,
-    0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-    0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70,
+    0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+    0x12, 0x68, 0x0a, 0x13, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b,
+    0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c,
+    0x69, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+    0x1a, 0x28, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+    0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74,
+    0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x18, 0x42, 0x61,
+    0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65,
+    0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70,
     0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55,
-    0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
-    0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65,
-    0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x6e, 0x6f,
-    0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74,
-    0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-    0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-    0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63,
-    0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x15,
-    0x50, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65,
-    0x64, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x29, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69,
-    0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
-    0x4c, 0x69, 0x6b, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-    0x1a, 0x2a, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-    0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x64,
-    0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xaa, 0x01, 0x0a,
-    0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-    0x42, 0x11, 0x4e, 0x6f, 0x74, 0x65, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x50, 0x72,
-    0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-    0x6d, 0x2f, 0x72, 0x79, 0x61, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f,
-    0x77, 0x68, 0x69, 0x6d, 0x65, 0x72, 0x2f, 0x69, 0x64, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67,
-    0x6f, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0xa2, 0x02, 0x03,
-    0x4e, 0x41, 0x58, 0xaa, 0x02, 0x0b, 0x4e, 0x6f, 0x74, 0x65, 0x2e, 0x41, 0x70, 0x69, 0x2e, 0x56,
-    0x31, 0xca, 0x02, 0x0b, 0x4e, 0x6f, 0x74, 0x65, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0xe2,
-    0x02, 0x17, 0x4e, 0x6f, 0x74, 0x65, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
-    0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x4e, 0x6f, 0x74, 0x65,
-    0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a, 0x3a, 0x56, 0x31, 0x4a, 0xaf, 0x19, 0x0a, 0x06, 0x12, 0x04,
-    0x00, 0x00, 0x6a, 0x01, 0x0a, 0x08, 0x0a, 0x01, 0x0c, 0x12, 0x03, 0x00, 0x00, 0x12, 0x0a, 0x08,
-    0x0a, 0x01, 0x02, 0x12, 0x03, 0x02, 0x00, 0x14, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x00, 0x12, 0x03,
-    0x04, 0x00, 0x25, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x01, 0x12, 0x03, 0x05, 0x00, 0x20, 0x0a, 0x08,
-    0x0a, 0x01, 0x08, 0x12, 0x03, 0x07, 0x00, 0x4d, 0x0a, 0x09, 0x0a, 0x02, 0x08, 0x0b, 0x12, 0x03,
-    0x07, 0x00, 0x4d, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x00, 0x12, 0x04, 0x09, 0x00, 0x11, 0x01, 0x0a,
-    0x0a, 0x0a, 0x03, 0x04, 0x00, 0x01, 0x12, 0x03, 0x09, 0x08, 0x17, 0x0a, 0x17, 0x0a, 0x04, 0x04,
-    0x00, 0x02, 0x00, 0x12, 0x03, 0x0a, 0x02, 0x38, 0x22, 0x0a, 0x20, 0xe7, 0xac, 0x94, 0xe8, 0xae,
-    0xb0, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x05, 0x12, 0x03, 0x0a,
-    0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x0a, 0x08, 0x0f,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x0a, 0x12, 0x13, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x08, 0x12, 0x03, 0x0a, 0x14, 0x37, 0x0a, 0x10, 0x0a, 0x09,
-    0x04, 0x00, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x03, 0x0a, 0x15, 0x36, 0x0a, 0x26,
-    0x0a, 0x04, 0x04, 0x00, 0x02, 0x01, 0x12, 0x03, 0x0b, 0x02, 0x14, 0x22, 0x19, 0x20, 0xe6, 0x89,
-    0xa7, 0xe8, 0xa1, 0x8c, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0xe7, 0x9a, 0x84, 0xe7, 0x94, 0xa8,
-    0xe6, 0x88, 0xb7, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x05, 0x12,
-    0x03, 0x0b, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x0b,
-    0x08, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x03, 0x12, 0x03, 0x0b, 0x12, 0x13,
-    0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x00, 0x04, 0x00, 0x12, 0x04, 0x0c, 0x02, 0x0f, 0x03, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x00, 0x04, 0x00, 0x01, 0x12, 0x03, 0x0c, 0x07, 0x10, 0x0a, 0x1d, 0x0a, 0x06,
-    0x04, 0x00, 0x04, 0x00, 0x02, 0x00, 0x12, 0x03, 0x0d, 0x04, 0x1c, 0x22, 0x0e, 0x20, 0xe5, 0x8f,
-    0x96, 0xe6, 0xb6, 0x88, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0x0a, 0x0a, 0x0e, 0x0a, 0x07, 0x04,
-    0x00, 0x04, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x0d, 0x04, 0x17, 0x0a, 0x0e, 0x0a, 0x07, 0x04,
-    0x00, 0x04, 0x00, 0x02, 0x00, 0x02, 0x12, 0x03, 0x0d, 0x1a, 0x1b, 0x0a, 0x17, 0x0a, 0x06, 0x04,
-    0x00, 0x04, 0x00, 0x02, 0x01, 0x12, 0x03, 0x0e, 0x04, 0x1c, 0x22, 0x08, 0x20, 0xe7, 0x82, 0xb9,
-    0xe8, 0xb5, 0x9e, 0x0a, 0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x00, 0x04, 0x00, 0x02, 0x01, 0x01, 0x12,
-    0x03, 0x0e, 0x04, 0x15, 0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x00, 0x04, 0x00, 0x02, 0x01, 0x02, 0x12,
-    0x03, 0x0e, 0x1a, 0x1b, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x02, 0x12, 0x03, 0x10, 0x02,
-    0x1a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x06, 0x12, 0x03, 0x10, 0x02, 0x0b, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x01, 0x12, 0x03, 0x10, 0x0c, 0x15, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x00, 0x02, 0x02, 0x03, 0x12, 0x03, 0x10, 0x18, 0x19, 0x0a, 0x09, 0x0a, 0x02, 0x04,
-    0x01, 0x12, 0x03, 0x13, 0x00, 0x1b, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x01, 0x01, 0x12, 0x03, 0x13,
-    0x08, 0x18, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x02, 0x12, 0x04, 0x15, 0x00, 0x17, 0x01, 0x0a, 0x0a,
-    0x0a, 0x03, 0x04, 0x02, 0x01, 0x12, 0x03, 0x15, 0x08, 0x1b, 0x0a, 0x16, 0x0a, 0x04, 0x04, 0x02,
-    0x02, 0x00, 0x12, 0x03, 0x16, 0x02, 0x38, 0x22, 0x09, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x69,
-    0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x05, 0x12, 0x03, 0x16, 0x02, 0x07,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x01, 0x12, 0x03, 0x16, 0x08, 0x0f, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x03, 0x12, 0x03, 0x16, 0x12, 0x13, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x02, 0x02, 0x00, 0x08, 0x12, 0x03, 0x16, 0x14, 0x37, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x02,
-    0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x03, 0x16, 0x15, 0x36, 0x0a, 0x0a, 0x0a, 0x02,
-    0x04, 0x03, 0x12, 0x04, 0x19, 0x00, 0x1c, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x03, 0x01, 0x12,
-    0x03, 0x19, 0x08, 0x1c, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x03, 0x02, 0x00, 0x12, 0x03, 0x1a, 0x02,
-    0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x05, 0x12, 0x03, 0x1a, 0x02, 0x07, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x01, 0x12, 0x03, 0x1a, 0x08, 0x0f, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x03, 0x02, 0x00, 0x03, 0x12, 0x03, 0x1a, 0x12, 0x13, 0x0a, 0x1b, 0x0a, 0x04, 0x04,
-    0x03, 0x02, 0x01, 0x12, 0x03, 0x1b, 0x02, 0x14, 0x22, 0x0e, 0x20, 0xe7, 0x82, 0xb9, 0xe8, 0xb5,
-    0x9e, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01,
-    0x05, 0x12, 0x03, 0x1b, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01, 0x01, 0x12,
-    0x03, 0x1b, 0x08, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01, 0x03, 0x12, 0x03, 0x1b,
-    0x12, 0x13, 0x0a, 0x35, 0x0a, 0x02, 0x04, 0x04, 0x12, 0x04, 0x1f, 0x00, 0x22, 0x01, 0x1a, 0x29,
-    0x20, 0xe6, 0xa3, 0x80, 0xe6, 0x9f, 0xa5, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe6, 0x98, 0xaf,
-    0xe5, 0x90, 0xa6, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0xe8, 0xbf, 0x87, 0xe6, 0x9f, 0x90, 0xe7,
-    0xaf, 0x87, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x04, 0x01,
-    0x12, 0x03, 0x1f, 0x08, 0x22, 0x0a, 0x16, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x00, 0x12, 0x03, 0x20,
-    0x02, 0x38, 0x22, 0x09, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x04, 0x02, 0x00, 0x05, 0x12, 0x03, 0x20, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x04, 0x02, 0x00, 0x01, 0x12, 0x03, 0x20, 0x08, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02,
-    0x00, 0x03, 0x12, 0x03, 0x20, 0x12, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x08,
-    0x12, 0x03, 0x20, 0x14, 0x37, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x04, 0x02, 0x00, 0x08, 0x87, 0x09,
-    0x04, 0x04, 0x12, 0x03, 0x20, 0x15, 0x36, 0x0a, 0x17, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x01, 0x12,
-    0x03, 0x21, 0x02, 0x14, 0x22, 0x0a, 0x20, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64, 0x0a,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x01, 0x05, 0x12, 0x03, 0x21, 0x02, 0x07, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x04, 0x02, 0x01, 0x01, 0x12, 0x03, 0x21, 0x08, 0x0b, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x04, 0x02, 0x01, 0x03, 0x12, 0x03, 0x21, 0x12, 0x13, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x05,
-    0x12, 0x04, 0x24, 0x00, 0x26, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x05, 0x01, 0x12, 0x03, 0x24,
-    0x08, 0x23, 0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x05, 0x02, 0x00, 0x12, 0x03, 0x25, 0x02, 0x11, 0x22,
-    0x0e, 0x20, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0x0a, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x00, 0x05, 0x12, 0x03, 0x25, 0x02, 0x06, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x05, 0x02, 0x00, 0x01, 0x12, 0x03, 0x25, 0x07, 0x0c, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x05, 0x02, 0x00, 0x03, 0x12, 0x03, 0x25, 0x0f, 0x10, 0x0a, 0x2c, 0x0a, 0x02, 0x04, 0x06, 0x12,
-    0x04, 0x29, 0x00, 0x2c, 0x01, 0x1a, 0x20, 0x20, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe5, 0x92,
-    0x8c, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe7, 0x9a, 0x84, 0xe4, 0xba, 0xa4, 0xe4, 0xba, 0x92,
-    0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x06, 0x01, 0x12, 0x03,
-    0x29, 0x08, 0x17, 0x0a, 0x21, 0x0a, 0x04, 0x04, 0x06, 0x02, 0x00, 0x12, 0x03, 0x2a, 0x02, 0x15,
-    0x22, 0x14, 0x20, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe7,
-    0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x05, 0x12,
-    0x03, 0x2a, 0x02, 0x06, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x01, 0x12, 0x03, 0x2a,
-    0x07, 0x0c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x03, 0x12, 0x03, 0x2a, 0x13, 0x14,
-    0x0a, 0x21, 0x0a, 0x04, 0x04, 0x06, 0x02, 0x01, 0x12, 0x03, 0x2b, 0x02, 0x15, 0x22, 0x14, 0x20,
-    0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe8, 0xaf, 0x84, 0xe8,
-    0xae, 0xba, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x01, 0x05, 0x12, 0x03, 0x2b, 0x02,
-    0x06, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x01, 0x01, 0x12, 0x03, 0x2b, 0x07, 0x10, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x01, 0x03, 0x12, 0x03, 0x2b, 0x13, 0x14, 0x0a, 0x0a, 0x0a,
-    0x02, 0x04, 0x07, 0x12, 0x04, 0x2e, 0x00, 0x31, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x07, 0x01,
-    0x12, 0x03, 0x2e, 0x08, 0x12, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x07, 0x02, 0x00, 0x12, 0x04, 0x2f,
-    0x02, 0x30, 0x64, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x04, 0x12, 0x03, 0x2f, 0x02,
-    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x05, 0x12, 0x03, 0x2f, 0x0b, 0x10, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x01, 0x12, 0x03, 0x2f, 0x11, 0x19, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x07, 0x02, 0x00, 0x03, 0x12, 0x03, 0x2f, 0x1c, 0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x07, 0x02, 0x00, 0x08, 0x12, 0x03, 0x30, 0x06, 0x63, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x07, 0x02,
-    0x00, 0x08, 0x87, 0x09, 0x12, 0x01, 0x12, 0x03, 0x30, 0x07, 0x33, 0x0a, 0x10, 0x0a, 0x09, 0x04,
-    0x07, 0x02, 0x00, 0x08, 0x87, 0x09, 0x12, 0x02, 0x12, 0x03, 0x30, 0x35, 0x62, 0x0a, 0x0a, 0x0a,
-    0x02, 0x04, 0x08, 0x12, 0x04, 0x33, 0x00, 0x36, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x08, 0x01,
-    0x12, 0x03, 0x33, 0x08, 0x27, 0x0a, 0x2c, 0x0a, 0x04, 0x04, 0x08, 0x02, 0x00, 0x12, 0x03, 0x35,
-    0x02, 0x78, 0x1a, 0x1f, 0x20, 0x75, 0x69, 0x64, 0x20, 0x2d, 0x3e, 0x20, 0x5b, 0x6e, 0x6f, 0x74,
-    0x65, 0x49, 0x64, 0x2c, 0x20, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x32, 0x2c, 0x20, 0x2e, 0x2e,
-    0x2e, 0x5d, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x06, 0x12, 0x03, 0x35, 0x02,
-    0x18, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x01, 0x12, 0x03, 0x35, 0x19, 0x21, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x03, 0x12, 0x03, 0x35, 0x24, 0x25, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x08, 0x02, 0x00, 0x08, 0x12, 0x03, 0x35, 0x26, 0x77, 0x0a, 0x10, 0x0a, 0x09, 0x04,
-    0x08, 0x02, 0x00, 0x08, 0x87, 0x09, 0x13, 0x01, 0x12, 0x03, 0x35, 0x27, 0x4d, 0x0a, 0x10, 0x0a,
-    0x09, 0x04, 0x08, 0x02, 0x00, 0x08, 0x87, 0x09, 0x13, 0x02, 0x12, 0x03, 0x35, 0x4f, 0x76, 0x0a,
-    0x0a, 0x0a, 0x02, 0x04, 0x09, 0x12, 0x04, 0x38, 0x00, 0x3b, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04,
-    0x09, 0x01, 0x12, 0x03, 0x38, 0x08, 0x12, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x09, 0x02, 0x00, 0x12,
-    0x03, 0x39, 0x02, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x00, 0x05, 0x12, 0x03, 0x39,
-    0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x00, 0x01, 0x12, 0x03, 0x39, 0x08, 0x0f,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x00, 0x03, 0x12, 0x03, 0x39, 0x12, 0x13, 0x0a, 0x0b,
-    0x0a, 0x04, 0x04, 0x09, 0x02, 0x01, 0x12, 0x03, 0x3a, 0x02, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x09, 0x02, 0x01, 0x05, 0x12, 0x03, 0x3a, 0x02, 0x06, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02,
-    0x01, 0x01, 0x12, 0x03, 0x3a, 0x08, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x01, 0x03,
-    0x12, 0x03, 0x3a, 0x12, 0x13, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0a, 0x12, 0x04, 0x3d, 0x00, 0x3f,
-    0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0a, 0x01, 0x12, 0x03, 0x3d, 0x08, 0x16, 0x0a, 0x0b, 0x0a,
-    0x04, 0x04, 0x0a, 0x02, 0x00, 0x12, 0x03, 0x3e, 0x02, 0x1f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a,
-    0x02, 0x00, 0x04, 0x12, 0x03, 0x3e, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x00,
-    0x06, 0x12, 0x03, 0x3e, 0x0b, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x00, 0x01, 0x12,
-    0x03, 0x3e, 0x16, 0x1a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x00, 0x03, 0x12, 0x03, 0x3e,
-    0x1d, 0x1e, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0b, 0x12, 0x04, 0x41, 0x00, 0x44, 0x01, 0x0a, 0x0a,
-    0x0a, 0x03, 0x04, 0x0b, 0x01, 0x12, 0x03, 0x41, 0x08, 0x28, 0x0a, 0x23, 0x0a, 0x04, 0x04, 0x0b,
-    0x02, 0x00, 0x12, 0x03, 0x43, 0x02, 0x29, 0x1a, 0x16, 0x20, 0x75, 0x69, 0x64, 0x20, 0x2d, 0x3e,
-    0x20, 0x7b, 0x7b, 0x7d, 0x2c, 0x20, 0x7b, 0x7d, 0x2c, 0x20, 0x2e, 0x2e, 0x2e, 0x7d, 0x0a, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x00, 0x06, 0x12, 0x03, 0x43, 0x02, 0x1c, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x0b, 0x02, 0x00, 0x01, 0x12, 0x03, 0x43, 0x1d, 0x24, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x0b, 0x02, 0x00, 0x03, 0x12, 0x03, 0x43, 0x27, 0x28, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0c, 0x12,
-    0x04, 0x46, 0x00, 0x49, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0c, 0x01, 0x12, 0x03, 0x46, 0x08,
-    0x21, 0x0a, 0x16, 0x0a, 0x04, 0x04, 0x0c, 0x02, 0x00, 0x12, 0x03, 0x47, 0x02, 0x38, 0x22, 0x09,
-    0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02,
-    0x00, 0x05, 0x12, 0x03, 0x47, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x00, 0x01,
-    0x12, 0x03, 0x47, 0x08, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x00, 0x03, 0x12, 0x03,
-    0x47, 0x12, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x00, 0x08, 0x12, 0x03, 0x47, 0x14,
-    0x37, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x0c, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x03,
-    0x47, 0x15, 0x36, 0x0a, 0x17, 0x0a, 0x04, 0x04, 0x0c, 0x02, 0x01, 0x12, 0x03, 0x48, 0x02, 0x14,
+    0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+    0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+    0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65,
+    0x72, 0x4c, 0x69, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+    0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x6e,
+    0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x6e, 0x6f, 0x74, 0x65,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x49,
+    0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+    0x74, 0x1a, 0x27, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+    0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69,
+    0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x15, 0x50, 0x61,
+    0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x4e,
+    0x6f, 0x74, 0x65, 0x12, 0x29, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+    0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69,
+    0x6b, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
+    0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67,
+    0x65, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x4e, 0x6f,
+    0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0f, 0x52, 0x65,
+    0x70, 0x6f, 0x72, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x56, 0x69, 0x65, 0x77, 0x73, 0x12, 0x23, 0x2e,
+    0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f,
+    0x72, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x56, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+    0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+    0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x56, 0x69, 0x65, 0x77, 0x73,
+    0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xaa, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d,
+    0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x42, 0x11, 0x4e, 0x6f,
+    0x74, 0x65, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+    0x01, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x79,
+    0x61, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x77, 0x68, 0x69, 0x6d,
+    0x65, 0x72, 0x2f, 0x69, 0x64, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x6e, 0x6f,
+    0x74, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x41, 0x58, 0xaa,
+    0x02, 0x0b, 0x4e, 0x6f, 0x74, 0x65, 0x2e, 0x41, 0x70, 0x69, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0b,
+    0x4e, 0x6f, 0x74, 0x65, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x17, 0x4e, 0x6f,
+    0x74, 0x65, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+    0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x4e, 0x6f, 0x74, 0x65, 0x3a, 0x3a, 0x41, 0x70,
+    0x69, 0x3a, 0x3a, 0x56, 0x31, 0x4a, 0xcc, 0x1c, 0x0a, 0x06, 0x12, 0x04, 0x00, 0x00, 0x76, 0x01,
+    0x0a, 0x08, 0x0a, 0x01, 0x0c, 0x12, 0x03, 0x00, 0x00, 0x12, 0x0a, 0x08, 0x0a, 0x01, 0x02, 0x12,
+    0x03, 0x02, 0x00, 0x14, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x00, 0x12, 0x03, 0x04, 0x00, 0x25, 0x0a,
+    0x09, 0x0a, 0x02, 0x03, 0x01, 0x12, 0x03, 0x05, 0x00, 0x20, 0x0a, 0x08, 0x0a, 0x01, 0x08, 0x12,
+    0x03, 0x07, 0x00, 0x4d, 0x0a, 0x09, 0x0a, 0x02, 0x08, 0x0b, 0x12, 0x03, 0x07, 0x00, 0x4d, 0x0a,
+    0x0a, 0x0a, 0x02, 0x04, 0x00, 0x12, 0x04, 0x09, 0x00, 0x11, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04,
+    0x00, 0x01, 0x12, 0x03, 0x09, 0x08, 0x17, 0x0a, 0x17, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x00, 0x12,
+    0x03, 0x0a, 0x02, 0x38, 0x22, 0x0a, 0x20, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x69, 0x64, 0x0a,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x05, 0x12, 0x03, 0x0a, 0x02, 0x07, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x0a, 0x08, 0x0f, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x0a, 0x12, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00,
+    0x02, 0x00, 0x08, 0x12, 0x03, 0x0a, 0x14, 0x37, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x00, 0x02, 0x00,
+    0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x03, 0x0a, 0x15, 0x36, 0x0a, 0x26, 0x0a, 0x04, 0x04, 0x00,
+    0x02, 0x01, 0x12, 0x03, 0x0b, 0x02, 0x14, 0x22, 0x19, 0x20, 0xe6, 0x89, 0xa7, 0xe8, 0xa1, 0x8c,
+    0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0xe7, 0x9a, 0x84, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69,
+    0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x05, 0x12, 0x03, 0x0b, 0x02, 0x07,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x0b, 0x08, 0x0b, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x03, 0x12, 0x03, 0x0b, 0x12, 0x13, 0x0a, 0x0c, 0x0a, 0x04,
+    0x04, 0x00, 0x04, 0x00, 0x12, 0x04, 0x0c, 0x02, 0x0f, 0x03, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00,
+    0x04, 0x00, 0x01, 0x12, 0x03, 0x0c, 0x07, 0x10, 0x0a, 0x1d, 0x0a, 0x06, 0x04, 0x00, 0x04, 0x00,
+    0x02, 0x00, 0x12, 0x03, 0x0d, 0x04, 0x1c, 0x22, 0x0e, 0x20, 0xe5, 0x8f, 0x96, 0xe6, 0xb6, 0x88,
+    0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0x0a, 0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x00, 0x04, 0x00, 0x02,
+    0x00, 0x01, 0x12, 0x03, 0x0d, 0x04, 0x17, 0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x00, 0x04, 0x00, 0x02,
+    0x00, 0x02, 0x12, 0x03, 0x0d, 0x1a, 0x1b, 0x0a, 0x17, 0x0a, 0x06, 0x04, 0x00, 0x04, 0x00, 0x02,
+    0x01, 0x12, 0x03, 0x0e, 0x04, 0x1c, 0x22, 0x08, 0x20, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0x0a,
+    0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x00, 0x04, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x0e, 0x04, 0x15,
+    0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x00, 0x04, 0x00, 0x02, 0x01, 0x02, 0x12, 0x03, 0x0e, 0x1a, 0x1b,
+    0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x02, 0x12, 0x03, 0x10, 0x02, 0x1a, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x00, 0x02, 0x02, 0x06, 0x12, 0x03, 0x10, 0x02, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x00, 0x02, 0x02, 0x01, 0x12, 0x03, 0x10, 0x0c, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02,
+    0x02, 0x03, 0x12, 0x03, 0x10, 0x18, 0x19, 0x0a, 0x09, 0x0a, 0x02, 0x04, 0x01, 0x12, 0x03, 0x13,
+    0x00, 0x1b, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x01, 0x01, 0x12, 0x03, 0x13, 0x08, 0x18, 0x0a, 0x0a,
+    0x0a, 0x02, 0x04, 0x02, 0x12, 0x04, 0x15, 0x00, 0x17, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x02,
+    0x01, 0x12, 0x03, 0x15, 0x08, 0x1b, 0x0a, 0x16, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x00, 0x12, 0x03,
+    0x16, 0x02, 0x38, 0x22, 0x09, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x69, 0x64, 0x0a, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x05, 0x12, 0x03, 0x16, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x02, 0x02, 0x00, 0x01, 0x12, 0x03, 0x16, 0x08, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02,
+    0x02, 0x00, 0x03, 0x12, 0x03, 0x16, 0x12, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00,
+    0x08, 0x12, 0x03, 0x16, 0x14, 0x37, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x02, 0x02, 0x00, 0x08, 0x87,
+    0x09, 0x04, 0x04, 0x12, 0x03, 0x16, 0x15, 0x36, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x03, 0x12, 0x04,
+    0x19, 0x00, 0x1c, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x03, 0x01, 0x12, 0x03, 0x19, 0x08, 0x1c,
+    0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x03, 0x02, 0x00, 0x12, 0x03, 0x1a, 0x02, 0x14, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x03, 0x02, 0x00, 0x05, 0x12, 0x03, 0x1a, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x03, 0x02, 0x00, 0x01, 0x12, 0x03, 0x1a, 0x08, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02,
+    0x00, 0x03, 0x12, 0x03, 0x1a, 0x12, 0x13, 0x0a, 0x1b, 0x0a, 0x04, 0x04, 0x03, 0x02, 0x01, 0x12,
+    0x03, 0x1b, 0x02, 0x14, 0x22, 0x0e, 0x20, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0xe6, 0x95, 0xb0,
+    0xe9, 0x87, 0x8f, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01, 0x05, 0x12, 0x03, 0x1b,
+    0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01, 0x01, 0x12, 0x03, 0x1b, 0x08, 0x0d,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01, 0x03, 0x12, 0x03, 0x1b, 0x12, 0x13, 0x0a, 0x35,
+    0x0a, 0x02, 0x04, 0x04, 0x12, 0x04, 0x1f, 0x00, 0x22, 0x01, 0x1a, 0x29, 0x20, 0xe6, 0xa3, 0x80,
+    0xe6, 0x9f, 0xa5, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe7,
+    0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0xe8, 0xbf, 0x87, 0xe6, 0x9f, 0x90, 0xe7, 0xaf, 0x87, 0xe7, 0xac,
+    0x94, 0xe8, 0xae, 0xb0, 0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x04, 0x01, 0x12, 0x03, 0x1f, 0x08,
+    0x22, 0x0a, 0x16, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x00, 0x12, 0x03, 0x20, 0x02, 0x38, 0x22, 0x09,
+    0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02,
+    0x00, 0x05, 0x12, 0x03, 0x20, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x01,
+    0x12, 0x03, 0x20, 0x08, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x03, 0x12, 0x03,
+    0x20, 0x12, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x08, 0x12, 0x03, 0x20, 0x14,
+    0x37, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x04, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x03,
+    0x20, 0x15, 0x36, 0x0a, 0x17, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x01, 0x12, 0x03, 0x21, 0x02, 0x14,
     0x22, 0x0a, 0x20, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x0c, 0x02, 0x01, 0x05, 0x12, 0x03, 0x48, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c,
-    0x02, 0x01, 0x01, 0x12, 0x03, 0x48, 0x08, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x01,
-    0x03, 0x12, 0x03, 0x48, 0x12, 0x13, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0d, 0x12, 0x04, 0x4b, 0x00,
-    0x4d, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0d, 0x01, 0x12, 0x03, 0x4b, 0x08, 0x22, 0x0a, 0x0b,
-    0x0a, 0x04, 0x04, 0x0d, 0x02, 0x00, 0x12, 0x03, 0x4c, 0x02, 0x22, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x0d, 0x02, 0x00, 0x06, 0x12, 0x03, 0x4c, 0x02, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0d, 0x02,
-    0x00, 0x01, 0x12, 0x03, 0x4c, 0x12, 0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0d, 0x02, 0x00, 0x03,
-    0x12, 0x03, 0x4c, 0x20, 0x21, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0e, 0x12, 0x04, 0x4f, 0x00, 0x53,
-    0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0e, 0x01, 0x12, 0x03, 0x4f, 0x08, 0x24, 0x0a, 0x0b, 0x0a,
-    0x04, 0x04, 0x0e, 0x02, 0x00, 0x12, 0x03, 0x50, 0x02, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e,
-    0x02, 0x00, 0x05, 0x12, 0x03, 0x50, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x00,
-    0x01, 0x12, 0x03, 0x50, 0x09, 0x0c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x00, 0x03, 0x12,
-    0x03, 0x50, 0x12, 0x13, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0e, 0x02, 0x01, 0x12, 0x03, 0x51, 0x02,
-    0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x01, 0x05, 0x12, 0x03, 0x51, 0x02, 0x08, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x01, 0x01, 0x12, 0x03, 0x51, 0x09, 0x0f, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x0e, 0x02, 0x01, 0x03, 0x12, 0x03, 0x51, 0x12, 0x13, 0x0a, 0x0b, 0x0a, 0x04, 0x04,
-    0x0e, 0x02, 0x02, 0x12, 0x03, 0x52, 0x02, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x02,
-    0x05, 0x12, 0x03, 0x52, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x02, 0x01, 0x12,
-    0x03, 0x52, 0x09, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x02, 0x03, 0x12, 0x03, 0x52,
-    0x12, 0x13, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0f, 0x12, 0x04, 0x55, 0x00, 0x59, 0x01, 0x0a, 0x0a,
-    0x0a, 0x03, 0x04, 0x0f, 0x01, 0x12, 0x03, 0x55, 0x08, 0x25, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0f,
-    0x02, 0x00, 0x12, 0x03, 0x56, 0x02, 0x28, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x00, 0x04,
-    0x12, 0x03, 0x56, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x00, 0x06, 0x12, 0x03,
-    0x56, 0x0b, 0x17, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x00, 0x01, 0x12, 0x03, 0x56, 0x18,
-    0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x00, 0x03, 0x12, 0x03, 0x56, 0x26, 0x27, 0x0a,
-    0x0b, 0x0a, 0x04, 0x04, 0x0f, 0x02, 0x01, 0x12, 0x03, 0x57, 0x02, 0x28, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x0f, 0x02, 0x01, 0x05, 0x12, 0x03, 0x57, 0x02, 0x06, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f,
-    0x02, 0x01, 0x01, 0x12, 0x03, 0x57, 0x18, 0x20, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x01,
-    0x03, 0x12, 0x03, 0x57, 0x26, 0x27, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0f, 0x02, 0x02, 0x12, 0x03,
-    0x58, 0x02, 0x28, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x02, 0x05, 0x12, 0x03, 0x58, 0x02,
-    0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x02, 0x01, 0x12, 0x03, 0x58, 0x18, 0x23, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x02, 0x03, 0x12, 0x03, 0x58, 0x26, 0x27, 0x0a, 0x4a, 0x0a,
-    0x02, 0x06, 0x00, 0x12, 0x04, 0x5c, 0x00, 0x6a, 0x01, 0x1a, 0x3e, 0x20, 0xe4, 0xb8, 0x8e, 0xe7,
-    0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe4, 0xba, 0xa4, 0xe4, 0xba, 0x92, 0xe9, 0x80, 0xbb, 0xe8, 0xbe,
-    0x91, 0xe7, 0x9b, 0xb8, 0xe5, 0x85, 0xb3, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xef, 0xbc, 0x8c,
-    0xe6, 0xaf, 0x94, 0xe5, 0xa6, 0x82, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0xe3, 0x80, 0x81, 0xe6,
-    0x94, 0xb6, 0xe8, 0x97, 0x8f, 0xe7, 0xad, 0x89, 0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x06, 0x00, 0x01,
-    0x12, 0x03, 0x5c, 0x08, 0x1b, 0x0a, 0x28, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x00, 0x12, 0x03, 0x5e,
-    0x02, 0x3b, 0x1a, 0x1b, 0x20, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0xe7, 0xac, 0x94, 0xe8, 0xae,
-    0xb0, 0x2f, 0xe5, 0x8f, 0x96, 0xe6, 0xb6, 0x88, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0x0a, 0x0a,
-    0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x5e, 0x06, 0x0e, 0x0a, 0x0c, 0x0a,
-    0x05, 0x06, 0x00, 0x02, 0x00, 0x02, 0x12, 0x03, 0x5e, 0x0f, 0x1e, 0x0a, 0x0c, 0x0a, 0x05, 0x06,
-    0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x5e, 0x29, 0x39, 0x0a, 0x27, 0x0a, 0x04, 0x06, 0x00, 0x02,
-    0x01, 0x12, 0x03, 0x60, 0x02, 0x47, 0x1a, 0x1a, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe7,
-    0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0xe6, 0x95, 0xb0, 0xe9, 0x87,
-    0x8f, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x60, 0x06, 0x12,
-    0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x02, 0x12, 0x03, 0x60, 0x13, 0x26, 0x0a, 0x0c,
-    0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x03, 0x12, 0x03, 0x60, 0x31, 0x45, 0x0a, 0x3c, 0x0a, 0x04,
-    0x06, 0x00, 0x02, 0x02, 0x12, 0x03, 0x62, 0x02, 0x5c, 0x1a, 0x2f, 0x20, 0xe6, 0xa3, 0x80, 0xe6,
-    0x9f, 0xa5, 0xe6, 0x9f, 0x90, 0xe4, 0xb8, 0xaa, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe6, 0x98,
-    0xaf, 0xe5, 0x90, 0xa6, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0xe8, 0xbf, 0x87, 0xe6, 0x9f, 0x90,
-    0xe7, 0xaf, 0x87, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00,
-    0x02, 0x02, 0x01, 0x12, 0x03, 0x62, 0x06, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x02,
-    0x02, 0x12, 0x03, 0x62, 0x1a, 0x34, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x03, 0x12,
-    0x03, 0x62, 0x3f, 0x5a, 0x0a, 0x3c, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x03, 0x12, 0x03, 0x64, 0x02,
-    0x6b, 0x1a, 0x2f, 0x20, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe6, 0xa3, 0x80, 0xe6, 0x9f, 0xa5,
-    0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe7, 0x82, 0xb9, 0xe8,
-    0xb5, 0x9e, 0xe8, 0xbf, 0x87, 0xe5, 0xa4, 0x9a, 0xe7, 0xaf, 0x87, 0xe7, 0xac, 0x94, 0xe8, 0xae,
-    0xb0, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x03, 0x01, 0x12, 0x03, 0x64, 0x06, 0x1e,
-    0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x03, 0x02, 0x12, 0x03, 0x64, 0x1f, 0x3e, 0x0a, 0x0c,
-    0x0a, 0x05, 0x06, 0x00, 0x02, 0x03, 0x03, 0x12, 0x03, 0x64, 0x49, 0x69, 0x0a, 0x2a, 0x0a, 0x04,
-    0x06, 0x00, 0x02, 0x04, 0x12, 0x03, 0x66, 0x02, 0x59, 0x1a, 0x1d, 0x20, 0xe8, 0x8e, 0xb7, 0xe5,
-    0x8f, 0x96, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe7, 0x9a, 0x84, 0xe4, 0xba, 0xa4, 0xe4, 0xba,
-    0x92, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x04,
-    0x01, 0x12, 0x03, 0x66, 0x06, 0x18, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x04, 0x02, 0x12,
-    0x03, 0x66, 0x19, 0x32, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x04, 0x03, 0x12, 0x03, 0x66,
-    0x3d, 0x57, 0x0a, 0x2d, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x05, 0x12, 0x03, 0x69, 0x02, 0x62, 0x1a,
-    0x20, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0x82,
-    0xb9, 0xe8, 0xb5, 0x9e, 0xe8, 0xbf, 0x87, 0xe7, 0x9a, 0x84, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0,
-    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x05, 0x01, 0x12, 0x03, 0x69, 0x06, 0x1b, 0x0a,
-    0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x05, 0x02, 0x12, 0x03, 0x69, 0x1c, 0x38, 0x0a, 0x0c, 0x0a,
-    0x05, 0x06, 0x00, 0x02, 0x05, 0x03, 0x12, 0x03, 0x69, 0x43, 0x60, 0x62, 0x06, 0x70, 0x72, 0x6f,
-    0x74, 0x6f, 0x33, 0x0a, 0xc8, 0x30, 0x0a, 0x1a, 0x6e, 0x6f, 0x74, 0x65, 0x2f, 0x61, 0x70, 0x69,
-    0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f,
-    0x74, 0x6f, 0x12, 0x0b, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x1a,
-    0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61,
-    0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x6e, 0x6f,
-    0x74, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x70,
-    0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x6e, 0x6f, 0x74, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-    0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x2e, 0x70,
-    0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x65, 0x78, 0x74, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-    0x73, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x33,
-    0x0a, 0x10, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-    0x73, 0x74, 0x12, 0x1f, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-    0x05, 0x42, 0x09, 0xba, 0x48, 0x06, 0x1a, 0x04, 0x18, 0x1e, 0x20, 0x00, 0x52, 0x05, 0x63, 0x6f,
-    0x75, 0x6e, 0x74, 0x22, 0x5a, 0x0a, 0x11, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x47, 0x65, 0x74,
-    0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
-    0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61,
-    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x74,
-    0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
-    0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
-    0x36, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65,
-    0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64,
-    0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52,
-    0x06, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x22, 0x74, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x46, 0x65,
-    0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d,
-    0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e,
-    0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x4e,
-    0x6f, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x2e, 0x0a,
-    0x03, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x6f, 0x74,
-    0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x4e, 0x6f, 0x74,
-    0x65, 0x49, 0x74, 0x65, 0x6d, 0x45, 0x78, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x22, 0x4d, 0x0a,
-    0x13, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71,
-    0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-    0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x08, 0x6e, 0x65, 0x65, 0x64, 0x5f, 0x6e,
-    0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xba, 0x48, 0x06, 0x1a, 0x04, 0x18,
-    0x1e, 0x20, 0x00, 0x52, 0x07, 0x6e, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x22, 0x16, 0x0a, 0x14,
-    0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
-    0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4b, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
-    0x65, 0x63, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-    0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75,
-    0x69, 0x64, 0x12, 0x1d, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-    0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x18, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
-    0x74, 0x22, 0x4c, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x65,
-    0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f,
-    0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-    0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x64,
-    0x4e, 0x6f, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22,
-    0x56, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x42, 0x79, 0x55, 0x69, 0x64,
-    0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01,
-    0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72,
-    0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
-    0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-    0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x84, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74,
-    0x46, 0x65, 0x65, 0x64, 0x42, 0x79, 0x55, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+    0x04, 0x04, 0x02, 0x01, 0x05, 0x12, 0x03, 0x21, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04,
+    0x02, 0x01, 0x01, 0x12, 0x03, 0x21, 0x08, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x01,
+    0x03, 0x12, 0x03, 0x21, 0x12, 0x13, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x05, 0x12, 0x04, 0x24, 0x00,
+    0x26, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x05, 0x01, 0x12, 0x03, 0x24, 0x08, 0x23, 0x0a, 0x1b,
+    0x0a, 0x04, 0x04, 0x05, 0x02, 0x00, 0x12, 0x03, 0x25, 0x02, 0x11, 0x22, 0x0e, 0x20, 0xe6, 0x98,
+    0xaf, 0xe5, 0x90, 0xa6, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x05, 0x02, 0x00, 0x05, 0x12, 0x03, 0x25, 0x02, 0x06, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02,
+    0x00, 0x01, 0x12, 0x03, 0x25, 0x07, 0x0c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x00, 0x03,
+    0x12, 0x03, 0x25, 0x0f, 0x10, 0x0a, 0x2c, 0x0a, 0x02, 0x04, 0x06, 0x12, 0x04, 0x29, 0x00, 0x2d,
+    0x01, 0x1a, 0x20, 0x20, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe5, 0x92, 0x8c, 0xe7, 0xac, 0x94,
+    0xe8, 0xae, 0xb0, 0xe7, 0x9a, 0x84, 0xe4, 0xba, 0xa4, 0xe4, 0xba, 0x92, 0xe4, 0xbf, 0xa1, 0xe6,
+    0x81, 0xaf, 0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x06, 0x01, 0x12, 0x03, 0x29, 0x08, 0x17, 0x0a,
+    0x21, 0x0a, 0x04, 0x04, 0x06, 0x02, 0x00, 0x12, 0x03, 0x2a, 0x02, 0x16, 0x22, 0x14, 0x20, 0xe7,
+    0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe7, 0x82, 0xb9, 0xe8, 0xb5,
+    0x9e, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x05, 0x12, 0x03, 0x2a, 0x02, 0x06,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x01, 0x12, 0x03, 0x2a, 0x08, 0x0d, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x03, 0x12, 0x03, 0x2a, 0x14, 0x15, 0x0a, 0x21, 0x0a, 0x04,
+    0x04, 0x06, 0x02, 0x01, 0x12, 0x03, 0x2b, 0x02, 0x16, 0x22, 0x14, 0x20, 0xe7, 0x94, 0xa8, 0xe6,
+    0x88, 0xb7, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x0a, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x01, 0x05, 0x12, 0x03, 0x2b, 0x02, 0x06, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x06, 0x02, 0x01, 0x01, 0x12, 0x03, 0x2b, 0x08, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x06, 0x02, 0x01, 0x03, 0x12, 0x03, 0x2b, 0x14, 0x15, 0x0a, 0x1e, 0x0a, 0x04, 0x04, 0x06, 0x02,
+    0x02, 0x12, 0x03, 0x2c, 0x02, 0x16, 0x22, 0x11, 0x20, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe6,
+    0xb5, 0x8f, 0xe8, 0xa7, 0x88, 0xe9, 0x87, 0x8f, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02,
+    0x02, 0x05, 0x12, 0x03, 0x2c, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x02, 0x01,
+    0x12, 0x03, 0x2c, 0x08, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x02, 0x03, 0x12, 0x03,
+    0x2c, 0x14, 0x15, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x07, 0x12, 0x04, 0x2f, 0x00, 0x32, 0x01, 0x0a,
+    0x0a, 0x0a, 0x03, 0x04, 0x07, 0x01, 0x12, 0x03, 0x2f, 0x08, 0x12, 0x0a, 0x0c, 0x0a, 0x04, 0x04,
+    0x07, 0x02, 0x00, 0x12, 0x04, 0x30, 0x02, 0x31, 0x64, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02,
+    0x00, 0x04, 0x12, 0x03, 0x30, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x05,
+    0x12, 0x03, 0x30, 0x0b, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x01, 0x12, 0x03,
+    0x30, 0x11, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x03, 0x12, 0x03, 0x30, 0x1c,
+    0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x08, 0x12, 0x03, 0x31, 0x06, 0x63, 0x0a,
+    0x10, 0x0a, 0x09, 0x04, 0x07, 0x02, 0x00, 0x08, 0x87, 0x09, 0x12, 0x01, 0x12, 0x03, 0x31, 0x07,
+    0x33, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x07, 0x02, 0x00, 0x08, 0x87, 0x09, 0x12, 0x02, 0x12, 0x03,
+    0x31, 0x35, 0x62, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x08, 0x12, 0x04, 0x34, 0x00, 0x37, 0x01, 0x0a,
+    0x0a, 0x0a, 0x03, 0x04, 0x08, 0x01, 0x12, 0x03, 0x34, 0x08, 0x27, 0x0a, 0x2c, 0x0a, 0x04, 0x04,
+    0x08, 0x02, 0x00, 0x12, 0x03, 0x36, 0x02, 0x78, 0x1a, 0x1f, 0x20, 0x75, 0x69, 0x64, 0x20, 0x2d,
+    0x3e, 0x20, 0x5b, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x2c, 0x20, 0x6e, 0x6f, 0x74, 0x65, 0x49,
+    0x64, 0x32, 0x2c, 0x20, 0x2e, 0x2e, 0x2e, 0x5d, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02,
+    0x00, 0x06, 0x12, 0x03, 0x36, 0x02, 0x18, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x01,
+    0x12, 0x03, 0x36, 0x19, 0x21, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x03, 0x12, 0x03,
+    0x36, 0x24, 0x25, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x08, 0x12, 0x03, 0x36, 0x26,
+    0x77, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x08, 0x02, 0x00, 0x08, 0x87, 0x09, 0x13, 0x01, 0x12, 0x03,
+    0x36, 0x27, 0x4d, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x08, 0x02, 0x00, 0x08, 0x87, 0x09, 0x13, 0x02,
+    0x12, 0x03, 0x36, 0x4f, 0x76, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x09, 0x12, 0x04, 0x39, 0x00, 0x3c,
+    0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x09, 0x01, 0x12, 0x03, 0x39, 0x08, 0x12, 0x0a, 0x0b, 0x0a,
+    0x04, 0x04, 0x09, 0x02, 0x00, 0x12, 0x03, 0x3a, 0x02, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09,
+    0x02, 0x00, 0x05, 0x12, 0x03, 0x3a, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x00,
+    0x01, 0x12, 0x03, 0x3a, 0x08, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x00, 0x03, 0x12,
+    0x03, 0x3a, 0x12, 0x13, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x09, 0x02, 0x01, 0x12, 0x03, 0x3b, 0x02,
+    0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x01, 0x05, 0x12, 0x03, 0x3b, 0x02, 0x06, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x01, 0x01, 0x12, 0x03, 0x3b, 0x08, 0x0d, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x09, 0x02, 0x01, 0x03, 0x12, 0x03, 0x3b, 0x12, 0x13, 0x0a, 0x0a, 0x0a, 0x02, 0x04,
+    0x0a, 0x12, 0x04, 0x3e, 0x00, 0x40, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0a, 0x01, 0x12, 0x03,
+    0x3e, 0x08, 0x16, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0a, 0x02, 0x00, 0x12, 0x03, 0x3f, 0x02, 0x1f,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x00, 0x04, 0x12, 0x03, 0x3f, 0x02, 0x0a, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x0a, 0x02, 0x00, 0x06, 0x12, 0x03, 0x3f, 0x0b, 0x15, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x0a, 0x02, 0x00, 0x01, 0x12, 0x03, 0x3f, 0x16, 0x1a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a,
+    0x02, 0x00, 0x03, 0x12, 0x03, 0x3f, 0x1d, 0x1e, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0b, 0x12, 0x04,
+    0x42, 0x00, 0x45, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0b, 0x01, 0x12, 0x03, 0x42, 0x08, 0x28,
+    0x0a, 0x23, 0x0a, 0x04, 0x04, 0x0b, 0x02, 0x00, 0x12, 0x03, 0x44, 0x02, 0x29, 0x1a, 0x16, 0x20,
+    0x75, 0x69, 0x64, 0x20, 0x2d, 0x3e, 0x20, 0x7b, 0x7b, 0x7d, 0x2c, 0x20, 0x7b, 0x7d, 0x2c, 0x20,
+    0x2e, 0x2e, 0x2e, 0x7d, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x00, 0x06, 0x12, 0x03,
+    0x44, 0x02, 0x1c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x00, 0x01, 0x12, 0x03, 0x44, 0x1d,
+    0x24, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x00, 0x03, 0x12, 0x03, 0x44, 0x27, 0x28, 0x0a,
+    0x0a, 0x0a, 0x02, 0x04, 0x0c, 0x12, 0x04, 0x47, 0x00, 0x4a, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04,
+    0x0c, 0x01, 0x12, 0x03, 0x47, 0x08, 0x21, 0x0a, 0x16, 0x0a, 0x04, 0x04, 0x0c, 0x02, 0x00, 0x12,
+    0x03, 0x48, 0x02, 0x38, 0x22, 0x09, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x69, 0x64, 0x0a, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x00, 0x05, 0x12, 0x03, 0x48, 0x02, 0x07, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x0c, 0x02, 0x00, 0x01, 0x12, 0x03, 0x48, 0x08, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x0c, 0x02, 0x00, 0x03, 0x12, 0x03, 0x48, 0x12, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02,
+    0x00, 0x08, 0x12, 0x03, 0x48, 0x14, 0x37, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x0c, 0x02, 0x00, 0x08,
+    0x87, 0x09, 0x04, 0x04, 0x12, 0x03, 0x48, 0x15, 0x36, 0x0a, 0x17, 0x0a, 0x04, 0x04, 0x0c, 0x02,
+    0x01, 0x12, 0x03, 0x49, 0x02, 0x14, 0x22, 0x0a, 0x20, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69,
+    0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x01, 0x05, 0x12, 0x03, 0x49, 0x02, 0x07,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x01, 0x01, 0x12, 0x03, 0x49, 0x08, 0x0b, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x0c, 0x02, 0x01, 0x03, 0x12, 0x03, 0x49, 0x12, 0x13, 0x0a, 0x0a, 0x0a, 0x02,
+    0x04, 0x0d, 0x12, 0x04, 0x4c, 0x00, 0x4e, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0d, 0x01, 0x12,
+    0x03, 0x4c, 0x08, 0x22, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0d, 0x02, 0x00, 0x12, 0x03, 0x4d, 0x02,
+    0x22, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0d, 0x02, 0x00, 0x06, 0x12, 0x03, 0x4d, 0x02, 0x11, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x0d, 0x02, 0x00, 0x01, 0x12, 0x03, 0x4d, 0x12, 0x1d, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x0d, 0x02, 0x00, 0x03, 0x12, 0x03, 0x4d, 0x20, 0x21, 0x0a, 0x0a, 0x0a, 0x02, 0x04,
+    0x0e, 0x12, 0x04, 0x50, 0x00, 0x54, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0e, 0x01, 0x12, 0x03,
+    0x50, 0x08, 0x24, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0e, 0x02, 0x00, 0x12, 0x03, 0x51, 0x02, 0x14,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x00, 0x05, 0x12, 0x03, 0x51, 0x02, 0x07, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x0e, 0x02, 0x00, 0x01, 0x12, 0x03, 0x51, 0x09, 0x0c, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x0e, 0x02, 0x00, 0x03, 0x12, 0x03, 0x51, 0x12, 0x13, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0e,
+    0x02, 0x01, 0x12, 0x03, 0x52, 0x02, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x01, 0x05,
+    0x12, 0x03, 0x52, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x01, 0x01, 0x12, 0x03,
+    0x52, 0x09, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x01, 0x03, 0x12, 0x03, 0x52, 0x12,
+    0x13, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0e, 0x02, 0x02, 0x12, 0x03, 0x53, 0x02, 0x14, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x0e, 0x02, 0x02, 0x05, 0x12, 0x03, 0x53, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x0e, 0x02, 0x02, 0x01, 0x12, 0x03, 0x53, 0x09, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e,
+    0x02, 0x02, 0x03, 0x12, 0x03, 0x53, 0x12, 0x13, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0f, 0x12, 0x04,
+    0x56, 0x00, 0x5a, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0f, 0x01, 0x12, 0x03, 0x56, 0x08, 0x25,
+    0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0f, 0x02, 0x00, 0x12, 0x03, 0x57, 0x02, 0x28, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x0f, 0x02, 0x00, 0x04, 0x12, 0x03, 0x57, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x0f, 0x02, 0x00, 0x06, 0x12, 0x03, 0x57, 0x0b, 0x17, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02,
+    0x00, 0x01, 0x12, 0x03, 0x57, 0x18, 0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x00, 0x03,
+    0x12, 0x03, 0x57, 0x26, 0x27, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0f, 0x02, 0x01, 0x12, 0x03, 0x58,
+    0x02, 0x28, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x01, 0x05, 0x12, 0x03, 0x58, 0x02, 0x06,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x01, 0x01, 0x12, 0x03, 0x58, 0x18, 0x20, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x0f, 0x02, 0x01, 0x03, 0x12, 0x03, 0x58, 0x26, 0x27, 0x0a, 0x0b, 0x0a, 0x04,
+    0x04, 0x0f, 0x02, 0x02, 0x12, 0x03, 0x59, 0x02, 0x28, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02,
+    0x02, 0x05, 0x12, 0x03, 0x59, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x02, 0x01,
+    0x12, 0x03, 0x59, 0x18, 0x23, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x02, 0x03, 0x12, 0x03,
+    0x59, 0x26, 0x27, 0x0a, 0x63, 0x0a, 0x02, 0x04, 0x10, 0x12, 0x04, 0x5d, 0x00, 0x60, 0x01, 0x1a,
+    0x57, 0x20, 0xe4, 0xb8, 0x8a, 0xe6, 0x8a, 0xa5, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe6, 0xb5,
+    0x8f, 0xe8, 0xa7, 0x88, 0x20, 0xe5, 0x90, 0x8c, 0xe4, 0xb8, 0x80, 0xe7, 0x94, 0xa8, 0xe6, 0x88,
+    0xb7, 0xe5, 0x9c, 0xa8, 0xe5, 0x8e, 0xbb, 0xe9, 0x87, 0x8d, 0xe7, 0xaa, 0x97, 0xe5, 0x8f, 0xa3,
+    0xe5, 0x86, 0x85, 0xe5, 0xa4, 0x9a, 0xe6, 0xac, 0xa1, 0xe6, 0xb5, 0x8f, 0xe8, 0xa7, 0x88, 0xe5,
+    0x90, 0x8c, 0xe4, 0xb8, 0x80, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe5, 0x8f, 0xaa, 0xe8, 0xae,
+    0xa1, 0xe4, 0xb8, 0x80, 0xe6, 0xac, 0xa1, 0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x10, 0x01, 0x12,
+    0x03, 0x5d, 0x08, 0x1e, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x10, 0x02, 0x00, 0x12, 0x04, 0x5e, 0x02,
+    0x5f, 0x64, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x00, 0x04, 0x12, 0x03, 0x5e, 0x02, 0x0a,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x00, 0x05, 0x12, 0x03, 0x5e, 0x0b, 0x10, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x10, 0x02, 0x00, 0x01, 0x12, 0x03, 0x5e, 0x11, 0x19, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x10, 0x02, 0x00, 0x03, 0x12, 0x03, 0x5e, 0x1c, 0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x10,
+    0x02, 0x00, 0x08, 0x12, 0x03, 0x5f, 0x06, 0x63, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x10, 0x02, 0x00,
+    0x08, 0x87, 0x09, 0x12, 0x01, 0x12, 0x03, 0x5f, 0x07, 0x33, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x10,
+    0x02, 0x00, 0x08, 0x87, 0x09, 0x12, 0x02, 0x12, 0x03, 0x5f, 0x35, 0x62, 0x0a, 0x09, 0x0a, 0x02,
+    0x04, 0x11, 0x12, 0x03, 0x62, 0x00, 0x22, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x11, 0x01, 0x12, 0x03,
+    0x62, 0x08, 0x1f, 0x0a, 0x4a, 0x0a, 0x02, 0x06, 0x00, 0x12, 0x04, 0x65, 0x00, 0x76, 0x01, 0x1a,
+    0x3e, 0x20, 0xe4, 0xb8, 0x8e, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe4, 0xba, 0xa4, 0xe4, 0xba,
+    0x92, 0xe9, 0x80, 0xbb, 0xe8, 0xbe, 0x91, 0xe7, 0x9b, 0xb8, 0xe5, 0x85, 0xb3, 0xe6, 0x9c, 0x8d,
+    0xe5, 0x8a, 0xa1, 0xef, 0xbc, 0x8c, 0xe6, 0xaf, 0x94, 0xe5, 0xa6, 0x82, 0xe7, 0x82, 0xb9, 0xe8,
+    0xb5, 0x9e, 0xe3, 0x80, 0x81, 0xe6, 0x94, 0xb6, 0xe8, 0x97, 0x8f, 0xe7, 0xad, 0x89, 0x0a, 0x0a,
+    0x0a, 0x0a, 0x03, 0x06, 0x00, 0x01, 0x12, 0x03, 0x65, 0x08, 0x1b, 0x0a, 0x28, 0x0a, 0x04, 0x06,
+    0x00, 0x02, 0x00, 0x12, 0x03, 0x67, 0x02, 0x3b, 0x1a, 0x1b, 0x20, 0xe7, 0x82, 0xb9, 0xe8, 0xb5,
+    0x9e, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x2f, 0xe5, 0x8f, 0x96, 0xe6, 0xb6, 0x88, 0xe7, 0x82,
+    0xb9, 0xe8, 0xb5, 0x9e, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03,
+    0x67, 0x06, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x02, 0x12, 0x03, 0x67, 0x0f,
+    0x1e, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x67, 0x29, 0x39, 0x0a,
+    0x27, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x01, 0x12, 0x03, 0x69, 0x02, 0x47, 0x1a, 0x1a, 0x20, 0xe8,
+    0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe7, 0x82, 0xb9, 0xe8, 0xb5,
+    0x9e, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01,
+    0x01, 0x12, 0x03, 0x69, 0x06, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x02, 0x12,
+    0x03, 0x69, 0x13, 0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x03, 0x12, 0x03, 0x69,
+    0x31, 0x45, 0x0a, 0x3c, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x02, 0x12, 0x03, 0x6b, 0x02, 0x5c, 0x1a,
+    0x2f, 0x20, 0xe6, 0xa3, 0x80, 0xe6, 0x9f, 0xa5, 0xe6, 0x9f, 0x90, 0xe4, 0xb8, 0xaa, 0xe7, 0x94,
+    0xa8, 0xe6, 0x88, 0xb7, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e,
+    0xe8, 0xbf, 0x87, 0xe6, 0x9f, 0x90, 0xe7, 0xaf, 0x87, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x0a,
+    0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x01, 0x12, 0x03, 0x6b, 0x06, 0x19, 0x0a, 0x0c,
+    0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x02, 0x12, 0x03, 0x6b, 0x1a, 0x34, 0x0a, 0x0c, 0x0a, 0x05,
+    0x06, 0x00, 0x02, 0x02, 0x03, 0x12, 0x03, 0x6b, 0x3f, 0x5a, 0x0a, 0x3c, 0x0a, 0x04, 0x06, 0x00,
+    0x02, 0x03, 0x12, 0x03, 0x6d, 0x02, 0x6b, 0x1a, 0x2f, 0x20, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f,
+    0xe6, 0xa3, 0x80, 0xe6, 0x9f, 0xa5, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe6, 0x98, 0xaf, 0xe5,
+    0x90, 0xa6, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0xe8, 0xbf, 0x87, 0xe5, 0xa4, 0x9a, 0xe7, 0xaf,
+    0x87, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x03,
+    0x01, 0x12, 0x03, 0x6d, 0x06, 0x1e, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x03, 0x02, 0x12,
+    0x03, 0x6d, 0x1f, 0x3e, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x03, 0x03, 0x12, 0x03, 0x6d,
+    0x49, 0x69, 0x0a, 0x2a, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x04, 0x12, 0x03, 0x6f, 0x02, 0x59, 0x1a,
+    0x1d, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe7, 0x9a,
+    0x84, 0xe4, 0xba, 0xa4, 0xe4, 0xba, 0x92, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x0a, 0x0a, 0x0c,
+    0x0a, 0x05, 0x06, 0x00, 0x02, 0x04, 0x01, 0x12, 0x03, 0x6f, 0x06, 0x18, 0x0a, 0x0c, 0x0a, 0x05,
+    0x06, 0x00, 0x02, 0x04, 0x02, 0x12, 0x03, 0x6f, 0x19, 0x32, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00,
+    0x02, 0x04, 0x03, 0x12, 0x03, 0x6f, 0x3d, 0x57, 0x0a, 0x2d, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x05,
+    0x12, 0x03, 0x72, 0x02, 0x62, 0x1a, 0x20, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe7, 0x94,
+    0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0xe8, 0xbf, 0x87, 0xe7, 0x9a, 0x84,
+    0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x05, 0x01,
+    0x12, 0x03, 0x72, 0x06, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x05, 0x02, 0x12, 0x03,
+    0x72, 0x1c, 0x38, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x05, 0x03, 0x12, 0x03, 0x72, 0x43,
+    0x60, 0x0a, 0x27, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x06, 0x12, 0x03, 0x75, 0x02, 0x50, 0x1a, 0x1a,
+    0x20, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe4, 0xb8, 0x8a, 0xe6, 0x8a, 0xa5, 0xe7, 0xac, 0x94,
+    0xe8, 0xae, 0xb0, 0xe6, 0xb5, 0x8f, 0xe8, 0xa7, 0x88, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00,
+    0x02, 0x06, 0x01, 0x12, 0x03, 0x75, 0x06, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x06,
+    0x02, 0x12, 0x03, 0x75, 0x16, 0x2c, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x06, 0x03, 0x12,
+    0x03, 0x75, 0x37, 0x4e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33, 0x0a, 0xd3, 0x40, 0x0a,
+    0x1a, 0x6e, 0x6f, 0x74, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74,
+    0x65, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x6e, 0x6f, 0x74,
+    0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61,
+    0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e,
+    0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x6e, 0x6f, 0x74, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+    0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x6e,
+    0x6f, 0x74, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x69,
+    0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x65,
+    0x78, 0x74, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+    0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x33, 0x0a, 0x10, 0x52, 0x61, 0x6e, 0x64, 0x6f,
+    0x6d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x05, 0x63,
+    0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xba, 0x48, 0x06, 0x1a,
+    0x04, 0x18, 0x1e, 0x20, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5a, 0x0a, 0x11,
+    0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
     0x65, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
     0x32, 0x19, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46,
     0x65, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65,
-    0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f,
-    0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72,
-    0x73, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18,
-    0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x22, 0x23,
-    0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75,
-    0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-    0x02, 0x69, 0x64, 0x22, 0x3c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x49, 0x6e, 0x66,
-    0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x03, 0x74, 0x61, 0x67,
-    0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70,
-    0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x03, 0x74, 0x61,
-    0x67, 0x22, 0x3f, 0x0a, 0x18, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65,
-    0x64, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a,
-    0x08, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x42,
-    0x08, 0xba, 0x48, 0x05, 0x92, 0x01, 0x02, 0x10, 0x1e, 0x52, 0x07, 0x6e, 0x6f, 0x74, 0x65, 0x49,
-    0x64, 0x73, 0x22, 0xc4, 0x01, 0x0a, 0x19, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x46,
-    0x65, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-    0x12, 0x4a, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-    0x32, 0x32, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42,
-    0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x73,
-    0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x45,
-    0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x1a, 0x5b, 0x0a, 0x0b,
-    0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-    0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x36, 0x0a,
-    0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e,
-    0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x65,
-    0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05,
-    0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2f, 0x0a, 0x1b, 0x47, 0x65, 0x74,
+    0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+    0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x36, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x46,
+    0x65, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
+    0x0a, 0x07, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
+    0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64,
+    0x22, 0x74, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x52,
+    0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18,
+    0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d,
+    0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x2e, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20,
+    0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+    0x31, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x45, 0x78,
+    0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x22, 0x65, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+    0x65, 0x6e, 0x64, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
+    0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12,
+    0x24, 0x0a, 0x08, 0x6e, 0x65, 0x65, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+    0x05, 0x42, 0x09, 0xba, 0x48, 0x06, 0x1a, 0x04, 0x18, 0x1e, 0x20, 0x00, 0x52, 0x07, 0x6e, 0x65,
+    0x65, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+    0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x83, 0x01,
+    0x0a, 0x14, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x47, 0x65, 0x74, 0x52, 0x65,
+    0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+    0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d,
+    0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+    0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65,
+    0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f,
+    0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4e,
+    0x65, 0x78, 0x74, 0x22, 0x4b, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+    0x63, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+    0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69,
+    0x64, 0x12, 0x1d, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+    0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x18, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+    0x22, 0x4c, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x65, 0x6e,
+    0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a,
+    0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e,
+    0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x4e,
+    0x6f, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x56,
+    0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x42, 0x79, 0x55, 0x69, 0x64, 0x52,
+    0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
+    0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73,
+    0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+    0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+    0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x84, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x46,
+    0x65, 0x65, 0x64, 0x42, 0x79, 0x55, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+    0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+    0x19, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65,
+    0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+    0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+    0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73,
+    0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03,
+    0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x22, 0x23, 0x0a,
+    0x11, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+    0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+    0x69, 0x64, 0x22, 0x3c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x49, 0x6e, 0x66, 0x6f,
+    0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18,
+    0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x03, 0x74, 0x61, 0x67,
+    0x22, 0x3f, 0x0a, 0x18, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64,
+    0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x08,
+    0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x42, 0x08,
+    0xba, 0x48, 0x05, 0x92, 0x01, 0x02, 0x10, 0x1e, 0x52, 0x07, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64,
+    0x73, 0x22, 0xc4, 0x01, 0x0a, 0x19, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x46, 0x65,
+    0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+    0x4a, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+    0x32, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61,
+    0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52,
+    0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x45, 0x6e,
+    0x74, 0x72, 0x79, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x1a, 0x5b, 0x0a, 0x0b, 0x52,
+    0x65, 0x73, 0x75, 0x6c, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+    0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x05,
+    0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x6f,
+    0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65,
+    0x64, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x76,
+    0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2f, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x50,
+    0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+    0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01,
+    0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x34, 0x0a, 0x1c, 0x47, 0x65, 0x74,
     0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e,
-    0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18,
-    0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x34, 0x0a, 0x1c, 0x47, 0x65,
-    0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75,
-    0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
-    0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-    0x22, 0x3b, 0x0a, 0x1e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x46, 0x65,
-    0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-    0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01,
-    0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x73, 0x22, 0xba, 0x01,
-    0x0a, 0x1f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x46, 0x65, 0x65, 0x64,
+    0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+    0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+    0x3b, 0x0a, 0x1e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x46, 0x65, 0x65,
+    0x64, 0x4e, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+    0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+    0x03, 0x28, 0x03, 0x52, 0x07, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x73, 0x22, 0xba, 0x01, 0x0a,
+    0x1f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x46, 0x65, 0x65, 0x64, 0x4e,
+    0x6f, 0x74, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+    0x12, 0x59, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
+    0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+    0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x46, 0x65, 0x65, 0x64,
     0x4e, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-    0x65, 0x12, 0x59, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01,
-    0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-    0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x46, 0x65, 0x65,
-    0x64, 0x4e, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-    0x73, 0x65, 0x2e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72,
-    0x79, 0x52, 0x09, 0x65, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0x3c, 0x0a, 0x0e,
-    0x45, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-    0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79,
-    0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-    0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2f, 0x0a, 0x14, 0x47, 0x65,
-    0x74, 0x4e, 0x6f, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-    0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-    0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x22, 0x2f, 0x0a, 0x15, 0x47,
-    0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
-    0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x01,
-    0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x32, 0xb6, 0x07, 0x0a,
-    0x0f, 0x4e, 0x6f, 0x74, 0x65, 0x46, 0x65, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-    0x12, 0x4a, 0x0a, 0x09, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x47, 0x65, 0x74, 0x12, 0x1d, 0x2e,
-    0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e, 0x64,
-    0x6f, 0x6d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6e,
-    0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x6f,
-    0x6d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0b,
-    0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x6e, 0x6f,
-    0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65,
-    0x64, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6e,
-    0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x65,
-    0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56,
-    0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12,
-    0x21, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-    0x74, 0x4e, 0x6f, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-    0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-    0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x65,
-    0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x11, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47,
-    0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x6e, 0x6f,
-    0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47,
-    0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-    0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-    0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x4e, 0x6f, 0x74,
-    0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0c, 0x52, 0x65,
-    0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x47, 0x65, 0x74, 0x12, 0x20, 0x2e, 0x6e, 0x6f, 0x74,
-    0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-    0x6e, 0x64, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6e,
-    0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d,
-    0x6d, 0x65, 0x6e, 0x64, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-    0x62, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74,
-    0x50, 0x6f, 0x73, 0x74, 0x12, 0x25, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-    0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74,
-    0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6e, 0x6f,
-    0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
-    0x72, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-    0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x42,
-    0x79, 0x55, 0x69, 0x64, 0x12, 0x21, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-    0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x42, 0x79, 0x55, 0x69, 0x64,
-    0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61,
-    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x42, 0x79,
-    0x55, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x47,
-    0x65, 0x74, 0x54, 0x61, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x2e, 0x6e, 0x6f, 0x74, 0x65,
-    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x49, 0x6e,
-    0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6e, 0x6f, 0x74, 0x65,
-    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x49, 0x6e,
-    0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x14, 0x47, 0x65,
-    0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75,
-    0x6e, 0x74, 0x12, 0x28, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-    0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x64,
-    0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6e,
-    0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x75,
-    0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-    0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68,
-    0x43, 0x68, 0x65, 0x63, 0x6b, 0x46, 0x65, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x69,
-    0x73, 0x74, 0x12, 0x2b, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-    0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x46, 0x65, 0x65, 0x64, 0x4e,
-    0x6f, 0x74, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-    0x2c, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61,
-    0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x46, 0x65, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65,
-    0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x06, 0x8a,
-    0xb5, 0x18, 0x02, 0x08, 0x01, 0x42, 0xa6, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f,
-    0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x42, 0x0d, 0x4e, 0x6f, 0x74, 0x65, 0x66,
-    0x65, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68,
-    0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x79, 0x61, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x62,
-    0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x77, 0x68, 0x69, 0x6d, 0x65, 0x72, 0x2f, 0x69, 0x64, 0x6c, 0x2f,
-    0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-    0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x41, 0x58, 0xaa, 0x02, 0x0b, 0x4e, 0x6f, 0x74, 0x65, 0x2e,
-    0x41, 0x70, 0x69, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0b, 0x4e, 0x6f, 0x74, 0x65, 0x5c, 0x41, 0x70,
-    0x69, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x17, 0x4e, 0x6f, 0x74, 0x65, 0x5c, 0x41, 0x70, 0x69, 0x5c,
-    0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-    0x0d, 0x4e, 0x6f, 0x74, 0x65, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a, 0x3a, 0x56, 0x31, 0x4a, 0xf5,
-    0x19, 0x0a, 0x07, 0x12, 0x05, 0x00, 0x00, 0x83, 0x01, 0x01, 0x0a, 0x08, 0x0a, 0x01, 0x0c, 0x12,
-    0x03, 0x00, 0x00, 0x12, 0x0a, 0x08, 0x0a, 0x01, 0x02, 0x12, 0x03, 0x02, 0x00, 0x14, 0x0a, 0x09,
-    0x0a, 0x02, 0x03, 0x00, 0x12, 0x03, 0x04, 0x00, 0x25, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x01, 0x12,
-    0x03, 0x05, 0x00, 0x20, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x02, 0x12, 0x03, 0x06, 0x00, 0x28, 0x0a,
-    0x09, 0x0a, 0x02, 0x03, 0x03, 0x12, 0x03, 0x07, 0x00, 0x22, 0x0a, 0x08, 0x0a, 0x01, 0x08, 0x12,
-    0x03, 0x09, 0x00, 0x4d, 0x0a, 0x09, 0x0a, 0x02, 0x08, 0x0b, 0x12, 0x03, 0x09, 0x00, 0x4d, 0x0a,
-    0x0a, 0x0a, 0x02, 0x04, 0x00, 0x12, 0x04, 0x0b, 0x00, 0x0d, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04,
-    0x00, 0x01, 0x12, 0x03, 0x0b, 0x08, 0x18, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x00, 0x12,
-    0x03, 0x0c, 0x02, 0x5b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x05, 0x12, 0x03, 0x0c,
-    0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x0c, 0x08, 0x0d,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x0c, 0x10, 0x11, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x08, 0x12, 0x03, 0x0c, 0x12, 0x5a, 0x0a, 0x10, 0x0a, 0x09,
-    0x04, 0x00, 0x02, 0x00, 0x08, 0x87, 0x09, 0x03, 0x04, 0x12, 0x03, 0x0c, 0x13, 0x34, 0x0a, 0x10,
-    0x0a, 0x09, 0x04, 0x00, 0x02, 0x00, 0x08, 0x87, 0x09, 0x03, 0x03, 0x12, 0x03, 0x0c, 0x36, 0x59,
-    0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x01, 0x12, 0x04, 0x0f, 0x00, 0x12, 0x01, 0x0a, 0x0a, 0x0a, 0x03,
-    0x04, 0x01, 0x01, 0x12, 0x03, 0x0f, 0x08, 0x19, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x00,
-    0x12, 0x03, 0x10, 0x02, 0x22, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x04, 0x12, 0x03,
-    0x10, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x06, 0x12, 0x03, 0x10, 0x0b,
-    0x17, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x01, 0x12, 0x03, 0x10, 0x18, 0x1d, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x03, 0x12, 0x03, 0x10, 0x20, 0x21, 0x0a, 0x0b, 0x0a,
-    0x04, 0x04, 0x01, 0x02, 0x01, 0x12, 0x03, 0x11, 0x02, 0x22, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01,
-    0x02, 0x01, 0x05, 0x12, 0x03, 0x11, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01,
-    0x01, 0x12, 0x03, 0x11, 0x18, 0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x03, 0x12,
-    0x03, 0x11, 0x20, 0x21, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x02, 0x12, 0x04, 0x14, 0x00, 0x16, 0x01,
-    0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x02, 0x01, 0x12, 0x03, 0x14, 0x08, 0x1a, 0x0a, 0x16, 0x0a, 0x04,
-    0x04, 0x02, 0x02, 0x00, 0x12, 0x03, 0x15, 0x02, 0x38, 0x22, 0x09, 0xe7, 0xac, 0x94, 0xe8, 0xae,
-    0xb0, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x05, 0x12, 0x03, 0x15,
-    0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x01, 0x12, 0x03, 0x15, 0x08, 0x0f,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x03, 0x12, 0x03, 0x15, 0x12, 0x13, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x08, 0x12, 0x03, 0x15, 0x14, 0x37, 0x0a, 0x10, 0x0a, 0x09,
-    0x04, 0x02, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x03, 0x15, 0x15, 0x36, 0x0a, 0x0a,
-    0x0a, 0x02, 0x04, 0x03, 0x12, 0x04, 0x18, 0x00, 0x1b, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x03,
-    0x01, 0x12, 0x03, 0x18, 0x08, 0x1b, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x03, 0x02, 0x00, 0x12, 0x03,
-    0x19, 0x02, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x06, 0x12, 0x03, 0x19, 0x02,
-    0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x01, 0x12, 0x03, 0x19, 0x12, 0x16, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x03, 0x12, 0x03, 0x19, 0x19, 0x1a, 0x0a, 0x0b, 0x0a,
-    0x04, 0x04, 0x03, 0x02, 0x01, 0x12, 0x03, 0x1a, 0x02, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03,
-    0x02, 0x01, 0x06, 0x12, 0x03, 0x1a, 0x02, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01,
-    0x01, 0x12, 0x03, 0x1a, 0x12, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01, 0x03, 0x12,
-    0x03, 0x1a, 0x19, 0x1a, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x04, 0x12, 0x04, 0x1d, 0x00, 0x21, 0x01,
-    0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x04, 0x01, 0x12, 0x03, 0x1d, 0x08, 0x1b, 0x0a, 0x17, 0x0a, 0x04,
-    0x04, 0x04, 0x02, 0x00, 0x12, 0x03, 0x1e, 0x02, 0x15, 0x22, 0x0a, 0x20, 0xe7, 0x94, 0xa8, 0xe6,
-    0x88, 0xb7, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x05, 0x12, 0x03,
-    0x1e, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x01, 0x12, 0x03, 0x1e, 0x08,
-    0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x03, 0x12, 0x03, 0x1e, 0x13, 0x14, 0x0a,
-    0x1c, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x01, 0x12, 0x04, 0x1f, 0x02, 0x20, 0x3b, 0x22, 0x0e, 0x20,
-    0xe6, 0x8e, 0xa8, 0xe8, 0x8d, 0x90, 0xe6, 0x9d, 0xa1, 0xe6, 0x95, 0xb0, 0x0a, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x04, 0x02, 0x01, 0x05, 0x12, 0x03, 0x1f, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x04, 0x02, 0x01, 0x01, 0x12, 0x03, 0x1f, 0x08, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02,
-    0x01, 0x03, 0x12, 0x03, 0x1f, 0x13, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x01, 0x08,
-    0x12, 0x04, 0x1f, 0x15, 0x20, 0x3a, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x04, 0x02, 0x01, 0x08, 0x87,
-    0x09, 0x03, 0x04, 0x12, 0x03, 0x1f, 0x16, 0x38, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x04, 0x02, 0x01,
-    0x08, 0x87, 0x09, 0x03, 0x03, 0x12, 0x03, 0x20, 0x16, 0x39, 0x0a, 0x09, 0x0a, 0x02, 0x04, 0x05,
-    0x12, 0x03, 0x23, 0x00, 0x1f, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x05, 0x01, 0x12, 0x03, 0x23, 0x08,
-    0x1c, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x06, 0x12, 0x04, 0x25, 0x00, 0x28, 0x01, 0x0a, 0x0a, 0x0a,
-    0x03, 0x04, 0x06, 0x01, 0x12, 0x03, 0x25, 0x08, 0x20, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x06, 0x02,
-    0x00, 0x12, 0x03, 0x26, 0x02, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x05, 0x12,
-    0x03, 0x26, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x01, 0x12, 0x03, 0x26,
-    0x08, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x03, 0x12, 0x03, 0x26, 0x10, 0x11,
-    0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x06, 0x02, 0x01, 0x12, 0x03, 0x27, 0x02, 0x37, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x06, 0x02, 0x01, 0x05, 0x12, 0x03, 0x27, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x06, 0x02, 0x01, 0x01, 0x12, 0x03, 0x27, 0x08, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02,
-    0x01, 0x03, 0x12, 0x03, 0x27, 0x10, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x01, 0x08,
-    0x12, 0x03, 0x27, 0x12, 0x36, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x06, 0x02, 0x01, 0x08, 0x87, 0x09,
-    0x03, 0x03, 0x12, 0x03, 0x27, 0x13, 0x35, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x07, 0x12, 0x04, 0x2a,
-    0x00, 0x2c, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x07, 0x01, 0x12, 0x03, 0x2a, 0x08, 0x21, 0x0a,
-    0x0b, 0x0a, 0x04, 0x04, 0x07, 0x02, 0x00, 0x12, 0x03, 0x2b, 0x02, 0x22, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x07, 0x02, 0x00, 0x04, 0x12, 0x03, 0x2b, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07,
-    0x02, 0x00, 0x06, 0x12, 0x03, 0x2b, 0x0b, 0x17, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00,
-    0x01, 0x12, 0x03, 0x2b, 0x18, 0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x03, 0x12,
-    0x03, 0x2b, 0x20, 0x21, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x08, 0x12, 0x04, 0x2e, 0x00, 0x32, 0x01,
-    0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x08, 0x01, 0x12, 0x03, 0x2e, 0x08, 0x1c, 0x0a, 0x0b, 0x0a, 0x04,
-    0x04, 0x08, 0x02, 0x00, 0x12, 0x03, 0x2f, 0x02, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02,
-    0x00, 0x05, 0x12, 0x03, 0x2f, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x01,
-    0x12, 0x03, 0x2f, 0x08, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x03, 0x12, 0x03,
-    0x2f, 0x11, 0x12, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x08, 0x02, 0x01, 0x12, 0x03, 0x30, 0x02, 0x13,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x01, 0x05, 0x12, 0x03, 0x30, 0x02, 0x07, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x08, 0x02, 0x01, 0x01, 0x12, 0x03, 0x30, 0x08, 0x0e, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x08, 0x02, 0x01, 0x03, 0x12, 0x03, 0x30, 0x11, 0x12, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x08,
-    0x02, 0x02, 0x12, 0x03, 0x31, 0x02, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x02, 0x05,
-    0x12, 0x03, 0x31, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x02, 0x01, 0x12, 0x03,
-    0x31, 0x08, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x02, 0x03, 0x12, 0x03, 0x31, 0x11,
-    0x12, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x09, 0x12, 0x04, 0x34, 0x00, 0x38, 0x01, 0x0a, 0x0a, 0x0a,
-    0x03, 0x04, 0x09, 0x01, 0x12, 0x03, 0x34, 0x08, 0x1d, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x09, 0x02,
-    0x00, 0x12, 0x03, 0x35, 0x02, 0x28, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x00, 0x04, 0x12,
-    0x03, 0x35, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x00, 0x06, 0x12, 0x03, 0x35,
-    0x0b, 0x17, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x00, 0x01, 0x12, 0x03, 0x35, 0x18, 0x1d,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x00, 0x03, 0x12, 0x03, 0x35, 0x26, 0x27, 0x0a, 0x0b,
-    0x0a, 0x04, 0x04, 0x09, 0x02, 0x01, 0x12, 0x03, 0x36, 0x02, 0x28, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x09, 0x02, 0x01, 0x05, 0x12, 0x03, 0x36, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02,
-    0x01, 0x01, 0x12, 0x03, 0x36, 0x18, 0x23, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x01, 0x03,
-    0x12, 0x03, 0x36, 0x26, 0x27, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x09, 0x02, 0x02, 0x12, 0x03, 0x37,
-    0x02, 0x28, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x02, 0x05, 0x12, 0x03, 0x37, 0x02, 0x06,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x02, 0x01, 0x12, 0x03, 0x37, 0x18, 0x20, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x09, 0x02, 0x02, 0x03, 0x12, 0x03, 0x37, 0x26, 0x27, 0x0a, 0x0a, 0x0a, 0x02,
-    0x04, 0x0a, 0x12, 0x04, 0x3a, 0x00, 0x3c, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0a, 0x01, 0x12,
-    0x03, 0x3a, 0x08, 0x19, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0a, 0x02, 0x00, 0x12, 0x03, 0x3b, 0x02,
-    0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x00, 0x05, 0x12, 0x03, 0x3b, 0x02, 0x07, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x00, 0x01, 0x12, 0x03, 0x3b, 0x08, 0x0a, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x0a, 0x02, 0x00, 0x03, 0x12, 0x03, 0x3b, 0x0d, 0x0e, 0x0a, 0x0a, 0x0a, 0x02, 0x04,
-    0x0b, 0x12, 0x04, 0x3e, 0x00, 0x40, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0b, 0x01, 0x12, 0x03,
-    0x3e, 0x08, 0x1a, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0b, 0x02, 0x00, 0x12, 0x03, 0x3f, 0x02, 0x12,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x00, 0x06, 0x12, 0x03, 0x3f, 0x02, 0x09, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x0b, 0x02, 0x00, 0x01, 0x12, 0x03, 0x3f, 0x0a, 0x0d, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x0b, 0x02, 0x00, 0x03, 0x12, 0x03, 0x3f, 0x10, 0x11, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0c,
-    0x12, 0x04, 0x42, 0x00, 0x44, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0c, 0x01, 0x12, 0x03, 0x42,
-    0x08, 0x20, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0c, 0x02, 0x00, 0x12, 0x03, 0x43, 0x02, 0x4e, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x00, 0x04, 0x12, 0x03, 0x43, 0x02, 0x0a, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x0c, 0x02, 0x00, 0x05, 0x12, 0x03, 0x43, 0x0b, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x0c, 0x02, 0x00, 0x01, 0x12, 0x03, 0x43, 0x11, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02,
-    0x00, 0x03, 0x12, 0x03, 0x43, 0x1c, 0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x00, 0x08,
-    0x12, 0x03, 0x43, 0x1e, 0x4d, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x0c, 0x02, 0x00, 0x08, 0x87, 0x09,
-    0x12, 0x02, 0x12, 0x03, 0x43, 0x1f, 0x4c, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0d, 0x12, 0x04, 0x46,
-    0x00, 0x48, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0d, 0x01, 0x12, 0x03, 0x46, 0x08, 0x21, 0x0a,
-    0x0b, 0x0a, 0x04, 0x04, 0x0d, 0x02, 0x00, 0x12, 0x03, 0x47, 0x02, 0x2d, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x0d, 0x02, 0x00, 0x06, 0x12, 0x03, 0x47, 0x02, 0x21, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0d,
-    0x02, 0x00, 0x01, 0x12, 0x03, 0x47, 0x22, 0x28, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0d, 0x02, 0x00,
-    0x03, 0x12, 0x03, 0x47, 0x2b, 0x2c, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0e, 0x12, 0x04, 0x4a, 0x00,
-    0x4c, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0e, 0x01, 0x12, 0x03, 0x4a, 0x08, 0x23, 0x0a, 0x17,
-    0x0a, 0x04, 0x04, 0x0e, 0x02, 0x00, 0x12, 0x03, 0x4b, 0x02, 0x10, 0x22, 0x0a, 0x20, 0xe7, 0x94,
-    0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x00, 0x05,
-    0x12, 0x03, 0x4b, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x00, 0x01, 0x12, 0x03,
-    0x4b, 0x08, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x00, 0x03, 0x12, 0x03, 0x4b, 0x0e,
-    0x0f, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0f, 0x12, 0x04, 0x4e, 0x00, 0x50, 0x01, 0x0a, 0x0a, 0x0a,
-    0x03, 0x04, 0x0f, 0x01, 0x12, 0x03, 0x4e, 0x08, 0x24, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0f, 0x02,
-    0x00, 0x12, 0x03, 0x4f, 0x02, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x00, 0x05, 0x12,
-    0x03, 0x4f, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x00, 0x01, 0x12, 0x03, 0x4f,
-    0x08, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x00, 0x03, 0x12, 0x03, 0x4f, 0x10, 0x11,
-    0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x10, 0x12, 0x04, 0x52, 0x00, 0x54, 0x01, 0x0a, 0x0a, 0x0a, 0x03,
-    0x04, 0x10, 0x01, 0x12, 0x03, 0x52, 0x08, 0x26, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x10, 0x02, 0x00,
-    0x12, 0x03, 0x53, 0x02, 0x1e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x00, 0x04, 0x12, 0x03,
-    0x53, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x00, 0x05, 0x12, 0x03, 0x53, 0x0b,
-    0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x00, 0x01, 0x12, 0x03, 0x53, 0x11, 0x19, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x00, 0x03, 0x12, 0x03, 0x53, 0x1c, 0x1d, 0x0a, 0x0a, 0x0a,
-    0x02, 0x04, 0x11, 0x12, 0x04, 0x56, 0x00, 0x58, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x11, 0x01,
-    0x12, 0x03, 0x56, 0x08, 0x27, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x11, 0x02, 0x00, 0x12, 0x03, 0x57,
-    0x02, 0x21, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x11, 0x02, 0x00, 0x06, 0x12, 0x03, 0x57, 0x02, 0x12,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x11, 0x02, 0x00, 0x01, 0x12, 0x03, 0x57, 0x13, 0x1c, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x11, 0x02, 0x00, 0x03, 0x12, 0x03, 0x57, 0x1f, 0x20, 0x0a, 0x0a, 0x0a, 0x02,
-    0x04, 0x12, 0x12, 0x04, 0x5a, 0x00, 0x5c, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x12, 0x01, 0x12,
-    0x03, 0x5a, 0x08, 0x1c, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x12, 0x02, 0x00, 0x12, 0x03, 0x5b, 0x02,
-    0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x12, 0x02, 0x00, 0x05, 0x12, 0x03, 0x5b, 0x02, 0x07, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x12, 0x02, 0x00, 0x01, 0x12, 0x03, 0x5b, 0x08, 0x0f, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x12, 0x02, 0x00, 0x03, 0x12, 0x03, 0x5b, 0x12, 0x13, 0x0a, 0x0a, 0x0a, 0x02, 0x04,
-    0x13, 0x12, 0x04, 0x5e, 0x00, 0x60, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x13, 0x01, 0x12, 0x03,
-    0x5e, 0x08, 0x1d, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x13, 0x02, 0x00, 0x12, 0x03, 0x5f, 0x02, 0x13,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x13, 0x02, 0x00, 0x05, 0x12, 0x03, 0x5f, 0x02, 0x07, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x13, 0x02, 0x00, 0x01, 0x12, 0x03, 0x5f, 0x08, 0x0e, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x13, 0x02, 0x00, 0x03, 0x12, 0x03, 0x5f, 0x11, 0x12, 0x0a, 0x2e, 0x0a, 0x02, 0x06, 0x00,
-    0x12, 0x05, 0x63, 0x00, 0x83, 0x01, 0x01, 0x1a, 0x21, 0x20, 0x6e, 0x6f, 0x74, 0x65, 0xe7, 0x9b,
+    0x65, 0x2e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+    0x52, 0x09, 0x65, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0x3c, 0x0a, 0x0e, 0x45,
+    0x78, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+    0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+    0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+    0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2f, 0x0a, 0x14, 0x47, 0x65, 0x74,
+    0x4e, 0x6f, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+    0x74, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+    0x28, 0x03, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x22, 0x2f, 0x0a, 0x15, 0x47, 0x65,
+    0x74, 0x4e, 0x6f, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+    0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x01, 0x20,
+    0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x22, 0x37, 0x0a, 0x1a, 0x4c,
+    0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+    0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x75, 0x69, 0x64,
+    0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52,
+    0x03, 0x75, 0x69, 0x64, 0x22, 0x50, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
+    0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+    0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
+    0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+    0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+    0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x77, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+    0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75,
+    0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+    0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22,
+    0x02, 0x20, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+    0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+    0x03, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+    0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+    0xc6, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+    0x6f, 0x6e, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b,
+    0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+    0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+    0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+    0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x05, 0x69,
+    0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x6f, 0x74,
+    0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x4e, 0x6f, 0x74,
+    0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+    0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+    0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x19, 0x0a,
+    0x08, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+    0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x32, 0x87, 0x09, 0x0a, 0x0f, 0x4e, 0x6f, 0x74,
+    0x65, 0x46, 0x65, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x09,
+    0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x47, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x6e, 0x6f, 0x74, 0x65,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x47, 0x65,
+    0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e,
+    0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x47, 0x65, 0x74,
+    0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x46,
+    0x65, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x4e, 0x6f, 0x74,
+    0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e,
+    0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x4e, 0x6f,
+    0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0d, 0x47, 0x65,
+    0x74, 0x4e, 0x6f, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x21, 0x2e, 0x6e, 0x6f,
+    0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74,
+    0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+    0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+    0x4e, 0x6f, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+    0x73, 0x65, 0x12, 0x62, 0x0a, 0x11, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x46, 0x65,
+    0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x46, 0x65,
+    0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+    0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74,
+    0x63, 0x68, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65,
+    0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0c, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+    0x65, 0x6e, 0x64, 0x47, 0x65, 0x74, 0x12, 0x20, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70,
+    0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x47, 0x65,
+    0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e,
+    0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+    0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x11, 0x47,
+    0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74,
+    0x12, 0x25, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+    0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74,
+    0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63,
+    0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+    0x56, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x42, 0x79, 0x55, 0x69, 0x64,
+    0x12, 0x21, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+    0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x42, 0x79, 0x55, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75,
+    0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+    0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x42, 0x79, 0x55, 0x69, 0x64, 0x52,
+    0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x54, 0x61,
+    0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+    0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+    0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62,
+    0x6c, 0x69, 0x63, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28,
+    0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+    0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e,
+    0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e,
+    0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+    0x50, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+    0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63,
+    0x6b, 0x46, 0x65, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x12, 0x2b,
+    0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74,
+    0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x46, 0x65, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x45,
+    0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6e, 0x6f,
+    0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43,
+    0x68, 0x65, 0x63, 0x6b, 0x46, 0x65, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x69, 0x73,
+    0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x13, 0x4c, 0x69, 0x73,
+    0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+    0x12, 0x27, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+    0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+    0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6e, 0x6f, 0x74, 0x65,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
+    0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+    0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+    0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x64, 0x12, 0x26, 0x2e, 0x6e, 0x6f, 0x74, 0x65,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c,
+    0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+    0x74, 0x1a, 0x27, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+    0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65,
+    0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x06, 0x8a, 0xb5, 0x18, 0x02,
+    0x08, 0x01, 0x42, 0xa6, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x2e,
+    0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x42, 0x0d, 0x4e, 0x6f, 0x74, 0x65, 0x66, 0x65, 0x65, 0x64,
+    0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+    0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x79, 0x61, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x62, 0x6f, 0x6f, 0x6b,
+    0x73, 0x2f, 0x77, 0x68, 0x69, 0x6d, 0x65, 0x72, 0x2f, 0x69, 0x64, 0x6c, 0x2f, 0x67, 0x65, 0x6e,
+    0x2f, 0x67, 0x6f, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0xa2,
+    0x02, 0x03, 0x4e, 0x41, 0x58, 0xaa, 0x02, 0x0b, 0x4e, 0x6f, 0x74, 0x65, 0x2e, 0x41, 0x70, 0x69,
+    0x2e, 0x56, 0x31, 0xca, 0x02, 0x0b, 0x4e, 0x6f, 0x74, 0x65, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56,
+    0x31, 0xe2, 0x02, 0x17, 0x4e, 0x6f, 0x74, 0x65, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0x5c,
+    0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x4e, 0x6f,
+    0x74, 0x65, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a, 0x3a, 0x56, 0x31, 0x4a, 0xdc, 0x23, 0x0a, 0x07,
+    0x12, 0x05, 0x00, 0x00, 0xa3, 0x01, 0x01, 0x0a, 0x08, 0x0a, 0x01, 0x0c, 0x12, 0x03, 0x00, 0x00,
+    0x12, 0x0a, 0x08, 0x0a, 0x01, 0x02, 0x12, 0x03, 0x02, 0x00, 0x14, 0x0a, 0x09, 0x0a, 0x02, 0x03,
+    0x00, 0x12, 0x03, 0x04, 0x00, 0x25, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x01, 0x12, 0x03, 0x05, 0x00,
+    0x20, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x02, 0x12, 0x03, 0x06, 0x00, 0x28, 0x0a, 0x09, 0x0a, 0x02,
+    0x03, 0x03, 0x12, 0x03, 0x07, 0x00, 0x22, 0x0a, 0x08, 0x0a, 0x01, 0x08, 0x12, 0x03, 0x09, 0x00,
+    0x4d, 0x0a, 0x09, 0x0a, 0x02, 0x08, 0x0b, 0x12, 0x03, 0x09, 0x00, 0x4d, 0x0a, 0x0a, 0x0a, 0x02,
+    0x04, 0x00, 0x12, 0x04, 0x0b, 0x00, 0x0d, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x00, 0x01, 0x12,
+    0x03, 0x0b, 0x08, 0x18, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x00, 0x12, 0x03, 0x0c, 0x02,
+    0x5b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x05, 0x12, 0x03, 0x0c, 0x02, 0x07, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x0c, 0x08, 0x0d, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x0c, 0x10, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x00, 0x02, 0x00, 0x08, 0x12, 0x03, 0x0c, 0x12, 0x5a, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x00, 0x02,
+    0x00, 0x08, 0x87, 0x09, 0x03, 0x04, 0x12, 0x03, 0x0c, 0x13, 0x34, 0x0a, 0x10, 0x0a, 0x09, 0x04,
+    0x00, 0x02, 0x00, 0x08, 0x87, 0x09, 0x03, 0x03, 0x12, 0x03, 0x0c, 0x36, 0x59, 0x0a, 0x0a, 0x0a,
+    0x02, 0x04, 0x01, 0x12, 0x04, 0x0f, 0x00, 0x12, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x01, 0x01,
+    0x12, 0x03, 0x0f, 0x08, 0x19, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x00, 0x12, 0x03, 0x10,
+    0x02, 0x22, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x04, 0x12, 0x03, 0x10, 0x02, 0x0a,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x06, 0x12, 0x03, 0x10, 0x0b, 0x17, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x01, 0x12, 0x03, 0x10, 0x18, 0x1d, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x01, 0x02, 0x00, 0x03, 0x12, 0x03, 0x10, 0x20, 0x21, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01,
+    0x02, 0x01, 0x12, 0x03, 0x11, 0x02, 0x22, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x05,
+    0x12, 0x03, 0x11, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x01, 0x12, 0x03,
+    0x11, 0x18, 0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x03, 0x12, 0x03, 0x11, 0x20,
+    0x21, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x02, 0x12, 0x04, 0x14, 0x00, 0x16, 0x01, 0x0a, 0x0a, 0x0a,
+    0x03, 0x04, 0x02, 0x01, 0x12, 0x03, 0x14, 0x08, 0x1a, 0x0a, 0x16, 0x0a, 0x04, 0x04, 0x02, 0x02,
+    0x00, 0x12, 0x03, 0x15, 0x02, 0x38, 0x22, 0x09, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x69, 0x64,
+    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x05, 0x12, 0x03, 0x15, 0x02, 0x07, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x01, 0x12, 0x03, 0x15, 0x08, 0x0f, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x02, 0x02, 0x00, 0x03, 0x12, 0x03, 0x15, 0x12, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x02, 0x02, 0x00, 0x08, 0x12, 0x03, 0x15, 0x14, 0x37, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x02, 0x02,
+    0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x03, 0x15, 0x15, 0x36, 0x0a, 0x0a, 0x0a, 0x02, 0x04,
+    0x03, 0x12, 0x04, 0x18, 0x00, 0x1b, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x03, 0x01, 0x12, 0x03,
+    0x18, 0x08, 0x1b, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x03, 0x02, 0x00, 0x12, 0x03, 0x19, 0x02, 0x1b,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x06, 0x12, 0x03, 0x19, 0x02, 0x0e, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x01, 0x12, 0x03, 0x19, 0x12, 0x16, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x03, 0x02, 0x00, 0x03, 0x12, 0x03, 0x19, 0x19, 0x1a, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x03,
+    0x02, 0x01, 0x12, 0x03, 0x1a, 0x02, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01, 0x06,
+    0x12, 0x03, 0x1a, 0x02, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01, 0x01, 0x12, 0x03,
+    0x1a, 0x12, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01, 0x03, 0x12, 0x03, 0x1a, 0x19,
+    0x1a, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x04, 0x12, 0x04, 0x1d, 0x00, 0x22, 0x01, 0x0a, 0x0a, 0x0a,
+    0x03, 0x04, 0x04, 0x01, 0x12, 0x03, 0x1d, 0x08, 0x1b, 0x0a, 0x17, 0x0a, 0x04, 0x04, 0x04, 0x02,
+    0x00, 0x12, 0x03, 0x1e, 0x02, 0x15, 0x22, 0x0a, 0x20, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69,
+    0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x05, 0x12, 0x03, 0x1e, 0x02, 0x07,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x01, 0x12, 0x03, 0x1e, 0x08, 0x0b, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x03, 0x12, 0x03, 0x1e, 0x13, 0x14, 0x0a, 0x1c, 0x0a, 0x04,
+    0x04, 0x04, 0x02, 0x01, 0x12, 0x04, 0x1f, 0x02, 0x20, 0x3b, 0x22, 0x0e, 0x20, 0xe6, 0x8e, 0xa8,
+    0xe8, 0x8d, 0x90, 0xe6, 0x9d, 0xa1, 0xe6, 0x95, 0xb0, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04,
+    0x02, 0x01, 0x05, 0x12, 0x03, 0x1f, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x01,
+    0x01, 0x12, 0x03, 0x1f, 0x08, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x01, 0x03, 0x12,
+    0x03, 0x1f, 0x13, 0x14, 0x0a, 0x0d, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x01, 0x08, 0x12, 0x04, 0x1f,
+    0x15, 0x20, 0x3a, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x04, 0x02, 0x01, 0x08, 0x87, 0x09, 0x03, 0x04,
+    0x12, 0x03, 0x1f, 0x16, 0x38, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x04, 0x02, 0x01, 0x08, 0x87, 0x09,
+    0x03, 0x03, 0x12, 0x03, 0x20, 0x16, 0x39, 0x0a, 0x58, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x02, 0x12,
+    0x03, 0x21, 0x02, 0x14, 0x22, 0x4b, 0x20, 0xe7, 0xbf, 0xbb, 0xe9, 0xa1, 0xb5, 0xe6, 0xb8, 0xb8,
+    0xe6, 0xa0, 0x87, 0x20, 0xe9, 0xa6, 0x96, 0xe6, 0xac, 0xa1, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82,
+    0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0x20, 0xe4, 0xb9, 0x8b, 0xe5, 0x90, 0x8e, 0xe4, 0xbc, 0xa0,
+    0xe5, 0x85, 0xa5, 0xe4, 0xb8, 0x8a, 0xe4, 0xb8, 0x80, 0xe6, 0xac, 0xa1, 0xe8, 0xbf, 0x94, 0xe5,
+    0x9b, 0x9e, 0xe7, 0x9a, 0x84, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x02, 0x05, 0x12, 0x03, 0x21, 0x02, 0x08, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x02, 0x01, 0x12, 0x03, 0x21, 0x09, 0x0f, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x04, 0x02, 0x02, 0x03, 0x12, 0x03, 0x21, 0x12, 0x13, 0x0a, 0x0a, 0x0a, 0x02, 0x04,
+    0x05, 0x12, 0x04, 0x24, 0x00, 0x28, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x05, 0x01, 0x12, 0x03,
+    0x24, 0x08, 0x1c, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x05, 0x02, 0x00, 0x12, 0x03, 0x25, 0x02, 0x28,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x00, 0x04, 0x12, 0x03, 0x25, 0x02, 0x0a, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x05, 0x02, 0x00, 0x06, 0x12, 0x03, 0x25, 0x0b, 0x17, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x05, 0x02, 0x00, 0x01, 0x12, 0x03, 0x25, 0x18, 0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05,
+    0x02, 0x00, 0x03, 0x12, 0x03, 0x25, 0x26, 0x27, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x05, 0x02, 0x01,
+    0x12, 0x03, 0x26, 0x02, 0x28, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x01, 0x05, 0x12, 0x03,
+    0x26, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x01, 0x01, 0x12, 0x03, 0x26, 0x18,
+    0x23, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x01, 0x03, 0x12, 0x03, 0x26, 0x26, 0x27, 0x0a,
+    0x0b, 0x0a, 0x04, 0x04, 0x05, 0x02, 0x02, 0x12, 0x03, 0x27, 0x02, 0x28, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x05, 0x02, 0x02, 0x05, 0x12, 0x03, 0x27, 0x02, 0x06, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05,
+    0x02, 0x02, 0x01, 0x12, 0x03, 0x27, 0x18, 0x20, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x05, 0x02, 0x02,
+    0x03, 0x12, 0x03, 0x27, 0x26, 0x27, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x06, 0x12, 0x04, 0x2a, 0x00,
+    0x2d, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x06, 0x01, 0x12, 0x03, 0x2a, 0x08, 0x20, 0x0a, 0x0b,
+    0x0a, 0x04, 0x04, 0x06, 0x02, 0x00, 0x12, 0x03, 0x2b, 0x02, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x06, 0x02, 0x00, 0x05, 0x12, 0x03, 0x2b, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02,
+    0x00, 0x01, 0x12, 0x03, 0x2b, 0x08, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x03,
+    0x12, 0x03, 0x2b, 0x10, 0x11, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x06, 0x02, 0x01, 0x12, 0x03, 0x2c,
+    0x02, 0x37, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x01, 0x05, 0x12, 0x03, 0x2c, 0x02, 0x07,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x01, 0x01, 0x12, 0x03, 0x2c, 0x08, 0x0d, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x06, 0x02, 0x01, 0x03, 0x12, 0x03, 0x2c, 0x10, 0x11, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x06, 0x02, 0x01, 0x08, 0x12, 0x03, 0x2c, 0x12, 0x36, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x06,
+    0x02, 0x01, 0x08, 0x87, 0x09, 0x03, 0x03, 0x12, 0x03, 0x2c, 0x13, 0x35, 0x0a, 0x0a, 0x0a, 0x02,
+    0x04, 0x07, 0x12, 0x04, 0x2f, 0x00, 0x31, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x07, 0x01, 0x12,
+    0x03, 0x2f, 0x08, 0x21, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x07, 0x02, 0x00, 0x12, 0x03, 0x30, 0x02,
+    0x22, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x04, 0x12, 0x03, 0x30, 0x02, 0x0a, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x07, 0x02, 0x00, 0x06, 0x12, 0x03, 0x30, 0x0b, 0x17, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x07, 0x02, 0x00, 0x01, 0x12, 0x03, 0x30, 0x18, 0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x07, 0x02, 0x00, 0x03, 0x12, 0x03, 0x30, 0x20, 0x21, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x08, 0x12,
+    0x04, 0x33, 0x00, 0x37, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x08, 0x01, 0x12, 0x03, 0x33, 0x08,
+    0x1c, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x08, 0x02, 0x00, 0x12, 0x03, 0x34, 0x02, 0x13, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x05, 0x12, 0x03, 0x34, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x08, 0x02, 0x00, 0x01, 0x12, 0x03, 0x34, 0x08, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08,
+    0x02, 0x00, 0x03, 0x12, 0x03, 0x34, 0x11, 0x12, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x08, 0x02, 0x01,
+    0x12, 0x03, 0x35, 0x02, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x01, 0x05, 0x12, 0x03,
+    0x35, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x01, 0x01, 0x12, 0x03, 0x35, 0x08,
+    0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x01, 0x03, 0x12, 0x03, 0x35, 0x11, 0x12, 0x0a,
+    0x0b, 0x0a, 0x04, 0x04, 0x08, 0x02, 0x02, 0x12, 0x03, 0x36, 0x02, 0x13, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x08, 0x02, 0x02, 0x05, 0x12, 0x03, 0x36, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08,
+    0x02, 0x02, 0x01, 0x12, 0x03, 0x36, 0x08, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x02,
+    0x03, 0x12, 0x03, 0x36, 0x11, 0x12, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x09, 0x12, 0x04, 0x39, 0x00,
+    0x3d, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x09, 0x01, 0x12, 0x03, 0x39, 0x08, 0x1d, 0x0a, 0x0b,
+    0x0a, 0x04, 0x04, 0x09, 0x02, 0x00, 0x12, 0x03, 0x3a, 0x02, 0x28, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x09, 0x02, 0x00, 0x04, 0x12, 0x03, 0x3a, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02,
+    0x00, 0x06, 0x12, 0x03, 0x3a, 0x0b, 0x17, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x00, 0x01,
+    0x12, 0x03, 0x3a, 0x18, 0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x00, 0x03, 0x12, 0x03,
+    0x3a, 0x26, 0x27, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x09, 0x02, 0x01, 0x12, 0x03, 0x3b, 0x02, 0x28,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x01, 0x05, 0x12, 0x03, 0x3b, 0x02, 0x07, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x09, 0x02, 0x01, 0x01, 0x12, 0x03, 0x3b, 0x18, 0x23, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x09, 0x02, 0x01, 0x03, 0x12, 0x03, 0x3b, 0x26, 0x27, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x09,
+    0x02, 0x02, 0x12, 0x03, 0x3c, 0x02, 0x28, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x02, 0x05,
+    0x12, 0x03, 0x3c, 0x02, 0x06, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x02, 0x01, 0x12, 0x03,
+    0x3c, 0x18, 0x20, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x09, 0x02, 0x02, 0x03, 0x12, 0x03, 0x3c, 0x26,
+    0x27, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0a, 0x12, 0x04, 0x3f, 0x00, 0x41, 0x01, 0x0a, 0x0a, 0x0a,
+    0x03, 0x04, 0x0a, 0x01, 0x12, 0x03, 0x3f, 0x08, 0x19, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0a, 0x02,
+    0x00, 0x12, 0x03, 0x40, 0x02, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x00, 0x05, 0x12,
+    0x03, 0x40, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x00, 0x01, 0x12, 0x03, 0x40,
+    0x08, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x00, 0x03, 0x12, 0x03, 0x40, 0x0d, 0x0e,
+    0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0b, 0x12, 0x04, 0x43, 0x00, 0x45, 0x01, 0x0a, 0x0a, 0x0a, 0x03,
+    0x04, 0x0b, 0x01, 0x12, 0x03, 0x43, 0x08, 0x1a, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0b, 0x02, 0x00,
+    0x12, 0x03, 0x44, 0x02, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x00, 0x06, 0x12, 0x03,
+    0x44, 0x02, 0x09, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x00, 0x01, 0x12, 0x03, 0x44, 0x0a,
+    0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x00, 0x03, 0x12, 0x03, 0x44, 0x10, 0x11, 0x0a,
+    0x0a, 0x0a, 0x02, 0x04, 0x0c, 0x12, 0x04, 0x47, 0x00, 0x49, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04,
+    0x0c, 0x01, 0x12, 0x03, 0x47, 0x08, 0x20, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0c, 0x02, 0x00, 0x12,
+    0x03, 0x48, 0x02, 0x4e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x00, 0x04, 0x12, 0x03, 0x48,
+    0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x00, 0x05, 0x12, 0x03, 0x48, 0x0b, 0x10,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x00, 0x01, 0x12, 0x03, 0x48, 0x11, 0x19, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x0c, 0x02, 0x00, 0x03, 0x12, 0x03, 0x48, 0x1c, 0x1d, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x0c, 0x02, 0x00, 0x08, 0x12, 0x03, 0x48, 0x1e, 0x4d, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x0c,
+    0x02, 0x00, 0x08, 0x87, 0x09, 0x12, 0x02, 0x12, 0x03, 0x48, 0x1f, 0x4c, 0x0a, 0x0a, 0x0a, 0x02,
+    0x04, 0x0d, 0x12, 0x04, 0x4b, 0x00, 0x4d, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0d, 0x01, 0x12,
+    0x03, 0x4b, 0x08, 0x21, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0d, 0x02, 0x00, 0x12, 0x03, 0x4c, 0x02,
+    0x2d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0d, 0x02, 0x00, 0x06, 0x12, 0x03, 0x4c, 0x02, 0x21, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x0d, 0x02, 0x00, 0x01, 0x12, 0x03, 0x4c, 0x22, 0x28, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x0d, 0x02, 0x00, 0x03, 0x12, 0x03, 0x4c, 0x2b, 0x2c, 0x0a, 0x0a, 0x0a, 0x02, 0x04,
+    0x0e, 0x12, 0x04, 0x4f, 0x00, 0x51, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0e, 0x01, 0x12, 0x03,
+    0x4f, 0x08, 0x23, 0x0a, 0x17, 0x0a, 0x04, 0x04, 0x0e, 0x02, 0x00, 0x12, 0x03, 0x50, 0x02, 0x10,
+    0x22, 0x0a, 0x20, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x69, 0x64, 0x0a, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x0e, 0x02, 0x00, 0x05, 0x12, 0x03, 0x50, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e,
+    0x02, 0x00, 0x01, 0x12, 0x03, 0x50, 0x08, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0e, 0x02, 0x00,
+    0x03, 0x12, 0x03, 0x50, 0x0e, 0x0f, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0f, 0x12, 0x04, 0x53, 0x00,
+    0x55, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0f, 0x01, 0x12, 0x03, 0x53, 0x08, 0x24, 0x0a, 0x0b,
+    0x0a, 0x04, 0x04, 0x0f, 0x02, 0x00, 0x12, 0x03, 0x54, 0x02, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x0f, 0x02, 0x00, 0x05, 0x12, 0x03, 0x54, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02,
+    0x00, 0x01, 0x12, 0x03, 0x54, 0x08, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0f, 0x02, 0x00, 0x03,
+    0x12, 0x03, 0x54, 0x10, 0x11, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x10, 0x12, 0x04, 0x57, 0x00, 0x59,
+    0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x10, 0x01, 0x12, 0x03, 0x57, 0x08, 0x26, 0x0a, 0x0b, 0x0a,
+    0x04, 0x04, 0x10, 0x02, 0x00, 0x12, 0x03, 0x58, 0x02, 0x1e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x10,
+    0x02, 0x00, 0x04, 0x12, 0x03, 0x58, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x00,
+    0x05, 0x12, 0x03, 0x58, 0x0b, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x00, 0x01, 0x12,
+    0x03, 0x58, 0x11, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x10, 0x02, 0x00, 0x03, 0x12, 0x03, 0x58,
+    0x1c, 0x1d, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x11, 0x12, 0x04, 0x5b, 0x00, 0x5d, 0x01, 0x0a, 0x0a,
+    0x0a, 0x03, 0x04, 0x11, 0x01, 0x12, 0x03, 0x5b, 0x08, 0x27, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x11,
+    0x02, 0x00, 0x12, 0x03, 0x5c, 0x02, 0x21, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x11, 0x02, 0x00, 0x06,
+    0x12, 0x03, 0x5c, 0x02, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x11, 0x02, 0x00, 0x01, 0x12, 0x03,
+    0x5c, 0x13, 0x1c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x11, 0x02, 0x00, 0x03, 0x12, 0x03, 0x5c, 0x1f,
+    0x20, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x12, 0x12, 0x04, 0x5f, 0x00, 0x61, 0x01, 0x0a, 0x0a, 0x0a,
+    0x03, 0x04, 0x12, 0x01, 0x12, 0x03, 0x5f, 0x08, 0x1c, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x12, 0x02,
+    0x00, 0x12, 0x03, 0x60, 0x02, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x12, 0x02, 0x00, 0x05, 0x12,
+    0x03, 0x60, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x12, 0x02, 0x00, 0x01, 0x12, 0x03, 0x60,
+    0x08, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x12, 0x02, 0x00, 0x03, 0x12, 0x03, 0x60, 0x12, 0x13,
+    0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x13, 0x12, 0x04, 0x63, 0x00, 0x65, 0x01, 0x0a, 0x0a, 0x0a, 0x03,
+    0x04, 0x13, 0x01, 0x12, 0x03, 0x63, 0x08, 0x1d, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x13, 0x02, 0x00,
+    0x12, 0x03, 0x64, 0x02, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x13, 0x02, 0x00, 0x05, 0x12, 0x03,
+    0x64, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x13, 0x02, 0x00, 0x01, 0x12, 0x03, 0x64, 0x08,
+    0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x13, 0x02, 0x00, 0x03, 0x12, 0x03, 0x64, 0x11, 0x12, 0x0a,
+    0x0a, 0x0a, 0x02, 0x04, 0x14, 0x12, 0x04, 0x67, 0x00, 0x69, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04,
+    0x14, 0x01, 0x12, 0x03, 0x67, 0x08, 0x22, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x14, 0x02, 0x00, 0x12,
+    0x03, 0x68, 0x02, 0x34, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x14, 0x02, 0x00, 0x05, 0x12, 0x03, 0x68,
+    0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x14, 0x02, 0x00, 0x01, 0x12, 0x03, 0x68, 0x08, 0x0b,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x14, 0x02, 0x00, 0x03, 0x12, 0x03, 0x68, 0x0e, 0x0f, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x14, 0x02, 0x00, 0x08, 0x12, 0x03, 0x68, 0x10, 0x33, 0x0a, 0x10, 0x0a, 0x09,
+    0x04, 0x14, 0x02, 0x00, 0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x03, 0x68, 0x11, 0x32, 0x0a, 0x0a,
+    0x0a, 0x02, 0x04, 0x15, 0x12, 0x04, 0x6b, 0x00, 0x6d, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x15,
+    0x01, 0x12, 0x03, 0x6b, 0x08, 0x23, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x15, 0x02, 0x00, 0x12, 0x03,
+    0x6c, 0x02, 0x24, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x15, 0x02, 0x00, 0x04, 0x12, 0x03, 0x6c, 0x02,
+    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x15, 0x02, 0x00, 0x06, 0x12, 0x03, 0x6c, 0x0b, 0x19, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x15, 0x02, 0x00, 0x01, 0x12, 0x03, 0x6c, 0x1a, 0x1f, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x15, 0x02, 0x00, 0x03, 0x12, 0x03, 0x6c, 0x22, 0x23, 0x0a, 0x0a, 0x0a, 0x02, 0x04,
+    0x16, 0x12, 0x04, 0x6f, 0x00, 0x73, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x16, 0x01, 0x12, 0x03,
+    0x6f, 0x08, 0x21, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x16, 0x02, 0x00, 0x12, 0x03, 0x70, 0x02, 0x3e,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x16, 0x02, 0x00, 0x05, 0x12, 0x03, 0x70, 0x02, 0x07, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x16, 0x02, 0x00, 0x01, 0x12, 0x03, 0x70, 0x08, 0x15, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x16, 0x02, 0x00, 0x03, 0x12, 0x03, 0x70, 0x18, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x16,
+    0x02, 0x00, 0x08, 0x12, 0x03, 0x70, 0x1a, 0x3d, 0x0a, 0x10, 0x0a, 0x09, 0x04, 0x16, 0x02, 0x00,
+    0x08, 0x87, 0x09, 0x04, 0x04, 0x12, 0x03, 0x70, 0x1b, 0x3c, 0x0a, 0x2b, 0x0a, 0x04, 0x04, 0x16,
+    0x02, 0x01, 0x12, 0x03, 0x71, 0x02, 0x1a, 0x22, 0x1e, 0x20, 0xe4, 0xb8, 0xba, 0x30, 0xe6, 0x97,
+    0xb6, 0xe4, 0xbb, 0x8e, 0xe5, 0x90, 0x88, 0xe9, 0x9b, 0x86, 0xe5, 0xbc, 0x80, 0xe5, 0xa4, 0xb4,
+    0xe5, 0xbc, 0x80, 0xe5, 0xa7, 0x8b, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x16, 0x02, 0x01, 0x05,
+    0x12, 0x03, 0x71, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x16, 0x02, 0x01, 0x01, 0x12, 0x03,
+    0x71, 0x08, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x16, 0x02, 0x01, 0x03, 0x12, 0x03, 0x71, 0x18,
+    0x19, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x16, 0x02, 0x02, 0x12, 0x03, 0x72, 0x02, 0x1a, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x16, 0x02, 0x02, 0x05, 0x12, 0x03, 0x72, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x16, 0x02, 0x02, 0x01, 0x12, 0x03, 0x72, 0x08, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x16,
+    0x02, 0x02, 0x03, 0x12, 0x03, 0x72, 0x18, 0x19, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x17, 0x12, 0x04,
+    0x75, 0x00, 0x7a, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x17, 0x01, 0x12, 0x03, 0x75, 0x08, 0x22,
+    0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x17, 0x02, 0x00, 0x12, 0x03, 0x76, 0x02, 0x28, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x17, 0x02, 0x00, 0x06, 0x12, 0x03, 0x76, 0x02, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x17, 0x02, 0x00, 0x01, 0x12, 0x03, 0x76, 0x18, 0x22, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x17, 0x02,
+    0x00, 0x03, 0x12, 0x03, 0x76, 0x26, 0x27, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x17, 0x02, 0x01, 0x12,
+    0x03, 0x77, 0x02, 0x28, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x17, 0x02, 0x01, 0x04, 0x12, 0x03, 0x77,
+    0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x17, 0x02, 0x01, 0x06, 0x12, 0x03, 0x77, 0x0b, 0x17,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x17, 0x02, 0x01, 0x01, 0x12, 0x03, 0x77, 0x18, 0x1d, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x17, 0x02, 0x01, 0x03, 0x12, 0x03, 0x77, 0x26, 0x27, 0x0a, 0x0b, 0x0a, 0x04,
+    0x04, 0x17, 0x02, 0x02, 0x12, 0x03, 0x78, 0x02, 0x28, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x17, 0x02,
+    0x02, 0x05, 0x12, 0x03, 0x78, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x17, 0x02, 0x02, 0x01,
+    0x12, 0x03, 0x78, 0x18, 0x23, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x17, 0x02, 0x02, 0x03, 0x12, 0x03,
+    0x78, 0x26, 0x27, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x17, 0x02, 0x03, 0x12, 0x03, 0x79, 0x02, 0x28,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x17, 0x02, 0x03, 0x05, 0x12, 0x03, 0x79, 0x02, 0x06, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x17, 0x02, 0x03, 0x01, 0x12, 0x03, 0x79, 0x18, 0x20, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x17, 0x02, 0x03, 0x03, 0x12, 0x03, 0x79, 0x26, 0x27, 0x0a, 0x2e, 0x0a, 0x02, 0x06, 0x00,
+    0x12, 0x05, 0x7d, 0x00, 0xa3, 0x01, 0x01, 0x1a, 0x21, 0x20, 0x6e, 0x6f, 0x74, 0x65, 0xe7, 0x9b,
     0xb8, 0xe5, 0x85, 0xb3, 0xe9, 0x9d, 0x9e, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0xe5, 0x8a, 0x9f,
     0xe8, 0x83, 0xbd, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x06, 0x00,
-    0x01, 0x12, 0x03, 0x63, 0x08, 0x17, 0x0a, 0x0a, 0x0a, 0x03, 0x06, 0x00, 0x03, 0x12, 0x03, 0x64,
-    0x02, 0x3e, 0x0a, 0x0e, 0x0a, 0x07, 0x06, 0x00, 0x03, 0xd1, 0x86, 0x03, 0x01, 0x12, 0x03, 0x64,
-    0x02, 0x3e, 0x0a, 0x27, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x00, 0x12, 0x03, 0x67, 0x02, 0x3e, 0x1a,
-    0x1a, 0x20, 0xe9, 0x9a, 0x8f, 0xe6, 0x9c, 0xba, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe7, 0xac,
-    0x94, 0xe8, 0xae, 0xb0, 0xe5, 0x86, 0x85, 0xe5, 0xae, 0xb9, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06,
-    0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x67, 0x06, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02,
-    0x00, 0x02, 0x12, 0x03, 0x67, 0x10, 0x20, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x03,
-    0x12, 0x03, 0x67, 0x2b, 0x3c, 0x0a, 0x1b, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x01, 0x12, 0x03, 0x6a,
-    0x02, 0x44, 0x1a, 0x0e, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe7, 0xac, 0x94, 0xe8, 0xae,
-    0xb0, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x6a, 0x06, 0x11,
-    0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x02, 0x12, 0x03, 0x6a, 0x12, 0x24, 0x0a, 0x0c,
-    0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x03, 0x12, 0x03, 0x6a, 0x2f, 0x42, 0x0a, 0x21, 0x0a, 0x04,
-    0x06, 0x00, 0x02, 0x02, 0x12, 0x03, 0x6d, 0x02, 0x4a, 0x1a, 0x14, 0x20, 0xe8, 0x8e, 0xb7, 0xe5,
-    0x8f, 0x96, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe4, 0xbd, 0x9c, 0xe8, 0x80, 0x85, 0x0a, 0x0a,
-    0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x01, 0x12, 0x03, 0x6d, 0x06, 0x13, 0x0a, 0x0c, 0x0a,
-    0x05, 0x06, 0x00, 0x02, 0x02, 0x02, 0x12, 0x03, 0x6d, 0x14, 0x28, 0x0a, 0x0c, 0x0a, 0x05, 0x06,
-    0x00, 0x02, 0x02, 0x03, 0x12, 0x03, 0x6d, 0x33, 0x48, 0x0a, 0x21, 0x0a, 0x04, 0x06, 0x00, 0x02,
-    0x03, 0x12, 0x03, 0x70, 0x02, 0x56, 0x1a, 0x14, 0x20, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe8,
-    0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x0a, 0x0a, 0x0c, 0x0a, 0x05,
-    0x06, 0x00, 0x02, 0x03, 0x01, 0x12, 0x03, 0x70, 0x06, 0x17, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00,
-    0x02, 0x03, 0x02, 0x12, 0x03, 0x70, 0x18, 0x30, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x03,
-    0x03, 0x12, 0x03, 0x70, 0x3b, 0x54, 0x0a, 0x21, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x04, 0x12, 0x03,
-    0x73, 0x02, 0x47, 0x1a, 0x14, 0x20, 0xe6, 0x8c, 0x89, 0xe7, 0x85, 0xa7, 0xe6, 0x8e, 0xa8, 0xe8,
-    0x8d, 0x90, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02,
-    0x04, 0x01, 0x12, 0x03, 0x73, 0x06, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x04, 0x02,
-    0x12, 0x03, 0x73, 0x13, 0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x04, 0x03, 0x12, 0x03,
-    0x73, 0x31, 0x45, 0x0a, 0x39, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x05, 0x12, 0x03, 0x76, 0x02, 0x56,
-    0x1a, 0x2c, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe6, 0x8c, 0x87, 0xe5, 0xae, 0x9a, 0xe7,
-    0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0x9a, 0x84, 0xe6, 0x9c, 0x80, 0xe8, 0xbf, 0x91, 0xe7, 0x9a,
-    0x84, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe5, 0x86, 0x85, 0xe5, 0xae, 0xb9, 0x0a, 0x0a, 0x0c,
-    0x0a, 0x05, 0x06, 0x00, 0x02, 0x05, 0x01, 0x12, 0x03, 0x76, 0x06, 0x17, 0x0a, 0x0c, 0x0a, 0x05,
-    0x06, 0x00, 0x02, 0x05, 0x02, 0x12, 0x03, 0x76, 0x18, 0x30, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00,
-    0x02, 0x05, 0x03, 0x12, 0x03, 0x76, 0x3b, 0x54, 0x0a, 0x36, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x06,
-    0x12, 0x03, 0x79, 0x02, 0x4a, 0x1a, 0x29, 0x20, 0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba, 0xe6, 0x8c,
-    0x87, 0xe5, 0xae, 0x9a, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe5, 0x85, 0xac, 0xe5, 0xbc, 0x80,
-    0xe7, 0x9a, 0x84, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe5, 0x86, 0x85, 0xe5, 0xae, 0xb9, 0x0a,
-    0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x06, 0x01, 0x12, 0x03, 0x79, 0x06, 0x13, 0x0a, 0x0c,
-    0x0a, 0x05, 0x06, 0x00, 0x02, 0x06, 0x02, 0x12, 0x03, 0x79, 0x14, 0x28, 0x0a, 0x0c, 0x0a, 0x05,
-    0x06, 0x00, 0x02, 0x06, 0x03, 0x12, 0x03, 0x79, 0x33, 0x48, 0x0a, 0x21, 0x0a, 0x04, 0x06, 0x00,
-    0x02, 0x07, 0x12, 0x03, 0x7c, 0x02, 0x41, 0x1a, 0x14, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96,
-    0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe6, 0xa0, 0x87, 0xe7, 0xad, 0xbe, 0x0a, 0x0a, 0x0c, 0x0a,
-    0x05, 0x06, 0x00, 0x02, 0x07, 0x01, 0x12, 0x03, 0x7c, 0x06, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x06,
-    0x00, 0x02, 0x07, 0x02, 0x12, 0x03, 0x7c, 0x11, 0x22, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02,
-    0x07, 0x03, 0x12, 0x03, 0x7c, 0x2d, 0x3f, 0x0a, 0x27, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x08, 0x12,
-    0x03, 0x7f, 0x02, 0x5f, 0x1a, 0x1a, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe7, 0x94, 0xa8,
-    0xe6, 0x88, 0xb7, 0xe6, 0x8a, 0x95, 0xe7, 0xa8, 0xbf, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x0a,
-    0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x08, 0x01, 0x12, 0x03, 0x7f, 0x06, 0x1a, 0x0a, 0x0c,
-    0x0a, 0x05, 0x06, 0x00, 0x02, 0x08, 0x02, 0x12, 0x03, 0x7f, 0x1b, 0x36, 0x0a, 0x0c, 0x0a, 0x05,
-    0x06, 0x00, 0x02, 0x08, 0x03, 0x12, 0x03, 0x7f, 0x41, 0x5d, 0x0a, 0x3d, 0x0a, 0x04, 0x06, 0x00,
-    0x02, 0x09, 0x12, 0x04, 0x82, 0x01, 0x02, 0x68, 0x1a, 0x2f, 0x20, 0xe6, 0xa3, 0x80, 0xe6, 0x9f,
-    0xa5, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0xad, 0x98,
-    0xe5, 0x9c, 0xa8, 0xef, 0xbc, 0x88, 0xe5, 0xad, 0x98, 0xe5, 0x9c, 0xa8, 0xe4, 0xb8, 0x94, 0xe5,
-    0x85, 0xac, 0xe5, 0xbc, 0x80, 0xef, 0xbc, 0x89, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02,
-    0x09, 0x01, 0x12, 0x04, 0x82, 0x01, 0x06, 0x1d, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x09,
-    0x02, 0x12, 0x04, 0x82, 0x01, 0x1e, 0x3c, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x09, 0x03,
-    0x12, 0x04, 0x82, 0x01, 0x47, 0x66, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+    0x01, 0x12, 0x03, 0x7d, 0x08, 0x17, 0x0a, 0x0a, 0x0a, 0x03, 0x06, 0x00, 0x03, 0x12, 0x03, 0x7e,
+    0x02, 0x3e, 0x0a, 0x0e, 0x0a, 0x07, 0x06, 0x00, 0x03, 0xd1, 0x86, 0x03, 0x01, 0x12, 0x03, 0x7e,
+    0x02, 0x3e, 0x0a, 0x28, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x00, 0x12, 0x04, 0x81, 0x01, 0x02, 0x3e,
+    0x1a, 0x1a, 0x20, 0xe9, 0x9a, 0x8f, 0xe6, 0x9c, 0xba, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe7,
+    0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe5, 0x86, 0x85, 0xe5, 0xae, 0xb9, 0x0a, 0x0a, 0x0d, 0x0a, 0x05,
+    0x06, 0x00, 0x02, 0x00, 0x01, 0x12, 0x04, 0x81, 0x01, 0x06, 0x0f, 0x0a, 0x0d, 0x0a, 0x05, 0x06,
+    0x00, 0x02, 0x00, 0x02, 0x12, 0x04, 0x81, 0x01, 0x10, 0x20, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00,
+    0x02, 0x00, 0x03, 0x12, 0x04, 0x81, 0x01, 0x2b, 0x3c, 0x0a, 0x1c, 0x0a, 0x04, 0x06, 0x00, 0x02,
+    0x01, 0x12, 0x04, 0x84, 0x01, 0x02, 0x44, 0x1a, 0x0e, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96,
+    0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x01,
+    0x12, 0x04, 0x84, 0x01, 0x06, 0x11, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x02, 0x12,
+    0x04, 0x84, 0x01, 0x12, 0x24, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x03, 0x12, 0x04,
+    0x84, 0x01, 0x2f, 0x42, 0x0a, 0x22, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x02, 0x12, 0x04, 0x87, 0x01,
+    0x02, 0x4a, 0x1a, 0x14, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe7, 0xac, 0x94, 0xe8, 0xae,
+    0xb0, 0xe4, 0xbd, 0x9c, 0xe8, 0x80, 0x85, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x02,
+    0x01, 0x12, 0x04, 0x87, 0x01, 0x06, 0x13, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x02,
+    0x12, 0x04, 0x87, 0x01, 0x14, 0x28, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x03, 0x12,
+    0x04, 0x87, 0x01, 0x33, 0x48, 0x0a, 0x22, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x03, 0x12, 0x04, 0x8a,
+    0x01, 0x02, 0x56, 0x1a, 0x14, 0x20, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe8, 0x8e, 0xb7, 0xe5,
+    0x8f, 0x96, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02,
+    0x03, 0x01, 0x12, 0x04, 0x8a, 0x01, 0x06, 0x17, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x03,
+    0x02, 0x12, 0x04, 0x8a, 0x01, 0x18, 0x30, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x03, 0x03,
+    0x12, 0x04, 0x8a, 0x01, 0x3b, 0x54, 0x0a, 0x22, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x04, 0x12, 0x04,
+    0x8d, 0x01, 0x02, 0x47, 0x1a, 0x14, 0x20, 0xe6, 0x8c, 0x89, 0xe7, 0x85, 0xa7, 0xe6, 0x8e, 0xa8,
+    0xe8, 0x8d, 0x90, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00,
+    0x02, 0x04, 0x01, 0x12, 0x04, 0x8d, 0x01, 0x06, 0x12, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02,
+    0x04, 0x02, 0x12, 0x04, 0x8d, 0x01, 0x13, 0x26, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x04,
+    0x03, 0x12, 0x04, 0x8d, 0x01, 0x31, 0x45, 0x0a, 0x3a, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x05, 0x12,
+    0x04, 0x90, 0x01, 0x02, 0x56, 0x1a, 0x2c, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe6, 0x8c,
+    0x87, 0xe5, 0xae, 0x9a, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0x9a, 0x84, 0xe6, 0x9c, 0x80,
+    0xe8, 0xbf, 0x91, 0xe7, 0x9a, 0x84, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe5, 0x86, 0x85, 0xe5,
+    0xae, 0xb9, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x05, 0x01, 0x12, 0x04, 0x90, 0x01,
+    0x06, 0x17, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x05, 0x02, 0x12, 0x04, 0x90, 0x01, 0x18,
+    0x30, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x05, 0x03, 0x12, 0x04, 0x90, 0x01, 0x3b, 0x54,
+    0x0a, 0x37, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x06, 0x12, 0x04, 0x93, 0x01, 0x02, 0x4a, 0x1a, 0x29,
+    0x20, 0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba, 0xe6, 0x8c, 0x87, 0xe5, 0xae, 0x9a, 0xe7, 0x94, 0xa8,
+    0xe6, 0x88, 0xb7, 0xe5, 0x85, 0xac, 0xe5, 0xbc, 0x80, 0xe7, 0x9a, 0x84, 0xe7, 0xac, 0x94, 0xe8,
+    0xae, 0xb0, 0xe5, 0x86, 0x85, 0xe5, 0xae, 0xb9, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02,
+    0x06, 0x01, 0x12, 0x04, 0x93, 0x01, 0x06, 0x13, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x06,
+    0x02, 0x12, 0x04, 0x93, 0x01, 0x14, 0x28, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x06, 0x03,
+    0x12, 0x04, 0x93, 0x01, 0x33, 0x48, 0x0a, 0x22, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x07, 0x12, 0x04,
+    0x96, 0x01, 0x02, 0x41, 0x1a, 0x14, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe7, 0xac, 0x94,
+    0xe8, 0xae, 0xb0, 0xe6, 0xa0, 0x87, 0xe7, 0xad, 0xbe, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00,
+    0x02, 0x07, 0x01, 0x12, 0x04, 0x96, 0x01, 0x06, 0x10, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02,
+    0x07, 0x02, 0x12, 0x04, 0x96, 0x01, 0x11, 0x22, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x07,
+    0x03, 0x12, 0x04, 0x96, 0x01, 0x2d, 0x3f, 0x0a, 0x28, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x08, 0x12,
+    0x04, 0x99, 0x01, 0x02, 0x5f, 0x1a, 0x1a, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe7, 0x94,
+    0xa8, 0xe6, 0x88, 0xb7, 0xe6, 0x8a, 0x95, 0xe7, 0xa8, 0xbf, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f,
+    0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x08, 0x01, 0x12, 0x04, 0x99, 0x01, 0x06, 0x1a,
+    0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x08, 0x02, 0x12, 0x04, 0x99, 0x01, 0x1b, 0x36, 0x0a,
+    0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x08, 0x03, 0x12, 0x04, 0x99, 0x01, 0x41, 0x5d, 0x0a, 0x3d,
+    0x0a, 0x04, 0x06, 0x00, 0x02, 0x09, 0x12, 0x04, 0x9c, 0x01, 0x02, 0x68, 0x1a, 0x2f, 0x20, 0xe6,
+    0xa3, 0x80, 0xe6, 0x9f, 0xa5, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe6, 0x98, 0xaf, 0xe5, 0x90,
+    0xa6, 0xe5, 0xad, 0x98, 0xe5, 0x9c, 0xa8, 0xef, 0xbc, 0x88, 0xe5, 0xad, 0x98, 0xe5, 0x9c, 0xa8,
+    0xe4, 0xb8, 0x94, 0xe5, 0x85, 0xac, 0xe5, 0xbc, 0x80, 0xef, 0xbc, 0x89, 0x0a, 0x0a, 0x0d, 0x0a,
+    0x05, 0x06, 0x00, 0x02, 0x09, 0x01, 0x12, 0x04, 0x9c, 0x01, 0x06, 0x1d, 0x0a, 0x0d, 0x0a, 0x05,
+    0x06, 0x00, 0x02, 0x09, 0x02, 0x12, 0x04, 0x9c, 0x01, 0x1e, 0x3c, 0x0a, 0x0d, 0x0a, 0x05, 0x06,
+    0x00, 0x02, 0x09, 0x03, 0x12, 0x04, 0x9c, 0x01, 0x47, 0x66, 0x0a, 0x2b, 0x0a, 0x04, 0x06, 0x00,
+    0x02, 0x0a, 0x12, 0x04, 0x9f, 0x01, 0x02, 0x5c, 0x1a, 0x1d, 0x20, 0xe5, 0x88, 0x97, 0xe5, 0x87,
+    0xba, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe5, 0x85, 0xac, 0xe5, 0xbc, 0x80, 0xe7, 0x9a, 0x84,
+    0xe5, 0x90, 0x88, 0xe9, 0x9b, 0x86, 0x0a, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0a, 0x01,
+    0x12, 0x04, 0x9f, 0x01, 0x06, 0x19, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0a, 0x02, 0x12,
+    0x04, 0x9f, 0x01, 0x1a, 0x34, 0x0a, 0x0d, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x0a, 0x03, 0x12, 0x04,
+    0x9f, 0x01, 0x3f, 0x5a, 0x0a, 0x37, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x0b, 0x12, 0x04, 0xa2, 0x01,
+    0x02, 0x59, 0x1a, 0x29, 0x20, 0xe6, 0x8c, 0x89, 0xe9, 0xa1, 0xba, 0xe5, 0xba, 0x8f, 0xe5, 0x88,
+    0x97, 0xe5, 0x87, 0xba, 0xe5, 0x90, 0x88, 0xe9, 0x9b, 0x86, 0xe4, 0xb8, 0xad, 0xe5, 0x85, 0xac,
+    0xe5, 0xbc, 0x80, 0xe7, 0x9a, 0x84, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x0a, 0x0a, 0x0d, 0x0a,
+    0x05, 0x06, 0x00, 0x02, 0x0b, 0x01, 0x12, 0x04, 0xa2, 0x01, 0x06, 0x18, 0x0a, 0x0d, 0x0a, 0x05,
+    0x06, 0x00, 0x02, 0x0b, 0x02, 0x12, 0x04, 0xa2, 0x01, 0x19, 0x32, 0x0a, 0x0d, 0x0a, 0x05, 0x06,
+    0x00, 0x02, 0x0b, 0x03, 0x12, 0x04, 0xa2, 0x01, 0x3d, 0x57, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+    0x6f, 0x33,
 ];
 include!("note.api.v1.tonic.rs");
 // @@protoc_insertion_point(module)
\ No newline at end of file
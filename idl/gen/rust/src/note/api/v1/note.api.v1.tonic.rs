@@ -201,7 +201,7 @@ pub mod note_creator_service_client {
                 .insert(GrpcMethod::new("note.api.v1.NoteCreatorService", "UpdateNote"));
             self.inner.unary(req, path, codec).await
         }
-        /** 删除笔记
+        /** 删除笔记 删除后的笔记进入回收站 超过保留时间后彻底删除
 */
         pub async fn delete_note(
             &mut self,
@@ -358,204 +358,1229 @@ pub mod note_creator_service_client {
                 .insert(GrpcMethod::new("note.api.v1.NoteCreatorService", "AddTag"));
             self.inner.unary(req, path, codec).await
         }
-    }
-}
-/// Generated server implementations.
-pub mod note_creator_service_server {
-    #![allow(
-        unused_variables,
-        dead_code,
-        missing_docs,
-        clippy::wildcard_imports,
-        clippy::let_unit_value,
-    )]
-    use tonic::codegen::*;
-    /// Generated trait containing gRPC methods that should be implemented for use with NoteCreatorServiceServer.
-    #[async_trait]
-    pub trait NoteCreatorService: std::marker::Send + std::marker::Sync + 'static {
-        /** 检查用户是否拥有指定的笔记
+        /** 列出草稿
 */
-        async fn is_user_own_note(
-            &self,
-            request: tonic::Request<super::IsUserOwnNoteRequest>,
+        pub async fn list_drafts(
+            &mut self,
+            request: impl tonic::IntoRequest<super::ListDraftsRequest>,
         ) -> std::result::Result<
-            tonic::Response<super::IsUserOwnNoteResponse>,
+            tonic::Response<super::ListDraftsResponse>,
             tonic::Status,
-        >;
-        /** 判断笔记是否存在
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/note.api.v1.NoteCreatorService/ListDrafts",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("note.api.v1.NoteCreatorService", "ListDrafts"));
+            self.inner.unary(req, path, codec).await
+        }
+        /** 发布草稿 也可用于修改定时发布笔记的发布时间
 */
-        async fn is_note_exist(
-            &self,
-            request: tonic::Request<super::IsNoteExistRequest>,
+        pub async fn publish_draft(
+            &mut self,
+            request: impl tonic::IntoRequest<super::PublishDraftRequest>,
         ) -> std::result::Result<
-            tonic::Response<super::IsNoteExistResponse>,
+            tonic::Response<super::PublishDraftResponse>,
             tonic::Status,
-        >;
-        /** 创建笔记
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/note.api.v1.NoteCreatorService/PublishDraft",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("note.api.v1.NoteCreatorService", "PublishDraft"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /** 列出笔记的修订版本
 */
-        async fn create_note(
-            &self,
-            request: tonic::Request<super::CreateNoteRequest>,
+        pub async fn list_note_revisions(
+            &mut self,
+            request: impl tonic::IntoRequest<super::ListNoteRevisionsRequest>,
         ) -> std::result::Result<
-            tonic::Response<super::CreateNoteResponse>,
+            tonic::Response<super::ListNoteRevisionsResponse>,
             tonic::Status,
-        >;
-        /** 更新笔记
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/note.api.v1.NoteCreatorService/ListNoteRevisions",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "note.api.v1.NoteCreatorService",
+                        "ListNoteRevisions",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /** 获取笔记的某个修订版本
 */
-        async fn update_note(
-            &self,
-            request: tonic::Request<super::UpdateNoteRequest>,
+        pub async fn get_note_revision(
+            &mut self,
+            request: impl tonic::IntoRequest<super::GetNoteRevisionRequest>,
         ) -> std::result::Result<
-            tonic::Response<super::UpdateNoteResponse>,
+            tonic::Response<super::GetNoteRevisionResponse>,
             tonic::Status,
-        >;
-        /** 删除笔记
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/note.api.v1.NoteCreatorService/GetNoteRevision",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("note.api.v1.NoteCreatorService", "GetNoteRevision"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /** 将笔记恢复到某个修订版本 恢复操作会生成新的版本
 */
-        async fn delete_note(
-            &self,
-            request: tonic::Request<super::DeleteNoteRequest>,
+        pub async fn restore_note_revision(
+            &mut self,
+            request: impl tonic::IntoRequest<super::RestoreNoteRevisionRequest>,
         ) -> std::result::Result<
-            tonic::Response<super::DeleteNoteResponse>,
+            tonic::Response<super::RestoreNoteRevisionResponse>,
             tonic::Status,
-        >;
-        /** 获取笔记的信息
-*/
-        async fn get_note(
-            &self,
-            request: tonic::Request<super::GetNoteRequest>,
-        ) -> std::result::Result<tonic::Response<super::GetNoteResponse>, tonic::Status>;
-        /** 列出笔记
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/note.api.v1.NoteCreatorService/RestoreNoteRevision",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "note.api.v1.NoteCreatorService",
+                        "RestoreNoteRevision",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /** 列出回收站中的笔记
 */
-        async fn list_note(
-            &self,
-            request: tonic::Request<super::ListNoteRequest>,
+        pub async fn list_deleted_notes(
+            &mut self,
+            request: impl tonic::IntoRequest<super::ListDeletedNotesRequest>,
         ) -> std::result::Result<
-            tonic::Response<super::ListNoteResponse>,
+            tonic::Response<super::ListDeletedNotesResponse>,
             tonic::Status,
-        >;
-        /** 分页列出笔记
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/note.api.v1.NoteCreatorService/ListDeletedNotes",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("note.api.v1.NoteCreatorService", "ListDeletedNotes"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /** 从回收站中恢复笔记
 */
-        async fn page_list_note(
-            &self,
-            request: tonic::Request<super::PageListNoteRequest>,
+        pub async fn restore_note(
+            &mut self,
+            request: impl tonic::IntoRequest<super::RestoreNoteRequest>,
         ) -> std::result::Result<
-            tonic::Response<super::PageListNoteResponse>,
+            tonic::Response<super::RestoreNoteResponse>,
             tonic::Status,
-        >;
-        /** 获取用户投稿数量
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/note.api.v1.NoteCreatorService/RestoreNote",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("note.api.v1.NoteCreatorService", "RestoreNote"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /** 新建合集
 */
-        async fn get_posted_count(
-            &self,
-            request: tonic::Request<super::GetPostedCountRequest>,
+        pub async fn create_collection(
+            &mut self,
+            request: impl tonic::IntoRequest<super::CreateCollectionRequest>,
         ) -> std::result::Result<
-            tonic::Response<super::GetPostedCountResponse>,
+            tonic::Response<super::CreateCollectionResponse>,
             tonic::Status,
-        >;
-        /** 新增标签
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/note.api.v1.NoteCreatorService/CreateCollection",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("note.api.v1.NoteCreatorService", "CreateCollection"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /** 修改合集信息
 */
-        async fn add_tag(
-            &self,
-            request: tonic::Request<super::AddTagRequest>,
-        ) -> std::result::Result<tonic::Response<super::AddTagResponse>, tonic::Status>;
-    }
-    /** 和笔记管理相关的服务
- 比如发布笔记，修改笔记，删除笔记等管理笔记的功能
+        pub async fn update_collection(
+            &mut self,
+            request: impl tonic::IntoRequest<super::UpdateCollectionRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::UpdateCollectionResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/note.api.v1.NoteCreatorService/UpdateCollection",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("note.api.v1.NoteCreatorService", "UpdateCollection"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /** 删除合集 合集中的笔记不受影响
 */
-    #[derive(Debug)]
-    pub struct NoteCreatorServiceServer<T> {
-        inner: Arc<T>,
-        accept_compression_encodings: EnabledCompressionEncodings,
-        send_compression_encodings: EnabledCompressionEncodings,
-        max_decoding_message_size: Option<usize>,
-        max_encoding_message_size: Option<usize>,
-    }
-    impl<T> NoteCreatorServiceServer<T> {
-        pub fn new(inner: T) -> Self {
-            Self::from_arc(Arc::new(inner))
-        }
-        pub fn from_arc(inner: Arc<T>) -> Self {
-            Self {
-                inner,
-                accept_compression_encodings: Default::default(),
-                send_compression_encodings: Default::default(),
-                max_decoding_message_size: None,
-                max_encoding_message_size: None,
-            }
-        }
-        pub fn with_interceptor<F>(
-            inner: T,
-            interceptor: F,
-        ) -> InterceptedService<Self, F>
-        where
-            F: tonic::service::Interceptor,
-        {
-            InterceptedService::new(Self::new(inner), interceptor)
+        pub async fn delete_collection(
+            &mut self,
+            request: impl tonic::IntoRequest<super::DeleteCollectionRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::DeleteCollectionResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/note.api.v1.NoteCreatorService/DeleteCollection",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("note.api.v1.NoteCreatorService", "DeleteCollection"),
+                );
+            self.inner.unary(req, path, codec).await
         }
-        /// Enable decompressing requests with the given encoding.
-        #[must_use]
-        pub fn accept_compressed(mut self, encoding: CompressionEncoding) -> Self {
-            self.accept_compression_encodings.enable(encoding);
-            self
+        /** 获取合集详情
+*/
+        pub async fn get_collection(
+            &mut self,
+            request: impl tonic::IntoRequest<super::GetCollectionRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetCollectionResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/note.api.v1.NoteCreatorService/GetCollection",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("note.api.v1.NoteCreatorService", "GetCollection"),
+                );
+            self.inner.unary(req, path, codec).await
         }
-        /// Compress responses with the given encoding, if the client supports it.
-        #[must_use]
-        pub fn send_compressed(mut self, encoding: CompressionEncoding) -> Self {
-            self.send_compression_encodings.enable(encoding);
-            self
+        /** 列出自己的全部合集
+*/
+        pub async fn list_collections(
+            &mut self,
+            request: impl tonic::IntoRequest<super::ListCollectionsRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::ListCollectionsResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/note.api.v1.NoteCreatorService/ListCollections",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("note.api.v1.NoteCreatorService", "ListCollections"),
+                );
+            self.inner.unary(req, path, codec).await
         }
-        /// Limits the maximum size of a decoded message.
-        ///
-        /// Default: `4MB`
-        #[must_use]
-        pub fn max_decoding_message_size(mut self, limit: usize) -> Self {
-            self.max_decoding_message_size = Some(limit);
-            self
+        /** 添加笔记到合集
+*/
+        pub async fn add_collection_notes(
+            &mut self,
+            request: impl tonic::IntoRequest<super::AddCollectionNotesRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::AddCollectionNotesResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/note.api.v1.NoteCreatorService/AddCollectionNotes",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "note.api.v1.NoteCreatorService",
+                        "AddCollectionNotes",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
         }
-        /// Limits the maximum size of an encoded message.
-        ///
-        /// Default: `usize::MAX`
-        #[must_use]
-        pub fn max_encoding_message_size(mut self, limit: usize) -> Self {
-            self.max_encoding_message_size = Some(limit);
-            self
+        /** 从合集中移除笔记
+*/
+        pub async fn remove_collection_notes(
+            &mut self,
+            request: impl tonic::IntoRequest<super::RemoveCollectionNotesRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::RemoveCollectionNotesResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/note.api.v1.NoteCreatorService/RemoveCollectionNotes",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "note.api.v1.NoteCreatorService",
+                        "RemoveCollectionNotes",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
         }
-    }
-    impl<T, B> tonic::codegen::Service<http::Request<B>> for NoteCreatorServiceServer<T>
-    where
-        T: NoteCreatorService,
-        B: Body + std::marker::Send + 'static,
-        B::Error: Into<StdError> + std::marker::Send + 'static,
-    {
-        type Response = http::Response<tonic::body::Body>;
-        type Error = std::convert::Infallible;
-        type Future = BoxFuture<Self::Response, Self::Error>;
-        fn poll_ready(
+        /** 调整合集中笔记的顺序
+*/
+        pub async fn sort_collection_notes(
             &mut self,
-            _cx: &mut Context<'_>,
-        ) -> Poll<std::result::Result<(), Self::Error>> {
-            Poll::Ready(Ok(()))
+            request: impl tonic::IntoRequest<super::SortCollectionNotesRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::SortCollectionNotesResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/note.api.v1.NoteCreatorService/SortCollectionNotes",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "note.api.v1.NoteCreatorService",
+                        "SortCollectionNotes",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
         }
-        fn call(&mut self, req: http::Request<B>) -> Self::Future {
-            match req.uri().path() {
-                "/note.api.v1.NoteCreatorService/IsUserOwnNote" => {
+    }
+}
+/// Generated server implementations.
+pub mod note_creator_service_server {
+    #![allow(
+        unused_variables,
+        dead_code,
+        missing_docs,
+        clippy::wildcard_imports,
+        clippy::let_unit_value,
+    )]
+    use tonic::codegen::*;
+    /// Generated trait containing gRPC methods that should be implemented for use with NoteCreatorServiceServer.
+    #[async_trait]
+    pub trait NoteCreatorService: std::marker::Send + std::marker::Sync + 'static {
+        /** 检查用户是否拥有指定的笔记
+*/
+        async fn is_user_own_note(
+            &self,
+            request: tonic::Request<super::IsUserOwnNoteRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::IsUserOwnNoteResponse>,
+            tonic::Status,
+        >;
+        /** 判断笔记是否存在
+*/
+        async fn is_note_exist(
+            &self,
+            request: tonic::Request<super::IsNoteExistRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::IsNoteExistResponse>,
+            tonic::Status,
+        >;
+        /** 创建笔记
+*/
+        async fn create_note(
+            &self,
+            request: tonic::Request<super::CreateNoteRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::CreateNoteResponse>,
+            tonic::Status,
+        >;
+        /** 更新笔记
+*/
+        async fn update_note(
+            &self,
+            request: tonic::Request<super::UpdateNoteRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::UpdateNoteResponse>,
+            tonic::Status,
+        >;
+        /** 删除笔记 删除后的笔记进入回收站 超过保留时间后彻底删除
+*/
+        async fn delete_note(
+            &self,
+            request: tonic::Request<super::DeleteNoteRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::DeleteNoteResponse>,
+            tonic::Status,
+        >;
+        /** 获取笔记的信息
+*/
+        async fn get_note(
+            &self,
+            request: tonic::Request<super::GetNoteRequest>,
+        ) -> std::result::Result<tonic::Response<super::GetNoteResponse>, tonic::Status>;
+        /** 列出笔记
+*/
+        async fn list_note(
+            &self,
+            request: tonic::Request<super::ListNoteRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::ListNoteResponse>,
+            tonic::Status,
+        >;
+        /** 分页列出笔记
+*/
+        async fn page_list_note(
+            &self,
+            request: tonic::Request<super::PageListNoteRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::PageListNoteResponse>,
+            tonic::Status,
+        >;
+        /** 获取用户投稿数量
+*/
+        async fn get_posted_count(
+            &self,
+            request: tonic::Request<super::GetPostedCountRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetPostedCountResponse>,
+            tonic::Status,
+        >;
+        /** 新增标签
+*/
+        async fn add_tag(
+            &self,
+            request: tonic::Request<super::AddTagRequest>,
+        ) -> std::result::Result<tonic::Response<super::AddTagResponse>, tonic::Status>;
+        /** 列出草稿
+*/
+        async fn list_drafts(
+            &self,
+            request: tonic::Request<super::ListDraftsRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::ListDraftsResponse>,
+            tonic::Status,
+        >;
+        /** 发布草稿 也可用于修改定时发布笔记的发布时间
+*/
+        async fn publish_draft(
+            &self,
+            request: tonic::Request<super::PublishDraftRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::PublishDraftResponse>,
+            tonic::Status,
+        >;
+        /** 列出笔记的修订版本
+*/
+        async fn list_note_revisions(
+            &self,
+            request: tonic::Request<super::ListNoteRevisionsRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::ListNoteRevisionsResponse>,
+            tonic::Status,
+        >;
+        /** 获取笔记的某个修订版本
+*/
+        async fn get_note_revision(
+            &self,
+            request: tonic::Request<super::GetNoteRevisionRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetNoteRevisionResponse>,
+            tonic::Status,
+        >;
+        /** 将笔记恢复到某个修订版本 恢复操作会生成新的版本
+*/
+        async fn restore_note_revision(
+            &self,
+            request: tonic::Request<super::RestoreNoteRevisionRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::RestoreNoteRevisionResponse>,
+            tonic::Status,
+        >;
+        /** 列出回收站中的笔记
+*/
+        async fn list_deleted_notes(
+            &self,
+            request: tonic::Request<super::ListDeletedNotesRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::ListDeletedNotesResponse>,
+            tonic::Status,
+        >;
+        /** 从回收站中恢复笔记
+*/
+        async fn restore_note(
+            &self,
+            request: tonic::Request<super::RestoreNoteRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::RestoreNoteResponse>,
+            tonic::Status,
+        >;
+        /** 新建合集
+*/
+        async fn create_collection(
+            &self,
+            request: tonic::Request<super::CreateCollectionRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::CreateCollectionResponse>,
+            tonic::Status,
+        >;
+        /** 修改合集信息
+*/
+        async fn update_collection(
+            &self,
+            request: tonic::Request<super::UpdateCollectionRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::UpdateCollectionResponse>,
+            tonic::Status,
+        >;
+        /** 删除合集 合集中的笔记不受影响
+*/
+        async fn delete_collection(
+            &self,
+            request: tonic::Request<super::DeleteCollectionRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::DeleteCollectionResponse>,
+            tonic::Status,
+        >;
+        /** 获取合集详情
+*/
+        async fn get_collection(
+            &self,
+            request: tonic::Request<super::GetCollectionRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetCollectionResponse>,
+            tonic::Status,
+        >;
+        /** 列出自己的全部合集
+*/
+        async fn list_collections(
+            &self,
+            request: tonic::Request<super::ListCollectionsRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::ListCollectionsResponse>,
+            tonic::Status,
+        >;
+        /** 添加笔记到合集
+*/
+        async fn add_collection_notes(
+            &self,
+            request: tonic::Request<super::AddCollectionNotesRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::AddCollectionNotesResponse>,
+            tonic::Status,
+        >;
+        /** 从合集中移除笔记
+*/
+        async fn remove_collection_notes(
+            &self,
+            request: tonic::Request<super::RemoveCollectionNotesRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::RemoveCollectionNotesResponse>,
+            tonic::Status,
+        >;
+        /** 调整合集中笔记的顺序
+*/
+        async fn sort_collection_notes(
+            &self,
+            request: tonic::Request<super::SortCollectionNotesRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::SortCollectionNotesResponse>,
+            tonic::Status,
+        >;
+    }
+    /** 和笔记管理相关的服务
+ 比如发布笔记，修改笔记，删除笔记等管理笔记的功能
+*/
+    #[derive(Debug)]
+    pub struct NoteCreatorServiceServer<T> {
+        inner: Arc<T>,
+        accept_compression_encodings: EnabledCompressionEncodings,
+        send_compression_encodings: EnabledCompressionEncodings,
+        max_decoding_message_size: Option<usize>,
+        max_encoding_message_size: Option<usize>,
+    }
+    impl<T> NoteCreatorServiceServer<T> {
+        pub fn new(inner: T) -> Self {
+            Self::from_arc(Arc::new(inner))
+        }
+        pub fn from_arc(inner: Arc<T>) -> Self {
+            Self {
+                inner,
+                accept_compression_encodings: Default::default(),
+                send_compression_encodings: Default::default(),
+                max_decoding_message_size: None,
+                max_encoding_message_size: None,
+            }
+        }
+        pub fn with_interceptor<F>(
+            inner: T,
+            interceptor: F,
+        ) -> InterceptedService<Self, F>
+        where
+            F: tonic::service::Interceptor,
+        {
+            InterceptedService::new(Self::new(inner), interceptor)
+        }
+        /// Enable decompressing requests with the given encoding.
+        #[must_use]
+        pub fn accept_compressed(mut self, encoding: CompressionEncoding) -> Self {
+            self.accept_compression_encodings.enable(encoding);
+            self
+        }
+        /// Compress responses with the given encoding, if the client supports it.
+        #[must_use]
+        pub fn send_compressed(mut self, encoding: CompressionEncoding) -> Self {
+            self.send_compression_encodings.enable(encoding);
+            self
+        }
+        /// Limits the maximum size of a decoded message.
+        ///
+        /// Default: `4MB`
+        #[must_use]
+        pub fn max_decoding_message_size(mut self, limit: usize) -> Self {
+            self.max_decoding_message_size = Some(limit);
+            self
+        }
+        /// Limits the maximum size of an encoded message.
+        ///
+        /// Default: `usize::MAX`
+        #[must_use]
+        pub fn max_encoding_message_size(mut self, limit: usize) -> Self {
+            self.max_encoding_message_size = Some(limit);
+            self
+        }
+    }
+    impl<T, B> tonic::codegen::Service<http::Request<B>> for NoteCreatorServiceServer<T>
+    where
+        T: NoteCreatorService,
+        B: Body + std::marker::Send + 'static,
+        B::Error: Into<StdError> + std::marker::Send + 'static,
+    {
+        type Response = http::Response<tonic::body::Body>;
+        type Error = std::convert::Infallible;
+        type Future = BoxFuture<Self::Response, Self::Error>;
+        fn poll_ready(
+            &mut self,
+            _cx: &mut Context<'_>,
+        ) -> Poll<std::result::Result<(), Self::Error>> {
+            Poll::Ready(Ok(()))
+        }
+        fn call(&mut self, req: http::Request<B>) -> Self::Future {
+            match req.uri().path() {
+                "/note.api.v1.NoteCreatorService/IsUserOwnNote" => {
+                    #[allow(non_camel_case_types)]
+                    struct IsUserOwnNoteSvc<T: NoteCreatorService>(pub Arc<T>);
+                    impl<
+                        T: NoteCreatorService,
+                    > tonic::server::UnaryService<super::IsUserOwnNoteRequest>
+                    for IsUserOwnNoteSvc<T> {
+                        type Response = super::IsUserOwnNoteResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::IsUserOwnNoteRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as NoteCreatorService>::is_user_own_note(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = IsUserOwnNoteSvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/note.api.v1.NoteCreatorService/IsNoteExist" => {
+                    #[allow(non_camel_case_types)]
+                    struct IsNoteExistSvc<T: NoteCreatorService>(pub Arc<T>);
+                    impl<
+                        T: NoteCreatorService,
+                    > tonic::server::UnaryService<super::IsNoteExistRequest>
+                    for IsNoteExistSvc<T> {
+                        type Response = super::IsNoteExistResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::IsNoteExistRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as NoteCreatorService>::is_note_exist(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = IsNoteExistSvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/note.api.v1.NoteCreatorService/CreateNote" => {
+                    #[allow(non_camel_case_types)]
+                    struct CreateNoteSvc<T: NoteCreatorService>(pub Arc<T>);
+                    impl<
+                        T: NoteCreatorService,
+                    > tonic::server::UnaryService<super::CreateNoteRequest>
+                    for CreateNoteSvc<T> {
+                        type Response = super::CreateNoteResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::CreateNoteRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as NoteCreatorService>::create_note(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = CreateNoteSvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/note.api.v1.NoteCreatorService/UpdateNote" => {
+                    #[allow(non_camel_case_types)]
+                    struct UpdateNoteSvc<T: NoteCreatorService>(pub Arc<T>);
+                    impl<
+                        T: NoteCreatorService,
+                    > tonic::server::UnaryService<super::UpdateNoteRequest>
+                    for UpdateNoteSvc<T> {
+                        type Response = super::UpdateNoteResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::UpdateNoteRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as NoteCreatorService>::update_note(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = UpdateNoteSvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/note.api.v1.NoteCreatorService/DeleteNote" => {
+                    #[allow(non_camel_case_types)]
+                    struct DeleteNoteSvc<T: NoteCreatorService>(pub Arc<T>);
+                    impl<
+                        T: NoteCreatorService,
+                    > tonic::server::UnaryService<super::DeleteNoteRequest>
+                    for DeleteNoteSvc<T> {
+                        type Response = super::DeleteNoteResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::DeleteNoteRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as NoteCreatorService>::delete_note(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = DeleteNoteSvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/note.api.v1.NoteCreatorService/GetNote" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetNoteSvc<T: NoteCreatorService>(pub Arc<T>);
+                    impl<
+                        T: NoteCreatorService,
+                    > tonic::server::UnaryService<super::GetNoteRequest>
+                    for GetNoteSvc<T> {
+                        type Response = super::GetNoteResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::GetNoteRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as NoteCreatorService>::get_note(&inner, request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = GetNoteSvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/note.api.v1.NoteCreatorService/ListNote" => {
+                    #[allow(non_camel_case_types)]
+                    struct ListNoteSvc<T: NoteCreatorService>(pub Arc<T>);
+                    impl<
+                        T: NoteCreatorService,
+                    > tonic::server::UnaryService<super::ListNoteRequest>
+                    for ListNoteSvc<T> {
+                        type Response = super::ListNoteResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::ListNoteRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as NoteCreatorService>::list_note(&inner, request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = ListNoteSvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/note.api.v1.NoteCreatorService/PageListNote" => {
+                    #[allow(non_camel_case_types)]
+                    struct PageListNoteSvc<T: NoteCreatorService>(pub Arc<T>);
+                    impl<
+                        T: NoteCreatorService,
+                    > tonic::server::UnaryService<super::PageListNoteRequest>
+                    for PageListNoteSvc<T> {
+                        type Response = super::PageListNoteResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::PageListNoteRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as NoteCreatorService>::page_list_note(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = PageListNoteSvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/note.api.v1.NoteCreatorService/GetPostedCount" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetPostedCountSvc<T: NoteCreatorService>(pub Arc<T>);
+                    impl<
+                        T: NoteCreatorService,
+                    > tonic::server::UnaryService<super::GetPostedCountRequest>
+                    for GetPostedCountSvc<T> {
+                        type Response = super::GetPostedCountResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::GetPostedCountRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as NoteCreatorService>::get_posted_count(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = GetPostedCountSvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/note.api.v1.NoteCreatorService/AddTag" => {
+                    #[allow(non_camel_case_types)]
+                    struct AddTagSvc<T: NoteCreatorService>(pub Arc<T>);
+                    impl<
+                        T: NoteCreatorService,
+                    > tonic::server::UnaryService<super::AddTagRequest>
+                    for AddTagSvc<T> {
+                        type Response = super::AddTagResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::AddTagRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as NoteCreatorService>::add_tag(&inner, request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = AddTagSvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/note.api.v1.NoteCreatorService/ListDrafts" => {
                     #[allow(non_camel_case_types)]
-                    struct IsUserOwnNoteSvc<T: NoteCreatorService>(pub Arc<T>);
+                    struct ListDraftsSvc<T: NoteCreatorService>(pub Arc<T>);
                     impl<
                         T: NoteCreatorService,
-                    > tonic::server::UnaryService<super::IsUserOwnNoteRequest>
-                    for IsUserOwnNoteSvc<T> {
-                        type Response = super::IsUserOwnNoteResponse;
+                    > tonic::server::UnaryService<super::ListDraftsRequest>
+                    for ListDraftsSvc<T> {
+                        type Response = super::ListDraftsResponse;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
                         fn call(
                             &mut self,
-                            request: tonic::Request<super::IsUserOwnNoteRequest>,
+                            request: tonic::Request<super::ListDraftsRequest>,
                         ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                <T as NoteCreatorService>::is_user_own_note(&inner, request)
+                                <T as NoteCreatorService>::list_drafts(&inner, request)
                                     .await
                             };
                             Box::pin(fut)
@@ -567,7 +1592,7 @@ pub mod note_creator_service_server {
                     let max_encoding_message_size = self.max_encoding_message_size;
                     let inner = self.inner.clone();
                     let fut = async move {
-                        let method = IsUserOwnNoteSvc(inner);
+                        let method = ListDraftsSvc(inner);
                         let codec = tonic_prost::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -583,25 +1608,25 @@ pub mod note_creator_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/note.api.v1.NoteCreatorService/IsNoteExist" => {
+                "/note.api.v1.NoteCreatorService/PublishDraft" => {
                     #[allow(non_camel_case_types)]
-                    struct IsNoteExistSvc<T: NoteCreatorService>(pub Arc<T>);
+                    struct PublishDraftSvc<T: NoteCreatorService>(pub Arc<T>);
                     impl<
                         T: NoteCreatorService,
-                    > tonic::server::UnaryService<super::IsNoteExistRequest>
-                    for IsNoteExistSvc<T> {
-                        type Response = super::IsNoteExistResponse;
+                    > tonic::server::UnaryService<super::PublishDraftRequest>
+                    for PublishDraftSvc<T> {
+                        type Response = super::PublishDraftResponse;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
                         fn call(
                             &mut self,
-                            request: tonic::Request<super::IsNoteExistRequest>,
+                            request: tonic::Request<super::PublishDraftRequest>,
                         ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                <T as NoteCreatorService>::is_note_exist(&inner, request)
+                                <T as NoteCreatorService>::publish_draft(&inner, request)
                                     .await
                             };
                             Box::pin(fut)
@@ -613,7 +1638,7 @@ pub mod note_creator_service_server {
                     let max_encoding_message_size = self.max_encoding_message_size;
                     let inner = self.inner.clone();
                     let fut = async move {
-                        let method = IsNoteExistSvc(inner);
+                        let method = PublishDraftSvc(inner);
                         let codec = tonic_prost::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -629,25 +1654,28 @@ pub mod note_creator_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/note.api.v1.NoteCreatorService/CreateNote" => {
+                "/note.api.v1.NoteCreatorService/ListNoteRevisions" => {
                     #[allow(non_camel_case_types)]
-                    struct CreateNoteSvc<T: NoteCreatorService>(pub Arc<T>);
+                    struct ListNoteRevisionsSvc<T: NoteCreatorService>(pub Arc<T>);
                     impl<
                         T: NoteCreatorService,
-                    > tonic::server::UnaryService<super::CreateNoteRequest>
-                    for CreateNoteSvc<T> {
-                        type Response = super::CreateNoteResponse;
+                    > tonic::server::UnaryService<super::ListNoteRevisionsRequest>
+                    for ListNoteRevisionsSvc<T> {
+                        type Response = super::ListNoteRevisionsResponse;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
                         fn call(
                             &mut self,
-                            request: tonic::Request<super::CreateNoteRequest>,
+                            request: tonic::Request<super::ListNoteRevisionsRequest>,
                         ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                <T as NoteCreatorService>::create_note(&inner, request)
+                                <T as NoteCreatorService>::list_note_revisions(
+                                        &inner,
+                                        request,
+                                    )
                                     .await
                             };
                             Box::pin(fut)
@@ -659,7 +1687,7 @@ pub mod note_creator_service_server {
                     let max_encoding_message_size = self.max_encoding_message_size;
                     let inner = self.inner.clone();
                     let fut = async move {
-                        let method = CreateNoteSvc(inner);
+                        let method = ListNoteRevisionsSvc(inner);
                         let codec = tonic_prost::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -675,25 +1703,28 @@ pub mod note_creator_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/note.api.v1.NoteCreatorService/UpdateNote" => {
+                "/note.api.v1.NoteCreatorService/GetNoteRevision" => {
                     #[allow(non_camel_case_types)]
-                    struct UpdateNoteSvc<T: NoteCreatorService>(pub Arc<T>);
+                    struct GetNoteRevisionSvc<T: NoteCreatorService>(pub Arc<T>);
                     impl<
                         T: NoteCreatorService,
-                    > tonic::server::UnaryService<super::UpdateNoteRequest>
-                    for UpdateNoteSvc<T> {
-                        type Response = super::UpdateNoteResponse;
+                    > tonic::server::UnaryService<super::GetNoteRevisionRequest>
+                    for GetNoteRevisionSvc<T> {
+                        type Response = super::GetNoteRevisionResponse;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
                         fn call(
                             &mut self,
-                            request: tonic::Request<super::UpdateNoteRequest>,
+                            request: tonic::Request<super::GetNoteRevisionRequest>,
                         ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                <T as NoteCreatorService>::update_note(&inner, request)
+                                <T as NoteCreatorService>::get_note_revision(
+                                        &inner,
+                                        request,
+                                    )
                                     .await
                             };
                             Box::pin(fut)
@@ -705,7 +1736,7 @@ pub mod note_creator_service_server {
                     let max_encoding_message_size = self.max_encoding_message_size;
                     let inner = self.inner.clone();
                     let fut = async move {
-                        let method = UpdateNoteSvc(inner);
+                        let method = GetNoteRevisionSvc(inner);
                         let codec = tonic_prost::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -721,25 +1752,28 @@ pub mod note_creator_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/note.api.v1.NoteCreatorService/DeleteNote" => {
+                "/note.api.v1.NoteCreatorService/RestoreNoteRevision" => {
                     #[allow(non_camel_case_types)]
-                    struct DeleteNoteSvc<T: NoteCreatorService>(pub Arc<T>);
+                    struct RestoreNoteRevisionSvc<T: NoteCreatorService>(pub Arc<T>);
                     impl<
                         T: NoteCreatorService,
-                    > tonic::server::UnaryService<super::DeleteNoteRequest>
-                    for DeleteNoteSvc<T> {
-                        type Response = super::DeleteNoteResponse;
+                    > tonic::server::UnaryService<super::RestoreNoteRevisionRequest>
+                    for RestoreNoteRevisionSvc<T> {
+                        type Response = super::RestoreNoteRevisionResponse;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
                         fn call(
                             &mut self,
-                            request: tonic::Request<super::DeleteNoteRequest>,
+                            request: tonic::Request<super::RestoreNoteRevisionRequest>,
                         ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                <T as NoteCreatorService>::delete_note(&inner, request)
+                                <T as NoteCreatorService>::restore_note_revision(
+                                        &inner,
+                                        request,
+                                    )
                                     .await
                             };
                             Box::pin(fut)
@@ -751,7 +1785,7 @@ pub mod note_creator_service_server {
                     let max_encoding_message_size = self.max_encoding_message_size;
                     let inner = self.inner.clone();
                     let fut = async move {
-                        let method = DeleteNoteSvc(inner);
+                        let method = RestoreNoteRevisionSvc(inner);
                         let codec = tonic_prost::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -767,25 +1801,268 @@ pub mod note_creator_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/note.api.v1.NoteCreatorService/GetNote" => {
+                "/note.api.v1.NoteCreatorService/ListDeletedNotes" => {
                     #[allow(non_camel_case_types)]
-                    struct GetNoteSvc<T: NoteCreatorService>(pub Arc<T>);
+                    struct ListDeletedNotesSvc<T: NoteCreatorService>(pub Arc<T>);
                     impl<
                         T: NoteCreatorService,
-                    > tonic::server::UnaryService<super::GetNoteRequest>
-                    for GetNoteSvc<T> {
-                        type Response = super::GetNoteResponse;
+                    > tonic::server::UnaryService<super::ListDeletedNotesRequest>
+                    for ListDeletedNotesSvc<T> {
+                        type Response = super::ListDeletedNotesResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::ListDeletedNotesRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as NoteCreatorService>::list_deleted_notes(
+                                        &inner,
+                                        request,
+                                    )
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = ListDeletedNotesSvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/note.api.v1.NoteCreatorService/RestoreNote" => {
+                    #[allow(non_camel_case_types)]
+                    struct RestoreNoteSvc<T: NoteCreatorService>(pub Arc<T>);
+                    impl<
+                        T: NoteCreatorService,
+                    > tonic::server::UnaryService<super::RestoreNoteRequest>
+                    for RestoreNoteSvc<T> {
+                        type Response = super::RestoreNoteResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::RestoreNoteRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as NoteCreatorService>::restore_note(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = RestoreNoteSvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/note.api.v1.NoteCreatorService/CreateCollection" => {
+                    #[allow(non_camel_case_types)]
+                    struct CreateCollectionSvc<T: NoteCreatorService>(pub Arc<T>);
+                    impl<
+                        T: NoteCreatorService,
+                    > tonic::server::UnaryService<super::CreateCollectionRequest>
+                    for CreateCollectionSvc<T> {
+                        type Response = super::CreateCollectionResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::CreateCollectionRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as NoteCreatorService>::create_collection(
+                                        &inner,
+                                        request,
+                                    )
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = CreateCollectionSvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/note.api.v1.NoteCreatorService/UpdateCollection" => {
+                    #[allow(non_camel_case_types)]
+                    struct UpdateCollectionSvc<T: NoteCreatorService>(pub Arc<T>);
+                    impl<
+                        T: NoteCreatorService,
+                    > tonic::server::UnaryService<super::UpdateCollectionRequest>
+                    for UpdateCollectionSvc<T> {
+                        type Response = super::UpdateCollectionResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::UpdateCollectionRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as NoteCreatorService>::update_collection(
+                                        &inner,
+                                        request,
+                                    )
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = UpdateCollectionSvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/note.api.v1.NoteCreatorService/DeleteCollection" => {
+                    #[allow(non_camel_case_types)]
+                    struct DeleteCollectionSvc<T: NoteCreatorService>(pub Arc<T>);
+                    impl<
+                        T: NoteCreatorService,
+                    > tonic::server::UnaryService<super::DeleteCollectionRequest>
+                    for DeleteCollectionSvc<T> {
+                        type Response = super::DeleteCollectionResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::DeleteCollectionRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as NoteCreatorService>::delete_collection(
+                                        &inner,
+                                        request,
+                                    )
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = DeleteCollectionSvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/note.api.v1.NoteCreatorService/GetCollection" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetCollectionSvc<T: NoteCreatorService>(pub Arc<T>);
+                    impl<
+                        T: NoteCreatorService,
+                    > tonic::server::UnaryService<super::GetCollectionRequest>
+                    for GetCollectionSvc<T> {
+                        type Response = super::GetCollectionResponse;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
                         fn call(
                             &mut self,
-                            request: tonic::Request<super::GetNoteRequest>,
+                            request: tonic::Request<super::GetCollectionRequest>,
                         ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                <T as NoteCreatorService>::get_note(&inner, request).await
+                                <T as NoteCreatorService>::get_collection(&inner, request)
+                                    .await
                             };
                             Box::pin(fut)
                         }
@@ -796,7 +2073,7 @@ pub mod note_creator_service_server {
                     let max_encoding_message_size = self.max_encoding_message_size;
                     let inner = self.inner.clone();
                     let fut = async move {
-                        let method = GetNoteSvc(inner);
+                        let method = GetCollectionSvc(inner);
                         let codec = tonic_prost::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -812,25 +2089,26 @@ pub mod note_creator_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/note.api.v1.NoteCreatorService/ListNote" => {
+                "/note.api.v1.NoteCreatorService/ListCollections" => {
                     #[allow(non_camel_case_types)]
-                    struct ListNoteSvc<T: NoteCreatorService>(pub Arc<T>);
+                    struct ListCollectionsSvc<T: NoteCreatorService>(pub Arc<T>);
                     impl<
                         T: NoteCreatorService,
-                    > tonic::server::UnaryService<super::ListNoteRequest>
-                    for ListNoteSvc<T> {
-                        type Response = super::ListNoteResponse;
+                    > tonic::server::UnaryService<super::ListCollectionsRequest>
+                    for ListCollectionsSvc<T> {
+                        type Response = super::ListCollectionsResponse;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
                         fn call(
                             &mut self,
-                            request: tonic::Request<super::ListNoteRequest>,
+                            request: tonic::Request<super::ListCollectionsRequest>,
                         ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                <T as NoteCreatorService>::list_note(&inner, request).await
+                                <T as NoteCreatorService>::list_collections(&inner, request)
+                                    .await
                             };
                             Box::pin(fut)
                         }
@@ -841,7 +2119,7 @@ pub mod note_creator_service_server {
                     let max_encoding_message_size = self.max_encoding_message_size;
                     let inner = self.inner.clone();
                     let fut = async move {
-                        let method = ListNoteSvc(inner);
+                        let method = ListCollectionsSvc(inner);
                         let codec = tonic_prost::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -857,25 +2135,28 @@ pub mod note_creator_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/note.api.v1.NoteCreatorService/PageListNote" => {
+                "/note.api.v1.NoteCreatorService/AddCollectionNotes" => {
                     #[allow(non_camel_case_types)]
-                    struct PageListNoteSvc<T: NoteCreatorService>(pub Arc<T>);
+                    struct AddCollectionNotesSvc<T: NoteCreatorService>(pub Arc<T>);
                     impl<
                         T: NoteCreatorService,
-                    > tonic::server::UnaryService<super::PageListNoteRequest>
-                    for PageListNoteSvc<T> {
-                        type Response = super::PageListNoteResponse;
+                    > tonic::server::UnaryService<super::AddCollectionNotesRequest>
+                    for AddCollectionNotesSvc<T> {
+                        type Response = super::AddCollectionNotesResponse;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
                         fn call(
                             &mut self,
-                            request: tonic::Request<super::PageListNoteRequest>,
+                            request: tonic::Request<super::AddCollectionNotesRequest>,
                         ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                <T as NoteCreatorService>::page_list_note(&inner, request)
+                                <T as NoteCreatorService>::add_collection_notes(
+                                        &inner,
+                                        request,
+                                    )
                                     .await
                             };
                             Box::pin(fut)
@@ -887,7 +2168,7 @@ pub mod note_creator_service_server {
                     let max_encoding_message_size = self.max_encoding_message_size;
                     let inner = self.inner.clone();
                     let fut = async move {
-                        let method = PageListNoteSvc(inner);
+                        let method = AddCollectionNotesSvc(inner);
                         let codec = tonic_prost::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -903,25 +2184,28 @@ pub mod note_creator_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/note.api.v1.NoteCreatorService/GetPostedCount" => {
+                "/note.api.v1.NoteCreatorService/RemoveCollectionNotes" => {
                     #[allow(non_camel_case_types)]
-                    struct GetPostedCountSvc<T: NoteCreatorService>(pub Arc<T>);
+                    struct RemoveCollectionNotesSvc<T: NoteCreatorService>(pub Arc<T>);
                     impl<
                         T: NoteCreatorService,
-                    > tonic::server::UnaryService<super::GetPostedCountRequest>
-                    for GetPostedCountSvc<T> {
-                        type Response = super::GetPostedCountResponse;
+                    > tonic::server::UnaryService<super::RemoveCollectionNotesRequest>
+                    for RemoveCollectionNotesSvc<T> {
+                        type Response = super::RemoveCollectionNotesResponse;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
                         fn call(
                             &mut self,
-                            request: tonic::Request<super::GetPostedCountRequest>,
+                            request: tonic::Request<super::RemoveCollectionNotesRequest>,
                         ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                <T as NoteCreatorService>::get_posted_count(&inner, request)
+                                <T as NoteCreatorService>::remove_collection_notes(
+                                        &inner,
+                                        request,
+                                    )
                                     .await
                             };
                             Box::pin(fut)
@@ -933,7 +2217,7 @@ pub mod note_creator_service_server {
                     let max_encoding_message_size = self.max_encoding_message_size;
                     let inner = self.inner.clone();
                     let fut = async move {
-                        let method = GetPostedCountSvc(inner);
+                        let method = RemoveCollectionNotesSvc(inner);
                         let codec = tonic_prost::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -949,25 +2233,29 @@ pub mod note_creator_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/note.api.v1.NoteCreatorService/AddTag" => {
+                "/note.api.v1.NoteCreatorService/SortCollectionNotes" => {
                     #[allow(non_camel_case_types)]
-                    struct AddTagSvc<T: NoteCreatorService>(pub Arc<T>);
+                    struct SortCollectionNotesSvc<T: NoteCreatorService>(pub Arc<T>);
                     impl<
                         T: NoteCreatorService,
-                    > tonic::server::UnaryService<super::AddTagRequest>
-                    for AddTagSvc<T> {
-                        type Response = super::AddTagResponse;
+                    > tonic::server::UnaryService<super::SortCollectionNotesRequest>
+                    for SortCollectionNotesSvc<T> {
+                        type Response = super::SortCollectionNotesResponse;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
                         fn call(
                             &mut self,
-                            request: tonic::Request<super::AddTagRequest>,
+                            request: tonic::Request<super::SortCollectionNotesRequest>,
                         ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                <T as NoteCreatorService>::add_tag(&inner, request).await
+                                <T as NoteCreatorService>::sort_collection_notes(
+                                        &inner,
+                                        request,
+                                    )
+                                    .await
                             };
                             Box::pin(fut)
                         }
@@ -978,7 +2266,7 @@ pub mod note_creator_service_server {
                     let max_encoding_message_size = self.max_encoding_message_size;
                     let inner = self.inner.clone();
                     let fut = async move {
-                        let method = AddTagSvc(inner);
+                        let method = SortCollectionNotesSvc(inner);
                         let codec = tonic_prost::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -1305,6 +2593,34 @@ pub mod note_interact_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
+        /** 批量上报笔记浏览
+*/
+        pub async fn report_note_views(
+            &mut self,
+            request: impl tonic::IntoRequest<super::ReportNoteViewsRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::ReportNoteViewsResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/note.api.v1.NoteInteractService/ReportNoteViews",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("note.api.v1.NoteInteractService", "ReportNoteViews"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
     }
 }
 /// Generated server implementations.
@@ -1374,6 +2690,15 @@ pub mod note_interact_service_server {
             tonic::Response<super::PageListUserLikedNoteResponse>,
             tonic::Status,
         >;
+        /** 批量上报笔记浏览
+*/
+        async fn report_note_views(
+            &self,
+            request: tonic::Request<super::ReportNoteViewsRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::ReportNoteViewsResponse>,
+            tonic::Status,
+        >;
     }
     /** 与笔记交互逻辑相关服务，比如点赞、收藏等
 */
@@ -1744,6 +3069,55 @@ pub mod note_interact_service_server {
                     };
                     Box::pin(fut)
                 }
+                "/note.api.v1.NoteInteractService/ReportNoteViews" => {
+                    #[allow(non_camel_case_types)]
+                    struct ReportNoteViewsSvc<T: NoteInteractService>(pub Arc<T>);
+                    impl<
+                        T: NoteInteractService,
+                    > tonic::server::UnaryService<super::ReportNoteViewsRequest>
+                    for ReportNoteViewsSvc<T> {
+                        type Response = super::ReportNoteViewsResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::ReportNoteViewsRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as NoteInteractService>::report_note_views(
+                                        &inner,
+                                        request,
+                                    )
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = ReportNoteViewsSvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 _ => {
                     Box::pin(async move {
                         let mut response = http::Response::new(
@@ -1901,6 +3275,8 @@ pub mod note_feed_service_client {
                 .insert(GrpcMethod::new("note.api.v1.NoteFeedService", "RandomGet"));
             self.inner.unary(req, path, codec).await
         }
+        /** 获取笔记
+*/
         pub async fn get_feed_note(
             &mut self,
             request: impl tonic::IntoRequest<super::GetFeedNoteRequest>,
@@ -1925,6 +3301,8 @@ pub mod note_feed_service_client {
                 .insert(GrpcMethod::new("note.api.v1.NoteFeedService", "GetFeedNote"));
             self.inner.unary(req, path, codec).await
         }
+        /** 获取笔记作者
+*/
         pub async fn get_note_author(
             &mut self,
             request: impl tonic::IntoRequest<super::GetNoteAuthorRequest>,
@@ -2145,6 +3523,62 @@ pub mod note_feed_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
+        /** 列出用户公开的合集
+*/
+        pub async fn list_user_collections(
+            &mut self,
+            request: impl tonic::IntoRequest<super::ListUserCollectionsRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::ListUserCollectionsResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/note.api.v1.NoteFeedService/ListUserCollections",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("note.api.v1.NoteFeedService", "ListUserCollections"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /** 按顺序列出合集中公开的笔记
+*/
+        pub async fn list_collection_feed(
+            &mut self,
+            request: impl tonic::IntoRequest<super::ListCollectionFeedRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::ListCollectionFeedResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/note.api.v1.NoteFeedService/ListCollectionFeed",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("note.api.v1.NoteFeedService", "ListCollectionFeed"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
     }
 }
 /// Generated server implementations.
@@ -2167,6 +3601,8 @@ pub mod note_feed_service_server {
             tonic::Response<super::RandomGetResponse>,
             tonic::Status,
         >;
+        /** 获取笔记
+*/
         async fn get_feed_note(
             &self,
             request: tonic::Request<super::GetFeedNoteRequest>,
@@ -2174,6 +3610,8 @@ pub mod note_feed_service_server {
             tonic::Response<super::GetFeedNoteResponse>,
             tonic::Status,
         >;
+        /** 获取笔记作者
+*/
         async fn get_note_author(
             &self,
             request: tonic::Request<super::GetNoteAuthorRequest>,
@@ -2244,6 +3682,24 @@ pub mod note_feed_service_server {
             tonic::Response<super::BatchCheckFeedNoteExistResponse>,
             tonic::Status,
         >;
+        /** 列出用户公开的合集
+*/
+        async fn list_user_collections(
+            &self,
+            request: tonic::Request<super::ListUserCollectionsRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::ListUserCollectionsResponse>,
+            tonic::Status,
+        >;
+        /** 按顺序列出合集中公开的笔记
+*/
+        async fn list_collection_feed(
+            &self,
+            request: tonic::Request<super::ListCollectionFeedRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::ListCollectionFeedResponse>,
+            tonic::Status,
+        >;
     }
     /** note相关非管理功能服务
 */
@@ -2793,6 +4249,104 @@ pub mod note_feed_service_server {
                     };
                     Box::pin(fut)
                 }
+                "/note.api.v1.NoteFeedService/ListUserCollections" => {
+                    #[allow(non_camel_case_types)]
+                    struct ListUserCollectionsSvc<T: NoteFeedService>(pub Arc<T>);
+                    impl<
+                        T: NoteFeedService,
+                    > tonic::server::UnaryService<super::ListUserCollectionsRequest>
+                    for ListUserCollectionsSvc<T> {
+                        type Response = super::ListUserCollectionsResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::ListUserCollectionsRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as NoteFeedService>::list_user_collections(
+                                        &inner,
+                                        request,
+                                    )
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = ListUserCollectionsSvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/note.api.v1.NoteFeedService/ListCollectionFeed" => {
+                    #[allow(non_camel_case_types)]
+                    struct ListCollectionFeedSvc<T: NoteFeedService>(pub Arc<T>);
+                    impl<
+                        T: NoteFeedService,
+                    > tonic::server::UnaryService<super::ListCollectionFeedRequest>
+                    for ListCollectionFeedSvc<T> {
+                        type Response = super::ListCollectionFeedResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::ListCollectionFeedRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as NoteFeedService>::list_collection_feed(
+                                        &inner,
+                                        request,
+                                    )
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = ListCollectionFeedSvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 _ => {
                     Box::pin(async move {
                         let mut response = http::Response::new(
@@ -33,6 +33,9 @@ pub struct Note {
     pub likes_count: i64,
     #[prost(int64, tag = "11")]
     pub comments_count: i64,
+    /// 指定可见的用户 仅visibility为CUSTOM时有效
+    #[prost(int64, repeated, tag = "12")]
+    pub audience_uids: ::prost::alloc::vec::Vec<i64>,
 }
 /// Nested message and enum types in `Note`.
 pub mod note {
@@ -78,6 +81,12 @@ pub mod note {
         Unspecified = 0,
         Public = 1,
         Private = 2,
+        /// 仅粉丝可见
+        Followers = 3,
+        /// 仅互关好友可见
+        Mutual = 4,
+        /// 仅指定用户可见
+        Custom = 5,
     }
     impl Visibility {
         /// String value of the enum field names used in the ProtoBuf definition.
@@ -89,6 +98,9 @@ pub mod note {
                 Self::Unspecified => "VISIBILITY_UNSPECIFIED",
                 Self::Public => "VISIBILITY_PUBLIC",
                 Self::Private => "VISIBILITY_PRIVATE",
+                Self::Followers => "VISIBILITY_FOLLOWERS",
+                Self::Mutual => "VISIBILITY_MUTUAL",
+                Self::Custom => "VISIBILITY_CUSTOM",
             }
         }
         /// Creates an enum from field names used in the ProtoBuf definition.
@@ -97,6 +109,9 @@ pub mod note {
                 "VISIBILITY_UNSPECIFIED" => Some(Self::Unspecified),
                 "VISIBILITY_PUBLIC" => Some(Self::Public),
                 "VISIBILITY_PRIVATE" => Some(Self::Private),
+                "VISIBILITY_FOLLOWERS" => Some(Self::Followers),
+                "VISIBILITY_MUTUAL" => Some(Self::Mutual),
+                "VISIBILITY_CUSTOM" => Some(Self::Custom),
                 _ => None,
             }
         }
@@ -144,6 +159,34 @@ pub struct BatchUpdateNoteCommentCountRequest {
 #[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
 pub struct BatchUpdateNoteCommentCountResponse {
 }
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct BatchGetNoteRequest {
+    #[prost(string, repeated, tag = "1")]
+    pub ids: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BatchGetNoteResponse {
+    /// note_id => note 不存在的文档不返回
+    #[prost(map = "string, message", tag = "1")]
+    pub notes: ::std::collections::HashMap<::prost::alloc::string::String, Note>,
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct ListNoteIdsRequest {
+    /// 上一页最后一个note_id 为空时从头开始
+    #[prost(string, tag = "1")]
+    pub cursor: ::prost::alloc::string::String,
+    #[prost(int32, tag = "2")]
+    pub count: i32,
+}
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct ListNoteIdsResponse {
+    #[prost(string, repeated, tag = "1")]
+    pub ids: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    #[prost(string, tag = "2")]
+    pub next_cursor: ::prost::alloc::string::String,
+    #[prost(bool, tag = "3")]
+    pub has_next: bool,
+}
 /// 笔记筛选过滤器
 #[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
 pub struct NoteFilter {
@@ -183,6 +226,9 @@ pub struct SearchNotesRequest {
     pub page_token: ::prost::alloc::string::String,
     #[prost(int32, tag = "4")]
     pub count: i32,
+    /// 搜索者 用于过滤不可见的笔记
+    #[prost(int64, tag = "5")]
+    pub viewer_uid: i64,
 }
 #[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
 pub struct SearchNotesResponse {
@@ -229,14 +275,14 @@ impl NoteFilterType {
 }
 /// Encoded file descriptor set for the `search.api.v1` package
 pub const FILE_DESCRIPTOR_SET: &[u8] = &[
-    0x0a, 0xa2, 0x12, 0x0a, 0x19, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+    0x0a, 0xe0, 0x15, 0x0a, 0x19, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2f, 0x61, 0x70, 0x69, 0x2f,
     0x76, 0x31, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d,
     0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x22, 0x43, 0x0a,
     0x07, 0x4e, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
     0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
     0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
     0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x74, 0x69,
-    0x6d, 0x65, 0x22, 0x96, 0x05, 0x0a, 0x04, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e,
+    0x6d, 0x65, 0x22, 0x84, 0x06, 0x0a, 0x04, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e,
     0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f,
     0x74, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20,
     0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65,
@@ -263,464 +309,590 @@ pub const FILE_DESCRIPTOR_SET: &[u8] = &[
     0x28, 0x03, 0x52, 0x0a, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25,
     0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
     0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-    0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x36, 0x0a, 0x06, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12,
-    0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69,
-    0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-    0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x53, 0x0a,
-    0x09, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x53,
-    0x53, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-    0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f,
-    0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10,
-    0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x49, 0x44, 0x45, 0x4f,
-    0x10, 0x02, 0x22, 0x57, 0x0a, 0x0a, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
-    0x12, 0x1a, 0x0a, 0x16, 0x56, 0x49, 0x53, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x55,
-    0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11,
-    0x56, 0x49, 0x53, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x55, 0x42, 0x4c, 0x49,
-    0x43, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x56, 0x49, 0x53, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54,
-    0x59, 0x5f, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x10, 0x02, 0x42, 0xaf, 0x01, 0x0a, 0x11,
-    0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-    0x31, 0x42, 0x0a, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-    0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x79, 0x61, 0x6e,
-    0x72, 0x65, 0x61, 0x64, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x77, 0x68, 0x69, 0x6d, 0x65, 0x72,
-    0x2f, 0x69, 0x64, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x73, 0x65, 0x61, 0x72,
-    0x63, 0x68, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x41, 0x58, 0xaa,
-    0x02, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x41, 0x70, 0x69, 0x2e, 0x56, 0x31, 0xca,
-    0x02, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0xe2,
-    0x02, 0x19, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0x5c,
-    0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x53, 0x65,
-    0x61, 0x72, 0x63, 0x68, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a, 0x3a, 0x56, 0x31, 0x4a, 0xdd, 0x0a,
-    0x0a, 0x06, 0x12, 0x04, 0x00, 0x00, 0x29, 0x01, 0x0a, 0x08, 0x0a, 0x01, 0x0c, 0x12, 0x03, 0x00,
-    0x00, 0x12, 0x0a, 0x08, 0x0a, 0x01, 0x02, 0x12, 0x03, 0x02, 0x00, 0x16, 0x0a, 0x08, 0x0a, 0x01,
-    0x08, 0x12, 0x03, 0x04, 0x00, 0x4f, 0x0a, 0x09, 0x0a, 0x02, 0x08, 0x0b, 0x12, 0x03, 0x04, 0x00,
-    0x4f, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x00, 0x12, 0x04, 0x06, 0x00, 0x0a, 0x01, 0x0a, 0x0a, 0x0a,
-    0x03, 0x04, 0x00, 0x01, 0x12, 0x03, 0x06, 0x08, 0x0f, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x00, 0x02,
-    0x00, 0x12, 0x03, 0x07, 0x02, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x05, 0x12,
-    0x03, 0x07, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x07,
-    0x09, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x07, 0x11, 0x12,
-    0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x01, 0x12, 0x03, 0x08, 0x02, 0x13, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x00, 0x02, 0x01, 0x05, 0x12, 0x03, 0x08, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x08, 0x09, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02,
-    0x01, 0x03, 0x12, 0x03, 0x08, 0x11, 0x12, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x02, 0x12,
-    0x03, 0x09, 0x02, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x05, 0x12, 0x03, 0x09,
-    0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x01, 0x12, 0x03, 0x09, 0x09, 0x0e,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x03, 0x12, 0x03, 0x09, 0x11, 0x12, 0x0a, 0x0a,
-    0x0a, 0x02, 0x04, 0x01, 0x12, 0x04, 0x0c, 0x00, 0x29, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x01,
-    0x01, 0x12, 0x03, 0x0c, 0x08, 0x0c, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x01, 0x03, 0x00, 0x12, 0x04,
-    0x0d, 0x02, 0x10, 0x03, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x03, 0x00, 0x01, 0x12, 0x03, 0x0d,
-    0x0a, 0x10, 0x0a, 0x0d, 0x0a, 0x06, 0x04, 0x01, 0x03, 0x00, 0x02, 0x00, 0x12, 0x03, 0x0e, 0x04,
-    0x18, 0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x01, 0x03, 0x00, 0x02, 0x00, 0x05, 0x12, 0x03, 0x0e, 0x04,
-    0x09, 0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x01, 0x03, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x0e, 0x0b,
-    0x0e, 0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x01, 0x03, 0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x0e, 0x16,
-    0x17, 0x0a, 0x0d, 0x0a, 0x06, 0x04, 0x01, 0x03, 0x00, 0x02, 0x01, 0x12, 0x03, 0x0f, 0x04, 0x18,
-    0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x01, 0x03, 0x00, 0x02, 0x01, 0x05, 0x12, 0x03, 0x0f, 0x04, 0x0a,
-    0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x01, 0x03, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x0f, 0x0b, 0x13,
-    0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x01, 0x03, 0x00, 0x02, 0x01, 0x03, 0x12, 0x03, 0x0f, 0x16, 0x17,
-    0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x01, 0x04, 0x00, 0x12, 0x04, 0x12, 0x02, 0x16, 0x03, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x01, 0x04, 0x00, 0x01, 0x12, 0x03, 0x12, 0x07, 0x10, 0x0a, 0x0d, 0x0a, 0x06,
-    0x04, 0x01, 0x04, 0x00, 0x02, 0x00, 0x12, 0x03, 0x13, 0x04, 0x1f, 0x0a, 0x0e, 0x0a, 0x07, 0x04,
-    0x01, 0x04, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x13, 0x04, 0x1a, 0x0a, 0x0e, 0x0a, 0x07, 0x04,
-    0x01, 0x04, 0x00, 0x02, 0x00, 0x02, 0x12, 0x03, 0x13, 0x1d, 0x1e, 0x0a, 0x0d, 0x0a, 0x06, 0x04,
-    0x01, 0x04, 0x00, 0x02, 0x01, 0x12, 0x03, 0x14, 0x04, 0x1f, 0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x01,
-    0x04, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x14, 0x04, 0x14, 0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x01,
-    0x04, 0x00, 0x02, 0x01, 0x02, 0x12, 0x03, 0x14, 0x1d, 0x1e, 0x0a, 0x0d, 0x0a, 0x06, 0x04, 0x01,
-    0x04, 0x00, 0x02, 0x02, 0x12, 0x03, 0x15, 0x04, 0x1f, 0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x01, 0x04,
-    0x00, 0x02, 0x02, 0x01, 0x12, 0x03, 0x15, 0x04, 0x14, 0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x01, 0x04,
-    0x00, 0x02, 0x02, 0x02, 0x12, 0x03, 0x15, 0x1d, 0x1e, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x01, 0x04,
-    0x01, 0x12, 0x04, 0x18, 0x02, 0x1c, 0x03, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x04, 0x01, 0x01,
-    0x12, 0x03, 0x18, 0x07, 0x11, 0x0a, 0x0d, 0x0a, 0x06, 0x04, 0x01, 0x04, 0x01, 0x02, 0x00, 0x12,
-    0x03, 0x19, 0x04, 0x1f, 0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x01, 0x04, 0x01, 0x02, 0x00, 0x01, 0x12,
-    0x03, 0x19, 0x04, 0x1a, 0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x01, 0x04, 0x01, 0x02, 0x00, 0x02, 0x12,
-    0x03, 0x19, 0x1d, 0x1e, 0x0a, 0x0d, 0x0a, 0x06, 0x04, 0x01, 0x04, 0x01, 0x02, 0x01, 0x12, 0x03,
-    0x1a, 0x04, 0x1f, 0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x01, 0x04, 0x01, 0x02, 0x01, 0x01, 0x12, 0x03,
-    0x1a, 0x04, 0x15, 0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x01, 0x04, 0x01, 0x02, 0x01, 0x02, 0x12, 0x03,
-    0x1a, 0x1d, 0x1e, 0x0a, 0x0d, 0x0a, 0x06, 0x04, 0x01, 0x04, 0x01, 0x02, 0x02, 0x12, 0x03, 0x1b,
-    0x04, 0x1f, 0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x01, 0x04, 0x01, 0x02, 0x02, 0x01, 0x12, 0x03, 0x1b,
-    0x04, 0x16, 0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x01, 0x04, 0x01, 0x02, 0x02, 0x02, 0x12, 0x03, 0x1b,
-    0x1d, 0x1e, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x00, 0x12, 0x03, 0x1e, 0x02, 0x26, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x05, 0x12, 0x03, 0x1e, 0x02, 0x08, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x01, 0x02, 0x00, 0x01, 0x12, 0x03, 0x1e, 0x13, 0x1a, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x01, 0x02, 0x00, 0x03, 0x12, 0x03, 0x1e, 0x24, 0x25, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02,
-    0x01, 0x12, 0x03, 0x1f, 0x02, 0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x05, 0x12,
-    0x03, 0x1f, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x01, 0x12, 0x03, 0x1f,
-    0x13, 0x18, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x03, 0x12, 0x03, 0x1f, 0x24, 0x25,
-    0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x02, 0x12, 0x03, 0x20, 0x02, 0x26, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x01, 0x02, 0x02, 0x05, 0x12, 0x03, 0x20, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x01, 0x02, 0x02, 0x01, 0x12, 0x03, 0x20, 0x13, 0x17, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02,
-    0x02, 0x03, 0x12, 0x03, 0x20, 0x24, 0x25, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x03, 0x12,
-    0x03, 0x21, 0x02, 0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x03, 0x05, 0x12, 0x03, 0x21,
-    0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x03, 0x01, 0x12, 0x03, 0x21, 0x13, 0x1c,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x03, 0x03, 0x12, 0x03, 0x21, 0x24, 0x25, 0x0a, 0x0b,
-    0x0a, 0x04, 0x04, 0x01, 0x02, 0x04, 0x12, 0x03, 0x22, 0x02, 0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x01, 0x02, 0x04, 0x05, 0x12, 0x03, 0x22, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02,
-    0x04, 0x01, 0x12, 0x03, 0x22, 0x13, 0x1c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x04, 0x03,
-    0x12, 0x03, 0x22, 0x24, 0x25, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x05, 0x12, 0x03, 0x23,
-    0x02, 0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x05, 0x06, 0x12, 0x03, 0x23, 0x02, 0x08,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x05, 0x01, 0x12, 0x03, 0x23, 0x13, 0x19, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x01, 0x02, 0x05, 0x03, 0x12, 0x03, 0x23, 0x24, 0x25, 0x0a, 0x0b, 0x0a, 0x04,
-    0x04, 0x01, 0x02, 0x06, 0x12, 0x03, 0x24, 0x02, 0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02,
-    0x06, 0x04, 0x12, 0x03, 0x24, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x06, 0x06,
-    0x12, 0x03, 0x24, 0x0b, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x06, 0x01, 0x12, 0x03,
-    0x24, 0x13, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x06, 0x03, 0x12, 0x03, 0x24, 0x24,
-    0x25, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x07, 0x12, 0x03, 0x25, 0x02, 0x26, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x01, 0x02, 0x07, 0x06, 0x12, 0x03, 0x25, 0x02, 0x0b, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x01, 0x02, 0x07, 0x01, 0x12, 0x03, 0x25, 0x13, 0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01,
-    0x02, 0x07, 0x03, 0x12, 0x03, 0x25, 0x24, 0x25, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x08,
-    0x12, 0x03, 0x26, 0x02, 0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x08, 0x06, 0x12, 0x03,
-    0x26, 0x02, 0x0c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x08, 0x01, 0x12, 0x03, 0x26, 0x13,
-    0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x08, 0x03, 0x12, 0x03, 0x26, 0x24, 0x25, 0x0a,
-    0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x09, 0x12, 0x03, 0x27, 0x02, 0x27, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x01, 0x02, 0x09, 0x05, 0x12, 0x03, 0x27, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01,
-    0x02, 0x09, 0x01, 0x12, 0x03, 0x27, 0x13, 0x1e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x09,
-    0x03, 0x12, 0x03, 0x27, 0x24, 0x26, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x0a, 0x12, 0x03,
-    0x28, 0x02, 0x27, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x0a, 0x05, 0x12, 0x03, 0x28, 0x02,
-    0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x0a, 0x01, 0x12, 0x03, 0x28, 0x13, 0x21, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x0a, 0x03, 0x12, 0x03, 0x28, 0x24, 0x26, 0x62, 0x06, 0x70,
-    0x72, 0x6f, 0x74, 0x6f, 0x33, 0x0a, 0xa3, 0x15, 0x0a, 0x1c, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
-    0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
-    0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61,
-    0x70, 0x69, 0x2e, 0x76, 0x31, 0x1a, 0x19, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2f, 0x61, 0x70,
-    0x69, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-    0x1a, 0x18, 0x65, 0x78, 0x74, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x6f, 0x70,
-    0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4d, 0x0a, 0x16, 0x42, 0x61,
-    0x74, 0x63, 0x68, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71,
-    0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x61, 0x67,
-    0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
-    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52,
-    0x08, 0x6e, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x67, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x42, 0x61, 0x74,
-    0x63, 0x68, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70,
-    0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x64, 0x64,
-    0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x6e,
-    0x6f, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x61,
-    0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x52,
-    0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41,
-    0x64, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a,
-    0x0a, 0x16, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74,
-    0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18,
-    0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x42, 0x61,
-    0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73,
-    0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x1f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55,
-    0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75,
-    0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x06, 0x63, 0x6f, 0x75,
-    0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x73, 0x65, 0x61, 0x72,
-    0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55,
-    0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75,
-    0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73,
-    0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x1a, 0x39, 0x0a,
-    0x0b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-    0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-    0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76,
-    0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x22, 0x0a, 0x20, 0x42, 0x61, 0x74, 0x63,
-    0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x43,
-    0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb6, 0x01, 0x0a,
-    0x22, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65,
-    0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-    0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
-    0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69,
-    0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e,
-    0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-    0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74,
-    0x72, 0x79, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x43, 0x6f,
-    0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-    0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-    0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-    0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x25, 0x0a, 0x23, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70,
-    0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43,
-    0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xba, 0x04, 0x0a,
-    0x0f, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-    0x12, 0x60, 0x0a, 0x0f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x74, 0x65,
-    0x54, 0x61, 0x67, 0x12, 0x25, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69,
-    0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x74, 0x65,
-    0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x65, 0x61,
-    0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+    0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63,
+    0x65, 0x5f, 0x75, 0x69, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x75,
+    0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x73, 0x1a, 0x36, 0x0a, 0x06, 0x41, 0x75,
+    0x74, 0x68, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+    0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61,
+    0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61,
+    0x6d, 0x65, 0x22, 0x53, 0x0a, 0x09, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+    0x1a, 0x0a, 0x16, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
+    0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x41,
+    0x53, 0x53, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x10,
+    0x01, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+    0x56, 0x49, 0x44, 0x45, 0x4f, 0x10, 0x02, 0x22, 0x9f, 0x01, 0x0a, 0x0a, 0x56, 0x69, 0x73, 0x69,
+    0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x16, 0x56, 0x49, 0x53, 0x49, 0x42, 0x49,
+    0x4c, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+    0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x56, 0x49, 0x53, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59,
+    0x5f, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x43, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x56, 0x49, 0x53,
+    0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x10,
+    0x02, 0x12, 0x18, 0x0a, 0x14, 0x56, 0x49, 0x53, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f,
+    0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x52, 0x53, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x56,
+    0x49, 0x53, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x4d, 0x55, 0x54, 0x55, 0x41, 0x4c,
+    0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x56, 0x49, 0x53, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59,
+    0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x10, 0x05, 0x42, 0xaf, 0x01, 0x0a, 0x11, 0x63, 0x6f,
+    0x6d, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x42,
+    0x0a, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x38, 0x67,
+    0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x79, 0x61, 0x6e, 0x72, 0x65,
+    0x61, 0x64, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x77, 0x68, 0x69, 0x6d, 0x65, 0x72, 0x2f, 0x69,
+    0x64, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+    0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x41, 0x58, 0xaa, 0x02, 0x0d,
+    0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x41, 0x70, 0x69, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d,
+    0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19,
+    0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
+    0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x53, 0x65, 0x61, 0x72,
+    0x63, 0x68, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a, 0x3a, 0x56, 0x31, 0x4a, 0xad, 0x0d, 0x0a, 0x06,
+    0x12, 0x04, 0x00, 0x00, 0x2d, 0x01, 0x0a, 0x08, 0x0a, 0x01, 0x0c, 0x12, 0x03, 0x00, 0x00, 0x12,
+    0x0a, 0x08, 0x0a, 0x01, 0x02, 0x12, 0x03, 0x02, 0x00, 0x16, 0x0a, 0x08, 0x0a, 0x01, 0x08, 0x12,
+    0x03, 0x04, 0x00, 0x4f, 0x0a, 0x09, 0x0a, 0x02, 0x08, 0x0b, 0x12, 0x03, 0x04, 0x00, 0x4f, 0x0a,
+    0x0a, 0x0a, 0x02, 0x04, 0x00, 0x12, 0x04, 0x06, 0x00, 0x0a, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04,
+    0x00, 0x01, 0x12, 0x03, 0x06, 0x08, 0x0f, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x00, 0x12,
+    0x03, 0x07, 0x02, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x05, 0x12, 0x03, 0x07,
+    0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x07, 0x09, 0x0b,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x07, 0x11, 0x12, 0x0a, 0x0b,
+    0x0a, 0x04, 0x04, 0x00, 0x02, 0x01, 0x12, 0x03, 0x08, 0x02, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x00, 0x02, 0x01, 0x05, 0x12, 0x03, 0x08, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02,
+    0x01, 0x01, 0x12, 0x03, 0x08, 0x09, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x03,
+    0x12, 0x03, 0x08, 0x11, 0x12, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x02, 0x12, 0x03, 0x09,
+    0x02, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x05, 0x12, 0x03, 0x09, 0x02, 0x07,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x01, 0x12, 0x03, 0x09, 0x09, 0x0e, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x00, 0x02, 0x02, 0x03, 0x12, 0x03, 0x09, 0x11, 0x12, 0x0a, 0x0a, 0x0a, 0x02,
+    0x04, 0x01, 0x12, 0x04, 0x0c, 0x00, 0x2d, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x01, 0x01, 0x12,
+    0x03, 0x0c, 0x08, 0x0c, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x01, 0x03, 0x00, 0x12, 0x04, 0x0d, 0x02,
+    0x10, 0x03, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x03, 0x00, 0x01, 0x12, 0x03, 0x0d, 0x0a, 0x10,
+    0x0a, 0x0d, 0x0a, 0x06, 0x04, 0x01, 0x03, 0x00, 0x02, 0x00, 0x12, 0x03, 0x0e, 0x04, 0x18, 0x0a,
+    0x0e, 0x0a, 0x07, 0x04, 0x01, 0x03, 0x00, 0x02, 0x00, 0x05, 0x12, 0x03, 0x0e, 0x04, 0x09, 0x0a,
+    0x0e, 0x0a, 0x07, 0x04, 0x01, 0x03, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x0e, 0x0b, 0x0e, 0x0a,
+    0x0e, 0x0a, 0x07, 0x04, 0x01, 0x03, 0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x0e, 0x16, 0x17, 0x0a,
+    0x0d, 0x0a, 0x06, 0x04, 0x01, 0x03, 0x00, 0x02, 0x01, 0x12, 0x03, 0x0f, 0x04, 0x18, 0x0a, 0x0e,
+    0x0a, 0x07, 0x04, 0x01, 0x03, 0x00, 0x02, 0x01, 0x05, 0x12, 0x03, 0x0f, 0x04, 0x0a, 0x0a, 0x0e,
+    0x0a, 0x07, 0x04, 0x01, 0x03, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x0f, 0x0b, 0x13, 0x0a, 0x0e,
+    0x0a, 0x07, 0x04, 0x01, 0x03, 0x00, 0x02, 0x01, 0x03, 0x12, 0x03, 0x0f, 0x16, 0x17, 0x0a, 0x0c,
+    0x0a, 0x04, 0x04, 0x01, 0x04, 0x00, 0x12, 0x04, 0x12, 0x02, 0x16, 0x03, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x01, 0x04, 0x00, 0x01, 0x12, 0x03, 0x12, 0x07, 0x10, 0x0a, 0x0d, 0x0a, 0x06, 0x04, 0x01,
+    0x04, 0x00, 0x02, 0x00, 0x12, 0x03, 0x13, 0x04, 0x1f, 0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x01, 0x04,
+    0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x13, 0x04, 0x1a, 0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x01, 0x04,
+    0x00, 0x02, 0x00, 0x02, 0x12, 0x03, 0x13, 0x1d, 0x1e, 0x0a, 0x0d, 0x0a, 0x06, 0x04, 0x01, 0x04,
+    0x00, 0x02, 0x01, 0x12, 0x03, 0x14, 0x04, 0x1f, 0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x01, 0x04, 0x00,
+    0x02, 0x01, 0x01, 0x12, 0x03, 0x14, 0x04, 0x14, 0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x01, 0x04, 0x00,
+    0x02, 0x01, 0x02, 0x12, 0x03, 0x14, 0x1d, 0x1e, 0x0a, 0x0d, 0x0a, 0x06, 0x04, 0x01, 0x04, 0x00,
+    0x02, 0x02, 0x12, 0x03, 0x15, 0x04, 0x1f, 0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x01, 0x04, 0x00, 0x02,
+    0x02, 0x01, 0x12, 0x03, 0x15, 0x04, 0x14, 0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x01, 0x04, 0x00, 0x02,
+    0x02, 0x02, 0x12, 0x03, 0x15, 0x1d, 0x1e, 0x0a, 0x0c, 0x0a, 0x04, 0x04, 0x01, 0x04, 0x01, 0x12,
+    0x04, 0x18, 0x02, 0x1f, 0x03, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x04, 0x01, 0x01, 0x12, 0x03,
+    0x18, 0x07, 0x11, 0x0a, 0x0d, 0x0a, 0x06, 0x04, 0x01, 0x04, 0x01, 0x02, 0x00, 0x12, 0x03, 0x19,
+    0x04, 0x1f, 0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x01, 0x04, 0x01, 0x02, 0x00, 0x01, 0x12, 0x03, 0x19,
+    0x04, 0x1a, 0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x01, 0x04, 0x01, 0x02, 0x00, 0x02, 0x12, 0x03, 0x19,
+    0x1d, 0x1e, 0x0a, 0x0d, 0x0a, 0x06, 0x04, 0x01, 0x04, 0x01, 0x02, 0x01, 0x12, 0x03, 0x1a, 0x04,
+    0x1f, 0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x01, 0x04, 0x01, 0x02, 0x01, 0x01, 0x12, 0x03, 0x1a, 0x04,
+    0x15, 0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x01, 0x04, 0x01, 0x02, 0x01, 0x02, 0x12, 0x03, 0x1a, 0x1d,
+    0x1e, 0x0a, 0x0d, 0x0a, 0x06, 0x04, 0x01, 0x04, 0x01, 0x02, 0x02, 0x12, 0x03, 0x1b, 0x04, 0x1f,
+    0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x01, 0x04, 0x01, 0x02, 0x02, 0x01, 0x12, 0x03, 0x1b, 0x04, 0x16,
+    0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x01, 0x04, 0x01, 0x02, 0x02, 0x02, 0x12, 0x03, 0x1b, 0x1d, 0x1e,
+    0x0a, 0x20, 0x0a, 0x06, 0x04, 0x01, 0x04, 0x01, 0x02, 0x03, 0x12, 0x03, 0x1c, 0x04, 0x1f, 0x22,
+    0x11, 0x20, 0xe4, 0xbb, 0x85, 0xe7, 0xb2, 0x89, 0xe4, 0xb8, 0x9d, 0xe5, 0x8f, 0xaf, 0xe8, 0xa7,
+    0x81, 0x0a, 0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x01, 0x04, 0x01, 0x02, 0x03, 0x01, 0x12, 0x03, 0x1c,
+    0x04, 0x18, 0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x01, 0x04, 0x01, 0x02, 0x03, 0x02, 0x12, 0x03, 0x1c,
+    0x1d, 0x1e, 0x0a, 0x26, 0x0a, 0x06, 0x04, 0x01, 0x04, 0x01, 0x02, 0x04, 0x12, 0x03, 0x1d, 0x04,
+    0x1f, 0x22, 0x17, 0x20, 0xe4, 0xbb, 0x85, 0xe4, 0xba, 0x92, 0xe5, 0x85, 0xb3, 0xe5, 0xa5, 0xbd,
+    0xe5, 0x8f, 0x8b, 0xe5, 0x8f, 0xaf, 0xe8, 0xa7, 0x81, 0x0a, 0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x01,
+    0x04, 0x01, 0x02, 0x04, 0x01, 0x12, 0x03, 0x1d, 0x04, 0x15, 0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x01,
+    0x04, 0x01, 0x02, 0x04, 0x02, 0x12, 0x03, 0x1d, 0x1d, 0x1e, 0x0a, 0x26, 0x0a, 0x06, 0x04, 0x01,
+    0x04, 0x01, 0x02, 0x05, 0x12, 0x03, 0x1e, 0x04, 0x1f, 0x22, 0x17, 0x20, 0xe4, 0xbb, 0x85, 0xe6,
+    0x8c, 0x87, 0xe5, 0xae, 0x9a, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe5, 0x8f, 0xaf, 0xe8, 0xa7,
+    0x81, 0x0a, 0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x01, 0x04, 0x01, 0x02, 0x05, 0x01, 0x12, 0x03, 0x1e,
+    0x04, 0x15, 0x0a, 0x0e, 0x0a, 0x07, 0x04, 0x01, 0x04, 0x01, 0x02, 0x05, 0x02, 0x12, 0x03, 0x1e,
+    0x1d, 0x1e, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x00, 0x12, 0x03, 0x21, 0x02, 0x26, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x05, 0x12, 0x03, 0x21, 0x02, 0x08, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x01, 0x02, 0x00, 0x01, 0x12, 0x03, 0x21, 0x13, 0x1a, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x01, 0x02, 0x00, 0x03, 0x12, 0x03, 0x21, 0x24, 0x25, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02,
+    0x01, 0x12, 0x03, 0x22, 0x02, 0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x05, 0x12,
+    0x03, 0x22, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x01, 0x12, 0x03, 0x22,
+    0x13, 0x18, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x03, 0x12, 0x03, 0x22, 0x24, 0x25,
+    0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x02, 0x12, 0x03, 0x23, 0x02, 0x26, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x01, 0x02, 0x02, 0x05, 0x12, 0x03, 0x23, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x01, 0x02, 0x02, 0x01, 0x12, 0x03, 0x23, 0x13, 0x17, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02,
+    0x02, 0x03, 0x12, 0x03, 0x23, 0x24, 0x25, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x03, 0x12,
+    0x03, 0x24, 0x02, 0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x03, 0x05, 0x12, 0x03, 0x24,
+    0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x03, 0x01, 0x12, 0x03, 0x24, 0x13, 0x1c,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x03, 0x03, 0x12, 0x03, 0x24, 0x24, 0x25, 0x0a, 0x0b,
+    0x0a, 0x04, 0x04, 0x01, 0x02, 0x04, 0x12, 0x03, 0x25, 0x02, 0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x01, 0x02, 0x04, 0x05, 0x12, 0x03, 0x25, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02,
+    0x04, 0x01, 0x12, 0x03, 0x25, 0x13, 0x1c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x04, 0x03,
+    0x12, 0x03, 0x25, 0x24, 0x25, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x05, 0x12, 0x03, 0x26,
+    0x02, 0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x05, 0x06, 0x12, 0x03, 0x26, 0x02, 0x08,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x05, 0x01, 0x12, 0x03, 0x26, 0x13, 0x19, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x01, 0x02, 0x05, 0x03, 0x12, 0x03, 0x26, 0x24, 0x25, 0x0a, 0x0b, 0x0a, 0x04,
+    0x04, 0x01, 0x02, 0x06, 0x12, 0x03, 0x27, 0x02, 0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02,
+    0x06, 0x04, 0x12, 0x03, 0x27, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x06, 0x06,
+    0x12, 0x03, 0x27, 0x0b, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x06, 0x01, 0x12, 0x03,
+    0x27, 0x13, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x06, 0x03, 0x12, 0x03, 0x27, 0x24,
+    0x25, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x07, 0x12, 0x03, 0x28, 0x02, 0x26, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x01, 0x02, 0x07, 0x06, 0x12, 0x03, 0x28, 0x02, 0x0b, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x01, 0x02, 0x07, 0x01, 0x12, 0x03, 0x28, 0x13, 0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01,
+    0x02, 0x07, 0x03, 0x12, 0x03, 0x28, 0x24, 0x25, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x08,
+    0x12, 0x03, 0x29, 0x02, 0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x08, 0x06, 0x12, 0x03,
+    0x29, 0x02, 0x0c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x08, 0x01, 0x12, 0x03, 0x29, 0x13,
+    0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x08, 0x03, 0x12, 0x03, 0x29, 0x24, 0x25, 0x0a,
+    0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x09, 0x12, 0x03, 0x2a, 0x02, 0x27, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x01, 0x02, 0x09, 0x05, 0x12, 0x03, 0x2a, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01,
+    0x02, 0x09, 0x01, 0x12, 0x03, 0x2a, 0x13, 0x1e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x09,
+    0x03, 0x12, 0x03, 0x2a, 0x24, 0x26, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x0a, 0x12, 0x03,
+    0x2b, 0x02, 0x27, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x0a, 0x05, 0x12, 0x03, 0x2b, 0x02,
+    0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x0a, 0x01, 0x12, 0x03, 0x2b, 0x13, 0x21, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x0a, 0x03, 0x12, 0x03, 0x2b, 0x24, 0x26, 0x0a, 0x44, 0x0a,
+    0x04, 0x04, 0x01, 0x02, 0x0b, 0x12, 0x03, 0x2c, 0x02, 0x27, 0x22, 0x37, 0x20, 0xe6, 0x8c, 0x87,
+    0xe5, 0xae, 0x9a, 0xe5, 0x8f, 0xaf, 0xe8, 0xa7, 0x81, 0xe7, 0x9a, 0x84, 0xe7, 0x94, 0xa8, 0xe6,
+    0x88, 0xb7, 0x20, 0xe4, 0xbb, 0x85, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+    0xe4, 0xb8, 0xba, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0xe6, 0x97, 0xb6, 0xe6, 0x9c, 0x89, 0xe6,
+    0x95, 0x88, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x0b, 0x04, 0x12, 0x03, 0x2c, 0x02,
+    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x0b, 0x05, 0x12, 0x03, 0x2c, 0x0b, 0x10, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x0b, 0x01, 0x12, 0x03, 0x2c, 0x13, 0x20, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x01, 0x02, 0x0b, 0x03, 0x12, 0x03, 0x2c, 0x24, 0x26, 0x62, 0x06, 0x70, 0x72, 0x6f,
+    0x74, 0x6f, 0x33, 0x0a, 0xbd, 0x20, 0x0a, 0x1c, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2f, 0x61,
+    0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70,
+    0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x76, 0x31, 0x1a, 0x19, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+    0x76, 0x31, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18,
+    0x65, 0x78, 0x74, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x6f, 0x70, 0x74, 0x69,
+    0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4d, 0x0a, 0x16, 0x42, 0x61, 0x74, 0x63,
+    0x68, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+    0x73, 0x74, 0x12, 0x33, 0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18,
+    0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x08, 0x6e,
+    0x6f, 0x74, 0x65, 0x54, 0x61, 0x67, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68,
     0x41, 0x64, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-    0x73, 0x65, 0x12, 0x57, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x64, 0x64, 0x4e, 0x6f,
-    0x74, 0x65, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-    0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x52,
-    0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e,
-    0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x64, 0x64, 0x4e,
-    0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0f, 0x42,
-    0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x25,
-    0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42,
-    0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65,
-    0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61,
-    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74,
-    0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7b, 0x0a,
-    0x18, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65,
-    0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x2e, 0x73, 0x65, 0x61, 0x72,
-    0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55,
+    0x73, 0x65, 0x22, 0x40, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x64, 0x64, 0x4e, 0x6f,
+    0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x6e, 0x6f, 0x74,
+    0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
+    0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x6e,
+    0x6f, 0x74, 0x65, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x64, 0x64,
+    0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x0a, 0x16,
+    0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52,
+    0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+    0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63,
+    0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+    0x6e, 0x73, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x1f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64,
+    0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+    0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+    0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64,
+    0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+    0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e,
+    0x74, 0x72, 0x79, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x43,
+    0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+    0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+    0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c,
+    0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x22, 0x0a, 0x20, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55,
     0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75,
-    0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x73, 0x65, 0x61, 0x72,
-    0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55,
-    0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75,
-    0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x84, 0x01, 0x0a, 0x1b, 0x42,
+    0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb6, 0x01, 0x0a, 0x22, 0x42,
     0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x43, 0x6f,
-    0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x2e, 0x73, 0x65, 0x61,
-    0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
-    0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-    0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e,
-    0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61,
-    0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6d,
-    0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-    0x65, 0x1a, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x42, 0xb2, 0x01, 0x0a, 0x11, 0x63, 0x6f,
-    0x6d, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x42,
-    0x0d, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-    0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x79, 0x61,
-    0x6e, 0x72, 0x65, 0x61, 0x64, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x77, 0x68, 0x69, 0x6d, 0x65,
-    0x72, 0x2f, 0x69, 0x64, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x73, 0x65, 0x61,
-    0x72, 0x63, 0x68, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x41, 0x58,
-    0xaa, 0x02, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x41, 0x70, 0x69, 0x2e, 0x56, 0x31,
-    0xca, 0x02, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31,
-    0xe2, 0x02, 0x19, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31,
-    0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x53,
-    0x65, 0x61, 0x72, 0x63, 0x68, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a, 0x3a, 0x56, 0x31, 0x4a, 0x82,
-    0x09, 0x0a, 0x06, 0x12, 0x04, 0x00, 0x00, 0x3b, 0x2e, 0x0a, 0x08, 0x0a, 0x01, 0x0c, 0x12, 0x03,
-    0x00, 0x00, 0x12, 0x0a, 0x08, 0x0a, 0x01, 0x02, 0x12, 0x03, 0x02, 0x00, 0x16, 0x0a, 0x09, 0x0a,
-    0x02, 0x03, 0x00, 0x12, 0x03, 0x04, 0x00, 0x23, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x01, 0x12, 0x03,
-    0x05, 0x00, 0x22, 0x0a, 0x08, 0x0a, 0x01, 0x08, 0x12, 0x03, 0x07, 0x00, 0x4f, 0x0a, 0x09, 0x0a,
-    0x02, 0x08, 0x0b, 0x12, 0x03, 0x07, 0x00, 0x4f, 0x0a, 0x26, 0x0a, 0x02, 0x06, 0x00, 0x12, 0x04,
-    0x0a, 0x00, 0x1b, 0x01, 0x1a, 0x1a, 0x20, 0xe6, 0x90, 0x9c, 0xe7, 0xb4, 0xa2, 0xe6, 0x95, 0xb0,
-    0xe6, 0x8d, 0xae, 0xe5, 0x86, 0x99, 0xe5, 0x85, 0xa5, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x0a,
-    0x0a, 0x0a, 0x0a, 0x03, 0x06, 0x00, 0x01, 0x12, 0x03, 0x0a, 0x08, 0x17, 0x0a, 0x0a, 0x0a, 0x03,
-    0x06, 0x00, 0x03, 0x12, 0x03, 0x0b, 0x02, 0x3e, 0x0a, 0x0e, 0x0a, 0x07, 0x06, 0x00, 0x03, 0xd1,
-    0x86, 0x03, 0x01, 0x12, 0x03, 0x0b, 0x02, 0x3e, 0x0a, 0x27, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x00,
-    0x12, 0x03, 0x0e, 0x02, 0x50, 0x1a, 0x1a, 0x20, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe5, 0x86,
-    0x99, 0xe5, 0x85, 0xa5, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe6, 0xa0, 0x87, 0xe7, 0xad, 0xbe,
-    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x0e, 0x06, 0x15, 0x0a,
-    0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x02, 0x12, 0x03, 0x0e, 0x16, 0x2c, 0x0a, 0x0c, 0x0a,
-    0x05, 0x06, 0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x0e, 0x37, 0x4e, 0x0a, 0x21, 0x0a, 0x04, 0x06,
-    0x00, 0x02, 0x01, 0x12, 0x03, 0x11, 0x02, 0x47, 0x1a, 0x14, 0x20, 0xe6, 0x89, 0xb9, 0xe9, 0x87,
-    0x8f, 0xe5, 0x86, 0x99, 0xe5, 0x85, 0xa5, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x0a, 0x0a, 0x0c,
-    0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x11, 0x06, 0x12, 0x0a, 0x0c, 0x0a, 0x05,
-    0x06, 0x00, 0x02, 0x01, 0x02, 0x12, 0x03, 0x11, 0x13, 0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00,
-    0x02, 0x01, 0x03, 0x12, 0x03, 0x11, 0x31, 0x45, 0x0a, 0x21, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x02,
-    0x12, 0x03, 0x14, 0x02, 0x50, 0x1a, 0x14, 0x20, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe5, 0x88,
-    0xa0, 0xe9, 0x99, 0xa4, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06,
-    0x00, 0x02, 0x02, 0x01, 0x12, 0x03, 0x14, 0x06, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02,
-    0x02, 0x02, 0x12, 0x03, 0x14, 0x16, 0x2c, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x03,
-    0x12, 0x03, 0x14, 0x37, 0x4e, 0x0a, 0x27, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x03, 0x12, 0x03, 0x17,
-    0x02, 0x6b, 0x1a, 0x1a, 0x20, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe7, 0xac, 0x94, 0xe8, 0xae,
-    0xb0, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x0a, 0x0a, 0x0c,
-    0x0a, 0x05, 0x06, 0x00, 0x02, 0x03, 0x01, 0x12, 0x03, 0x17, 0x06, 0x1e, 0x0a, 0x0c, 0x0a, 0x05,
-    0x06, 0x00, 0x02, 0x03, 0x02, 0x12, 0x03, 0x17, 0x1f, 0x3e, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00,
-    0x02, 0x03, 0x03, 0x12, 0x03, 0x17, 0x49, 0x69, 0x0a, 0x27, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x04,
-    0x12, 0x03, 0x1a, 0x02, 0x74, 0x1a, 0x1a, 0x20, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe7, 0xac,
-    0x94, 0xe8, 0xae, 0xb0, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f,
-    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x04, 0x01, 0x12, 0x03, 0x1a, 0x06, 0x21, 0x0a,
-    0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x04, 0x02, 0x12, 0x03, 0x1a, 0x22, 0x44, 0x0a, 0x0c, 0x0a,
-    0x05, 0x06, 0x00, 0x02, 0x04, 0x03, 0x12, 0x03, 0x1a, 0x4f, 0x72, 0x0a, 0x0a, 0x0a, 0x02, 0x04,
-    0x00, 0x12, 0x04, 0x1d, 0x00, 0x1f, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x00, 0x01, 0x12, 0x03,
-    0x1d, 0x08, 0x1e, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x00, 0x12, 0x03, 0x1e, 0x02, 0x21,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x04, 0x12, 0x03, 0x1e, 0x02, 0x0a, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x06, 0x12, 0x03, 0x1e, 0x0b, 0x12, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x1e, 0x13, 0x1c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00,
-    0x02, 0x00, 0x03, 0x12, 0x03, 0x1e, 0x1f, 0x20, 0x0a, 0x09, 0x0a, 0x02, 0x04, 0x01, 0x12, 0x03,
-    0x21, 0x00, 0x22, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x01, 0x01, 0x12, 0x03, 0x21, 0x08, 0x1f, 0x0a,
-    0x0a, 0x0a, 0x02, 0x04, 0x02, 0x12, 0x04, 0x23, 0x00, 0x25, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04,
-    0x02, 0x01, 0x12, 0x03, 0x23, 0x08, 0x1b, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x00, 0x12,
-    0x03, 0x24, 0x02, 0x1a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x04, 0x12, 0x03, 0x24,
-    0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x06, 0x12, 0x03, 0x24, 0x0b, 0x0f,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x01, 0x12, 0x03, 0x24, 0x10, 0x15, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x03, 0x12, 0x03, 0x24, 0x18, 0x19, 0x0a, 0x09, 0x0a, 0x02,
-    0x04, 0x03, 0x12, 0x03, 0x27, 0x00, 0x1f, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x03, 0x01, 0x12, 0x03,
-    0x27, 0x08, 0x1c, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x04, 0x12, 0x04, 0x29, 0x00, 0x2b, 0x01, 0x0a,
-    0x0a, 0x0a, 0x03, 0x04, 0x04, 0x01, 0x12, 0x03, 0x29, 0x08, 0x1e, 0x0a, 0x0b, 0x0a, 0x04, 0x04,
-    0x04, 0x02, 0x00, 0x12, 0x03, 0x2a, 0x02, 0x1a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00,
-    0x04, 0x12, 0x03, 0x2a, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x05, 0x12,
-    0x03, 0x2a, 0x0b, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x01, 0x12, 0x03, 0x2a,
-    0x12, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x03, 0x12, 0x03, 0x2a, 0x18, 0x19,
-    0x0a, 0x09, 0x0a, 0x02, 0x04, 0x05, 0x12, 0x03, 0x2d, 0x00, 0x22, 0x0a, 0x0a, 0x0a, 0x03, 0x04,
-    0x05, 0x01, 0x12, 0x03, 0x2d, 0x08, 0x1f, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x06, 0x12, 0x04, 0x2f,
-    0x00, 0x32, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x06, 0x01, 0x12, 0x03, 0x2f, 0x08, 0x27, 0x0a,
-    0x23, 0x0a, 0x04, 0x04, 0x06, 0x02, 0x00, 0x12, 0x03, 0x31, 0x02, 0x20, 0x1a, 0x16, 0x20, 0x6e,
-    0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x20, 0x3d, 0x3e, 0x20, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d,
-    0x65, 0x6e, 0x74, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x06, 0x12, 0x03, 0x31,
-    0x02, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x01, 0x12, 0x03, 0x31, 0x15, 0x1b,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x03, 0x12, 0x03, 0x31, 0x1e, 0x1f, 0x0a, 0x09,
-    0x0a, 0x02, 0x04, 0x07, 0x12, 0x03, 0x34, 0x00, 0x2b, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x07, 0x01,
-    0x12, 0x03, 0x34, 0x08, 0x28, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x08, 0x12, 0x04, 0x36, 0x00, 0x39,
-    0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x08, 0x01, 0x12, 0x03, 0x36, 0x08, 0x2a, 0x0a, 0x23, 0x0a,
-    0x04, 0x04, 0x08, 0x02, 0x00, 0x12, 0x03, 0x38, 0x02, 0x20, 0x1a, 0x16, 0x20, 0x6e, 0x6f, 0x74,
-    0x65, 0x5f, 0x69, 0x64, 0x20, 0x3d, 0x3e, 0x20, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e,
-    0x74, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x06, 0x12, 0x03, 0x38, 0x02, 0x14,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x01, 0x12, 0x03, 0x38, 0x15, 0x1b, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x03, 0x12, 0x03, 0x38, 0x1e, 0x1f, 0x0a, 0x09, 0x0a, 0x02,
-    0x04, 0x09, 0x12, 0x03, 0x3b, 0x00, 0x2e, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x09, 0x01, 0x12, 0x03,
-    0x3b, 0x08, 0x2b, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33, 0x0a, 0xf0, 0x15, 0x0a, 0x1a,
-    0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65,
-    0x61, 0x72, 0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x73, 0x65, 0x61, 0x72,
-    0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x1a, 0x19, 0x73, 0x65, 0x61, 0x72, 0x63,
-    0x68, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2e, 0x70,
-    0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x65, 0x78, 0x74, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-    0x73, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x55,
-    0x0a, 0x0a, 0x4e, 0x6f, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x04,
-    0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x61,
-    0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x46,
-    0x69, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
-    0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-    0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x55, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4e,
-    0x6f, 0x74, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-    0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65,
-    0x78, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-    0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-    0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5c, 0x0a, 0x16,
-    0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65,
-    0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
-    0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61,
-    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x05, 0x69,
-    0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20,
-    0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x98, 0x01, 0x0a, 0x12, 0x53,
-    0x65, 0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-    0x74, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01,
-    0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x33, 0x0a, 0x07, 0x66,
-    0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73,
-    0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74,
-    0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
-    0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03,
-    0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-    0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-    0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x80, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-    0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a,
-    0x08, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-    0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74,
-    0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65,
-    0x78, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-    0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x19, 0x0a,
-    0x08, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
-    0x07, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x73, 0x2a, 0x40, 0x0a, 0x0e, 0x4e, 0x6f, 0x74, 0x65,
-    0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x4f,
-    0x52, 0x54, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54,
-    0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x54, 0x45,
-    0x5f, 0x50, 0x55, 0x42, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x02, 0x32, 0xcc, 0x01, 0x0a, 0x0d, 0x53,
-    0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5d, 0x0a, 0x0e,
-    0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x67, 0x73, 0x12, 0x24,
-    0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-    0x65, 0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71,
-    0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70,
-    0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f, 0x74, 0x65, 0x54,
-    0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0b, 0x53,
-    0x65, 0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x73, 0x65, 0x61,
-    0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
-    0x68, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
-    0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
-    0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-    0x65, 0x1a, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x42, 0xb0, 0x01, 0x0a, 0x11, 0x63, 0x6f,
-    0x6d, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x42,
-    0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x38,
-    0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x79, 0x61, 0x6e, 0x72,
-    0x65, 0x61, 0x64, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x77, 0x68, 0x69, 0x6d, 0x65, 0x72, 0x2f,
-    0x69, 0x64, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63,
-    0x68, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x41, 0x58, 0xaa, 0x02,
-    0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x41, 0x70, 0x69, 0x2e, 0x56, 0x31, 0xca, 0x02,
-    0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0xe2, 0x02,
-    0x19, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0x5c, 0x47,
-    0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x53, 0x65, 0x61,
-    0x72, 0x63, 0x68, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a, 0x3a, 0x56, 0x31, 0x4a, 0x97, 0x0d, 0x0a,
-    0x06, 0x12, 0x04, 0x00, 0x00, 0x39, 0x01, 0x0a, 0x08, 0x0a, 0x01, 0x0c, 0x12, 0x03, 0x00, 0x00,
-    0x12, 0x0a, 0x08, 0x0a, 0x01, 0x02, 0x12, 0x03, 0x02, 0x00, 0x16, 0x0a, 0x09, 0x0a, 0x02, 0x03,
-    0x00, 0x12, 0x03, 0x04, 0x00, 0x23, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x01, 0x12, 0x03, 0x05, 0x00,
-    0x22, 0x0a, 0x08, 0x0a, 0x01, 0x08, 0x12, 0x03, 0x07, 0x00, 0x4f, 0x0a, 0x09, 0x0a, 0x02, 0x08,
-    0x0b, 0x12, 0x03, 0x07, 0x00, 0x4f, 0x0a, 0x1a, 0x0a, 0x02, 0x06, 0x00, 0x12, 0x04, 0x0a, 0x00,
-    0x12, 0x01, 0x1a, 0x0e, 0x20, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a,
-    0xa1, 0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x06, 0x00, 0x01, 0x12, 0x03, 0x0a, 0x08, 0x15, 0x0a, 0x0a,
-    0x0a, 0x03, 0x06, 0x00, 0x03, 0x12, 0x03, 0x0b, 0x02, 0x3e, 0x0a, 0x0e, 0x0a, 0x07, 0x06, 0x00,
-    0x03, 0xd1, 0x86, 0x03, 0x01, 0x12, 0x03, 0x0b, 0x02, 0x3e, 0x0a, 0x21, 0x0a, 0x04, 0x06, 0x00,
-    0x02, 0x00, 0x12, 0x03, 0x0e, 0x02, 0x4d, 0x1a, 0x14, 0x20, 0xe6, 0x90, 0x9c, 0xe7, 0xb4, 0xa2,
-    0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe6, 0xa0, 0x87, 0xe7, 0xad, 0xbe, 0x0a, 0x0a, 0x0c, 0x0a,
-    0x05, 0x06, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x0e, 0x06, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x06,
-    0x00, 0x02, 0x00, 0x02, 0x12, 0x03, 0x0e, 0x15, 0x2a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02,
-    0x00, 0x03, 0x12, 0x03, 0x0e, 0x35, 0x4b, 0x0a, 0x27, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x01, 0x12,
-    0x03, 0x11, 0x02, 0x44, 0x1a, 0x1a, 0x20, 0xe6, 0x8c, 0x89, 0xe7, 0x85, 0xa7, 0xe6, 0x9d, 0xa1,
-    0xe4, 0xbb, 0xb6, 0xe6, 0x90, 0x9c, 0xe7, 0xb4, 0xa2, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x0a,
-    0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x11, 0x06, 0x11, 0x0a, 0x0c,
-    0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x02, 0x12, 0x03, 0x11, 0x12, 0x24, 0x0a, 0x0c, 0x0a, 0x05,
-    0x06, 0x00, 0x02, 0x01, 0x03, 0x12, 0x03, 0x11, 0x2f, 0x42, 0x0a, 0x0a, 0x0a, 0x02, 0x05, 0x00,
-    0x12, 0x04, 0x14, 0x00, 0x18, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x05, 0x00, 0x01, 0x12, 0x03, 0x14,
-    0x05, 0x13, 0x0a, 0x1b, 0x0a, 0x04, 0x05, 0x00, 0x02, 0x00, 0x12, 0x03, 0x15, 0x02, 0x13, 0x22,
-    0x0e, 0x20, 0xe6, 0x8e, 0x92, 0xe5, 0xba, 0x8f, 0xe8, 0xa7, 0x84, 0xe5, 0x88, 0x99, 0x0a, 0x0a,
-    0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x15, 0x02, 0x0b, 0x0a, 0x0c, 0x0a,
-    0x05, 0x05, 0x00, 0x02, 0x00, 0x02, 0x12, 0x03, 0x15, 0x11, 0x12, 0x0a, 0x42, 0x0a, 0x04, 0x05,
-    0x00, 0x02, 0x01, 0x12, 0x03, 0x16, 0x02, 0x13, 0x22, 0x35, 0x20, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e,
-    0x8b, 0xe8, 0xbf, 0x87, 0xe6, 0xbb, 0xa4, 0xe8, 0xa7, 0x84, 0xe5, 0x88, 0x99, 0x3a, 0x20, 0xe7,
-    0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xef, 0xbc, 0x9a, 0xe5, 0x9b,
-    0xbe, 0xe6, 0x96, 0x87, 0x2f, 0xe8, 0xa7, 0x86, 0xe9, 0xa2, 0x91, 0xe7, 0xad, 0x89, 0x0a, 0x0a,
-    0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x16, 0x02, 0x0b, 0x0a, 0x0c, 0x0a,
-    0x05, 0x05, 0x00, 0x02, 0x01, 0x02, 0x12, 0x03, 0x16, 0x11, 0x12, 0x0a, 0x3b, 0x0a, 0x04, 0x05,
-    0x00, 0x02, 0x02, 0x12, 0x03, 0x17, 0x02, 0x13, 0x22, 0x2e, 0x20, 0xe5, 0x8f, 0x91, 0xe5, 0xb8,
-    0x83, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe8, 0xbf, 0x87, 0xe6, 0xbb, 0xa4, 0xe8, 0xa7, 0x84,
-    0xe5, 0x88, 0x99, 0x3a, 0x20, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe5, 0x8f, 0x91, 0xe5, 0xb8,
-    0x83, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x02,
-    0x01, 0x12, 0x03, 0x17, 0x02, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x02, 0x02, 0x12,
-    0x03, 0x17, 0x11, 0x12, 0x0a, 0x23, 0x0a, 0x02, 0x04, 0x00, 0x12, 0x04, 0x1b, 0x00, 0x1f, 0x01,
-    0x1a, 0x17, 0x20, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe7, 0xad, 0x9b, 0xe9, 0x80, 0x89, 0xe8,
-    0xbf, 0x87, 0xe6, 0xbb, 0xa4, 0xe5, 0x99, 0xa8, 0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x00, 0x01,
-    0x12, 0x03, 0x1b, 0x08, 0x12, 0x0a, 0x1e, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x00, 0x12, 0x03, 0x1c,
-    0x02, 0x1a, 0x22, 0x11, 0x20, 0xe8, 0xbf, 0x87, 0xe6, 0xbb, 0xa4, 0xe5, 0x99, 0xa8, 0xe7, 0xb1,
-    0xbb, 0xe5, 0x9e, 0x8b, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x06, 0x12, 0x03,
-    0x1c, 0x02, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x1c, 0x11,
-    0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x1c, 0x18, 0x19, 0x0a,
-    0x50, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x01, 0x12, 0x03, 0x1e, 0x02, 0x13, 0x1a, 0x2a, 0x20, 0xe4,
-    0xb8, 0x8d, 0xe5, 0x90, 0x8c, 0xe7, 0x9a, 0x84, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x74, 0x79,
-    0x70, 0x65, 0xe6, 0x94, 0xaf, 0xe6, 0x8c, 0x81, 0xe4, 0xb8, 0x8d, 0xe5, 0x90, 0x8c, 0xe7, 0x9a,
-    0x84, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x0a, 0x22, 0x17, 0x20, 0xe8, 0xbf, 0x87, 0xe6, 0xbb,
-    0xa4, 0xe5, 0x99, 0xa8, 0xe7, 0x9a, 0x84, 0xe6, 0x9d, 0xa1, 0xe4, 0xbb, 0xb6, 0xe5, 0x80, 0xbc,
-    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x05, 0x12, 0x03, 0x1e, 0x02, 0x08, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x1e, 0x09, 0x0e, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x00, 0x02, 0x01, 0x03, 0x12, 0x03, 0x1e, 0x11, 0x12, 0x0a, 0x20, 0x0a, 0x02, 0x04,
-    0x01, 0x12, 0x04, 0x22, 0x00, 0x26, 0x01, 0x1a, 0x14, 0x20, 0xe6, 0x90, 0x9c, 0xe7, 0xb4, 0xa2,
-    0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe6, 0xa0, 0x87, 0xe7, 0xad, 0xbe, 0x0a, 0x0a, 0x0a, 0x0a,
-    0x03, 0x04, 0x01, 0x01, 0x12, 0x03, 0x22, 0x08, 0x1d, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02,
-    0x00, 0x12, 0x03, 0x23, 0x02, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x05, 0x12,
-    0x03, 0x23, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x01, 0x12, 0x03, 0x23,
-    0x09, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x00, 0x03, 0x12, 0x03, 0x23, 0x11, 0x12,
-    0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x01, 0x12, 0x03, 0x24, 0x02, 0x13, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x01, 0x02, 0x01, 0x05, 0x12, 0x03, 0x24, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
-    0x01, 0x02, 0x01, 0x01, 0x12, 0x03, 0x24, 0x09, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02,
-    0x01, 0x03, 0x12, 0x03, 0x24, 0x11, 0x12, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x02, 0x12,
-    0x03, 0x25, 0x02, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x02, 0x05, 0x12, 0x03, 0x25,
-    0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x02, 0x01, 0x12, 0x03, 0x25, 0x09, 0x0e,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x02, 0x03, 0x12, 0x03, 0x25, 0x11, 0x12, 0x0a, 0x0a,
-    0x0a, 0x02, 0x04, 0x02, 0x12, 0x04, 0x28, 0x00, 0x2b, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x02,
-    0x01, 0x12, 0x03, 0x28, 0x08, 0x1e, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x00, 0x12, 0x03,
-    0x29, 0x02, 0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x04, 0x12, 0x03, 0x29, 0x02,
-    0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x06, 0x12, 0x03, 0x29, 0x0b, 0x12, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x01, 0x12, 0x03, 0x29, 0x13, 0x18, 0x0a, 0x0c, 0x0a,
-    0x05, 0x04, 0x02, 0x02, 0x00, 0x03, 0x12, 0x03, 0x29, 0x1b, 0x1c, 0x0a, 0x0b, 0x0a, 0x04, 0x04,
-    0x02, 0x02, 0x01, 0x12, 0x03, 0x2a, 0x02, 0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01,
-    0x05, 0x12, 0x03, 0x2a, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01, 0x01, 0x12,
-    0x03, 0x2a, 0x13, 0x18, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01, 0x03, 0x12, 0x03, 0x2a,
-    0x1b, 0x1c, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x03, 0x12, 0x04, 0x2d, 0x00, 0x32, 0x01, 0x0a, 0x0a,
-    0x0a, 0x03, 0x04, 0x03, 0x01, 0x12, 0x03, 0x2d, 0x08, 0x1a, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x03,
-    0x02, 0x00, 0x12, 0x03, 0x2e, 0x02, 0x25, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x05,
-    0x12, 0x03, 0x2e, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x01, 0x12, 0x03,
-    0x2e, 0x16, 0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x03, 0x12, 0x03, 0x2e, 0x23,
-    0x24, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x03, 0x02, 0x01, 0x12, 0x03, 0x2f, 0x02, 0x25, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x03, 0x02, 0x01, 0x04, 0x12, 0x03, 0x2f, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x03, 0x02, 0x01, 0x06, 0x12, 0x03, 0x2f, 0x0b, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03,
-    0x02, 0x01, 0x01, 0x12, 0x03, 0x2f, 0x16, 0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01,
-    0x03, 0x12, 0x03, 0x2f, 0x23, 0x24, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x03, 0x02, 0x02, 0x12, 0x03,
-    0x30, 0x02, 0x25, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x02, 0x05, 0x12, 0x03, 0x30, 0x02,
-    0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x02, 0x01, 0x12, 0x03, 0x30, 0x16, 0x20, 0x0a,
-    0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x02, 0x03, 0x12, 0x03, 0x30, 0x23, 0x24, 0x0a, 0x0b, 0x0a,
-    0x04, 0x04, 0x03, 0x02, 0x03, 0x12, 0x03, 0x31, 0x02, 0x25, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03,
-    0x02, 0x03, 0x05, 0x12, 0x03, 0x31, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x03,
-    0x01, 0x12, 0x03, 0x31, 0x16, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x03, 0x03, 0x12,
-    0x03, 0x31, 0x23, 0x24, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x04, 0x12, 0x04, 0x34, 0x00, 0x39, 0x01,
-    0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x04, 0x01, 0x12, 0x03, 0x34, 0x08, 0x1b, 0x0a, 0x0b, 0x0a, 0x04,
-    0x04, 0x04, 0x02, 0x00, 0x12, 0x03, 0x35, 0x02, 0x21, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02,
-    0x00, 0x05, 0x12, 0x03, 0x35, 0x02, 0x06, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x01,
-    0x12, 0x03, 0x35, 0x12, 0x1a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x03, 0x12, 0x03,
-    0x35, 0x1f, 0x20, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x01, 0x12, 0x03, 0x36, 0x02, 0x21,
-    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x01, 0x05, 0x12, 0x03, 0x36, 0x02, 0x08, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x04, 0x02, 0x01, 0x01, 0x12, 0x03, 0x36, 0x12, 0x1c, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x04, 0x02, 0x01, 0x03, 0x12, 0x03, 0x36, 0x1f, 0x20, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x04,
-    0x02, 0x02, 0x12, 0x03, 0x37, 0x02, 0x21, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x02, 0x05,
-    0x12, 0x03, 0x37, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x02, 0x01, 0x12, 0x03,
-    0x37, 0x12, 0x17, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x02, 0x03, 0x12, 0x03, 0x37, 0x1f,
-    0x20, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x03, 0x12, 0x03, 0x38, 0x02, 0x21, 0x0a, 0x0c,
-    0x0a, 0x05, 0x04, 0x04, 0x02, 0x03, 0x04, 0x12, 0x03, 0x38, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05,
-    0x04, 0x04, 0x02, 0x03, 0x05, 0x12, 0x03, 0x38, 0x0b, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04,
-    0x02, 0x03, 0x01, 0x12, 0x03, 0x38, 0x12, 0x1a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x03,
-    0x03, 0x12, 0x03, 0x38, 0x1f, 0x20, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+    0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+    0x74, 0x12, 0x55, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+    0x0b, 0x32, 0x3d, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+    0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74,
+    0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
+    0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+    0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x43, 0x6f, 0x75, 0x6e,
+    0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+    0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+    0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+    0x02, 0x38, 0x01, 0x22, 0x25, 0x0a, 0x23, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61,
+    0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75,
+    0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x0a, 0x13, 0x42, 0x61,
+    0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+    0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03,
+    0x69, 0x64, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74,
+    0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x05,
+    0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x65,
+    0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63,
+    0x68, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+    0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x6e, 0x6f, 0x74,
+    0x65, 0x73, 0x1a, 0x4d, 0x0a, 0x0a, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+    0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+    0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+    0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+    0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+    0x01, 0x22, 0x42, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x73,
+    0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+    0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12,
+    0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+    0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x63, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74,
+    0x65, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03,
+    0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x1f,
+    0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20,
+    0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12,
+    0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+    0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x32, 0xe9, 0x05, 0x0a, 0x0f, 0x44,
+    0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x60,
+    0x0a, 0x0f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x54, 0x61,
+    0x67, 0x12, 0x25, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+    0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x54, 0x61,
+    0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
+    0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x64,
+    0x64, 0x4e, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+    0x12, 0x57, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x74, 0x65,
+    0x12, 0x22, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+    0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71,
+    0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70,
+    0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x74,
+    0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0f, 0x42, 0x61, 0x74,
+    0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x25, 0x2e, 0x73,
+    0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74,
+    0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+    0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e,
+    0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7b, 0x0a, 0x18, 0x42,
+    0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69,
+    0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64,
+    0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+    0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64,
+    0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+    0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x84, 0x01, 0x0a, 0x1b, 0x42, 0x61, 0x74,
+    0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+    0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
+    0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70,
+    0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43,
+    0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x73, 0x65,
+    0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63,
+    0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+    0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+    0x57, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x12,
+    0x22, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+    0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+    0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69,
+    0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65,
+    0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
+    0x4e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x73, 0x12, 0x21, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+    0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x65,
+    0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x65, 0x61,
+    0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e,
+    0x6f, 0x74, 0x65, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x06,
+    0x8a, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x42, 0xb2, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x73,
+    0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x42, 0x0d, 0x44, 0x6f,
+    0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x38, 0x67,
+    0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x79, 0x61, 0x6e, 0x72, 0x65,
+    0x61, 0x64, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x77, 0x68, 0x69, 0x6d, 0x65, 0x72, 0x2f, 0x69,
+    0x64, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+    0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x41, 0x58, 0xaa, 0x02, 0x0d,
+    0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x41, 0x70, 0x69, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d,
+    0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19,
+    0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
+    0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x53, 0x65, 0x61, 0x72,
+    0x63, 0x68, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a, 0x3a, 0x56, 0x31, 0x4a, 0xed, 0x0f, 0x0a, 0x06,
+    0x12, 0x04, 0x00, 0x00, 0x55, 0x01, 0x0a, 0x08, 0x0a, 0x01, 0x0c, 0x12, 0x03, 0x00, 0x00, 0x12,
+    0x0a, 0x08, 0x0a, 0x01, 0x02, 0x12, 0x03, 0x02, 0x00, 0x16, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x00,
+    0x12, 0x03, 0x04, 0x00, 0x23, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x01, 0x12, 0x03, 0x05, 0x00, 0x22,
+    0x0a, 0x08, 0x0a, 0x01, 0x08, 0x12, 0x03, 0x07, 0x00, 0x4f, 0x0a, 0x09, 0x0a, 0x02, 0x08, 0x0b,
+    0x12, 0x03, 0x07, 0x00, 0x4f, 0x0a, 0x26, 0x0a, 0x02, 0x06, 0x00, 0x12, 0x04, 0x0a, 0x00, 0x21,
+    0x01, 0x1a, 0x1a, 0x20, 0xe6, 0x90, 0x9c, 0xe7, 0xb4, 0xa2, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae,
+    0xe5, 0x86, 0x99, 0xe5, 0x85, 0xa5, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x0a, 0x0a, 0x0a, 0x0a,
+    0x03, 0x06, 0x00, 0x01, 0x12, 0x03, 0x0a, 0x08, 0x17, 0x0a, 0x0a, 0x0a, 0x03, 0x06, 0x00, 0x03,
+    0x12, 0x03, 0x0b, 0x02, 0x3e, 0x0a, 0x0e, 0x0a, 0x07, 0x06, 0x00, 0x03, 0xd1, 0x86, 0x03, 0x01,
+    0x12, 0x03, 0x0b, 0x02, 0x3e, 0x0a, 0x27, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x00, 0x12, 0x03, 0x0e,
+    0x02, 0x50, 0x1a, 0x1a, 0x20, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe5, 0x86, 0x99, 0xe5, 0x85,
+    0xa5, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe6, 0xa0, 0x87, 0xe7, 0xad, 0xbe, 0x0a, 0x0a, 0x0c,
+    0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x0e, 0x06, 0x15, 0x0a, 0x0c, 0x0a, 0x05,
+    0x06, 0x00, 0x02, 0x00, 0x02, 0x12, 0x03, 0x0e, 0x16, 0x2c, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00,
+    0x02, 0x00, 0x03, 0x12, 0x03, 0x0e, 0x37, 0x4e, 0x0a, 0x21, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x01,
+    0x12, 0x03, 0x11, 0x02, 0x47, 0x1a, 0x14, 0x20, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe5, 0x86,
+    0x99, 0xe5, 0x85, 0xa5, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06,
+    0x00, 0x02, 0x01, 0x01, 0x12, 0x03, 0x11, 0x06, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02,
+    0x01, 0x02, 0x12, 0x03, 0x11, 0x13, 0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x03,
+    0x12, 0x03, 0x11, 0x31, 0x45, 0x0a, 0x21, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x02, 0x12, 0x03, 0x14,
+    0x02, 0x50, 0x1a, 0x14, 0x20, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe5, 0x88, 0xa0, 0xe9, 0x99,
+    0xa4, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x02,
+    0x01, 0x12, 0x03, 0x14, 0x06, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x02, 0x12,
+    0x03, 0x14, 0x16, 0x2c, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x02, 0x03, 0x12, 0x03, 0x14,
+    0x37, 0x4e, 0x0a, 0x27, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x03, 0x12, 0x03, 0x17, 0x02, 0x6b, 0x1a,
+    0x1a, 0x20, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe7, 0x82,
+    0xb9, 0xe8, 0xb5, 0x9e, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06,
+    0x00, 0x02, 0x03, 0x01, 0x12, 0x03, 0x17, 0x06, 0x1e, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02,
+    0x03, 0x02, 0x12, 0x03, 0x17, 0x1f, 0x3e, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x03, 0x03,
+    0x12, 0x03, 0x17, 0x49, 0x69, 0x0a, 0x27, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x04, 0x12, 0x03, 0x1a,
+    0x02, 0x74, 0x1a, 0x1a, 0x20, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe7, 0xac, 0x94, 0xe8, 0xae,
+    0xb0, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x0a, 0x0a, 0x0c,
+    0x0a, 0x05, 0x06, 0x00, 0x02, 0x04, 0x01, 0x12, 0x03, 0x1a, 0x06, 0x21, 0x0a, 0x0c, 0x0a, 0x05,
+    0x06, 0x00, 0x02, 0x04, 0x02, 0x12, 0x03, 0x1a, 0x22, 0x44, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00,
+    0x02, 0x04, 0x03, 0x12, 0x03, 0x1a, 0x4f, 0x72, 0x0a, 0x40, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x05,
+    0x12, 0x03, 0x1d, 0x02, 0x47, 0x1a, 0x33, 0x20, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe8, 0x8e,
+    0xb7, 0xe5, 0x8f, 0x96, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe6, 0x96, 0x87, 0xe6, 0xa1, 0xa3,
+    0x20, 0xe7, 0x94, 0xa8, 0xe4, 0xba, 0x8e, 0xe5, 0x92, 0x8c, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae,
+    0xe6, 0xba, 0x90, 0xe6, 0xa0, 0xb8, 0xe5, 0xaf, 0xb9, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00,
+    0x02, 0x05, 0x01, 0x12, 0x03, 0x1d, 0x06, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x05,
+    0x02, 0x12, 0x03, 0x1d, 0x13, 0x26, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x05, 0x03, 0x12,
+    0x03, 0x1d, 0x31, 0x45, 0x0a, 0x71, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x06, 0x12, 0x03, 0x20, 0x02,
+    0x44, 0x1a, 0x64, 0x20, 0xe6, 0x8c, 0x89, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x69, 0x64, 0xe9,
+    0xa1, 0xba, 0xe5, 0xba, 0x8f, 0xe5, 0x88, 0x86, 0xe9, 0xa1, 0xb5, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f,
+    0x96, 0xe5, 0x85, 0xa8, 0xe9, 0x83, 0xa8, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe6, 0x96, 0x87,
+    0xe6, 0xa1, 0xa3, 0x69, 0x64, 0x20, 0xe7, 0x94, 0xa8, 0xe4, 0xba, 0x8e, 0xe6, 0xb8, 0x85, 0xe7,
+    0x90, 0x86, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0xe6, 0xba, 0x90, 0xe4, 0xb8, 0xad, 0xe5, 0xb7,
+    0xb2, 0xe7, 0xbb, 0x8f, 0xe4, 0xb8, 0x8d, 0xe5, 0xad, 0x98, 0xe5, 0x9c, 0xa8, 0xe7, 0x9a, 0x84,
+    0xe6, 0x96, 0x87, 0xe6, 0xa1, 0xa3, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x06, 0x01,
+    0x12, 0x03, 0x20, 0x06, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x06, 0x02, 0x12, 0x03,
+    0x20, 0x12, 0x24, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x06, 0x03, 0x12, 0x03, 0x20, 0x2f,
+    0x42, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x00, 0x12, 0x04, 0x23, 0x00, 0x25, 0x01, 0x0a, 0x0a, 0x0a,
+    0x03, 0x04, 0x00, 0x01, 0x12, 0x03, 0x23, 0x08, 0x1e, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x00, 0x02,
+    0x00, 0x12, 0x03, 0x24, 0x02, 0x21, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x04, 0x12,
+    0x03, 0x24, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x06, 0x12, 0x03, 0x24,
+    0x0b, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x24, 0x13, 0x1c,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x24, 0x1f, 0x20, 0x0a, 0x09,
+    0x0a, 0x02, 0x04, 0x01, 0x12, 0x03, 0x27, 0x00, 0x22, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x01, 0x01,
+    0x12, 0x03, 0x27, 0x08, 0x1f, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x02, 0x12, 0x04, 0x29, 0x00, 0x2b,
+    0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x02, 0x01, 0x12, 0x03, 0x29, 0x08, 0x1b, 0x0a, 0x0b, 0x0a,
+    0x04, 0x04, 0x02, 0x02, 0x00, 0x12, 0x03, 0x2a, 0x02, 0x1a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02,
+    0x02, 0x00, 0x04, 0x12, 0x03, 0x2a, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00,
+    0x06, 0x12, 0x03, 0x2a, 0x0b, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x01, 0x12,
+    0x03, 0x2a, 0x10, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x03, 0x12, 0x03, 0x2a,
+    0x18, 0x19, 0x0a, 0x09, 0x0a, 0x02, 0x04, 0x03, 0x12, 0x03, 0x2d, 0x00, 0x1f, 0x0a, 0x0a, 0x0a,
+    0x03, 0x04, 0x03, 0x01, 0x12, 0x03, 0x2d, 0x08, 0x1c, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x04, 0x12,
+    0x04, 0x2f, 0x00, 0x31, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x04, 0x01, 0x12, 0x03, 0x2f, 0x08,
+    0x1e, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x00, 0x12, 0x03, 0x30, 0x02, 0x1a, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x04, 0x12, 0x03, 0x30, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x04, 0x02, 0x00, 0x05, 0x12, 0x03, 0x30, 0x0b, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04,
+    0x02, 0x00, 0x01, 0x12, 0x03, 0x30, 0x12, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00,
+    0x03, 0x12, 0x03, 0x30, 0x18, 0x19, 0x0a, 0x09, 0x0a, 0x02, 0x04, 0x05, 0x12, 0x03, 0x33, 0x00,
+    0x22, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x05, 0x01, 0x12, 0x03, 0x33, 0x08, 0x1f, 0x0a, 0x0a, 0x0a,
+    0x02, 0x04, 0x06, 0x12, 0x04, 0x35, 0x00, 0x38, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x06, 0x01,
+    0x12, 0x03, 0x35, 0x08, 0x27, 0x0a, 0x23, 0x0a, 0x04, 0x04, 0x06, 0x02, 0x00, 0x12, 0x03, 0x37,
+    0x02, 0x20, 0x1a, 0x16, 0x20, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x20, 0x3d, 0x3e, 0x20,
+    0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06,
+    0x02, 0x00, 0x06, 0x12, 0x03, 0x37, 0x02, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00,
+    0x01, 0x12, 0x03, 0x37, 0x15, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x06, 0x02, 0x00, 0x03, 0x12,
+    0x03, 0x37, 0x1e, 0x1f, 0x0a, 0x09, 0x0a, 0x02, 0x04, 0x07, 0x12, 0x03, 0x3a, 0x00, 0x2b, 0x0a,
+    0x0a, 0x0a, 0x03, 0x04, 0x07, 0x01, 0x12, 0x03, 0x3a, 0x08, 0x28, 0x0a, 0x0a, 0x0a, 0x02, 0x04,
+    0x08, 0x12, 0x04, 0x3c, 0x00, 0x3f, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x08, 0x01, 0x12, 0x03,
+    0x3c, 0x08, 0x2a, 0x0a, 0x23, 0x0a, 0x04, 0x04, 0x08, 0x02, 0x00, 0x12, 0x03, 0x3e, 0x02, 0x20,
+    0x1a, 0x16, 0x20, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x20, 0x3d, 0x3e, 0x20, 0x69, 0x6e,
+    0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00,
+    0x06, 0x12, 0x03, 0x3e, 0x02, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x01, 0x12,
+    0x03, 0x3e, 0x15, 0x1b, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x08, 0x02, 0x00, 0x03, 0x12, 0x03, 0x3e,
+    0x1e, 0x1f, 0x0a, 0x09, 0x0a, 0x02, 0x04, 0x09, 0x12, 0x03, 0x41, 0x00, 0x2e, 0x0a, 0x0a, 0x0a,
+    0x03, 0x04, 0x09, 0x01, 0x12, 0x03, 0x41, 0x08, 0x2b, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0a, 0x12,
+    0x04, 0x43, 0x00, 0x45, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0a, 0x01, 0x12, 0x03, 0x43, 0x08,
+    0x1b, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0a, 0x02, 0x00, 0x12, 0x03, 0x44, 0x02, 0x1a, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x0a, 0x02, 0x00, 0x04, 0x12, 0x03, 0x44, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x0a, 0x02, 0x00, 0x05, 0x12, 0x03, 0x44, 0x0b, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a,
+    0x02, 0x00, 0x01, 0x12, 0x03, 0x44, 0x12, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0a, 0x02, 0x00,
+    0x03, 0x12, 0x03, 0x44, 0x18, 0x19, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0b, 0x12, 0x04, 0x47, 0x00,
+    0x4a, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0b, 0x01, 0x12, 0x03, 0x47, 0x08, 0x1c, 0x0a, 0x3a,
+    0x0a, 0x04, 0x04, 0x0b, 0x02, 0x00, 0x12, 0x03, 0x49, 0x02, 0x1e, 0x1a, 0x2d, 0x20, 0x6e, 0x6f,
+    0x74, 0x65, 0x5f, 0x69, 0x64, 0x20, 0x3d, 0x3e, 0x20, 0x6e, 0x6f, 0x74, 0x65, 0x20, 0xe4, 0xb8,
+    0x8d, 0xe5, 0xad, 0x98, 0xe5, 0x9c, 0xa8, 0xe7, 0x9a, 0x84, 0xe6, 0x96, 0x87, 0xe6, 0xa1, 0xa3,
+    0xe4, 0xb8, 0x8d, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0b,
+    0x02, 0x00, 0x06, 0x12, 0x03, 0x49, 0x02, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x00,
+    0x01, 0x12, 0x03, 0x49, 0x14, 0x19, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0b, 0x02, 0x00, 0x03, 0x12,
+    0x03, 0x49, 0x1c, 0x1d, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0c, 0x12, 0x04, 0x4b, 0x00, 0x4f, 0x01,
+    0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x0c, 0x01, 0x12, 0x03, 0x4b, 0x08, 0x1a, 0x0a, 0x41, 0x0a, 0x04,
+    0x04, 0x0c, 0x02, 0x00, 0x12, 0x03, 0x4d, 0x02, 0x14, 0x1a, 0x34, 0x20, 0xe4, 0xb8, 0x8a, 0xe4,
+    0xb8, 0x80, 0xe9, 0xa1, 0xb5, 0xe6, 0x9c, 0x80, 0xe5, 0x90, 0x8e, 0xe4, 0xb8, 0x80, 0xe4, 0xb8,
+    0xaa, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x20, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe6,
+    0x97, 0xb6, 0xe4, 0xbb, 0x8e, 0xe5, 0xa4, 0xb4, 0xe5, 0xbc, 0x80, 0xe5, 0xa7, 0x8b, 0x0a, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x00, 0x05, 0x12, 0x03, 0x4d, 0x02, 0x08, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x0c, 0x02, 0x00, 0x01, 0x12, 0x03, 0x4d, 0x09, 0x0f, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x0c, 0x02, 0x00, 0x03, 0x12, 0x03, 0x4d, 0x12, 0x13, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0c, 0x02,
+    0x01, 0x12, 0x03, 0x4e, 0x02, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x01, 0x05, 0x12,
+    0x03, 0x4e, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x01, 0x01, 0x12, 0x03, 0x4e,
+    0x09, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0c, 0x02, 0x01, 0x03, 0x12, 0x03, 0x4e, 0x12, 0x13,
+    0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x0d, 0x12, 0x04, 0x51, 0x00, 0x55, 0x01, 0x0a, 0x0a, 0x0a, 0x03,
+    0x04, 0x0d, 0x01, 0x12, 0x03, 0x51, 0x08, 0x1b, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0d, 0x02, 0x00,
+    0x12, 0x03, 0x52, 0x02, 0x22, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0d, 0x02, 0x00, 0x04, 0x12, 0x03,
+    0x52, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0d, 0x02, 0x00, 0x05, 0x12, 0x03, 0x52, 0x0b,
+    0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0d, 0x02, 0x00, 0x01, 0x12, 0x03, 0x52, 0x12, 0x15, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x0d, 0x02, 0x00, 0x03, 0x12, 0x03, 0x52, 0x20, 0x21, 0x0a, 0x0b, 0x0a,
+    0x04, 0x04, 0x0d, 0x02, 0x01, 0x12, 0x03, 0x53, 0x02, 0x22, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0d,
+    0x02, 0x01, 0x05, 0x12, 0x03, 0x53, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0d, 0x02, 0x01,
+    0x01, 0x12, 0x03, 0x53, 0x12, 0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0d, 0x02, 0x01, 0x03, 0x12,
+    0x03, 0x53, 0x20, 0x21, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x0d, 0x02, 0x02, 0x12, 0x03, 0x54, 0x02,
+    0x22, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x0d, 0x02, 0x02, 0x05, 0x12, 0x03, 0x54, 0x02, 0x06, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x0d, 0x02, 0x02, 0x01, 0x12, 0x03, 0x54, 0x12, 0x1a, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x0d, 0x02, 0x02, 0x03, 0x12, 0x03, 0x54, 0x20, 0x21, 0x62, 0x06, 0x70, 0x72, 0x6f,
+    0x74, 0x6f, 0x33, 0x0a, 0xf2, 0x16, 0x0a, 0x1a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2f, 0x61,
+    0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f,
+    0x74, 0x6f, 0x12, 0x0d, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+    0x31, 0x1a, 0x19, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+    0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x65, 0x78,
+    0x74, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+    0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x55, 0x0a, 0x0a, 0x4e, 0x6f, 0x74, 0x65, 0x46, 0x69,
+    0x6c, 0x74, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+    0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+    0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70,
+    0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+    0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x55, 0x0a,
+    0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52,
+    0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01,
+    0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+    0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14,
+    0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63,
+    0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5c, 0x0a, 0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f,
+    0x74, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c,
+    0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+    0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f,
+    0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05,
+    0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74,
+    0x61, 0x6c, 0x22, 0xb7, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f, 0x74,
+    0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79,
+    0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77,
+    0x6f, 0x72, 0x64, 0x12, 0x33, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02,
+    0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70,
+    0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52,
+    0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+    0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+    0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+    0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a,
+    0x0a, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+    0x03, 0x52, 0x09, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x55, 0x69, 0x64, 0x22, 0x80, 0x01, 0x0a,
+    0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+    0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74,
+    0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x12,
+    0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+    0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14,
+    0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74,
+    0x6f, 0x74, 0x61, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x73,
+    0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x73, 0x2a,
+    0x40, 0x0a, 0x0e, 0x4e, 0x6f, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70,
+    0x65, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x10, 0x00,
+    0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x01, 0x12,
+    0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x54, 0x45, 0x5f, 0x50, 0x55, 0x42, 0x54, 0x49, 0x4d, 0x45, 0x10,
+    0x02, 0x32, 0xcc, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76,
+    0x69, 0x63, 0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f, 0x74,
+    0x65, 0x54, 0x61, 0x67, 0x73, 0x12, 0x24, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61,
+    0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f, 0x74, 0x65,
+    0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x65,
+    0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72,
+    0x63, 0x68, 0x4e, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+    0x73, 0x65, 0x12, 0x54, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f, 0x74, 0x65,
+    0x73, 0x12, 0x21, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+    0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71,
+    0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x61, 0x70,
+    0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f, 0x74, 0x65, 0x73,
+    0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x08, 0x01,
+    0x42, 0xb0, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e,
+    0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x72,
+    0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+    0x6d, 0x2f, 0x72, 0x79, 0x61, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f,
+    0x77, 0x68, 0x69, 0x6d, 0x65, 0x72, 0x2f, 0x69, 0x64, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67,
+    0x6f, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0xa2,
+    0x02, 0x03, 0x53, 0x41, 0x58, 0xaa, 0x02, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x41,
+    0x70, 0x69, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5c, 0x41,
+    0x70, 0x69, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5c, 0x41,
+    0x70, 0x69, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+    0x61, 0xea, 0x02, 0x0f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a,
+    0x3a, 0x56, 0x31, 0x4a, 0xfa, 0x0d, 0x0a, 0x06, 0x12, 0x04, 0x00, 0x00, 0x3a, 0x01, 0x0a, 0x08,
+    0x0a, 0x01, 0x0c, 0x12, 0x03, 0x00, 0x00, 0x12, 0x0a, 0x08, 0x0a, 0x01, 0x02, 0x12, 0x03, 0x02,
+    0x00, 0x16, 0x0a, 0x09, 0x0a, 0x02, 0x03, 0x00, 0x12, 0x03, 0x04, 0x00, 0x23, 0x0a, 0x09, 0x0a,
+    0x02, 0x03, 0x01, 0x12, 0x03, 0x05, 0x00, 0x22, 0x0a, 0x08, 0x0a, 0x01, 0x08, 0x12, 0x03, 0x07,
+    0x00, 0x4f, 0x0a, 0x09, 0x0a, 0x02, 0x08, 0x0b, 0x12, 0x03, 0x07, 0x00, 0x4f, 0x0a, 0x1a, 0x0a,
+    0x02, 0x06, 0x00, 0x12, 0x04, 0x0a, 0x00, 0x12, 0x01, 0x1a, 0x0e, 0x20, 0xe6, 0x9f, 0xa5, 0xe8,
+    0xaf, 0xa2, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x06, 0x00, 0x01,
+    0x12, 0x03, 0x0a, 0x08, 0x15, 0x0a, 0x0a, 0x0a, 0x03, 0x06, 0x00, 0x03, 0x12, 0x03, 0x0b, 0x02,
+    0x3e, 0x0a, 0x0e, 0x0a, 0x07, 0x06, 0x00, 0x03, 0xd1, 0x86, 0x03, 0x01, 0x12, 0x03, 0x0b, 0x02,
+    0x3e, 0x0a, 0x21, 0x0a, 0x04, 0x06, 0x00, 0x02, 0x00, 0x12, 0x03, 0x0e, 0x02, 0x4d, 0x1a, 0x14,
+    0x20, 0xe6, 0x90, 0x9c, 0xe7, 0xb4, 0xa2, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe6, 0xa0, 0x87,
+    0xe7, 0xad, 0xbe, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x01, 0x12, 0x03, 0x0e,
+    0x06, 0x14, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x02, 0x12, 0x03, 0x0e, 0x15, 0x2a,
+    0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x00, 0x03, 0x12, 0x03, 0x0e, 0x35, 0x4b, 0x0a, 0x27,
+    0x0a, 0x04, 0x06, 0x00, 0x02, 0x01, 0x12, 0x03, 0x11, 0x02, 0x44, 0x1a, 0x1a, 0x20, 0xe6, 0x8c,
+    0x89, 0xe7, 0x85, 0xa7, 0xe6, 0x9d, 0xa1, 0xe4, 0xbb, 0xb6, 0xe6, 0x90, 0x9c, 0xe7, 0xb4, 0xa2,
+    0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x01,
+    0x12, 0x03, 0x11, 0x06, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x02, 0x12, 0x03,
+    0x11, 0x12, 0x24, 0x0a, 0x0c, 0x0a, 0x05, 0x06, 0x00, 0x02, 0x01, 0x03, 0x12, 0x03, 0x11, 0x2f,
+    0x42, 0x0a, 0x0a, 0x0a, 0x02, 0x05, 0x00, 0x12, 0x04, 0x14, 0x00, 0x18, 0x01, 0x0a, 0x0a, 0x0a,
+    0x03, 0x05, 0x00, 0x01, 0x12, 0x03, 0x14, 0x05, 0x13, 0x0a, 0x1b, 0x0a, 0x04, 0x05, 0x00, 0x02,
+    0x00, 0x12, 0x03, 0x15, 0x02, 0x13, 0x22, 0x0e, 0x20, 0xe6, 0x8e, 0x92, 0xe5, 0xba, 0x8f, 0xe8,
+    0xa7, 0x84, 0xe5, 0x88, 0x99, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x00, 0x01, 0x12,
+    0x03, 0x15, 0x02, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x00, 0x02, 0x12, 0x03, 0x15,
+    0x11, 0x12, 0x0a, 0x42, 0x0a, 0x04, 0x05, 0x00, 0x02, 0x01, 0x12, 0x03, 0x16, 0x02, 0x13, 0x22,
+    0x35, 0x20, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xe8, 0xbf, 0x87, 0xe6, 0xbb, 0xa4, 0xe8, 0xa7,
+    0x84, 0xe5, 0x88, 0x99, 0x3a, 0x20, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe7, 0xb1, 0xbb, 0xe5,
+    0x9e, 0x8b, 0xef, 0xbc, 0x9a, 0xe5, 0x9b, 0xbe, 0xe6, 0x96, 0x87, 0x2f, 0xe8, 0xa7, 0x86, 0xe9,
+    0xa2, 0x91, 0xe7, 0xad, 0x89, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x01, 0x01, 0x12,
+    0x03, 0x16, 0x02, 0x0b, 0x0a, 0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x01, 0x02, 0x12, 0x03, 0x16,
+    0x11, 0x12, 0x0a, 0x3b, 0x0a, 0x04, 0x05, 0x00, 0x02, 0x02, 0x12, 0x03, 0x17, 0x02, 0x13, 0x22,
+    0x2e, 0x20, 0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe8, 0xbf,
+    0x87, 0xe6, 0xbb, 0xa4, 0xe8, 0xa7, 0x84, 0xe5, 0x88, 0x99, 0x3a, 0x20, 0xe7, 0xac, 0x94, 0xe8,
+    0xae, 0xb0, 0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x0a, 0x0a,
+    0x0c, 0x0a, 0x05, 0x05, 0x00, 0x02, 0x02, 0x01, 0x12, 0x03, 0x17, 0x02, 0x0e, 0x0a, 0x0c, 0x0a,
+    0x05, 0x05, 0x00, 0x02, 0x02, 0x02, 0x12, 0x03, 0x17, 0x11, 0x12, 0x0a, 0x23, 0x0a, 0x02, 0x04,
+    0x00, 0x12, 0x04, 0x1b, 0x00, 0x1f, 0x01, 0x1a, 0x17, 0x20, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0,
+    0xe7, 0xad, 0x9b, 0xe9, 0x80, 0x89, 0xe8, 0xbf, 0x87, 0xe6, 0xbb, 0xa4, 0xe5, 0x99, 0xa8, 0x0a,
+    0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x00, 0x01, 0x12, 0x03, 0x1b, 0x08, 0x12, 0x0a, 0x1e, 0x0a, 0x04,
+    0x04, 0x00, 0x02, 0x00, 0x12, 0x03, 0x1c, 0x02, 0x1a, 0x22, 0x11, 0x20, 0xe8, 0xbf, 0x87, 0xe6,
+    0xbb, 0xa4, 0xe5, 0x99, 0xa8, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x0a, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x00, 0x02, 0x00, 0x06, 0x12, 0x03, 0x1c, 0x02, 0x10, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00,
+    0x02, 0x00, 0x01, 0x12, 0x03, 0x1c, 0x11, 0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x00,
+    0x03, 0x12, 0x03, 0x1c, 0x18, 0x19, 0x0a, 0x50, 0x0a, 0x04, 0x04, 0x00, 0x02, 0x01, 0x12, 0x03,
+    0x1e, 0x02, 0x13, 0x1a, 0x2a, 0x20, 0xe4, 0xb8, 0x8d, 0xe5, 0x90, 0x8c, 0xe7, 0x9a, 0x84, 0x66,
+    0x69, 0x6c, 0x74, 0x65, 0x72, 0x74, 0x79, 0x70, 0x65, 0xe6, 0x94, 0xaf, 0xe6, 0x8c, 0x81, 0xe4,
+    0xb8, 0x8d, 0xe5, 0x90, 0x8c, 0xe7, 0x9a, 0x84, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x0a, 0x22,
+    0x17, 0x20, 0xe8, 0xbf, 0x87, 0xe6, 0xbb, 0xa4, 0xe5, 0x99, 0xa8, 0xe7, 0x9a, 0x84, 0xe6, 0x9d,
+    0xa1, 0xe4, 0xbb, 0xb6, 0xe5, 0x80, 0xbc, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01,
+    0x05, 0x12, 0x03, 0x1e, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x01, 0x12,
+    0x03, 0x1e, 0x09, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x00, 0x02, 0x01, 0x03, 0x12, 0x03, 0x1e,
+    0x11, 0x12, 0x0a, 0x20, 0x0a, 0x02, 0x04, 0x01, 0x12, 0x04, 0x22, 0x00, 0x26, 0x01, 0x1a, 0x14,
+    0x20, 0xe6, 0x90, 0x9c, 0xe7, 0xb4, 0xa2, 0xe7, 0xac, 0x94, 0xe8, 0xae, 0xb0, 0xe6, 0xa0, 0x87,
+    0xe7, 0xad, 0xbe, 0x0a, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x01, 0x01, 0x12, 0x03, 0x22, 0x08, 0x1d,
+    0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x00, 0x12, 0x03, 0x23, 0x02, 0x13, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x01, 0x02, 0x00, 0x05, 0x12, 0x03, 0x23, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x01, 0x02, 0x00, 0x01, 0x12, 0x03, 0x23, 0x09, 0x0d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02,
+    0x00, 0x03, 0x12, 0x03, 0x23, 0x11, 0x12, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x01, 0x02, 0x01, 0x12,
+    0x03, 0x24, 0x02, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x05, 0x12, 0x03, 0x24,
+    0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x01, 0x12, 0x03, 0x24, 0x09, 0x0d,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x01, 0x03, 0x12, 0x03, 0x24, 0x11, 0x12, 0x0a, 0x0b,
+    0x0a, 0x04, 0x04, 0x01, 0x02, 0x02, 0x12, 0x03, 0x25, 0x02, 0x13, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x01, 0x02, 0x02, 0x05, 0x12, 0x03, 0x25, 0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02,
+    0x02, 0x01, 0x12, 0x03, 0x25, 0x09, 0x0e, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x01, 0x02, 0x02, 0x03,
+    0x12, 0x03, 0x25, 0x11, 0x12, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x02, 0x12, 0x04, 0x28, 0x00, 0x2b,
+    0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x02, 0x01, 0x12, 0x03, 0x28, 0x08, 0x1e, 0x0a, 0x0b, 0x0a,
+    0x04, 0x04, 0x02, 0x02, 0x00, 0x12, 0x03, 0x29, 0x02, 0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02,
+    0x02, 0x00, 0x04, 0x12, 0x03, 0x29, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00,
+    0x06, 0x12, 0x03, 0x29, 0x0b, 0x12, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x01, 0x12,
+    0x03, 0x29, 0x13, 0x18, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x00, 0x03, 0x12, 0x03, 0x29,
+    0x1b, 0x1c, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x02, 0x02, 0x01, 0x12, 0x03, 0x2a, 0x02, 0x1d, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x02, 0x02, 0x01, 0x05, 0x12, 0x03, 0x2a, 0x02, 0x07, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x02, 0x02, 0x01, 0x01, 0x12, 0x03, 0x2a, 0x13, 0x18, 0x0a, 0x0c, 0x0a, 0x05, 0x04,
+    0x02, 0x02, 0x01, 0x03, 0x12, 0x03, 0x2a, 0x1b, 0x1c, 0x0a, 0x0a, 0x0a, 0x02, 0x04, 0x03, 0x12,
+    0x04, 0x2d, 0x00, 0x33, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x03, 0x01, 0x12, 0x03, 0x2d, 0x08,
+    0x1a, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x03, 0x02, 0x00, 0x12, 0x03, 0x2e, 0x02, 0x25, 0x0a, 0x0c,
+    0x0a, 0x05, 0x04, 0x03, 0x02, 0x00, 0x05, 0x12, 0x03, 0x2e, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05,
+    0x04, 0x03, 0x02, 0x00, 0x01, 0x12, 0x03, 0x2e, 0x16, 0x1d, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03,
+    0x02, 0x00, 0x03, 0x12, 0x03, 0x2e, 0x23, 0x24, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x03, 0x02, 0x01,
+    0x12, 0x03, 0x2f, 0x02, 0x25, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01, 0x04, 0x12, 0x03,
+    0x2f, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01, 0x06, 0x12, 0x03, 0x2f, 0x0b,
+    0x15, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01, 0x01, 0x12, 0x03, 0x2f, 0x16, 0x1d, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x01, 0x03, 0x12, 0x03, 0x2f, 0x23, 0x24, 0x0a, 0x0b, 0x0a,
+    0x04, 0x04, 0x03, 0x02, 0x02, 0x12, 0x03, 0x30, 0x02, 0x25, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03,
+    0x02, 0x02, 0x05, 0x12, 0x03, 0x30, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x02,
+    0x01, 0x12, 0x03, 0x30, 0x16, 0x20, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x02, 0x03, 0x12,
+    0x03, 0x30, 0x23, 0x24, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x03, 0x02, 0x03, 0x12, 0x03, 0x31, 0x02,
+    0x25, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x03, 0x05, 0x12, 0x03, 0x31, 0x02, 0x07, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x03, 0x01, 0x12, 0x03, 0x31, 0x16, 0x1b, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x03, 0x02, 0x03, 0x03, 0x12, 0x03, 0x31, 0x23, 0x24, 0x0a, 0x37, 0x0a, 0x04, 0x04,
+    0x03, 0x02, 0x04, 0x12, 0x03, 0x32, 0x02, 0x25, 0x22, 0x2a, 0x20, 0xe6, 0x90, 0x9c, 0xe7, 0xb4,
+    0xa2, 0xe8, 0x80, 0x85, 0x20, 0xe7, 0x94, 0xa8, 0xe4, 0xba, 0x8e, 0xe8, 0xbf, 0x87, 0xe6, 0xbb,
+    0xa4, 0xe4, 0xb8, 0x8d, 0xe5, 0x8f, 0xaf, 0xe8, 0xa7, 0x81, 0xe7, 0x9a, 0x84, 0xe7, 0xac, 0x94,
+    0xe8, 0xae, 0xb0, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x04, 0x05, 0x12, 0x03, 0x32,
+    0x02, 0x07, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x04, 0x01, 0x12, 0x03, 0x32, 0x16, 0x20,
+    0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x03, 0x02, 0x04, 0x03, 0x12, 0x03, 0x32, 0x23, 0x24, 0x0a, 0x0a,
+    0x0a, 0x02, 0x04, 0x04, 0x12, 0x04, 0x35, 0x00, 0x3a, 0x01, 0x0a, 0x0a, 0x0a, 0x03, 0x04, 0x04,
+    0x01, 0x12, 0x03, 0x35, 0x08, 0x1b, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x00, 0x12, 0x03,
+    0x36, 0x02, 0x21, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x05, 0x12, 0x03, 0x36, 0x02,
+    0x06, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x01, 0x12, 0x03, 0x36, 0x12, 0x1a, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x00, 0x03, 0x12, 0x03, 0x36, 0x1f, 0x20, 0x0a, 0x0b, 0x0a,
+    0x04, 0x04, 0x04, 0x02, 0x01, 0x12, 0x03, 0x37, 0x02, 0x21, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04,
+    0x02, 0x01, 0x05, 0x12, 0x03, 0x37, 0x02, 0x08, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x01,
+    0x01, 0x12, 0x03, 0x37, 0x12, 0x1c, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x01, 0x03, 0x12,
+    0x03, 0x37, 0x1f, 0x20, 0x0a, 0x0b, 0x0a, 0x04, 0x04, 0x04, 0x02, 0x02, 0x12, 0x03, 0x38, 0x02,
+    0x21, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x02, 0x05, 0x12, 0x03, 0x38, 0x02, 0x07, 0x0a,
+    0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x02, 0x01, 0x12, 0x03, 0x38, 0x12, 0x17, 0x0a, 0x0c, 0x0a,
+    0x05, 0x04, 0x04, 0x02, 0x02, 0x03, 0x12, 0x03, 0x38, 0x1f, 0x20, 0x0a, 0x0b, 0x0a, 0x04, 0x04,
+    0x04, 0x02, 0x03, 0x12, 0x03, 0x39, 0x02, 0x21, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x03,
+    0x04, 0x12, 0x03, 0x39, 0x02, 0x0a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x03, 0x05, 0x12,
+    0x03, 0x39, 0x0b, 0x11, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x03, 0x01, 0x12, 0x03, 0x39,
+    0x12, 0x1a, 0x0a, 0x0c, 0x0a, 0x05, 0x04, 0x04, 0x02, 0x03, 0x03, 0x12, 0x03, 0x39, 0x1f, 0x20,
+    0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 ];
 include!("search.api.v1.tonic.rs");
 // @@protoc_insertion_point(module)
\ No newline at end of file
@@ -226,6 +226,56 @@ pub mod document_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
+        pub async fn batch_get_note(
+            &mut self,
+            request: impl tonic::IntoRequest<super::BatchGetNoteRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::BatchGetNoteResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/search.api.v1.DocumentService/BatchGetNote",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("search.api.v1.DocumentService", "BatchGetNote"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        pub async fn list_note_ids(
+            &mut self,
+            request: impl tonic::IntoRequest<super::ListNoteIdsRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::ListNoteIdsResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::unknown(
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic_prost::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/search.api.v1.DocumentService/ListNoteIds",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("search.api.v1.DocumentService", "ListNoteIds"));
+            self.inner.unary(req, path, codec).await
+        }
     }
 }
 /// Generated server implementations.
@@ -276,6 +326,20 @@ pub mod document_service_server {
             tonic::Response<super::BatchUpdateNoteCommentCountResponse>,
             tonic::Status,
         >;
+        async fn batch_get_note(
+            &self,
+            request: tonic::Request<super::BatchGetNoteRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::BatchGetNoteResponse>,
+            tonic::Status,
+        >;
+        async fn list_note_ids(
+            &self,
+            request: tonic::Request<super::ListNoteIdsRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::ListNoteIdsResponse>,
+            tonic::Status,
+        >;
     }
     #[derive(Debug)]
     pub struct DocumentServiceServer<T> {
@@ -596,6 +660,97 @@ pub mod document_service_server {
                     };
                     Box::pin(fut)
                 }
+                "/search.api.v1.DocumentService/BatchGetNote" => {
+                    #[allow(non_camel_case_types)]
+                    struct BatchGetNoteSvc<T: DocumentService>(pub Arc<T>);
+                    impl<
+                        T: DocumentService,
+                    > tonic::server::UnaryService<super::BatchGetNoteRequest>
+                    for BatchGetNoteSvc<T> {
+                        type Response = super::BatchGetNoteResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::BatchGetNoteRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as DocumentService>::batch_get_note(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = BatchGetNoteSvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/search.api.v1.DocumentService/ListNoteIds" => {
+                    #[allow(non_camel_case_types)]
+                    struct ListNoteIdsSvc<T: DocumentService>(pub Arc<T>);
+                    impl<
+                        T: DocumentService,
+                    > tonic::server::UnaryService<super::ListNoteIdsRequest>
+                    for ListNoteIdsSvc<T> {
+                        type Response = super::ListNoteIdsResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::ListNoteIdsRequest>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as DocumentService>::list_note_ids(&inner, request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let method = ListNoteIdsSvc(inner);
+                        let codec = tonic_prost::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 _ => {
                     Box::pin(async move {
                         let mut response = http::Response::new(
@@ -17,45 +17,60 @@ func UnaryServerErrorHandler(ctx context.Context,
 	info *grpc.UnaryServerInfo,
 	handler grpc.UnaryHandler) (resp any, err error) {
 
+	resp, err = handler(ctx, req)
+	if err == nil {
+		return resp, err
+	}
+
+	return resp, serverError(ctx, err)
+}
+
+// 处理grpc server stream返回的interceptor
+func StreamServerErrorHandler(srv any,
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+
+	err := handler(srv, ss)
+	if err == nil {
+		return nil
+	}
+
+	return serverError(ss.Context(), err)
+}
+
+// 将错误转成grpc status并自动输出日志
+func serverError(ctx context.Context, rawErr error) error {
 	var (
-		code   codes.Code = codes.Unknown
-		msg    string
-		rawErr error
+		code codes.Code = codes.Unknown
+		msg  string
 	)
 
 	// 自动输出日志
 	defer func() {
-		if rawErr != nil {
-			errPxy, ok := rawErr.(xerror.ErrProxy)
-			if ok && errPxy != nil {
-				// msg, underErr := xerror.UnwrapMsg(errPxy)
-				prepare := xlog.Err(errPxy).
-					FieldMap(errPxy.Fields()).
-					ExtraMap(errPxy.Extra()).
-					Extras("stack", stacktrace.FormatFrames(xerror.UnwrapFrames(errPxy)))
-				if codeShouldLogError(code) {
-					prepare.Errorx(errPxy.Context())
-				} else {
-					prepare.Infox(errPxy.Context())
-				}
+		errPxy, ok := rawErr.(xerror.ErrProxy)
+		if ok && errPxy != nil {
+			// msg, underErr := xerror.UnwrapMsg(errPxy)
+			prepare := xlog.Err(errPxy).
+				FieldMap(errPxy.Fields()).
+				ExtraMap(errPxy.Extra()).
+				Extras("stack", stacktrace.FormatFrames(xerror.UnwrapFrames(errPxy)))
+			if codeShouldLogError(code) {
+				prepare.Errorx(errPxy.Context())
+			} else {
+				prepare.Infox(errPxy.Context())
+			}
+		} else {
+			prepare := xlog.Msg(rawErr.Error()).Err(rawErr)
+			if codeShouldLogError(code) {
+				prepare.Errorx(ctx)
 			} else {
-				prepare := xlog.Msg(rawErr.Error()).Err(rawErr)
-				if codeShouldLogError(code) {
-					prepare.Errorx(ctx)
-				} else {
-					prepare.Infox(ctx)
-				}
+				prepare.Infox(ctx)
 			}
 		}
 	}()
 
-	resp, err = handler(ctx, req)
-	rawErr = err
-	if err == nil {
-		return resp, err
-	}
-
-	err = xerror.Cause(err)
+	err := xerror.Cause(rawErr)
 	// 转成Error对象透传到下游
 	xerr, ok := err.(*xerror.Error)
 	if ok {
@@ -73,7 +88,7 @@ func UnaryServerErrorHandler(ctx context.Context,
 		}
 	}
 
-	return resp, status.Error(code, msg)
+	return status.Error(code, msg)
 }
 
 func codeShouldLogError(code codes.Code) bool {
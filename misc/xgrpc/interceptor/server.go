@@ -6,23 +6,44 @@ import (
 	"google.golang.org/grpc"
 )
 
-// server端拦截器
-func InstallUnaryServerInterceptors(server *zrpc.RpcServer,
-	customs ...grpc.UnaryServerInterceptor) {
-	// 默认拦截器
-	interceptors := []grpc.UnaryServerInterceptor{
+// server端默认拦截器 不经过zrpc直接创建grpc.Server时使用
+func DefaultUnaryServerInterceptors() []grpc.UnaryServerInterceptor {
+	return []grpc.UnaryServerInterceptor{
 		UnaryServerErrorHandler,
 		UnaryServerRecovery,
 		UnaryServerMetadataExtract,
 		UnaryServerValidateHandler,
 		UnaryServerExtensionHandler,
 	}
+}
+
+// server端流式接口默认拦截器
+func DefaultStreamServerInterceptors() []grpc.StreamServerInterceptor {
+	return []grpc.StreamServerInterceptor{
+		StreamServerErrorHandler,
+	}
+}
+
+// server端拦截器
+func InstallUnaryServerInterceptors(server *zrpc.RpcServer,
+	customs ...grpc.UnaryServerInterceptor) {
+	// 默认拦截器
+	interceptors := DefaultUnaryServerInterceptors()
 
 	// 自定义拦截器
 	interceptors = append(interceptors, customs...)
 	server.AddUnaryInterceptors(interceptors...)
 }
 
+// server端流式接口拦截器
+func InstallStreamServerInterceptors(server *zrpc.RpcServer,
+	customs ...grpc.StreamServerInterceptor) {
+	interceptors := DefaultStreamServerInterceptors()
+
+	interceptors = append(interceptors, customs...)
+	server.AddStreamInterceptors(interceptors...)
+}
+
 func WithUnaryChecker(checkers ...checker.UnaryServerMetadataChecker) grpc.UnaryServerInterceptor {
 	return UnaryServerMetadataCheck(checkers...)
 }
@@ -43,6 +43,16 @@ func (hc HeaderCarrier) KafkaHeader() []kafka.Header {
 	return hds
 }
 
+func lookupHeader(hds []kafka.Header, key string) (string, bool) {
+	for _, h := range hds {
+		if h.Key == key {
+			return string(h.Value), true
+		}
+	}
+
+	return "", false
+}
+
 func HeaderCarrierFromKafkaHeaders(hds []kafka.Header) HeaderCarrier {
 	m := make(map[string]string, len(hds))
 	for _, r := range hds {
@@ -94,6 +94,17 @@ func (w *Writer) WriteMessages(ctx context.Context, msgs ...kafka.Message) error
 	kafkaHeaders := headerCarrier.KafkaHeader()
 
 	for idx := range len(msgs) {
+		// 消息自带uid时以消息的为准 可以一次写入属于不同用户的消息
+		if msgUid, ok := lookupHeader(msgs[idx].Headers, header.HeaderUid); ok {
+			msgHeaderCarrier := make(HeaderCarrier, len(headerCarrier))
+			for k, v := range headerCarrier {
+				msgHeaderCarrier[k] = v
+			}
+			msgHeaderCarrier[header.HeaderUid] = msgUid
+			msgs[idx].Headers = msgHeaderCarrier.KafkaHeader()
+			continue
+		}
+
 		msgs[idx].Headers = kafkaHeaders
 	}
 
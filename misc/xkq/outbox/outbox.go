@@ -0,0 +1,194 @@
+// Package outbox 事务性发件箱
+//
+// 业务在本地事务中将待投递的消息写入发件箱表 由Relay在事务提交后异步投递到kafka,
+// 避免本地数据已提交但是消息丢失的问题; 消息至少投递一次 消费方需要保证幂等.
+//
+// Relay先在短事务中将消息标记为投递中(utime作为租约开始时间) 再在事务外投递,
+// 投递期间不持有行锁 不会阻塞业务事务写入发件箱.
+//
+// 发件箱表结构(表名可自定义):
+//
+//	CREATE TABLE IF NOT EXISTS xxx_outbox (
+//		`id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+//		`topic` VARCHAR(128) NOT NULL DEFAULT '',
+//		`msg_key` VARBINARY(255) NOT NULL DEFAULT '',
+//		`msg_value` MEDIUMBLOB NOT NULL,
+//		`uid` BIGINT NOT NULL DEFAULT 0,
+//		`state` TINYINT NOT NULL DEFAULT 0,
+//		`ctime` BIGINT NOT NULL DEFAULT 0,
+//		`utime` BIGINT NOT NULL DEFAULT 0,
+//		PRIMARY KEY (`id`),
+//		KEY `idx_state_id` (`state`, `id`)
+//	)ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/misc/metadata"
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xslice"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+)
+
+// 消息投递状态
+const (
+	statePending int8 = 0
+	stateSent    int8 = 1
+	stateSending int8 = 2 // 已被某个Relay领取 租约过期后可被重新领取
+)
+
+const fields = "id,topic,msg_key,msg_value,uid,state,ctime,utime"
+
+// 待投递的消息
+type Message struct {
+	Topic string
+	Key   []byte
+	Value []byte
+}
+
+type record struct {
+	Id    int64  `db:"id"`
+	Topic string `db:"topic"`
+	Key   []byte `db:"msg_key"`
+	Value []byte `db:"msg_value"`
+	Uid   int64  `db:"uid"` // 写入时ctx中的uid 投递时还原到消息header中
+	State int8   `db:"state"`
+	Ctime int64  `db:"ctime"`
+	Utime int64  `db:"utime"`
+}
+
+// 发件箱
+type Outbox struct {
+	db    *xsql.DB
+	table string
+}
+
+func New(db *xsql.DB, table string) *Outbox {
+	return &Outbox{
+		db:    db,
+		table: table,
+	}
+}
+
+// Add 写入待投递的消息
+//
+// ctx中有事务时在该事务中写入 应该和业务数据变更在同一个事务中调用
+func (o *Outbox) Add(ctx context.Context, msgs ...Message) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	var (
+		now  = time.Now().Unix()
+		uid  = metadata.Uid(ctx)
+		args = make([]any, 0, len(msgs)*7)
+	)
+	for _, msg := range msgs {
+		args = append(args, msg.Topic, msg.Key, msg.Value, uid, statePending, now, now)
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("(?,?,?,?,?,?,?),", len(msgs)), ",")
+	sql := fmt.Sprintf("INSERT INTO %s(topic,msg_key,msg_value,uid,state,ctime,utime) VALUES %s",
+		o.table, placeholders)
+	_, err := o.db.ExecCtx(ctx, sql, args...)
+	if err != nil {
+		return xerror.Wrapf(xsql.ConvertError(err), "outbox add failed").WithExtra("table", o.table).WithCtx(ctx)
+	}
+
+	return nil
+}
+
+// 按写入顺序领取待投递的消息 领取后标记为投递中
+//
+// 多个实例同时领取时会在加锁处串行; 有其它实例的租约还未过期时不领取,
+// 保证同一时间只有一个实例在投递 同一个key的消息按顺序投递
+func (o *Outbox) claim(ctx context.Context, limit int, lease time.Duration) ([]*record, error) {
+	var claimed []*record
+	err := o.db.Transact(ctx, func(ctx context.Context) error {
+		records, err := o.fetchForUpdate(ctx, limit)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		leaseDeadline := now.Add(-lease).Unix()
+		for _, rec := range records {
+			if rec.State == stateSending && rec.Utime >= leaseDeadline {
+				return nil
+			}
+		}
+
+		if len(records) == 0 {
+			return nil
+		}
+
+		ids := make([]int64, 0, len(records))
+		for _, rec := range records {
+			ids = append(ids, rec.Id)
+		}
+		sql := fmt.Sprintf("UPDATE %s SET state=?, utime=? WHERE id IN (%s)", o.table, xslice.JoinInts(ids))
+		_, err = o.db.ExecCtx(ctx, sql, stateSending, now.Unix())
+		if err != nil {
+			return xerror.Wrap(xsql.ConvertError(err))
+		}
+
+		claimed = records
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return claimed, nil
+}
+
+// 加锁读取最早的待投递和投递中的消息 加锁读能读到其它实例刚提交的领取结果
+func (o *Outbox) fetchForUpdate(ctx context.Context, limit int) ([]*record, error) {
+	sql := fmt.Sprintf("SELECT %s FROM %s WHERE state IN (?,?) ORDER BY id ASC LIMIT ? FOR UPDATE", fields, o.table)
+	var records []*record
+	err := o.db.QueryRowsCtx(ctx, &records, sql, statePending, stateSending, limit)
+	if err != nil {
+		err = xsql.ConvertError(err)
+		if xsql.IsNoRecord(err) {
+			return nil, nil
+		}
+		return nil, xerror.Wrap(err)
+	}
+
+	return records, nil
+}
+
+func (o *Outbox) markSent(ctx context.Context, ids []int64) error {
+	return o.setStateFromSending(ctx, ids, stateSent)
+}
+
+// 投递失败的消息放回待投递 下一次领取时按顺序重试
+func (o *Outbox) release(ctx context.Context, ids []int64) error {
+	return o.setStateFromSending(ctx, ids, statePending)
+}
+
+func (o *Outbox) setStateFromSending(ctx context.Context, ids []int64, state int8) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	sql := fmt.Sprintf("UPDATE %s SET state=?, utime=? WHERE id IN (%s) AND state=?",
+		o.table, xslice.JoinInts(ids))
+	_, err := o.db.ExecCtx(ctx, sql, state, time.Now().Unix(), stateSending)
+	return xerror.Wrap(xsql.ConvertError(err))
+}
+
+// 清理before之前已经投递的消息 返回清理的条数
+func (o *Outbox) purgeSent(ctx context.Context, before int64, limit int) (int64, error) {
+	sql := fmt.Sprintf("DELETE FROM %s WHERE state=? AND utime<? LIMIT ?", o.table)
+	res, err := o.db.ExecCtx(ctx, sql, stateSent, before, limit)
+	if err != nil {
+		return 0, xerror.Wrap(xsql.ConvertError(err))
+	}
+
+	return res.RowsAffected()
+}
@@ -0,0 +1,231 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/misc/concurrent"
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xkq/header"
+	"github.com/ryanreadbooks/whimer/misc/xlog"
+
+	"github.com/segmentio/kafka-go"
+)
+
+const (
+	defaultInterval      = time.Second
+	defaultBatchSize     = 100
+	defaultRetention     = 3 * 24 * time.Hour
+	defaultLease         = 30 * time.Second
+	defaultPurgeInterval = time.Hour
+	purgeBatchSize       = 1000
+)
+
+// 消息投递 xkq/kafka.Writer 实现了此接口
+//
+// 需要使用同步写 并且使用按key分区的Balancer才能保证同一个key的消息有序;
+// 每条消息的uid放在消息header中 一批消息只调用一次WriteMessages
+type Publisher interface {
+	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
+}
+
+type RelayConfig struct {
+	Interval  time.Duration `json:"interval,default=1s"`    // 轮询间隔
+	BatchSize int           `json:"batch_size,default=100"` // 每次投递的最大条数
+	Retention time.Duration `json:"retention,default=72h"`  // 已投递消息的保留时间
+	Lease     time.Duration `json:"lease,default=30s"`      // 领取消息后的租约时长 超过后其它实例可重新领取
+}
+
+// 后台投递发件箱中的消息
+type Relay struct {
+	box *Outbox
+	pub Publisher
+	c   RelayConfig
+
+	quitCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+func NewRelay(box *Outbox, pub Publisher, c RelayConfig) *Relay {
+	if c.Interval <= 0 {
+		c.Interval = defaultInterval
+	}
+	if c.BatchSize <= 0 {
+		c.BatchSize = defaultBatchSize
+	}
+	if c.Retention <= 0 {
+		c.Retention = defaultRetention
+	}
+	if c.Lease <= 0 {
+		c.Lease = defaultLease
+	}
+
+	return &Relay{
+		box:    box,
+		pub:    pub,
+		c:      c,
+		quitCh: make(chan struct{}),
+	}
+}
+
+// Start 启动后台投递
+func (r *Relay) Start(ctx context.Context) {
+	r.wg.Add(1)
+	concurrent.SafeGo2(ctx, concurrent.SafeGo2Opt{
+		Name:             "xkq.outbox.relay_loop",
+		InheritCtxCancel: true,
+		Job: func(ctx context.Context) error {
+			defer r.wg.Done()
+			r.loop(ctx)
+			return nil
+		},
+	})
+}
+
+// Stop 停止后台投递 等待正在进行的投递完成
+func (r *Relay) Stop() {
+	close(r.quitCh)
+	r.wg.Wait()
+}
+
+func (r *Relay) loop(ctx context.Context) {
+	ticker := time.NewTicker(r.c.Interval)
+	defer ticker.Stop()
+	purgeTicker := time.NewTicker(defaultPurgeInterval)
+	defer purgeTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-r.quitCh:
+			return
+		case <-ticker.C:
+			r.drain(ctx)
+		case <-purgeTicker.C:
+			r.purge(ctx)
+		}
+	}
+}
+
+// 一直投递直到没有积压
+func (r *Relay) drain(ctx context.Context) {
+	for {
+		n, err := r.RelayOnce(ctx)
+		if err != nil {
+			xlog.Msg("outbox relay failed").Err(err).Extra("table", r.box.table).Errorx(ctx)
+			return
+		}
+
+		if n < r.c.BatchSize {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-r.quitCh:
+			return
+		default:
+		}
+	}
+}
+
+// RelayOnce 投递一批消息 返回成功投递的条数
+func (r *Relay) RelayOnce(ctx context.Context) (int, error) {
+	records, err := r.box.claim(ctx, r.c.BatchSize, r.c.Lease)
+	if err != nil {
+		return 0, xerror.Wrapf(err, "outbox claim failed").WithExtra("table", r.box.table).WithCtx(ctx)
+	}
+	if len(records) == 0 {
+		return 0, nil
+	}
+
+	// 需要在租约内完成投递
+	pubCtx, cancel := context.WithTimeout(ctx, r.c.Lease)
+	sentIds, failedIds, pubErr := publishRecords(pubCtx, r.pub, records)
+	cancel()
+
+	// 投递失败时已经投递成功的部分也需要标记 避免重复投递
+	if err := r.box.markSent(ctx, sentIds); err != nil {
+		return 0, xerror.Wrapf(err, "outbox mark sent failed").
+			WithExtras("table", r.box.table, "count", len(sentIds)).
+			WithCtx(ctx)
+	}
+	if err := r.box.release(ctx, failedIds); err != nil {
+		xlog.Msg("outbox release failed").Err(err).Extras("table", r.box.table, "count", len(failedIds)).Errorx(ctx)
+	}
+
+	if pubErr != nil {
+		return len(sentIds), xerror.Wrapf(pubErr, "outbox relay once partially failed").
+			WithExtras("table", r.box.table, "sent", len(sentIds)).
+			WithCtx(ctx)
+	}
+
+	return len(sentIds), nil
+}
+
+// 一次投递一批消息 每条消息的uid放在header中
+//
+// 返回投递成功和投递失败的消息id
+func publishRecords(ctx context.Context, pub Publisher, records []*record) ([]int64, []int64, error) {
+	if len(records) == 0 {
+		return nil, nil, nil
+	}
+
+	msgs := make([]kafka.Message, 0, len(records))
+	for _, rec := range records {
+		msgs = append(msgs, kafka.Message{
+			Topic: rec.Topic,
+			Key:   rec.Key,
+			Value: rec.Value,
+			Headers: []kafka.Header{
+				{Key: header.HeaderUid, Value: []byte(strconv.FormatInt(rec.Uid, 10))},
+			},
+		})
+	}
+
+	err := pub.WriteMessages(ctx, msgs...)
+	if err == nil {
+		ids := make([]int64, 0, len(records))
+		for _, rec := range records {
+			ids = append(ids, rec.Id)
+		}
+		return ids, nil, nil
+	}
+
+	// 部分写入失败时按消息区分 其它错误视为全部失败
+	var writeErrs kafka.WriteErrors
+	if !errors.As(err, &writeErrs) || len(writeErrs) != len(records) {
+		writeErrs = nil
+	}
+
+	var sentIds, failedIds []int64
+	for idx, rec := range records {
+		if writeErrs != nil && writeErrs[idx] == nil {
+			sentIds = append(sentIds, rec.Id)
+		} else {
+			failedIds = append(failedIds, rec.Id)
+		}
+	}
+
+	return sentIds, failedIds, xerror.Wrapf(err, "outbox publish messages failed").WithExtra("count", len(msgs))
+}
+
+func (r *Relay) purge(ctx context.Context) {
+	before := time.Now().Add(-r.c.Retention).Unix()
+	for {
+		n, err := r.box.purgeSent(ctx, before, purgeBatchSize)
+		if err != nil {
+			xlog.Msg("outbox purge sent failed").Err(err).Extra("table", r.box.table).Errorx(ctx)
+			return
+		}
+
+		if n < purgeBatchSize {
+			return
+		}
+	}
+}
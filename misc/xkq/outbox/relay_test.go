@@ -0,0 +1,82 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ryanreadbooks/whimer/misc/xkq/header"
+	"github.com/segmentio/kafka-go"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type fakePublisher struct {
+	batches [][]kafka.Message
+	err     error
+}
+
+func (p *fakePublisher) WriteMessages(ctx context.Context, msgs ...kafka.Message) error {
+	if p.err != nil {
+		return p.err
+	}
+
+	p.batches = append(p.batches, msgs)
+	return nil
+}
+
+func newRecords(uids ...int64) []*record {
+	records := make([]*record, 0, len(uids))
+	for i, uid := range uids {
+		records = append(records, &record{
+			Id:    int64(i + 1),
+			Topic: "topic",
+			Key:   []byte("key"),
+			Value: []byte{byte(i)},
+			Uid:   uid,
+		})
+	}
+	return records
+}
+
+func TestPublishRecords(t *testing.T) {
+	Convey("publishRecords", t, func() {
+		ctx := context.Background()
+
+		Convey("publish in one batch with uid header", func() {
+			pub := &fakePublisher{}
+			sentIds, failedIds, err := publishRecords(ctx, pub, newRecords(1, 1, 2, 1))
+			So(err, ShouldBeNil)
+			So(sentIds, ShouldResemble, []int64{1, 2, 3, 4})
+			So(failedIds, ShouldBeEmpty)
+			So(pub.batches, ShouldHaveLength, 1)
+			So(pub.batches[0], ShouldHaveLength, 4)
+			So(pub.batches[0][1].Value, ShouldResemble, []byte{1})
+			So(pub.batches[0][2].Headers, ShouldResemble, []kafka.Header{
+				{Key: header.HeaderUid, Value: []byte("2")},
+			})
+		})
+
+		Convey("partially failed", func() {
+			pub := &fakePublisher{err: kafka.WriteErrors{nil, errors.New("write failed"), nil}}
+			sentIds, failedIds, err := publishRecords(ctx, pub, newRecords(1, 2, 3))
+			So(err, ShouldNotBeNil)
+			So(sentIds, ShouldResemble, []int64{1, 3})
+			So(failedIds, ShouldResemble, []int64{2})
+		})
+
+		Convey("all failed", func() {
+			pub := &fakePublisher{err: errors.New("write failed")}
+			sentIds, failedIds, err := publishRecords(ctx, pub, newRecords(1, 2))
+			So(err, ShouldNotBeNil)
+			So(sentIds, ShouldBeEmpty)
+			So(failedIds, ShouldResemble, []int64{1, 2})
+		})
+
+		Convey("empty", func() {
+			sentIds, failedIds, err := publishRecords(ctx, &fakePublisher{}, nil)
+			So(err, ShouldBeNil)
+			So(sentIds, ShouldBeEmpty)
+			So(failedIds, ShouldBeEmpty)
+		})
+	})
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/ryanreadbooks/whimer/msger/internal/model"
 	"github.com/ryanreadbooks/whimer/msger/internal/srv"
+	"github.com/ryanreadbooks/whimer/msger/internal/srv/systemchat"
 )
 
 type SystemNotificationServiceServer struct {
@@ -25,7 +26,40 @@ func NewSystemNotificationServiceServer(srv *srv.Service) *SystemNotificationSer
 // 系统通知
 func (s *SystemNotificationServiceServer) NotifySystemNotice(ctx context.Context, in *systemv1.NotifySystemNoticeRequest) (
 	*systemv1.NotifySystemNoticeResponse, error) {
-	return nil, nil
+	if len(in.GetContents()) == 0 {
+		return &systemv1.NotifySystemNoticeResponse{}, nil
+	}
+
+	reqs := make([]*model.SystemNotifyNoticeMsg, 0, len(in.Contents))
+	for _, c := range in.Contents {
+		notice := systemchat.SystemNotice{
+			Title:   c.GetTitle(),
+			Content: c.GetContent(),
+		}
+		req := &model.SystemNotifyNoticeMsg{
+			Target:  c.GetTargetUid(),
+			Content: []byte(notice.Format()),
+		}
+		if c.GetTitle() == "" && c.GetContent() == "" || !isSysMsgContentValid(req) {
+			continue
+		}
+
+		reqs = append(reqs, req)
+	}
+
+	msgIds, err := s.Service.SystemChatSrv.NotifyNoticeSystemMsg(ctx, reqs)
+	if err != nil {
+		return nil, err
+	}
+
+	respMsgIds := make(map[int64]*pbmsg.StringList)
+	for recvUid, msgIds := range msgIds {
+		respMsgIds[recvUid] = &pbmsg.StringList{
+			Items: msgIds,
+		}
+	}
+
+	return &systemv1.NotifySystemNoticeResponse{MsgIds: respMsgIds}, nil
 }
 
 // 回复我的
@@ -187,3 +187,36 @@ func MakeSystemNotifyLikesMsgAsSlice(msgs []*SystemNotifyLikesMsg) []ISystemMsg
 
 	return ms
 }
+
+// 系统通知的发起者
+const SystemNoticeUid int64 = -1
+
+type SystemNotifyNoticeMsg struct {
+	Target  int64  `json:"target"`  // 被通知的用户
+	Content []byte `json:"content"` // 通知完整内容
+}
+
+func (m *SystemNotifyNoticeMsg) GetUid() int64 {
+	return SystemNoticeUid
+}
+
+func (m *SystemNotifyNoticeMsg) GetTargetUid() int64 {
+	return m.Target
+}
+
+func (m *SystemNotifyNoticeMsg) GetContent() []byte {
+	return m.Content
+}
+
+func (m *SystemNotifyNoticeMsg) AsSystemMsg() ISystemMsg {
+	return m
+}
+
+func MakeSystemNotifyNoticeMsgAsSlice(msgs []*SystemNotifyNoticeMsg) []ISystemMsg {
+	ms := make([]ISystemMsg, 0, len(msgs))
+	for _, m := range msgs {
+		ms = append(ms, m.AsSystemMsg())
+	}
+
+	return ms
+}
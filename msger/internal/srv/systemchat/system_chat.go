@@ -112,6 +112,14 @@ func (s *SystemChatSrv) notifySystemMsg(ctx context.Context,
 	return targetMsgIds, nil
 }
 
+// 批量发送系统通知
+func (s *SystemChatSrv) NotifyNoticeSystemMsg(ctx context.Context,
+	reqs []*model.SystemNotifyNoticeMsg) (map[int64][]string, error) {
+
+	iMsgReqs := model.MakeSystemNotifyNoticeMsgAsSlice(reqs)
+	return s.notifySystemMsg(ctx, model.SystemNotifyNoticeChat, iMsgReqs)
+}
+
 // 发送@我的系统消息
 func (s *SystemChatSrv) NotifyMentionSystemMsg(ctx context.Context,
 	reqs []*model.SystemNotifyMentionMsg) (map[int64][]string, error) {
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"os"
@@ -10,12 +11,14 @@ import (
 	"github.com/ryanreadbooks/whimer/note/internal/biz"
 	"github.com/ryanreadbooks/whimer/note/internal/config"
 	"github.com/ryanreadbooks/whimer/note/internal/infra"
+	job "github.com/ryanreadbooks/whimer/note/internal/job/cron"
 	"github.com/ryanreadbooks/whimer/note/internal/srv"
 	"github.com/zeromicro/go-zero/core/conf"
 )
 
 const (
 	NoteSyncToEs = "notesynces"
+	EsReconcile  = "esreconcile"
 )
 
 var (
@@ -44,13 +47,18 @@ func main() {
 	switch *handleType {
 	case NoteSyncToEs:
 		err = notesynces.Handle(&config.Conf, bizz, svc, dt)
+	case EsReconcile:
+		// 单实例执行一轮完整的核对
+		var report *job.EsReconcileReport
+		report, err = job.NewEsReconciler(&config.Conf, bizz, dt).RunOnce(context.Background(), 0, 1)
+		xlog.Msg("es reconcile finished").Extras("report", report).Info()
 	default:
 		xlog.Msgf("unsupported handle type: %s", *handleType).Error()
 		os.Exit(1)
 	}
 
 	if err != nil {
-		xlog.Msgf("notesynces failed").Err(err).Error()
+		xlog.Msgf("%s failed", *handleType).Err(err).Error()
 		os.Exit(1)
 	}
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/ryanreadbooks/whimer/note/internal/entry/http"
 	"github.com/ryanreadbooks/whimer/note/internal/global"
 	"github.com/ryanreadbooks/whimer/note/internal/infra"
+	job "github.com/ryanreadbooks/whimer/note/internal/job/cron"
 	"github.com/ryanreadbooks/whimer/note/internal/srv"
 
 	"github.com/zeromicro/go-zero/core/conf"
@@ -51,6 +52,11 @@ func main() {
 	group.Add(svc)
 	group.Add(grpcServer)
 	group.Add(httpServer)
+	if config.Conf.EsReconcile.Enable {
+		group.Add(job.NewEsReconciler(&config.Conf, bizz, dt))
+	}
+	group.Add(job.NewRecyclePurger(&config.Conf, bizz))
+	group.Add(job.NewViewFlusher(&config.Conf, bizz))
 	logx.Info("note is serving...")
 	group.Start()
 }
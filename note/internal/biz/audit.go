@@ -0,0 +1,321 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"unicode/utf8"
+
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+	"github.com/ryanreadbooks/whimer/note/internal/data"
+	"github.com/ryanreadbooks/whimer/note/internal/global"
+	"github.com/ryanreadbooks/whimer/note/internal/infra/moderation"
+	"github.com/ryanreadbooks/whimer/note/internal/model"
+	"github.com/ryanreadbooks/whimer/note/internal/model/convert"
+)
+
+// 笔记审核
+//
+// 机审由审核提供方给出结论 无法判定的笔记进入人工复审队列;
+// 每篇笔记只保留最近一次的审核结果
+type NoteAuditBiz struct {
+	data *data.Data
+	note *NoteBiz
+}
+
+func NewNoteAuditBiz(dt *data.Data, note *NoteBiz) *NoteAuditBiz {
+	return &NoteAuditBiz{
+		data: dt,
+		note: note,
+	}
+}
+
+// 对笔记进行机审 返回的结果需要调用方保存
+func (b *NoteAuditBiz) Moderate(ctx context.Context, noteId int64) (*model.NoteAudit, error) {
+	note, err := b.note.GetNote(ctx, noteId)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "audit biz get note failed").WithExtra("noteId", noteId).WithCtx(ctx)
+	}
+	err = b.note.AssembleNotesExt(ctx, note.AsSlice())
+	if err != nil {
+		return nil, xerror.Wrapf(err, "audit biz assemble note ext failed").WithExtra("noteId", noteId).WithCtx(ctx)
+	}
+
+	// 发布后的编辑只审核变更的部分
+	diff, err := b.getEditDiff(ctx, noteId)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "audit biz get edit diff failed").WithExtra("noteId", noteId).WithCtx(ctx)
+	}
+
+	provider := moderation.GetProvider()
+	var textVerdict *moderation.Verdict
+	if text := moderationText(note, diff); text != nil {
+		textVerdict, err = provider.ModerateText(ctx, text)
+		if err != nil {
+			return nil, xerror.Wrapf(err, "audit biz moderate text failed").WithExtra("noteId", noteId).WithCtx(ctx)
+		}
+	}
+
+	var assetVerdict *moderation.Verdict
+	switch note.Type {
+	case model.AssetTypeImage:
+		if images := moderationImages(note, diff); images != nil {
+			assetVerdict, err = provider.ModerateImage(ctx, images)
+		}
+	case model.AssetTypeVideo:
+		// 视频资源为转码后的结果 资源有变化时整体审核
+		if diff == nil || diff.AssetChanged() {
+			videos := &moderation.VideoContent{}
+			if note.Videos != nil {
+				for _, item := range note.Videos.Items {
+					videos.Keys = append(videos.Keys, item.Key)
+				}
+			}
+			assetVerdict, err = provider.ModerateVideo(ctx, videos)
+		}
+	}
+	if err != nil {
+		return nil, xerror.Wrapf(err, "audit biz moderate asset failed").WithExtra("noteId", noteId).WithCtx(ctx)
+	}
+
+	verdict := moderation.Merge(textVerdict, assetVerdict)
+
+	return &model.NoteAudit{
+		NoteId:   noteId,
+		Decision: auditDecisionFromVerdict(verdict),
+		Reason:   truncateAuditReason(verdict.Reason),
+		Provider: provider.Name(),
+		Operator: model.AuditOperatorMachine,
+	}, nil
+}
+
+// 获取发布后编辑的变更 不是发布后的编辑时返回nil 表示需要审核全部内容
+func (b *NoteAuditBiz) getEditDiff(ctx context.Context, noteId int64) (*model.NoteRevisionDiff, error) {
+	latest, err := b.data.NoteRevision.GetLatest(ctx, noteId)
+	if err != nil {
+		if xsql.IsNoRecord(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	rev := convert.NoteRevisionFromDao(latest)
+	if !rev.IsEditAfterPublish() {
+		return nil, nil
+	}
+
+	return rev.Diff, nil
+}
+
+// 需要审核的文本 没有需要审核的内容时返回nil
+func moderationText(note *model.Note, diff *model.NoteRevisionDiff) *moderation.TextContent {
+	if diff != nil && !diff.TextChanged() {
+		return nil
+	}
+
+	text := &moderation.TextContent{}
+	if diff == nil || diff.TitleChanged {
+		text.Title = note.Title
+	}
+	if diff == nil || diff.DescChanged {
+		text.Desc = note.Desc
+	}
+	for _, tag := range note.Tags {
+		if diff == nil || slices.Contains(diff.AddedTagIds, tag.Id) {
+			text.Tags = append(text.Tags, tag.Name)
+		}
+	}
+
+	return text
+}
+
+// 需要审核的图片 没有需要审核的内容时返回nil
+func moderationImages(note *model.Note, diff *model.NoteRevisionDiff) *moderation.ImageContent {
+	images := &moderation.ImageContent{}
+	for _, img := range note.Images {
+		if diff == nil || slices.Contains(diff.AddedAssetKeys, img.Key) {
+			images.Keys = append(images.Keys, img.Key)
+		}
+	}
+
+	if diff != nil && len(images.Keys) == 0 {
+		return nil
+	}
+	return images
+}
+
+func auditDecisionFromVerdict(v *moderation.Verdict) model.AuditDecision {
+	switch v.Decision {
+	case moderation.DecisionReject:
+		return model.AuditDecisionReject
+	case moderation.DecisionReview:
+		return model.AuditDecisionReview
+	default:
+		return model.AuditDecisionPass
+	}
+}
+
+func truncateAuditReason(reason string) string {
+	if utf8.RuneCountInString(reason) <= maxAuditReasonLen {
+		return reason
+	}
+	return string([]rune(reason)[:maxAuditReasonLen])
+}
+
+// 保存审核结果
+func (b *NoteAuditBiz) SaveAudit(ctx context.Context, audit *model.NoteAudit) error {
+	err := b.data.NoteAudit.Upsert(ctx, convert.NoteAuditAsDao(audit))
+	if err != nil {
+		return xerror.Wrapf(err, "note audit dao upsert failed").WithExtra("noteId", audit.NoteId).WithCtx(ctx)
+	}
+
+	return nil
+}
+
+// 获取笔记最近一次审核结果
+func (b *NoteAuditBiz) GetAudit(ctx context.Context, noteId int64) (*model.NoteAudit, error) {
+	audit, err := b.data.NoteAudit.GetById(ctx, noteId)
+	if err != nil {
+		if xsql.IsNoRecord(err) {
+			return nil, global.ErrNoteAuditNotFound
+		}
+		return nil, xerror.Wrapf(err, "note audit dao get failed").WithExtra("noteId", noteId).WithCtx(ctx)
+	}
+
+	return convert.NoteAuditFromDao(audit), nil
+}
+
+// 批量获取审核结果
+func (b *NoteAuditBiz) BatchGetAudit(ctx context.Context, noteIds []int64) (map[int64]*model.NoteAudit, error) {
+	audits, err := b.data.NoteAudit.BatchGetByIds(ctx, noteIds)
+	if err != nil && !xsql.IsNoRecord(err) {
+		return nil, xerror.Wrapf(err, "note audit dao batch get failed").WithCtx(ctx)
+	}
+
+	result := make(map[int64]*model.NoteAudit, len(audits))
+	for _, audit := range audits {
+		result[audit.NoteId] = convert.NoteAuditFromDao(audit)
+	}
+
+	return result, nil
+}
+
+// 人工复审 返回加锁查询后的笔记 只包含基础信息
+func (b *NoteAuditBiz) ResolveReview(ctx context.Context, req *ResolveReviewRequest) (*model.Note, error) {
+	note, err := b.data.Note.FindOneForUpdate(ctx, req.NoteId) // lock record for update
+	if errors.Is(err, xsql.ErrNoRecord) {
+		return nil, global.ErrNoteNotFound
+	}
+	if err != nil {
+		return nil, xerror.Wrapf(err, "biz find one note failed").WithExtra("req", req).WithCtx(ctx)
+	}
+
+	if !model.IsNoteStateConsideredAsReviewing(note.State) {
+		return nil, global.ErrNoteNotInReview
+	}
+
+	var (
+		decision = model.AuditDecisionReject
+		state    = model.NoteStateRejected
+	)
+	if req.Pass {
+		decision = model.AuditDecisionPass
+		state = model.NoteStateAuditPassed
+	}
+
+	err = b.data.Note.UpdateState(ctx, req.NoteId, state)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "note dao update state failed").WithExtra("req", req).WithCtx(ctx)
+	}
+
+	err = b.SaveAudit(ctx, &model.NoteAudit{
+		NoteId:   req.NoteId,
+		Decision: decision,
+		Reason:   req.Reason,
+		Operator: req.Operator,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := convert.NoteFromDao(note)
+	result.State = state
+
+	return result, nil
+}
+
+// 封禁已发布的笔记 返回加锁查询后的笔记 只包含基础信息
+func (b *NoteAuditBiz) BanNote(ctx context.Context, req *BanNoteRequest) (*model.Note, error) {
+	note, err := b.data.Note.FindOneForUpdate(ctx, req.NoteId) // lock record for update
+	if errors.Is(err, xsql.ErrNoRecord) {
+		return nil, global.ErrNoteNotFound
+	}
+	if err != nil {
+		return nil, xerror.Wrapf(err, "biz find one note failed").WithExtra("req", req).WithCtx(ctx)
+	}
+
+	if !model.IsNoteStateConsideredAsPublished(note.State) {
+		return nil, global.ErrNoteCannotBan
+	}
+
+	err = b.data.Note.UpgradeState(ctx, req.NoteId, model.NoteStateBanned)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "note dao update state failed").WithExtra("req", req).WithCtx(ctx)
+	}
+
+	err = b.SaveAudit(ctx, &model.NoteAudit{
+		NoteId:   req.NoteId,
+		Decision: model.AuditDecisionBan,
+		Reason:   req.Reason,
+		Operator: req.Operator,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := convert.NoteFromDao(note)
+	result.State = model.NoteStateBanned
+
+	return result, nil
+}
+
+// 列出人工复审队列
+func (b *NoteAuditBiz) ListReviewQueue(ctx context.Context, cursor int64, count int32) (*model.Notes, model.PageResult, error) {
+	nextPage := model.PageResult{}
+
+	if cursor == 0 {
+		cursor = model.MaxCursor
+	}
+
+	notes, err := b.data.Note.ListByCursor(ctx, cursor, count,
+		data.WithNoteStateIn(model.NoteStateAuditReviewing))
+	if errors.Is(err, xsql.ErrNoRecord) {
+		return &model.Notes{}, nextPage, nil
+	}
+	if err != nil {
+		return nil, nextPage, xerror.Wrapf(err, "biz note list review queue failed").
+			WithExtras("cursor", cursor, "count", count).
+			WithCtx(ctx)
+	}
+
+	if len(notes) > 0 {
+		nextPage.NextCursor = notes[len(notes)-1].Id
+		if len(notes) == int(count) {
+			nextPage.HasNext = true
+		}
+	}
+
+	notesResp, err := b.note.AssembleNotes(ctx, convert.NoteSliceFromDao(notes))
+	if err != nil {
+		return nil, nextPage, xerror.Wrapf(err, "biz note failed to assemble notes when list review queue").
+			WithExtras("cursor", cursor, "count", count).
+			WithCtx(ctx)
+	}
+	err = b.note.AssembleNotesExt(ctx, notesResp.Items)
+	if err != nil {
+		return nil, nextPage, xerror.Wrapf(err, "biz note assemble note ext failed").WithCtx(ctx)
+	}
+
+	return notesResp, nextPage, nil
+}
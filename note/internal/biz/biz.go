@@ -9,11 +9,14 @@ import (
 type Biz struct {
 	data *data.Data
 
-	Note      *NoteBiz
-	Interact  *NoteInteractBiz
-	Creator   *NoteCreatorBiz
-	Procedure *NoteProcedureBiz
-	NoteEvent *NoteEventBiz
+	Note       *NoteBiz
+	Interact   *NoteInteractBiz
+	Creator    *NoteCreatorBiz
+	Procedure  *NoteProcedureBiz
+	NoteEvent  *NoteEventBiz
+	Recommend  *NoteRecommendBiz
+	Audit      *NoteAuditBiz
+	Collection *NoteCollectionBiz
 }
 
 func New(dt *data.Data) *Biz {
@@ -22,14 +25,20 @@ func New(dt *data.Data) *Biz {
 	interact := NewNoteInteractBiz(dt, note)
 	procedure := NewNoteProcedureBiz(dt)
 	noteEvent := NewNoteEventBiz(dt)
+	recommend := NewNoteRecommendBiz(dt, note, interact)
+	audit := NewNoteAuditBiz(dt, note)
+	collection := NewNoteCollectionBiz(dt, note)
 
 	return &Biz{
-		data:      dt,
-		Note:      note,
-		Interact:  interact,
-		Creator:   creator,
-		Procedure: procedure,
-		NoteEvent: noteEvent,
+		data:       dt,
+		Note:       note,
+		Interact:   interact,
+		Creator:    creator,
+		Procedure:  procedure,
+		NoteEvent:  noteEvent,
+		Recommend:  recommend,
+		Audit:      audit,
+		Collection: collection,
 	}
 }
 
@@ -0,0 +1,454 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/misc/metadata"
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xslice"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+	"github.com/ryanreadbooks/whimer/note/internal/data"
+	"github.com/ryanreadbooks/whimer/note/internal/global"
+	notedao "github.com/ryanreadbooks/whimer/note/internal/infra/dao/note"
+	"github.com/ryanreadbooks/whimer/note/internal/model"
+)
+
+// 笔记合集
+//
+// 作者将自己的笔记按顺序组织成合集 一篇笔记只能属于一个合集;
+// 合集本身只区分公开和私有 合集中笔记的可见性仍然由笔记自己决定
+type NoteCollectionBiz struct {
+	data *data.Data
+	note *NoteBiz
+}
+
+func NewNoteCollectionBiz(dt *data.Data, note *NoteBiz) *NoteCollectionBiz {
+	return &NoteCollectionBiz{
+		data: dt,
+		note: note,
+	}
+}
+
+func collectionFromPO(po *notedao.CollectionPO) *model.Collection {
+	return &model.Collection{
+		Id:      po.Id,
+		Owner:   po.Owner,
+		Title:   po.Title,
+		Desc:    po.Desc,
+		Privacy: po.Privacy,
+		Cover:   po.Cover,
+		Ctime:   po.Ctime,
+		Utime:   po.Utime,
+	}
+}
+
+// 新建合集 需要在事务中调用
+//
+// 锁住用户已有的合集后再检查数量 避免并发新建时超过上限
+func (b *NoteCollectionBiz) CreateCollection(ctx context.Context, req *CollectionRequest) (int64, error) {
+	uid := metadata.Uid(ctx)
+
+	cnt, err := b.data.NoteCollection.CountByOwnerForUpdate(ctx, uid)
+	if err != nil {
+		return 0, xerror.Wrapf(err, "data count collection failed").WithExtra("uid", uid).WithCtx(ctx)
+	}
+	if cnt >= model.MaxUserCollections {
+		return 0, global.ErrCollectionLimit
+	}
+
+	now := time.Now().Unix()
+	id, err := b.data.NoteCollection.Insert(ctx, &notedao.CollectionPO{
+		Owner:   uid,
+		Title:   req.Title,
+		Desc:    req.Desc,
+		Privacy: req.Privacy,
+		Ctime:   now,
+		Utime:   now,
+	})
+	if err != nil {
+		return 0, xerror.Wrapf(err, "data insert collection failed").WithExtra("req", req).WithCtx(ctx)
+	}
+
+	return id, nil
+}
+
+// 获取合集并加锁 只有作者可以操作
+func (b *NoteCollectionBiz) getOwnedCollectionForUpdate(ctx context.Context, id int64) (*notedao.CollectionPO, error) {
+	po, err := b.data.NoteCollection.GetByIdForUpdate(ctx, id)
+	if err != nil {
+		if xsql.IsNoRecord(err) {
+			return nil, global.ErrCollectionNotFound
+		}
+		return nil, xerror.Wrapf(err, "data get collection failed").WithExtra("collectionId", id).WithCtx(ctx)
+	}
+
+	if po.Owner != metadata.Uid(ctx) {
+		return nil, global.ErrNotCollectionOwner
+	}
+
+	return po, nil
+}
+
+func (b *NoteCollectionBiz) listCollectionNoteIds(ctx context.Context, id int64) ([]int64, error) {
+	items, err := b.data.NoteCollection.ListItems(ctx, id)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "data list collection items failed").WithExtra("collectionId", id).WithCtx(ctx)
+	}
+
+	noteIds := make([]int64, 0, len(items))
+	for _, item := range items {
+		noteIds = append(noteIds, item.NoteId)
+	}
+
+	return noteIds, nil
+}
+
+// 修改合集信息 需要在事务中调用
+func (b *NoteCollectionBiz) UpdateCollection(ctx context.Context, req *CollectionRequest) error {
+	po, err := b.getOwnedCollectionForUpdate(ctx, req.CollectionId)
+	if err != nil {
+		return err
+	}
+
+	noteIds, err := b.listCollectionNoteIds(ctx, po.Id)
+	if err != nil {
+		return err
+	}
+
+	cover := req.Cover
+	if cover == 0 {
+		if len(noteIds) > 0 {
+			cover = noteIds[0]
+		}
+	} else if !slices.Contains(noteIds, cover) {
+		return global.ErrCollectionCoverInvalid
+	}
+
+	po.Title = req.Title
+	po.Desc = req.Desc
+	po.Privacy = req.Privacy
+	po.Cover = cover
+	po.Utime = time.Now().Unix()
+	err = b.data.NoteCollection.Update(ctx, po)
+	if err != nil {
+		return xerror.Wrapf(err, "data update collection failed").WithExtra("req", req).WithCtx(ctx)
+	}
+
+	return nil
+}
+
+// 删除合集 合集中的笔记不受影响 需要在事务中调用
+func (b *NoteCollectionBiz) DeleteCollection(ctx context.Context, id int64) error {
+	_, err := b.getOwnedCollectionForUpdate(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = b.data.NoteCollection.Delete(ctx, id)
+	if err != nil {
+		return xerror.Wrapf(err, "data delete collection failed").WithExtra("collectionId", id).WithCtx(ctx)
+	}
+
+	return nil
+}
+
+// 获取浏览者可见的合集 包含按顺序排列的全部笔记
+func (b *NoteCollectionBiz) GetCollection(ctx context.Context, id, viewer int64) (*model.Collection, error) {
+	po, err := b.data.NoteCollection.GetById(ctx, id)
+	if err != nil {
+		if xsql.IsNoRecord(err) {
+			return nil, global.ErrCollectionNotFound
+		}
+		return nil, xerror.Wrapf(err, "data get collection failed").WithExtra("collectionId", id).WithCtx(ctx)
+	}
+
+	collection := collectionFromPO(po)
+	if !collection.IsVisibleTo(viewer) {
+		return nil, global.ErrCollectionNotFound
+	}
+
+	collection.NoteIds, err = b.listCollectionNoteIds(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	collection.NoteCount = int64(len(collection.NoteIds))
+
+	return collection, nil
+}
+
+// 列出用户对浏览者可见的合集
+func (b *NoteCollectionBiz) ListCollections(ctx context.Context, owner, viewer int64) ([]*model.Collection, error) {
+	pos, err := b.data.NoteCollection.ListByOwner(ctx, owner)
+	if err != nil {
+		if xsql.IsNoRecord(err) {
+			return []*model.Collection{}, nil
+		}
+		return nil, xerror.Wrapf(err, "data list collection failed").WithExtra("owner", owner).WithCtx(ctx)
+	}
+
+	collections := make([]*model.Collection, 0, len(pos))
+	ids := make([]int64, 0, len(pos))
+	for _, po := range pos {
+		collection := collectionFromPO(po)
+		if !collection.IsVisibleTo(viewer) {
+			continue
+		}
+		collections = append(collections, collection)
+		ids = append(ids, collection.Id)
+	}
+
+	counts, err := b.data.NoteCollection.BatchCountItems(ctx, ids)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "data count collection items failed").WithExtra("owner", owner).WithCtx(ctx)
+	}
+	for _, collection := range collections {
+		collection.NoteCount = counts[collection.Id]
+	}
+
+	return collections, nil
+}
+
+// 检查用户是否拥有全部笔记 和IsUserOwnNote的判断保持一致 回收站中的笔记视为不存在
+func (b *NoteCollectionBiz) checkUserOwnNotes(ctx context.Context, uid int64, noteIds []int64) error {
+	notes, err := b.note.BatchGetNoteWithoutAsset(ctx, noteIds)
+	if err != nil {
+		return xerror.Wrapf(err, "biz batch get note failed").WithCtx(ctx)
+	}
+
+	for _, noteId := range noteIds {
+		note, ok := notes[noteId]
+		if !ok || model.IsNoteStateConsideredAsRecycled(note.State) {
+			return global.ErrNoteNotFound
+		}
+		if note.Owner != uid {
+			return global.ErrNotNoteOwner
+		}
+	}
+
+	return nil
+}
+
+// 按顺序将笔记追加到合集末尾 已经在合集中的笔记忽略 需要在事务中调用
+func (b *NoteCollectionBiz) AddNotes(ctx context.Context, id int64, noteIds []int64) error {
+	po, err := b.getOwnedCollectionForUpdate(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	noteIds = xslice.Uniq(noteIds)
+	err = b.checkUserOwnNotes(ctx, po.Owner, noteIds)
+	if err != nil {
+		return err
+	}
+
+	existing, err := b.data.NoteCollection.BatchGetItemByNoteIds(ctx, noteIds)
+	if err != nil {
+		return xerror.Wrapf(err, "data batch get collection items failed").WithCtx(ctx)
+	}
+
+	items, err := b.data.NoteCollection.ListItems(ctx, id)
+	if err != nil {
+		return xerror.Wrapf(err, "data list collection items failed").WithExtra("collectionId", id).WithCtx(ctx)
+	}
+
+	var lastSeq int64
+	if len(items) > 0 {
+		lastSeq = items[len(items)-1].Seq
+	}
+
+	now := time.Now().Unix()
+	newItems := make([]*notedao.CollectionItemPO, 0, len(noteIds))
+	for _, noteId := range noteIds {
+		if item, ok := existing[noteId]; ok {
+			if item.CollectionId != id {
+				return global.ErrNoteInOtherCollection.Msgf("笔记%d已属于其它合集", noteId)
+			}
+			continue
+		}
+
+		lastSeq++
+		newItems = append(newItems, &notedao.CollectionItemPO{
+			NoteId:       noteId,
+			CollectionId: id,
+			Seq:          lastSeq,
+			Ctime:        now,
+		})
+	}
+	if len(newItems) == 0 {
+		return nil
+	}
+
+	if len(items)+len(newItems) > model.MaxCollectionNotes {
+		return global.ErrCollectionFull
+	}
+
+	err = b.data.NoteCollection.AddItems(ctx, newItems)
+	if err != nil {
+		return xerror.Wrapf(err, "data add collection items failed").WithExtra("collectionId", id).WithCtx(ctx)
+	}
+
+	// 空合集默认使用第一篇笔记作为封面
+	if po.Cover == 0 {
+		err = b.data.NoteCollection.UpdateCover(ctx, id, newItems[0].NoteId)
+		if err != nil {
+			return xerror.Wrapf(err, "data update collection cover failed").WithExtra("collectionId", id).WithCtx(ctx)
+		}
+	}
+
+	return nil
+}
+
+// 从合集中移除笔记 移除的笔记是封面时使用剩余的第一篇笔记作为封面 需要在事务中调用
+func (b *NoteCollectionBiz) RemoveNotes(ctx context.Context, id int64, noteIds []int64) error {
+	po, err := b.getOwnedCollectionForUpdate(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	noteIds = xslice.Uniq(noteIds)
+	err = b.data.NoteCollection.RemoveItems(ctx, id, noteIds)
+	if err != nil {
+		return xerror.Wrapf(err, "data remove collection items failed").WithExtra("collectionId", id).WithCtx(ctx)
+	}
+
+	if !slices.Contains(noteIds, po.Cover) {
+		return nil
+	}
+
+	remains, err := b.listCollectionNoteIds(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	var cover int64
+	if len(remains) > 0 {
+		cover = remains[0]
+	}
+	err = b.data.NoteCollection.UpdateCover(ctx, id, cover)
+	if err != nil {
+		return xerror.Wrapf(err, "data update collection cover failed").WithExtra("collectionId", id).WithCtx(ctx)
+	}
+
+	return nil
+}
+
+// 按照noteIds的顺序重排合集 noteIds需要和合集中的笔记完全一致 需要在事务中调用
+func (b *NoteCollectionBiz) SortNotes(ctx context.Context, id int64, noteIds []int64) error {
+	_, err := b.getOwnedCollectionForUpdate(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	current, err := b.listCollectionNoteIds(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if len(xslice.Uniq(noteIds)) != len(noteIds) || len(noteIds) != len(current) {
+		return global.ErrCollectionSortInvalid
+	}
+	for _, noteId := range noteIds {
+		if !slices.Contains(current, noteId) {
+			return global.ErrCollectionSortInvalid
+		}
+	}
+
+	for idx, noteId := range noteIds {
+		err = b.data.NoteCollection.UpdateItemSeq(ctx, id, noteId, int64(idx+1))
+		if err != nil {
+			return xerror.Wrapf(err, "data update collection item seq failed").
+				WithExtras("collectionId", id, "noteId", noteId).WithCtx(ctx)
+		}
+	}
+
+	return nil
+}
+
+// 过滤出合集中已发布的公开笔记 保持原有顺序
+func (b *NoteCollectionBiz) filterPublicNoteIds(ctx context.Context, noteIds []int64) ([]int64, map[int64]*model.Note, error) {
+	notes, err := b.note.BatchGetNoteWithoutAsset(ctx, noteIds)
+	if err != nil {
+		return nil, nil, xerror.Wrapf(err, "biz batch get note failed").WithCtx(ctx)
+	}
+
+	publicIds := make([]int64, 0, len(noteIds))
+	for _, noteId := range noteIds {
+		note, ok := notes[noteId]
+		if ok && note.State == model.NoteStatePublished && note.IsPublic() {
+			publicIds = append(publicIds, noteId)
+		}
+	}
+
+	return publicIds, notes, nil
+}
+
+// 按顺序分页列出合集中已发布的公开笔记 cursor为上一页最后一篇笔记在合集中的位置
+func (b *NoteCollectionBiz) ListPublicNotes(ctx context.Context, id, viewer int64, cursor int64, count int32) (
+	*model.Collection, *model.Notes, model.PageResult, error,
+) {
+	nextPage := model.PageResult{}
+	collection, err := b.GetCollection(ctx, id, viewer)
+	if err != nil {
+		return nil, nil, nextPage, err
+	}
+
+	publicIds, notes, err := b.filterPublicNoteIds(ctx, collection.NoteIds)
+	if err != nil {
+		return nil, nil, nextPage, err
+	}
+
+	start := min(max(int(cursor), 0), len(publicIds))
+	end := min(start+int(count), len(publicIds))
+	if end < len(publicIds) {
+		nextPage.HasNext = true
+		nextPage.NextCursor = int64(end)
+	}
+
+	pageNotes := make([]*model.Note, 0, end-start)
+	for _, noteId := range publicIds[start:end] {
+		pageNotes = append(pageNotes, notes[noteId])
+	}
+
+	result, err := b.note.AssembleNotes(ctx, pageNotes)
+	if err != nil {
+		return nil, nil, nextPage, xerror.Wrapf(err, "biz assemble notes failed when list collection").
+			WithExtras("collectionId", id, "cursor", cursor).WithCtx(ctx)
+	}
+
+	return collection, result, nextPage, nil
+}
+
+// 填充笔记所属的合集以及前后笔记
+//
+// 作者以外的浏览者看不到私有合集 前后笔记也只在已发布的公开笔记中查找
+func (b *NoteCollectionBiz) AssignNoteCollection(ctx context.Context, viewer int64, note *model.Note) error {
+	item, err := b.data.NoteCollection.GetItemByNoteId(ctx, note.NoteId)
+	if err != nil {
+		if xsql.IsNoRecord(err) {
+			return nil
+		}
+		return xerror.Wrapf(err, "data get collection item failed").WithExtra("noteId", note.NoteId).WithCtx(ctx)
+	}
+
+	collection, err := b.GetCollection(ctx, item.CollectionId, viewer)
+	if err != nil {
+		if errors.Is(err, global.ErrCollectionNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	noteIds := collection.NoteIds
+	if viewer != collection.Owner {
+		noteIds, _, err = b.filterPublicNoteIds(ctx, noteIds)
+		if err != nil {
+			return err
+		}
+	}
+
+	note.Collection = model.NewCollectionLink(collection, noteIds, note.NoteId)
+	return nil
+}
@@ -39,7 +39,7 @@ func isNoteExtValid(ext *notedao.ExtPO) bool {
 		return false
 	}
 
-	if ext.Tags != "" || len(ext.AtUsers) > 0 {
+	if ext.Tags != "" || len(ext.AtUsers) > 0 || ext.Audience != "" {
 		return true
 	}
 
@@ -111,13 +111,19 @@ func (b *NoteCreatorBiz) CreateNote(ctx context.Context, req *CreateNoteRequest)
 		noteId int64
 	)
 
+	// 草稿不进入发布流程
+	state := model.NoteStateInit
+	if req.Draft {
+		state = model.NoteStateDraft
+	}
+
 	now := time.Now().Unix()
 	newNotePO := &notedao.NotePO{
 		Title:    req.Basic.Title,
 		Desc:     req.Basic.Desc,
 		Privacy:  req.Basic.Privacy,
 		NoteType: req.Basic.NoteType,
-		State:    model.NoteStateInit,
+		State:    state,
 		Owner:    uid,
 		Ip:       ip,
 		CreateAt: now,
@@ -125,6 +131,7 @@ func (b *NoteCreatorBiz) CreateNote(ctx context.Context, req *CreateNoteRequest)
 	}
 
 	newNote := convert.NoteFromDao(newNotePO)
+	newNote.Audience = req.Basic.Audience
 	noteAssetsPO := assignNoteAssets(newNote, req)
 
 	// begin tx
@@ -153,6 +160,13 @@ func (b *NoteCreatorBiz) CreateNote(ctx context.Context, req *CreateNoteRequest)
 			return xerror.Wrapf(errTx, "note ext insert tx failed")
 		}
 
+		// 草稿和定时发布需要保存额外信息
+		if req.Draft || req.PublishAt > 0 {
+			if errTx = b.saveNoteDraft(ctx, noteId, req); errTx != nil {
+				return xerror.Wrapf(errTx, "note draft insert tx failed")
+			}
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -168,9 +182,10 @@ func (b *NoteCreatorBiz) CreateNote(ctx context.Context, req *CreateNoteRequest)
 // 保存笔记扩展信息 包括扩展信息和at人等额外信息
 func (b *NoteCreatorBiz) upsertNoteExt(ctx context.Context, noteId int64, req *CreateNoteRequest) error {
 	ext := &notedao.ExtPO{
-		NoteId:  noteId,
-		Tags:    xslice.JoinInts(req.TagIds),
-		AtUsers: json.RawMessage{},
+		NoteId:   noteId,
+		Tags:     xslice.JoinInts(req.TagIds),
+		AtUsers:  json.RawMessage{},
+		Audience: xslice.JoinInts(req.Basic.Audience),
 	}
 	if len(req.AtUsers) > 0 {
 		if data, err := json.Marshal(req.AtUsers); err == nil {
@@ -210,6 +225,11 @@ func (b *NoteCreatorBiz) UpdateNote(ctx context.Context, req *UpdateNoteRequest)
 		return nil, nil, global.ErrNotNoteOwner
 	}
 
+	// 回收站中的笔记需要先恢复
+	if model.IsNoteStateConsideredAsRecycled(oldNote.State) {
+		return nil, nil, global.ErrNoteNotFound
+	}
+
 	newNotePO := &notedao.NotePO{
 		Id:       noteId,
 		Title:    req.Basic.Title,
@@ -222,7 +242,14 @@ func (b *NoteCreatorBiz) UpdateNote(ctx context.Context, req *UpdateNoteRequest)
 		Owner:    oldNote.Owner,
 		Ip:       ip,
 	}
+	// 更新前的内容 用于生成修订版本
+	oldContent, err := b.loadRevisionContent(ctx, oldNote)
+	if err != nil {
+		return nil, nil, xerror.Wrapf(err, "biz load note revision content failed")
+	}
+
 	newNote := convert.NoteFromDao(newNotePO)
+	newNote.Audience = req.Basic.Audience
 	assetUpdated, err := b.handleNoteAssets(ctx, newNote, &req.CreateNoteRequest, req.Video != nil)
 	if err != nil {
 		return nil, nil, xerror.Wrapf(err, "biz update note assets failed")
@@ -233,6 +260,18 @@ func (b *NoteCreatorBiz) UpdateNote(ctx context.Context, req *UpdateNoteRequest)
 		newNotePO.State = model.NoteStateAuditing
 	}
 
+	// 草稿和定时发布的笔记更新后保持原状态 发布时再走完整流程
+	if model.IsNoteStateWaitingPublish(oldNote.State) {
+		newNote.State = oldNote.State
+		newNotePO.State = oldNote.State
+		if assetUpdated && newNote.Type == model.AssetTypeVideo && req.Video.FileId != "" {
+			err = b.updateNoteDraftVideo(ctx, noteId, req.Video.FileId)
+			if err != nil {
+				return nil, nil, xerror.Wrapf(err, "note draft dao update video tx failed")
+			}
+		}
+	}
+
 	// 更新基础信息
 	err = b.data.Note.Update(ctx, newNotePO)
 	if err != nil {
@@ -244,6 +283,10 @@ func (b *NoteCreatorBiz) UpdateNote(ctx context.Context, req *UpdateNoteRequest)
 		return nil, nil, xerror.Wrapf(err, "noteext dao upsert tx failed when updating note")
 	}
 
+	if err = b.saveNoteRevision(ctx, oldNote, oldContent, newNotePO, &req.CreateNoteRequest); err != nil {
+		return nil, nil, xerror.Wrapf(err, "biz save note revision failed when updating note")
+	}
+
 	return newNote, convert.NoteCoreFromDao(oldNote), nil
 }
 
@@ -338,42 +381,6 @@ func (b *NoteCreatorBiz) getOldNoteAssets(
 	}
 }
 
-func (b *NoteCreatorBiz) DeleteNote(ctx context.Context, req *DeleteNoteRequest) (*model.NoteCore, error) {
-	var (
-		uid    int64 = metadata.Uid(ctx)
-		noteId       = req.NoteId
-	)
-
-	queried, err := b.data.Note.FindOneForUpdate(ctx, noteId) // lock record here
-	if errors.Is(err, xsql.ErrNoRecord) {
-		return nil, global.ErrNoteNotFound
-	}
-	if err != nil {
-		return nil, xerror.Wrapf(err, "repo find one note failed").WithExtra("req", req).WithCtx(ctx)
-	}
-
-	if uid != queried.Owner {
-		return nil, global.ErrNotNoteOwner
-	}
-
-	err = b.data.Note.DeleteNote(ctx, queried)
-	if err != nil {
-		return nil, xerror.Wrapf(err, "data delete note failed")
-	}
-
-	err = b.data.NoteAsset.DeleteByNoteId(ctx, noteId)
-	if err != nil {
-		return nil, xerror.Wrapf(err, "data delete note asset failed")
-	}
-
-	err = b.data.NoteExt.Delete(ctx, noteId)
-	if err != nil {
-		return nil, xerror.Wrapf(err, "data delete note ext failed")
-	}
-
-	return convert.NoteCoreFromDao(queried), nil
-}
-
 func (b *NoteCreatorBiz) CreatorGetNote(ctx context.Context, noteId int64) (*model.Note, error) {
 	var (
 		uid = metadata.Uid(ctx)
@@ -392,6 +399,10 @@ func (b *NoteCreatorBiz) CreatorGetNote(ctx context.Context, noteId int64) (*mod
 		return nil, global.ErrNotNoteOwner
 	}
 
+	if model.IsNoteStateConsideredAsRecycled(note.State) {
+		return nil, global.ErrNoteNotFound
+	}
+
 	res, err := b.note.AssembleNotes(ctx, convert.NoteFromDao(note).AsSlice())
 	if err != nil || len(res.Items) == 0 {
 		return nil, xerror.Wrapf(err, "assemble notes failed").WithExtra("noteId", noteId).WithCtx(ctx)
@@ -408,7 +419,9 @@ func (b *NoteCreatorBiz) CreatorGetNote(ctx context.Context, noteId int64) (*mod
 func (b *NoteCreatorBiz) ListNote(ctx context.Context) (*model.Notes, error) {
 	uid := metadata.Uid(ctx)
 
-	notes, err := b.data.Note.List(ctx, data.WithNoteOwnerEqual(uid))
+	notes, err := b.data.Note.List(ctx,
+		data.WithNoteOwnerEqual(uid),
+		data.WithNoteStateNotIn(model.LifeCycleNoteRecycled()...))
 	if errors.Is(err, xsql.ErrNoRecord) {
 		return &model.Notes{}, nil
 	}
@@ -438,7 +451,9 @@ func (b *NoteCreatorBiz) PageListNoteWithCursor(ctx context.Context, cursor int6
 	if cursor == 0 {
 		cursor = model.MaxCursor
 	}
-	notes, err := b.data.Note.ListByCursor(ctx, cursor, count, data.WithNoteOwnerEqual(uid))
+	notes, err := b.data.Note.ListByCursor(ctx, cursor, count,
+		data.WithNoteOwnerEqual(uid),
+		data.WithNoteStateNotIn(model.LifeCycleNoteRecycled()...))
 	if errors.Is(err, xsql.ErrNoRecord) {
 		return &model.Notes{}, nextPage, nil
 	}
@@ -495,6 +510,10 @@ func (b *NoteCreatorBiz) PageListNote(
 		notes, total, err = b.pageListRejectedNote(ctx, uid, page, count)
 	case notev1.NoteLifeCycleState_LIFE_CYCLE_STATE_BANNED:
 		notes, total, err = b.pageListBannedNote(ctx, uid, page, count)
+	case notev1.NoteLifeCycleState_LIFE_CYCLE_STATE_DRAFT:
+		notes, total, err = b.pageListNoteWithStates(ctx, uid, page, count, model.LifeCycleNoteDraft())
+	case notev1.NoteLifeCycleState_LIFE_CYCLE_STATE_SCHEDULED:
+		notes, total, err = b.pageListNoteWithStates(ctx, uid, page, count, model.LifeCycleNoteScheduled())
 	default:
 		return nil, 0, global.ErrArgs.Msg("invalid life cycle state")
 	}
@@ -601,6 +620,25 @@ func (b *NoteCreatorBiz) pageListBannedNote(
 	return notes, total, nil
 }
 
+// 草稿和定时发布
+func (b *NoteCreatorBiz) pageListNoteWithStates(
+	ctx context.Context, uid int64, page, count int32, states []model.NoteState,
+) ([]*notedao.NotePO, int64, error) {
+	total, err := b.data.Note.GetCountWithStateByOwner(ctx, uid, states...)
+	if err != nil {
+		return nil, 0, xerror.Wrapf(err, "biz note count by owner failed").WithCtx(ctx)
+	}
+
+	notes, err := b.data.Note.ListByPage(ctx, page, count,
+		data.WithNoteOwnerEqual(uid),
+		data.WithNoteStateIn(states...))
+	if err != nil {
+		return nil, 0, xerror.Wrapf(err, "biz note page list failed").WithCtx(ctx)
+	}
+
+	return notes, total, nil
+}
+
 // 新增笔记标签
 func (b *NoteCreatorBiz) AddTag(ctx context.Context, name string) (int64, error) {
 	id, err := b.data.Tag.Create(ctx, &tagdao.Tag{Name: name})
@@ -689,6 +727,11 @@ func (b *NoteCreatorBiz) TransferNoteStateToRejected(ctx context.Context, note *
 	return b.upgradeNoteState(ctx, note.NoteId, model.NoteStateRejected)
 }
 
+// 设置笔记状态为人工复审中
+func (b *NoteCreatorBiz) TransferNoteStateToAuditReviewing(ctx context.Context, note *model.Note) error {
+	return b.upgradeNoteState(ctx, note.NoteId, model.NoteStateAuditReviewing)
+}
+
 // 设置笔记状态为被封禁
 func (b *NoteCreatorBiz) TransferNoteStateToBanned(ctx context.Context, note *model.Note) error {
 	return b.upgradeNoteState(ctx, note.NoteId, model.NoteStateBanned)
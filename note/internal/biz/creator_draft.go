@@ -0,0 +1,193 @@
+package biz
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ryanreadbooks/whimer/misc/metadata"
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+	"github.com/ryanreadbooks/whimer/note/internal/data"
+	"github.com/ryanreadbooks/whimer/note/internal/global"
+	notedao "github.com/ryanreadbooks/whimer/note/internal/infra/dao/note"
+	"github.com/ryanreadbooks/whimer/note/internal/model"
+	"github.com/ryanreadbooks/whimer/note/internal/model/convert"
+)
+
+// 草稿和定时发布
+//
+// 草稿和定时发布的笔记在进入发布流程前需要额外保存定时发布时间和原始视频fileId,
+// 这部分信息在笔记进入资源处理流程时被取出并删除
+
+// 保存草稿信息
+func (b *NoteCreatorBiz) saveNoteDraft(ctx context.Context, noteId int64, req *CreateNoteRequest) error {
+	draft := &notedao.DraftPO{
+		NoteId:    noteId,
+		PublishAt: req.PublishAt,
+	}
+	if req.Basic.NoteType == model.AssetTypeVideo && req.Video != nil {
+		draft.VideoRawUrl = req.Video.FileId
+	}
+
+	return b.data.NoteDraft.Upsert(ctx, draft)
+}
+
+// 草稿更新了视频资源后需要同步更新原始视频fileId
+func (b *NoteCreatorBiz) updateNoteDraftVideo(ctx context.Context, noteId int64, rawUrl string) error {
+	draft, err := b.data.NoteDraft.GetById(ctx, noteId)
+	if err != nil {
+		if !xsql.IsNoRecord(err) {
+			return err
+		}
+		draft = &notedao.DraftPO{NoteId: noteId}
+	}
+
+	draft.VideoRawUrl = rawUrl
+	return b.data.NoteDraft.Upsert(ctx, draft)
+}
+
+// 获取笔记的定时发布时间 不存在时返回0
+func (b *NoteCreatorBiz) GetDraftPublishAt(ctx context.Context, noteId int64) (int64, error) {
+	draft, err := b.data.NoteDraft.GetById(ctx, noteId)
+	if err != nil {
+		if xsql.IsNoRecord(err) {
+			return 0, nil
+		}
+		return 0, xerror.Wrapf(err, "note draft dao get failed").WithExtra("noteId", noteId).WithCtx(ctx)
+	}
+
+	return draft.PublishAt, nil
+}
+
+// 从草稿信息中恢复视频笔记的视频资源 用于进入资源处理流程
+func (b *NoteCreatorBiz) LoadDraftVideo(ctx context.Context, note *model.Note) error {
+	draft, err := b.data.NoteDraft.GetById(ctx, note.NoteId)
+	if err != nil {
+		if xsql.IsNoRecord(err) {
+			return global.ErrVideoNoteAssetNotExist
+		}
+		return xerror.Wrapf(err, "note draft dao get failed").WithExtra("noteId", note.NoteId).WithCtx(ctx)
+	}
+
+	assets, err := b.data.NoteAsset.FindVideoNoteAssets(ctx, note.NoteId)
+	if err != nil && !errors.Is(err, xsql.ErrNoRecord) {
+		return xerror.Wrapf(err, "noteasset dao find video assets failed").WithExtra("noteId", note.NoteId).WithCtx(ctx)
+	}
+
+	video := &model.NoteVideo{}
+	for _, asset := range assets {
+		if asset.AssetType != model.AssetTypeVideo {
+			continue
+		}
+		video.Items = append(video.Items, &model.NoteVideoItem{
+			Key:   asset.AssetKey,
+			Media: &model.NoteVideoMedia{},
+		})
+	}
+	if draft.VideoRawUrl == "" || len(video.Items) == 0 {
+		return global.ErrVideoNoteAssetNotExist
+	}
+
+	video.SetRawUrl(draft.VideoRawUrl)
+	note.Videos = video
+
+	return nil
+}
+
+// 删除草稿信息
+func (b *NoteCreatorBiz) DeleteDraft(ctx context.Context, noteId int64) error {
+	err := b.data.NoteDraft.Delete(ctx, noteId)
+	if err != nil {
+		return xerror.Wrapf(err, "note draft dao delete failed").WithExtra("noteId", noteId).WithCtx(ctx)
+	}
+
+	return nil
+}
+
+// 发布草稿 笔记需要是草稿或者等待定时发布的状态
+//
+// 返回的笔记为加锁查询后的笔记 只包含基础信息
+func (b *NoteCreatorBiz) PublishDraft(ctx context.Context, req *PublishDraftRequest) (*model.Note, error) {
+	uid := metadata.Uid(ctx)
+
+	note, err := b.data.Note.FindOneForUpdate(ctx, req.NoteId) // lock record for update
+	if errors.Is(err, xsql.ErrNoRecord) {
+		return nil, global.ErrNoteNotFound
+	}
+	if err != nil {
+		return nil, xerror.Wrapf(err, "biz find one note failed").WithExtra("req", req).WithCtx(ctx)
+	}
+
+	if uid != note.Owner {
+		return nil, global.ErrNotNoteOwner
+	}
+
+	if !model.IsNoteStateWaitingPublish(note.State) {
+		return nil, global.ErrNoteNotDraft
+	}
+
+	err = b.data.NoteDraft.UpdatePublishAt(ctx, req.NoteId, req.PublishAt)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "note draft dao update publish at failed").WithExtra("req", req).WithCtx(ctx)
+	}
+
+	return convert.NoteFromDao(note), nil
+}
+
+// 列出草稿
+func (b *NoteCreatorBiz) ListDrafts(ctx context.Context, cursor int64, count int32) (*model.Notes, model.PageResult, error) {
+	var (
+		uid      = metadata.Uid(ctx)
+		nextPage = model.PageResult{}
+	)
+
+	if cursor == 0 {
+		cursor = model.MaxCursor
+	}
+	notes, err := b.data.Note.ListByCursor(ctx, cursor, count,
+		data.WithNoteOwnerEqual(uid),
+		data.WithNoteStateIn(model.LifeCycleNoteDraft()...))
+	if errors.Is(err, xsql.ErrNoRecord) {
+		return &model.Notes{}, nextPage, nil
+	}
+	if err != nil {
+		return nil, nextPage, xerror.Wrapf(err, "biz note list drafts failed").
+			WithExtras("cursor", cursor, "count", count).
+			WithCtx(ctx)
+	}
+
+	if len(notes) > 0 {
+		nextPage.NextCursor = notes[len(notes)-1].Id
+		if len(notes) == int(count) {
+			nextPage.HasNext = true
+		}
+	}
+
+	notesResp, err := b.note.AssembleNotes(ctx, convert.NoteSliceFromDao(notes))
+	if err != nil {
+		return nil, nextPage, xerror.Wrapf(err, "biz note failed to assemble notes when list drafts").
+			WithExtras("cursor", cursor, "count", count).
+			WithCtx(ctx)
+	}
+	err = b.note.AssembleNotesExt(ctx, notesResp.Items)
+	if err != nil {
+		return nil, nextPage, xerror.Wrapf(err, "biz note assemble note ext failed").WithCtx(ctx)
+	}
+
+	return notesResp, nextPage, nil
+}
+
+// 设置笔记状态为等待定时发布
+func (b *NoteCreatorBiz) TransferNoteStateToScheduled(ctx context.Context, note *model.Note) error {
+	return b.upgradeNoteState(ctx, note.NoteId, model.NoteStateScheduled)
+}
+
+// 定时发布失败时将笔记退回草稿
+func (b *NoteCreatorBiz) TransferNoteStateToDraft(ctx context.Context, note *model.Note) error {
+	err := b.data.Note.UpdateState(ctx, note.NoteId, model.NoteStateDraft)
+	if err != nil {
+		return xerror.Wrapf(err, "note dao update state failed").WithExtra("noteId", note.NoteId).WithCtx(ctx)
+	}
+
+	return nil
+}
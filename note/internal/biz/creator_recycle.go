@@ -0,0 +1,277 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/misc/metadata"
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+	"github.com/ryanreadbooks/whimer/note/internal/config"
+	"github.com/ryanreadbooks/whimer/note/internal/data"
+	"github.com/ryanreadbooks/whimer/note/internal/global"
+	notedao "github.com/ryanreadbooks/whimer/note/internal/infra/dao/note"
+	"github.com/ryanreadbooks/whimer/note/internal/model"
+	"github.com/ryanreadbooks/whimer/note/internal/model/convert"
+)
+
+// 回收站
+//
+// 删除笔记时只将笔记状态设置为回收站状态 保留资源和扩展信息等数据;
+// 在保留期内可以恢复 超过保留期后由后台任务彻底删除
+
+// 删除笔记 放入回收站
+func (b *NoteCreatorBiz) DeleteNote(ctx context.Context, req *DeleteNoteRequest) (*model.NoteCore, error) {
+	var (
+		uid    int64 = metadata.Uid(ctx)
+		noteId       = req.NoteId
+	)
+
+	queried, err := b.data.Note.FindOneForUpdate(ctx, noteId) // lock record here
+	if errors.Is(err, xsql.ErrNoRecord) {
+		return nil, global.ErrNoteNotFound
+	}
+	if err != nil {
+		return nil, xerror.Wrapf(err, "repo find one note failed").WithExtra("req", req).WithCtx(ctx)
+	}
+
+	if uid != queried.Owner {
+		return nil, global.ErrNotNoteOwner
+	}
+
+	if model.IsNoteStateConsideredAsRecycled(queried.State) {
+		return nil, global.ErrNoteNotFound
+	}
+
+	err = b.data.Note.UpdateState(ctx, noteId, model.NoteStateRecycled)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "data update note state failed")
+	}
+
+	now := time.Now()
+	err = b.data.NoteRecycle.Upsert(ctx, &notedao.RecyclePO{
+		NoteId:    noteId,
+		PrevState: queried.State,
+		DeleteAt:  now.Unix(),
+		ExpireAt:  now.Add(config.Conf.Recycle.Retention).Unix(),
+	})
+	if err != nil {
+		return nil, xerror.Wrapf(err, "data insert note recycle failed")
+	}
+
+	return convert.NoteCoreFromDao(queried), nil
+}
+
+// 从回收站中恢复笔记
+//
+// 返回恢复后的笔记 只包含基础信息
+func (b *NoteCreatorBiz) RestoreNote(ctx context.Context, noteId int64) (*model.Note, error) {
+	uid := metadata.Uid(ctx)
+
+	queried, err := b.data.Note.FindOneForUpdate(ctx, noteId) // lock record here
+	if errors.Is(err, xsql.ErrNoRecord) {
+		return nil, global.ErrNoteNotFound
+	}
+	if err != nil {
+		return nil, xerror.Wrapf(err, "repo find one note failed").WithExtra("noteId", noteId).WithCtx(ctx)
+	}
+
+	if uid != queried.Owner {
+		return nil, global.ErrNotNoteOwner
+	}
+
+	if !model.IsNoteStateConsideredAsRecycled(queried.State) {
+		return nil, global.ErrNoteNotRecycled
+	}
+
+	recycled, err := b.data.NoteRecycle.GetById(ctx, noteId)
+	if err != nil {
+		if xsql.IsNoRecord(err) {
+			return nil, global.ErrNoteNotRecycled
+		}
+		return nil, xerror.Wrapf(err, "data get note recycle failed").WithExtra("noteId", noteId).WithCtx(ctx)
+	}
+
+	// 过期的笔记等待彻底删除
+	if recycled.ExpireAt <= time.Now().Unix() {
+		return nil, global.ErrNoteRecycleExpired
+	}
+
+	state := model.RestoredNoteState(recycled.PrevState)
+	err = b.data.Note.UpdateState(ctx, noteId, state)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "data update note state failed").WithExtra("noteId", noteId).WithCtx(ctx)
+	}
+
+	err = b.data.NoteRecycle.Delete(ctx, noteId)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "data delete note recycle failed").WithExtra("noteId", noteId).WithCtx(ctx)
+	}
+
+	queried.State = state
+	return convert.NoteFromDao(queried), nil
+}
+
+// 列出回收站中的笔记
+func (b *NoteCreatorBiz) ListDeletedNotes(ctx context.Context, cursor int64, count int32) (
+	[]*model.RecycledNote, model.PageResult, error,
+) {
+	var (
+		uid      = metadata.Uid(ctx)
+		nextPage = model.PageResult{}
+	)
+
+	if cursor == 0 {
+		cursor = model.MaxCursor
+	}
+	notes, err := b.data.Note.ListByCursor(ctx, cursor, count,
+		data.WithNoteOwnerEqual(uid),
+		data.WithNoteStateIn(model.LifeCycleNoteRecycled()...))
+	if errors.Is(err, xsql.ErrNoRecord) {
+		return []*model.RecycledNote{}, nextPage, nil
+	}
+	if err != nil {
+		return nil, nextPage, xerror.Wrapf(err, "biz note list deleted failed").
+			WithExtras("cursor", cursor, "count", count).
+			WithCtx(ctx)
+	}
+
+	if len(notes) > 0 {
+		nextPage.NextCursor = notes[len(notes)-1].Id
+		if len(notes) == int(count) {
+			nextPage.HasNext = true
+		}
+	}
+
+	notesResp, err := b.note.AssembleNotes(ctx, convert.NoteSliceFromDao(notes))
+	if err != nil {
+		return nil, nextPage, xerror.Wrapf(err, "biz note failed to assemble notes when list deleted").
+			WithExtras("cursor", cursor, "count", count).
+			WithCtx(ctx)
+	}
+	err = b.note.AssembleNotesExt(ctx, notesResp.Items)
+	if err != nil {
+		return nil, nextPage, xerror.Wrapf(err, "biz note assemble note ext failed").WithCtx(ctx)
+	}
+
+	recycles, err := b.data.NoteRecycle.BatchGetById(ctx, notesResp.GetIds())
+	if err != nil && !errors.Is(err, xsql.ErrNoRecord) {
+		return nil, nextPage, xerror.Wrapf(err, "data batch get note recycle failed").WithCtx(ctx)
+	}
+
+	items := make([]*model.RecycledNote, 0, len(notesResp.Items))
+	for _, note := range notesResp.Items {
+		item := &model.RecycledNote{Note: note}
+		if r, ok := recycles[note.NoteId]; ok {
+			item.DeleteAt = r.DeleteAt
+			item.ExpireAt = r.ExpireAt
+		}
+		items = append(items, item)
+	}
+
+	return items, nextPage, nil
+}
+
+// 列出已经超过保留期的笔记
+func (b *NoteCreatorBiz) ListExpiredRecycledNotes(ctx context.Context, limit int32) ([]*notedao.RecyclePO, error) {
+	recycles, err := b.data.NoteRecycle.ListExpired(ctx, time.Now().Unix(), limit)
+	if err != nil && !errors.Is(err, xsql.ErrNoRecord) {
+		return nil, xerror.Wrapf(err, "data list expired note recycle failed").WithCtx(ctx)
+	}
+
+	return recycles, nil
+}
+
+// 获取回收站中笔记的全部资源key 包括视频转码后的资源和封面
+//
+// 笔记已经不在回收站中时返回ErrNoteNotRecycled
+func (b *NoteCreatorBiz) GetRecycledNoteAssetKeys(ctx context.Context, noteId int64) ([]string, error) {
+	note, err := b.data.Note.FindOne(ctx, noteId)
+	if err != nil {
+		if xsql.IsNoRecord(err) {
+			return nil, global.ErrNoteNotFound
+		}
+		return nil, xerror.Wrapf(err, "repo find one note failed").WithExtra("noteId", noteId).WithCtx(ctx)
+	}
+
+	if !model.IsNoteStateConsideredAsRecycled(note.State) {
+		return nil, global.ErrNoteNotRecycled
+	}
+
+	assets, err := b.data.NoteAsset.FindByNoteIds(ctx, []int64{noteId})
+	if err != nil && !errors.Is(err, xsql.ErrNoRecord) {
+		return nil, xerror.Wrapf(err, "data find note assets failed").WithExtra("noteId", noteId).WithCtx(ctx)
+	}
+
+	keys := make([]string, 0, len(assets))
+	for _, asset := range assets {
+		keys = append(keys, asset.AssetKey)
+	}
+
+	return keys, nil
+}
+
+// 彻底删除回收站中的笔记 需要在事务中调用
+//
+// 笔记已经不在回收站中时返回ErrNoteNotRecycled
+func (b *NoteCreatorBiz) PurgeNote(ctx context.Context, noteId int64) (*model.NoteCore, error) {
+	queried, err := b.data.Note.FindOneForUpdate(ctx, noteId) // lock record here
+	if errors.Is(err, xsql.ErrNoRecord) {
+		return nil, global.ErrNoteNotFound
+	}
+	if err != nil {
+		return nil, xerror.Wrapf(err, "repo find one note failed").WithExtra("noteId", noteId).WithCtx(ctx)
+	}
+
+	if !model.IsNoteStateConsideredAsRecycled(queried.State) {
+		return nil, global.ErrNoteNotRecycled
+	}
+
+	err = b.data.Note.DeleteNote(ctx, queried)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "data delete note failed")
+	}
+
+	err = b.data.NoteAsset.DeleteByNoteId(ctx, noteId)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "data delete note asset failed")
+	}
+
+	err = b.data.NoteExt.Delete(ctx, noteId)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "data delete note ext failed")
+	}
+
+	err = b.data.NoteDraft.Delete(ctx, noteId)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "data delete note draft failed")
+	}
+
+	err = b.data.NoteAudit.Delete(ctx, noteId)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "data delete note audit failed")
+	}
+
+	err = b.data.NoteRevision.DeleteByNoteId(ctx, noteId)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "data delete note revisions failed")
+	}
+
+	err = b.data.NoteRecycle.Delete(ctx, noteId)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "data delete note recycle failed")
+	}
+
+	err = b.data.NoteView.Delete(ctx, noteId)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "data delete note view failed")
+	}
+
+	err = b.data.NoteCollection.RemoveNote(ctx, noteId)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "data remove note from collection failed")
+	}
+
+	return convert.NoteCoreFromDao(queried), nil
+}
@@ -0,0 +1,249 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/ryanreadbooks/whimer/misc/metadata"
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xslice"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+	"github.com/ryanreadbooks/whimer/note/internal/global"
+	notedao "github.com/ryanreadbooks/whimer/note/internal/infra/dao/note"
+	"github.com/ryanreadbooks/whimer/note/internal/model"
+	"github.com/ryanreadbooks/whimer/note/internal/model/convert"
+)
+
+// 笔记修订版本
+//
+// 每次更新笔记都会生成一个新的版本 记录更新后的完整内容和相对上一个版本的变更;
+// 笔记第一次更新时会先补充一个更新前的基础版本 恢复某个版本等同于用该版本的内容更新笔记
+
+// 读取笔记当前的内容
+func (b *NoteCreatorBiz) loadRevisionContent(ctx context.Context, note *notedao.NotePO) (*model.NoteRevisionContent, error) {
+	content := &model.NoteRevisionContent{
+		Title:   note.Title,
+		Desc:    note.Desc,
+		Privacy: note.Privacy,
+	}
+
+	assets, err := b.getOldNoteAssets(ctx, note.Id, note.NoteType)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "biz get note assets failed")
+	}
+	for _, asset := range assets {
+		content.AssetKeys = append(content.AssetKeys, asset.AssetKey)
+		if note.NoteType == model.AssetTypeImage && asset.AssetType == model.AssetTypeImage {
+			meta := model.NewAssetImageMetaFromJson(asset.AssetMeta)
+			content.Images = append(content.Images, &model.NoteRevisionImage{
+				Key:    asset.AssetKey,
+				Width:  meta.Width,
+				Height: meta.Height,
+				Format: meta.Format,
+			})
+		}
+	}
+
+	ext, err := b.data.NoteExt.GetById(ctx, note.Id)
+	if err != nil && !errors.Is(err, xsql.ErrNoRecord) {
+		return nil, xerror.Wrapf(err, "noteext dao get failed")
+	}
+	if ext != nil {
+		content.TagIds = xslice.SplitInts[int64](ext.Tags, ",")
+		if len(ext.AtUsers) > 0 {
+			_ = json.Unmarshal(ext.AtUsers, &content.AtUsers)
+		}
+	}
+
+	return content, nil
+}
+
+// 保存更新生成的版本 需要在更新笔记的事务中调用
+func (b *NoteCreatorBiz) saveNoteRevision(
+	ctx context.Context,
+	oldNote *notedao.NotePO,
+	oldContent *model.NoteRevisionContent,
+	newNote *notedao.NotePO,
+	req *CreateNoteRequest,
+) error {
+	var (
+		version   int64
+		prevVideo *model.NoteRevisionVideo
+	)
+
+	latest, err := b.data.NoteRevision.GetLatest(ctx, oldNote.Id)
+	if err != nil && !errors.Is(err, xsql.ErrNoRecord) {
+		return xerror.Wrapf(err, "note revision dao get latest failed")
+	}
+
+	if latest != nil {
+		version = latest.Version
+		prevVideo = convert.NoteRevisionFromDao(latest).Content.Video
+	} else {
+		// 第一次更新 先保存更新前的内容作为基础版本
+		version = 1
+		base := &model.NoteRevision{
+			NoteId:    oldNote.Id,
+			Version:   version,
+			Content:   oldContent,
+			Diff:      model.DiffNoteRevisionContent(nil, oldContent),
+			PrevState: oldNote.State,
+		}
+		err = b.data.NoteRevision.Insert(ctx, convert.NoteRevisionAsDao(base))
+		if err != nil {
+			return xerror.Wrapf(err, "note revision dao insert base failed")
+		}
+	}
+
+	newContent, err := b.loadRevisionContent(ctx, newNote)
+	if err != nil {
+		return err
+	}
+	// 标签和at用户以请求为准 请求中清空时数据库中不会更新
+	newContent.TagIds = req.TagIds
+	newContent.AtUsers = req.AtUsers
+	newContent.Video = prevVideo
+	if req.Video != nil && req.Video.FileId != "" {
+		newContent.Video = &model.NoteRevisionVideo{
+			FileId:       req.Video.FileId,
+			TargetFileId: req.Video.TargetFileId,
+			CoverFileId:  req.Video.CoverFileId,
+		}
+	}
+
+	rev := &model.NoteRevision{
+		NoteId:    oldNote.Id,
+		Version:   version + 1,
+		Content:   newContent,
+		Diff:      model.DiffNoteRevisionContent(oldContent, newContent),
+		PrevState: oldNote.State,
+	}
+	err = b.data.NoteRevision.Insert(ctx, convert.NoteRevisionAsDao(rev))
+	if err != nil {
+		return xerror.Wrapf(err, "note revision dao insert failed").WithExtra("version", rev.Version)
+	}
+
+	return nil
+}
+
+// 检查当前用户是否为笔记作者
+func (b *NoteCreatorBiz) checkNoteOwner(ctx context.Context, noteId int64) (*notedao.NotePO, error) {
+	note, err := b.data.Note.FindOne(ctx, noteId)
+	if err != nil {
+		if xsql.IsNoRecord(err) {
+			return nil, global.ErrNoteNotFound
+		}
+		return nil, xerror.Wrapf(err, "biz find one note failed").WithExtra("noteId", noteId).WithCtx(ctx)
+	}
+
+	if metadata.Uid(ctx) != note.Owner {
+		return nil, global.ErrNotNoteOwner
+	}
+
+	return note, nil
+}
+
+// 列出笔记的修订版本 按版本号倒序
+func (b *NoteCreatorBiz) ListNoteRevisions(ctx context.Context, noteId, cursor int64, count int32) (
+	[]*model.NoteRevision, model.PageResult, error,
+) {
+	nextPage := model.PageResult{}
+	if _, err := b.checkNoteOwner(ctx, noteId); err != nil {
+		return nil, nextPage, err
+	}
+
+	if cursor <= 0 {
+		cursor = model.MaxCursor
+	}
+	revs, err := b.data.NoteRevision.ListByCursor(ctx, noteId, cursor, count)
+	if err != nil && !errors.Is(err, xsql.ErrNoRecord) {
+		return nil, nextPage, xerror.Wrapf(err, "note revision dao list failed").
+			WithExtras("noteId", noteId, "cursor", cursor, "count", count).
+			WithCtx(ctx)
+	}
+
+	if len(revs) > 0 {
+		nextPage.NextCursor = revs[len(revs)-1].Version
+		if len(revs) == int(count) {
+			nextPage.HasNext = true
+		}
+	}
+
+	items := make([]*model.NoteRevision, 0, len(revs))
+	for _, rev := range revs {
+		items = append(items, convert.NoteRevisionFromDao(rev))
+	}
+
+	return items, nextPage, nil
+}
+
+// 获取笔记的某个修订版本
+func (b *NoteCreatorBiz) GetNoteRevision(ctx context.Context, noteId, version int64) (*model.NoteRevision, error) {
+	if _, err := b.checkNoteOwner(ctx, noteId); err != nil {
+		return nil, err
+	}
+
+	rev, err := b.data.NoteRevision.Get(ctx, noteId, version)
+	if err != nil {
+		if xsql.IsNoRecord(err) {
+			return nil, global.ErrNoteRevisionNotFound
+		}
+		return nil, xerror.Wrapf(err, "note revision dao get failed").
+			WithExtras("noteId", noteId, "version", version).
+			WithCtx(ctx)
+	}
+
+	return convert.NoteRevisionFromDao(rev), nil
+}
+
+// 根据修订版本构造更新笔记的请求
+//
+// 视频笔记的版本中没有记录视频时保持当前的视频不变
+func (b *NoteCreatorBiz) BuildRestoreRevisionRequest(ctx context.Context, noteId, version int64) (*UpdateNoteRequest, error) {
+	note, err := b.checkNoteOwner(ctx, noteId)
+	if err != nil {
+		return nil, err
+	}
+
+	rev, err := b.GetNoteRevision(ctx, noteId, version)
+	if err != nil {
+		return nil, err
+	}
+
+	content := rev.Content
+	req := &UpdateNoteRequest{
+		NoteId: noteId,
+		CreateNoteRequest: CreateNoteRequest{
+			Basic: CreateNoteRequestBasic{
+				Title:    content.Title,
+				Desc:     content.Desc,
+				Privacy:  content.Privacy,
+				NoteType: note.NoteType,
+			},
+			TagIds:  content.TagIds,
+			AtUsers: content.AtUsers,
+		},
+	}
+
+	switch note.NoteType {
+	case model.AssetTypeImage:
+		for _, img := range content.Images {
+			req.Images = append(req.Images, CreateNoteRequestImage{
+				FileId: img.Key,
+				Width:  img.Width,
+				Height: img.Height,
+				Format: img.Format,
+			})
+		}
+	case model.AssetTypeVideo:
+		req.Video = &CreateNoteRequestVideo{}
+		if content.Video != nil {
+			req.Video.FileId = content.Video.FileId
+			req.Video.TargetFileId = content.Video.TargetFileId
+			req.Video.CoverFileId = content.Video.CoverFileId
+		}
+	}
+
+	return req, nil
+}
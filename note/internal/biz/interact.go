@@ -6,6 +6,7 @@ import (
 
 	commentv1 "github.com/ryanreadbooks/whimer/idl/gen/go/comment/api/v1"
 	counterv1 "github.com/ryanreadbooks/whimer/idl/gen/go/counter/api/v1"
+	"github.com/ryanreadbooks/whimer/misc/concurrent"
 	"github.com/ryanreadbooks/whimer/misc/xerror"
 	"github.com/ryanreadbooks/whimer/misc/xlog"
 	"github.com/ryanreadbooks/whimer/note/internal/data"
@@ -67,6 +68,18 @@ func (b *NoteInteractBiz) LikeNote(ctx context.Context, uid int64, noteId int64,
 			WithExtra("noteId", noteId).WithCtx(ctx)
 	}
 
+	// 点赞数据同时计入推荐热度
+	delta := int64(recommendHotDelta)
+	if operation == UnDoLike {
+		delta = -delta
+	}
+	concurrent.SafeGo(func() {
+		ctx := context.WithoutCancel(ctx)
+		if err := b.data.Recommend.IncrHot(ctx, noteId, delta); err != nil {
+			xlog.Msg("recommend data incr hot failed").Err(err).Extras("noteId", noteId).Errorx(ctx)
+		}
+	})
+
 	return nil
 }
 
@@ -138,51 +151,54 @@ func (b *NoteInteractBiz) BatchCheckUserLikeStatus(ctx context.Context, uidNoteI
 
 // 获取笔记点赞信息并填充
 func (b *NoteInteractBiz) AssignNoteLikes(ctx context.Context, batch *model.Notes) (*model.Notes, error) {
-	var (
-		notes   = batch.Items
-		noteIds = make([]int64, 0, len(notes))
-		reqs    = make([]*counterv1.GetSummaryRequest, 0, len(notes))
-		resp    *counterv1.BatchGetSummaryResponse
-		err     error
-	)
-
-	for _, note := range notes {
-		noteIds = append(noteIds, note.NoteId)
+	m, err := b.BatchGetNoteLikes(ctx, batch.GetIds())
+	if err != nil {
+		// 仅打印日志不返回error
+		xlog.Msg("counter failed to batch get summary").
+			Err(err).
+			Extra("note_ids", batch.GetIds()).
+			Infox(ctx)
+		return batch, nil
+	}
+
+	// 赋值
+	for _, item := range batch.Items {
+		if likeCnt, ok := m[item.NoteId]; ok {
+			item.Likes = likeCnt
+		}
+	}
+
+	return batch, nil
+}
+
+// 批量获取笔记点赞数量
+func (b *NoteInteractBiz) BatchGetNoteLikes(ctx context.Context, noteIds []int64) (map[int64]int64, error) {
+	if len(noteIds) == 0 {
+		return map[int64]int64{}, nil
+	}
+
+	reqs := make([]*counterv1.GetSummaryRequest, 0, len(noteIds))
+	for _, noteId := range noteIds {
 		reqs = append(reqs, &counterv1.GetSummaryRequest{
 			BizCode: global.NoteLikeBizcode,
-			Oid:     note.NoteId,
+			Oid:     noteId,
 		})
 	}
 
-	if len(reqs) > 0 {
-		// 获取点赞数量
-		resp, err = dep.GetCounter().BatchGetSummary(ctx,
-			&counterv1.BatchGetSummaryRequest{
-				Requests: reqs,
-			})
-		if err != nil {
-			// 仅打印日志不返回error
-			xlog.Msg("counter failed to batch get summary").
-				Err(err).
-				Extra("note_ids", noteIds).
-				Infox(ctx)
-		}
+	resp, err := dep.GetCounter().BatchGetSummary(ctx,
+		&counterv1.BatchGetSummaryRequest{
+			Requests: reqs,
+		})
+	if err != nil {
+		return nil, xerror.Wrapf(err, "counter batch get summary failed").WithCtx(ctx)
 	}
 
-	if resp != nil {
-		m := make(map[int64]int64, len(resp.Responses))
-		for _, r := range resp.Responses {
-			m[r.Oid] = r.Count
-		}
-		// 赋值
-		for _, item := range batch.Items {
-			if likeCnt, ok := m[item.NoteId]; ok {
-				item.Likes = likeCnt
-			}
-		}
+	m := make(map[int64]int64, len(resp.Responses))
+	for _, r := range resp.Responses {
+		m[r.Oid] = r.Count
 	}
 
-	return batch, nil
+	return m, nil
 }
 
 // 获取笔记点赞数量
@@ -230,26 +246,33 @@ func (b *NoteInteractBiz) GetNoteReplyCount(ctx context.Context, noteId int64) (
 
 // 获取笔记的评论信息并填充
 func (b *NoteInteractBiz) AssignNoteReplies(ctx context.Context, batch *model.Notes) (*model.Notes, error) {
-	noteIds := batch.GetIds()
-
-	resp, err := dep.GetCommenter().BatchCountComment(ctx, &commentv1.BatchCountCommentRequest{
-		Oids: noteIds,
-	})
+	m, err := b.BatchGetNoteReplies(ctx, batch.GetIds())
 	if err != nil {
 		xlog.Msg("counter failed to batch count reply").
 			Err(err).
-			Extra("note_ids", noteIds).
+			Extra("note_ids", batch.GetIds()).
 			Infox(ctx)
+		return batch, nil
 	}
 
-	if resp != nil {
-		m := make(map[int64]int64, len(resp.Numbers))
-		maps.Copy(m, resp.Numbers)
-
-		for _, note := range batch.Items {
-			note.Replies = m[note.NoteId]
-		}
+	for _, note := range batch.Items {
+		note.Replies = m[note.NoteId]
 	}
 
 	return batch, nil
 }
+
+// 批量获取笔记评论数量
+func (b *NoteInteractBiz) BatchGetNoteReplies(ctx context.Context, noteIds []int64) (map[int64]int64, error) {
+	resp, err := dep.GetCommenter().BatchCountComment(ctx, &commentv1.BatchCountCommentRequest{
+		Oids: noteIds,
+	})
+	if err != nil {
+		return nil, xerror.Wrapf(err, "commenter batch count comment failed").WithCtx(ctx)
+	}
+
+	m := make(map[int64]int64, len(resp.Numbers))
+	maps.Copy(m, resp.Numbers)
+
+	return m, nil
+}
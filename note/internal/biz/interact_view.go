@@ -0,0 +1,138 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xlog"
+	"github.com/ryanreadbooks/whimer/misc/xslice"
+	"github.com/ryanreadbooks/whimer/note/internal/config"
+	"github.com/ryanreadbooks/whimer/note/internal/model"
+)
+
+// 笔记浏览量
+//
+// 浏览先在缓存中按用户去重并累加 再由后台任务定期汇总到数据库;
+// 读取的浏览量只包含已经汇总的部分
+
+const (
+	noteViewFlushRetention  = 24 * time.Hour
+	noteViewFlushPurgeLimit = 500
+)
+
+// 上报浏览者浏览过的笔记 只统计浏览者可见的已发布笔记 返回计数的数量
+//
+// viewerUid为0表示未登录的浏览者 viewer为去重使用的浏览者标识
+func (b *NoteInteractBiz) ReportNoteViews(ctx context.Context, viewerUid int64, viewer string, noteIds []int64) (int, error) {
+	noteIds = xslice.Uniq(noteIds)
+	if viewer == "" || len(noteIds) == 0 {
+		return 0, nil
+	}
+
+	notes, err := b.note.BatchGetNoteWithoutAsset(ctx, noteIds)
+	if err != nil {
+		return 0, xerror.Wrapf(err, "biz batch get note failed when report views").WithCtx(ctx)
+	}
+
+	published := make([]*model.Note, 0, len(notes))
+	for _, noteId := range noteIds {
+		if note, ok := notes[noteId]; ok && note.State == model.NoteStatePublished {
+			published = append(published, note)
+		}
+	}
+
+	visible, err := b.note.FilterVisibleNotes(ctx, viewerUid, published)
+	if err != nil {
+		return 0, xerror.Wrapf(err, "biz filter visible notes failed when report views").WithCtx(ctx)
+	}
+
+	counted := 0
+	for _, note := range visible {
+		added, err := b.data.NoteView.AddView(ctx, note.NoteId, viewer, config.Conf.View.DedupWindow)
+		if err != nil {
+			return counted, xerror.Wrapf(err, "data add note view failed").WithExtra("noteId", note.NoteId).WithCtx(ctx)
+		}
+		if added {
+			counted++
+		}
+	}
+
+	return counted, nil
+}
+
+// 批量获取笔记浏览量
+func (b *NoteInteractBiz) BatchGetNoteViews(ctx context.Context, noteIds []int64) (map[int64]int64, error) {
+	if len(noteIds) == 0 {
+		return map[int64]int64{}, nil
+	}
+
+	views, err := b.data.NoteView.BatchGet(ctx, noteIds)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "data batch get note views failed").WithCtx(ctx)
+	}
+
+	return views, nil
+}
+
+// 获取笔记浏览量并填充
+func (b *NoteInteractBiz) AssignNoteViews(ctx context.Context, batch *model.Notes) (*model.Notes, error) {
+	m, err := b.BatchGetNoteViews(ctx, batch.GetIds())
+	if err != nil {
+		// 仅打印日志不返回error
+		xlog.Msg("note view failed to batch get").
+			Err(err).
+			Extra("note_ids", batch.GetIds()).
+			Infox(ctx)
+		return batch, nil
+	}
+
+	for _, item := range batch.Items {
+		item.Views = m[item.NoteId]
+	}
+
+	return batch, nil
+}
+
+// 将缓存中待汇总的浏览量累加到数据库 返回汇总的笔记数量
+//
+// 写入数据库失败时缓存中的数据保留到下一次重试;
+// 批次id和浏览量在同一个事务中写入 确认失败后重试同一批次时不会重复累加
+func (b *NoteInteractBiz) FlushNoteViews(ctx context.Context) (int, error) {
+	batchId, pending, err := b.data.NoteView.TakePending(ctx)
+	if err != nil {
+		return 0, xerror.Wrapf(err, "data take pending note views failed").WithCtx(ctx)
+	}
+
+	if len(pending) != 0 {
+		err = b.data.DB().Transact(ctx, func(ctx context.Context) error {
+			first, err := b.data.NoteView.MarkFlushed(ctx, batchId)
+			if err != nil {
+				return xerror.Wrapf(err, "data mark note views flushed failed")
+			}
+			if !first {
+				xlog.Msg("note views batch already flushed, skip").Extra("batchId", batchId).Infox(ctx)
+				return nil
+			}
+
+			return b.data.NoteView.BatchIncr(ctx, pending)
+		})
+		if err != nil {
+			return 0, xerror.Wrapf(err, "data batch incr note views failed").
+				WithExtras("count", len(pending), "batchId", batchId).WithCtx(ctx)
+		}
+	}
+
+	err = b.data.NoteView.AckPending(ctx)
+	if err != nil {
+		return len(pending), xerror.Wrapf(err, "data ack pending note views failed").WithCtx(ctx)
+	}
+
+	// 确认失败的批次在下一次汇总时就会重试 批次记录只需要保留较短的时间
+	before := time.Now().Add(-noteViewFlushRetention).Unix()
+	if _, err := b.data.NoteView.PurgeFlushed(ctx, before, noteViewFlushPurgeLimit); err != nil {
+		xlog.Msg("data purge flushed note view batches failed").Err(err).Errorx(ctx)
+	}
+
+	return len(pending), nil
+}
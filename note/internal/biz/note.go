@@ -136,7 +136,12 @@ func (b *NoteBiz) GetUserRecentNote(ctx context.Context, uid int64, count int32)
 	return resp, nil
 }
 
-func (b *NoteBiz) ListUserPublicNote(ctx context.Context, uid int64, cursor int64, count int32) (*model.Notes, model.PageResult, error) {
+// 列出用户已发布且对浏览者可见的笔记 不包括私有笔记
+//
+// 过滤后的数量可能少于count 是否有下一页以过滤前的数量为准
+func (b *NoteBiz) ListUserVisibleNote(ctx context.Context, uid, viewer int64, cursor int64, count int32) (
+	*model.Notes, model.PageResult, error,
+) {
 	nextPage := model.PageResult{}
 	if cursor == 0 {
 		cursor = model.MaxCursor
@@ -146,7 +151,8 @@ func (b *NoteBiz) ListUserPublicNote(ctx context.Context, uid int64, cursor int6
 
 	notes, err := b.data.Note.ListByCursor(ctx, cursor, newCount,
 		data.WithNoteOwnerEqual(uid),
-		data.WithNotePrivacyEqual(model.PrivacyPublic),
+		data.WithNotePrivacyIn(model.PrivacyPublic, model.PrivacyFollowers,
+			model.PrivacyMutualFollowers, model.PrivacyCustom),
 		data.WithNoteStateEqual(model.NoteStatePublished),
 	)
 	if err != nil {
@@ -176,6 +182,12 @@ func (b *NoteBiz) ListUserPublicNote(ctx context.Context, uid int64, cursor int6
 				WithExtras("uid", uid, "count", count).WithCtx(ctx)
 	}
 
+	resp.Items, err = b.FilterVisibleNotes(ctx, viewer, resp.Items)
+	if err != nil {
+		return nil, nextPage, xerror.Wrapf(err, "biz filter visible notes failed").
+			WithExtras("uid", uid, "viewer", viewer).WithCtx(ctx)
+	}
+
 	return resp, nextPage, nil
 }
 
@@ -198,7 +210,7 @@ func (b *NoteBiz) IsNoteExist(ctx context.Context, noteId int64) (bool, error) {
 		return false, nil
 	}
 
-	_, err := b.data.Note.FindOne(ctx, noteId)
+	note, err := b.data.Note.FindOne(ctx, noteId)
 	if err != nil {
 		if !xsql.IsNoRecord(err) {
 			return false, xerror.Wrapf(err, "note repo find one failed").WithExtra("noteId", noteId).WithCtx(ctx)
@@ -206,7 +218,8 @@ func (b *NoteBiz) IsNoteExist(ctx context.Context, noteId int64) (bool, error) {
 		return false, nil
 	}
 
-	return true, nil
+	// 回收站中的笔记视为已删除
+	return !model.IsNoteStateConsideredAsRecycled(note.State), nil
 }
 
 // 获取笔记作者
@@ -325,6 +338,8 @@ func (b *NoteBiz) AssembleNotesExt(ctx context.Context, notes []*model.Note) err
 				extMap[noteId].AtUsers = atUsers
 			}
 		}
+
+		extMap[noteId].SetAudience(extPo.Audience)
 	}
 
 	totalTagIds = xslice.Uniq(totalTagIds)
@@ -355,6 +370,9 @@ func (b *NoteBiz) AssembleNotesExt(ctx context.Context, notes []*model.Note) err
 			// 2. at_users
 			n.AtUsers = ext.AtUsers
 
+			// 3. audience
+			n.Audience = ext.Audience
+
 			// TODO other ext attributes if necessary
 		}
 	}
@@ -0,0 +1,142 @@
+package biz
+
+import (
+	"context"
+
+	relationv1 "github.com/ryanreadbooks/whimer/idl/gen/go/relation/api/v1"
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xmap"
+	"github.com/ryanreadbooks/whimer/misc/xslice"
+	"github.com/ryanreadbooks/whimer/note/internal/infra/dep"
+	"github.com/ryanreadbooks/whimer/note/internal/model"
+)
+
+// 笔记对浏览者的可见性
+//
+// 公开笔记所有人可见 私有笔记仅作者可见;
+// 粉丝可见和互关可见需要查询关系服务 指定用户可见需要笔记的audience信息
+
+// 判断笔记对浏览者是否可见
+func (b *NoteBiz) IsNoteVisible(ctx context.Context, viewer int64, note *model.Note) (bool, error) {
+	visible, err := b.FilterVisibleNotes(ctx, viewer, []*model.Note{note})
+	if err != nil {
+		return false, err
+	}
+
+	return len(visible) != 0, nil
+}
+
+// 过滤出浏览者可见的笔记 保持原有顺序
+func (b *NoteBiz) FilterVisibleNotes(ctx context.Context, viewer int64, notes []*model.Note) ([]*model.Note, error) {
+	if len(notes) == 0 {
+		return notes, nil
+	}
+
+	if err := b.fillNotesAudience(ctx, viewer, notes); err != nil {
+		return nil, err
+	}
+
+	relations, err := b.getViewerRelations(ctx, viewer, notes)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := make([]*model.Note, 0, len(notes))
+	for _, note := range notes {
+		if note.IsVisibleTo(viewer, relations[note.Owner]) {
+			visible = append(visible, note)
+		}
+	}
+
+	return visible, nil
+}
+
+// 指定用户可见的笔记如果没有填充audience 则从ext中补充
+func (b *NoteBiz) fillNotesAudience(ctx context.Context, viewer int64, notes []*model.Note) error {
+	if viewer == 0 {
+		return nil
+	}
+
+	targets := make(map[int64][]*model.Note)
+	for _, note := range notes {
+		if note.Privacy == model.PrivacyCustom && note.Owner != viewer && len(note.Audience) == 0 {
+			targets[note.NoteId] = append(targets[note.NoteId], note)
+		}
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+
+	exts, err := b.data.NoteExt.BatchGetById(ctx, xmap.Keys(targets))
+	if err != nil {
+		return xerror.Wrapf(err, "note ext dao failed to batch get").WithCtx(ctx)
+	}
+
+	for _, ext := range exts {
+		noteExt := &model.NoteExt{}
+		noteExt.SetAudience(ext.Audience)
+		for _, note := range targets[ext.NoteId] {
+			note.Audience = noteExt.Audience
+		}
+	}
+
+	return nil
+}
+
+// 查询浏览者和笔记作者的关注关系 只查询需要判断关系的作者
+func (b *NoteBiz) getViewerRelations(ctx context.Context, viewer int64, notes []*model.Note) (
+	map[int64]model.ViewerRelation, error,
+) {
+	relations := make(map[int64]model.ViewerRelation)
+	if viewer == 0 {
+		return relations, nil
+	}
+
+	owners := make([]int64, 0, len(notes))
+	mutualOwners := make(map[int64]struct{})
+	for _, note := range notes {
+		if note.Owner == viewer {
+			continue
+		}
+
+		switch note.Privacy {
+		case model.PrivacyFollowers:
+			owners = append(owners, note.Owner)
+		case model.PrivacyMutualFollowers:
+			owners = append(owners, note.Owner)
+			mutualOwners[note.Owner] = struct{}{}
+		}
+	}
+	if len(owners) == 0 {
+		return relations, nil
+	}
+
+	owners = xslice.Uniq(owners)
+	resp, err := dep.GetRelationer().BatchCheckUserFollowed(ctx, &relationv1.BatchCheckUserFollowedRequest{
+		Uid:     viewer,
+		Targets: owners,
+	})
+	if err != nil {
+		return nil, xerror.Wrapf(err, "relation batch check user followed failed").
+			WithExtras("viewer", viewer, "owners", owners).WithCtx(ctx)
+	}
+
+	for _, owner := range owners {
+		rel := model.ViewerRelation{Following: resp.GetStatus()[owner]}
+		// 互关需要确认作者也关注了浏览者 浏览者未关注作者时无需再查
+		if _, ok := mutualOwners[owner]; ok && rel.Following {
+			back, err := dep.GetRelationer().CheckUserFollowed(ctx, &relationv1.CheckUserFollowedRequest{
+				Uid:   owner,
+				Other: viewer,
+			})
+			if err != nil {
+				return nil, xerror.Wrapf(err, "relation check user followed failed").
+					WithExtras("viewer", viewer, "owner", owner).WithCtx(ctx)
+			}
+			rel.FollowedBy = back.GetFollowed()
+		}
+		relations[owner] = rel
+	}
+
+	return relations, nil
+}
@@ -24,12 +24,13 @@ func NewNoteProcedureBiz(dt *data.Data) *NoteProcedureBiz {
 }
 
 type CreateProcedureRecordReq struct {
-	NoteId      int64
-	Protype     model.ProcedureType
-	TaskId      string
-	MaxRetryCnt int
-	Params      []byte        // 自定义调用参数
-	ExpiredTTL  time.Duration // 任务过期时间，0 表示永不过期
+	NoteId        int64
+	Protype       model.ProcedureType
+	TaskId        string
+	MaxRetryCnt   int
+	Params        []byte        // 自定义调用参数
+	ExpiredTTL    time.Duration // 任务过期时间，0 表示永不过期
+	NextCheckTime int64         // 首次检查时间 0表示使用默认的重试间隔
 }
 
 // 创建一条任务记录 如果存在且状态非处理中则更新
@@ -56,6 +57,9 @@ func (b *NoteProcedureBiz) CreateRecord(
 
 	now := time.Now()
 	nextCheckTime := now.Add(config.Conf.RetryConfig.ProcedureRetry.TaskRegister.RetryInterval).Unix()
+	if req.NextCheckTime > 0 {
+		nextCheckTime = req.NextCheckTime
+	}
 
 	// 计算过期时间，默认 1 小时
 	expiredTTL := req.ExpiredTTL
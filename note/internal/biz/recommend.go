@@ -0,0 +1,310 @@
+package biz
+
+import (
+	"context"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/misc/concurrent"
+	"github.com/ryanreadbooks/whimer/misc/uuid"
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xlog"
+	"github.com/ryanreadbooks/whimer/note/internal/data"
+	"github.com/ryanreadbooks/whimer/note/internal/global"
+	"github.com/ryanreadbooks/whimer/note/internal/model"
+	"github.com/ryanreadbooks/whimer/note/internal/model/convert"
+)
+
+const (
+	recommendRecallLimit   = 100             // 每个召回源最多召回的笔记数
+	recommendRankLimit     = 200             // 进入打分阶段的最多笔记数
+	recommendRecallTimeout = 2 * time.Second // 单个召回源的超时时间
+	recommendHalfLife      = 48 * time.Hour  // 新鲜度半衰期
+	recommendHotDelta      = 1               // 每次点赞增加的热度
+)
+
+type weightedRecommendSource struct {
+	source RecommendSource
+	weight float64
+}
+
+// 推荐请求
+type RecommendRequest struct {
+	Uid     int64
+	NeedNum int
+	Cursor  string // 为空表示开始新的推荐会话
+}
+
+// 推荐结果
+type RecommendResult struct {
+	Notes      *model.Notes
+	NextCursor string
+	HasNext    bool
+}
+
+type recommendItem struct {
+	note  *model.Note
+	score float64 // 召回得分 打分后为最终得分
+}
+
+// 笔记推荐
+//
+// 推荐流程分为召回 过滤 打分和去重四个阶段
+// 游标为推荐会话id 同一会话中返回过的笔记不会再次返回;
+// 登录用户近期曝光过的笔记只在候选不足时用于补足
+type NoteRecommendBiz struct {
+	data     *data.Data
+	note     *NoteBiz
+	interact *NoteInteractBiz
+
+	sources []weightedRecommendSource
+}
+
+func NewNoteRecommendBiz(dt *data.Data, note *NoteBiz, interact *NoteInteractBiz) *NoteRecommendBiz {
+	b := &NoteRecommendBiz{
+		data:     dt,
+		note:     note,
+		interact: interact,
+	}
+
+	b.RegisterSource(&followingRecommendSource{data: dt}, 1.5)
+	b.RegisterSource(&likedTagRecommendSource{data: dt}, 1.2)
+	b.RegisterSource(&hotRecommendSource{data: dt}, 1.0)
+	b.RegisterSource(&freshRecommendSource{data: dt}, 0.8)
+
+	return b
+}
+
+// 注册召回源 weight为召回源在打分阶段的权重
+func (b *NoteRecommendBiz) RegisterSource(source RecommendSource, weight float64) {
+	b.sources = append(b.sources, weightedRecommendSource{source: source, weight: weight})
+}
+
+// 获取推荐笔记
+func (b *NoteRecommendBiz) Recommend(ctx context.Context, req *RecommendRequest) (*RecommendResult, error) {
+	session := req.Cursor
+	if session == "" {
+		session = uuid.NewUUID().String()
+	} else if _, err := uuid.ParseString(session); err != nil {
+		return nil, global.ErrRecommendCursorInvalid
+	}
+
+	sessionShown, err := b.data.Recommend.GetSessionShown(ctx, session)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "recommend data get session shown failed").
+			WithExtras("uid", req.Uid, "session", session).WithCtx(ctx)
+	}
+
+	userShown := map[int64]struct{}{}
+	if req.Uid != 0 {
+		userShown, err = b.data.Recommend.GetUserShown(ctx, req.Uid)
+		if err != nil {
+			// 用户维度的去重失败不影响推荐
+			xlog.Msg("recommend data get user shown failed").Err(err).Extras("uid", req.Uid).Errorx(ctx)
+			userShown = map[int64]struct{}{}
+		}
+	}
+
+	scores := b.recall(ctx, req.Uid)
+	for noteId := range sessionShown {
+		delete(scores, noteId)
+	}
+
+	items, err := b.filter(ctx, req.Uid, scores)
+	if err != nil {
+		return nil, err
+	}
+
+	b.rank(ctx, items)
+
+	picked, hasNext := pickRecommendItems(items, userShown, req.NeedNum)
+	notes := &model.Notes{Items: make([]*model.Note, 0, len(picked))}
+	for _, item := range picked {
+		notes.Items = append(notes.Items, item.note)
+	}
+
+	if len(notes.Items) > 0 {
+		noteIds := notes.GetIds()
+		err = b.data.Recommend.AddSessionShown(ctx, session, noteIds)
+		if err != nil {
+			return nil, xerror.Wrapf(err, "recommend data add session shown failed").
+				WithExtras("uid", req.Uid, "session", session).WithCtx(ctx)
+		}
+
+		if req.Uid != 0 {
+			concurrent.SafeGo(func() {
+				ctx := context.WithoutCancel(ctx)
+				if err := b.data.Recommend.AddUserShown(ctx, req.Uid, noteIds); err != nil {
+					xlog.Msg("recommend data add user shown failed").Err(err).Extras("uid", req.Uid).Errorx(ctx)
+				}
+			})
+		}
+
+		notes, err = b.note.AssembleNotes(ctx, notes.Items)
+		if err != nil {
+			return nil, xerror.Wrapf(err, "recommend assemble notes failed").WithExtra("uid", req.Uid).WithCtx(ctx)
+		}
+	}
+
+	return &RecommendResult{
+		Notes:      notes,
+		NextCursor: session,
+		HasNext:    hasNext,
+	}, nil
+}
+
+// 从所有召回源并发召回 返回笔记id和加权后的召回得分
+//
+// 被多个召回源召回的笔记得分累加 单个召回源失败时忽略该召回源
+func (b *NoteRecommendBiz) recall(ctx context.Context, uid int64) map[int64]float64 {
+	var (
+		wg      sync.WaitGroup
+		results = make([][]*RecommendCandidate, len(b.sources))
+	)
+
+	for i, ws := range b.sources {
+		wg.Add(1)
+		concurrent.SafeGo(func() {
+			defer wg.Done()
+			rCtx, cancel := context.WithTimeout(ctx, recommendRecallTimeout)
+			defer cancel()
+
+			candidates, err := ws.source.Recall(rCtx, uid, recommendRecallLimit)
+			if err != nil {
+				xlog.Msg("recommend source recall failed").
+					Err(err).
+					Extras("source", ws.source.Name(), "uid", uid).
+					Errorx(ctx)
+				return
+			}
+			results[i] = candidates
+		})
+	}
+	wg.Wait()
+
+	scores := make(map[int64]float64)
+	for i, candidates := range results {
+		for _, c := range candidates {
+			scores[c.NoteId] += b.sources[i].weight * c.Score
+		}
+	}
+
+	return scores
+}
+
+// 过滤掉不可推荐的笔记 只保留其他用户公开发布的笔记
+func (b *NoteRecommendBiz) filter(ctx context.Context, uid int64, scores map[int64]float64) ([]*recommendItem, error) {
+	if len(scores) == 0 {
+		return []*recommendItem{}, nil
+	}
+
+	noteIds := make([]int64, 0, len(scores))
+	for noteId := range scores {
+		noteIds = append(noteIds, noteId)
+	}
+	// 候选过多时只保留召回得分最高的部分
+	if len(noteIds) > recommendRankLimit {
+		slices.SortFunc(noteIds, func(a, b int64) int {
+			return compareScore(scores[a], scores[b], a, b)
+		})
+		noteIds = noteIds[:recommendRankLimit]
+	}
+
+	pos, err := b.data.Note.BatchGet(ctx, noteIds)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "note data batch get failed").WithExtra("uid", uid).WithCtx(ctx)
+	}
+
+	items := make([]*recommendItem, 0, len(pos))
+	for _, po := range pos {
+		if po.Privacy != model.PrivacyPublic || po.State != model.NoteStatePublished || po.Owner == uid {
+			continue
+		}
+
+		items = append(items, &recommendItem{
+			note:  convert.NoteFromDao(po),
+			score: scores[po.Id],
+		})
+	}
+
+	return items, nil
+}
+
+// 从排好序的候选中选出needNum篇 未曝光过的笔记优先 曝光过的笔记用于补足
+func pickRecommendItems(
+	items []*recommendItem,
+	userShown map[int64]struct{},
+	needNum int,
+) ([]*recommendItem, bool) {
+	fresh := make([]*recommendItem, 0, len(items))
+	seen := make([]*recommendItem, 0, len(items))
+	for _, item := range items {
+		if _, ok := userShown[item.note.NoteId]; ok {
+			seen = append(seen, item)
+		} else {
+			fresh = append(fresh, item)
+		}
+	}
+
+	picked := append(fresh, seen...)
+	hasNext := len(picked) > needNum
+	if hasNext {
+		picked = picked[:needNum]
+	}
+
+	return picked, hasNext
+}
+
+// 结合互动数据和新鲜度打分 并按得分从高到低排序
+func (b *NoteRecommendBiz) rank(ctx context.Context, items []*recommendItem) {
+	if len(items) == 0 {
+		return
+	}
+
+	notes := &model.Notes{Items: make([]*model.Note, 0, len(items))}
+	for _, item := range items {
+		notes.Items = append(notes.Items, item.note)
+	}
+	// 互动数据获取失败时按0处理
+	b.interact.AssignNoteLikes(ctx, notes)
+	b.interact.AssignNoteViews(ctx, notes)
+	b.interact.AssignNoteReplies(ctx, notes)
+
+	now := time.Now()
+	for _, item := range items {
+		item.score = scoreRecommendItem(item.score, item.note, now)
+	}
+
+	slices.SortFunc(items, func(a, b *recommendItem) int {
+		return compareScore(a.score, b.score, a.note.NoteId, b.note.NoteId)
+	})
+}
+
+// 最终得分 = 召回得分 * 互动得分 * 新鲜度
+func scoreRecommendItem(recallScore float64, note *model.Note, now time.Time) float64 {
+	engagement := 1 + math.Log1p(float64(note.Likes)) + 0.5*math.Log1p(float64(note.Replies))
+
+	age := max(now.Sub(time.Unix(note.CreateAt, 0)), 0)
+	freshness := math.Exp2(-float64(age) / float64(recommendHalfLife))
+
+	return recallScore * engagement * freshness
+}
+
+// 得分高的排在前面 得分相同时较新的笔记在前
+func compareScore(sa, sb float64, ida, idb int64) int {
+	if sa != sb {
+		if sa > sb {
+			return -1
+		}
+		return 1
+	}
+
+	if ida > idb {
+		return -1
+	} else if ida < idb {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,231 @@
+package biz
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	counterv1 "github.com/ryanreadbooks/whimer/idl/gen/go/counter/api/v1"
+	relationv1 "github.com/ryanreadbooks/whimer/idl/gen/go/relation/api/v1"
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xslice"
+	"github.com/ryanreadbooks/whimer/note/internal/data"
+	"github.com/ryanreadbooks/whimer/note/internal/global"
+	notedao "github.com/ryanreadbooks/whimer/note/internal/infra/dao/note"
+	"github.com/ryanreadbooks/whimer/note/internal/infra/dep"
+	"github.com/ryanreadbooks/whimer/note/internal/model"
+)
+
+const (
+	RecommendSourceFollowing = "following" // 关注的作者
+	RecommendSourceLikedTag  = "liked_tag" // 点赞过的标签
+	RecommendSourceFresh     = "fresh"     // 最新发布
+	RecommendSourceHot       = "hot"       // 近期热门
+)
+
+const (
+	followingRecallUserNum = 250 // 最多使用的关注用户数
+	likedTagRecallNoteNum  = 20  // 用于提取标签的最近点赞笔记数
+	likedTagRecallTagNum   = 5   // 最多使用的标签数
+	hotRecallDays          = 2   // 热度统计的天数
+)
+
+// 推荐候选笔记
+type RecommendCandidate struct {
+	NoteId int64
+	Score  float64 // 召回源内的得分 取值[0,1]
+}
+
+// 推荐召回源
+//
+// 召回源只负责给出候选笔记id 笔记状态和可见性的过滤以及打分在后续阶段统一处理
+type RecommendSource interface {
+	Name() string
+	// uid为0表示未登录用户
+	Recall(ctx context.Context, uid int64, limit int) ([]*RecommendCandidate, error)
+}
+
+func uniformCandidates(noteIds []int64) []*RecommendCandidate {
+	candidates := make([]*RecommendCandidate, 0, len(noteIds))
+	for _, noteId := range noteIds {
+		candidates = append(candidates, &RecommendCandidate{NoteId: noteId, Score: 1})
+	}
+
+	return candidates
+}
+
+// 关注作者最近发布的笔记
+type followingRecommendSource struct {
+	data *data.Data
+}
+
+func (s *followingRecommendSource) Name() string {
+	return RecommendSourceFollowing
+}
+
+func (s *followingRecommendSource) Recall(ctx context.Context, uid int64, limit int) ([]*RecommendCandidate, error) {
+	if uid == 0 {
+		return nil, nil
+	}
+
+	resp, err := dep.GetRelationer().GetUserFollowingList(ctx, &relationv1.GetUserFollowingListRequest{
+		Uid: uid,
+		Cond: &relationv1.QueryCondition{
+			Offset: 0,
+			Count:  followingRecallUserNum,
+		},
+	})
+	if err != nil {
+		return nil, xerror.Wrapf(err, "relation get user following list failed").WithExtra("uid", uid).WithCtx(ctx)
+	}
+
+	if len(resp.GetFollowings()) == 0 {
+		return nil, nil
+	}
+
+	notes, err := s.data.Note.ListByPage(ctx, 1, int32(limit),
+		data.WithNoteOwnerIn(resp.GetFollowings()...),
+		data.WithNotePrivacyEqual(model.PrivacyPublic),
+		data.WithNoteStateEqual(model.NoteStatePublished),
+	)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "note data list following notes failed").WithExtra("uid", uid).WithCtx(ctx)
+	}
+
+	return uniformCandidates(xslice.Extract(notes, func(n *notedao.NotePO) int64 { return n.Id })), nil
+}
+
+// 与用户最近点赞笔记标签相同的笔记
+type likedTagRecommendSource struct {
+	data *data.Data
+}
+
+func (s *likedTagRecommendSource) Name() string {
+	return RecommendSourceLikedTag
+}
+
+func (s *likedTagRecommendSource) Recall(ctx context.Context, uid int64, limit int) ([]*RecommendCandidate, error) {
+	if uid == 0 {
+		return nil, nil
+	}
+
+	resp, err := dep.GetCounter().PageGetUserRecord(ctx, &counterv1.PageGetUserRecordRequest{
+		BizCode:  global.NoteLikeBizcode,
+		Uid:      uid,
+		Count:    likedTagRecallNoteNum,
+		SortRule: counterv1.SortRule_SORT_RULE_DESC,
+	})
+	if err != nil {
+		return nil, xerror.Wrapf(err, "counter page get user record failed").WithExtra("uid", uid).WithCtx(ctx)
+	}
+
+	likedNoteIds := make([]int64, 0, len(resp.GetItems()))
+	for _, item := range resp.GetItems() {
+		likedNoteIds = append(likedNoteIds, item.Oid)
+	}
+	if len(likedNoteIds) == 0 {
+		return nil, nil
+	}
+
+	exts, err := s.data.NoteExt.BatchGetById(ctx, likedNoteIds)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "note ext data batch get failed").WithExtra("uid", uid).WithCtx(ctx)
+	}
+
+	tagIds := topLikedTags(exts, likedTagRecallTagNum)
+	if len(tagIds) == 0 {
+		return nil, nil
+	}
+
+	noteIds, err := s.data.NoteExt.ListNoteIdsByTags(ctx, tagIds, limit)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "note ext data list by tags failed").WithExtra("uid", uid).WithCtx(ctx)
+	}
+
+	// 已经点赞过的笔记不再推荐
+	liked := xslice.AsMap(likedNoteIds)
+	noteIds = xslice.Filter(noteIds, func(_ int, noteId int64) bool {
+		_, ok := liked[noteId]
+		return ok
+	})
+
+	return uniformCandidates(noteIds), nil
+}
+
+// 按出现次数选出用户最常点赞的标签 次数相同时较新的标签在前
+func topLikedTags(exts []*notedao.ExtPO, limit int) []int64 {
+	tagFreq := make(map[int64]int)
+	for _, ext := range exts {
+		for _, tagId := range xslice.SplitInts[int64](ext.Tags, ",") {
+			tagFreq[tagId]++
+		}
+	}
+
+	tagIds := make([]int64, 0, len(tagFreq))
+	for tagId := range tagFreq {
+		tagIds = append(tagIds, tagId)
+	}
+	slices.SortFunc(tagIds, func(a, b int64) int {
+		if tagFreq[a] != tagFreq[b] {
+			return tagFreq[b] - tagFreq[a]
+		}
+		return cmp.Compare(b, a)
+	})
+	if len(tagIds) > limit {
+		tagIds = tagIds[:limit]
+	}
+
+	return tagIds
+}
+
+// 最新发布的笔记
+type freshRecommendSource struct {
+	data *data.Data
+}
+
+func (s *freshRecommendSource) Name() string {
+	return RecommendSourceFresh
+}
+
+func (s *freshRecommendSource) Recall(ctx context.Context, uid int64, limit int) ([]*RecommendCandidate, error) {
+	notes, err := s.data.Note.ListByPage(ctx, 1, int32(limit),
+		data.WithNotePrivacyEqual(model.PrivacyPublic),
+		data.WithNoteStateEqual(model.NoteStatePublished),
+	)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "note data list fresh notes failed").WithCtx(ctx)
+	}
+
+	return uniformCandidates(xslice.Extract(notes, func(n *notedao.NotePO) int64 { return n.Id })), nil
+}
+
+// 近期点赞最多的笔记
+type hotRecommendSource struct {
+	data *data.Data
+}
+
+func (s *hotRecommendSource) Name() string {
+	return RecommendSourceHot
+}
+
+func (s *hotRecommendSource) Recall(ctx context.Context, uid int64, limit int) ([]*RecommendCandidate, error) {
+	hots, err := s.data.Recommend.ListHot(ctx, hotRecallDays, limit)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "recommend data list hot failed").WithCtx(ctx)
+	}
+
+	var maxHot int64
+	for _, hot := range hots {
+		maxHot = max(maxHot, hot)
+	}
+
+	candidates := make([]*RecommendCandidate, 0, len(hots))
+	for noteId, hot := range hots {
+		candidates = append(candidates, &RecommendCandidate{
+			NoteId: noteId,
+			Score:  float64(hot) / float64(maxHot),
+		})
+	}
+
+	return candidates, nil
+}
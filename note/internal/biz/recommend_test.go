@@ -0,0 +1,114 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	notedao "github.com/ryanreadbooks/whimer/note/internal/infra/dao/note"
+	"github.com/ryanreadbooks/whimer/note/internal/model"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type fakeRecommendSource struct {
+	name       string
+	candidates []*RecommendCandidate
+	err        error
+}
+
+func (s *fakeRecommendSource) Name() string {
+	return s.name
+}
+
+func (s *fakeRecommendSource) Recall(ctx context.Context, uid int64, limit int) ([]*RecommendCandidate, error) {
+	return s.candidates, s.err
+}
+
+func TestRecommendRecall(t *testing.T) {
+	Convey("recall merges candidates from all sources", t, func() {
+		b := &NoteRecommendBiz{}
+		b.RegisterSource(&fakeRecommendSource{
+			name:       "following",
+			candidates: []*RecommendCandidate{{NoteId: 1, Score: 1}, {NoteId: 2, Score: 0.5}},
+		}, 1.5)
+		b.RegisterSource(&fakeRecommendSource{
+			name:       "hot",
+			candidates: uniformCandidates([]int64{2, 3}),
+		}, 1.0)
+		// 失败的召回源被忽略
+		b.RegisterSource(&fakeRecommendSource{
+			name:       "broken",
+			candidates: uniformCandidates([]int64{4}),
+			err:        errors.New("recall failed"),
+		}, 1.0)
+
+		scores := b.recall(context.Background(), 100)
+		So(scores, ShouldHaveLength, 3)
+		So(scores[1], ShouldAlmostEqual, 1.5)
+		// 被多个召回源召回的笔记只出现一次 得分累加
+		So(scores[2], ShouldAlmostEqual, 1.75)
+		So(scores[3], ShouldAlmostEqual, 1.0)
+		So(scores, ShouldNotContainKey, int64(4))
+	})
+}
+
+func TestPickRecommendItems(t *testing.T) {
+	Convey("pickRecommendItems", t, func() {
+		items := make([]*recommendItem, 0, 4)
+		for _, noteId := range []int64{1, 2, 3, 4} {
+			items = append(items, &recommendItem{note: &model.Note{NoteId: noteId}})
+		}
+		userShown := map[int64]struct{}{2: {}, 3: {}}
+
+		cases := []struct {
+			name    string
+			needNum int
+			want    []int64
+			hasNext bool
+		}{
+			{name: "only fresh", needNum: 2, want: []int64{1, 4}, hasNext: true},
+			{name: "fill with shown", needNum: 3, want: []int64{1, 4, 2}, hasNext: true},
+			{name: "exactly all", needNum: 4, want: []int64{1, 4, 2, 3}, hasNext: false},
+			{name: "not enough", needNum: 10, want: []int64{1, 4, 2, 3}, hasNext: false},
+		}
+
+		for _, c := range cases {
+			Convey(c.name, func() {
+				picked, hasNext := pickRecommendItems(items, userShown, c.needNum)
+				got := make([]int64, 0, len(picked))
+				for _, item := range picked {
+					got = append(got, item.note.NoteId)
+				}
+				So(got, ShouldResemble, c.want)
+				So(hasNext, ShouldEqual, c.hasNext)
+			})
+		}
+	})
+}
+
+func TestTopLikedTags(t *testing.T) {
+	Convey("topLikedTags", t, func() {
+		cases := []struct {
+			name  string
+			tags  []string
+			limit int
+			want  []int64
+		}{
+			{name: "no tags", tags: []string{"", ""}, limit: 5, want: []int64{}},
+			{name: "by frequency", tags: []string{"1,2", "2,3", "2,3"}, limit: 5, want: []int64{2, 3, 1}},
+			{name: "same frequency newer first", tags: []string{"5,7", "6"}, limit: 5, want: []int64{7, 6, 5}},
+			{name: "limited", tags: []string{"1,2,3", "3,4", "3"}, limit: 2, want: []int64{3, 4}},
+		}
+
+		for _, c := range cases {
+			Convey(c.name, func() {
+				exts := make([]*notedao.ExtPO, 0, len(c.tags))
+				for i, tags := range c.tags {
+					exts = append(exts, &notedao.ExtPO{NoteId: int64(i + 1), Tags: tags})
+				}
+				So(topLikedTags(exts, c.limit), ShouldResemble, c.want)
+			})
+		}
+	})
+}
@@ -2,7 +2,11 @@ package biz
 
 import (
 	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
 
+	"github.com/ryanreadbooks/whimer/misc/xslice"
 	"github.com/ryanreadbooks/whimer/note/internal/global"
 	"github.com/ryanreadbooks/whimer/note/internal/model"
 )
@@ -12,6 +16,8 @@ const (
 	maxDescLen  = 1000
 	maxImageLen = 9
 	maxTagCount = 10
+
+	maxPublishAhead = 30 * 24 * time.Hour // 定时发布最多提前30天
 )
 
 type CreateNoteRequestBasic struct {
@@ -19,6 +25,7 @@ type CreateNoteRequestBasic struct {
 	Desc     string         `json:"desc"`
 	Privacy  model.Privacy  `json:"privacy"`
 	NoteType model.NoteType `json:"note_type"`
+	Audience []int64        `json:"audience,omitempty"` // 指定可见的用户 仅PrivacyCustom时有效
 }
 
 type CreateNoteRequestImage struct {
@@ -55,6 +62,10 @@ type CreateNoteRequest struct {
 	Video   *CreateNoteRequestVideo  `json:"video,omitempty"`
 	TagIds  []int64                  `json:"tag_ids"`
 	AtUsers []*model.AtUser          `json:"at_users"`
+
+	// 以下字段仅在创建笔记时生效
+	Draft     bool  `json:"draft"`      // 保存为草稿
+	PublishAt int64 `json:"publish_at"` // 定时发布时间 unix秒 为0表示立即发布
 }
 
 func (r *CreateNoteRequest) Validate() error {
@@ -75,10 +86,24 @@ func (r *CreateNoteRequest) Validate() error {
 		return global.ErrArgs.Msg(fmt.Sprintf("笔记描述最多%d个字符", maxTitleLen))
 	}
 
-	if r.Basic.Privacy != model.PrivacyPublic && r.Basic.Privacy != model.PrivacyPrivate {
+	if !r.Basic.Privacy.IsValid() {
 		return global.ErrArgs.Msg("笔记参数权限不支持")
 	}
 
+	if r.Basic.Privacy == model.PrivacyCustom {
+		r.Basic.Audience = xslice.Uniq(xslice.Filter(r.Basic.Audience, func(_ int, uid int64) bool {
+			return uid <= 0
+		}))
+		if len(r.Basic.Audience) == 0 {
+			return global.ErrArgs.Msg("未指定可见用户")
+		}
+		if len(r.Basic.Audience) > model.MaxNoteAudience {
+			return global.ErrArgs.Msg(fmt.Sprintf("最多指定%d个可见用户", model.MaxNoteAudience))
+		}
+	} else {
+		r.Basic.Audience = nil
+	}
+
 	noteType := model.NoteType(r.Basic.NoteType)
 	switch noteType {
 	case model.AssetTypeImage:
@@ -97,11 +122,34 @@ func (r *CreateNoteRequest) Validate() error {
 		return global.ErrArgs.Msg(fmt.Sprintf("笔记最多支持%d个标签", maxTagCount))
 	}
 
+	if r.PublishAt != 0 {
+		if r.Draft {
+			return global.ErrNotePublishAtInvalid.Msg("草稿不能设置定时发布时间")
+		}
+		if err := ValidatePublishAt(r.PublishAt); err != nil {
+			return err
+		}
+	}
+
 	r.AtUsers = model.FilterInvalidAtUsers(r.AtUsers)
 
 	return nil
 }
 
+// 定时发布时间需要在未来maxPublishAhead时间内 为0表示立即发布
+func ValidatePublishAt(publishAt int64) error {
+	if publishAt == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	if publishAt <= now.Unix() || publishAt > now.Add(maxPublishAhead).Unix() {
+		return global.ErrNotePublishAtInvalid
+	}
+
+	return nil
+}
+
 type CreateNoteResponse struct {
 	NoteId string `json:"note_id"`
 }
@@ -139,6 +187,119 @@ func (r *DeleteNoteRequest) Validate() error {
 	return nil
 }
 
+type PublishDraftRequest struct {
+	NoteId    int64 `json:"note_id"`
+	PublishAt int64 `json:"publish_at"` // 定时发布时间 unix秒 为0表示立即发布
+}
+
+func (r *PublishDraftRequest) Validate() error {
+	if r == nil {
+		return global.ErrNilReq
+	}
+
+	if r.NoteId <= 0 {
+		return global.ErrArgs.Msg("笔记不存在")
+	}
+
+	return ValidatePublishAt(r.PublishAt)
+}
+
+const maxAuditReasonLen = 255
+
+func validateAuditReason(reason string) error {
+	if utf8.RuneCountInString(reason) > maxAuditReasonLen {
+		return global.ErrArgs.Msg("审核原因过长")
+	}
+	return nil
+}
+
+// 人工复审
+type ResolveReviewRequest struct {
+	NoteId   int64  `json:"note_id"`
+	Pass     bool   `json:"pass"`
+	Reason   string `json:"reason,optional"`
+	Operator int64  `json:"operator"`
+}
+
+func (r *ResolveReviewRequest) Validate() error {
+	if r == nil {
+		return global.ErrNilReq
+	}
+
+	if r.NoteId <= 0 {
+		return global.ErrArgs.Msg("笔记不存在")
+	}
+
+	if r.Operator <= 0 {
+		return global.ErrArgs.Msg("未指定审核人")
+	}
+
+	return validateAuditReason(r.Reason)
+}
+
+// 封禁已发布的笔记
+type BanNoteRequest struct {
+	NoteId   int64  `json:"note_id"`
+	Reason   string `json:"reason"`
+	Operator int64  `json:"operator"`
+}
+
+func (r *BanNoteRequest) Validate() error {
+	if r == nil {
+		return global.ErrNilReq
+	}
+
+	if r.NoteId <= 0 {
+		return global.ErrArgs.Msg("笔记不存在")
+	}
+
+	if r.Operator <= 0 {
+		return global.ErrArgs.Msg("未指定审核人")
+	}
+
+	if r.Reason == "" {
+		return global.ErrArgs.Msg("未指定封禁原因")
+	}
+
+	return validateAuditReason(r.Reason)
+}
+
 type GetNoteReq struct {
 	NoteId int64 `path:"note_id"`
 }
+
+const (
+	maxCollectionTitleLen = 40
+	maxCollectionDescLen  = 500
+)
+
+// 新建或者修改合集
+type CollectionRequest struct {
+	CollectionId int64         `json:"collection_id"` // 新建时为0
+	Title        string        `json:"title"`
+	Desc         string        `json:"desc"`
+	Privacy      model.Privacy `json:"privacy"`
+	Cover        int64         `json:"cover"` // 仅修改时有效 为0时使用合集中的第一篇笔记
+}
+
+func (r *CollectionRequest) Validate() error {
+	if r == nil {
+		return global.ErrNilReq
+	}
+
+	r.Title = strings.TrimSpace(r.Title)
+	titleLen := utf8.RuneCountInString(r.Title)
+	if titleLen == 0 || titleLen > maxCollectionTitleLen {
+		return global.ErrArgs.Msg("合集标题长度不合法")
+	}
+
+	if utf8.RuneCountInString(r.Desc) > maxCollectionDescLen {
+		return global.ErrArgs.Msg("合集描述过长")
+	}
+
+	if !model.IsCollectionPrivacyValid(r.Privacy) {
+		return global.ErrCollectionPrivacyInvalid
+	}
+
+	return nil
+}
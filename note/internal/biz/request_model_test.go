@@ -0,0 +1,53 @@
+package biz
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/note/internal/global"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestValidatePublishAt(t *testing.T) {
+	Convey("ValidatePublishAt", t, func() {
+		now := time.Now()
+		cases := []struct {
+			name      string
+			publishAt int64
+			valid     bool
+		}{
+			{name: "publish now", publishAt: 0, valid: true},
+			{name: "in the past", publishAt: now.Add(-time.Minute).Unix(), valid: false},
+			{name: "right now", publishAt: now.Unix(), valid: false},
+			{name: "in a minute", publishAt: now.Add(time.Minute).Unix(), valid: true},
+			{name: "within max ahead", publishAt: now.Add(maxPublishAhead - time.Minute).Unix(), valid: true},
+			{name: "beyond max ahead", publishAt: now.Add(maxPublishAhead + time.Minute).Unix(), valid: false},
+		}
+
+		for _, c := range cases {
+			Convey(c.name, func() {
+				err := ValidatePublishAt(c.publishAt)
+				if c.valid {
+					So(err, ShouldBeNil)
+				} else {
+					So(errors.Is(err, global.ErrNotePublishAtInvalid), ShouldBeTrue)
+				}
+			})
+		}
+	})
+}
+
+func TestPublishDraftRequest_Validate(t *testing.T) {
+	Convey("PublishDraftRequest.Validate", t, func() {
+		var nilReq *PublishDraftRequest
+		So(nilReq.Validate(), ShouldNotBeNil)
+		So((&PublishDraftRequest{NoteId: 0}).Validate(), ShouldNotBeNil)
+		So((&PublishDraftRequest{NoteId: 1}).Validate(), ShouldBeNil)
+		So((&PublishDraftRequest{NoteId: 1, PublishAt: time.Now().Add(time.Hour).Unix()}).Validate(), ShouldBeNil)
+
+		err := (&PublishDraftRequest{NoteId: 1, PublishAt: time.Now().Add(-time.Hour).Unix()}).Validate()
+		So(errors.Is(err, global.ErrNotePublishAtInvalid), ShouldBeTrue)
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/ryanreadbooks/whimer/misc/obfuscate"
 	"github.com/ryanreadbooks/whimer/misc/xconf"
 	"github.com/ryanreadbooks/whimer/misc/xkq/kafka"
+	"github.com/ryanreadbooks/whimer/misc/xkq/outbox"
 	pkgid "github.com/ryanreadbooks/whimer/note/pkg/id"
 
 	"github.com/zeromicro/go-zero/core/discov"
@@ -41,11 +42,15 @@ type Config struct {
 			Comment   xconf.Discovery `json:"comment"`
 			Search    xconf.Discovery `json:"search"`
 			Conductor xconf.Discovery `json:"conductor"`
+			Relation  xconf.Discovery `json:"relation"`
 		} `json:"grpc"`
 	} `json:"external"`
 
 	Kafka *kafka.Config `json:"kafka"`
 
+	// 事件发件箱投递
+	Outbox outbox.RelayConfig `json:"outbox,optional"`
+
 	Salt string `json:"salt"`
 
 	Obfuscate struct {
@@ -59,6 +64,17 @@ type Config struct {
 
 	// 额外使用etcd进行分片分配
 	Etcd discov.EtcdConf `json:"etcd"`
+
+	Moderation ModerationConfig `json:"moderation,optional"`
+
+	// 笔记和搜索文档的定时核对修复
+	EsReconcile EsReconcileConfig `json:"es_reconcile,optional"`
+
+	// 笔记回收站
+	Recycle RecycleConfig `json:"recycle,optional"`
+
+	// 笔记浏览量统计
+	View ViewConfig `json:"view,optional"`
 }
 
 func (c *Config) Init() error {
@@ -75,6 +91,9 @@ func (c *Config) Init() error {
 
 type DevCallbacks struct {
 	NoteProcessCallback string `json:"note_process_callback"`
+
+	// conductor 命名空间的回调签名密钥 为空则不校验签名
+	Secret string `json:"secret,optional"`
 }
 
 type RetryConfig struct {
@@ -92,3 +111,62 @@ type ProcedureTaskRegisterConfig struct {
 	SlotGapSec     int           `json:"slot_gap_sec,default=10"` // 时间片长度 单位秒
 	FutureInterval time.Duration `json:"future_interval,default=1m"`
 }
+
+const (
+	ModerationProviderNone  = "none"  // 不审核 全部通过
+	ModerationProviderLocal = "local" // 本地敏感词和图片指纹审核
+)
+
+type ModerationConfig struct {
+	Provider string                `json:"provider,default=none,options=none|local"`
+	Local    LocalModerationConfig `json:"local,optional"`
+}
+
+type LocalModerationConfig struct {
+	// 敏感词文件 每行一个词 词后可以跟 |review 表示命中后进入人工复审
+	WordListFile string `json:"word_list_file,optional"`
+	// 违规图片感知哈希文件 每行一个16进制的64位哈希
+	ImageHashFile string `json:"image_hash_file,optional"`
+	// 感知哈希汉明距离不超过该值视为命中
+	ImageHashThreshold int `json:"image_hash_threshold,default=8"`
+	// 图片下载地址前缀 和资源key拼接得到图片地址
+	ImageBaseUrl string `json:"image_base_url,optional"`
+	// 文件变更检查间隔
+	ReloadInterval time.Duration `json:"reload_interval,default=30s"`
+}
+
+type EsReconcileConfig struct {
+	Enable bool `json:"enable,optional"`
+	// 只输出核对报告 不修复
+	DryRun   bool          `json:"dry_run,optional"`
+	Interval time.Duration `json:"interval,default=10m"`
+	// 每批核对的笔记数量 也是扫描索引时每批核对的文档数量
+	BatchSize int32 `json:"batch_size,default=200"`
+	// 多实例分片使用的etcd key前缀 不能和其它分片任务相同
+	EtcdKey string `json:"etcd_key,default=/whimer/note/es_reconcile/shard"`
+}
+
+type RecycleConfig struct {
+	// 删除后在回收站中保留的时间 超过后彻底删除
+	Retention     time.Duration `json:"retention,default=720h"`
+	PurgeInterval time.Duration `json:"purge_interval,default=10m"`
+	// 每次彻底删除的笔记数量
+	PurgeBatch int32 `json:"purge_batch,default=100"`
+	// 彻底删除时清理对象存储中的资源 endpoint为空时不清理
+	Oss RecycleOssConfig `json:"oss,optional"`
+}
+
+type RecycleOssConfig struct {
+	Ak       string `json:"ak,optional"`
+	Sk       string `json:"sk,optional"`
+	Endpoint string `json:"endpoint,optional"`
+	Location string `json:"location,optional"`
+	Secure   bool   `json:"secure,optional"`
+}
+
+type ViewConfig struct {
+	// 同一用户对同一笔记的浏览在窗口内只计一次
+	DedupWindow time.Duration `json:"dedup_window,default=24h"`
+	// 浏览量从缓存汇总到数据库的间隔
+	FlushInterval time.Duration `json:"flush_interval,default=1m"`
+}
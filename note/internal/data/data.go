@@ -1,6 +1,9 @@
 package data
 
 import (
+	"context"
+
+	"github.com/ryanreadbooks/whimer/misc/xkq/outbox"
 	"github.com/ryanreadbooks/whimer/misc/xsql"
 	"github.com/ryanreadbooks/whimer/note/internal/config"
 	"github.com/ryanreadbooks/whimer/note/internal/data/event"
@@ -12,6 +15,8 @@ import (
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
 )
 
+const noteOutboxTable = "note_outbox"
+
 // Data 数据层入口
 // 协调数据库和缓存操作，对上层(biz)屏蔽底层数据存取细节
 type Data struct {
@@ -19,11 +24,22 @@ type Data struct {
 	cache *redis.Redis
 	kafka *infrakafka.Publisher
 
+	// 事件发件箱后台投递
+	outboxRelay *outbox.Relay
+
 	Note            *NoteData
 	NoteAsset       *NoteAssetData
 	NoteExt         *NoteExtData
+	NoteDraft       *NoteDraftData
+	NoteRevision    *NoteRevisionData
+	NoteRecycle     *NoteRecycleData
+	NoteView        *NoteViewData
+	NoteCollection  *NoteCollectionData
+	NoteAudit       *NoteAuditData
 	ProcedureRecord *ProcedureRecordData
 	Tag             *TagData
+	Recommend       *RecommendData
+	JobCheckpoint   *JobCheckpointData
 
 	NoteEventBus *event.NoteEventBus
 }
@@ -53,31 +69,63 @@ func MustNew(c *config.Config, cache *redis.Redis, publisher *infrakafka.Publish
 	// 初始化底层dao - note相关
 	noteRepo := notedao.NewNoteRepo(db)
 	noteCache := notedao.NewNoteCache(cache)
+	recommendCache := notedao.NewRecommendCache(cache)
+	jobCheckpointCache := notedao.NewJobCheckpointCache(cache)
 	noteAssetRepo := notedao.NewNoteAssetRepo(db)
 	noteExtRepo := notedao.NewNoteExtRepo(db)
+	noteTagRepo := notedao.NewNoteTagRepo(db)
+	noteDraftRepo := notedao.NewNoteDraftRepo(db)
+	noteRevisionRepo := notedao.NewNoteRevisionRepo(db)
+	noteRecycleRepo := notedao.NewNoteRecycleRepo(db)
+	noteViewRepo := notedao.NewNoteViewRepo(db)
+	noteViewCache := notedao.NewNoteViewCache(cache)
+	noteCollectionRepo := notedao.NewNoteCollectionRepo(db)
+	noteAuditRepo := notedao.NewNoteAuditRepo(db)
 	procedureRecordRepo := notedao.NewProcedureRecordRepo(db)
 
 	// 初始化底层dao - tag相关
 	tagRepo := tagdao.NewTagRepo(db)
 	tagCache := tagdao.NewTagCache(cache)
 
+	// 事件发件箱 需要同步写保证按笔记有序
+	noteOutbox := outbox.New(db, noteOutboxTable)
+
 	return &Data{
-		db:    db,
-		cache: cache,
-		kafka: publisher,
+		db:          db,
+		cache:       cache,
+		kafka:       publisher,
+		outboxRelay: outbox.NewRelay(noteOutbox, publisher.Writer(), c.Outbox),
 
 		// 数据库相关
 		Note:            NewNoteData(noteRepo, noteCache),
 		NoteAsset:       NewNoteAssetData(noteAssetRepo),
-		NoteExt:         NewNoteExtData(noteExtRepo),
+		NoteExt:         NewNoteExtData(noteExtRepo, noteTagRepo),
+		NoteDraft:       NewNoteDraftData(noteDraftRepo),
+		NoteRevision:    NewNoteRevisionData(noteRevisionRepo),
+		NoteRecycle:     NewNoteRecycleData(noteRecycleRepo),
+		NoteView:        NewNoteViewData(noteViewRepo, noteViewCache),
+		NoteCollection:  NewNoteCollectionData(noteCollectionRepo),
+		NoteAudit:       NewNoteAuditData(noteAuditRepo),
 		ProcedureRecord: NewProcedureRecordData(procedureRecordRepo),
 		Tag:             NewTagData(tagRepo, tagCache),
+		Recommend:       NewRecommendData(recommendCache),
+		JobCheckpoint:   NewJobCheckpointData(jobCheckpointCache),
 
 		// 消息队列相关
-		NoteEventBus:    event.NewNoteEventBus(publisher),
+		NoteEventBus:    event.NewNoteEventBus(noteOutbox),
 	}
 }
 
+// 启动发件箱后台投递
+func (d *Data) StartOutboxRelay(ctx context.Context) {
+	d.outboxRelay.Start(ctx)
+}
+
+// 停止发件箱后台投递
+func (d *Data) StopOutboxRelay() {
+	d.outboxRelay.Stop()
+}
+
 func (d *Data) DB() *xsql.DB {
 	return d.db
 }
@@ -44,5 +44,8 @@ func modelNoteToEventNote(note *model.Note) *event.Note {
 		Videos:  videos,
 		Tags:    noteTags,
 		AtUsers: note.AtUsers,
+
+		Privacy:  note.Privacy,
+		Audience: note.Audience,
 	}
 }
@@ -6,12 +6,10 @@ import (
 	"time"
 
 	"github.com/ryanreadbooks/whimer/misc/xerror"
-	infrakfk "github.com/ryanreadbooks/whimer/note/internal/infra/kafka"
+	"github.com/ryanreadbooks/whimer/misc/xkq/outbox"
 	"github.com/ryanreadbooks/whimer/note/internal/model"
 	eventmodel "github.com/ryanreadbooks/whimer/note/internal/model/event"
 	pkgid "github.com/ryanreadbooks/whimer/note/pkg/id"
-
-	"github.com/segmentio/kafka-go"
 )
 
 const (
@@ -19,13 +17,15 @@ const (
 )
 
 // 笔记相关事件统一处理
+//
+// 事件先写入发件箱 在ctx中有事务时和业务数据在同一个事务中提交, 由发件箱后台投递到kafka
 type NoteEventBus struct {
-	pub *infrakfk.Publisher
+	box *outbox.Outbox
 }
 
-func NewNoteEventBus(pub *infrakfk.Publisher) *NoteEventBus {
+func NewNoteEventBus(box *outbox.Outbox) *NoteEventBus {
 	return &NoteEventBus{
-		pub: pub,
+		box: box,
 	}
 }
 
@@ -73,13 +73,13 @@ func (e *NoteEventBus) NotePublished(ctx context.Context, note *model.Note) erro
 			WithCtx(ctx)
 	}
 
-	err = e.pub.AsyncWriter().WriteMessages(ctx, kafka.Message{
+	err = e.box.Add(ctx, outbox.Message{
 		Topic: NoteEventTopic,
 		Key:   []byte(noteId),
 		Value: evtBytes,
 	})
 	if err != nil {
-		return xerror.Wrapf(err, "note event bus note published failed to write message").
+		return xerror.Wrapf(err, "note event bus note published failed to add outbox message").
 			WithExtra("note_id", note.NoteId).
 			WithCtx(ctx)
 	}
@@ -101,13 +101,13 @@ func (e *NoteEventBus) NoteDeleted(ctx context.Context, note *model.Note, reason
 			WithCtx(ctx)
 	}
 
-	err = e.pub.AsyncWriter().WriteMessages(ctx, kafka.Message{
+	err = e.box.Add(ctx, outbox.Message{
 		Topic: NoteEventTopic,
 		Key:   []byte(noteId),
 		Value: evtBytes,
 	})
 	if err != nil {
-		return xerror.Wrapf(err, "note event bus note deleted failed to write message").
+		return xerror.Wrapf(err, "note event bus note deleted failed to add outbox message").
 			WithExtra("note_id", note.NoteId).
 			WithCtx(ctx)
 	}
@@ -161,13 +161,13 @@ func (e *NoteEventBus) NoteLiked(ctx context.Context, noteId, userId, ownerId in
 			WithCtx(ctx)
 	}
 
-	err = e.pub.AsyncWriter().WriteMessages(ctx, kafka.Message{
+	err = e.box.Add(ctx, outbox.Message{
 		Topic: NoteEventTopic,
 		Key:   []byte(noteIdStr),
 		Value: evtBytes,
 	})
 	if err != nil {
-		return xerror.Wrapf(err, "note event bus note liked failed to write message").
+		return xerror.Wrapf(err, "note event bus note liked failed to add outbox message").
 			WithExtra("note_id", noteId).
 			WithCtx(ctx)
 	}
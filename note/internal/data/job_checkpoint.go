@@ -0,0 +1,38 @@
+package data
+
+import (
+	"context"
+
+	notedao "github.com/ryanreadbooks/whimer/note/internal/infra/dao/note"
+)
+
+// JobCheckpointData 后台任务扫描进度 只存放在缓存中
+type JobCheckpointData struct {
+	cache *notedao.JobCheckpointCache
+}
+
+func NewJobCheckpointData(cache *notedao.JobCheckpointCache) *JobCheckpointData {
+	return &JobCheckpointData{
+		cache: cache,
+	}
+}
+
+// Get 获取任务进度 不存在时返回0
+func (d *JobCheckpointData) Get(ctx context.Context, name string) (int64, error) {
+	return d.cache.Get(ctx, name)
+}
+
+// Set 保存任务进度
+func (d *JobCheckpointData) Set(ctx context.Context, name string, cursor int64) error {
+	return d.cache.Set(ctx, name, cursor)
+}
+
+// GetString 获取字符串形式的任务进度 不存在时返回空字符串
+func (d *JobCheckpointData) GetString(ctx context.Context, name string) (string, error) {
+	return d.cache.GetString(ctx, name)
+}
+
+// SetString 保存字符串形式的任务进度
+func (d *JobCheckpointData) SetString(ctx context.Context, name string, cursor string) error {
+	return d.cache.SetString(ctx, name, cursor)
+}
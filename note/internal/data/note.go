@@ -304,6 +304,11 @@ func (d *NoteData) ListByCursor(ctx context.Context, cursor int64, limit int32,
 	return d.repo.ListByCursor(ctx, cursor, limit, conds...)
 }
 
+// ListByIdAsc 按id升序扫描笔记 不经过缓存
+func (d *NoteData) ListByIdAsc(ctx context.Context, afterId int64, limit int32, conds ...NoteCondition) ([]*notedao.NotePO, error) {
+	return d.repo.ListByIdAsc(ctx, afterId, limit, conds...)
+}
+
 // ListByPage 页码分页查询笔记（支持 conditions）
 func (d *NoteData) ListByPage(ctx context.Context, page, count int32, conds ...NoteCondition) ([]*notedao.NotePO, error) {
 	return d.repo.ListByPage(ctx, page, count, conds...)
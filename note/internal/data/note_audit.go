@@ -0,0 +1,38 @@
+package data
+
+import (
+	"context"
+
+	notedao "github.com/ryanreadbooks/whimer/note/internal/infra/dao/note"
+)
+
+// NoteAuditData 笔记审核结果数据层
+type NoteAuditData struct {
+	repo *notedao.NoteAuditRepo
+}
+
+func NewNoteAuditData(repo *notedao.NoteAuditRepo) *NoteAuditData {
+	return &NoteAuditData{
+		repo: repo,
+	}
+}
+
+// Upsert 插入或更新审核结果
+func (d *NoteAuditData) Upsert(ctx context.Context, audit *notedao.AuditPO) error {
+	return d.repo.Upsert(ctx, audit)
+}
+
+// GetById 根据笔记ID获取审核结果
+func (d *NoteAuditData) GetById(ctx context.Context, noteId int64) (*notedao.AuditPO, error) {
+	return d.repo.GetById(ctx, noteId)
+}
+
+// BatchGetByIds 批量获取审核结果
+func (d *NoteAuditData) BatchGetByIds(ctx context.Context, noteIds []int64) ([]*notedao.AuditPO, error) {
+	return d.repo.BatchGetByIds(ctx, noteIds)
+}
+
+// Delete 删除审核结果
+func (d *NoteAuditData) Delete(ctx context.Context, noteId int64) error {
+	return d.repo.Delete(ctx, noteId)
+}
@@ -0,0 +1,164 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+	notedao "github.com/ryanreadbooks/whimer/note/internal/infra/dao/note"
+)
+
+// NoteCollectionData 笔记合集数据层
+type NoteCollectionData struct {
+	repo *notedao.NoteCollectionRepo
+}
+
+func NewNoteCollectionData(repo *notedao.NoteCollectionRepo) *NoteCollectionData {
+	return &NoteCollectionData{
+		repo: repo,
+	}
+}
+
+// Insert 新建合集 返回合集id
+func (d *NoteCollectionData) Insert(ctx context.Context, po *notedao.CollectionPO) (int64, error) {
+	return d.repo.Insert(ctx, po)
+}
+
+// Update 更新合集信息
+func (d *NoteCollectionData) Update(ctx context.Context, po *notedao.CollectionPO) error {
+	return d.repo.Update(ctx, po)
+}
+
+// UpdateCover 更新合集封面
+func (d *NoteCollectionData) UpdateCover(ctx context.Context, id, cover int64) error {
+	return d.repo.UpdateCover(ctx, id, cover, time.Now().Unix())
+}
+
+// GetById 获取合集
+func (d *NoteCollectionData) GetById(ctx context.Context, id int64) (*notedao.CollectionPO, error) {
+	return d.repo.GetById(ctx, id)
+}
+
+// GetByIdForUpdate 获取合集并加锁 需要在事务中调用
+func (d *NoteCollectionData) GetByIdForUpdate(ctx context.Context, id int64) (*notedao.CollectionPO, error) {
+	return d.repo.GetByIdForUpdate(ctx, id)
+}
+
+// ListByOwner 列出用户的全部合集
+func (d *NoteCollectionData) ListByOwner(ctx context.Context, owner int64) ([]*notedao.CollectionPO, error) {
+	return d.repo.ListByOwner(ctx, owner)
+}
+
+// CountByOwnerForUpdate 获取用户的合集数量并加锁 需要在事务中调用
+func (d *NoteCollectionData) CountByOwnerForUpdate(ctx context.Context, owner int64) (int64, error) {
+	return d.repo.CountByOwnerForUpdate(ctx, owner)
+}
+
+// Delete 删除合集以及合集中的笔记关系
+func (d *NoteCollectionData) Delete(ctx context.Context, id int64) error {
+	err := d.repo.DeleteItemsByCollection(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return d.repo.Delete(ctx, id)
+}
+
+// AddItems 添加笔记到合集
+func (d *NoteCollectionData) AddItems(ctx context.Context, items []*notedao.CollectionItemPO) error {
+	return d.repo.BatchInsertItems(ctx, items)
+}
+
+// RemoveItems 从合集中移除笔记
+func (d *NoteCollectionData) RemoveItems(ctx context.Context, collectionId int64, noteIds []int64) error {
+	return d.repo.DeleteItems(ctx, collectionId, noteIds)
+}
+
+// UpdateItemSeq 更新笔记在合集中的顺序
+func (d *NoteCollectionData) UpdateItemSeq(ctx context.Context, collectionId, noteId, seq int64) error {
+	return d.repo.UpdateItemSeq(ctx, collectionId, noteId, seq)
+}
+
+// GetItemByNoteId 获取笔记所属的合集 不属于任何合集时返回xsql.ErrNoRecord
+func (d *NoteCollectionData) GetItemByNoteId(ctx context.Context, noteId int64) (*notedao.CollectionItemPO, error) {
+	return d.repo.GetItemByNoteId(ctx, noteId)
+}
+
+// BatchGetItemByNoteIds 批量获取笔记所属的合集 noteId -> item
+func (d *NoteCollectionData) BatchGetItemByNoteIds(ctx context.Context, noteIds []int64) (
+	map[int64]*notedao.CollectionItemPO, error,
+) {
+	pos, err := d.repo.BatchGetItemByNoteIds(ctx, noteIds)
+	if err != nil && !xsql.IsNoRecord(err) {
+		return nil, err
+	}
+
+	res := make(map[int64]*notedao.CollectionItemPO, len(pos))
+	for _, po := range pos {
+		res[po.NoteId] = po
+	}
+
+	return res, nil
+}
+
+// ListItems 按顺序列出合集中的笔记
+func (d *NoteCollectionData) ListItems(ctx context.Context, collectionId int64) ([]*notedao.CollectionItemPO, error) {
+	items, err := d.repo.ListItems(ctx, collectionId)
+	if err != nil && !xsql.IsNoRecord(err) {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// BatchCountItems 批量获取合集中的笔记数量 collectionId -> count
+func (d *NoteCollectionData) BatchCountItems(ctx context.Context, collectionIds []int64) (map[int64]int64, error) {
+	pos, err := d.repo.BatchCountItems(ctx, collectionIds)
+	if err != nil && !xsql.IsNoRecord(err) {
+		return nil, err
+	}
+
+	res := make(map[int64]int64, len(pos))
+	for _, po := range pos {
+		res[po.CollectionId] = po.Cnt
+	}
+
+	return res, nil
+}
+
+// RemoveNote 将笔记移出所属的合集 笔记是合集封面时使用合集中的第一篇笔记作为新封面
+func (d *NoteCollectionData) RemoveNote(ctx context.Context, noteId int64) error {
+	item, err := d.repo.GetItemByNoteId(ctx, noteId)
+	if err != nil {
+		if xsql.IsNoRecord(err) {
+			return nil
+		}
+		return err
+	}
+
+	collection, err := d.repo.GetByIdForUpdate(ctx, item.CollectionId)
+	if err != nil {
+		return err
+	}
+
+	err = d.repo.DeleteItems(ctx, item.CollectionId, []int64{noteId})
+	if err != nil {
+		return err
+	}
+
+	if collection.Cover != noteId {
+		return nil
+	}
+
+	items, err := d.ListItems(ctx, item.CollectionId)
+	if err != nil {
+		return err
+	}
+
+	var cover int64
+	if len(items) > 0 {
+		cover = items[0].NoteId
+	}
+
+	return d.repo.UpdateCover(ctx, item.CollectionId, cover, time.Now().Unix())
+}
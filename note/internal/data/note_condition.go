@@ -37,3 +37,17 @@ func WithNotePrivacyEqual(privacy model.Privacy) NoteCondition {
 	return notedao.WithNotePrivacyEqual(privacy)
 }
 
+// WithNotePrivacyIn 指定多个笔记隐私类型
+func WithNotePrivacyIn(privacies ...model.Privacy) NoteCondition {
+	return notedao.WithNotePrivacyIn(privacies...)
+}
+
+// WithNoteOwnerIn 指定多个笔记所有者
+func WithNoteOwnerIn(uids ...int64) NoteCondition {
+	return notedao.WithNoteOwnerIn(uids...)
+}
+
+// WithNoteIdShard 按id取模分片
+func WithNoteIdShard(idx, total int) NoteCondition {
+	return notedao.WithNoteIdShard(idx, total)
+}
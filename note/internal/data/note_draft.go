@@ -0,0 +1,38 @@
+package data
+
+import (
+	"context"
+
+	notedao "github.com/ryanreadbooks/whimer/note/internal/infra/dao/note"
+)
+
+// NoteDraftData 笔记草稿数据层
+type NoteDraftData struct {
+	repo *notedao.NoteDraftRepo
+}
+
+func NewNoteDraftData(repo *notedao.NoteDraftRepo) *NoteDraftData {
+	return &NoteDraftData{
+		repo: repo,
+	}
+}
+
+// Upsert 插入或更新草稿信息
+func (d *NoteDraftData) Upsert(ctx context.Context, draft *notedao.DraftPO) error {
+	return d.repo.Upsert(ctx, draft)
+}
+
+// UpdatePublishAt 更新定时发布时间
+func (d *NoteDraftData) UpdatePublishAt(ctx context.Context, noteId int64, publishAt int64) error {
+	return d.repo.UpdatePublishAt(ctx, noteId, publishAt)
+}
+
+// GetById 根据笔记ID获取草稿信息
+func (d *NoteDraftData) GetById(ctx context.Context, noteId int64) (*notedao.DraftPO, error) {
+	return d.repo.GetById(ctx, noteId)
+}
+
+// Delete 删除草稿信息
+func (d *NoteDraftData) Delete(ctx context.Context, noteId int64) error {
+	return d.repo.Delete(ctx, noteId)
+}
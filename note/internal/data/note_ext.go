@@ -3,28 +3,46 @@ package data
 import (
 	"context"
 
+	"github.com/ryanreadbooks/whimer/misc/xslice"
 	notedao "github.com/ryanreadbooks/whimer/note/internal/infra/dao/note"
 )
 
 // NoteExtData 笔记扩展信息数据层
 type NoteExtData struct {
-	repo *notedao.NoteExtRepo
+	repo    *notedao.NoteExtRepo
+	tagRepo *notedao.NoteTagRepo
 }
 
-func NewNoteExtData(repo *notedao.NoteExtRepo) *NoteExtData {
+func NewNoteExtData(repo *notedao.NoteExtRepo, tagRepo *notedao.NoteTagRepo) *NoteExtData {
 	return &NoteExtData{
-		repo: repo,
+		repo:    repo,
+		tagRepo: tagRepo,
 	}
 }
 
-// Upsert 插入或更新扩展信息
+// Upsert 插入或更新扩展信息 同时更新笔记和标签的关联 需要在事务中调用
 func (d *NoteExtData) Upsert(ctx context.Context, ext *notedao.ExtPO) error {
-	return d.repo.Upsert(ctx, ext)
+	err := d.repo.Upsert(ctx, ext)
+	if err != nil {
+		return err
+	}
+
+	var tagIds []int64
+	if ext.Tags != "" {
+		tagIds = xslice.Uniq(xslice.SplitInts[int64](ext.Tags, ","))
+	}
+
+	return d.tagRepo.Replace(ctx, ext.NoteId, tagIds)
 }
 
-// Delete 删除扩展信息
+// Delete 删除扩展信息和笔记标签关联 需要在事务中调用
 func (d *NoteExtData) Delete(ctx context.Context, noteId int64) error {
-	return d.repo.Delete(ctx, noteId)
+	err := d.repo.Delete(ctx, noteId)
+	if err != nil {
+		return err
+	}
+
+	return d.tagRepo.DeleteByNoteId(ctx, noteId)
 }
 
 // GetById 根据笔记ID获取扩展信息
@@ -36,3 +54,8 @@ func (d *NoteExtData) GetById(ctx context.Context, noteId int64) (*notedao.ExtPO
 func (d *NoteExtData) BatchGetById(ctx context.Context, noteIds []int64) ([]*notedao.ExtPO, error) {
 	return d.repo.BatchGetById(ctx, noteIds)
 }
+
+// ListNoteIdsByTags 获取带有任一指定标签的笔记id
+func (d *NoteExtData) ListNoteIdsByTags(ctx context.Context, tagIds []int64, limit int) ([]int64, error) {
+	return d.tagRepo.ListNoteIdsByTags(ctx, tagIds, limit)
+}
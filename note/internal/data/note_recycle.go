@@ -0,0 +1,53 @@
+package data
+
+import (
+	"context"
+
+	notedao "github.com/ryanreadbooks/whimer/note/internal/infra/dao/note"
+)
+
+// NoteRecycleData 笔记回收站数据层
+type NoteRecycleData struct {
+	repo *notedao.NoteRecycleRepo
+}
+
+func NewNoteRecycleData(repo *notedao.NoteRecycleRepo) *NoteRecycleData {
+	return &NoteRecycleData{
+		repo: repo,
+	}
+}
+
+// Upsert 放入回收站
+func (d *NoteRecycleData) Upsert(ctx context.Context, po *notedao.RecyclePO) error {
+	return d.repo.Upsert(ctx, po)
+}
+
+// GetById 获取笔记的回收站记录
+func (d *NoteRecycleData) GetById(ctx context.Context, noteId int64) (*notedao.RecyclePO, error) {
+	return d.repo.GetById(ctx, noteId)
+}
+
+// BatchGetById 批量获取笔记的回收站记录
+func (d *NoteRecycleData) BatchGetById(ctx context.Context, noteIds []int64) (map[int64]*notedao.RecyclePO, error) {
+	pos, err := d.repo.BatchGetById(ctx, noteIds)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[int64]*notedao.RecyclePO, len(pos))
+	for _, po := range pos {
+		res[po.NoteId] = po
+	}
+
+	return res, nil
+}
+
+// ListExpired 列出已经过期需要彻底删除的记录
+func (d *NoteRecycleData) ListExpired(ctx context.Context, before int64, limit int32) ([]*notedao.RecyclePO, error) {
+	return d.repo.ListExpired(ctx, before, limit)
+}
+
+// Delete 移出回收站
+func (d *NoteRecycleData) Delete(ctx context.Context, noteId int64) error {
+	return d.repo.Delete(ctx, noteId)
+}
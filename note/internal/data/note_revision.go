@@ -0,0 +1,43 @@
+package data
+
+import (
+	"context"
+
+	notedao "github.com/ryanreadbooks/whimer/note/internal/infra/dao/note"
+)
+
+// NoteRevisionData 笔记修订版本数据层
+type NoteRevisionData struct {
+	repo *notedao.NoteRevisionRepo
+}
+
+func NewNoteRevisionData(repo *notedao.NoteRevisionRepo) *NoteRevisionData {
+	return &NoteRevisionData{
+		repo: repo,
+	}
+}
+
+// Insert 插入新版本
+func (d *NoteRevisionData) Insert(ctx context.Context, rev *notedao.RevisionPO) error {
+	return d.repo.Insert(ctx, rev)
+}
+
+// Get 获取指定版本
+func (d *NoteRevisionData) Get(ctx context.Context, noteId, version int64) (*notedao.RevisionPO, error) {
+	return d.repo.Get(ctx, noteId, version)
+}
+
+// GetLatest 获取最新版本
+func (d *NoteRevisionData) GetLatest(ctx context.Context, noteId int64) (*notedao.RevisionPO, error) {
+	return d.repo.GetLatest(ctx, noteId)
+}
+
+// ListByCursor 按版本号倒序列出版本
+func (d *NoteRevisionData) ListByCursor(ctx context.Context, noteId, cursor int64, count int32) ([]*notedao.RevisionPO, error) {
+	return d.repo.ListByCursor(ctx, noteId, cursor, count)
+}
+
+// DeleteByNoteId 删除笔记的全部版本
+func (d *NoteRevisionData) DeleteByNoteId(ctx context.Context, noteId int64) error {
+	return d.repo.DeleteByNoteId(ctx, noteId)
+}
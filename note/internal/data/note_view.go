@@ -0,0 +1,81 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	notedao "github.com/ryanreadbooks/whimer/note/internal/infra/dao/note"
+)
+
+// NoteViewData 笔记浏览量数据层 去重和待汇总的浏览量在缓存中 汇总后的浏览量在数据库中
+type NoteViewData struct {
+	repo  *notedao.NoteViewRepo
+	cache *notedao.NoteViewCache
+}
+
+func NewNoteViewData(repo *notedao.NoteViewRepo, cache *notedao.NoteViewCache) *NoteViewData {
+	return &NoteViewData{
+		repo:  repo,
+		cache: cache,
+	}
+}
+
+// AddView 记录一次浏览 窗口内重复的浏览者不计数 返回是否计数
+func (d *NoteViewData) AddView(ctx context.Context, noteId int64, viewer string, window time.Duration) (bool, error) {
+	added, err := d.cache.AddViewer(ctx, noteId, viewer, window)
+	if err != nil || !added {
+		return false, err
+	}
+
+	err = d.cache.IncrPending(ctx, noteId, 1)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// TakePending 取出等待汇总的浏览量和批次id
+func (d *NoteViewData) TakePending(ctx context.Context) (string, map[int64]int64, error) {
+	return d.cache.TakePending(ctx)
+}
+
+// AckPending 确认已经汇总的浏览量
+func (d *NoteViewData) AckPending(ctx context.Context) error {
+	return d.cache.AckPending(ctx)
+}
+
+// BatchIncr 批量累加数据库中的浏览量
+func (d *NoteViewData) BatchIncr(ctx context.Context, deltas map[int64]int64) error {
+	return d.repo.BatchIncr(ctx, deltas)
+}
+
+// MarkFlushed 记录已经汇总的批次 批次已经汇总过时返回false
+func (d *NoteViewData) MarkFlushed(ctx context.Context, batchId string) (bool, error) {
+	return d.repo.MarkFlushed(ctx, batchId)
+}
+
+// PurgeFlushed 清理过期的汇总批次记录
+func (d *NoteViewData) PurgeFlushed(ctx context.Context, before int64, limit int) (int64, error) {
+	return d.repo.PurgeFlushed(ctx, before, limit)
+}
+
+// BatchGet 批量获取浏览量 没有记录的笔记不在结果中
+func (d *NoteViewData) BatchGet(ctx context.Context, noteIds []int64) (map[int64]int64, error) {
+	pos, err := d.repo.BatchGetById(ctx, noteIds)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[int64]int64, len(pos))
+	for _, po := range pos {
+		res[po.NoteId] = po.Views
+	}
+
+	return res, nil
+}
+
+// Delete 删除笔记的浏览量
+func (d *NoteViewData) Delete(ctx context.Context, noteId int64) error {
+	return d.repo.Delete(ctx, noteId)
+}
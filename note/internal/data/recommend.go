@@ -0,0 +1,48 @@
+package data
+
+import (
+	"context"
+
+	notedao "github.com/ryanreadbooks/whimer/note/internal/infra/dao/note"
+)
+
+// RecommendData 推荐数据层 热度和曝光记录只存放在缓存中
+type RecommendData struct {
+	cache *notedao.RecommendCache
+}
+
+func NewRecommendData(cache *notedao.RecommendCache) *RecommendData {
+	return &RecommendData{
+		cache: cache,
+	}
+}
+
+// IncrHot 增加笔记热度
+func (d *RecommendData) IncrHot(ctx context.Context, noteId int64, delta int64) error {
+	return d.cache.IncrHot(ctx, noteId, delta)
+}
+
+// ListHot 获取最近days天的热门笔记及其热度
+func (d *RecommendData) ListHot(ctx context.Context, days int, limit int) (map[int64]int64, error) {
+	return d.cache.ListHot(ctx, days, limit)
+}
+
+// AddUserShown 记录向用户曝光的笔记
+func (d *RecommendData) AddUserShown(ctx context.Context, uid int64, noteIds []int64) error {
+	return d.cache.AddUserShown(ctx, uid, noteIds)
+}
+
+// GetUserShown 获取向用户曝光过的笔记
+func (d *RecommendData) GetUserShown(ctx context.Context, uid int64) (map[int64]struct{}, error) {
+	return d.cache.GetUserShown(ctx, uid)
+}
+
+// AddSessionShown 记录推荐会话中返回的笔记
+func (d *RecommendData) AddSessionShown(ctx context.Context, session string, noteIds []int64) error {
+	return d.cache.AddSessionShown(ctx, session, noteIds)
+}
+
+// GetSessionShown 获取推荐会话中返回过的笔记
+func (d *RecommendData) GetSessionShown(ctx context.Context, session string) (map[int64]struct{}, error) {
+	return d.cache.GetSessionShown(ctx, session)
+}
@@ -78,6 +78,7 @@ func convertToNoteCreateReq(in *notev1.CreateNoteRequest) *biz.CreateNoteRequest
 			Desc:     in.Basic.Desc,
 			Privacy:  model.Privacy(in.Basic.Privacy),
 			NoteType: model.NoteType(in.Basic.AssetType),
+			Audience: in.Basic.GetAudience(),
 		},
 		Images:  images,
 		TagIds:  in.GetTags().GetTagList(),
@@ -95,6 +96,8 @@ func (s *NoteCreatorServiceServer) CreateNote(ctx context.Context, in *notev1.Cr
 	}
 
 	req := convertToNoteCreateReq(in)
+	req.Draft = in.GetDraft()
+	req.PublishAt = in.GetPublishAt()
 	if err := req.Validate(); err != nil {
 		return nil, err
 	}
@@ -180,7 +183,7 @@ func (s *NoteCreatorServiceServer) GetNote(ctx context.Context, in *notev1.GetNo
 		return nil, err
 	}
 
-	return &notev1.GetNoteResponse{Note: data.AsPb()}, nil
+	return &notev1.GetNoteResponse{Note: data.AsOwnerPb()}, nil
 }
 
 // 列出笔记
@@ -194,7 +197,7 @@ func (s *NoteCreatorServiceServer) ListNote(ctx context.Context, in *notev1.List
 
 	items := make([]*notev1.NoteItem, 0, len(data.Items))
 	for _, item := range data.Items {
-		items = append(items, item.AsPb())
+		items = append(items, item.AsOwnerPb())
 	}
 
 	return &notev1.ListNoteResponse{
@@ -204,6 +207,141 @@ func (s *NoteCreatorServiceServer) ListNote(ctx context.Context, in *notev1.List
 	}, nil
 }
 
+// 列出草稿
+func (s *NoteCreatorServiceServer) ListDrafts(ctx context.Context, in *notev1.ListDraftsRequest) (
+	*notev1.ListDraftsResponse, error,
+) {
+	if in.Count <= 0 || in.Count > 20 {
+		in.Count = 20
+	}
+
+	data, nextPage, err := s.Srv.NoteCreatorSrv.ListDrafts(ctx, in.Cursor, in.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*notev1.NoteItem, 0, len(data.Items))
+	for _, item := range data.Items {
+		items = append(items, item.AsOwnerPb())
+	}
+
+	return &notev1.ListDraftsResponse{
+		Items:      items,
+		NextCursor: nextPage.NextCursor,
+		HasNext:    nextPage.HasNext,
+	}, nil
+}
+
+// 发布草稿
+func (s *NoteCreatorServiceServer) PublishDraft(ctx context.Context, in *notev1.PublishDraftRequest) (
+	*notev1.PublishDraftResponse, error,
+) {
+	if in == nil {
+		return nil, global.ErrNilReq
+	}
+
+	req := biz.PublishDraftRequest{
+		NoteId:    in.NoteId,
+		PublishAt: in.PublishAt,
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	err := s.Srv.NoteCreatorSrv.PublishDraft(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &notev1.PublishDraftResponse{}, nil
+}
+
+// 列出笔记的修订版本
+func (s *NoteCreatorServiceServer) ListNoteRevisions(ctx context.Context, in *notev1.ListNoteRevisionsRequest) (
+	*notev1.ListNoteRevisionsResponse, error,
+) {
+	if in.Count <= 0 || in.Count > 20 {
+		in.Count = 20
+	}
+
+	revs, nextPage, err := s.Srv.NoteCreatorSrv.ListRevisions(ctx, in.NoteId, in.Cursor, in.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*notev1.NoteRevision, 0, len(revs))
+	for _, rev := range revs {
+		items = append(items, rev.AsPb())
+	}
+
+	return &notev1.ListNoteRevisionsResponse{
+		Items:      items,
+		NextCursor: nextPage.NextCursor,
+		HasNext:    nextPage.HasNext,
+	}, nil
+}
+
+// 获取笔记的修订版本
+func (s *NoteCreatorServiceServer) GetNoteRevision(ctx context.Context, in *notev1.GetNoteRevisionRequest) (
+	*notev1.GetNoteRevisionResponse, error,
+) {
+	rev, err := s.Srv.NoteCreatorSrv.GetRevision(ctx, in.NoteId, in.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &notev1.GetNoteRevisionResponse{Revision: rev.AsPb()}, nil
+}
+
+// 恢复到某个修订版本
+func (s *NoteCreatorServiceServer) RestoreNoteRevision(ctx context.Context, in *notev1.RestoreNoteRevisionRequest) (
+	*notev1.RestoreNoteRevisionResponse, error,
+) {
+	err := s.Srv.NoteCreatorSrv.RestoreRevision(ctx, in.NoteId, in.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &notev1.RestoreNoteRevisionResponse{}, nil
+}
+
+// 列出回收站中的笔记
+func (s *NoteCreatorServiceServer) ListDeletedNotes(ctx context.Context, in *notev1.ListDeletedNotesRequest) (
+	*notev1.ListDeletedNotesResponse, error,
+) {
+	if in.Count <= 0 || in.Count > 20 {
+		in.Count = 20
+	}
+
+	data, nextPage, err := s.Srv.NoteCreatorSrv.ListDeleted(ctx, in.Cursor, in.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*notev1.DeletedNoteItem, 0, len(data))
+	for _, item := range data {
+		items = append(items, item.AsPb())
+	}
+
+	return &notev1.ListDeletedNotesResponse{
+		Items:      items,
+		NextCursor: nextPage.NextCursor,
+		HasNext:    nextPage.HasNext,
+	}, nil
+}
+
+// 从回收站中恢复笔记
+func (s *NoteCreatorServiceServer) RestoreNote(ctx context.Context, in *notev1.RestoreNoteRequest) (
+	*notev1.RestoreNoteResponse, error,
+) {
+	err := s.Srv.NoteCreatorSrv.Restore(ctx, in.NoteId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &notev1.RestoreNoteResponse{}, nil
+}
+
 func (s *NoteCreatorServiceServer) GetPostedCount(ctx context.Context, in *notev1.GetPostedCountRequest) (
 	*notev1.GetPostedCountResponse, error,
 ) {
@@ -231,7 +369,7 @@ func (s *NoteCreatorServiceServer) PageListNote(ctx context.Context,
 
 	items := make([]*notev1.NoteItem, 0, len(data.Items))
 	for _, item := range data.Items {
-		items = append(items, item.AsPb())
+		items = append(items, item.AsOwnerPb())
 	}
 
 	return &notev1.PageListNoteResponse{
@@ -254,3 +392,114 @@ func (s *NoteCreatorServiceServer) AddTag(ctx context.Context, in *notev1.AddTag
 
 	return &notev1.AddTagResponse{Id: id}, nil
 }
+
+// 新建合集
+func (s *NoteCreatorServiceServer) CreateCollection(ctx context.Context, in *notev1.CreateCollectionRequest) (
+	*notev1.CreateCollectionResponse, error,
+) {
+	id, err := s.Srv.NoteCollectionSrv.Create(ctx, &srv.CollectionRequest{
+		Title:   in.Title,
+		Desc:    in.Desc,
+		Privacy: model.Privacy(in.Privacy),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &notev1.CreateCollectionResponse{CollectionId: id}, nil
+}
+
+// 修改合集信息
+func (s *NoteCreatorServiceServer) UpdateCollection(ctx context.Context, in *notev1.UpdateCollectionRequest) (
+	*notev1.UpdateCollectionResponse, error,
+) {
+	err := s.Srv.NoteCollectionSrv.Update(ctx, &srv.CollectionRequest{
+		CollectionId: in.CollectionId,
+		Title:        in.Title,
+		Desc:         in.Desc,
+		Privacy:      model.Privacy(in.Privacy),
+		Cover:        in.CoverNoteId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &notev1.UpdateCollectionResponse{}, nil
+}
+
+// 删除合集
+func (s *NoteCreatorServiceServer) DeleteCollection(ctx context.Context, in *notev1.DeleteCollectionRequest) (
+	*notev1.DeleteCollectionResponse, error,
+) {
+	err := s.Srv.NoteCollectionSrv.Delete(ctx, in.CollectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &notev1.DeleteCollectionResponse{}, nil
+}
+
+// 获取合集详情
+func (s *NoteCreatorServiceServer) GetCollection(ctx context.Context, in *notev1.GetCollectionRequest) (
+	*notev1.GetCollectionResponse, error,
+) {
+	collection, err := s.Srv.NoteCollectionSrv.Get(ctx, in.CollectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &notev1.GetCollectionResponse{Collection: collection.AsPb()}, nil
+}
+
+// 列出自己的全部合集
+func (s *NoteCreatorServiceServer) ListCollections(ctx context.Context, in *notev1.ListCollectionsRequest) (
+	*notev1.ListCollectionsResponse, error,
+) {
+	collections, err := s.Srv.NoteCollectionSrv.ListOwned(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*notev1.NoteCollection, 0, len(collections))
+	for _, c := range collections {
+		items = append(items, c.AsPb())
+	}
+
+	return &notev1.ListCollectionsResponse{Items: items}, nil
+}
+
+// 添加笔记到合集
+func (s *NoteCreatorServiceServer) AddCollectionNotes(ctx context.Context, in *notev1.AddCollectionNotesRequest) (
+	*notev1.AddCollectionNotesResponse, error,
+) {
+	err := s.Srv.NoteCollectionSrv.AddNotes(ctx, in.CollectionId, in.NoteIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &notev1.AddCollectionNotesResponse{}, nil
+}
+
+// 从合集中移除笔记
+func (s *NoteCreatorServiceServer) RemoveCollectionNotes(ctx context.Context, in *notev1.RemoveCollectionNotesRequest) (
+	*notev1.RemoveCollectionNotesResponse, error,
+) {
+	err := s.Srv.NoteCollectionSrv.RemoveNotes(ctx, in.CollectionId, in.NoteIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &notev1.RemoveCollectionNotesResponse{}, nil
+}
+
+// 调整合集中笔记的顺序
+func (s *NoteCreatorServiceServer) SortCollectionNotes(ctx context.Context, in *notev1.SortCollectionNotesRequest) (
+	*notev1.SortCollectionNotesResponse, error,
+) {
+	err := s.Srv.NoteCollectionSrv.SortNotes(ctx, in.CollectionId, in.NoteIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &notev1.SortCollectionNotesResponse{}, nil
+}
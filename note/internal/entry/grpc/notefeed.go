@@ -41,6 +41,21 @@ func (s *NoteFeedServiceServer) RandomGet(ctx context.Context, in *notev1.Random
 	}, nil
 }
 
+func (s *NoteFeedServiceServer) RecommendGet(ctx context.Context, in *notev1.RecommendGetRequest) (
+	*notev1.RecommendGetResponse, error,
+) {
+	notes, nextCursor, hasNext, err := s.Srv.NoteFeedSrv.RecommendGet(ctx, in.Uid, in.NeedNum, in.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &notev1.RecommendGetResponse{
+		Items:      model.PbFeedNoteItemsFromNotes(notes),
+		NextCursor: nextCursor,
+		HasNext:    hasNext,
+	}, nil
+}
+
 func (s *NoteFeedServiceServer) GetFeedNote(ctx context.Context, in *notev1.GetFeedNoteRequest) (
 	*notev1.GetFeedNoteResponse, error) {
 	resp, err := s.Srv.NoteFeedSrv.GetNoteDetail(ctx, in.NoteId)
@@ -51,8 +66,9 @@ func (s *NoteFeedServiceServer) GetFeedNote(ctx context.Context, in *notev1.GetF
 	return &notev1.GetFeedNoteResponse{
 		Item: resp.AsFeedPb(),
 		Ext: &notev1.FeedNoteItemExt{
-			Tags:    model.NoteTagListAsPb(resp.Tags),
-			AtUsers: model.AtUsersAsPb(resp.AtUsers),
+			Tags:       model.NoteTagListAsPb(resp.Tags),
+			AtUsers:    model.AtUsersAsPb(resp.AtUsers),
+			Collection: resp.Collection.AsPb(),
 		}}, nil
 }
 
@@ -182,3 +198,44 @@ func (s *NoteFeedServiceServer) GetNoteAuthor(ctx context.Context, in *notev1.Ge
 
 	return &notev1.GetNoteAuthorResponse{Author: author}, nil
 }
+
+// 列出用户公开的合集
+func (s *NoteFeedServiceServer) ListUserCollections(ctx context.Context, in *notev1.ListUserCollectionsRequest) (
+	*notev1.ListUserCollectionsResponse, error) {
+	collections, err := s.Srv.NoteCollectionSrv.ListUserCollections(ctx, in.Uid)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*notev1.NoteCollection, 0, len(collections))
+	for _, c := range collections {
+		items = append(items, c.AsPb())
+	}
+
+	return &notev1.ListUserCollectionsResponse{Items: items}, nil
+}
+
+// 按顺序列出合集中公开的笔记
+func (s *NoteFeedServiceServer) ListCollectionFeed(ctx context.Context, in *notev1.ListCollectionFeedRequest) (
+	*notev1.ListCollectionFeedResponse, error) {
+	if in.Count <= 0 || in.Count > 20 {
+		in.Count = 20
+	}
+
+	collection, resp, page, err := s.Srv.NoteCollectionSrv.ListFeed(ctx, in.CollectionId, in.Cursor, in.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*notev1.FeedNoteItem, 0, len(resp.Items))
+	for _, item := range resp.Items {
+		items = append(items, item.AsFeedPb())
+	}
+
+	return &notev1.ListCollectionFeedResponse{
+		Collection: collection.AsPb(),
+		Items:      items,
+		NextCursor: page.NextCursor,
+		HasNext:    page.HasNext,
+	}, nil
+}
@@ -90,10 +90,22 @@ func (s *NoteInteractServiceServer) GetNoteInteraction(ctx context.Context, in *
 	return &notev1.GetNoteInteractionResponse{
 		Interaction: &notev1.NoteInteraction{
 			Liked: resp.Liked,
+			Views: resp.Views,
 		},
 	}, nil
 }
 
+// 批量上报笔记浏览
+func (s *NoteInteractServiceServer) ReportNoteViews(ctx context.Context, in *notev1.ReportNoteViewsRequest) (
+	*notev1.ReportNoteViewsResponse, error) {
+	err := s.Srv.NoteInteractSrv.ReportNoteViews(ctx, in.NoteIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &notev1.ReportNoteViewsResponse{}, nil
+}
+
 // 列出用户点赞过的笔记
 func (s *NoteInteractServiceServer) PageListUserLikedNote(ctx context.Context, in *notev1.PageListUserLikedNoteRequest) (
 	*notev1.PageListUserLikedNoteResponse, error) {
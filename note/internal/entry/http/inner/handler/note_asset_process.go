@@ -4,9 +4,12 @@ import (
 	"encoding/json"
 	"net/http"
 
+	conductorcallback "github.com/ryanreadbooks/whimer/conductor/pkg/go/sdk/callback"
 	conductorsdk "github.com/ryanreadbooks/whimer/conductor/pkg/go/sdk/task"
+	"github.com/ryanreadbooks/whimer/misc/xerror"
 	"github.com/ryanreadbooks/whimer/misc/xhttp"
 	"github.com/ryanreadbooks/whimer/misc/xlog"
+	"github.com/ryanreadbooks/whimer/note/internal/config"
 	"github.com/ryanreadbooks/whimer/note/internal/model"
 	"github.com/ryanreadbooks/whimer/note/internal/srv"
 	"github.com/zeromicro/go-zero/rest/httpx"
@@ -43,6 +46,18 @@ type VideoOutput struct {
 // NoteAssetProcessCallback 笔记处理流程回调
 func (h *Handler) NoteAssetProcessCallback() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		if secret := config.Conf.DevCallbacks.Secret; secret != "" {
+			_, err := conductorcallback.VerifyRequest(r, secret, conductorcallback.DefaultTolerance)
+			if err != nil {
+				xlog.Msg("note process callback signature verify failed").
+					Err(err).
+					Extras("callbackId", r.Header.Get(conductorcallback.HeaderCallbackId)).
+					Errorx(r.Context())
+				xhttp.Error(r, w, xerror.ErrPermission)
+				return
+			}
+		}
+
 		req, err := xhttp.ParseValidate[NoteAssetCallbackPayload](httpx.Parse, r)
 		if err != nil {
 			xhttp.Error(r, w, err)
@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/ryanreadbooks/whimer/misc/xhttp"
+	"github.com/ryanreadbooks/whimer/note/internal/biz"
+	"github.com/ryanreadbooks/whimer/note/internal/global"
+	"github.com/ryanreadbooks/whimer/note/internal/srv"
+)
+
+const maxReviewQueueCount = 50
+
+type ListReviewQueueReq struct {
+	Cursor int64 `form:"cursor,optional"`
+	Count  int32 `form:"count,default=20"`
+}
+
+func (r *ListReviewQueueReq) Validate() error {
+	if r.Count <= 0 || r.Count > maxReviewQueueCount {
+		return global.ErrArgs.Msg("count参数错误")
+	}
+
+	return nil
+}
+
+type ListReviewQueueResp struct {
+	Items      []*srv.ReviewQueueItem `json:"items"`
+	NextCursor int64                  `json:"next_cursor"`
+	HasNext    bool                   `json:"has_next"`
+}
+
+// ListReviewQueue 列出人工复审队列
+func (h *Handler) ListReviewQueue() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, err := xhttp.ParseValidateForm[ListReviewQueueReq](r)
+		if err != nil {
+			xhttp.Error(r, w, err)
+			return
+		}
+
+		items, page, err := h.Svc.NoteAuditSrv.ListReviewQueue(r.Context(), req.Cursor, req.Count)
+		if err != nil {
+			xhttp.Error(r, w, err)
+			return
+		}
+
+		xhttp.OkJson(w, &ListReviewQueueResp{
+			Items:      items,
+			NextCursor: page.NextCursor,
+			HasNext:    page.HasNext,
+		})
+	}
+}
+
+// ResolveReview 人工复审
+func (h *Handler) ResolveReview() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, err := xhttp.ParseValidateJsonBody[biz.ResolveReviewRequest](r)
+		if err != nil {
+			xhttp.Error(r, w, err)
+			return
+		}
+
+		err = h.Svc.NoteAuditSrv.ResolveReview(r.Context(), req)
+		if err != nil {
+			xhttp.Error(r, w, err)
+			return
+		}
+
+		xhttp.OkJson(w, nil)
+	}
+}
+
+// BanNote 封禁已发布的笔记
+func (h *Handler) BanNote() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, err := xhttp.ParseValidateJsonBody[biz.BanNoteRequest](r)
+		if err != nil {
+			xhttp.Error(r, w, err)
+			return
+		}
+
+		err = h.Svc.NoteAuditSrv.BanNote(r.Context(), req)
+		if err != nil {
+			xhttp.Error(r, w, err)
+			return
+		}
+
+		xhttp.OkJson(w, nil)
+	}
+}
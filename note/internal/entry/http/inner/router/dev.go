@@ -18,7 +18,9 @@ func rootGroup(engine *rest.Server) *xhttp.RouterGroup {
 func RegisterInner(engine *rest.Server, h *handler.Handler) {
 	rg := rootGroup(engine)
 	apiV1Dev := rg.Group("/api/v1/dev")
+	apiV1Admin := rg.Group("/api/v1/admin")
 
 	// register all routes
 	regNoteProcessRoutes(apiV1Dev, h)
+	regNoteAuditRoutes(apiV1Admin, h)
 }
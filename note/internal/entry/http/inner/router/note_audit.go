@@ -0,0 +1,18 @@
+package router
+
+import (
+	"github.com/ryanreadbooks/whimer/misc/xhttp"
+	"github.com/ryanreadbooks/whimer/note/internal/entry/http/inner/handler"
+)
+
+func regNoteAuditRoutes(group *xhttp.RouterGroup, h *handler.Handler) {
+	auditGroup := group.Group("/audit")
+	{
+		// /inner/api/v1/admin/audit/review/list
+		auditGroup.Get("/review/list", h.ListReviewQueue())
+		// /inner/api/v1/admin/audit/review/resolve
+		auditGroup.Post("/review/resolve", h.ResolveReview())
+		// /inner/api/v1/admin/audit/ban
+		auditGroup.Post("/ban", h.BanNote())
+	}
+}
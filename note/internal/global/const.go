@@ -14,3 +14,8 @@ const (
 	NoteImageProcessTaskType = "image_process"
 	NoteVideoProcessTaskType = "video_process"
 )
+
+// 用户发布笔记触发的资源处理任务优先于批量回刷等后台任务
+const (
+	NotePublishProcessPriority int32 = 5
+)
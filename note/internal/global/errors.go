@@ -23,6 +23,19 @@ const (
 	ErrTagNotFoundCode
 	ErrNoteProcessingCode
 	ErrVideoNoteAssetNotExistCode
+	ErrRecommendCursorInvalidCode
+	ErrNoteNotDraftCode
+	ErrNotePublishAtInvalidCode
+	ErrNoteNotInReviewCode
+	ErrNoteCannotBanCode
+	ErrNoteNotRecycledCode
+	ErrNoteRecycleExpiredCode
+	ErrCollectionPrivacyInvalidCode
+	ErrCollectionLimitCode
+	ErrCollectionFullCode
+	ErrNoteInOtherCollectionCode
+	ErrCollectionCoverInvalidCode
+	ErrCollectionSortInvalidCode
 )
 
 // 5xx
@@ -42,6 +55,9 @@ const (
 
 	ErrNoteNoteNotFoundCode = ErrNotFoundCode + iota
 	ErrNoteProcedureNoteFoundCode
+	ErrNoteAuditNotFoundCode
+	ErrNoteRevisionNotFoundCode
+	ErrCollectionNotFoundCode
 )
 
 // 403
@@ -50,6 +66,7 @@ const (
 
 	ErrNoteNotNoteOwnerCode = ErrPermissionCode + iota
 	ErrNoteNoteNotPublicCode
+	ErrNotCollectionOwnerCode
 )
 
 // 业务错误定义
@@ -70,15 +87,36 @@ var (
 	ErrTagNotFound            = ErrBizNoteArgs.ErrCode(ErrTagNotFoundCode).Msg("标签不存在")
 	ErrNoteProcessing         = ErrBizNoteArgs.ErrCode(ErrNoteProcessingCode).Msg("笔记正在处理中")
 	ErrVideoNoteAssetNotExist = ErrBizNoteArgs.ErrCode(ErrVideoNoteAssetNotExistCode).Msg("未指定视频资源文件")
+	ErrRecommendCursorInvalid = ErrBizNoteArgs.ErrCode(ErrRecommendCursorInvalidCode).Msg("推荐游标无效")
+	ErrNoteNotDraft           = ErrBizNoteArgs.ErrCode(ErrNoteNotDraftCode).Msg("笔记不是草稿")
+	ErrNotePublishAtInvalid   = ErrBizNoteArgs.ErrCode(ErrNotePublishAtInvalidCode).Msg("定时发布时间无效")
+	ErrNoteNotInReview        = ErrBizNoteArgs.ErrCode(ErrNoteNotInReviewCode).Msg("笔记不在人工复审中")
+	ErrNoteCannotBan          = ErrBizNoteArgs.ErrCode(ErrNoteCannotBanCode).Msg("笔记未发布 不能封禁")
+	ErrNoteNotRecycled        = ErrBizNoteArgs.ErrCode(ErrNoteNotRecycledCode).Msg("笔记不在回收站中")
+	ErrNoteRecycleExpired     = ErrBizNoteArgs.ErrCode(ErrNoteRecycleExpiredCode).Msg("笔记已超过恢复期限")
+
+	// 合集参数错误
+	ErrCollectionPrivacyInvalid = ErrBizNoteArgs.ErrCode(ErrCollectionPrivacyInvalidCode).Msg("合集仅支持公开或私有")
+	ErrCollectionLimit          = ErrBizNoteArgs.ErrCode(ErrCollectionLimitCode).Msg("合集数量已达上限")
+	ErrCollectionFull           = ErrBizNoteArgs.ErrCode(ErrCollectionFullCode).Msg("合集中的笔记数量已达上限")
+	ErrNoteInOtherCollection    = ErrBizNoteArgs.ErrCode(ErrNoteInOtherCollectionCode).Msg("笔记已属于其它合集")
+	ErrCollectionCoverInvalid   = ErrBizNoteArgs.ErrCode(ErrCollectionCoverInvalidCode).Msg("封面笔记不在合集中")
+	ErrCollectionSortInvalid    = ErrBizNoteArgs.ErrCode(ErrCollectionSortInvalidCode).Msg("排序的笔记和合集中的笔记不一致")
 
 	// 笔记操作失败
 	ErrInsertNoteFail        = ErrBizNoteInternal.ErrCode(ErrNoteInsertNoteFailCode).Msg("添加笔记失败")
 	ErrNoteNotFound          = ErrNotFound.ErrCode(ErrNoteNoteNotFoundCode).Msg("笔记不存在")
 	ErrNoteProcedureNotFound = ErrNotFound.ErrCode(ErrNoteProcedureNoteFoundCode).Msg("笔记流程不存在")
+	ErrNoteAuditNotFound     = ErrNotFound.ErrCode(ErrNoteAuditNotFoundCode).Msg("笔记审核结果不存在")
+	ErrNoteRevisionNotFound  = ErrNotFound.ErrCode(ErrNoteRevisionNotFoundCode).Msg("笔记版本不存在")
 	ErrUpdateNoteFail        = ErrBizNoteInternal.ErrCode(ErrNoteUpdateNoteFailCode).Msg("更新笔记失败")
 	ErrDeleteNoteFail        = ErrBizNoteInternal.ErrCode(ErrNoteDeleteNoteFailCode).Msg("删除笔记失败")
 	ErrGetNoteFail           = ErrBizNoteInternal.ErrCode(ErrNoteGetNoteFailCode).Msg("获取笔记失败")
 	ErrGetNoteLikesFail      = ErrBizNoteInternal.ErrCode(ErrNoteGetNoteLikesFailCode).Msg("获取点赞数据失败")
 	ErrNotNoteOwner          = ErrBizNoteDenied.ErrCode(ErrNoteNotNoteOwnerCode).Msg("你不拥有该笔记")
 	ErrNoteNotPublic         = ErrBizNoteDenied.ErrCode(ErrNoteNoteNotPublicCode).Msg("笔记非公开")
+
+	// 合集操作失败
+	ErrCollectionNotFound = ErrNotFound.ErrCode(ErrCollectionNotFoundCode).Msg("合集不存在")
+	ErrNotCollectionOwner = ErrBizNoteDenied.ErrCode(ErrNotCollectionOwnerCode).Msg("你不拥有该合集")
 )
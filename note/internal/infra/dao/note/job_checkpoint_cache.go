@@ -0,0 +1,75 @@
+package note
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/ryanreadbooks/whimer/misc/xconv"
+	"github.com/ryanreadbooks/whimer/misc/xtime"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const (
+	jobCheckpointKey = "note:job:checkpoint:" // note:job:checkpoint:%s
+
+	jobCheckpointTTL = 30 * xtime.DaySec
+)
+
+func getJobCheckpointKey(name string) string {
+	return jobCheckpointKey + name
+}
+
+// JobCheckpointCache 后台任务扫描进度 - 纯缓存操作
+type JobCheckpointCache struct {
+	cache *redis.Redis
+}
+
+func NewJobCheckpointCache(cache *redis.Redis) *JobCheckpointCache {
+	return &JobCheckpointCache{
+		cache: cache,
+	}
+}
+
+// Get 获取任务进度 不存在时返回0
+func (c *JobCheckpointCache) Get(ctx context.Context, name string) (int64, error) {
+	if c.cache == nil {
+		return 0, nil
+	}
+
+	val, err := c.cache.GetCtx(ctx, getJobCheckpointKey(name))
+	if err != nil {
+		return 0, err
+	}
+	if val == "" {
+		return 0, nil
+	}
+
+	return strconv.ParseInt(val, 10, 64)
+}
+
+// Set 保存任务进度
+func (c *JobCheckpointCache) Set(ctx context.Context, name string, cursor int64) error {
+	if c.cache == nil {
+		return nil
+	}
+
+	return c.cache.SetexCtx(ctx, getJobCheckpointKey(name), xconv.FormatInt(cursor), jobCheckpointTTL)
+}
+
+// GetString 获取字符串形式的任务进度 不存在时返回空字符串
+func (c *JobCheckpointCache) GetString(ctx context.Context, name string) (string, error) {
+	if c.cache == nil {
+		return "", nil
+	}
+
+	return c.cache.GetCtx(ctx, getJobCheckpointKey(name))
+}
+
+// SetString 保存字符串形式的任务进度
+func (c *JobCheckpointCache) SetString(ctx context.Context, name string, cursor string) error {
+	if c.cache == nil {
+		return nil
+	}
+
+	return c.cache.SetexCtx(ctx, getJobCheckpointKey(name), cursor, jobCheckpointTTL)
+}
@@ -0,0 +1,90 @@
+package note
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xslice"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+	"github.com/ryanreadbooks/whimer/note/internal/model"
+)
+
+// 笔记审核结果 每篇笔记只保留最近一次审核结果
+type AuditPO struct {
+	NoteId   int64               `db:"note_id"`
+	TaskId   string              `db:"task_id"` // 机审对应的流程taskId
+	Decision model.AuditDecision `db:"decision"`
+	Reason   string              `db:"reason"`
+	Provider string              `db:"provider"`
+	Operator int64               `db:"operator"` // 人工审核操作人 0表示机审
+	Ctime    int64               `db:"ctime"`
+	Utime    int64               `db:"utime"`
+}
+
+const (
+	auditFields = "note_id,task_id,decision,reason,provider,operator,ctime,utime"
+)
+
+// NoteAuditRepo 笔记审核结果数据库仓储 - 纯数据库操作
+type NoteAuditRepo struct {
+	db *xsql.DB
+}
+
+func NewNoteAuditRepo(db *xsql.DB) *NoteAuditRepo {
+	return &NoteAuditRepo{
+		db: db,
+	}
+}
+
+func (d *NoteAuditRepo) Upsert(ctx context.Context, audit *AuditPO) error {
+	now := time.Now().Unix()
+	if audit.Ctime == 0 {
+		audit.Ctime = now
+	}
+	audit.Utime = now
+
+	const sql = "INSERT INTO note_audit(" + auditFields + ") VALUES(?,?,?,?,?,?,?,?) " +
+		" ON DUPLICATE KEY UPDATE task_id=VALUES(task_id),decision=VALUES(decision),reason=VALUES(reason)," +
+		"provider=VALUES(provider),operator=VALUES(operator),utime=VALUES(utime)"
+
+	_, err := d.db.ExecCtx(ctx, sql,
+		audit.NoteId,
+		audit.TaskId,
+		audit.Decision,
+		audit.Reason,
+		audit.Provider,
+		audit.Operator,
+		audit.Ctime,
+		audit.Utime)
+	return xerror.Wrap(xsql.ConvertError(err))
+}
+
+func (d *NoteAuditRepo) GetById(ctx context.Context, noteId int64) (*AuditPO, error) {
+	const sql = "SELECT " + auditFields + " FROM note_audit WHERE note_id=?"
+	var audit AuditPO
+	err := d.db.QueryRowCtx(ctx, &audit, sql, noteId)
+	if err != nil {
+		return nil, xerror.Wrap(xsql.ConvertError(err))
+	}
+
+	return &audit, nil
+}
+
+func (d *NoteAuditRepo) BatchGetByIds(ctx context.Context, noteIds []int64) ([]*AuditPO, error) {
+	if len(noteIds) == 0 {
+		return []*AuditPO{}, nil
+	}
+
+	var audits []*AuditPO
+	const sql = "SELECT " + auditFields + " FROM note_audit WHERE note_id IN (%s)"
+	err := d.db.QueryRowsCtx(ctx, &audits, fmt.Sprintf(sql, xslice.JoinInts(noteIds)))
+	return audits, xerror.Wrap(xsql.ConvertError(err))
+}
+
+func (d *NoteAuditRepo) Delete(ctx context.Context, noteId int64) error {
+	const sql = "DELETE FROM note_audit WHERE note_id=? LIMIT 1"
+	_, err := d.db.ExecCtx(ctx, sql, noteId)
+	return xerror.Wrap(xsql.ConvertError(err))
+}
@@ -0,0 +1,204 @@
+package note
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xslice"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+	"github.com/ryanreadbooks/whimer/note/internal/model"
+)
+
+// 笔记合集
+type CollectionPO struct {
+	Id      int64         `db:"id"`
+	Owner   int64         `db:"owner"`
+	Title   string        `db:"title"`
+	Desc    string        `db:"desc"`
+	Privacy model.Privacy `db:"privacy"`
+	Cover   int64         `db:"cover"` // 作为封面的笔记id
+	Ctime   int64         `db:"ctime"`
+	Utime   int64         `db:"utime"`
+}
+
+// 合集中的笔记 一篇笔记只能属于一个合集
+type CollectionItemPO struct {
+	NoteId       int64 `db:"note_id"`
+	CollectionId int64 `db:"collection_id"`
+	Seq          int64 `db:"seq"` // 笔记在合集中的顺序 从小到大排列
+	Ctime        int64 `db:"ctime"`
+}
+
+type CollectionCountPO struct {
+	CollectionId int64 `db:"collection_id"`
+	Cnt          int64 `db:"cnt"`
+}
+
+const (
+	collectionFields     = "id,owner,title,`desc`,privacy,cover,ctime,utime"
+	collectionItemFields = "note_id,collection_id,seq,ctime"
+)
+
+// NoteCollectionRepo 笔记合集数据库仓储 - 纯数据库操作
+type NoteCollectionRepo struct {
+	db *xsql.DB
+}
+
+func NewNoteCollectionRepo(db *xsql.DB) *NoteCollectionRepo {
+	return &NoteCollectionRepo{
+		db: db,
+	}
+}
+
+func (d *NoteCollectionRepo) Insert(ctx context.Context, po *CollectionPO) (int64, error) {
+	const sql = "INSERT INTO note_collection(owner,title,`desc`,privacy,cover,ctime,utime) VALUES(?,?,?,?,?,?,?)"
+	res, err := d.db.ExecCtx(ctx, sql, po.Owner, po.Title, po.Desc, po.Privacy, po.Cover, po.Ctime, po.Utime)
+	if err != nil {
+		return 0, xerror.Wrap(xsql.ConvertError(err))
+	}
+
+	newId, err := res.LastInsertId()
+	if err != nil {
+		return 0, xerror.Wrap(xsql.ConvertError(err))
+	}
+
+	return newId, nil
+}
+
+func (d *NoteCollectionRepo) Update(ctx context.Context, po *CollectionPO) error {
+	const sql = "UPDATE note_collection SET title=?,`desc`=?,privacy=?,cover=?,utime=? WHERE id=?"
+	_, err := d.db.ExecCtx(ctx, sql, po.Title, po.Desc, po.Privacy, po.Cover, po.Utime, po.Id)
+	return xerror.Wrap(xsql.ConvertError(err))
+}
+
+func (d *NoteCollectionRepo) UpdateCover(ctx context.Context, id, cover, utime int64) error {
+	const sql = "UPDATE note_collection SET cover=?,utime=? WHERE id=?"
+	_, err := d.db.ExecCtx(ctx, sql, cover, utime, id)
+	return xerror.Wrap(xsql.ConvertError(err))
+}
+
+func (d *NoteCollectionRepo) GetById(ctx context.Context, id int64) (*CollectionPO, error) {
+	const sql = "SELECT " + collectionFields + " FROM note_collection WHERE id=?"
+	var po CollectionPO
+	err := d.db.QueryRowCtx(ctx, &po, sql, id)
+	if err != nil {
+		return nil, xerror.Wrap(xsql.ConvertError(err))
+	}
+
+	return &po, nil
+}
+
+func (d *NoteCollectionRepo) GetByIdForUpdate(ctx context.Context, id int64) (*CollectionPO, error) {
+	const sql = "SELECT " + collectionFields + " FROM note_collection WHERE id=? FOR UPDATE"
+	var po CollectionPO
+	err := d.db.QueryRowCtx(ctx, &po, sql, id)
+	if err != nil {
+		return nil, xerror.Wrap(xsql.ConvertError(err))
+	}
+
+	return &po, nil
+}
+
+func (d *NoteCollectionRepo) ListByOwner(ctx context.Context, owner int64) ([]*CollectionPO, error) {
+	const sql = "SELECT " + collectionFields + " FROM note_collection WHERE owner=? ORDER BY id DESC"
+	var pos []*CollectionPO
+	err := d.db.QueryRowsCtx(ctx, &pos, sql, owner)
+	return pos, xerror.Wrap(xsql.ConvertError(err))
+}
+
+// 锁住用户的全部合集 并发新建合集时需要等待 需要在事务中调用
+func (d *NoteCollectionRepo) CountByOwnerForUpdate(ctx context.Context, owner int64) (int64, error) {
+	const sql = "SELECT COUNT(*) FROM note_collection WHERE owner=? FOR UPDATE"
+	var cnt int64
+	err := d.db.QueryRowCtx(ctx, &cnt, sql, owner)
+	return cnt, xerror.Wrap(xsql.ConvertError(err))
+}
+
+func (d *NoteCollectionRepo) Delete(ctx context.Context, id int64) error {
+	const sql = "DELETE FROM note_collection WHERE id=? LIMIT 1"
+	_, err := d.db.ExecCtx(ctx, sql, id)
+	return xerror.Wrap(xsql.ConvertError(err))
+}
+
+func (d *NoteCollectionRepo) BatchInsertItems(ctx context.Context, items []*CollectionItemPO) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, 0, len(items))
+	args := make([]any, 0, len(items)*4)
+	for _, item := range items {
+		placeholders = append(placeholders, "(?,?,?,?)")
+		args = append(args, item.NoteId, item.CollectionId, item.Seq, item.Ctime)
+	}
+
+	sql := "INSERT INTO note_collection_item(" + collectionItemFields + ") VALUES " + strings.Join(placeholders, ",")
+	_, err := d.db.ExecCtx(ctx, sql, args...)
+	return xerror.Wrap(xsql.ConvertError(err))
+}
+
+func (d *NoteCollectionRepo) UpdateItemSeq(ctx context.Context, collectionId, noteId, seq int64) error {
+	const sql = "UPDATE note_collection_item SET seq=? WHERE note_id=? AND collection_id=?"
+	_, err := d.db.ExecCtx(ctx, sql, seq, noteId, collectionId)
+	return xerror.Wrap(xsql.ConvertError(err))
+}
+
+func (d *NoteCollectionRepo) GetItemByNoteId(ctx context.Context, noteId int64) (*CollectionItemPO, error) {
+	const sql = "SELECT " + collectionItemFields + " FROM note_collection_item WHERE note_id=?"
+	var po CollectionItemPO
+	err := d.db.QueryRowCtx(ctx, &po, sql, noteId)
+	if err != nil {
+		return nil, xerror.Wrap(xsql.ConvertError(err))
+	}
+
+	return &po, nil
+}
+
+func (d *NoteCollectionRepo) BatchGetItemByNoteIds(ctx context.Context, noteIds []int64) ([]*CollectionItemPO, error) {
+	if len(noteIds) == 0 {
+		return []*CollectionItemPO{}, nil
+	}
+
+	const sql = "SELECT " + collectionItemFields + " FROM note_collection_item WHERE note_id IN (%s)"
+	var pos []*CollectionItemPO
+	err := d.db.QueryRowsCtx(ctx, &pos, fmt.Sprintf(sql, xslice.JoinInts(noteIds)))
+	return pos, xerror.Wrap(xsql.ConvertError(err))
+}
+
+// 按顺序列出合集中的全部笔记
+func (d *NoteCollectionRepo) ListItems(ctx context.Context, collectionId int64) ([]*CollectionItemPO, error) {
+	const sql = "SELECT " + collectionItemFields + " FROM note_collection_item WHERE collection_id=? ORDER BY seq ASC"
+	var pos []*CollectionItemPO
+	err := d.db.QueryRowsCtx(ctx, &pos, sql, collectionId)
+	return pos, xerror.Wrap(xsql.ConvertError(err))
+}
+
+func (d *NoteCollectionRepo) BatchCountItems(ctx context.Context, collectionIds []int64) ([]*CollectionCountPO, error) {
+	if len(collectionIds) == 0 {
+		return []*CollectionCountPO{}, nil
+	}
+
+	const sql = "SELECT collection_id,COUNT(*) AS cnt FROM note_collection_item " +
+		"WHERE collection_id IN (%s) GROUP BY collection_id"
+	var pos []*CollectionCountPO
+	err := d.db.QueryRowsCtx(ctx, &pos, fmt.Sprintf(sql, xslice.JoinInts(collectionIds)))
+	return pos, xerror.Wrap(xsql.ConvertError(err))
+}
+
+func (d *NoteCollectionRepo) DeleteItems(ctx context.Context, collectionId int64, noteIds []int64) error {
+	if len(noteIds) == 0 {
+		return nil
+	}
+
+	const sql = "DELETE FROM note_collection_item WHERE collection_id=? AND note_id IN (%s)"
+	_, err := d.db.ExecCtx(ctx, fmt.Sprintf(sql, xslice.JoinInts(noteIds)), collectionId)
+	return xerror.Wrap(xsql.ConvertError(err))
+}
+
+func (d *NoteCollectionRepo) DeleteItemsByCollection(ctx context.Context, collectionId int64) error {
+	const sql = "DELETE FROM note_collection_item WHERE collection_id=?"
+	_, err := d.db.ExecCtx(ctx, sql, collectionId)
+	return xerror.Wrap(xsql.ConvertError(err))
+}
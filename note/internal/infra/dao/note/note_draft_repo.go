@@ -0,0 +1,70 @@
+package note
+
+import (
+	"context"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+)
+
+// 草稿和定时发布笔记进入发布流程前需要保留的信息
+type DraftPO struct {
+	NoteId      int64  `db:"note_id"`
+	PublishAt   int64  `db:"publish_at"`    // 定时发布时间 为0表示未设置
+	VideoRawUrl string `db:"video_raw_url"` // 视频笔记的原始视频fileId 进入资源处理流程时使用
+	Ctime       int64  `db:"ctime"`
+	Utime       int64  `db:"utime"`
+}
+
+const (
+	draftFields = "note_id,publish_at,video_raw_url,ctime,utime"
+)
+
+// NoteDraftRepo 笔记草稿数据库仓储 - 纯数据库操作
+type NoteDraftRepo struct {
+	db *xsql.DB
+}
+
+func NewNoteDraftRepo(db *xsql.DB) *NoteDraftRepo {
+	return &NoteDraftRepo{
+		db: db,
+	}
+}
+
+func (d *NoteDraftRepo) Upsert(ctx context.Context, draft *DraftPO) error {
+	now := time.Now().Unix()
+	if draft.Ctime == 0 {
+		draft.Ctime = now
+	}
+	draft.Utime = now
+
+	const sql = "INSERT INTO note_draft(" + draftFields + ") VALUES(?,?,?,?,?) " +
+		" ON DUPLICATE KEY UPDATE publish_at=VALUES(publish_at),video_raw_url=VALUES(video_raw_url),utime=VALUES(utime)"
+
+	_, err := d.db.ExecCtx(ctx, sql, draft.NoteId, draft.PublishAt, draft.VideoRawUrl, draft.Ctime, draft.Utime)
+	return xerror.Wrap(xsql.ConvertError(err))
+}
+
+func (d *NoteDraftRepo) UpdatePublishAt(ctx context.Context, noteId int64, publishAt int64) error {
+	const sql = "UPDATE note_draft SET publish_at=?,utime=? WHERE note_id=?"
+	_, err := d.db.ExecCtx(ctx, sql, publishAt, time.Now().Unix(), noteId)
+	return xerror.Wrap(xsql.ConvertError(err))
+}
+
+func (d *NoteDraftRepo) GetById(ctx context.Context, noteId int64) (*DraftPO, error) {
+	const sql = "SELECT " + draftFields + " FROM note_draft WHERE note_id=?"
+	var draft DraftPO
+	err := d.db.QueryRowCtx(ctx, &draft, sql, noteId)
+	if err != nil {
+		return nil, xerror.Wrap(xsql.ConvertError(err))
+	}
+
+	return &draft, nil
+}
+
+func (d *NoteDraftRepo) Delete(ctx context.Context, noteId int64) error {
+	const sql = "DELETE FROM note_draft WHERE note_id=? LIMIT 1"
+	_, err := d.db.ExecCtx(ctx, sql, noteId)
+	return xerror.Wrap(xsql.ConvertError(err))
+}
@@ -12,15 +12,16 @@ import (
 )
 
 type ExtPO struct {
-	NoteId  int64           `db:"note_id"`  // note id
-	Tags    string          `db:"tags"`     // note tags: shape like, tag_id1,tag_id2,...,tag_idN
-	AtUsers json.RawMessage `db:"at_users"` // at users: json object string: [{"nickname":"user1","uid":1001},{"nickname":"user2","uid":1002}]
-	Ctime   int64           `db:"ctime"`
-	Utime   int64           `db:"utime"`
+	NoteId   int64           `db:"note_id"`  // note id
+	Tags     string          `db:"tags"`     // note tags: shape like, tag_id1,tag_id2,...,tag_idN
+	AtUsers  json.RawMessage `db:"at_users"` // at users: json object string: [{"nickname":"user1","uid":1001},{"nickname":"user2","uid":1002}]
+	Audience string          `db:"audience"` // custom audience: shape like, uid1,uid2,...,uidN
+	Ctime    int64           `db:"ctime"`
+	Utime    int64           `db:"utime"`
 }
 
 const (
-	extFields = "note_id,tags,at_users,ctime,utime"
+	extFields = "note_id,tags,at_users,audience,ctime,utime"
 )
 
 // NoteExtRepo 笔记扩展信息数据库仓储 - 纯数据库操作
@@ -46,10 +47,10 @@ func (d *NoteExtRepo) Upsert(ctx context.Context, ext *ExtPO) error {
 		atUsers = ext.AtUsers
 	}
 
-	const sql = "INSERT INTO note_ext(" + extFields + ") VALUES(?,?,?,?,?) " +
-		" ON DUPLICATE KEY UPDATE tags=VALUES(tags),at_users=VALUES(at_users),utime=VALUES(utime)"
+	const sql = "INSERT INTO note_ext(" + extFields + ") VALUES(?,?,?,?,?,?) " +
+		" ON DUPLICATE KEY UPDATE tags=VALUES(tags),at_users=VALUES(at_users),audience=VALUES(audience),utime=VALUES(utime)"
 
-	_, err := d.db.ExecCtx(ctx, sql, ext.NoteId, ext.Tags, atUsers, ext.Ctime, ext.Utime)
+	_, err := d.db.ExecCtx(ctx, sql, ext.NoteId, ext.Tags, atUsers, ext.Audience, ext.Ctime, ext.Utime)
 	return xerror.Wrap(xsql.ConvertError(err))
 }
 
@@ -62,7 +63,7 @@ func (d *NoteExtRepo) Delete(ctx context.Context, noteId int64) error {
 func (d *NoteExtRepo) GetById(ctx context.Context, noteId int64) (*ExtPO, error) {
 	const sql = "SELECT " + extFields + " FROM note_ext WHERE note_id=?"
 	var ext ExtPO
-	err := d.db.QueryRowCtx(ctx, &ext, sql)
+	err := d.db.QueryRowCtx(ctx, &ext, sql, noteId)
 	return &ext, xerror.Wrap(xsql.ConvertError(err))
 }
 
@@ -72,4 +73,3 @@ func (d *NoteExtRepo) BatchGetById(ctx context.Context, noteIds []int64) ([]*Ext
 	err := d.db.QueryRowsCtx(ctx, &exts, fmt.Sprintf(sql, xslice.JoinInts(noteIds)))
 	return exts, xerror.Wrap(xsql.ConvertError(err))
 }
-
@@ -0,0 +1,77 @@
+package note
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xslice"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+	"github.com/ryanreadbooks/whimer/note/internal/model"
+)
+
+// 回收站中的笔记 恢复或者彻底删除后移除
+type RecyclePO struct {
+	NoteId    int64           `db:"note_id"`
+	PrevState model.NoteState `db:"prev_state"` // 删除前的笔记状态
+	DeleteAt  int64           `db:"delete_at"`
+	ExpireAt  int64           `db:"expire_at"` // 超过该时间后彻底删除
+}
+
+const (
+	recycleFields = "note_id,prev_state,delete_at,expire_at"
+)
+
+// NoteRecycleRepo 笔记回收站数据库仓储 - 纯数据库操作
+type NoteRecycleRepo struct {
+	db *xsql.DB
+}
+
+func NewNoteRecycleRepo(db *xsql.DB) *NoteRecycleRepo {
+	return &NoteRecycleRepo{
+		db: db,
+	}
+}
+
+func (d *NoteRecycleRepo) Upsert(ctx context.Context, po *RecyclePO) error {
+	const sql = "INSERT INTO note_recycle(" + recycleFields + ") VALUES(?,?,?,?) " +
+		"ON DUPLICATE KEY UPDATE prev_state=VALUES(prev_state),delete_at=VALUES(delete_at),expire_at=VALUES(expire_at)"
+	_, err := d.db.ExecCtx(ctx, sql, po.NoteId, po.PrevState, po.DeleteAt, po.ExpireAt)
+	return xerror.Wrap(xsql.ConvertError(err))
+}
+
+func (d *NoteRecycleRepo) GetById(ctx context.Context, noteId int64) (*RecyclePO, error) {
+	const sql = "SELECT " + recycleFields + " FROM note_recycle WHERE note_id=?"
+	var po RecyclePO
+	err := d.db.QueryRowCtx(ctx, &po, sql, noteId)
+	if err != nil {
+		return nil, xerror.Wrap(xsql.ConvertError(err))
+	}
+
+	return &po, nil
+}
+
+func (d *NoteRecycleRepo) BatchGetById(ctx context.Context, noteIds []int64) ([]*RecyclePO, error) {
+	if len(noteIds) == 0 {
+		return []*RecyclePO{}, nil
+	}
+
+	const sql = "SELECT " + recycleFields + " FROM note_recycle WHERE note_id IN (%s)"
+	var pos []*RecyclePO
+	err := d.db.QueryRowsCtx(ctx, &pos, fmt.Sprintf(sql, xslice.JoinInts(noteIds)))
+	return pos, xerror.Wrap(xsql.ConvertError(err))
+}
+
+// 列出过期时间早于before的记录
+func (d *NoteRecycleRepo) ListExpired(ctx context.Context, before int64, limit int32) ([]*RecyclePO, error) {
+	const sql = "SELECT " + recycleFields + " FROM note_recycle WHERE expire_at<=? ORDER BY expire_at ASC LIMIT ?"
+	var pos []*RecyclePO
+	err := d.db.QueryRowsCtx(ctx, &pos, sql, before, limit)
+	return pos, xerror.Wrap(xsql.ConvertError(err))
+}
+
+func (d *NoteRecycleRepo) Delete(ctx context.Context, noteId int64) error {
+	const sql = "DELETE FROM note_recycle WHERE note_id=? LIMIT 1"
+	_, err := d.db.ExecCtx(ctx, sql, noteId)
+	return xerror.Wrap(xsql.ConvertError(err))
+}
@@ -195,6 +195,35 @@ func (r *NoteRepo) ListByCursor(
 	return res, nil
 }
 
+// 按id升序扫描id大于afterId的笔记 用于后台任务遍历
+func (r *NoteRepo) ListByIdAsc(
+	ctx context.Context, afterId int64, limit int32,
+	conds ...NoteRepoCondition,
+) ([]*NotePO, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+	sb.Select(noteFields...)
+	sb.From(noteTableName)
+	sb.Where(sb.GreaterThan("id", afterId))
+
+	nrc := &noteRepoCondition{
+		sb: sb,
+	}
+	for _, cond := range conds {
+		cond(nrc)
+	}
+
+	sb.OrderByAsc("id")
+	sb.Limit(int(limit))
+
+	sql, args := sb.Build()
+	res := make([]*NotePO, 0, limit)
+	err := r.db.QueryRowsCtx(ctx, &res, sql, args...)
+	if err != nil {
+		return nil, xerror.Wrap(xsql.ConvertError(err))
+	}
+	return res, nil
+}
+
 // ListByPage 分页查询笔记（支持 offset/limit 方式）
 func (r *NoteRepo) ListByPage(
 	ctx context.Context, page, count int32,
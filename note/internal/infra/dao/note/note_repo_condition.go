@@ -1,6 +1,8 @@
 package note
 
 import (
+	"strconv"
+
 	"github.com/huandu/go-sqlbuilder"
 	"github.com/ryanreadbooks/whimer/misc/xslice"
 	"github.com/ryanreadbooks/whimer/note/internal/model"
@@ -55,3 +57,34 @@ func WithNotePrivacyEqual(privacy model.Privacy) NoteRepoCondition {
 		c.sb.Where(c.sb.EQ("privacy", privacy))
 	}
 }
+
+func WithNotePrivacyIn(privacies ...model.Privacy) NoteRepoCondition {
+	return func(c *noteRepoCondition) {
+		if len(privacies) == 0 {
+			return
+		}
+
+		c.sb.Where(c.sb.In("privacy", xslice.Any(privacies)...))
+	}
+}
+
+func WithNoteOwnerIn(uids ...int64) NoteRepoCondition {
+	return func(c *noteRepoCondition) {
+		if len(uids) == 0 {
+			return
+		}
+
+		c.sb.Where(c.sb.In("owner", xslice.Any(uids)...))
+	}
+}
+
+// 按id取模分片 只保留id%total==idx的笔记
+func WithNoteIdShard(idx, total int) NoteRepoCondition {
+	return func(c *noteRepoCondition) {
+		if total <= 1 {
+			return
+		}
+
+		c.sb.Where(c.sb.EQ("MOD(id, "+strconv.Itoa(total)+")", idx))
+	}
+}
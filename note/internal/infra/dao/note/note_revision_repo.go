@@ -0,0 +1,88 @@
+package note
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+	"github.com/ryanreadbooks/whimer/note/internal/model"
+)
+
+// 笔记修订版本 只插入不更新
+type RevisionPO struct {
+	Id        int64           `db:"id"`
+	NoteId    int64           `db:"note_id"`
+	Version   int64           `db:"version"`
+	Content   json.RawMessage `db:"content"`    // 版本完整内容 json
+	Diff      json.RawMessage `db:"diff"`       // 和上一个版本的变更 json
+	PrevState model.NoteState `db:"prev_state"` // 更新前的笔记状态
+	Ctime     int64           `db:"ctime"`
+}
+
+const (
+	revisionFields = "id,note_id,version,content,diff,prev_state,ctime"
+)
+
+// NoteRevisionRepo 笔记修订版本数据库仓储 - 纯数据库操作
+type NoteRevisionRepo struct {
+	db *xsql.DB
+}
+
+func NewNoteRevisionRepo(db *xsql.DB) *NoteRevisionRepo {
+	return &NoteRevisionRepo{
+		db: db,
+	}
+}
+
+func (d *NoteRevisionRepo) Insert(ctx context.Context, rev *RevisionPO) error {
+	if rev.Ctime == 0 {
+		rev.Ctime = time.Now().Unix()
+	}
+
+	const sql = "INSERT INTO note_revision(note_id,version,content,diff,prev_state,ctime) VALUES(?,?,?,?,?,?)"
+	_, err := d.db.ExecCtx(ctx, sql, rev.NoteId, rev.Version, rev.Content, rev.Diff, rev.PrevState, rev.Ctime)
+	return xerror.Wrap(xsql.ConvertError(err))
+}
+
+func (d *NoteRevisionRepo) Get(ctx context.Context, noteId, version int64) (*RevisionPO, error) {
+	const sql = "SELECT " + revisionFields + " FROM note_revision WHERE note_id=? AND version=?"
+	var rev RevisionPO
+	err := d.db.QueryRowCtx(ctx, &rev, sql, noteId, version)
+	if err != nil {
+		return nil, xerror.Wrap(xsql.ConvertError(err))
+	}
+
+	return &rev, nil
+}
+
+// 获取最新的版本
+func (d *NoteRevisionRepo) GetLatest(ctx context.Context, noteId int64) (*RevisionPO, error) {
+	const sql = "SELECT " + revisionFields + " FROM note_revision WHERE note_id=? ORDER BY version DESC LIMIT 1"
+	var rev RevisionPO
+	err := d.db.QueryRowCtx(ctx, &rev, sql, noteId)
+	if err != nil {
+		return nil, xerror.Wrap(xsql.ConvertError(err))
+	}
+
+	return &rev, nil
+}
+
+// 按版本号倒序列出小于cursor的版本
+func (d *NoteRevisionRepo) ListByCursor(ctx context.Context, noteId, cursor int64, count int32) ([]*RevisionPO, error) {
+	const sql = "SELECT " + revisionFields + " FROM note_revision WHERE note_id=? AND version<? ORDER BY version DESC LIMIT ?"
+	var revs []*RevisionPO
+	err := d.db.QueryRowsCtx(ctx, &revs, sql, noteId, cursor, count)
+	if err != nil {
+		return nil, xerror.Wrap(xsql.ConvertError(err))
+	}
+
+	return revs, nil
+}
+
+func (d *NoteRevisionRepo) DeleteByNoteId(ctx context.Context, noteId int64) error {
+	const sql = "DELETE FROM note_revision WHERE note_id=?"
+	_, err := d.db.ExecCtx(ctx, sql, noteId)
+	return xerror.Wrap(xsql.ConvertError(err))
+}
@@ -0,0 +1,80 @@
+package note
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+)
+
+// 笔记和标签的关联 用于按标签查找笔记
+type NoteTagPO struct {
+	TagId  int64 `db:"tag_id"`
+	NoteId int64 `db:"note_id"`
+	Ctime  int64 `db:"ctime"`
+}
+
+// NoteTagRepo 笔记标签关联数据库仓储 - 纯数据库操作
+type NoteTagRepo struct {
+	db *xsql.DB
+}
+
+func NewNoteTagRepo(db *xsql.DB) *NoteTagRepo {
+	return &NoteTagRepo{
+		db: db,
+	}
+}
+
+// Replace 覆盖笔记的标签 需要在事务中调用
+func (d *NoteTagRepo) Replace(ctx context.Context, noteId int64, tagIds []int64) error {
+	err := d.DeleteByNoteId(ctx, noteId)
+	if err != nil {
+		return err
+	}
+
+	if len(tagIds) == 0 {
+		return nil
+	}
+
+	now := time.Now().Unix()
+	placeholders := make([]string, 0, len(tagIds))
+	args := make([]any, 0, len(tagIds)*3)
+	for _, tagId := range tagIds {
+		placeholders = append(placeholders, "(?,?,?)")
+		args = append(args, tagId, noteId, now)
+	}
+
+	sql := "INSERT IGNORE INTO note_tag(tag_id,note_id,ctime) VALUES " + strings.Join(placeholders, ",")
+	_, err = d.db.ExecCtx(ctx, sql, args...)
+	return xerror.Wrap(xsql.ConvertError(err))
+}
+
+func (d *NoteTagRepo) DeleteByNoteId(ctx context.Context, noteId int64) error {
+	const sql = "DELETE FROM note_tag WHERE note_id=?"
+	_, err := d.db.ExecCtx(ctx, sql, noteId)
+	return xerror.Wrap(xsql.ConvertError(err))
+}
+
+// ListNoteIdsByTags 获取带有任一指定标签的笔记id 按笔记id倒序
+//
+// 每个标签单独按主键倒序取limit条后再合并 避免扫描标签下的全部笔记
+func (d *NoteTagRepo) ListNoteIdsByTags(ctx context.Context, tagIds []int64, limit int) ([]int64, error) {
+	if len(tagIds) == 0 {
+		return []int64{}, nil
+	}
+
+	subs := make([]string, 0, len(tagIds))
+	args := make([]any, 0, len(tagIds)*2+1)
+	for _, tagId := range tagIds {
+		subs = append(subs, "(SELECT note_id FROM note_tag WHERE tag_id=? ORDER BY note_id DESC LIMIT ?)")
+		args = append(args, tagId, limit)
+	}
+	args = append(args, limit)
+
+	sql := strings.Join(subs, " UNION ") + " ORDER BY note_id DESC LIMIT ?"
+	var noteIds []int64
+	err := d.db.QueryRowsCtx(ctx, &noteIds, sql, args...)
+	return noteIds, xerror.Wrap(xsql.ConvertError(err))
+}
@@ -0,0 +1,80 @@
+package note
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestNoteTagRepo_ListNoteIdsByTags(t *testing.T) {
+	Convey("ListNoteIdsByTags", t, func() {
+		var (
+			repo   = NewNoteTagRepo(testDb)
+			base   = time.Now().UnixNano()
+			tagA   = base
+			tagB   = base + 1
+			tagC   = base + 2
+			noteId = base
+		)
+
+		// 笔记 noteId+1 同时带有 tagA 和 tagB
+		noteTags := map[int64][]int64{
+			noteId:     {tagA},
+			noteId + 1: {tagA, tagB},
+			noteId + 2: {tagB},
+			noteId + 3: {tagC},
+		}
+		replace := func(noteId int64, tagIds []int64) {
+			err := testDb.Transact(ctx, func(ctx context.Context) error {
+				return repo.Replace(ctx, noteId, tagIds)
+			})
+			So(err, ShouldBeNil)
+		}
+		for noteId, tagIds := range noteTags {
+			replace(noteId, tagIds)
+		}
+		defer func() {
+			for noteId := range noteTags {
+				repo.DeleteByNoteId(ctx, noteId)
+			}
+		}()
+
+		cases := []struct {
+			name   string
+			tagIds []int64
+			limit  int
+			want   []int64
+		}{
+			{name: "no tags", tagIds: nil, limit: 10, want: []int64{}},
+			{name: "single tag", tagIds: []int64{tagA}, limit: 10, want: []int64{noteId + 1, noteId}},
+			{name: "merge and dedup", tagIds: []int64{tagA, tagB}, limit: 10, want: []int64{noteId + 2, noteId + 1, noteId}},
+			{name: "limited", tagIds: []int64{tagA, tagB, tagC}, limit: 2, want: []int64{noteId + 3, noteId + 2}},
+		}
+		for _, c := range cases {
+			Convey(c.name, func() {
+				noteIds, err := repo.ListNoteIdsByTags(ctx, c.tagIds, c.limit)
+				So(err, ShouldBeNil)
+				So(noteIds, ShouldResemble, c.want)
+			})
+		}
+
+		Convey("replace tags", func() {
+			replace(noteId+1, []int64{tagC})
+
+			noteIds, err := repo.ListNoteIdsByTags(ctx, []int64{tagA}, 10)
+			So(err, ShouldBeNil)
+			So(noteIds, ShouldResemble, []int64{noteId})
+
+			noteIds, err = repo.ListNoteIdsByTags(ctx, []int64{tagC}, 10)
+			So(err, ShouldBeNil)
+			So(noteIds, ShouldResemble, []int64{noteId + 3, noteId + 1})
+
+			So(repo.DeleteByNoteId(ctx, noteId+3), ShouldBeNil)
+			noteIds, err = repo.ListNoteIdsByTags(ctx, []int64{tagC}, 10)
+			So(err, ShouldBeNil)
+			So(noteIds, ShouldResemble, []int64{noteId + 1})
+		})
+	})
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/ryanreadbooks/whimer/misc/xsql"
+	"github.com/ryanreadbooks/whimer/note/internal/model"
 
 	. "github.com/smartystreets/goconvey/convey"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
@@ -79,3 +81,43 @@ func TestNoteExt_Get(t *testing.T) {
 
 	})
 }
+
+func TestNote_UpgradeState(t *testing.T) {
+	Convey("UpgradeState from draft to published", t, func() {
+		now := time.Now().Unix()
+		noteId, err := noteRepo.Insert(ctx, &NotePO{
+			Title:    "scheduled",
+			Owner:    100,
+			Privacy:  model.PrivacyPublic,
+			NoteType: model.AssetTypeImage,
+			State:    model.NoteStateDraft,
+			CreateAt: now,
+			UpdateAt: now,
+		})
+		So(err, ShouldBeNil)
+		defer noteRepo.Delete(ctx, noteId)
+
+		getState := func() model.NoteState {
+			po, err := noteRepo.FindOne(ctx, noteId)
+			So(err, ShouldBeNil)
+			return po.State
+		}
+
+		cases := []struct {
+			target model.NoteState
+			want   model.NoteState
+		}{
+			{model.NoteStateScheduled, model.NoteStateScheduled},
+			{model.NoteStateProcessing, model.NoteStateProcessing},
+			// 并发的旧流程不能把状态退回去
+			{model.NoteStateScheduled, model.NoteStateProcessing},
+			{model.NoteStateAuditPassed, model.NoteStateAuditPassed},
+			{model.NoteStatePublished, model.NoteStatePublished},
+			{model.NoteStateDraft, model.NoteStatePublished},
+		}
+		for _, c := range cases {
+			So(noteRepo.UpgradeState(ctx, noteId, c.target), ShouldBeNil)
+			So(getState(), ShouldEqual, c.want)
+		}
+	})
+}
@@ -0,0 +1,142 @@
+package note
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/misc/xconv"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const (
+	noteViewDedupKey    = "note:view:dedup:%d:%d" // note:view:dedup:{noteId}:{window}
+	noteViewPendingKey  = "note:view:pending"     // 等待汇总到数据库的浏览量 hash noteId -> count
+	noteViewFlushingKey = "note:view:flushing"    // 正在汇总到数据库的浏览量
+
+	noteViewBatchField = "batch" // flushing中记录本次汇总批次id的字段
+)
+
+func getNoteViewDedupKey(noteId int64, window int64) string {
+	return fmt.Sprintf(noteViewDedupKey, noteId, window)
+}
+
+// 将pending转移到flushing后返回flushing中的全部数据
+//
+// flushing已经存在说明上一次汇总没有完成 直接返回上一次的数据重试;
+// 每批数据带有唯一的批次id 重试时批次id不变 用于数据库中判断该批次是否已经汇总
+var takeNoteViewPendingScript = redis.NewScript(`
+redis.replicate_commands()
+if redis.call("EXISTS", KEYS[2]) == 0 then
+	if redis.call("EXISTS", KEYS[1]) == 0 then
+		return {}
+	end
+	redis.call("RENAME", KEYS[1], KEYS[2])
+end
+if redis.call("HEXISTS", KEYS[2], ARGV[1]) == 0 then
+	local t = redis.call("TIME")
+	redis.call("HSET", KEYS[2], ARGV[1], t[1] .. string.format("%06d", tonumber(t[2])))
+end
+return redis.call("HGETALL", KEYS[2])
+`)
+
+// NoteViewCache 笔记浏览量缓存 - 纯缓存操作
+//
+// 每个笔记每个去重窗口使用一个HyperLogLog记录浏览过的用户 新用户浏览时累加到待汇总的hash中
+type NoteViewCache struct {
+	cache *redis.Redis
+}
+
+func NewNoteViewCache(cache *redis.Redis) *NoteViewCache {
+	return &NoteViewCache{
+		cache: cache,
+	}
+}
+
+// AddViewer 记录窗口内浏览过笔记的用户 返回是否为窗口内新的浏览者
+//
+// HyperLogLog存在误差 极少数新的浏览者会被当作重复浏览
+func (c *NoteViewCache) AddViewer(ctx context.Context, noteId int64, viewer string, window time.Duration) (bool, error) {
+	if c.cache == nil {
+		return false, nil
+	}
+
+	now := time.Now()
+	windowSec := int64(window.Seconds())
+	if windowSec <= 0 {
+		windowSec = 1
+	}
+	key := getNoteViewDedupKey(noteId, now.Unix()/windowSec)
+	added, err := c.cache.PfaddCtx(ctx, key, viewer)
+	if err != nil {
+		return false, err
+	}
+
+	if added {
+		err = c.cache.ExpireCtx(ctx, key, int(windowSec))
+		if err != nil {
+			return added, err
+		}
+	}
+
+	return added, nil
+}
+
+// IncrPending 累加等待汇总的浏览量
+func (c *NoteViewCache) IncrPending(ctx context.Context, noteId int64, delta int) error {
+	if c.cache == nil {
+		return nil
+	}
+
+	_, err := c.cache.HincrbyCtx(ctx, noteViewPendingKey, xconv.FormatInt(noteId), delta)
+	return err
+}
+
+// TakePending 取出等待汇总的浏览量和批次id 汇总完成后需要调用AckPending
+func (c *NoteViewCache) TakePending(ctx context.Context) (string, map[int64]int64, error) {
+	if c.cache == nil {
+		return "", map[int64]int64{}, nil
+	}
+
+	res, err := c.cache.ScriptRunCtx(ctx, takeNoteViewPendingScript,
+		[]string{noteViewPendingKey, noteViewFlushingKey}, noteViewBatchField)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var (
+		batchId  string
+		pairs, _ = res.([]any)
+		result   = make(map[int64]int64, len(pairs)/2)
+	)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		field, _ := pairs[i].(string)
+		value, _ := pairs[i+1].(string)
+		if field == noteViewBatchField {
+			batchId = value
+			continue
+		}
+		noteId, err := strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			continue
+		}
+		cnt, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || cnt <= 0 {
+			continue
+		}
+		result[noteId] += cnt
+	}
+
+	return batchId, result, nil
+}
+
+// AckPending 汇总完成后删除已经取出的浏览量
+func (c *NoteViewCache) AckPending(ctx context.Context) error {
+	if c.cache == nil {
+		return nil
+	}
+
+	_, err := c.cache.DelCtx(ctx, noteViewFlushingKey)
+	return err
+}
@@ -0,0 +1,111 @@
+package note
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/misc/xmap"
+
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xslice"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
+)
+
+// 笔记浏览量 由缓存中去重后的浏览定期汇总
+type ViewPO struct {
+	NoteId int64 `db:"note_id"`
+	Views  int64 `db:"views"`
+	Utime  int64 `db:"utime"`
+}
+
+const (
+	viewFields = "note_id,views,utime"
+
+	viewIncrBatchSize = 200
+)
+
+// NoteViewRepo 笔记浏览量数据库仓储 - 纯数据库操作
+type NoteViewRepo struct {
+	db *xsql.DB
+}
+
+func NewNoteViewRepo(db *xsql.DB) *NoteViewRepo {
+	return &NoteViewRepo{
+		db: db,
+	}
+}
+
+// BatchIncr 批量累加浏览量 noteId -> delta
+//
+// 按noteId顺序分批写入 避免单条sql过大
+func (d *NoteViewRepo) BatchIncr(ctx context.Context, deltas map[int64]int64) error {
+	if len(deltas) == 0 {
+		return nil
+	}
+
+	now := time.Now().Unix()
+	noteIds := xmap.Keys(deltas)
+	slices.Sort(noteIds)
+	return xslice.BatchExec(noteIds, viewIncrBatchSize, func(start, end int) error {
+		placeholders := make([]string, 0, end-start)
+		args := make([]any, 0, (end-start)*3)
+		for _, noteId := range noteIds[start:end] {
+			placeholders = append(placeholders, "(?,?,?)")
+			args = append(args, noteId, deltas[noteId], now)
+		}
+
+		sql := "INSERT INTO note_view(" + viewFields + ") VALUES " + strings.Join(placeholders, ",") +
+			" ON DUPLICATE KEY UPDATE views=views+VALUES(views),utime=VALUES(utime)"
+		_, err := d.db.ExecCtx(ctx, sql, args...)
+		return xerror.Wrap(xsql.ConvertError(err))
+	})
+}
+
+// MarkFlushed 记录已经汇总的批次 批次已经记录过时返回false
+//
+// 需要和BatchIncr在同一个事务中调用
+func (d *NoteViewRepo) MarkFlushed(ctx context.Context, batchId string) (bool, error) {
+	const sql = "INSERT IGNORE INTO note_view_flush(batch_id,ctime) VALUES (?,?)"
+	res, err := d.db.ExecCtx(ctx, sql, batchId, time.Now().Unix())
+	if err != nil {
+		return false, xerror.Wrap(xsql.ConvertError(err))
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, xerror.Wrap(xsql.ConvertError(err))
+	}
+
+	return affected > 0, nil
+}
+
+// PurgeFlushed 清理before之前的汇总批次记录
+func (d *NoteViewRepo) PurgeFlushed(ctx context.Context, before int64, limit int) (int64, error) {
+	const sql = "DELETE FROM note_view_flush WHERE ctime<? LIMIT ?"
+	res, err := d.db.ExecCtx(ctx, sql, before, limit)
+	if err != nil {
+		return 0, xerror.Wrap(xsql.ConvertError(err))
+	}
+
+	return res.RowsAffected()
+}
+
+func (d *NoteViewRepo) BatchGetById(ctx context.Context, noteIds []int64) ([]*ViewPO, error) {
+	if len(noteIds) == 0 {
+		return []*ViewPO{}, nil
+	}
+
+	const sql = "SELECT " + viewFields + " FROM note_view WHERE note_id IN (%s)"
+	var pos []*ViewPO
+	err := d.db.QueryRowsCtx(ctx, &pos, fmt.Sprintf(sql, xslice.JoinInts(noteIds)))
+	return pos, xerror.Wrap(xsql.ConvertError(err))
+}
+
+func (d *NoteViewRepo) Delete(ctx context.Context, noteId int64) error {
+	const sql = "DELETE FROM note_view WHERE note_id=? LIMIT 1"
+	_, err := d.db.ExecCtx(ctx, sql, noteId)
+	return xerror.Wrap(xsql.ConvertError(err))
+}
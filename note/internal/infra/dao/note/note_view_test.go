@@ -0,0 +1,158 @@
+package note
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+var (
+	noteViewOnce  sync.Once
+	noteViewRepo  *NoteViewRepo
+	noteViewCache *NoteViewCache
+)
+
+func setupNoteView() {
+	noteViewOnce.Do(func() {
+		noteViewRepo = NewNoteViewRepo(testDb)
+		noteViewCache = NewNoteViewCache(redis.MustNewRedis(redis.RedisConf{
+			Host: "127.0.0.1:7542",
+			Type: "node",
+		}))
+	})
+}
+
+func getNoteViews(noteIds []int64) map[int64]int64 {
+	pos, err := noteViewRepo.BatchGetById(ctx, noteIds)
+	So(err, ShouldBeNil)
+
+	views := make(map[int64]int64, len(pos))
+	for _, po := range pos {
+		views[po.NoteId] = po.Views
+	}
+	return views
+}
+
+func TestNoteViewCache_AddViewer(t *testing.T) {
+	setupNoteView()
+
+	Convey("AddViewer dedup within window", t, func() {
+		noteId := time.Now().UnixNano()
+
+		added, err := noteViewCache.AddViewer(ctx, noteId, "100", time.Hour)
+		So(err, ShouldBeNil)
+		So(added, ShouldBeTrue)
+
+		// 窗口内重复浏览不计数
+		added, err = noteViewCache.AddViewer(ctx, noteId, "100", time.Hour)
+		So(err, ShouldBeNil)
+		So(added, ShouldBeFalse)
+
+		added, err = noteViewCache.AddViewer(ctx, noteId, "ip:127.0.0.1", time.Hour)
+		So(err, ShouldBeNil)
+		So(added, ShouldBeTrue)
+
+		// 其它笔记不受影响
+		added, err = noteViewCache.AddViewer(ctx, noteId+1, "100", time.Hour)
+		So(err, ShouldBeNil)
+		So(added, ShouldBeTrue)
+	})
+}
+
+func TestNoteViewRepo_BatchIncr(t *testing.T) {
+	setupNoteView()
+
+	Convey("BatchIncr in chunks", t, func() {
+		var (
+			base    = time.Now().UnixNano()
+			count   = viewIncrBatchSize*2 + 50
+			deltas  = make(map[int64]int64, count)
+			noteIds = make([]int64, 0, count)
+		)
+		for i := range count {
+			noteId := base + int64(i)
+			deltas[noteId] = int64(i%5 + 1)
+			noteIds = append(noteIds, noteId)
+		}
+		defer func() {
+			for _, noteId := range noteIds {
+				noteViewRepo.Delete(ctx, noteId)
+			}
+		}()
+
+		So(noteViewRepo.BatchIncr(ctx, deltas), ShouldBeNil)
+		So(getNoteViews(noteIds), ShouldResemble, deltas)
+
+		// 再次累加 每一批都需要生效
+		So(noteViewRepo.BatchIncr(ctx, deltas), ShouldBeNil)
+		views := getNoteViews(noteIds)
+		So(views, ShouldHaveLength, count)
+		for noteId, delta := range deltas {
+			So(views[noteId], ShouldEqual, delta*2)
+		}
+	})
+}
+
+func TestNoteView_ReplayFlush(t *testing.T) {
+	setupNoteView()
+
+	Convey("replayed flush batch is applied once", t, func() {
+		_, err := noteViewCache.cache.DelCtx(ctx, noteViewPendingKey, noteViewFlushingKey)
+		So(err, ShouldBeNil)
+
+		base := time.Now().UnixNano()
+		noteIds := []int64{base, base + 1}
+		defer func() {
+			noteViewCache.AckPending(ctx)
+			for _, noteId := range noteIds {
+				noteViewRepo.Delete(ctx, noteId)
+			}
+		}()
+
+		So(noteViewCache.IncrPending(ctx, noteIds[0], 2), ShouldBeNil)
+		So(noteViewCache.IncrPending(ctx, noteIds[1], 3), ShouldBeNil)
+
+		flush := func(batchId string, pending map[int64]int64) bool {
+			var first bool
+			err := testDb.Transact(ctx, func(ctx context.Context) error {
+				var err error
+				first, err = noteViewRepo.MarkFlushed(ctx, batchId)
+				if err != nil || !first {
+					return err
+				}
+				return noteViewRepo.BatchIncr(ctx, pending)
+			})
+			So(err, ShouldBeNil)
+			return first
+		}
+
+		batchId, pending, err := noteViewCache.TakePending(ctx)
+		So(err, ShouldBeNil)
+		So(batchId, ShouldNotBeEmpty)
+		So(pending, ShouldResemble, map[int64]int64{noteIds[0]: 2, noteIds[1]: 3})
+		So(flush(batchId, pending), ShouldBeTrue)
+
+		// 确认失败 期间产生的新浏览不会混入正在汇总的批次
+		So(noteViewCache.IncrPending(ctx, noteIds[0], 1), ShouldBeNil)
+
+		replayId, replayed, err := noteViewCache.TakePending(ctx)
+		So(err, ShouldBeNil)
+		So(replayId, ShouldEqual, batchId)
+		So(replayed, ShouldResemble, pending)
+		So(flush(replayId, replayed), ShouldBeFalse)
+		So(getNoteViews(noteIds), ShouldResemble, map[int64]int64{noteIds[0]: 2, noteIds[1]: 3})
+
+		// 确认后下一批使用新的批次id
+		So(noteViewCache.AckPending(ctx), ShouldBeNil)
+		nextId, next, err := noteViewCache.TakePending(ctx)
+		So(err, ShouldBeNil)
+		So(nextId, ShouldNotEqual, batchId)
+		So(next, ShouldResemble, map[int64]int64{noteIds[0]: 1})
+		So(flush(nextId, next), ShouldBeTrue)
+		So(getNoteViews(noteIds), ShouldResemble, map[int64]int64{noteIds[0]: 3, noteIds[1]: 3})
+	})
+}
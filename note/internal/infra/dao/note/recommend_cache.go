@@ -0,0 +1,169 @@
+package note
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/misc/xconv"
+	"github.com/ryanreadbooks/whimer/misc/xtime"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const (
+	recommendHotCacheKey     = "note:recommend:hot:%s"         // note:recommend:hot:20060102
+	recommendUserShownKey    = "note:recommend:shown:uid:"     // note:recommend:shown:uid:%d
+	recommendSessionShownKey = "note:recommend:shown:session:" // note:recommend:shown:session:%s
+
+	recommendHotDayLayout = "20060102"
+
+	recommendHotTTL          = 3 * xtime.DaySec
+	recommendUserShownTTL    = 3 * xtime.DaySec
+	recommendSessionShownTTL = 30 * xtime.MinuteSec
+
+	// 用户维度最多保留的已曝光笔记数量
+	recommendUserShownMaxSize = 1000
+)
+
+func getRecommendHotCacheKey(t time.Time) string {
+	return fmt.Sprintf(recommendHotCacheKey, t.Format(recommendHotDayLayout))
+}
+
+func getRecommendUserShownKey(uid int64) string {
+	return recommendUserShownKey + xconv.FormatInt(uid)
+}
+
+func getRecommendSessionShownKey(session string) string {
+	return recommendSessionShownKey + session
+}
+
+// RecommendCache 推荐相关缓存 - 纯缓存操作
+//
+// 热度按天分桶记录在zset中 曝光记录分为会话维度和用户维度
+type RecommendCache struct {
+	cache *redis.Redis
+}
+
+func NewRecommendCache(cache *redis.Redis) *RecommendCache {
+	return &RecommendCache{
+		cache: cache,
+	}
+}
+
+// IncrHot 增加笔记当天的热度
+func (c *RecommendCache) IncrHot(ctx context.Context, noteId int64, delta int64) error {
+	if c.cache == nil {
+		return nil
+	}
+
+	key := getRecommendHotCacheKey(time.Now())
+	_, err := c.cache.ZincrbyCtx(ctx, key, delta, xconv.FormatInt(noteId))
+	if err != nil {
+		return err
+	}
+
+	return c.cache.ExpireCtx(ctx, key, recommendHotTTL)
+}
+
+// ListHot 获取最近days天热度最高的笔记 返回笔记id和累计热度
+//
+// 每天最多取limit条 结果未排序
+func (c *RecommendCache) ListHot(ctx context.Context, days int, limit int) (map[int64]int64, error) {
+	if c.cache == nil || limit <= 0 {
+		return map[int64]int64{}, nil
+	}
+
+	now := time.Now()
+	result := make(map[int64]int64, limit)
+	for i := range days {
+		key := getRecommendHotCacheKey(now.AddDate(0, 0, -i))
+		pairs, err := c.cache.ZrevrangeWithScoresCtx(ctx, key, 0, int64(limit-1))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pair := range pairs {
+			noteId, err := strconv.ParseInt(pair.Key, 10, 64)
+			if err != nil || pair.Score <= 0 {
+				continue
+			}
+			result[noteId] += pair.Score
+		}
+	}
+
+	return result, nil
+}
+
+// AddUserShown 记录已经向用户曝光的笔记 只保留最近的recommendUserShownMaxSize条
+func (c *RecommendCache) AddUserShown(ctx context.Context, uid int64, noteIds []int64) error {
+	if c.cache == nil || len(noteIds) == 0 {
+		return nil
+	}
+
+	key := getRecommendUserShownKey(uid)
+	err := c.addShown(ctx, key, noteIds, recommendUserShownTTL)
+	if err != nil {
+		return err
+	}
+
+	// 按曝光时间裁剪掉最早的记录
+	_, err = c.cache.ZremrangebyrankCtx(ctx, key, 0, -recommendUserShownMaxSize-1)
+	return err
+}
+
+// GetUserShown 获取已经向用户曝光的笔记
+func (c *RecommendCache) GetUserShown(ctx context.Context, uid int64) (map[int64]struct{}, error) {
+	return c.getShown(ctx, getRecommendUserShownKey(uid))
+}
+
+// AddSessionShown 记录在推荐会话中已经返回的笔记
+func (c *RecommendCache) AddSessionShown(ctx context.Context, session string, noteIds []int64) error {
+	if c.cache == nil || len(noteIds) == 0 {
+		return nil
+	}
+
+	return c.addShown(ctx, getRecommendSessionShownKey(session), noteIds, recommendSessionShownTTL)
+}
+
+// GetSessionShown 获取在推荐会话中已经返回的笔记
+func (c *RecommendCache) GetSessionShown(ctx context.Context, session string) (map[int64]struct{}, error) {
+	return c.getShown(ctx, getRecommendSessionShownKey(session))
+}
+
+func (c *RecommendCache) addShown(ctx context.Context, key string, noteIds []int64, ttl int) error {
+	now := time.Now().UnixMilli()
+	pairs := make([]redis.Pair, 0, len(noteIds))
+	for _, noteId := range noteIds {
+		pairs = append(pairs, redis.Pair{Key: xconv.FormatInt(noteId), Score: now})
+	}
+
+	_, err := c.cache.ZaddsCtx(ctx, key, pairs...)
+	if err != nil {
+		return err
+	}
+
+	return c.cache.ExpireCtx(ctx, key, ttl)
+}
+
+func (c *RecommendCache) getShown(ctx context.Context, key string) (map[int64]struct{}, error) {
+	if c.cache == nil {
+		return map[int64]struct{}{}, nil
+	}
+
+	members, err := c.cache.ZrangeCtx(ctx, key, 0, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int64]struct{}, len(members))
+	for _, member := range members {
+		noteId, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			continue
+		}
+		result[noteId] = struct{}{}
+	}
+
+	return result, nil
+}
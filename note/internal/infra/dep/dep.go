@@ -5,6 +5,7 @@ import (
 	commentv1 "github.com/ryanreadbooks/whimer/idl/gen/go/comment/api/v1"
 	counterv1 "github.com/ryanreadbooks/whimer/idl/gen/go/counter/api/v1"
 	userv1 "github.com/ryanreadbooks/whimer/idl/gen/go/passport/api/user/v1"
+	relationv1 "github.com/ryanreadbooks/whimer/idl/gen/go/relation/api/v1"
 	searchv1 "github.com/ryanreadbooks/whimer/idl/gen/go/search/api/v1"
 	"github.com/ryanreadbooks/whimer/misc/xgrpc"
 	"github.com/ryanreadbooks/whimer/note/internal/config"
@@ -15,6 +16,7 @@ var (
 	commenter       commentv1.CommentServiceClient // 评论服务
 	searchdocer     searchv1.DocumentServiceClient // 搜索服务
 	userer          userv1.UserServiceClient
+	relationer      relationv1.RelationServiceClient // 关系服务
 	conductProducer *producer.Client
 )
 
@@ -32,6 +34,10 @@ func Init(c *config.Config) {
 		xgrpc.NewRecoverableClientConn(c.External.Grpc.Passport),
 	)
 
+	relationer = relationv1.NewRelationServiceClient(
+		xgrpc.NewRecoverableClientConn(c.External.Grpc.Relation),
+	)
+
 	conductProducer, _ = producer.New(producer.ClientOptions{
 		HostConf:  c.External.Grpc.Conductor,
 		Namespace: "note",
@@ -54,6 +60,10 @@ func GetUserer() userv1.UserServiceClient {
 	return userer
 }
 
+func GetRelationer() relationv1.RelationServiceClient {
+	return relationer
+}
+
 func GetConductProducer() *producer.Client {
 	return conductProducer
 }
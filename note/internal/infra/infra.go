@@ -8,6 +8,8 @@ import (
 	"github.com/ryanreadbooks/whimer/note/internal/infra/dep"
 	"github.com/ryanreadbooks/whimer/note/internal/infra/etcd"
 	"github.com/ryanreadbooks/whimer/note/internal/infra/kafka"
+	"github.com/ryanreadbooks/whimer/note/internal/infra/moderation"
+	"github.com/ryanreadbooks/whimer/note/internal/infra/oss"
 	"github.com/zeromicro/go-zero/core/logx"
 	"github.com/zeromicro/go-zero/core/stores/redis"
 )
@@ -29,6 +31,8 @@ func Init(conf *config.Config) {
 		kafka.Init(conf)
 		dt = data.MustNew(conf, cache, kafka.GetPublisher())
 		dep.Init(conf)
+		moderation.Init(conf)
+		oss.Init(conf)
 	})
 }
 
@@ -44,6 +48,7 @@ func Cache() *redis.Redis {
 func Close() {
 	logx.Info("closing infra")
 	kafka.Close()
+	moderation.Close()
 	dt.Close()
 }
 
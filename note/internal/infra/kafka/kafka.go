@@ -64,10 +64,12 @@ func Init(c *config.Config) {
 		Async:     true,
 	})
 
+	// 同步写时调用方会等待BatchTimeout 默认1s太长
 	writer = xkafka.NewWriter(&kafka.Writer{
-		Addr:      kafka.TCP(addrs...),
-		Balancer:  &kafka.Hash{},
-		Transport: &transport,
+		Addr:         kafka.TCP(addrs...),
+		Balancer:     &kafka.Hash{},
+		Transport:    &transport,
+		BatchTimeout: 10 * time.Millisecond,
 	})
 
 	pub = &Publisher{
@@ -0,0 +1,122 @@
+package moderation
+
+import (
+	"unicode"
+)
+
+// 敏感词匹配时统一转小写 忽略大小写差异
+func normalizeRune(r rune) rune {
+	return unicode.ToLower(r)
+}
+
+type acNode struct {
+	next map[rune]int32
+	fail int32
+	// 以该节点结尾的模式串下标 包含通过fail链可达的输出
+	outputs []int32
+}
+
+// Aho-Corasick自动机 构建后只读 可以并发匹配
+type acMatcher struct {
+	nodes    []acNode
+	patterns []string
+}
+
+func newACMatcher(patterns []string) *acMatcher {
+	m := &acMatcher{
+		nodes: []acNode{{next: map[rune]int32{}}},
+	}
+
+	for _, p := range patterns {
+		if p == "" {
+			continue
+		}
+		m.insert(p, int32(len(m.patterns)))
+		m.patterns = append(m.patterns, p)
+	}
+	m.build()
+
+	return m
+}
+
+func (m *acMatcher) insert(pattern string, idx int32) {
+	var cur int32
+	for _, r := range pattern {
+		r = normalizeRune(r)
+		nxt, ok := m.nodes[cur].next[r]
+		if !ok {
+			nxt = int32(len(m.nodes))
+			m.nodes = append(m.nodes, acNode{next: map[rune]int32{}})
+			m.nodes[cur].next[r] = nxt
+		}
+		cur = nxt
+	}
+	m.nodes[cur].outputs = append(m.nodes[cur].outputs, idx)
+}
+
+// 按层构建fail指针
+func (m *acMatcher) build() {
+	queue := make([]int32, 0, len(m.nodes))
+	for _, child := range m.nodes[0].next {
+		m.nodes[child].fail = 0
+		queue = append(queue, child)
+	}
+
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for r, child := range m.nodes[cur].next {
+			fail := m.nodes[cur].fail
+			for {
+				if nxt, ok := m.nodes[fail].next[r]; ok && nxt != child {
+					m.nodes[child].fail = nxt
+					break
+				}
+				if fail == 0 {
+					m.nodes[child].fail = 0
+					break
+				}
+				fail = m.nodes[fail].fail
+			}
+			failNode := m.nodes[child].fail
+			m.nodes[child].outputs = append(m.nodes[child].outputs, m.nodes[failNode].outputs...)
+			queue = append(queue, child)
+		}
+	}
+}
+
+// 返回文本中命中的模式串下标 每个模式串最多返回一次
+func (m *acMatcher) match(text string) []int {
+	if m == nil || len(m.patterns) == 0 {
+		return nil
+	}
+
+	var (
+		cur  int32
+		hits []int
+		seen = make(map[int32]struct{})
+	)
+	for _, r := range text {
+		r = normalizeRune(r)
+		for {
+			if nxt, ok := m.nodes[cur].next[r]; ok {
+				cur = nxt
+				break
+			}
+			if cur == 0 {
+				break
+			}
+			cur = m.nodes[cur].fail
+		}
+
+		for _, idx := range m.nodes[cur].outputs {
+			if _, ok := seen[idx]; ok {
+				continue
+			}
+			seen[idx] = struct{}{}
+			hits = append(hits, int(idx))
+		}
+	}
+
+	return hits
+}
@@ -0,0 +1,161 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xlog"
+	"github.com/ryanreadbooks/whimer/note/internal/config"
+)
+
+const (
+	localImageFetchTimeout = 10 * time.Second
+	localImageMaxBytes     = 20 << 20
+)
+
+var _ Provider = (*LocalProvider)(nil)
+
+// 本地审核
+//
+// 文本使用敏感词匹配 图片使用感知哈希匹配违规图片库;
+// 视频没有抽帧能力 不做处理
+type LocalProvider struct {
+	words    *wordFilter
+	hashes   *hashBlocklist
+	baseUrl  string
+	client   *http.Client
+	watchers []*fileWatcher
+}
+
+func MustNewLocalProvider(c *config.LocalModerationConfig) *LocalProvider {
+	p, err := NewLocalProvider(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return p
+}
+
+func NewLocalProvider(c *config.LocalModerationConfig) (*LocalProvider, error) {
+	p := &LocalProvider{
+		words:   newWordFilter(),
+		hashes:  newHashBlocklist(c.ImageHashThreshold),
+		baseUrl: strings.TrimSuffix(c.ImageBaseUrl, "/"),
+		client:  &http.Client{Timeout: localImageFetchTimeout},
+	}
+
+	if c.WordListFile != "" {
+		w := newFileWatcher(c.WordListFile, c.ReloadInterval, p.words.load)
+		if err := w.start(); err != nil {
+			p.Close()
+			return nil, fmt.Errorf("load word list failed: %w", err)
+		}
+		p.watchers = append(p.watchers, w)
+	}
+
+	if c.ImageHashFile != "" {
+		w := newFileWatcher(c.ImageHashFile, c.ReloadInterval, p.hashes.load)
+		if err := w.start(); err != nil {
+			p.Close()
+			return nil, fmt.Errorf("load image hash list failed: %w", err)
+		}
+		p.watchers = append(p.watchers, w)
+	}
+
+	return p, nil
+}
+
+func (p *LocalProvider) Name() string {
+	return config.ModerationProviderLocal
+}
+
+func (p *LocalProvider) Close() {
+	for _, w := range p.watchers {
+		w.stop()
+	}
+}
+
+func (p *LocalProvider) ModerateText(ctx context.Context, content *TextContent) (*Verdict, error) {
+	texts := append([]string{content.Title, content.Desc}, content.Tags...)
+	decision, hits := p.words.check(texts...)
+	if decision == DecisionPass {
+		return Pass(), nil
+	}
+
+	return &Verdict{
+		Decision: decision,
+		Reason:   "命中敏感词: " + strings.Join(hits, ","),
+		Hits:     hits,
+	}, nil
+}
+
+func (p *LocalProvider) ModerateImage(ctx context.Context, content *ImageContent) (*Verdict, error) {
+	if p.baseUrl == "" {
+		return Pass(), nil
+	}
+
+	verdict := Pass()
+	for _, key := range content.Keys {
+		img, err := p.fetchImage(ctx, key)
+		if err != nil {
+			return nil, xerror.Wrapf(err, "local moderation fetch image failed").WithExtra("key", key).WithCtx(ctx)
+		}
+		if img == nil {
+			continue
+		}
+
+		hash := PHash(img)
+		if entry, ok := p.hashes.check(hash); ok {
+			reason := "命中违规图片"
+			if entry.label != "" {
+				reason += ": " + entry.label
+			}
+			verdict = Merge(verdict, &Verdict{
+				Decision: DecisionReject,
+				Reason:   reason,
+				Hits:     []string{key},
+			})
+		}
+	}
+
+	return verdict, nil
+}
+
+func (p *LocalProvider) ModerateVideo(ctx context.Context, content *VideoContent) (*Verdict, error) {
+	return Pass(), nil
+}
+
+// 下载并解码图片 不支持的图片格式返回nil
+func (p *LocalProvider) fetchImage(ctx context.Context, key string) (image.Image, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.baseUrl+"/"+strings.TrimPrefix(key, "/"), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	img, _, err := image.Decode(io.LimitReader(resp.Body, localImageMaxBytes))
+	if err != nil {
+		xlog.Msg("local moderation decode image failed, skip").Err(err).Extras("key", key).Infox(ctx)
+		return nil, nil
+	}
+
+	return img, nil
+}
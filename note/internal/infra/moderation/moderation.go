@@ -0,0 +1,116 @@
+package moderation
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ryanreadbooks/whimer/note/internal/config"
+)
+
+type Decision int8
+
+// 审核结论 数值越大越严重
+const (
+	DecisionPass   Decision = 1 // 通过
+	DecisionReview Decision = 2 // 需要人工复审
+	DecisionReject Decision = 3 // 不通过
+)
+
+// 审核结果
+type Verdict struct {
+	Decision Decision
+	Reason   string
+	Hits     []string // 命中的敏感词或者图片
+}
+
+func Pass() *Verdict {
+	return &Verdict{Decision: DecisionPass}
+}
+
+// 合并多个审核结果 取最严重的结论 原因和命中项合并
+func Merge(verdicts ...*Verdict) *Verdict {
+	merged := Pass()
+	reasons := make([]string, 0, len(verdicts))
+	for _, v := range verdicts {
+		if v == nil || v.Decision == DecisionPass {
+			continue
+		}
+		if v.Decision > merged.Decision {
+			merged.Decision = v.Decision
+		}
+		if v.Reason != "" {
+			reasons = append(reasons, v.Reason)
+		}
+		merged.Hits = append(merged.Hits, v.Hits...)
+	}
+	merged.Reason = strings.Join(reasons, ";")
+
+	return merged
+}
+
+// 待审核的文本
+type TextContent struct {
+	Title string
+	Desc  string
+	Tags  []string
+}
+
+// 待审核的图片 key为资源key
+type ImageContent struct {
+	Keys []string
+}
+
+// 待审核的视频 key为资源key
+type VideoContent struct {
+	Keys []string
+}
+
+// Provider 审核提供方
+//
+// 返回错误表示审核本身失败 而不是审核不通过
+type Provider interface {
+	Name() string
+	ModerateText(ctx context.Context, content *TextContent) (*Verdict, error)
+	ModerateImage(ctx context.Context, content *ImageContent) (*Verdict, error)
+	ModerateVideo(ctx context.Context, content *VideoContent) (*Verdict, error)
+}
+
+var provider Provider = passProvider{}
+
+func Init(c *config.Config) {
+	switch c.Moderation.Provider {
+	case config.ModerationProviderLocal:
+		provider = MustNewLocalProvider(&c.Moderation.Local)
+	default:
+		provider = passProvider{}
+	}
+}
+
+func GetProvider() Provider {
+	return provider
+}
+
+func Close() {
+	if closer, ok := provider.(interface{ Close() }); ok {
+		closer.Close()
+	}
+}
+
+// 不做任何审核 全部通过
+type passProvider struct{}
+
+func (passProvider) Name() string {
+	return config.ModerationProviderNone
+}
+
+func (passProvider) ModerateText(ctx context.Context, content *TextContent) (*Verdict, error) {
+	return Pass(), nil
+}
+
+func (passProvider) ModerateImage(ctx context.Context, content *ImageContent) (*Verdict, error) {
+	return Pass(), nil
+}
+
+func (passProvider) ModerateVideo(ctx context.Context, content *VideoContent) (*Verdict, error) {
+	return Pass(), nil
+}
@@ -0,0 +1,118 @@
+package moderation
+
+import (
+	"image"
+	"image/color"
+	"slices"
+	"strconv"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestACMatcher(t *testing.T) {
+	Convey("acMatcher match", t, func() {
+		m := newACMatcher([]string{"he", "she", "his", "hers", "赌博", "博彩"})
+
+		hits := m.match("ushers")
+		matched := make([]string, 0, len(hits))
+		for _, idx := range hits {
+			matched = append(matched, m.patterns[idx])
+		}
+		slices.Sort(matched)
+		So(matched, ShouldResemble, []string{"he", "hers", "she"})
+
+		hits = m.match("网络赌博彩票")
+		So(len(hits), ShouldEqual, 2)
+
+		// 忽略大小写
+		hits = m.match("SHE")
+		So(len(hits), ShouldEqual, 2)
+
+		So(m.match("nothing here to see"), ShouldHaveLength, 1)
+		So(m.match(""), ShouldBeEmpty)
+		So(newACMatcher(nil).match("she"), ShouldBeEmpty)
+	})
+}
+
+func TestWordFilter(t *testing.T) {
+	Convey("wordFilter check", t, func() {
+		f := newWordFilter()
+		decision, hits := f.check("anything")
+		So(decision, ShouldEqual, DecisionPass)
+		So(hits, ShouldBeEmpty)
+
+		err := f.load([]byte("# comment\n赌博\n代购|review\n\n外挂|reject\n"))
+		So(err, ShouldBeNil)
+
+		decision, hits = f.check("海外代购", "")
+		So(decision, ShouldEqual, DecisionReview)
+		So(hits, ShouldResemble, []string{"代购"})
+
+		decision, hits = f.check("海外代购", "游戏外挂")
+		So(decision, ShouldEqual, DecisionReject)
+		So(hits, ShouldHaveLength, 2)
+
+		// 格式错误时保留旧词表
+		err = f.load([]byte("赌博|unknown"))
+		So(err, ShouldNotBeNil)
+		decision, _ = f.check("赌博")
+		So(decision, ShouldEqual, DecisionReject)
+	})
+}
+
+func newGradientImage(w, h int, invert bool) image.Image {
+	img := image.NewGray(image.Rect(0, 0, w, h))
+	for y := range h {
+		for x := range w {
+			v := uint8((x*255/w + y*255/h) / 2)
+			if (x/(w/4)+y/(h/4))%2 == 0 {
+				v /= 2
+			}
+			if invert {
+				v = 255 - v
+			}
+			img.SetGray(x, y, color.Gray{Y: v})
+		}
+	}
+	return img
+}
+
+func TestPHash(t *testing.T) {
+	Convey("PHash", t, func() {
+		origin := PHash(newGradientImage(256, 256, false))
+		scaled := PHash(newGradientImage(512, 384, false))
+		inverted := PHash(newGradientImage(256, 256, true))
+
+		So(HammingDistance(origin, origin), ShouldEqual, 0)
+		So(HammingDistance(origin, scaled), ShouldBeLessThanOrEqualTo, 8)
+		So(HammingDistance(origin, inverted), ShouldBeGreaterThan, 16)
+
+		l := newHashBlocklist(8)
+		_, ok := l.check(origin)
+		So(ok, ShouldBeFalse)
+
+		So(l.load([]byte("# blocked\n"+formatHash(origin)+" sample\n")), ShouldBeNil)
+		entry, ok := l.check(scaled)
+		So(ok, ShouldBeTrue)
+		So(entry.label, ShouldEqual, "sample")
+
+		So(l.load([]byte("not-a-hash")), ShouldNotBeNil)
+	})
+}
+
+func TestMerge(t *testing.T) {
+	Convey("Merge", t, func() {
+		v := Merge(Pass(), nil, &Verdict{Decision: DecisionReview, Reason: "a", Hits: []string{"x"}},
+			&Verdict{Decision: DecisionReject, Reason: "b", Hits: []string{"y"}})
+		So(v.Decision, ShouldEqual, DecisionReject)
+		So(v.Reason, ShouldEqual, "a;b")
+		So(v.Hits, ShouldResemble, []string{"x", "y"})
+
+		So(Merge().Decision, ShouldEqual, DecisionPass)
+	})
+}
+
+func formatHash(hash uint64) string {
+	return strconv.FormatUint(hash, 16)
+}
@@ -0,0 +1,189 @@
+package moderation
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"image"
+	"math"
+	"math/bits"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+const (
+	phashSampleSize = 32 // 缩放后的边长
+	phashLowFreq    = 8  // 取左上角低频部分的边长
+	phashBoxSamples = 8  // 缩放时每个区域每个方向最多采样的点数
+)
+
+// 计算图片的感知哈希(pHash)
+//
+// 图片缩放到32x32灰度图后做二维DCT 取左上角8x8低频系数,
+// 大于中位数的位置为1 相似图片的哈希汉明距离较小
+func PHash(img image.Image) uint64 {
+	pixels := grayscaleResize(img, phashSampleSize)
+	coeffs := dct2d(pixels, phashSampleSize)
+
+	lowFreq := make([]float64, 0, phashLowFreq*phashLowFreq)
+	for y := range phashLowFreq {
+		for x := range phashLowFreq {
+			lowFreq = append(lowFreq, coeffs[y*phashSampleSize+x])
+		}
+	}
+
+	sorted := slices.Clone(lowFreq)
+	slices.Sort(sorted)
+	median := (sorted[len(sorted)/2-1] + sorted[len(sorted)/2]) / 2
+
+	var hash uint64
+	for i, c := range lowFreq {
+		if c > median {
+			hash |= 1 << uint(len(lowFreq)-1-i)
+		}
+	}
+
+	return hash
+}
+
+// 两个哈希的汉明距离
+func HammingDistance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
+
+// 缩放为size*size的灰度图 每个目标像素取对应区域内采样点的平均值
+func grayscaleResize(img image.Image, size int) []float64 {
+	var (
+		bounds = img.Bounds()
+		w, h   = bounds.Dx(), bounds.Dy()
+		pixels = make([]float64, size*size)
+	)
+	if w == 0 || h == 0 {
+		return pixels
+	}
+
+	for ty := range size {
+		y0, y1 := bounds.Min.Y+ty*h/size, bounds.Min.Y+(ty+1)*h/size
+		y1 = max(y1, y0+1)
+		stepY := max((y1-y0)/phashBoxSamples, 1)
+		for tx := range size {
+			x0, x1 := bounds.Min.X+tx*w/size, bounds.Min.X+(tx+1)*w/size
+			x1 = max(x1, x0+1)
+			stepX := max((x1-x0)/phashBoxSamples, 1)
+
+			var sum, cnt float64
+			for y := y0; y < y1; y += stepY {
+				for x := x0; x < x1; x += stepX {
+					r, g, b, _ := img.At(x, y).RGBA()
+					sum += 0.299*float64(r>>8) + 0.587*float64(g>>8) + 0.114*float64(b>>8)
+					cnt++
+				}
+			}
+			pixels[ty*size+tx] = sum / cnt
+		}
+	}
+
+	return pixels
+}
+
+// 二维DCT-II 先对行再对列做一维变换
+func dct2d(pixels []float64, size int) []float64 {
+	cosTable := make([]float64, size*size)
+	for k := range size {
+		for n := range size {
+			cosTable[k*size+n] = math.Cos(math.Pi / float64(size) * (float64(n) + 0.5) * float64(k))
+		}
+	}
+
+	dct1d := func(in, out []float64, stride int) {
+		for k := range size {
+			var sum float64
+			for n := range size {
+				sum += in[n*stride] * cosTable[k*size+n]
+			}
+			out[k*stride] = sum
+		}
+	}
+
+	rows := make([]float64, size*size)
+	for y := range size {
+		dct1d(pixels[y*size:], rows[y*size:], 1)
+	}
+	result := make([]float64, size*size)
+	for x := range size {
+		dct1d(rows[x:], result[x:], size)
+	}
+
+	return result
+}
+
+type hashEntry struct {
+	hash  uint64
+	label string
+}
+
+// 违规图片哈希列表 可以热更新
+//
+// 文件格式: 每行一个16进制的64位哈希 后面可以跟空格和说明 #开头为注释
+type hashBlocklist struct {
+	threshold int
+	entries   atomic.Pointer[[]hashEntry]
+}
+
+func newHashBlocklist(threshold int) *hashBlocklist {
+	l := &hashBlocklist{threshold: threshold}
+	l.entries.Store(&[]hashEntry{})
+	return l
+}
+
+func parseHashList(content []byte) ([]hashEntry, error) {
+	var (
+		entries []hashEntry
+		scanner = bufio.NewScanner(bytes.NewReader(content))
+		lineNo  = 0
+	)
+
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		hexHash, label, _ := strings.Cut(line, " ")
+		hash, err := strconv.ParseUint(strings.TrimPrefix(hexHash, "0x"), 16, 64)
+		if err != nil {
+			return nil, fmt.Errorf("image hash list line %d: %w", lineNo, err)
+		}
+
+		entries = append(entries, hashEntry{hash: hash, label: strings.TrimSpace(label)})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func (l *hashBlocklist) load(content []byte) error {
+	entries, err := parseHashList(content)
+	if err != nil {
+		return err
+	}
+	l.entries.Store(&entries)
+
+	return nil
+}
+
+// 返回命中的条目
+func (l *hashBlocklist) check(hash uint64) (hashEntry, bool) {
+	for _, e := range *l.entries.Load() {
+		if HammingDistance(hash, e.hash) <= l.threshold {
+			return e, true
+		}
+	}
+
+	return hashEntry{}, false
+}
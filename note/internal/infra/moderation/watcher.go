@@ -0,0 +1,93 @@
+package moderation
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/misc/concurrent"
+	"github.com/ryanreadbooks/whimer/misc/xlog"
+)
+
+// 定时检查文件修改时间 文件变更后重新加载
+//
+// 加载失败时保留上一次成功加载的内容
+type fileWatcher struct {
+	path     string
+	interval time.Duration
+	load     func(content []byte) error
+
+	modTime time.Time
+	quit    chan struct{}
+	once    sync.Once
+}
+
+func newFileWatcher(path string, interval time.Duration, load func(content []byte) error) *fileWatcher {
+	return &fileWatcher{
+		path:     path,
+		interval: interval,
+		load:     load,
+		quit:     make(chan struct{}),
+	}
+}
+
+// 首次加载失败直接返回错误
+func (w *fileWatcher) start() error {
+	if _, err := w.reload(); err != nil {
+		return err
+	}
+
+	if w.interval <= 0 {
+		return nil
+	}
+
+	concurrent.SafeGo(func() {
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-w.quit:
+				return
+			case <-ticker.C:
+				reloaded, err := w.reload()
+				if err != nil {
+					xlog.Msg("moderation file reload failed").Err(err).Extras("path", w.path).Errorx(context.Background())
+					continue
+				}
+				if reloaded {
+					xlog.Msg("moderation file reloaded").Extras("path", w.path).Infox(context.Background())
+				}
+			}
+		}
+	})
+
+	return nil
+}
+
+func (w *fileWatcher) reload() (bool, error) {
+	stat, err := os.Stat(w.path)
+	if err != nil {
+		return false, err
+	}
+	if stat.ModTime().Equal(w.modTime) {
+		return false, nil
+	}
+
+	content, err := os.ReadFile(w.path)
+	if err != nil {
+		return false, err
+	}
+	if err = w.load(content); err != nil {
+		return false, err
+	}
+	w.modTime = stat.ModTime()
+
+	return true, nil
+}
+
+func (w *fileWatcher) stop() {
+	w.once.Do(func() {
+		close(w.quit)
+	})
+}
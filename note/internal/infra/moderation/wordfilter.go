@@ -0,0 +1,109 @@
+package moderation
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strings"
+	"sync/atomic"
+)
+
+const (
+	wordLevelReject = "reject"
+	wordLevelReview = "review"
+)
+
+type wordDict struct {
+	matcher *acMatcher
+	levels  []Decision // 与matcher中的模式串一一对应
+}
+
+// 敏感词过滤 词表可以热更新
+//
+// 词表格式: 每行一个词 #开头为注释;
+// 词后可以跟 |review 表示命中后进入人工复审 默认 |reject 直接拒绝
+type wordFilter struct {
+	dict atomic.Pointer[wordDict]
+}
+
+func newWordFilter() *wordFilter {
+	f := &wordFilter{}
+	f.dict.Store(&wordDict{matcher: newACMatcher(nil)})
+	return f
+}
+
+func parseWordList(content []byte) (*wordDict, error) {
+	var (
+		words   []string
+		levels  []Decision
+		scanner = bufio.NewScanner(bytes.NewReader(content))
+		lineNo  = 0
+	)
+
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		word, level, _ := strings.Cut(line, "|")
+		word = strings.TrimSpace(word)
+		if word == "" {
+			continue
+		}
+
+		decision := DecisionReject
+		switch strings.TrimSpace(level) {
+		case "", wordLevelReject:
+		case wordLevelReview:
+			decision = DecisionReview
+		default:
+			return nil, fmt.Errorf("word list line %d: unknown level %q", lineNo, level)
+		}
+
+		words = append(words, word)
+		levels = append(levels, decision)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return &wordDict{
+		matcher: newACMatcher(words),
+		levels:  levels,
+	}, nil
+}
+
+func (f *wordFilter) load(content []byte) error {
+	dict, err := parseWordList(content)
+	if err != nil {
+		return err
+	}
+	f.dict.Store(dict)
+
+	return nil
+}
+
+// 检查文本 返回最严重的结论和命中的词
+func (f *wordFilter) check(texts ...string) (Decision, []string) {
+	var (
+		dict     = f.dict.Load()
+		decision = DecisionPass
+		hits     []string
+		seen     = make(map[int]struct{})
+	)
+
+	for _, text := range texts {
+		for _, idx := range dict.matcher.match(text) {
+			if _, ok := seen[idx]; ok {
+				continue
+			}
+			seen[idx] = struct{}{}
+			hits = append(hits, dict.matcher.patterns[idx])
+			decision = max(decision, dict.levels[idx])
+		}
+	}
+
+	return decision, hits
+}
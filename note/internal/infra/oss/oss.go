@@ -0,0 +1,64 @@
+package oss
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ryanreadbooks/whimer/misc/oss/uploader"
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/note/internal/config"
+)
+
+// Remover 删除对象存储中的笔记资源
+type Remover interface {
+	// key为资源key 包含bucket名
+	Remove(ctx context.Context, key string) error
+}
+
+var remover Remover = noopRemover{}
+
+func Init(c *config.Config) {
+	oc := c.Recycle.Oss
+	if oc.Endpoint == "" {
+		remover = noopRemover{}
+		return
+	}
+
+	up, err := uploader.New(uploader.Config{
+		Ak:       oc.Ak,
+		Sk:       oc.Sk,
+		Endpoint: oc.Endpoint,
+		Location: oc.Location,
+		Secure:   oc.Secure,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	remover = &ossRemover{up: up}
+}
+
+func GetRemover() Remover {
+	return remover
+}
+
+type ossRemover struct {
+	up *uploader.Uploader
+}
+
+func (r *ossRemover) Remove(ctx context.Context, key string) error {
+	// 第一个 / 之前都是bucket name
+	bucket, name, ok := strings.Cut(key, "/")
+	if !ok || bucket == "" || name == "" {
+		return xerror.ErrArgs.Msgf("资源key不合法: %s", key)
+	}
+
+	return r.up.Remove(ctx, bucket, name)
+}
+
+// 没有配置对象存储时不清理
+type noopRemover struct{}
+
+func (noopRemover) Remove(ctx context.Context, key string) error {
+	return nil
+}
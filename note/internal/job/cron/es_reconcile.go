@@ -2,36 +2,587 @@ package job
 
 import (
 	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 
+	userv1 "github.com/ryanreadbooks/whimer/idl/gen/go/passport/api/user/v1"
+	searchv1 "github.com/ryanreadbooks/whimer/idl/gen/go/search/api/v1"
+	"github.com/ryanreadbooks/whimer/misc/concurrent"
+	"github.com/ryanreadbooks/whimer/misc/shard"
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xlog"
+	"github.com/ryanreadbooks/whimer/misc/xslice"
+	"github.com/ryanreadbooks/whimer/misc/xsql"
 	"github.com/ryanreadbooks/whimer/note/internal/biz"
+	"github.com/ryanreadbooks/whimer/note/internal/config"
+	"github.com/ryanreadbooks/whimer/note/internal/data"
+	"github.com/ryanreadbooks/whimer/note/internal/global"
+	"github.com/ryanreadbooks/whimer/note/internal/infra"
+	notedao "github.com/ryanreadbooks/whimer/note/internal/infra/dao/note"
+	"github.com/ryanreadbooks/whimer/note/internal/infra/dep"
+	"github.com/ryanreadbooks/whimer/note/internal/model"
+	"github.com/ryanreadbooks/whimer/note/internal/model/convert"
+	"github.com/ryanreadbooks/whimer/note/pkg/id"
 )
 
-// TODO 数据库和es之间的定时同步任务
+// 搜索服务单次批量获取文档的上限
+const esReconcileGetDocLimit = 500
+
+const (
+	esReconcileCheckpoint      = "es_reconcile"
+	esReconcileSweepCheckpoint = "es_reconcile_sweep"
+)
+
+// 数据库和es之间的定时核对任务
+//
+// 按id顺序扫描全部笔记 和搜索文档逐一核对后修复差异;
+// 一轮扫描结束后再按文档顺序扫描搜索索引 删除找不到可见笔记的文档;
+// 扫描进度保存在缓存中 中断后从上次的位置继续; 多实例时按笔记id分片扫描
 type EsReconciler struct {
-	bizz biz.Biz
+	c config.EsReconcileConfig
+
+	bizz     *biz.Biz
+	dt       *data.Data
+	shardMgr *shard.Manager
 
 	ctx    context.Context
 	cancel context.CancelFunc
+	wg     sync.WaitGroup
 }
 
-type EsReconcileConfig struct {
-}
-
-func NewEsReconciler(biz biz.Biz, cfg EsReconcileConfig) *EsReconciler {
+func NewEsReconciler(c *config.Config, bizz *biz.Biz, dt *data.Data) *EsReconciler {
 	ctx, cancel := context.WithCancel(context.Background())
 	r := &EsReconciler{
-		bizz:   biz,
-		ctx:    ctx,
-		cancel: cancel,
+		c:        c.EsReconcile,
+		bizz:     bizz,
+		dt:       dt,
+		shardMgr: shard.NewManager(infra.Etcd().GetClient(), c.EsReconcile.EtcdKey, global.GetHostname()),
+		ctx:      ctx,
+		cancel:   cancel,
 	}
 
 	return r
 }
 
 func (r *EsReconciler) Start() {
+	err := r.shardMgr.Start(r.ctx)
+	if err != nil {
+		panic(fmt.Errorf("es reconcile shard manager start failed: %w", err))
+	}
 
+	r.wg.Add(1)
+	concurrent.SafeGo2(r.ctx, concurrent.SafeGo2Opt{
+		Name:             "note.job.es_reconcile_loop",
+		InheritCtxCancel: true,
+		Job: func(ctx context.Context) error {
+			defer r.wg.Done()
+			r.loop(ctx)
+			return nil
+		},
+	})
 }
 
 func (r *EsReconciler) Stop() {
+	xlog.Msg("es reconciler stop").Info()
 	r.cancel()
+	r.wg.Wait()
+	r.shardMgr.Stop()
+}
+
+func (r *EsReconciler) loop(ctx context.Context) {
+	ticker := time.NewTicker(r.c.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			sd := r.shardMgr.GetShard()
+			// 抢不到分片 由其它实例执行
+			if !sd.Active {
+				continue
+			}
+
+			report, err := r.RunOnce(ctx, sd.Index, sd.Total)
+			if err != nil {
+				xlog.Msg("es reconcile run failed").Err(err).Extras("report", report).Errorx(ctx)
+				continue
+			}
+		}
+	}
+}
+
+// 核对报告
+type EsReconcileReport struct {
+	Scanned      int  `json:"scanned"`       // 核对的笔记数量
+	Swept        int  `json:"swept"`         // 扫描索引时核对的文档数量
+	Missing      int  `json:"missing"`       // 缺失的文档数量
+	Mismatched   int  `json:"mismatched"`    // 内容不一致的文档数量
+	Stale        int  `json:"stale"`         // 需要删除的文档数量
+	LikeFixed    int  `json:"like_fixed"`    // 点赞数不一致的文档数量
+	CommentFixed int  `json:"comment_fixed"` // 评论数不一致的文档数量
+	DryRun       bool `json:"dry_run"`
+}
+
+func (rp *EsReconcileReport) merge(d *esReconcileDiff) {
+	rp.Missing += len(d.Missing)
+	rp.Mismatched += len(d.Mismatched)
+	rp.Stale += len(d.Stale)
+	rp.LikeFixed += len(d.LikeIncrs)
+	rp.CommentFixed += len(d.CommentIncrs)
+}
+
+func (r *EsReconciler) checkpointName(prefix string, shardIdx, totalShard int) string {
+	name := fmt.Sprintf("%s:%d:%d", prefix, totalShard, shardIdx)
+	if r.c.DryRun {
+		name += ":dryrun"
+	}
+	return name
+}
+
+// RunOnce 从上次的进度开始核对分片内的笔记 直到扫描完一轮或者任务退出
+func (r *EsReconciler) RunOnce(ctx context.Context, shardIdx, totalShard int) (*EsReconcileReport, error) {
+	var (
+		name   = r.checkpointName(esReconcileCheckpoint, shardIdx, totalShard)
+		report = &EsReconcileReport{DryRun: r.c.DryRun}
+	)
+
+	cursor, err := r.dt.JobCheckpoint.Get(ctx, name)
+	if err != nil {
+		return report, xerror.Wrapf(err, "es reconcile get checkpoint failed").WithExtra("name", name).WithCtx(ctx)
+	}
+
+	for {
+		if ctx.Err() != nil {
+			return report, nil
+		}
+
+		notes, err := r.dt.Note.ListByIdAsc(ctx, cursor, r.c.BatchSize, data.WithNoteIdShard(shardIdx, totalShard))
+		if err != nil && !xsql.IsNoRecord(err) {
+			return report, xerror.Wrapf(err, "es reconcile list notes failed").
+				WithExtras("cursor", cursor, "shard", shardIdx, "total", totalShard).
+				WithCtx(ctx)
+		}
+
+		if len(notes) > 0 {
+			err = r.reconcileBatch(ctx, notes, report)
+			if err != nil {
+				return report, xerror.Wrapf(err, "es reconcile batch failed").WithExtra("cursor", cursor).WithCtx(ctx)
+			}
+
+			cursor = notes[len(notes)-1].Id
+		}
+
+		// 一轮扫描结束后从头开始
+		finished := len(notes) < int(r.c.BatchSize)
+		if finished {
+			cursor = 0
+		}
+
+		err = r.dt.JobCheckpoint.Set(ctx, name, cursor)
+		if err != nil {
+			return report, xerror.Wrapf(err, "es reconcile set checkpoint failed").
+				WithExtras("name", name, "cursor", cursor).
+				WithCtx(ctx)
+		}
+
+		if finished {
+			break
+		}
+	}
+
+	// 笔记已经被彻底删除的文档无法通过扫描笔记发现 需要反过来扫描索引
+	err = r.sweepIndex(ctx, shardIdx, totalShard, report)
+	if err != nil {
+		return report, xerror.Wrapf(err, "es reconcile sweep index failed").WithCtx(ctx)
+	}
+
+	if ctx.Err() == nil {
+		xlog.Msg("es reconcile pass finished").
+			Extras("report", report, "shard", shardIdx, "total", totalShard).
+			Infox(ctx)
+	}
+
+	return report, nil
+}
+
+// 从上次的进度开始按文档顺序扫描索引 删除分片内找不到可见笔记的文档
+func (r *EsReconciler) sweepIndex(ctx context.Context, shardIdx, totalShard int, report *EsReconcileReport) error {
+	name := r.checkpointName(esReconcileSweepCheckpoint, shardIdx, totalShard)
+	cursor, err := r.dt.JobCheckpoint.GetString(ctx, name)
+	if err != nil {
+		return xerror.Wrapf(err, "get checkpoint failed").WithExtra("name", name)
+	}
+
+	for {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		resp, err := dep.GetSearchDocer().ListNoteIds(ctx, &searchv1.ListNoteIdsRequest{
+			Cursor: cursor,
+			Count:  r.c.BatchSize,
+		})
+		if err != nil {
+			return xerror.Wrapf(err, "search docer list note ids failed").WithExtra("cursor", cursor)
+		}
+
+		if len(resp.GetIds()) > 0 {
+			err = r.sweepBatch(ctx, resp.GetIds(), shardIdx, totalShard, report)
+			if err != nil {
+				return xerror.Wrapf(err, "sweep batch failed").WithExtra("cursor", cursor)
+			}
+
+			cursor = resp.GetNextCursor()
+		}
+
+		finished := !resp.GetHasNext()
+		if finished {
+			cursor = ""
+		}
+
+		err = r.dt.JobCheckpoint.SetString(ctx, name, cursor)
+		if err != nil {
+			return xerror.Wrapf(err, "set checkpoint failed").WithExtras("name", name, "cursor", cursor)
+		}
+
+		if finished {
+			return nil
+		}
+	}
+}
+
+func (r *EsReconciler) sweepBatch(
+	ctx context.Context,
+	docIds []string,
+	shardIdx, totalShard int,
+	report *EsReconcileReport,
+) error {
+	var (
+		stale   []string
+		noteIds = make(map[string]int64, len(docIds))
+	)
+	for _, docId := range docIds {
+		var noteId id.NoteId
+		if err := noteId.UnmarshalText([]byte(docId)); err != nil {
+			// 无法解析的文档只由第一个分片删除
+			if shardIdx == 0 {
+				stale = append(stale, docId)
+			}
+			continue
+		}
+
+		if totalShard > 1 && noteId.Int64()%int64(totalShard) != int64(shardIdx) {
+			continue
+		}
+		noteIds[docId] = noteId.Int64()
+	}
+
+	notes, err := r.dt.Note.BatchGet(ctx, xslice.Uniq(slices.Collect(maps.Values(noteIds))), data.WithoutCache())
+	if err != nil {
+		return xerror.Wrapf(err, "batch get notes failed").WithExtra("count", len(noteIds))
+	}
+
+	stale = append(stale, staleDocIds(noteIds, notes)...)
+	report.Swept += len(noteIds)
+	report.Stale += len(stale)
+	if len(stale) == 0 {
+		return nil
+	}
+
+	if r.c.DryRun {
+		xlog.Msg("es reconcile found stale docs").Extras("stale", stale).Infox(ctx)
+		return nil
+	}
+
+	return r.applyDiff(ctx, &esReconcileDiff{Stale: stale})
+}
+
+func (r *EsReconciler) reconcileBatch(ctx context.Context, notes []*notedao.NotePO, report *EsReconcileReport) error {
+	expected, err := r.buildExpectedDocs(ctx, notes)
+	if err != nil {
+		return xerror.Wrapf(err, "es reconcile build expected docs failed")
+	}
+
+	// 已经被彻底删除的笔记在扫描索引时处理
+	checkIds := make([]string, 0, len(notes))
+	for _, n := range notes {
+		checkIds = append(checkIds, id.NoteId(n.Id).String())
+	}
+
+	actual := make(map[string]*searchv1.Note, len(checkIds))
+	err = xslice.BatchExec(checkIds, esReconcileGetDocLimit, func(start, end int) error {
+		ids := checkIds[start:end]
+		resp, err := dep.GetSearchDocer().BatchGetNote(ctx, &searchv1.BatchGetNoteRequest{Ids: ids})
+		if err != nil {
+			return xerror.Wrapf(err, "search docer batch get note failed").WithExtra("count", len(ids))
+		}
+		for k, v := range resp.GetNotes() {
+			actual[k] = v
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	diff := diffNotes(expected, actual)
+	report.Scanned += len(notes)
+	report.merge(diff)
+	if diff.empty() {
+		return nil
+	}
+
+	if r.c.DryRun {
+		xlog.Msg("es reconcile found diff").
+			Extras("missing", len(diff.Missing), "mismatched", len(diff.Mismatched), "stale", diff.Stale,
+				"like_incrs", diff.LikeIncrs, "comment_incrs", diff.CommentIncrs).
+			Infox(ctx)
+		return nil
+	}
+
+	return r.applyDiff(ctx, diff)
+}
+
+// 期望的文档 已发布的非私有笔记会出现在搜索中 搜索时再根据可见范围过滤
+func (r *EsReconciler) buildExpectedDocs(ctx context.Context, pos []*notedao.NotePO) (map[string]*searchv1.Note, error) {
+	publics := make([]*notedao.NotePO, 0, len(pos))
+	for _, n := range pos {
+		if isSearchable(n) {
+			publics = append(publics, n)
+		}
+	}
+	if len(publics) == 0 {
+		return map[string]*searchv1.Note{}, nil
+	}
+
+	notes, err := r.bizz.Note.AssembleNotes(ctx, convert.NoteSliceFromDao(publics))
+	if err != nil {
+		return nil, xerror.Wrapf(err, "assemble notes failed")
+	}
+
+	err = r.bizz.Note.AssembleNotesExt(ctx, notes.Items)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "assemble note exts failed")
+	}
+
+	// 计数不一致会被修复 所以这里的错误需要返回 不能当成0处理
+	noteIds := notes.GetIds()
+	likes, err := r.bizz.Interact.BatchGetNoteLikes(ctx, noteIds)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "batch get note likes failed")
+	}
+	replies, err := r.bizz.Interact.BatchGetNoteReplies(ctx, noteIds)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "batch get note replies failed")
+	}
+
+	owners := xslice.Uniq(xslice.Extract(notes.Items, func(n *model.Note) int64 { return n.Owner }))
+	userResp, err := dep.GetUserer().BatchGetUser(ctx, &userv1.BatchGetUserRequest{Uids: owners})
+	if err != nil {
+		return nil, xerror.Wrapf(err, "batch get user failed")
+	}
+	nicknames := make(map[int64]string, len(userResp.GetUsers()))
+	for _, u := range userResp.GetUsers() {
+		nicknames[u.GetUid()] = u.GetNickname()
+	}
+
+	docs := make(map[string]*searchv1.Note, len(notes.Items))
+	for _, item := range notes.Items {
+		tagList := make([]*searchv1.NoteTag, 0, len(item.Tags))
+		for _, tag := range item.Tags {
+			tagList = append(tagList, &searchv1.NoteTag{
+				Id:    id.TagId(tag.Id).String(),
+				Name:  tag.Name,
+				Ctime: tag.Ctime,
+			})
+		}
+
+		assetType := searchv1.Note_ASSET_TYPE_IMAGE
+		if item.Type == model.NoteTypeVideo {
+			assetType = searchv1.Note_ASSET_TYPE_VIDEO
+		}
+
+		noteId := id.NoteId(item.NoteId).String()
+		docs[noteId] = &searchv1.Note{
+			NoteId:   noteId,
+			Title:    item.Title,
+			Desc:     item.Desc,
+			CreateAt: item.CreateAt,
+			UpdateAt: item.UpdateAt,
+			Author: &searchv1.Note_Author{
+				Uid:      item.Owner,
+				Nickname: nicknames[item.Owner],
+			},
+			TagList:       tagList,
+			Visibility:    noteVisibilityAsSearchPb(item.Privacy),
+			AudienceUids:  item.Audience,
+			AssetType:     assetType,
+			LikesCount:    likes[item.NoteId],
+			CommentsCount: replies[item.NoteId],
+		}
+	}
+
+	return docs, nil
+}
+
+func (r *EsReconciler) applyDiff(ctx context.Context, diff *esReconcileDiff) error {
+	docer := dep.GetSearchDocer()
+
+	upserts := append(slices.Clone(diff.Missing), diff.Mismatched...)
+	if len(upserts) > 0 {
+		_, err := docer.BatchAddNote(ctx, &searchv1.BatchAddNoteRequest{Notes: upserts})
+		if err != nil {
+			return xerror.Wrapf(err, "search docer batch add note failed").WithExtra("count", len(upserts))
+		}
+	}
+
+	if len(diff.Stale) > 0 {
+		_, err := docer.BatchDeleteNote(ctx, &searchv1.BatchDeleteNoteRequest{Ids: diff.Stale})
+		if err != nil {
+			return xerror.Wrapf(err, "search docer batch delete note failed").WithExtra("ids", diff.Stale)
+		}
+	}
+
+	if len(diff.LikeIncrs) > 0 {
+		_, err := docer.BatchUpdateNoteLikeCount(ctx, &searchv1.BatchUpdateNoteLikeCountRequest{Counts: diff.LikeIncrs})
+		if err != nil {
+			return xerror.Wrapf(err, "search docer batch update like count failed").WithExtra("counts", diff.LikeIncrs)
+		}
+	}
+
+	if len(diff.CommentIncrs) > 0 {
+		_, err := docer.BatchUpdateNoteCommentCount(ctx, &searchv1.BatchUpdateNoteCommentCountRequest{Counts: diff.CommentIncrs})
+		if err != nil {
+			return xerror.Wrapf(err, "search docer batch update comment count failed").WithExtra("counts", diff.CommentIncrs)
+		}
+	}
+
+	return nil
+}
+
+// 笔记是否应该出现在搜索中
+func isSearchable(n *notedao.NotePO) bool {
+	return n.State == model.NoteStatePublished && n.Privacy != model.PrivacyPrivate
+}
+
+// 找不到笔记或者笔记不应该出现在搜索中的文档id
+func staleDocIds(noteIds map[string]int64, notes map[int64]*notedao.NotePO) []string {
+	stale := make([]string, 0)
+	for docId, noteId := range noteIds {
+		n, ok := notes[noteId]
+		if !ok || !isSearchable(n) {
+			stale = append(stale, docId)
+		}
+	}
+	slices.Sort(stale)
+
+	return stale
+}
+
+// 核对结果
+type esReconcileDiff struct {
+	Missing      []*searchv1.Note // 文档不存在
+	Mismatched   []*searchv1.Note // 标题/描述/标签不一致 需要整体覆盖
+	Stale        []string         // 笔记已经不可见 需要删除文档
+	LikeIncrs    map[string]int64 // 点赞数的修正增量
+	CommentIncrs map[string]int64 // 评论数的修正增量
+}
+
+func (d *esReconcileDiff) empty() bool {
+	return len(d.Missing) == 0 && len(d.Mismatched) == 0 && len(d.Stale) == 0 &&
+		len(d.LikeIncrs) == 0 && len(d.CommentIncrs) == 0
+}
+
+// 核对期望的文档和实际的文档
+//
+// 需要整体覆盖的文档已经包含正确的计数 不再单独修正计数
+func diffNotes(expected, actual map[string]*searchv1.Note) *esReconcileDiff {
+	diff := &esReconcileDiff{
+		LikeIncrs:    make(map[string]int64),
+		CommentIncrs: make(map[string]int64),
+	}
+
+	for noteId, want := range expected {
+		got, ok := actual[noteId]
+		if !ok {
+			diff.Missing = append(diff.Missing, want)
+			continue
+		}
+
+		if !sameNoteContent(want, got) {
+			diff.Mismatched = append(diff.Mismatched, want)
+			continue
+		}
+
+		if incr := want.GetLikesCount() - got.GetLikesCount(); incr != 0 {
+			diff.LikeIncrs[noteId] = incr
+		}
+		if incr := want.GetCommentsCount() - got.GetCommentsCount(); incr != 0 {
+			diff.CommentIncrs[noteId] = incr
+		}
+	}
+
+	for noteId := range actual {
+		if _, ok := expected[noteId]; !ok {
+			diff.Stale = append(diff.Stale, noteId)
+		}
+	}
+
+	// 保证输出稳定
+	slices.SortFunc(diff.Missing, func(a, b *searchv1.Note) int { return strings.Compare(a.NoteId, b.NoteId) })
+	slices.SortFunc(diff.Mismatched, func(a, b *searchv1.Note) int { return strings.Compare(a.NoteId, b.NoteId) })
+	slices.Sort(diff.Stale)
+
+	return diff
+}
+
+func noteVisibilityAsSearchPb(privacy model.Privacy) searchv1.Note_Visibility {
+	switch privacy {
+	case model.PrivacyPublic:
+		return searchv1.Note_VISIBILITY_PUBLIC
+	case model.PrivacyFollowers:
+		return searchv1.Note_VISIBILITY_FOLLOWERS
+	case model.PrivacyMutualFollowers:
+		return searchv1.Note_VISIBILITY_MUTUAL
+	case model.PrivacyCustom:
+		return searchv1.Note_VISIBILITY_CUSTOM
+	}
+	return searchv1.Note_VISIBILITY_PRIVATE
+}
+
+func sameNoteContent(want, got *searchv1.Note) bool {
+	if want.GetTitle() != got.GetTitle() || want.GetDesc() != got.GetDesc() {
+		return false
+	}
+
+	// 可见范围不一致可能导致笔记泄露
+	if want.GetVisibility() != got.GetVisibility() {
+		return false
+	}
+
+	sortedUids := func(n *searchv1.Note) []int64 {
+		uids := slices.Clone(n.GetAudienceUids())
+		slices.Sort(uids)
+		return uids
+	}
+	if !slices.Equal(sortedUids(want), sortedUids(got)) {
+		return false
+	}
+
+	tagIds := func(n *searchv1.Note) []string {
+		ids := make([]string, 0, len(n.GetTagList()))
+		for _, t := range n.GetTagList() {
+			ids = append(ids, t.GetId())
+		}
+		slices.Sort(ids)
+		return ids
+	}
+
+	return slices.Equal(tagIds(want), tagIds(got))
 }
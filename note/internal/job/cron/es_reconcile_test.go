@@ -0,0 +1,88 @@
+package job
+
+import (
+	"testing"
+
+	searchv1 "github.com/ryanreadbooks/whimer/idl/gen/go/search/api/v1"
+	notedao "github.com/ryanreadbooks/whimer/note/internal/infra/dao/note"
+	"github.com/ryanreadbooks/whimer/note/internal/model"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDiffNotes(t *testing.T) {
+	Convey("diffNotes", t, func() {
+		newNote := func(id, title string, likes, comments int64, tagIds ...string) *searchv1.Note {
+			tags := make([]*searchv1.NoteTag, 0, len(tagIds))
+			for _, tid := range tagIds {
+				tags = append(tags, &searchv1.NoteTag{Id: tid})
+			}
+			return &searchv1.Note{NoteId: id, Title: title, LikesCount: likes, CommentsCount: comments, TagList: tags}
+		}
+
+		expected := map[string]*searchv1.Note{
+			"a": newNote("a", "t1", 1, 1),
+			"b": newNote("b", "t2", 5, 3, "x", "y"),
+			"c": newNote("c", "t3", 10, 2, "x"),
+			"d": newNote("d", "t4", 0, 0),
+		}
+		actual := map[string]*searchv1.Note{
+			"b": newNote("b", "t2", 2, 3, "y", "x"), // 标签顺序不同不算不一致
+			"c": newNote("c", "old", 0, 0, "x"),
+			"d": newNote("d", "t4", 0, 0),
+			"e": newNote("e", "t5", 0, 0),
+		}
+
+		diff := diffNotes(expected, actual)
+		So(diff.Missing, ShouldHaveLength, 1)
+		So(diff.Missing[0].NoteId, ShouldEqual, "a")
+		So(diff.Mismatched, ShouldHaveLength, 1)
+		So(diff.Mismatched[0].NoteId, ShouldEqual, "c")
+		So(diff.Stale, ShouldResemble, []string{"e"})
+		// 整体覆盖的文档不再单独修正计数
+		So(diff.LikeIncrs, ShouldResemble, map[string]int64{"b": 3})
+		So(diff.CommentIncrs, ShouldBeEmpty)
+		So(diff.empty(), ShouldBeFalse)
+
+		So(diffNotes(expected, expected).empty(), ShouldBeTrue)
+		So(diffNotes(nil, nil).empty(), ShouldBeTrue)
+	})
+
+	Convey("diffNotes visibility", t, func() {
+		newNote := func(id string, vis searchv1.Note_Visibility, audience ...int64) *searchv1.Note {
+			return &searchv1.Note{NoteId: id, Visibility: vis, AudienceUids: audience}
+		}
+
+		expected := map[string]*searchv1.Note{
+			"a": newNote("a", searchv1.Note_VISIBILITY_FOLLOWERS),
+			"b": newNote("b", searchv1.Note_VISIBILITY_CUSTOM, 1, 2),
+			"c": newNote("c", searchv1.Note_VISIBILITY_CUSTOM, 1, 2),
+		}
+		actual := map[string]*searchv1.Note{
+			"a": newNote("a", searchv1.Note_VISIBILITY_PUBLIC),
+			"b": newNote("b", searchv1.Note_VISIBILITY_CUSTOM, 2, 1), // 顺序不同不算不一致
+			"c": newNote("c", searchv1.Note_VISIBILITY_CUSTOM, 1, 2, 3),
+		}
+
+		diff := diffNotes(expected, actual)
+		So(diff.Missing, ShouldBeEmpty)
+		So(diff.Mismatched, ShouldHaveLength, 2)
+		So(diff.Mismatched[0].NoteId, ShouldEqual, "a")
+		So(diff.Mismatched[1].NoteId, ShouldEqual, "c")
+	})
+}
+
+func TestStaleDocIds(t *testing.T) {
+	Convey("staleDocIds", t, func() {
+		notes := map[int64]*notedao.NotePO{
+			1: {Id: 1, State: model.NoteStatePublished, Privacy: model.PrivacyPublic},
+			2: {Id: 2, State: model.NoteStatePublished, Privacy: model.PrivacyPrivate},
+			3: {Id: 3, State: model.NoteStatePublished, Privacy: model.PrivacyFollowers},
+		}
+		noteIds := map[string]int64{"a": 1, "b": 2, "c": 3, "d": 4}
+
+		So(staleDocIds(noteIds, notes), ShouldResemble, []string{"b", "d"})
+		So(staleDocIds(noteIds, nil), ShouldResemble, []string{"a", "b", "c", "d"})
+		So(staleDocIds(nil, notes), ShouldBeEmpty)
+	})
+}
@@ -0,0 +1,142 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/misc/concurrent"
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xlog"
+	"github.com/ryanreadbooks/whimer/note/internal/biz"
+	"github.com/ryanreadbooks/whimer/note/internal/config"
+	"github.com/ryanreadbooks/whimer/note/internal/global"
+	"github.com/ryanreadbooks/whimer/note/internal/infra/oss"
+	"github.com/ryanreadbooks/whimer/note/internal/model"
+	eventmodel "github.com/ryanreadbooks/whimer/note/internal/model/event"
+)
+
+// 回收站定时清理任务
+//
+// 定时找出超过保留期的笔记 先删除对象存储中的资源再彻底删除笔记数据;
+// 资源删除失败的笔记留到下一轮重试 多实例同时执行时通过加锁检查笔记状态保证只删除一次
+type RecyclePurger struct {
+	c config.RecycleConfig
+
+	bizz *biz.Biz
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewRecyclePurger(c *config.Config, bizz *biz.Biz) *RecyclePurger {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &RecyclePurger{
+		c:      c.Recycle,
+		bizz:   bizz,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+func (p *RecyclePurger) Start() {
+	p.wg.Add(1)
+	concurrent.SafeGo2(p.ctx, concurrent.SafeGo2Opt{
+		Name:             "note.job.recycle_purge_loop",
+		InheritCtxCancel: true,
+		Job: func(ctx context.Context) error {
+			defer p.wg.Done()
+			p.loop(ctx)
+			return nil
+		},
+	})
+}
+
+func (p *RecyclePurger) Stop() {
+	xlog.Msg("recycle purger stop").Info()
+	p.cancel()
+	p.wg.Wait()
+}
+
+func (p *RecyclePurger) loop(ctx context.Context) {
+	ticker := time.NewTicker(p.c.PurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := p.RunOnce(ctx)
+			if err != nil {
+				xlog.Msg("recycle purge run failed").Err(err).Extras("purged", purged).Errorx(ctx)
+			}
+		}
+	}
+}
+
+// RunOnce 彻底删除一批过期的笔记 返回成功删除的数量
+func (p *RecyclePurger) RunOnce(ctx context.Context) (int, error) {
+	expired, err := p.bizz.Creator.ListExpiredRecycledNotes(ctx, p.c.PurgeBatch)
+	if err != nil {
+		return 0, xerror.Wrapf(err, "list expired recycled notes failed")
+	}
+
+	purged := 0
+	for _, r := range expired {
+		if ctx.Err() != nil {
+			return purged, nil
+		}
+
+		if err := p.purge(ctx, r.NoteId); err != nil {
+			xlog.Msg("recycle purge note failed").Err(err).Extras("noteId", r.NoteId).Errorx(ctx)
+			continue
+		}
+		purged++
+	}
+
+	return purged, nil
+}
+
+func (p *RecyclePurger) purge(ctx context.Context, noteId int64) error {
+	keys, err := p.bizz.Creator.GetRecycledNoteAssetKeys(ctx, noteId)
+	if err != nil {
+		if isNoteGone(err) {
+			return nil
+		}
+		return err
+	}
+
+	// 资源全部删除成功后才删除笔记数据 否则下一轮重试
+	remover := oss.GetRemover()
+	for _, key := range keys {
+		if err := remover.Remove(ctx, key); err != nil {
+			return xerror.Wrapf(err, "remove note asset failed").WithExtra("key", key)
+		}
+	}
+
+	err = p.bizz.Tx(ctx, func(ctx context.Context) error {
+		old, errTx := p.bizz.Creator.PurgeNote(ctx, noteId)
+		if errTx != nil {
+			return errTx
+		}
+
+		// 最终删除事件 下游清理缓存和搜索文档
+		return p.bizz.NoteEvent.NoteDeleted(ctx, model.NoteFromNoteCore(old), eventmodel.NoteDeleteReasonPureDelete)
+	})
+	if err != nil {
+		if isNoteGone(err) {
+			return nil
+		}
+		return xerror.Wrapf(err, "purge note tx failed")
+	}
+
+	return nil
+}
+
+// 笔记已经被恢复或者被其它实例删除
+func isNoteGone(err error) bool {
+	return errors.Is(err, global.ErrNoteNotRecycled) || errors.Is(err, global.ErrNoteNotFound)
+}
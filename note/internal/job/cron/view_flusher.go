@@ -0,0 +1,98 @@
+package job
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/misc/concurrent"
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xlog"
+	"github.com/ryanreadbooks/whimer/note/internal/biz"
+	"github.com/ryanreadbooks/whimer/note/internal/config"
+	"github.com/ryanreadbooks/whimer/note/internal/infra"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const viewFlusherLockKey = "note:job:view_flusher:lock"
+
+// 浏览量汇总任务
+//
+// 定时将缓存中去重后的浏览量累加到数据库; 多实例时通过加锁保证同一时间只有一个实例在汇总;
+// 未汇总的浏览量一直保留在缓存中 退出时不需要额外处理
+type ViewFlusher struct {
+	c config.ViewConfig
+
+	bizz *biz.Biz
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewViewFlusher(c *config.Config, bizz *biz.Biz) *ViewFlusher {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &ViewFlusher{
+		c:      c.View,
+		bizz:   bizz,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+func (f *ViewFlusher) Start() {
+	f.wg.Add(1)
+	concurrent.SafeGo2(f.ctx, concurrent.SafeGo2Opt{
+		Name:             "note.job.view_flush_loop",
+		InheritCtxCancel: true,
+		Job: func(ctx context.Context) error {
+			defer f.wg.Done()
+			f.loop(ctx)
+			return nil
+		},
+	})
+}
+
+func (f *ViewFlusher) Stop() {
+	xlog.Msg("view flusher stop").Info()
+	f.cancel()
+	f.wg.Wait()
+}
+
+func (f *ViewFlusher) loop(ctx context.Context) {
+	ticker := time.NewTicker(f.c.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			flushed, err := f.RunOnce(ctx)
+			if err != nil {
+				xlog.Msg("view flush run failed").Err(err).Extras("flushed", flushed).Errorx(ctx)
+			}
+		}
+	}
+}
+
+// RunOnce 汇总一次浏览量 返回汇总的笔记数量 没有拿到锁时直接返回
+func (f *ViewFlusher) RunOnce(ctx context.Context) (int, error) {
+	locker := redis.NewRedisLock(infra.Cache(), viewFlusherLockKey)
+	expire := f.c.FlushInterval
+	if expire < time.Minute {
+		expire = time.Minute
+	}
+	locker.SetExpire(int(expire.Seconds()))
+	held, err := locker.AcquireCtx(ctx)
+	if err != nil {
+		return 0, xerror.Wrapf(err, "acquire view flusher lock failed")
+	}
+	if !held {
+		return 0, nil
+	}
+	defer locker.ReleaseCtx(context.WithoutCancel(ctx))
+
+	return f.bizz.Interact.FlushNoteViews(ctx)
+}
@@ -0,0 +1,52 @@
+package model
+
+type AuditDecision int8
+
+// 审核结论 数值越大越严重
+const (
+	// 通过
+	AuditDecisionPass AuditDecision = 1
+
+	// 需要人工复审
+	AuditDecisionReview AuditDecision = 2
+
+	// 不通过
+	AuditDecisionReject AuditDecision = 3
+
+	// 发布后违规封禁
+	AuditDecisionBan AuditDecision = 4
+)
+
+func (d AuditDecision) String() string {
+	switch d {
+	case AuditDecisionPass:
+		return "pass"
+	case AuditDecisionReview:
+		return "review"
+	case AuditDecisionReject:
+		return "reject"
+	case AuditDecisionBan:
+		return "ban"
+	default:
+		return ""
+	}
+}
+
+// 人工审核操作人为0表示机审
+const AuditOperatorMachine int64 = 0
+
+// 笔记审核结果
+type NoteAudit struct {
+	NoteId   int64         `json:"note_id"`
+	TaskId   string        `json:"task_id"` // 机审对应的流程taskId
+	Decision AuditDecision `json:"decision"`
+	Reason   string        `json:"reason"`
+	Provider string        `json:"provider"` // 审核提供方
+	Operator int64         `json:"operator"` // 人工审核操作人
+	Ctime    int64         `json:"ctime"`
+	Utime    int64         `json:"utime"`
+}
+
+func (a *NoteAudit) IsMachine() bool {
+	return a.Operator == AuditOperatorMachine
+}
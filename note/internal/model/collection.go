@@ -0,0 +1,100 @@
+package model
+
+import (
+	"slices"
+
+	notev1 "github.com/ryanreadbooks/whimer/idl/gen/go/note/api/v1"
+)
+
+const (
+	// 每个用户最多创建的合集数量
+	MaxUserCollections = 100
+	// 每个合集最多包含的笔记数量
+	MaxCollectionNotes = 500
+)
+
+// 合集只区分公开和私有
+func IsCollectionPrivacyValid(p Privacy) bool {
+	return p == PrivacyPublic || p == PrivacyPrivate
+}
+
+// 笔记合集
+type Collection struct {
+	Id        int64
+	Owner     int64
+	Title     string
+	Desc      string
+	Privacy   Privacy
+	Cover     int64 // 作为封面的笔记
+	NoteCount int64
+	Ctime     int64
+	Utime     int64
+
+	NoteIds []int64 // 按顺序排列的笔记 只在详情中填充
+}
+
+// 私有合集只有作者可见
+func (c *Collection) IsVisibleTo(viewer int64) bool {
+	if viewer != 0 && viewer == c.Owner {
+		return true
+	}
+
+	return c.Privacy == PrivacyPublic
+}
+
+func (c *Collection) AsPb() *notev1.NoteCollection {
+	return &notev1.NoteCollection{
+		CollectionId: c.Id,
+		Owner:        c.Owner,
+		Title:        c.Title,
+		Desc:         c.Desc,
+		Privacy:      int32(c.Privacy),
+		CoverNoteId:  c.Cover,
+		NoteCount:    c.NoteCount,
+		Ctime:        c.Ctime,
+		Utime:        c.Utime,
+		NoteIds:      c.NoteIds,
+	}
+}
+
+// 笔记在合集中的位置
+type CollectionLink struct {
+	CollectionId int64
+	Title        string
+	Prev         int64 // 上一篇笔记 为0表示没有
+	Next         int64 // 下一篇笔记 为0表示没有
+}
+
+// 根据合集中按顺序排列的笔记找出noteId的前后笔记 noteId不在其中时返回nil
+func NewCollectionLink(c *Collection, orderedNoteIds []int64, noteId int64) *CollectionLink {
+	idx := slices.Index(orderedNoteIds, noteId)
+	if idx < 0 {
+		return nil
+	}
+
+	link := &CollectionLink{
+		CollectionId: c.Id,
+		Title:        c.Title,
+	}
+	if idx > 0 {
+		link.Prev = orderedNoteIds[idx-1]
+	}
+	if idx < len(orderedNoteIds)-1 {
+		link.Next = orderedNoteIds[idx+1]
+	}
+
+	return link
+}
+
+func (l *CollectionLink) AsPb() *notev1.NoteCollectionLink {
+	if l == nil {
+		return nil
+	}
+
+	return &notev1.NoteCollectionLink{
+		CollectionId: l.CollectionId,
+		Title:        l.Title,
+		PrevNoteId:   l.Prev,
+		NextNoteId:   l.Next,
+	}
+}
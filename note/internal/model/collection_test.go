@@ -0,0 +1,39 @@
+package model
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestNewCollectionLink(t *testing.T) {
+	Convey("NewCollectionLink", t, func() {
+		c := &Collection{Id: 1, Title: "travel"}
+		ids := []int64{10, 20, 30}
+
+		So(NewCollectionLink(c, ids, 40), ShouldBeNil)
+		So(NewCollectionLink(c, ids, 10), ShouldResemble, &CollectionLink{CollectionId: 1, Title: "travel", Next: 20})
+		So(NewCollectionLink(c, ids, 20), ShouldResemble, &CollectionLink{CollectionId: 1, Title: "travel", Prev: 10, Next: 30})
+		So(NewCollectionLink(c, ids, 30), ShouldResemble, &CollectionLink{CollectionId: 1, Title: "travel", Prev: 20})
+		So(NewCollectionLink(c, []int64{10}, 10), ShouldResemble, &CollectionLink{CollectionId: 1, Title: "travel"})
+
+		var link *CollectionLink
+		So(link.AsPb(), ShouldBeNil)
+	})
+}
+
+func TestCollectionIsVisibleTo(t *testing.T) {
+	Convey("Collection.IsVisibleTo", t, func() {
+		public := &Collection{Owner: 100, Privacy: PrivacyPublic}
+		private := &Collection{Owner: 100, Privacy: PrivacyPrivate}
+
+		So(public.IsVisibleTo(0), ShouldBeTrue)
+		So(public.IsVisibleTo(200), ShouldBeTrue)
+		So(private.IsVisibleTo(0), ShouldBeFalse)
+		So(private.IsVisibleTo(200), ShouldBeFalse)
+		So(private.IsVisibleTo(100), ShouldBeTrue)
+
+		So(IsCollectionPrivacyValid(PrivacyPublic), ShouldBeTrue)
+		So(IsCollectionPrivacyValid(PrivacyFollowers), ShouldBeFalse)
+	})
+}
@@ -1,6 +1,8 @@
 package convert
 
 import (
+	"encoding/json"
+
 	"github.com/ryanreadbooks/whimer/misc/xnet"
 	notedao "github.com/ryanreadbooks/whimer/note/internal/infra/dao/note"
 	tagdao "github.com/ryanreadbooks/whimer/note/internal/infra/dao/tag"
@@ -60,3 +62,66 @@ func NoteTagFromDao(d *tagdao.Tag) *model.NoteTag {
 		Ctime: d.Ctime,
 	}
 }
+
+func NoteAuditFromDao(d *notedao.AuditPO) *model.NoteAudit {
+	if d == nil {
+		return nil
+	}
+
+	return &model.NoteAudit{
+		NoteId:   d.NoteId,
+		TaskId:   d.TaskId,
+		Decision: d.Decision,
+		Reason:   d.Reason,
+		Provider: d.Provider,
+		Operator: d.Operator,
+		Ctime:    d.Ctime,
+		Utime:    d.Utime,
+	}
+}
+
+func NoteAuditAsDao(a *model.NoteAudit) *notedao.AuditPO {
+	return &notedao.AuditPO{
+		NoteId:   a.NoteId,
+		TaskId:   a.TaskId,
+		Decision: a.Decision,
+		Reason:   a.Reason,
+		Provider: a.Provider,
+		Operator: a.Operator,
+		Ctime:    a.Ctime,
+		Utime:    a.Utime,
+	}
+}
+
+func NoteRevisionFromDao(d *notedao.RevisionPO) *model.NoteRevision {
+	if d == nil {
+		return nil
+	}
+
+	rev := &model.NoteRevision{
+		NoteId:    d.NoteId,
+		Version:   d.Version,
+		Content:   &model.NoteRevisionContent{},
+		Diff:      &model.NoteRevisionDiff{},
+		PrevState: d.PrevState,
+		Ctime:     d.Ctime,
+	}
+	_ = json.Unmarshal(d.Content, rev.Content)
+	_ = json.Unmarshal(d.Diff, rev.Diff)
+
+	return rev
+}
+
+func NoteRevisionAsDao(r *model.NoteRevision) *notedao.RevisionPO {
+	content, _ := json.Marshal(r.Content)
+	diff, _ := json.Marshal(r.Diff)
+
+	return &notedao.RevisionPO{
+		NoteId:    r.NoteId,
+		Version:   r.Version,
+		Content:   content,
+		Diff:      diff,
+		PrevState: r.PrevState,
+		Ctime:     r.Ctime,
+	}
+}
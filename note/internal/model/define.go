@@ -15,8 +15,33 @@ const (
 
 	// 私有
 	PrivacyPrivate = Privacy(v1.NotePrivacy_PRIVATE)
+
+	// 仅粉丝可见
+	PrivacyFollowers = Privacy(v1.NotePrivacy_FOLLOWERS)
+
+	// 仅互关好友可见
+	PrivacyMutualFollowers = Privacy(v1.NotePrivacy_MUTUAL_FOLLOWERS)
+
+	// 仅指定用户可见
+	PrivacyCustom = Privacy(v1.NotePrivacy_CUSTOM)
 )
 
+// 指定可见用户的最大数量
+const MaxNoteAudience = 200
+
+func (p Privacy) IsValid() bool {
+	switch p {
+	case PrivacyPublic, PrivacyPrivate, PrivacyFollowers, PrivacyMutualFollowers, PrivacyCustom:
+		return true
+	}
+	return false
+}
+
+// 是否为部分用户可见 需要结合浏览者判断
+func (p Privacy) IsAudienceRestricted() bool {
+	return p == PrivacyFollowers || p == PrivacyMutualFollowers || p == PrivacyCustom
+}
+
 type NoteType int8
 
 func (t NoteType) String() string {
@@ -57,11 +82,25 @@ type NoteState int8
 //	             ▼                           ▼                           ▼
 //	        ProcessFailed                 Rejected                      Banned
 //
+// 机审无法判定或者机审失败时进入人工复审 复审结束后流转到AuditPassed或者Rejected:
+//
+//	Auditing ──▶ AuditReviewing ──▶ AuditPassed / Rejected
+//
+// 草稿和定时发布的笔记在进入发布流程前停留在Init之后 Processing之前:
+//
+//	Draft ──▶ Scheduled ──▶ Processing ──▶ ...
+//
 // 笔记状态
 const (
 	// 初始状态
 	NoteStateInit = NoteState(v1.NoteState_NOTE_STATE_UNSPECIFIED)
 
+	// 草稿
+	NoteStateDraft = NoteState(v1.NoteState_DRAFT)
+
+	// 等待定时发布
+	NoteStateScheduled = NoteState(v1.NoteState_SCHEDULED)
+
 	// 资源处理中
 	NoteStateProcessing = NoteState(v1.NoteState_PROCESSING)
 
@@ -80,11 +119,17 @@ const (
 	// 审核通过
 	NoteStateAuditPassed = NoteState(v1.NoteState_AUDIT_PASSED)
 
+	// 人工复审中
+	NoteStateAuditReviewing = NoteState(v1.NoteState_AUDIT_REVIEWING)
+
 	// 已发布
 	NoteStatePublished = NoteState(v1.NoteState_PUBLISHED)
 
 	// 被封禁
 	NoteStateBanned = NoteState(v1.NoteState_BANNED)
+
+	// 已删除 在回收站中
+	NoteStateRecycled = NoteState(v1.NoteState_RECYCLED)
 )
 
 // 对外暴露状态
@@ -96,6 +141,12 @@ func NoteStateAsLifeCycleState(state NoteState) v1.NoteLifeCycleState {
 		return v1.NoteLifeCycleState_LIFE_CYCLE_STATE_BANNED
 	case NoteStateProcessFailed, NoteStateRejected:
 		return v1.NoteLifeCycleState_LIFE_CYCLE_STATE_REJECTED
+	case NoteStateDraft:
+		return v1.NoteLifeCycleState_LIFE_CYCLE_STATE_DRAFT
+	case NoteStateScheduled:
+		return v1.NoteLifeCycleState_LIFE_CYCLE_STATE_SCHEDULED
+	case NoteStateRecycled:
+		return v1.NoteLifeCycleState_LIFE_CYCLE_STATE_RECYCLED
 	default:
 		return v1.NoteLifeCycleState_LIFE_CYCLE_STATE_AUDITING
 	}
@@ -112,6 +163,7 @@ func LifeCycleNoteAuditing() []NoteState {
 		NoteStateProcessing,
 		NoteStateProcessed,
 		NoteStateAuditPassed,
+		NoteStateAuditReviewing,
 	}
 }
 
@@ -123,6 +175,18 @@ func LifeCycleNoteBanned() []NoteState {
 	return []NoteState{NoteStateBanned}
 }
 
+func LifeCycleNoteDraft() []NoteState {
+	return []NoteState{NoteStateDraft}
+}
+
+func LifeCycleNoteScheduled() []NoteState {
+	return []NoteState{NoteStateScheduled}
+}
+
+func LifeCycleNoteRecycled() []NoteState {
+	return []NoteState{NoteStateRecycled}
+}
+
 func IsNoteStateConsideredAsPublished(state NoteState) bool {
 	return slices.Contains(LifeCycleNotePublished(), state)
 }
@@ -139,6 +203,32 @@ func IsNoteStateConsideredAsBanned(state NoteState) bool {
 	return state == NoteStateBanned
 }
 
+func IsNoteStateConsideredAsReviewing(state NoteState) bool {
+	return state == NoteStateAuditReviewing
+}
+
+func IsNoteStateConsideredAsDraft(state NoteState) bool {
+	return state == NoteStateDraft
+}
+
+func IsNoteStateConsideredAsScheduled(state NoteState) bool {
+	return state == NoteStateScheduled
+}
+
+// 草稿或等待定时发布 可以发起发布
+func IsNoteStateWaitingPublish(state NoteState) bool {
+	return IsNoteStateConsideredAsDraft(state) || IsNoteStateConsideredAsScheduled(state)
+}
+
+func IsNoteStateConsideredAsRecycled(state NoteState) bool {
+	return state == NoteStateRecycled
+}
+
+// 笔记还未进入发布流程
+func IsNoteStateBeforeProcessing(state NoteState) bool {
+	return state < NoteStateProcessing
+}
+
 type ProcedureStatus int8
 
 // 本地流程处理状态记录
@@ -156,10 +246,13 @@ const (
 type ProcedureType string
 
 const (
+	// 定时发布流程 等待到发布时间后进入资源处理流程
+	ProcedureTypeSchedule ProcedureType = "schedule"
+
 	// 资源处理流程
 	ProcedureTypeAssetProcess ProcedureType = "asset_process"
 
-	// 审核流程（预留）
+	// 审核流程
 	ProcedureTypeAudit ProcedureType = "audit"
 
 	// 发布流程
@@ -171,9 +264,11 @@ const (
 // 用以判断当前笔记流程进行到哪一步 用于中断正在进行的流程
 func MapNoteStateToProcedureType(state NoteState) ProcedureType {
 	switch state {
+	case NoteStateScheduled:
+		return ProcedureTypeSchedule
 	case NoteStateInit, NoteStateProcessing, NoteStateProcessFailed:
 		return ProcedureTypeAssetProcess
-	case NoteStateProcessed, NoteStateAuditing, NoteStateRejected:
+	case NoteStateProcessed, NoteStateAuditing, NoteStateAuditReviewing, NoteStateRejected:
 		return ProcedureTypeAudit
 	case NoteStateAuditPassed, NoteStatePublished, NoteStateBanned:
 		return ProcedureTypePublish
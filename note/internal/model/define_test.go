@@ -0,0 +1,52 @@
+package model
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestIsNoteStateWaitingPublish(t *testing.T) {
+	Convey("IsNoteStateWaitingPublish", t, func() {
+		cases := []struct {
+			state NoteState
+			want  bool
+		}{
+			{NoteStateDraft, true},
+			{NoteStateScheduled, true},
+			{NoteStateProcessing, false},
+			{NoteStateAuditing, false},
+			{NoteStatePublished, false},
+			{NoteStateBanned, false},
+			{NoteStateRecycled, false},
+		}
+
+		for _, c := range cases {
+			So(IsNoteStateWaitingPublish(c.state), ShouldEqual, c.want)
+		}
+	})
+}
+
+func TestNoteStatePublishOrder(t *testing.T) {
+	Convey("publish flow only upgrades state", t, func() {
+		// UpgradeState 依赖状态值递增
+		flow := []NoteState{
+			NoteStateDraft,
+			NoteStateScheduled,
+			NoteStateProcessing,
+			NoteStateProcessed,
+			NoteStateAuditing,
+			NoteStateAuditPassed,
+			NoteStatePublished,
+		}
+		for i := 1; i < len(flow); i++ {
+			So(flow[i], ShouldBeGreaterThan, flow[i-1])
+		}
+
+		So(IsNoteStateBeforeProcessing(NoteStateDraft), ShouldBeTrue)
+		So(IsNoteStateBeforeProcessing(NoteStateScheduled), ShouldBeTrue)
+		So(IsNoteStateBeforeProcessing(NoteStateProcessing), ShouldBeFalse)
+		So(MapNoteStateToProcedureType(NoteStateScheduled), ShouldEqual, ProcedureTypeSchedule)
+		So(MapNoteStateToProcedureType(NoteStatePublished), ShouldEqual, ProcedureTypePublish)
+	})
+}
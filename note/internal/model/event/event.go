@@ -33,6 +33,8 @@ type NoteDeleteReason string
 const (
 	NoteDeleteReasonPureDelete    NoteDeleteReason = "pure_delete"
 	NoteDeleteReasonPrivacyChange NoteDeleteReason = "privacy_change"
+	NoteDeleteReasonBanned        NoteDeleteReason = "banned"
+	NoteDeleteReasonRecycled      NoteDeleteReason = "recycled" // 放入回收站 还可以恢复
 )
 
 // 笔记删除事件
@@ -16,6 +16,9 @@ type Note struct {
 	Videos  []string        `json:"videos,omitempty"` // asset keys
 	Tags    []*NoteTag      `json:"tags,omitempty"`
 	AtUsers []*model.AtUser `json:"at_users,omitempty"`
+
+	Privacy  model.Privacy `json:"privacy,omitempty"`
+	Audience []int64       `json:"audience,omitempty"` // 指定可见的用户 仅PrivacyCustom时有效
 }
 
 type NoteTag struct {
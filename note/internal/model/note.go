@@ -1,6 +1,8 @@
 package model
 
 import (
+	"slices"
+
 	notev1 "github.com/ryanreadbooks/whimer/idl/gen/go/note/api/v1"
 )
 
@@ -77,10 +79,14 @@ type Note struct {
 	Videos   *NoteVideo    `json:"videos"`  // 包含多种编码的视频资源
 	Likes    int64         `json:"likes"`   // 点赞数
 	Replies  int64         `json:"replies"` // 评论数
+	Views    int64         `json:"views"`   // 浏览量
 
 	// ext字段
-	Tags    []*NoteTag `json:"tags,omitempty"`
-	AtUsers []*AtUser  `json:"at_users,omitempty"`
+	Tags     []*NoteTag `json:"tags,omitempty"`
+	AtUsers  []*AtUser  `json:"at_users,omitempty"`
+	Audience []int64    `json:"audience,omitempty"` // 指定可见的用户
+
+	Collection *CollectionLink `json:"collection,omitempty"` // 所属的合集
 }
 
 func (n *Note) IsPublic() bool {
@@ -91,6 +97,32 @@ func (n *Note) IsPrivate() bool {
 	return n.Privacy == PrivacyPrivate
 }
 
+// 浏览者和笔记作者之间的关注关系
+type ViewerRelation struct {
+	Following  bool // 浏览者关注了作者
+	FollowedBy bool // 作者关注了浏览者
+}
+
+// 笔记对浏览者是否可见 未登录的浏览者只能看到公开笔记
+func (n *Note) IsVisibleTo(viewer int64, rel ViewerRelation) bool {
+	if viewer != 0 && viewer == n.Owner {
+		return true
+	}
+
+	switch n.Privacy {
+	case PrivacyPublic:
+		return true
+	case PrivacyFollowers:
+		return viewer != 0 && rel.Following
+	case PrivacyMutualFollowers:
+		return viewer != 0 && rel.Following && rel.FollowedBy
+	case PrivacyCustom:
+		return viewer != 0 && slices.Contains(n.Audience, viewer)
+	}
+
+	return false
+}
+
 func (n *Note) AsSlice() []*Note {
 	return []*Note{n}
 }
@@ -109,6 +141,7 @@ func (i *Note) AsPb() *notev1.NoteItem {
 		Images:         i.Images.AsPb(),
 		Likes:          i.Likes,
 		Replies:        i.Replies,
+		Views:          i.Views,
 		Owner:          i.Owner,
 		Videos:         i.Videos.AsPb(),
 		LifeCycleState: NoteStateAsLifeCycleState(i.State),
@@ -118,7 +151,16 @@ func (i *Note) AsPb() *notev1.NoteItem {
 	res.Tags = NoteTagListAsPb(i.Tags)
 	// at_users
 	res.AtUsers = AtUsersAsPb(i.AtUsers)
+	// collection
+	res.Collection = i.Collection.AsPb()
+
+	return res
+}
 
+// 转换成pb并附带指定可见的用户 只能返回给笔记作者
+func (i *Note) AsOwnerPb() *notev1.NoteItem {
+	res := i.AsPb()
+	res.Audience = i.Audience
 	return res
 }
 
@@ -163,12 +205,14 @@ func PbFeedNoteItemsFromNotes(ns *Notes) []*notev1.FeedNoteItem {
 
 // 每个用户和笔记的交互情况
 type UserInteraction struct {
-	Liked bool // 是否点赞
+	Liked bool  // 是否点赞
+	Views int64 // 笔记浏览量
 }
 
 func (u *UserInteraction) AsPb() *notev1.NoteInteraction {
 	return &notev1.NoteInteraction{
 		Liked: u.Liked,
+		Views: u.Views,
 	}
 }
 
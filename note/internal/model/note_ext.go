@@ -6,14 +6,19 @@ import (
 )
 
 type NoteExt struct {
-	TagIds  []int64
-	AtUsers []*AtUser
+	TagIds   []int64
+	AtUsers  []*AtUser
+	Audience []int64
 }
 
 func (e *NoteExt) SetTagIds(s string) {
 	e.TagIds = xslice.SplitInts[int64](s, ",")
 }
 
+func (e *NoteExt) SetAudience(s string) {
+	e.Audience = xslice.SplitInts[int64](s, ",")
+}
+
 type NoteTag struct {
 	Id    int64  `json:"id"`
 	Name  string `json:"name"`
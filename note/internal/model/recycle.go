@@ -0,0 +1,39 @@
+package model
+
+import (
+	notev1 "github.com/ryanreadbooks/whimer/idl/gen/go/note/api/v1"
+)
+
+// 回收站中的笔记
+type RecycledNote struct {
+	Note     *Note
+	DeleteAt int64
+	ExpireAt int64 // 超过该时间后彻底删除 不能再恢复
+}
+
+func (r *RecycledNote) AsPb() *notev1.DeletedNoteItem {
+	return &notev1.DeletedNoteItem{
+		Note:     r.Note.AsOwnerPb(),
+		DeleteAt: r.DeleteAt,
+		ExpireAt: r.ExpireAt,
+	}
+}
+
+// 从回收站恢复后的笔记状态
+//
+// 删除时正在进行的流程已经被终止 流程中的笔记恢复为未通过 由作者编辑后重新提交
+func RestoredNoteState(prevState NoteState) NoteState {
+	switch prevState {
+	case NoteStateDraft, NoteStateScheduled:
+		// 定时发布的流程已经取消 需要重新发布
+		return NoteStateDraft
+	case NoteStatePublished, NoteStateBanned, NoteStateRejected, NoteStateProcessFailed:
+		return prevState
+	}
+
+	if MapNoteStateToProcedureType(prevState) == ProcedureTypeAssetProcess {
+		return NoteStateProcessFailed
+	}
+
+	return NoteStateRejected
+}
@@ -0,0 +1,21 @@
+package model
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRestoredNoteState(t *testing.T) {
+	Convey("RestoredNoteState", t, func() {
+		So(RestoredNoteState(NoteStatePublished), ShouldEqual, NoteStatePublished)
+		So(RestoredNoteState(NoteStateBanned), ShouldEqual, NoteStateBanned)
+		So(RestoredNoteState(NoteStateDraft), ShouldEqual, NoteStateDraft)
+		So(RestoredNoteState(NoteStateScheduled), ShouldEqual, NoteStateDraft)
+		So(RestoredNoteState(NoteStateRejected), ShouldEqual, NoteStateRejected)
+		So(RestoredNoteState(NoteStateInit), ShouldEqual, NoteStateProcessFailed)
+		So(RestoredNoteState(NoteStateProcessing), ShouldEqual, NoteStateProcessFailed)
+		So(RestoredNoteState(NoteStateAuditing), ShouldEqual, NoteStateRejected)
+		So(RestoredNoteState(NoteStateAuditReviewing), ShouldEqual, NoteStateRejected)
+	})
+}
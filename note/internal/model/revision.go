@@ -0,0 +1,155 @@
+package model
+
+import (
+	"slices"
+
+	notev1 "github.com/ryanreadbooks/whimer/idl/gen/go/note/api/v1"
+)
+
+// 笔记修订版本中的图片
+type NoteRevisionImage struct {
+	Key    string `json:"key"`
+	Width  uint32 `json:"width"`
+	Height uint32 `json:"height"`
+	Format string `json:"format"`
+}
+
+// 笔记修订版本中的视频 只有通过更新上传了视频时才会记录
+type NoteRevisionVideo struct {
+	FileId       string `json:"file_id"`
+	TargetFileId string `json:"target_file_id"`
+	CoverFileId  string `json:"cover_file_id"`
+}
+
+// 笔记某个版本的完整内容
+type NoteRevisionContent struct {
+	Title     string               `json:"title"`
+	Desc      string               `json:"desc"`
+	Privacy   Privacy              `json:"privacy"`
+	Images    []*NoteRevisionImage `json:"images,omitempty"`
+	Video     *NoteRevisionVideo   `json:"video,omitempty"`
+	AssetKeys []string             `json:"asset_keys,omitempty"` // 笔记的全部资源key
+	TagIds    []int64              `json:"tag_ids,omitempty"`
+	AtUsers   []*AtUser            `json:"at_users,omitempty"`
+}
+
+// 和上一个版本相比的变更
+type NoteRevisionDiff struct {
+	TitleChanged     bool     `json:"title_changed,omitempty"`
+	DescChanged      bool     `json:"desc_changed,omitempty"`
+	PrivacyChanged   bool     `json:"privacy_changed,omitempty"`
+	AddedAssetKeys   []string `json:"added_asset_keys,omitempty"`
+	RemovedAssetKeys []string `json:"removed_asset_keys,omitempty"`
+	AddedTagIds      []int64  `json:"added_tag_ids,omitempty"`
+	RemovedTagIds    []int64  `json:"removed_tag_ids,omitempty"`
+}
+
+// 计算从prev到cur的变更 prev为nil时视为全部新增
+func DiffNoteRevisionContent(prev, cur *NoteRevisionContent) *NoteRevisionDiff {
+	if prev == nil {
+		prev = &NoteRevisionContent{}
+	}
+
+	diff := &NoteRevisionDiff{
+		TitleChanged:   prev.Title != cur.Title,
+		DescChanged:    prev.Desc != cur.Desc,
+		PrivacyChanged: prev.Privacy != cur.Privacy,
+	}
+	diff.AddedAssetKeys, diff.RemovedAssetKeys = diffSlice(prev.AssetKeys, cur.AssetKeys)
+	diff.AddedTagIds, diff.RemovedTagIds = diffSlice(prev.TagIds, cur.TagIds)
+
+	return diff
+}
+
+// 返回cur相比prev新增和删除的元素
+func diffSlice[T comparable](prev, cur []T) (added, removed []T) {
+	for _, v := range cur {
+		if !slices.Contains(prev, v) {
+			added = append(added, v)
+		}
+	}
+	for _, v := range prev {
+		if !slices.Contains(cur, v) {
+			removed = append(removed, v)
+		}
+	}
+	return
+}
+
+// 文本是否有变更
+func (d *NoteRevisionDiff) TextChanged() bool {
+	return d.TitleChanged || d.DescChanged || len(d.AddedTagIds) > 0
+}
+
+// 资源是否有变更
+func (d *NoteRevisionDiff) AssetChanged() bool {
+	return len(d.AddedAssetKeys) > 0 || len(d.RemovedAssetKeys) > 0
+}
+
+func (d *NoteRevisionDiff) Empty() bool {
+	return !d.TextChanged() && !d.PrivacyChanged && !d.AssetChanged() && len(d.RemovedTagIds) == 0
+}
+
+// 笔记的修订版本 每次更新笔记时生成 生成后不再修改
+type NoteRevision struct {
+	NoteId    int64                `json:"note_id"`
+	Version   int64                `json:"version"` // 从1开始递增
+	Content   *NoteRevisionContent `json:"content"`
+	Diff      *NoteRevisionDiff    `json:"diff"`
+	PrevState NoteState            `json:"prev_state"` // 更新前的笔记状态
+	Ctime     int64                `json:"ctime"`
+}
+
+// 是否为发布后的编辑 发布后的编辑只需要审核变更的部分
+func (r *NoteRevision) IsEditAfterPublish() bool {
+	return r.Version > 1 && IsNoteStateConsideredAsPublished(r.PrevState)
+}
+
+func (r *NoteRevision) AsPb() *notev1.NoteRevision {
+	if r == nil {
+		return nil
+	}
+
+	content := &notev1.NoteRevisionContent{
+		Title:     r.Content.Title,
+		Desc:      r.Content.Desc,
+		Privacy:   int32(r.Content.Privacy),
+		AssetKeys: r.Content.AssetKeys,
+		TagIds:    r.Content.TagIds,
+	}
+	for _, img := range r.Content.Images {
+		content.Images = append(content.Images, &notev1.CreateReqImage{
+			FileId: img.Key,
+			Width:  img.Width,
+			Height: img.Height,
+			Format: img.Format,
+		})
+	}
+	if r.Content.Video != nil {
+		content.Video = &notev1.CreateReqVideo{
+			FileId:       r.Content.Video.FileId,
+			TargetFileId: r.Content.Video.TargetFileId,
+			CoverFileId:  r.Content.Video.CoverFileId,
+		}
+	}
+	for _, u := range r.Content.AtUsers {
+		content.AtUsers = append(content.AtUsers, u.AsPb())
+	}
+
+	return &notev1.NoteRevision{
+		NoteId:  r.NoteId,
+		Version: r.Version,
+		Content: content,
+		Diff: &notev1.NoteRevisionDiff{
+			TitleChanged:     r.Diff.TitleChanged,
+			DescChanged:      r.Diff.DescChanged,
+			PrivacyChanged:   r.Diff.PrivacyChanged,
+			AddedAssetKeys:   r.Diff.AddedAssetKeys,
+			RemovedAssetKeys: r.Diff.RemovedAssetKeys,
+			AddedTagIds:      r.Diff.AddedTagIds,
+			RemovedTagIds:    r.Diff.RemovedTagIds,
+		},
+		EditAfterPublish: r.IsEditAfterPublish(),
+		Ctime:            r.Ctime,
+	}
+}
@@ -0,0 +1,59 @@
+package model
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDiffNoteRevisionContent(t *testing.T) {
+	Convey("DiffNoteRevisionContent", t, func() {
+		prev := &NoteRevisionContent{
+			Title:     "title",
+			Desc:      "desc",
+			Privacy:   PrivacyPublic,
+			AssetKeys: []string{"a", "b"},
+			TagIds:    []int64{1, 2},
+		}
+		cur := &NoteRevisionContent{
+			Title:     "title",
+			Desc:      "new desc",
+			Privacy:   PrivacyPublic,
+			AssetKeys: []string{"b", "c"},
+			TagIds:    []int64{2, 3},
+		}
+
+		diff := DiffNoteRevisionContent(prev, cur)
+		So(diff.TitleChanged, ShouldBeFalse)
+		So(diff.DescChanged, ShouldBeTrue)
+		So(diff.PrivacyChanged, ShouldBeFalse)
+		So(diff.AddedAssetKeys, ShouldResemble, []string{"c"})
+		So(diff.RemovedAssetKeys, ShouldResemble, []string{"a"})
+		So(diff.AddedTagIds, ShouldResemble, []int64{3})
+		So(diff.RemovedTagIds, ShouldResemble, []int64{1})
+		So(diff.TextChanged(), ShouldBeTrue)
+		So(diff.AssetChanged(), ShouldBeTrue)
+
+		So(DiffNoteRevisionContent(cur, cur).Empty(), ShouldBeTrue)
+
+		// 只删除标签不需要审核文本
+		removeTag := DiffNoteRevisionContent(cur, &NoteRevisionContent{
+			Title: cur.Title, Desc: cur.Desc, Privacy: cur.Privacy, AssetKeys: cur.AssetKeys, TagIds: []int64{2},
+		})
+		So(removeTag.TextChanged(), ShouldBeFalse)
+		So(removeTag.Empty(), ShouldBeFalse)
+
+		base := DiffNoteRevisionContent(nil, prev)
+		So(base.TitleChanged, ShouldBeTrue)
+		So(base.AddedAssetKeys, ShouldResemble, []string{"a", "b"})
+	})
+}
+
+func TestNoteRevisionIsEditAfterPublish(t *testing.T) {
+	Convey("IsEditAfterPublish", t, func() {
+		So((&NoteRevision{Version: 2, PrevState: NoteStatePublished}).IsEditAfterPublish(), ShouldBeTrue)
+		So((&NoteRevision{Version: 1, PrevState: NoteStatePublished}).IsEditAfterPublish(), ShouldBeFalse)
+		So((&NoteRevision{Version: 3, PrevState: NoteStateRejected}).IsEditAfterPublish(), ShouldBeFalse)
+		So((&NoteRevision{Version: 3, PrevState: NoteStateDraft}).IsEditAfterPublish(), ShouldBeFalse)
+	})
+}
@@ -0,0 +1,58 @@
+package model
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestNoteIsVisibleTo(t *testing.T) {
+	Convey("Note.IsVisibleTo", t, func() {
+		const owner, viewer = 100, 200
+		newNote := func(privacy Privacy, audience ...int64) *Note {
+			return &Note{Owner: owner, Privacy: privacy, Audience: audience}
+		}
+		following := ViewerRelation{Following: true}
+		mutual := ViewerRelation{Following: true, FollowedBy: true}
+
+		Convey("owner can see all", func() {
+			for _, p := range []Privacy{PrivacyPublic, PrivacyPrivate, PrivacyFollowers, PrivacyMutualFollowers, PrivacyCustom} {
+				So(newNote(p).IsVisibleTo(owner, ViewerRelation{}), ShouldBeTrue)
+			}
+		})
+
+		Convey("anonymous viewer", func() {
+			So(newNote(PrivacyPublic).IsVisibleTo(0, ViewerRelation{}), ShouldBeTrue)
+			So(newNote(PrivacyFollowers).IsVisibleTo(0, following), ShouldBeFalse)
+			So(newNote(PrivacyCustom, 0).IsVisibleTo(0, ViewerRelation{}), ShouldBeFalse)
+		})
+
+		Convey("restricted audience", func() {
+			So(newNote(PrivacyPrivate).IsVisibleTo(viewer, mutual), ShouldBeFalse)
+
+			So(newNote(PrivacyFollowers).IsVisibleTo(viewer, ViewerRelation{}), ShouldBeFalse)
+			So(newNote(PrivacyFollowers).IsVisibleTo(viewer, following), ShouldBeTrue)
+
+			So(newNote(PrivacyMutualFollowers).IsVisibleTo(viewer, following), ShouldBeFalse)
+			So(newNote(PrivacyMutualFollowers).IsVisibleTo(viewer, ViewerRelation{FollowedBy: true}), ShouldBeFalse)
+			So(newNote(PrivacyMutualFollowers).IsVisibleTo(viewer, mutual), ShouldBeTrue)
+
+			So(newNote(PrivacyCustom, 300).IsVisibleTo(viewer, mutual), ShouldBeFalse)
+			So(newNote(PrivacyCustom, 300, viewer).IsVisibleTo(viewer, ViewerRelation{}), ShouldBeTrue)
+		})
+
+		Convey("unknown privacy", func() {
+			So(Privacy(0).IsValid(), ShouldBeFalse)
+			So(PrivacyCustom.IsValid(), ShouldBeTrue)
+			So(newNote(Privacy(99)).IsVisibleTo(viewer, mutual), ShouldBeFalse)
+		})
+	})
+}
+
+func TestNoteAudienceAsPb(t *testing.T) {
+	Convey("Note audience only in owner pb", t, func() {
+		note := &Note{Owner: 100, Privacy: PrivacyCustom, Audience: []int64{200, 300}}
+		So(note.AsPb().Audience, ShouldBeEmpty)
+		So(note.AsOwnerPb().Audience, ShouldResemble, []int64{200, 300})
+	})
+}
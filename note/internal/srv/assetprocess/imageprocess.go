@@ -2,6 +2,7 @@ package assetprocess
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/ryanreadbooks/whimer/note/internal/biz"
@@ -42,6 +43,9 @@ func (p *ImageProcessor) Process(ctx context.Context, note *model.Note) (string,
 			CallbackUrl: callbackUrl,
 			MaxRetry:    5,
 			ExpireAfter: 1 * time.Hour,
+			Priority:    global.NotePublishProcessPriority,
+			// 同一版本的笔记只处理一次 避免超时重试后重复提交
+			IdempotencyKey: fmt.Sprintf("note_image_process:%d:%d", note.NoteId, note.UpdateAt),
 		})
 	if err != nil {
 		return "", xerror.Wrapf(err, "srv creator schedule task failed").
@@ -2,6 +2,7 @@ package assetprocess
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"time"
 
@@ -103,6 +104,9 @@ func (p *VideoProcessor) Process(ctx context.Context, note *model.Note) (string,
 			CallbackUrl: callbackUrl,
 			MaxRetry:    3,
 			ExpireAfter: time.Hour * 2,
+			Priority:    global.NotePublishProcessPriority,
+			// 同一份原始视频只转码一次 避免超时重试后重复提交
+			IdempotencyKey: fmt.Sprintf("note_video_process:%d:%s", note.NoteId, note.Videos.GetRawUrl()),
 		},
 	)
 	if err != nil {
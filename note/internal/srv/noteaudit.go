@@ -0,0 +1,143 @@
+package srv
+
+import (
+	"context"
+
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xlog"
+	"github.com/ryanreadbooks/whimer/note/internal/biz"
+	"github.com/ryanreadbooks/whimer/note/internal/model"
+	eventmodel "github.com/ryanreadbooks/whimer/note/internal/model/event"
+	"github.com/ryanreadbooks/whimer/note/internal/srv/procedure"
+)
+
+// 笔记审核管理 人工复审和封禁
+type NoteAuditSrv struct {
+	parent *Service
+
+	biz          *biz.Biz
+	noteAuditBiz *biz.NoteAuditBiz
+	noteEventBiz *biz.NoteEventBiz
+	procedureMgr *procedure.Manager
+}
+
+func NewNoteAuditSrv(p *Service, biz *biz.Biz, procedureMgr *procedure.Manager) *NoteAuditSrv {
+	return &NoteAuditSrv{
+		parent:       p,
+		biz:          biz,
+		noteAuditBiz: biz.Audit,
+		noteEventBiz: biz.NoteEvent,
+		procedureMgr: procedureMgr,
+	}
+}
+
+// 人工复审队列中的笔记和机审结果
+type ReviewQueueItem struct {
+	Note  *model.Note      `json:"note"`
+	Audit *model.NoteAudit `json:"audit"`
+}
+
+// 列出人工复审队列
+func (s *NoteAuditSrv) ListReviewQueue(ctx context.Context, cursor int64, count int32) ([]*ReviewQueueItem, model.PageResult, error) {
+	notes, nextPage, err := s.noteAuditBiz.ListReviewQueue(ctx, cursor, count)
+	if err != nil {
+		return nil, nextPage, xerror.Wrapf(err, "srv audit list review queue failed").WithCtx(ctx)
+	}
+
+	audits, err := s.noteAuditBiz.BatchGetAudit(ctx, notes.GetIds())
+	if err != nil {
+		return nil, nextPage, xerror.Wrapf(err, "srv audit batch get audit failed").WithCtx(ctx)
+	}
+
+	items := make([]*ReviewQueueItem, 0, len(notes.Items))
+	for _, note := range notes.Items {
+		items = append(items, &ReviewQueueItem{
+			Note:  note,
+			Audit: audits[note.NoteId],
+		})
+	}
+
+	return items, nextPage, nil
+}
+
+// 人工复审
+//
+// 复审结果落库后完成审核流程 通过则继续进入发布流程
+func (s *NoteAuditSrv) ResolveReview(ctx context.Context, req *biz.ResolveReviewRequest) error {
+	var note *model.Note
+	err := s.biz.Tx(ctx, func(ctx context.Context) error {
+		var errTx error
+		note, errTx = s.noteAuditBiz.ResolveReview(ctx, req)
+		if errTx != nil {
+			return xerror.Wrapf(errTx, "srv audit resolve review failed").WithCtx(ctx)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return xerror.Wrapf(err, "srv audit resolve review tx failed").WithCtx(ctx)
+	}
+
+	// 此步失败时由流程后台轮询审核结果兜底
+	var taskId string
+	record, err := s.procedureMgr.GetTask(ctx, note.NoteId, model.ProcedureTypeAudit)
+	if err != nil {
+		xlog.Msg("srv audit get audit task failed").
+			Err(err).
+			Extras("note_id", note.NoteId).
+			Errorx(ctx)
+		return nil
+	}
+	if record != nil {
+		taskId = record.TaskId
+	}
+
+	decision := model.AuditDecisionReject
+	if req.Pass {
+		decision = model.AuditDecisionPass
+	}
+	err = s.procedureMgr.Complete(ctx, &procedure.CompleteResult{
+		NoteId:  note.NoteId,
+		Protype: model.ProcedureTypeAudit,
+		TaskId:  taskId,
+		Success: req.Pass,
+		Arg: &model.NoteAudit{
+			NoteId:   note.NoteId,
+			TaskId:   taskId,
+			Decision: decision,
+			Reason:   req.Reason,
+			Operator: req.Operator,
+		},
+	})
+	if err != nil {
+		xlog.Msg("srv audit complete audit procedure failed").
+			Err(err).
+			Extras("note_id", note.NoteId, "task_id", taskId).
+			Errorx(ctx)
+	}
+
+	return nil
+}
+
+// 封禁已发布的笔记
+func (s *NoteAuditSrv) BanNote(ctx context.Context, req *biz.BanNoteRequest) error {
+	err := s.biz.Tx(ctx, func(ctx context.Context) error {
+		note, errTx := s.noteAuditBiz.BanNote(ctx, req)
+		if errTx != nil {
+			return xerror.Wrapf(errTx, "srv audit ban note failed").WithCtx(ctx)
+		}
+
+		// 封禁后对外不可见 和删除一样通知下游
+		errTx = s.noteEventBiz.NoteDeleted(ctx, note, eventmodel.NoteDeleteReasonBanned)
+		if errTx != nil {
+			return xerror.Wrapf(errTx, "srv audit publish note banned event failed").WithCtx(ctx)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return xerror.Wrapf(err, "srv audit ban note tx failed").WithCtx(ctx)
+	}
+
+	return nil
+}
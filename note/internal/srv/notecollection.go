@@ -0,0 +1,171 @@
+package srv
+
+import (
+	"context"
+
+	"github.com/ryanreadbooks/whimer/misc/metadata"
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/note/internal/biz"
+	"github.com/ryanreadbooks/whimer/note/internal/global"
+	"github.com/ryanreadbooks/whimer/note/internal/model"
+)
+
+type CollectionRequest = biz.CollectionRequest
+
+type NoteCollectionSrv struct {
+	parent *Service
+
+	biz               *biz.Biz
+	noteInteractBiz   *biz.NoteInteractBiz
+	noteCollectionBiz *biz.NoteCollectionBiz
+}
+
+func NewNoteCollectionSrv(p *Service, biz *biz.Biz) *NoteCollectionSrv {
+	return &NoteCollectionSrv{
+		parent:            p,
+		biz:               biz,
+		noteInteractBiz:   biz.Interact,
+		noteCollectionBiz: biz.Collection,
+	}
+}
+
+// 新建合集
+func (s *NoteCollectionSrv) Create(ctx context.Context, req *CollectionRequest) (int64, error) {
+	if err := req.Validate(); err != nil {
+		return 0, err
+	}
+
+	var id int64
+	err := s.biz.Tx(ctx, func(ctx context.Context) error {
+		var err error
+		id, err = s.noteCollectionBiz.CreateCollection(ctx, req)
+		return err
+	})
+	if err != nil {
+		return 0, xerror.Wrapf(err, "srv collection create failed").WithCtx(ctx)
+	}
+
+	return id, nil
+}
+
+// 修改合集信息
+func (s *NoteCollectionSrv) Update(ctx context.Context, req *CollectionRequest) error {
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	err := s.biz.Tx(ctx, func(ctx context.Context) error {
+		return s.noteCollectionBiz.UpdateCollection(ctx, req)
+	})
+	if err != nil {
+		return xerror.Wrapf(err, "srv collection update failed").WithCtx(ctx)
+	}
+
+	return nil
+}
+
+// 删除合集
+func (s *NoteCollectionSrv) Delete(ctx context.Context, id int64) error {
+	err := s.biz.Tx(ctx, func(ctx context.Context) error {
+		return s.noteCollectionBiz.DeleteCollection(ctx, id)
+	})
+	if err != nil {
+		return xerror.Wrapf(err, "srv collection delete failed").WithExtra("collectionId", id).WithCtx(ctx)
+	}
+
+	return nil
+}
+
+// 作者获取自己的合集详情
+func (s *NoteCollectionSrv) Get(ctx context.Context, id int64) (*model.Collection, error) {
+	uid := metadata.Uid(ctx)
+	collection, err := s.noteCollectionBiz.GetCollection(ctx, id, uid)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "srv collection get failed").WithExtra("collectionId", id).WithCtx(ctx)
+	}
+
+	if collection.Owner != uid {
+		return nil, global.ErrNotCollectionOwner
+	}
+
+	return collection, nil
+}
+
+// 作者列出自己的全部合集
+func (s *NoteCollectionSrv) ListOwned(ctx context.Context) ([]*model.Collection, error) {
+	uid := metadata.Uid(ctx)
+	collections, err := s.noteCollectionBiz.ListCollections(ctx, uid, uid)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "srv collection list owned failed").WithCtx(ctx)
+	}
+
+	return collections, nil
+}
+
+// 添加笔记到合集
+func (s *NoteCollectionSrv) AddNotes(ctx context.Context, id int64, noteIds []int64) error {
+	err := s.biz.Tx(ctx, func(ctx context.Context) error {
+		return s.noteCollectionBiz.AddNotes(ctx, id, noteIds)
+	})
+	if err != nil {
+		return xerror.Wrapf(err, "srv collection add notes failed").
+			WithExtras("collectionId", id, "noteIds", noteIds).WithCtx(ctx)
+	}
+
+	return nil
+}
+
+// 从合集中移除笔记
+func (s *NoteCollectionSrv) RemoveNotes(ctx context.Context, id int64, noteIds []int64) error {
+	err := s.biz.Tx(ctx, func(ctx context.Context) error {
+		return s.noteCollectionBiz.RemoveNotes(ctx, id, noteIds)
+	})
+	if err != nil {
+		return xerror.Wrapf(err, "srv collection remove notes failed").
+			WithExtras("collectionId", id, "noteIds", noteIds).WithCtx(ctx)
+	}
+
+	return nil
+}
+
+// 调整合集中笔记的顺序
+func (s *NoteCollectionSrv) SortNotes(ctx context.Context, id int64, noteIds []int64) error {
+	err := s.biz.Tx(ctx, func(ctx context.Context) error {
+		return s.noteCollectionBiz.SortNotes(ctx, id, noteIds)
+	})
+	if err != nil {
+		return xerror.Wrapf(err, "srv collection sort notes failed").
+			WithExtras("collectionId", id, "noteIds", noteIds).WithCtx(ctx)
+	}
+
+	return nil
+}
+
+// 列出用户对请求者可见的合集
+func (s *NoteCollectionSrv) ListUserCollections(ctx context.Context, user int64) ([]*model.Collection, error) {
+	collections, err := s.noteCollectionBiz.ListCollections(ctx, user, metadata.Uid(ctx))
+	if err != nil {
+		return nil, xerror.Wrapf(err, "srv collection list user collections failed").WithExtra("user", user).WithCtx(ctx)
+	}
+
+	return collections, nil
+}
+
+// 按顺序列出合集中公开的笔记
+func (s *NoteCollectionSrv) ListFeed(ctx context.Context, id int64, cursor int64, count int32) (
+	*model.Collection, *model.Notes, model.PageResult, error,
+) {
+	collection, result, page, err := s.noteCollectionBiz.ListPublicNotes(ctx, id, metadata.Uid(ctx), cursor, count)
+	if err != nil {
+		return nil, nil, page, xerror.Wrapf(err, "srv collection list feed failed").WithExtra("collectionId", id).WithCtx(ctx)
+	}
+
+	// 列表中不返回全部笔记id
+	collection.NoteIds = nil
+
+	result, _ = s.noteInteractBiz.AssignNoteLikes(ctx, result)
+	result, _ = s.noteInteractBiz.AssignNoteViews(ctx, result)
+	result, _ = s.noteInteractBiz.AssignNoteReplies(ctx, result)
+
+	return collection, result, page, nil
+}
@@ -19,6 +19,8 @@ type (
 	CreateNoteRequest = biz.CreateNoteRequest
 	UpdateNoteRequest = biz.UpdateNoteRequest
 	DeleteNoteRequest = biz.DeleteNoteRequest
+
+	PublishDraftRequest = biz.PublishDraftRequest
 )
 
 type NoteCreatorSrv struct {
@@ -30,6 +32,7 @@ type NoteCreatorSrv struct {
 	noteCreatorBiz   *biz.NoteCreatorBiz
 	noteInteractBiz  *biz.NoteInteractBiz
 	noteEventBiz     *biz.NoteEventBiz
+	collectionBiz    *biz.NoteCollectionBiz
 	procedureMgr     *procedure.Manager
 }
 
@@ -42,6 +45,7 @@ func NewNoteCreatorSrv(p *Service, biz *biz.Biz, procedureMgr *procedure.Manager
 		noteCreatorBiz:   biz.Creator,
 		noteInteractBiz:  biz.Interact,
 		noteEventBiz:     biz.NoteEvent,
+		collectionBiz:    biz.Collection,
 
 		procedureMgr: procedureMgr,
 	}
@@ -75,10 +79,16 @@ func (s *NoteCreatorSrv) Create(ctx context.Context, req *CreateNoteRequest) (in
 			return xerror.Wrapf(errTx, "srv creator create note failed").WithCtx(ctx)
 		}
 
+		// 草稿不进入发布流程
+		if req.Draft {
+			return nil
+		}
+
 		// 初始化流程记录
 		proceed, errTx = s.procedureMgr.RunPipeline(ctx, &procedure.RunPipelineParam{
 			Note:       newNote,
-			StartStage: procedure.StartAtAssetProcess(),
+			StartStage: publishStartStage(req.PublishAt),
+			PublishAt:  req.PublishAt,
 		})
 		if errTx != nil {
 			return xerror.Wrapf(errTx, "srv creator init procedure failed").WithCtx(ctx)
@@ -91,11 +101,22 @@ func (s *NoteCreatorSrv) Create(ctx context.Context, req *CreateNoteRequest) (in
 	}
 
 	// 继续执行流程任务
-	_ = proceed(ctx)
+	if proceed != nil {
+		_ = proceed(ctx)
+	}
 
 	return newNote.NoteId, nil
 }
 
+// 定时发布的笔记从定时发布流程开始 否则直接从资源处理开始
+func publishStartStage(publishAt int64) model.ProcedureType {
+	if publishAt > 0 {
+		return procedure.StartAtSchedule()
+	}
+
+	return procedure.StartAtAssetProcess()
+}
+
 // 更新笔记
 func (s *NoteCreatorSrv) Update(ctx context.Context, req *UpdateNoteRequest) error {
 	var (
@@ -137,6 +158,12 @@ func (s *NoteCreatorSrv) Update(ctx context.Context, req *UpdateNoteRequest) err
 			}
 		}
 
+		// 草稿和定时发布的笔记保持原状态 不需要重新走流程
+		if model.IsNoteStateConsideredAsDraft(newNote.State) ||
+			model.IsNoteStateConsideredAsScheduled(newNote.State) {
+			return nil
+		}
+
 		startAt := procedure.StartAtAssetProcess()
 		if newNote.State != model.NoteStateInit {
 			startAt = procedure.StartAtAudit()
@@ -168,12 +195,85 @@ func (s *NoteCreatorSrv) Update(ctx context.Context, req *UpdateNoteRequest) err
 	}
 
 	// 继续后续流程
+	if proceed != nil {
+		_ = proceed(ctx)
+	}
+
+	return nil
+}
+
+// 发布草稿
+//
+// 等待定时发布的笔记也可以重新发布 此时会取消原有的定时发布流程
+func (s *NoteCreatorSrv) PublishDraft(ctx context.Context, req *PublishDraftRequest) error {
+	var proceed func(ctx context.Context) bool
+
+	err := s.biz.Tx(ctx, func(ctx context.Context) error {
+		note, errTx := s.noteCreatorBiz.PublishDraft(ctx, req)
+		if errTx != nil {
+			return xerror.Wrapf(errTx, "srv creator publish draft failed").WithCtx(ctx)
+		}
+
+		if model.IsNoteStateConsideredAsScheduled(note.State) {
+			errTx = s.procedureMgr.CancelTask(ctx, note.NoteId, model.ProcedureTypeSchedule)
+			if errTx != nil {
+				return xerror.Wrapf(errTx, "srv creator cancel schedule task failed").WithCtx(ctx)
+			}
+		}
+
+		proceed, errTx = s.procedureMgr.RunPipeline(ctx, &procedure.RunPipelineParam{
+			Note:       note,
+			StartStage: publishStartStage(req.PublishAt),
+			PublishAt:  req.PublishAt,
+		})
+		if errTx != nil {
+			return xerror.Wrapf(errTx, "srv creator init procedure failed").WithCtx(ctx)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return xerror.Wrapf(err, "srv creator publish draft tx failed").WithCtx(ctx)
+	}
+
 	_ = proceed(ctx)
 
 	return nil
 }
 
-// 删除笔记
+// 列出笔记的修订版本
+func (s *NoteCreatorSrv) ListRevisions(ctx context.Context, noteId, cursor int64, count int32) (
+	[]*model.NoteRevision, model.PageResult, error,
+) {
+	revs, nextPage, err := s.noteCreatorBiz.ListNoteRevisions(ctx, noteId, cursor, count)
+	if err != nil {
+		return nil, nextPage, xerror.Wrapf(err, "srv creator list revisions failed").WithCtx(ctx)
+	}
+
+	return revs, nextPage, nil
+}
+
+// 获取笔记的修订版本
+func (s *NoteCreatorSrv) GetRevision(ctx context.Context, noteId, version int64) (*model.NoteRevision, error) {
+	rev, err := s.noteCreatorBiz.GetNoteRevision(ctx, noteId, version)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "srv creator get revision failed").WithCtx(ctx)
+	}
+
+	return rev, nil
+}
+
+// 恢复到某个修订版本 和普通更新一样生成新的版本并重新进入流程
+func (s *NoteCreatorSrv) RestoreRevision(ctx context.Context, noteId, version int64) error {
+	req, err := s.noteCreatorBiz.BuildRestoreRevisionRequest(ctx, noteId, version)
+	if err != nil {
+		return xerror.Wrapf(err, "srv creator build restore request failed").WithCtx(ctx)
+	}
+
+	return s.Update(ctx, req)
+}
+
+// 删除笔记 放入回收站
 func (s *NoteCreatorSrv) Delete(ctx context.Context, req *DeleteNoteRequest) error {
 	var (
 		oldNote      *model.Note
@@ -187,6 +287,13 @@ func (s *NoteCreatorSrv) Delete(ctx context.Context, req *DeleteNoteRequest) err
 		if errTx != nil {
 			return xerror.Wrapf(errTx, "srv create delete note failed").WithCtx(ctx)
 		}
+		oldNote = model.NoteFromNoteCore(old)
+
+		// 删除事件和删除操作在同一个事务中写入发件箱 放入回收站后笔记不再对外可见
+		errTx = s.noteEventBiz.NoteDeleted(ctx, oldNote, eventmodel.NoteDeleteReasonRecycled)
+		if errTx != nil {
+			return xerror.Wrapf(errTx, "srv creator publish note deleted event failed").WithCtx(ctx)
+		}
 
 		curTask, errTx := s.procedureMgr.GetTask(ctx, old.NoteId,
 			model.MapNoteStateToProcedureType(old.State))
@@ -201,7 +308,6 @@ func (s *NoteCreatorSrv) Delete(ctx context.Context, req *DeleteNoteRequest) err
 			curTaskId = curTask.TaskId
 		}
 		curProcStage = model.MapNoteStateToProcedureType(old.State)
-		oldNote = model.NoteFromNoteCore(old)
 
 		return nil
 	})
@@ -220,18 +326,60 @@ func (s *NoteCreatorSrv) Delete(ctx context.Context, req *DeleteNoteRequest) err
 		}
 	}
 
-	// 发布删除事件
-	if err := s.noteEventBiz.NoteDeleted(ctx, oldNote, eventmodel.NoteDeleteReasonPureDelete); err != nil {
-		// 这步失败仅打日志
-		xlog.Msg("srv creator publish note deleted event failed").
-			Err(err).
-			Extras("note_id", oldNote.NoteId).
-			Errorx(ctx)
+	return nil
+}
+
+// 从回收站中恢复笔记
+//
+// 恢复为已发布的笔记需要重新广播发布事件
+func (s *NoteCreatorSrv) Restore(ctx context.Context, noteId int64) error {
+	err := s.biz.Tx(ctx, func(ctx context.Context) error {
+		note, errTx := s.noteCreatorBiz.RestoreNote(ctx, noteId)
+		if errTx != nil {
+			return xerror.Wrapf(errTx, "srv creator restore note failed").WithCtx(ctx)
+		}
+
+		if !model.IsNoteStateConsideredAsPublished(note.State) || note.IsPrivate() {
+			return nil
+		}
+
+		fullNote, errTx := s.noteBiz.GetNote(ctx, noteId)
+		if errTx != nil {
+			return xerror.Wrapf(errTx, "srv creator get note failed").WithCtx(ctx)
+		}
+		fullNote.State = note.State
+
+		errTx = s.noteBiz.AssembleNotesExt(ctx, fullNote.AsSlice())
+		if errTx != nil {
+			return xerror.Wrapf(errTx, "srv creator assemble note ext failed").WithCtx(ctx)
+		}
+
+		errTx = s.noteEventBiz.NotePublished(ctx, fullNote)
+		if errTx != nil {
+			return xerror.Wrapf(errTx, "srv creator publish note published event failed").WithCtx(ctx)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return xerror.Wrapf(err, "srv creator restore note tx failed").WithExtra("noteId", noteId).WithCtx(ctx)
 	}
 
 	return nil
 }
 
+// 列出回收站中的笔记
+func (s *NoteCreatorSrv) ListDeleted(ctx context.Context, cursor int64, count int32) (
+	[]*model.RecycledNote, model.PageResult, error,
+) {
+	items, nextPage, err := s.noteCreatorBiz.ListDeletedNotes(ctx, cursor, count)
+	if err != nil {
+		return nil, nextPage, xerror.Wrapf(err, "srv creator list deleted notes failed").WithCtx(ctx)
+	}
+
+	return items, nextPage, nil
+}
+
 // 列出某用户所有笔记
 func (s *NoteCreatorSrv) List(ctx context.Context, cursor int64, count int32) (*model.Notes, model.PageResult, error) {
 	resp, nextPage, err := s.noteCreatorBiz.PageListNoteWithCursor(ctx, cursor, count)
@@ -240,11 +388,22 @@ func (s *NoteCreatorSrv) List(ctx context.Context, cursor int64, count int32) (*
 	}
 
 	resp, _ = s.noteInteractBiz.AssignNoteLikes(ctx, resp)
+	resp, _ = s.noteInteractBiz.AssignNoteViews(ctx, resp)
 	resp, _ = s.noteInteractBiz.AssignNoteReplies(ctx, resp)
 
 	return resp, nextPage, nil
 }
 
+// 列出某用户的草稿
+func (s *NoteCreatorSrv) ListDrafts(ctx context.Context, cursor int64, count int32) (*model.Notes, model.PageResult, error) {
+	resp, nextPage, err := s.noteCreatorBiz.ListDrafts(ctx, cursor, count)
+	if err != nil {
+		return nil, nextPage, xerror.Wrapf(err, "srv creator list drafts failed").WithCtx(ctx)
+	}
+
+	return resp, nextPage, nil
+}
+
 func (s *NoteCreatorSrv) PageList(ctx context.Context, page, count int32, lcState notev1.NoteLifeCycleState) (*model.Notes, int64, error) {
 	resp, total, err := s.noteCreatorBiz.PageListNote(ctx, page, count, lcState)
 	if err != nil {
@@ -252,6 +411,7 @@ func (s *NoteCreatorSrv) PageList(ctx context.Context, page, count int32, lcStat
 	}
 
 	resp, _ = s.noteInteractBiz.AssignNoteLikes(ctx, resp)
+	resp, _ = s.noteInteractBiz.AssignNoteViews(ctx, resp)
 	resp, _ = s.noteInteractBiz.AssignNoteReplies(ctx, resp)
 
 	return resp, total, nil
@@ -266,6 +426,12 @@ func (s *NoteCreatorSrv) GetNote(ctx context.Context, noteId int64) (*model.Note
 
 	resp, _ := s.noteInteractBiz.AssignNoteLikes(ctx, &model.Notes{Items: []*model.Note{note}})
 	resp, _ = s.noteInteractBiz.AssignNoteReplies(ctx, resp)
+	resp, _ = s.noteInteractBiz.AssignNoteViews(ctx, resp)
+
+	err = s.collectionBiz.AssignNoteCollection(ctx, note.Owner, note)
+	if err != nil {
+		xlog.Msg("srv creator assign note collection failed").Err(err).Extra("noteId", noteId).Errorx(ctx)
+	}
 
 	return resp.Items[0], nil
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/ryanreadbooks/whimer/misc/xlog"
 	"github.com/ryanreadbooks/whimer/misc/xmap"
 	maps "github.com/ryanreadbooks/whimer/misc/xmap"
+	"github.com/ryanreadbooks/whimer/misc/xslice"
 	"github.com/ryanreadbooks/whimer/note/internal/biz"
 	"github.com/ryanreadbooks/whimer/note/internal/data"
 	"github.com/ryanreadbooks/whimer/note/internal/global"
@@ -27,6 +28,8 @@ type NoteFeedSrv struct {
 	noteBiz         *biz.NoteBiz
 	noteCreatorBiz  *biz.NoteCreatorBiz
 	noteInteractBiz *biz.NoteInteractBiz
+	recommendBiz    *biz.NoteRecommendBiz
+	collectionBiz   *biz.NoteCollectionBiz
 }
 
 func NewNoteFeedSrv(ctx *Service, biz *biz.Biz, dt *data.Data) *NoteFeedSrv {
@@ -36,6 +39,8 @@ func NewNoteFeedSrv(ctx *Service, biz *biz.Biz, dt *data.Data) *NoteFeedSrv {
 		noteBiz:         biz.Note,
 		noteCreatorBiz:  biz.Creator,
 		noteInteractBiz: biz.Interact,
+		recommendBiz:    biz.Recommend,
+		collectionBiz:   biz.Collection,
 	}
 
 	return s
@@ -108,12 +113,30 @@ func (s *NoteFeedSrv) randomGet(ctx context.Context, count int) (*model.Notes, e
 	}
 
 	result, _ = s.noteInteractBiz.AssignNoteLikes(ctx, result)
+	result, _ = s.noteInteractBiz.AssignNoteViews(ctx, result)
 	result, _ = s.noteInteractBiz.AssignNoteReplies(ctx, result)
 
 	return result, nil
 }
 
-// 获取笔记详情 不包含private范围的笔记
+// 信息流推荐 cursor为空时开始新的推荐会话
+func (s *NoteFeedSrv) RecommendGet(ctx context.Context, uid int64, needNum int32, cursor string) (
+	*model.Notes, string, bool, error,
+) {
+	result, err := s.recommendBiz.Recommend(ctx, &biz.RecommendRequest{
+		Uid:     uid,
+		NeedNum: int(needNum),
+		Cursor:  cursor,
+	})
+	if err != nil {
+		return nil, "", false, xerror.Wrapf(err, "feed srv recommend failed").WithExtra("uid", uid).WithCtx(ctx)
+	}
+
+	// 互动数据已经在打分阶段填充
+	return result.Notes, result.NextCursor, result.HasNext, nil
+}
+
+// 获取笔记详情 不包含对请求者不可见的笔记
 func (s *NoteFeedSrv) GetNoteDetail(ctx context.Context, noteId int64) (*model.Note, error) {
 	uid := metadata.Uid(ctx)
 
@@ -122,10 +145,6 @@ func (s *NoteFeedSrv) GetNoteDetail(ctx context.Context, noteId int64) (*model.N
 		return nil, xerror.Wrapf(err, "get note detail failed").WithExtra("noteId", noteId).WithCtx(ctx)
 	}
 
-	if note.Privacy == model.PrivacyPrivate && note.Owner != uid {
-		return nil, global.ErrNoteNotPublic
-	}
-
 	if note.State != model.NoteStatePublished {
 		return nil, global.ErrNoteNotFound
 	}
@@ -138,9 +157,24 @@ func (s *NoteFeedSrv) GetNoteDetail(ctx context.Context, noteId int64) (*model.N
 		return nil, xerror.Wrapf(err, "assemble notes ext failed").WithExtra("noteId", noteId).WithCtx(ctx)
 	}
 
+	visible, err := s.noteBiz.IsNoteVisible(ctx, uid, note)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "check note visible failed").WithExtra("noteId", noteId).WithCtx(ctx)
+	}
+	if !visible {
+		return nil, global.ErrNoteNotPublic
+	}
+
 	res, _ = s.noteInteractBiz.AssignNoteLikes(ctx, res)
+	res, _ = s.noteInteractBiz.AssignNoteViews(ctx, res)
 	res, _ = s.noteInteractBiz.AssignNoteReplies(ctx, res)
 
+	// 合集获取失败不影响笔记详情
+	err = s.collectionBiz.AssignNoteCollection(ctx, uid, note)
+	if err != nil {
+		xlog.Msg("feed srv assign note collection failed").Err(err).Extra("noteId", noteId).Errorx(ctx)
+	}
+
 	return res.Items[0], nil
 }
 
@@ -148,7 +182,7 @@ func (s *NoteFeedSrv) GetNoteAuthor(ctx context.Context, noteId int64) (int64, e
 	return s.noteBiz.GetNoteOwner(ctx, noteId)
 }
 
-// 批量获取笔记详情 不包含private范围的笔记
+// 批量获取笔记详情 不包含对请求者不可见的笔记
 func (s *NoteFeedSrv) BatchGetNoteDetail(ctx context.Context, noteIds []int64) (map[int64]*model.Note, error) {
 	operator := metadata.Uid(ctx)
 
@@ -161,20 +195,9 @@ func (s *NoteFeedSrv) BatchGetNoteDetail(ctx context.Context, noteIds []int64) (
 		return map[int64]*model.Note{}, nil
 	}
 
-	// 过滤掉private的笔记
 	// 过滤掉未发布的笔记
 	pNotes := xmap.Filter(notes, func(k int64, v *model.Note) bool {
-		// 如果请求者不是笔记作者 则需要过滤掉private
-		// 并且还要过滤掉未发布的笔记
-		if operator != v.Owner && v.Privacy == model.PrivacyPrivate {
-			return true
-		}
-
-		if v.State != model.NoteStatePublished {
-			return true
-		}
-
-		return false
+		return v.State != model.NoteStatePublished
 	})
 
 	nns := &model.Notes{Items: xmap.Values(pNotes)}
@@ -183,7 +206,14 @@ func (s *NoteFeedSrv) BatchGetNoteDetail(ctx context.Context, noteIds []int64) (
 		return nil, xerror.Wrapf(err, "assemble notes ext failed").WithCtx(ctx)
 	}
 
+	// 过滤掉对请求者不可见的笔记
+	nns.Items, err = s.noteBiz.FilterVisibleNotes(ctx, operator, nns.Items)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "filter visible notes failed").WithCtx(ctx)
+	}
+
 	nns, _ = s.noteInteractBiz.AssignNoteLikes(ctx, nns)
+	nns, _ = s.noteInteractBiz.AssignNoteViews(ctx, nns)
 	nns, _ = s.noteInteractBiz.AssignNoteReplies(ctx, nns)
 
 	resp := make(map[int64]*model.Note, len(nns.Items))
@@ -202,21 +232,23 @@ func (s *NoteFeedSrv) GetUserRecentNotes(ctx context.Context, user int64, maxCou
 	}
 
 	result, _ = s.noteInteractBiz.AssignNoteLikes(ctx, result)
+	result, _ = s.noteInteractBiz.AssignNoteViews(ctx, result)
 	result, _ = s.noteInteractBiz.AssignNoteReplies(ctx, result)
 
 	return result, nil
 }
 
-// 列出用户公开的笔记
+// 列出用户对请求者可见的笔记
 func (s *NoteFeedSrv) ListUserPublicNotes(ctx context.Context, user int64, cursor int64, count int32) (*model.Notes,
 	model.PageResult, error,
 ) {
-	result, page, err := s.noteBiz.ListUserPublicNote(ctx, user, cursor, count)
+	result, page, err := s.noteBiz.ListUserVisibleNote(ctx, user, metadata.Uid(ctx), cursor, count)
 	if err != nil {
 		return nil, page, xerror.Wrapf(err, "feed srv failed to lsit user public note")
 	}
 
 	result, _ = s.noteInteractBiz.AssignNoteLikes(ctx, result)
+	result, _ = s.noteInteractBiz.AssignNoteViews(ctx, result)
 	result, _ = s.noteInteractBiz.AssignNoteReplies(ctx, result)
 	return result, page, nil
 }
@@ -246,14 +278,40 @@ func (s *NoteFeedSrv) BatchCheckNoteExistence(ctx context.Context, noteIds []int
 		return nil, xerror.Wrapf(err, "note biz failed to batch get")
 	}
 
-	result := make(map[int64]bool, len(notes))
-	for _, noteId := range noteIds {
-		if target, ok := notes[noteId]; ok {
-			// 如果笔记存在，公开笔记所有人都可访问，私有笔记只有所有者可访问
-			result[noteId] = target.Privacy != model.PrivacyPrivate || target.Owner == reqUid
-		} else {
-			result[noteId] = false
+	return checkNoteExistence(noteIds, xmap.Values(notes), func(candidates []*model.Note) ([]*model.Note, error) {
+		visible, err := s.noteBiz.FilterVisibleNotes(ctx, reqUid, candidates)
+		if err != nil {
+			return nil, xerror.Wrapf(err, "note biz failed to filter visible notes")
 		}
+		return visible, nil
+	})
+}
+
+// 笔记存在 不在回收站中 并且对请求者可见
+func checkNoteExistence(
+	noteIds []int64,
+	notes []*model.Note,
+	filterVisible func([]*model.Note) ([]*model.Note, error),
+) (map[int64]bool, error) {
+	// 软删除后笔记仍然留在表中 回收站中的笔记视为已删除
+	candidates := xslice.Filter(notes, func(_ int, n *model.Note) bool {
+		return model.IsNoteStateConsideredAsRecycled(n.State)
+	})
+
+	visible, err := filterVisible(candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	visibleIds := make(map[int64]struct{}, len(visible))
+	for _, n := range visible {
+		visibleIds[n.NoteId] = struct{}{}
+	}
+
+	result := make(map[int64]bool, len(noteIds))
+	for _, noteId := range noteIds {
+		_, ok := visibleIds[noteId]
+		result[noteId] = ok
 	}
 
 	return result, nil
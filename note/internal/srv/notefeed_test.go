@@ -0,0 +1,50 @@
+package srv
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ryanreadbooks/whimer/note/internal/model"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCheckNoteExistence(t *testing.T) {
+	Convey("checkNoteExistence", t, func() {
+		notes := []*model.Note{
+			{NoteId: 1, Owner: 100, State: model.NoteStatePublished, Privacy: model.PrivacyPublic},
+			{NoteId: 2, Owner: 100, State: model.NoteStateRecycled, Privacy: model.PrivacyPublic},
+			{NoteId: 3, Owner: 100, State: model.NoteStatePublished, Privacy: model.PrivacyFollowers},
+		}
+		// 未登录的浏览者
+		anonymous := func(candidates []*model.Note) ([]*model.Note, error) {
+			visible := make([]*model.Note, 0, len(candidates))
+			for _, n := range candidates {
+				if n.IsVisibleTo(0, model.ViewerRelation{}) {
+					visible = append(visible, n)
+				}
+			}
+			return visible, nil
+		}
+
+		result, err := checkNoteExistence([]int64{1, 2, 3, 4}, notes, anonymous)
+		So(err, ShouldBeNil)
+		So(result, ShouldResemble, map[int64]bool{1: true, 2: false, 3: false, 4: false})
+
+		// 回收站中的笔记对作者也视为已删除
+		var filtered []int64
+		_, err = checkNoteExistence([]int64{2}, notes, func(candidates []*model.Note) ([]*model.Note, error) {
+			for _, n := range candidates {
+				filtered = append(filtered, n.NoteId)
+			}
+			return candidates, nil
+		})
+		So(err, ShouldBeNil)
+		So(filtered, ShouldNotContain, int64(2))
+
+		_, err = checkNoteExistence([]int64{1}, notes, func([]*model.Note) ([]*model.Note, error) {
+			return nil, errors.New("filter failed")
+		})
+		So(err, ShouldNotBeNil)
+	})
+}
@@ -12,6 +12,7 @@ import (
 	notev1 "github.com/ryanreadbooks/whimer/idl/gen/go/note/api/v1"
 
 	"github.com/ryanreadbooks/whimer/misc/metadata"
+	"github.com/ryanreadbooks/whimer/misc/xconv"
 	"github.com/ryanreadbooks/whimer/misc/xerror"
 	"github.com/ryanreadbooks/whimer/misc/xlog"
 )
@@ -51,8 +52,12 @@ func (s *NoteInteractSrv) LikeNote(ctx context.Context, in *notev1.LikeNoteReque
 		return nil, xerror.Wrapf(err, "get public note failed").WithExtra("noteId", in.NoteId).WithCtx(ctx)
 	}
 
-	// 非公开笔记 其它人不能点赞
-	if note.Privacy != model.PrivacyPublic && opUid != note.Owner {
+	// 不可见的笔记 其它人不能点赞
+	visible, err := s.noteBiz.IsNoteVisible(ctx, opUid, note)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "check note visible failed").WithExtra("noteId", in.NoteId).WithCtx(ctx)
+	}
+	if !visible {
 		return nil, global.ErrNoteNotPublic
 	}
 
@@ -89,7 +94,11 @@ func (s *NoteInteractSrv) GetNoteLikes(ctx context.Context, noteId int64) (int64
 		return 0, xerror.Wrapf(err, "get public note failed").WithExtra("noteId", noteId).WithCtx(ctx)
 	}
 
-	if note.Privacy != model.PrivacyPublic && uid != note.Owner {
+	visible, err := s.noteBiz.IsNoteVisible(ctx, uid, note)
+	if err != nil {
+		return 0, xerror.Wrapf(err, "check note visible failed").WithExtra("noteId", noteId).WithCtx(ctx)
+	}
+	if !visible {
 		return 0, global.ErrNoteNotPublic
 	}
 
@@ -116,13 +125,17 @@ func (s *NoteInteractSrv) BatchCheckUserLikeStatus(ctx context.Context, req map[
 func (s *NoteInteractSrv) GetNoteInteraction(ctx context.Context, noteId int64) (*model.UserInteraction, error) {
 	uid := metadata.Uid(ctx)
 
-	// 非公开笔记非作者不能获取互动状态
+	// 不可见的笔记不能获取互动状态
 	note, err := s.noteBiz.GetNote(ctx, noteId)
 	if err != nil {
 		return nil, xerror.Wrapf(err, "get public note failed").WithExtra("noteId", noteId).WithCtx(ctx)
 	}
 
-	if note.Privacy != model.PrivacyPublic && uid != note.Owner {
+	visible, err := s.noteBiz.IsNoteVisible(ctx, uid, note)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "check note visible failed").WithExtra("noteId", noteId).WithCtx(ctx)
+	}
+	if !visible {
 		return nil, global.ErrNoteNotPublic
 	}
 
@@ -131,7 +144,40 @@ func (s *NoteInteractSrv) GetNoteInteraction(ctx context.Context, noteId int64)
 		return nil, xerror.Wrapf(err, "common check user like status failed")
 	}
 
-	return &model.UserInteraction{Liked: liked}, nil
+	// 浏览量获取失败不影响交互状态
+	views, err := s.noteInteractBiz.BatchGetNoteViews(ctx, []int64{noteId})
+	if err != nil {
+		xlog.Msg("srv get note views failed").Err(err).Extra("noteId", noteId).Errorx(ctx)
+	}
+
+	return &model.UserInteraction{Liked: liked, Views: views[noteId]}, nil
+}
+
+// 上报浏览过的笔记 未登录用户使用客户端ip去重
+func (s *NoteInteractSrv) ReportNoteViews(ctx context.Context, noteIds []int64) error {
+	viewer := getNoteViewer(ctx)
+	if viewer == "" {
+		return nil
+	}
+
+	_, err := s.noteInteractBiz.ReportNoteViews(ctx, metadata.Uid(ctx), viewer, noteIds)
+	if err != nil {
+		return xerror.Wrapf(err, "srv report note views failed").WithExtra("noteIds", noteIds).WithCtx(ctx)
+	}
+
+	return nil
+}
+
+func getNoteViewer(ctx context.Context) string {
+	if uid := metadata.Uid(ctx); uid != 0 {
+		return xconv.FormatInt(uid)
+	}
+
+	if ip := metadata.ClientIp(ctx); ip != "" {
+		return "ip:" + ip
+	}
+
+	return ""
 }
 
 // 列出用户点赞过的笔记
@@ -50,7 +50,17 @@ func (p *AssetProcedure) Type() model.ProcedureType {
 func (p *AssetProcedure) BeforeExecute(ctx context.Context, param *ProcedureParam) (bool, error) {
 	note := param.Note
 	// 如果是笔记更新场景 不需要更新资源key的情况下不需要重走资源流程
-	if note.State == model.NoteStateInit {
+	if model.IsNoteStateBeforeProcessing(note.State) {
+		// 草稿和定时发布的笔记需要先取出草稿信息
+		if note.State != model.NoteStateInit {
+			err := p.takeDraft(ctx, note)
+			if err != nil {
+				return false, xerror.Wrapf(err, "asset procedure take note draft failed").
+					WithExtra("note_id", note.NoteId).
+					WithCtx(ctx)
+			}
+		}
+
 		err := p.noteCreatorBiz.TransferNoteStateToProcessing(ctx, note)
 		if err != nil {
 			return false, xerror.Wrapf(err, "asset procedure set note state processing failed").
@@ -63,6 +73,18 @@ func (p *AssetProcedure) BeforeExecute(ctx context.Context, param *ProcedurePara
 	return false, nil
 }
 
+// 取出草稿信息 视频笔记需要恢复原始视频资源
+func (p *AssetProcedure) takeDraft(ctx context.Context, note *model.Note) error {
+	if note.Type == model.AssetTypeVideo && note.Videos == nil {
+		err := p.noteCreatorBiz.LoadDraftVideo(ctx, note)
+		if err != nil {
+			return err
+		}
+	}
+
+	return p.noteCreatorBiz.DeleteDraft(ctx, note.NoteId)
+}
+
 func (p *AssetProcedure) Execute(ctx context.Context, param *ProcedureParam) (string, error) {
 	note := param.Note
 	if note.Type == model.AssetTypeVideo {
@@ -3,7 +3,9 @@ package procedure
 import (
 	"context"
 	"errors"
+	"time"
 
+	"github.com/ryanreadbooks/whimer/misc/uuid"
 	"github.com/ryanreadbooks/whimer/misc/xerror"
 	"github.com/ryanreadbooks/whimer/misc/xlog"
 	"github.com/ryanreadbooks/whimer/note/internal/biz"
@@ -12,22 +14,38 @@ import (
 )
 
 var (
-	_ Procedure     = (*AuditProcedure)(nil)
-	_ AutoCompleter = (*AuditProcedure)(nil) // TODO audit未实现 这里先自动成功
+	_ Procedure          = (*AuditProcedure)(nil)
+	_ AutoCompleter      = (*AuditProcedure)(nil)
+	_ ProcedureScheduler = (*AuditProcedure)(nil)
+)
+
+const (
+	// 人工复审耗时较长 流程过期时间需要覆盖复审时间
+	auditExpireTTL = 7 * 24 * time.Hour
+	// 人工复审中的流程轮询间隔
+	auditReviewPollInterval = 10 * time.Minute
+	// 机审失败转人工复审时记录的原因
+	auditMachineFailedReason = "机审失败 转人工复审"
 )
 
 // 笔记审核
 //
-// 流水线中资源处理完成后进入审核流程
+// 流水线中资源处理完成后进入审核流程;
+// 机审在本地同步完成 结果保存后由自动完成或者后台轮询流转笔记状态,
+// 需要人工复审的笔记停留在复审状态 由人工复审结束后继续流程
 type AuditProcedure struct {
-	noteBiz        *biz.NoteBiz
-	noteCreatorBiz *biz.NoteCreatorBiz
+	noteBiz          *biz.NoteBiz
+	noteCreatorBiz   *biz.NoteCreatorBiz
+	noteAuditBiz     *biz.NoteAuditBiz
+	noteProcedureBiz *biz.NoteProcedureBiz
 }
 
 func NewAuditProcedure(bizz *biz.Biz) *AuditProcedure {
 	return &AuditProcedure{
-		noteBiz:        bizz.Note,
-		noteCreatorBiz: bizz.Creator,
+		noteBiz:          bizz.Note,
+		noteCreatorBiz:   bizz.Creator,
+		noteAuditBiz:     bizz.Audit,
+		noteProcedureBiz: bizz.Procedure,
 	}
 }
 
@@ -45,8 +63,11 @@ func (p *AuditProcedure) BeforeExecute(ctx context.Context, param *ProcedurePara
 			WithCtx(ctx)
 	}
 
-	// TODO 后续接入审核系统时返回 true
-	return false, nil
+	return true, nil
+}
+
+func (p *AuditProcedure) ScheduleAt(param *ProcedureParam) (int64, time.Duration) {
+	return 0, auditExpireTTL
 }
 
 // 返回的note只有basic信息 没有额外信息
@@ -80,8 +101,41 @@ func (p *AuditProcedure) upgradeStateCheck(
 }
 
 func (p *AuditProcedure) Execute(ctx context.Context, param *ProcedureParam) (string, error) {
-	// TODO 调用审核系统
-	return "", nil
+	return p.doAudit(ctx, param.Note.NoteId)
+}
+
+// 机审并保存结果 需要人工复审时笔记进入复审状态
+func (p *AuditProcedure) doAudit(ctx context.Context, noteId int64) (string, error) {
+	audit, err := p.noteAuditBiz.Moderate(ctx, noteId)
+	if err != nil {
+		return "", xerror.Wrapf(err, "audit procedure moderate failed").
+			WithExtra("note_id", noteId).
+			WithCtx(ctx)
+	}
+
+	taskId := uuid.NewUUID().String()
+	audit.TaskId = taskId
+	err = p.noteAuditBiz.SaveAudit(ctx, audit)
+	if err != nil {
+		return "", xerror.Wrapf(err, "audit procedure save audit failed").
+			WithExtra("note_id", noteId).
+			WithCtx(ctx)
+	}
+
+	if audit.Decision == model.AuditDecisionReview {
+		err = p.noteCreatorBiz.TransferNoteStateToAuditReviewing(ctx, &model.Note{NoteId: noteId})
+		if err != nil {
+			return "", xerror.Wrapf(err, "audit procedure set note state reviewing failed").
+				WithExtra("note_id", noteId).
+				WithCtx(ctx)
+		}
+	}
+
+	xlog.Msgf("audit procedure moderate completed").
+		Extras("note_id", noteId, "task_id", taskId, "decision", audit.Decision.String(), "reason", audit.Reason).
+		Infox(ctx)
+
+	return taskId, nil
 }
 
 func (p *AuditProcedure) OnSuccess(ctx context.Context, result *ProcedureResult) (bool, error) {
@@ -106,8 +160,13 @@ func (p *AuditProcedure) OnSuccess(ctx context.Context, result *ProcedureResult)
 	return true, nil
 }
 
-// TODO 失败是审核失败 不是审核不通过
+// 审核不通过时Arg为审核结果;
+// Arg为nil时表示机审本身失败(重试次数耗尽或者过期) 此时转人工复审
 func (p *AuditProcedure) OnFailure(ctx context.Context, result *ProcedureResult) (bool, error) {
+	if _, ok := result.Arg.(*model.NoteAudit); !ok {
+		return p.onMachineFailure(ctx, result)
+	}
+
 	// 审核不通过 设置状态为 Rejected
 	note, abort := p.upgradeStateCheck(ctx, result.NoteId, model.NoteStateRejected)
 	if abort {
@@ -129,24 +188,106 @@ func (p *AuditProcedure) OnFailure(ctx context.Context, result *ProcedureResult)
 	return true, nil
 }
 
+func (p *AuditProcedure) onMachineFailure(ctx context.Context, result *ProcedureResult) (bool, error) {
+	note, abort := p.upgradeStateCheck(ctx, result.NoteId, model.NoteStateAuditReviewing)
+	if abort {
+		return true, nil
+	}
+
+	if note == nil {
+		note = &model.Note{
+			NoteId: result.NoteId,
+		}
+	}
+
+	err := p.noteAuditBiz.SaveAudit(ctx, &model.NoteAudit{
+		NoteId:   result.NoteId,
+		TaskId:   result.TaskId,
+		Decision: model.AuditDecisionReview,
+		Reason:   auditMachineFailedReason,
+		Operator: model.AuditOperatorMachine,
+	})
+	if err != nil {
+		return false, xerror.Wrapf(err, "audit procedure save audit failed").
+			WithExtra("note_id", result.NoteId).
+			WithCtx(ctx)
+	}
+
+	err = p.noteCreatorBiz.TransferNoteStateToAuditReviewing(ctx, note)
+	if err != nil {
+		return false, xerror.Wrapf(err, "audit procedure set note state reviewing failed").
+			WithExtra("note_id", result.NoteId).
+			WithCtx(ctx)
+	}
+
+	return true, nil
+}
+
 func (p *AuditProcedure) OnAbort(ctx context.Context, note *model.Note, taskId string) error {
 	return nil
 }
 
 func (p *AuditProcedure) PollResult(ctx context.Context, record *biz.ProcedureRecord) (PollState, any, error) {
-	// TODO 轮询审核结果
-	return PollStateSuccess, nil, nil
+	audit, err := p.noteAuditBiz.GetAudit(ctx, record.NoteId)
+	if err != nil {
+		if errors.Is(err, global.ErrNoteAuditNotFound) {
+			return PollStateFailure, nil, nil
+		}
+		return PollStateRunning, nil, xerror.Wrapf(err, "audit procedure get audit failed").
+			WithExtra("note_id", record.NoteId).
+			WithCtx(ctx)
+	}
+
+	switch audit.Decision {
+	case model.AuditDecisionPass:
+		return PollStateSuccess, audit, nil
+	case model.AuditDecisionReject:
+		return PollStateFailure, audit, nil
+	}
+
+	// 人工复审中 降低轮询频率
+	record.NextCheckTime = time.Now().Add(auditReviewPollInterval).Unix()
+	err = p.noteProcedureBiz.UpdateTaskIdRetryNextCheckTime(ctx, record)
+	if err != nil {
+		xlog.Msg("audit procedure update next check time failed").
+			Err(err).
+			Extras("record_id", record.Id, "note_id", record.NoteId).
+			Errorx(ctx)
+	}
+
+	return PollStateRunning, nil, nil
 }
 
 func (p *AuditProcedure) Retry(ctx context.Context, record *biz.ProcedureRecord) (string, error) {
-	// TODO 重试审核
-	return "", nil
+	return p.doAudit(ctx, record.NoteId)
 }
 
+// 机审有明确结论时直接完成 需要人工复审时等待复审结束
 func (p *AuditProcedure) AutoComplete(
 	ctx context.Context,
 	param *ProcedureParam,
 	taskId string,
 ) (success, autoComplete bool, arg any) {
-	return true, true, nil
+	audit, err := p.noteAuditBiz.GetAudit(ctx, param.Note.NoteId)
+	if err != nil {
+		// 后台轮询时再处理
+		xlog.Msg("audit procedure get audit failed when auto complete").
+			Err(err).
+			Extras("note_id", param.Note.NoteId, "task_id", taskId).
+			Errorx(ctx)
+		return false, false, nil
+	}
+
+	if audit.TaskId != taskId {
+		return false, false, nil
+	}
+
+	switch audit.Decision {
+	case model.AuditDecisionPass:
+		return true, true, audit
+	case model.AuditDecisionReject:
+		return false, true, audit
+	}
+
+	return false, false, nil
 }
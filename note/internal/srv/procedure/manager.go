@@ -74,12 +74,12 @@ func NewManager(c *config.Config, bizz *biz.Biz) (*Manager, error) {
 
 	// 流程实现注册 后续有新增的流程都需要注册在这里
 	registry := NewRegistry()
+	registry.Register(NewScheduleProcedure(bizz))
 	registry.Register(NewAssetProcedure(bizz))
 	registry.Register(NewAuditProcedure(bizz))
 	registry.Register(NewPublishProcedure(bizz))
 
 	txHelper := newTxHelper(bizz)
-	retryHelper := newRetryHelper2(bizz, txHelper)
 	m := &Manager{
 		c: c,
 
@@ -90,12 +90,12 @@ func NewManager(c *config.Config, bizz *biz.Biz) (*Manager, error) {
 		noteBiz:          bizz.Note,
 		noteProcedureBiz: bizz.Procedure,
 		noteCreatorBiz:   bizz.Creator,
-		retryHelper:      retryHelper,
 		txHelper:         txHelper,
 
 		pools:  pools,
 		quitCh: make(chan struct{}),
 	}
+	m.retryHelper = newRetryHelper2(bizz, txHelper, m.proceedNext)
 
 	// 初始化正常流水线
 	standardPipeline, err := innerStandardPipeline(m)
@@ -118,6 +118,7 @@ func (m *Manager) selectPool(protype model.ProcedureType) *ants.Pool {
 type RunPipelineParam struct {
 	Note       *model.Note
 	StartStage pipelineStage
+	PublishAt  int64 // 定时发布时间 仅从定时发布流程开始时需要
 }
 
 // 从某个流程节点开始运行流水线
@@ -204,7 +205,8 @@ func (m *Manager) CreateRecord(
 	}
 
 	procParam := &ProcedureParam{
-		Note: param.Note,
+		Note:      param.Note,
+		PublishAt: param.PublishAt,
 	}
 
 	// 流程初始化
@@ -225,13 +227,18 @@ func (m *Manager) CreateRecord(
 	}
 
 	// taskId 先留空后续再填充
-	err = m.noteProcedureBiz.CreateRecord(ctx, &biz.CreateProcedureRecordReq{
+	recordReq := &biz.CreateProcedureRecordReq{
 		NoteId:      param.Note.NoteId,
 		Protype:     protype,
 		TaskId:      "",
 		MaxRetryCnt: maxRetryCnt,
 		Params:      params,
-	})
+	}
+	if scheduler, ok := any(proc).(ProcedureScheduler); ok {
+		recordReq.NextCheckTime, recordReq.ExpiredTTL = scheduler.ScheduleAt(procParam)
+	}
+
+	err = m.noteProcedureBiz.CreateRecord(ctx, recordReq)
 	if err != nil {
 		return nil, nil, xerror.Wrapf(err, "procedure manager create record failed").
 			WithExtra("note_id", param.Note.NoteId).
@@ -385,7 +392,13 @@ func (m *Manager) handleSuccess(ctx context.Context, result *CompleteResult, pro
 		return xerror.Wrap(err)
 	}
 
-	// 成功后需要将流水线流转到下一个流程
+	m.proceedNext(ctx, result)
+
+	return nil
+}
+
+// 成功后需要将流水线流转到下一个流程
+func (m *Manager) proceedNext(ctx context.Context, result *CompleteResult) {
 	nextProcType := m.pipeline.nextOf(result.Protype)
 	if nextProcType != "" {
 		// 启动流水线的下一个流程
@@ -416,8 +429,6 @@ func (m *Manager) handleSuccess(ctx context.Context, result *CompleteResult, pro
 			},
 		})
 	}
-
-	return nil
 }
 
 func (m *Manager) CompleteAssetSuccess(ctx context.Context, noteId int64, taskId string, arg any) error {
@@ -529,6 +540,11 @@ func (m *Manager) scanAndRetry(ctx context.Context) {
 		return
 	}
 
+	// 最早的记录也还没到本轮的扫描范围 比如发布时间还很远的定时发布
+	if record.NextCheckTime > time.Now().Unix()+futureSec {
+		return
+	}
+
 	timeStart := record.NextCheckTime
 	// 取整到能被slotGapSec整除
 	timeStart = timeStart - timeStart%int64(slotGapSec)
@@ -12,6 +12,10 @@ import (
 type pipelineStage = model.ProcedureType
 
 // 流水线开始位置
+func StartAtSchedule() pipelineStage {
+	return model.ProcedureTypeSchedule
+}
+
 func StartAtAssetProcess() pipelineStage {
 	return model.ProcedureTypeAssetProcess
 }
@@ -108,9 +112,12 @@ func (b *pipelineAssembler) assemble() (*pipeline, error) {
 
 // 标准流水线
 //
-// 标准笔记发布流程: 资源处理 -> 审核 -> 发布
+// 标准笔记发布流程: (定时发布) -> 资源处理 -> 审核 -> 发布
+//
+// 非定时发布的笔记直接从资源处理开始
 func innerStandardPipeline(mgr *Manager) (*pipeline, error) {
 	return newPipelineAssembler(mgr).
+		addProcedure(model.ProcedureTypeSchedule).
 		addProcedure(model.ProcedureTypeAssetProcess).
 		addProcedure(model.ProcedureTypeAudit). // TODO 审核暂未实现
 		addProcedure(model.ProcedureTypePublish).
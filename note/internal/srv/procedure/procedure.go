@@ -3,6 +3,7 @@ package procedure
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/ryanreadbooks/whimer/note/internal/biz"
 	"github.com/ryanreadbooks/whimer/note/internal/model"
@@ -26,7 +27,8 @@ type ProcedureResult struct {
 }
 
 type ProcedureParam struct {
-	Note *model.Note
+	Note      *model.Note
+	PublishAt int64 // 定时发布时间 unix秒
 }
 
 // Procedure 流程处理器接口
@@ -104,3 +106,11 @@ type ProcedureParamProvider interface {
 	// 参数的序列化和反序列化由各流程自行负责
 	Provide(param *ProcedureParam) []byte
 }
+
+// 实现此接口以自定义本地流程记录的首次检查时间
+//
+// 用于需要等待到指定时间才继续的流程 比如定时发布
+type ProcedureScheduler interface {
+	// 返回首次检查时间(unix秒)和流程的过期时长
+	ScheduleAt(param *ProcedureParam) (checkTime int64, expiredTTL time.Duration)
+}
@@ -98,7 +98,7 @@ func (p *PublishProcedure) doPublish(ctx context.Context, note *model.Note) (str
 // 广播笔记发布事件
 //
 // 根据当前笔记的 privacy 状态决定发布或删除事件:
-//   - 公开笔记 -> 发布 NotePublished 事件
+//   - 公开和部分用户可见的笔记 -> 发布 NotePublished 事件 事件中携带可见范围
 //   - 私有笔记 -> 发布 NoteDeleted 事件
 func (p *PublishProcedure) Execute(ctx context.Context, param *ProcedureParam) (string, error) {
 	note := param.Note
@@ -106,7 +106,7 @@ func (p *PublishProcedure) Execute(ctx context.Context, param *ProcedureParam) (
 	return p.doExecute(ctx, note, pubDeleted)
 }
 
-// 消息队列信息发送成功
+// 事件已写入发件箱 由发件箱投递到消息队列
 func (p *PublishProcedure) OnSuccess(ctx context.Context, result *ProcedureResult) (bool, error) {
 	// 简单记录
 	xlog.Msgf("publish procedure on success completed, note(%d) is published to events", result.NoteId).
@@ -20,11 +20,16 @@ type retryPollResultFunc func(ctx context.Context, record *biz.ProcedureRecord)
 
 type onCompleteFunc func(ctx context.Context, result *ProcedureResult) (bool, error)
 
+// 流程成功后流转到下一个流程
+type proceedNextFunc func(ctx context.Context, result *CompleteResult)
+
 // procedure的通用重试逻辑
 type retryHelper struct {
 	txHelper         *txHelper
 	noteBiz          *biz.NoteBiz
 	noteProcedureBiz *biz.NoteProcedureBiz
+
+	proceedNext proceedNextFunc
 }
 
 func newRetryHelper(bizz *biz.Biz) *retryHelper {
@@ -35,11 +40,12 @@ func newRetryHelper(bizz *biz.Biz) *retryHelper {
 	}
 }
 
-func newRetryHelper2(bizz *biz.Biz, txHelper *txHelper) *retryHelper {
+func newRetryHelper2(bizz *biz.Biz, txHelper *txHelper, proceedNext proceedNextFunc) *retryHelper {
 	return &retryHelper{
 		txHelper:         txHelper,
 		noteBiz:          bizz.Note,
 		noteProcedureBiz: bizz.Procedure,
+		proceedNext:      proceedNext,
 	}
 }
 
@@ -98,7 +104,14 @@ func (h *retryHelper) pollAndComplete(
 
 	switch state {
 	case PollStateSuccess:
-		return h.txHelper.txHandleSuccess(ctx, result, onSuccess)
+		if err := h.txHelper.txHandleSuccess(ctx, result, onSuccess); err != nil {
+			return err
+		}
+		// 轮询到成功也需要继续流水线的后续流程
+		if h.proceedNext != nil {
+			h.proceedNext(ctx, result)
+		}
+		return nil
 	case PollStateFailure:
 		return h.txHelper.txHandleFailure(ctx, result, onFailure)
 	default:
@@ -0,0 +1,169 @@
+package procedure
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ryanreadbooks/whimer/misc/uuid"
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xlog"
+	"github.com/ryanreadbooks/whimer/note/internal/biz"
+	"github.com/ryanreadbooks/whimer/note/internal/global"
+	"github.com/ryanreadbooks/whimer/note/internal/model"
+)
+
+var (
+	_ Procedure          = (*ScheduleProcedure)(nil)
+	_ AutoCompleter      = (*ScheduleProcedure)(nil)
+	_ ProcedureScheduler = (*ScheduleProcedure)(nil)
+)
+
+// 超过发布时间后仍未进入后续流程的定时发布视为失败
+const scheduleExpireBuffer = time.Hour
+
+// 定时发布
+//
+// 流水线中资源处理之前的等待流程 流程记录的首次检查时间即为发布时间,
+// 由后台扫描在发布时间到达后轮询成功 随后流转到资源处理流程
+type ScheduleProcedure struct {
+	noteBiz          *biz.NoteBiz
+	noteCreatorBiz   *biz.NoteCreatorBiz
+	noteProcedureBiz *biz.NoteProcedureBiz
+}
+
+func NewScheduleProcedure(bizz *biz.Biz) *ScheduleProcedure {
+	return &ScheduleProcedure{
+		noteBiz:          bizz.Note,
+		noteCreatorBiz:   bizz.Creator,
+		noteProcedureBiz: bizz.Procedure,
+	}
+}
+
+func (p *ScheduleProcedure) Type() model.ProcedureType {
+	return model.ProcedureTypeSchedule
+}
+
+func (p *ScheduleProcedure) BeforeExecute(ctx context.Context, param *ProcedureParam) (bool, error) {
+	note := param.Note
+	err := p.noteCreatorBiz.TransferNoteStateToScheduled(ctx, note)
+	if err != nil {
+		return false, xerror.Wrapf(err, "schedule procedure set note state scheduled failed").
+			WithExtra("note_id", note.NoteId).
+			WithCtx(ctx)
+	}
+
+	return true, nil
+}
+
+func (p *ScheduleProcedure) ScheduleAt(param *ProcedureParam) (int64, time.Duration) {
+	ttl := time.Until(time.Unix(param.PublishAt, 0)) + scheduleExpireBuffer
+	return param.PublishAt, max(ttl, scheduleExpireBuffer)
+}
+
+// 定时发布没有远程调用 taskId仅用于标识流程已经登记 后台扫描时轮询结果
+func (p *ScheduleProcedure) Execute(ctx context.Context, param *ProcedureParam) (string, error) {
+	return uuid.NewUUID().String(), nil
+}
+
+func (p *ScheduleProcedure) OnSuccess(ctx context.Context, result *ProcedureResult) (bool, error) {
+	// 笔记状态由资源处理流程负责流转
+	xlog.Msgf("schedule procedure on success completed, note(%d) is due to publish", result.NoteId).
+		Extras("note_id", result.NoteId, "task_id", result.TaskId).
+		Infox(ctx)
+
+	return true, nil
+}
+
+// 定时发布失败时笔记退回草稿 由作者重新发布
+func (p *ScheduleProcedure) OnFailure(ctx context.Context, result *ProcedureResult) (bool, error) {
+	note, err := p.noteBiz.GetNoteCoreWithoutCache(ctx, result.NoteId)
+	if err != nil {
+		if errors.Is(err, global.ErrNoteNotFound) {
+			return true, nil
+		}
+		return false, xerror.Wrapf(err, "schedule procedure get note failed").
+			WithExtra("note_id", result.NoteId).
+			WithCtx(ctx)
+	}
+
+	if !model.IsNoteStateConsideredAsScheduled(note.State) {
+		return true, nil
+	}
+
+	err = p.noteCreatorBiz.TransferNoteStateToDraft(ctx, note)
+	if err != nil {
+		return false, xerror.Wrapf(err, "schedule procedure set note state draft failed").
+			WithExtra("note_id", result.NoteId).
+			WithCtx(ctx)
+	}
+
+	xlog.Msgf("schedule procedure on failure completed, note(%d) is back to draft", result.NoteId).
+		Extras("note_id", result.NoteId, "task_id", result.TaskId).
+		Infox(ctx)
+
+	return true, nil
+}
+
+func (p *ScheduleProcedure) OnAbort(ctx context.Context, note *model.Note, taskId string) error {
+	return nil
+}
+
+func (p *ScheduleProcedure) PollResult(ctx context.Context, record *biz.ProcedureRecord) (PollState, any, error) {
+	note, err := p.noteBiz.GetNoteCoreWithoutCache(ctx, record.NoteId)
+	if err != nil {
+		if errors.Is(err, global.ErrNoteNotFound) {
+			return PollStateFailure, nil, nil
+		}
+		return PollStateRunning, nil, xerror.Wrapf(err, "schedule procedure get note failed").
+			WithExtra("note_id", record.NoteId).
+			WithCtx(ctx)
+	}
+
+	// 已经不在等待定时发布
+	if !model.IsNoteStateConsideredAsScheduled(note.State) {
+		return PollStateFailure, nil, nil
+	}
+
+	publishAt, err := p.noteCreatorBiz.GetDraftPublishAt(ctx, record.NoteId)
+	if err != nil {
+		return PollStateRunning, nil, xerror.Wrapf(err, "schedule procedure get publish at failed").
+			WithExtra("note_id", record.NoteId).
+			WithCtx(ctx)
+	}
+
+	if publishAt <= time.Now().Unix() {
+		return PollStateSuccess, nil, nil
+	}
+
+	// 重试时下次检查时间被提前了 需要重新对齐到发布时间 避免被反复扫描
+	if record.NextCheckTime != publishAt {
+		record.NextCheckTime = publishAt
+		err = p.noteProcedureBiz.UpdateTaskIdRetryNextCheckTime(ctx, record)
+		if err != nil {
+			xlog.Msg("schedule procedure update next check time failed").
+				Err(err).
+				Extras("record_id", record.Id, "note_id", record.NoteId).
+				Errorx(ctx)
+		}
+	}
+
+	return PollStateRunning, nil, nil
+}
+
+func (p *ScheduleProcedure) Retry(ctx context.Context, record *biz.ProcedureRecord) (string, error) {
+	return uuid.NewUUID().String(), nil
+}
+
+// 发布时间已到时直接完成
+func (p *ScheduleProcedure) AutoComplete(
+	ctx context.Context,
+	param *ProcedureParam,
+	taskId string,
+) (success, autoComplete bool, arg any) {
+	if param.PublishAt <= time.Now().Unix() {
+		return true, true, nil
+	}
+
+	return false, false, nil
+}
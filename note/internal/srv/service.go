@@ -13,12 +13,15 @@ type Service struct {
 	rootCtx    context.Context
 	rootCancel context.CancelFunc
 	c          *config.Config
+	dt         *data.Data
 
 	// domain service
-	NoteCreatorSrv   *NoteCreatorSrv
-	NoteFeedSrv      *NoteFeedSrv
-	NoteInteractSrv  *NoteInteractSrv
-	NoteProcedureSrv *NoteProcedureSrv
+	NoteCreatorSrv    *NoteCreatorSrv
+	NoteFeedSrv       *NoteFeedSrv
+	NoteInteractSrv   *NoteInteractSrv
+	NoteProcedureSrv  *NoteProcedureSrv
+	NoteAuditSrv      *NoteAuditSrv
+	NoteCollectionSrv *NoteCollectionSrv
 }
 
 // 初始化一个service
@@ -28,6 +31,7 @@ func MustNewService(c *config.Config, bizz *biz.Biz, dt *data.Data) *Service {
 		rootCtx:    rootCtx,
 		rootCancel: rootCancel,
 		c:          c,
+		dt:         dt,
 	}
 
 	// 笔记发布流程管理
@@ -41,14 +45,18 @@ func MustNewService(c *config.Config, bizz *biz.Biz, dt *data.Data) *Service {
 	s.NoteFeedSrv = NewNoteFeedSrv(s, bizz, dt)
 	s.NoteInteractSrv = NewNoteInteractSrv(s, bizz)
 	s.NoteProcedureSrv = NewNoteProcedureSrv(c, bizz, procedureMgr)
+	s.NoteAuditSrv = NewNoteAuditSrv(s, bizz, procedureMgr)
+	s.NoteCollectionSrv = NewNoteCollectionSrv(s, bizz)
 
 	return s
 }
 
 func (s *Service) Start() {
+	s.dt.StartOutboxRelay(s.rootCtx)
 	s.NoteProcedureSrv.goStartBackgroundHandle(s.rootCtx)
 }
 
 func (s *Service) Stop() {
 	s.NoteProcedureSrv.StopBackgroundHandle()
+	s.dt.StopOutboxRelay()
 }
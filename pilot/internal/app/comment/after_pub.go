@@ -6,7 +6,10 @@ import (
 	"errors"
 
 	"github.com/ryanreadbooks/whimer/pilot/internal/app/comment/dto"
+	"github.com/ryanreadbooks/whimer/pilot/internal/domain/comment/entity"
+	cmtvo "github.com/ryanreadbooks/whimer/pilot/internal/domain/comment/vo"
 	mentionvo "github.com/ryanreadbooks/whimer/pilot/internal/domain/common/mention/vo"
+	notevo "github.com/ryanreadbooks/whimer/pilot/internal/domain/note/vo"
 	notifyvo "github.com/ryanreadbooks/whimer/pilot/internal/domain/systemnotify/vo"
 
 	"github.com/ryanreadbooks/whimer/misc/concurrent"
@@ -23,32 +26,19 @@ func (s *Service) AfterCommentPublished(
 ) {
 	operator := metadata.Uid(ctx)
 
-	// 异步处理
+	// 1&2. 异步处理被@的用户
 	concurrent.SafeGo2(ctx, concurrent.SafeGo2Opt{
 		Name: "pilot.commentapp.after_pub.handle_at_users",
 		Job: func(ctx context.Context) error {
-			// 1. 追加最近联系人
-			atUsers := cmd.AtUsers.ToMentionVo()
-			// 去重+排除自己
-			validAtUsers := make(mentionvo.AtUserList, 0, len(atUsers))
-			for _, atUser := range atUsers {
-				if atUser.Uid == operator {
-					continue
-				}
-				validAtUsers = append(validAtUsers, atUser)
-			}
-
-			validAtUsers = xslice.UniqF(validAtUsers, func(v *mentionvo.AtUser) int64 { return v.Uid })
-
-			if len(validAtUsers) > 0 {
-				err := s.userDomainService.AppendRecentContactsAtUser(ctx, operator, validAtUsers)
-				// 2. TODO 通知被@的用户
-				err2 := s.afterCommentPublishedHandleAtUsers(ctx, operator, validAtUsers, commentId, cmd)
-				err3 := errors.Join(err, err2)
-				return err3
-			}
-
-			return nil
+			return s.handleMentionedUsers(ctx, operator, cmd.AtUsers.ToMentionVo(),
+				&notifyvo.NotifyAtUsersOnCommentParamContent{
+					SourceUid: operator,
+					Comment:   cmd.Content,
+					NoteId:    cmd.Oid,
+					CommentId: commentId,
+					RootId:    cmd.RootId,
+					ParentId:  cmd.ParentId,
+				})
 		},
 	})
 
@@ -58,24 +48,118 @@ func (s *Service) AfterCommentPublished(
 	// 4. TODO 通知笔记作者
 }
 
-func (s *Service) afterCommentPublishedHandleAtUsers(
+// 评论编辑后执行的操作
+//
+// 只处理本次编辑新增的@用户 已经被@过的用户不重复通知
+func (s *Service) AfterCommentEdited(
+	ctx context.Context,
+	comment *entity.Comment,
+	addedAtUsers []cmtvo.AtUser,
+) {
+	if len(addedAtUsers) == 0 {
+		return
+	}
+
+	operator := metadata.Uid(ctx)
+	atUsers := make(mentionvo.AtUserList, 0, len(addedAtUsers))
+	for _, au := range addedAtUsers {
+		atUsers = append(atUsers, &mentionvo.AtUser{
+			Uid:      au.Uid,
+			Nickname: au.Nickname,
+		})
+	}
+
+	concurrent.SafeGo2(ctx, concurrent.SafeGo2Opt{
+		Name:       "pilot.commentapp.after_edit.handle_at_users",
+		LogOnError: true,
+		Job: func(ctx context.Context) error {
+			return s.handleMentionedUsers(ctx, operator, atUsers,
+				&notifyvo.NotifyAtUsersOnCommentParamContent{
+					SourceUid: operator,
+					Comment:   comment.Content,
+					NoteId:    notevo.NoteId(comment.Oid),
+					CommentId: comment.Id,
+					RootId:    comment.RootId,
+					ParentId:  comment.ParentId,
+				})
+		},
+	})
+}
+
+// 等待审核的评论通过后执行的操作
+//
+// 评论等待审核时没有处理被@的用户 通过后以评论发布者的身份处理
+func (s *Service) AfterHeldCommentsApproved(ctx context.Context, comments []*entity.Comment) {
+	for _, comment := range comments {
+		if len(comment.AtUsers) == 0 {
+			continue
+		}
+
+		atUsers := make(mentionvo.AtUserList, 0, len(comment.AtUsers))
+		for _, au := range comment.AtUsers {
+			atUsers = append(atUsers, &mentionvo.AtUser{
+				Uid:      au.Uid,
+				Nickname: au.Nickname,
+			})
+		}
+
+		concurrent.SafeGo2(ctx, concurrent.SafeGo2Opt{
+			Name:       "pilot.commentapp.after_approve.handle_at_users",
+			LogOnError: true,
+			Job: func(ctx context.Context) error {
+				return s.handleMentionedUsers(ctx, comment.Uid, atUsers,
+					&notifyvo.NotifyAtUsersOnCommentParamContent{
+						SourceUid: comment.Uid,
+						Comment:   comment.Content,
+						NoteId:    notevo.NoteId(comment.Oid),
+						CommentId: comment.Id,
+						RootId:    comment.RootId,
+						ParentId:  comment.ParentId,
+					})
+			},
+		})
+	}
+}
+
+// 处理评论中被@的用户
+func (s *Service) handleMentionedUsers(
+	ctx context.Context,
+	operator int64,
+	atUsers mentionvo.AtUserList,
+	content *notifyvo.NotifyAtUsersOnCommentParamContent,
+) error {
+	// 去重+排除自己
+	validAtUsers := make(mentionvo.AtUserList, 0, len(atUsers))
+	for _, atUser := range atUsers {
+		if atUser.Uid == operator {
+			continue
+		}
+		validAtUsers = append(validAtUsers, atUser)
+	}
+
+	validAtUsers = xslice.UniqF(validAtUsers, func(v *mentionvo.AtUser) int64 { return v.Uid })
+	if len(validAtUsers) == 0 {
+		return nil
+	}
+
+	// 1. 追加最近联系人
+	err := s.userDomainService.AppendRecentContactsAtUser(ctx, operator, validAtUsers)
+	// 2. 通知被@的用户
+	err2 := s.notifyAtUsersOnComment(ctx, operator, validAtUsers, content)
+
+	return errors.Join(err, err2)
+}
+
+func (s *Service) notifyAtUsersOnComment(
 	ctx context.Context,
 	operator int64,
 	targetUsers []*mentionvo.AtUser,
-	commentId int64,
-	cmd *dto.PublishCommentCommand,
+	content *notifyvo.NotifyAtUsersOnCommentParamContent,
 ) error {
 	err := s.systemNotifyDomainService.NotifyAtUsersOnComment(ctx, &notifyvo.NotifyAtUsersOnCommentParam{
 		Uid:         operator,
 		TargetUsers: targetUsers,
-		Content: &notifyvo.NotifyAtUsersOnCommentParamContent{
-			SourceUid: operator,
-			Comment:   cmd.Content,
-			NoteId:    cmd.Oid,
-			CommentId: commentId,
-			RootId:    cmd.RootId,
-			ParentId:  cmd.ParentId,
-		},
+		Content:     content,
 	})
 	if err != nil {
 		return xerror.Wrapf(err, "system notify service notify at users on comment failed").WithCtx(ctx)
@@ -13,6 +13,8 @@ import (
 const (
 	maxContentLen       = 2000
 	maxNumCommentImages = 9
+	maxBlockedKeywords  = 50
+	maxApproveHeldCount = 50
 )
 
 // CommentImageCommand 评论图片
@@ -62,34 +64,50 @@ func (c *PublishCommentCommand) Validate() error {
 		if len(c.Images) == 0 {
 			return errors.ErrNoCommentImage
 		}
-		if len(c.Images) > maxNumCommentImages {
-			return errors.ErrTooManyImages
+		if err := checkCommentImages(c.Images); err != nil {
+			return err
 		}
+	case cmtvo.CommentTypeEmoji:
+		// 表情评论的内容为单个[pack:item] 由评论服务校验
+		if contentLen <= 0 {
+			return errors.ErrContentEmpty
+		}
+		if len(c.Images) > 0 {
+			return errors.ErrInvalidCommentType
+		}
+	default:
+		return errors.ErrInvalidCommentType
+	}
 
-		for _, img := range c.Images {
-			if img.StoreKey == "" {
-				return errors.ErrInvalidStoreKey
-			}
+	return nil
+}
 
-			if img.Width == 0 || img.Height == 0 || img.Format == "" {
-				return errors.ErrMissingImageInfo
-			}
+func checkCommentImages(images []CommentImageCommand) error {
+	if len(images) > maxNumCommentImages {
+		return errors.ErrTooManyImages
+	}
 
-			if err := commondto.CheckImageFormat(img.Format); err != nil {
-				return err
-			}
+	for _, img := range images {
+		if img.StoreKey == "" {
+			return errors.ErrInvalidStoreKey
+		}
+
+		if img.Width == 0 || img.Height == 0 || img.Format == "" {
+			return errors.ErrMissingImageInfo
+		}
+
+		if err := commondto.CheckImageFormat(img.Format); err != nil {
+			return err
 		}
-	default:
-		return errors.ErrInvalidCommentType
 	}
 
 	return nil
 }
 
-func (c *PublishCommentCommand) ToRepoParams() *repository.AddCommentParams {
-	images := make([]cmtvo.CommentImage, 0, len(c.Images))
-	for _, img := range c.Images {
-		images = append(images, cmtvo.CommentImage{
+func commentImagesToVo(images []CommentImageCommand) []cmtvo.CommentImage {
+	res := make([]cmtvo.CommentImage, 0, len(images))
+	for _, img := range images {
+		res = append(res, cmtvo.CommentImage{
 			StoreKey: img.StoreKey,
 			Width:    img.Width,
 			Height:   img.Height,
@@ -97,14 +115,22 @@ func (c *PublishCommentCommand) ToRepoParams() *repository.AddCommentParams {
 		})
 	}
 
-	atUsers := make([]cmtvo.AtUser, 0, len(c.AtUsers))
-	for _, au := range c.AtUsers {
-		atUsers = append(atUsers, cmtvo.AtUser{
+	return res
+}
+
+func atUsersToVo(atUsers commondto.AtUserList) []cmtvo.AtUser {
+	res := make([]cmtvo.AtUser, 0, len(atUsers))
+	for _, au := range atUsers {
+		res = append(res, cmtvo.AtUser{
 			Uid:      au.Uid,
 			Nickname: au.Nickname,
 		})
 	}
 
+	return res
+}
+
+func (c *PublishCommentCommand) ToRepoParams() *repository.AddCommentParams {
 	return &repository.AddCommentParams{
 		Type:     int32(c.CommentType),
 		Oid:      int64(c.Oid),
@@ -112,14 +138,64 @@ func (c *PublishCommentCommand) ToRepoParams() *repository.AddCommentParams {
 		RootId:   c.RootId,
 		ParentId: c.ParentId,
 		ReplyUid: c.ReplyUid,
-		Images:   images,
-		AtUsers:  atUsers,
+		Images:   commentImagesToVo(c.Images),
+		AtUsers:  atUsersToVo(c.AtUsers),
 	}
 }
 
 // PublishCommentResult 发布评论结果
 type PublishCommentResult struct {
 	CommentId int64 `json:"comment_id"`
+	Held      bool  `json:"held"` // 评论等待作者审核 审核通过前仅自己可见
+}
+
+// EditCommentCommand 编辑评论命令
+//
+// 图片为空时评论变为纯文本评论
+type EditCommentCommand struct {
+	CommentId int64                 `json:"comment_id"`
+	Content   string                `json:"content"`
+	Images    []CommentImageCommand `json:"images,omitempty,optional"`
+	AtUsers   commondto.AtUserList  `json:"at_users,omitempty,optional"`
+}
+
+func (c *EditCommentCommand) Validate() error {
+	if c.CommentId <= 0 {
+		return errors.ErrCommentNotFound
+	}
+
+	contentLen := utf8.RuneCountInString(c.Content)
+	if contentLen > maxContentLen {
+		return errors.ErrContentTooLong
+	}
+
+	if len(c.AtUsers) > 0 {
+		c.AtUsers = c.AtUsers.Filter()
+	}
+
+	if len(c.Images) == 0 {
+		if contentLen <= 0 {
+			return errors.ErrContentEmpty
+		}
+		return nil
+	}
+
+	return checkCommentImages(c.Images)
+}
+
+func (c *EditCommentCommand) ToRepoParams() *repository.EditCommentParams {
+	return &repository.EditCommentParams{
+		CommentId: c.CommentId,
+		Content:   c.Content,
+		Images:    commentImagesToVo(c.Images),
+		AtUsers:   atUsersToVo(c.AtUsers),
+	}
+}
+
+// EditCommentResult 编辑评论结果
+type EditCommentResult struct {
+	Etime int64 `json:"etime"`
+	Held  bool  `json:"held"`
 }
 
 // DeleteCommentCommand 删除评论命令
@@ -203,3 +279,65 @@ type UploadTicket struct {
 	UploadAddr  string   `json:"upload_addr"`
 	Token       string   `json:"token"`
 }
+
+// UpdateCommentSettingCommand 更新笔记评论设置命令
+type UpdateCommentSettingCommand struct {
+	Oid             notevo.NoteId     `json:"oid"`
+	Mode            cmtvo.CommentMode `json:"mode"`
+	BlockedKeywords []string          `json:"blocked_keywords"`
+}
+
+func (c *UpdateCommentSettingCommand) Validate() error {
+	if c.Oid <= 0 {
+		return errors.ErrNoteNotFound
+	}
+	switch c.Mode {
+	case cmtvo.CommentModeOpen, cmtvo.CommentModeClosed, cmtvo.CommentModeFollowers:
+	default:
+		return errors.ErrInvalidCommentMode
+	}
+	if len(c.BlockedKeywords) > maxBlockedKeywords {
+		return errors.ErrTooManyBlockedKeywords
+	}
+	return nil
+}
+
+func (c *UpdateCommentSettingCommand) ToRepoParams() *repository.UpdateCommentSettingParams {
+	return &repository.UpdateCommentSettingParams{
+		Oid:             int64(c.Oid),
+		Mode:            c.Mode,
+		BlockedKeywords: c.BlockedKeywords,
+	}
+}
+
+// MuteCommentUserCommand 禁止用户在自己的笔记下评论
+type MuteCommentUserCommand struct {
+	Uid    int64            `json:"uid"`
+	Action cmtvo.MuteAction `json:"action"`
+}
+
+func (c *MuteCommentUserCommand) Validate() error {
+	if c.Uid <= 0 {
+		return errors.ErrInvalidParams
+	}
+	if c.Action != cmtvo.MuteActionUnmute && c.Action != cmtvo.MuteActionMute {
+		return errors.ErrInvalidAction
+	}
+	return nil
+}
+
+// ApproveHeldCommentsCommand 通过等待审核的评论
+type ApproveHeldCommentsCommand struct {
+	Oid        notevo.NoteId `json:"oid"`
+	CommentIds []int64       `json:"comment_ids"`
+}
+
+func (c *ApproveHeldCommentsCommand) Validate() error {
+	if c.Oid <= 0 {
+		return errors.ErrNoteNotFound
+	}
+	if len(c.CommentIds) == 0 || len(c.CommentIds) > maxApproveHeldCount {
+		return errors.ErrInvalidParams
+	}
+	return nil
+}
@@ -22,6 +22,15 @@ type CommentImageMetadata struct {
 	Type   string `json:"type"`
 }
 
+// 评论内容中引用的表情 客户端按照token替换内容中的[pack:item]
+type CommentEmoji struct {
+	Token  string `json:"token"`
+	Url    string `json:"url"`
+	Width  uint32 `json:"width"`
+	Height uint32 `json:"height"`
+	Format string `json:"format"`
+}
+
 // 与评论的交互信息
 type CommentInteract struct {
 	Liked bool `json:"liked"`
@@ -58,6 +67,9 @@ type Comment struct {
 	SubsCount int64               `json:"subs_count"`
 	Images    []*CommentImage     `json:"images,omitempty"`
 	AtUsers   []*commondto.AtUser `json:"at_users,omitempty"`
+	IsEdited  bool                `json:"is_edited"`
+	Etime     int64               `json:"etime"` // 最后一次编辑时间
+	Emojis    []*CommentEmoji     `json:"emojis,omitempty"`
 
 	User     *commondto.User `json:"user"`
 	Interact CommentInteract `json:"interact"`
@@ -78,17 +90,19 @@ type DetailedComment struct {
 
 // 评论列表响应
 type CommentListResult struct {
-	Items      []*Comment `json:"items"`
-	NextCursor int64      `json:"next_cursor"`
-	HasNext    bool       `json:"has_next"`
+	Items           []*Comment `json:"items"`
+	NextCursor      int64      `json:"next_cursor"`
+	NextTrendCursor string     `json:"next_trend_cursor,omitempty"`
+	HasNext         bool       `json:"has_next"`
 }
 
 // 带子评论的评论列表响应
 type DetailedCommentListResult struct {
-	Comments   []*DetailedComment `json:"comments"`
-	PinComment *DetailedComment   `json:"pin_comment,omitempty"`
-	NextCursor int64              `json:"next_cursor"`
-	HasNext    bool               `json:"has_next"`
+	Comments        []*DetailedComment `json:"comments"`
+	PinComment      *DetailedComment   `json:"pin_comment,omitempty"`
+	NextCursor      int64              `json:"next_cursor"`
+	NextTrendCursor string             `json:"next_trend_cursor,omitempty"`
+	HasNext         bool               `json:"has_next"`
 }
 
 // 点赞数响应
@@ -122,6 +136,17 @@ func EntityToComment(e *entity.Comment) *Comment {
 		})
 	}
 
+	emojis := make([]*CommentEmoji, 0, len(e.Emojis))
+	for _, emoji := range e.Emojis {
+		emojis = append(emojis, &CommentEmoji{
+			Token:  emoji.Token,
+			Url:    emojiUrl(emoji.Key),
+			Width:  emoji.Width,
+			Height: emoji.Height,
+			Format: emoji.Format,
+		})
+	}
+
 	return &Comment{
 		Id:        e.Id,
 		Oid:       e.Oid,
@@ -139,6 +164,9 @@ func EntityToComment(e *entity.Comment) *Comment {
 		SubsCount: e.SubsCount,
 		Images:    images,
 		AtUsers:   atUsers,
+		IsEdited:  e.IsEdited,
+		Etime:     e.Etime,
+		Emojis:    emojis,
 	}
 }
 
@@ -0,0 +1,80 @@
+package dto
+
+import (
+	"github.com/ryanreadbooks/whimer/misc/imgproxy"
+	"github.com/ryanreadbooks/whimer/pilot/internal/config"
+	"github.com/ryanreadbooks/whimer/pilot/internal/domain/comment/entity"
+	storagevo "github.com/ryanreadbooks/whimer/pilot/internal/domain/common/storage/vo"
+)
+
+func emojiUrl(key string) string {
+	if key == "" {
+		return ""
+	}
+
+	bucket := storagevo.ObjectTypeCommentEmoji.Metadata().Bucket
+	return imgproxy.GetSignedUrl(config.Conf.Oss.DisplayEndpointBucket(bucket),
+		key,
+		config.Conf.ImgProxyAuth.GetKey(),
+		config.Conf.ImgProxyAuth.GetSalt(),
+	)
+}
+
+// 表情包
+type EmojiPack struct {
+	Code     string `json:"code"`
+	Name     string `json:"name"`
+	CoverUrl string `json:"cover_url"`
+	Version  int64  `json:"version"` // 版本变化时客户端需要重新拉取表情包
+}
+
+func EntityToEmojiPack(e *entity.EmojiPack) *EmojiPack {
+	if e == nil {
+		return nil
+	}
+
+	return &EmojiPack{
+		Code:     e.Code,
+		Name:     e.Name,
+		CoverUrl: emojiUrl(e.CoverKey),
+		Version:  e.Version,
+	}
+}
+
+// 表情包中的表情
+type EmojiItem struct {
+	Token  string `json:"token"` // 在评论内容中引用的文本 [pack:item]
+	Code   string `json:"code"`
+	Name   string `json:"name"`
+	Url    string `json:"url"`
+	Width  uint32 `json:"width"`
+	Height uint32 `json:"height"`
+	Format string `json:"format"`
+}
+
+func EntityToEmojiItem(e *entity.EmojiItem) *EmojiItem {
+	if e == nil {
+		return nil
+	}
+
+	return &EmojiItem{
+		Token:  "[" + e.Pack + ":" + e.Code + "]",
+		Code:   e.Code,
+		Name:   e.Name,
+		Url:    emojiUrl(e.Key),
+		Width:  e.Width,
+		Height: e.Height,
+		Format: e.Format,
+	}
+}
+
+// ListEmojiPacksResult 表情包列表
+type ListEmojiPacksResult struct {
+	Packs []*EmojiPack `json:"packs"`
+}
+
+// GetEmojiPackResult 表情包及其表情
+type GetEmojiPackResult struct {
+	Pack  *EmojiPack   `json:"pack"`
+	Items []*EmojiItem `json:"items"`
+}
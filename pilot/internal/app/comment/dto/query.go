@@ -5,12 +5,18 @@ import (
 	notevo "github.com/ryanreadbooks/whimer/pilot/internal/domain/note/vo"
 )
 
+const (
+	defaultPageCount = 20
+	maxPageCount     = 50
+)
+
 // GetCommentsQuery 获取评论查询
 type GetCommentsQuery struct {
-	Oid    notevo.NoteId `form:"oid"`
-	Cursor int64         `form:"cursor,optional"`
-	SortBy int           `form:"sort_by,optional"`
-	SeekId int64         `form:"seek_id,optional"`
+	Oid         notevo.NoteId `form:"oid"`
+	Cursor      int64         `form:"cursor,optional"`
+	TrendCursor string        `form:"trend_cursor,optional"` // 按热度排序时使用
+	SortBy      int           `form:"sort_by,optional"`
+	SeekId      int64         `form:"seek_id,optional"`
 }
 
 func (q *GetCommentsQuery) Validate() error {
@@ -54,3 +60,63 @@ type GetLikeCountResult struct {
 	CommentId int64 `json:"comment_id"`
 	Likes     int64 `json:"likes"`
 }
+
+// GetEmojiPackQuery 获取表情包
+type GetEmojiPackQuery struct {
+	Code string `form:"code"`
+}
+
+func (q *GetEmojiPackQuery) Validate() error {
+	if q.Code == "" {
+		return errors.ErrInvalidParams
+	}
+	return nil
+}
+
+// GetCommentSettingQuery 获取笔记评论设置
+type GetCommentSettingQuery struct {
+	Oid notevo.NoteId `form:"oid"`
+}
+
+func (q *GetCommentSettingQuery) Validate() error {
+	if q.Oid <= 0 {
+		return errors.ErrNoteNotFound
+	}
+	return nil
+}
+
+// ListMutedCommentUsersQuery 分页获取禁止评论的用户
+type ListMutedCommentUsersQuery struct {
+	Cursor int64 `form:"cursor,optional"`
+	Count  int32 `form:"count,optional"`
+}
+
+func (q *ListMutedCommentUsersQuery) Validate() error {
+	if q.Count < 0 || q.Count > maxPageCount {
+		return errors.ErrInvalidParams
+	}
+	if q.Count == 0 {
+		q.Count = defaultPageCount
+	}
+	return nil
+}
+
+// ListHeldCommentsQuery 分页获取笔记下等待审核的评论
+type ListHeldCommentsQuery struct {
+	Oid    notevo.NoteId `form:"oid"`
+	Cursor int64         `form:"cursor,optional"`
+	Count  int32         `form:"count,optional"`
+}
+
+func (q *ListHeldCommentsQuery) Validate() error {
+	if q.Oid <= 0 {
+		return errors.ErrNoteNotFound
+	}
+	if q.Count < 0 || q.Count > maxPageCount {
+		return errors.ErrInvalidParams
+	}
+	if q.Count == 0 {
+		q.Count = defaultPageCount
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package dto
+
+import (
+	commondto "github.com/ryanreadbooks/whimer/pilot/internal/app/common/dto"
+	"github.com/ryanreadbooks/whimer/pilot/internal/domain/comment/entity"
+	cmtvo "github.com/ryanreadbooks/whimer/pilot/internal/domain/comment/vo"
+	notevo "github.com/ryanreadbooks/whimer/pilot/internal/domain/note/vo"
+)
+
+// 笔记的评论设置
+type CommentSetting struct {
+	Oid             notevo.NoteId     `json:"oid"`
+	Mode            cmtvo.CommentMode `json:"mode"`
+	BlockedKeywords []string          `json:"blocked_keywords"`
+	Mtime           int64             `json:"mtime"`
+}
+
+func EntityToCommentSetting(e *entity.CommentSetting) *CommentSetting {
+	if e == nil {
+		return nil
+	}
+
+	keywords := e.BlockedKeywords
+	if keywords == nil {
+		keywords = []string{}
+	}
+
+	return &CommentSetting{
+		Oid:             notevo.NoteId(e.Oid),
+		Mode:            e.Mode,
+		BlockedKeywords: keywords,
+		Mtime:           e.Mtime,
+	}
+}
+
+// 被禁止评论的用户
+type MutedCommentUser struct {
+	User  *commondto.User `json:"user"`
+	Ctime int64           `json:"ctime"` // 禁止时间
+}
+
+// ListMutedCommentUsersResult 禁止评论的用户列表
+type ListMutedCommentUsersResult struct {
+	Items      []*MutedCommentUser `json:"items"`
+	NextCursor int64               `json:"next_cursor"`
+	HasNext    bool                `json:"has_next"`
+}
+
+// ApproveHeldCommentsResult 审核通过结果
+type ApproveHeldCommentsResult struct {
+	Approved int64 `json:"approved"`
+}
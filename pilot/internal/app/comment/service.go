@@ -83,25 +83,31 @@ func (s *Service) checkNoteExist(ctx context.Context, noteId int64) error {
 }
 
 // PublishComment 发布评论
-func (s *Service) PublishComment(ctx context.Context, cmd *dto.PublishCommentCommand) (int64, error) {
+func (s *Service) PublishComment(ctx context.Context, cmd *dto.PublishCommentCommand) (*dto.PublishCommentResult, error) {
 	// 校验回复的用户是否正确
 	if err := s.checkPubReqValid(ctx, cmd); err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	// 检查笔记是否存在
 	if err := s.checkNoteExist(ctx, int64(cmd.Oid)); err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	commentId, err := s.commentAdapter.AddComment(ctx, cmd.ToRepoParams())
+	res, err := s.commentAdapter.AddComment(ctx, cmd.ToRepoParams())
 	if err != nil {
-		return 0, xerror.Wrapf(err, "comment adapter add comment failed").WithCtx(ctx)
+		return nil, xerror.Wrapf(err, "comment adapter add comment failed").WithCtx(ctx)
 	}
 
-	s.AfterCommentPublished(ctx, commentId, cmd)
+	// 等待审核的评论在审核通过前不通知相关用户 被@的用户在审核通过后处理
+	if !res.Held {
+		s.AfterCommentPublished(ctx, res.CommentId, cmd)
+	}
 
-	return commentId, nil
+	return &dto.PublishCommentResult{
+		CommentId: res.CommentId,
+		Held:      res.Held,
+	}, nil
 }
 
 func (s *Service) checkPubReqValid(ctx context.Context, cmd *dto.PublishCommentCommand) error {
@@ -136,9 +142,10 @@ func (s *Service) PageGetRootComments(ctx context.Context, q *dto.GetCommentsQue
 	}
 
 	result, err := s.commentAdapter.PageGetRootComments(ctx, &repository.PageGetCommentsParams{
-		Oid:    int64(q.Oid),
-		Cursor: q.Cursor,
-		SortBy: commentvo.SortType(q.SortBy),
+		Oid:         int64(q.Oid),
+		Cursor:      q.Cursor,
+		TrendCursor: q.TrendCursor,
+		SortBy:      commentvo.SortType(q.SortBy),
 	})
 	if err != nil {
 		return nil, xerror.Wrapf(err, "comment adapter page get root comments failed").WithCtx(ctx)
@@ -154,9 +161,10 @@ func (s *Service) PageGetRootComments(ctx context.Context, q *dto.GetCommentsQue
 	}
 
 	return &dto.CommentListResult{
-		Items:      items,
-		NextCursor: result.NextCursor,
-		HasNext:    result.HasNext,
+		Items:           items,
+		NextCursor:      result.NextCursor,
+		NextTrendCursor: result.NextTrendCursor,
+		HasNext:         result.HasNext,
 	}, nil
 }
 
@@ -236,9 +244,10 @@ func (s *Service) PageGetComments(ctx context.Context, q *dto.GetCommentsQuery)
 
 	result, err := s.commentAdapter.PageGetDetailedComments(ctx,
 		&repository.PageGetDetailedCommentsParams{
-			Oid:    oid,
-			Cursor: q.Cursor,
-			SortBy: commentvo.SortType(q.SortBy),
+			Oid:         oid,
+			Cursor:      q.Cursor,
+			TrendCursor: q.TrendCursor,
+			SortBy:      commentvo.SortType(q.SortBy),
 		})
 	if err != nil {
 		return nil, xerror.Wrapf(err, "comment adapter page get detailed comments failed").WithCtx(ctx)
@@ -282,10 +291,11 @@ func (s *Service) PageGetComments(ctx context.Context, q *dto.GetCommentsQuery)
 	}
 
 	return &dto.DetailedCommentListResult{
-		Comments:   comments,
-		PinComment: pinned,
-		NextCursor: result.NextCursor,
-		HasNext:    result.HasNext,
+		Comments:        comments,
+		PinComment:      pinned,
+		NextCursor:      result.NextCursor,
+		NextTrendCursor: result.NextTrendCursor,
+		HasNext:         result.HasNext,
 	}, nil
 }
 
@@ -369,6 +379,28 @@ func (s *Service) getSeekedComment(ctx context.Context, oid, seekId int64) (*dto
 	return dtoItem, nil
 }
 
+// EditComment 编辑评论
+func (s *Service) EditComment(ctx context.Context, cmd *dto.EditCommentCommand) (*dto.EditCommentResult, error) {
+	result, err := s.commentAdapter.EditComment(ctx, cmd.ToRepoParams())
+	if err != nil {
+		return nil, xerror.Wrapf(err, "comment adapter edit comment failed").WithCtx(ctx)
+	}
+
+	// 编辑后等待审核时 被@的用户在审核通过后处理
+	if len(result.AddedAtUsers) > 0 && !result.Held {
+		comment, err := s.commentAdapter.GetComment(ctx, cmd.CommentId)
+		if err != nil {
+			// 编辑已经成功 通知失败不影响结果
+			xlog.Msg("comment adapter get comment failed after edit").Err(err).
+				Extras("commentId", cmd.CommentId).Errorx(ctx)
+		} else {
+			s.AfterCommentEdited(ctx, comment, result.AddedAtUsers)
+		}
+	}
+
+	return &dto.EditCommentResult{Etime: result.Etime, Held: result.Held}, nil
+}
+
 // DeleteComment 删除评论
 func (s *Service) DeleteComment(ctx context.Context, cmd *dto.DeleteCommentCommand) error {
 	err := s.commentAdapter.DelComment(ctx, cmd.CommentId, int64(cmd.Oid))
@@ -568,3 +600,36 @@ func (s *Service) GetUploadImageTicket(ctx context.Context, count int32) (*stora
 
 	return tickets, nil
 }
+
+// ListEmojiPacks 列出表情包
+func (s *Service) ListEmojiPacks(ctx context.Context) (*dto.ListEmojiPacksResult, error) {
+	packs, err := s.commentAdapter.ListEmojiPacks(ctx)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "comment adapter list emoji packs failed").WithCtx(ctx)
+	}
+
+	res := make([]*dto.EmojiPack, 0, len(packs))
+	for _, p := range packs {
+		res = append(res, dto.EntityToEmojiPack(p))
+	}
+
+	return &dto.ListEmojiPacksResult{Packs: res}, nil
+}
+
+// GetEmojiPack 获取表情包及其表情
+func (s *Service) GetEmojiPack(ctx context.Context, q *dto.GetEmojiPackQuery) (*dto.GetEmojiPackResult, error) {
+	pack, items, err := s.commentAdapter.GetEmojiPack(ctx, q.Code)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "comment adapter get emoji pack failed").WithCtx(ctx)
+	}
+
+	res := make([]*dto.EmojiItem, 0, len(items))
+	for _, item := range items {
+		res = append(res, dto.EntityToEmojiItem(item))
+	}
+
+	return &dto.GetEmojiPackResult{
+		Pack:  dto.EntityToEmojiPack(pack),
+		Items: res,
+	}, nil
+}
@@ -0,0 +1,121 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/ryanreadbooks/whimer/pilot/internal/app/comment/dto"
+	"github.com/ryanreadbooks/whimer/pilot/internal/domain/comment/repository"
+
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/misc/xslice"
+)
+
+// GetCommentSetting 获取笔记的评论设置 仅笔记作者可查看
+func (s *Service) GetCommentSetting(ctx context.Context, q *dto.GetCommentSettingQuery) (*dto.CommentSetting, error) {
+	setting, err := s.commentAdapter.GetCommentSetting(ctx, int64(q.Oid))
+	if err != nil {
+		return nil, xerror.Wrapf(err, "comment adapter get comment setting failed").WithCtx(ctx)
+	}
+
+	return dto.EntityToCommentSetting(setting), nil
+}
+
+// UpdateCommentSetting 更新笔记的评论设置
+func (s *Service) UpdateCommentSetting(ctx context.Context, cmd *dto.UpdateCommentSettingCommand) (*dto.CommentSetting, error) {
+	setting, err := s.commentAdapter.UpdateCommentSetting(ctx, cmd.ToRepoParams())
+	if err != nil {
+		return nil, xerror.Wrapf(err, "comment adapter update comment setting failed").WithCtx(ctx)
+	}
+
+	return dto.EntityToCommentSetting(setting), nil
+}
+
+// MuteCommentUser 禁止/解除禁止用户在自己的笔记下评论
+func (s *Service) MuteCommentUser(ctx context.Context, cmd *dto.MuteCommentUserCommand) error {
+	err := s.commentAdapter.MuteCommentUser(ctx, cmd.Uid, cmd.Action)
+	if err != nil {
+		return xerror.Wrapf(err, "comment adapter mute comment user failed").WithCtx(ctx)
+	}
+
+	return nil
+}
+
+// ListMutedCommentUsers 分页获取被禁止评论的用户
+func (s *Service) ListMutedCommentUsers(ctx context.Context, q *dto.ListMutedCommentUsersQuery) (
+	*dto.ListMutedCommentUsersResult, error,
+) {
+	result, err := s.commentAdapter.ListMutedCommentUsers(ctx, q.Cursor, q.Count)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "comment adapter list muted comment users failed").WithCtx(ctx)
+	}
+
+	items := make([]*dto.MutedCommentUser, 0, len(result.Items))
+	if len(result.Items) > 0 {
+		uids := make([]int64, 0, len(result.Items))
+		for _, item := range result.Items {
+			uids = append(uids, item.Uid)
+		}
+
+		userMap, err := s.userAdapter.BatchGetUser(ctx, xslice.Uniq(uids))
+		if err != nil {
+			return nil, xerror.Wrapf(err, "user adapter batch get user failed").WithCtx(ctx)
+		}
+
+		for _, item := range result.Items {
+			user := dto.UserVoToCommentUser(userMap[item.Uid])
+			if user == nil {
+				continue
+			}
+			items = append(items, &dto.MutedCommentUser{
+				User:  user,
+				Ctime: item.Ctime,
+			})
+		}
+	}
+
+	return &dto.ListMutedCommentUsersResult{
+		Items:      items,
+		NextCursor: result.NextCursor,
+		HasNext:    result.HasNext,
+	}, nil
+}
+
+// ListHeldComments 分页获取笔记下等待审核的评论
+func (s *Service) ListHeldComments(ctx context.Context, q *dto.ListHeldCommentsQuery) (*dto.CommentListResult, error) {
+	result, err := s.commentAdapter.ListHeldComments(ctx, &repository.ListHeldCommentsParams{
+		Oid:    int64(q.Oid),
+		Cursor: q.Cursor,
+		Count:  q.Count,
+	})
+	if err != nil {
+		return nil, xerror.Wrapf(err, "comment adapter list held comments failed").WithCtx(ctx)
+	}
+
+	items := make([]*dto.Comment, 0, len(result.Items))
+	if len(result.Items) > 0 {
+		items, err = s.attachCommentsUsers(ctx, result.Items)
+		if err != nil {
+			return nil, xerror.Wrapf(err, "attach comments users failed").WithCtx(ctx)
+		}
+	}
+
+	return &dto.CommentListResult{
+		Items:      items,
+		NextCursor: result.NextCursor,
+		HasNext:    result.HasNext,
+	}, nil
+}
+
+// ApproveHeldComments 通过等待审核的评论
+func (s *Service) ApproveHeldComments(ctx context.Context, cmd *dto.ApproveHeldCommentsCommand) (
+	*dto.ApproveHeldCommentsResult, error,
+) {
+	approved, err := s.commentAdapter.ApproveHeldComments(ctx, int64(cmd.Oid), xslice.Uniq(cmd.CommentIds))
+	if err != nil {
+		return nil, xerror.Wrapf(err, "comment adapter approve held comments failed").WithCtx(ctx)
+	}
+
+	s.AfterHeldCommentsApproved(ctx, approved)
+
+	return &dto.ApproveHeldCommentsResult{Approved: int64(len(approved))}, nil
+}
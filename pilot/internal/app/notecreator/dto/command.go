@@ -21,12 +21,17 @@ type CreateNoteBasic struct {
 	Desc    string            `json:"desc"`
 	Privacy notevo.Visibility `json:"privacy"`
 	Type    notevo.NoteType   `json:"type"`
+
+	Audience []int64 `json:"audience,omitempty"` // 仅指定用户可见时的用户
 }
 
 func (c *CreateNoteBasic) Validate() error {
 	if !c.Privacy.CheckValid() {
 		return errors.ErrUnsupportedVisibility
 	}
+	if c.Privacy == notevo.VisibilityCustom && len(c.Audience) == 0 {
+		return errors.ErrEmptyAudience
+	}
 	if utf8.RuneCountInString(c.Title) > maxTitleLen {
 		return errors.ErrWrongTitleLength
 	}
@@ -110,6 +115,10 @@ type CreateNoteCommand struct {
 	TagList []TagId                `json:"tag_list,optional"`
 	AtUsers commondto.AtUserList   `json:"at_users,optional"`
 
+	// 仅创建时生效
+	Draft     bool  `json:"draft,optional"`      // 保存为草稿
+	PublishAt int64 `json:"publish_at,optional"` // 定时发布时间 unix秒 为0表示立即发布
+
 	// internal usage only
 	strictVideo bool `json:"-"`
 }
@@ -149,6 +158,16 @@ func (c *CreateNoteCommand) ValidateTags() error {
 	return nil
 }
 
+func (c *CreateNoteCommand) ValidatePublish() error {
+	if c.PublishAt < 0 {
+		return errors.ErrPublishAtInvalid
+	}
+	if c.Draft && c.PublishAt > 0 {
+		return errors.ErrDraftWithPublishAt
+	}
+	return nil
+}
+
 func (c *CreateNoteCommand) Validate() error {
 	if c == nil {
 		return errors.ErrNilArg
@@ -159,6 +178,10 @@ func (c *CreateNoteCommand) Validate() error {
 		return err
 	}
 
+	if err := c.ValidatePublish(); err != nil {
+		return err
+	}
+
 	if err := c.ValidateImages(); err != nil {
 		return err
 	}
@@ -203,6 +226,25 @@ type UpdateNoteResult struct {
 	NoteId notevo.NoteId `json:"note_id"`
 }
 
+// 发布草稿
+type PublishDraftCommand struct {
+	NoteId    notevo.NoteId `json:"note_id"`
+	PublishAt int64         `json:"publish_at,optional"` // 定时发布时间 unix秒 为0表示立即发布
+}
+
+func (c *PublishDraftCommand) Validate() error {
+	if c == nil {
+		return errors.ErrNilArg
+	}
+	if c.NoteId <= 0 {
+		return errors.ErrNoteNotFound
+	}
+	if c.PublishAt < 0 {
+		return errors.ErrPublishAtInvalid
+	}
+	return nil
+}
+
 // 新增标签
 type AddTagCommand struct {
 	Name string `json:"name"`
@@ -31,6 +31,22 @@ func (q *PageListNotesQuery) Validate() error {
 	return nil
 }
 
+// ListDraftsQuery 草稿列表请求
+type ListDraftsQuery struct {
+	Cursor int64 `form:"cursor,optional"`
+	Count  int32 `form:"count,default=20"`
+}
+
+func (q *ListDraftsQuery) Validate() error {
+	if q.Cursor < 0 {
+		q.Cursor = 0
+	}
+	if q.Count <= 0 || q.Count > 20 {
+		q.Count = 20
+	}
+	return nil
+}
+
 // Note 创作者笔记详情结果
 //
 // 面向端
@@ -39,6 +55,7 @@ type Note struct {
 	Title    string          `json:"title"`
 	Desc     string          `json:"desc"`
 	Privacy  int8            `json:"privacy"`
+	Audience []int64         `json:"audience,omitempty"` // 仅指定用户可见时的用户
 	CreateAt int64           `json:"create_at"`
 	UpdateAt int64           `json:"update_at"`
 	IpLoc    string          `json:"ip_loc"`
@@ -49,6 +66,7 @@ type Note struct {
 
 	Likes   int64 `json:"likes"`
 	Replies int64 `json:"replies"`
+	Views   int64 `json:"views"`
 
 	AtUsers []*commondto.AtUser  `json:"at_users,omitempty"`
 	TagList []*commondto.NoteTag `json:"tag_list,omitempty"`
@@ -61,6 +79,12 @@ type NoteList struct {
 	Items []*Note `json:"items"`
 }
 
+type DraftList struct {
+	Items      []*Note `json:"items"`
+	NextCursor int64   `json:"next_cursor"`
+	HasNext    bool    `json:"has_next"`
+}
+
 // SearchedTag 搜索到的标签
 type SearchedTag struct {
 	Id   string `json:"id"`
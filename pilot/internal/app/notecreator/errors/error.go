@@ -21,4 +21,7 @@ var (
 	ErrTagNameSpace          = xerror.ErrArgs.Msg("标签名不能存在空格")
 	ErrTagNameSpecialChar    = xerror.ErrArgs.Msg("标签名不能包含特殊字符")
 	ErrTagNameTooLong        = xerror.ErrArgs.Msg("标签名太长")
+	ErrPublishAtInvalid      = xerror.ErrArgs.Msg("定时发布时间无效")
+	ErrDraftWithPublishAt    = xerror.ErrArgs.Msg("草稿不能设置定时发布时间")
+	ErrEmptyAudience         = xerror.ErrArgs.Msg("未指定可见用户")
 )
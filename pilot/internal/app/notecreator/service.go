@@ -48,7 +48,10 @@ func convertCommandToCreateNoteParams(cmd *dto.CreateNoteCommand) *entity.Create
 		Title:     cmd.Basic.Title,
 		Desc:      cmd.Basic.Desc,
 		Privacy:   cmd.Basic.Privacy,
+		Audience:  cmd.Basic.Audience,
 		AssetType: cmd.Basic.Type.AsAssetType(),
+		Draft:     cmd.Draft,
+		PublishAt: cmd.PublishAt,
 	}
 
 	// images
@@ -257,6 +260,38 @@ func (s *Service) PageListNotes(ctx context.Context, query *dto.PageListNotesQue
 	}, nil
 }
 
+// 列出草稿
+func (s *Service) ListDrafts(ctx context.Context, query *dto.ListDraftsQuery) (*dto.DraftList, error) {
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	result, err := s.noteCreatorAdapter.ListDrafts(ctx,
+		&entity.ListDraftsParams{
+			Cursor: query.Cursor,
+			Count:  query.Count,
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	noteItems := make([]*dto.Note, 0, len(result.Items))
+	for _, item := range result.Items {
+		noteItems = append(noteItems, s.convertEntityNoteToDto(ctx, item))
+	}
+
+	return &dto.DraftList{
+		Items:      noteItems,
+		NextCursor: result.NextCursor,
+		HasNext:    result.HasNext,
+	}, nil
+}
+
+// 发布草稿
+func (s *Service) PublishDraft(ctx context.Context, cmd *dto.PublishDraftCommand) error {
+	return s.noteCreatorAdapter.PublishDraft(ctx, int64(cmd.NoteId), cmd.PublishAt)
+}
+
 // 获取单个笔记
 func (s *Service) GetNote(ctx context.Context, noteId notevo.NoteId) (*dto.Note, error) {
 	note, err := s.noteCreatorAdapter.GetNote(ctx, int64(noteId))
@@ -300,12 +335,14 @@ func (s *Service) convertEntityNoteToDto(ctx context.Context, note *entity.Creat
 		Title:    note.Title,
 		Desc:     note.Desc,
 		Privacy:  int8(note.Privacy),
+		Audience: note.Audience,
 		CreateAt: note.CreateTime,
 		UpdateAt: note.UpdateTime,
 		Type:     note.Type,
 
 		Likes:   note.Likes,
 		Replies: note.Replies,
+		Views:   note.Views,
 		AtUsers: atUsers,
 		TagList: tagList,
 
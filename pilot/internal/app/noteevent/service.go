@@ -82,6 +82,12 @@ func (s *Service) OnNotePublished(ctx context.Context, ev pkgnote.NotePublishedE
 		assetType = notevo.AssetTypeVideo
 	}
 
+	// 旧事件不携带可见范围 只有公开笔记会发布
+	visibility := notevo.Visibility(ev.Note.Privacy)
+	if !visibility.CheckValid() {
+		visibility = notevo.VisibilityPublic
+	}
+
 	// 1. 写入es
 	err = s.noteSearchAdapter.AddNote(ctx, &entity.SearchNote{
 		NoteId:         notevo.NoteId(ev.Note.Id),
@@ -93,7 +99,8 @@ func (s *Service) OnNotePublished(ctx context.Context, ev pkgnote.NotePublishedE
 		AuthorNickname: username,
 		TagList:        tagList,
 		AssetType:      assetType,
-		Visibility:     notevo.VisibilityPublic,
+		Visibility:     visibility,
+		AudienceUids:   ev.Note.Audience,
 	})
 	if err != nil {
 		// log here
@@ -28,6 +28,38 @@ func (r *GetRandomQuery) Validate() error {
 	return nil
 }
 
+type GetRecommendQuery struct {
+	NeedNum  int    `form:"need_num"`
+	Cursor   string `form:"cursor,optional"` // 首次请求为空 之后传入上一次返回的next_cursor
+	Platform string `form:"platform,optional"`
+	Category string `form:"category,optional"`
+}
+
+func (r *GetRecommendQuery) Validate() error {
+	const (
+		maxAllowedNeedNum = 20
+	)
+
+	if r == nil {
+		return xerror.ErrNilArg
+	}
+
+	if r.NeedNum > maxAllowedNeedNum {
+		return errors.ErrTooManyNotes
+	}
+	if r.NeedNum <= 0 {
+		r.NeedNum = 10
+	}
+
+	return nil
+}
+
+type GetRecommendResult struct {
+	Items      []*FeedNote `json:"items"`
+	NextCursor string      `json:"next_cursor"`
+	HasNext    bool        `json:"has_next"`
+}
+
 type GetFeedNoteQuery struct {
 	NoteId notevo.NoteId `form:"note_id" path:"note_id"`
 	Source string        `form:"source,optional"`
@@ -80,6 +80,27 @@ func (s *Service) GetRandom(
 	return feedNotes, nil
 }
 
+// 获取推荐笔记
+func (s *Service) GetRecommend(
+	ctx context.Context,
+	query *dto.GetRecommendQuery,
+) ([]*dto.FeedNote, *commondto.PageResultV2, error) {
+	notes, page, err := s.noteFeedAdapter.RecommendGet(ctx, metadata.Uid(ctx), int32(query.NeedNum), query.Cursor)
+	if err != nil {
+		return nil, nil, xerror.Wrapf(err, "note feed adapter recommend get failed").WithExtras("query", query).WithCtx(ctx)
+	}
+
+	feedNotes, err := s.assembleFeedNotes(ctx, notes)
+	if err != nil {
+		return nil, nil, xerror.Wrapf(err, "note feed adapter assemble feed notes failed").WithCtx(ctx)
+	}
+
+	return feedNotes, &commondto.PageResultV2{
+		NextCursor: page.NextCursor,
+		HasNext:    page.HasNext,
+	}, nil
+}
+
 func (s *Service) GetFeedNote(
 	ctx context.Context,
 	query *dto.GetFeedNoteQuery,
@@ -400,6 +421,7 @@ func (s *Service) SearchNotes(ctx context.Context, query *dto.SearchNotesQuery)
 		PageToken: query.PageToken,
 		Count:     query.Count,
 		Filters:   filters,
+		ViewerUid: metadata.Uid(ctx),
 	})
 	if err != nil {
 		return nil, xerror.Wrapf(err, "note search adapter search note failed").
@@ -416,7 +438,7 @@ func (s *Service) SearchNotes(ctx context.Context, query *dto.SearchNotesQuery)
 		return result, nil
 	}
 
-	// 3. 获取笔记详情
+	// 3. 获取笔记详情 粉丝和互关可见的笔记在这里根据关注关系再次过滤
 	noteIds := make([]int64, 0, len(searchResult.NoteIds))
 	for _, id := range searchResult.NoteIds {
 		noteIds = append(noteIds, id.Int64())
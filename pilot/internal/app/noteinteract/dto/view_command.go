@@ -0,0 +1,26 @@
+package dto
+
+import (
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/pilot/internal/app/noteinteract/errors"
+	"github.com/ryanreadbooks/whimer/pilot/internal/domain/note/vo"
+)
+
+const maxReportViewNotes = 50
+
+// 客户端批量上报浏览过的笔记
+type ReportNoteViewsCommand struct {
+	NoteIds []vo.NoteId `json:"note_ids"`
+}
+
+func (c *ReportNoteViewsCommand) Validate() error {
+	if c == nil {
+		return xerror.ErrNilArg
+	}
+
+	if len(c.NoteIds) == 0 || len(c.NoteIds) > maxReportViewNotes {
+		return errors.ErrInvalidViewNotes
+	}
+
+	return nil
+}
@@ -4,4 +4,5 @@ import "github.com/ryanreadbooks/whimer/misc/xerror"
 
 var (
 	ErrUnsupportedAction = xerror.ErrArgs.Msg("不支持的操作")
+	ErrInvalidViewNotes  = xerror.ErrArgs.Msg("上报的笔记数量不合法")
 )
\ No newline at end of file
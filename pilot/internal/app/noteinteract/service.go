@@ -61,6 +61,16 @@ func (s *Service) GetLikeCount(ctx context.Context, noteId int64) (int64, error)
 	return cnt, err
 }
 
+// 上报浏览过的笔记
+func (s *Service) ReportNoteViews(ctx context.Context, cmd *dto.ReportNoteViewsCommand) error {
+	noteIds := make([]int64, 0, len(cmd.NoteIds))
+	for _, noteId := range cmd.NoteIds {
+		noteIds = append(noteIds, noteId.Int64())
+	}
+
+	return s.noteLikesAdapter.ReportViews(ctx, noteIds)
+}
+
 func (s *Service) notifyUserNoteLiked(ctx context.Context, uid, noteId int64) {
 	concurrent.SafeGo2(ctx, concurrent.SafeGo2Opt{
 		Name:       "pilot.noteinteract.notify_user",
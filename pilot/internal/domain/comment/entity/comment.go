@@ -19,6 +19,9 @@ type Comment struct {
 	SubsCount int64
 	Images    []*CommentImage
 	AtUsers   []*AtUser
+	IsEdited  bool
+	Etime     int64 // 最后一次编辑时间
+	Emojis    []*CommentEmoji
 }
 
 type AtUser struct {
@@ -34,6 +37,18 @@ type CommentImage struct {
 	Type   string
 }
 
+// 评论内容中以[pack:item]形式引用的表情
+type CommentEmoji struct {
+	Token   string
+	Pack    string
+	Code    string
+	Key     string
+	Width   uint32
+	Height  uint32
+	Format  string
+	Version int64
+}
+
 // 子评论列表
 type SubComments struct {
 	Items      []*Comment
@@ -0,0 +1,21 @@
+package entity
+
+// 表情包
+type EmojiPack struct {
+	Code     string
+	Name     string
+	CoverKey string
+	Version  int64
+}
+
+// 表情包中的表情
+type EmojiItem struct {
+	Pack    string
+	Code    string
+	Name    string
+	Key     string
+	Width   uint32
+	Height  uint32
+	Format  string
+	Version int64
+}
@@ -0,0 +1,17 @@
+package entity
+
+import "github.com/ryanreadbooks/whimer/pilot/internal/domain/comment/vo"
+
+// 作者对笔记的评论设置
+type CommentSetting struct {
+	Oid             int64
+	Mode            vo.CommentMode
+	BlockedKeywords []string
+	Mtime           int64
+}
+
+// 被作者禁止评论的用户
+type MutedUser struct {
+	Uid   int64
+	Ctime int64
+}
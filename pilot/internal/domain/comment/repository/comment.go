@@ -18,11 +18,13 @@ type CommentAdapter interface {
 	BatchCheckUsersLikeComment(ctx context.Context, mappings map[int64][]int64) (map[int64]map[int64]bool, error)
 
 	// 发布评论
-	AddComment(ctx context.Context, p *AddCommentParams) (int64, error)
+	AddComment(ctx context.Context, p *AddCommentParams) (*AddCommentResult, error)
 	// 获取评论
 	GetComment(ctx context.Context, commentId int64) (*entity.Comment, error)
 	// 获取评论发布者
 	GetCommentUser(ctx context.Context, commentId int64) (int64, error)
+	// 编辑评论
+	EditComment(ctx context.Context, p *EditCommentParams) (*EditCommentResult, error)
 	// 删除评论
 	DelComment(ctx context.Context, commentId, oid int64) error
 	// 置顶/取消置顶评论
@@ -44,6 +46,24 @@ type CommentAdapter interface {
 	PageGetDetailedComments(ctx context.Context, p *PageGetDetailedCommentsParams) (*PageGetDetailedCommentsResult, error)
 	// 获取置顶评论
 	GetPinnedComment(ctx context.Context, oid int64) (*entity.DetailedComment, error)
+
+	// 列出上架中的表情包
+	ListEmojiPacks(ctx context.Context) ([]*entity.EmojiPack, error)
+	// 获取表情包及其上架中的表情
+	GetEmojiPack(ctx context.Context, code string) (*entity.EmojiPack, []*entity.EmojiItem, error)
+
+	// 获取笔记的评论设置
+	GetCommentSetting(ctx context.Context, oid int64) (*entity.CommentSetting, error)
+	// 更新笔记的评论设置
+	UpdateCommentSetting(ctx context.Context, p *UpdateCommentSettingParams) (*entity.CommentSetting, error)
+	// 禁止/解除禁止用户评论
+	MuteCommentUser(ctx context.Context, uid int64, action vo.MuteAction) error
+	// 分页获取禁止评论的用户
+	ListMutedCommentUsers(ctx context.Context, cursor int64, count int32) (*ListMutedCommentUsersResult, error)
+	// 分页获取等待审核的评论
+	ListHeldComments(ctx context.Context, p *ListHeldCommentsParams) (*PageGetCommentsResult, error)
+	// 通过等待审核的评论 返回通过的评论
+	ApproveHeldComments(ctx context.Context, oid int64, commentIds []int64) ([]*entity.Comment, error)
 }
 
 type BatchCheckCommentedParams struct {
@@ -67,16 +87,39 @@ type AddCommentParams struct {
 	AtUsers  []vo.AtUser
 }
 
+type AddCommentResult struct {
+	CommentId int64
+	// 等待作者审核
+	Held bool
+}
+
+type EditCommentParams struct {
+	CommentId int64
+	Content   string
+	Images    []vo.CommentImage
+	AtUsers   []vo.AtUser
+}
+
+type EditCommentResult struct {
+	Etime int64
+	// 本次编辑新增的@用户
+	AddedAtUsers []vo.AtUser
+	// 编辑后等待作者审核
+	Held bool
+}
+
 type PageGetCommentsParams struct {
-	Oid    int64
-	Cursor int64
-	SortBy vo.SortType
+	Oid         int64
+	Cursor      int64
+	TrendCursor string
+	SortBy      vo.SortType
 }
 
 type PageGetCommentsResult struct {
-	Items      []*entity.Comment
-	NextCursor int64
-	HasNext    bool
+	Items           []*entity.Comment
+	NextCursor      int64
+	NextTrendCursor string
+	HasNext         bool
 }
 
 type PageGetSubCommentsParams struct {
@@ -86,13 +129,33 @@ type PageGetSubCommentsParams struct {
 }
 
 type PageGetDetailedCommentsParams struct {
-	Oid    int64
-	Cursor int64
-	SortBy vo.SortType
+	Oid         int64
+	Cursor      int64
+	TrendCursor string
+	SortBy      vo.SortType
 }
 
 type PageGetDetailedCommentsResult struct {
-	Items      []*entity.DetailedComment
+	Items           []*entity.DetailedComment
+	NextCursor      int64
+	NextTrendCursor string
+	HasNext         bool
+}
+
+type UpdateCommentSettingParams struct {
+	Oid             int64
+	Mode            vo.CommentMode
+	BlockedKeywords []string
+}
+
+type ListMutedCommentUsersResult struct {
+	Items      []*entity.MutedUser
 	NextCursor int64
 	HasNext    bool
 }
+
+type ListHeldCommentsParams struct {
+	Oid    int64
+	Cursor int64
+	Count  int32
+}
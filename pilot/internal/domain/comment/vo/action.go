@@ -6,6 +6,7 @@ type CommentType int32
 const (
 	CommentTypeText      CommentType = 0 // 纯文本
 	CommentTypeImageText CommentType = 1 // 图文
+	CommentTypeEmoji     CommentType = 2 // 表情
 )
 
 // 点赞/点踩操作
@@ -24,6 +25,23 @@ const (
 	PinActionPin   PinAction = 1 // 置顶
 )
 
+// 禁止评论操作
+type MuteAction int8
+
+const (
+	MuteActionUnmute MuteAction = 0 // 解除禁止
+	MuteActionMute   MuteAction = 1 // 禁止
+)
+
+// 笔记的评论模式
+type CommentMode int32
+
+const (
+	CommentModeOpen      CommentMode = 0 // 所有人可评论
+	CommentModeClosed    CommentMode = 1 // 关闭评论
+	CommentModeFollowers CommentMode = 2 // 仅关注作者的用户可评论
+)
+
 // 排序类型
 type SortType int32
 
@@ -21,6 +21,7 @@ const (
 	ObjectTypeNoteVideo      ObjectType = "note_video"
 	ObjectTypeNoteVideoCover ObjectType = "note_video_cover"
 	ObjectTypeCommentImage   ObjectType = "comment_image"
+	ObjectTypeCommentEmoji   ObjectType = "comment_emoji" // 评论表情 由运营维护 不开放给用户上传
 )
 
 func (t ObjectType) String() string {
@@ -137,6 +138,12 @@ var objectTypeMetadataMap = map[ObjectType]ObjectTypeMetadata{
 		PrependPrefix: true,
 		stringer:      keygen.RandomStringerV7{},
 	},
+	ObjectTypeCommentEmoji: {
+		Bucket:        "pics",
+		Prefix:        "cmt_emoji",
+		PrependBucket: true,
+		PrependPrefix: true,
+	},
 }
 
 // ObjectInfo 通用对象信息
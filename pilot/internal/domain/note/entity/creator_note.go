@@ -12,6 +12,7 @@ type CreatorNote struct {
 	Title      string
 	Desc       string
 	Privacy    vo.Visibility
+	Audience   []int64 // 仅指定用户可见时的用户
 	AssetType  vo.AssetType
 	Status     vo.NoteStatus
 	Type       vo.NoteType
@@ -25,12 +26,14 @@ type CreatorNote struct {
 
 	Likes   int64
 	Replies int64
+	Views   int64
 }
 
 type CreateNoteParams struct {
 	Title     string
 	Desc      string
 	Privacy   vo.Visibility
+	Audience  []int64 // 仅指定用户可见时的用户
 	AssetType vo.AssetType
 
 	Images []*NoteImage
@@ -38,6 +41,10 @@ type CreateNoteParams struct {
 
 	AtUsers mentionvo.AtUserList
 	Tags    []*NoteTag
+
+	// 仅创建时生效
+	Draft     bool  // 保存为草稿
+	PublishAt int64 // 定时发布时间 为0表示立即发布
 }
 
 // 补充视频文件目标id
@@ -62,3 +69,16 @@ type PageListNotesResult struct {
 	Total int64
 	Items []*CreatorNote
 }
+
+// ListDraftsParams 草稿列表查询参数
+type ListDraftsParams struct {
+	Cursor int64
+	Count  int32
+}
+
+// ListDraftsResult 草稿列表查询结果
+type ListDraftsResult struct {
+	Items      []*CreatorNote
+	NextCursor int64
+	HasNext    bool
+}
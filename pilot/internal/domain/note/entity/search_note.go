@@ -13,4 +13,5 @@ type SearchNote struct {
 	TagList        []*SearchedNoteTag
 	AssetType      vo.AssetType
 	Visibility     vo.Visibility
+	AudienceUids   []int64 // 仅指定用户可见时的用户
 }
@@ -14,6 +14,10 @@ type NoteCreatorAdapter interface {
 	DeleteNote(ctx context.Context, noteId int64) error
 	PageListNotes(ctx context.Context, params *entity.PageListNotesParams) (*entity.PageListNotesResult, error)
 
+	// 草稿相关
+	ListDrafts(ctx context.Context, params *entity.ListDraftsParams) (*entity.ListDraftsResult, error)
+	PublishDraft(ctx context.Context, noteId int64, publishAt int64) error
+
 	// Tag 相关
 	AddTag(ctx context.Context, name string) (int64, error)
 	SearchTags(ctx context.Context, name string) ([]*entity.SearchedNoteTag, error)
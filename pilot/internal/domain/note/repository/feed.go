@@ -20,6 +20,9 @@ type NoteFeedAdapter interface {
 	// 获取笔记
 	RandomGet(ctx context.Context, count int32) ([]*entity.FeedNote, error)
 
+	// 获取推荐笔记 cursor为空时开始新的推荐会话
+	RecommendGet(ctx context.Context, uid int64, needNum int32, cursor string) ([]*entity.FeedNote, *CursorPageResultV2, error)
+
 	GetNote(ctx context.Context, noteId int64) (*entity.FeedNote, *entity.FeedNoteExt, error)
 
 	BatchGetNotes(ctx context.Context, noteIds []int64) (map[int64]*entity.FeedNote, error)
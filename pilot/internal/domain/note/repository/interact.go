@@ -46,4 +46,7 @@ type NoteLikesAdapter interface {
 
 	// 获取笔记点赞数量
 	GetLikeCount(ctx context.Context, noteId int64) (int64, error)
+
+	// 批量上报浏览过的笔记
+	ReportViews(ctx context.Context, noteIds []int64) error
 }
@@ -19,6 +19,7 @@ type SearchNoteParams struct {
 	PageToken string
 	Count     int32
 	Filters   []*NoteSearchFilter
+	ViewerUid int64 // 搜索者 搜索结果只包含搜索者可见的笔记
 }
 
 // 搜索笔记结果
@@ -3,12 +3,15 @@ package vo
 type Visibility int8
 
 const (
-	VisibilityPublic  Visibility = 1
-	VisibilityPrivate Visibility = 2
+	VisibilityPublic          Visibility = 1
+	VisibilityPrivate         Visibility = 2
+	VisibilityFollowers       Visibility = 3 // 仅粉丝可见
+	VisibilityMutualFollowers Visibility = 4 // 仅互关好友可见
+	VisibilityCustom          Visibility = 5 // 仅指定用户可见
 )
 
 func (v Visibility) CheckValid() bool {
-	return v == VisibilityPublic || v == VisibilityPrivate
+	return v >= VisibilityPublic && v <= VisibilityCustom
 }
 
 type AssetType int8
@@ -72,11 +75,14 @@ const (
 	NoteStatusAuditing  NoteStatus = "auditing"
 	NoteStatusRejected  NoteStatus = "rejected"
 	NoteStatusBanned    NoteStatus = "banned"
+	NoteStatusDraft     NoteStatus = "draft"
+	NoteStatusScheduled NoteStatus = "scheduled"
 )
 
 func (s NoteStatus) IsValid() bool {
 	switch s {
-	case NoteStatusPublished, NoteStatusAuditing, NoteStatusBanned:
+	case NoteStatusPublished, NoteStatusAuditing, NoteStatusBanned,
+		NoteStatusDraft, NoteStatusScheduled:
 		return true
 	}
 	return false
@@ -95,4 +101,4 @@ func (a LikeAction) IsDo() bool {
 
 func (a LikeAction) IsUndo() bool {
 	return a == LikeActionUndo
-}
\ No newline at end of file
+}
@@ -36,13 +36,13 @@ func (h *Handler) PublishNoteComment() http.HandlerFunc {
 		}
 
 		ctx := r.Context()
-		commentId, err := h.commentApp.PublishComment(ctx, cmd)
+		res, err := h.commentApp.PublishComment(ctx, cmd)
 		if err != nil {
 			xhttp.Error(r, w, err)
 			return
 		}
 
-		httpx.OkJson(w, &dto.PublishCommentResult{CommentId: commentId})
+		httpx.OkJson(w, res)
 	}
 }
 
@@ -107,6 +107,26 @@ func (h *Handler) PageGetNoteComments() http.HandlerFunc {
 	}
 }
 
+// 编辑评论
+func (h *Handler) EditNoteComment() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cmd, err := xhttp.ParseValidate[dto.EditCommentCommand](httpx.ParseJsonBody, r)
+		if err != nil {
+			xhttp.Error(r, w, err)
+			return
+		}
+
+		ctx := r.Context()
+		res, err := h.commentApp.EditComment(ctx, cmd)
+		if err != nil {
+			xhttp.Error(r, w, err)
+			return
+		}
+
+		httpx.OkJson(w, res)
+	}
+}
+
 func (h *Handler) DelNoteComment() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		cmd, err := xhttp.ParseValidate[dto.DeleteCommentCommand](httpx.ParseJsonBody, r)
@@ -221,6 +241,159 @@ func (h *Handler) UploadCommentImages() http.HandlerFunc {
 	}
 }
 
+// 列出表情包
+func (h *Handler) ListCommentEmojiPacks() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, err := h.commentApp.ListEmojiPacks(r.Context())
+		if err != nil {
+			xhttp.Error(r, w, err)
+			return
+		}
+
+		httpx.OkJson(w, res)
+	}
+}
+
+// 获取表情包及其表情
+func (h *Handler) GetCommentEmojiPack() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q, err := xhttp.ParseValidate[dto.GetEmojiPackQuery](httpx.ParseForm, r)
+		if err != nil {
+			xhttp.Error(r, w, err)
+			return
+		}
+
+		ctx := r.Context()
+		res, err := h.commentApp.GetEmojiPack(ctx, q)
+		if err != nil {
+			xhttp.Error(r, w, err)
+			return
+		}
+
+		httpx.OkJson(w, res)
+	}
+}
+
+// 获取笔记的评论设置
+func (h *Handler) GetNoteCommentSetting() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q, err := xhttp.ParseValidate[dto.GetCommentSettingQuery](httpx.ParseForm, r)
+		if err != nil {
+			xhttp.Error(r, w, err)
+			return
+		}
+
+		ctx := r.Context()
+		res, err := h.commentApp.GetCommentSetting(ctx, q)
+		if err != nil {
+			xhttp.Error(r, w, err)
+			return
+		}
+
+		httpx.OkJson(w, res)
+	}
+}
+
+// 更新笔记的评论设置
+func (h *Handler) UpdateNoteCommentSetting() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cmd, err := xhttp.ParseValidate[dto.UpdateCommentSettingCommand](httpx.ParseJsonBody, r)
+		if err != nil {
+			xhttp.Error(r, w, err)
+			return
+		}
+
+		ctx := r.Context()
+		res, err := h.commentApp.UpdateCommentSetting(ctx, cmd)
+		if err != nil {
+			xhttp.Error(r, w, err)
+			return
+		}
+
+		httpx.OkJson(w, res)
+	}
+}
+
+// 禁止/解除禁止用户评论
+func (h *Handler) MuteCommentUser() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cmd, err := xhttp.ParseValidate[dto.MuteCommentUserCommand](httpx.ParseJsonBody, r)
+		if err != nil {
+			xhttp.Error(r, w, err)
+			return
+		}
+
+		ctx := r.Context()
+		err = h.commentApp.MuteCommentUser(ctx, cmd)
+		if err != nil {
+			xhttp.Error(r, w, err)
+			return
+		}
+
+		httpx.OkJson(w, nil)
+	}
+}
+
+// 列出被禁止评论的用户
+func (h *Handler) ListMutedCommentUsers() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q, err := xhttp.ParseValidate[dto.ListMutedCommentUsersQuery](httpx.ParseForm, r)
+		if err != nil {
+			xhttp.Error(r, w, err)
+			return
+		}
+
+		ctx := r.Context()
+		res, err := h.commentApp.ListMutedCommentUsers(ctx, q)
+		if err != nil {
+			xhttp.Error(r, w, err)
+			return
+		}
+
+		httpx.OkJson(w, res)
+	}
+}
+
+// 列出笔记下等待审核的评论
+func (h *Handler) ListHeldNoteComments() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q, err := xhttp.ParseValidate[dto.ListHeldCommentsQuery](httpx.ParseForm, r)
+		if err != nil {
+			xhttp.Error(r, w, err)
+			return
+		}
+
+		ctx := r.Context()
+		res, err := h.commentApp.ListHeldComments(ctx, q)
+		if err != nil {
+			xhttp.Error(r, w, err)
+			return
+		}
+
+		httpx.OkJson(w, res)
+	}
+}
+
+// 通过等待审核的评论
+func (h *Handler) ApproveHeldNoteComments() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cmd, err := xhttp.ParseValidate[dto.ApproveHeldCommentsCommand](httpx.ParseJsonBody, r)
+		if err != nil {
+			xhttp.Error(r, w, err)
+			return
+		}
+
+		ctx := r.Context()
+		res, err := h.commentApp.ApproveHeldComments(ctx, cmd)
+		if err != nil {
+			xhttp.Error(r, w, err)
+			return
+		}
+
+		httpx.OkJson(w, res)
+	}
+}
+
 func newUploadTicket(t *storagevo.UploadTicketDeprecated) *dto.UploadTicket {
 	return &dto.UploadTicket{
 		StoreKeys:   t.FileIds,
@@ -1,7 +1,6 @@
 package feed
 
 import (
-	"math/rand"
 	"net/http"
 
 	"github.com/ryanreadbooks/whimer/misc/xhttp"
@@ -26,22 +25,23 @@ func NewHandler(c *config.Config, manager *app.Manager) *Handler {
 
 func (h *Handler) GetRecommend() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		req, err := xhttp.ParseValidate[dto.GetRandomQuery](httpx.ParseForm, r)
+		req, err := xhttp.ParseValidate[dto.GetRecommendQuery](httpx.ParseForm, r)
 		if err != nil {
 			xhttp.Error(r, w, err)
 			return
 		}
 
-		resp, err := h.noteFeedApp.GetRandom(r.Context(), req)
+		resp, page, err := h.noteFeedApp.GetRecommend(r.Context(), req)
 		if err != nil {
 			xhttp.Error(r, w, err)
 			return
 		}
 
-		// make it random
-		rand.Shuffle(len(resp), func(i, j int) { resp[i], resp[j] = resp[j], resp[i] })
-
-		xhttp.OkJson(w, resp)
+		xhttp.OkJson(w, &dto.GetRecommendResult{
+			Items:      resp,
+			NextCursor: page.NextCursor,
+			HasNext:    page.HasNext,
+		})
 	}
 }
 
@@ -90,6 +90,46 @@ func (h *Handler) CreatorPageListNotes() http.HandlerFunc {
 	}
 }
 
+// 列出草稿
+func (h *Handler) CreatorListDrafts() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query, err := xhttp.ParseValidate[dto.ListDraftsQuery](httpx.ParseForm, r)
+		if err != nil {
+			xhttp.Error(r, w, err)
+			return
+		}
+
+		ctx := r.Context()
+		result, err := h.noteCreatorApp.ListDrafts(ctx, query)
+		if err != nil {
+			xhttp.Error(r, w, err)
+			return
+		}
+
+		xhttp.OkJson(w, result)
+	}
+}
+
+// 发布草稿
+func (h *Handler) CreatorPublishDraft() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		command, err := xhttp.ParseValidateJsonBody[dto.PublishDraftCommand](r)
+		if err != nil {
+			xhttp.Error(r, w, err)
+			return
+		}
+
+		ctx := r.Context()
+		err = h.noteCreatorApp.PublishDraft(ctx, command)
+		if err != nil {
+			xhttp.Error(r, w, err)
+			return
+		}
+
+		xhttp.OkJson(w, nil)
+	}
+}
+
 // 获取个人笔记
 func (h *Handler) CreatorGetNote() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -53,6 +53,25 @@ func (h *Handler) LikeNote() http.HandlerFunc {
 	}
 }
 
+// 批量上报浏览过的笔记
+func (h *Handler) ReportNoteViews() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, err := xhttp.ParseValidate[dto.ReportNoteViewsCommand](httpx.ParseJsonBody, r)
+		if err != nil {
+			xhttp.Error(r, w, xerror.ErrArgs.Msg(err.Error()))
+			return
+		}
+
+		err = h.noteInteractApp.ReportNoteViews(r.Context(), req)
+		if err != nil {
+			xhttp.Error(r, w, err)
+			return
+		}
+
+		xhttp.OkJson(w, nil)
+	}
+}
+
 // 获取笔记点赞数量
 func (h *Handler) GetNoteLikeCount() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
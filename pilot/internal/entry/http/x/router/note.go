@@ -26,6 +26,10 @@ func regNoteRoutes(group *xhttp.RouterGroup, h *handler.Handler) {
 				v1g.Get("/list", h.Note.CreatorPageListNotes())
 				// 获取笔记
 				v1g.Get("/get/:note_id", h.Note.CreatorGetNote())
+				// 列出草稿
+				v1g.Get("/drafts", h.Note.CreatorListDrafts())
+				// 发布草稿
+				v1g.Post("/drafts/publish", h.Note.CreatorPublishDraft())
 			}
 		}
 
@@ -49,6 +53,17 @@ func regNoteRoutes(group *xhttp.RouterGroup, h *handler.Handler) {
 			}
 		}
 
+		// 笔记浏览上报 未登录用户也可以上报
+		// /note/view
+		noteView := noteGroup.Group("/view", middleware.CanLogin())
+		{
+			v1g := noteView.Group("/v1")
+			{
+				// 批量上报浏览过的笔记
+				v1g.Post("/report", h.Note.ReportNoteViews())
+			}
+		}
+
 		// 笔记评论相关接口
 		// /note/comment
 		noteCommentGroup := noteGroup.Group("/comment", middleware.CanLogin())
@@ -64,6 +79,8 @@ func regNoteRoutes(group *xhttp.RouterGroup, h *handler.Handler) {
 				v1AuthedGroup.Get("/subs", h.Comment.PageGetNoteSubComments())
 				// 分页获取评论
 				v1AuthedGroup.Get("/pages", h.Comment.PageGetNoteComments())
+				// 编辑评论
+				v1AuthedGroup.Post("/edit", h.Comment.EditNoteComment())
 				// 删除评论
 				v1AuthedGroup.Post("/del", h.Comment.DelNoteComment())
 				// 置顶评论
@@ -76,6 +93,24 @@ func regNoteRoutes(group *xhttp.RouterGroup, h *handler.Handler) {
 				v1AuthedGroup.Get("/likes", h.Comment.GetNoteCommentLikeCount())
 				// 评论中插入图片申请上传凭证
 				v1AuthedGroup.Get("/upload/images", h.Comment.UploadCommentImages())
+				// 获取笔记的评论设置
+				v1AuthedGroup.Get("/setting", h.Comment.GetNoteCommentSetting())
+				// 更新笔记的评论设置
+				v1AuthedGroup.Post("/setting/update", h.Comment.UpdateNoteCommentSetting())
+				// 禁止/解除禁止用户评论
+				v1AuthedGroup.Post("/mute", h.Comment.MuteCommentUser())
+				// 列出被禁止评论的用户
+				v1AuthedGroup.Get("/mute/list", h.Comment.ListMutedCommentUsers())
+				// 列出等待审核的评论
+				v1AuthedGroup.Get("/held", h.Comment.ListHeldNoteComments())
+				// 通过等待审核的评论
+				v1AuthedGroup.Post("/held/approve", h.Comment.ApproveHeldNoteComments())
+			}
+			{
+				// 列出表情包
+				v1Group.Get("/emoji/packs", h.Comment.ListCommentEmojiPacks())
+				// 获取表情包及其表情
+				v1Group.Get("/emoji/pack", h.Comment.GetCommentEmojiPack())
 			}
 		}
 	}
@@ -18,15 +18,21 @@ import (
 
 type CommentAdapterImpl struct {
 	commentCli    commentv1.CommentServiceClient
+	emojiCli      commentv1.CommentEmojiServiceClient
+	settingCli    commentv1.CommentSettingServiceClient
 	noteStatCache *notecache.StatStore
 }
 
 func NewCommentAdapterImpl(
 	commentCli commentv1.CommentServiceClient,
+	emojiCli commentv1.CommentEmojiServiceClient,
+	settingCli commentv1.CommentSettingServiceClient,
 	noteStatCache *notecache.StatStore,
 ) *CommentAdapterImpl {
 	return &CommentAdapterImpl{
 		commentCli:    commentCli,
+		emojiCli:      emojiCli,
+		settingCli:    settingCli,
 		noteStatCache: noteStatCache,
 	}
 }
@@ -93,25 +99,9 @@ func (a *CommentAdapterImpl) BatchCheckUsersLikeComment(ctx context.Context,
 	return result, nil
 }
 
-func (a *CommentAdapterImpl) AddComment(ctx context.Context, p *repository.AddCommentParams) (int64, error) {
-	images := make([]*commentv1.CommentReqImage, 0, len(p.Images))
-	for _, img := range p.Images {
-		images = append(images, &commentv1.CommentReqImage{
-			StoreKey: img.StoreKey,
-			Width:    img.Width,
-			Height:   img.Height,
-			Format:   img.Format,
-		})
-	}
-
-	atUsers := make([]*commentv1.CommentAtUser, 0, len(p.AtUsers))
-	for _, au := range p.AtUsers {
-		atUsers = append(atUsers, &commentv1.CommentAtUser{
-			Uid:      au.Uid,
-			Nickname: au.Nickname,
-		})
-	}
-
+func (a *CommentAdapterImpl) AddComment(ctx context.Context,
+	p *repository.AddCommentParams,
+) (*repository.AddCommentResult, error) {
 	resp, err := a.commentCli.AddComment(ctx,
 		&commentv1.AddCommentRequest{
 			Type:     commentv1.CommentType(p.Type),
@@ -120,17 +110,41 @@ func (a *CommentAdapterImpl) AddComment(ctx context.Context, p *repository.AddCo
 			RootId:   p.RootId,
 			ParentId: p.ParentId,
 			ReplyUid: p.ReplyUid,
-			Images:   images,
-			AtUsers:  atUsers,
+			Images:   convert.CommentImagesAsPb(p.Images),
+			AtUsers:  convert.AtUsersAsPb(p.AtUsers),
 		})
 	if err != nil {
-		return 0, xerror.Wrap(err)
+		return nil, xerror.Wrap(err)
 	}
 
 	// 同步评论增量到缓存
 	a.syncCommentCount(ctx, p.Oid, 1)
 
-	return resp.CommentId, nil
+	return &repository.AddCommentResult{
+		CommentId: resp.GetCommentId(),
+		Held:      resp.GetHeld(),
+	}, nil
+}
+
+func (a *CommentAdapterImpl) EditComment(ctx context.Context,
+	p *repository.EditCommentParams,
+) (*repository.EditCommentResult, error) {
+	resp, err := a.commentCli.EditComment(ctx,
+		&commentv1.EditCommentRequest{
+			CommentId: p.CommentId,
+			Content:   p.Content,
+			Images:    convert.CommentImagesAsPb(p.Images),
+			AtUsers:   convert.AtUsersAsPb(p.AtUsers),
+		})
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return &repository.EditCommentResult{
+		Etime:        resp.GetEtime(),
+		AddedAtUsers: convert.PbAtUsersToVo(resp.GetAddedAtUsers()),
+		Held:         resp.GetHeld(),
+	}, nil
 }
 
 func (a *CommentAdapterImpl) GetComment(ctx context.Context, commentId int64) (*entity.Comment, error) {
@@ -273,9 +287,10 @@ func (a *CommentAdapterImpl) PageGetRootComments(
 ) (*repository.PageGetCommentsResult, error) {
 	resp, err := a.commentCli.PageGetComment(ctx,
 		&commentv1.PageGetCommentRequest{
-			Oid:    p.Oid,
-			Cursor: p.Cursor,
-			SortBy: commentv1.SortType(p.SortBy),
+			Oid:         p.Oid,
+			Cursor:      p.Cursor,
+			SortBy:      commentv1.SortType(p.SortBy),
+			TrendCursor: p.TrendCursor,
 		})
 	if err != nil {
 		return nil, xerror.Wrap(err)
@@ -287,9 +302,10 @@ func (a *CommentAdapterImpl) PageGetRootComments(
 	}
 
 	return &repository.PageGetCommentsResult{
-		Items:      items,
-		NextCursor: resp.NextCursor,
-		HasNext:    resp.HasNext,
+		Items:           items,
+		NextCursor:      resp.NextCursor,
+		NextTrendCursor: resp.NextTrendCursor,
+		HasNext:         resp.HasNext,
 	}, nil
 }
 
@@ -323,9 +339,10 @@ func (a *CommentAdapterImpl) PageGetDetailedComments(
 ) (*repository.PageGetDetailedCommentsResult, error) {
 	resp, err := a.commentCli.PageGetDetailedComment(ctx,
 		&commentv1.PageGetDetailedCommentRequest{
-			Oid:    p.Oid,
-			Cursor: p.Cursor,
-			SortBy: commentv1.SortType(p.SortBy),
+			Oid:         p.Oid,
+			Cursor:      p.Cursor,
+			SortBy:      commentv1.SortType(p.SortBy),
+			TrendCursor: p.TrendCursor,
 		})
 	if err != nil {
 		return nil, xerror.Wrap(err)
@@ -337,9 +354,10 @@ func (a *CommentAdapterImpl) PageGetDetailedComments(
 	}
 
 	return &repository.PageGetDetailedCommentsResult{
-		Items:      items,
-		NextCursor: resp.NextCursor,
-		HasNext:    resp.HasNext,
+		Items:           items,
+		NextCursor:      resp.NextCursor,
+		NextTrendCursor: resp.NextTrendCursor,
+		HasNext:         resp.HasNext,
 	}, nil
 }
 
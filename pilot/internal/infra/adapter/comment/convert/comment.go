@@ -30,6 +30,20 @@ func PbCommentItemToEntity(p *commentv1.CommentItem) *entity.Comment {
 		})
 	}
 
+	emojis := make([]*entity.CommentEmoji, 0, len(p.Emojis))
+	for _, e := range p.Emojis {
+		emojis = append(emojis, &entity.CommentEmoji{
+			Token:   e.GetToken(),
+			Pack:    e.GetPack(),
+			Code:    e.GetCode(),
+			Key:     e.GetKey(),
+			Width:   e.GetWidth(),
+			Height:  e.GetHeight(),
+			Format:  e.GetFormat(),
+			Version: e.GetVersion(),
+		})
+	}
+
 	return &entity.Comment{
 		Id:        p.Id,
 		Oid:       p.Oid,
@@ -48,6 +62,9 @@ func PbCommentItemToEntity(p *commentv1.CommentItem) *entity.Comment {
 		SubsCount: p.SubsCount,
 		Images:    images,
 		AtUsers:   atUsers,
+		IsEdited:  p.IsEdited,
+		Etime:     p.Etime,
+		Emojis:    emojis,
 	}
 }
 
@@ -71,6 +88,74 @@ func PbDetailedCommentItemToEntity(p *commentv1.DetailedCommentItem) *entity.Det
 	}
 }
 
+func PbEmojiPackToEntity(p *commentv1.EmojiPack) *entity.EmojiPack {
+	if p == nil {
+		return nil
+	}
+
+	return &entity.EmojiPack{
+		Code:     p.GetCode(),
+		Name:     p.GetName(),
+		CoverKey: p.GetCoverKey(),
+		Version:  p.GetVersion(),
+	}
+}
+
+func PbEmojiItemToEntity(p *commentv1.EmojiItem) *entity.EmojiItem {
+	if p == nil {
+		return nil
+	}
+
+	return &entity.EmojiItem{
+		Pack:    p.GetPack(),
+		Code:    p.GetCode(),
+		Name:    p.GetName(),
+		Key:     p.GetKey(),
+		Width:   p.GetWidth(),
+		Height:  p.GetHeight(),
+		Format:  p.GetFormat(),
+		Version: p.GetVersion(),
+	}
+}
+
+func CommentImagesAsPb(images []vo.CommentImage) []*commentv1.CommentReqImage {
+	res := make([]*commentv1.CommentReqImage, 0, len(images))
+	for _, img := range images {
+		res = append(res, &commentv1.CommentReqImage{
+			StoreKey: img.StoreKey,
+			Width:    img.Width,
+			Height:   img.Height,
+			Format:   img.Format,
+		})
+	}
+
+	return res
+}
+
+func AtUsersAsPb(atUsers []vo.AtUser) []*commentv1.CommentAtUser {
+	res := make([]*commentv1.CommentAtUser, 0, len(atUsers))
+	for _, au := range atUsers {
+		res = append(res, &commentv1.CommentAtUser{
+			Uid:      au.Uid,
+			Nickname: au.Nickname,
+		})
+	}
+
+	return res
+}
+
+func PbAtUsersToVo(atUsers []*commentv1.CommentAtUser) []vo.AtUser {
+	res := make([]vo.AtUser, 0, len(atUsers))
+	for _, au := range atUsers {
+		res = append(res, vo.AtUser{
+			Uid:      au.GetUid(),
+			Nickname: au.GetNickname(),
+		})
+	}
+
+	return res
+}
+
 func PinActionAsPb(action vo.PinAction) commentv1.CommentAction {
 	switch action {
 	case vo.PinActionPin:
@@ -80,6 +165,28 @@ func PinActionAsPb(action vo.PinAction) commentv1.CommentAction {
 	}
 }
 
+func MuteActionAsPb(action vo.MuteAction) commentv1.CommentAction {
+	switch action {
+	case vo.MuteActionMute:
+		return commentv1.CommentAction_COMMENT_ACTION_DO
+	default:
+		return commentv1.CommentAction_COMMENT_ACTION_UNDO
+	}
+}
+
+func PbCommentSettingToEntity(p *commentv1.CommentSetting) *entity.CommentSetting {
+	if p == nil {
+		return nil
+	}
+
+	return &entity.CommentSetting{
+		Oid:             p.GetOid(),
+		Mode:            vo.CommentMode(p.GetMode()),
+		BlockedKeywords: p.GetBlockedKeywords(),
+		Mtime:           p.GetMtime(),
+	}
+}
+
 func ThumbActionAsPb(action vo.ThumbAction) commentv1.CommentAction {
 	switch action {
 	case vo.ThumbActionDo:
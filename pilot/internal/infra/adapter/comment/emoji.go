@@ -0,0 +1,42 @@
+package comment
+
+import (
+	"context"
+
+	commentv1 "github.com/ryanreadbooks/whimer/idl/gen/go/comment/api/v1"
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/pilot/internal/domain/comment/entity"
+	"github.com/ryanreadbooks/whimer/pilot/internal/infra/adapter/comment/convert"
+)
+
+func (a *CommentAdapterImpl) ListEmojiPacks(ctx context.Context) ([]*entity.EmojiPack, error) {
+	resp, err := a.emojiCli.ListEmojiPacks(ctx, &commentv1.ListEmojiPacksRequest{})
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	packs := make([]*entity.EmojiPack, 0, len(resp.GetPacks()))
+	for _, p := range resp.GetPacks() {
+		packs = append(packs, convert.PbEmojiPackToEntity(p))
+	}
+
+	return packs, nil
+}
+
+func (a *CommentAdapterImpl) GetEmojiPack(ctx context.Context, code string) (
+	*entity.EmojiPack, []*entity.EmojiItem, error,
+) {
+	resp, err := a.emojiCli.GetEmojiPack(ctx, &commentv1.GetEmojiPackRequest{
+		Code: code,
+	})
+	if err != nil {
+		return nil, nil, xerror.Wrap(err)
+	}
+
+	items := make([]*entity.EmojiItem, 0, len(resp.GetItems()))
+	for _, item := range resp.GetItems() {
+		items = append(items, convert.PbEmojiItemToEntity(item))
+	}
+
+	return convert.PbEmojiPackToEntity(resp.GetPack()), items, nil
+}
@@ -0,0 +1,119 @@
+package comment
+
+import (
+	"context"
+
+	commentv1 "github.com/ryanreadbooks/whimer/idl/gen/go/comment/api/v1"
+	"github.com/ryanreadbooks/whimer/misc/xerror"
+	"github.com/ryanreadbooks/whimer/pilot/internal/domain/comment/entity"
+	"github.com/ryanreadbooks/whimer/pilot/internal/domain/comment/repository"
+	"github.com/ryanreadbooks/whimer/pilot/internal/domain/comment/vo"
+	"github.com/ryanreadbooks/whimer/pilot/internal/infra/adapter/comment/convert"
+)
+
+func (a *CommentAdapterImpl) GetCommentSetting(ctx context.Context, oid int64) (*entity.CommentSetting, error) {
+	resp, err := a.settingCli.GetCommentSetting(ctx, &commentv1.GetCommentSettingRequest{
+		Oid: oid,
+	})
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return convert.PbCommentSettingToEntity(resp.GetSetting()), nil
+}
+
+func (a *CommentAdapterImpl) UpdateCommentSetting(ctx context.Context,
+	p *repository.UpdateCommentSettingParams,
+) (*entity.CommentSetting, error) {
+	resp, err := a.settingCli.UpdateCommentSetting(ctx, &commentv1.UpdateCommentSettingRequest{
+		Oid:             p.Oid,
+		Mode:            commentv1.CommentMode(p.Mode),
+		BlockedKeywords: p.BlockedKeywords,
+	})
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return convert.PbCommentSettingToEntity(resp.GetSetting()), nil
+}
+
+func (a *CommentAdapterImpl) MuteCommentUser(ctx context.Context, uid int64, action vo.MuteAction) error {
+	_, err := a.settingCli.MuteCommentUser(ctx, &commentv1.MuteCommentUserRequest{
+		Uid:    uid,
+		Action: convert.MuteActionAsPb(action),
+	})
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+func (a *CommentAdapterImpl) ListMutedCommentUsers(ctx context.Context, cursor int64, count int32) (
+	*repository.ListMutedCommentUsersResult, error,
+) {
+	resp, err := a.settingCli.ListMutedCommentUsers(ctx, &commentv1.ListMutedCommentUsersRequest{
+		Cursor: cursor,
+		Count:  count,
+	})
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	items := make([]*entity.MutedUser, 0, len(resp.GetUsers()))
+	for _, u := range resp.GetUsers() {
+		items = append(items, &entity.MutedUser{
+			Uid:   u.GetUid(),
+			Ctime: u.GetCtime(),
+		})
+	}
+
+	return &repository.ListMutedCommentUsersResult{
+		Items:      items,
+		NextCursor: resp.GetNextCursor(),
+		HasNext:    resp.GetHasNext(),
+	}, nil
+}
+
+func (a *CommentAdapterImpl) ListHeldComments(ctx context.Context,
+	p *repository.ListHeldCommentsParams,
+) (*repository.PageGetCommentsResult, error) {
+	resp, err := a.settingCli.ListHeldComments(ctx, &commentv1.ListHeldCommentsRequest{
+		Oid:    p.Oid,
+		Cursor: p.Cursor,
+		Count:  p.Count,
+	})
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	items := make([]*entity.Comment, 0, len(resp.GetComments()))
+	for _, item := range resp.GetComments() {
+		items = append(items, convert.PbCommentItemToEntity(item))
+	}
+
+	return &repository.PageGetCommentsResult{
+		Items:      items,
+		NextCursor: resp.GetNextCursor(),
+		HasNext:    resp.GetHasNext(),
+	}, nil
+}
+
+func (a *CommentAdapterImpl) ApproveHeldComments(ctx context.Context, oid int64, commentIds []int64) (
+	[]*entity.Comment, error,
+) {
+	resp, err := a.settingCli.ApproveHeldComments(ctx, &commentv1.ApproveHeldCommentsRequest{
+		Oid:        oid,
+		CommentIds: commentIds,
+	})
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	items := make([]*entity.Comment, 0, len(resp.GetComments()))
+	for _, item := range resp.GetComments() {
+		items = append(items, convert.PbCommentItemToEntity(item))
+	}
+
+	return items, nil
+}
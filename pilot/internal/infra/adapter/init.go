@@ -67,6 +67,8 @@ func Init(c *config.Config, cache *redis.Redis) {
 
 	commentAdapter = comment.NewCommentAdapterImpl(
 		dep.Commenter(),
+		dep.CommentEmojier(),
+		dep.CommentSetter(),
 		infracache.NoteStatStore(),
 	)
 
@@ -51,6 +51,7 @@ func EntityCreateNoteParamsAsPb(params *entity.CreateNoteParams) *notev1.CreateN
 			Desc:      params.Desc,
 			Privacy:   NotePrivacyAsPb(params.Privacy),
 			AssetType: NoteAssetTypeAsPb(params.AssetType),
+			Audience:  params.Audience,
 		},
 		Images:  imageReqs,
 		Video:   videoReq,
@@ -58,6 +59,8 @@ func EntityCreateNoteParamsAsPb(params *entity.CreateNoteParams) *notev1.CreateN
 		Tags: &notev1.CreateReqTag{
 			TagList: tagList,
 		},
+		Draft:     params.Draft,
+		PublishAt: params.PublishAt,
 	}
 
 	return req
@@ -134,6 +137,7 @@ func PbNoteToCreatorEntity(note *notev1.NoteItem) *entity.CreatorNote {
 		Title:      note.GetTitle(),
 		Desc:       note.GetDesc(),
 		Privacy:    PbNotePrivacyToVo(note.GetPrivacy()),
+		Audience:   note.GetAudience(),
 		AssetType:  PbNoteAssetTypeToVo(note.GetNoteType()),
 		Status:     PbLifeCycleStateToNoteStatus(note.GetLifeCycleState()),
 		Type:       PbNoteAssetTypeToVo(note.NoteType).AsNoteType(),
@@ -146,6 +150,7 @@ func PbNoteToCreatorEntity(note *notev1.NoteItem) *entity.CreatorNote {
 		AtUsers:    BatchPbAtUsersToVos(note.GetAtUsers()),
 		Likes:      note.Likes,
 		Replies:    note.Replies,
+		Views:      note.Views,
 		OwnerId:    note.Owner,
 	}
 }
@@ -191,6 +196,10 @@ func PbLifeCycleStateToNoteStatus(state notev1.NoteLifeCycleState) vo.NoteStatus
 		return vo.NoteStatusBanned
 	case notev1.NoteLifeCycleState_LIFE_CYCLE_STATE_REJECTED:
 		return vo.NoteStatusRejected
+	case notev1.NoteLifeCycleState_LIFE_CYCLE_STATE_DRAFT:
+		return vo.NoteStatusDraft
+	case notev1.NoteLifeCycleState_LIFE_CYCLE_STATE_SCHEDULED:
+		return vo.NoteStatusScheduled
 	default:
 		return vo.NoteStatusUnknown
 	}
@@ -202,6 +211,12 @@ func PbNotePrivacyToVo(privacy int32) vo.Visibility {
 		return vo.VisibilityPublic
 	case int32(notev1.NotePrivacy_PRIVATE):
 		return vo.VisibilityPrivate
+	case int32(notev1.NotePrivacy_FOLLOWERS):
+		return vo.VisibilityFollowers
+	case int32(notev1.NotePrivacy_MUTUAL_FOLLOWERS):
+		return vo.VisibilityMutualFollowers
+	case int32(notev1.NotePrivacy_CUSTOM):
+		return vo.VisibilityCustom
 	default:
 		return vo.Visibility(notev1.NotePrivacy_NOTE_PRIVACY_UNSPECIFIED)
 	}
@@ -213,6 +228,12 @@ func NotePrivacyAsPb(privacy vo.Visibility) int32 {
 		return int32(notev1.NotePrivacy_PUBLIC)
 	case vo.VisibilityPrivate:
 		return int32(notev1.NotePrivacy_PRIVATE)
+	case vo.VisibilityFollowers:
+		return int32(notev1.NotePrivacy_FOLLOWERS)
+	case vo.VisibilityMutualFollowers:
+		return int32(notev1.NotePrivacy_MUTUAL_FOLLOWERS)
+	case vo.VisibilityCustom:
+		return int32(notev1.NotePrivacy_CUSTOM)
 	}
 	return int32(notev1.NotePrivacy_NOTE_PRIVACY_UNSPECIFIED)
 }
@@ -249,6 +270,10 @@ func NoteStatusToLifeCycleState(status vo.NoteStatus) notev1.NoteLifeCycleState
 		return notev1.NoteLifeCycleState_LIFE_CYCLE_STATE_BANNED
 	case vo.NoteStatusRejected:
 		return notev1.NoteLifeCycleState_LIFE_CYCLE_STATE_REJECTED
+	case vo.NoteStatusDraft:
+		return notev1.NoteLifeCycleState_LIFE_CYCLE_STATE_DRAFT
+	case vo.NoteStatusScheduled:
+		return notev1.NoteLifeCycleState_LIFE_CYCLE_STATE_SCHEDULED
 	default:
 		return notev1.NoteLifeCycleState_NOTE_LIFE_CYCLE_STATE_UNSPECIFIED
 	}
@@ -18,9 +18,10 @@ func EntitySearchNoteToPb(note *entity.SearchNote) *searchv1.Note {
 			Uid:      note.AuthorUid,
 			Nickname: note.AuthorNickname,
 		},
-		TagList:    BatchEntitySearchedNoteTagsAsPb(note.TagList),
-		AssetType:  VoAssetTypeAsPb(note.AssetType),
-		Visibility: VoVisibilityAsPb(note.Visibility),
+		TagList:      BatchEntitySearchedNoteTagsAsPb(note.TagList),
+		AssetType:    VoAssetTypeAsPb(note.AssetType),
+		Visibility:   VoVisibilityAsPb(note.Visibility),
+		AudienceUids: note.AudienceUids,
 	}
 }
 
@@ -60,6 +61,12 @@ func VoVisibilityAsPb(visibility vo.Visibility) searchv1.Note_Visibility {
 		return searchv1.Note_VISIBILITY_PUBLIC
 	case vo.VisibilityPrivate:
 		return searchv1.Note_VISIBILITY_PRIVATE
+	case vo.VisibilityFollowers:
+		return searchv1.Note_VISIBILITY_FOLLOWERS
+	case vo.VisibilityMutualFollowers:
+		return searchv1.Note_VISIBILITY_MUTUAL
+	case vo.VisibilityCustom:
+		return searchv1.Note_VISIBILITY_CUSTOM
 	}
 	return searchv1.Note_VISIBILITY_UNSPECIFIED
 }
@@ -102,6 +102,36 @@ func (a *CreatorAdapterImpl) PageListNotes(ctx context.Context, params *entity.P
 	}, nil
 }
 
+// 列出草稿
+func (a *CreatorAdapterImpl) ListDrafts(ctx context.Context, params *entity.ListDraftsParams) (*entity.ListDraftsResult, error) {
+	resp, err := a.noteCreatorCli.ListDrafts(ctx, &notev1.ListDraftsRequest{
+		Cursor: params.Cursor,
+		Count:  params.Count,
+	})
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return &entity.ListDraftsResult{
+		Items:      convert.BatchPbNotesToCreatorEntities(resp.GetItems()),
+		NextCursor: resp.GetNextCursor(),
+		HasNext:    resp.GetHasNext(),
+	}, nil
+}
+
+// 发布草稿 publishAt为0表示立即发布
+func (a *CreatorAdapterImpl) PublishDraft(ctx context.Context, noteId int64, publishAt int64) error {
+	_, err := a.noteCreatorCli.PublishDraft(ctx, &notev1.PublishDraftRequest{
+		NoteId:    noteId,
+		PublishAt: publishAt,
+	})
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
 // 新增标签
 func (a *CreatorAdapterImpl) AddTag(ctx context.Context, name string) (int64, error) {
 	resp, err := a.noteCreatorCli.AddTag(ctx, &notev1.AddTagRequest{Name: name})
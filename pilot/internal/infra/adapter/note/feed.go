@@ -45,6 +45,32 @@ func (a *NoteFeedAdapterImpl) RandomGet(ctx context.Context, count int32) ([]*en
 	return convert.BatchPbFeedNotesToEntities(items), nil
 }
 
+func (a *NoteFeedAdapterImpl) RecommendGet(ctx context.Context,
+	uid int64, needNum int32, cursor string) (
+	[]*entity.FeedNote, *repository.CursorPageResultV2, error,
+) {
+	resp, err := a.noteFeedServer.RecommendGet(ctx,
+		&notev1.RecommendGetRequest{
+			Uid:     uid,
+			NeedNum: needNum,
+			Cursor:  cursor,
+		})
+	if err != nil {
+		return nil, &repository.CursorPageResultV2{}, xerror.Wrap(err)
+	}
+
+	page := &repository.CursorPageResultV2{
+		NextCursor: resp.GetNextCursor(),
+		HasNext:    resp.GetHasNext(),
+	}
+	items := resp.GetItems()
+	if len(items) == 0 {
+		return []*entity.FeedNote{}, page, nil
+	}
+
+	return convert.BatchPbFeedNotesToEntities(items), page, nil
+}
+
 func (a *NoteFeedAdapterImpl) GetNote(ctx context.Context, noteId int64) (*entity.FeedNote, *entity.FeedNoteExt, error) {
 	resp, err := a.noteFeedServer.GetFeedNote(ctx,
 		&notev1.GetFeedNoteRequest{
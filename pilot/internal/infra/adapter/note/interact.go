@@ -116,6 +116,17 @@ func (a *InteractAdapterImpl) GetLikeCount(ctx context.Context, noteId int64) (i
 	return resp.GetLikes(), nil
 }
 
+func (a *InteractAdapterImpl) ReportViews(ctx context.Context, noteIds []int64) error {
+	_, err := a.noteInteractCli.ReportNoteViews(ctx, &notev1.ReportNoteViewsRequest{
+		NoteIds: noteIds,
+	})
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
 func (a *InteractAdapterImpl) BatchCheckUserLikeStatus(ctx context.Context,
 	mappings map[int64][]int64,
 ) (map[int64]map[int64]bool, error) {
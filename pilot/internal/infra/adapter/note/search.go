@@ -71,6 +71,7 @@ func (a *NoteSearchAdapterImpl) SearchNote(ctx context.Context, params *reposito
 		PageToken: params.PageToken,
 		Count:     params.Count,
 		Filters:   filters,
+		ViewerUid: params.ViewerUid,
 	})
 	if err != nil {
 		return nil, xerror.Wrap(err)
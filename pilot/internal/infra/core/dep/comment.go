@@ -7,16 +7,27 @@ import (
 )
 
 var (
-	// 笔记服务
-	commenter commentv1.CommentServiceClient
+	// 评论服务
+	commenter      commentv1.CommentServiceClient
+	commentEmojier commentv1.CommentEmojiServiceClient
+	commentSetter  commentv1.CommentSettingServiceClient
 )
 
 func InitCommenter(c *config.Config) {
-	commenter = commentv1.NewCommentServiceClient(
-		xgrpc.NewRecoverableClientConn(c.Backend.Comment),
-	)
+	conn := xgrpc.NewRecoverableClientConn(c.Backend.Comment)
+	commenter = commentv1.NewCommentServiceClient(conn)
+	commentEmojier = commentv1.NewCommentEmojiServiceClient(conn)
+	commentSetter = commentv1.NewCommentSettingServiceClient(conn)
 }
 
 func Commenter() commentv1.CommentServiceClient {
 	return commenter
 }
+
+func CommentEmojier() commentv1.CommentEmojiServiceClient {
+	return commentEmojier
+}
+
+func CommentSetter() commentv1.CommentSettingServiceClient {
+	return commentSetter
+}
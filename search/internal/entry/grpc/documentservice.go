@@ -119,3 +119,47 @@ func (s *DocumentServiceServerImpl) BatchUpdateNoteCommentCount(ctx context.Cont
 
 	return resp, nil
 }
+
+const maxBatchGetNoteCount = 500
+
+// 批量获取笔记文档
+func (s *DocumentServiceServerImpl) BatchGetNote(ctx context.Context,
+	in *searchv1.BatchGetNoteRequest) (*searchv1.BatchGetNoteResponse, error) {
+	var resp = &searchv1.BatchGetNoteResponse{}
+	if len(in.GetIds()) == 0 {
+		return resp, nil
+	}
+
+	if len(in.GetIds()) > maxBatchGetNoteCount {
+		return nil, xerror.ErrArgs.Msg("too many note ids")
+	}
+
+	notes, err := s.svc.DocumentSrv.GetNoteDocs(ctx, in.GetIds())
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Notes = notes
+	return resp, nil
+}
+
+const maxListNoteIdsCount = 1000
+
+// 按笔记id顺序分页获取笔记文档id
+func (s *DocumentServiceServerImpl) ListNoteIds(ctx context.Context,
+	in *searchv1.ListNoteIdsRequest) (*searchv1.ListNoteIdsResponse, error) {
+	if in.GetCount() <= 0 || in.GetCount() > maxListNoteIdsCount {
+		return nil, xerror.ErrArgs.Msg("invalid count")
+	}
+
+	ids, nextCursor, hasNext, err := s.svc.DocumentSrv.ListNoteDocIds(ctx, in.GetCursor(), in.GetCount())
+	if err != nil {
+		return nil, err
+	}
+
+	return &searchv1.ListNoteIdsResponse{
+		Ids:        ids,
+		NextCursor: nextCursor,
+		HasNext:    hasNext,
+	}, nil
+}
@@ -37,6 +37,7 @@ type Note struct {
 	TagList       []*NoteTag         `json:"tag_list"`
 	AssetType     pkg.NoteAssetType  `json:"asset_type"`
 	Visibility    pkg.NoteVisibility `json:"visibility"`
+	AudienceUids  []int64            `json:"audience_uids,omitempty"` // 仅指定用户可见时的用户
 	LikesCount    int64              `json:"likes_count"`
 	CommentsCount int64              `json:"comments_count"`
 }
@@ -111,6 +112,7 @@ func (Note) Mappings() *types.TypeMapping {
 			},
 			"asset_type":     types.NewKeywordProperty(),
 			"visibility":     types.NewKeywordProperty(),
+			"audience_uids":  types.NewLongNumberProperty(),
 			"likes_count":    types.NewLongNumberProperty(),
 			"comments_count": types.NewLongNumberProperty(),
 		},
@@ -259,9 +261,76 @@ func (n *NoteIndexer) BulkDelete(ctx context.Context, ids []string) error {
 	return common.DoBulkDelete(ctx, n.es, _noteIns.AliasIndex(), noteDocIds)
 }
 
+// 批量获取文档 不存在的文档不返回
+func (n *NoteIndexer) BatchGet(ctx context.Context, ids []string) ([]*Note, error) {
+	if len(ids) == 0 {
+		return []*Note{}, nil
+	}
+
+	noteDocIds := make([]string, 0, len(ids))
+	for _, id := range ids {
+		noteDocIds = append(noteDocIds, fmtNoteDocIdString(id))
+	}
+
+	resp, err := n.es.Search().
+		Index(_noteIns.AliasIndex()).
+		Query(&types.Query{
+			Ids: &types.IdsQuery{Values: noteDocIds},
+		}).
+		Size(len(noteDocIds)).
+		Do(ctx)
+	if err != nil {
+		return nil, xelaserror.Convert(err)
+	}
+
+	notes := make([]*Note, 0, len(resp.Hits.Hits))
+	for _, hit := range resp.Hits.Hits {
+		var note Note
+		if err := json.Unmarshal(hit.Source_, &note); err != nil {
+			xlog.Msg("note indexer unmarshal note doc failed").Err(err).Errorx(ctx)
+			continue
+		}
+		notes = append(notes, &note)
+	}
+
+	return notes, nil
+}
+
+// 按note_id升序分页获取文档的note_id afterId为空时从头开始
+func (n *NoteIndexer) ListIds(ctx context.Context, afterId string, count int) ([]string, error) {
+	query := n.es.Search().
+		Index(_noteIns.AliasIndex()).
+		Query(&types.Query{
+			MatchAll: &types.MatchAllQuery{},
+		}).
+		Sort(noteSortByNoteIdAsc).
+		Source_(noteSearchResultIncludes).
+		Size(count)
+	if len(afterId) > 0 {
+		query.SearchAfter(afterId)
+	}
+
+	resp, err := query.Do(ctx)
+	if err != nil {
+		return nil, xelaserror.Convert(err)
+	}
+
+	noteIds := make([]string, 0, len(resp.Hits.Hits))
+	for _, hit := range resp.Hits.Hits {
+		var nid NoteSearchResult
+		if err := json.Unmarshal(hit.Source_, &nid); err != nil {
+			xlog.Msg("note indexer unmarshal note id failed").Err(err).Errorx(ctx)
+			continue
+		}
+		noteIds = append(noteIds, nid.NoteId)
+	}
+
+	return noteIds, nil
+}
+
 var (
 	// search querys
-	noteVisibilityFilter = types.Query{
+	notePublicFilter = types.Query{
 		Term: map[string]types.TermQuery{"visibility": {Value: pkg.VisibilityPublic}},
 	}
 
@@ -281,6 +350,14 @@ var (
 		},
 	}
 
+	noteSortByNoteIdAsc = types.SortOptions{
+		SortOptions: map[string]types.FieldSort{
+			"note_id": {
+				Order: &sortorder.Asc,
+			},
+		},
+	}
+
 	noteDefaultSortByScore = types.SortOptions{
 		Score_: &types.ScoreSort{Order: &sortorder.Desc},
 	}
@@ -348,6 +425,7 @@ type searchNoteOption struct {
 	sortBy         string
 	filterNoteType string
 	filterPubTime  string
+	viewerUid      int64
 }
 
 // 排序规则
@@ -377,6 +455,43 @@ func (o *searchNoteOption) sortRule() []types.SortCombinations {
 	return noteDefaultSort
 }
 
+// 可见范围过滤
+//
+// 未登录只能搜到公开笔记; 登录后还可以搜到自己的笔记、指定自己可见的笔记以及粉丝/互关可见的笔记
+// 粉丝/互关可见的笔记需要调用方结合关注关系再次过滤
+func (o *searchNoteOption) visibilityFilter() types.Query {
+	if o.viewerUid == 0 {
+		return notePublicFilter
+	}
+
+	return types.Query{
+		Bool: &types.BoolQuery{
+			Should: []types.Query{
+				notePublicFilter,
+				{
+					Term: map[string]types.TermQuery{"author.uid": {Value: o.viewerUid}},
+				},
+				{
+					Terms: &types.TermsQuery{
+						TermsQuery: map[string]types.TermsQueryField{
+							"visibility": []types.FieldValue{pkg.VisibilityFollowers, pkg.VisibilityMutual},
+						},
+					},
+				},
+				{
+					Bool: &types.BoolQuery{
+						Filter: []types.Query{
+							{Term: map[string]types.TermQuery{"visibility": {Value: pkg.VisibilityCustom}}},
+							{Term: map[string]types.TermQuery{"audience_uids": {Value: o.viewerUid}}},
+						},
+					},
+				},
+			},
+			MinimumShouldMatch: 1,
+		},
+	}
+}
+
 func (searchNoteOption) assetTypeFilter(assetType string) types.Query {
 	return types.Query{
 		Terms: &types.TermsQuery{
@@ -464,6 +579,13 @@ func WithSearchNotePubTime(s string) SearchNoteOption {
 	}
 }
 
+// 搜索者 用于过滤搜索者不可见的笔记
+func WithSearchNoteViewer(uid int64) SearchNoteOption {
+	return func(o *searchNoteOption) {
+		o.viewerUid = uid
+	}
+}
+
 func WithSearchNotePubInOneDay() SearchNoteOption {
 	return WithSearchNotePubTime(pkg.NoteFilterPubTimeInOneDay)
 }
@@ -554,7 +676,7 @@ func (n *NoteIndexer) Search(ctx context.Context, keyword,
 	shouldQuery = append(shouldQuery, tagListQuery...)
 	shouldQuery = append(shouldQuery, authorQuery...)
 
-	filters := []types.Query{noteVisibilityFilter}
+	filters := []types.Query{opt.visibilityFilter()}
 	filters = append(filters, opt.filterRule()...)
 
 	boolQuery := types.BoolQuery{
@@ -63,12 +63,54 @@ func makeIndexNote(n *searchv1.Note) *noteindex.Note {
 			Uid:      n.Author.Uid,
 			Nickname: n.Author.Nickname,
 		},
-		TagList:    indexTags,
-		AssetType:  pkg.NoteAssetConverter[n.GetAssetType()],
-		Visibility: pkg.NoteVisibilityConverter[n.GetVisibility()],
+		TagList:       indexTags,
+		AssetType:     pkg.NoteAssetConverter[n.GetAssetType()],
+		Visibility:    pkg.NoteVisibilityConverter[n.GetVisibility()],
+		AudienceUids:  n.GetAudienceUids(),
+		LikesCount:    n.LikesCount,
+		CommentsCount: n.CommentsCount,
 	}
 }
 
+func makePbNote(n *noteindex.Note) *searchv1.Note {
+	tags := make([]*searchv1.NoteTag, 0, len(n.TagList))
+	for _, t := range n.TagList {
+		tags = append(tags, &searchv1.NoteTag{
+			Id:    t.Id,
+			Name:  t.Name,
+			Ctime: t.Ctime,
+		})
+	}
+
+	note := &searchv1.Note{
+		NoteId:   n.NoteId,
+		Title:    n.Title,
+		Desc:     n.Desc,
+		CreateAt: n.CreateAt,
+		UpdateAt: n.UpdateAt,
+		Author: &searchv1.Note_Author{
+			Uid:      n.Author.Uid,
+			Nickname: n.Author.Nickname,
+		},
+		TagList:       tags,
+		AudienceUids:  n.AudienceUids,
+		LikesCount:    n.LikesCount,
+		CommentsCount: n.CommentsCount,
+	}
+	for k, v := range pkg.NoteAssetConverter {
+		if v == n.AssetType && k != searchv1.Note_ASSET_TYPE_UNSPECIFIED {
+			note.AssetType = k
+		}
+	}
+	for k, v := range pkg.NoteVisibilityConverter {
+		if v == n.Visibility {
+			note.Visibility = k
+		}
+	}
+
+	return note
+}
+
 // 异步提交写入
 func (s *DocumentService) AddNoteTagDocs(ctx context.Context, tags []*searchv1.NoteTag) error {
 	indexTags := make([]*noteindex.NoteTag, 0, len(tags))
@@ -106,6 +148,38 @@ func (s *DocumentService) UpdateNoteDocLikeCount(ctx context.Context, reqs map[s
 	return infra.KafkaDao().NoteEventProducer.PutNoteLikeEvent(ctx, reqs)
 }
 
+// 直接从es中批量获取笔记文档
+func (s *DocumentService) GetNoteDocs(ctx context.Context, ids []string) (map[string]*searchv1.Note, error) {
+	notes, err := infra.EsDao().NoteIndexer.BatchGet(ctx, ids)
+	if err != nil {
+		return nil, xerror.Wrapf(err, "document get note docs failed").WithExtra("count", len(ids)).WithCtx(ctx)
+	}
+
+	result := make(map[string]*searchv1.Note, len(notes))
+	for _, n := range notes {
+		result[n.NoteId] = makePbNote(n)
+	}
+
+	return result, nil
+}
+
+// 按note_id顺序分页获取es中的笔记文档id
+func (s *DocumentService) ListNoteDocIds(ctx context.Context, cursor string, count int32) (
+	ids []string, nextCursor string, hasNext bool, err error,
+) {
+	ids, err = infra.EsDao().NoteIndexer.ListIds(ctx, cursor, int(count))
+	if err != nil {
+		return nil, "", false, xerror.Wrapf(err, "document list note doc ids failed").
+			WithExtras("cursor", cursor, "count", count).WithCtx(ctx)
+	}
+
+	if len(ids) > 0 {
+		nextCursor = ids[len(ids)-1]
+	}
+
+	return ids, nextCursor, len(ids) == int(count), nil
+}
+
 func (s *DocumentService) UpdateNoteDocCommentCount(ctx context.Context, reqs map[string]int64) error {
 	return infra.KafkaDao().NoteEventProducer.PutNoteCommentEvent(ctx, reqs)
 }
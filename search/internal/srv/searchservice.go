@@ -40,6 +40,7 @@ func (s *SearchService) SearchNotes(ctx context.Context, in *searchv1.SearchNote
 			filters = append(filters, noteindex.WithSearchNotePubTime(filterValue))
 		}
 	}
+	filters = append(filters, noteindex.WithSearchNoteViewer(in.ViewerUid))
 
 	res, err := infra.EsDao().NoteIndexer.Search(ctx,
 		in.Keyword, in.PageToken, in.Count,
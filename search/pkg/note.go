@@ -21,14 +21,20 @@ var (
 type NoteVisibility string
 
 const (
-	VisibilityPublic  NoteVisibility = "public"
-	VisibilityPrivate NoteVisibility = "private"
+	VisibilityPublic    NoteVisibility = "public"
+	VisibilityPrivate   NoteVisibility = "private"
+	VisibilityFollowers NoteVisibility = "followers" // 仅粉丝可见
+	VisibilityMutual    NoteVisibility = "mutual"    // 仅互关好友可见
+	VisibilityCustom    NoteVisibility = "custom"    // 仅指定用户可见
 )
 
 var (
 	NoteVisibilityConverter = map[searchv1.Note_Visibility]NoteVisibility{
-		searchv1.Note_VISIBILITY_PUBLIC:  VisibilityPublic,
-		searchv1.Note_VISIBILITY_PRIVATE: VisibilityPrivate,
+		searchv1.Note_VISIBILITY_PUBLIC:    VisibilityPublic,
+		searchv1.Note_VISIBILITY_PRIVATE:   VisibilityPrivate,
+		searchv1.Note_VISIBILITY_FOLLOWERS: VisibilityFollowers,
+		searchv1.Note_VISIBILITY_MUTUAL:    VisibilityMutual,
+		searchv1.Note_VISIBILITY_CUSTOM:    VisibilityCustom,
 	}
 )
 
@@ -19,3 +19,115 @@ CREATE TABLE IF NOT EXISTS note_asset (
 	PRIMARY KEY (`id`),
 	KEY idx_note_id(`note_id`) 
 )ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='笔记资源表';
+
+CREATE TABLE IF NOT EXISTS note_draft (
+	`note_id` BIGINT NOT NULL COMMENT '笔记id',
+	`publish_at` BIGINT NOT NULL DEFAULT 0 COMMENT '定时发布时间',
+	`video_raw_url` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '原始视频fileId',
+	`ctime` BIGINT NOT NULL DEFAULT 0 COMMENT '创建时间',
+	`utime` BIGINT NOT NULL DEFAULT 0 COMMENT '更新时间',
+	PRIMARY KEY (`note_id`)
+)ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='笔记草稿表';
+
+CREATE TABLE IF NOT EXISTS note_audit (
+	`note_id` BIGINT NOT NULL COMMENT '笔记id',
+	`task_id` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '机审流程taskId',
+	`decision` TINYINT NOT NULL DEFAULT 0 COMMENT '审核结论',
+	`reason` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '审核原因',
+	`provider` VARCHAR(32) NOT NULL DEFAULT '' COMMENT '审核提供方',
+	`operator` BIGINT NOT NULL DEFAULT 0 COMMENT '人工审核操作人 0表示机审',
+	`ctime` BIGINT NOT NULL DEFAULT 0 COMMENT '创建时间',
+	`utime` BIGINT NOT NULL DEFAULT 0 COMMENT '更新时间',
+	PRIMARY KEY (`note_id`)
+)ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='笔记审核结果表';
+
+CREATE TABLE IF NOT EXISTS note_ext (
+	`note_id` BIGINT NOT NULL COMMENT '笔记id',
+	`tags` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '标签id 逗号分隔',
+	`at_users` JSON DEFAULT NULL COMMENT '@用户',
+	`audience` TEXT COMMENT '指定可见的用户id 逗号分隔',
+	`ctime` BIGINT NOT NULL DEFAULT 0 COMMENT '创建时间',
+	`utime` BIGINT NOT NULL DEFAULT 0 COMMENT '更新时间',
+	PRIMARY KEY (`note_id`)
+)ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='笔记扩展信息表';
+
+-- 已有数据从note_ext回填:
+-- INSERT IGNORE INTO note_tag(tag_id,note_id,ctime) SELECT t.tag_id,e.note_id,e.ctime FROM note_ext e,
+--   JSON_TABLE(CONCAT('[',e.tags,']'),'$[*]' COLUMNS(tag_id BIGINT PATH '$')) t WHERE e.tags<>'';
+CREATE TABLE IF NOT EXISTS note_tag (
+	`tag_id` BIGINT NOT NULL COMMENT '标签id',
+	`note_id` BIGINT NOT NULL COMMENT '笔记id',
+	`ctime` BIGINT NOT NULL DEFAULT 0 COMMENT '创建时间',
+	PRIMARY KEY (`tag_id`, `note_id`),
+	KEY idx_note_id(`note_id`)
+)ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='笔记和标签关联表';
+
+CREATE TABLE IF NOT EXISTS note_revision (
+	`id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+	`note_id` BIGINT NOT NULL COMMENT '笔记id',
+	`version` BIGINT NOT NULL DEFAULT 0 COMMENT '版本号',
+	`content` JSON NOT NULL COMMENT '版本完整内容',
+	`diff` JSON NOT NULL COMMENT '和上一个版本的变更',
+	`prev_state` TINYINT NOT NULL DEFAULT 0 COMMENT '更新前的笔记状态',
+	`ctime` BIGINT NOT NULL DEFAULT 0 COMMENT '创建时间',
+	PRIMARY KEY (`id`),
+	UNIQUE KEY `uk_note_version` (`note_id`, `version`)
+)ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='笔记修订版本表';
+
+CREATE TABLE IF NOT EXISTS note_recycle (
+	`note_id` BIGINT NOT NULL COMMENT '笔记id',
+	`prev_state` TINYINT NOT NULL DEFAULT 0 COMMENT '删除前的笔记状态',
+	`delete_at` BIGINT NOT NULL DEFAULT 0 COMMENT '删除时间',
+	`expire_at` BIGINT NOT NULL DEFAULT 0 COMMENT '彻底删除时间',
+	PRIMARY KEY (`note_id`),
+	KEY idx_expire_at(`expire_at`)
+)ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='笔记回收站表';
+
+CREATE TABLE IF NOT EXISTS note_view (
+	`note_id` BIGINT NOT NULL COMMENT '笔记id',
+	`views` BIGINT NOT NULL DEFAULT 0 COMMENT '浏览量',
+	`utime` BIGINT NOT NULL DEFAULT 0 COMMENT '更新时间',
+	PRIMARY KEY (`note_id`)
+)ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='笔记浏览量表';
+
+CREATE TABLE IF NOT EXISTS note_view_flush (
+	`batch_id` VARCHAR(32) NOT NULL COMMENT '缓存中浏览量汇总批次id',
+	`ctime` BIGINT NOT NULL DEFAULT 0 COMMENT '汇总时间',
+	PRIMARY KEY (`batch_id`),
+	KEY `idx_ctime` (`ctime`)
+)ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='笔记浏览量汇总批次表';
+
+CREATE TABLE IF NOT EXISTS note_collection (
+	`id` BIGINT NOT NULL AUTO_INCREMENT,
+	`owner` BIGINT NOT NULL COMMENT '合集作者',
+	`title` VARCHAR(128) NOT NULL DEFAULT '' COMMENT '合集标题',
+	`desc` VARCHAR(1024) NOT NULL DEFAULT '' COMMENT '合集描述',
+	`privacy` TINYINT NOT NULL DEFAULT 1 COMMENT '可见范围',
+	`cover` BIGINT NOT NULL DEFAULT 0 COMMENT '作为封面的笔记id',
+	`ctime` BIGINT NOT NULL DEFAULT 0 COMMENT '创建时间',
+	`utime` BIGINT NOT NULL DEFAULT 0 COMMENT '更新时间',
+	PRIMARY KEY (`id`),
+	KEY idx_owner(`owner`)
+)ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='笔记合集表';
+
+CREATE TABLE IF NOT EXISTS note_collection_item (
+	`note_id` BIGINT NOT NULL COMMENT '笔记id 一篇笔记只能属于一个合集',
+	`collection_id` BIGINT NOT NULL COMMENT '合集id',
+	`seq` BIGINT NOT NULL DEFAULT 0 COMMENT '笔记在合集中的顺序',
+	`ctime` BIGINT NOT NULL DEFAULT 0 COMMENT '加入合集的时间',
+	PRIMARY KEY (`note_id`),
+	KEY idx_collection_seq(`collection_id`, `seq`)
+)ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='笔记合集内容表';
+
+CREATE TABLE IF NOT EXISTS note_outbox (
+	`id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+	`topic` VARCHAR(128) NOT NULL DEFAULT '' COMMENT '消息topic',
+	`msg_key` VARBINARY(255) NOT NULL DEFAULT '' COMMENT '消息key',
+	`msg_value` MEDIUMBLOB NOT NULL COMMENT '消息内容',
+	`uid` BIGINT NOT NULL DEFAULT 0 COMMENT '写入时的操作用户',
+	`state` TINYINT NOT NULL DEFAULT 0 COMMENT '0-待投递 1-已投递',
+	`ctime` BIGINT NOT NULL DEFAULT 0 COMMENT '创建时间',
+	`utime` BIGINT NOT NULL DEFAULT 0 COMMENT '更新时间',
+	PRIMARY KEY (`id`),
+	KEY idx_state_id(`state`, `id`)
+)ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='笔记事件发件箱';